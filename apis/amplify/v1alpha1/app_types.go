@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CustomRule is a redirect or rewrite rule of an app.
+type CustomRule struct {
+	// Source address pattern of the rule.
+	Source string `json:"source"`
+
+	// Target address of the rule.
+	Target string `json:"target"`
+
+	// Status code of the rule, e.g. 200 for a rewrite or 301 for a redirect.
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// Condition of the rule, such as a country code.
+	// +optional
+	Condition *string `json:"condition,omitempty"`
+}
+
+// AppParameters define the desired state of an AWS Amplify app.
+type AppParameters struct {
+	// Name of the app.
+	Name string `json:"name"`
+
+	// Description of the app.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Repository is the URL of the Git repository of the app.
+	// +optional
+	Repository *string `json:"repository,omitempty"`
+
+	// AccessTokenSecretRef references the key of a secret that holds the
+	// personal access token used to connect a GitHub repository.
+	// +immutable
+	// +optional
+	AccessTokenSecretRef *runtimev1alpha1.SecretKeySelector `json:"accessTokenSecretRef,omitempty"`
+
+	// OAuthTokenSecretRef references the key of a secret that holds the
+	// OAuth token used to connect a Bitbucket or CodeCommit repository.
+	// +immutable
+	// +optional
+	OAuthTokenSecretRef *runtimev1alpha1.SecretKeySelector `json:"oauthTokenSecretRef,omitempty"`
+
+	// BuildSpec is the YAML build specification of the app.
+	// +optional
+	BuildSpec *string `json:"buildSpec,omitempty"`
+
+	// EnvironmentVariables of the builds of the app.
+	// +optional
+	EnvironmentVariables map[string]string `json:"environmentVariables,omitempty"`
+
+	// CustomRules are the redirect and rewrite rules of the app.
+	// +optional
+	CustomRules []CustomRule `json:"customRules,omitempty"`
+
+	// EnableBranchAutoBuild enables automated builds of the branches of the
+	// app.
+	// +optional
+	EnableBranchAutoBuild *bool `json:"enableBranchAutoBuild,omitempty"`
+
+	// EnableAutoBranchCreation enables the automated creation of branches
+	// whose name matches one of the AutoBranchCreationPatterns.
+	// +optional
+	EnableAutoBranchCreation *bool `json:"enableAutoBranchCreation,omitempty"`
+
+	// AutoBranchCreationPatterns are the glob patterns of the names of the
+	// branches created automatically.
+	// +optional
+	AutoBranchCreationPatterns []string `json:"autoBranchCreationPatterns,omitempty"`
+
+	// IAMServiceRoleARN is the ARN of the IAM role Amplify assumes to build
+	// and deploy the app.
+	// +optional
+	IAMServiceRoleARN *string `json:"iamServiceRoleArn,omitempty"`
+
+	// IAMServiceRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	IAMServiceRoleARNRef *runtimev1alpha1.Reference `json:"iamServiceRoleArnRef,omitempty"`
+
+	// IAMServiceRoleARNSelector selects a reference to an IAMRole to retrieve
+	// its ARN.
+	// +optional
+	IAMServiceRoleARNSelector *runtimev1alpha1.Selector `json:"iamServiceRoleArnSelector,omitempty"`
+
+	// Tags to assign to the app when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An AppSpec defines the desired state of an App.
+type AppSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AppParameters `json:"forProvider"`
+}
+
+// AppObservation keeps the state for the external resource
+type AppObservation struct {
+	// AppARN is the ARN of the app.
+	AppARN string `json:"appArn,omitempty"`
+
+	// DefaultDomain is the domain the branches of the app are served from
+	// by default, as <branch>.<defaultDomain>.
+	DefaultDomain string `json:"defaultDomain,omitempty"`
+}
+
+// An AppStatus represents the observed state of an App.
+type AppStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AppObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An App is a managed resource that represents an AWS Amplify app, which
+// builds and hosts a front-end from a Git repository. The external name of
+// the resource is the ID of the app assigned by Amplify.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".status.atProvider.defaultDomain"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type App struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppSpec   `json:"spec"`
+	Status AppStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppList contains a list of Apps
+type AppList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []App `json:"items"`
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// BranchParameters define the desired state of an AWS Amplify branch.
+type BranchParameters struct {
+	// AppID is the ID of the app of the branch.
+	// +immutable
+	// +optional
+	AppID *string `json:"appId,omitempty"`
+
+	// AppIDRef references an App to retrieve its ID.
+	// +optional
+	AppIDRef *runtimev1alpha1.Reference `json:"appIdRef,omitempty"`
+
+	// AppIDSelector selects a reference to an App to retrieve its ID.
+	// +optional
+	AppIDSelector *runtimev1alpha1.Selector `json:"appIdSelector,omitempty"`
+
+	// Description of the branch.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Stage of the branch.
+	// +kubebuilder:validation:Enum=PRODUCTION;BETA;DEVELOPMENT;EXPERIMENTAL;PULL_REQUEST
+	// +optional
+	Stage *string `json:"stage,omitempty"`
+
+	// Framework of the branch, e.g. React.
+	// +optional
+	Framework *string `json:"framework,omitempty"`
+
+	// BuildSpec is the YAML build specification of the branch, overriding
+	// the one of the app.
+	// +optional
+	BuildSpec *string `json:"buildSpec,omitempty"`
+
+	// EnvironmentVariables of the builds of the branch.
+	// +optional
+	EnvironmentVariables map[string]string `json:"environmentVariables,omitempty"`
+
+	// EnableAutoBuild enables builds on every push to the branch.
+	// +optional
+	EnableAutoBuild *bool `json:"enableAutoBuild,omitempty"`
+
+	// EnablePullRequestPreview enables previews of the pull requests opened
+	// against the branch.
+	// +optional
+	EnablePullRequestPreview *bool `json:"enablePullRequestPreview,omitempty"`
+
+	// EnableNotification enables notifications of the builds of the branch.
+	// +optional
+	EnableNotification *bool `json:"enableNotification,omitempty"`
+
+	// Tags to assign to the branch when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A BranchSpec defines the desired state of a Branch.
+type BranchSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  BranchParameters `json:"forProvider,omitempty"`
+}
+
+// BranchObservation keeps the state for the external resource
+type BranchObservation struct {
+	// BranchARN is the ARN of the branch.
+	BranchARN string `json:"branchArn,omitempty"`
+
+	// ActiveJobID is the ID of the build job in progress, if any.
+	ActiveJobID string `json:"activeJobId,omitempty"`
+
+	// CustomDomains of the branch.
+	CustomDomains []string `json:"customDomains,omitempty"`
+}
+
+// A BranchStatus represents the observed state of a Branch.
+type BranchStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     BranchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Branch is a managed resource that represents a branch of an AWS Amplify
+// app. The external name of the resource is the name of the Git branch.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STAGE",type="string",JSONPath=".spec.forProvider.stage"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Branch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchSpec   `json:"spec"`
+	Status BranchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchList contains a list of Branches
+type BranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Branch `json:"items"`
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SubDomainSetting maps a subdomain to a branch.
+type SubDomainSetting struct {
+	// Prefix of the subdomain, or an empty string for the domain itself.
+	Prefix string `json:"prefix"`
+
+	// BranchName is the name of the branch served from the subdomain.
+	BranchName string `json:"branchName"`
+}
+
+// DomainParameters define the desired state of an AWS Amplify domain
+// association.
+type DomainParameters struct {
+	// AppID is the ID of the app of the domain.
+	// +immutable
+	// +optional
+	AppID *string `json:"appId,omitempty"`
+
+	// AppIDRef references an App to retrieve its ID.
+	// +optional
+	AppIDRef *runtimev1alpha1.Reference `json:"appIdRef,omitempty"`
+
+	// AppIDSelector selects a reference to an App to retrieve its ID.
+	// +optional
+	AppIDSelector *runtimev1alpha1.Selector `json:"appIdSelector,omitempty"`
+
+	// SubDomainSettings map the subdomains of the domain to branches.
+	// +kubebuilder:validation:MinItems=1
+	SubDomainSettings []SubDomainSetting `json:"subDomainSettings"`
+
+	// EnableAutoSubDomain enables the automated creation of subdomains for
+	// branches.
+	// +optional
+	EnableAutoSubDomain *bool `json:"enableAutoSubDomain,omitempty"`
+}
+
+// A DomainSpec defines the desired state of a Domain.
+type DomainSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DomainParameters `json:"forProvider"`
+}
+
+// SubDomain is the observed state of a subdomain.
+type SubDomain struct {
+	// Prefix of the subdomain.
+	Prefix string `json:"prefix,omitempty"`
+
+	// BranchName is the name of the branch served from the subdomain.
+	BranchName string `json:"branchName,omitempty"`
+
+	// DNSRecord is the DNS record that points the subdomain to Amplify.
+	DNSRecord string `json:"dnsRecord,omitempty"`
+
+	// Verified is true once the DNS record of the subdomain is verified.
+	Verified bool `json:"verified,omitempty"`
+}
+
+// DomainObservation keeps the state for the external resource
+type DomainObservation struct {
+	// DomainAssociationARN is the ARN of the domain association.
+	DomainAssociationARN string `json:"domainAssociationArn,omitempty"`
+
+	// DomainStatus is the status of the domain association.
+	DomainStatus string `json:"domainStatus,omitempty"`
+
+	// StatusReason explains the status of the domain association.
+	StatusReason string `json:"statusReason,omitempty"`
+
+	// CertificateVerificationDNSRecord is the DNS record that must be
+	// created to verify the ownership of the domain.
+	CertificateVerificationDNSRecord string `json:"certificateVerificationDnsRecord,omitempty"`
+
+	// SubDomains of the domain association.
+	SubDomains []SubDomain `json:"subDomains,omitempty"`
+}
+
+// A DomainStatus represents the observed state of a Domain.
+type DomainStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Domain is a managed resource that represents the association of a
+// custom domain with an AWS Amplify app. The external name of the resource
+// is the domain name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.domainStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Domain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainSpec   `json:"spec"`
+	Status DomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainList contains a list of Domains
+type DomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Domain `json:"items"`
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ResolveReferences of this App
+func (mg *App) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.iamServiceRoleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IAMServiceRoleARN),
+		Reference:    mg.Spec.ForProvider.IAMServiceRoleARNRef,
+		Selector:     mg.Spec.ForProvider.IAMServiceRoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.IAMServiceRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IAMServiceRoleARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Branch
+func (mg *Branch) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.appId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AppID),
+		Reference:    mg.Spec.ForProvider.AppIDRef,
+		Selector:     mg.Spec.ForProvider.AppIDSelector,
+		To:           reference.To{Managed: &App{}, List: &AppList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.AppID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AppIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Domain
+func (mg *Domain) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.appId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AppID),
+		Reference:    mg.Spec.ForProvider.AppIDRef,
+		Selector:     mg.Spec.ForProvider.AppIDSelector,
+		To:           reference.To{Managed: &App{}, List: &AppList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.AppID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AppIDRef = rsp.ResolvedReference
+
+	return nil
+}
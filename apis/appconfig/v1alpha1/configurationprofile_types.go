@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Validator checks a configuration before it is deployed.
+type Validator struct {
+	// Type of the validator.
+	// +kubebuilder:validation:Enum=JSON_SCHEMA;LAMBDA
+	Type string `json:"type"`
+
+	// Content is either the JSON schema document or the ARN of the Lambda
+	// function, depending on the type of the validator.
+	Content string `json:"content"`
+}
+
+// ConfigurationProfileParameters define the desired state of an AWS AppConfig
+// configuration profile.
+type ConfigurationProfileParameters struct {
+	// ApplicationID is the ID of the application the configuration profile
+	// belongs to.
+	// +immutable
+	// +optional
+	ApplicationID *string `json:"applicationId,omitempty"`
+
+	// ApplicationIDRef references an Application to retrieve its ID.
+	// +immutable
+	// +optional
+	ApplicationIDRef *runtimev1alpha1.Reference `json:"applicationIdRef,omitempty"`
+
+	// ApplicationIDSelector selects a reference to an Application to retrieve
+	// its ID.
+	// +optional
+	ApplicationIDSelector *runtimev1alpha1.Selector `json:"applicationIdSelector,omitempty"`
+
+	// Name of the configuration profile.
+	Name string `json:"name"`
+
+	// Description of the configuration profile.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// LocationURI is the URI of the configuration, for example
+	// ssm-parameter://<parameter name>, ssm-document://<document name> or
+	// s3://<bucket>/<object key>.
+	// +immutable
+	LocationURI string `json:"locationUri"`
+
+	// RetrievalRoleARN is the ARN of an IAM role with permission to access
+	// the configuration at the location URI.
+	// +optional
+	RetrievalRoleARN *string `json:"retrievalRoleArn,omitempty"`
+
+	// RetrievalRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RetrievalRoleARNRef *runtimev1alpha1.Reference `json:"retrievalRoleArnRef,omitempty"`
+
+	// RetrievalRoleARNSelector selects a reference to an IAMRole to retrieve
+	// its ARN.
+	// +optional
+	RetrievalRoleARNSelector *runtimev1alpha1.Selector `json:"retrievalRoleArnSelector,omitempty"`
+
+	// Validators check the configuration before it is deployed.
+	// +optional
+	Validators []Validator `json:"validators,omitempty"`
+
+	// Tags to assign to the configuration profile when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ConfigurationProfileSpec defines the desired state of a
+// ConfigurationProfile.
+type ConfigurationProfileSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ConfigurationProfileParameters `json:"forProvider"`
+}
+
+// ConfigurationProfileObservation keeps the state for the external resource
+type ConfigurationProfileObservation struct {
+	// The configuration profile ID assigned by AppConfig.
+	ConfigurationProfileID string `json:"configurationProfileId,omitempty"`
+}
+
+// A ConfigurationProfileStatus represents the observed state of a
+// ConfigurationProfile.
+type ConfigurationProfileStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ConfigurationProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConfigurationProfile is a managed resource that represents an AWS
+// AppConfig configuration profile. The external name of the resource is the
+// configuration profile ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ConfigurationProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurationProfileSpec   `json:"spec"`
+	Status ConfigurationProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigurationProfileList contains a list of ConfigurationProfiles
+type ConfigurationProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConfigurationProfile `json:"items"`
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DeploymentStrategyParameters define the desired state of an AWS AppConfig
+// deployment strategy.
+type DeploymentStrategyParameters struct {
+	// Name of the deployment strategy.
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the deployment strategy.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DeploymentDurationInMinutes is the total amount of time for a
+	// deployment to last.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1440
+	DeploymentDurationInMinutes int64 `json:"deploymentDurationInMinutes"`
+
+	// FinalBakeTimeInMinutes is the amount of time AppConfig monitors for
+	// alarms before considering the deployment to be complete.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1440
+	// +optional
+	FinalBakeTimeInMinutes *int64 `json:"finalBakeTimeInMinutes,omitempty"`
+
+	// GrowthFactor is the percentage of targets to receive a deployed
+	// configuration during each interval, expressed as a decimal string
+	// between 1 and 100, e.g. "20" or "12.5".
+	GrowthFactor string `json:"growthFactor"`
+
+	// GrowthType is the algorithm used to define how the percentage grows
+	// over time.
+	// +kubebuilder:validation:Enum=LINEAR;EXPONENTIAL
+	// +optional
+	GrowthType *string `json:"growthType,omitempty"`
+
+	// ReplicateTo saves the deployment strategy to a Systems Manager (SSM)
+	// document.
+	// +kubebuilder:validation:Enum=NONE;SSM_DOCUMENT
+	// +immutable
+	ReplicateTo string `json:"replicateTo"`
+
+	// Tags to assign to the deployment strategy when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A DeploymentStrategySpec defines the desired state of a DeploymentStrategy.
+type DeploymentStrategySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DeploymentStrategyParameters `json:"forProvider"`
+}
+
+// DeploymentStrategyObservation keeps the state for the external resource
+type DeploymentStrategyObservation struct {
+	// The deployment strategy ID assigned by AppConfig.
+	DeploymentStrategyID string `json:"deploymentStrategyId,omitempty"`
+}
+
+// A DeploymentStrategyStatus represents the observed state of a
+// DeploymentStrategy.
+type DeploymentStrategyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DeploymentStrategyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DeploymentStrategy is a managed resource that represents an AWS AppConfig
+// deployment strategy. The external name of the resource is the deployment
+// strategy ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DeploymentStrategy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeploymentStrategySpec   `json:"spec"`
+	Status DeploymentStrategyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeploymentStrategyList contains a list of DeploymentStrategies
+type DeploymentStrategyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeploymentStrategy `json:"items"`
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Monitor is an Amazon CloudWatch alarm that AppConfig watches during a
+// deployment to the environment.
+type Monitor struct {
+	// ARN of the Amazon CloudWatch alarm.
+	// +optional
+	AlarmARN *string `json:"alarmArn,omitempty"`
+
+	// ARN of an IAM role for AppConfig to monitor the alarm.
+	// +optional
+	AlarmRoleARN *string `json:"alarmRoleArn,omitempty"`
+}
+
+// EnvironmentParameters define the desired state of an AWS AppConfig
+// environment.
+type EnvironmentParameters struct {
+	// ApplicationID is the ID of the application the environment belongs to.
+	// +immutable
+	// +optional
+	ApplicationID *string `json:"applicationId,omitempty"`
+
+	// ApplicationIDRef references an Application to retrieve its ID.
+	// +immutable
+	// +optional
+	ApplicationIDRef *runtimev1alpha1.Reference `json:"applicationIdRef,omitempty"`
+
+	// ApplicationIDSelector selects a reference to an Application to retrieve
+	// its ID.
+	// +optional
+	ApplicationIDSelector *runtimev1alpha1.Selector `json:"applicationIdSelector,omitempty"`
+
+	// Name of the environment.
+	Name string `json:"name"`
+
+	// Description of the environment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Monitors are the CloudWatch alarms AppConfig watches during
+	// deployments, rolling back when any of them goes into alarm.
+	// +optional
+	Monitors []Monitor `json:"monitors,omitempty"`
+
+	// Tags to assign to the environment when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An EnvironmentSpec defines the desired state of an Environment.
+type EnvironmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EnvironmentParameters `json:"forProvider"`
+}
+
+// EnvironmentObservation keeps the state for the external resource
+type EnvironmentObservation struct {
+	// The environment ID assigned by AppConfig.
+	EnvironmentID string `json:"environmentId,omitempty"`
+
+	// The state of the environment, e.g. ReadyForDeployment or Deploying.
+	State string `json:"state,omitempty"`
+}
+
+// An EnvironmentStatus represents the observed state of an Environment.
+type EnvironmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     EnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Environment is a managed resource that represents an AWS AppConfig
+// environment. The external name of the resource is the environment ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environments
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
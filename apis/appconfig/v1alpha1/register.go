@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "appconfig.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Application type metadata.
+var (
+	ApplicationKind             = reflect.TypeOf(Application{}).Name()
+	ApplicationGroupKind        = schema.GroupKind{Group: Group, Kind: ApplicationKind}.String()
+	ApplicationKindAPIVersion   = ApplicationKind + "." + SchemeGroupVersion.String()
+	ApplicationGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationKind)
+)
+
+// Environment type metadata.
+var (
+	EnvironmentKind             = reflect.TypeOf(Environment{}).Name()
+	EnvironmentGroupKind        = schema.GroupKind{Group: Group, Kind: EnvironmentKind}.String()
+	EnvironmentKindAPIVersion   = EnvironmentKind + "." + SchemeGroupVersion.String()
+	EnvironmentGroupVersionKind = SchemeGroupVersion.WithKind(EnvironmentKind)
+)
+
+// ConfigurationProfile type metadata.
+var (
+	ConfigurationProfileKind             = reflect.TypeOf(ConfigurationProfile{}).Name()
+	ConfigurationProfileGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigurationProfileKind}.String()
+	ConfigurationProfileKindAPIVersion   = ConfigurationProfileKind + "." + SchemeGroupVersion.String()
+	ConfigurationProfileGroupVersionKind = SchemeGroupVersion.WithKind(ConfigurationProfileKind)
+)
+
+// DeploymentStrategy type metadata.
+var (
+	DeploymentStrategyKind             = reflect.TypeOf(DeploymentStrategy{}).Name()
+	DeploymentStrategyGroupKind        = schema.GroupKind{Group: Group, Kind: DeploymentStrategyKind}.String()
+	DeploymentStrategyKindAPIVersion   = DeploymentStrategyKind + "." + SchemeGroupVersion.String()
+	DeploymentStrategyGroupVersionKind = SchemeGroupVersion.WithKind(DeploymentStrategyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Application{}, &ApplicationList{})
+	SchemeBuilder.Register(&Environment{}, &EnvironmentList{})
+	SchemeBuilder.Register(&ConfigurationProfile{}, &ConfigurationProfileList{})
+	SchemeBuilder.Register(&DeploymentStrategy{}, &DeploymentStrategyList{})
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ScalableTargetResourceID returns the spec.forProvider.resourceId of a
+// ScalableTarget.
+func ScalableTargetResourceID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		st, ok := mg.(*ScalableTarget)
+		if !ok {
+			return ""
+		}
+		return st.Spec.ForProvider.ResourceID
+	}
+}
+
+// ResolveReferences of this ScalableTarget
+func (mg *ScalableTarget) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.roleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RoleARN),
+		Reference:    mg.Spec.ForProvider.RoleARNRef,
+		Selector:     mg.Spec.ForProvider.RoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ScalingPolicy
+func (mg *ScalingPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ResourceID),
+		Reference:    mg.Spec.ForProvider.ResourceIDRef,
+		Selector:     mg.Spec.ForProvider.ResourceIDSelector,
+		To:           reference.To{Managed: &ScalableTarget{}, List: &ScalableTargetList{}},
+		Extract:      ScalableTargetResourceID(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.ResourceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ResourceIDRef = rsp.ResolvedReference
+
+	return nil
+}
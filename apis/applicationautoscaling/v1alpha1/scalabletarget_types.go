@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SuspendedState controls which scaling activities of a scalable target are
+// suspended.
+type SuspendedState struct {
+	// DynamicScalingInSuspended suspends scale in activities triggered by
+	// scaling policies.
+	// +optional
+	DynamicScalingInSuspended *bool `json:"dynamicScalingInSuspended,omitempty"`
+
+	// DynamicScalingOutSuspended suspends scale out activities triggered by
+	// scaling policies.
+	// +optional
+	DynamicScalingOutSuspended *bool `json:"dynamicScalingOutSuspended,omitempty"`
+
+	// ScheduledScalingSuspended suspends scaling activities that involve
+	// scheduled actions.
+	// +optional
+	ScheduledScalingSuspended *bool `json:"scheduledScalingSuspended,omitempty"`
+}
+
+// ScalableTargetParameters define the desired state of an Application Auto
+// Scaling scalable target.
+type ScalableTargetParameters struct {
+	// ServiceNamespace is the namespace of the AWS service that provides the
+	// resource, e.g. dynamodb, ecs or lambda.
+	// +immutable
+	// +kubebuilder:validation:Enum=ecs;elasticmapreduce;ec2;appstream;dynamodb;rds;sagemaker;custom-resource;comprehend;lambda;cassandra
+	ServiceNamespace string `json:"serviceNamespace"`
+
+	// ResourceID identifies the resource to scale, e.g. table/my-table for a
+	// DynamoDB table, service/my-cluster/my-service for an ECS service or
+	// function:my-function:my-alias for Lambda provisioned concurrency.
+	// +immutable
+	ResourceID string `json:"resourceId"`
+
+	// ScalableDimension is the property of the resource to scale, e.g.
+	// dynamodb:table:ReadCapacityUnits, ecs:service:DesiredCount or
+	// lambda:function:ProvisionedConcurrency.
+	// +immutable
+	ScalableDimension string `json:"scalableDimension"`
+
+	// MinCapacity is the lower bound the resource is scaled in to.
+	// +kubebuilder:validation:Minimum=0
+	MinCapacity int64 `json:"minCapacity"`
+
+	// MaxCapacity is the upper bound the resource is scaled out to.
+	// +kubebuilder:validation:Minimum=0
+	MaxCapacity int64 `json:"maxCapacity"`
+
+	// RoleARN is the ARN of the IAM role that allows Application Auto Scaling
+	// to modify the scalable target. The service-linked role of the service
+	// is used when omitted.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// SuspendedState suspends or resumes scaling activities of the target.
+	// +optional
+	SuspendedState *SuspendedState `json:"suspendedState,omitempty"`
+}
+
+// A ScalableTargetSpec defines the desired state of a ScalableTarget.
+type ScalableTargetSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ScalableTargetParameters `json:"forProvider"`
+}
+
+// ScalableTargetObservation keeps the state for the external resource.
+type ScalableTargetObservation struct {
+	// RoleARN is the ARN of the IAM role used by Application Auto Scaling
+	// for the target.
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// CreationTime is the time the scalable target was registered.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+}
+
+// A ScalableTargetStatus represents the observed state of a ScalableTarget.
+type ScalableTargetStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ScalableTargetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ScalableTarget is a managed resource that registers a resource, such as
+// a DynamoDB table, an ECS service or a Lambda alias, with Application Auto
+// Scaling.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".spec.forProvider.resourceId"
+// +kubebuilder:printcolumn:name="DIMENSION",type="string",JSONPath=".spec.forProvider.scalableDimension"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ScalableTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScalableTargetSpec   `json:"spec"`
+	Status ScalableTargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalableTargetList contains a list of ScalableTargets
+type ScalableTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScalableTarget `json:"items"`
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// StepAdjustment describes the scaling adjustment applied when the metric
+// breaching the alarm falls within a range.
+type StepAdjustment struct {
+	// MetricIntervalLowerBound is the lower bound of the range, relative to
+	// the alarm threshold and expressed as a decimal string, e.g. "10.5".
+	// The range is unbounded below when omitted.
+	// +optional
+	MetricIntervalLowerBound *string `json:"metricIntervalLowerBound,omitempty"`
+
+	// MetricIntervalUpperBound is the upper bound of the range, relative to
+	// the alarm threshold and expressed as a decimal string. The range is
+	// unbounded above when omitted.
+	// +optional
+	MetricIntervalUpperBound *string `json:"metricIntervalUpperBound,omitempty"`
+
+	// ScalingAdjustment is the amount by which to scale, interpreted
+	// according to the adjustment type.
+	ScalingAdjustment int64 `json:"scalingAdjustment"`
+}
+
+// StepScalingPolicyConfiguration configures a step scaling policy.
+type StepScalingPolicyConfiguration struct {
+	// AdjustmentType specifies whether the scaling adjustment is an absolute
+	// number or a percentage of the current capacity.
+	// +kubebuilder:validation:Enum=ChangeInCapacity;PercentChangeInCapacity;ExactCapacity
+	// +optional
+	AdjustmentType *string `json:"adjustmentType,omitempty"`
+
+	// Cooldown is the amount of time, in seconds, to wait after a scaling
+	// activity completes before another one can start.
+	// +optional
+	Cooldown *int64 `json:"cooldown,omitempty"`
+
+	// MetricAggregationType is the aggregation type for the CloudWatch
+	// metrics.
+	// +kubebuilder:validation:Enum=Average;Minimum;Maximum
+	// +optional
+	MetricAggregationType *string `json:"metricAggregationType,omitempty"`
+
+	// MinAdjustmentMagnitude is the minimum number to adjust the capacity by
+	// when the adjustment type is PercentChangeInCapacity.
+	// +optional
+	MinAdjustmentMagnitude *int64 `json:"minAdjustmentMagnitude,omitempty"`
+
+	// StepAdjustments is the set of adjustments that apply depending on the
+	// size of the alarm breach.
+	// +optional
+	StepAdjustments []StepAdjustment `json:"stepAdjustments,omitempty"`
+}
+
+// MetricDimension is a name and value pair of a CloudWatch metric dimension.
+type MetricDimension struct {
+	// Name of the dimension.
+	Name string `json:"name"`
+
+	// Value of the dimension.
+	Value string `json:"value"`
+}
+
+// CustomizedMetricSpecification describes a CloudWatch metric to track.
+type CustomizedMetricSpecification struct {
+	// Dimensions of the metric.
+	// +optional
+	Dimensions []MetricDimension `json:"dimensions,omitempty"`
+
+	// MetricName is the name of the metric.
+	MetricName string `json:"metricName"`
+
+	// Namespace is the namespace of the metric.
+	Namespace string `json:"namespace"`
+
+	// Statistic of the metric to track.
+	// +kubebuilder:validation:Enum=Average;Minimum;Maximum;SampleCount;Sum
+	Statistic string `json:"statistic"`
+
+	// Unit of the metric.
+	// +optional
+	Unit *string `json:"unit,omitempty"`
+}
+
+// PredefinedMetricSpecification describes a predefined metric to track.
+type PredefinedMetricSpecification struct {
+	// PredefinedMetricType is the metric type, e.g.
+	// DynamoDBReadCapacityUtilization, ECSServiceAverageCPUUtilization or
+	// LambdaProvisionedConcurrencyUtilization.
+	PredefinedMetricType string `json:"predefinedMetricType"`
+
+	// ResourceLabel identifies the target group for the
+	// ALBRequestCountPerTarget metric type.
+	// +optional
+	ResourceLabel *string `json:"resourceLabel,omitempty"`
+}
+
+// TargetTrackingScalingPolicyConfiguration configures a target tracking
+// scaling policy.
+type TargetTrackingScalingPolicyConfiguration struct {
+	// CustomizedMetricSpecification is a CloudWatch metric to track.
+	// +optional
+	CustomizedMetricSpecification *CustomizedMetricSpecification `json:"customizedMetricSpecification,omitempty"`
+
+	// DisableScaleIn prevents the policy from scaling in the target.
+	// +optional
+	DisableScaleIn *bool `json:"disableScaleIn,omitempty"`
+
+	// PredefinedMetricSpecification is a predefined metric to track.
+	// +optional
+	PredefinedMetricSpecification *PredefinedMetricSpecification `json:"predefinedMetricSpecification,omitempty"`
+
+	// ScaleInCooldown is the amount of time, in seconds, after a scale in
+	// activity completes before another one can start.
+	// +optional
+	ScaleInCooldown *int64 `json:"scaleInCooldown,omitempty"`
+
+	// ScaleOutCooldown is the amount of time, in seconds, after a scale out
+	// activity completes before another one can start.
+	// +optional
+	ScaleOutCooldown *int64 `json:"scaleOutCooldown,omitempty"`
+
+	// TargetValue is the value the metric is kept at or close to, expressed
+	// as a decimal string, e.g. "70.0".
+	TargetValue string `json:"targetValue"`
+}
+
+// ScalingPolicyParameters define the desired state of an Application Auto
+// Scaling policy.
+type ScalingPolicyParameters struct {
+	// ServiceNamespace is the namespace of the AWS service that provides the
+	// scaled resource.
+	// +immutable
+	// +kubebuilder:validation:Enum=ecs;elasticmapreduce;ec2;appstream;dynamodb;rds;sagemaker;custom-resource;comprehend;lambda;cassandra
+	ServiceNamespace string `json:"serviceNamespace"`
+
+	// ResourceID identifies the scaled resource. It must already be
+	// registered as a scalable target.
+	// +immutable
+	// +optional
+	ResourceID *string `json:"resourceId,omitempty"`
+
+	// ResourceIDRef references a ScalableTarget to retrieve its resource ID.
+	// +optional
+	ResourceIDRef *runtimev1alpha1.Reference `json:"resourceIdRef,omitempty"`
+
+	// ResourceIDSelector selects a reference to a ScalableTarget to retrieve
+	// its resource ID.
+	// +optional
+	ResourceIDSelector *runtimev1alpha1.Selector `json:"resourceIdSelector,omitempty"`
+
+	// ScalableDimension is the scaled property of the resource.
+	// +immutable
+	ScalableDimension string `json:"scalableDimension"`
+
+	// PolicyType is the type of the scaling policy.
+	// +kubebuilder:validation:Enum=StepScaling;TargetTrackingScaling
+	PolicyType string `json:"policyType"`
+
+	// StepScalingPolicyConfiguration is required when the policy type is
+	// StepScaling.
+	// +optional
+	StepScalingPolicyConfiguration *StepScalingPolicyConfiguration `json:"stepScalingPolicyConfiguration,omitempty"`
+
+	// TargetTrackingScalingPolicyConfiguration is required when the policy
+	// type is TargetTrackingScaling.
+	// +optional
+	TargetTrackingScalingPolicyConfiguration *TargetTrackingScalingPolicyConfiguration `json:"targetTrackingScalingPolicyConfiguration,omitempty"`
+}
+
+// A ScalingPolicySpec defines the desired state of a ScalingPolicy.
+type ScalingPolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ScalingPolicyParameters `json:"forProvider"`
+}
+
+// Alarm is a CloudWatch alarm associated with a scaling policy.
+type Alarm struct {
+	// AlarmARN is the ARN of the alarm.
+	AlarmARN string `json:"alarmArn"`
+
+	// AlarmName is the name of the alarm.
+	AlarmName string `json:"alarmName"`
+}
+
+// ScalingPolicyObservation keeps the state for the external resource.
+type ScalingPolicyObservation struct {
+	// PolicyARN is the ARN of the scaling policy.
+	PolicyARN string `json:"policyArn,omitempty"`
+
+	// Alarms are the CloudWatch alarms associated with the policy. Target
+	// tracking policies create and manage their own alarms.
+	Alarms []Alarm `json:"alarms,omitempty"`
+}
+
+// A ScalingPolicyStatus represents the observed state of a ScalingPolicy.
+type ScalingPolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ScalingPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ScalingPolicy is a managed resource that represents an Application Auto
+// Scaling policy for a scalable target.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.policyType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ScalingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScalingPolicySpec   `json:"spec"`
+	Status ScalingPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalingPolicyList contains a list of ScalingPolicies
+type ScalingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScalingPolicy `json:"items"`
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// QueuePolicyParameters define the desired state of the access policy of an
+// AWS SQS queue.
+type QueuePolicyParameters struct {
+	// QueueURL is the URL of the queue the policy is attached to.
+	// +immutable
+	// +optional
+	QueueURL *string `json:"queueUrl,omitempty"`
+
+	// QueueURLRef references a Queue to retrieve its URL.
+	// +optional
+	QueueURLRef *runtimev1alpha1.Reference `json:"queueUrlRef,omitempty"`
+
+	// QueueURLSelector selects a reference to a Queue to retrieve its URL.
+	// +optional
+	QueueURLSelector *runtimev1alpha1.Selector `json:"queueUrlSelector,omitempty"`
+
+	// Policy is the JSON access policy document of the queue, e.g. to allow
+	// an SNS topic of another account to send messages to it.
+	Policy string `json:"policy"`
+}
+
+// QueuePolicySpec defines the desired state of a QueuePolicy.
+type QueuePolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  QueuePolicyParameters `json:"forProvider"`
+}
+
+// QueuePolicyStatus represents the observed state of a QueuePolicy.
+type QueuePolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A QueuePolicy is a managed resource that represents the access policy of an
+// AWS SQS queue. The queue itself is managed separately, e.g. by a Queue.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type QueuePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QueuePolicySpec   `json:"spec"`
+	Status QueuePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QueuePolicyList contains a list of QueuePolicy
+type QueuePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QueuePolicy `json:"items"`
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// QueueURL returns the status.atProvider.URL of a Queue.
+func QueueURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Queue)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.URL
+	}
+}
+
+// QueueARN returns the status.atProvider.ARN of a Queue.
+func QueueARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Queue)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
+// ResolveReferences of this Queue
+func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.RedrivePolicy == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.redrivePolicy.deadLetterQueueARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RedrivePolicy.DeadLetterQueueARN),
+		Reference:    mg.Spec.ForProvider.RedrivePolicy.DeadLetterQueueARNRef,
+		Selector:     mg.Spec.ForProvider.RedrivePolicy.DeadLetterQueueARNSelector,
+		To:           reference.To{Managed: &Queue{}, List: &QueueList{}},
+		Extract:      QueueARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.RedrivePolicy.DeadLetterQueueARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RedrivePolicy.DeadLetterQueueARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this QueuePolicy
+func (mg *QueuePolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.queueUrl
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.QueueURL),
+		Reference:    mg.Spec.ForProvider.QueueURLRef,
+		Selector:     mg.Spec.ForProvider.QueueURLSelector,
+		To:           reference.To{Managed: &Queue{}, List: &QueueList{}},
+		Extract:      QueueURL(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.QueueURL = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.QueueURLRef = rsp.ResolvedReference
+
+	return nil
+}
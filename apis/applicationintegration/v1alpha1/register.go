@@ -45,6 +45,15 @@ var (
 	QueueGroupVersionKind = SchemeGroupVersion.WithKind(QueueKind)
 )
 
+// QueuePolicy type metadata.
+var (
+	QueuePolicyKind             = reflect.TypeOf(QueuePolicy{}).Name()
+	QueuePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: QueuePolicyKind}.String()
+	QueuePolicyKindAPIVersion   = QueuePolicyKind + "." + SchemeGroupVersion.String()
+	QueuePolicyGroupVersionKind = SchemeGroupVersion.WithKind(QueuePolicyKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Queue{}, &QueueList{})
+	SchemeBuilder.Register(&QueuePolicy{}, &QueuePolicyList{})
 }
@@ -64,6 +64,15 @@ type RedrivePolicy struct {
 	// +optional
 	DeadLetterQueueARN *string `json:"deadLetterQueueARN,omitempty"`
 
+	// DeadLetterQueueARNRef references a Queue to retrieve its ARN.
+	// +optional
+	DeadLetterQueueARNRef *runtimev1alpha1.Reference `json:"deadLetterQueueARNRef,omitempty"`
+
+	// DeadLetterQueueARNSelector selects a reference to a Queue to retrieve
+	// its ARN.
+	// +optional
+	DeadLetterQueueARNSelector *runtimev1alpha1.Selector `json:"deadLetterQueueARNSelector,omitempty"`
+
 	// The number of times a message is delivered to the source queue before
 	// being moved to the dead-letter queue.
 	// +optional
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -165,6 +166,128 @@ func (in *QueueParameters) DeepCopy() *QueueParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicy) DeepCopyInto(out *QueuePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicy.
+func (in *QueuePolicy) DeepCopy() *QueuePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueuePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyList) DeepCopyInto(out *QueuePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QueuePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyList.
+func (in *QueuePolicyList) DeepCopy() *QueuePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueuePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyParameters) DeepCopyInto(out *QueuePolicyParameters) {
+	*out = *in
+	if in.QueueURL != nil {
+		in, out := &in.QueueURL, &out.QueueURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueURLRef != nil {
+		in, out := &in.QueueURLRef, &out.QueueURLRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.QueueURLSelector != nil {
+		in, out := &in.QueueURLSelector, &out.QueueURLSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyParameters.
+func (in *QueuePolicyParameters) DeepCopy() *QueuePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicySpec) DeepCopyInto(out *QueuePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicySpec.
+func (in *QueuePolicySpec) DeepCopy() *QueuePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyStatus) DeepCopyInto(out *QueuePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyStatus.
+func (in *QueuePolicyStatus) DeepCopy() *QueuePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 	*out = *in
@@ -207,6 +330,16 @@ func (in *RedrivePolicy) DeepCopyInto(out *RedrivePolicy) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeadLetterQueueARNRef != nil {
+		in, out := &in.DeadLetterQueueARNRef, &out.DeadLetterQueueARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DeadLetterQueueARNSelector != nil {
+		in, out := &in.DeadLetterQueueARNSelector, &out.DeadLetterQueueARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MaxReceiveCount != nil {
 		in, out := &in.MaxReceiveCount, &out.MaxReceiveCount
 		*out = new(int64)
@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DynamoDBDataSourceConfig configures a data source backed by a DynamoDB
+// table.
+type DynamoDBDataSourceConfig struct {
+	// TableName is the name of the DynamoDB table.
+	// +optional
+	TableName *string `json:"tableName,omitempty"`
+
+	// TableNameRef references a DynamoTable to retrieve its name.
+	// +optional
+	TableNameRef *runtimev1alpha1.Reference `json:"tableNameRef,omitempty"`
+
+	// TableNameSelector selects a reference to a DynamoTable to retrieve its
+	// name.
+	// +optional
+	TableNameSelector *runtimev1alpha1.Selector `json:"tableNameSelector,omitempty"`
+
+	// AWSRegion the table was created in.
+	AWSRegion string `json:"awsRegion"`
+
+	// UseCallerCredentials accesses the table with the credentials of the
+	// caller of the API instead of the service role.
+	// +optional
+	UseCallerCredentials *bool `json:"useCallerCredentials,omitempty"`
+}
+
+// LambdaDataSourceConfig configures a data source backed by a Lambda
+// function.
+type LambdaDataSourceConfig struct {
+	// FunctionARN is the ARN of the Lambda function.
+	FunctionARN string `json:"functionArn"`
+}
+
+// HTTPDataSourceConfig configures a data source backed by an HTTP endpoint.
+type HTTPDataSourceConfig struct {
+	// Endpoint is the URL of the HTTP endpoint, including the scheme.
+	Endpoint string `json:"endpoint"`
+}
+
+// DataSourceParameters define the desired state of an AWS AppSync data
+// source.
+type DataSourceParameters struct {
+	// APIID is the ID of the GraphQL API of the data source.
+	// +immutable
+	// +optional
+	APIID *string `json:"apiId,omitempty"`
+
+	// APIIDRef references a GraphQLAPI to retrieve its ID.
+	// +optional
+	APIIDRef *runtimev1alpha1.Reference `json:"apiIdRef,omitempty"`
+
+	// APIIDSelector selects a reference to a GraphQLAPI to retrieve its ID.
+	// +optional
+	APIIDSelector *runtimev1alpha1.Selector `json:"apiIdSelector,omitempty"`
+
+	// Type of the data source. The configuration of the matching type must
+	// be set.
+	// +kubebuilder:validation:Enum=AMAZON_DYNAMODB;AWS_LAMBDA;HTTP;NONE
+	Type string `json:"type"`
+
+	// Description of the data source.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// ServiceRoleARN is the ARN of the IAM role AppSync assumes to access
+	// the data source.
+	// +optional
+	ServiceRoleARN *string `json:"serviceRoleArn,omitempty"`
+
+	// ServiceRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	ServiceRoleARNRef *runtimev1alpha1.Reference `json:"serviceRoleArnRef,omitempty"`
+
+	// ServiceRoleARNSelector selects a reference to an IAMRole to retrieve
+	// its ARN.
+	// +optional
+	ServiceRoleARNSelector *runtimev1alpha1.Selector `json:"serviceRoleArnSelector,omitempty"`
+
+	// DynamoDBConfig is required when the type is AMAZON_DYNAMODB.
+	// +optional
+	DynamoDBConfig *DynamoDBDataSourceConfig `json:"dynamoDBConfig,omitempty"`
+
+	// LambdaConfig is required when the type is AWS_LAMBDA.
+	// +optional
+	LambdaConfig *LambdaDataSourceConfig `json:"lambdaConfig,omitempty"`
+
+	// HTTPConfig is required when the type is HTTP.
+	// +optional
+	HTTPConfig *HTTPDataSourceConfig `json:"httpConfig,omitempty"`
+}
+
+// A DataSourceSpec defines the desired state of a DataSource.
+type DataSourceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DataSourceParameters `json:"forProvider"`
+}
+
+// DataSourceObservation keeps the state for the external resource
+type DataSourceObservation struct {
+	// DataSourceARN is the ARN of the data source.
+	DataSourceARN string `json:"dataSourceArn,omitempty"`
+}
+
+// A DataSourceStatus represents the observed state of a DataSource.
+type DataSourceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DataSourceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DataSource is a managed resource that represents an AWS AppSync data
+// source. The external name of the resource is the name of the data source,
+// which may only contain alphanumeric characters and underscores.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DataSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataSourceSpec   `json:"spec"`
+	Status DataSourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataSourceList contains a list of DataSources
+type DataSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataSource `json:"items"`
+}
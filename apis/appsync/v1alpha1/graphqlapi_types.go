@@ -0,0 +1,238 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Authentication types supported by a GraphQL API.
+const (
+	AuthenticationTypeAPIKey = "API_KEY"
+)
+
+// A ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key of the ConfigMap data to select.
+	Key string `json:"key"`
+}
+
+// OpenIDConnectConfig configures authentication with an OpenID Connect
+// provider.
+type OpenIDConnectConfig struct {
+	// Issuer is the issuer of the OpenID Connect tokens, which must match the
+	// iss claim of the tokens.
+	Issuer string `json:"issuer"`
+
+	// ClientID of the relying party, which must match the aud claim of the
+	// tokens.
+	// +optional
+	ClientID *string `json:"clientId,omitempty"`
+
+	// AuthTTL is the number of milliseconds a token is valid after being
+	// authenticated.
+	// +optional
+	AuthTTL *int64 `json:"authTtl,omitempty"`
+
+	// IatTTL is the number of milliseconds a token is valid after being
+	// issued.
+	// +optional
+	IatTTL *int64 `json:"iatTtl,omitempty"`
+}
+
+// CognitoUserPoolConfig configures authentication with an Amazon Cognito
+// user pool for an additional authentication provider.
+type CognitoUserPoolConfig struct {
+	// UserPoolID is the ID of the user pool.
+	UserPoolID string `json:"userPoolId"`
+
+	// AWSRegion the user pool was created in.
+	AWSRegion string `json:"awsRegion"`
+
+	// AppIDClientRegex is a regular expression that the client IDs of the
+	// user pool apps must match.
+	// +optional
+	AppIDClientRegex *string `json:"appIdClientRegex,omitempty"`
+}
+
+// UserPoolConfig configures authentication with an Amazon Cognito user
+// pool.
+type UserPoolConfig struct {
+	CognitoUserPoolConfig `json:",inline"`
+
+	// DefaultAction taken when a request does not match the user pool
+	// authorization.
+	// +kubebuilder:validation:Enum=ALLOW;DENY
+	DefaultAction string `json:"defaultAction"`
+}
+
+// AdditionalAuthenticationProvider is an authentication mode accepted by
+// the API besides its default one.
+type AdditionalAuthenticationProvider struct {
+	// AuthenticationType of the provider.
+	// +kubebuilder:validation:Enum=API_KEY;AWS_IAM;AMAZON_COGNITO_USER_POOLS;OPENID_CONNECT
+	AuthenticationType string `json:"authenticationType"`
+
+	// OpenIDConnectConfig is required when the authentication type is
+	// OPENID_CONNECT.
+	// +optional
+	OpenIDConnectConfig *OpenIDConnectConfig `json:"openIDConnectConfig,omitempty"`
+
+	// UserPoolConfig is required when the authentication type is
+	// AMAZON_COGNITO_USER_POOLS.
+	// +optional
+	UserPoolConfig *CognitoUserPoolConfig `json:"userPoolConfig,omitempty"`
+}
+
+// LogConfig configures the CloudWatch logs of the API.
+type LogConfig struct {
+	// CloudWatchLogsRoleARN is the ARN of the IAM role AppSync assumes to
+	// write to CloudWatch Logs.
+	// +optional
+	CloudWatchLogsRoleARN *string `json:"cloudWatchLogsRoleArn,omitempty"`
+
+	// CloudWatchLogsRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	CloudWatchLogsRoleARNRef *runtimev1alpha1.Reference `json:"cloudWatchLogsRoleArnRef,omitempty"`
+
+	// CloudWatchLogsRoleARNSelector selects a reference to an IAMRole to
+	// retrieve its ARN.
+	// +optional
+	CloudWatchLogsRoleARNSelector *runtimev1alpha1.Selector `json:"cloudWatchLogsRoleArnSelector,omitempty"`
+
+	// FieldLogLevel is the level of the field resolution logs.
+	// +kubebuilder:validation:Enum=NONE;ERROR;ALL
+	FieldLogLevel string `json:"fieldLogLevel"`
+
+	// ExcludeVerboseContent excludes the headers, context and evaluated
+	// mapping templates from the logs.
+	// +optional
+	ExcludeVerboseContent *bool `json:"excludeVerboseContent,omitempty"`
+}
+
+// GraphQLAPIParameters define the desired state of an AWS AppSync GraphQL
+// API.
+type GraphQLAPIParameters struct {
+	// Name of the API.
+	Name string `json:"name"`
+
+	// AuthenticationType is the default authentication mode of the API.
+	// +kubebuilder:validation:Enum=API_KEY;AWS_IAM;AMAZON_COGNITO_USER_POOLS;OPENID_CONNECT
+	AuthenticationType string `json:"authenticationType"`
+
+	// AdditionalAuthenticationProviders are the authentication modes
+	// accepted besides the default one.
+	// +optional
+	AdditionalAuthenticationProviders []AdditionalAuthenticationProvider `json:"additionalAuthenticationProviders,omitempty"`
+
+	// OpenIDConnectConfig is required when the authentication type is
+	// OPENID_CONNECT.
+	// +optional
+	OpenIDConnectConfig *OpenIDConnectConfig `json:"openIDConnectConfig,omitempty"`
+
+	// UserPoolConfig is required when the authentication type is
+	// AMAZON_COGNITO_USER_POOLS.
+	// +optional
+	UserPoolConfig *UserPoolConfig `json:"userPoolConfig,omitempty"`
+
+	// LogConfig configures the CloudWatch logs of the API.
+	// +optional
+	LogConfig *LogConfig `json:"logConfig,omitempty"`
+
+	// XRayEnabled enables X-Ray tracing of the API.
+	// +optional
+	XRayEnabled *bool `json:"xrayEnabled,omitempty"`
+
+	// SchemaConfigMapRef selects the key of a ConfigMap that holds the
+	// GraphQL schema of the API in SDL format. The schema is applied again
+	// whenever the content of the key changes.
+	// +optional
+	SchemaConfigMapRef *ConfigMapKeySelector `json:"schemaConfigMapRef,omitempty"`
+
+	// Tags to assign to the API when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A GraphQLAPISpec defines the desired state of a GraphQLAPI.
+type GraphQLAPISpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  GraphQLAPIParameters `json:"forProvider"`
+}
+
+// GraphQLAPIObservation keeps the state for the external resource
+type GraphQLAPIObservation struct {
+	// ARN of the API.
+	ARN string `json:"arn,omitempty"`
+
+	// URIs of the API endpoints, keyed by endpoint type, e.g. GRAPHQL or
+	// REALTIME.
+	URIs map[string]string `json:"uris,omitempty"`
+
+	// SchemaStatus is the status of the last schema creation.
+	SchemaStatus string `json:"schemaStatus,omitempty"`
+
+	// SchemaChecksum is the SHA-256 checksum of the last schema applied to
+	// the API.
+	SchemaChecksum string `json:"schemaChecksum,omitempty"`
+}
+
+// A GraphQLAPIStatus represents the observed state of a GraphQLAPI.
+type GraphQLAPIStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     GraphQLAPIObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GraphQLAPI is a managed resource that represents an AWS AppSync GraphQL
+// API. The external name of the resource is the ID of the API assigned by
+// AppSync. The GraphQL endpoint of the API is published to the connection
+// secret, along with an API key when API_KEY authentication is enabled.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SCHEMA",type="string",JSONPath=".status.atProvider.schemaStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type GraphQLAPI struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GraphQLAPISpec   `json:"spec"`
+	Status GraphQLAPIStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GraphQLAPIList contains a list of GraphQLAPIs
+type GraphQLAPIList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GraphQLAPI `json:"items"`
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	databasev1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ResolveReferences of this GraphQLAPI
+func (mg *GraphQLAPI) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.logConfig.cloudWatchLogsRoleArn
+	if mg.Spec.ForProvider.LogConfig != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LogConfig.CloudWatchLogsRoleARN),
+			Reference:    mg.Spec.ForProvider.LogConfig.CloudWatchLogsRoleARNRef,
+			Selector:     mg.Spec.ForProvider.LogConfig.CloudWatchLogsRoleARNSelector,
+			To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+			Extract:      iamv1beta1.IAMRoleARN(),
+		})
+		if err != nil {
+			return err
+		}
+		mg.Spec.ForProvider.LogConfig.CloudWatchLogsRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.LogConfig.CloudWatchLogsRoleARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this DataSource
+func (mg *DataSource) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.apiId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.APIID),
+		Reference:    mg.Spec.ForProvider.APIIDRef,
+		Selector:     mg.Spec.ForProvider.APIIDSelector,
+		To:           reference.To{Managed: &GraphQLAPI{}, List: &GraphQLAPIList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.APIID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.APIIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.serviceRoleArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ServiceRoleARN),
+		Reference:    mg.Spec.ForProvider.ServiceRoleARNRef,
+		Selector:     mg.Spec.ForProvider.ServiceRoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.ServiceRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ServiceRoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dynamoDBConfig.tableName
+	if mg.Spec.ForProvider.DynamoDBConfig != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DynamoDBConfig.TableName),
+			Reference:    mg.Spec.ForProvider.DynamoDBConfig.TableNameRef,
+			Selector:     mg.Spec.ForProvider.DynamoDBConfig.TableNameSelector,
+			To:           reference.To{Managed: &databasev1alpha1.DynamoTable{}, List: &databasev1alpha1.DynamoTableList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		mg.Spec.ForProvider.DynamoDBConfig.TableName = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.DynamoDBConfig.TableNameRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Resolver
+func (mg *Resolver) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.apiId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.APIID),
+		Reference:    mg.Spec.ForProvider.APIIDRef,
+		Selector:     mg.Spec.ForProvider.APIIDSelector,
+		To:           reference.To{Managed: &GraphQLAPI{}, List: &GraphQLAPIList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.APIID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.APIIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dataSourceName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DataSourceName),
+		Reference:    mg.Spec.ForProvider.DataSourceNameRef,
+		Selector:     mg.Spec.ForProvider.DataSourceNameSelector,
+		To:           reference.To{Managed: &DataSource{}, List: &DataSourceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DataSourceName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DataSourceNameRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CachingConfig configures the caching of the results of a resolver.
+type CachingConfig struct {
+	// TTL is the number of seconds results are cached for.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+
+	// CachingKeys are the context keys, such as $context.arguments, the
+	// cached results are keyed by.
+	// +optional
+	CachingKeys []string `json:"cachingKeys,omitempty"`
+}
+
+// ResolverParameters define the desired state of an AWS AppSync resolver.
+type ResolverParameters struct {
+	// APIID is the ID of the GraphQL API of the resolver.
+	// +immutable
+	// +optional
+	APIID *string `json:"apiId,omitempty"`
+
+	// APIIDRef references a GraphQLAPI to retrieve its ID.
+	// +optional
+	APIIDRef *runtimev1alpha1.Reference `json:"apiIdRef,omitempty"`
+
+	// APIIDSelector selects a reference to a GraphQLAPI to retrieve its ID.
+	// +optional
+	APIIDSelector *runtimev1alpha1.Selector `json:"apiIdSelector,omitempty"`
+
+	// TypeName is the name of the GraphQL type of the resolved field.
+	// +immutable
+	TypeName string `json:"typeName"`
+
+	// FieldName is the name of the resolved field.
+	// +immutable
+	FieldName string `json:"fieldName"`
+
+	// Kind of the resolver. A UNIT resolver invokes a single data source,
+	// while a PIPELINE resolver invokes a series of functions.
+	// +kubebuilder:validation:Enum=UNIT;PIPELINE
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+
+	// DataSourceName is the name of the data source a UNIT resolver
+	// invokes.
+	// +optional
+	DataSourceName *string `json:"dataSourceName,omitempty"`
+
+	// DataSourceNameRef references a DataSource to retrieve its name.
+	// +optional
+	DataSourceNameRef *runtimev1alpha1.Reference `json:"dataSourceNameRef,omitempty"`
+
+	// DataSourceNameSelector selects a reference to a DataSource to retrieve
+	// its name.
+	// +optional
+	DataSourceNameSelector *runtimev1alpha1.Selector `json:"dataSourceNameSelector,omitempty"`
+
+	// PipelineFunctions are the IDs of the functions a PIPELINE resolver
+	// invokes, in order.
+	// +optional
+	PipelineFunctions []string `json:"pipelineFunctions,omitempty"`
+
+	// RequestMappingTemplate is the VTL template that maps the GraphQL
+	// request to the data source request.
+	RequestMappingTemplate string `json:"requestMappingTemplate"`
+
+	// ResponseMappingTemplate is the VTL template that maps the data source
+	// response to the GraphQL response.
+	// +optional
+	ResponseMappingTemplate *string `json:"responseMappingTemplate,omitempty"`
+
+	// CachingConfig configures the caching of the results of the resolver.
+	// +optional
+	CachingConfig *CachingConfig `json:"cachingConfig,omitempty"`
+}
+
+// A ResolverSpec defines the desired state of a Resolver.
+type ResolverSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResolverParameters `json:"forProvider"`
+}
+
+// ResolverObservation keeps the state for the external resource
+type ResolverObservation struct {
+	// ResolverARN is the ARN of the resolver.
+	ResolverARN string `json:"resolverArn,omitempty"`
+}
+
+// A ResolverStatus represents the observed state of a Resolver.
+type ResolverStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResolverObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Resolver is a managed resource that represents an AWS AppSync resolver,
+// which attaches a field of the schema of a GraphQL API to a data source.
+// The resolver is identified by the API, type and field it resolves.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.typeName"
+// +kubebuilder:printcolumn:name="FIELD",type="string",JSONPath=".spec.forProvider.fieldName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Resolver struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResolverSpec   `json:"spec"`
+	Status ResolverStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResolverList contains a list of Resolvers
+type ResolverList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Resolver `json:"items"`
+}
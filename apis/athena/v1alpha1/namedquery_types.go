@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// NamedQueryParameters define the desired state of an AWS Athena named
+// query. Athena does not support updating a named query, so all of its
+// parameters are immutable.
+type NamedQueryParameters struct {
+	// Name of the query.
+	// +immutable
+	Name string `json:"name"`
+
+	// Database to which the query belongs.
+	// +immutable
+	Database string `json:"database"`
+
+	// QueryString is the SQL statement that makes up the query.
+	// +immutable
+	QueryString string `json:"queryString"`
+
+	// Description of the query.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// WorkGroup in which the named query is saved. Athena uses the primary
+	// workgroup if this is not set.
+	// +immutable
+	// +optional
+	WorkGroup *string `json:"workGroup,omitempty"`
+}
+
+// A NamedQuerySpec defines the desired state of a NamedQuery.
+type NamedQuerySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NamedQueryParameters `json:"forProvider"`
+}
+
+// NamedQueryObservation keeps the state for the external resource
+type NamedQueryObservation struct {
+	// The unique ID assigned to the query by Athena.
+	NamedQueryID string `json:"namedQueryId,omitempty"`
+}
+
+// A NamedQueryStatus represents the observed state of a NamedQuery.
+type NamedQueryStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     NamedQueryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NamedQuery is a managed resource that represents an AWS Athena named
+// query. The external name of the resource is the named query ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="WORKGROUP",type="string",JSONPath=".spec.forProvider.workGroup"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NamedQuery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NamedQuerySpec   `json:"spec"`
+	Status NamedQueryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamedQueryList contains a list of NamedQueries
+type NamedQueryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamedQuery `json:"items"`
+}
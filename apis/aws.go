@@ -22,24 +22,62 @@ import (
 
 	acmv1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	amplifyv1alpha1 "github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	appconfigv1alpha1 "github.com/crossplane/provider-aws/apis/appconfig/v1alpha1"
+	applicationautoscalingv1alpha1 "github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
 	integrationv1alpha1 "github.com/crossplane/provider-aws/apis/applicationintegration/v1alpha1"
+	appsyncv1alpha1 "github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	athenav1alpha1 "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
 	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	cloudhsmv2v1alpha1 "github.com/crossplane/provider-aws/apis/cloudhsmv2/v1alpha1"
+	cloudwatchv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	cloudwatchlogsv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
 	computev1alpha3 "github.com/crossplane/provider-aws/apis/compute/v1alpha3"
 	databasev1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
 	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	datasyncv1alpha1 "github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	daxv1alpha1 "github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	detectivev1alpha1 "github.com/crossplane/provider-aws/apis/detective/v1alpha1"
+	directoryservicev1alpha1 "github.com/crossplane/provider-aws/apis/directoryservice/v1alpha1"
 	ec2v1alpha4 "github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	ecsv1alpha1 "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
 	eksv1alpha1 "github.com/crossplane/provider-aws/apis/eks/v1alpha1"
 	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	elasticbeanstalkv1alpha1 "github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
 	elasticloadbalancingv1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	firehosev1alpha1 "github.com/crossplane/provider-aws/apis/firehose/v1alpha1"
+	fmsv1alpha1 "github.com/crossplane/provider-aws/apis/fms/v1alpha1"
+	gluev1alpha1 "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
 	identityv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	imagebuilderv1alpha1 "github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	iotv1alpha1 "github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	kinesisvideov1alpha1 "github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	lakeformationv1alpha1 "github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	licensemanagerv1alpha1 "github.com/crossplane/provider-aws/apis/licensemanager/v1alpha1"
+	macie2v1alpha1 "github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
 	notificationv1alpha3 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	organizationsv1alpha1 "github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	pinpointv1alpha1 "github.com/crossplane/provider-aws/apis/pinpoint/v1alpha1"
+	qldbv1alpha1 "github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+	quicksightv1alpha1 "github.com/crossplane/provider-aws/apis/quicksight/v1alpha1"
 	redshiftv1alpha1 "github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
 	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	route53resolverv1alpha1 "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	s3v1alpha1 "github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	s3controlv1alpha1 "github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	servicequotasv1alpha1 "github.com/crossplane/provider-aws/apis/servicequotas/v1alpha1"
+	snowballv1alpha1 "github.com/crossplane/provider-aws/apis/snowball/v1alpha1"
+	ssmv1alpha1 "github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
 	storagev1alpha3 "github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+	stsv1alpha1 "github.com/crossplane/provider-aws/apis/sts/v1alpha1"
+	syntheticsv1alpha1 "github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	xrayv1alpha1 "github.com/crossplane/provider-aws/apis/xray/v1alpha1"
 )
 
 func init() {
@@ -65,6 +103,44 @@ func init() {
 		integrationv1alpha1.SchemeBuilder.AddToScheme,
 		redshiftv1alpha1.SchemeBuilder.AddToScheme,
 		eksv1alpha1.SchemeBuilder.AddToScheme,
+		xrayv1alpha1.SchemeBuilder.AddToScheme,
+		appconfigv1alpha1.SchemeBuilder.AddToScheme,
+		ssmv1alpha1.SchemeBuilder.AddToScheme,
+		imagebuilderv1alpha1.SchemeBuilder.AddToScheme,
+		ecsv1alpha1.SchemeBuilder.AddToScheme,
+		elasticbeanstalkv1alpha1.SchemeBuilder.AddToScheme,
+		qldbv1alpha1.SchemeBuilder.AddToScheme,
+		lakeformationv1alpha1.SchemeBuilder.AddToScheme,
+		macie2v1alpha1.SchemeBuilder.AddToScheme,
+		kmsv1alpha1.SchemeBuilder.AddToScheme,
+		cloudhsmv2v1alpha1.SchemeBuilder.AddToScheme,
+		daxv1alpha1.SchemeBuilder.AddToScheme,
+		kinesisvideov1alpha1.SchemeBuilder.AddToScheme,
+		iotv1alpha1.SchemeBuilder.AddToScheme,
+		appsyncv1alpha1.SchemeBuilder.AddToScheme,
+		amplifyv1alpha1.SchemeBuilder.AddToScheme,
+		pinpointv1alpha1.SchemeBuilder.AddToScheme,
+		route53resolverv1alpha1.SchemeBuilder.AddToScheme,
+		s3controlv1alpha1.SchemeBuilder.AddToScheme,
+		s3v1alpha1.SchemeBuilder.AddToScheme,
+		stsv1alpha1.SchemeBuilder.AddToScheme,
+		applicationautoscalingv1alpha1.SchemeBuilder.AddToScheme,
+		cloudwatchv1alpha1.SchemeBuilder.AddToScheme,
+		syntheticsv1alpha1.SchemeBuilder.AddToScheme,
+		cloudwatchlogsv1alpha1.SchemeBuilder.AddToScheme,
+		firehosev1alpha1.SchemeBuilder.AddToScheme,
+		gluev1alpha1.SchemeBuilder.AddToScheme,
+		athenav1alpha1.SchemeBuilder.AddToScheme,
+		quicksightv1alpha1.SchemeBuilder.AddToScheme,
+		organizationsv1alpha1.SchemeBuilder.AddToScheme,
+		servicequotasv1alpha1.SchemeBuilder.AddToScheme,
+		licensemanagerv1alpha1.SchemeBuilder.AddToScheme,
+		datasyncv1alpha1.SchemeBuilder.AddToScheme,
+		snowballv1alpha1.SchemeBuilder.AddToScheme,
+		directoryservicev1alpha1.SchemeBuilder.AddToScheme,
+		detectivev1alpha1.SchemeBuilder.AddToScheme,
+		fmsv1alpha1.SchemeBuilder.AddToScheme,
+		elbv2v1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
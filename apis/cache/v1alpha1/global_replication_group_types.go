@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GlobalReplicationGroupParameters define the desired state of an AWS
+// ElastiCache Global Datastore.
+type GlobalReplicationGroupParameters struct {
+	// GlobalReplicationGroupIDSuffix is appended to a prefix chosen by AWS
+	// to form the ID of the global datastore.
+	// +immutable
+	GlobalReplicationGroupIDSuffix string `json:"globalReplicationGroupIdSuffix"`
+
+	// GlobalReplicationGroupDescription is the description of the global
+	// datastore.
+	// +optional
+	GlobalReplicationGroupDescription *string `json:"globalReplicationGroupDescription,omitempty"`
+
+	// PrimaryReplicationGroupID is the ID of the primary member of the
+	// global datastore. The global datastore is created from a replication
+	// group in the region of its provider. Setting it and PrimaryRegion to a
+	// secondary member fails the global datastore over to that member.
+	// +optional
+	PrimaryReplicationGroupID *string `json:"primaryReplicationGroupId,omitempty"`
+
+	// PrimaryReplicationGroupIDRef references a ReplicationGroup to retrieve
+	// its ID.
+	// +optional
+	PrimaryReplicationGroupIDRef *runtimev1alpha1.Reference `json:"primaryReplicationGroupIdRef,omitempty"`
+
+	// PrimaryReplicationGroupIDSelector selects a reference to a
+	// ReplicationGroup to retrieve its ID.
+	// +optional
+	PrimaryReplicationGroupIDSelector *runtimev1alpha1.Selector `json:"primaryReplicationGroupIdSelector,omitempty"`
+
+	// PrimaryRegion is the region of the primary member of the global
+	// datastore.
+	// +optional
+	PrimaryRegion *string `json:"primaryRegion,omitempty"`
+
+	// AutomaticFailoverEnabled specifies whether a read replica is promoted
+	// automatically when the primary node of a member fails.
+	// +optional
+	AutomaticFailoverEnabled *bool `json:"automaticFailoverEnabled,omitempty"`
+
+	// CacheNodeType is the node type of all members of the global datastore.
+	// It can only be scaled up.
+	// +optional
+	CacheNodeType *string `json:"cacheNodeType,omitempty"`
+
+	// EngineVersion is the Redis version of all members of the global
+	// datastore. It can only be upgraded.
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+}
+
+// A GlobalReplicationGroupSpec defines the desired state of a
+// GlobalReplicationGroup.
+type GlobalReplicationGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  GlobalReplicationGroupParameters `json:"forProvider"`
+}
+
+// GlobalReplicationGroupMember is a replication group that is a member of a
+// global datastore.
+type GlobalReplicationGroupMember struct {
+	// ReplicationGroupID is the ID of the replication group.
+	ReplicationGroupID string `json:"replicationGroupId,omitempty"`
+
+	// ReplicationGroupRegion is the region of the replication group.
+	ReplicationGroupRegion string `json:"replicationGroupRegion,omitempty"`
+
+	// Role of the replication group, either PRIMARY or SECONDARY.
+	Role string `json:"role,omitempty"`
+
+	// Status of the membership.
+	Status string `json:"status,omitempty"`
+}
+
+// GlobalReplicationGroupObservation keeps the state for the external resource
+type GlobalReplicationGroupObservation struct {
+	// ARN of the global datastore.
+	ARN string `json:"arn,omitempty"`
+
+	// Status of the global datastore.
+	Status string `json:"status,omitempty"`
+
+	// Engine of the global datastore.
+	Engine string `json:"engine,omitempty"`
+
+	// ClusterEnabled is true if the global datastore is partitioned into
+	// shards.
+	ClusterEnabled bool `json:"clusterEnabled,omitempty"`
+
+	// Members of the global datastore.
+	Members []GlobalReplicationGroupMember `json:"members,omitempty"`
+}
+
+// A GlobalReplicationGroupStatus represents the observed state of a
+// GlobalReplicationGroup.
+type GlobalReplicationGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     GlobalReplicationGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GlobalReplicationGroup is a managed resource that represents an AWS
+// ElastiCache for Redis Global Datastore, which replicates a primary
+// ReplicationGroup to secondary ReplicationGroups in other regions. Secondary
+// members join by setting the globalReplicationGroupId of their
+// ReplicationGroup. The external name of the resource is the ID assigned by
+// AWS. All secondary members must be deleted before the global datastore can
+// be deleted; its primary member is retained.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PRIMARY",type="string",JSONPath=".spec.forProvider.primaryReplicationGroupId"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type GlobalReplicationGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GlobalReplicationGroupSpec   `json:"spec"`
+	Status GlobalReplicationGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalReplicationGroupList contains a list of GlobalReplicationGroups
+type GlobalReplicationGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GlobalReplicationGroup `json:"items"`
+}
@@ -23,7 +23,8 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
-	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 )
 
 // ResolveReferences of this CacheSubnetGroup
@@ -35,7 +36,7 @@ func (mg *CacheSubnetGroup) ResolveReferences(ctx context.Context, c client.Read
 		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
 		References:    mg.Spec.ForProvider.SubnetIDRefs,
 		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
-		To:            reference.To{Managed: &v1beta1.Subnet{}, List: &v1beta1.SubnetList{}},
+		To:            reference.To{Managed: &network.Subnet{}, List: &network.SubnetList{}},
 		Extract:       reference.ExternalName(),
 	})
 	if err != nil {
@@ -46,3 +47,24 @@ func (mg *CacheSubnetGroup) ResolveReferences(ctx context.Context, c client.Read
 
 	return nil
 }
+
+// ResolveReferences of this GlobalReplicationGroup
+func (mg *GlobalReplicationGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.primaryReplicationGroupId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PrimaryReplicationGroupID),
+		Reference:    mg.Spec.ForProvider.PrimaryReplicationGroupIDRef,
+		Selector:     mg.Spec.ForProvider.PrimaryReplicationGroupIDSelector,
+		To:           reference.To{Managed: &v1beta1.ReplicationGroup{}, List: &v1beta1.ReplicationGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.PrimaryReplicationGroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PrimaryReplicationGroupIDRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -45,6 +45,15 @@ var (
 	CacheSubnetGroupGroupVersionKind = SchemeGroupVersion.WithKind(CacheSubnetGroupKind)
 )
 
+// GlobalReplicationGroup type metadata.
+var (
+	GlobalReplicationGroupKind             = reflect.TypeOf(GlobalReplicationGroup{}).Name()
+	GlobalReplicationGroupGroupKind        = schema.GroupKind{Group: Group, Kind: GlobalReplicationGroupKind}.String()
+	GlobalReplicationGroupKindAPIVersion   = GlobalReplicationGroupKind + "." + SchemeGroupVersion.String()
+	GlobalReplicationGroupGroupVersionKind = SchemeGroupVersion.WithKind(GlobalReplicationGroupKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&CacheSubnetGroup{}, &CacheSubnetGroupList{})
+	SchemeBuilder.Register(&GlobalReplicationGroup{}, &GlobalReplicationGroupList{})
 }
@@ -162,3 +162,186 @@ func (in *CacheSubnetGroupStatus) DeepCopy() *CacheSubnetGroupStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroup) DeepCopyInto(out *GlobalReplicationGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroup.
+func (in *GlobalReplicationGroup) DeepCopy() *GlobalReplicationGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalReplicationGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupList) DeepCopyInto(out *GlobalReplicationGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalReplicationGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupList.
+func (in *GlobalReplicationGroupList) DeepCopy() *GlobalReplicationGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalReplicationGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupMember) DeepCopyInto(out *GlobalReplicationGroupMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupMember.
+func (in *GlobalReplicationGroupMember) DeepCopy() *GlobalReplicationGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupObservation) DeepCopyInto(out *GlobalReplicationGroupObservation) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]GlobalReplicationGroupMember, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupObservation.
+func (in *GlobalReplicationGroupObservation) DeepCopy() *GlobalReplicationGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupParameters) DeepCopyInto(out *GlobalReplicationGroupParameters) {
+	*out = *in
+	if in.GlobalReplicationGroupDescription != nil {
+		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrimaryReplicationGroupID != nil {
+		in, out := &in.PrimaryReplicationGroupID, &out.PrimaryReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrimaryReplicationGroupIDRef != nil {
+		in, out := &in.PrimaryReplicationGroupIDRef, &out.PrimaryReplicationGroupIDRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.PrimaryReplicationGroupIDSelector != nil {
+		in, out := &in.PrimaryReplicationGroupIDSelector, &out.PrimaryReplicationGroupIDSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrimaryRegion != nil {
+		in, out := &in.PrimaryRegion, &out.PrimaryRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutomaticFailoverEnabled != nil {
+		in, out := &in.AutomaticFailoverEnabled, &out.AutomaticFailoverEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheNodeType != nil {
+		in, out := &in.CacheNodeType, &out.CacheNodeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupParameters.
+func (in *GlobalReplicationGroupParameters) DeepCopy() *GlobalReplicationGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupSpec) DeepCopyInto(out *GlobalReplicationGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupSpec.
+func (in *GlobalReplicationGroupSpec) DeepCopy() *GlobalReplicationGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupStatus) DeepCopyInto(out *GlobalReplicationGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupStatus.
+func (in *GlobalReplicationGroupStatus) DeepCopy() *GlobalReplicationGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
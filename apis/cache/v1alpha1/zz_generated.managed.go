@@ -92,3 +92,73 @@ func (mg *CacheSubnetGroup) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
 func (mg *CacheSubnetGroup) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetBindingPhase of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this GlobalReplicationGroup.
+func (mg *GlobalReplicationGroup) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -166,6 +166,9 @@ type SlotMigration struct {
 // ReplicationGroupObservation contains the observation of the status of
 // the given ReplicationGroup.
 type ReplicationGroupObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the replication group.
+	ARN string `json:"arn,omitempty"`
+
 	// AutomaticFailover indicates the status of Multi-AZ with automatic failover
 	// for this Redis replication group.
 	AutomaticFailover string `json:"automaticFailoverStatus,omitempty"`
@@ -357,6 +360,14 @@ type ReplicationGroupParameters struct {
 	// +optional
 	EngineVersion *string `json:"engineVersion,omitempty"`
 
+	// GlobalReplicationGroupID is the ID of the global datastore the
+	// replication group joins as a secondary member. A secondary member must
+	// be in a different region than the primary member of the global
+	// datastore and inherits its engine settings.
+	// +immutable
+	// +optional
+	GlobalReplicationGroupID *string `json:"globalReplicationGroupId,omitempty"`
+
 	// NodeGroupConfigurationSpec specifies a list of node group (shard)
 	// configuration options.
 	//
@@ -323,6 +323,11 @@ func (in *ReplicationGroupParameters) DeepCopyInto(out *ReplicationGroupParamete
 		*out = new(string)
 		**out = **in
 	}
+	if in.GlobalReplicationGroupID != nil {
+		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
 	if in.NodeGroupConfiguration != nil {
 		in, out := &in.NodeGroupConfiguration, &out.NodeGroupConfiguration
 		*out = make([]NodeGroupConfigurationSpec, len(*in))
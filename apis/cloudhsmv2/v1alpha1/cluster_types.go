@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ClusterParameters define the desired state of an AWS CloudHSM cluster.
+type ClusterParameters struct {
+	// HSMType is the type of HSMs in the cluster, e.g. hsm1.medium.
+	// +immutable
+	HSMType string `json:"hsmType"`
+
+	// SourceBackupID is the ID of the backup to restore the cluster from.
+	// +immutable
+	// +optional
+	SourceBackupID *string `json:"sourceBackupId,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets the HSMs of the cluster can be
+	// placed in, at most one per Availability Zone.
+	// +immutable
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// Tags to assign to the cluster when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ClusterSpec defines the desired state of a Cluster.
+type ClusterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ClusterParameters `json:"forProvider"`
+}
+
+// ClusterCertificates are the certificates of a cluster used to initialize
+// it.
+type ClusterCertificates struct {
+	// ClusterCSR is the certificate signing request of the cluster. It is
+	// available once the first HSM of an uninitialized cluster is active, and
+	// must be signed by the issuing certificate authority of the cluster.
+	ClusterCSR string `json:"clusterCsr,omitempty"`
+
+	// ClusterCertificate is the signed certificate of the cluster.
+	ClusterCertificate string `json:"clusterCertificate,omitempty"`
+
+	// HSMCertificate is the certificate of the HSM that issued the CSR.
+	HSMCertificate string `json:"hsmCertificate,omitempty"`
+
+	// AWSHardwareCertificate is the certificate of the HSM hardware issued by
+	// AWS.
+	AWSHardwareCertificate string `json:"awsHardwareCertificate,omitempty"`
+
+	// ManufacturerHardwareCertificate is the certificate of the HSM hardware
+	// issued by its manufacturer.
+	ManufacturerHardwareCertificate string `json:"manufacturerHardwareCertificate,omitempty"`
+}
+
+// ClusterObservation keeps the state for the external resource
+type ClusterObservation struct {
+	// State of the cluster.
+	State string `json:"state,omitempty"`
+
+	// StateMessage describes the state of the cluster.
+	StateMessage string `json:"stateMessage,omitempty"`
+
+	// SecurityGroup is the ID of the security group of the cluster.
+	SecurityGroup string `json:"securityGroup,omitempty"`
+
+	// VPCID is the ID of the VPC of the cluster.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// SubnetMapping maps the Availability Zones of the cluster to its
+	// subnets.
+	SubnetMapping map[string]string `json:"subnetMapping,omitempty"`
+
+	// Certificates of the cluster.
+	Certificates ClusterCertificates `json:"certificates,omitempty"`
+}
+
+// A ClusterStatus represents the observed state of a Cluster.
+type ClusterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Cluster is a managed resource that represents an AWS CloudHSM cluster.
+// The external name of the resource is the cluster ID assigned by CloudHSM.
+// An uninitialized cluster is considered available so that its first Hsm
+// can be created, after which the certificate signing request needed to
+// initialize it is surfaced in its status. All HSMs of a cluster must be
+// deleted before the cluster can be deleted.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Clusters
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
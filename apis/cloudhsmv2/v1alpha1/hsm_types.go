@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// HsmParameters define the desired state of an HSM of an AWS CloudHSM
+// cluster.
+type HsmParameters struct {
+	// ClusterID is the ID of the cluster of the HSM.
+	// +immutable
+	// +optional
+	ClusterID *string `json:"clusterId,omitempty"`
+
+	// ClusterIDRef references a Cluster to retrieve its ID.
+	// +optional
+	ClusterIDRef *runtimev1alpha1.Reference `json:"clusterIdRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a Cluster to retrieve its ID.
+	// +optional
+	ClusterIDSelector *runtimev1alpha1.Selector `json:"clusterIdSelector,omitempty"`
+
+	// AvailabilityZone of the HSM. The cluster must have a subnet in it.
+	// +immutable
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// IPAddress of the HSM in the subnet of its Availability Zone. An
+	// address is chosen automatically when it is omitted.
+	// +immutable
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+}
+
+// An HsmSpec defines the desired state of an Hsm.
+type HsmSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  HsmParameters `json:"forProvider"`
+}
+
+// HsmObservation keeps the state for the external resource
+type HsmObservation struct {
+	// State of the HSM.
+	State string `json:"state,omitempty"`
+
+	// StateMessage describes the state of the HSM.
+	StateMessage string `json:"stateMessage,omitempty"`
+
+	// SubnetID is the ID of the subnet of the HSM.
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// ENIID is the ID of the network interface of the HSM.
+	ENIID string `json:"eniId,omitempty"`
+
+	// ENIIP is the IP address of the network interface of the HSM.
+	ENIIP string `json:"eniIp,omitempty"`
+}
+
+// An HsmStatus represents the observed state of an Hsm.
+type HsmStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     HsmObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Hsm is a managed resource that represents a hardware security module of
+// an AWS CloudHSM cluster. The external name of the resource is the HSM ID
+// assigned by CloudHSM.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterId"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.eniIp"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Hsm struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HsmSpec   `json:"spec"`
+	Status HsmStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HsmList contains a list of Hsms
+type HsmList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Hsm `json:"items"`
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CompositeAlarmParameters define the desired state of an AWS CloudWatch
+// composite alarm.
+type CompositeAlarmParameters struct {
+	// ActionsEnabled indicates whether actions are executed when the alarm
+	// changes state. Defaults to true.
+	// +optional
+	ActionsEnabled *bool `json:"actionsEnabled,omitempty"`
+
+	// AlarmActions are the ARNs of the actions to execute when the alarm
+	// transitions into the ALARM state.
+	// +optional
+	AlarmActions []string `json:"alarmActions,omitempty"`
+
+	// AlarmDescription is the description of the alarm.
+	// +optional
+	AlarmDescription *string `json:"alarmDescription,omitempty"`
+
+	// AlarmRule is a boolean expression over the states of other alarms,
+	// e.g. ALARM("cpu-high") AND NOT OK("memory-high").
+	AlarmRule string `json:"alarmRule"`
+
+	// InsufficientDataActions are the ARNs of the actions to execute when the
+	// alarm transitions into the INSUFFICIENT_DATA state.
+	// +optional
+	InsufficientDataActions []string `json:"insufficientDataActions,omitempty"`
+
+	// OKActions are the ARNs of the actions to execute when the alarm
+	// transitions into the OK state.
+	// +optional
+	OKActions []string `json:"okActions,omitempty"`
+
+	// Tags to assign to the alarm when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A CompositeAlarmSpec defines the desired state of a CompositeAlarm.
+type CompositeAlarmSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CompositeAlarmParameters `json:"forProvider"`
+}
+
+// CompositeAlarmObservation keeps the state for the external resource.
+type CompositeAlarmObservation struct {
+	// AlarmARN is the ARN of the alarm.
+	AlarmARN string `json:"alarmArn,omitempty"`
+
+	// StateValue is the state of the alarm: OK, ALARM or INSUFFICIENT_DATA.
+	StateValue string `json:"stateValue,omitempty"`
+
+	// StateReason is a human-readable explanation of the alarm state.
+	StateReason string `json:"stateReason,omitempty"`
+}
+
+// A CompositeAlarmStatus represents the observed state of a CompositeAlarm.
+type CompositeAlarmStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CompositeAlarmObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CompositeAlarm is a managed resource that represents an AWS CloudWatch
+// alarm whose state is derived from the states of other alarms.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.stateValue"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CompositeAlarm struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CompositeAlarmSpec   `json:"spec"`
+	Status CompositeAlarmStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CompositeAlarmList contains a list of CompositeAlarms
+type CompositeAlarmList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CompositeAlarm `json:"items"`
+}
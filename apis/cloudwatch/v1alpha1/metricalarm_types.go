@@ -0,0 +1,260 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Dimension is a name and value pair that further identifies a metric.
+type Dimension struct {
+	// Name of the dimension.
+	Name string `json:"name"`
+
+	// Value of the dimension.
+	Value string `json:"value"`
+}
+
+// Metric identifies a CloudWatch metric.
+type Metric struct {
+	// Dimensions of the metric.
+	// +optional
+	Dimensions []Dimension `json:"dimensions,omitempty"`
+
+	// MetricName is the name of the metric.
+	// +optional
+	MetricName *string `json:"metricName,omitempty"`
+
+	// Namespace of the metric.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// MetricStat defines the metric to be returned, along with the statistics,
+// period, and units.
+type MetricStat struct {
+	// Metric to return.
+	Metric Metric `json:"metric"`
+
+	// Period, in seconds, to use when retrieving the metric.
+	// +kubebuilder:validation:Minimum=1
+	Period int64 `json:"period"`
+
+	// Stat is the statistic to return, e.g. Average, Sum or p99.
+	Stat string `json:"stat"`
+
+	// Unit of the metric.
+	// +optional
+	Unit *string `json:"unit,omitempty"`
+}
+
+// MetricDataQuery is a metric or a math expression that an alarm evaluates.
+// Exactly one of Expression and MetricStat must be set.
+type MetricDataQuery struct {
+	// ID is a short name used to refer to this query in expressions and in
+	// the ThresholdMetricID of the alarm.
+	ID string `json:"id"`
+
+	// Expression is a math expression to be performed on the returned data,
+	// e.g. ANOMALY_DETECTION_BAND(m1, 2).
+	// +optional
+	Expression *string `json:"expression,omitempty"`
+
+	// Label is a human-readable label for this query.
+	// +optional
+	Label *string `json:"label,omitempty"`
+
+	// MetricStat is the metric to be returned.
+	// +optional
+	MetricStat *MetricStat `json:"metricStat,omitempty"`
+
+	// Period, in seconds, of the returned data points.
+	// +optional
+	Period *int64 `json:"period,omitempty"`
+
+	// ReturnData indicates whether this query is the one the alarm is
+	// evaluated on. Exactly one query must return data. Defaults to true.
+	// +optional
+	ReturnData *bool `json:"returnData,omitempty"`
+}
+
+// MetricAlarmParameters define the desired state of an AWS CloudWatch metric
+// alarm.
+type MetricAlarmParameters struct {
+	// ActionsEnabled indicates whether actions are executed when the alarm
+	// changes state. Defaults to true.
+	// +optional
+	ActionsEnabled *bool `json:"actionsEnabled,omitempty"`
+
+	// AlarmActions are the ARNs of the actions to execute when the alarm
+	// transitions into the ALARM state.
+	// +optional
+	AlarmActions []string `json:"alarmActions,omitempty"`
+
+	// AlarmDescription is the description of the alarm.
+	// +optional
+	AlarmDescription *string `json:"alarmDescription,omitempty"`
+
+	// ComparisonOperator is the arithmetic operation to use when comparing
+	// the statistic and the threshold. The LessThanLowerOrGreaterThanUpper,
+	// LessThanLower and GreaterThanUpper operators are used with anomaly
+	// detection bands.
+	// +kubebuilder:validation:Enum=GreaterThanOrEqualToThreshold;GreaterThanThreshold;LessThanThreshold;LessThanOrEqualToThreshold;LessThanLowerOrGreaterThanUpperThreshold;LessThanLowerThreshold;GreaterThanUpperThreshold
+	ComparisonOperator string `json:"comparisonOperator"`
+
+	// DatapointsToAlarm is the number of data points that must be breaching
+	// to trigger the alarm.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DatapointsToAlarm *int64 `json:"datapointsToAlarm,omitempty"`
+
+	// Dimensions of the metric the alarm is evaluated on. Only used together
+	// with MetricName.
+	// +optional
+	Dimensions []Dimension `json:"dimensions,omitempty"`
+
+	// EvaluateLowSampleCountPercentile determines the behaviour of
+	// percentile-based alarms during periods with too few data points.
+	// +kubebuilder:validation:Enum=evaluate;ignore
+	// +optional
+	EvaluateLowSampleCountPercentile *string `json:"evaluateLowSampleCountPercentile,omitempty"`
+
+	// EvaluationPeriods is the number of periods over which data is compared
+	// to the threshold.
+	// +kubebuilder:validation:Minimum=1
+	EvaluationPeriods int64 `json:"evaluationPeriods"`
+
+	// ExtendedStatistic is the percentile statistic of the metric, e.g. p99.
+	// Only used together with MetricName.
+	// +optional
+	ExtendedStatistic *string `json:"extendedStatistic,omitempty"`
+
+	// InsufficientDataActions are the ARNs of the actions to execute when the
+	// alarm transitions into the INSUFFICIENT_DATA state.
+	// +optional
+	InsufficientDataActions []string `json:"insufficientDataActions,omitempty"`
+
+	// MetricName is the name of the metric the alarm is evaluated on. Use
+	// Metrics instead for math expressions and anomaly detection.
+	// +optional
+	MetricName *string `json:"metricName,omitempty"`
+
+	// Metrics are the metric and expression queries the alarm is evaluated
+	// on. An anomaly detection alarm pairs a metric with an
+	// ANOMALY_DETECTION_BAND expression and sets ThresholdMetricID to the ID
+	// of that expression.
+	// +optional
+	Metrics []MetricDataQuery `json:"metrics,omitempty"`
+
+	// Namespace of the metric the alarm is evaluated on. Only used together
+	// with MetricName.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// OKActions are the ARNs of the actions to execute when the alarm
+	// transitions into the OK state.
+	// +optional
+	OKActions []string `json:"okActions,omitempty"`
+
+	// Period, in seconds, over which the statistic is applied. Only used
+	// together with MetricName.
+	// +optional
+	Period *int64 `json:"period,omitempty"`
+
+	// Statistic of the metric. Only used together with MetricName.
+	// +kubebuilder:validation:Enum=SampleCount;Average;Sum;Minimum;Maximum
+	// +optional
+	Statistic *string `json:"statistic,omitempty"`
+
+	// Tags to assign to the alarm when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Threshold is the value to compare the statistic with, expressed as a
+	// decimal string, e.g. "80.5". Not used by anomaly detection alarms.
+	// +optional
+	Threshold *string `json:"threshold,omitempty"`
+
+	// ThresholdMetricID is the ID of the ANOMALY_DETECTION_BAND expression
+	// in Metrics that the alarm compares the metric with.
+	// +optional
+	ThresholdMetricID *string `json:"thresholdMetricId,omitempty"`
+
+	// TreatMissingData sets how the alarm handles missing data points.
+	// +kubebuilder:validation:Enum=breaching;notBreaching;ignore;missing
+	// +optional
+	TreatMissingData *string `json:"treatMissingData,omitempty"`
+
+	// Unit of the metric. Only used together with MetricName.
+	// +optional
+	Unit *string `json:"unit,omitempty"`
+}
+
+// A MetricAlarmSpec defines the desired state of a MetricAlarm.
+type MetricAlarmSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  MetricAlarmParameters `json:"forProvider"`
+}
+
+// MetricAlarmObservation keeps the state for the external resource.
+type MetricAlarmObservation struct {
+	// AlarmARN is the ARN of the alarm.
+	AlarmARN string `json:"alarmArn,omitempty"`
+
+	// StateValue is the state of the alarm: OK, ALARM or INSUFFICIENT_DATA.
+	StateValue string `json:"stateValue,omitempty"`
+
+	// StateReason is a human-readable explanation of the alarm state.
+	StateReason string `json:"stateReason,omitempty"`
+}
+
+// A MetricAlarmStatus represents the observed state of a MetricAlarm.
+type MetricAlarmStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     MetricAlarmObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MetricAlarm is a managed resource that represents an AWS CloudWatch alarm
+// that watches a single metric, a math expression or an anomaly detection
+// band.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.stateValue"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MetricAlarm struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MetricAlarmSpec   `json:"spec"`
+	Status MetricAlarmStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MetricAlarmList contains a list of MetricAlarms
+type MetricAlarmList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MetricAlarm `json:"items"`
+}
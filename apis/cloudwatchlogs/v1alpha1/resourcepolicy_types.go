@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ResourcePolicyParameters define the desired state of an AWS CloudWatch Logs
+// resource policy.
+type ResourcePolicyParameters struct {
+	// PolicyDocument is the JSON policy document that allows AWS services
+	// to write to log groups of the account, e.g. Route 53 query logging or
+	// Elasticsearch slow logs.
+	PolicyDocument string `json:"policyDocument"`
+}
+
+// A ResourcePolicySpec defines the desired state of a ResourcePolicy.
+type ResourcePolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResourcePolicyParameters `json:"forProvider"`
+}
+
+// ResourcePolicyObservation keeps the state for the external resource
+type ResourcePolicyObservation struct {
+	// LastUpdatedTime is the time the policy was last updated, in
+	// milliseconds since the epoch.
+	LastUpdatedTime int64 `json:"lastUpdatedTime,omitempty"`
+}
+
+// A ResourcePolicyStatus represents the observed state of a ResourcePolicy.
+type ResourcePolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResourcePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourcePolicy is a managed resource that represents an AWS CloudWatch
+// Logs resource policy. The external name of the resource is the name of the
+// policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ResourcePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourcePolicySpec   `json:"spec"`
+	Status ResourcePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourcePolicyList contains a list of ResourcePolicies
+type ResourcePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourcePolicy `json:"items"`
+}
@@ -160,6 +160,58 @@ type StreamSpecification struct {
 	StreamViewType *string `json:"StreamViewType,omitempty"`
 }
 
+// ReplicaAutoScaling specifies the auto scaling of the provisioned read
+// capacity of a replica.
+type ReplicaAutoScaling struct {
+	// MinimumUnits is the minimum provisioned read capacity of the replica.
+	MinimumUnits int64 `json:"minimumUnits"`
+
+	// MaximumUnits is the maximum provisioned read capacity of the replica.
+	MaximumUnits int64 `json:"maximumUnits"`
+
+	// TargetUtilizationPercent is the percentage of consumed to provisioned
+	// read capacity the auto scaling policy tracks, between 20 and 90.
+	TargetUtilizationPercent int64 `json:"targetUtilizationPercent"`
+}
+
+// ReplicaGlobalSecondaryIndex represents the settings of a global secondary
+// index of a replica.
+type ReplicaGlobalSecondaryIndex struct {
+	// IndexName is the name of the global secondary index.
+	IndexName string `json:"indexName"`
+
+	// ReadCapacityUnitsOverride is the read capacity of the index in the
+	// replica if it differs from the one of the index in the source table.
+	// +optional
+	ReadCapacityUnitsOverride *int64 `json:"readCapacityUnitsOverride,omitempty"`
+}
+
+// Replica represents a replica of a global table in another AWS region.
+type Replica struct {
+	// RegionName is the region of the replica.
+	RegionName string `json:"regionName"`
+
+	// KMSMasterKeyID is the ID of the AWS KMS customer master key used to
+	// encrypt the replica, if it differs from the default DynamoDB key.
+	// +optional
+	KMSMasterKeyID *string `json:"kmsMasterKeyId,omitempty"`
+
+	// ReadCapacityUnitsOverride is the read capacity of the replica if it
+	// differs from the one of the source table.
+	// +optional
+	ReadCapacityUnitsOverride *int64 `json:"readCapacityUnitsOverride,omitempty"`
+
+	// GlobalSecondaryIndexes are the replica specific settings of the global
+	// secondary indexes of the table.
+	// +optional
+	GlobalSecondaryIndexes []ReplicaGlobalSecondaryIndex `json:"globalSecondaryIndexes,omitempty"`
+
+	// ReadCapacityAutoScaling specifies the auto scaling of the provisioned
+	// read capacity of the replica.
+	// +optional
+	ReadCapacityAutoScaling *ReplicaAutoScaling `json:"readCapacityAutoScaling,omitempty"`
+}
+
 // DynamoTableParameters define the desired state of an AWS DynomoDBTable
 type DynamoTableParameters struct {
 	// An array of attributes that describe the key schema for the table and indexes.
@@ -195,6 +247,12 @@ type DynamoTableParameters struct {
 	// A list of key-value pairs to label the table.
 	// +optional
 	Tags []Tag `json:"tag,omitempty"`
+
+	// Replicas of the table in other AWS regions, which make it a global
+	// table (version 2019.11.21). Replicas are added and removed one at a
+	// time.
+	// +optional
+	Replicas []Replica `json:"replicas,omitempty"`
 }
 
 // A DynamoTableSpec defines the desired state of a DynamoDB Table.
@@ -242,6 +300,25 @@ type DynamoTableObservation struct {
 
 	// Unique identifier for the table for which the backup was created.
 	TableName string `json:"tableName,omitempty"`
+
+	// Replicas of the table in other AWS regions.
+	Replicas []ReplicaObservation `json:"replicas,omitempty"`
+}
+
+// ReplicaObservation keeps the state of a replica of a global table.
+type ReplicaObservation struct {
+	// RegionName is the region of the replica.
+	RegionName string `json:"regionName,omitempty"`
+
+	// ReplicaStatus is the state of the replica.
+	ReplicaStatus string `json:"replicaStatus,omitempty"`
+
+	// ReplicaStatusDescription describes the state of the replica.
+	ReplicaStatusDescription string `json:"replicaStatusDescription,omitempty"`
+
+	// ReplicaStatusPercentProgress is the progress of the creation of the
+	// replica.
+	ReplicaStatusPercentProgress string `json:"replicaStatusPercentProgress,omitempty"`
 }
 
 // A DynamoTableStatus represents the observed state of a DynamoDB Table.
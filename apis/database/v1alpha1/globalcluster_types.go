@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// GlobalClusterParameters define the desired state of an Amazon Aurora
+// global database.
+type GlobalClusterParameters struct {
+	// SourceDBClusterIdentifier is the ARN of an existing Aurora DB cluster
+	// that becomes the primary cluster of the global database. Engine,
+	// EngineVersion, DatabaseName and StorageEncrypted are inherited from
+	// it and must be omitted when it is set.
+	// +immutable
+	// +optional
+	SourceDBClusterIdentifier *string `json:"sourceDBClusterIdentifier,omitempty"`
+
+	// Engine of the global database, e.g. aurora-mysql or aurora-postgresql.
+	// +immutable
+	// +optional
+	Engine *string `json:"engine,omitempty"`
+
+	// EngineVersion of the global database.
+	// +immutable
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// DatabaseName is the name of the database created in the global
+	// database, up to 64 alphanumeric characters.
+	// +immutable
+	// +optional
+	DatabaseName *string `json:"databaseName,omitempty"`
+
+	// StorageEncrypted specifies whether the storage of the global database
+	// is encrypted.
+	// +immutable
+	// +optional
+	StorageEncrypted *bool `json:"storageEncrypted,omitempty"`
+
+	// DeletionProtection specifies whether the global database can be
+	// deleted.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+}
+
+// A GlobalClusterSpec defines the desired state of a GlobalCluster.
+type GlobalClusterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  GlobalClusterParameters `json:"forProvider,omitempty"`
+}
+
+// GlobalClusterMember is a DB cluster that is a member of a global database.
+type GlobalClusterMember struct {
+	// DBClusterARN is the ARN of the DB cluster.
+	DBClusterARN string `json:"dbClusterArn,omitempty"`
+
+	// IsWriter is true if the DB cluster is the primary cluster of the
+	// global database.
+	IsWriter bool `json:"isWriter,omitempty"`
+
+	// Readers are the ARNs of the secondary clusters replicating from the DB
+	// cluster.
+	Readers []string `json:"readers,omitempty"`
+}
+
+// GlobalClusterObservation keeps the state for the external resource
+type GlobalClusterObservation struct {
+	// ARN of the global database.
+	ARN string `json:"arn,omitempty"`
+
+	// ResourceID is the region-unique, immutable identifier of the global
+	// database.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// Status of the global database.
+	Status string `json:"status,omitempty"`
+
+	// Members of the global database.
+	Members []GlobalClusterMember `json:"members,omitempty"`
+}
+
+// A GlobalClusterStatus represents the observed state of a GlobalCluster.
+type GlobalClusterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     GlobalClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GlobalCluster is a managed resource that represents an Amazon Aurora
+// global database spanning multiple regions. The external name of the
+// resource is the identifier of the global database. Secondary DB clusters
+// join it by being created with its identifier, and all of them must be
+// removed before the global database can be deleted.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engine"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type GlobalCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GlobalClusterSpec   `json:"spec"`
+	Status GlobalClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalClusterList contains a list of GlobalClusters
+type GlobalClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GlobalCluster `json:"items"`
+}
@@ -45,6 +45,15 @@ var (
 	DynamoTableGroupVersionKind = SchemeGroupVersion.WithKind(DynamoTableKind)
 )
 
+// GlobalCluster type metadata.
+var (
+	GlobalClusterKind             = reflect.TypeOf(GlobalCluster{}).Name()
+	GlobalClusterGroupKind        = schema.GroupKind{Group: Group, Kind: GlobalClusterKind}.String()
+	GlobalClusterKindAPIVersion   = GlobalClusterKind + "." + SchemeGroupVersion.String()
+	GlobalClusterGroupVersionKind = SchemeGroupVersion.WithKind(GlobalClusterKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&DynamoTable{}, &DynamoTableList{})
+	SchemeBuilder.Register(&GlobalCluster{}, &GlobalClusterList{})
 }
@@ -126,6 +126,11 @@ func (in *DynamoTableObservation) DeepCopyInto(out *DynamoTableObservation) {
 		}
 	}
 	in.ProvisionedThroughput.DeepCopyInto(&out.ProvisionedThroughput)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]ReplicaObservation, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoTableObservation.
@@ -185,6 +190,13 @@ func (in *DynamoTableParameters) DeepCopyInto(out *DynamoTableParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]Replica, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoTableParameters.
@@ -231,6 +243,186 @@ func (in *DynamoTableStatus) DeepCopy() *DynamoTableStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalCluster) DeepCopyInto(out *GlobalCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalCluster.
+func (in *GlobalCluster) DeepCopy() *GlobalCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalClusterList) DeepCopyInto(out *GlobalClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterList.
+func (in *GlobalClusterList) DeepCopy() *GlobalClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalClusterMember) DeepCopyInto(out *GlobalClusterMember) {
+	*out = *in
+	if in.Readers != nil {
+		in, out := &in.Readers, &out.Readers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterMember.
+func (in *GlobalClusterMember) DeepCopy() *GlobalClusterMember {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalClusterMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalClusterObservation) DeepCopyInto(out *GlobalClusterObservation) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]GlobalClusterMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterObservation.
+func (in *GlobalClusterObservation) DeepCopy() *GlobalClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalClusterParameters) DeepCopyInto(out *GlobalClusterParameters) {
+	*out = *in
+	if in.SourceDBClusterIdentifier != nil {
+		in, out := &in.SourceDBClusterIdentifier, &out.SourceDBClusterIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageEncrypted != nil {
+		in, out := &in.StorageEncrypted, &out.StorageEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterParameters.
+func (in *GlobalClusterParameters) DeepCopy() *GlobalClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalClusterSpec) DeepCopyInto(out *GlobalClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterSpec.
+func (in *GlobalClusterSpec) DeepCopy() *GlobalClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalClusterStatus) DeepCopyInto(out *GlobalClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterStatus.
+func (in *GlobalClusterStatus) DeepCopy() *GlobalClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalSecondaryIndex) DeepCopyInto(out *GlobalSecondaryIndex) {
 	*out = *in
@@ -356,6 +548,93 @@ func (in *ProvisionedThroughput) DeepCopy() *ProvisionedThroughput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Replica) DeepCopyInto(out *Replica) {
+	*out = *in
+	if in.KMSMasterKeyID != nil {
+		in, out := &in.KMSMasterKeyID, &out.KMSMasterKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReadCapacityUnitsOverride != nil {
+		in, out := &in.ReadCapacityUnitsOverride, &out.ReadCapacityUnitsOverride
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GlobalSecondaryIndexes != nil {
+		in, out := &in.GlobalSecondaryIndexes, &out.GlobalSecondaryIndexes
+		*out = make([]ReplicaGlobalSecondaryIndex, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReadCapacityAutoScaling != nil {
+		in, out := &in.ReadCapacityAutoScaling, &out.ReadCapacityAutoScaling
+		*out = new(ReplicaAutoScaling)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Replica.
+func (in *Replica) DeepCopy() *Replica {
+	if in == nil {
+		return nil
+	}
+	out := new(Replica)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaAutoScaling) DeepCopyInto(out *ReplicaAutoScaling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaAutoScaling.
+func (in *ReplicaAutoScaling) DeepCopy() *ReplicaAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaGlobalSecondaryIndex) DeepCopyInto(out *ReplicaGlobalSecondaryIndex) {
+	*out = *in
+	if in.ReadCapacityUnitsOverride != nil {
+		in, out := &in.ReadCapacityUnitsOverride, &out.ReadCapacityUnitsOverride
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaGlobalSecondaryIndex.
+func (in *ReplicaGlobalSecondaryIndex) DeepCopy() *ReplicaGlobalSecondaryIndex {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaGlobalSecondaryIndex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaObservation) DeepCopyInto(out *ReplicaObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaObservation.
+func (in *ReplicaObservation) DeepCopy() *ReplicaObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSESpecification) DeepCopyInto(out *SSESpecification) {
 	*out = *in
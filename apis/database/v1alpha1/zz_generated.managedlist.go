@@ -28,3 +28,12 @@ func (l *DynamoTableList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this GlobalClusterList.
+func (l *GlobalClusterList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
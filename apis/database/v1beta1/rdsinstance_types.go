@@ -688,6 +688,15 @@ type RDSInstanceParameters struct {
 	// +optional
 	Domain *string `json:"domain,omitempty"`
 
+	// DomainRef references a Directory to retrieve its ID and set Domain.
+	// +optional
+	DomainRef *runtimev1alpha1.Reference `json:"domainRef,omitempty"`
+
+	// DomainSelector selects a reference to a Directory to retrieve its ID
+	// and set Domain.
+	// +optional
+	DomainSelector *runtimev1alpha1.Selector `json:"domainSelector,omitempty"`
+
 	// DomainIAMRoleName specifies the name of the IAM role to be used when making API calls to the
 	// Directory Service.
 	// +optional
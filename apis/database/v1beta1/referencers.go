@@ -23,6 +23,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	directoryservice "github.com/crossplane/provider-aws/apis/directoryservice/v1alpha1"
 	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
 )
@@ -80,6 +81,20 @@ func (mg *RDSInstance) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.DomainIAMRoleName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.DomainIAMRoleNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.domain
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Domain),
+		Reference:    mg.Spec.ForProvider.DomainRef,
+		Selector:     mg.Spec.ForProvider.DomainSelector,
+		To:           reference.To{Managed: &directoryservice.Directory{}, List: &directoryservice.DirectoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.Domain = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DomainRef = rsp.ResolvedReference
+
 	// Resolve spec.forProvider.monitoringRoleArn
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.MonitoringRoleARN),
@@ -832,6 +832,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DomainRef != nil {
+		in, out := &in.DomainRef, &out.DomainRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.DomainSelector != nil {
+		in, out := &in.DomainSelector, &out.DomainSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DomainIAMRoleName != nil {
 		in, out := &in.DomainIAMRoleName, &out.DomainIAMRoleName
 		*out = new(string)
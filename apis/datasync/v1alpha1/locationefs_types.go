@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LocationEFSParameters define the desired state of an AWS DataSync EFS
+// location. DataSync does not support updating a location, so all of its
+// parameters are immutable.
+type LocationEFSParameters struct {
+	// EFSFilesystemARN is the ARN of the EFS file system of the location.
+	// +immutable
+	EFSFilesystemARN string `json:"efsFilesystemArn"`
+
+	// SubnetARN is the ARN of the subnet DataSync mounts the file system
+	// in. The subnet must have a mount target of the file system.
+	// +immutable
+	SubnetARN string `json:"subnetArn"`
+
+	// SecurityGroupARNs are the ARNs of the security groups of the mount
+	// target of the file system.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	SecurityGroupARNs []string `json:"securityGroupArns"`
+
+	// Subdirectory is the path in the file system that is read from or
+	// written to.
+	// +immutable
+	// +optional
+	Subdirectory *string `json:"subdirectory,omitempty"`
+
+	// Tags to assign to the location when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LocationEFSSpec defines the desired state of a LocationEFS.
+type LocationEFSSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LocationEFSParameters `json:"forProvider"`
+}
+
+// LocationEFSObservation keeps the state for the external resource
+type LocationEFSObservation struct {
+	// LocationURI is the URL of the location, for example
+	// s3://bucket/prefix.
+	LocationURI string `json:"locationUri,omitempty"`
+}
+
+// A LocationEFSStatus represents the observed state of a LocationEFS.
+type LocationEFSStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LocationEFSObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LocationEFS is a managed resource that represents an AWS DataSync EFS
+// location. The external name of the resource is the location ARN.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URI",type="string",JSONPath=".status.atProvider.locationUri"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LocationEFS struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocationEFSSpec   `json:"spec"`
+	Status LocationEFSStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocationEFSList contains a list of LocationEFSs
+type LocationEFSList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocationEFS `json:"items"`
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// ResolveReferences of this LocationS3
+func (mg *LocationS3) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.s3Bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.S3Bucket),
+		Reference:    mg.Spec.ForProvider.S3BucketRef,
+		Selector:     mg.Spec.ForProvider.S3BucketSelector,
+		To:           reference.To{Managed: &storagev1alpha3.S3Bucket{}, List: &storagev1alpha3.S3BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.S3Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.S3BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.bucketAccessRoleArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BucketAccessRoleARN),
+		Reference:    mg.Spec.ForProvider.BucketAccessRoleARNRef,
+		Selector:     mg.Spec.ForProvider.BucketAccessRoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.BucketAccessRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketAccessRoleARNRef = rsp.ResolvedReference
+
+	return nil
+}
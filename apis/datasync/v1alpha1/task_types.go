@@ -0,0 +1,207 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair attached to a DataSync resource.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value *string `json:"value,omitempty"`
+}
+
+// FilterRule selects the files a task skips.
+type FilterRule struct {
+	// FilterType is the type of the filter.
+	// +optional
+	// +kubebuilder:validation:Enum=SIMPLE_PATTERN
+	FilterType *string `json:"filterType,omitempty"`
+
+	// Value is a list of patterns separated by a pipe, for example
+	// /folder1|/folder2.
+	Value string `json:"value"`
+}
+
+// TaskOptions configure how a task transfers files. DataSync fills the
+// options that are not set with its defaults.
+type TaskOptions struct {
+	// BytesPerSecond limits the bandwidth of the task. -1 means no limit.
+	// +optional
+	BytesPerSecond *int64 `json:"bytesPerSecond,omitempty"`
+
+	// VerifyMode determines whether the transferred data is verified.
+	// +optional
+	// +kubebuilder:validation:Enum=POINT_IN_TIME_CONSISTENT;ONLY_FILES_TRANSFERRED;NONE
+	VerifyMode *string `json:"verifyMode,omitempty"`
+
+	// OverwriteMode determines whether files at the destination are
+	// overwritten.
+	// +optional
+	// +kubebuilder:validation:Enum=ALWAYS;NEVER
+	OverwriteMode *string `json:"overwriteMode,omitempty"`
+
+	// PreserveDeletedFiles determines whether files deleted at the source
+	// are kept at the destination.
+	// +optional
+	// +kubebuilder:validation:Enum=PRESERVE;REMOVE
+	PreserveDeletedFiles *string `json:"preserveDeletedFiles,omitempty"`
+
+	// PreserveDevices determines whether device and special files are
+	// copied.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PRESERVE
+	PreserveDevices *string `json:"preserveDevices,omitempty"`
+
+	// Atime determines whether the last access time of files is preserved.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;BEST_EFFORT
+	Atime *string `json:"atime,omitempty"`
+
+	// Mtime determines whether the last modification time of files is
+	// preserved.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PRESERVE
+	Mtime *string `json:"mtime,omitempty"`
+
+	// UID determines whether the user ID of files is preserved.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;INT_VALUE;NAME;BOTH
+	UID *string `json:"uid,omitempty"`
+
+	// GID determines whether the group ID of files is preserved.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;INT_VALUE;NAME;BOTH
+	GID *string `json:"gid,omitempty"`
+
+	// PosixPermissions determines whether POSIX permissions of files are
+	// preserved.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PRESERVE
+	PosixPermissions *string `json:"posixPermissions,omitempty"`
+
+	// TaskQueueing determines whether executions are queued while another
+	// execution of the task runs.
+	// +optional
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	TaskQueueing *string `json:"taskQueueing,omitempty"`
+
+	// LogLevel of the logs published to CloudWatch.
+	// +optional
+	// +kubebuilder:validation:Enum=OFF;BASIC;TRANSFER
+	LogLevel *string `json:"logLevel,omitempty"`
+}
+
+// TaskParameters define the desired state of an AWS DataSync task.
+type TaskParameters struct {
+	// Name of the task.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// SourceLocationARN is the ARN of the location files are transferred
+	// from.
+	// +immutable
+	SourceLocationARN string `json:"sourceLocationArn"`
+
+	// DestinationLocationARN is the ARN of the location files are
+	// transferred to.
+	// +immutable
+	DestinationLocationARN string `json:"destinationLocationArn"`
+
+	// CloudWatchLogGroupARN is the ARN of the log group the task logs to.
+	// +optional
+	CloudWatchLogGroupARN *string `json:"cloudWatchLogGroupArn,omitempty"`
+
+	// ScheduleExpression is a cron or rate expression that runs the task
+	// periodically, for example rate(1 day).
+	// +optional
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+
+	// Excludes are the filters of files the task skips.
+	// +optional
+	Excludes []FilterRule `json:"excludes,omitempty"`
+
+	// Options configure how the task transfers files.
+	// +optional
+	Options *TaskOptions `json:"options,omitempty"`
+
+	// Tags to assign to the task when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TaskSpec defines the desired state of a Task.
+type TaskSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TaskParameters `json:"forProvider"`
+}
+
+// TaskObservation keeps the state for the external resource
+type TaskObservation struct {
+	// Status of the task.
+	Status string `json:"status,omitempty"`
+
+	// CurrentTaskExecutionARN is the ARN of the running execution of the
+	// task.
+	CurrentTaskExecutionARN string `json:"currentTaskExecutionArn,omitempty"`
+
+	// ErrorCode is the code of the error the task failed with, if any.
+	ErrorCode string `json:"errorCode,omitempty"`
+
+	// ErrorDetail describes the error the task failed with, if any.
+	ErrorDetail string `json:"errorDetail,omitempty"`
+}
+
+// A TaskStatus represents the observed state of a Task.
+type TaskStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TaskObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Task is a managed resource that represents an AWS DataSync task. The
+// external name of the resource is the task ARN.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Task struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaskSpec   `json:"spec"`
+	Status TaskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskList contains a list of Tasks
+type TaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Task `json:"items"`
+}
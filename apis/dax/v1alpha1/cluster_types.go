@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ClusterParameters define the desired state of an AWS DynamoDB Accelerator
+// cluster.
+type ClusterParameters struct {
+	// Description of the cluster.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// IAMRoleARN is the ARN of the IAM role the cluster assumes to access
+	// DynamoDB on behalf of applications.
+	// +immutable
+	// +optional
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+
+	// IAMRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	IAMRoleARNRef *runtimev1alpha1.Reference `json:"iamRoleArnRef,omitempty"`
+
+	// IAMRoleARNSelector selects a reference to an IAMRole to retrieve its
+	// ARN.
+	// +optional
+	IAMRoleARNSelector *runtimev1alpha1.Selector `json:"iamRoleArnSelector,omitempty"`
+
+	// NodeType is the compute and memory capacity of the nodes of the
+	// cluster, e.g. dax.r4.large.
+	// +immutable
+	NodeType string `json:"nodeType"`
+
+	// ReplicationFactor is the number of nodes in the cluster, one primary
+	// node and the rest read replicas.
+	// +kubebuilder:validation:Minimum=1
+	ReplicationFactor int64 `json:"replicationFactor"`
+
+	// AvailabilityZones in which the nodes of the cluster are created.
+	// +immutable
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// SubnetGroupName is the name of the subnet group of the cluster.
+	// +immutable
+	// +optional
+	SubnetGroupName *string `json:"subnetGroupName,omitempty"`
+
+	// SubnetGroupNameRef references a SubnetGroup to retrieve its name.
+	// +optional
+	SubnetGroupNameRef *runtimev1alpha1.Reference `json:"subnetGroupNameRef,omitempty"`
+
+	// SubnetGroupNameSelector selects a reference to a SubnetGroup to
+	// retrieve its name.
+	// +optional
+	SubnetGroupNameSelector *runtimev1alpha1.Selector `json:"subnetGroupNameSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the nodes of the
+	// cluster.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// ParameterGroupName is the name of the parameter group of the cluster.
+	// +optional
+	ParameterGroupName *string `json:"parameterGroupName,omitempty"`
+
+	// PreferredMaintenanceWindow is the weekly time range in UTC during which
+	// maintenance is performed, e.g. sun:05:00-sun:09:00.
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// NotificationTopicARN is the ARN of the SNS topic notifications are sent
+	// to.
+	// +optional
+	NotificationTopicARN *string `json:"notificationTopicArn,omitempty"`
+
+	// SSEEnabled specifies whether the data of the cluster is encrypted at
+	// rest.
+	// +immutable
+	// +optional
+	SSEEnabled *bool `json:"sseEnabled,omitempty"`
+
+	// Tags to assign to the cluster when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ClusterSpec defines the desired state of a Cluster.
+type ClusterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ClusterParameters `json:"forProvider"`
+}
+
+// Endpoint is the address and port of a cluster or node.
+type Endpoint struct {
+	// Address is the DNS hostname of the endpoint.
+	Address string `json:"address,omitempty"`
+
+	// Port of the endpoint.
+	Port int64 `json:"port,omitempty"`
+}
+
+// Node is a node of a cluster.
+type Node struct {
+	// NodeID is the ID of the node.
+	NodeID string `json:"nodeId,omitempty"`
+
+	// AvailabilityZone of the node.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// Endpoint of the node.
+	Endpoint Endpoint `json:"endpoint,omitempty"`
+
+	// NodeStatus is the state of the node.
+	NodeStatus string `json:"nodeStatus,omitempty"`
+}
+
+// ClusterObservation keeps the state for the external resource
+type ClusterObservation struct {
+	// ClusterARN is the ARN of the cluster.
+	ClusterARN string `json:"clusterArn,omitempty"`
+
+	// Status of the cluster.
+	Status string `json:"status,omitempty"`
+
+	// ClusterDiscoveryEndpoint is the endpoint applications use to discover
+	// the nodes of the cluster.
+	ClusterDiscoveryEndpoint Endpoint `json:"clusterDiscoveryEndpoint,omitempty"`
+
+	// ActiveNodes is the number of nodes of the cluster that are active.
+	ActiveNodes int64 `json:"activeNodes,omitempty"`
+
+	// TotalNodes is the number of nodes of the cluster.
+	TotalNodes int64 `json:"totalNodes,omitempty"`
+
+	// Nodes of the cluster.
+	Nodes []Node `json:"nodes,omitempty"`
+
+	// SSEStatus is the state of the encryption at rest of the cluster.
+	SSEStatus string `json:"sseStatus,omitempty"`
+}
+
+// A ClusterStatus represents the observed state of a Cluster.
+type ClusterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Cluster is a managed resource that represents an AWS DynamoDB Accelerator
+// (DAX) cluster. The external name of the resource is the name of the
+// cluster, and its discovery endpoint is published as a connection detail.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NODE-TYPE",type="string",JSONPath=".spec.forProvider.nodeType"
+// +kubebuilder:printcolumn:name="NODES",type="integer",JSONPath=".status.atProvider.totalNodes"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Clusters
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SubnetGroupParameters define the desired state of an AWS DynamoDB
+// Accelerator subnet group.
+type SubnetGroupParameters struct {
+	// Description of the subnet group.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets of the subnet group.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+}
+
+// A SubnetGroupSpec defines the desired state of a SubnetGroup.
+type SubnetGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SubnetGroupParameters `json:"forProvider,omitempty"`
+}
+
+// SubnetGroupObservation keeps the state for the external resource
+type SubnetGroupObservation struct {
+	// VPCID is the ID of the VPC of the subnet group.
+	VPCID string `json:"vpcId,omitempty"`
+}
+
+// A SubnetGroupStatus represents the observed state of a SubnetGroup.
+type SubnetGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SubnetGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SubnetGroup is a managed resource that represents an AWS DynamoDB
+// Accelerator (DAX) subnet group. The external name of the resource is the
+// name of the subnet group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".status.atProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SubnetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetGroupSpec   `json:"spec"`
+	Status SubnetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubnetGroupList contains a list of SubnetGroups
+type SubnetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SubnetGroup `json:"items"`
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// MemberParameters define the desired state of a member account of an
+// Amazon Detective behavior graph.
+type MemberParameters struct {
+	// GraphARN is the ARN of the behavior graph the account is invited to.
+	// +immutable
+	// +optional
+	GraphARN *string `json:"graphArn,omitempty"`
+
+	// GraphARNRef references a Graph to retrieve its ARN.
+	// +optional
+	GraphARNRef *runtimev1alpha1.Reference `json:"graphArnRef,omitempty"`
+
+	// GraphARNSelector selects a reference to a Graph to retrieve its ARN.
+	// +optional
+	GraphARNSelector *runtimev1alpha1.Selector `json:"graphArnSelector,omitempty"`
+
+	// AccountID is the ID of the AWS account that is invited.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// EmailAddress is the email address of the root user of the account.
+	// +immutable
+	EmailAddress string `json:"emailAddress"`
+
+	// Message is added to the invitation email sent to the account.
+	// +immutable
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// A MemberSpec defines the desired state of a Member.
+type MemberSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  MemberParameters `json:"forProvider"`
+}
+
+// MemberObservation keeps the state for the external resource
+type MemberObservation struct {
+	// Status of the membership, for example INVITED or ENABLED.
+	Status string `json:"status,omitempty"`
+
+	// DisabledReason is the reason the account is not enabled, if any.
+	DisabledReason string `json:"disabledReason,omitempty"`
+
+	// MasterID is the ID of the master account of the graph.
+	MasterID string `json:"masterId,omitempty"`
+}
+
+// A MemberStatus represents the observed state of a Member.
+type MemberStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     MemberObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Member is a managed resource that represents a member account of an
+// Amazon Detective behavior graph. Creating a Member invites the account to
+// the graph.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".spec.forProvider.accountId"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Member struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MemberSpec   `json:"spec"`
+	Status MemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MemberList contains a list of Members
+type MemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Member `json:"items"`
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair attached to a directory.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// DirectoryParameters define the desired state of an AWS Managed Microsoft
+// AD directory. Directory Service does not support changing these settings
+// after the directory is created, so all of them are immutable.
+type DirectoryParameters struct {
+	// Name is the fully qualified domain name of the directory, for example
+	// corp.example.com.
+	// +immutable
+	Name string `json:"name"`
+
+	// ShortName is the NetBIOS name of the directory, for example CORP.
+	// +immutable
+	// +optional
+	ShortName *string `json:"shortName,omitempty"`
+
+	// Description of the directory.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Edition of the directory.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=Enterprise;Standard
+	Edition *string `json:"edition,omitempty"`
+
+	// PasswordSecretRef references the secret that contains the password of
+	// the Admin user of the directory.
+	// +immutable
+	PasswordSecretRef runtimev1alpha1.SecretKeySelector `json:"passwordSecretRef"`
+
+	// VPCID is the ID of the VPC the directory is created in.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the two subnets the domain controllers are
+	// created in. The subnets must be in different availability zones.
+	// +immutable
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their IDs.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// Tags to assign to the directory when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DirectorySpec defines the desired state of a Directory.
+type DirectorySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DirectoryParameters `json:"forProvider"`
+}
+
+// DirectoryObservation keeps the state for the external resource
+type DirectoryObservation struct {
+	// Stage of the directory.
+	Stage string `json:"stage,omitempty"`
+
+	// StageReason is the reason of the current stage, if any.
+	StageReason string `json:"stageReason,omitempty"`
+
+	// DNSIPAddrs are the IP addresses of the DNS servers of the directory.
+	DNSIPAddrs []string `json:"dnsIpAddrs,omitempty"`
+
+	// AccessURL is the access URL of the directory, for example
+	// corp.awsapps.com.
+	AccessURL string `json:"accessUrl,omitempty"`
+
+	// Alias of the directory.
+	Alias string `json:"alias,omitempty"`
+
+	// SecurityGroupID is the ID of the security group of the domain
+	// controllers.
+	SecurityGroupID string `json:"securityGroupId,omitempty"`
+}
+
+// A DirectoryStatus represents the observed state of a Directory.
+type DirectoryStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DirectoryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Directory is a managed resource that represents an AWS Managed Microsoft
+// AD directory. The external name of the resource is the directory ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STAGE",type="string",JSONPath=".status.atProvider.stage"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Directory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DirectorySpec   `json:"spec"`
+	Status DirectoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectoryList contains a list of Directories
+type DirectoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Directory `json:"items"`
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// EBSEncryptionByDefaultParameters define the desired state of EBS
+// encryption by default in a region of an AWS account.
+type EBSEncryptionByDefaultParameters struct {
+	// KMSKeyID is the ARN of the KMS key that new EBS volumes are encrypted
+	// with by default. The AWS managed key for EBS is used when it is
+	// omitted.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+}
+
+// An EBSEncryptionByDefaultSpec defines the desired state of an
+// EBSEncryptionByDefault.
+type EBSEncryptionByDefaultSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EBSEncryptionByDefaultParameters `json:"forProvider,omitempty"`
+}
+
+// An EBSEncryptionByDefaultStatus represents the observed state of an
+// EBSEncryptionByDefault.
+type EBSEncryptionByDefaultStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EBSEncryptionByDefault is a managed resource that enables encryption of
+// new EBS volumes by default in the region of its provider. The setting
+// exists once per account and region, so the external name of the resource
+// is not used. Deleting an EBSEncryptionByDefault disables encryption by
+// default and resets the default KMS key.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.kmsKeyId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EBSEncryptionByDefault struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EBSEncryptionByDefaultSpec   `json:"spec"`
+	Status EBSEncryptionByDefaultStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EBSEncryptionByDefaultList contains a list of EBSEncryptionByDefaults
+type EBSEncryptionByDefaultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EBSEncryptionByDefault `json:"items"`
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// FleetLaunchTemplateSpecification identifies the launch template the fleet
+// launches instances from. Exactly one of LaunchTemplateID and
+// LaunchTemplateName must be set.
+type FleetLaunchTemplateSpecification struct {
+	// LaunchTemplateID is the ID of the launch template.
+	// +optional
+	LaunchTemplateID *string `json:"launchTemplateId,omitempty"`
+
+	// LaunchTemplateName is the name of the launch template.
+	// +optional
+	LaunchTemplateName *string `json:"launchTemplateName,omitempty"`
+
+	// Version of the launch template: a version number, $Latest or $Default.
+	Version string `json:"version"`
+}
+
+// FleetLaunchTemplateOverrides override parameters of the launch template
+// for one instance pool of the fleet.
+type FleetLaunchTemplateOverrides struct {
+	// AvailabilityZone to launch the instances in.
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// InstanceType of the instances, e.g. m5.large.
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// MaxPrice is the maximum price per unit hour to pay for a Spot
+	// Instance.
+	// +optional
+	MaxPrice *string `json:"maxPrice,omitempty"`
+
+	// SubnetID to launch the instances in.
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// Priority of the override when the on-demand allocation strategy is
+	// prioritized. A lower number means a higher priority.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Priority *int64 `json:"priority,omitempty"`
+
+	// WeightedCapacity is the number of units an instance of this pool
+	// provides towards the target capacity, as a decimal number, e.g. "2.5".
+	// +optional
+	WeightedCapacity *string `json:"weightedCapacity,omitempty"`
+}
+
+// FleetLaunchTemplateConfig is a launch template and the overrides applied
+// to it.
+type FleetLaunchTemplateConfig struct {
+	// LaunchTemplateSpecification identifies the launch template.
+	LaunchTemplateSpecification FleetLaunchTemplateSpecification `json:"launchTemplateSpecification"`
+
+	// Overrides of the launch template. Each override defines an instance
+	// pool the fleet can launch instances in.
+	// +optional
+	Overrides []FleetLaunchTemplateOverrides `json:"overrides,omitempty"`
+}
+
+// TargetCapacitySpecification defines the number of units the fleet should
+// provide and how they are split between On-Demand and Spot Instances.
+type TargetCapacitySpecification struct {
+	// TotalTargetCapacity is the number of units to request.
+	// +kubebuilder:validation:Minimum=0
+	TotalTargetCapacity int64 `json:"totalTargetCapacity"`
+
+	// OnDemandTargetCapacity is the number of units to request as On-Demand
+	// Instances.
+	// +optional
+	OnDemandTargetCapacity *int64 `json:"onDemandTargetCapacity,omitempty"`
+
+	// SpotTargetCapacity is the number of units to request as Spot
+	// Instances.
+	// +optional
+	SpotTargetCapacity *int64 `json:"spotTargetCapacity,omitempty"`
+
+	// DefaultTargetCapacityType is the type of capacity used for the units
+	// not covered by OnDemandTargetCapacity and SpotTargetCapacity.
+	// +kubebuilder:validation:Enum=spot;on-demand
+	// +optional
+	DefaultTargetCapacityType *string `json:"defaultTargetCapacityType,omitempty"`
+}
+
+// FleetSpotOptions configure how the fleet launches Spot Instances.
+type FleetSpotOptions struct {
+	// AllocationStrategy determines the Spot pools instances are launched
+	// from.
+	// +kubebuilder:validation:Enum=lowest-price;diversified;capacity-optimized
+	// +optional
+	AllocationStrategy *string `json:"allocationStrategy,omitempty"`
+
+	// InstanceInterruptionBehavior is the behavior when a Spot Instance is
+	// interrupted.
+	// +kubebuilder:validation:Enum=hibernate;stop;terminate
+	// +optional
+	InstanceInterruptionBehavior *string `json:"instanceInterruptionBehavior,omitempty"`
+
+	// InstancePoolsToUseCount is the number of lowest priced Spot pools to
+	// use when AllocationStrategy is lowest-price.
+	// +optional
+	InstancePoolsToUseCount *int64 `json:"instancePoolsToUseCount,omitempty"`
+
+	// MaxTotalPrice is the maximum amount per hour to pay for Spot
+	// Instances.
+	// +optional
+	MaxTotalPrice *string `json:"maxTotalPrice,omitempty"`
+
+	// MinTargetCapacity is the minimum Spot capacity that must be reached,
+	// or no instances are launched.
+	// +optional
+	MinTargetCapacity *int64 `json:"minTargetCapacity,omitempty"`
+
+	// SingleAvailabilityZone launches all Spot Instances in a single
+	// availability zone.
+	// +optional
+	SingleAvailabilityZone *bool `json:"singleAvailabilityZone,omitempty"`
+
+	// SingleInstanceType launches all Spot Instances with the same instance
+	// type.
+	// +optional
+	SingleInstanceType *bool `json:"singleInstanceType,omitempty"`
+}
+
+// FleetOnDemandOptions configure how the fleet launches On-Demand Instances.
+type FleetOnDemandOptions struct {
+	// AllocationStrategy determines the order the launch template overrides
+	// are used in.
+	// +kubebuilder:validation:Enum=lowest-price;prioritized
+	// +optional
+	AllocationStrategy *string `json:"allocationStrategy,omitempty"`
+
+	// MaxTotalPrice is the maximum amount per hour to pay for On-Demand
+	// Instances.
+	// +optional
+	MaxTotalPrice *string `json:"maxTotalPrice,omitempty"`
+
+	// MinTargetCapacity is the minimum On-Demand capacity that must be
+	// reached, or no instances are launched.
+	// +optional
+	MinTargetCapacity *int64 `json:"minTargetCapacity,omitempty"`
+
+	// SingleAvailabilityZone launches all On-Demand Instances in a single
+	// availability zone.
+	// +optional
+	SingleAvailabilityZone *bool `json:"singleAvailabilityZone,omitempty"`
+
+	// SingleInstanceType launches all On-Demand Instances with the same
+	// instance type.
+	// +optional
+	SingleInstanceType *bool `json:"singleInstanceType,omitempty"`
+}
+
+// EC2FleetParameters define the desired state of an AWS EC2 Fleet. Only
+// the target capacity, the excess capacity termination policy and the tags
+// can be changed after creation, and only for fleets of type maintain.
+type EC2FleetParameters struct {
+	// LaunchTemplateConfigs are the launch templates the fleet launches
+	// instances from.
+	// +immutable
+	LaunchTemplateConfigs []FleetLaunchTemplateConfig `json:"launchTemplateConfigs"`
+
+	// TargetCapacitySpecification of the fleet.
+	TargetCapacitySpecification TargetCapacitySpecification `json:"targetCapacitySpecification"`
+
+	// SpotOptions of the fleet.
+	// +immutable
+	// +optional
+	SpotOptions *FleetSpotOptions `json:"spotOptions,omitempty"`
+
+	// OnDemandOptions of the fleet.
+	// +immutable
+	// +optional
+	OnDemandOptions *FleetOnDemandOptions `json:"onDemandOptions,omitempty"`
+
+	// ExcessCapacityTerminationPolicy determines whether running instances
+	// are terminated when the target capacity is decreased below the
+	// current size of the fleet.
+	// +kubebuilder:validation:Enum=termination;no-termination
+	// +optional
+	ExcessCapacityTerminationPolicy *string `json:"excessCapacityTerminationPolicy,omitempty"`
+
+	// ReplaceUnhealthyInstances replaces unhealthy instances. Only
+	// supported for fleets of type maintain.
+	// +immutable
+	// +optional
+	ReplaceUnhealthyInstances *bool `json:"replaceUnhealthyInstances,omitempty"`
+
+	// TerminateInstancesWithExpiration terminates the running instances
+	// when the fleet request expires.
+	// +immutable
+	// +optional
+	TerminateInstancesWithExpiration *bool `json:"terminateInstancesWithExpiration,omitempty"`
+
+	// Type of the fleet. A request fleet places a one-time request for the
+	// target capacity, a maintain fleet also replenishes interrupted Spot
+	// Instances.
+	// +kubebuilder:validation:Enum=request;maintain
+	// +immutable
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// TerminateInstancesOnDeletion terminates the instances of the fleet
+	// when the fleet is deleted. Defaults to true.
+	// +optional
+	TerminateInstancesOnDeletion *bool `json:"terminateInstancesOnDeletion,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// An EC2FleetSpec defines the desired state of an EC2Fleet.
+type EC2FleetSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EC2FleetParameters `json:"forProvider"`
+}
+
+// EC2FleetObservation keeps the state for the external resource
+type EC2FleetObservation struct {
+	// FleetID is the ID of the fleet.
+	FleetID string `json:"fleetId,omitempty"`
+
+	// FleetState is the current state of the fleet.
+	FleetState string `json:"fleetState,omitempty"`
+
+	// ActivityStatus indicates the progress of the fleet towards its target
+	// capacity.
+	ActivityStatus string `json:"activityStatus,omitempty"`
+
+	// FulfilledCapacity is the number of units fulfilled by the fleet.
+	FulfilledCapacity string `json:"fulfilledCapacity,omitempty"`
+
+	// FulfilledOnDemandCapacity is the number of units fulfilled by
+	// On-Demand Instances.
+	FulfilledOnDemandCapacity string `json:"fulfilledOnDemandCapacity,omitempty"`
+}
+
+// An EC2FleetStatus represents the observed state of an EC2Fleet.
+type EC2FleetStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     EC2FleetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EC2Fleet is a managed resource that represents an AWS EC2 Fleet.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TARGET",type="integer",JSONPath=".spec.forProvider.targetCapacitySpecification.totalTargetCapacity"
+// +kubebuilder:printcolumn:name="FULFILLED",type="string",JSONPath=".status.atProvider.fulfilledCapacity"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,path=ec2fleets,categories={crossplane,managed,aws}
+type EC2Fleet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EC2FleetSpec   `json:"spec"`
+	Status EC2FleetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EC2FleetList contains a list of EC2Fleets
+type EC2FleetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EC2Fleet `json:"items"`
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// ImageLaunchPermissions define who, besides the owner, can launch instances
+// from an AMI.
+type ImageLaunchPermissions struct {
+	// UserIDs are the AWS account IDs the AMI is shared with.
+	// +optional
+	UserIDs []string `json:"userIds,omitempty"`
+
+	// Groups the AMI is shared with. Use "all" to make the AMI public.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ImageParameters define the desired state of an AWS AMI copy.
+type ImageParameters struct {
+	// SourceImageID is the ID of the AMI to copy.
+	// +immutable
+	SourceImageID string `json:"sourceImageId"`
+
+	// SourceRegion is the region that contains the AMI to copy. The copy is
+	// created in the region of the provider.
+	// +immutable
+	SourceRegion string `json:"sourceRegion"`
+
+	// Name of the new AMI.
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the new AMI.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Encrypted specifies whether the snapshots of the copied AMI are
+	// encrypted.
+	// +immutable
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// KMSKeyID is the KMS key used to re-encrypt the snapshots of the copied
+	// AMI. Encrypted must be true when it is set. The default key for EBS is
+	// used when it is omitted.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// LaunchPermissions of the new AMI.
+	// +optional
+	LaunchPermissions *ImageLaunchPermissions `json:"launchPermissions,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// An ImageSpec defines the desired state of an Image.
+type ImageSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ImageParameters `json:"forProvider"`
+}
+
+// ImageObservation keeps the state for the external resource
+type ImageObservation struct {
+	// ImageID is the ID of the AMI.
+	ImageID string `json:"imageId,omitempty"`
+
+	// ImageState is the current state of the AMI.
+	ImageState string `json:"imageState,omitempty"`
+
+	// StateReason explains why the AMI is in its current state.
+	StateReason string `json:"stateReason,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the AMI.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// CreationDate is the date the AMI was created.
+	CreationDate string `json:"creationDate,omitempty"`
+
+	// Public indicates whether the AMI has public launch permissions.
+	Public bool `json:"public,omitempty"`
+
+	// Architecture of the AMI.
+	Architecture string `json:"architecture,omitempty"`
+
+	// SnapshotIDs are the IDs of the EBS snapshots that back the AMI.
+	SnapshotIDs []string `json:"snapshotIds,omitempty"`
+}
+
+// An ImageStatus represents the observed state of an Image.
+type ImageStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ImageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Image is a managed resource that represents an AWS AMI copied from
+// another AMI, possibly in a different region, and the accounts it is shared
+// with. Deleting an Image deregisters the AMI; the snapshots that back it are
+// retained.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.imageState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Image struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageSpec   `json:"spec"`
+	Status ImageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageList contains a list of Images
+type ImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Image `json:"items"`
+}
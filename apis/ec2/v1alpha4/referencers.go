@@ -78,3 +78,29 @@ func (mg *RouteTable) ResolveReferences(ctx context.Context, c client.Reader) er
 
 	return nil
 }
+
+// ResolveReferences of this EC2Fleet
+func (mg *EC2Fleet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.launchTemplateConfigs[].overrides[].subnetId
+	for i := range mg.Spec.ForProvider.LaunchTemplateConfigs {
+		overrides := mg.Spec.ForProvider.LaunchTemplateConfigs[i].Overrides
+		for j := range overrides {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(overrides[j].SubnetID),
+				Reference:    overrides[j].SubnetIDRef,
+				Selector:     overrides[j].SubnetIDSelector,
+				To:           reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+				Extract:      reference.ExternalName(),
+			})
+			if err != nil {
+				return err
+			}
+			overrides[j].SubnetID = reference.ToPtrValue(rsp.ResolvedValue)
+			overrides[j].SubnetIDRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
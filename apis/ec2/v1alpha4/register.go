@@ -48,6 +48,33 @@ var (
 	RouteTableGroupVersionKind = SchemeGroupVersion.WithKind(RouteTableKind)
 )
 
+// Image type metadata.
+var (
+	ImageKind             = reflect.TypeOf(Image{}).Name()
+	ImageGroupKind        = schema.GroupKind{Group: Group, Kind: ImageKind}.String()
+	ImageKindAPIVersion   = ImageKind + "." + SchemeGroupVersion.String()
+	ImageGroupVersionKind = SchemeGroupVersion.WithKind(ImageKind)
+)
+
+// EC2Fleet type metadata.
+var (
+	EC2FleetKind             = reflect.TypeOf(EC2Fleet{}).Name()
+	EC2FleetGroupKind        = schema.GroupKind{Group: Group, Kind: EC2FleetKind}.String()
+	EC2FleetKindAPIVersion   = EC2FleetKind + "." + SchemeGroupVersion.String()
+	EC2FleetGroupVersionKind = SchemeGroupVersion.WithKind(EC2FleetKind)
+)
+
+// EBSEncryptionByDefault type metadata.
+var (
+	EBSEncryptionByDefaultKind             = reflect.TypeOf(EBSEncryptionByDefault{}).Name()
+	EBSEncryptionByDefaultGroupKind        = schema.GroupKind{Group: Group, Kind: EBSEncryptionByDefaultKind}.String()
+	EBSEncryptionByDefaultKindAPIVersion   = EBSEncryptionByDefaultKind + "." + SchemeGroupVersion.String()
+	EBSEncryptionByDefaultGroupVersionKind = SchemeGroupVersion.WithKind(EBSEncryptionByDefaultKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&RouteTable{}, &RouteTableList{})
+	SchemeBuilder.Register(&Image{}, &ImageList{})
+	SchemeBuilder.Register(&EC2Fleet{}, &EC2FleetList{})
+	SchemeBuilder.Register(&EBSEncryptionByDefault{}, &EBSEncryptionByDefaultList{})
 }
@@ -132,6 +132,9 @@ type RouteTableObservation struct {
 	// RouteTableID is the ID of the RouteTable.
 	RouteTableID string `json:"routeTableId,omitempty"`
 
+	// RouteTableARN is the Amazon Resource Name (ARN) of the RouteTable.
+	RouteTableARN string `json:"routeTableArn,omitempty"`
+
 	// The actual routes created for the route table.
 	Routes []RouteState `json:"routes,omitempty"`
 
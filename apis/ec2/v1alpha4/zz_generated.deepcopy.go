@@ -71,6 +71,660 @@ func (in *AssociationState) DeepCopy() *AssociationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSEncryptionByDefault) DeepCopyInto(out *EBSEncryptionByDefault) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSEncryptionByDefault.
+func (in *EBSEncryptionByDefault) DeepCopy() *EBSEncryptionByDefault {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSEncryptionByDefault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EBSEncryptionByDefault) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSEncryptionByDefaultList) DeepCopyInto(out *EBSEncryptionByDefaultList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EBSEncryptionByDefault, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSEncryptionByDefaultList.
+func (in *EBSEncryptionByDefaultList) DeepCopy() *EBSEncryptionByDefaultList {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSEncryptionByDefaultList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EBSEncryptionByDefaultList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSEncryptionByDefaultParameters) DeepCopyInto(out *EBSEncryptionByDefaultParameters) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSEncryptionByDefaultParameters.
+func (in *EBSEncryptionByDefaultParameters) DeepCopy() *EBSEncryptionByDefaultParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSEncryptionByDefaultParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSEncryptionByDefaultSpec) DeepCopyInto(out *EBSEncryptionByDefaultSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSEncryptionByDefaultSpec.
+func (in *EBSEncryptionByDefaultSpec) DeepCopy() *EBSEncryptionByDefaultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSEncryptionByDefaultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSEncryptionByDefaultStatus) DeepCopyInto(out *EBSEncryptionByDefaultStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSEncryptionByDefaultStatus.
+func (in *EBSEncryptionByDefaultStatus) DeepCopy() *EBSEncryptionByDefaultStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSEncryptionByDefaultStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2Fleet) DeepCopyInto(out *EC2Fleet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2Fleet.
+func (in *EC2Fleet) DeepCopy() *EC2Fleet {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2Fleet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EC2Fleet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetList) DeepCopyInto(out *EC2FleetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EC2Fleet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetList.
+func (in *EC2FleetList) DeepCopy() *EC2FleetList {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EC2FleetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetObservation) DeepCopyInto(out *EC2FleetObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetObservation.
+func (in *EC2FleetObservation) DeepCopy() *EC2FleetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetParameters) DeepCopyInto(out *EC2FleetParameters) {
+	*out = *in
+	if in.LaunchTemplateConfigs != nil {
+		in, out := &in.LaunchTemplateConfigs, &out.LaunchTemplateConfigs
+		*out = make([]FleetLaunchTemplateConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.TargetCapacitySpecification.DeepCopyInto(&out.TargetCapacitySpecification)
+	if in.SpotOptions != nil {
+		in, out := &in.SpotOptions, &out.SpotOptions
+		*out = new(FleetSpotOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnDemandOptions != nil {
+		in, out := &in.OnDemandOptions, &out.OnDemandOptions
+		*out = new(FleetOnDemandOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExcessCapacityTerminationPolicy != nil {
+		in, out := &in.ExcessCapacityTerminationPolicy, &out.ExcessCapacityTerminationPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplaceUnhealthyInstances != nil {
+		in, out := &in.ReplaceUnhealthyInstances, &out.ReplaceUnhealthyInstances
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TerminateInstancesWithExpiration != nil {
+		in, out := &in.TerminateInstancesWithExpiration, &out.TerminateInstancesWithExpiration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.TerminateInstancesOnDeletion != nil {
+		in, out := &in.TerminateInstancesOnDeletion, &out.TerminateInstancesOnDeletion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetParameters.
+func (in *EC2FleetParameters) DeepCopy() *EC2FleetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetSpec) DeepCopyInto(out *EC2FleetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetSpec.
+func (in *EC2FleetSpec) DeepCopy() *EC2FleetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetStatus) DeepCopyInto(out *EC2FleetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetStatus.
+func (in *EC2FleetStatus) DeepCopy() *EC2FleetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetLaunchTemplateConfig) DeepCopyInto(out *FleetLaunchTemplateConfig) {
+	*out = *in
+	in.LaunchTemplateSpecification.DeepCopyInto(&out.LaunchTemplateSpecification)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]FleetLaunchTemplateOverrides, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetLaunchTemplateConfig.
+func (in *FleetLaunchTemplateConfig) DeepCopy() *FleetLaunchTemplateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetLaunchTemplateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetLaunchTemplateOverrides) DeepCopyInto(out *FleetLaunchTemplateOverrides) {
+	*out = *in
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceType != nil {
+		in, out := &in.InstanceType, &out.InstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxPrice != nil {
+		in, out := &in.MaxPrice, &out.MaxPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WeightedCapacity != nil {
+		in, out := &in.WeightedCapacity, &out.WeightedCapacity
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetLaunchTemplateOverrides.
+func (in *FleetLaunchTemplateOverrides) DeepCopy() *FleetLaunchTemplateOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetLaunchTemplateOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetLaunchTemplateSpecification) DeepCopyInto(out *FleetLaunchTemplateSpecification) {
+	*out = *in
+	if in.LaunchTemplateID != nil {
+		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTemplateName != nil {
+		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetLaunchTemplateSpecification.
+func (in *FleetLaunchTemplateSpecification) DeepCopy() *FleetLaunchTemplateSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetLaunchTemplateSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetOnDemandOptions) DeepCopyInto(out *FleetOnDemandOptions) {
+	*out = *in
+	if in.AllocationStrategy != nil {
+		in, out := &in.AllocationStrategy, &out.AllocationStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxTotalPrice != nil {
+		in, out := &in.MaxTotalPrice, &out.MaxTotalPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinTargetCapacity != nil {
+		in, out := &in.MinTargetCapacity, &out.MinTargetCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SingleAvailabilityZone != nil {
+		in, out := &in.SingleAvailabilityZone, &out.SingleAvailabilityZone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SingleInstanceType != nil {
+		in, out := &in.SingleInstanceType, &out.SingleInstanceType
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetOnDemandOptions.
+func (in *FleetOnDemandOptions) DeepCopy() *FleetOnDemandOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetOnDemandOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetSpotOptions) DeepCopyInto(out *FleetSpotOptions) {
+	*out = *in
+	if in.AllocationStrategy != nil {
+		in, out := &in.AllocationStrategy, &out.AllocationStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceInterruptionBehavior != nil {
+		in, out := &in.InstanceInterruptionBehavior, &out.InstanceInterruptionBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstancePoolsToUseCount != nil {
+		in, out := &in.InstancePoolsToUseCount, &out.InstancePoolsToUseCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxTotalPrice != nil {
+		in, out := &in.MaxTotalPrice, &out.MaxTotalPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinTargetCapacity != nil {
+		in, out := &in.MinTargetCapacity, &out.MinTargetCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SingleAvailabilityZone != nil {
+		in, out := &in.SingleAvailabilityZone, &out.SingleAvailabilityZone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SingleInstanceType != nil {
+		in, out := &in.SingleInstanceType, &out.SingleInstanceType
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetSpotOptions.
+func (in *FleetSpotOptions) DeepCopy() *FleetSpotOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetSpotOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
+	if in == nil {
+		return nil
+	}
+	out := new(Image)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Image) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageLaunchPermissions) DeepCopyInto(out *ImageLaunchPermissions) {
+	*out = *in
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageLaunchPermissions.
+func (in *ImageLaunchPermissions) DeepCopy() *ImageLaunchPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageLaunchPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageList) DeepCopyInto(out *ImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Image, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageList.
+func (in *ImageList) DeepCopy() *ImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageObservation) DeepCopyInto(out *ImageObservation) {
+	*out = *in
+	if in.SnapshotIDs != nil {
+		in, out := &in.SnapshotIDs, &out.SnapshotIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageObservation.
+func (in *ImageObservation) DeepCopy() *ImageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageParameters) DeepCopyInto(out *ImageParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchPermissions != nil {
+		in, out := &in.LaunchPermissions, &out.LaunchPermissions
+		*out = new(ImageLaunchPermissions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageParameters.
+func (in *ImageParameters) DeepCopy() *ImageParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
+func (in *ImageSpec) DeepCopy() *ImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatus.
+func (in *ImageStatus) DeepCopy() *ImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
@@ -287,3 +941,33 @@ func (in *RouteTableStatus) DeepCopy() *RouteTableStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetCapacitySpecification) DeepCopyInto(out *TargetCapacitySpecification) {
+	*out = *in
+	if in.OnDemandTargetCapacity != nil {
+		in, out := &in.OnDemandTargetCapacity, &out.OnDemandTargetCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SpotTargetCapacity != nil {
+		in, out := &in.SpotTargetCapacity, &out.SpotTargetCapacity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DefaultTargetCapacityType != nil {
+		in, out := &in.DefaultTargetCapacityType, &out.DefaultTargetCapacityType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetCapacitySpecification.
+func (in *TargetCapacitySpecification) DeepCopy() *TargetCapacitySpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetCapacitySpecification)
+	in.DeepCopyInto(out)
+	return out
+}
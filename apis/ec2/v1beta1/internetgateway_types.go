@@ -78,6 +78,9 @@ type InternetGatewayObservation struct {
 	// The ID of the internet gateway.
 	InternetGatewayID string `json:"internetGatewayId"`
 
+	// The Amazon Resource Name (ARN) of the internet gateway.
+	InternetGatewayARN string `json:"internetGatewayArn,omitempty"`
+
 	// The ID of the AWS account that owns the internet gateway.
 	OwnerID string `json:"ownerID"`
 }
@@ -209,6 +209,9 @@ type SecurityGroupObservation struct {
 
 	// SecurityGroupID is the ID of the SecurityGroup.
 	SecurityGroupID string `json:"securityGroupID"`
+
+	// SecurityGroupARN is the Amazon Resource Name (ARN) of the SecurityGroup.
+	SecurityGroupARN string `json:"securityGroupArn,omitempty"`
 }
 
 // A SecurityGroupStatus represents the observed state of a SecurityGroup.
@@ -95,6 +95,9 @@ type SubnetObservation struct {
 
 	// SubnetID is the ID of the Subnet.
 	SubnetID string `json:"subnetId,omitempty"`
+
+	// SubnetARN is the Amazon Resource Name (ARN) of the Subnet.
+	SubnetARN string `json:"subnetArn,omitempty"`
 }
 
 // A SubnetStatus represents the observed state of a Subnet.
@@ -114,6 +114,12 @@ type VPCObservation struct {
 
 	// VPCState is the current state of the VPC.
 	VPCState string `json:"vpcState,omitempty"`
+
+	// VPCID is the ID of the VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// VPCARN is the Amazon Resource Name (ARN) of the VPC.
+	VPCARN string `json:"vpcArn,omitempty"`
 }
 
 // A VPCStatus represents the observed state of a VPC.
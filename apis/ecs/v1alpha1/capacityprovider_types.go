@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ManagedScaling configures how ECS scales the Auto Scaling group of a
+// capacity provider.
+type ManagedScaling struct {
+	// Status of managed scaling.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// TargetCapacity is the target utilization, in percent, of the Auto
+	// Scaling group.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TargetCapacity *int64 `json:"targetCapacity,omitempty"`
+
+	// MinimumScalingStepSize is the minimum number of instances ECS scales
+	// in or out at one time.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	MinimumScalingStepSize *int64 `json:"minimumScalingStepSize,omitempty"`
+
+	// MaximumScalingStepSize is the maximum number of instances ECS scales
+	// in or out at one time.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	MaximumScalingStepSize *int64 `json:"maximumScalingStepSize,omitempty"`
+}
+
+// AutoScalingGroupProvider configures the Auto Scaling group that provides
+// the capacity.
+type AutoScalingGroupProvider struct {
+	// AutoScalingGroupARN is the ARN of the Auto Scaling group.
+	AutoScalingGroupARN string `json:"autoScalingGroupArn"`
+
+	// ManagedScaling configures how ECS scales the Auto Scaling group.
+	// +optional
+	ManagedScaling *ManagedScaling `json:"managedScaling,omitempty"`
+
+	// ManagedTerminationProtection prevents ECS from terminating instances
+	// that run tasks when scaling in. Managed scaling must be enabled and the
+	// Auto Scaling group must have instance protection from scale in enabled
+	// to use it.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	ManagedTerminationProtection *string `json:"managedTerminationProtection,omitempty"`
+}
+
+// CapacityProviderParameters define the desired state of an Amazon ECS
+// capacity provider.
+type CapacityProviderParameters struct {
+	// AutoScalingGroupProvider configures the Auto Scaling group that
+	// provides the capacity.
+	// +immutable
+	AutoScalingGroupProvider AutoScalingGroupProvider `json:"autoScalingGroupProvider"`
+
+	// Tags to assign to the capacity provider when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A CapacityProviderSpec defines the desired state of a CapacityProvider.
+type CapacityProviderSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CapacityProviderParameters `json:"forProvider"`
+}
+
+// CapacityProviderObservation keeps the state for the external resource
+type CapacityProviderObservation struct {
+	// The ARN of the capacity provider.
+	ARN string `json:"arn,omitempty"`
+
+	// Status of the capacity provider.
+	Status string `json:"status,omitempty"`
+}
+
+// A CapacityProviderStatus represents the observed state of a
+// CapacityProvider.
+type CapacityProviderStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CapacityProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CapacityProvider is a managed resource that represents an Amazon ECS
+// capacity provider backed by an Auto Scaling group. The external name of the
+// resource is the capacity provider name. The ECS API does not support
+// deleting capacity providers, so deleting a CapacityProvider only stops
+// managing it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CapacityProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CapacityProviderSpec   `json:"spec"`
+	Status CapacityProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CapacityProviderList contains a list of CapacityProviders
+type CapacityProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CapacityProvider `json:"items"`
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CapacityProviderStrategyItem defines how tasks are spread across a capacity
+// provider.
+type CapacityProviderStrategyItem struct {
+	// CapacityProvider is the name of the capacity provider.
+	// +optional
+	CapacityProvider string `json:"capacityProvider,omitempty"`
+
+	// CapacityProviderRef references a CapacityProvider to retrieve its name.
+	// +optional
+	CapacityProviderRef *runtimev1alpha1.Reference `json:"capacityProviderRef,omitempty"`
+
+	// CapacityProviderSelector selects a reference to a CapacityProvider to
+	// retrieve its name.
+	// +optional
+	CapacityProviderSelector *runtimev1alpha1.Selector `json:"capacityProviderSelector,omitempty"`
+
+	// Base is the minimum number of tasks run on the capacity provider. Only
+	// one capacity provider in a strategy can have a base.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100000
+	// +optional
+	Base *int64 `json:"base,omitempty"`
+
+	// Weight is the relative share of tasks run on the capacity provider.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// ClusterCapacityProvidersParameters define the desired capacity providers of
+// an Amazon ECS cluster.
+type ClusterCapacityProvidersParameters struct {
+	// Cluster is the name or ARN of the ECS cluster.
+	// +immutable
+	Cluster string `json:"cluster"`
+
+	// CapacityProviders are the names of the capacity providers associated
+	// with the cluster. FARGATE and FARGATE_SPOT can be used as well.
+	// +optional
+	CapacityProviders []string `json:"capacityProviders,omitempty"`
+
+	// CapacityProviderRefs references CapacityProviders to retrieve their
+	// names.
+	// +optional
+	CapacityProviderRefs []runtimev1alpha1.Reference `json:"capacityProviderRefs,omitempty"`
+
+	// CapacityProviderSelector selects references to CapacityProviders to
+	// retrieve their names.
+	// +optional
+	CapacityProviderSelector *runtimev1alpha1.Selector `json:"capacityProviderSelector,omitempty"`
+
+	// DefaultCapacityProviderStrategy is used by services and tasks that
+	// are created without a launch type or capacity provider strategy. Every
+	// capacity provider of the strategy must be associated with the cluster.
+	// +optional
+	DefaultCapacityProviderStrategy []CapacityProviderStrategyItem `json:"defaultCapacityProviderStrategy,omitempty"`
+}
+
+// A ClusterCapacityProvidersSpec defines the desired state of a
+// ClusterCapacityProviders.
+type ClusterCapacityProvidersSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ClusterCapacityProvidersParameters `json:"forProvider"`
+}
+
+// ClusterCapacityProvidersObservation keeps the state for the external
+// resource
+type ClusterCapacityProvidersObservation struct {
+	// The ARN of the cluster.
+	ClusterARN string `json:"clusterArn,omitempty"`
+
+	// Status of the cluster.
+	Status string `json:"status,omitempty"`
+
+	// AttachmentsStatus is the status of the capacity provider associations
+	// of the cluster.
+	AttachmentsStatus string `json:"attachmentsStatus,omitempty"`
+}
+
+// A ClusterCapacityProvidersStatus represents the observed state of a
+// ClusterCapacityProviders.
+type ClusterCapacityProvidersStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ClusterCapacityProvidersObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterCapacityProviders is a managed resource that represents the
+// capacity providers and default capacity provider strategy of an existing
+// Amazon ECS cluster. The external name of the resource is the cluster name.
+// Deleting a ClusterCapacityProviders removes all capacity providers from the
+// cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.cluster"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ClusterCapacityProviders struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterCapacityProvidersSpec   `json:"spec"`
+	Status ClusterCapacityProvidersStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterCapacityProvidersList contains a list of ClusterCapacityProviders
+type ClusterCapacityProvidersList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterCapacityProviders `json:"items"`
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+)
+
+// ResolveReferences of this ClusterCapacityProviders
+func (mg *ClusterCapacityProviders) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.capacityProviders
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.CapacityProviders,
+		References:    mg.Spec.ForProvider.CapacityProviderRefs,
+		Selector:      mg.Spec.ForProvider.CapacityProviderSelector,
+		To:            reference.To{Managed: &CapacityProvider{}, List: &CapacityProviderList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CapacityProviders = mrsp.ResolvedValues
+	mg.Spec.ForProvider.CapacityProviderRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.defaultCapacityProviderStrategy[].capacityProvider
+	for i := range mg.Spec.ForProvider.DefaultCapacityProviderStrategy {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.DefaultCapacityProviderStrategy[i].CapacityProvider,
+			Reference:    mg.Spec.ForProvider.DefaultCapacityProviderStrategy[i].CapacityProviderRef,
+			Selector:     mg.Spec.ForProvider.DefaultCapacityProviderStrategy[i].CapacityProviderSelector,
+			To:           reference.To{Managed: &CapacityProvider{}, List: &CapacityProviderList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		mg.Spec.ForProvider.DefaultCapacityProviderStrategy[i].CapacityProvider = rsp.ResolvedValue
+		mg.Spec.ForProvider.DefaultCapacityProviderStrategy[i].CapacityProviderRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
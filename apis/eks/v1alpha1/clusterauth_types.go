@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// RoleMapping maps an IAM role to a Kubernetes user and groups.
+type RoleMapping struct {
+	// RoleARN is the ARN of the IAM role.
+	RoleARN string `json:"rolearn"`
+
+	// Username is the Kubernetes user the role is mapped to, e.g.
+	// system:node:{{EC2PrivateDNSName}}.
+	Username string `json:"username"`
+
+	// Groups are the Kubernetes groups the role is mapped to, e.g.
+	// system:masters.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// UserMapping maps an IAM user to a Kubernetes user and groups.
+type UserMapping struct {
+	// UserARN is the ARN of the IAM user.
+	UserARN string `json:"userarn"`
+
+	// Username is the Kubernetes user the IAM user is mapped to.
+	Username string `json:"username"`
+
+	// Groups are the Kubernetes groups the IAM user is mapped to.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ClusterAuthParameters define the desired IAM mappings of an EKS cluster.
+type ClusterAuthParameters struct {
+	// ClusterName is the name of the cluster whose aws-auth ConfigMap is
+	// managed.
+	// +immutable
+	// +optional
+	ClusterName *string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set the
+	// ClusterName. The kubeconfig the Cluster writes to its connection
+	// secret is used to connect to the cluster, so either the reference or
+	// the selector must be set.
+	// +immutable
+	// +optional
+	ClusterNameRef *runtimev1alpha1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used to set the
+	// ClusterName.
+	// +optional
+	ClusterNameSelector *runtimev1alpha1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// MapRoles are the IAM roles that are granted access to the cluster.
+	// +optional
+	MapRoles []RoleMapping `json:"mapRoles,omitempty"`
+
+	// MapUsers are the IAM users that are granted access to the cluster.
+	// +optional
+	MapUsers []UserMapping `json:"mapUsers,omitempty"`
+
+	// MapAccounts are the IDs of the AWS accounts whose IAM users are
+	// mapped to Kubernetes users of the same name.
+	// +optional
+	MapAccounts []string `json:"mapAccounts,omitempty"`
+}
+
+// A ClusterAuthSpec defines the desired state of a ClusterAuth.
+type ClusterAuthSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ClusterAuthParameters `json:"forProvider"`
+}
+
+// ClusterAuthObservation keeps the state for the external resource
+type ClusterAuthObservation struct {
+	// RoleARNs are the IAM roles last mapped by this resource.
+	RoleARNs []string `json:"roleArns,omitempty"`
+
+	// UserARNs are the IAM users last mapped by this resource.
+	UserARNs []string `json:"userArns,omitempty"`
+
+	// Accounts are the AWS accounts last mapped by this resource.
+	Accounts []string `json:"accounts,omitempty"`
+}
+
+// A ClusterAuthStatus represents the observed state of a ClusterAuth.
+type ClusterAuthStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ClusterAuthObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterAuth is a managed resource that represents the IAM mappings in
+// the aws-auth ConfigMap of an EKS cluster. It connects to the cluster with
+// the kubeconfig published by the referenced Cluster and only adds, updates
+// and removes its own entries, so the entries added by EKS, e.g. for the
+// node roles of managed node groups, are left untouched.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ClusterAuth struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterAuthSpec   `json:"spec"`
+	Status ClusterAuthStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterAuthList contains a list of ClusterAuths
+type ClusterAuthList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAuth `json:"items"`
+}
@@ -45,6 +45,15 @@ var (
 	NodeGroupGroupVersionKind = SchemeGroupVersion.WithKind(NodeGroupKind)
 )
 
+// ClusterAuth type metadata.
+var (
+	ClusterAuthKind             = reflect.TypeOf(ClusterAuth{}).Name()
+	ClusterAuthGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterAuthKind}.String()
+	ClusterAuthKindAPIVersion   = ClusterAuthKind + "." + SchemeGroupVersion.String()
+	ClusterAuthGroupVersionKind = SchemeGroupVersion.WithKind(ClusterAuthKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&NodeGroup{}, &NodeGroupList{})
+	SchemeBuilder.Register(&ClusterAuth{}, &ClusterAuthList{})
 }
@@ -189,6 +189,10 @@ type RemoteAccessConfig struct {
 // NodeGroupScalingConfig is the configuration for scaling a node group.
 type NodeGroupScalingConfig struct {
 	// The current number of worker nodes that the managed node group should maintain.
+	// It is neither late-initialized nor compared with the node group unless
+	// it is set, so that it can be left to the cluster autoscaler. Once set it
+	// can still be left to the autoscaler by ignoring it with the
+	// aws.crossplane.io/ignore-fields annotation.
 	// +optional
 	DesiredSize *int64 `json:"desiredSize,omitempty"`
 
@@ -40,6 +40,178 @@ func (in *AutoScalingGroup) DeepCopy() *AutoScalingGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuth) DeepCopyInto(out *ClusterAuth) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuth.
+func (in *ClusterAuth) DeepCopy() *ClusterAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAuth) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuthList) DeepCopyInto(out *ClusterAuthList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAuth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthList.
+func (in *ClusterAuthList) DeepCopy() *ClusterAuthList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuthList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAuthList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuthObservation) DeepCopyInto(out *ClusterAuthObservation) {
+	*out = *in
+	if in.RoleARNs != nil {
+		in, out := &in.RoleARNs, &out.RoleARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserARNs != nil {
+		in, out := &in.UserARNs, &out.UserARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Accounts != nil {
+		in, out := &in.Accounts, &out.Accounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthObservation.
+func (in *ClusterAuthObservation) DeepCopy() *ClusterAuthObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuthObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuthParameters) DeepCopyInto(out *ClusterAuthParameters) {
+	*out = *in
+	if in.ClusterName != nil {
+		in, out := &in.ClusterName, &out.ClusterName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterNameRef != nil {
+		in, out := &in.ClusterNameRef, &out.ClusterNameRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ClusterNameSelector != nil {
+		in, out := &in.ClusterNameSelector, &out.ClusterNameSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MapRoles != nil {
+		in, out := &in.MapRoles, &out.MapRoles
+		*out = make([]RoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MapUsers != nil {
+		in, out := &in.MapUsers, &out.MapUsers
+		*out = make([]UserMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MapAccounts != nil {
+		in, out := &in.MapAccounts, &out.MapAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthParameters.
+func (in *ClusterAuthParameters) DeepCopy() *ClusterAuthParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuthParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuthSpec) DeepCopyInto(out *ClusterAuthSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthSpec.
+func (in *ClusterAuthSpec) DeepCopy() *ClusterAuthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuthStatus) DeepCopyInto(out *ClusterAuthStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthStatus.
+func (in *ClusterAuthStatus) DeepCopy() *ClusterAuthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Issue) DeepCopyInto(out *Issue) {
 	*out = *in
@@ -393,3 +565,43 @@ func (in *RemoteAccessConfig) DeepCopy() *RemoteAccessConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleMapping) DeepCopyInto(out *RoleMapping) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleMapping.
+func (in *RoleMapping) DeepCopy() *RoleMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserMapping) DeepCopyInto(out *UserMapping) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserMapping.
+func (in *UserMapping) DeepCopy() *UserMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(UserMapping)
+	in.DeepCopyInto(out)
+	return out
+}
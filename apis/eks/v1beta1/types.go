@@ -246,6 +246,26 @@ type ClusterObservation struct {
 
 	// The current status of the cluster.
 	Status ClusterStatusType `json:"status,omitempty"`
+
+	// Update is the last update of the cluster started by the controller,
+	// e.g. a Kubernetes version upgrade.
+	Update *ClusterUpdate `json:"update,omitempty"`
+}
+
+// ClusterUpdate is an update of a cluster.
+type ClusterUpdate struct {
+	// ID of the update.
+	ID string `json:"id"`
+
+	// Type of the update, e.g. VersionUpdate.
+	Type string `json:"type,omitempty"`
+
+	// Status of the update. Can be InProgress, Failed, Cancelled or
+	// Successful.
+	Status string `json:"status,omitempty"`
+
+	// Errors that occurred during the update.
+	Errors []string `json:"errors,omitempty"`
 }
 
 // Identity is the identity information for a cluster.
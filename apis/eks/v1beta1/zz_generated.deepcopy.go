@@ -93,6 +93,11 @@ func (in *ClusterObservation) DeepCopyInto(out *ClusterObservation) {
 	}
 	out.Identity = in.Identity
 	out.ResourcesVpcConfig = in.ResourcesVpcConfig
+	if in.Update != nil {
+		in, out := &in.Update, &out.Update
+		*out = new(ClusterUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservation.
@@ -194,6 +199,26 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpdate) DeepCopyInto(out *ClusterUpdate) {
+	*out = *in
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpdate.
+func (in *ClusterUpdate) DeepCopy() *ClusterUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 	*out = *in
@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// OptionSetting is a configuration option of an environment.
+type OptionSetting struct {
+	// Namespace of the option, e.g. aws:autoscaling:asg.
+	Namespace string `json:"namespace"`
+
+	// OptionName is the name of the option, e.g. MinSize.
+	OptionName string `json:"optionName"`
+
+	// ResourceName is the name of the scheduled action for options of the
+	// aws:autoscaling:scheduledaction namespace.
+	// +optional
+	ResourceName *string `json:"resourceName,omitempty"`
+
+	// Value of the option.
+	Value string `json:"value"`
+}
+
+// EnvironmentTier specifies whether an environment serves web requests or
+// runs a worker.
+type EnvironmentTier struct {
+	// Name of the tier.
+	// +kubebuilder:validation:Enum=WebServer;Worker
+	Name string `json:"name"`
+
+	// Type of the tier.
+	// +kubebuilder:validation:Enum=Standard;SQS/HTTP
+	Type string `json:"type"`
+}
+
+// EnvironmentParameters define the desired state of an AWS Elastic Beanstalk
+// environment.
+type EnvironmentParameters struct {
+	// ApplicationName is the name of the application the environment belongs
+	// to.
+	// +immutable
+	// +optional
+	ApplicationName *string `json:"applicationName,omitempty"`
+
+	// ApplicationNameRef references an Application to retrieve its name.
+	// +optional
+	ApplicationNameRef *runtimev1alpha1.Reference `json:"applicationNameRef,omitempty"`
+
+	// ApplicationNameSelector selects a reference to an Application to
+	// retrieve its name.
+	// +optional
+	ApplicationNameSelector *runtimev1alpha1.Selector `json:"applicationNameSelector,omitempty"`
+
+	// VersionLabel is the label of the application version deployed to the
+	// environment. The sample application is deployed when it is omitted.
+	// +optional
+	VersionLabel *string `json:"versionLabel,omitempty"`
+
+	// VersionLabelRef references an ApplicationVersion to retrieve its label.
+	// +optional
+	VersionLabelRef *runtimev1alpha1.Reference `json:"versionLabelRef,omitempty"`
+
+	// VersionLabelSelector selects a reference to an ApplicationVersion to
+	// retrieve its label.
+	// +optional
+	VersionLabelSelector *runtimev1alpha1.Selector `json:"versionLabelSelector,omitempty"`
+
+	// Description of the environment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// CNAMEPrefix is the prefix of the CNAME of the environment. A random
+	// prefix is used when it is omitted.
+	// +immutable
+	// +optional
+	CNAMEPrefix *string `json:"cnamePrefix,omitempty"`
+
+	// SolutionStackName is the name of the solution stack the environment
+	// runs on, e.g. "64bit Amazon Linux 2 v3.1.0 running Go 1". Exactly one
+	// of SolutionStackName, PlatformARN and TemplateName must be set.
+	// +optional
+	SolutionStackName *string `json:"solutionStackName,omitempty"`
+
+	// PlatformARN is the ARN of the platform the environment runs on.
+	// +optional
+	PlatformARN *string `json:"platformArn,omitempty"`
+
+	// TemplateName is the name of the configuration template the
+	// environment is created from.
+	// +immutable
+	// +optional
+	TemplateName *string `json:"templateName,omitempty"`
+
+	// Tier of the environment. A web server environment is created when it
+	// is omitted.
+	// +immutable
+	// +optional
+	Tier *EnvironmentTier `json:"tier,omitempty"`
+
+	// OptionSettings configure the environment and the AWS resources it
+	// runs on. Options that are not listed keep the values chosen by Elastic
+	// Beanstalk.
+	// +optional
+	OptionSettings []OptionSetting `json:"optionSettings,omitempty"`
+
+	// Tags to assign to the environment when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An EnvironmentSpec defines the desired state of an Environment.
+type EnvironmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EnvironmentParameters `json:"forProvider"`
+}
+
+// EnvironmentObservation keeps the state for the external resource
+type EnvironmentObservation struct {
+	// The ID of the environment.
+	EnvironmentID string `json:"environmentId,omitempty"`
+
+	// The ARN of the environment.
+	ARN string `json:"arn,omitempty"`
+
+	// CNAME is the URL to the load balancer of the environment.
+	CNAME string `json:"cname,omitempty"`
+
+	// EndpointURL is the URL of the load balancer, or the public IP address
+	// of the instance of single-instance environments.
+	EndpointURL string `json:"endpointUrl,omitempty"`
+
+	// Status of the environment.
+	Status string `json:"status,omitempty"`
+
+	// Health of the environment.
+	Health string `json:"health,omitempty"`
+}
+
+// An EnvironmentStatus represents the observed state of an Environment.
+type EnvironmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     EnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Environment is a managed resource that represents an AWS Elastic
+// Beanstalk environment. The external name of the resource is the environment
+// name. The CNAME of the environment is published as the endpoint connection
+// detail.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="HEALTH",type="string",JSONPath=".status.atProvider.health"
+// +kubebuilder:printcolumn:name="CNAME",type="string",JSONPath=".status.atProvider.cname"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environments
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	storagev1alpha3 "github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// ResolveReferences of this ApplicationVersion
+func (mg *ApplicationVersion) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.applicationName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ApplicationName),
+		Reference:    mg.Spec.ForProvider.ApplicationNameRef,
+		Selector:     mg.Spec.ForProvider.ApplicationNameSelector,
+		To:           reference.To{Managed: &Application{}, List: &ApplicationList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.ApplicationName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ApplicationNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sourceBundle.s3Bucket
+	if mg.Spec.ForProvider.SourceBundle != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceBundle.S3Bucket),
+			Reference:    mg.Spec.ForProvider.SourceBundle.S3BucketRef,
+			Selector:     mg.Spec.ForProvider.SourceBundle.S3BucketSelector,
+			To:           reference.To{Managed: &storagev1alpha3.S3Bucket{}, List: &storagev1alpha3.S3BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		mg.Spec.ForProvider.SourceBundle.S3Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.SourceBundle.S3BucketRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Environment
+func (mg *Environment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.applicationName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ApplicationName),
+		Reference:    mg.Spec.ForProvider.ApplicationNameRef,
+		Selector:     mg.Spec.ForProvider.ApplicationNameSelector,
+		To:           reference.To{Managed: &Application{}, List: &ApplicationList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.ApplicationName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ApplicationNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.versionLabel
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VersionLabel),
+		Reference:    mg.Spec.ForProvider.VersionLabelRef,
+		Selector:     mg.Spec.ForProvider.VersionLabelSelector,
+		To:           reference.To{Managed: &ApplicationVersion{}, List: &ApplicationVersionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.VersionLabel = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VersionLabelRef = rsp.ResolvedReference
+
+	return nil
+}
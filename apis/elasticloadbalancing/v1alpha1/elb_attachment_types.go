@@ -38,9 +38,11 @@ type ELBAttachmentParameters struct {
 	// +optional
 	ELBNameSelector *runtimev1alpha1.Selector `json:"elbNameSelector,omitempty"`
 
-	// List of identities of the instances to be attached.
+	// List of identities of the instances to be attached. It may be omitted
+	// when the external name of the ELBAttachment identifies the instance.
 	// +immutable
-	InstanceID string `json:"instanceId"`
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
 }
 
 // An ELBAttachmentSpec defines the desired state of an ELBAttachment.
@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag defines a key value pair that can be attached to a load balancer.
+type Tag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// FixedResponseConfig describes the response of a fixed-response action.
+type FixedResponseConfig struct {
+	// The content type of the response: text/plain, text/css, text/html,
+	// application/javascript or application/json.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// The body of the response.
+	// +optional
+	MessageBody *string `json:"messageBody,omitempty"`
+
+	// The HTTP response code, 2XX, 4XX or 5XX.
+	// +kubebuilder:validation:Pattern=`^(2|4|5)\d\d$`
+	StatusCode string `json:"statusCode"`
+}
+
+// RedirectConfig describes where a redirect action redirects to. Components
+// that are not set keep their value in the original request.
+type RedirectConfig struct {
+	// The hostname to redirect to.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// The absolute path to redirect to, starting with a slash.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// The port to redirect to, from 1 to 65535.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// The protocol to redirect to, HTTP or HTTPS.
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The query to redirect to, without the leading question mark.
+	// +optional
+	Query *string `json:"query,omitempty"`
+
+	// The HTTP redirect code, HTTP_301 or HTTP_302.
+	// +kubebuilder:validation:Enum=HTTP_301;HTTP_302
+	StatusCode string `json:"statusCode"`
+}
+
+// An Action is what a listener does with the requests it receives.
+type Action struct {
+	// The type of the action. Network Load Balancers only support forward.
+	// +kubebuilder:validation:Enum=forward;redirect;fixed-response
+	Type string `json:"type"`
+
+	// The order in which the actions are performed, from 1 to 50000.
+	// +optional
+	Order *int64 `json:"order,omitempty"`
+
+	// The ARN of the target group to forward requests to. Required for
+	// forward actions.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupArn,omitempty"`
+
+	// The response of a fixed-response action.
+	// +optional
+	FixedResponseConfig *FixedResponseConfig `json:"fixedResponseConfig,omitempty"`
+
+	// The redirect of a redirect action.
+	// +optional
+	RedirectConfig *RedirectConfig `json:"redirectConfig,omitempty"`
+}
+
+// A Listener checks for connection requests on a port of the load balancer.
+type Listener struct {
+	// The port on which the load balancer is listening. Each listener of a
+	// load balancer must listen on a different port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// The protocol of the listener. Application Load Balancers support HTTP
+	// and HTTPS, Network Load Balancers support TCP, TLS, UDP and TCP_UDP.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	Protocol string `json:"protocol"`
+
+	// The ARN of the default certificate of the listener. Required for HTTPS
+	// and TLS listeners.
+	// +optional
+	CertificateARN *string `json:"certificateArn,omitempty"`
+
+	// The security policy that defines which protocols and ciphers HTTPS and
+	// TLS listeners support. Defaults to ELBSecurityPolicy-2016-08.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// The actions of the listener for requests no rule matches.
+	// +kubebuilder:validation:MinItems=1
+	DefaultActions []Action `json:"defaultActions"`
+}
+
+// LoadBalancerParameters define the desired state of an AWS Application or
+// Network Load Balancer.
+type LoadBalancerParameters struct {
+	// The type of the load balancer, application or network. Defaults to
+	// application.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=application;network
+	Type *string `json:"type,omitempty"`
+
+	// The scheme of the load balancer, internet-facing or internal. Defaults
+	// to internet-facing.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=internet-facing;internal
+	Scheme *string `json:"scheme,omitempty"`
+
+	// The type of IP addresses the subnets of the load balancer use, ipv4 or
+	// dualstack. Internal load balancers must use ipv4.
+	// +optional
+	// +kubebuilder:validation:Enum=ipv4;dualstack
+	IPAddressType *string `json:"ipAddressType,omitempty"`
+
+	// The IDs of the subnets to attach to the load balancer, at most one per
+	// Availability Zone. Application Load Balancers need subnets in at least
+	// two Availability Zones. The subnets of Network Load Balancers cannot
+	// be changed once it is created.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references to a Subnet to and retrieves its SubnetID
+	// +optional
+	SubnetIDRefs []runtimev1alpha1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects a set of references that each retrieve the subnetID from the referenced Subnet
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The IDs of the security groups to assign to the load balancer. Only
+	// Application Load Balancers have security groups.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references to a SecurityGroup and retrieves its SecurityGroupID
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects a set of references that each retrieve the SecurityGroupID from the referenced SecurityGroup
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// The listeners of the load balancer.
+	// +optional
+	Listeners []Listener `json:"listeners,omitempty"`
+
+	// A list of tags to assign to the load balancer.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LoadBalancerSpec defines the desired state of a LoadBalancer.
+type LoadBalancerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LoadBalancerParameters `json:"forProvider"`
+}
+
+// LoadBalancerObservation keeps the state for the external resource
+type LoadBalancerObservation struct {
+	// The Amazon Resource Name (ARN) of the load balancer.
+	LoadBalancerARN string `json:"loadBalancerArn,omitempty"`
+
+	// The DNS name of the load balancer.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// The ID of the Amazon Route 53 hosted zone of the load balancer.
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneId,omitempty"`
+
+	// The ID of the VPC of the load balancer.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// The state of the load balancer, one of active, provisioning,
+	// active_impaired or failed.
+	State string `json:"state,omitempty"`
+
+	// The Availability Zones of the load balancer.
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+}
+
+// A LoadBalancerStatus represents the observed state of a LoadBalancer.
+type LoadBalancerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LoadBalancerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoadBalancer is a managed resource that represents an AWS Application or
+// Network Load Balancer. The external name of the resource is the name of
+// the load balancer.
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="DNSNAME",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerSpec   `json:"spec"`
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancers
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
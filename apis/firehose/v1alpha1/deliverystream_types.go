@@ -0,0 +1,436 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// BufferingHints specifies how long and how much data is buffered before it
+// is delivered to the destination. Whichever limit is reached first triggers
+// the delivery.
+type BufferingHints struct {
+	// IntervalInSeconds is how long data is buffered.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=900
+	// +optional
+	IntervalInSeconds *int64 `json:"intervalInSeconds,omitempty"`
+
+	// SizeInMBs is how much data is buffered.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	SizeInMBs *int64 `json:"sizeInMBs,omitempty"`
+}
+
+// CloudWatchLoggingOptions specifies where delivery errors are logged.
+type CloudWatchLoggingOptions struct {
+	// Enabled controls whether delivery errors are logged.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// LogGroupName is the name of the CloudWatch Logs log group.
+	// +optional
+	LogGroupName *string `json:"logGroupName,omitempty"`
+
+	// LogStreamName is the name of the CloudWatch Logs log stream.
+	// +optional
+	LogStreamName *string `json:"logStreamName,omitempty"`
+}
+
+// ProcessorParameter is a parameter of a data processor.
+type ProcessorParameter struct {
+	// ParameterName is the name of the parameter, e.g. LambdaArn.
+	// +kubebuilder:validation:Enum=LambdaArn;NumberOfRetries;RoleArn;BufferSizeInMBs;BufferIntervalInSeconds
+	ParameterName string `json:"parameterName"`
+
+	// ParameterValue is the value of the parameter.
+	ParameterValue string `json:"parameterValue"`
+}
+
+// Processor is a data processor that transforms records before they are
+// delivered.
+type Processor struct {
+	// Type of the processor.
+	// +kubebuilder:validation:Enum=Lambda
+	Type string `json:"type"`
+
+	// Parameters of the processor. A Lambda processor requires the LambdaArn
+	// parameter to be set to the ARN of the function that transforms the
+	// records.
+	// +optional
+	Parameters []ProcessorParameter `json:"parameters,omitempty"`
+}
+
+// ProcessingConfiguration specifies how records are transformed before they
+// are delivered.
+type ProcessingConfiguration struct {
+	// Enabled controls whether records are processed.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Processors that transform the records.
+	// +optional
+	Processors []Processor `json:"processors,omitempty"`
+}
+
+// HiveJSONSerDe specifies the Apache Hive JSON SerDe used to deserialize
+// input records.
+type HiveJSONSerDe struct {
+	// TimestampFormats are the Joda-Time patterns used to parse timestamps.
+	// +optional
+	TimestampFormats []string `json:"timestampFormats,omitempty"`
+}
+
+// OpenXJSONSerDe specifies the OpenX JSON SerDe used to deserialize input
+// records.
+type OpenXJSONSerDe struct {
+	// CaseInsensitive controls whether JSON keys are lowercased before they
+	// are deserialized.
+	// +optional
+	CaseInsensitive *bool `json:"caseInsensitive,omitempty"`
+
+	// ColumnToJSONKeyMappings maps column names to JSON keys that differ
+	// from them.
+	// +optional
+	ColumnToJSONKeyMappings map[string]string `json:"columnToJsonKeyMappings,omitempty"`
+
+	// ConvertDotsInJSONKeysToUnderscores controls whether dots in JSON keys
+	// are replaced with underscores.
+	// +optional
+	ConvertDotsInJSONKeysToUnderscores *bool `json:"convertDotsInJsonKeysToUnderscores,omitempty"`
+}
+
+// Deserializer specifies how input records are deserialized. Exactly one of
+// its fields must be set.
+type Deserializer struct {
+	// HiveJSONSerDe uses the Apache Hive JSON SerDe.
+	// +optional
+	HiveJSONSerDe *HiveJSONSerDe `json:"hiveJsonSerDe,omitempty"`
+
+	// OpenXJSONSerDe uses the OpenX JSON SerDe.
+	// +optional
+	OpenXJSONSerDe *OpenXJSONSerDe `json:"openXJsonSerDe,omitempty"`
+}
+
+// InputFormatConfiguration specifies the format of input records.
+type InputFormatConfiguration struct {
+	// Deserializer used to read input records.
+	Deserializer Deserializer `json:"deserializer"`
+}
+
+// OrcSerDe specifies the ORC SerDe used to serialize output records.
+type OrcSerDe struct {
+	// BlockSizeBytes is the HDFS block size.
+	// +kubebuilder:validation:Minimum=67108864
+	// +optional
+	BlockSizeBytes *int64 `json:"blockSizeBytes,omitempty"`
+
+	// BloomFilterColumns are the columns a Bloom filter is created for.
+	// +optional
+	BloomFilterColumns []string `json:"bloomFilterColumns,omitempty"`
+
+	// Compression codec of the ORC files.
+	// +kubebuilder:validation:Enum=NONE;ZLIB;SNAPPY
+	// +optional
+	Compression *string `json:"compression,omitempty"`
+
+	// EnablePadding controls whether stripes are padded to HDFS block
+	// boundaries.
+	// +optional
+	EnablePadding *bool `json:"enablePadding,omitempty"`
+
+	// FormatVersion of the ORC files.
+	// +kubebuilder:validation:Enum=V0_11;V0_12
+	// +optional
+	FormatVersion *string `json:"formatVersion,omitempty"`
+
+	// StripeSizeBytes is the number of bytes in each stripe.
+	// +kubebuilder:validation:Minimum=8388608
+	// +optional
+	StripeSizeBytes *int64 `json:"stripeSizeBytes,omitempty"`
+}
+
+// ParquetSerDe specifies the Parquet SerDe used to serialize output records.
+type ParquetSerDe struct {
+	// BlockSizeBytes is the HDFS block size.
+	// +kubebuilder:validation:Minimum=67108864
+	// +optional
+	BlockSizeBytes *int64 `json:"blockSizeBytes,omitempty"`
+
+	// Compression codec of the Parquet files.
+	// +kubebuilder:validation:Enum=UNCOMPRESSED;GZIP;SNAPPY
+	// +optional
+	Compression *string `json:"compression,omitempty"`
+
+	// EnableDictionaryCompression controls whether dictionary compression is
+	// used.
+	// +optional
+	EnableDictionaryCompression *bool `json:"enableDictionaryCompression,omitempty"`
+
+	// MaxPaddingBytes is the maximum amount of padding to apply.
+	// +optional
+	MaxPaddingBytes *int64 `json:"maxPaddingBytes,omitempty"`
+
+	// PageSizeBytes is the Parquet page size.
+	// +kubebuilder:validation:Minimum=65536
+	// +optional
+	PageSizeBytes *int64 `json:"pageSizeBytes,omitempty"`
+
+	// WriterVersion of the Parquet files.
+	// +kubebuilder:validation:Enum=V1;V2
+	// +optional
+	WriterVersion *string `json:"writerVersion,omitempty"`
+}
+
+// Serializer specifies how output records are serialized. Exactly one of its
+// fields must be set.
+type Serializer struct {
+	// OrcSerDe writes Apache ORC files.
+	// +optional
+	OrcSerDe *OrcSerDe `json:"orcSerDe,omitempty"`
+
+	// ParquetSerDe writes Apache Parquet files.
+	// +optional
+	ParquetSerDe *ParquetSerDe `json:"parquetSerDe,omitempty"`
+}
+
+// OutputFormatConfiguration specifies the format records are written in.
+type OutputFormatConfiguration struct {
+	// Serializer used to write output records.
+	Serializer Serializer `json:"serializer"`
+}
+
+// SchemaConfiguration specifies the AWS Glue table that holds the schema of
+// the records.
+type SchemaConfiguration struct {
+	// CatalogID is the ID of the Glue Data Catalog. Defaults to the ID of the
+	// account.
+	// +optional
+	CatalogID *string `json:"catalogId,omitempty"`
+
+	// DatabaseName is the name of the Glue database that contains the table.
+	DatabaseName string `json:"databaseName"`
+
+	// Region of the Glue table. Defaults to the region of the delivery
+	// stream.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// RoleARN is the ARN of the IAM role used to access the Glue table.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// TableName is the name of the Glue table.
+	TableName string `json:"tableName"`
+
+	// VersionID is the version of the table schema to use. Defaults to
+	// LATEST, which always uses the most recent version.
+	// +optional
+	VersionID *string `json:"versionId,omitempty"`
+}
+
+// DataFormatConversionConfiguration specifies how JSON input records are
+// converted to Apache Parquet or Apache ORC before they are delivered.
+type DataFormatConversionConfiguration struct {
+	// Enabled controls whether records are converted.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// InputFormatConfiguration specifies the format of input records.
+	InputFormatConfiguration InputFormatConfiguration `json:"inputFormatConfiguration"`
+
+	// OutputFormatConfiguration specifies the format records are written
+	// in.
+	OutputFormatConfiguration OutputFormatConfiguration `json:"outputFormatConfiguration"`
+
+	// SchemaConfiguration specifies the Glue table that holds the schema of
+	// the records.
+	SchemaConfiguration SchemaConfiguration `json:"schemaConfiguration"`
+}
+
+// ExtendedS3DestinationConfiguration specifies an Amazon S3 destination.
+type ExtendedS3DestinationConfiguration struct {
+	// BucketARN is the ARN of the S3 bucket records are delivered to.
+	BucketARN string `json:"bucketArn"`
+
+	// BufferingHints specifies how data is buffered before it is delivered.
+	// +optional
+	BufferingHints *BufferingHints `json:"bufferingHints,omitempty"`
+
+	// CloudWatchLoggingOptions specifies where delivery errors are logged.
+	// +optional
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `json:"cloudWatchLoggingOptions,omitempty"`
+
+	// CompressionFormat of the delivered objects. Must be UNCOMPRESSED if
+	// data format conversion is enabled.
+	// +kubebuilder:validation:Enum=UNCOMPRESSED;GZIP;ZIP;Snappy;HADOOP_SNAPPY
+	// +optional
+	CompressionFormat *string `json:"compressionFormat,omitempty"`
+
+	// DataFormatConversionConfiguration specifies how records are converted
+	// to a columnar format before they are delivered.
+	// +optional
+	DataFormatConversionConfiguration *DataFormatConversionConfiguration `json:"dataFormatConversionConfiguration,omitempty"`
+
+	// ErrorOutputPrefix is prepended to the keys of objects that contain
+	// records that could not be delivered.
+	// +optional
+	ErrorOutputPrefix *string `json:"errorOutputPrefix,omitempty"`
+
+	// Prefix is prepended to the keys of delivered objects.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// ProcessingConfiguration specifies how records are transformed before
+	// they are delivered.
+	// +optional
+	ProcessingConfiguration *ProcessingConfiguration `json:"processingConfiguration,omitempty"`
+
+	// RoleARN is the ARN of the IAM role used to deliver records.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+}
+
+// KinesisStreamSourceConfiguration specifies the Kinesis data stream a
+// delivery stream reads from.
+type KinesisStreamSourceConfiguration struct {
+	// KinesisStreamARN is the ARN of the Kinesis data stream.
+	KinesisStreamARN string `json:"kinesisStreamArn"`
+
+	// RoleARN is the ARN of the IAM role used to read from the stream.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+}
+
+// Tag is a key-value pair assigned to a delivery stream.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// DeliveryStreamParameters define the desired state of an AWS Kinesis Data
+// Firehose delivery stream.
+type DeliveryStreamParameters struct {
+	// DeliveryStreamType is the kind of source the delivery stream reads
+	// from. Defaults to DirectPut.
+	// +kubebuilder:validation:Enum=DirectPut;KinesisStreamAsSource
+	// +immutable
+	// +optional
+	DeliveryStreamType *string `json:"deliveryStreamType,omitempty"`
+
+	// ExtendedS3DestinationConfiguration specifies the S3 destination
+	// records are delivered to.
+	ExtendedS3DestinationConfiguration ExtendedS3DestinationConfiguration `json:"extendedS3DestinationConfiguration"`
+
+	// KinesisStreamSourceConfiguration specifies the Kinesis data stream
+	// the delivery stream reads from. Required if DeliveryStreamType is
+	// KinesisStreamAsSource.
+	// +immutable
+	// +optional
+	KinesisStreamSourceConfiguration *KinesisStreamSourceConfiguration `json:"kinesisStreamSourceConfiguration,omitempty"`
+
+	// Tags to assign to the delivery stream when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DeliveryStreamSpec defines the desired state of a DeliveryStream.
+type DeliveryStreamSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DeliveryStreamParameters `json:"forProvider"`
+}
+
+// DeliveryStreamObservation keeps the state for the external resource.
+type DeliveryStreamObservation struct {
+	// ARN of the delivery stream.
+	ARN string `json:"arn,omitempty"`
+
+	// DestinationID is the ID of the S3 destination of the delivery stream.
+	DestinationID string `json:"destinationId,omitempty"`
+
+	// Status of the delivery stream.
+	Status string `json:"status,omitempty"`
+
+	// VersionID is the version of the configuration of the delivery stream.
+	// It changes every time the destination is updated.
+	VersionID string `json:"versionId,omitempty"`
+}
+
+// A DeliveryStreamStatus represents the observed state of a DeliveryStream.
+type DeliveryStreamStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DeliveryStreamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DeliveryStream is a managed resource that represents an AWS Kinesis Data
+// Firehose delivery stream.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DeliveryStream struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeliveryStreamSpec   `json:"spec"`
+	Status DeliveryStreamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeliveryStreamList contains a list of DeliveryStreams
+type DeliveryStreamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeliveryStream `json:"items"`
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ResolveReferences of this DeliveryStream
+func (mg *DeliveryStream) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.extendedS3DestinationConfiguration.roleArn
+	d := &mg.Spec.ForProvider.ExtendedS3DestinationConfiguration
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(d.RoleARN),
+		Reference:    d.RoleARNRef,
+		Selector:     d.RoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return err
+	}
+	d.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	d.RoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.extendedS3DestinationConfiguration.dataFormatConversionConfiguration.schemaConfiguration.roleArn
+	if d.DataFormatConversionConfiguration != nil {
+		s := &d.DataFormatConversionConfiguration.SchemaConfiguration
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(s.RoleARN),
+			Reference:    s.RoleARNRef,
+			Selector:     s.RoleARNSelector,
+			To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+			Extract:      iamv1beta1.IAMRoleARN(),
+		})
+		if err != nil {
+			return err
+		}
+		s.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+		s.RoleARNRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.kinesisStreamSourceConfiguration.roleArn
+	if k := mg.Spec.ForProvider.KinesisStreamSourceConfiguration; k != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(k.RoleARN),
+			Reference:    k.RoleARNRef,
+			Selector:     k.RoleARNSelector,
+			To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+			Extract:      iamv1beta1.IAMRoleARN(),
+		})
+		if err != nil {
+			return err
+		}
+		k.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+		k.RoleARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
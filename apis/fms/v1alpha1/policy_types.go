@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SecurityServicePolicyData describes the security service that protects the
+// resources in scope of a policy.
+type SecurityServicePolicyData struct {
+	// Type of the security service, which determines the type of the policy.
+	// +immutable
+	// +kubebuilder:validation:Enum=WAF;WAFV2;SHIELD_ADVANCED;SECURITY_GROUPS_COMMON;SECURITY_GROUPS_CONTENT_AUDIT;SECURITY_GROUPS_USAGE_AUDIT
+	Type string `json:"type"`
+
+	// ManagedServiceData is the JSON document with the details that are
+	// specific to the service type. It is empty for SHIELD_ADVANCED.
+	// +optional
+	ManagedServiceData *string `json:"managedServiceData,omitempty"`
+}
+
+// PolicyScope lists the accounts and organizational units of an AWS
+// Organization that a policy includes or excludes. An organizational unit
+// covers all of its accounts and child organizational units, including the
+// ones that are added later.
+type PolicyScope struct {
+	// Accounts is the list of AWS account IDs.
+	// +optional
+	Accounts []string `json:"accounts,omitempty"`
+
+	// OrganizationalUnits is the list of organizational unit IDs.
+	// +optional
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty"`
+}
+
+// ResourceTag is a tag that resources in scope of a policy are matched
+// against.
+type ResourceTag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// PolicyParameters define the desired state of an AWS Firewall Manager
+// policy.
+type PolicyParameters struct {
+	// Name of the policy.
+	Name string `json:"name"`
+
+	// SecurityServicePolicyData describes the security service that protects
+	// the resources in scope of the policy.
+	SecurityServicePolicyData SecurityServicePolicyData `json:"securityServicePolicyData"`
+
+	// ResourceType is the AWS CloudFormation type of the resources in scope of
+	// the policy, for example AWS::ElasticLoadBalancingV2::LoadBalancer.
+	ResourceType string `json:"resourceType"`
+
+	// ResourceTypeList is the list of resource types in scope of the policy,
+	// for policies that cover more than one type.
+	// +optional
+	ResourceTypeList []string `json:"resourceTypeList,omitempty"`
+
+	// ResourceTags selects the resources in scope of the policy by tag.
+	// +optional
+	ResourceTags []ResourceTag `json:"resourceTags,omitempty"`
+
+	// ExcludeResourceTags excludes the resources with the ResourceTags from
+	// the policy instead of including only them.
+	// +optional
+	ExcludeResourceTags bool `json:"excludeResourceTags,omitempty"`
+
+	// RemediationEnabled applies the policy automatically to new and
+	// non-compliant resources.
+	// +optional
+	RemediationEnabled bool `json:"remediationEnabled,omitempty"`
+
+	// IncludeMap lists the accounts and organizational units the policy
+	// applies to. If it is set, ExcludeMap is ignored.
+	// +optional
+	IncludeMap *PolicyScope `json:"includeMap,omitempty"`
+
+	// ExcludeMap lists the accounts and organizational units the policy does
+	// not apply to.
+	// +optional
+	ExcludeMap *PolicyScope `json:"excludeMap,omitempty"`
+
+	// DeleteAllPolicyResources cleans up the resources that Firewall Manager
+	// created for the policy, such as web ACLs and security groups, when the
+	// policy is deleted.
+	// +optional
+	DeleteAllPolicyResources *bool `json:"deleteAllPolicyResources,omitempty"`
+}
+
+// A PolicySpec defines the desired state of a Policy.
+type PolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PolicyParameters `json:"forProvider"`
+}
+
+// PolicyObservation keeps the state for the external resource
+type PolicyObservation struct {
+	// ARN of the policy.
+	ARN string `json:"arn,omitempty"`
+
+	// PolicyUpdateToken identifies the current version of the policy.
+	PolicyUpdateToken string `json:"policyUpdateToken,omitempty"`
+}
+
+// A PolicyStatus represents the observed state of a Policy.
+type PolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Policy is a managed resource that represents an AWS Firewall Manager
+// policy, which applies a security service across the accounts of an AWS
+// Organization. It must be managed with the credentials of the Firewall
+// Manager administrator account. The external name of the resource is the
+// policy ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.securityServicePolicyData.type"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Policy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicySpec   `json:"spec"`
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyList contains a list of Policies
+type PolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Policy `json:"items"`
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// JobCommand specifies the script a job runs.
+type JobCommand struct {
+	// Name of the job command: glueetl for an Apache Spark ETL job,
+	// gluestreaming for a streaming ETL job or pythonshell for a Python shell
+	// job.
+	// +kubebuilder:validation:Enum=glueetl;gluestreaming;pythonshell
+	Name string `json:"name"`
+
+	// PythonVersion is the Python version the script runs with, e.g. 3.
+	// +optional
+	PythonVersion *string `json:"pythonVersion,omitempty"`
+
+	// ScriptS3Bucket is the name of the S3 bucket the script is stored in.
+	// +optional
+	ScriptS3Bucket *string `json:"scriptS3Bucket,omitempty"`
+
+	// ScriptS3BucketRef references an S3Bucket to retrieve its name.
+	// +optional
+	ScriptS3BucketRef *runtimev1alpha1.Reference `json:"scriptS3BucketRef,omitempty"`
+
+	// ScriptS3BucketSelector selects a reference to an S3Bucket to retrieve
+	// its name.
+	// +optional
+	ScriptS3BucketSelector *runtimev1alpha1.Selector `json:"scriptS3BucketSelector,omitempty"`
+
+	// ScriptS3Key is the key of the script within ScriptS3Bucket.
+	ScriptS3Key string `json:"scriptS3Key"`
+}
+
+// JobParameters define the desired state of an AWS Glue job.
+type JobParameters struct {
+	// Command specifies the script the job runs.
+	Command JobCommand `json:"command"`
+
+	// Connections are the names of the Glue connections the job uses, e.g.
+	// to reach a database in a VPC.
+	// +optional
+	Connections []string `json:"connections,omitempty"`
+
+	// DefaultArguments are passed to the script on every run unless a run
+	// overrides them.
+	// +optional
+	DefaultArguments map[string]string `json:"defaultArguments,omitempty"`
+
+	// Description of the job.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// GlueVersion determines the versions of Apache Spark and Python the job
+	// runs with, e.g. 2.0.
+	// +optional
+	GlueVersion *string `json:"glueVersion,omitempty"`
+
+	// MaxConcurrentRuns is the maximum number of concurrent runs of the job.
+	// +optional
+	MaxConcurrentRuns *int64 `json:"maxConcurrentRuns,omitempty"`
+
+	// MaxRetries is the maximum number of times a failed run is retried.
+	// +optional
+	MaxRetries *int64 `json:"maxRetries,omitempty"`
+
+	// NonOverridableArguments are passed to the script on every run and
+	// cannot be overridden by a run.
+	// +optional
+	NonOverridableArguments map[string]string `json:"nonOverridableArguments,omitempty"`
+
+	// NotifyDelayAfter is the number of minutes after a run starts before a
+	// delay notification is sent.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NotifyDelayAfter *int64 `json:"notifyDelayAfter,omitempty"`
+
+	// NumberOfWorkers is the number of workers of WorkerType that are
+	// allocated when the job runs.
+	// +optional
+	NumberOfWorkers *int64 `json:"numberOfWorkers,omitempty"`
+
+	// Role is the name or ARN of the IAM role the job runs with.
+	// +optional
+	Role *string `json:"role,omitempty"`
+
+	// RoleRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleRef *runtimev1alpha1.Reference `json:"roleRef,omitempty"`
+
+	// RoleSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleSelector *runtimev1alpha1.Selector `json:"roleSelector,omitempty"`
+
+	// SecurityConfiguration is the name of the Glue security configuration
+	// the job uses.
+	// +optional
+	SecurityConfiguration *string `json:"securityConfiguration,omitempty"`
+
+	// Tags to assign to the job when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Timeout is the number of minutes a run may take before it is stopped.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty"`
+
+	// WorkerType is the type of the workers that are allocated when the job
+	// runs.
+	// +kubebuilder:validation:Enum=Standard;G.1X;G.2X
+	// +optional
+	WorkerType *string `json:"workerType,omitempty"`
+}
+
+// A JobSpec defines the desired state of a Job.
+type JobSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  JobParameters `json:"forProvider"`
+}
+
+// JobObservation keeps the state for the external resource.
+type JobObservation struct {
+	// CreatedOn is the time the job was created.
+	CreatedOn *metav1.Time `json:"createdOn,omitempty"`
+
+	// LastModifiedOn is the time the job was last modified.
+	LastModifiedOn *metav1.Time `json:"lastModifiedOn,omitempty"`
+}
+
+// A JobStatus represents the observed state of a Job.
+type JobStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     JobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Job is a managed resource that represents an AWS Glue job.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Job struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobSpec   `json:"spec"`
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobList contains a list of Jobs
+type JobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Job `json:"items"`
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+)
+
+// ResolveReferences of this Job
+func (mg *Job) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.command.scriptS3Bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Command.ScriptS3Bucket),
+		Reference:    mg.Spec.ForProvider.Command.ScriptS3BucketRef,
+		Selector:     mg.Spec.ForProvider.Command.ScriptS3BucketSelector,
+		To:           reference.To{Managed: &storagev1alpha3.S3Bucket{}, List: &storagev1alpha3.S3BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.Command.ScriptS3Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Command.ScriptS3BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.role
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Role),
+		Reference:    mg.Spec.ForProvider.RoleRef,
+		Selector:     mg.Spec.ForProvider.RoleSelector,
+		To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+		Extract:      iamv1beta1.IAMRoleARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.Role = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Trigger
+func (mg *Trigger) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.actions[].jobName
+	for i := range mg.Spec.ForProvider.Actions {
+		a := &mg.Spec.ForProvider.Actions[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(a.JobName),
+			Reference:    a.JobNameRef,
+			Selector:     a.JobNameSelector,
+			To:           reference.To{Managed: &Job{}, List: &JobList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		a.JobName = reference.ToPtrValue(rsp.ResolvedValue)
+		a.JobNameRef = rsp.ResolvedReference
+	}
+
+	if mg.Spec.ForProvider.Predicate == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.predicate.conditions[].jobName
+	for i := range mg.Spec.ForProvider.Predicate.Conditions {
+		cd := &mg.Spec.ForProvider.Predicate.Conditions[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(cd.JobName),
+			Reference:    cd.JobNameRef,
+			Selector:     cd.JobNameSelector,
+			To:           reference.To{Managed: &Job{}, List: &JobList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		cd.JobName = reference.ToPtrValue(rsp.ResolvedValue)
+		cd.JobNameRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
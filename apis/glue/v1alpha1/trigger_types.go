@@ -0,0 +1,199 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TriggerAction specifies a job or crawler that a trigger starts.
+type TriggerAction struct {
+	// Arguments passed to the job, overriding its default arguments.
+	// +optional
+	Arguments map[string]string `json:"arguments,omitempty"`
+
+	// CrawlerName is the name of the crawler to start.
+	// +optional
+	CrawlerName *string `json:"crawlerName,omitempty"`
+
+	// JobName is the name of the job to start.
+	// +optional
+	JobName *string `json:"jobName,omitempty"`
+
+	// JobNameRef references a Job to retrieve its name.
+	// +optional
+	JobNameRef *runtimev1alpha1.Reference `json:"jobNameRef,omitempty"`
+
+	// JobNameSelector selects a reference to a Job to retrieve its name.
+	// +optional
+	JobNameSelector *runtimev1alpha1.Selector `json:"jobNameSelector,omitempty"`
+
+	// NotifyDelayAfter is the number of minutes after a run starts before a
+	// delay notification is sent.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NotifyDelayAfter *int64 `json:"notifyDelayAfter,omitempty"`
+
+	// SecurityConfiguration is the name of the Glue security configuration
+	// the run uses.
+	// +optional
+	SecurityConfiguration *string `json:"securityConfiguration,omitempty"`
+
+	// Timeout is the number of minutes the run may take before it is
+	// stopped, overriding the timeout of the job.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty"`
+}
+
+// TriggerCondition is a condition on the outcome of a job or crawler run.
+type TriggerCondition struct {
+	// CrawlerName is the name of the crawler whose run is watched.
+	// +optional
+	CrawlerName *string `json:"crawlerName,omitempty"`
+
+	// CrawlState is the state the crawl must reach.
+	// +kubebuilder:validation:Enum=RUNNING;CANCELLING;CANCELLED;SUCCEEDED;FAILED
+	// +optional
+	CrawlState *string `json:"crawlState,omitempty"`
+
+	// JobName is the name of the job whose run is watched.
+	// +optional
+	JobName *string `json:"jobName,omitempty"`
+
+	// JobNameRef references a Job to retrieve its name.
+	// +optional
+	JobNameRef *runtimev1alpha1.Reference `json:"jobNameRef,omitempty"`
+
+	// JobNameSelector selects a reference to a Job to retrieve its name.
+	// +optional
+	JobNameSelector *runtimev1alpha1.Selector `json:"jobNameSelector,omitempty"`
+
+	// LogicalOperator compares the state of the run with State or
+	// CrawlState. Defaults to EQUALS.
+	// +kubebuilder:validation:Enum=EQUALS
+	// +optional
+	LogicalOperator *string `json:"logicalOperator,omitempty"`
+
+	// State is the state the job run must reach.
+	// +kubebuilder:validation:Enum=STARTING;RUNNING;STOPPING;STOPPED;SUCCEEDED;FAILED;TIMEOUT
+	// +optional
+	State *string `json:"state,omitempty"`
+}
+
+// TriggerPredicate specifies the conditions that fire a conditional trigger.
+type TriggerPredicate struct {
+	// Conditions on the outcome of job or crawler runs.
+	Conditions []TriggerCondition `json:"conditions"`
+
+	// Logical determines whether all (AND) or any (ANY) of the conditions
+	// must be met. Defaults to AND.
+	// +kubebuilder:validation:Enum=AND;ANY
+	// +optional
+	Logical *string `json:"logical,omitempty"`
+}
+
+// TriggerParameters define the desired state of an AWS Glue trigger.
+type TriggerParameters struct {
+	// Actions are the jobs and crawlers the trigger starts.
+	Actions []TriggerAction `json:"actions"`
+
+	// Description of the trigger.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Enabled controls whether a scheduled or conditional trigger fires.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Predicate specifies the conditions that fire a CONDITIONAL trigger.
+	// +optional
+	Predicate *TriggerPredicate `json:"predicate,omitempty"`
+
+	// Schedule is the cron expression of a SCHEDULED trigger, e.g.
+	// cron(15 12 * * ? *).
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+
+	// Tags to assign to the trigger when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Type of the trigger.
+	// +kubebuilder:validation:Enum=SCHEDULED;CONDITIONAL;ON_DEMAND
+	// +immutable
+	Type string `json:"type"`
+
+	// WorkflowName is the name of the workflow the trigger belongs to.
+	// +immutable
+	// +optional
+	WorkflowName *string `json:"workflowName,omitempty"`
+}
+
+// A TriggerSpec defines the desired state of a Trigger.
+type TriggerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TriggerParameters `json:"forProvider"`
+}
+
+// TriggerObservation keeps the state for the external resource.
+type TriggerObservation struct {
+	// ID of the trigger.
+	ID string `json:"id,omitempty"`
+
+	// State of the trigger.
+	State string `json:"state,omitempty"`
+}
+
+// A TriggerStatus represents the observed state of a Trigger.
+type TriggerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TriggerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Trigger is a managed resource that represents an AWS Glue trigger, which
+// starts jobs and crawlers on a schedule, on demand or when other runs
+// complete.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Trigger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TriggerSpec   `json:"spec"`
+	Status TriggerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TriggerList contains a list of Triggers
+type TriggerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Trigger `json:"items"`
+}
@@ -25,3 +25,32 @@ type Tag struct {
 	// The value associated with this tag.
 	Value string `json:"value"`
 }
+
+// PolicySimulationParameters describe requests whose evaluation against the
+// policies of an IAM identity is simulated, for example to verify that a
+// high-risk policy does not grant more than intended.
+type PolicySimulationParameters struct {
+	// ActionNames are the API actions to simulate, for example
+	// iam:CreateUser.
+	ActionNames []string `json:"actionNames"`
+
+	// ResourceARNs are the ARNs of the resources to simulate the actions on.
+	// All resources are simulated if omitted.
+	// +optional
+	ResourceARNs []string `json:"resourceArns,omitempty"`
+}
+
+// PolicySimulationResult is the result of simulating a request against the
+// policies of an IAM identity.
+type PolicySimulationResult struct {
+	// ActionName is the API action that was simulated.
+	ActionName string `json:"actionName"`
+
+	// ResourceName is the ARN of the resource the action was simulated on.
+	// +optional
+	ResourceName string `json:"resourceName,omitempty"`
+
+	// Decision is the result of the simulation; one of allowed,
+	// explicitDeny or implicitDeny.
+	Decision string `json:"decision"`
+}
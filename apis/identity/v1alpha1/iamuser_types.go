@@ -33,9 +33,65 @@ type IAMUserParameters struct {
 	// +optional
 	PermissionsBoundary *string `json:"permissionsBoundary,omitempty"`
 
+	// PermissionsBoundaryRef references an IAMPolicy to retrieve its ARN and
+	// use it as the permissions boundary.
+	// +optional
+	PermissionsBoundaryRef *runtimev1alpha1.Reference `json:"permissionsBoundaryRef,omitempty"`
+
+	// PermissionsBoundarySelector selects a reference to an IAMPolicy to
+	// retrieve its ARN and use it as the permissions boundary.
+	// +optional
+	PermissionsBoundarySelector *runtimev1alpha1.Selector `json:"permissionsBoundarySelector,omitempty"`
+
+	// PolicySimulation simulates requests against the policies of the user
+	// and reports the results in its status.
+	// +optional
+	PolicySimulation *PolicySimulationParameters `json:"policySimulation,omitempty"`
+
 	// A list of tags that you want to attach to the newly created user.
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// LoginProfile enables the user to sign in to the AWS Management Console
+	// with a password. The user name and password are published to the
+	// connection secret of the user when the login profile is created.
+	// +optional
+	LoginProfile *LoginProfileParameters `json:"loginProfile,omitempty"`
+
+	// VirtualMFADevice provisions and enables a virtual MFA device for the
+	// user. The seed of the device is published to the connection secret of
+	// the user when it is created, so that it can be added to an
+	// authenticator app.
+	// +optional
+	VirtualMFADevice *VirtualMFADeviceParameters `json:"virtualMFADevice,omitempty"`
+}
+
+// LoginProfileParameters define the desired state of the console login
+// profile of an AWS IAM User.
+type LoginProfileParameters struct {
+	// PasswordSecretRef references the secret that contains the initial
+	// password of the user. If no reference is given, a password will be
+	// auto-generated.
+	// +optional
+	// +immutable
+	PasswordSecretRef *runtimev1alpha1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// PasswordResetRequired specifies whether the user must set a new
+	// password the first time they sign in. It only takes effect when the
+	// login profile is created, because AWS clears it once the user has set
+	// a new password.
+	// +optional
+	// +immutable
+	PasswordResetRequired *bool `json:"passwordResetRequired,omitempty"`
+}
+
+// VirtualMFADeviceParameters define the desired state of the virtual MFA
+// device of an AWS IAM User. The device is named after the user.
+type VirtualMFADeviceParameters struct {
+	// The path for the virtual MFA device.
+	// +optional
+	// +immutable
+	Path *string `json:"path,omitempty"`
 }
 
 // An IAMUserSpec defines the desired state of an IAM User.
@@ -51,6 +107,12 @@ type IAMUserObservation struct {
 
 	// The stable and unique string identifying the user.
 	UserID string `json:"userId,omitempty"`
+
+	// The serial number of the virtual MFA device of the user.
+	MFADeviceSerialNumber string `json:"mfaDeviceSerialNumber,omitempty"`
+
+	// The results of the policy simulation of the user.
+	PolicySimulationResults []PolicySimulationResult `json:"policySimulationResults,omitempty"`
 }
 
 // An IAMUserStatus represents the observed state of an IAM User.
@@ -137,3 +137,24 @@ func (mg *IAMGroupPolicyAttachment) ResolveReferences(ctx context.Context, c cli
 
 	return nil
 }
+
+// ResolveReferences of this IAMUser
+func (mg *IAMUser) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.permissionsBoundary
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PermissionsBoundary),
+		Reference:    mg.Spec.ForProvider.PermissionsBoundaryRef,
+		Selector:     mg.Spec.ForProvider.PermissionsBoundarySelector,
+		To:           reference.To{Managed: &IAMPolicy{}, List: &IAMPolicyList{}},
+		Extract:      IAMPolicyARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.PermissionsBoundary = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PermissionsBoundaryRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -654,6 +654,11 @@ func (in *IAMUserList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMUserObservation) DeepCopyInto(out *IAMUserObservation) {
 	*out = *in
+	if in.PolicySimulationResults != nil {
+		in, out := &in.PolicySimulationResults, &out.PolicySimulationResults
+		*out = make([]PolicySimulationResult, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserObservation.
@@ -679,11 +684,36 @@ func (in *IAMUserParameters) DeepCopyInto(out *IAMUserParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PermissionsBoundaryRef != nil {
+		in, out := &in.PermissionsBoundaryRef, &out.PermissionsBoundaryRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.PermissionsBoundarySelector != nil {
+		in, out := &in.PermissionsBoundarySelector, &out.PermissionsBoundarySelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicySimulation != nil {
+		in, out := &in.PolicySimulation, &out.PolicySimulation
+		*out = new(PolicySimulationParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.LoginProfile != nil {
+		in, out := &in.LoginProfile, &out.LoginProfile
+		*out = new(LoginProfileParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualMFADevice != nil {
+		in, out := &in.VirtualMFADevice, &out.VirtualMFADevice
+		*out = new(VirtualMFADeviceParameters)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserParameters.
@@ -860,7 +890,7 @@ func (in *IAMUserSpec) DeepCopy() *IAMUserSpec {
 func (in *IAMUserStatus) DeepCopyInto(out *IAMUserStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMUserStatus.
@@ -873,6 +903,71 @@ func (in *IAMUserStatus) DeepCopy() *IAMUserStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileParameters) DeepCopyInto(out *LoginProfileParameters) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(corev1alpha1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordResetRequired != nil {
+		in, out := &in.PasswordResetRequired, &out.PasswordResetRequired
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileParameters.
+func (in *LoginProfileParameters) DeepCopy() *LoginProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySimulationParameters) DeepCopyInto(out *PolicySimulationParameters) {
+	*out = *in
+	if in.ActionNames != nil {
+		in, out := &in.ActionNames, &out.ActionNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceARNs != nil {
+		in, out := &in.ResourceARNs, &out.ResourceARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySimulationParameters.
+func (in *PolicySimulationParameters) DeepCopy() *PolicySimulationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySimulationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySimulationResult) DeepCopyInto(out *PolicySimulationResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySimulationResult.
+func (in *PolicySimulationResult) DeepCopy() *PolicySimulationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySimulationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -887,3 +982,23 @@ func (in *Tag) DeepCopy() *Tag {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMFADeviceParameters) DeepCopyInto(out *VirtualMFADeviceParameters) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMFADeviceParameters.
+func (in *VirtualMFADeviceParameters) DeepCopy() *VirtualMFADeviceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMFADeviceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
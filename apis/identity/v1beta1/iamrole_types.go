@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 )
 
 // Tag represents user-provided metadata that can be associated
@@ -70,10 +72,24 @@ type IAMRoleParameters struct {
 	Path *string `json:"path,omitempty"`
 
 	// PermissionsBoundary is the ARN of the policy that is used to set the permissions boundary for the role.
-	// +immutable
 	// +optional
 	PermissionsBoundary *string `json:"permissionsBoundary,omitempty"`
 
+	// PermissionsBoundaryRef references an IAMPolicy to retrieve its ARN and
+	// use it as the permissions boundary.
+	// +optional
+	PermissionsBoundaryRef *runtimev1alpha1.Reference `json:"permissionsBoundaryRef,omitempty"`
+
+	// PermissionsBoundarySelector selects a reference to an IAMPolicy to
+	// retrieve its ARN and use it as the permissions boundary.
+	// +optional
+	PermissionsBoundarySelector *runtimev1alpha1.Selector `json:"permissionsBoundarySelector,omitempty"`
+
+	// PolicySimulation simulates requests against the policies of the role
+	// and reports the results in its status.
+	// +optional
+	PolicySimulation *v1alpha1.PolicySimulationParameters `json:"policySimulation,omitempty"`
+
 	// Tags. For more information about
 	// tagging, see Tagging IAM Identities (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags.html)
 	// in the IAM User Guide.
@@ -99,6 +115,11 @@ type IAMRoleExternalStatus struct {
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
 	RoleID string `json:"roleID"`
+
+	// PolicySimulationResults are the results of the policy simulation of
+	// the role.
+	// +optional
+	PolicySimulationResults []v1alpha1.PolicySimulationResult `json:"policySimulationResults,omitempty"`
 }
 
 // An IAMRoleStatus represents the observed state of an IAMRole.
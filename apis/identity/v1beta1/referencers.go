@@ -72,3 +72,24 @@ func (mg *IAMRolePolicyAttachment) ResolveReferences(ctx context.Context, c clie
 
 	return nil
 }
+
+// ResolveReferences of this IAMRole
+func (mg *IAMRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.permissionsBoundary
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PermissionsBoundary),
+		Reference:    mg.Spec.ForProvider.PermissionsBoundaryRef,
+		Selector:     mg.Spec.ForProvider.PermissionsBoundarySelector,
+		To:           reference.To{Managed: &v1alpha1.IAMPolicy{}, List: &v1alpha1.IAMPolicyList{}},
+		Extract:      v1alpha1.IAMPolicyARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.PermissionsBoundary = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PermissionsBoundaryRef = rsp.ResolvedReference
+
+	return nil
+}
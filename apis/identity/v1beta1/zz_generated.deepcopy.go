@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	identityv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -55,6 +56,11 @@ func (in *IAMRole) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMRoleExternalStatus) DeepCopyInto(out *IAMRoleExternalStatus) {
 	*out = *in
+	if in.PolicySimulationResults != nil {
+		in, out := &in.PolicySimulationResults, &out.PolicySimulationResults
+		*out = make([]identityv1alpha1.PolicySimulationResult, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMRoleExternalStatus.
@@ -122,6 +128,21 @@ func (in *IAMRoleParameters) DeepCopyInto(out *IAMRoleParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PermissionsBoundaryRef != nil {
+		in, out := &in.PermissionsBoundaryRef, &out.PermissionsBoundaryRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.PermissionsBoundarySelector != nil {
+		in, out := &in.PermissionsBoundarySelector, &out.PermissionsBoundarySelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicySimulation != nil {
+		in, out := &in.PolicySimulation, &out.PolicySimulation
+		*out = new(identityv1alpha1.PolicySimulationParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -303,7 +324,7 @@ func (in *IAMRoleSpec) DeepCopy() *IAMRoleSpec {
 func (in *IAMRoleStatus) DeepCopyInto(out *IAMRoleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMRoleStatus.
@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ComponentParameters define the desired state of an EC2 Image Builder
+// component. Components are versioned and cannot be changed once created;
+// publish a new SemanticVersion as a new Component instead.
+type ComponentParameters struct {
+	// Name of the component.
+	// +immutable
+	Name string `json:"name"`
+
+	// SemanticVersion of the component, in the form major.minor.patch.
+	// +immutable
+	SemanticVersion string `json:"semanticVersion"`
+
+	// Platform of the component.
+	// +immutable
+	// +kubebuilder:validation:Enum=Windows;Linux
+	Platform string `json:"platform"`
+
+	// Data is the YAML document that defines the component. Either Data or
+	// URI must be set.
+	// +immutable
+	// +optional
+	Data *string `json:"data,omitempty"`
+
+	// URI is the S3 URI of the YAML document that defines the component.
+	// Either Data or URI must be set.
+	// +immutable
+	// +optional
+	URI *string `json:"uri,omitempty"`
+
+	// Description of the component.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// ChangeDescription describes what changed in this version of the
+	// component.
+	// +immutable
+	// +optional
+	ChangeDescription *string `json:"changeDescription,omitempty"`
+
+	// KMSKeyID is the ID of the KMS key used to encrypt the component.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// SupportedOSVersions are the operating system versions the component
+	// supports, e.g. "Amazon Linux 2".
+	// +immutable
+	// +optional
+	SupportedOSVersions []string `json:"supportedOsVersions,omitempty"`
+
+	// Tags to assign to the component.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ComponentSpec defines the desired state of a Component.
+type ComponentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ComponentParameters `json:"forProvider"`
+}
+
+// ComponentObservation keeps the state for the external resource
+type ComponentObservation struct {
+	// The ARN of the component build version.
+	ARN string `json:"arn,omitempty"`
+
+	// The owner of the component.
+	Owner string `json:"owner,omitempty"`
+
+	// The type of the component, BUILD or TEST.
+	Type string `json:"type,omitempty"`
+
+	// Whether the component is encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// A ComponentStatus represents the observed state of a Component.
+type ComponentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ComponentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Component is a managed resource that represents an EC2 Image Builder
+// component. The external name of the resource is the component build
+// version ARN.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.semanticVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Component struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComponentSpec   `json:"spec"`
+	Status ComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComponentList contains a list of Components
+type ComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Component `json:"items"`
+}
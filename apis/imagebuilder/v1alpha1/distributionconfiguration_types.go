@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LaunchPermission configures who can launch the distributed AMI.
+type LaunchPermission struct {
+	// UserIDs are the AWS account IDs allowed to launch the AMI.
+	// +optional
+	UserIDs []string `json:"userIds,omitempty"`
+
+	// UserGroups allowed to launch the AMI. Use "all" to make it public.
+	// +optional
+	UserGroups []string `json:"userGroups,omitempty"`
+}
+
+// AMIDistribution configures the AMI created in a distribution region.
+type AMIDistribution struct {
+	// Name of the AMI. It can contain Image Builder expressions such as
+	// {{imagebuilder:buildDate}}.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Description of the AMI.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// AMITags to assign to the AMI.
+	// +optional
+	AMITags map[string]string `json:"amiTags,omitempty"`
+
+	// LaunchPermission of the AMI.
+	// +optional
+	LaunchPermission *LaunchPermission `json:"launchPermission,omitempty"`
+}
+
+// Distribution defines how images are distributed to a region.
+type Distribution struct {
+	// Region the image is distributed to.
+	Region string `json:"region"`
+
+	// AMIDistribution configures the AMI created in the region.
+	// +optional
+	AMIDistribution *AMIDistribution `json:"amiDistribution,omitempty"`
+
+	// LicenseConfigurationARNs are the License Manager configurations
+	// associated with the AMI.
+	// +optional
+	LicenseConfigurationARNs []string `json:"licenseConfigurationArns,omitempty"`
+}
+
+// DistributionConfigurationParameters define the desired state of an EC2
+// Image Builder distribution configuration.
+type DistributionConfigurationParameters struct {
+	// Name of the distribution configuration.
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the distribution configuration.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Distributions per region.
+	Distributions []Distribution `json:"distributions"`
+
+	// Tags to assign to the distribution configuration.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A DistributionConfigurationSpec defines the desired state of a
+// DistributionConfiguration.
+type DistributionConfigurationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DistributionConfigurationParameters `json:"forProvider"`
+}
+
+// DistributionConfigurationObservation keeps the state for the external
+// resource
+type DistributionConfigurationObservation struct {
+	// The ARN of the distribution configuration.
+	ARN string `json:"arn,omitempty"`
+
+	// The date the distribution configuration was last updated.
+	DateUpdated string `json:"dateUpdated,omitempty"`
+}
+
+// A DistributionConfigurationStatus represents the observed state of a
+// DistributionConfiguration.
+type DistributionConfigurationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DistributionConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DistributionConfiguration is a managed resource that represents an EC2
+// Image Builder distribution configuration. The external name of the
+// resource is the distribution configuration ARN.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DistributionConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DistributionConfigurationSpec   `json:"spec"`
+	Status DistributionConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DistributionConfigurationList contains a list of DistributionConfigurations
+type DistributionConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DistributionConfiguration `json:"items"`
+}
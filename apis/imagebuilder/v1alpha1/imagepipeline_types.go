@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PipelineSchedule configures when the pipeline builds new images.
+type PipelineSchedule struct {
+	// ScheduleExpression is a cron expression that determines how often the
+	// pipeline is evaluated, e.g. cron(0 0 * * ? *).
+	ScheduleExpression string `json:"scheduleExpression"`
+
+	// PipelineExecutionStartCondition determines whether the pipeline runs on
+	// every schedule match, or only when dependency updates are available.
+	// +kubebuilder:validation:Enum=EXPRESSION_MATCH_ONLY;EXPRESSION_MATCH_AND_DEPENDENCY_UPDATES_AVAILABLE
+	// +optional
+	PipelineExecutionStartCondition *string `json:"pipelineExecutionStartCondition,omitempty"`
+}
+
+// ImageTestsConfiguration configures the tests run on built images.
+type ImageTestsConfiguration struct {
+	// ImageTestsEnabled enables the image tests.
+	// +optional
+	ImageTestsEnabled *bool `json:"imageTestsEnabled,omitempty"`
+
+	// TimeoutMinutes is the maximum time the tests may run.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=1440
+	// +optional
+	TimeoutMinutes *int64 `json:"timeoutMinutes,omitempty"`
+}
+
+// ImagePipelineParameters define the desired state of an EC2 Image Builder
+// image pipeline.
+type ImagePipelineParameters struct {
+	// Name of the image pipeline.
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the image pipeline.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// ImageRecipeARN is the ARN of the image recipe the pipeline builds.
+	// +optional
+	ImageRecipeARN *string `json:"imageRecipeArn,omitempty"`
+
+	// ImageRecipeARNRef references an ImageRecipe to retrieve its ARN.
+	// +optional
+	ImageRecipeARNRef *runtimev1alpha1.Reference `json:"imageRecipeArnRef,omitempty"`
+
+	// ImageRecipeARNSelector selects a reference to an ImageRecipe to
+	// retrieve its ARN.
+	// +optional
+	ImageRecipeARNSelector *runtimev1alpha1.Selector `json:"imageRecipeArnSelector,omitempty"`
+
+	// InfrastructureConfigurationARN is the ARN of the infrastructure
+	// configuration used to build and test images.
+	// +optional
+	InfrastructureConfigurationARN *string `json:"infrastructureConfigurationArn,omitempty"`
+
+	// InfrastructureConfigurationARNRef references an
+	// InfrastructureConfiguration to retrieve its ARN.
+	// +optional
+	InfrastructureConfigurationARNRef *runtimev1alpha1.Reference `json:"infrastructureConfigurationArnRef,omitempty"`
+
+	// InfrastructureConfigurationARNSelector selects a reference to an
+	// InfrastructureConfiguration to retrieve its ARN.
+	// +optional
+	InfrastructureConfigurationARNSelector *runtimev1alpha1.Selector `json:"infrastructureConfigurationArnSelector,omitempty"`
+
+	// DistributionConfigurationARN is the ARN of the distribution
+	// configuration used to distribute built images.
+	// +optional
+	DistributionConfigurationARN *string `json:"distributionConfigurationArn,omitempty"`
+
+	// DistributionConfigurationARNRef references a DistributionConfiguration
+	// to retrieve its ARN.
+	// +optional
+	DistributionConfigurationARNRef *runtimev1alpha1.Reference `json:"distributionConfigurationArnRef,omitempty"`
+
+	// DistributionConfigurationARNSelector selects a reference to a
+	// DistributionConfiguration to retrieve its ARN.
+	// +optional
+	DistributionConfigurationARNSelector *runtimev1alpha1.Selector `json:"distributionConfigurationArnSelector,omitempty"`
+
+	// EnhancedImageMetadataEnabled collects additional information about the
+	// image, such as the operating system version and installed packages.
+	// +optional
+	EnhancedImageMetadataEnabled *bool `json:"enhancedImageMetadataEnabled,omitempty"`
+
+	// ImageTestsConfiguration configures the tests run on built images.
+	// +optional
+	ImageTestsConfiguration *ImageTestsConfiguration `json:"imageTestsConfiguration,omitempty"`
+
+	// Schedule of the pipeline. Pipelines without a schedule only run when
+	// started manually.
+	// +optional
+	Schedule *PipelineSchedule `json:"schedule,omitempty"`
+
+	// Status of the pipeline.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// Tags to assign to the image pipeline.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An ImagePipelineSpec defines the desired state of an ImagePipeline.
+type ImagePipelineSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ImagePipelineParameters `json:"forProvider"`
+}
+
+// ImagePipelineObservation keeps the state for the external resource
+type ImagePipelineObservation struct {
+	// The ARN of the image pipeline.
+	ARN string `json:"arn,omitempty"`
+
+	// The platform of the image pipeline.
+	Platform string `json:"platform,omitempty"`
+
+	// The date the image pipeline last ran.
+	DateLastRun string `json:"dateLastRun,omitempty"`
+
+	// The date the image pipeline will run next.
+	DateNextRun string `json:"dateNextRun,omitempty"`
+}
+
+// An ImagePipelineStatus represents the observed state of an ImagePipeline.
+type ImagePipelineStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ImagePipelineObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ImagePipeline is a managed resource that represents an EC2 Image Builder
+// image pipeline. The external name of the resource is the image pipeline
+// ARN.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
+// +kubebuilder:printcolumn:name="NEXT-RUN",type="string",JSONPath=".status.atProvider.dateNextRun"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ImagePipeline struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImagePipelineSpec   `json:"spec"`
+	Status ImagePipelineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImagePipelineList contains a list of ImagePipelines
+type ImagePipelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImagePipeline `json:"items"`
+}
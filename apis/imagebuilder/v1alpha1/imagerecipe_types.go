@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ComponentConfiguration is a component applied by an image recipe.
+type ComponentConfiguration struct {
+	// ComponentARN is the ARN of the component.
+	// +optional
+	ComponentARN *string `json:"componentArn,omitempty"`
+
+	// ComponentARNRef references a Component to retrieve its ARN.
+	// +optional
+	ComponentARNRef *runtimev1alpha1.Reference `json:"componentArnRef,omitempty"`
+
+	// ComponentARNSelector selects a reference to a Component to retrieve its
+	// ARN.
+	// +optional
+	ComponentARNSelector *runtimev1alpha1.Selector `json:"componentArnSelector,omitempty"`
+}
+
+// EBSBlockDevice configures an Amazon EBS volume of the build instance.
+type EBSBlockDevice struct {
+	// DeleteOnTermination configures the deletion of the volume on
+	// instance termination.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+
+	// Encrypted configures encryption of the volume.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// IOPS is the number of I/O operations per second to provision.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// KMSKeyID is the KMS key used to encrypt the volume.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// SnapshotID is the snapshot that defines the device contents.
+	// +optional
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// VolumeSize in GiB.
+	// +optional
+	VolumeSize *int64 `json:"volumeSize,omitempty"`
+
+	// VolumeType of the volume.
+	// +kubebuilder:validation:Enum=standard;io1;gp2;sc1;st1
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+}
+
+// BlockDeviceMapping defines a block device of the build instance.
+type BlockDeviceMapping struct {
+	// DeviceName is the device the mapping applies to, e.g. /dev/xvda.
+	// +optional
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// EBS configures the Amazon EBS volume of the device.
+	// +optional
+	EBS *EBSBlockDevice `json:"ebs,omitempty"`
+
+	// NoDevice suppresses the device mapping from the parent image.
+	// +optional
+	NoDevice *string `json:"noDevice,omitempty"`
+
+	// VirtualName is the instance store volume name, e.g. ephemeral0.
+	// +optional
+	VirtualName *string `json:"virtualName,omitempty"`
+}
+
+// ImageRecipeParameters define the desired state of an EC2 Image Builder
+// image recipe. Image recipes are versioned and cannot be changed once
+// created; publish a new SemanticVersion as a new ImageRecipe instead.
+type ImageRecipeParameters struct {
+	// Name of the image recipe.
+	// +immutable
+	Name string `json:"name"`
+
+	// SemanticVersion of the image recipe, in the form major.minor.patch.
+	// +immutable
+	SemanticVersion string `json:"semanticVersion"`
+
+	// ParentImage is the image the recipe builds on, either an AMI ID or an
+	// Image Builder image ARN.
+	// +immutable
+	ParentImage string `json:"parentImage"`
+
+	// Components applied to the parent image, in order.
+	// +immutable
+	Components []ComponentConfiguration `json:"components"`
+
+	// BlockDeviceMappings of the build instance.
+	// +immutable
+	// +optional
+	BlockDeviceMappings []BlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
+
+	// Description of the image recipe.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Tags to assign to the image recipe.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An ImageRecipeSpec defines the desired state of an ImageRecipe.
+type ImageRecipeSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ImageRecipeParameters `json:"forProvider"`
+}
+
+// ImageRecipeObservation keeps the state for the external resource
+type ImageRecipeObservation struct {
+	// The ARN of the image recipe.
+	ARN string `json:"arn,omitempty"`
+
+	// The owner of the image recipe.
+	Owner string `json:"owner,omitempty"`
+
+	// The platform of the image recipe.
+	Platform string `json:"platform,omitempty"`
+}
+
+// An ImageRecipeStatus represents the observed state of an ImageRecipe.
+type ImageRecipeStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ImageRecipeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ImageRecipe is a managed resource that represents an EC2 Image Builder
+// image recipe. The external name of the resource is the image recipe ARN.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.semanticVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ImageRecipe struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageRecipeSpec   `json:"spec"`
+	Status ImageRecipeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageRecipeList contains a list of ImageRecipes
+type ImageRecipeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageRecipe `json:"items"`
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// S3Logs configures where build logs are written in Amazon S3.
+type S3Logs struct {
+	// S3BucketName is the bucket logs are written to.
+	S3BucketName string `json:"s3BucketName"`
+
+	// S3KeyPrefix is the key prefix logs are written under.
+	// +optional
+	S3KeyPrefix *string `json:"s3KeyPrefix,omitempty"`
+}
+
+// InfrastructureConfigurationParameters define the desired state of an EC2
+// Image Builder infrastructure configuration.
+type InfrastructureConfigurationParameters struct {
+	// Name of the infrastructure configuration.
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the infrastructure configuration.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// InstanceProfileName is the name of the IAM instance profile attached to
+	// the build and test instances.
+	InstanceProfileName string `json:"instanceProfileName"`
+
+	// InstanceTypes used to build and test images.
+	// +optional
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+
+	// KeyPair is the EC2 key pair used to connect to the build instances.
+	// +optional
+	KeyPair *string `json:"keyPair,omitempty"`
+
+	// S3Logs configures where build logs are written.
+	// +optional
+	S3Logs *S3Logs `json:"s3Logs,omitempty"`
+
+	// SecurityGroupIDs of the build instances.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// SubnetID the build instances are launched in.
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SNSTopicARN is the ARN of the SNS topic build notifications are sent
+	// to.
+	// +optional
+	SNSTopicARN *string `json:"snsTopicArn,omitempty"`
+
+	// SNSTopicARNRef references an SNSTopic to retrieve its ARN.
+	// +optional
+	SNSTopicARNRef *runtimev1alpha1.Reference `json:"snsTopicArnRef,omitempty"`
+
+	// SNSTopicARNSelector selects a reference to an SNSTopic to retrieve its
+	// ARN.
+	// +optional
+	SNSTopicARNSelector *runtimev1alpha1.Selector `json:"snsTopicArnSelector,omitempty"`
+
+	// TerminateInstanceOnFailure terminates the build instance when the
+	// build fails. Set it to false to keep the instance for troubleshooting.
+	// +optional
+	TerminateInstanceOnFailure *bool `json:"terminateInstanceOnFailure,omitempty"`
+
+	// Tags to assign to the infrastructure configuration.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An InfrastructureConfigurationSpec defines the desired state of an
+// InfrastructureConfiguration.
+type InfrastructureConfigurationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  InfrastructureConfigurationParameters `json:"forProvider"`
+}
+
+// InfrastructureConfigurationObservation keeps the state for the external
+// resource
+type InfrastructureConfigurationObservation struct {
+	// The ARN of the infrastructure configuration.
+	ARN string `json:"arn,omitempty"`
+
+	// The date the infrastructure configuration was last updated.
+	DateUpdated string `json:"dateUpdated,omitempty"`
+}
+
+// An InfrastructureConfigurationStatus represents the observed state of an
+// InfrastructureConfiguration.
+type InfrastructureConfigurationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     InfrastructureConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InfrastructureConfiguration is a managed resource that represents an EC2
+// Image Builder infrastructure configuration. The external name of the
+// resource is the infrastructure configuration ARN.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type InfrastructureConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InfrastructureConfigurationSpec   `json:"spec"`
+	Status InfrastructureConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InfrastructureConfigurationList contains a list of
+// InfrastructureConfigurations
+type InfrastructureConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InfrastructureConfiguration `json:"items"`
+}
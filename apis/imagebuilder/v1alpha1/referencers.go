@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	notificationv1alpha1 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+)
+
+// ResolveReferences of this ImageRecipe
+func (mg *ImageRecipe) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.components[].componentArn
+	for i := range mg.Spec.ForProvider.Components {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Components[i].ComponentARN),
+			Reference:    mg.Spec.ForProvider.Components[i].ComponentARNRef,
+			Selector:     mg.Spec.ForProvider.Components[i].ComponentARNSelector,
+			To:           reference.To{Managed: &Component{}, List: &ComponentList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return err
+		}
+		mg.Spec.ForProvider.Components[i].ComponentARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Components[i].ComponentARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this InfrastructureConfiguration
+func (mg *InfrastructureConfiguration) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.securityGroupIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.SecurityGroup{}, List: &ec2v1beta1.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.subnetId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SubnetID),
+		Reference:    mg.Spec.ForProvider.SubnetIDRef,
+		Selector:     mg.Spec.ForProvider.SubnetIDSelector,
+		To:           reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SubnetID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SubnetIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.snsTopicArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SNSTopicARN),
+		Reference:    mg.Spec.ForProvider.SNSTopicARNRef,
+		Selector:     mg.Spec.ForProvider.SNSTopicARNSelector,
+		To:           reference.To{Managed: &notificationv1alpha1.SNSTopic{}, List: &notificationv1alpha1.SNSTopicList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.SNSTopicARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SNSTopicARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ImagePipeline
+func (mg *ImagePipeline) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.imageRecipeArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ImageRecipeARN),
+		Reference:    mg.Spec.ForProvider.ImageRecipeARNRef,
+		Selector:     mg.Spec.ForProvider.ImageRecipeARNSelector,
+		To:           reference.To{Managed: &ImageRecipe{}, List: &ImageRecipeList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.ImageRecipeARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ImageRecipeARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.infrastructureConfigurationArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.InfrastructureConfigurationARN),
+		Reference:    mg.Spec.ForProvider.InfrastructureConfigurationARNRef,
+		Selector:     mg.Spec.ForProvider.InfrastructureConfigurationARNSelector,
+		To:           reference.To{Managed: &InfrastructureConfiguration{}, List: &InfrastructureConfigurationList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.InfrastructureConfigurationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.InfrastructureConfigurationARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.distributionConfigurationArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DistributionConfigurationARN),
+		Reference:    mg.Spec.ForProvider.DistributionConfigurationARNRef,
+		Selector:     mg.Spec.ForProvider.DistributionConfigurationARNSelector,
+		To:           reference.To{Managed: &DistributionConfiguration{}, List: &DistributionConfigurationList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DistributionConfigurationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DistributionConfigurationARNRef = rsp.ResolvedReference
+
+	return nil
+}
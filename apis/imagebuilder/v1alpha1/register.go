@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "imagebuilder.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Component type metadata.
+var (
+	ComponentKind             = reflect.TypeOf(Component{}).Name()
+	ComponentGroupKind        = schema.GroupKind{Group: Group, Kind: ComponentKind}.String()
+	ComponentKindAPIVersion   = ComponentKind + "." + SchemeGroupVersion.String()
+	ComponentGroupVersionKind = SchemeGroupVersion.WithKind(ComponentKind)
+)
+
+// ImageRecipe type metadata.
+var (
+	ImageRecipeKind             = reflect.TypeOf(ImageRecipe{}).Name()
+	ImageRecipeGroupKind        = schema.GroupKind{Group: Group, Kind: ImageRecipeKind}.String()
+	ImageRecipeKindAPIVersion   = ImageRecipeKind + "." + SchemeGroupVersion.String()
+	ImageRecipeGroupVersionKind = SchemeGroupVersion.WithKind(ImageRecipeKind)
+)
+
+// InfrastructureConfiguration type metadata.
+var (
+	InfrastructureConfigurationKind             = reflect.TypeOf(InfrastructureConfiguration{}).Name()
+	InfrastructureConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: InfrastructureConfigurationKind}.String()
+	InfrastructureConfigurationKindAPIVersion   = InfrastructureConfigurationKind + "." + SchemeGroupVersion.String()
+	InfrastructureConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(InfrastructureConfigurationKind)
+)
+
+// DistributionConfiguration type metadata.
+var (
+	DistributionConfigurationKind             = reflect.TypeOf(DistributionConfiguration{}).Name()
+	DistributionConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: DistributionConfigurationKind}.String()
+	DistributionConfigurationKindAPIVersion   = DistributionConfigurationKind + "." + SchemeGroupVersion.String()
+	DistributionConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(DistributionConfigurationKind)
+)
+
+// ImagePipeline type metadata.
+var (
+	ImagePipelineKind             = reflect.TypeOf(ImagePipeline{}).Name()
+	ImagePipelineGroupKind        = schema.GroupKind{Group: Group, Kind: ImagePipelineKind}.String()
+	ImagePipelineKindAPIVersion   = ImagePipelineKind + "." + SchemeGroupVersion.String()
+	ImagePipelineGroupVersionKind = SchemeGroupVersion.WithKind(ImagePipelineKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Component{}, &ComponentList{})
+	SchemeBuilder.Register(&ImageRecipe{}, &ImageRecipeList{})
+	SchemeBuilder.Register(&InfrastructureConfiguration{}, &InfrastructureConfigurationList{})
+	SchemeBuilder.Register(&DistributionConfiguration{}, &DistributionConfigurationList{})
+	SchemeBuilder.Register(&ImagePipeline{}, &ImagePipelineList{})
+}
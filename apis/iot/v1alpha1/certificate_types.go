@@ -0,0 +1,122 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CertificateParameters define the desired state of an AWS IoT certificate.
+type CertificateParameters struct {
+	// CertificateSigningRequest is a PEM encoded certificate signing request
+	// the certificate is issued for. AWS IoT generates a key pair for the
+	// certificate if it is omitted, and its private and public keys are
+	// published to the connection secret.
+	// +immutable
+	// +optional
+	CertificateSigningRequest *string `json:"certificateSigningRequest,omitempty"`
+
+	// Status of the certificate. Devices can only connect with a
+	// certificate that is ACTIVE.
+	// +kubebuilder:validation:Enum=ACTIVE;INACTIVE
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// PolicyNames are the names of the policies attached to the certificate.
+	// +optional
+	PolicyNames []string `json:"policyNames,omitempty"`
+
+	// PolicyNameRefs references Policies to retrieve their names.
+	// +optional
+	PolicyNameRefs []runtimev1alpha1.Reference `json:"policyNameRefs,omitempty"`
+
+	// PolicyNameSelector selects references to Policies to retrieve their
+	// names.
+	// +optional
+	PolicyNameSelector *runtimev1alpha1.Selector `json:"policyNameSelector,omitempty"`
+
+	// ThingNames are the names of the things the certificate is attached
+	// to.
+	// +optional
+	ThingNames []string `json:"thingNames,omitempty"`
+
+	// ThingNameRefs references Things to retrieve their names.
+	// +optional
+	ThingNameRefs []runtimev1alpha1.Reference `json:"thingNameRefs,omitempty"`
+
+	// ThingNameSelector selects references to Things to retrieve their
+	// names.
+	// +optional
+	ThingNameSelector *runtimev1alpha1.Selector `json:"thingNameSelector,omitempty"`
+}
+
+// A CertificateSpec defines the desired state of a Certificate.
+type CertificateSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CertificateParameters `json:"forProvider,omitempty"`
+}
+
+// CertificateObservation keeps the state for the external resource
+type CertificateObservation struct {
+	// CertificateARN is the ARN of the certificate.
+	CertificateARN string `json:"certificateArn,omitempty"`
+
+	// Status of the certificate.
+	Status string `json:"status,omitempty"`
+
+	// NotAfter is the time the certificate expires.
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+}
+
+// A CertificateStatus represents the observed state of a Certificate.
+type CertificateStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Certificate is a managed resource that represents an AWS IoT X.509
+// certificate used by devices to authenticate. The external name of the
+// resource is the ID of the certificate assigned by AWS IoT. The PEM encoded
+// certificate is published to the connection secret, along with the key
+// pair when AWS IoT generated it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateList contains a list of Certificates
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Certificate `json:"items"`
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PolicyParameters define the desired state of an AWS IoT policy.
+type PolicyParameters struct {
+	// Document is the JSON policy document that grants devices access to
+	// AWS IoT operations.
+	Document string `json:"document"`
+
+	// Tags to assign to the policy when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A PolicySpec defines the desired state of a Policy.
+type PolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PolicyParameters `json:"forProvider"`
+}
+
+// PolicyObservation keeps the state for the external resource
+type PolicyObservation struct {
+	// PolicyARN is the ARN of the policy.
+	PolicyARN string `json:"policyArn,omitempty"`
+
+	// DefaultVersionID is the ID of the version of the policy in effect.
+	DefaultVersionID string `json:"defaultVersionId,omitempty"`
+}
+
+// A PolicyStatus represents the observed state of a Policy.
+type PolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Policy is a managed resource that represents an AWS IoT policy. The
+// external name of the resource is the name of the policy. Changes to the
+// document create a new default version of the policy, and the oldest
+// version is removed once the limit of five versions is reached.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.defaultVersionId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Policy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicySpec   `json:"spec"`
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyList contains a list of Policies
+type PolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Policy `json:"items"`
+}
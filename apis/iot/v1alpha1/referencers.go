@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+)
+
+// ResolveReferences of this Thing
+func (mg *Thing) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.thingTypeName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ThingTypeName),
+		Reference:    mg.Spec.ForProvider.ThingTypeNameRef,
+		Selector:     mg.Spec.ForProvider.ThingTypeNameSelector,
+		To:           reference.To{Managed: &ThingType{}, List: &ThingTypeList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.ThingTypeName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ThingTypeNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Certificate
+func (mg *Certificate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.policyNames
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.PolicyNames,
+		References:    mg.Spec.ForProvider.PolicyNameRefs,
+		Selector:      mg.Spec.ForProvider.PolicyNameSelector,
+		To:            reference.To{Managed: &Policy{}, List: &PolicyList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.PolicyNames = mrsp.ResolvedValues
+	mg.Spec.ForProvider.PolicyNameRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.thingNames
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ThingNames,
+		References:    mg.Spec.ForProvider.ThingNameRefs,
+		Selector:      mg.Spec.ForProvider.ThingNameSelector,
+		To:            reference.To{Managed: &Thing{}, List: &ThingList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.ThingNames = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ThingNameRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "iot.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Thing type metadata.
+var (
+	ThingKind             = reflect.TypeOf(Thing{}).Name()
+	ThingGroupKind        = schema.GroupKind{Group: Group, Kind: ThingKind}.String()
+	ThingKindAPIVersion   = ThingKind + "." + SchemeGroupVersion.String()
+	ThingGroupVersionKind = SchemeGroupVersion.WithKind(ThingKind)
+)
+
+// ThingType type metadata.
+var (
+	ThingTypeKind             = reflect.TypeOf(ThingType{}).Name()
+	ThingTypeGroupKind        = schema.GroupKind{Group: Group, Kind: ThingTypeKind}.String()
+	ThingTypeKindAPIVersion   = ThingTypeKind + "." + SchemeGroupVersion.String()
+	ThingTypeGroupVersionKind = SchemeGroupVersion.WithKind(ThingTypeKind)
+)
+
+// Policy type metadata.
+var (
+	PolicyKind             = reflect.TypeOf(Policy{}).Name()
+	PolicyGroupKind        = schema.GroupKind{Group: Group, Kind: PolicyKind}.String()
+	PolicyKindAPIVersion   = PolicyKind + "." + SchemeGroupVersion.String()
+	PolicyGroupVersionKind = SchemeGroupVersion.WithKind(PolicyKind)
+)
+
+// Certificate type metadata.
+var (
+	CertificateKind             = reflect.TypeOf(Certificate{}).Name()
+	CertificateGroupKind        = schema.GroupKind{Group: Group, Kind: CertificateKind}.String()
+	CertificateKindAPIVersion   = CertificateKind + "." + SchemeGroupVersion.String()
+	CertificateGroupVersionKind = SchemeGroupVersion.WithKind(CertificateKind)
+)
+
+// TopicRule type metadata.
+var (
+	TopicRuleKind             = reflect.TypeOf(TopicRule{}).Name()
+	TopicRuleGroupKind        = schema.GroupKind{Group: Group, Kind: TopicRuleKind}.String()
+	TopicRuleKindAPIVersion   = TopicRuleKind + "." + SchemeGroupVersion.String()
+	TopicRuleGroupVersionKind = SchemeGroupVersion.WithKind(TopicRuleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Thing{}, &ThingList{})
+	SchemeBuilder.Register(&ThingType{}, &ThingTypeList{})
+	SchemeBuilder.Register(&Policy{}, &PolicyList{})
+	SchemeBuilder.Register(&Certificate{}, &CertificateList{})
+	SchemeBuilder.Register(&TopicRule{}, &TopicRuleList{})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ThingParameters define the desired state of an AWS IoT thing.
+type ThingParameters struct {
+	// ThingTypeName is the name of the thing type of the thing.
+	// +optional
+	ThingTypeName *string `json:"thingTypeName,omitempty"`
+
+	// ThingTypeNameRef references a ThingType to retrieve its name.
+	// +optional
+	ThingTypeNameRef *runtimev1alpha1.Reference `json:"thingTypeNameRef,omitempty"`
+
+	// ThingTypeNameSelector selects a reference to a ThingType to retrieve
+	// its name.
+	// +optional
+	ThingTypeNameSelector *runtimev1alpha1.Selector `json:"thingTypeNameSelector,omitempty"`
+
+	// Attributes of the thing, used to search and group things.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// A ThingSpec defines the desired state of a Thing.
+type ThingSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ThingParameters `json:"forProvider,omitempty"`
+}
+
+// ThingObservation keeps the state for the external resource
+type ThingObservation struct {
+	// ThingARN is the ARN of the thing.
+	ThingARN string `json:"thingArn,omitempty"`
+
+	// ThingID is the ID of the thing.
+	ThingID string `json:"thingId,omitempty"`
+
+	// Version of the thing, which is incremented whenever the thing is
+	// updated.
+	Version int64 `json:"version,omitempty"`
+}
+
+// A ThingStatus represents the observed state of a Thing.
+type ThingStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ThingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Thing is a managed resource that represents an AWS IoT thing, the
+// registry entry of a device. The external name of the resource is the name
+// of the thing.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.thingTypeName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Thing struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ThingSpec   `json:"spec"`
+	Status ThingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ThingList contains a list of Things
+type ThingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Thing `json:"items"`
+}
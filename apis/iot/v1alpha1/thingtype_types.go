@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ThingTypeParameters define the desired state of an AWS IoT thing type.
+type ThingTypeParameters struct {
+	// Description of the thing type.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// SearchableAttributes are the attributes of the things of this type
+	// that can be used in searches, up to three.
+	// +kubebuilder:validation:MaxItems=3
+	// +immutable
+	// +optional
+	SearchableAttributes []string `json:"searchableAttributes,omitempty"`
+
+	// Deprecated specifies whether the thing type is deprecated. No new
+	// things can be associated with a deprecated thing type. A thing type is
+	// deprecated before it is deleted.
+	// +optional
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// Tags to assign to the thing type when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ThingTypeSpec defines the desired state of a ThingType.
+type ThingTypeSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ThingTypeParameters `json:"forProvider,omitempty"`
+}
+
+// ThingTypeObservation keeps the state for the external resource
+type ThingTypeObservation struct {
+	// ThingTypeARN is the ARN of the thing type.
+	ThingTypeARN string `json:"thingTypeArn,omitempty"`
+
+	// ThingTypeID is the ID of the thing type.
+	ThingTypeID string `json:"thingTypeId,omitempty"`
+
+	// DeprecationDate is the time the thing type was deprecated.
+	DeprecationDate *metav1.Time `json:"deprecationDate,omitempty"`
+}
+
+// A ThingTypeStatus represents the observed state of a ThingType.
+type ThingTypeStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ThingTypeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ThingType is a managed resource that represents an AWS IoT thing type.
+// The external name of the resource is the name of the thing type. AWS IoT
+// only deletes thing types that have been deprecated for five minutes, so
+// deletion deprecates the thing type first and completes once that period
+// has elapsed.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DEPRECATED",type="boolean",JSONPath=".spec.forProvider.deprecated"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ThingType struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ThingTypeSpec   `json:"spec"`
+	Status ThingTypeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ThingTypeList contains a list of ThingTypes
+type ThingTypeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ThingType `json:"items"`
+}
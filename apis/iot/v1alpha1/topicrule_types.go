@@ -0,0 +1,250 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LambdaAction invokes a Lambda function.
+type LambdaAction struct {
+	// FunctionARN is the ARN of the Lambda function.
+	FunctionARN string `json:"functionArn"`
+}
+
+// SNSAction publishes to an SNS topic.
+type SNSAction struct {
+	// TargetARN is the ARN of the SNS topic.
+	TargetARN string `json:"targetArn"`
+
+	// RoleARN is the ARN of the IAM role that grants access to the topic.
+	RoleARN string `json:"roleArn"`
+
+	// MessageFormat of the published message.
+	// +kubebuilder:validation:Enum=RAW;JSON
+	// +optional
+	MessageFormat *string `json:"messageFormat,omitempty"`
+}
+
+// SQSAction sends the message to an SQS queue.
+type SQSAction struct {
+	// QueueURL is the URL of the SQS queue.
+	QueueURL string `json:"queueUrl"`
+
+	// RoleARN is the ARN of the IAM role that grants access to the queue.
+	RoleARN string `json:"roleArn"`
+
+	// UseBase64 specifies whether the message is base64 encoded.
+	// +optional
+	UseBase64 *bool `json:"useBase64,omitempty"`
+}
+
+// KinesisAction writes the message to a Kinesis stream.
+type KinesisAction struct {
+	// StreamName is the name of the Kinesis stream.
+	StreamName string `json:"streamName"`
+
+	// RoleARN is the ARN of the IAM role that grants access to the stream.
+	RoleARN string `json:"roleArn"`
+
+	// PartitionKey of the record written to the stream.
+	// +optional
+	PartitionKey *string `json:"partitionKey,omitempty"`
+}
+
+// RepublishAction republishes the message to another MQTT topic.
+type RepublishAction struct {
+	// Topic is the MQTT topic the message is republished to.
+	Topic string `json:"topic"`
+
+	// RoleARN is the ARN of the IAM role that grants access to the topic.
+	RoleARN string `json:"roleArn"`
+
+	// QoS is the quality of service level of the republished message.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	// +optional
+	QoS *int64 `json:"qos,omitempty"`
+}
+
+// S3Action writes the message to an S3 bucket.
+type S3Action struct {
+	// BucketName is the name of the S3 bucket.
+	BucketName string `json:"bucketName"`
+
+	// Key of the object the message is written to.
+	Key string `json:"key"`
+
+	// RoleARN is the ARN of the IAM role that grants access to the bucket.
+	RoleARN string `json:"roleArn"`
+
+	// CannedACL is the canned ACL applied to the object.
+	// +optional
+	CannedACL *string `json:"cannedAcl,omitempty"`
+}
+
+// DynamoDBv2Action writes each attribute of the message to a column of a
+// DynamoDB table.
+type DynamoDBv2Action struct {
+	// TableName is the name of the DynamoDB table.
+	TableName string `json:"tableName"`
+
+	// RoleARN is the ARN of the IAM role that grants access to the table.
+	RoleARN string `json:"roleArn"`
+}
+
+// FirehoseAction writes the message to a Kinesis Data Firehose delivery
+// stream.
+type FirehoseAction struct {
+	// DeliveryStreamName is the name of the delivery stream.
+	DeliveryStreamName string `json:"deliveryStreamName"`
+
+	// RoleARN is the ARN of the IAM role that grants access to the delivery
+	// stream.
+	RoleARN string `json:"roleArn"`
+
+	// Separator appended to the message, one of \n, \t, \r\n or a comma.
+	// +optional
+	Separator *string `json:"separator,omitempty"`
+}
+
+// CloudWatchLogsAction sends the message to a CloudWatch Logs log group.
+type CloudWatchLogsAction struct {
+	// LogGroupName is the name of the log group.
+	LogGroupName string `json:"logGroupName"`
+
+	// RoleARN is the ARN of the IAM role that grants access to the log group.
+	RoleARN string `json:"roleArn"`
+}
+
+// TopicRuleAction is an action taken when a topic rule matches a message.
+// Exactly one of the actions must be set.
+type TopicRuleAction struct {
+	// Lambda invokes a Lambda function.
+	// +optional
+	Lambda *LambdaAction `json:"lambda,omitempty"`
+
+	// SNS publishes to an SNS topic.
+	// +optional
+	SNS *SNSAction `json:"sns,omitempty"`
+
+	// SQS sends the message to an SQS queue.
+	// +optional
+	SQS *SQSAction `json:"sqs,omitempty"`
+
+	// Kinesis writes the message to a Kinesis stream.
+	// +optional
+	Kinesis *KinesisAction `json:"kinesis,omitempty"`
+
+	// Republish republishes the message to another MQTT topic.
+	// +optional
+	Republish *RepublishAction `json:"republish,omitempty"`
+
+	// S3 writes the message to an S3 bucket.
+	// +optional
+	S3 *S3Action `json:"s3,omitempty"`
+
+	// DynamoDBv2 writes the message to a DynamoDB table.
+	// +optional
+	DynamoDBv2 *DynamoDBv2Action `json:"dynamoDBv2,omitempty"`
+
+	// Firehose writes the message to a Kinesis Data Firehose delivery
+	// stream.
+	// +optional
+	Firehose *FirehoseAction `json:"firehose,omitempty"`
+
+	// CloudWatchLogs sends the message to a CloudWatch Logs log group.
+	// +optional
+	CloudWatchLogs *CloudWatchLogsAction `json:"cloudwatchLogs,omitempty"`
+}
+
+// TopicRuleParameters define the desired state of an AWS IoT topic rule.
+type TopicRuleParameters struct {
+	// SQL is the SQL statement that selects the messages the rule acts on,
+	// e.g. SELECT * FROM 'sensors/+/telemetry'.
+	SQL string `json:"sql"`
+
+	// Description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// AWSIoTSQLVersion is the version of the SQL rules engine the statement
+	// is evaluated with.
+	// +optional
+	AWSIoTSQLVersion *string `json:"awsIotSqlVersion,omitempty"`
+
+	// RuleDisabled specifies whether the rule is disabled.
+	// +optional
+	RuleDisabled *bool `json:"ruleDisabled,omitempty"`
+
+	// Actions taken when the rule matches a message.
+	// +kubebuilder:validation:MinItems=1
+	Actions []TopicRuleAction `json:"actions"`
+
+	// ErrorAction is taken when one of the actions fails.
+	// +optional
+	ErrorAction *TopicRuleAction `json:"errorAction,omitempty"`
+}
+
+// A TopicRuleSpec defines the desired state of a TopicRule.
+type TopicRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TopicRuleParameters `json:"forProvider"`
+}
+
+// TopicRuleObservation keeps the state for the external resource
+type TopicRuleObservation struct {
+	// RuleARN is the ARN of the rule.
+	RuleARN string `json:"ruleArn,omitempty"`
+}
+
+// A TopicRuleStatus represents the observed state of a TopicRule.
+type TopicRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TopicRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TopicRule is a managed resource that represents an AWS IoT topic rule,
+// which routes the messages published by devices to other AWS services. The
+// external name of the resource is the name of the rule, which may only
+// contain alphanumeric characters and underscores.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISABLED",type="boolean",JSONPath=".spec.forProvider.ruleDisabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TopicRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TopicRuleSpec   `json:"spec"`
+	Status TopicRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TopicRuleList contains a list of TopicRules
+type TopicRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TopicRule `json:"items"`
+}
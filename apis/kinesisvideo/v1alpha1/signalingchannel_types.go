@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SignalingChannelParameters define the desired state of an Amazon Kinesis
+// Video signaling channel.
+type SignalingChannelParameters struct {
+	// ChannelType is the type of the signaling channel. SINGLE_MASTER is the
+	// only supported type.
+	// +kubebuilder:validation:Enum=SINGLE_MASTER
+	// +immutable
+	// +optional
+	ChannelType *string `json:"channelType,omitempty"`
+
+	// MessageTTLSeconds is the period of time a signaling message is stored
+	// by the channel if it is not delivered.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	MessageTTLSeconds *int64 `json:"messageTtlSeconds,omitempty"`
+
+	// Tags to assign to the signaling channel when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A SignalingChannelSpec defines the desired state of a SignalingChannel.
+type SignalingChannelSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SignalingChannelParameters `json:"forProvider,omitempty"`
+}
+
+// SignalingChannelObservation keeps the state for the external resource
+type SignalingChannelObservation struct {
+	// ChannelARN is the ARN of the signaling channel.
+	ChannelARN string `json:"channelArn,omitempty"`
+
+	// ChannelStatus is the state of the signaling channel.
+	ChannelStatus string `json:"channelStatus,omitempty"`
+
+	// Version of the signaling channel, which changes whenever the channel
+	// is updated.
+	Version string `json:"version,omitempty"`
+}
+
+// A SignalingChannelStatus represents the observed state of a
+// SignalingChannel.
+type SignalingChannelStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SignalingChannelObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SignalingChannel is a managed resource that represents an Amazon Kinesis
+// Video signaling channel used to establish WebRTC peer connections. The
+// external name of the resource is the name of the channel.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.channelStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SignalingChannel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SignalingChannelSpec   `json:"spec"`
+	Status SignalingChannelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SignalingChannelList contains a list of SignalingChannels
+type SignalingChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SignalingChannel `json:"items"`
+}
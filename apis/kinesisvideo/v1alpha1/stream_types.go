@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// StreamParameters define the desired state of an Amazon Kinesis Video
+// stream.
+type StreamParameters struct {
+	// DeviceName is the name of the device writing to the stream.
+	// +optional
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// MediaType is the media type of the stream, e.g. video/h264.
+	// +optional
+	MediaType *string `json:"mediaType,omitempty"`
+
+	// KMSKeyID is the ID or alias of the AWS KMS key used to encrypt the
+	// data of the stream. The Kinesis Video managed key is used if it is
+	// omitted.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// DataRetentionInHours is the number of hours the data of the stream is
+	// retained. Data is not retained if it is 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DataRetentionInHours *int64 `json:"dataRetentionInHours,omitempty"`
+
+	// Tags to assign to the stream when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A StreamSpec defines the desired state of a Stream.
+type StreamSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  StreamParameters `json:"forProvider,omitempty"`
+}
+
+// StreamObservation keeps the state for the external resource
+type StreamObservation struct {
+	// StreamARN is the ARN of the stream.
+	StreamARN string `json:"streamArn,omitempty"`
+
+	// Status of the stream.
+	Status string `json:"status,omitempty"`
+
+	// Version of the stream, which changes whenever the stream is updated.
+	Version string `json:"version,omitempty"`
+}
+
+// A StreamStatus represents the observed state of a Stream.
+type StreamStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     StreamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Stream is a managed resource that represents an Amazon Kinesis Video
+// stream. The external name of the resource is the name of the stream.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RETENTION",type="integer",JSONPath=".spec.forProvider.dataRetentionInHours"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Stream struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StreamSpec   `json:"spec"`
+	Status StreamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StreamList contains a list of Streams
+type StreamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stream `json:"items"`
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// GrantOperation is an operation a grant permits.
+// +kubebuilder:validation:Enum=Decrypt;Encrypt;GenerateDataKey;GenerateDataKeyWithoutPlaintext;ReEncryptFrom;ReEncryptTo;Sign;Verify;GetPublicKey;CreateGrant;RetireGrant;DescribeKey;GenerateDataKeyPair;GenerateDataKeyPairWithoutPlaintext
+type GrantOperation string
+
+// GrantConstraints limit the operations of a grant to requests with a
+// matching encryption context.
+type GrantConstraints struct {
+	// EncryptionContextEquals permits operations only when the encryption
+	// context of the request matches it exactly.
+	// +optional
+	EncryptionContextEquals map[string]string `json:"encryptionContextEquals,omitempty"`
+
+	// EncryptionContextSubset permits operations only when the encryption
+	// context of the request includes it.
+	// +optional
+	EncryptionContextSubset map[string]string `json:"encryptionContextSubset,omitempty"`
+}
+
+// GrantParameters define the desired state of an AWS KMS grant.
+type GrantParameters struct {
+	// KeyID is the ID or ARN of the customer master key the grant applies to.
+	// The ARN must be used for keys of other accounts.
+	// +immutable
+	KeyID string `json:"keyId"`
+
+	// GranteePrincipal is the principal that is given permission to perform
+	// the operations, e.g. the ARN of an IAM role or an AWS account.
+	// +immutable
+	// +optional
+	GranteePrincipal *string `json:"granteePrincipal,omitempty"`
+
+	// GranteePrincipalRef references an IAMRole to retrieve its ARN.
+	// +optional
+	GranteePrincipalRef *runtimev1alpha1.Reference `json:"granteePrincipalRef,omitempty"`
+
+	// GranteePrincipalSelector selects a reference to an IAMRole to retrieve
+	// its ARN.
+	// +optional
+	GranteePrincipalSelector *runtimev1alpha1.Selector `json:"granteePrincipalSelector,omitempty"`
+
+	// Operations the grant permits.
+	// +immutable
+	Operations []GrantOperation `json:"operations"`
+
+	// RetiringPrincipal is the principal that is given permission to retire
+	// the grant.
+	// +immutable
+	// +optional
+	RetiringPrincipal *string `json:"retiringPrincipal,omitempty"`
+
+	// Constraints limit the operations to requests with a matching
+	// encryption context.
+	// +immutable
+	// +optional
+	Constraints *GrantConstraints `json:"constraints,omitempty"`
+
+	// Name of the grant. Creating a grant with the same name, key, grantee
+	// and operations as an existing grant returns the existing grant.
+	// +immutable
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// A GrantSpec defines the desired state of a Grant.
+type GrantSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  GrantParameters `json:"forProvider"`
+}
+
+// GrantObservation keeps the state for the external resource
+type GrantObservation struct {
+	// IssuingAccount is the account under which the grant was issued.
+	IssuingAccount string `json:"issuingAccount,omitempty"`
+
+	// CreationDate is the time the grant was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// A GrantStatus represents the observed state of a Grant.
+type GrantStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     GrantObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Grant is a managed resource that represents an AWS KMS grant, which
+// allows a principal, possibly of another account, to use a customer master
+// key. The external name of the resource is the grant ID assigned by KMS.
+// Grants cannot be changed once created. Deleting a Grant revokes it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="GRANTEE",type="string",JSONPath=".spec.forProvider.granteePrincipal"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Grant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GrantSpec   `json:"spec"`
+	Status GrantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GrantList contains a list of Grants
+type GrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Grant `json:"items"`
+}
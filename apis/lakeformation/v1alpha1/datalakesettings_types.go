@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PrincipalPermissions are the permissions granted to a principal.
+type PrincipalPermissions struct {
+	// Principal is the identifier of the principal, e.g. the ARN of an IAM
+	// user or role, or IAM_ALLOWED_PRINCIPALS.
+	Principal string `json:"principal"`
+
+	// Permissions granted to the principal.
+	Permissions []Permission `json:"permissions"`
+}
+
+// Permission is a Lake Formation permission.
+// +kubebuilder:validation:Enum=ALL;SELECT;ALTER;DROP;DELETE;INSERT;CREATE_DATABASE;CREATE_TABLE;DATA_LOCATION_ACCESS
+type Permission string
+
+// DataLakeSettingsParameters define the desired state of the AWS Lake
+// Formation data lake settings.
+type DataLakeSettingsParameters struct {
+	// CatalogID is the ID of the Data Catalog the settings apply to. The
+	// catalog of the account is used when it is omitted.
+	// +immutable
+	// +optional
+	CatalogID *string `json:"catalogId,omitempty"`
+
+	// DataLakeAdmins are the ARNs of the IAM users and roles that
+	// administer the data lake.
+	// +optional
+	DataLakeAdmins []string `json:"dataLakeAdmins,omitempty"`
+
+	// CreateDatabaseDefaultPermissions are granted on new databases.
+	// +optional
+	CreateDatabaseDefaultPermissions []PrincipalPermissions `json:"createDatabaseDefaultPermissions,omitempty"`
+
+	// CreateTableDefaultPermissions are granted on new tables.
+	// +optional
+	CreateTableDefaultPermissions []PrincipalPermissions `json:"createTableDefaultPermissions,omitempty"`
+}
+
+// A DataLakeSettingsSpec defines the desired state of a DataLakeSettings.
+type DataLakeSettingsSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DataLakeSettingsParameters `json:"forProvider,omitempty"`
+}
+
+// A DataLakeSettingsStatus represents the observed state of a
+// DataLakeSettings.
+type DataLakeSettingsStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DataLakeSettings is a managed resource that represents the AWS Lake
+// Formation settings of a Data Catalog. There is a single set of settings per
+// catalog, so the external name of the resource is not used. Deleting a
+// DataLakeSettings restores the default settings, which have no data lake
+// administrators and grant ALL to IAM_ALLOWED_PRINCIPALS on new databases and
+// tables.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DataLakeSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataLakeSettingsSpec   `json:"spec"`
+	Status DataLakeSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataLakeSettingsList contains a list of DataLakeSettings
+type DataLakeSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataLakeSettings `json:"items"`
+}
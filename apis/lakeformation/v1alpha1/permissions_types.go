@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CatalogResource is the Data Catalog itself.
+type CatalogResource struct{}
+
+// DatabaseResource is a database in the Data Catalog.
+type DatabaseResource struct {
+	// Name of the database.
+	Name string `json:"name"`
+}
+
+// TableResource is a table in the Data Catalog.
+type TableResource struct {
+	// DatabaseName is the name of the database of the table.
+	DatabaseName string `json:"databaseName"`
+
+	// Name of the table.
+	Name string `json:"name"`
+}
+
+// ColumnWildcard selects all columns of a table except the excluded ones.
+type ColumnWildcard struct {
+	// ExcludedColumnNames are the columns that are not selected.
+	// +optional
+	ExcludedColumnNames []string `json:"excludedColumnNames,omitempty"`
+}
+
+// TableWithColumnsResource is a set of columns of a table in the Data
+// Catalog.
+type TableWithColumnsResource struct {
+	// DatabaseName is the name of the database of the table.
+	DatabaseName string `json:"databaseName"`
+
+	// Name of the table.
+	Name string `json:"name"`
+
+	// ColumnNames are the selected columns. Either ColumnNames or
+	// ColumnWildcard must be set.
+	// +optional
+	ColumnNames []string `json:"columnNames,omitempty"`
+
+	// ColumnWildcard selects all columns except the excluded ones.
+	// +optional
+	ColumnWildcard *ColumnWildcard `json:"columnWildcard,omitempty"`
+}
+
+// DataLocationResource is an Amazon S3 location registered with Lake
+// Formation.
+type DataLocationResource struct {
+	// ResourceARN is the ARN of the registered location.
+	ResourceARN string `json:"resourceArn"`
+}
+
+// PermissionsResource is the resource permissions are granted on. Exactly
+// one of its fields must be set.
+type PermissionsResource struct {
+	// Catalog grants permissions on the Data Catalog.
+	// +optional
+	Catalog *CatalogResource `json:"catalog,omitempty"`
+
+	// Database grants permissions on a database.
+	// +optional
+	Database *DatabaseResource `json:"database,omitempty"`
+
+	// Table grants permissions on a table.
+	// +optional
+	Table *TableResource `json:"table,omitempty"`
+
+	// TableWithColumns grants permissions on columns of a table.
+	// +optional
+	TableWithColumns *TableWithColumnsResource `json:"tableWithColumns,omitempty"`
+
+	// DataLocation grants permissions on a registered Amazon S3 location.
+	// +optional
+	DataLocation *DataLocationResource `json:"dataLocation,omitempty"`
+}
+
+// PermissionsParameters define the desired state of AWS Lake Formation
+// permissions.
+type PermissionsParameters struct {
+	// CatalogID is the ID of the Data Catalog of the resource. The catalog
+	// of the account is used when it is omitted.
+	// +immutable
+	// +optional
+	CatalogID *string `json:"catalogId,omitempty"`
+
+	// Principal is the identifier of the principal the permissions are
+	// granted to, e.g. the ARN of an IAM user or role.
+	// +immutable
+	// +optional
+	Principal *string `json:"principal,omitempty"`
+
+	// PrincipalRef references an IAMRole to retrieve its ARN.
+	// +optional
+	PrincipalRef *runtimev1alpha1.Reference `json:"principalRef,omitempty"`
+
+	// PrincipalSelector selects a reference to an IAMRole to retrieve its
+	// ARN.
+	// +optional
+	PrincipalSelector *runtimev1alpha1.Selector `json:"principalSelector,omitempty"`
+
+	// Resource the permissions are granted on.
+	// +immutable
+	Resource PermissionsResource `json:"resource"`
+
+	// Permissions granted to the principal.
+	Permissions []Permission `json:"permissions"`
+
+	// PermissionsWithGrantOption are the permissions the principal can
+	// grant to other principals. They must be a subset of Permissions.
+	// +optional
+	PermissionsWithGrantOption []Permission `json:"permissionsWithGrantOption,omitempty"`
+}
+
+// A PermissionsSpec defines the desired state of a Permissions.
+type PermissionsSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PermissionsParameters `json:"forProvider"`
+}
+
+// A PermissionsStatus represents the observed state of a Permissions.
+type PermissionsStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Permissions is a managed resource that represents the AWS Lake Formation
+// permissions of a principal on a Data Catalog resource or data location. The
+// permissions are identified by their principal and resource, so the
+// external name of the resource is not used. Deleting a Permissions revokes
+// the permissions.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRINCIPAL",type="string",JSONPath=".spec.forProvider.principal"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Permissions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PermissionsSpec   `json:"spec"`
+	Status PermissionsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PermissionsList contains a list of Permissions
+type PermissionsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Permissions `json:"items"`
+}
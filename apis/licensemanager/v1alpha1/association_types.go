@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AssociationParameters define the desired state of an association between
+// a resource and an AWS License Manager license configuration.
+type AssociationParameters struct {
+	// ResourceARN is the ARN of the resource that consumes licenses, for
+	// example an AMI or an EC2 instance.
+	// +immutable
+	ResourceARN string `json:"resourceArn"`
+
+	// LicenseConfigurationARN is the ARN of the license configuration.
+	// +immutable
+	// +optional
+	LicenseConfigurationARN *string `json:"licenseConfigurationArn,omitempty"`
+
+	// LicenseConfigurationARNRef references a LicenseConfiguration to
+	// retrieve its ARN.
+	// +optional
+	LicenseConfigurationARNRef *runtimev1alpha1.Reference `json:"licenseConfigurationArnRef,omitempty"`
+
+	// LicenseConfigurationARNSelector selects a reference to a
+	// LicenseConfiguration to retrieve its ARN.
+	// +optional
+	LicenseConfigurationARNSelector *runtimev1alpha1.Selector `json:"licenseConfigurationArnSelector,omitempty"`
+}
+
+// An AssociationSpec defines the desired state of an Association.
+type AssociationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AssociationParameters `json:"forProvider"`
+}
+
+// An AssociationStatus represents the observed state of an Association.
+type AssociationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Association is a managed resource that associates a resource with an
+// AWS License Manager license configuration, so that the licenses it
+// consumes are tracked.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".spec.forProvider.resourceArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Association struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AssociationSpec   `json:"spec"`
+	Status AssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AssociationList contains a list of Associations
+type AssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Association `json:"items"`
+}
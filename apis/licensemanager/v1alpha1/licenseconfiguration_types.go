@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Tag is a key-value pair attached to a License Manager resource.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// LicenseConfigurationParameters define the desired state of an AWS License
+// Manager license configuration.
+type LicenseConfigurationParameters struct {
+	// Name of the license configuration.
+	Name string `json:"name"`
+
+	// Description of the license configuration.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// LicenseCountingType is the dimension licenses are counted in.
+	// +immutable
+	// +kubebuilder:validation:Enum=vCPU;Instance;Core;Socket
+	LicenseCountingType string `json:"licenseCountingType"`
+
+	// LicenseCount is the number of licenses managed by the license
+	// configuration.
+	// +optional
+	LicenseCount *int64 `json:"licenseCount,omitempty"`
+
+	// LicenseCountHardLimit prevents the launch of resources that would
+	// exceed the license count.
+	// +optional
+	LicenseCountHardLimit *bool `json:"licenseCountHardLimit,omitempty"`
+
+	// LicenseRules restrict which resources consume licenses, for example
+	// #minimumSockets=2 or #allowedTenancy=EC2-DedicatedHost.
+	// +optional
+	LicenseRules []string `json:"licenseRules,omitempty"`
+
+	// Tags to assign to the license configuration when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LicenseConfigurationSpec defines the desired state of a
+// LicenseConfiguration.
+type LicenseConfigurationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LicenseConfigurationParameters `json:"forProvider"`
+}
+
+// LicenseConfigurationObservation keeps the state for the external resource
+type LicenseConfigurationObservation struct {
+	// ARN of the license configuration.
+	ARN string `json:"arn,omitempty"`
+
+	// LicenseConfigurationID is the unique ID of the license configuration.
+	LicenseConfigurationID string `json:"licenseConfigurationId,omitempty"`
+
+	// ConsumedLicenses is the number of licenses currently consumed.
+	ConsumedLicenses int64 `json:"consumedLicenses,omitempty"`
+
+	// OwnerAccountID is the ID of the account that owns the license
+	// configuration.
+	OwnerAccountID string `json:"ownerAccountId,omitempty"`
+
+	// Status of the license configuration.
+	Status string `json:"status,omitempty"`
+}
+
+// A LicenseConfigurationStatus represents the observed state of a
+// LicenseConfiguration.
+type LicenseConfigurationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LicenseConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LicenseConfiguration is a managed resource that represents an AWS License
+// Manager license configuration. The external name of the resource is the
+// ARN of the license configuration.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="COUNTING",type="string",JSONPath=".spec.forProvider.licenseCountingType"
+// +kubebuilder:printcolumn:name="COUNT",type="integer",JSONPath=".spec.forProvider.licenseCount"
+// +kubebuilder:printcolumn:name="CONSUMED",type="integer",JSONPath=".status.atProvider.consumedLicenses"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LicenseConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LicenseConfigurationSpec   `json:"spec"`
+	Status LicenseConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LicenseConfigurationList contains a list of LicenseConfigurations
+type LicenseConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LicenseConfiguration `json:"items"`
+}
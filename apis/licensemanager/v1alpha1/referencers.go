@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+)
+
+// ResolveReferences of this Association
+func (mg *Association) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.licenseConfigurationArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LicenseConfigurationARN),
+		Reference:    mg.Spec.ForProvider.LicenseConfigurationARNRef,
+		Selector:     mg.Spec.ForProvider.LicenseConfigurationARNSelector,
+		To:           reference.To{Managed: &LicenseConfiguration{}, List: &LicenseConfigurationList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.LicenseConfigurationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LicenseConfigurationARNRef = rsp.ResolvedReference
+
+	return nil
+}
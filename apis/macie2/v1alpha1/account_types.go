@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AccountParameters define the desired state of the Amazon Macie session of
+// an account.
+type AccountParameters struct {
+	// FindingPublishingFrequency is how often updated policy findings are
+	// published to Amazon EventBridge.
+	// +kubebuilder:validation:Enum=FIFTEEN_MINUTES;ONE_HOUR;SIX_HOURS
+	// +optional
+	FindingPublishingFrequency *string `json:"findingPublishingFrequency,omitempty"`
+
+	// Status of Macie for the account. PAUSED suspends Macie without
+	// deleting its configuration and findings.
+	// +kubebuilder:validation:Enum=ENABLED;PAUSED
+	// +optional
+	Status *string `json:"status,omitempty"`
+}
+
+// An AccountSpec defines the desired state of an Account.
+type AccountSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AccountParameters `json:"forProvider,omitempty"`
+}
+
+// AccountObservation keeps the state for the external resource
+type AccountObservation struct {
+	// ServiceRole is the ARN of the service-linked role Macie uses.
+	ServiceRole string `json:"serviceRole,omitempty"`
+
+	// CreatedAt is the time Macie was enabled for the account.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// An AccountStatus represents the observed state of an Account.
+type AccountStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Account is a managed resource that represents the Amazon Macie session
+// of the account in the region of its provider. There is a single session per
+// account and region, so the external name of the resource is not used.
+// Deleting an Account disables Macie, which deletes its configuration and
+// findings.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Account struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountSpec   `json:"spec"`
+	Status AccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountList contains a list of Accounts
+type AccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Account `json:"items"`
+}
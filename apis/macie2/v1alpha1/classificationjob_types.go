@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// S3BucketDefinition is a set of buckets of an account to analyze.
+type S3BucketDefinition struct {
+	// AccountID is the ID of the account that owns the buckets.
+	AccountID string `json:"accountId"`
+
+	// Buckets are the names of the buckets to analyze.
+	Buckets []string `json:"buckets"`
+}
+
+// S3JobDefinition defines the buckets a classification job analyzes.
+type S3JobDefinition struct {
+	// BucketDefinitions are the buckets to analyze.
+	BucketDefinitions []S3BucketDefinition `json:"bucketDefinitions"`
+}
+
+// DailySchedule runs a job every day.
+type DailySchedule struct{}
+
+// WeeklySchedule runs a job every week.
+type WeeklySchedule struct {
+	// DayOfWeek the job runs on.
+	// +kubebuilder:validation:Enum=SUNDAY;MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY
+	DayOfWeek string `json:"dayOfWeek"`
+}
+
+// MonthlySchedule runs a job every month.
+type MonthlySchedule struct {
+	// DayOfMonth the job runs on. The job runs on the last day of shorter
+	// months when the day does not exist.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=31
+	DayOfMonth int64 `json:"dayOfMonth"`
+}
+
+// JobScheduleFrequency is the recurrence of a scheduled job. Exactly one of
+// its fields must be set.
+type JobScheduleFrequency struct {
+	// DailySchedule runs the job every day.
+	// +optional
+	DailySchedule *DailySchedule `json:"dailySchedule,omitempty"`
+
+	// WeeklySchedule runs the job every week.
+	// +optional
+	WeeklySchedule *WeeklySchedule `json:"weeklySchedule,omitempty"`
+
+	// MonthlySchedule runs the job every month.
+	// +optional
+	MonthlySchedule *MonthlySchedule `json:"monthlySchedule,omitempty"`
+}
+
+// ClassificationJobParameters define the desired state of an Amazon Macie
+// classification job.
+type ClassificationJobParameters struct {
+	// Name of the job.
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the job.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// JobType is whether the job runs once or on a schedule.
+	// +kubebuilder:validation:Enum=ONE_TIME;SCHEDULED
+	// +immutable
+	JobType string `json:"jobType"`
+
+	// ScheduleFrequency is the recurrence of a scheduled job.
+	// +immutable
+	// +optional
+	ScheduleFrequency *JobScheduleFrequency `json:"scheduleFrequency,omitempty"`
+
+	// InitialRun analyzes all existing objects when a scheduled job is
+	// created, rather than only objects created after it.
+	// +immutable
+	// +optional
+	InitialRun *bool `json:"initialRun,omitempty"`
+
+	// SamplingPercentage is the percentage of eligible objects the job
+	// analyzes.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +immutable
+	// +optional
+	SamplingPercentage *int64 `json:"samplingPercentage,omitempty"`
+
+	// S3JobDefinition defines the buckets the job analyzes.
+	// +immutable
+	S3JobDefinition S3JobDefinition `json:"s3JobDefinition"`
+
+	// CustomDataIdentifierIDs are the IDs of the custom data identifiers the
+	// job uses in addition to the managed data identifiers.
+	// +immutable
+	// +optional
+	CustomDataIdentifierIDs []string `json:"customDataIdentifierIds,omitempty"`
+
+	// CustomDataIdentifierIDRefs references CustomDataIdentifiers to
+	// retrieve their IDs.
+	// +optional
+	CustomDataIdentifierIDRefs []runtimev1alpha1.Reference `json:"customDataIdentifierIdRefs,omitempty"`
+
+	// CustomDataIdentifierIDSelector selects references to
+	// CustomDataIdentifiers to retrieve their IDs.
+	// +optional
+	CustomDataIdentifierIDSelector *runtimev1alpha1.Selector `json:"customDataIdentifierIdSelector,omitempty"`
+
+	// JobStatus is the desired status of the job. A PAUSED job can be
+	// resumed by setting it to RUNNING again within 30 days.
+	// +kubebuilder:validation:Enum=RUNNING;PAUSED
+	// +optional
+	JobStatus *string `json:"jobStatus,omitempty"`
+
+	// Tags to assign to the job when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ClassificationJobSpec defines the desired state of a ClassificationJob.
+type ClassificationJobSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ClassificationJobParameters `json:"forProvider"`
+}
+
+// ClassificationJobObservation keeps the state for the external resource
+type ClassificationJobObservation struct {
+	// The ARN of the job.
+	JobARN string `json:"jobArn,omitempty"`
+
+	// JobStatus is the current status of the job.
+	JobStatus string `json:"jobStatus,omitempty"`
+
+	// LastRunTime is the time the job last started to run.
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+}
+
+// A ClassificationJobStatus represents the observed state of a
+// ClassificationJob.
+type ClassificationJobStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ClassificationJobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClassificationJob is a managed resource that represents an Amazon Macie
+// sensitive data discovery job. The external name of the resource is the job
+// ID assigned by Macie. Macie does not delete jobs, so deleting a
+// ClassificationJob cancels the job.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.jobStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ClassificationJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClassificationJobSpec   `json:"spec"`
+	Status ClassificationJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClassificationJobList contains a list of ClassificationJobs
+type ClassificationJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClassificationJob `json:"items"`
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CustomDataIdentifierParameters define the desired state of an Amazon Macie
+// custom data identifier.
+type CustomDataIdentifierParameters struct {
+	// Name of the custom data identifier.
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the custom data identifier.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Regex is the regular expression that defines the pattern to match.
+	// +immutable
+	Regex string `json:"regex"`
+
+	// Keywords of which at least one must be in proximity of the matched
+	// text.
+	// +immutable
+	// +optional
+	Keywords []string `json:"keywords,omitempty"`
+
+	// IgnoreWords are matches of the regular expression that are ignored.
+	// +immutable
+	// +optional
+	IgnoreWords []string `json:"ignoreWords,omitempty"`
+
+	// MaximumMatchDistance is the maximum number of characters between a
+	// keyword and the matched text.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=300
+	// +immutable
+	// +optional
+	MaximumMatchDistance *int64 `json:"maximumMatchDistance,omitempty"`
+
+	// Tags to assign to the custom data identifier when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A CustomDataIdentifierSpec defines the desired state of a
+// CustomDataIdentifier.
+type CustomDataIdentifierSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CustomDataIdentifierParameters `json:"forProvider"`
+}
+
+// CustomDataIdentifierObservation keeps the state for the external resource
+type CustomDataIdentifierObservation struct {
+	// The ARN of the custom data identifier.
+	ARN string `json:"arn,omitempty"`
+}
+
+// A CustomDataIdentifierStatus represents the observed state of a
+// CustomDataIdentifier.
+type CustomDataIdentifierStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CustomDataIdentifierObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CustomDataIdentifier is a managed resource that represents an Amazon
+// Macie custom data identifier. The external name of the resource is the ID
+// assigned by Macie. Custom data identifiers cannot be changed once created.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CustomDataIdentifier struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomDataIdentifierSpec   `json:"spec"`
+	Status CustomDataIdentifierStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomDataIdentifierList contains a list of CustomDataIdentifiers
+type CustomDataIdentifierList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomDataIdentifier `json:"items"`
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+)
+
+// ResolveReferences of this ClassificationJob
+func (mg *ClassificationJob) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.customDataIdentifierIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.CustomDataIdentifierIDs,
+		References:    mg.Spec.ForProvider.CustomDataIdentifierIDRefs,
+		Selector:      mg.Spec.ForProvider.CustomDataIdentifierIDSelector,
+		To:            reference.To{Managed: &CustomDataIdentifier{}, List: &CustomDataIdentifierList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CustomDataIdentifierIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.CustomDataIdentifierIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
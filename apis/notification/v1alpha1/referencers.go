@@ -21,6 +21,8 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sqsv1alpha1 "github.com/crossplane/provider-aws/apis/applicationintegration/v1alpha1"
 )
 
 // ResolveReferences for SNS Subscription managed type
@@ -42,5 +44,40 @@ func (mg *SNSSubscription) ResolveReferences(ctx context.Context, c client.Reade
 	mg.Spec.ForProvider.TopicARN = rsp.ResolvedValue
 	mg.Spec.ForProvider.TopicARNRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.deadLetterQueueARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DeadLetterQueueARN),
+		Reference:    mg.Spec.ForProvider.DeadLetterQueueARNRef,
+		Selector:     mg.Spec.ForProvider.DeadLetterQueueARNSelector,
+		To:           reference.To{Managed: &sqsv1alpha1.Queue{}, List: &sqsv1alpha1.QueueList{}},
+		Extract:      sqsv1alpha1.QueueARN(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.DeadLetterQueueARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DeadLetterQueueARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences for SNS Topic Policy managed type
+func (mg *SNSTopicPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.TopicARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TopicARN),
+		Reference:    mg.Spec.ForProvider.TopicARNRef,
+		Selector:     mg.Spec.ForProvider.TopicARNSelector,
+		To:           reference.To{Managed: &SNSTopic{}, List: &SNSTopicList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.TopicARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TopicARNRef = rsp.ResolvedReference
+
 	return nil
 }
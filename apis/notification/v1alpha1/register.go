@@ -53,7 +53,25 @@ var (
 	SNSSubscriptionGroupVersionKind = SchemeGroupVersion.WithKind(SNSSubscriptionKind)
 )
 
+// SNSPlatformApplication type metadata.
+var (
+	SNSPlatformApplicationKind             = reflect.TypeOf(SNSPlatformApplication{}).Name()
+	SNSPlatformApplicationGroupKind        = schema.GroupKind{Group: Group, Kind: SNSPlatformApplicationKind}.String()
+	SNSPlatformApplicationKindAPIVersion   = SNSPlatformApplicationKind + "." + SchemeGroupVersion.String()
+	SNSPlatformApplicationGroupVersionKind = SchemeGroupVersion.WithKind(SNSPlatformApplicationKind)
+)
+
+// SNSTopicPolicy type metadata.
+var (
+	SNSTopicPolicyKind             = reflect.TypeOf(SNSTopicPolicy{}).Name()
+	SNSTopicPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: SNSTopicPolicyKind}.String()
+	SNSTopicPolicyKindAPIVersion   = SNSTopicPolicyKind + "." + SchemeGroupVersion.String()
+	SNSTopicPolicyGroupVersionKind = SchemeGroupVersion.WithKind(SNSTopicPolicyKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&SNSTopic{}, &SNSTopicList{})
 	SchemeBuilder.Register(&SNSSubscription{}, &SNSSubscriptionList{})
+	SchemeBuilder.Register(&SNSPlatformApplication{}, &SNSPlatformApplicationList{})
+	SchemeBuilder.Register(&SNSTopicPolicy{}, &SNSTopicPolicyList{})
 }
@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SNSPlatformApplicationParameters define the desired state of a AWS SNS
+// Platform Application
+type SNSPlatformApplicationParameters struct {
+	// Name refers to the name of the AWS SNS Platform Application
+	// +immutable
+	Name string `json:"name"`
+
+	// Platform is the push notification service of the application. GCM is
+	// used for Firebase Cloud Messaging.
+	// +immutable
+	// +kubebuilder:validation:Enum=ADM;APNS;APNS_SANDBOX;APNS_VOIP;APNS_VOIP_SANDBOX;BAIDU;GCM;MPNS;WNS
+	Platform string `json:"platform"`
+
+	// PlatformCredentialSecretRef references the key of a secret that holds
+	// the credential of the platform, e.g. the private key of an APNS
+	// certificate or the server key of a GCM application. It is only sent
+	// to AWS when the application is created.
+	// +immutable
+	PlatformCredentialSecretRef runtimev1alpha1.SecretKeySelector `json:"platformCredentialSecretRef"`
+
+	// PlatformPrincipalSecretRef references the key of a secret that holds
+	// the principal of the platform, e.g. the APNS certificate or the client
+	// ID of an ADM application. It is only sent to AWS when the application
+	// is created.
+	// +immutable
+	// +optional
+	PlatformPrincipalSecretRef *runtimev1alpha1.SecretKeySelector `json:"platformPrincipalSecretRef,omitempty"`
+
+	// EventEndpointCreated is the ARN of the topic that is notified when an
+	// endpoint is added to the application.
+	// +optional
+	EventEndpointCreated *string `json:"eventEndpointCreated,omitempty"`
+
+	// EventEndpointDeleted is the ARN of the topic that is notified when an
+	// endpoint is deleted from the application.
+	// +optional
+	EventEndpointDeleted *string `json:"eventEndpointDeleted,omitempty"`
+
+	// EventEndpointUpdated is the ARN of the topic that is notified when an
+	// endpoint of the application is changed.
+	// +optional
+	EventEndpointUpdated *string `json:"eventEndpointUpdated,omitempty"`
+
+	// EventDeliveryFailure is the ARN of the topic that is notified when a
+	// delivery to an endpoint of the application fails permanently.
+	// +optional
+	EventDeliveryFailure *string `json:"eventDeliveryFailure,omitempty"`
+
+	// SuccessFeedbackRoleARN is the ARN of the IAM role used to write
+	// successful delivery logs to CloudWatch.
+	// +optional
+	SuccessFeedbackRoleARN *string `json:"successFeedbackRoleArn,omitempty"`
+
+	// FailureFeedbackRoleARN is the ARN of the IAM role used to write failed
+	// delivery logs to CloudWatch.
+	// +optional
+	FailureFeedbackRoleARN *string `json:"failureFeedbackRoleArn,omitempty"`
+
+	// SuccessFeedbackSampleRate is the percentage, from 0 to 100, of
+	// successfully delivered messages to log.
+	// +optional
+	SuccessFeedbackSampleRate *string `json:"successFeedbackSampleRate,omitempty"`
+}
+
+// SNSPlatformApplicationSpec defined the desired state of a AWS SNS Platform
+// Application
+type SNSPlatformApplicationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SNSPlatformApplicationParameters `json:"forProvider"`
+}
+
+// SNSPlatformApplicationObservation represents the observed state of a AWS
+// SNS Platform Application
+type SNSPlatformApplicationObservation struct {
+	// Enabled is false once the platform disabled the application, e.g.
+	// because its credential expired.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// AppleCertificateExpirationDate is the expiration date of the APNS
+	// certificate of the application.
+	// +optional
+	AppleCertificateExpirationDate *string `json:"appleCertificateExpirationDate,omitempty"`
+}
+
+// SNSPlatformApplicationStatus is the status of AWS SNS Platform Application
+type SNSPlatformApplicationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SNSPlatformApplicationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SNSPlatformApplication defines a managed resource that represents state of
+// a AWS SNS Platform Application, which mobile endpoints register with to
+// receive push notifications
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="PLATFORM",type="string",JSONPath=".spec.forProvider.platform"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SNSPlatformApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SNSPlatformApplicationSpec   `json:"spec"`
+	Status SNSPlatformApplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SNSPlatformApplicationList contains a list of SNSPlatformApplication
+type SNSPlatformApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SNSPlatformApplication `json:"items"`
+}
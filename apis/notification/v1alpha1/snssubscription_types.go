@@ -72,6 +72,21 @@ type SNSSubscriptionParameters struct {
 	//  analysis or reprocessing.
 	// +optional
 	RedrivePolicy *string `json:"redrivePolicy,omitempty"`
+
+	// DeadLetterQueueARN is the ARN of the Amazon SQS dead-letter queue that
+	// undeliverable messages are sent to. It is used to build the redrive
+	// policy of the subscription and is ignored if RedrivePolicy is set.
+	// +optional
+	DeadLetterQueueARN *string `json:"deadLetterQueueARN,omitempty"`
+
+	// DeadLetterQueueARNRef references a Queue to retrieve its ARN.
+	// +optional
+	DeadLetterQueueARNRef *runtimev1alpha1.Reference `json:"deadLetterQueueARNRef,omitempty"`
+
+	// DeadLetterQueueARNSelector selects a reference to a Queue to retrieve
+	// its ARN.
+	// +optional
+	DeadLetterQueueARNSelector *runtimev1alpha1.Selector `json:"deadLetterQueueARNSelector,omitempty"`
 }
 
 // SNSSubscriptionSpec defined the desired state of a AWS SNS Topic
@@ -64,7 +64,8 @@ type SNSTopicParameters struct {
 	KMSMasterKeyID *string `json:"kmsMasterKeyId,omitempty"`
 
 	// The policy that defines who can access your topic. By default,
-	// only the topic owner can publish or subscribe to the topic.
+	// only the topic owner can publish or subscribe to the topic. Leave it
+	// unset when the policy is managed by a SNSTopicPolicy.
 	// +optional
 	Policy *string `json:"policy,omitempty"`
 
@@ -91,6 +92,10 @@ type SNSTopicSpec struct {
 // SNSTopicObservation represents the observed state of a AWS SNS Topic
 type SNSTopicObservation struct {
 
+	// ARN is the Amazon Resource Name (ARN) of SNS Topic
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
 	// Owner refers to owner of SNS Topic
 	// +optional
 	Owner *string `json:"owner,omitempty"`
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SNSTopicPolicyParameters define the desired state of the access policy of
+// an AWS SNS Topic
+type SNSTopicPolicyParameters struct {
+	// TopicARN is the ARN of the SNS Topic the policy is attached to.
+	// +immutable
+	// +optional
+	TopicARN *string `json:"topicArn,omitempty"`
+
+	// TopicARNRef references a SNS Topic and retrieves its TopicArn
+	// +optional
+	TopicARNRef *runtimev1alpha1.Reference `json:"topicArnRef,omitempty"`
+
+	// TopicARNSelector selects a reference to a SNS Topic and retrieves
+	// its TopicArn
+	// +optional
+	TopicARNSelector *runtimev1alpha1.Selector `json:"topicArnSelector,omitempty"`
+
+	// Policy is the JSON access policy document of the topic, e.g. to allow
+	// another account to publish or subscribe to it.
+	Policy string `json:"policy"`
+}
+
+// SNSTopicPolicySpec defines the desired state of a SNSTopicPolicy
+type SNSTopicPolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SNSTopicPolicyParameters `json:"forProvider"`
+}
+
+// SNSTopicPolicyStatus is the status of a SNSTopicPolicy
+type SNSTopicPolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// SNSTopicPolicy defines a managed resource that represents the access
+// policy of an AWS SNS Topic. The policy field of the referenced SNSTopic
+// should be left unset when its policy is managed by a SNSTopicPolicy.
+// Deleting a SNSTopicPolicy restores the default policy of the topic.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TOPIC-ARN",type="string",JSONPath=".spec.forProvider.topicArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SNSTopicPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SNSTopicPolicySpec   `json:"spec"`
+	Status SNSTopicPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SNSTopicPolicyList contains a list of SNSTopicPolicy
+type SNSTopicPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SNSTopicPolicy `json:"items"`
+}
@@ -25,6 +25,180 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSPlatformApplication) DeepCopyInto(out *SNSPlatformApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSPlatformApplication.
+func (in *SNSPlatformApplication) DeepCopy() *SNSPlatformApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSPlatformApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SNSPlatformApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSPlatformApplicationList) DeepCopyInto(out *SNSPlatformApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SNSPlatformApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSPlatformApplicationList.
+func (in *SNSPlatformApplicationList) DeepCopy() *SNSPlatformApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSPlatformApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SNSPlatformApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSPlatformApplicationObservation) DeepCopyInto(out *SNSPlatformApplicationObservation) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AppleCertificateExpirationDate != nil {
+		in, out := &in.AppleCertificateExpirationDate, &out.AppleCertificateExpirationDate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSPlatformApplicationObservation.
+func (in *SNSPlatformApplicationObservation) DeepCopy() *SNSPlatformApplicationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSPlatformApplicationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSPlatformApplicationParameters) DeepCopyInto(out *SNSPlatformApplicationParameters) {
+	*out = *in
+	out.PlatformCredentialSecretRef = in.PlatformCredentialSecretRef
+	if in.PlatformPrincipalSecretRef != nil {
+		in, out := &in.PlatformPrincipalSecretRef, &out.PlatformPrincipalSecretRef
+		*out = new(corev1alpha1.SecretKeySelector)
+		**out = **in
+	}
+	if in.EventEndpointCreated != nil {
+		in, out := &in.EventEndpointCreated, &out.EventEndpointCreated
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventEndpointDeleted != nil {
+		in, out := &in.EventEndpointDeleted, &out.EventEndpointDeleted
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventEndpointUpdated != nil {
+		in, out := &in.EventEndpointUpdated, &out.EventEndpointUpdated
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventDeliveryFailure != nil {
+		in, out := &in.EventDeliveryFailure, &out.EventDeliveryFailure
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuccessFeedbackRoleARN != nil {
+		in, out := &in.SuccessFeedbackRoleARN, &out.SuccessFeedbackRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureFeedbackRoleARN != nil {
+		in, out := &in.FailureFeedbackRoleARN, &out.FailureFeedbackRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuccessFeedbackSampleRate != nil {
+		in, out := &in.SuccessFeedbackSampleRate, &out.SuccessFeedbackSampleRate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSPlatformApplicationParameters.
+func (in *SNSPlatformApplicationParameters) DeepCopy() *SNSPlatformApplicationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSPlatformApplicationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSPlatformApplicationSpec) DeepCopyInto(out *SNSPlatformApplicationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSPlatformApplicationSpec.
+func (in *SNSPlatformApplicationSpec) DeepCopy() *SNSPlatformApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSPlatformApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSPlatformApplicationStatus) DeepCopyInto(out *SNSPlatformApplicationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSPlatformApplicationStatus.
+func (in *SNSPlatformApplicationStatus) DeepCopy() *SNSPlatformApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSPlatformApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SNSSubscription) DeepCopyInto(out *SNSSubscription) {
 	*out = *in
@@ -147,6 +321,21 @@ func (in *SNSSubscriptionParameters) DeepCopyInto(out *SNSSubscriptionParameters
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeadLetterQueueARN != nil {
+		in, out := &in.DeadLetterQueueARN, &out.DeadLetterQueueARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeadLetterQueueARNRef != nil {
+		in, out := &in.DeadLetterQueueARNRef, &out.DeadLetterQueueARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DeadLetterQueueARNSelector != nil {
+		in, out := &in.DeadLetterQueueARNSelector, &out.DeadLetterQueueARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSSubscriptionParameters.
@@ -255,6 +444,11 @@ func (in *SNSTopicList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SNSTopicObservation) DeepCopyInto(out *SNSTopicObservation) {
 	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
 	if in.Owner != nil {
 		in, out := &in.Owner, &out.Owner
 		*out = new(string)
@@ -329,6 +523,128 @@ func (in *SNSTopicParameters) DeepCopy() *SNSTopicParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSTopicPolicy) DeepCopyInto(out *SNSTopicPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSTopicPolicy.
+func (in *SNSTopicPolicy) DeepCopy() *SNSTopicPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSTopicPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SNSTopicPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSTopicPolicyList) DeepCopyInto(out *SNSTopicPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SNSTopicPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSTopicPolicyList.
+func (in *SNSTopicPolicyList) DeepCopy() *SNSTopicPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSTopicPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SNSTopicPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSTopicPolicyParameters) DeepCopyInto(out *SNSTopicPolicyParameters) {
+	*out = *in
+	if in.TopicARN != nil {
+		in, out := &in.TopicARN, &out.TopicARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.TopicARNRef != nil {
+		in, out := &in.TopicARNRef, &out.TopicARNRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.TopicARNSelector != nil {
+		in, out := &in.TopicARNSelector, &out.TopicARNSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSTopicPolicyParameters.
+func (in *SNSTopicPolicyParameters) DeepCopy() *SNSTopicPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSTopicPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSTopicPolicySpec) DeepCopyInto(out *SNSTopicPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSTopicPolicySpec.
+func (in *SNSTopicPolicySpec) DeepCopy() *SNSTopicPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSTopicPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSTopicPolicyStatus) DeepCopyInto(out *SNSTopicPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSTopicPolicyStatus.
+func (in *SNSTopicPolicyStatus) DeepCopy() *SNSTopicPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSTopicPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SNSTopicSpec) DeepCopyInto(out *SNSTopicSpec) {
 	*out = *in
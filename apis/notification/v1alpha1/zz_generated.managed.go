@@ -23,6 +23,76 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+// GetBindingPhase of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this SNSPlatformApplication.
+func (mg *SNSPlatformApplication) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
 // GetBindingPhase of this SNSSubscription.
 func (mg *SNSSubscription) GetBindingPhase() runtimev1alpha1.BindingPhase {
 	return mg.Status.GetBindingPhase()
@@ -162,3 +232,73 @@ func (mg *SNSTopic) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
 func (mg *SNSTopic) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetBindingPhase of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetClassReference of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) GetClassReference() *corev1.ObjectReference {
+	return mg.Spec.ClassReference
+}
+
+// GetCondition of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetProviderReference of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) GetProviderReference() runtimev1alpha1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetReclaimPolicy of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) GetWriteConnectionSecretToReference() *runtimev1alpha1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetClassReference of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) SetClassReference(r *corev1.ObjectReference) {
+	mg.Spec.ClassReference = r
+}
+
+// SetConditions of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetProviderReference of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) SetProviderReference(r runtimev1alpha1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetReclaimPolicy of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this SNSTopicPolicy.
+func (mg *SNSTopicPolicy) SetWriteConnectionSecretToReference(r *runtimev1alpha1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -20,6 +20,15 @@ package v1alpha1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this SNSPlatformApplicationList.
+func (l *SNSPlatformApplicationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this SNSSubscriptionList.
 func (l *SNSSubscriptionList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -37,3 +46,12 @@ func (l *SNSTopicList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this SNSTopicPolicyList.
+func (l *SNSTopicPolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AWSServiceAccessParameters define the desired state of trusted access for an
+// AWS service in an AWS organization.
+type AWSServiceAccessParameters struct {
+	// ServicePrincipal of the AWS service to enable trusted access for, for
+	// example config.amazonaws.com.
+	// +immutable
+	ServicePrincipal string `json:"servicePrincipal"`
+}
+
+// An AWSServiceAccessSpec defines the desired state of an AWSServiceAccess.
+type AWSServiceAccessSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AWSServiceAccessParameters `json:"forProvider"`
+}
+
+// AWSServiceAccessObservation keeps the state for the external resource
+type AWSServiceAccessObservation struct {
+	// DateEnabled is when trusted access was enabled for the service.
+	DateEnabled *metav1.Time `json:"dateEnabled,omitempty"`
+}
+
+// An AWSServiceAccessStatus represents the observed state of an AWSServiceAccess.
+type AWSServiceAccessStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AWSServiceAccessObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AWSServiceAccess is a managed resource that enables trusted access
+// for an AWS service in an AWS organization.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.servicePrincipal"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AWSServiceAccess struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSServiceAccessSpec   `json:"spec"`
+	Status AWSServiceAccessStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSServiceAccessList contains a list of AWSServiceAccesses
+type AWSServiceAccessList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSServiceAccess `json:"items"`
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DelegatedAdministratorParameters define the desired state of an AWS Organizations
+// delegated administrator.
+type DelegatedAdministratorParameters struct {
+	// AccountID is the ID of the member account to register as a delegated
+	// administrator.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// ServicePrincipal of the AWS service the account administers, for
+	// example securityhub.amazonaws.com.
+	// +immutable
+	ServicePrincipal string `json:"servicePrincipal"`
+}
+
+// A DelegatedAdministratorSpec defines the desired state of a DelegatedAdministrator.
+type DelegatedAdministratorSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DelegatedAdministratorParameters `json:"forProvider"`
+}
+
+// DelegatedAdministratorObservation keeps the state for the external resource
+type DelegatedAdministratorObservation struct {
+	// DelegationEnabledDate is when the account became a delegated
+	// administrator for the service.
+	DelegationEnabledDate *metav1.Time `json:"delegationEnabledDate,omitempty"`
+}
+
+// A DelegatedAdministratorStatus represents the observed state of a DelegatedAdministrator.
+type DelegatedAdministratorStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DelegatedAdministratorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DelegatedAdministrator is a managed resource that registers a member
+// account of an AWS organization as the delegated administrator of an AWS
+// service.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".spec.forProvider.accountId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DelegatedAdministrator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DelegatedAdministratorSpec   `json:"spec"`
+	Status DelegatedAdministratorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DelegatedAdministratorList contains a list of DelegatedAdministrators
+type DelegatedAdministratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DelegatedAdministrator `json:"items"`
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "organizations.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Policy type metadata.
+var (
+	PolicyKind             = reflect.TypeOf(Policy{}).Name()
+	PolicyGroupKind        = schema.GroupKind{Group: Group, Kind: PolicyKind}.String()
+	PolicyKindAPIVersion   = PolicyKind + "." + SchemeGroupVersion.String()
+	PolicyGroupVersionKind = SchemeGroupVersion.WithKind(PolicyKind)
+)
+
+// PolicyAttachment type metadata.
+var (
+	PolicyAttachmentKind             = reflect.TypeOf(PolicyAttachment{}).Name()
+	PolicyAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: PolicyAttachmentKind}.String()
+	PolicyAttachmentKindAPIVersion   = PolicyAttachmentKind + "." + SchemeGroupVersion.String()
+	PolicyAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(PolicyAttachmentKind)
+)
+
+// DelegatedAdministrator type metadata.
+var (
+	DelegatedAdministratorKind             = reflect.TypeOf(DelegatedAdministrator{}).Name()
+	DelegatedAdministratorGroupKind        = schema.GroupKind{Group: Group, Kind: DelegatedAdministratorKind}.String()
+	DelegatedAdministratorKindAPIVersion   = DelegatedAdministratorKind + "." + SchemeGroupVersion.String()
+	DelegatedAdministratorGroupVersionKind = SchemeGroupVersion.WithKind(DelegatedAdministratorKind)
+)
+
+// AWSServiceAccess type metadata.
+var (
+	AWSServiceAccessKind             = reflect.TypeOf(AWSServiceAccess{}).Name()
+	AWSServiceAccessGroupKind        = schema.GroupKind{Group: Group, Kind: AWSServiceAccessKind}.String()
+	AWSServiceAccessKindAPIVersion   = AWSServiceAccessKind + "." + SchemeGroupVersion.String()
+	AWSServiceAccessGroupVersionKind = SchemeGroupVersion.WithKind(AWSServiceAccessKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Policy{}, &PolicyList{})
+	SchemeBuilder.Register(&PolicyAttachment{}, &PolicyAttachmentList{})
+	SchemeBuilder.Register(&DelegatedAdministrator{}, &DelegatedAdministratorList{})
+	SchemeBuilder.Register(&AWSServiceAccess{}, &AWSServiceAccessList{})
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// QuietTime is the daily period in which messages are not sent.
+type QuietTime struct {
+	// Start of the quiet time in HH:MM format, e.g. 22:00.
+	Start string `json:"start"`
+
+	// End of the quiet time in HH:MM format, e.g. 07:00.
+	End string `json:"end"`
+}
+
+// CampaignLimits are the default sending limits of the campaigns of an app.
+type CampaignLimits struct {
+	// Daily is the maximum number of messages a campaign can send to a
+	// single endpoint in a day.
+	// +optional
+	Daily *int64 `json:"daily,omitempty"`
+
+	// MaximumDuration is the maximum duration of a campaign in seconds.
+	// +optional
+	MaximumDuration *int64 `json:"maximumDuration,omitempty"`
+
+	// MessagesPerSecond is the maximum number of messages a campaign can send
+	// each second.
+	// +optional
+	MessagesPerSecond *int64 `json:"messagesPerSecond,omitempty"`
+
+	// Total is the maximum number of messages a campaign can send to a
+	// single endpoint.
+	// +optional
+	Total *int64 `json:"total,omitempty"`
+}
+
+// GCMChannel configures Firebase Cloud Messaging for an app.
+type GCMChannel struct {
+	// Enabled enables the channel.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// APIKeySecretRef references the key of a secret that holds the server
+	// key of the Firebase project. It is only sent to AWS when the channel is
+	// created or its other settings change.
+	APIKeySecretRef runtimev1alpha1.SecretKeySelector `json:"apiKeySecretRef"`
+}
+
+// APNSChannel configures the Apple Push Notification service for an app.
+// Either a certificate and private key or a token key must be supplied.
+type APNSChannel struct {
+	// Enabled enables the channel.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// DefaultAuthenticationMethod is used when both a certificate and a token
+	// key are supplied.
+	// +kubebuilder:validation:Enum=CERTIFICATE;TOKEN
+	// +optional
+	DefaultAuthenticationMethod *string `json:"defaultAuthenticationMethod,omitempty"`
+
+	// CertificateSecretRef references the key of a secret that holds the
+	// PEM encoded APNs certificate.
+	// +optional
+	CertificateSecretRef *runtimev1alpha1.SecretKeySelector `json:"certificateSecretRef,omitempty"`
+
+	// PrivateKeySecretRef references the key of a secret that holds the
+	// PEM encoded private key of the APNs certificate.
+	// +optional
+	PrivateKeySecretRef *runtimev1alpha1.SecretKeySelector `json:"privateKeySecretRef,omitempty"`
+
+	// TokenKeySecretRef references the key of a secret that holds the
+	// authentication key used for token authentication.
+	// +optional
+	TokenKeySecretRef *runtimev1alpha1.SecretKeySelector `json:"tokenKeySecretRef,omitempty"`
+
+	// TokenKeyID is the ID of the token key.
+	// +optional
+	TokenKeyID *string `json:"tokenKeyId,omitempty"`
+
+	// BundleID is the bundle identifier of the iOS app.
+	// +optional
+	BundleID *string `json:"bundleId,omitempty"`
+
+	// TeamID is the ID of the Apple developer team.
+	// +optional
+	TeamID *string `json:"teamId,omitempty"`
+}
+
+// AppParameters define the desired state of an Amazon Pinpoint app.
+type AppParameters struct {
+	// Name of the app.
+	// +immutable
+	Name string `json:"name"`
+
+	// QuietTime is the default quiet time of the campaigns of the app.
+	// +optional
+	QuietTime *QuietTime `json:"quietTime,omitempty"`
+
+	// Limits are the default sending limits of the campaigns of the app.
+	// +optional
+	Limits *CampaignLimits `json:"limits,omitempty"`
+
+	// GCMChannel configures push notifications to Android devices through
+	// Firebase Cloud Messaging.
+	// +optional
+	GCMChannel *GCMChannel `json:"gcmChannel,omitempty"`
+
+	// APNSChannel configures push notifications to Apple devices.
+	// +optional
+	APNSChannel *APNSChannel `json:"apnsChannel,omitempty"`
+
+	// Tags to assign to the app when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An AppSpec defines the desired state of an App.
+type AppSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AppParameters `json:"forProvider"`
+}
+
+// ChannelObservation is the observed state of a channel of an app.
+type ChannelObservation struct {
+	// Enabled is true if the channel is enabled.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// HasCredential is true if the channel has a credential.
+	HasCredential bool `json:"hasCredential,omitempty"`
+
+	// LastModifiedDate is when the channel was last modified.
+	LastModifiedDate string `json:"lastModifiedDate,omitempty"`
+}
+
+// AppObservation keeps the state for the external resource
+type AppObservation struct {
+	// ARN of the app.
+	ARN string `json:"arn,omitempty"`
+
+	// GCMChannel is the observed state of the GCM channel of the app.
+	GCMChannel *ChannelObservation `json:"gcmChannel,omitempty"`
+
+	// APNSChannel is the observed state of the APNs channel of the app.
+	APNSChannel *ChannelObservation `json:"apnsChannel,omitempty"`
+}
+
+// An AppStatus represents the observed state of an App.
+type AppStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AppObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An App is a managed resource that represents an Amazon Pinpoint app, which
+// holds the push notification channels of a mobile application. The
+// external name of the resource is the ID of the app.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type App struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppSpec   `json:"spec"`
+	Status AppStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppList contains a list of Apps
+type AppList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []App `json:"items"`
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// KinesisConfiguration is the Kinesis data stream the journal is streamed
+// to.
+type KinesisConfiguration struct {
+	// StreamARN is the ARN of the Kinesis data stream.
+	StreamARN string `json:"streamArn"`
+
+	// AggregationEnabled aggregates multiple journal records into a single
+	// Kinesis record. It is enabled by AWS when it is omitted.
+	// +optional
+	AggregationEnabled *bool `json:"aggregationEnabled,omitempty"`
+}
+
+// JournalKinesisStreamParameters define the desired state of an Amazon QLDB
+// journal stream.
+type JournalKinesisStreamParameters struct {
+	// LedgerName is the name of the ledger whose journal is streamed.
+	// +immutable
+	// +optional
+	LedgerName *string `json:"ledgerName,omitempty"`
+
+	// LedgerNameRef references a Ledger to retrieve its name.
+	// +optional
+	LedgerNameRef *runtimev1alpha1.Reference `json:"ledgerNameRef,omitempty"`
+
+	// LedgerNameSelector selects a reference to a Ledger to retrieve its
+	// name.
+	// +optional
+	LedgerNameSelector *runtimev1alpha1.Selector `json:"ledgerNameSelector,omitempty"`
+
+	// StreamName is a name for the journal stream. It does not need to be
+	// unique.
+	// +immutable
+	StreamName string `json:"streamName"`
+
+	// RoleARN is the ARN of an IAM role that allows QLDB to write to the
+	// Kinesis data stream.
+	// +immutable
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// KinesisConfiguration is the Kinesis data stream the journal is
+	// streamed to.
+	// +immutable
+	KinesisConfiguration KinesisConfiguration `json:"kinesisConfiguration"`
+
+	// InclusiveStartTime is the time from which journal blocks are
+	// streamed. It must be in the past.
+	// +immutable
+	InclusiveStartTime metav1.Time `json:"inclusiveStartTime"`
+
+	// ExclusiveEndTime is the time until which journal blocks are streamed.
+	// The stream runs until it is deleted when it is omitted.
+	// +immutable
+	// +optional
+	ExclusiveEndTime *metav1.Time `json:"exclusiveEndTime,omitempty"`
+
+	// Tags to assign to the journal stream when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A JournalKinesisStreamSpec defines the desired state of a
+// JournalKinesisStream.
+type JournalKinesisStreamSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  JournalKinesisStreamParameters `json:"forProvider"`
+}
+
+// JournalKinesisStreamObservation keeps the state for the external resource
+type JournalKinesisStreamObservation struct {
+	// The ARN of the journal stream.
+	ARN string `json:"arn,omitempty"`
+
+	// Status of the journal stream.
+	Status string `json:"status,omitempty"`
+
+	// ErrorCause is the reason an impaired stream failed.
+	ErrorCause string `json:"errorCause,omitempty"`
+}
+
+// A JournalKinesisStreamStatus represents the observed state of a
+// JournalKinesisStream.
+type JournalKinesisStreamStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     JournalKinesisStreamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A JournalKinesisStream is a managed resource that represents an Amazon
+// QLDB journal stream to Kinesis Data Streams. The external name of the
+// resource is the stream ID assigned by QLDB. Deleting a JournalKinesisStream
+// cancels the stream.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type JournalKinesisStream struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JournalKinesisStreamSpec   `json:"spec"`
+	Status JournalKinesisStreamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JournalKinesisStreamList contains a list of JournalKinesisStreams
+type JournalKinesisStreamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JournalKinesisStream `json:"items"`
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LedgerParameters define the desired state of an Amazon QLDB ledger.
+type LedgerParameters struct {
+	// PermissionsMode of the ledger. ALLOW_ALL is the only supported mode,
+	// which allows IAM policies to control access to the ledger API.
+	// +kubebuilder:validation:Enum=ALLOW_ALL
+	// +immutable
+	PermissionsMode string `json:"permissionsMode"`
+
+	// DeletionProtection prevents the ledger from being deleted. It must be
+	// disabled before the ledger can be deleted. Deletion protection is
+	// enabled by AWS when it is omitted.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// Tags to assign to the ledger when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A LedgerSpec defines the desired state of a Ledger.
+type LedgerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LedgerParameters `json:"forProvider"`
+}
+
+// LedgerObservation keeps the state for the external resource
+type LedgerObservation struct {
+	// The ARN of the ledger.
+	ARN string `json:"arn,omitempty"`
+
+	// State of the ledger.
+	State string `json:"state,omitempty"`
+
+	// CreationDateTime is the time the ledger was created.
+	CreationDateTime *metav1.Time `json:"creationDateTime,omitempty"`
+}
+
+// A LedgerStatus represents the observed state of a Ledger.
+type LedgerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LedgerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Ledger is a managed resource that represents an Amazon QLDB ledger. The
+// external name of the resource is the ledger name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Ledger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LedgerSpec   `json:"spec"`
+	Status LedgerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LedgerList contains a list of Ledgers
+type LedgerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Ledger `json:"items"`
+}
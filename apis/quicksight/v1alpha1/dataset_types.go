@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// InputColumn is a column of a physical table.
+type InputColumn struct {
+	// Name of the column.
+	Name string `json:"name"`
+
+	// Type of the column.
+	// +kubebuilder:validation:Enum=STRING;INTEGER;DECIMAL;DATETIME;BIT;BOOLEAN;JSON
+	Type string `json:"type"`
+}
+
+// RelationalTable is a table read from a relational data source.
+type RelationalTable struct {
+	// DataSourceARN is the ARN of the data source the table is read from.
+	// +optional
+	DataSourceARN *string `json:"dataSourceArn,omitempty"`
+
+	// DataSourceARNRef references a DataSource to retrieve its ARN.
+	// +optional
+	DataSourceARNRef *runtimev1alpha1.Reference `json:"dataSourceArnRef,omitempty"`
+
+	// DataSourceARNSelector selects a reference to a DataSource to retrieve
+	// its ARN.
+	// +optional
+	DataSourceARNSelector *runtimev1alpha1.Selector `json:"dataSourceArnSelector,omitempty"`
+
+	// Name of the table.
+	Name string `json:"name"`
+
+	// Schema of the table.
+	// +optional
+	Schema *string `json:"schema,omitempty"`
+
+	// InputColumns of the table.
+	InputColumns []InputColumn `json:"inputColumns"`
+}
+
+// CustomSQL is a table defined by a SQL query against a data source.
+type CustomSQL struct {
+	// DataSourceARN is the ARN of the data source the query runs against.
+	// +optional
+	DataSourceARN *string `json:"dataSourceArn,omitempty"`
+
+	// DataSourceARNRef references a DataSource to retrieve its ARN.
+	// +optional
+	DataSourceARNRef *runtimev1alpha1.Reference `json:"dataSourceArnRef,omitempty"`
+
+	// DataSourceARNSelector selects a reference to a DataSource to retrieve
+	// its ARN.
+	// +optional
+	DataSourceARNSelector *runtimev1alpha1.Selector `json:"dataSourceArnSelector,omitempty"`
+
+	// Name to display for the query.
+	Name string `json:"name"`
+
+	// SQLQuery is the SQL statement.
+	SQLQuery string `json:"sqlQuery"`
+
+	// Columns returned by the query.
+	// +optional
+	Columns []InputColumn `json:"columns,omitempty"`
+}
+
+// PhysicalTable is a source table of a data set. Exactly one of
+// RelationalTable or CustomSQL must be set.
+type PhysicalTable struct {
+	// ID of the table, unique within the data set.
+	ID string `json:"id"`
+
+	// RelationalTable reads a table from a relational data source.
+	// +optional
+	RelationalTable *RelationalTable `json:"relationalTable,omitempty"`
+
+	// CustomSQL runs a SQL query against a data source.
+	// +optional
+	CustomSQL *CustomSQL `json:"customSql,omitempty"`
+}
+
+// DataSetParameters define the desired state of an AWS QuickSight data set.
+type DataSetParameters struct {
+	// AccountID is the ID of the AWS account that owns the data set.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Name is the display name of the data set.
+	Name string `json:"name"`
+
+	// ImportMode indicates whether the data is imported into SPICE or
+	// queried directly.
+	// +kubebuilder:validation:Enum=SPICE;DIRECT_QUERY
+	ImportMode string `json:"importMode"`
+
+	// PhysicalTables are the source tables of the data set.
+	PhysicalTables []PhysicalTable `json:"physicalTables"`
+
+	// Permissions granted on the data set when it is created.
+	// +immutable
+	// +optional
+	Permissions []ResourcePermission `json:"permissions,omitempty"`
+
+	// Tags to assign to the data set when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DataSetSpec defines the desired state of a DataSet.
+type DataSetSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DataSetParameters `json:"forProvider"`
+}
+
+// DataSetObservation keeps the state for the external resource
+type DataSetObservation struct {
+	// ARN of the data set.
+	ARN string `json:"arn,omitempty"`
+
+	// ConsumedSPICECapacityInBytes is the SPICE capacity used by the data
+	// set.
+	ConsumedSPICECapacityInBytes int64 `json:"consumedSpiceCapacityInBytes,omitempty"`
+}
+
+// A DataSetStatus represents the observed state of a DataSet.
+type DataSetStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DataSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DataSet is a managed resource that represents an AWS QuickSight data set.
+// The external name of the resource is the data set ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IMPORT-MODE",type="string",JSONPath=".spec.forProvider.importMode"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DataSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataSetSpec   `json:"spec"`
+	Status DataSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataSetList contains a list of DataSets
+type DataSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataSet `json:"items"`
+}
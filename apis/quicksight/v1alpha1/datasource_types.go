@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AthenaParameters are the parameters of an Athena data source.
+type AthenaParameters struct {
+	// WorkGroup is the Athena workgroup used to run queries. The primary
+	// workgroup is used if this is not set.
+	// +optional
+	WorkGroup *string `json:"workGroup,omitempty"`
+}
+
+// RDSParameters are the parameters of an RDS data source.
+type RDSParameters struct {
+	// Database is the name of the database to connect to.
+	Database string `json:"database"`
+
+	// InstanceID is the identifier of the RDS instance.
+	// +optional
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef references an RDSInstance to retrieve its identifier.
+	// +optional
+	InstanceIDRef *runtimev1alpha1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects a reference to an RDSInstance to retrieve
+	// its identifier.
+	// +optional
+	InstanceIDSelector *runtimev1alpha1.Selector `json:"instanceIdSelector,omitempty"`
+}
+
+// ResourcePermission grants a QuickSight principal access to a resource.
+type ResourcePermission struct {
+	// Principal is the ARN of a QuickSight user or group.
+	Principal string `json:"principal"`
+
+	// Actions granted to the principal.
+	Actions []string `json:"actions"`
+}
+
+// Tag is a key-value pair attached to a QuickSight resource.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// DataSourceParameters define the desired state of an AWS QuickSight data
+// source.
+type DataSourceParameters struct {
+	// AccountID is the ID of the AWS account that owns the data source.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Name is the display name of the data source.
+	Name string `json:"name"`
+
+	// Type of the data source. Data sources backed by an RDS instance use the
+	// type of the instance's engine together with RDSParameters.
+	// +immutable
+	// +kubebuilder:validation:Enum=ATHENA;AURORA;AURORA_POSTGRESQL;MARIADB;MYSQL;POSTGRESQL;SQLSERVER
+	Type string `json:"type"`
+
+	// AthenaParameters configure an Athena data source.
+	// +optional
+	AthenaParameters *AthenaParameters `json:"athenaParameters,omitempty"`
+
+	// RDSParameters configure a data source backed by an RDS instance.
+	// +optional
+	RDSParameters *RDSParameters `json:"rdsParameters,omitempty"`
+
+	// CredentialsSecretRef references a secret that holds the username and
+	// password QuickSight uses to connect to the data source, such as the
+	// connection secret of an RDSInstance. The credentials are sent whenever
+	// the data source is created or updated.
+	// +optional
+	CredentialsSecretRef *runtimev1alpha1.SecretReference `json:"credentialsSecretRef,omitempty"`
+
+	// DisableSSL disables SSL when connecting to the data source.
+	// +optional
+	DisableSSL *bool `json:"disableSsl,omitempty"`
+
+	// VPCConnectionARN is the ARN of the QuickSight VPC connection used to
+	// reach the data source.
+	// +optional
+	VPCConnectionARN *string `json:"vpcConnectionArn,omitempty"`
+
+	// Permissions granted on the data source when it is created.
+	// +immutable
+	// +optional
+	Permissions []ResourcePermission `json:"permissions,omitempty"`
+
+	// Tags to assign to the data source when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DataSourceSpec defines the desired state of a DataSource.
+type DataSourceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DataSourceParameters `json:"forProvider"`
+}
+
+// DataSourceObservation keeps the state for the external resource
+type DataSourceObservation struct {
+	// ARN of the data source.
+	ARN string `json:"arn,omitempty"`
+
+	// Status of the data source.
+	Status string `json:"status,omitempty"`
+
+	// ErrorMessage describes why QuickSight cannot connect to the data
+	// source, if it cannot.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// A DataSourceStatus represents the observed state of a DataSource.
+type DataSourceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DataSourceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DataSource is a managed resource that represents an AWS QuickSight data
+// source. The external name of the resource is the data source ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DataSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataSourceSpec   `json:"spec"`
+	Status DataSourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataSourceList contains a list of DataSources
+type DataSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataSource `json:"items"`
+}
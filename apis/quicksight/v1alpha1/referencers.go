@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+// DataSourceARN returns the status.atProvider.ARN of a DataSource.
+func DataSourceARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*DataSource)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
+// ResolveReferences of this DataSource
+func (mg *DataSource) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.RDSParameters == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.rdsParameters.instanceId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RDSParameters.InstanceID),
+		Reference:    mg.Spec.ForProvider.RDSParameters.InstanceIDRef,
+		Selector:     mg.Spec.ForProvider.RDSParameters.InstanceIDSelector,
+		To:           reference.To{Managed: &databasev1beta1.RDSInstance{}, List: &databasev1beta1.RDSInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.RDSParameters.InstanceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RDSParameters.InstanceIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this DataSet
+func (mg *DataSet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.PhysicalTables {
+		t := &mg.Spec.ForProvider.PhysicalTables[i]
+
+		// Resolve spec.forProvider.physicalTables[].relationalTable.dataSourceArn
+		if t.RelationalTable != nil {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(t.RelationalTable.DataSourceARN),
+				Reference:    t.RelationalTable.DataSourceARNRef,
+				Selector:     t.RelationalTable.DataSourceARNSelector,
+				To:           reference.To{Managed: &DataSource{}, List: &DataSourceList{}},
+				Extract:      DataSourceARN(),
+			})
+			if err != nil {
+				return err
+			}
+			t.RelationalTable.DataSourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+			t.RelationalTable.DataSourceARNRef = rsp.ResolvedReference
+		}
+
+		// Resolve spec.forProvider.physicalTables[].customSql.dataSourceArn
+		if t.CustomSQL != nil {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(t.CustomSQL.DataSourceARN),
+				Reference:    t.CustomSQL.DataSourceARNRef,
+				Selector:     t.CustomSQL.DataSourceARNSelector,
+				To:           reference.To{Managed: &DataSource{}, List: &DataSourceList{}},
+				Extract:      DataSourceARN(),
+			})
+			if err != nil {
+				return err
+			}
+			t.CustomSQL.DataSourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+			t.CustomSQL.DataSourceARNRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
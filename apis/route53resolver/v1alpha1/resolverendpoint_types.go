@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// IPAddress is an IP address of a resolver endpoint in one of the subnets of
+// the VPC.
+type IPAddress struct {
+	// SubnetID is the ID of the subnet of the IP address.
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDRef *runtimev1alpha1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *runtimev1alpha1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// IP is the IPv4 address to use. An available address of the subnet is
+	// chosen if it is omitted.
+	// +optional
+	IP *string `json:"ip,omitempty"`
+}
+
+// ResolverEndpointParameters define the desired state of an Amazon Route 53
+// Resolver endpoint.
+type ResolverEndpointParameters struct {
+	// Name of the resolver endpoint.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Direction of the DNS queries of the endpoint. INBOUND endpoints
+	// receive queries from the network to the VPC, OUTBOUND endpoints forward
+	// queries from the VPC to the network.
+	// +immutable
+	// +kubebuilder:validation:Enum=INBOUND;OUTBOUND
+	Direction string `json:"direction"`
+
+	// IPAddresses of the endpoint. At least two addresses, preferably in
+	// different availability zones, are required.
+	// +kubebuilder:validation:MinItems=2
+	IPAddresses []IPAddress `json:"ipAddresses"`
+
+	// SecurityGroupIDs are the IDs of the security groups that control the
+	// traffic of the endpoint.
+	// +immutable
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references SecurityGroups to retrieve their IDs.
+	// +optional
+	SecurityGroupIDRefs []runtimev1alpha1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups to
+	// retrieve their IDs.
+	// +optional
+	SecurityGroupIDSelector *runtimev1alpha1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags to assign to the endpoint when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ResolverEndpointSpec defines the desired state of a ResolverEndpoint.
+type ResolverEndpointSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResolverEndpointParameters `json:"forProvider"`
+}
+
+// IPAddressObservation is the observed state of an IP address of a resolver
+// endpoint.
+type IPAddressObservation struct {
+	// IPID is the ID of the IP address.
+	IPID string `json:"ipId,omitempty"`
+
+	// IP is the IPv4 address.
+	IP string `json:"ip,omitempty"`
+
+	// SubnetID is the ID of the subnet of the IP address.
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// Status of the IP address.
+	Status string `json:"status,omitempty"`
+}
+
+// ResolverEndpointObservation keeps the state for the external resource
+type ResolverEndpointObservation struct {
+	// ARN of the resolver endpoint.
+	ARN string `json:"arn,omitempty"`
+
+	// HostVPCID is the ID of the VPC of the endpoint.
+	HostVPCID string `json:"hostVpcId,omitempty"`
+
+	// Status of the resolver endpoint.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage is the detailed description of the status.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// IPAddresses of the resolver endpoint.
+	IPAddresses []IPAddressObservation `json:"ipAddresses,omitempty"`
+}
+
+// A ResolverEndpointStatus represents the observed state of a
+// ResolverEndpoint.
+type ResolverEndpointStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResolverEndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResolverEndpoint is a managed resource that represents an Amazon Route 53
+// Resolver endpoint, which lets DNS queries flow between a VPC and an
+// on-premises network. The external name of the resource is the ID of the
+// endpoint.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DIRECTION",type="string",JSONPath=".spec.forProvider.direction"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".status.atProvider.hostVpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ResolverEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResolverEndpointSpec   `json:"spec"`
+	Status ResolverEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResolverEndpointList contains a list of ResolverEndpoints
+type ResolverEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResolverEndpoint `json:"items"`
+}
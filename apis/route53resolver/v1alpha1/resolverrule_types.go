@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TargetAddress is a DNS resolver that queries are forwarded to.
+type TargetAddress struct {
+	// IP is the IPv4 address of the DNS resolver.
+	IP string `json:"ip"`
+
+	// Port of the DNS resolver. Defaults to 53.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+}
+
+// ResolverRuleParameters define the desired state of an Amazon Route 53
+// Resolver rule.
+type ResolverRuleParameters struct {
+	// Name of the resolver rule.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// DomainName is the domain whose DNS queries are handled by the rule,
+	// e.g. corp.example.com.
+	// +immutable
+	DomainName string `json:"domainName"`
+
+	// RuleType of the rule. FORWARD rules send the queries to the target
+	// IPs, SYSTEM rules override a FORWARD rule of a parent domain.
+	// +immutable
+	// +kubebuilder:validation:Enum=FORWARD;SYSTEM;RECURSIVE
+	RuleType string `json:"ruleType"`
+
+	// ResolverEndpointID is the ID of the outbound resolver endpoint that
+	// forwards the queries. Required for FORWARD rules.
+	// +optional
+	ResolverEndpointID *string `json:"resolverEndpointId,omitempty"`
+
+	// ResolverEndpointIDRef references a ResolverEndpoint to retrieve its ID.
+	// +optional
+	ResolverEndpointIDRef *runtimev1alpha1.Reference `json:"resolverEndpointIdRef,omitempty"`
+
+	// ResolverEndpointIDSelector selects a reference to a ResolverEndpoint to
+	// retrieve its ID.
+	// +optional
+	ResolverEndpointIDSelector *runtimev1alpha1.Selector `json:"resolverEndpointIdSelector,omitempty"`
+
+	// TargetIPs are the DNS resolvers, typically on-premises, that the
+	// queries are forwarded to. Required for FORWARD rules.
+	// +optional
+	TargetIPs []TargetAddress `json:"targetIps,omitempty"`
+
+	// Tags to assign to the rule when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ResolverRuleSpec defines the desired state of a ResolverRule.
+type ResolverRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResolverRuleParameters `json:"forProvider"`
+}
+
+// ResolverRuleObservation keeps the state for the external resource
+type ResolverRuleObservation struct {
+	// ARN of the resolver rule.
+	ARN string `json:"arn,omitempty"`
+
+	// OwnerID is the ID of the account that created the rule.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// ShareStatus tells whether the rule is shared with other accounts.
+	ShareStatus string `json:"shareStatus,omitempty"`
+
+	// Status of the resolver rule.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage is the detailed description of the status.
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// A ResolverRuleStatus represents the observed state of a ResolverRule.
+type ResolverRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResolverRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResolverRule is a managed resource that represents an Amazon Route 53
+// Resolver rule, which forwards the DNS queries of a domain to resolvers
+// outside of the VPC. The external name of the resource is the ID of the
+// rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.domainName"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.ruleType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ResolverRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResolverRuleSpec   `json:"spec"`
+	Status ResolverRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResolverRuleList contains a list of ResolverRules
+type ResolverRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResolverRule `json:"items"`
+}
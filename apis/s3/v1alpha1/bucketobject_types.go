@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// A ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key of the ConfigMap data or binary data to select.
+	Key string `json:"key"`
+}
+
+// ContentSource is the source of the content of an object.
+type ContentSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a Secret.
+	// +optional
+	SecretKeyRef *runtimev1alpha1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// BucketObjectParameters define the desired state of an Amazon S3 object.
+type BucketObjectParameters struct {
+	// Bucket is the name of the bucket of the object.
+	// +immutable
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references an S3Bucket to retrieve its name.
+	// +optional
+	BucketRef *runtimev1alpha1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to an S3Bucket to retrieve its
+	// name.
+	// +optional
+	BucketSelector *runtimev1alpha1.Selector `json:"bucketSelector,omitempty"`
+
+	// Key of the object in the bucket, e.g. lambda/handler.zip.
+	// +immutable
+	Key string `json:"key"`
+
+	// Content is the inline content of the object. Either Content or
+	// ContentFrom must be set.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentFrom selects the content of the object from a ConfigMap or a
+	// Secret. Binary content, e.g. a zip archive, can be stored in the binary
+	// data of a ConfigMap or in a Secret.
+	// +optional
+	ContentFrom *ContentSource `json:"contentFrom,omitempty"`
+
+	// ContentType is the MIME type of the object.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// ACL is the canned ACL applied to the object when it is uploaded.
+	// +kubebuilder:validation:Enum=private;public-read;public-read-write;authenticated-read;aws-exec-read;bucket-owner-read;bucket-owner-full-control
+	// +optional
+	ACL *string `json:"acl,omitempty"`
+
+	// ServerSideEncryption is the algorithm used to encrypt the object.
+	// +kubebuilder:validation:Enum=AES256;"aws:kms"
+	// +optional
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// SSEKMSKeyID is the ID of the KMS key used to encrypt the object if the
+	// server side encryption is aws:kms.
+	// +optional
+	SSEKMSKeyID *string `json:"sseKmsKeyId,omitempty"`
+
+	// Metadata is the user defined metadata of the object.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// A BucketObjectSpec defines the desired state of a BucketObject.
+type BucketObjectSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  BucketObjectParameters `json:"forProvider"`
+}
+
+// BucketObjectObservation keeps the state for the external resource
+type BucketObjectObservation struct {
+	// ETag of the object.
+	ETag string `json:"etag,omitempty"`
+
+	// ContentMD5 is the hex encoded MD5 digest of the content that was last
+	// uploaded by the controller. It is used to detect drift of objects
+	// whose ETag is not the MD5 digest of their content, e.g. objects
+	// encrypted with a KMS key.
+	ContentMD5 string `json:"contentMd5,omitempty"`
+
+	// VersionID of the object if versioning is enabled on the bucket.
+	VersionID string `json:"versionId,omitempty"`
+
+	// ContentLength is the size of the object in bytes.
+	ContentLength int64 `json:"contentLength,omitempty"`
+}
+
+// A BucketObjectStatus represents the observed state of a BucketObject.
+type BucketObjectStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     BucketObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BucketObject is a managed resource that represents an Amazon S3 object.
+// It uploads inline content or content of a ConfigMap or Secret to a bucket,
+// e.g. to seed Lambda deployment packages or bootstrap files, and uploads it
+// again when the ETag of the object no longer matches the content.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type BucketObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketObjectSpec   `json:"spec"`
+	Status BucketObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketObjectList contains a list of BucketObjects
+type BucketObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketObject `json:"items"`
+}
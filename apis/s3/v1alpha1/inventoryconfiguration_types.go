@@ -0,0 +1,165 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// InventoryEncryption specifies how the inventory reports are encrypted.
+type InventoryEncryption struct {
+	// Type of the server side encryption of the inventory reports.
+	// +kubebuilder:validation:Enum=SSE-S3;SSE-KMS
+	Type string `json:"type"`
+
+	// KMSKeyID is the ID of the KMS key used to encrypt the inventory reports
+	// if the type is SSE-KMS.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+}
+
+// InventoryDestination is the bucket the inventory reports are published to.
+type InventoryDestination struct {
+	// Bucket is the name of the bucket the inventory reports are published
+	// to.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references an S3Bucket to retrieve its name.
+	// +optional
+	BucketRef *runtimev1alpha1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to an S3Bucket to retrieve its
+	// name.
+	// +optional
+	BucketSelector *runtimev1alpha1.Selector `json:"bucketSelector,omitempty"`
+
+	// AccountID is the ID of the account that owns the destination bucket.
+	// The owner of the bucket is not validated if it is omitted.
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Format of the inventory reports.
+	// +kubebuilder:validation:Enum=CSV;ORC;Parquet
+	Format string `json:"format"`
+
+	// Prefix that is prepended to the keys of the inventory reports.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Encryption of the inventory reports.
+	// +optional
+	Encryption *InventoryEncryption `json:"encryption,omitempty"`
+}
+
+// InventorySchedule specifies how often inventory reports are produced.
+type InventorySchedule struct {
+	// Frequency of the inventory reports.
+	// +kubebuilder:validation:Enum=Daily;Weekly
+	Frequency string `json:"frequency"`
+}
+
+// InventoryConfigurationParameters define the desired state of an Amazon S3
+// inventory configuration.
+type InventoryConfigurationParameters struct {
+	// Bucket is the name of the bucket whose objects are listed.
+	// +immutable
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references an S3Bucket to retrieve its name.
+	// +optional
+	BucketRef *runtimev1alpha1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to an S3Bucket to retrieve its
+	// name.
+	// +optional
+	BucketSelector *runtimev1alpha1.Selector `json:"bucketSelector,omitempty"`
+
+	// Destination of the inventory reports.
+	Destination InventoryDestination `json:"destination"`
+
+	// Prefix limits the inventory to the objects whose keys start with it.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// IncludedObjectVersions specifies whether all versions of the objects
+	// or only the current versions are listed.
+	// +kubebuilder:validation:Enum=All;Current
+	IncludedObjectVersions string `json:"includedObjectVersions"`
+
+	// IsEnabled specifies whether inventory reports are produced.
+	IsEnabled bool `json:"isEnabled"`
+
+	// OptionalFields are the metadata fields listed in addition to the key
+	// of the objects, e.g. Size or StorageClass.
+	// +optional
+	OptionalFields []string `json:"optionalFields,omitempty"`
+
+	// Schedule of the inventory reports.
+	Schedule InventorySchedule `json:"schedule"`
+}
+
+// An InventoryConfigurationSpec defines the desired state of an
+// InventoryConfiguration.
+type InventoryConfigurationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  InventoryConfigurationParameters `json:"forProvider"`
+}
+
+// InventoryConfigurationObservation keeps the state for the external resource
+type InventoryConfigurationObservation struct {
+}
+
+// An InventoryConfigurationStatus represents the observed state of an
+// InventoryConfiguration.
+type InventoryConfigurationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     InventoryConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InventoryConfiguration is a managed resource that represents an Amazon
+// S3 inventory configuration, which publishes daily or weekly reports that
+// list the objects of a bucket and their metadata to another bucket. The
+// external name of the resource is the ID of the configuration.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="FREQUENCY",type="string",JSONPath=".spec.forProvider.schedule.frequency"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type InventoryConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InventoryConfigurationSpec   `json:"spec"`
+	Status InventoryConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InventoryConfigurationList contains a list of InventoryConfigurations
+type InventoryConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InventoryConfiguration `json:"items"`
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PublicAccessBlockConfiguration restricts the public access of an access
+// point.
+type PublicAccessBlockConfiguration struct {
+	// BlockPublicACLs rejects requests that set public ACLs.
+	// +optional
+	BlockPublicACLs *bool `json:"blockPublicAcls,omitempty"`
+
+	// IgnorePublicACLs ignores the public ACLs of the objects.
+	// +optional
+	IgnorePublicACLs *bool `json:"ignorePublicAcls,omitempty"`
+
+	// BlockPublicPolicy rejects policies that grant public access.
+	// +optional
+	BlockPublicPolicy *bool `json:"blockPublicPolicy,omitempty"`
+
+	// RestrictPublicBuckets restricts access to principals of the account
+	// and AWS services if the policy grants public access.
+	// +optional
+	RestrictPublicBuckets *bool `json:"restrictPublicBuckets,omitempty"`
+}
+
+// AccessPointParameters define the desired state of an Amazon S3 access
+// point.
+type AccessPointParameters struct {
+	// AccountID is the ID of the AWS account that owns the access point.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Bucket is the name of the bucket of the access point.
+	// +immutable
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references an S3Bucket to retrieve its name.
+	// +optional
+	BucketRef *runtimev1alpha1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to an S3Bucket to retrieve its
+	// name.
+	// +optional
+	BucketSelector *runtimev1alpha1.Selector `json:"bucketSelector,omitempty"`
+
+	// VPCID restricts the access point to requests from the VPC. The access
+	// point is reachable from the internet if it is omitted.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its ID.
+	// +optional
+	VPCIDRef *runtimev1alpha1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its ID.
+	// +optional
+	VPCIDSelector *runtimev1alpha1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// PublicAccessBlockConfiguration restricts the public access of the
+	// access point.
+	// +immutable
+	// +optional
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// Policy is the JSON encoded resource policy of the access point. The
+	// policy is removed from the access point if it is omitted.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+}
+
+// An AccessPointSpec defines the desired state of an AccessPoint.
+type AccessPointSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AccessPointParameters `json:"forProvider"`
+}
+
+// AccessPointObservation keeps the state for the external resource
+type AccessPointObservation struct {
+	// NetworkOrigin is VPC if the access point only accepts requests from a
+	// VPC, Internet otherwise.
+	NetworkOrigin string `json:"networkOrigin,omitempty"`
+}
+
+// An AccessPointStatus represents the observed state of an AccessPoint.
+type AccessPointStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPoint is a managed resource that represents an Amazon S3 access
+// point, a named network endpoint with its own policy that grants access to
+// the objects of a bucket. The external name of the resource is the name of
+// the access point.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="ORIGIN",type="string",JSONPath=".status.atProvider.networkOrigin"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPointSpec   `json:"spec"`
+	Status AccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPointList contains a list of AccessPoints
+type AccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPoint `json:"items"`
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AccountPublicAccessBlockParameters define the desired state of the Amazon
+// S3 public access block of an AWS account.
+type AccountPublicAccessBlockParameters struct {
+	// AccountID is the ID of the AWS account the public access block applies
+	// to.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// PublicAccessBlockConfiguration restricts the public access of all
+	// buckets and access points of the account.
+	PublicAccessBlockConfiguration PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration"`
+}
+
+// An AccountPublicAccessBlockSpec defines the desired state of an
+// AccountPublicAccessBlock.
+type AccountPublicAccessBlockSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AccountPublicAccessBlockParameters `json:"forProvider"`
+}
+
+// An AccountPublicAccessBlockStatus represents the observed state of an
+// AccountPublicAccessBlock.
+type AccountPublicAccessBlockStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountPublicAccessBlock is a managed resource that represents the
+// Amazon S3 public access block of an AWS account. There is a single public
+// access block per account, so the external name of the resource is not
+// used. Deleting an AccountPublicAccessBlock removes the public access block
+// from the account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".spec.forProvider.accountId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountPublicAccessBlock struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountPublicAccessBlockSpec   `json:"spec"`
+	Status AccountPublicAccessBlockStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountPublicAccessBlockList contains a list of AccountPublicAccessBlocks
+type AccountPublicAccessBlockList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountPublicAccessBlock `json:"items"`
+}
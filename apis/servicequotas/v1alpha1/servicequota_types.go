@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ServiceQuotaParameters define the desired value of an AWS service quota.
+type ServiceQuotaParameters struct {
+	// ServiceCode identifies the service of the quota, for example ec2.
+	// +immutable
+	ServiceCode string `json:"serviceCode"`
+
+	// QuotaCode identifies the quota within the service, for example
+	// L-1216C47A.
+	// +immutable
+	QuotaCode string `json:"quotaCode"`
+
+	// NOTE: The quota values are float64 in the AWS SDK but float is not
+	// supported by controller-runtime.
+	// See https://github.com/kubernetes-sigs/controller-tools/issues/245
+
+	// DesiredValue is the value the quota should be increased to. A new
+	// increase request is filed when it is raised above both the applied
+	// value and the value of the last request, and that request is closed.
+	// +kubebuilder:validation:Minimum=0
+	DesiredValue int64 `json:"desiredValue"`
+}
+
+// A ServiceQuotaSpec defines the desired state of a ServiceQuota.
+type ServiceQuotaSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ServiceQuotaParameters `json:"forProvider"`
+}
+
+// ServiceQuotaObservation keeps the state for the external resource
+type ServiceQuotaObservation struct {
+	// QuotaARN is the ARN of the quota.
+	QuotaARN string `json:"quotaArn,omitempty"`
+
+	// QuotaName is the name of the quota.
+	QuotaName string `json:"quotaName,omitempty"`
+
+	// Value is the value of the quota that currently applies.
+	Value int64 `json:"value,omitempty"`
+
+	// RequestedValue is the value of the last increase request.
+	RequestedValue int64 `json:"requestedValue,omitempty"`
+
+	// RequestStatus is the status of the last increase request, one of
+	// PENDING, CASE_OPENED, APPROVED, DENIED or CASE_CLOSED.
+	RequestStatus string `json:"requestStatus,omitempty"`
+
+	// CaseID is the ID of the support case opened for the last increase
+	// request.
+	CaseID string `json:"caseId,omitempty"`
+}
+
+// A ServiceQuotaStatus represents the observed state of a ServiceQuota.
+type ServiceQuotaStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ServiceQuotaObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceQuota is a managed resource that requests increases of an AWS
+// service quota. The external name of the resource is the ID of the last
+// increase request. Increase requests cannot be withdrawn, so deleting a
+// ServiceQuota leaves the quota as it is.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.serviceCode"
+// +kubebuilder:printcolumn:name="QUOTA",type="string",JSONPath=".spec.forProvider.quotaCode"
+// +kubebuilder:printcolumn:name="VALUE",type="integer",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="REQUEST",type="string",JSONPath=".status.atProvider.requestStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ServiceQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceQuotaSpec   `json:"spec"`
+	Status ServiceQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceQuotaList contains a list of ServiceQuotas
+type ServiceQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceQuota `json:"items"`
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// KeyRange limits the objects of a bucket that are transferred.
+type KeyRange struct {
+	// BeginMarker is the key the range starts at, inclusive.
+	// +optional
+	BeginMarker *string `json:"beginMarker,omitempty"`
+
+	// EndMarker is the key the range ends at, inclusive.
+	// +optional
+	EndMarker *string `json:"endMarker,omitempty"`
+}
+
+// S3Resource is an S3 bucket data is imported into or exported from.
+type S3Resource struct {
+	// Bucket is the name of the S3 bucket.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references an S3Bucket to retrieve its name.
+	// +optional
+	BucketRef *runtimev1alpha1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to an S3Bucket to retrieve its
+	// name.
+	// +optional
+	BucketSelector *runtimev1alpha1.Selector `json:"bucketSelector,omitempty"`
+
+	// KeyRange limits the objects that are transferred. All objects are
+	// transferred when it is not set.
+	// +optional
+	KeyRange *KeyRange `json:"keyRange,omitempty"`
+}
+
+// Notification configures the SNS notifications sent for a job.
+type Notification struct {
+	// SNSTopicARN is the ARN of the SNS topic notifications are published
+	// to.
+	// +optional
+	SNSTopicARN *string `json:"snsTopicArn,omitempty"`
+
+	// JobStatesToNotify are the job states that trigger a notification.
+	// +optional
+	JobStatesToNotify []string `json:"jobStatesToNotify,omitempty"`
+
+	// NotifyAll sends a notification for every job state change.
+	// +optional
+	NotifyAll *bool `json:"notifyAll,omitempty"`
+}
+
+// SnowballJobParameters define the desired state of an AWS Snowball job.
+// Snowball only accepts updates to a job before the device is prepared.
+type SnowballJobParameters struct {
+	// JobType is the type of the job.
+	// +immutable
+	// +kubebuilder:validation:Enum=IMPORT;EXPORT;LOCAL_USE
+	JobType string `json:"jobType"`
+
+	// AddressID is the ID of the address the device is shipped to.
+	AddressID string `json:"addressId"`
+
+	// ForwardingAddressID is the ID of the address the device is forwarded
+	// to after it is returned.
+	// +optional
+	ForwardingAddressID *string `json:"forwardingAddressId,omitempty"`
+
+	// Description of the job.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// S3Resources are the S3 buckets the job transfers data to or from.
+	// +optional
+	S3Resources []S3Resource `json:"s3Resources,omitempty"`
+
+	// RoleARN is the ARN of the IAM role Snowball assumes to access the
+	// buckets.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// KMSKeyARN is the ARN of the KMS key the data on the device is
+	// encrypted with.
+	// +immutable
+	// +optional
+	KMSKeyARN *string `json:"kmsKeyArn,omitempty"`
+
+	// ShippingOption is the speed the device is shipped with.
+	// +optional
+	// +kubebuilder:validation:Enum=SECOND_DAY;NEXT_DAY;EXPRESS;STANDARD
+	ShippingOption *string `json:"shippingOption,omitempty"`
+
+	// SnowballType is the type of the device.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=STANDARD;EDGE;EDGE_C;EDGE_CG;EDGE_S
+	SnowballType *string `json:"snowballType,omitempty"`
+
+	// SnowballCapacityPreference is the preferred capacity of the device.
+	// +optional
+	// +kubebuilder:validation:Enum=T50;T80;T100;T42;T98;NoPreference
+	SnowballCapacityPreference *string `json:"snowballCapacityPreference,omitempty"`
+
+	// Notification configures the SNS notifications sent for the job.
+	// +optional
+	Notification *Notification `json:"notification,omitempty"`
+}
+
+// A SnowballJobSpec defines the desired state of a SnowballJob.
+type SnowballJobSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SnowballJobParameters `json:"forProvider"`
+}
+
+// Shipment is the state of a shipment of the device.
+type Shipment struct {
+	// Status of the shipment.
+	Status string `json:"status,omitempty"`
+
+	// TrackingNumber of the shipment.
+	TrackingNumber string `json:"trackingNumber,omitempty"`
+}
+
+// SnowballJobObservation keeps the state for the external resource
+type SnowballJobObservation struct {
+	// JobState is the current state of the job.
+	JobState string `json:"jobState,omitempty"`
+
+	// InboundShipment is the shipment of the device back to AWS.
+	InboundShipment *Shipment `json:"inboundShipment,omitempty"`
+
+	// OutboundShipment is the shipment of the device to the customer.
+	OutboundShipment *Shipment `json:"outboundShipment,omitempty"`
+}
+
+// A SnowballJobStatus represents the observed state of a SnowballJob.
+type SnowballJobStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SnowballJobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SnowballJob is a managed resource that represents an AWS Snowball job.
+// The external name of the resource is the job ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.jobState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SnowballJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnowballJobSpec   `json:"spec"`
+	Status SnowballJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnowballJobList contains a list of SnowballJobs
+type SnowballJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SnowballJob `json:"items"`
+}
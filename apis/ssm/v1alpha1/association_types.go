@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AssociationOutputLocation is the S3 location association execution
+// output is written to.
+type AssociationOutputLocation struct {
+	// S3BucketName is the name of the S3 bucket.
+	S3BucketName string `json:"s3BucketName"`
+
+	// S3KeyPrefix is the S3 key prefix.
+	// +optional
+	S3KeyPrefix *string `json:"s3KeyPrefix,omitempty"`
+
+	// S3Region is the region of the S3 bucket.
+	// +optional
+	S3Region *string `json:"s3Region,omitempty"`
+}
+
+// AssociationParameters define the desired state of an AWS Systems Manager
+// State Manager association.
+type AssociationParameters struct {
+	// DocumentName is the name of the SSM document or Automation runbook to
+	// apply to the targets.
+	// +immutable
+	DocumentName string `json:"documentName"`
+
+	// DocumentVersion of the document to apply, e.g. $DEFAULT, $LATEST or a
+	// specific version number.
+	// +optional
+	DocumentVersion *string `json:"documentVersion,omitempty"`
+
+	// AssociationName is a friendly name for the association.
+	// +optional
+	AssociationName *string `json:"associationName,omitempty"`
+
+	// Targets the association applies to.
+	// +optional
+	Targets []Target `json:"targets,omitempty"`
+
+	// Parameters for the document.
+	// +optional
+	Parameters map[string][]string `json:"parameters,omitempty"`
+
+	// ScheduleExpression is a cron or rate expression that specifies when the
+	// association runs.
+	// +optional
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+
+	// MaxConcurrency is the maximum number or percentage of targets allowed
+	// to run the association at the same time.
+	// +optional
+	MaxConcurrency *string `json:"maxConcurrency,omitempty"`
+
+	// MaxErrors is the number or percentage of errors allowed before the
+	// system stops sending requests to run the association on additional
+	// targets.
+	// +optional
+	MaxErrors *string `json:"maxErrors,omitempty"`
+
+	// ComplianceSeverity is the severity level to assign to the association.
+	// +kubebuilder:validation:Enum=CRITICAL;HIGH;MEDIUM;LOW;UNSPECIFIED
+	// +optional
+	ComplianceSeverity *string `json:"complianceSeverity,omitempty"`
+
+	// SyncCompliance is the mode for generating association compliance.
+	// +kubebuilder:validation:Enum=AUTO;MANUAL
+	// +optional
+	SyncCompliance *string `json:"syncCompliance,omitempty"`
+
+	// AutomationTargetParameterName specifies the parameter that will be
+	// used for rate-controlled execution of an Automation document.
+	// +optional
+	AutomationTargetParameterName *string `json:"automationTargetParameterName,omitempty"`
+
+	// OutputLocation is the S3 location association execution output is
+	// written to.
+	// +optional
+	OutputLocation *AssociationOutputLocation `json:"outputLocation,omitempty"`
+}
+
+// An AssociationSpec defines the desired state of an Association.
+type AssociationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AssociationParameters `json:"forProvider"`
+}
+
+// AssociationObservation keeps the state for the external resource
+type AssociationObservation struct {
+	// The ID of the association.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The current version of the association.
+	AssociationVersion string `json:"associationVersion,omitempty"`
+
+	// The status of the association, e.g. Pending, Success or Failed.
+	Status string `json:"status,omitempty"`
+
+	// The date on which the association was last run.
+	LastExecutionDate *metav1.Time `json:"lastExecutionDate,omitempty"`
+}
+
+// An AssociationStatus represents the observed state of an Association.
+type AssociationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Association is a managed resource that represents an AWS Systems
+// Manager State Manager association. The external name of the resource is
+// the association ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DOCUMENT",type="string",JSONPath=".spec.forProvider.documentName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Association struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AssociationSpec   `json:"spec"`
+	Status AssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AssociationList contains a list of Associations
+type AssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Association `json:"items"`
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Tag is a key-value pair attached to an AWS Systems Manager resource.
+type Tag struct {
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	Value string `json:"value"`
+}
+
+// Target selects the instances or resources that a maintenance window or
+// association acts on, e.g. Key=tag:Environment,Values=production or
+// Key=InstanceIds,Values=i-1234567890abcdef0.
+type Target struct {
+	// Key of the target selector.
+	Key string `json:"key"`
+
+	// Values for the target selector key.
+	Values []string `json:"values"`
+}
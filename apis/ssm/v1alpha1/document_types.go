@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Document statuses.
+const (
+	DocumentStatusCreating = "Creating"
+	DocumentStatusActive   = "Active"
+	DocumentStatusUpdating = "Updating"
+	DocumentStatusDeleting = "Deleting"
+	DocumentStatusFailed   = "Failed"
+)
+
+// DocumentParameters define the desired state of an AWS Systems Manager
+// document.
+type DocumentParameters struct {
+	// Content of the document. Changes are compared semantically for JSON
+	// and YAML documents, so formatting and key order are ignored. Every
+	// change creates a new document version that becomes the default
+	// version.
+	Content string `json:"content"`
+
+	// DocumentFormat is the format of the content.
+	// +kubebuilder:validation:Enum=YAML;JSON;TEXT
+	// +optional
+	DocumentFormat *string `json:"documentFormat,omitempty"`
+
+	// DocumentType is the type of the document, e.g. Command or Automation.
+	// +immutable
+	// +kubebuilder:validation:Enum=Command;Policy;Automation;Session;Package;ApplicationConfiguration;ApplicationConfigurationSchema;DeploymentStrategy;ChangeCalendar
+	// +optional
+	DocumentType *string `json:"documentType,omitempty"`
+
+	// TargetType specifies the type of resource the document can run on,
+	// e.g. /AWS::EC2::Instance.
+	// +optional
+	TargetType *string `json:"targetType,omitempty"`
+
+	// VersionName is an optional name for the document version, e.g.
+	// "Release 12". It must be unique across versions of the document.
+	// +optional
+	VersionName *string `json:"versionName,omitempty"`
+
+	// AccountIDs the document is shared with. Use "All" to make the document
+	// public.
+	// +optional
+	AccountIDs []string `json:"accountIds,omitempty"`
+
+	// Tags to assign to the document when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DocumentSpec defines the desired state of a Document.
+type DocumentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DocumentParameters `json:"forProvider"`
+}
+
+// DocumentObservation keeps the state for the external resource
+type DocumentObservation struct {
+	// The default version of the document.
+	DefaultVersion string `json:"defaultVersion,omitempty"`
+
+	// The latest version of the document.
+	LatestVersion string `json:"latestVersion,omitempty"`
+
+	// The status of the document, e.g. Creating, Active or Failed.
+	Status string `json:"status,omitempty"`
+
+	// A message explaining the current status of the document.
+	StatusInformation string `json:"statusInformation,omitempty"`
+
+	// The SHA-256 hash of the latest document version.
+	Hash string `json:"hash,omitempty"`
+
+	// The AWS account ID that owns the document.
+	Owner string `json:"owner,omitempty"`
+
+	// The schema version of the document content.
+	SchemaVersion string `json:"schemaVersion,omitempty"`
+
+	// The operating systems the document applies to.
+	PlatformTypes []string `json:"platformTypes,omitempty"`
+}
+
+// A DocumentStatus represents the observed state of a Document.
+type DocumentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DocumentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Document is a managed resource that represents an AWS Systems Manager
+// document. The external name of the resource is the document name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.documentType"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.defaultVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Document struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DocumentSpec   `json:"spec"`
+	Status DocumentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DocumentList contains a list of Documents
+type DocumentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Document `json:"items"`
+}
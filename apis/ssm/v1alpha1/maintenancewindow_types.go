@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// MaintenanceWindowParameters define the desired state of an AWS Systems
+// Manager maintenance window.
+type MaintenanceWindowParameters struct {
+	// Name of the maintenance window.
+	Name string `json:"name"`
+
+	// Description of the maintenance window.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Schedule of the maintenance window in the form of a cron or rate
+	// expression, e.g. cron(0 2 ? * SUN *).
+	Schedule string `json:"schedule"`
+
+	// ScheduleTimezone is the time zone that the scheduled maintenance window
+	// executions are based on, in Internet Assigned Numbers Authority (IANA)
+	// format, e.g. America/Los_Angeles.
+	// +optional
+	ScheduleTimezone *string `json:"scheduleTimezone,omitempty"`
+
+	// Duration of the maintenance window in hours.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=24
+	Duration int64 `json:"duration"`
+
+	// Cutoff is the number of hours before the end of the maintenance window
+	// that Systems Manager stops scheduling new tasks for execution.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	Cutoff int64 `json:"cutoff"`
+
+	// AllowUnassociatedTargets enables a maintenance window task to run on
+	// managed instances even if no targets are registered with the window.
+	AllowUnassociatedTargets bool `json:"allowUnassociatedTargets"`
+
+	// Enabled indicates whether the maintenance window is enabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// StartDate is the date and time, in ISO-8601 Extended format, for when
+	// the maintenance window becomes active.
+	// +optional
+	StartDate *string `json:"startDate,omitempty"`
+
+	// EndDate is the date and time, in ISO-8601 Extended format, for when the
+	// maintenance window is scheduled to become inactive.
+	// +optional
+	EndDate *string `json:"endDate,omitempty"`
+
+	// Tags to assign to the maintenance window when it is created.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A MaintenanceWindowSpec defines the desired state of a MaintenanceWindow.
+type MaintenanceWindowSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  MaintenanceWindowParameters `json:"forProvider"`
+}
+
+// MaintenanceWindowObservation keeps the state for the external resource
+type MaintenanceWindowObservation struct {
+	// The ID of the maintenance window.
+	WindowID string `json:"windowId,omitempty"`
+
+	// The next time the maintenance window will actually run, taking into
+	// account any specified times for the window to become active or
+	// inactive.
+	NextExecutionTime string `json:"nextExecutionTime,omitempty"`
+}
+
+// A MaintenanceWindowStatus represents the observed state of a
+// MaintenanceWindow.
+type MaintenanceWindowStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     MaintenanceWindowObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MaintenanceWindow is a managed resource that represents an AWS Systems
+// Manager maintenance window. The external name of the resource is the
+// window ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".spec.forProvider.schedule"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MaintenanceWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MaintenanceWindowSpec   `json:"spec"`
+	Status MaintenanceWindowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaintenanceWindowList contains a list of MaintenanceWindows
+type MaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceWindow `json:"items"`
+}
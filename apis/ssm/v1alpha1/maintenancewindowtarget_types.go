@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// MaintenanceWindowTargetParameters define the desired state of a target
+// registered with an AWS Systems Manager maintenance window.
+type MaintenanceWindowTargetParameters struct {
+	// WindowID is the ID of the maintenance window to register the target
+	// with.
+	// +immutable
+	// +optional
+	WindowID *string `json:"windowId,omitempty"`
+
+	// WindowIDRef references a MaintenanceWindow to retrieve its ID.
+	// +immutable
+	// +optional
+	WindowIDRef *runtimev1alpha1.Reference `json:"windowIdRef,omitempty"`
+
+	// WindowIDSelector selects a reference to a MaintenanceWindow to retrieve
+	// its ID.
+	// +optional
+	WindowIDSelector *runtimev1alpha1.Selector `json:"windowIdSelector,omitempty"`
+
+	// ResourceType is the type of target being registered with the
+	// maintenance window.
+	// +immutable
+	// +kubebuilder:validation:Enum=INSTANCE;RESOURCE_GROUP
+	ResourceType string `json:"resourceType"`
+
+	// Targets to register with the maintenance window.
+	Targets []Target `json:"targets"`
+
+	// Name of the target.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Description of the target.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OwnerInformation is user-provided value that will be included in any
+	// CloudWatch events raised while running tasks for these targets.
+	// +optional
+	OwnerInformation *string `json:"ownerInformation,omitempty"`
+}
+
+// A MaintenanceWindowTargetSpec defines the desired state of a
+// MaintenanceWindowTarget.
+type MaintenanceWindowTargetSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  MaintenanceWindowTargetParameters `json:"forProvider"`
+}
+
+// MaintenanceWindowTargetObservation keeps the state for the external resource
+type MaintenanceWindowTargetObservation struct {
+	// The ID of the target registration.
+	WindowTargetID string `json:"windowTargetId,omitempty"`
+}
+
+// A MaintenanceWindowTargetStatus represents the observed state of a
+// MaintenanceWindowTarget.
+type MaintenanceWindowTargetStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     MaintenanceWindowTargetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MaintenanceWindowTarget is a managed resource that represents a target
+// registered with an AWS Systems Manager maintenance window. The external
+// name of the resource is the window target ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="WINDOW",type="string",JSONPath=".spec.forProvider.windowId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MaintenanceWindowTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MaintenanceWindowTargetSpec   `json:"spec"`
+	Status MaintenanceWindowTargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaintenanceWindowTargetList contains a list of MaintenanceWindowTargets
+type MaintenanceWindowTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceWindowTarget `json:"items"`
+}
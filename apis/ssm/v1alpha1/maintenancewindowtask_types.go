@@ -0,0 +1,259 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CloudWatchOutputConfig configures sending Run Command output to Amazon
+// CloudWatch Logs.
+type CloudWatchOutputConfig struct {
+	// CloudWatchLogGroupName is the name of the log group the output is sent
+	// to. If omitted, Systems Manager creates a group named
+	// /aws/ssm/<SystemsManagerDocumentName>.
+	// +optional
+	CloudWatchLogGroupName *string `json:"cloudWatchLogGroupName,omitempty"`
+
+	// CloudWatchOutputEnabled enables sending command output to CloudWatch
+	// Logs.
+	// +optional
+	CloudWatchOutputEnabled *bool `json:"cloudWatchOutputEnabled,omitempty"`
+}
+
+// RunCommandParameters are the parameters for a RUN_COMMAND task.
+type RunCommandParameters struct {
+	// Comment is information about the commands to run.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// DocumentVersion of the SSM document to run, e.g. $DEFAULT, $LATEST or
+	// a specific version number.
+	// +optional
+	DocumentVersion *string `json:"documentVersion,omitempty"`
+
+	// OutputS3BucketName is the name of the S3 bucket command output is
+	// written to.
+	// +optional
+	OutputS3BucketName *string `json:"outputS3BucketName,omitempty"`
+
+	// OutputS3KeyPrefix is the S3 key prefix command output is written under.
+	// +optional
+	OutputS3KeyPrefix *string `json:"outputS3KeyPrefix,omitempty"`
+
+	// Parameters for the SSM document.
+	// +optional
+	Parameters map[string][]string `json:"parameters,omitempty"`
+
+	// ServiceRoleARN is the ARN of the IAM role used to publish Amazon SNS
+	// notifications for the commands.
+	// +optional
+	ServiceRoleARN *string `json:"serviceRoleArn,omitempty"`
+
+	// TimeoutSeconds is the number of seconds to wait for a command to start
+	// before it is considered to have failed.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=2592000
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// CloudWatchOutputConfig configures sending command output to CloudWatch
+	// Logs.
+	// +optional
+	CloudWatchOutputConfig *CloudWatchOutputConfig `json:"cloudWatchOutputConfig,omitempty"`
+}
+
+// AutomationParameters are the parameters for an AUTOMATION task.
+type AutomationParameters struct {
+	// DocumentVersion of the Automation document to run.
+	// +optional
+	DocumentVersion *string `json:"documentVersion,omitempty"`
+
+	// Parameters for the Automation document.
+	// +optional
+	Parameters map[string][]string `json:"parameters,omitempty"`
+}
+
+// LambdaParameters are the parameters for a LAMBDA task.
+type LambdaParameters struct {
+	// ClientContext passes client-specific information to the Lambda
+	// function, encoded as base64.
+	// +optional
+	ClientContext *string `json:"clientContext,omitempty"`
+
+	// Payload is the JSON that is passed to the Lambda function as input.
+	// +optional
+	Payload *string `json:"payload,omitempty"`
+
+	// Qualifier is a Lambda function version or alias name.
+	// +optional
+	Qualifier *string `json:"qualifier,omitempty"`
+}
+
+// StepFunctionsParameters are the parameters for a STEP_FUNCTIONS task.
+type StepFunctionsParameters struct {
+	// Input is the JSON input for the state machine execution.
+	// +optional
+	Input *string `json:"input,omitempty"`
+
+	// Name of the state machine execution.
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// TaskInvocationParameters are the parameters the task is run with. Only the
+// field that matches the task type is used.
+type TaskInvocationParameters struct {
+	// RunCommand parameters.
+	// +optional
+	RunCommand *RunCommandParameters `json:"runCommand,omitempty"`
+
+	// Automation parameters.
+	// +optional
+	Automation *AutomationParameters `json:"automation,omitempty"`
+
+	// Lambda parameters.
+	// +optional
+	Lambda *LambdaParameters `json:"lambda,omitempty"`
+
+	// StepFunctions parameters.
+	// +optional
+	StepFunctions *StepFunctionsParameters `json:"stepFunctions,omitempty"`
+}
+
+// MaintenanceWindowTaskParameters define the desired state of a task
+// registered with an AWS Systems Manager maintenance window.
+type MaintenanceWindowTaskParameters struct {
+	// WindowID is the ID of the maintenance window to register the task with.
+	// +immutable
+	// +optional
+	WindowID *string `json:"windowId,omitempty"`
+
+	// WindowIDRef references a MaintenanceWindow to retrieve its ID.
+	// +immutable
+	// +optional
+	WindowIDRef *runtimev1alpha1.Reference `json:"windowIdRef,omitempty"`
+
+	// WindowIDSelector selects a reference to a MaintenanceWindow to retrieve
+	// its ID.
+	// +optional
+	WindowIDSelector *runtimev1alpha1.Selector `json:"windowIdSelector,omitempty"`
+
+	// TaskType is the type of task being registered.
+	// +immutable
+	// +kubebuilder:validation:Enum=RUN_COMMAND;AUTOMATION;STEP_FUNCTIONS;LAMBDA
+	TaskType string `json:"taskType"`
+
+	// TaskARN is the ARN of the task to run. For RUN_COMMAND and AUTOMATION
+	// tasks this is the name of the SSM document.
+	TaskARN string `json:"taskArn"`
+
+	// Targets the task runs on, e.g. Key=WindowTargetIds,Values=<target id>.
+	// +optional
+	Targets []Target `json:"targets,omitempty"`
+
+	// MaxConcurrency is the maximum number or percentage of targets the task
+	// is allowed to run on in parallel.
+	MaxConcurrency string `json:"maxConcurrency"`
+
+	// MaxErrors is the maximum number or percentage of errors allowed before
+	// the task stops being scheduled.
+	MaxErrors string `json:"maxErrors"`
+
+	// Priority of the task in the maintenance window. The lower the number,
+	// the higher the priority.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Priority *int64 `json:"priority,omitempty"`
+
+	// ServiceRoleARN is the ARN of the IAM service role Systems Manager
+	// assumes when running the task.
+	// +optional
+	ServiceRoleARN *string `json:"serviceRoleArn,omitempty"`
+
+	// ServiceRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	ServiceRoleARNRef *runtimev1alpha1.Reference `json:"serviceRoleArnRef,omitempty"`
+
+	// ServiceRoleARNSelector selects a reference to an IAMRole to retrieve
+	// its ARN.
+	// +optional
+	ServiceRoleARNSelector *runtimev1alpha1.Selector `json:"serviceRoleArnSelector,omitempty"`
+
+	// Name of the task.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Description of the task.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// TaskInvocationParameters are the parameters the task is run with.
+	// +optional
+	TaskInvocationParameters *TaskInvocationParameters `json:"taskInvocationParameters,omitempty"`
+}
+
+// A MaintenanceWindowTaskSpec defines the desired state of a
+// MaintenanceWindowTask.
+type MaintenanceWindowTaskSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  MaintenanceWindowTaskParameters `json:"forProvider"`
+}
+
+// MaintenanceWindowTaskObservation keeps the state for the external resource
+type MaintenanceWindowTaskObservation struct {
+	// The ID of the task registration.
+	WindowTaskID string `json:"windowTaskId,omitempty"`
+}
+
+// A MaintenanceWindowTaskStatus represents the observed state of a
+// MaintenanceWindowTask.
+type MaintenanceWindowTaskStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     MaintenanceWindowTaskObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MaintenanceWindowTask is a managed resource that represents a task
+// registered with an AWS Systems Manager maintenance window. The external
+// name of the resource is the window task ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.taskType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MaintenanceWindowTask struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MaintenanceWindowTaskSpec   `json:"spec"`
+	Status MaintenanceWindowTaskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaintenanceWindowTaskList contains a list of MaintenanceWindowTasks
+type MaintenanceWindowTaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceWindowTask `json:"items"`
+}
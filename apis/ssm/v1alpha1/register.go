@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "ssm.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// MaintenanceWindow type metadata.
+var (
+	MaintenanceWindowKind             = reflect.TypeOf(MaintenanceWindow{}).Name()
+	MaintenanceWindowGroupKind        = schema.GroupKind{Group: Group, Kind: MaintenanceWindowKind}.String()
+	MaintenanceWindowKindAPIVersion   = MaintenanceWindowKind + "." + SchemeGroupVersion.String()
+	MaintenanceWindowGroupVersionKind = SchemeGroupVersion.WithKind(MaintenanceWindowKind)
+)
+
+// MaintenanceWindowTarget type metadata.
+var (
+	MaintenanceWindowTargetKind             = reflect.TypeOf(MaintenanceWindowTarget{}).Name()
+	MaintenanceWindowTargetGroupKind        = schema.GroupKind{Group: Group, Kind: MaintenanceWindowTargetKind}.String()
+	MaintenanceWindowTargetKindAPIVersion   = MaintenanceWindowTargetKind + "." + SchemeGroupVersion.String()
+	MaintenanceWindowTargetGroupVersionKind = SchemeGroupVersion.WithKind(MaintenanceWindowTargetKind)
+)
+
+// MaintenanceWindowTask type metadata.
+var (
+	MaintenanceWindowTaskKind             = reflect.TypeOf(MaintenanceWindowTask{}).Name()
+	MaintenanceWindowTaskGroupKind        = schema.GroupKind{Group: Group, Kind: MaintenanceWindowTaskKind}.String()
+	MaintenanceWindowTaskKindAPIVersion   = MaintenanceWindowTaskKind + "." + SchemeGroupVersion.String()
+	MaintenanceWindowTaskGroupVersionKind = SchemeGroupVersion.WithKind(MaintenanceWindowTaskKind)
+)
+
+// Association type metadata.
+var (
+	AssociationKind             = reflect.TypeOf(Association{}).Name()
+	AssociationGroupKind        = schema.GroupKind{Group: Group, Kind: AssociationKind}.String()
+	AssociationKindAPIVersion   = AssociationKind + "." + SchemeGroupVersion.String()
+	AssociationGroupVersionKind = SchemeGroupVersion.WithKind(AssociationKind)
+)
+
+// Document type metadata.
+var (
+	DocumentKind             = reflect.TypeOf(Document{}).Name()
+	DocumentGroupKind        = schema.GroupKind{Group: Group, Kind: DocumentKind}.String()
+	DocumentKindAPIVersion   = DocumentKind + "." + SchemeGroupVersion.String()
+	DocumentGroupVersionKind = SchemeGroupVersion.WithKind(DocumentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MaintenanceWindow{}, &MaintenanceWindowList{})
+	SchemeBuilder.Register(&MaintenanceWindowTarget{}, &MaintenanceWindowTargetList{})
+	SchemeBuilder.Register(&MaintenanceWindowTask{}, &MaintenanceWindowTaskList{})
+	SchemeBuilder.Register(&Association{}, &AssociationList{})
+	SchemeBuilder.Register(&Document{}, &DocumentList{})
+}
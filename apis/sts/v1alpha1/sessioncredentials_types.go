@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SessionCredentialsParameters define the desired state of a set of temporary
+// AWS credentials obtained by assuming an IAM role.
+type SessionCredentialsParameters struct {
+	// RoleARN is the Amazon Resource Name (ARN) of the role to assume.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNRef *runtimev1alpha1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAMRole to retrieve its ARN.
+	// +optional
+	RoleARNSelector *runtimev1alpha1.Selector `json:"roleArnSelector,omitempty"`
+
+	// RoleSessionName is an identifier for the assumed role session that is
+	// recorded in CloudTrail. Defaults to the name of the SessionCredentials.
+	// +kubebuilder:validation:Pattern=`^[\w+=,.@-]{2,64}$`
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+
+	// DurationSeconds is the lifetime of the issued credentials. It cannot
+	// exceed the maximum session duration of the role. Defaults to one hour.
+	// +kubebuilder:validation:Minimum=900
+	// +kubebuilder:validation:Maximum=43200
+	// +optional
+	DurationSeconds *int64 `json:"durationSeconds,omitempty"`
+
+	// ExternalID is the unique identifier that the trust policy of the role
+	// may require from third parties assuming it.
+	// +optional
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// Policy is an inline session policy in JSON format that further scopes
+	// down the permissions of the issued credentials.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+
+	// PolicyARNs are the ARNs of managed policies to use as session policies.
+	// +optional
+	PolicyARNs []string `json:"policyArns,omitempty"`
+
+	// RefreshBefore is how long before their expiration the credentials are
+	// replaced with new ones. Defaults to five minutes.
+	// +optional
+	RefreshBefore *metav1.Duration `json:"refreshBefore,omitempty"`
+}
+
+// A SessionCredentialsSpec defines the desired state of a SessionCredentials.
+type SessionCredentialsSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SessionCredentialsParameters `json:"forProvider"`
+}
+
+// SessionCredentialsObservation keeps the state of the issued credentials.
+type SessionCredentialsObservation struct {
+	// RoleARN is the ARN of the role the current credentials were issued for.
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// AssumedRoleARN is the ARN of the assumed role session.
+	AssumedRoleARN string `json:"assumedRoleArn,omitempty"`
+
+	// AssumedRoleID is the unique identifier of the assumed role session.
+	AssumedRoleID string `json:"assumedRoleId,omitempty"`
+
+	// Expiration is the time at which the current credentials expire.
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+}
+
+// A SessionCredentialsStatus represents the observed state of a
+// SessionCredentials.
+type SessionCredentialsStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SessionCredentialsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SessionCredentials is a managed resource that assumes an IAM role and
+// writes the resulting short-lived credentials to its connection secret,
+// replacing them before they expire.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXPIRATION",type="string",JSONPath=".status.atProvider.expiration"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SessionCredentials struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SessionCredentialsSpec   `json:"spec"`
+	Status SessionCredentialsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SessionCredentialsList contains a list of SessionCredentials
+type SessionCredentialsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SessionCredentials `json:"items"`
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CanaryCode specifies the script the canary runs. The script is either
+// stored in S3 as a zip file or supplied inline.
+type CanaryCode struct {
+	// Handler is the entry point of the script, e.g. pageLoadBlueprint.handler.
+	Handler string `json:"handler"`
+
+	// S3Bucket is the name of the S3 bucket the zipped script is stored in.
+	// +optional
+	S3Bucket *string `json:"s3Bucket,omitempty"`
+
+	// S3Key is the key of the zipped script within S3Bucket.
+	// +optional
+	S3Key *string `json:"s3Key,omitempty"`
+
+	// S3Version is the version of the zipped script within S3Bucket.
+	// +optional
+	S3Version *string `json:"s3Version,omitempty"`
+
+	// Script is the source of the script. It is packaged for the runtime
+	// in a file named after the handler. Mutually exclusive with S3Bucket.
+	// +optional
+	Script *string `json:"script,omitempty"`
+}
+
+// CanaryRunConfig specifies the environment a canary runs in.
+type CanaryRunConfig struct {
+	// MemoryInMB is the amount of memory available to the canary.
+	// +kubebuilder:validation:Minimum=960
+	// +optional
+	MemoryInMB *int64 `json:"memoryInMB,omitempty"`
+
+	// TimeoutInSeconds is how long the canary may run before it is stopped.
+	// +kubebuilder:validation:Minimum=60
+	TimeoutInSeconds int64 `json:"timeoutInSeconds"`
+}
+
+// CanarySchedule specifies how often the canary runs.
+type CanarySchedule struct {
+	// DurationInSeconds is how long the canary keeps running on its schedule
+	// after it is started. A value of 0 runs it until it is stopped.
+	// +optional
+	DurationInSeconds *int64 `json:"durationInSeconds,omitempty"`
+
+	// Expression is a rate expression such as rate(5 minutes), or rate(0
+	// minute) to run the canary once when it is started.
+	Expression string `json:"expression"`
+}
+
+// VPCConfig specifies the VPC the canary runs in.
+type VPCConfig struct {
+	// SecurityGroupIDs are the IDs of the security groups of the canary.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets the canary runs in.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+}
+
+// CanaryParameters define the desired state of an AWS CloudWatch Synthetics
+// canary.
+type CanaryParameters struct {
+	// ArtifactS3Bucket is the name of the S3 bucket the canary stores the
+	// results of its runs in.
+	// +optional
+	ArtifactS3Bucket *string `json:"artifactS3Bucket,omitempty"`
+
+	// ArtifactS3BucketRef references an S3Bucket to retrieve its name.
+	// +optional
+	ArtifactS3BucketRef *runtimev1alpha1.Reference `json:"artifactS3BucketRef,omitempty"`
+
+	// ArtifactS3BucketSelector selects a reference to an S3Bucket to
+	// retrieve its name.
+	// +optional
+	ArtifactS3BucketSelector *runtimev1alpha1.Selector `json:"artifactS3BucketSelector,omitempty"`
+
+	// ArtifactS3Prefix is the prefix within ArtifactS3Bucket under which run
+	// results are stored.
+	// +optional
+	ArtifactS3Prefix *string `json:"artifactS3Prefix,omitempty"`
+
+	// Code is the script the canary runs.
+	Code CanaryCode `json:"code"`
+
+	// ExecutionRoleARN is the ARN of the IAM role the canary runs with.
+	// +optional
+	ExecutionRoleARN *string `json:"executionRoleArn,omitempty"`
+
+	// ExecutionRoleARNRef references an IAMRole to retrieve its ARN.
+	// +optional
+	ExecutionRoleARNRef *runtimev1alpha1.Reference `json:"executionRoleArnRef,omitempty"`
+
+	// ExecutionRoleARNSelector selects a reference to an IAMRole to retrieve
+	// its ARN.
+	// +optional
+	ExecutionRoleARNSelector *runtimev1alpha1.Selector `json:"executionRoleArnSelector,omitempty"`
+
+	// FailureRetentionPeriodInDays is how long data about failed runs is
+	// kept.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailureRetentionPeriodInDays *int64 `json:"failureRetentionPeriodInDays,omitempty"`
+
+	// RunConfig specifies the environment the canary runs in.
+	// +optional
+	RunConfig *CanaryRunConfig `json:"runConfig,omitempty"`
+
+	// Running controls whether the canary runs on its schedule. Defaults to
+	// true.
+	// +optional
+	Running *bool `json:"running,omitempty"`
+
+	// RuntimeVersion is the version of the runtime the canary uses, e.g.
+	// syn-nodejs-2.0.
+	RuntimeVersion string `json:"runtimeVersion"`
+
+	// Schedule specifies how often the canary runs.
+	Schedule CanarySchedule `json:"schedule"`
+
+	// SuccessRetentionPeriodInDays is how long data about successful runs is
+	// kept.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	SuccessRetentionPeriodInDays *int64 `json:"successRetentionPeriodInDays,omitempty"`
+
+	// Tags to assign to the canary when it is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// VPCConfig specifies the VPC the canary runs in.
+	// +optional
+	VPCConfig *VPCConfig `json:"vpcConfig,omitempty"`
+}
+
+// A CanarySpec defines the desired state of a Canary.
+type CanarySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CanaryParameters `json:"forProvider"`
+}
+
+// CanaryObservation keeps the state for the external resource.
+type CanaryObservation struct {
+	// ID is the unique identifier of the canary.
+	ID string `json:"id,omitempty"`
+
+	// EngineARN is the ARN of the Lambda function that runs the canary.
+	EngineARN string `json:"engineArn,omitempty"`
+
+	// State of the canary.
+	State string `json:"state,omitempty"`
+
+	// StateReason explains why the canary is in its current state.
+	StateReason string `json:"stateReason,omitempty"`
+
+	// VPCID is the ID of the VPC the canary runs in.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// CodeChecksum is the SHA-256 checksum of the last code configuration
+	// applied to the canary. It is used to detect changes to the script,
+	// which AWS does not return.
+	CodeChecksum string `json:"codeChecksum,omitempty"`
+}
+
+// A CanaryStatus represents the observed state of a Canary.
+type CanaryStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CanaryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Canary is a managed resource that represents an AWS CloudWatch Synthetics
+// canary, a script that periodically checks an endpoint or API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Canary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CanarySpec   `json:"spec"`
+	Status CanaryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CanaryList contains a list of Canaries
+type CanaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Canary `json:"items"`
+}
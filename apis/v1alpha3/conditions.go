@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TypeHealthy Providers have credentials that AWS accepts.
+const TypeHealthy runtimev1alpha1.ConditionType = "Healthy"
+
+// Reasons a Provider is or is not healthy.
+const (
+	ReasonIdentified       runtimev1alpha1.ConditionReason = "Identified"
+	ReasonIdentifyingError runtimev1alpha1.ConditionReason = "IdentifyingError"
+)
+
+// Healthy returns a condition that indicates AWS identified the credentials
+// of a Provider.
+func Healthy() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonIdentified,
+	}
+}
+
+// Unhealthy returns a condition that indicates the credentials of a Provider
+// could not be identified, for example because they are missing, invalid or
+// not allowed to assume the configured role.
+func Unhealthy(err error) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonIdentifyingError,
+		Message:            err.Error(),
+	}
+}
+
+// TypeDriftReported managed resources may have a DriftReport.
+const TypeDriftReported runtimev1alpha1.ConditionType = "DriftReported"
+
+// Reasons a managed resource does or does not have a DriftReport.
+const (
+	ReasonReportRecorded runtimev1alpha1.ConditionReason = "ReportRecorded"
+	ReasonReportCleared  runtimev1alpha1.ConditionReason = "ReportCleared"
+)
+
+// DriftReported returns a condition that indicates the drift of a managed
+// resource was recorded in a DriftReport.
+func DriftReported() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeDriftReported,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReportRecorded,
+	}
+}
+
+// DriftReportCleared returns a condition that indicates the DriftReport of a
+// managed resource was deleted.
+func DriftReportCleared() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeDriftReported,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReportCleared,
+	}
+}
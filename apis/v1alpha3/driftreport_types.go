@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// A DriftReason explains how an external resource differs from the desired
+// state of its managed resource.
+type DriftReason string
+
+// Reasons an external resource may differ from the desired state.
+const (
+	DriftReasonExternalResourceMissing DriftReason = "ExternalResourceMissing"
+	DriftReasonNotUpToDate             DriftReason = "NotUpToDate"
+)
+
+// A DriftReportStatus records whether the external resource of a managed
+// resource differs from the desired state of the managed resource.
+type DriftReportStatus struct {
+	// ResourceRef references the managed resource.
+	ResourceRef runtimev1alpha1.TypedReference `json:"resourceRef"`
+
+	// Drifted is true while the external resource differs from the desired
+	// state of the managed resource.
+	Drifted bool `json:"drifted"`
+
+	// Reason the external resource differs from the desired state.
+	// +optional
+	Reason DriftReason `json:"reason,omitempty"`
+
+	// LastTransitionTime is the last time the external resource started or
+	// stopped differing from the desired state.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DriftReport records the drift that was detected, but not corrected, for a
+// managed resource whose drift mode is Report.
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".status.resourceRef.kind"
+// +kubebuilder:printcolumn:name="RESOURCE",type="string",JSONPath=".status.resourceRef.name"
+// +kubebuilder:printcolumn:name="DRIFTED",type="boolean",JSONPath=".status.drifted"
+// +kubebuilder:printcolumn:name="REASON",type="string",JSONPath=".status.reason"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,aws}
+type DriftReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status DriftReportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DriftReportList contains a list of DriftReport
+type DriftReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DriftReport `json:"items"`
+}
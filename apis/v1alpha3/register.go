@@ -45,6 +45,15 @@ var (
 	ProviderGroupVersionKind = SchemeGroupVersion.WithKind(ProviderKind)
 )
 
+// DriftReport type metadata.
+var (
+	DriftReportKind             = reflect.TypeOf(DriftReport{}).Name()
+	DriftReportGroupKind        = schema.GroupKind{Group: Group, Kind: DriftReportKind}.String()
+	DriftReportKindAPIVersion   = DriftReportKind + "." + SchemeGroupVersion.String()
+	DriftReportGroupVersionKind = SchemeGroupVersion.WithKind(DriftReportKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Provider{}, &ProviderList{})
+	SchemeBuilder.Register(&DriftReport{}, &DriftReportList{})
 }
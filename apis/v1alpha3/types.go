@@ -36,6 +36,76 @@ type ProviderSpec struct {
 	// If set to true, credentialsSecretRef will be ignored.
 	// +optional
 	UseServiceAccount *bool `json:"useServiceAccount,omitempty"`
+
+	// CABundleSecretRef references a Secret key that holds PEM encoded
+	// certificates to trust, in addition to those of the system, when
+	// connecting to AWS endpoints.
+	// +optional
+	CABundleSecretRef *runtimev1alpha1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// InsecureSkipVerify disables verification of the certificates presented
+	// by AWS endpoints. It is meant for test environments, such as LocalStack
+	// with self-signed certificates, and must not be used in production.
+	// +optional
+	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+
+	// Proxy through which requests to AWS endpoints are sent, instead of the
+	// proxy configured by the environment of the provider process.
+	// +optional
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+
+	// AssumeRoleARN of an IAM role that managed resources using this
+	// provider are managed as. The role is assumed using the credentials
+	// otherwise in use, either those of the credentials Secret or those of
+	// the ServiceAccount, which allows a single provider pod to manage
+	// resources in several AWS accounts.
+	// +optional
+	AssumeRoleARN *string `json:"assumeRoleARN,omitempty"`
+
+	// WebIdentity configures the IAM role that a provider with
+	// useServiceAccount set assumes with sts:AssumeRoleWithWebIdentity,
+	// instead of the role and token that EKS injects into the environment
+	// of the provider pod. This allows Providers served by the same pod to
+	// authenticate as different roles.
+	// +optional
+	WebIdentity *WebIdentityConfig `json:"webIdentity,omitempty"`
+}
+
+// A WebIdentityConfig configures the IAM role that is assumed with a web
+// identity token, such as that of a Kubernetes ServiceAccount.
+type WebIdentityConfig struct {
+	// RoleARN of the IAM role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// TokenPath of the file that holds the web identity token. Defaults to
+	// the path of the AWS_WEB_IDENTITY_TOKEN_FILE environment variable.
+	// +optional
+	TokenPath *string `json:"tokenPath,omitempty"`
+}
+
+// A ProxyConfig configures an HTTP or HTTPS egress proxy.
+type ProxyConfig struct {
+	// URL of the proxy, for example http://proxy.example.com:3128.
+	URL string `json:"url"`
+
+	// CredentialsSecretRef references a Secret key that holds the credentials
+	// used to authenticate to the proxy, in the form username:password.
+	// +optional
+	CredentialsSecretRef *runtimev1alpha1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// A ProviderStatus represents the observed state of a Provider.
+type ProviderStatus struct {
+	runtimev1alpha1.ConditionedStatus `json:",inline"`
+
+	// AccountID of the AWS account the credentials of the Provider belong to.
+	AccountID string `json:"accountId,omitempty"`
+
+	// ARN of the IAM identity the credentials of the Provider authenticate as.
+	ARN string `json:"arn,omitempty"`
+
+	// Partition of the AWS account, for example aws or aws-cn.
+	Partition string `json:"partition,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -43,14 +113,18 @@ type ProviderSpec struct {
 // A Provider configures an AWS 'provider', i.e. a connection to a particular
 // AWS account using a particular AWS IAM role.
 // +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.region"
+// +kubebuilder:printcolumn:name="HEALTHY",type="string",JSONPath=".status.conditions[?(@.type=='Healthy')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.accountId"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,aws}
 type Provider struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ProviderSpec `json:"spec"`
+	Spec   ProviderSpec   `json:"spec"`
+	Status ProviderStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
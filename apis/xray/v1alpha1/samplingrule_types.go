@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SamplingRuleParameters define the desired state of an AWS X-Ray sampling
+// rule.
+type SamplingRuleParameters struct {
+	// Matches attributes derived from the request.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+
+	// The percentage of matching requests to instrument, after the reservoir
+	// is exhausted, expressed as a decimal string between 0 and 1, e.g. "0.05".
+	FixedRate string `json:"fixedRate"`
+
+	// Matches the HTTP method of a request.
+	HTTPMethod string `json:"httpMethod"`
+
+	// Matches the hostname from a request URL.
+	Host string `json:"host"`
+
+	// The priority of the sampling rule.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9999
+	Priority int64 `json:"priority"`
+
+	// A fixed number of matching requests to instrument per second, prior to
+	// applying the fixed rate. The reservoir is not used directly by services,
+	// but applies to all services using the rule collectively.
+	ReservoirSize int64 `json:"reservoirSize"`
+
+	// Matches the ARN of the AWS resource on which the service runs.
+	ResourceARN string `json:"resourceArn"`
+
+	// Matches the name that the service uses to identify itself in segments.
+	ServiceName string `json:"serviceName"`
+
+	// Matches the origin that the service uses to identify its type in
+	// segments.
+	ServiceType string `json:"serviceType"`
+
+	// Matches the path from a request URL.
+	URLPath string `json:"urlPath"`
+
+	// The version of the sampling rule format. Only version 1 is supported.
+	// +immutable
+	// +optional
+	Version *int64 `json:"version,omitempty"`
+}
+
+// A SamplingRuleSpec defines the desired state of a SamplingRule.
+type SamplingRuleSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SamplingRuleParameters `json:"forProvider"`
+}
+
+// SamplingRuleObservation keeps the state for the external resource
+type SamplingRuleObservation struct {
+	// The ARN of the sampling rule.
+	RuleARN string `json:"ruleArn,omitempty"`
+
+	// When the rule was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// When the rule was last modified.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+}
+
+// A SamplingRuleStatus represents the observed state of a SamplingRule.
+type SamplingRuleStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SamplingRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SamplingRule is a managed resource that represents an AWS X-Ray sampling
+// rule. The external name of the resource is used as the rule name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SamplingRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SamplingRuleSpec   `json:"spec"`
+	Status SamplingRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SamplingRuleList contains a list of SamplingRules
+type SamplingRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SamplingRule `json:"items"`
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// XRayGroupParameters define the desired state of an AWS X-Ray group.
+type XRayGroupParameters struct {
+	// The filter expression defining criteria by which to group traces.
+	// +optional
+	FilterExpression *string `json:"filterExpression,omitempty"`
+}
+
+// An XRayGroupSpec defines the desired state of an XRayGroup.
+type XRayGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  XRayGroupParameters `json:"forProvider,omitempty"`
+}
+
+// XRayGroupObservation keeps the state for the external resource
+type XRayGroupObservation struct {
+	// The ARN of the group generated based on the GroupName.
+	GroupARN string `json:"groupArn,omitempty"`
+}
+
+// An XRayGroupStatus represents the observed state of an XRayGroup.
+type XRayGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     XRayGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An XRayGroup is a managed resource that represents an AWS X-Ray group. The
+// external name of the resource is used as the group name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.groupArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type XRayGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   XRayGroupSpec   `json:"spec"`
+	Status XRayGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// XRayGroupList contains a list of XRayGroups
+type XRayGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []XRayGroup `json:"items"`
+}
@@ -0,0 +1,61 @@
+#!/usr/bin/env bash
+set -e
+
+# Runs the e2e tests of the managed resource controllers, which are guarded by
+# the e2e build tag, against LocalStack. Set E2E_AWS_ENDPOINT to use a running
+# AWS compatible endpoint instead, or E2E_AWS_CREDENTIALS without
+# E2E_AWS_ENDPOINT to run the tests against AWS itself.
+
+# setting up colors
+BLU='\033[0;34m'
+GRN='\033[0;32m'
+RED='\033[0;31m'
+NOC='\033[0m' # No Color
+echo_step(){
+    printf "\n${BLU}>>>>>>> %s${NOC}\n" "$1"
+}
+echo_success(){
+    printf "\n${GRN}%s${NOC}\n" "$1"
+}
+echo_error(){
+    printf "\n${RED}%s${NOC}" "$1"
+    exit 1
+}
+
+scriptdir="$( cd "$( dirname "${BASH_SOURCE[0]}" )" && pwd )"
+projectdir="$( cd "${scriptdir}"/../.. && pwd )"
+
+LOCALSTACK_IMAGE="${LOCALSTACK_IMAGE:-localstack/localstack:0.11.3}"
+LOCALSTACK_PORT="${LOCALSTACK_PORT:-4566}"
+E2E_PACKAGES="${E2E_PACKAGES:-./pkg/...}"
+E2E_TEST_TIMEOUT="${E2E_TEST_TIMEOUT:-60m}"
+
+if [ -z "${E2E_AWS_ENDPOINT}" ] && [ -z "${E2E_AWS_CREDENTIALS}" ]; then
+  container="provider-aws-e2e-localstack"
+
+  function cleanup {
+    echo_step "stopping LocalStack"
+    docker rm -f "${container}" >/dev/null
+  }
+  trap cleanup EXIT
+
+  echo_step "starting LocalStack ${LOCALSTACK_IMAGE}"
+  docker run -d --name "${container}" -p "${LOCALSTACK_PORT}:4566" -e USE_SSL=1 "${LOCALSTACK_IMAGE}" >/dev/null
+
+  export E2E_AWS_ENDPOINT="https://localhost:${LOCALSTACK_PORT}"
+
+  counter=0
+  echo -n "waiting for LocalStack to become ready..." >&2
+  until curl -ksf "${E2E_AWS_ENDPOINT}/health" >/dev/null; do
+    if [ "$counter" -ge 120 ]; then echo_error "TIMEOUT"; fi
+    (( counter+=5 ))
+    echo -n "." >&2
+    sleep 5
+  done
+fi
+
+echo_step "running e2e tests against ${E2E_AWS_ENDPOINT:-AWS}"
+cd "${projectdir}"
+go test -tags e2e -count 1 -timeout "${E2E_TEST_TIMEOUT}" ${E2E_PACKAGES}
+
+echo_success "e2e tests passed"
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/external"
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/importer"
+)
+
+func main() {
+	var (
+		app      = kingpin.New(filepath.Base(os.Args[0]), "Generate AWS managed resources for the existing resources of an AWS account. Credentials are read from the environment or the shared AWS configuration files.").DefaultEnvars()
+		region   = app.Flag("region", "Region whose resources are imported.").Required().String()
+		profile  = app.Flag("profile", "Profile of the shared AWS configuration files whose credentials are used.").String()
+		provider = app.Flag("provider", "Name of the Provider that the generated managed resources use.").Required().String()
+		kinds    = app.Flag("kinds", "Kinds of managed resources to generate, such as VPC or IAMRole. All supported kinds are generated if none are supplied. May be repeated or comma separated. Supported kinds are "+strings.Join(importer.Kinds(), ", ")+".").Strings()
+		policy   = app.Flag("reclaim-policy", "Reclaim policy of the generated managed resources.").Default(string(runtimev1alpha1.ReclaimRetain)).Enum(string(runtimev1alpha1.ReclaimRetain), string(runtimev1alpha1.ReclaimDelete))
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	kingpin.FatalIfError(awsclients.ValidateRegion(*region), "Invalid region")
+
+	cfgs := []external.Config{external.WithRegion(*region)}
+	if *profile != "" {
+		cfgs = append(cfgs, external.WithSharedConfigProfile(*profile))
+	}
+	cfg, err := external.LoadDefaultAWSConfig(cfgs...)
+	kingpin.FatalIfError(err, "Cannot load AWS configuration")
+
+	k := splitFlag(*kinds)
+	if len(k) == 0 {
+		k = importer.Kinds()
+	}
+
+	mgs, err := importer.Import(context.Background(), cfg, k, importer.Options{
+		ProviderName:  *provider,
+		ReclaimPolicy: runtimev1alpha1.ReclaimPolicy(*policy),
+	})
+	kingpin.FatalIfError(err, "Cannot import resources")
+	kingpin.FatalIfError(importer.Write(os.Stdout, mgs), "Cannot write managed resources")
+}
+
+// splitFlag splits values of a repeatable flag that may also be comma
+// separated.
+func splitFlag(values []string) []string {
+	out := []string{}
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
@@ -17,18 +17,29 @@ limitations under the License.
 package main
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"gopkg.in/alecthomas/kingpin.v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	crossplaneapis "github.com/crossplane/crossplane/apis"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/crossplane/provider-aws/apis"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
 	"github.com/crossplane/provider-aws/pkg/controller"
+	"github.com/crossplane/provider-aws/pkg/controller/events"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 func main() {
@@ -36,6 +47,30 @@ func main() {
 		app        = kingpin.New(filepath.Base(os.Args[0]), "AWS support for Crossplane.").DefaultEnvars()
 		debug      = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod = app.Flag("sync", "Controller manager sync period duration such as 300ms, 1.5h or 2h45m").Short('s').Default("1h").Duration()
+		probeAddr  = app.Flag("health-probe-bind-address", "Address at which the /healthz and /readyz endpoints are served.").Default(":8081").String()
+		enabled    = app.Flag("enable-controllers", "API groups whose controllers are started, such as ec2 or identity. All API groups are enabled if none are supplied. May be repeated or comma separated.").Strings()
+		disabled   = app.Flag("disable-controllers", "API groups whose controllers are not started. May be repeated or comma separated.").Strings()
+		ec2Cache   = app.Flag("ec2-describe-cache-ttl", "Observe Subnets, SecurityGroups and RouteTables from one paginated Describe call per provider that is refreshed at this interval, such as 1m. Disabled when zero.").Default("0s").Duration()
+		driftMode  = app.Flag("drift-mode", "How differences between managed resources and their external resources are handled, unless a managed resource sets the "+drift.AnnotationKeyMode+" annotation. Enforce corrects them, Report only records them in DriftReports. Report is read-only: external resources are never created, updated or deleted, and deleting a managed resource orphans its external resource regardless of its deletion policy.").Default(string(drift.ModeEnforce)).Enum(string(drift.ModeEnforce), string(drift.ModeReport))
+
+		leaderElection = app.Flag("leader-election", "Use leader election for the controller manager.").Short('l').Default("false").Bool()
+		shards         = app.Flag("shards", "Number of shards that managed resources are partitioned into. Each shard must be served by its own provider replica. Controllers that do not reconcile managed resources, such as those that bind resource claims, run only in shard 0.").Default("1").Uint32()
+		shardIndex     = app.Flag("shard-index", "Index of the shard served by this replica, from 0 to the number of shards minus 1.").Default("0").Uint32()
+
+		eventQueueURL      = app.Flag("event-queue-url", "URL of an SQS queue that an EventBridge rule delivers CloudTrail events to. Managed resources whose external resources the events mention are reconciled immediately. Disabled when empty. Each shard needs its own queue.").String()
+		eventQueueProvider = app.Flag("event-queue-provider", "Name of the Provider whose credentials and region are used to receive events from the event queue.").String()
+
+		rateLimitBaseDelay = app.Flag("rate-limit-base-delay", "Delay before a managed resource whose reconcile failed is retried. The delay doubles with each consecutive failure.").Default(ratelimit.DefaultOptions.BaseDelay.String()).Duration()
+		rateLimitMaxDelay  = app.Flag("rate-limit-max-delay", "Longest delay before a managed resource whose reconcile failed is retried.").Default(ratelimit.DefaultOptions.MaxDelay.String()).Duration()
+		rateLimitJitter    = app.Flag("rate-limit-jitter", "Fraction between 0 and 1 by which each retry delay is randomly lengthened, so that resources that failed together are not retried together.").Default(fmt.Sprint(ratelimit.DefaultOptions.Jitter)).Float64()
+		rateLimitQPS       = app.Flag("rate-limit-qps", "Retries per second of each controller.").Default(fmt.Sprint(ratelimit.DefaultOptions.QPS)).Float64()
+		rateLimitBucket    = app.Flag("rate-limit-bucket-size", "Retries each controller may burst to above its retries per second.").Default(fmt.Sprint(ratelimit.DefaultOptions.BucketSize)).Int()
+		rateLimitOverrides = app.Flag("rate-limit-override", "Rate limit of a single controller, such as managed/vpc.ec2.aws.crossplane.io=max-delay=5m,qps=2. Controllers are named by their type, such as managed, claimbinding or target, and the lowercase kind and API group they are named after; unknown controllers are rejected. Keys are base-delay, max-delay, jitter, qps and bucket-size; others are taken from the rate-limit flags. May be repeated.").StringMap()
+
+		propagationWindow    = app.Flag("propagation-window", "How long after a managed resource was created the AWS API may report it as not found before it is created again. Managed resources report that they are within this window with the "+string(propagation.TypePropagating)+" condition. Disabled when zero.").Default(propagation.DefaultWindow.String()).Duration()
+		propagationOverrides = app.Flag("propagation-window-override", "Propagation window of a single API group, such as identity=5m. The identity API group, whose IAM API is eventually consistent, defaults to "+propagation.WindowFor("identity").String()+". May be repeated.").StringMap()
+
+		resourceMetrics = app.Flag("resource-metrics", "Record when each managed resource was last synced in a metric labelled with its name. There is one series per managed resource, which may be too many to scrape.").Default("false").Bool()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -48,17 +83,83 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
-	log.Debug("Starting", "sync-period", syncPeriod.String())
+	ec2.DefaultDescribeCache.TTL = *ec2Cache
+	drift.DefaultMode = drift.Mode(*driftMode)
+	metrics.PerResource = *resourceMetrics
+
+	ratelimit.DefaultOptions = ratelimit.Options{
+		BaseDelay:  *rateLimitBaseDelay,
+		MaxDelay:   *rateLimitMaxDelay,
+		Jitter:     *rateLimitJitter,
+		QPS:        *rateLimitQPS,
+		BucketSize: *rateLimitBucket,
+	}
+	kingpin.FatalIfError(ratelimit.DefaultOptions.Validate(), "Invalid rate limit")
+
+	propagation.DefaultWindow = *propagationWindow
+	for group, w := range *propagationOverrides {
+		d, err := time.ParseDuration(w)
+		kingpin.FatalIfError(err, "Invalid propagation window of API group %s", group)
+		propagation.Windows[group] = d
+	}
+
+	s := shard.Shard{Index: *shardIndex, Total: *shards}
+	kingpin.FatalIfError(s.Validate(), "Invalid sharding configuration")
+
+	log.Debug("Starting", "sync-period", syncPeriod.String(), "shard", s.Index, "shards", s.Total, "rate-limit", ratelimit.DefaultOptions.String())
 
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
-	mgr, err := ctrl.NewManager(cfg, ctrl.Options{SyncPeriod: syncPeriod})
+	// Replicas serving the same shard compete for the same lease, so that each
+	// shard has exactly one active replica.
+	electionID := "crossplane-leader-election-provider-aws"
+	if s.Total > 1 {
+		electionID = fmt.Sprintf("%s-shard-%d", electionID, s.Index)
+	}
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		SyncPeriod:             syncPeriod,
+		HealthProbeBindAddress: *probeAddr,
+		LeaderElection:         *leaderElection,
+		LeaderElectionID:       electionID,
+		NewCache:               shard.NewCacheFunc(s),
+	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
 	kingpin.FatalIfError(crossplaneapis.AddToScheme(mgr.GetScheme()), "Cannot add core Crossplane APIs to scheme")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
-	kingpin.FatalIfError(controller.Setup(mgr, log), "Cannot setup AWS controllers")
+	ratelimit.Overrides, err = ratelimit.ParseOverrides(*rateLimitOverrides, ratelimit.DefaultOptions, mgr.GetScheme())
+	kingpin.FatalIfError(err, "Invalid rate limit override")
+	include, err := controller.NewGroupFilter(splitFlag(*enabled), splitFlag(*disabled))
+	kingpin.FatalIfError(err, "Invalid controller selection")
+	kingpin.FatalIfError(controller.Setup(mgr, log, include, s), "Cannot setup AWS controllers")
+	if *eventQueueURL != "" {
+		if *eventQueueProvider == "" {
+			kingpin.Fatalf("--event-queue-provider is required when --event-queue-url is set")
+		}
+		kingpin.FatalIfError(events.Setup(mgr, log, *eventQueueURL, *eventQueueProvider, include), "Cannot setup event queue")
+	}
+
+	// The readiness check reads Providers and their Secrets directly from the
+	// API server rather than from the cache, which is only started once the
+	// manager runs.
+	kingpin.FatalIfError(mgr.AddHealthzCheck("ping", healthz.Ping), "Cannot add liveness check")
+	kingpin.FatalIfError(mgr.AddReadyzCheck("credentials", controller.ProviderCredentialsCheck(mgr.GetAPIReader())), "Cannot add readiness check")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 
 }
+
+// splitFlag splits values of a repeatable flag that may also be comma
+// separated.
+func splitFlag(values []string) []string {
+	out := []string{}
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
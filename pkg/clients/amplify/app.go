@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amplify
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AppClient is the external client used for App Custom Resource
+type AppClient interface {
+	CreateAppRequest(*amplify.CreateAppInput) amplify.CreateAppRequest
+	GetAppRequest(*amplify.GetAppInput) amplify.GetAppRequest
+	UpdateAppRequest(*amplify.UpdateAppInput) amplify.UpdateAppRequest
+	DeleteAppRequest(*amplify.DeleteAppInput) amplify.DeleteAppRequest
+}
+
+// NewAppClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewAppClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (AppClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return amplify.New(*cfg), err
+}
+
+func generateCustomRules(rules []v1alpha1.CustomRule) []amplify.CustomRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	res := make([]amplify.CustomRule, len(rules))
+	for i, r := range rules {
+		res[i] = amplify.CustomRule{
+			Source:    aws.String(r.Source),
+			Target:    aws.String(r.Target),
+			Status:    r.Status,
+			Condition: r.Condition,
+		}
+	}
+	return res
+}
+
+// GenerateCreateAppInput returns the input to create an app with the
+// supplied parameters and repository tokens.
+func GenerateCreateAppInput(p v1alpha1.AppParameters, accessToken, oauthToken *string) *amplify.CreateAppInput {
+	in := &amplify.CreateAppInput{
+		Name:                       aws.String(p.Name),
+		Description:                p.Description,
+		Repository:                 p.Repository,
+		AccessToken:                accessToken,
+		OauthToken:                 oauthToken,
+		BuildSpec:                  p.BuildSpec,
+		EnvironmentVariables:       p.EnvironmentVariables,
+		CustomRules:                generateCustomRules(p.CustomRules),
+		EnableBranchAutoBuild:      p.EnableBranchAutoBuild,
+		EnableAutoBranchCreation:   p.EnableAutoBranchCreation,
+		AutoBranchCreationPatterns: p.AutoBranchCreationPatterns,
+		IamServiceRoleArn:          p.IAMServiceRoleARN,
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = p.Tags
+	}
+	return in
+}
+
+// GenerateUpdateAppInput returns the input to update the app with the
+// supplied ID to the desired parameters.
+func GenerateUpdateAppInput(id string, p v1alpha1.AppParameters) *amplify.UpdateAppInput {
+	return &amplify.UpdateAppInput{
+		AppId:                      aws.String(id),
+		Name:                       aws.String(p.Name),
+		Description:                p.Description,
+		Repository:                 p.Repository,
+		BuildSpec:                  p.BuildSpec,
+		EnvironmentVariables:       p.EnvironmentVariables,
+		CustomRules:                generateCustomRules(p.CustomRules),
+		EnableBranchAutoBuild:      p.EnableBranchAutoBuild,
+		EnableAutoBranchCreation:   p.EnableAutoBranchCreation,
+		AutoBranchCreationPatterns: p.AutoBranchCreationPatterns,
+		IamServiceRoleArn:          p.IAMServiceRoleARN,
+	}
+}
+
+// LateInitializeApp fills the empty fields in the supplied parameters with
+// the values observed on the app.
+func LateInitializeApp(p *v1alpha1.AppParameters, app amplify.App) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, app.Description)
+	p.Repository = awsclients.LateInitializeStringPtr(p.Repository, app.Repository)
+	p.BuildSpec = awsclients.LateInitializeStringPtr(p.BuildSpec, app.BuildSpec)
+	p.EnableBranchAutoBuild = awsclients.LateInitializeBoolPtr(p.EnableBranchAutoBuild, app.EnableBranchAutoBuild)
+	p.EnableAutoBranchCreation = awsclients.LateInitializeBoolPtr(p.EnableAutoBranchCreation, app.EnableAutoBranchCreation)
+}
+
+// GenerateAppObservation returns the observation of the supplied app.
+func GenerateAppObservation(app amplify.App) v1alpha1.AppObservation {
+	return v1alpha1.AppObservation{
+		AppARN:        aws.StringValue(app.AppArn),
+		DefaultDomain: aws.StringValue(app.DefaultDomain),
+	}
+}
+
+// IsAppUpToDate returns true if the supplied app matches the desired
+// parameters.
+func IsAppUpToDate(p v1alpha1.AppParameters, app amplify.App) bool {
+	desired := GenerateUpdateAppInput(aws.StringValue(app.AppId), p)
+	observed := &amplify.UpdateAppInput{
+		AppId:                      app.AppId,
+		Name:                       app.Name,
+		Description:                app.Description,
+		Repository:                 app.Repository,
+		BuildSpec:                  app.BuildSpec,
+		EnvironmentVariables:       app.EnvironmentVariables,
+		CustomRules:                app.CustomRules,
+		EnableBranchAutoBuild:      app.EnableBranchAutoBuild,
+		EnableAutoBranchCreation:   app.EnableAutoBranchCreation,
+		AutoBranchCreationPatterns: app.AutoBranchCreationPatterns,
+		IamServiceRoleArn:          app.IamServiceRoleArn,
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(amplify.UpdateAppInput{}, amplify.CustomRule{}))
+}
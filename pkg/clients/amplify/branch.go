@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amplify
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// BranchClient is the external client used for Branch Custom Resource
+type BranchClient interface {
+	CreateBranchRequest(*amplify.CreateBranchInput) amplify.CreateBranchRequest
+	GetBranchRequest(*amplify.GetBranchInput) amplify.GetBranchRequest
+	UpdateBranchRequest(*amplify.UpdateBranchInput) amplify.UpdateBranchRequest
+	DeleteBranchRequest(*amplify.DeleteBranchInput) amplify.DeleteBranchRequest
+}
+
+// NewBranchClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewBranchClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (BranchClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return amplify.New(*cfg), err
+}
+
+// GenerateCreateBranchInput returns the input to create a branch with the
+// supplied name and parameters.
+func GenerateCreateBranchInput(name string, p v1alpha1.BranchParameters) *amplify.CreateBranchInput {
+	in := &amplify.CreateBranchInput{
+		AppId:                    p.AppID,
+		BranchName:               aws.String(name),
+		Description:              p.Description,
+		Stage:                    amplify.Stage(aws.StringValue(p.Stage)),
+		Framework:                p.Framework,
+		BuildSpec:                p.BuildSpec,
+		EnvironmentVariables:     p.EnvironmentVariables,
+		EnableAutoBuild:          p.EnableAutoBuild,
+		EnablePullRequestPreview: p.EnablePullRequestPreview,
+		EnableNotification:       p.EnableNotification,
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = p.Tags
+	}
+	return in
+}
+
+// GenerateUpdateBranchInput returns the input to update the branch with the
+// supplied name to the desired parameters.
+func GenerateUpdateBranchInput(name string, p v1alpha1.BranchParameters) *amplify.UpdateBranchInput {
+	return &amplify.UpdateBranchInput{
+		AppId:                    p.AppID,
+		BranchName:               aws.String(name),
+		Description:              p.Description,
+		Stage:                    amplify.Stage(aws.StringValue(p.Stage)),
+		Framework:                p.Framework,
+		BuildSpec:                p.BuildSpec,
+		EnvironmentVariables:     p.EnvironmentVariables,
+		EnableAutoBuild:          p.EnableAutoBuild,
+		EnablePullRequestPreview: p.EnablePullRequestPreview,
+		EnableNotification:       p.EnableNotification,
+	}
+}
+
+// LateInitializeBranch fills the empty fields in the supplied parameters
+// with the values observed on the branch.
+func LateInitializeBranch(p *v1alpha1.BranchParameters, b amplify.Branch) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, b.Description)
+	if p.Stage == nil && b.Stage != "" {
+		p.Stage = aws.String(string(b.Stage))
+	}
+	p.Framework = awsclients.LateInitializeStringPtr(p.Framework, b.Framework)
+	p.BuildSpec = awsclients.LateInitializeStringPtr(p.BuildSpec, b.BuildSpec)
+	p.EnableAutoBuild = awsclients.LateInitializeBoolPtr(p.EnableAutoBuild, b.EnableAutoBuild)
+	p.EnablePullRequestPreview = awsclients.LateInitializeBoolPtr(p.EnablePullRequestPreview, b.EnablePullRequestPreview)
+	p.EnableNotification = awsclients.LateInitializeBoolPtr(p.EnableNotification, b.EnableNotification)
+}
+
+// GenerateBranchObservation returns the observation of the supplied branch.
+func GenerateBranchObservation(b amplify.Branch) v1alpha1.BranchObservation {
+	return v1alpha1.BranchObservation{
+		BranchARN:     aws.StringValue(b.BranchArn),
+		ActiveJobID:   aws.StringValue(b.ActiveJobId),
+		CustomDomains: b.CustomDomains,
+	}
+}
+
+// IsBranchUpToDate returns true if the supplied branch matches the desired
+// parameters.
+func IsBranchUpToDate(p v1alpha1.BranchParameters, b amplify.Branch) bool {
+	desired := GenerateUpdateBranchInput(aws.StringValue(b.BranchName), p)
+	observed := &amplify.UpdateBranchInput{
+		AppId:                    p.AppID,
+		BranchName:               b.BranchName,
+		Description:              b.Description,
+		Stage:                    b.Stage,
+		Framework:                b.Framework,
+		BuildSpec:                b.BuildSpec,
+		EnvironmentVariables:     b.EnvironmentVariables,
+		EnableAutoBuild:          b.EnableAutoBuild,
+		EnablePullRequestPreview: b.EnablePullRequestPreview,
+		EnableNotification:       b.EnableNotification,
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(amplify.UpdateBranchInput{}))
+}
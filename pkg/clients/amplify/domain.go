@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amplify
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DomainClient is the external client used for Domain Custom Resource
+type DomainClient interface {
+	CreateDomainAssociationRequest(*amplify.CreateDomainAssociationInput) amplify.CreateDomainAssociationRequest
+	GetDomainAssociationRequest(*amplify.GetDomainAssociationInput) amplify.GetDomainAssociationRequest
+	UpdateDomainAssociationRequest(*amplify.UpdateDomainAssociationInput) amplify.UpdateDomainAssociationRequest
+	DeleteDomainAssociationRequest(*amplify.DeleteDomainAssociationInput) amplify.DeleteDomainAssociationRequest
+}
+
+// NewDomainClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewDomainClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DomainClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return amplify.New(*cfg), err
+}
+
+func generateSubDomainSettings(settings []v1alpha1.SubDomainSetting) []amplify.SubDomainSetting {
+	res := make([]amplify.SubDomainSetting, len(settings))
+	for i, s := range settings {
+		res[i] = amplify.SubDomainSetting{
+			Prefix:     aws.String(s.Prefix),
+			BranchName: aws.String(s.BranchName),
+		}
+	}
+	return res
+}
+
+// GenerateCreateDomainAssociationInput returns the input to associate the
+// supplied domain with an app.
+func GenerateCreateDomainAssociationInput(domain string, p v1alpha1.DomainParameters) *amplify.CreateDomainAssociationInput {
+	return &amplify.CreateDomainAssociationInput{
+		AppId:               p.AppID,
+		DomainName:          aws.String(domain),
+		SubDomainSettings:   generateSubDomainSettings(p.SubDomainSettings),
+		EnableAutoSubDomain: p.EnableAutoSubDomain,
+	}
+}
+
+// GenerateUpdateDomainAssociationInput returns the input to update the
+// association of the supplied domain to the desired parameters.
+func GenerateUpdateDomainAssociationInput(domain string, p v1alpha1.DomainParameters) *amplify.UpdateDomainAssociationInput {
+	return &amplify.UpdateDomainAssociationInput{
+		AppId:               p.AppID,
+		DomainName:          aws.String(domain),
+		SubDomainSettings:   generateSubDomainSettings(p.SubDomainSettings),
+		EnableAutoSubDomain: p.EnableAutoSubDomain,
+	}
+}
+
+// LateInitializeDomain fills the empty fields in the supplied parameters
+// with the values observed on the domain association.
+func LateInitializeDomain(p *v1alpha1.DomainParameters, d amplify.DomainAssociation) {
+	p.EnableAutoSubDomain = awsclients.LateInitializeBoolPtr(p.EnableAutoSubDomain, d.EnableAutoSubDomain)
+}
+
+// GenerateDomainObservation returns the observation of the supplied domain
+// association.
+func GenerateDomainObservation(d amplify.DomainAssociation) v1alpha1.DomainObservation {
+	o := v1alpha1.DomainObservation{
+		DomainAssociationARN:             aws.StringValue(d.DomainAssociationArn),
+		DomainStatus:                     string(d.DomainStatus),
+		StatusReason:                     aws.StringValue(d.StatusReason),
+		CertificateVerificationDNSRecord: aws.StringValue(d.CertificateVerificationDNSRecord),
+	}
+	for _, s := range d.SubDomains {
+		sd := v1alpha1.SubDomain{
+			DNSRecord: aws.StringValue(s.DnsRecord),
+			Verified:  aws.BoolValue(s.Verified),
+		}
+		if s.SubDomainSetting != nil {
+			sd.Prefix = aws.StringValue(s.SubDomainSetting.Prefix)
+			sd.BranchName = aws.StringValue(s.SubDomainSetting.BranchName)
+		}
+		o.SubDomains = append(o.SubDomains, sd)
+	}
+	return o
+}
+
+// IsDomainUpToDate returns true if the supplied domain association matches
+// the desired parameters. The order of the subdomain settings is ignored.
+func IsDomainUpToDate(p v1alpha1.DomainParameters, d amplify.DomainAssociation) bool {
+	if aws.BoolValue(p.EnableAutoSubDomain) != aws.BoolValue(d.EnableAutoSubDomain) {
+		return false
+	}
+	desired := make([]v1alpha1.SubDomainSetting, len(p.SubDomainSettings))
+	copy(desired, p.SubDomainSettings)
+	observed := make([]v1alpha1.SubDomainSetting, 0, len(d.SubDomains))
+	for _, s := range d.SubDomains {
+		if s.SubDomainSetting == nil {
+			continue
+		}
+		observed = append(observed, v1alpha1.SubDomainSetting{
+			Prefix:     aws.StringValue(s.SubDomainSetting.Prefix),
+			BranchName: aws.StringValue(s.SubDomainSetting.BranchName),
+		})
+	}
+	less := func(s []v1alpha1.SubDomainSetting) func(i, j int) bool {
+		return func(i, j int) bool { return s[i].Prefix < s[j].Prefix }
+	}
+	sort.Slice(desired, less(desired))
+	sort.Slice(observed, less(observed))
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty())
+}
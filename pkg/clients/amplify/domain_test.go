@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amplify
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+)
+
+func TestIsDomainUpToDate(t *testing.T) {
+	params := v1alpha1.DomainParameters{
+		AppID: aws.String("app"),
+		SubDomainSettings: []v1alpha1.SubDomainSetting{
+			{Prefix: "www", BranchName: "main"},
+			{Prefix: "dev", BranchName: "develop"},
+		},
+	}
+	observed := amplify.DomainAssociation{
+		DomainName:          aws.String("example.com"),
+		EnableAutoSubDomain: aws.Bool(false),
+		SubDomains: []amplify.SubDomain{
+			{SubDomainSetting: &amplify.SubDomainSetting{Prefix: aws.String("dev"), BranchName: aws.String("develop")}},
+			{SubDomainSetting: &amplify.SubDomainSetting{Prefix: aws.String("www"), BranchName: aws.String("main")}},
+		},
+	}
+
+	cases := map[string]struct {
+		params func(*v1alpha1.DomainParameters)
+		want   bool
+	}{
+		"UpToDate": {
+			params: func(*v1alpha1.DomainParameters) {},
+			want:   true,
+		},
+		"BranchChanged": {
+			params: func(p *v1alpha1.DomainParameters) { p.SubDomainSettings[1].BranchName = "main" },
+			want:   false,
+		},
+		"SubDomainRemoved": {
+			params: func(p *v1alpha1.DomainParameters) { p.SubDomainSettings = p.SubDomainSettings[:1] },
+			want:   false,
+		},
+		"AutoSubDomainEnabled": {
+			params: func(p *v1alpha1.DomainParameters) { p.EnableAutoSubDomain = aws.Bool(true) },
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := *params.DeepCopy()
+			tc.params(&p)
+			if diff := cmp.Diff(tc.want, IsDomainUpToDate(p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/amplify"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AppClient = (*MockAppClient)(nil)
+
+// MockAppClient is a type that implements all the methods for AppClient interface
+type MockAppClient struct {
+	MockCreateApp func(*amplify.CreateAppInput) amplify.CreateAppRequest
+	MockGetApp    func(*amplify.GetAppInput) amplify.GetAppRequest
+	MockUpdateApp func(*amplify.UpdateAppInput) amplify.UpdateAppRequest
+	MockDeleteApp func(*amplify.DeleteAppInput) amplify.DeleteAppRequest
+}
+
+// CreateAppRequest calls the underlying MockCreateApp method.
+func (c *MockAppClient) CreateAppRequest(i *amplify.CreateAppInput) amplify.CreateAppRequest {
+	return c.MockCreateApp(i)
+}
+
+// GetAppRequest calls the underlying MockGetApp method.
+func (c *MockAppClient) GetAppRequest(i *amplify.GetAppInput) amplify.GetAppRequest {
+	return c.MockGetApp(i)
+}
+
+// UpdateAppRequest calls the underlying MockUpdateApp method.
+func (c *MockAppClient) UpdateAppRequest(i *amplify.UpdateAppInput) amplify.UpdateAppRequest {
+	return c.MockUpdateApp(i)
+}
+
+// DeleteAppRequest calls the underlying MockDeleteApp method.
+func (c *MockAppClient) DeleteAppRequest(i *amplify.DeleteAppInput) amplify.DeleteAppRequest {
+	return c.MockDeleteApp(i)
+}
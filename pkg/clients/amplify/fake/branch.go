@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/amplify"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BranchClient = (*MockBranchClient)(nil)
+
+// MockBranchClient is a type that implements all the methods for BranchClient interface
+type MockBranchClient struct {
+	MockCreateBranch func(*amplify.CreateBranchInput) amplify.CreateBranchRequest
+	MockGetBranch    func(*amplify.GetBranchInput) amplify.GetBranchRequest
+	MockUpdateBranch func(*amplify.UpdateBranchInput) amplify.UpdateBranchRequest
+	MockDeleteBranch func(*amplify.DeleteBranchInput) amplify.DeleteBranchRequest
+}
+
+// CreateBranchRequest calls the underlying MockCreateBranch method.
+func (c *MockBranchClient) CreateBranchRequest(i *amplify.CreateBranchInput) amplify.CreateBranchRequest {
+	return c.MockCreateBranch(i)
+}
+
+// GetBranchRequest calls the underlying MockGetBranch method.
+func (c *MockBranchClient) GetBranchRequest(i *amplify.GetBranchInput) amplify.GetBranchRequest {
+	return c.MockGetBranch(i)
+}
+
+// UpdateBranchRequest calls the underlying MockUpdateBranch method.
+func (c *MockBranchClient) UpdateBranchRequest(i *amplify.UpdateBranchInput) amplify.UpdateBranchRequest {
+	return c.MockUpdateBranch(i)
+}
+
+// DeleteBranchRequest calls the underlying MockDeleteBranch method.
+func (c *MockBranchClient) DeleteBranchRequest(i *amplify.DeleteBranchInput) amplify.DeleteBranchRequest {
+	return c.MockDeleteBranch(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/amplify"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DomainClient = (*MockDomainClient)(nil)
+
+// MockDomainClient is a type that implements all the methods for DomainClient interface
+type MockDomainClient struct {
+	MockCreateDomainAssociation func(*amplify.CreateDomainAssociationInput) amplify.CreateDomainAssociationRequest
+	MockGetDomainAssociation    func(*amplify.GetDomainAssociationInput) amplify.GetDomainAssociationRequest
+	MockUpdateDomainAssociation func(*amplify.UpdateDomainAssociationInput) amplify.UpdateDomainAssociationRequest
+	MockDeleteDomainAssociation func(*amplify.DeleteDomainAssociationInput) amplify.DeleteDomainAssociationRequest
+}
+
+// CreateDomainAssociationRequest calls the underlying MockCreateDomainAssociation method.
+func (c *MockDomainClient) CreateDomainAssociationRequest(i *amplify.CreateDomainAssociationInput) amplify.CreateDomainAssociationRequest {
+	return c.MockCreateDomainAssociation(i)
+}
+
+// GetDomainAssociationRequest calls the underlying MockGetDomainAssociation method.
+func (c *MockDomainClient) GetDomainAssociationRequest(i *amplify.GetDomainAssociationInput) amplify.GetDomainAssociationRequest {
+	return c.MockGetDomainAssociation(i)
+}
+
+// UpdateDomainAssociationRequest calls the underlying MockUpdateDomainAssociation method.
+func (c *MockDomainClient) UpdateDomainAssociationRequest(i *amplify.UpdateDomainAssociationInput) amplify.UpdateDomainAssociationRequest {
+	return c.MockUpdateDomainAssociation(i)
+}
+
+// DeleteDomainAssociationRequest calls the underlying MockDeleteDomainAssociation method.
+func (c *MockDomainClient) DeleteDomainAssociationRequest(i *amplify.DeleteDomainAssociationInput) amplify.DeleteDomainAssociationRequest {
+	return c.MockDeleteDomainAssociation(i)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+
+	"github.com/crossplane/provider-aws/apis/appconfig/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ApplicationClient is the external client used for Application Custom
+// Resource
+type ApplicationClient interface {
+	CreateApplicationRequest(*appconfig.CreateApplicationInput) appconfig.CreateApplicationRequest
+	GetApplicationRequest(*appconfig.GetApplicationInput) appconfig.GetApplicationRequest
+	UpdateApplicationRequest(*appconfig.UpdateApplicationInput) appconfig.UpdateApplicationRequest
+	DeleteApplicationRequest(*appconfig.DeleteApplicationInput) appconfig.DeleteApplicationRequest
+}
+
+// NewApplicationClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewApplicationClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ApplicationClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return appconfig.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the AppConfig resource
+// doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == appconfig.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateApplicationInput returns the input to create an application
+// from the supplied parameters.
+func GenerateCreateApplicationInput(p v1alpha1.ApplicationParameters) *appconfig.CreateApplicationInput {
+	return &appconfig.CreateApplicationInput{
+		Name:        aws.String(p.Name),
+		Description: p.Description,
+		Tags:        p.Tags,
+	}
+}
+
+// GenerateUpdateApplicationInput returns the input to update the application
+// with the given ID from the supplied parameters.
+func GenerateUpdateApplicationInput(id string, p v1alpha1.ApplicationParameters) *appconfig.UpdateApplicationInput {
+	return &appconfig.UpdateApplicationInput{
+		ApplicationId: aws.String(id),
+		Name:          aws.String(p.Name),
+		Description:   p.Description,
+	}
+}
+
+// LateInitializeApplication fills the empty fields in
+// *v1alpha1.ApplicationParameters with the values seen in
+// appconfig.GetApplicationOutput.
+func LateInitializeApplication(in *v1alpha1.ApplicationParameters, o *appconfig.GetApplicationOutput) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+}
+
+// IsApplicationUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsApplicationUpToDate(p v1alpha1.ApplicationParameters, o appconfig.GetApplicationOutput) bool {
+	return p.Name == aws.StringValue(o.Name) &&
+		aws.StringValue(p.Description) == aws.StringValue(o.Description)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/appconfig/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ConfigurationProfileClient is the external client used for
+// ConfigurationProfile Custom Resource
+type ConfigurationProfileClient interface {
+	CreateConfigurationProfileRequest(*appconfig.CreateConfigurationProfileInput) appconfig.CreateConfigurationProfileRequest
+	GetConfigurationProfileRequest(*appconfig.GetConfigurationProfileInput) appconfig.GetConfigurationProfileRequest
+	UpdateConfigurationProfileRequest(*appconfig.UpdateConfigurationProfileInput) appconfig.UpdateConfigurationProfileRequest
+	DeleteConfigurationProfileRequest(*appconfig.DeleteConfigurationProfileInput) appconfig.DeleteConfigurationProfileRequest
+}
+
+// NewConfigurationProfileClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewConfigurationProfileClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ConfigurationProfileClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return appconfig.New(*cfg), err
+}
+
+func generateValidators(in []v1alpha1.Validator) []appconfig.Validator {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]appconfig.Validator, len(in))
+	for i, v := range in {
+		out[i] = appconfig.Validator{Content: aws.String(v.Content), Type: appconfig.ValidatorType(v.Type)}
+	}
+	return out
+}
+
+// GenerateCreateConfigurationProfileInput returns the input to create a
+// configuration profile from the supplied parameters.
+func GenerateCreateConfigurationProfileInput(p v1alpha1.ConfigurationProfileParameters) *appconfig.CreateConfigurationProfileInput {
+	return &appconfig.CreateConfigurationProfileInput{
+		ApplicationId:    p.ApplicationID,
+		Name:             aws.String(p.Name),
+		Description:      p.Description,
+		LocationUri:      aws.String(p.LocationURI),
+		RetrievalRoleArn: p.RetrievalRoleARN,
+		Validators:       generateValidators(p.Validators),
+		Tags:             p.Tags,
+	}
+}
+
+// GenerateUpdateConfigurationProfileInput returns the input to update the
+// configuration profile with the given ID from the supplied parameters.
+func GenerateUpdateConfigurationProfileInput(id string, p v1alpha1.ConfigurationProfileParameters) *appconfig.UpdateConfigurationProfileInput {
+	return &appconfig.UpdateConfigurationProfileInput{
+		ApplicationId:          p.ApplicationID,
+		ConfigurationProfileId: aws.String(id),
+		Name:                   aws.String(p.Name),
+		Description:            p.Description,
+		RetrievalRoleArn:       p.RetrievalRoleARN,
+		Validators:             generateValidators(p.Validators),
+	}
+}
+
+// LateInitializeConfigurationProfile fills the empty fields in
+// *v1alpha1.ConfigurationProfileParameters with the values seen in
+// appconfig.GetConfigurationProfileOutput.
+func LateInitializeConfigurationProfile(in *v1alpha1.ConfigurationProfileParameters, o *appconfig.GetConfigurationProfileOutput) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+	in.RetrievalRoleARN = awsclients.LateInitializeStringPtr(in.RetrievalRoleARN, o.RetrievalRoleArn)
+}
+
+// IsConfigurationProfileUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsConfigurationProfileUpToDate(p v1alpha1.ConfigurationProfileParameters, o appconfig.GetConfigurationProfileOutput) bool {
+	return p.Name == aws.StringValue(o.Name) &&
+		aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		aws.StringValue(p.RetrievalRoleARN) == aws.StringValue(o.RetrievalRoleArn) &&
+		cmp.Equal(generateValidators(p.Validators), o.Validators, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appconfig
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/appconfig/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errInvalidGrowthFactor = "growthFactor must be a decimal number between 1 and 100"
+)
+
+// DeploymentStrategyClient is the external client used for DeploymentStrategy
+// Custom Resource
+type DeploymentStrategyClient interface {
+	CreateDeploymentStrategyRequest(*appconfig.CreateDeploymentStrategyInput) appconfig.CreateDeploymentStrategyRequest
+	GetDeploymentStrategyRequest(*appconfig.GetDeploymentStrategyInput) appconfig.GetDeploymentStrategyRequest
+	UpdateDeploymentStrategyRequest(*appconfig.UpdateDeploymentStrategyInput) appconfig.UpdateDeploymentStrategyRequest
+	DeleteDeploymentStrategyRequest(*appconfig.DeleteDeploymentStrategyInput) appconfig.DeleteDeploymentStrategyRequest
+}
+
+// NewDeploymentStrategyClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewDeploymentStrategyClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DeploymentStrategyClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return appconfig.New(*cfg), err
+}
+
+func parseGrowthFactor(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 1 || f > 100 {
+		return 0, errors.New(errInvalidGrowthFactor)
+	}
+	return f, nil
+}
+
+// GenerateCreateDeploymentStrategyInput returns the input to create a
+// deployment strategy from the supplied parameters.
+func GenerateCreateDeploymentStrategyInput(p v1alpha1.DeploymentStrategyParameters) (*appconfig.CreateDeploymentStrategyInput, error) {
+	gf, err := parseGrowthFactor(p.GrowthFactor)
+	if err != nil {
+		return nil, err
+	}
+	return &appconfig.CreateDeploymentStrategyInput{
+		Name:                        aws.String(p.Name),
+		Description:                 p.Description,
+		DeploymentDurationInMinutes: aws.Int64(p.DeploymentDurationInMinutes),
+		FinalBakeTimeInMinutes:      p.FinalBakeTimeInMinutes,
+		GrowthFactor:                aws.Float64(gf),
+		GrowthType:                  appconfig.GrowthType(aws.StringValue(p.GrowthType)),
+		ReplicateTo:                 appconfig.ReplicateTo(p.ReplicateTo),
+		Tags:                        p.Tags,
+	}, nil
+}
+
+// GenerateUpdateDeploymentStrategyInput returns the input to update the
+// deployment strategy with the given ID from the supplied parameters.
+func GenerateUpdateDeploymentStrategyInput(id string, p v1alpha1.DeploymentStrategyParameters) (*appconfig.UpdateDeploymentStrategyInput, error) {
+	gf, err := parseGrowthFactor(p.GrowthFactor)
+	if err != nil {
+		return nil, err
+	}
+	return &appconfig.UpdateDeploymentStrategyInput{
+		DeploymentStrategyId:        aws.String(id),
+		Description:                 p.Description,
+		DeploymentDurationInMinutes: aws.Int64(p.DeploymentDurationInMinutes),
+		FinalBakeTimeInMinutes:      p.FinalBakeTimeInMinutes,
+		GrowthFactor:                aws.Float64(gf),
+		GrowthType:                  appconfig.GrowthType(aws.StringValue(p.GrowthType)),
+	}, nil
+}
+
+// LateInitializeDeploymentStrategy fills the empty fields in
+// *v1alpha1.DeploymentStrategyParameters with the values seen in
+// appconfig.GetDeploymentStrategyOutput.
+func LateInitializeDeploymentStrategy(in *v1alpha1.DeploymentStrategyParameters, o *appconfig.GetDeploymentStrategyOutput) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+	in.FinalBakeTimeInMinutes = awsclients.LateInitializeInt64Ptr(in.FinalBakeTimeInMinutes, o.FinalBakeTimeInMinutes)
+	if in.GrowthType == nil && o.GrowthType != "" {
+		in.GrowthType = aws.String(string(o.GrowthType))
+	}
+}
+
+// IsDeploymentStrategyUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsDeploymentStrategyUpToDate(p v1alpha1.DeploymentStrategyParameters, o appconfig.GetDeploymentStrategyOutput) bool {
+	gf, err := parseGrowthFactor(p.GrowthFactor)
+	if err != nil || gf != aws.Float64Value(o.GrowthFactor) {
+		return false
+	}
+	return aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		p.DeploymentDurationInMinutes == aws.Int64Value(o.DeploymentDurationInMinutes) &&
+		aws.Int64Value(p.FinalBakeTimeInMinutes) == aws.Int64Value(o.FinalBakeTimeInMinutes) &&
+		aws.StringValue(p.GrowthType) == string(o.GrowthType)
+}
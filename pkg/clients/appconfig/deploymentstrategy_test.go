@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appconfig
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appconfig/v1alpha1"
+)
+
+func strategyParams(m ...func(*v1alpha1.DeploymentStrategyParameters)) v1alpha1.DeploymentStrategyParameters {
+	p := v1alpha1.DeploymentStrategyParameters{
+		Name:                        "linear",
+		DeploymentDurationInMinutes: 10,
+		FinalBakeTimeInMinutes:      aws.Int64(5),
+		GrowthFactor:                "12.5",
+		GrowthType:                  aws.String("LINEAR"),
+		ReplicateTo:                 "NONE",
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func strategy(m ...func(*appconfig.GetDeploymentStrategyOutput)) appconfig.GetDeploymentStrategyOutput {
+	o := appconfig.GetDeploymentStrategyOutput{
+		Name:                        aws.String("linear"),
+		DeploymentDurationInMinutes: aws.Int64(10),
+		FinalBakeTimeInMinutes:      aws.Int64(5),
+		GrowthFactor:                aws.Float64(12.5),
+		GrowthType:                  appconfig.GrowthTypeLinear,
+		ReplicateTo:                 appconfig.ReplicateToNone,
+	}
+	for _, f := range m {
+		f(&o)
+	}
+	return o
+}
+
+func TestGenerateCreateDeploymentStrategyInput(t *testing.T) {
+	type want struct {
+		input *appconfig.CreateDeploymentStrategyInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		params v1alpha1.DeploymentStrategyParameters
+		want   want
+	}{
+		"Valid": {
+			params: strategyParams(),
+			want: want{input: &appconfig.CreateDeploymentStrategyInput{
+				Name:                        aws.String("linear"),
+				DeploymentDurationInMinutes: aws.Int64(10),
+				FinalBakeTimeInMinutes:      aws.Int64(5),
+				GrowthFactor:                aws.Float64(12.5),
+				GrowthType:                  appconfig.GrowthTypeLinear,
+				ReplicateTo:                 appconfig.ReplicateToNone,
+			}},
+		},
+		"GrowthFactorOutOfRange": {
+			params: strategyParams(func(p *v1alpha1.DeploymentStrategyParameters) { p.GrowthFactor = "0.5" }),
+			want:   want{err: errors.New(errInvalidGrowthFactor)},
+		},
+		"GrowthFactorNotANumber": {
+			params: strategyParams(func(p *v1alpha1.DeploymentStrategyParameters) { p.GrowthFactor = "fast" }),
+			want:   want{err: errors.New(errInvalidGrowthFactor)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateCreateDeploymentStrategyInput(tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDeploymentStrategyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params   v1alpha1.DeploymentStrategyParameters
+		observed appconfig.GetDeploymentStrategyOutput
+		want     bool
+	}{
+		"SameFields": {
+			params:   strategyParams(),
+			observed: strategy(),
+			want:     true,
+		},
+		"DifferentGrowthFactor": {
+			params:   strategyParams(func(p *v1alpha1.DeploymentStrategyParameters) { p.GrowthFactor = "50" }),
+			observed: strategy(),
+			want:     false,
+		},
+		"DifferentGrowthType": {
+			params:   strategyParams(),
+			observed: strategy(func(o *appconfig.GetDeploymentStrategyOutput) { o.GrowthType = appconfig.GrowthTypeExponential }),
+			want:     false,
+		},
+		"DifferentBakeTime": {
+			params:   strategyParams(func(p *v1alpha1.DeploymentStrategyParameters) { p.FinalBakeTimeInMinutes = aws.Int64(0) }),
+			observed: strategy(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDeploymentStrategyUpToDate(tc.params, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeDeploymentStrategy(t *testing.T) {
+	cases := map[string]struct {
+		params   v1alpha1.DeploymentStrategyParameters
+		observed appconfig.GetDeploymentStrategyOutput
+		want     v1alpha1.DeploymentStrategyParameters
+	}{
+		"AllFilled": {
+			params:   strategyParams(),
+			observed: strategy(),
+			want:     strategyParams(),
+		},
+		"DefaultsFromAWS": {
+			params: strategyParams(func(p *v1alpha1.DeploymentStrategyParameters) {
+				p.FinalBakeTimeInMinutes = nil
+				p.GrowthType = nil
+			}),
+			observed: strategy(),
+			want:     strategyParams(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeDeploymentStrategy(&tc.params, &tc.observed)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
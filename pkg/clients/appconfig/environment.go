@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/appconfig/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// EnvironmentClient is the external client used for Environment Custom
+// Resource
+type EnvironmentClient interface {
+	CreateEnvironmentRequest(*appconfig.CreateEnvironmentInput) appconfig.CreateEnvironmentRequest
+	GetEnvironmentRequest(*appconfig.GetEnvironmentInput) appconfig.GetEnvironmentRequest
+	UpdateEnvironmentRequest(*appconfig.UpdateEnvironmentInput) appconfig.UpdateEnvironmentRequest
+	DeleteEnvironmentRequest(*appconfig.DeleteEnvironmentInput) appconfig.DeleteEnvironmentRequest
+}
+
+// NewEnvironmentClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewEnvironmentClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (EnvironmentClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return appconfig.New(*cfg), err
+}
+
+func generateMonitors(in []v1alpha1.Monitor) []appconfig.Monitor {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]appconfig.Monitor, len(in))
+	for i, m := range in {
+		out[i] = appconfig.Monitor{AlarmArn: m.AlarmARN, AlarmRoleArn: m.AlarmRoleARN}
+	}
+	return out
+}
+
+// GenerateCreateEnvironmentInput returns the input to create an environment
+// from the supplied parameters.
+func GenerateCreateEnvironmentInput(p v1alpha1.EnvironmentParameters) *appconfig.CreateEnvironmentInput {
+	return &appconfig.CreateEnvironmentInput{
+		ApplicationId: p.ApplicationID,
+		Name:          aws.String(p.Name),
+		Description:   p.Description,
+		Monitors:      generateMonitors(p.Monitors),
+		Tags:          p.Tags,
+	}
+}
+
+// GenerateUpdateEnvironmentInput returns the input to update the environment
+// with the given ID from the supplied parameters.
+func GenerateUpdateEnvironmentInput(id string, p v1alpha1.EnvironmentParameters) *appconfig.UpdateEnvironmentInput {
+	return &appconfig.UpdateEnvironmentInput{
+		ApplicationId: p.ApplicationID,
+		EnvironmentId: aws.String(id),
+		Name:          aws.String(p.Name),
+		Description:   p.Description,
+		Monitors:      generateMonitors(p.Monitors),
+	}
+}
+
+// GenerateEnvironmentObservation is used to produce
+// v1alpha1.EnvironmentObservation from appconfig.GetEnvironmentOutput.
+func GenerateEnvironmentObservation(o appconfig.GetEnvironmentOutput) v1alpha1.EnvironmentObservation {
+	return v1alpha1.EnvironmentObservation{
+		EnvironmentID: aws.StringValue(o.Id),
+		State:         string(o.State),
+	}
+}
+
+// LateInitializeEnvironment fills the empty fields in
+// *v1alpha1.EnvironmentParameters with the values seen in
+// appconfig.GetEnvironmentOutput.
+func LateInitializeEnvironment(in *v1alpha1.EnvironmentParameters, o *appconfig.GetEnvironmentOutput) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+	if len(in.Monitors) == 0 && len(o.Monitors) != 0 {
+		in.Monitors = make([]v1alpha1.Monitor, len(o.Monitors))
+		for i, m := range o.Monitors {
+			in.Monitors[i] = v1alpha1.Monitor{AlarmARN: m.AlarmArn, AlarmRoleARN: m.AlarmRoleArn}
+		}
+	}
+}
+
+// IsEnvironmentUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsEnvironmentUpToDate(p v1alpha1.EnvironmentParameters, o appconfig.GetEnvironmentOutput) bool {
+	return p.Name == aws.StringValue(o.Name) &&
+		aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		cmp.Equal(generateMonitors(p.Monitors), o.Monitors, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appconfig"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ApplicationClient = (*MockApplicationClient)(nil)
+
+// MockApplicationClient is a type that implements all the methods for ApplicationClient interface
+type MockApplicationClient struct {
+	MockCreateApplication func(*appconfig.CreateApplicationInput) appconfig.CreateApplicationRequest
+	MockGetApplication    func(*appconfig.GetApplicationInput) appconfig.GetApplicationRequest
+	MockUpdateApplication func(*appconfig.UpdateApplicationInput) appconfig.UpdateApplicationRequest
+	MockDeleteApplication func(*appconfig.DeleteApplicationInput) appconfig.DeleteApplicationRequest
+}
+
+// CreateApplicationRequest calls the underlying MockCreateApplication method.
+func (c *MockApplicationClient) CreateApplicationRequest(i *appconfig.CreateApplicationInput) appconfig.CreateApplicationRequest {
+	return c.MockCreateApplication(i)
+}
+
+// GetApplicationRequest calls the underlying MockGetApplication method.
+func (c *MockApplicationClient) GetApplicationRequest(i *appconfig.GetApplicationInput) appconfig.GetApplicationRequest {
+	return c.MockGetApplication(i)
+}
+
+// UpdateApplicationRequest calls the underlying MockUpdateApplication method.
+func (c *MockApplicationClient) UpdateApplicationRequest(i *appconfig.UpdateApplicationInput) appconfig.UpdateApplicationRequest {
+	return c.MockUpdateApplication(i)
+}
+
+// DeleteApplicationRequest calls the underlying MockDeleteApplication method.
+func (c *MockApplicationClient) DeleteApplicationRequest(i *appconfig.DeleteApplicationInput) appconfig.DeleteApplicationRequest {
+	return c.MockDeleteApplication(i)
+}
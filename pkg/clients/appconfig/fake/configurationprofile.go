@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appconfig"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ConfigurationProfileClient = (*MockConfigurationProfileClient)(nil)
+
+// MockConfigurationProfileClient is a type that implements all the methods for ConfigurationProfileClient interface
+type MockConfigurationProfileClient struct {
+	MockCreateConfigurationProfile func(*appconfig.CreateConfigurationProfileInput) appconfig.CreateConfigurationProfileRequest
+	MockGetConfigurationProfile    func(*appconfig.GetConfigurationProfileInput) appconfig.GetConfigurationProfileRequest
+	MockUpdateConfigurationProfile func(*appconfig.UpdateConfigurationProfileInput) appconfig.UpdateConfigurationProfileRequest
+	MockDeleteConfigurationProfile func(*appconfig.DeleteConfigurationProfileInput) appconfig.DeleteConfigurationProfileRequest
+}
+
+// CreateConfigurationProfileRequest calls the underlying MockCreateConfigurationProfile method.
+func (c *MockConfigurationProfileClient) CreateConfigurationProfileRequest(i *appconfig.CreateConfigurationProfileInput) appconfig.CreateConfigurationProfileRequest {
+	return c.MockCreateConfigurationProfile(i)
+}
+
+// GetConfigurationProfileRequest calls the underlying MockGetConfigurationProfile method.
+func (c *MockConfigurationProfileClient) GetConfigurationProfileRequest(i *appconfig.GetConfigurationProfileInput) appconfig.GetConfigurationProfileRequest {
+	return c.MockGetConfigurationProfile(i)
+}
+
+// UpdateConfigurationProfileRequest calls the underlying MockUpdateConfigurationProfile method.
+func (c *MockConfigurationProfileClient) UpdateConfigurationProfileRequest(i *appconfig.UpdateConfigurationProfileInput) appconfig.UpdateConfigurationProfileRequest {
+	return c.MockUpdateConfigurationProfile(i)
+}
+
+// DeleteConfigurationProfileRequest calls the underlying MockDeleteConfigurationProfile method.
+func (c *MockConfigurationProfileClient) DeleteConfigurationProfileRequest(i *appconfig.DeleteConfigurationProfileInput) appconfig.DeleteConfigurationProfileRequest {
+	return c.MockDeleteConfigurationProfile(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appconfig"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DeploymentStrategyClient = (*MockDeploymentStrategyClient)(nil)
+
+// MockDeploymentStrategyClient is a type that implements all the methods for DeploymentStrategyClient interface
+type MockDeploymentStrategyClient struct {
+	MockCreateDeploymentStrategy func(*appconfig.CreateDeploymentStrategyInput) appconfig.CreateDeploymentStrategyRequest
+	MockGetDeploymentStrategy    func(*appconfig.GetDeploymentStrategyInput) appconfig.GetDeploymentStrategyRequest
+	MockUpdateDeploymentStrategy func(*appconfig.UpdateDeploymentStrategyInput) appconfig.UpdateDeploymentStrategyRequest
+	MockDeleteDeploymentStrategy func(*appconfig.DeleteDeploymentStrategyInput) appconfig.DeleteDeploymentStrategyRequest
+}
+
+// CreateDeploymentStrategyRequest calls the underlying MockCreateDeploymentStrategy method.
+func (c *MockDeploymentStrategyClient) CreateDeploymentStrategyRequest(i *appconfig.CreateDeploymentStrategyInput) appconfig.CreateDeploymentStrategyRequest {
+	return c.MockCreateDeploymentStrategy(i)
+}
+
+// GetDeploymentStrategyRequest calls the underlying MockGetDeploymentStrategy method.
+func (c *MockDeploymentStrategyClient) GetDeploymentStrategyRequest(i *appconfig.GetDeploymentStrategyInput) appconfig.GetDeploymentStrategyRequest {
+	return c.MockGetDeploymentStrategy(i)
+}
+
+// UpdateDeploymentStrategyRequest calls the underlying MockUpdateDeploymentStrategy method.
+func (c *MockDeploymentStrategyClient) UpdateDeploymentStrategyRequest(i *appconfig.UpdateDeploymentStrategyInput) appconfig.UpdateDeploymentStrategyRequest {
+	return c.MockUpdateDeploymentStrategy(i)
+}
+
+// DeleteDeploymentStrategyRequest calls the underlying MockDeleteDeploymentStrategy method.
+func (c *MockDeploymentStrategyClient) DeleteDeploymentStrategyRequest(i *appconfig.DeleteDeploymentStrategyInput) appconfig.DeleteDeploymentStrategyRequest {
+	return c.MockDeleteDeploymentStrategy(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appconfig"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EnvironmentClient = (*MockEnvironmentClient)(nil)
+
+// MockEnvironmentClient is a type that implements all the methods for EnvironmentClient interface
+type MockEnvironmentClient struct {
+	MockCreateEnvironment func(*appconfig.CreateEnvironmentInput) appconfig.CreateEnvironmentRequest
+	MockGetEnvironment    func(*appconfig.GetEnvironmentInput) appconfig.GetEnvironmentRequest
+	MockUpdateEnvironment func(*appconfig.UpdateEnvironmentInput) appconfig.UpdateEnvironmentRequest
+	MockDeleteEnvironment func(*appconfig.DeleteEnvironmentInput) appconfig.DeleteEnvironmentRequest
+}
+
+// CreateEnvironmentRequest calls the underlying MockCreateEnvironment method.
+func (c *MockEnvironmentClient) CreateEnvironmentRequest(i *appconfig.CreateEnvironmentInput) appconfig.CreateEnvironmentRequest {
+	return c.MockCreateEnvironment(i)
+}
+
+// GetEnvironmentRequest calls the underlying MockGetEnvironment method.
+func (c *MockEnvironmentClient) GetEnvironmentRequest(i *appconfig.GetEnvironmentInput) appconfig.GetEnvironmentRequest {
+	return c.MockGetEnvironment(i)
+}
+
+// UpdateEnvironmentRequest calls the underlying MockUpdateEnvironment method.
+func (c *MockEnvironmentClient) UpdateEnvironmentRequest(i *appconfig.UpdateEnvironmentInput) appconfig.UpdateEnvironmentRequest {
+	return c.MockUpdateEnvironment(i)
+}
+
+// DeleteEnvironmentRequest calls the underlying MockDeleteEnvironment method.
+func (c *MockEnvironmentClient) DeleteEnvironmentRequest(i *appconfig.DeleteEnvironmentInput) appconfig.DeleteEnvironmentRequest {
+	return c.MockDeleteEnvironment(i)
+}
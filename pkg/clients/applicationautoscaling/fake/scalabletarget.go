@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ScalableTargetClient = (*MockScalableTargetClient)(nil)
+
+// MockScalableTargetClient is a type that implements all the methods for ScalableTargetClient interface
+type MockScalableTargetClient struct {
+	MockRegisterScalableTarget   func(*applicationautoscaling.RegisterScalableTargetInput) applicationautoscaling.RegisterScalableTargetRequest
+	MockDescribeScalableTargets  func(*applicationautoscaling.DescribeScalableTargetsInput) applicationautoscaling.DescribeScalableTargetsRequest
+	MockDeregisterScalableTarget func(*applicationautoscaling.DeregisterScalableTargetInput) applicationautoscaling.DeregisterScalableTargetRequest
+}
+
+// RegisterScalableTargetRequest calls the underlying MockRegisterScalableTarget method.
+func (c *MockScalableTargetClient) RegisterScalableTargetRequest(i *applicationautoscaling.RegisterScalableTargetInput) applicationautoscaling.RegisterScalableTargetRequest {
+	return c.MockRegisterScalableTarget(i)
+}
+
+// DescribeScalableTargetsRequest calls the underlying MockDescribeScalableTargets method.
+func (c *MockScalableTargetClient) DescribeScalableTargetsRequest(i *applicationautoscaling.DescribeScalableTargetsInput) applicationautoscaling.DescribeScalableTargetsRequest {
+	return c.MockDescribeScalableTargets(i)
+}
+
+// DeregisterScalableTargetRequest calls the underlying MockDeregisterScalableTarget method.
+func (c *MockScalableTargetClient) DeregisterScalableTargetRequest(i *applicationautoscaling.DeregisterScalableTargetInput) applicationautoscaling.DeregisterScalableTargetRequest {
+	return c.MockDeregisterScalableTarget(i)
+}
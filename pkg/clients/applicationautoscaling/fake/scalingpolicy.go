@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ScalingPolicyClient = (*MockScalingPolicyClient)(nil)
+
+// MockScalingPolicyClient is a type that implements all the methods for ScalingPolicyClient interface
+type MockScalingPolicyClient struct {
+	MockPutScalingPolicy        func(*applicationautoscaling.PutScalingPolicyInput) applicationautoscaling.PutScalingPolicyRequest
+	MockDescribeScalingPolicies func(*applicationautoscaling.DescribeScalingPoliciesInput) applicationautoscaling.DescribeScalingPoliciesRequest
+	MockDeleteScalingPolicy     func(*applicationautoscaling.DeleteScalingPolicyInput) applicationautoscaling.DeleteScalingPolicyRequest
+}
+
+// PutScalingPolicyRequest calls the underlying MockPutScalingPolicy method.
+func (c *MockScalingPolicyClient) PutScalingPolicyRequest(i *applicationautoscaling.PutScalingPolicyInput) applicationautoscaling.PutScalingPolicyRequest {
+	return c.MockPutScalingPolicy(i)
+}
+
+// DescribeScalingPoliciesRequest calls the underlying MockDescribeScalingPolicies method.
+func (c *MockScalingPolicyClient) DescribeScalingPoliciesRequest(i *applicationautoscaling.DescribeScalingPoliciesInput) applicationautoscaling.DescribeScalingPoliciesRequest {
+	return c.MockDescribeScalingPolicies(i)
+}
+
+// DeleteScalingPolicyRequest calls the underlying MockDeleteScalingPolicy method.
+func (c *MockScalingPolicyClient) DeleteScalingPolicyRequest(i *applicationautoscaling.DeleteScalingPolicyInput) applicationautoscaling.DeleteScalingPolicyRequest {
+	return c.MockDeleteScalingPolicy(i)
+}
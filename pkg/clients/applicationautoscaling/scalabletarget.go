@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationautoscaling
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ScalableTargetClient is the external client used for ScalableTarget Custom
+// Resource
+type ScalableTargetClient interface {
+	RegisterScalableTargetRequest(*applicationautoscaling.RegisterScalableTargetInput) applicationautoscaling.RegisterScalableTargetRequest
+	DescribeScalableTargetsRequest(*applicationautoscaling.DescribeScalableTargetsInput) applicationautoscaling.DescribeScalableTargetsRequest
+	DeregisterScalableTargetRequest(*applicationautoscaling.DeregisterScalableTargetInput) applicationautoscaling.DeregisterScalableTargetRequest
+}
+
+// NewScalableTargetClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewScalableTargetClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ScalableTargetClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return applicationautoscaling.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the scalable target or the
+// scaling policy does not exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == applicationautoscaling.ErrCodeObjectNotFoundException
+	}
+	return false
+}
+
+func generateSuspendedState(in *v1alpha1.SuspendedState) *applicationautoscaling.SuspendedState {
+	if in == nil {
+		return nil
+	}
+	return &applicationautoscaling.SuspendedState{
+		DynamicScalingInSuspended:  in.DynamicScalingInSuspended,
+		DynamicScalingOutSuspended: in.DynamicScalingOutSuspended,
+		ScheduledScalingSuspended:  in.ScheduledScalingSuspended,
+	}
+}
+
+// GenerateDescribeScalableTargetsInput returns the input to find the
+// scalable target described by the supplied parameters.
+func GenerateDescribeScalableTargetsInput(p v1alpha1.ScalableTargetParameters) *applicationautoscaling.DescribeScalableTargetsInput {
+	return &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace:  applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceIds:       []string{p.ResourceID},
+		ScalableDimension: applicationautoscaling.ScalableDimension(p.ScalableDimension),
+	}
+}
+
+// GenerateRegisterScalableTargetInput returns the input to register or
+// update the scalable target described by the supplied parameters.
+func GenerateRegisterScalableTargetInput(p v1alpha1.ScalableTargetParameters) *applicationautoscaling.RegisterScalableTargetInput {
+	return &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceId:        aws.String(p.ResourceID),
+		ScalableDimension: applicationautoscaling.ScalableDimension(p.ScalableDimension),
+		MinCapacity:       aws.Int64(p.MinCapacity),
+		MaxCapacity:       aws.Int64(p.MaxCapacity),
+		RoleARN:           p.RoleARN,
+		SuspendedState:    generateSuspendedState(p.SuspendedState),
+	}
+}
+
+// GenerateDeregisterScalableTargetInput returns the input to deregister the
+// scalable target described by the supplied parameters.
+func GenerateDeregisterScalableTargetInput(p v1alpha1.ScalableTargetParameters) *applicationautoscaling.DeregisterScalableTargetInput {
+	return &applicationautoscaling.DeregisterScalableTargetInput{
+		ServiceNamespace:  applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceId:        aws.String(p.ResourceID),
+		ScalableDimension: applicationautoscaling.ScalableDimension(p.ScalableDimension),
+	}
+}
+
+// LateInitializeScalableTarget fills the empty fields in
+// *v1alpha1.ScalableTargetParameters with the values seen in
+// applicationautoscaling.ScalableTarget.
+func LateInitializeScalableTarget(in *v1alpha1.ScalableTargetParameters, t *applicationautoscaling.ScalableTarget) {
+	if t == nil {
+		return
+	}
+	in.RoleARN = awsclients.LateInitializeStringPtr(in.RoleARN, t.RoleARN)
+}
+
+// GenerateScalableTargetObservation is used to produce
+// v1alpha1.ScalableTargetObservation from
+// applicationautoscaling.ScalableTarget.
+func GenerateScalableTargetObservation(t applicationautoscaling.ScalableTarget) v1alpha1.ScalableTargetObservation {
+	o := v1alpha1.ScalableTargetObservation{
+		RoleARN: aws.StringValue(t.RoleARN),
+	}
+	if t.CreationTime != nil {
+		c := metav1.NewTime(*t.CreationTime)
+		o.CreationTime = &c
+	}
+	return o
+}
+
+// IsScalableTargetUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsScalableTargetUpToDate(p v1alpha1.ScalableTargetParameters, t applicationautoscaling.ScalableTarget) bool {
+	if p.MinCapacity != aws.Int64Value(t.MinCapacity) || p.MaxCapacity != aws.Int64Value(t.MaxCapacity) {
+		return false
+	}
+	if p.RoleARN != nil && aws.StringValue(p.RoleARN) != aws.StringValue(t.RoleARN) {
+		return false
+	}
+	if s := p.SuspendedState; s != nil {
+		observed := t.SuspendedState
+		if observed == nil {
+			observed = &applicationautoscaling.SuspendedState{}
+		}
+		return aws.BoolValue(s.DynamicScalingInSuspended) == aws.BoolValue(observed.DynamicScalingInSuspended) &&
+			aws.BoolValue(s.DynamicScalingOutSuspended) == aws.BoolValue(observed.DynamicScalingOutSuspended) &&
+			aws.BoolValue(s.ScheduledScalingSuspended) == aws.BoolValue(observed.ScheduledScalingSuspended)
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationautoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+)
+
+var (
+	roleARN = "arn:aws:iam::123456789012:role/autoscaling"
+)
+
+func targetParams(m ...func(*v1alpha1.ScalableTargetParameters)) v1alpha1.ScalableTargetParameters {
+	p := v1alpha1.ScalableTargetParameters{
+		ServiceNamespace:  "ecs",
+		ResourceID:        "service/default/example",
+		ScalableDimension: "ecs:service:DesiredCount",
+		MinCapacity:       1,
+		MaxCapacity:       10,
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func target(m ...func(*applicationautoscaling.ScalableTarget)) applicationautoscaling.ScalableTarget {
+	t := applicationautoscaling.ScalableTarget{
+		ServiceNamespace:  applicationautoscaling.ServiceNamespaceEcs,
+		ResourceId:        aws.String("service/default/example"),
+		ScalableDimension: applicationautoscaling.ScalableDimensionEcsServiceDesiredCount,
+		MinCapacity:       aws.Int64(1),
+		MaxCapacity:       aws.Int64(10),
+		RoleARN:           aws.String(roleARN),
+	}
+	for _, f := range m {
+		f(&t)
+	}
+	return t
+}
+
+func TestLateInitializeScalableTarget(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ScalableTargetParameters
+		t    applicationautoscaling.ScalableTarget
+		want v1alpha1.ScalableTargetParameters
+	}{
+		"RoleARN": {
+			p: targetParams(),
+			t: target(),
+			want: targetParams(func(p *v1alpha1.ScalableTargetParameters) {
+				p.RoleARN = aws.String(roleARN)
+			}),
+		},
+		"KeepsSpecifiedRoleARN": {
+			p: targetParams(func(p *v1alpha1.ScalableTargetParameters) {
+				p.RoleARN = aws.String("other")
+			}),
+			t: target(),
+			want: targetParams(func(p *v1alpha1.ScalableTargetParameters) {
+				p.RoleARN = aws.String("other")
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeScalableTarget(&tc.p, &tc.t)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsScalableTargetUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ScalableTargetParameters
+		t    applicationautoscaling.ScalableTarget
+		want bool
+	}{
+		"UpToDate": {
+			p:    targetParams(),
+			t:    target(),
+			want: true,
+		},
+		"MinCapacityChanged": {
+			p: targetParams(func(p *v1alpha1.ScalableTargetParameters) {
+				p.MinCapacity = 2
+			}),
+			t:    target(),
+			want: false,
+		},
+		"RoleARNChanged": {
+			p: targetParams(func(p *v1alpha1.ScalableTargetParameters) {
+				p.RoleARN = aws.String("other")
+			}),
+			t:    target(),
+			want: false,
+		},
+		"SuspendedStateUnchanged": {
+			p: targetParams(func(p *v1alpha1.ScalableTargetParameters) {
+				p.SuspendedState = &v1alpha1.SuspendedState{DynamicScalingInSuspended: aws.Bool(false)}
+			}),
+			t:    target(),
+			want: true,
+		},
+		"SuspendedStateChanged": {
+			p: targetParams(func(p *v1alpha1.ScalableTargetParameters) {
+				p.SuspendedState = &v1alpha1.SuspendedState{ScheduledScalingSuspended: aws.Bool(true)}
+			}),
+			t: target(func(t *applicationautoscaling.ScalableTarget) {
+				t.SuspendedState = &applicationautoscaling.SuspendedState{ScheduledScalingSuspended: aws.Bool(false)}
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsScalableTargetUpToDate(tc.p, tc.t)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationautoscaling
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errInvalidTargetValue   = "targetValue must be a decimal number"
+	errInvalidIntervalBound = "metric interval bounds must be decimal numbers"
+)
+
+// ScalingPolicyClient is the external client used for ScalingPolicy Custom
+// Resource
+type ScalingPolicyClient interface {
+	PutScalingPolicyRequest(*applicationautoscaling.PutScalingPolicyInput) applicationautoscaling.PutScalingPolicyRequest
+	DescribeScalingPoliciesRequest(*applicationautoscaling.DescribeScalingPoliciesInput) applicationautoscaling.DescribeScalingPoliciesRequest
+	DeleteScalingPolicyRequest(*applicationautoscaling.DeleteScalingPolicyInput) applicationautoscaling.DeleteScalingPolicyRequest
+}
+
+// NewScalingPolicyClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewScalingPolicyClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ScalingPolicyClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return applicationautoscaling.New(*cfg), err
+}
+
+func parseBound(s *string) (*float64, error) {
+	if s == nil {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(*s, 64)
+	if err != nil {
+		return nil, errors.New(errInvalidIntervalBound)
+	}
+	return aws.Float64(f), nil
+}
+
+func generateStepScalingPolicyConfiguration(in *v1alpha1.StepScalingPolicyConfiguration) (*applicationautoscaling.StepScalingPolicyConfiguration, error) {
+	if in == nil {
+		return nil, nil
+	}
+	c := &applicationautoscaling.StepScalingPolicyConfiguration{
+		AdjustmentType:         applicationautoscaling.AdjustmentType(aws.StringValue(in.AdjustmentType)),
+		Cooldown:               in.Cooldown,
+		MetricAggregationType:  applicationautoscaling.MetricAggregationType(aws.StringValue(in.MetricAggregationType)),
+		MinAdjustmentMagnitude: in.MinAdjustmentMagnitude,
+	}
+	for _, s := range in.StepAdjustments {
+		lower, err := parseBound(s.MetricIntervalLowerBound)
+		if err != nil {
+			return nil, err
+		}
+		upper, err := parseBound(s.MetricIntervalUpperBound)
+		if err != nil {
+			return nil, err
+		}
+		c.StepAdjustments = append(c.StepAdjustments, applicationautoscaling.StepAdjustment{
+			MetricIntervalLowerBound: lower,
+			MetricIntervalUpperBound: upper,
+			ScalingAdjustment:        aws.Int64(s.ScalingAdjustment),
+		})
+	}
+	return c, nil
+}
+
+func generateTargetTrackingScalingPolicyConfiguration(in *v1alpha1.TargetTrackingScalingPolicyConfiguration) (*applicationautoscaling.TargetTrackingScalingPolicyConfiguration, error) {
+	if in == nil {
+		return nil, nil
+	}
+	target, err := strconv.ParseFloat(in.TargetValue, 64)
+	if err != nil {
+		return nil, errors.New(errInvalidTargetValue)
+	}
+	c := &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+		DisableScaleIn:   in.DisableScaleIn,
+		ScaleInCooldown:  in.ScaleInCooldown,
+		ScaleOutCooldown: in.ScaleOutCooldown,
+		TargetValue:      aws.Float64(target),
+	}
+	if m := in.CustomizedMetricSpecification; m != nil {
+		c.CustomizedMetricSpecification = &applicationautoscaling.CustomizedMetricSpecification{
+			MetricName: aws.String(m.MetricName),
+			Namespace:  aws.String(m.Namespace),
+			Statistic:  applicationautoscaling.MetricStatistic(m.Statistic),
+			Unit:       m.Unit,
+		}
+		for _, d := range m.Dimensions {
+			c.CustomizedMetricSpecification.Dimensions = append(c.CustomizedMetricSpecification.Dimensions, applicationautoscaling.MetricDimension{
+				Name:  aws.String(d.Name),
+				Value: aws.String(d.Value),
+			})
+		}
+	}
+	if m := in.PredefinedMetricSpecification; m != nil {
+		c.PredefinedMetricSpecification = &applicationautoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: applicationautoscaling.MetricType(m.PredefinedMetricType),
+			ResourceLabel:        m.ResourceLabel,
+		}
+	}
+	return c, nil
+}
+
+// GenerateDescribeScalingPoliciesInput returns the input to find the scaling
+// policy with the given name for the supplied parameters.
+func GenerateDescribeScalingPoliciesInput(name string, p v1alpha1.ScalingPolicyParameters) *applicationautoscaling.DescribeScalingPoliciesInput {
+	return &applicationautoscaling.DescribeScalingPoliciesInput{
+		PolicyNames:       []string{name},
+		ServiceNamespace:  applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceId:        p.ResourceID,
+		ScalableDimension: applicationautoscaling.ScalableDimension(p.ScalableDimension),
+	}
+}
+
+// GeneratePutScalingPolicyInput returns the input to create or update the
+// scaling policy with the given name from the supplied parameters.
+func GeneratePutScalingPolicyInput(name string, p v1alpha1.ScalingPolicyParameters) (*applicationautoscaling.PutScalingPolicyInput, error) {
+	step, err := generateStepScalingPolicyConfiguration(p.StepScalingPolicyConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	target, err := generateTargetTrackingScalingPolicyConfiguration(p.TargetTrackingScalingPolicyConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	return &applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:                               aws.String(name),
+		PolicyType:                               applicationautoscaling.PolicyType(p.PolicyType),
+		ServiceNamespace:                         applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceId:                               p.ResourceID,
+		ScalableDimension:                        applicationautoscaling.ScalableDimension(p.ScalableDimension),
+		StepScalingPolicyConfiguration:           step,
+		TargetTrackingScalingPolicyConfiguration: target,
+	}, nil
+}
+
+// GenerateDeleteScalingPolicyInput returns the input to delete the scaling
+// policy with the given name.
+func GenerateDeleteScalingPolicyInput(name string, p v1alpha1.ScalingPolicyParameters) *applicationautoscaling.DeleteScalingPolicyInput {
+	return &applicationautoscaling.DeleteScalingPolicyInput{
+		PolicyName:        aws.String(name),
+		ServiceNamespace:  applicationautoscaling.ServiceNamespace(p.ServiceNamespace),
+		ResourceId:        p.ResourceID,
+		ScalableDimension: applicationautoscaling.ScalableDimension(p.ScalableDimension),
+	}
+}
+
+// LateInitializeScalingPolicy fills the empty fields in
+// *v1alpha1.ScalingPolicyParameters with the values seen in
+// applicationautoscaling.ScalingPolicy.
+func LateInitializeScalingPolicy(in *v1alpha1.ScalingPolicyParameters, sp *applicationautoscaling.ScalingPolicy) {
+	if sp == nil {
+		return
+	}
+	if in.StepScalingPolicyConfiguration != nil && sp.StepScalingPolicyConfiguration != nil {
+		c := sp.StepScalingPolicyConfiguration
+		in.StepScalingPolicyConfiguration.Cooldown = awsclients.LateInitializeInt64Ptr(in.StepScalingPolicyConfiguration.Cooldown, c.Cooldown)
+		if in.StepScalingPolicyConfiguration.MetricAggregationType == nil && c.MetricAggregationType != "" {
+			in.StepScalingPolicyConfiguration.MetricAggregationType = aws.String(string(c.MetricAggregationType))
+		}
+	}
+	if in.TargetTrackingScalingPolicyConfiguration != nil && sp.TargetTrackingScalingPolicyConfiguration != nil {
+		c := sp.TargetTrackingScalingPolicyConfiguration
+		in.TargetTrackingScalingPolicyConfiguration.DisableScaleIn = awsclients.LateInitializeBoolPtr(in.TargetTrackingScalingPolicyConfiguration.DisableScaleIn, c.DisableScaleIn)
+		in.TargetTrackingScalingPolicyConfiguration.ScaleInCooldown = awsclients.LateInitializeInt64Ptr(in.TargetTrackingScalingPolicyConfiguration.ScaleInCooldown, c.ScaleInCooldown)
+		in.TargetTrackingScalingPolicyConfiguration.ScaleOutCooldown = awsclients.LateInitializeInt64Ptr(in.TargetTrackingScalingPolicyConfiguration.ScaleOutCooldown, c.ScaleOutCooldown)
+	}
+}
+
+// GenerateScalingPolicyObservation is used to produce
+// v1alpha1.ScalingPolicyObservation from applicationautoscaling.ScalingPolicy.
+func GenerateScalingPolicyObservation(sp applicationautoscaling.ScalingPolicy) v1alpha1.ScalingPolicyObservation {
+	o := v1alpha1.ScalingPolicyObservation{
+		PolicyARN: aws.StringValue(sp.PolicyARN),
+	}
+	for _, a := range sp.Alarms {
+		o.Alarms = append(o.Alarms, v1alpha1.Alarm{
+			AlarmARN:  aws.StringValue(a.AlarmARN),
+			AlarmName: aws.StringValue(a.AlarmName),
+		})
+	}
+	return o
+}
+
+// IsScalingPolicyUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsScalingPolicyUpToDate(p v1alpha1.ScalingPolicyParameters, sp applicationautoscaling.ScalingPolicy) bool {
+	in, err := GeneratePutScalingPolicyInput(aws.StringValue(sp.PolicyName), p)
+	if err != nil {
+		return false
+	}
+	opts := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(
+			applicationautoscaling.StepScalingPolicyConfiguration{},
+			applicationautoscaling.StepAdjustment{},
+			applicationautoscaling.TargetTrackingScalingPolicyConfiguration{},
+			applicationautoscaling.CustomizedMetricSpecification{},
+			applicationautoscaling.MetricDimension{},
+			applicationautoscaling.PredefinedMetricSpecification{},
+		),
+	}
+	return in.PolicyType == sp.PolicyType &&
+		cmp.Equal(in.StepScalingPolicyConfiguration, sp.StepScalingPolicyConfiguration, opts...) &&
+		cmp.Equal(in.TargetTrackingScalingPolicyConfiguration, sp.TargetTrackingScalingPolicyConfiguration, opts...)
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationautoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+)
+
+var (
+	policyName = "example"
+)
+
+func stepPolicyParams(m ...func(*v1alpha1.ScalingPolicyParameters)) v1alpha1.ScalingPolicyParameters {
+	p := v1alpha1.ScalingPolicyParameters{
+		ServiceNamespace:  "lambda",
+		ResourceID:        aws.String("function:example:live"),
+		ScalableDimension: "lambda:function:ProvisionedConcurrency",
+		PolicyType:        "StepScaling",
+		StepScalingPolicyConfiguration: &v1alpha1.StepScalingPolicyConfiguration{
+			AdjustmentType: aws.String("ChangeInCapacity"),
+			StepAdjustments: []v1alpha1.StepAdjustment{
+				{MetricIntervalUpperBound: aws.String("0"), ScalingAdjustment: -1},
+				{MetricIntervalLowerBound: aws.String("0"), MetricIntervalUpperBound: aws.String("12.5"), ScalingAdjustment: 1},
+				{MetricIntervalLowerBound: aws.String("12.5"), ScalingAdjustment: 3},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func stepPolicy(m ...func(*applicationautoscaling.ScalingPolicy)) applicationautoscaling.ScalingPolicy {
+	sp := applicationautoscaling.ScalingPolicy{
+		PolicyName:        aws.String(policyName),
+		PolicyType:        applicationautoscaling.PolicyTypeStepScaling,
+		ServiceNamespace:  applicationautoscaling.ServiceNamespaceLambda,
+		ResourceId:        aws.String("function:example:live"),
+		ScalableDimension: applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+		StepScalingPolicyConfiguration: &applicationautoscaling.StepScalingPolicyConfiguration{
+			AdjustmentType: applicationautoscaling.AdjustmentTypeChangeInCapacity,
+			StepAdjustments: []applicationautoscaling.StepAdjustment{
+				{MetricIntervalUpperBound: aws.Float64(0), ScalingAdjustment: aws.Int64(-1)},
+				{MetricIntervalLowerBound: aws.Float64(0), MetricIntervalUpperBound: aws.Float64(12.5), ScalingAdjustment: aws.Int64(1)},
+				{MetricIntervalLowerBound: aws.Float64(12.5), ScalingAdjustment: aws.Int64(3)},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&sp)
+	}
+	return sp
+}
+
+func TestGeneratePutScalingPolicyInput(t *testing.T) {
+	type want struct {
+		in  *applicationautoscaling.PutScalingPolicyInput
+		err error
+	}
+
+	cases := map[string]struct {
+		p v1alpha1.ScalingPolicyParameters
+		want
+	}{
+		"StepScaling": {
+			p: stepPolicyParams(),
+			want: want{
+				in: &applicationautoscaling.PutScalingPolicyInput{
+					PolicyName:                     aws.String(policyName),
+					PolicyType:                     applicationautoscaling.PolicyTypeStepScaling,
+					ServiceNamespace:               applicationautoscaling.ServiceNamespaceLambda,
+					ResourceId:                     aws.String("function:example:live"),
+					ScalableDimension:              applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+					StepScalingPolicyConfiguration: stepPolicy().StepScalingPolicyConfiguration,
+				},
+			},
+		},
+		"TargetTracking": {
+			p: v1alpha1.ScalingPolicyParameters{
+				ServiceNamespace:  "ecs",
+				ResourceID:        aws.String("service/default/example"),
+				ScalableDimension: "ecs:service:DesiredCount",
+				PolicyType:        "TargetTrackingScaling",
+				TargetTrackingScalingPolicyConfiguration: &v1alpha1.TargetTrackingScalingPolicyConfiguration{
+					CustomizedMetricSpecification: &v1alpha1.CustomizedMetricSpecification{
+						Dimensions: []v1alpha1.MetricDimension{{Name: "QueueName", Value: "jobs"}},
+						MetricName: "ApproximateNumberOfMessagesVisible",
+						Namespace:  "AWS/SQS",
+						Statistic:  "Average",
+					},
+					ScaleOutCooldown: aws.Int64(60),
+					TargetValue:      "0.75",
+				},
+			},
+			want: want{
+				in: &applicationautoscaling.PutScalingPolicyInput{
+					PolicyName:        aws.String(policyName),
+					PolicyType:        applicationautoscaling.PolicyTypeTargetTrackingScaling,
+					ServiceNamespace:  applicationautoscaling.ServiceNamespaceEcs,
+					ResourceId:        aws.String("service/default/example"),
+					ScalableDimension: applicationautoscaling.ScalableDimensionEcsServiceDesiredCount,
+					TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+						CustomizedMetricSpecification: &applicationautoscaling.CustomizedMetricSpecification{
+							Dimensions: []applicationautoscaling.MetricDimension{{Name: aws.String("QueueName"), Value: aws.String("jobs")}},
+							MetricName: aws.String("ApproximateNumberOfMessagesVisible"),
+							Namespace:  aws.String("AWS/SQS"),
+							Statistic:  applicationautoscaling.MetricStatisticAverage,
+						},
+						ScaleOutCooldown: aws.Int64(60),
+						TargetValue:      aws.Float64(0.75),
+					},
+				},
+			},
+		},
+		"InvalidTargetValue": {
+			p: v1alpha1.ScalingPolicyParameters{
+				PolicyType: "TargetTrackingScaling",
+				TargetTrackingScalingPolicyConfiguration: &v1alpha1.TargetTrackingScalingPolicyConfiguration{
+					TargetValue: "high",
+				},
+			},
+			want: want{
+				err: errors.New(errInvalidTargetValue),
+			},
+		},
+		"InvalidIntervalBound": {
+			p: stepPolicyParams(func(p *v1alpha1.ScalingPolicyParameters) {
+				p.StepScalingPolicyConfiguration.StepAdjustments[0].MetricIntervalUpperBound = aws.String("zero")
+			}),
+			want: want{
+				err: errors.New(errInvalidIntervalBound),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GeneratePutScalingPolicyInput(policyName, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, got, cmpopts.IgnoreUnexported(
+				applicationautoscaling.PutScalingPolicyInput{},
+				applicationautoscaling.StepScalingPolicyConfiguration{},
+				applicationautoscaling.StepAdjustment{},
+				applicationautoscaling.TargetTrackingScalingPolicyConfiguration{},
+				applicationautoscaling.CustomizedMetricSpecification{},
+				applicationautoscaling.MetricDimension{},
+			)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsScalingPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ScalingPolicyParameters
+		sp   applicationautoscaling.ScalingPolicy
+		want bool
+	}{
+		"UpToDate": {
+			p:    stepPolicyParams(),
+			sp:   stepPolicy(),
+			want: true,
+		},
+		"StepChanged": {
+			p: stepPolicyParams(func(p *v1alpha1.ScalingPolicyParameters) {
+				p.StepScalingPolicyConfiguration.StepAdjustments[2].ScalingAdjustment = 5
+			}),
+			sp:   stepPolicy(),
+			want: false,
+		},
+		"BoundChanged": {
+			p: stepPolicyParams(func(p *v1alpha1.ScalingPolicyParameters) {
+				p.StepScalingPolicyConfiguration.StepAdjustments[1].MetricIntervalUpperBound = aws.String("10")
+			}),
+			sp:   stepPolicy(),
+			want: false,
+		},
+		"InvalidSpec": {
+			p: stepPolicyParams(func(p *v1alpha1.ScalingPolicyParameters) {
+				p.StepScalingPolicyConfiguration.StepAdjustments[1].MetricIntervalUpperBound = aws.String("ten")
+			}),
+			sp:   stepPolicy(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsScalingPolicyUpToDate(tc.p, tc.sp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeScalingPolicy(t *testing.T) {
+	p := stepPolicyParams()
+	sp := stepPolicy(func(sp *applicationautoscaling.ScalingPolicy) {
+		sp.StepScalingPolicyConfiguration.Cooldown = aws.Int64(300)
+		sp.StepScalingPolicyConfiguration.MetricAggregationType = applicationautoscaling.MetricAggregationTypeAverage
+	})
+	LateInitializeScalingPolicy(&p, &sp)
+	want := stepPolicyParams(func(p *v1alpha1.ScalingPolicyParameters) {
+		p.StepScalingPolicyConfiguration.Cooldown = aws.Int64(300)
+		p.StepScalingPolicyConfiguration.MetricAggregationType = aws.String("Average")
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
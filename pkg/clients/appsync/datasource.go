@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appsync
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DataSourceClient is the external client used for DataSource Custom Resource
+type DataSourceClient interface {
+	CreateDataSourceRequest(*appsync.CreateDataSourceInput) appsync.CreateDataSourceRequest
+	GetDataSourceRequest(*appsync.GetDataSourceInput) appsync.GetDataSourceRequest
+	UpdateDataSourceRequest(*appsync.UpdateDataSourceInput) appsync.UpdateDataSourceRequest
+	DeleteDataSourceRequest(*appsync.DeleteDataSourceInput) appsync.DeleteDataSourceRequest
+}
+
+// NewDataSourceClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewDataSourceClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DataSourceClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return appsync.New(*cfg), err
+}
+
+func generateDynamodbDataSourceConfig(c *v1alpha1.DynamoDBDataSourceConfig) *appsync.DynamodbDataSourceConfig {
+	if c == nil {
+		return nil
+	}
+	return &appsync.DynamodbDataSourceConfig{
+		TableName:            c.TableName,
+		AwsRegion:            aws.String(c.AWSRegion),
+		UseCallerCredentials: c.UseCallerCredentials,
+	}
+}
+
+func generateLambdaDataSourceConfig(c *v1alpha1.LambdaDataSourceConfig) *appsync.LambdaDataSourceConfig {
+	if c == nil {
+		return nil
+	}
+	return &appsync.LambdaDataSourceConfig{LambdaFunctionArn: aws.String(c.FunctionARN)}
+}
+
+func generateHTTPDataSourceConfig(c *v1alpha1.HTTPDataSourceConfig) *appsync.HttpDataSourceConfig {
+	if c == nil {
+		return nil
+	}
+	return &appsync.HttpDataSourceConfig{Endpoint: aws.String(c.Endpoint)}
+}
+
+// GenerateCreateDataSourceInput returns the input to create a data source
+// with the supplied name and parameters.
+func GenerateCreateDataSourceInput(name string, p v1alpha1.DataSourceParameters) *appsync.CreateDataSourceInput {
+	return &appsync.CreateDataSourceInput{
+		ApiId:          p.APIID,
+		Name:           aws.String(name),
+		Type:           appsync.DataSourceType(p.Type),
+		Description:    p.Description,
+		ServiceRoleArn: p.ServiceRoleARN,
+		DynamodbConfig: generateDynamodbDataSourceConfig(p.DynamoDBConfig),
+		LambdaConfig:   generateLambdaDataSourceConfig(p.LambdaConfig),
+		HttpConfig:     generateHTTPDataSourceConfig(p.HTTPConfig),
+	}
+}
+
+// GenerateUpdateDataSourceInput returns the input to update the data source
+// with the supplied name to the desired parameters.
+func GenerateUpdateDataSourceInput(name string, p v1alpha1.DataSourceParameters) *appsync.UpdateDataSourceInput {
+	return &appsync.UpdateDataSourceInput{
+		ApiId:          p.APIID,
+		Name:           aws.String(name),
+		Type:           appsync.DataSourceType(p.Type),
+		Description:    p.Description,
+		ServiceRoleArn: p.ServiceRoleARN,
+		DynamodbConfig: generateDynamodbDataSourceConfig(p.DynamoDBConfig),
+		LambdaConfig:   generateLambdaDataSourceConfig(p.LambdaConfig),
+		HttpConfig:     generateHTTPDataSourceConfig(p.HTTPConfig),
+	}
+}
+
+// LateInitializeDataSource fills the empty fields in the supplied parameters
+// with the values observed on the data source.
+func LateInitializeDataSource(p *v1alpha1.DataSourceParameters, ds appsync.DataSource) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, ds.Description)
+	if p.DynamoDBConfig != nil && ds.DynamodbConfig != nil {
+		p.DynamoDBConfig.UseCallerCredentials = awsclients.LateInitializeBoolPtr(p.DynamoDBConfig.UseCallerCredentials, ds.DynamodbConfig.UseCallerCredentials)
+	}
+}
+
+// GenerateDataSourceObservation returns the observation of the supplied data
+// source.
+func GenerateDataSourceObservation(ds appsync.DataSource) v1alpha1.DataSourceObservation {
+	return v1alpha1.DataSourceObservation{
+		DataSourceARN: aws.StringValue(ds.DataSourceArn),
+	}
+}
+
+// IsDataSourceUpToDate returns true if the supplied data source matches the
+// desired parameters.
+func IsDataSourceUpToDate(p v1alpha1.DataSourceParameters, ds appsync.DataSource) bool {
+	desired := GenerateUpdateDataSourceInput(aws.StringValue(ds.Name), p)
+	observed := &appsync.UpdateDataSourceInput{
+		ApiId:          p.APIID,
+		Name:           ds.Name,
+		Type:           ds.Type,
+		Description:    ds.Description,
+		ServiceRoleArn: ds.ServiceRoleArn,
+		DynamodbConfig: ds.DynamodbConfig,
+		LambdaConfig:   ds.LambdaConfig,
+		HttpConfig:     ds.HttpConfig,
+	}
+	// AppSync reports the defaults of the DynamoDB settings that are not
+	// exposed as parameters.
+	if observed.DynamodbConfig != nil {
+		c := *observed.DynamodbConfig
+		c.Versioned, c.DeltaSyncConfig = nil, nil
+		observed.DynamodbConfig = &c
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(
+			appsync.UpdateDataSourceInput{},
+			appsync.DynamodbDataSourceConfig{},
+			appsync.LambdaDataSourceConfig{},
+			appsync.HttpDataSourceConfig{},
+		))
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appsync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.GraphQLAPIClient = (*MockGraphQLAPIClient)(nil)
+
+// MockGraphQLAPIClient is a type that implements all the methods for GraphQLAPIClient interface
+type MockGraphQLAPIClient struct {
+	MockCreateGraphqlApi        func(*appsync.CreateGraphqlApiInput) appsync.CreateGraphqlApiRequest
+	MockGetGraphqlApi           func(*appsync.GetGraphqlApiInput) appsync.GetGraphqlApiRequest
+	MockUpdateGraphqlApi        func(*appsync.UpdateGraphqlApiInput) appsync.UpdateGraphqlApiRequest
+	MockDeleteGraphqlApi        func(*appsync.DeleteGraphqlApiInput) appsync.DeleteGraphqlApiRequest
+	MockStartSchemaCreation     func(*appsync.StartSchemaCreationInput) appsync.StartSchemaCreationRequest
+	MockGetSchemaCreationStatus func(*appsync.GetSchemaCreationStatusInput) appsync.GetSchemaCreationStatusRequest
+	MockCreateApiKey            func(*appsync.CreateApiKeyInput) appsync.CreateApiKeyRequest
+	MockListApiKeys             func(*appsync.ListApiKeysInput) appsync.ListApiKeysRequest
+}
+
+// CreateGraphqlApiRequest calls the underlying MockCreateGraphqlApi method.
+func (c *MockGraphQLAPIClient) CreateGraphqlApiRequest(i *appsync.CreateGraphqlApiInput) appsync.CreateGraphqlApiRequest {
+	return c.MockCreateGraphqlApi(i)
+}
+
+// GetGraphqlApiRequest calls the underlying MockGetGraphqlApi method.
+func (c *MockGraphQLAPIClient) GetGraphqlApiRequest(i *appsync.GetGraphqlApiInput) appsync.GetGraphqlApiRequest {
+	return c.MockGetGraphqlApi(i)
+}
+
+// UpdateGraphqlApiRequest calls the underlying MockUpdateGraphqlApi method.
+func (c *MockGraphQLAPIClient) UpdateGraphqlApiRequest(i *appsync.UpdateGraphqlApiInput) appsync.UpdateGraphqlApiRequest {
+	return c.MockUpdateGraphqlApi(i)
+}
+
+// DeleteGraphqlApiRequest calls the underlying MockDeleteGraphqlApi method.
+func (c *MockGraphQLAPIClient) DeleteGraphqlApiRequest(i *appsync.DeleteGraphqlApiInput) appsync.DeleteGraphqlApiRequest {
+	return c.MockDeleteGraphqlApi(i)
+}
+
+// StartSchemaCreationRequest calls the underlying MockStartSchemaCreation method.
+func (c *MockGraphQLAPIClient) StartSchemaCreationRequest(i *appsync.StartSchemaCreationInput) appsync.StartSchemaCreationRequest {
+	return c.MockStartSchemaCreation(i)
+}
+
+// GetSchemaCreationStatusRequest calls the underlying MockGetSchemaCreationStatus method.
+func (c *MockGraphQLAPIClient) GetSchemaCreationStatusRequest(i *appsync.GetSchemaCreationStatusInput) appsync.GetSchemaCreationStatusRequest {
+	return c.MockGetSchemaCreationStatus(i)
+}
+
+// CreateApiKeyRequest calls the underlying MockCreateApiKey method.
+func (c *MockGraphQLAPIClient) CreateApiKeyRequest(i *appsync.CreateApiKeyInput) appsync.CreateApiKeyRequest {
+	return c.MockCreateApiKey(i)
+}
+
+// ListApiKeysRequest calls the underlying MockListApiKeys method.
+func (c *MockGraphQLAPIClient) ListApiKeysRequest(i *appsync.ListApiKeysInput) appsync.ListApiKeysRequest {
+	return c.MockListApiKeys(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/appsync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ResolverClient = (*MockResolverClient)(nil)
+
+// MockResolverClient is a type that implements all the methods for ResolverClient interface
+type MockResolverClient struct {
+	MockCreateResolver func(*appsync.CreateResolverInput) appsync.CreateResolverRequest
+	MockGetResolver    func(*appsync.GetResolverInput) appsync.GetResolverRequest
+	MockUpdateResolver func(*appsync.UpdateResolverInput) appsync.UpdateResolverRequest
+	MockDeleteResolver func(*appsync.DeleteResolverInput) appsync.DeleteResolverRequest
+}
+
+// CreateResolverRequest calls the underlying MockCreateResolver method.
+func (c *MockResolverClient) CreateResolverRequest(i *appsync.CreateResolverInput) appsync.CreateResolverRequest {
+	return c.MockCreateResolver(i)
+}
+
+// GetResolverRequest calls the underlying MockGetResolver method.
+func (c *MockResolverClient) GetResolverRequest(i *appsync.GetResolverInput) appsync.GetResolverRequest {
+	return c.MockGetResolver(i)
+}
+
+// UpdateResolverRequest calls the underlying MockUpdateResolver method.
+func (c *MockResolverClient) UpdateResolverRequest(i *appsync.UpdateResolverInput) appsync.UpdateResolverRequest {
+	return c.MockUpdateResolver(i)
+}
+
+// DeleteResolverRequest calls the underlying MockDeleteResolver method.
+func (c *MockResolverClient) DeleteResolverRequest(i *appsync.DeleteResolverInput) appsync.DeleteResolverRequest {
+	return c.MockDeleteResolver(i)
+}
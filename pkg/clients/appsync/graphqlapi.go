@@ -0,0 +1,266 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appsync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Connection details of a GraphQLAPI.
+const (
+	ConnectionKeyRealtimeEndpoint = "realtimeEndpoint"
+	ConnectionKeyAPIKey           = "apiKey"
+)
+
+// Endpoint types of a GraphQL API.
+const (
+	URIKeyGraphQL  = "GRAPHQL"
+	URIKeyRealtime = "REALTIME"
+)
+
+// GraphQLAPIClient is the external client used for GraphQLAPI Custom Resource
+type GraphQLAPIClient interface {
+	CreateGraphqlApiRequest(*appsync.CreateGraphqlApiInput) appsync.CreateGraphqlApiRequest
+	GetGraphqlApiRequest(*appsync.GetGraphqlApiInput) appsync.GetGraphqlApiRequest
+	UpdateGraphqlApiRequest(*appsync.UpdateGraphqlApiInput) appsync.UpdateGraphqlApiRequest
+	DeleteGraphqlApiRequest(*appsync.DeleteGraphqlApiInput) appsync.DeleteGraphqlApiRequest
+	StartSchemaCreationRequest(*appsync.StartSchemaCreationInput) appsync.StartSchemaCreationRequest
+	GetSchemaCreationStatusRequest(*appsync.GetSchemaCreationStatusInput) appsync.GetSchemaCreationStatusRequest
+	CreateApiKeyRequest(*appsync.CreateApiKeyInput) appsync.CreateApiKeyRequest
+	ListApiKeysRequest(*appsync.ListApiKeysInput) appsync.ListApiKeysRequest
+}
+
+// NewGraphQLAPIClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewGraphQLAPIClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (GraphQLAPIClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return appsync.New(*cfg), err
+}
+
+func generateOpenIDConnectConfig(c *v1alpha1.OpenIDConnectConfig) *appsync.OpenIDConnectConfig {
+	if c == nil {
+		return nil
+	}
+	return &appsync.OpenIDConnectConfig{
+		Issuer:   aws.String(c.Issuer),
+		ClientId: c.ClientID,
+		AuthTTL:  c.AuthTTL,
+		IatTTL:   c.IatTTL,
+	}
+}
+
+func generateUserPoolConfig(c *v1alpha1.UserPoolConfig) *appsync.UserPoolConfig {
+	if c == nil {
+		return nil
+	}
+	return &appsync.UserPoolConfig{
+		UserPoolId:       aws.String(c.UserPoolID),
+		AwsRegion:        aws.String(c.AWSRegion),
+		AppIdClientRegex: c.AppIDClientRegex,
+		DefaultAction:    appsync.DefaultAction(c.DefaultAction),
+	}
+}
+
+func generateAdditionalAuthenticationProviders(ps []v1alpha1.AdditionalAuthenticationProvider) []appsync.AdditionalAuthenticationProvider {
+	if len(ps) == 0 {
+		return nil
+	}
+	res := make([]appsync.AdditionalAuthenticationProvider, len(ps))
+	for i, p := range ps {
+		res[i] = appsync.AdditionalAuthenticationProvider{
+			AuthenticationType:  appsync.AuthenticationType(p.AuthenticationType),
+			OpenIDConnectConfig: generateOpenIDConnectConfig(p.OpenIDConnectConfig),
+		}
+		if p.UserPoolConfig != nil {
+			res[i].UserPoolConfig = &appsync.CognitoUserPoolConfig{
+				UserPoolId:       aws.String(p.UserPoolConfig.UserPoolID),
+				AwsRegion:        aws.String(p.UserPoolConfig.AWSRegion),
+				AppIdClientRegex: p.UserPoolConfig.AppIDClientRegex,
+			}
+		}
+	}
+	return res
+}
+
+func generateLogConfig(c *v1alpha1.LogConfig) *appsync.LogConfig {
+	if c == nil {
+		return nil
+	}
+	return &appsync.LogConfig{
+		CloudWatchLogsRoleArn: c.CloudWatchLogsRoleARN,
+		FieldLogLevel:         appsync.FieldLogLevel(c.FieldLogLevel),
+		ExcludeVerboseContent: c.ExcludeVerboseContent,
+	}
+}
+
+// GenerateCreateGraphqlAPIInput returns the input to create a GraphQL API
+// with the supplied parameters.
+func GenerateCreateGraphqlAPIInput(p v1alpha1.GraphQLAPIParameters) *appsync.CreateGraphqlApiInput {
+	in := &appsync.CreateGraphqlApiInput{
+		Name:                              aws.String(p.Name),
+		AuthenticationType:                appsync.AuthenticationType(p.AuthenticationType),
+		AdditionalAuthenticationProviders: generateAdditionalAuthenticationProviders(p.AdditionalAuthenticationProviders),
+		OpenIDConnectConfig:               generateOpenIDConnectConfig(p.OpenIDConnectConfig),
+		UserPoolConfig:                    generateUserPoolConfig(p.UserPoolConfig),
+		LogConfig:                         generateLogConfig(p.LogConfig),
+		XrayEnabled:                       p.XRayEnabled,
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = p.Tags
+	}
+	return in
+}
+
+// GenerateUpdateGraphqlAPIInput returns the input to update the GraphQL API
+// with the supplied ID to the desired parameters.
+func GenerateUpdateGraphqlAPIInput(id string, p v1alpha1.GraphQLAPIParameters) *appsync.UpdateGraphqlApiInput {
+	return &appsync.UpdateGraphqlApiInput{
+		ApiId:                             aws.String(id),
+		Name:                              aws.String(p.Name),
+		AuthenticationType:                appsync.AuthenticationType(p.AuthenticationType),
+		AdditionalAuthenticationProviders: generateAdditionalAuthenticationProviders(p.AdditionalAuthenticationProviders),
+		OpenIDConnectConfig:               generateOpenIDConnectConfig(p.OpenIDConnectConfig),
+		UserPoolConfig:                    generateUserPoolConfig(p.UserPoolConfig),
+		LogConfig:                         generateLogConfig(p.LogConfig),
+		XrayEnabled:                       p.XRayEnabled,
+	}
+}
+
+// LateInitializeGraphQLAPI fills the empty fields in the supplied parameters
+// with the values observed on the GraphQL API.
+func LateInitializeGraphQLAPI(p *v1alpha1.GraphQLAPIParameters, api appsync.GraphqlApi) {
+	p.XRayEnabled = awsclients.LateInitializeBoolPtr(p.XRayEnabled, api.XrayEnabled)
+	if p.LogConfig != nil && api.LogConfig != nil {
+		p.LogConfig.ExcludeVerboseContent = awsclients.LateInitializeBoolPtr(p.LogConfig.ExcludeVerboseContent, api.LogConfig.ExcludeVerboseContent)
+	}
+}
+
+// GenerateGraphQLAPIObservation returns the observation of the supplied
+// GraphQL API.
+func GenerateGraphQLAPIObservation(api appsync.GraphqlApi) v1alpha1.GraphQLAPIObservation {
+	return v1alpha1.GraphQLAPIObservation{
+		ARN:  aws.StringValue(api.Arn),
+		URIs: api.Uris,
+	}
+}
+
+// GetGraphQLAPIConnectionDetails returns the endpoints of the supplied
+// GraphQL API as connection details.
+func GetGraphQLAPIConnectionDetails(api appsync.GraphqlApi) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{}
+	if v, ok := api.Uris[URIKeyGraphQL]; ok {
+		conn[runtimev1alpha1.ResourceCredentialsSecretEndpointKey] = []byte(v)
+	}
+	if v, ok := api.Uris[URIKeyRealtime]; ok {
+		conn[ConnectionKeyRealtimeEndpoint] = []byte(v)
+	}
+	return conn
+}
+
+// IsGraphQLAPIUpToDate returns true if the supplied GraphQL API matches the
+// desired parameters.
+func IsGraphQLAPIUpToDate(p v1alpha1.GraphQLAPIParameters, api appsync.GraphqlApi) bool {
+	desired := GenerateUpdateGraphqlAPIInput(aws.StringValue(api.ApiId), p)
+	observed := &appsync.UpdateGraphqlApiInput{
+		ApiId:                             api.ApiId,
+		Name:                              api.Name,
+		AuthenticationType:                api.AuthenticationType,
+		AdditionalAuthenticationProviders: api.AdditionalAuthenticationProviders,
+		OpenIDConnectConfig:               api.OpenIDConnectConfig,
+		UserPoolConfig:                    api.UserPoolConfig,
+		LogConfig:                         api.LogConfig,
+		XrayEnabled:                       api.XrayEnabled,
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(
+			appsync.UpdateGraphqlApiInput{},
+			appsync.AdditionalAuthenticationProvider{},
+			appsync.OpenIDConnectConfig{},
+			appsync.CognitoUserPoolConfig{},
+			appsync.UserPoolConfig{},
+			appsync.LogConfig{},
+		))
+}
+
+// UsesAPIKey returns true if the supplied parameters enable API_KEY
+// authentication.
+func UsesAPIKey(p v1alpha1.GraphQLAPIParameters) bool {
+	if p.AuthenticationType == v1alpha1.AuthenticationTypeAPIKey {
+		return true
+	}
+	for _, a := range p.AdditionalAuthenticationProviders {
+		if a.AuthenticationType == v1alpha1.AuthenticationTypeAPIKey {
+			return true
+		}
+	}
+	return false
+}
+
+// ListAPIKeys returns the API keys of the GraphQL API with the supplied ID.
+func ListAPIKeys(ctx context.Context, c GraphQLAPIClient, id string) ([]appsync.ApiKey, error) {
+	input := &appsync.ListApiKeysInput{ApiId: aws.String(id)}
+	var keys []appsync.ApiKey
+	for {
+		rsp, err := c.ListApiKeysRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, rsp.ApiKeys...)
+		if aws.StringValue(rsp.NextToken) == "" {
+			return keys, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+// ValidAPIKey returns the API key among the supplied ones that expires the
+// latest, or nil if they all expired before the supplied time.
+func ValidAPIKey(keys []appsync.ApiKey, now time.Time) *appsync.ApiKey {
+	var res *appsync.ApiKey
+	for i := range keys {
+		if aws.Int64Value(keys[i].Expires) <= now.Unix() {
+			continue
+		}
+		if res == nil || aws.Int64Value(keys[i].Expires) > aws.Int64Value(res.Expires) {
+			res = &keys[i]
+		}
+	}
+	return res
+}
+
+// SchemaChecksum returns the SHA-256 checksum of the supplied schema.
+func SchemaChecksum(schema string) string {
+	sum := sha256.Sum256([]byte(schema))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+)
+
+func TestIsGraphQLAPIUpToDate(t *testing.T) {
+	params := v1alpha1.GraphQLAPIParameters{
+		Name:               "my-api",
+		AuthenticationType: "AMAZON_COGNITO_USER_POOLS",
+		UserPoolConfig: &v1alpha1.UserPoolConfig{
+			CognitoUserPoolConfig: v1alpha1.CognitoUserPoolConfig{UserPoolID: "pool", AWSRegion: "us-east-1"},
+			DefaultAction:         "ALLOW",
+		},
+		AdditionalAuthenticationProviders: []v1alpha1.AdditionalAuthenticationProvider{{AuthenticationType: "API_KEY"}},
+	}
+	observed := appsync.GraphqlApi{
+		ApiId:              aws.String("id"),
+		Name:               aws.String("my-api"),
+		AuthenticationType: appsync.AuthenticationTypeAmazonCognitoUserPools,
+		UserPoolConfig: &appsync.UserPoolConfig{
+			UserPoolId:    aws.String("pool"),
+			AwsRegion:     aws.String("us-east-1"),
+			DefaultAction: appsync.DefaultActionAllow,
+		},
+		AdditionalAuthenticationProviders: []appsync.AdditionalAuthenticationProvider{{AuthenticationType: appsync.AuthenticationTypeApiKey}},
+		Uris:                              map[string]string{URIKeyGraphQL: "https://example.com/graphql"},
+	}
+
+	cases := map[string]struct {
+		params func(*v1alpha1.GraphQLAPIParameters)
+		want   bool
+	}{
+		"UpToDate": {
+			params: func(*v1alpha1.GraphQLAPIParameters) {},
+			want:   true,
+		},
+		"DefaultActionChanged": {
+			params: func(p *v1alpha1.GraphQLAPIParameters) { p.UserPoolConfig.DefaultAction = "DENY" },
+			want:   false,
+		},
+		"ProviderRemoved": {
+			params: func(p *v1alpha1.GraphQLAPIParameters) { p.AdditionalAuthenticationProviders = nil },
+			want:   false,
+		},
+		"XRayEnabled": {
+			params: func(p *v1alpha1.GraphQLAPIParameters) { p.XRayEnabled = aws.Bool(true) },
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := *params.DeepCopy()
+			tc.params(&p)
+			if diff := cmp.Diff(tc.want, IsGraphQLAPIUpToDate(p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidAPIKey(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	cases := map[string]struct {
+		keys []appsync.ApiKey
+		want *appsync.ApiKey
+	}{
+		"NoKeys": {},
+		"AllExpired": {
+			keys: []appsync.ApiKey{{Id: aws.String("a"), Expires: aws.Int64(999)}},
+		},
+		"LatestExpiry": {
+			keys: []appsync.ApiKey{
+				{Id: aws.String("a"), Expires: aws.Int64(999)},
+				{Id: aws.String("b"), Expires: aws.Int64(3000)},
+				{Id: aws.String("c"), Expires: aws.Int64(2000)},
+			},
+			want: &appsync.ApiKey{Id: aws.String("b"), Expires: aws.Int64(3000)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ValidAPIKey(tc.keys, now)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appsync
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ResolverClient is the external client used for Resolver Custom Resource
+type ResolverClient interface {
+	CreateResolverRequest(*appsync.CreateResolverInput) appsync.CreateResolverRequest
+	GetResolverRequest(*appsync.GetResolverInput) appsync.GetResolverRequest
+	UpdateResolverRequest(*appsync.UpdateResolverInput) appsync.UpdateResolverRequest
+	DeleteResolverRequest(*appsync.DeleteResolverInput) appsync.DeleteResolverRequest
+}
+
+// NewResolverClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewResolverClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ResolverClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return appsync.New(*cfg), err
+}
+
+func generateCachingConfig(c *v1alpha1.CachingConfig) *appsync.CachingConfig {
+	if c == nil {
+		return nil
+	}
+	return &appsync.CachingConfig{
+		Ttl:         c.TTL,
+		CachingKeys: c.CachingKeys,
+	}
+}
+
+func generatePipelineConfig(functions []string) *appsync.PipelineConfig {
+	if len(functions) == 0 {
+		return nil
+	}
+	return &appsync.PipelineConfig{Functions: functions}
+}
+
+// GenerateCreateResolverInput returns the input to create a resolver with
+// the supplied parameters.
+func GenerateCreateResolverInput(p v1alpha1.ResolverParameters) *appsync.CreateResolverInput {
+	return &appsync.CreateResolverInput{
+		ApiId:                   p.APIID,
+		TypeName:                aws.String(p.TypeName),
+		FieldName:               aws.String(p.FieldName),
+		Kind:                    appsync.ResolverKind(aws.StringValue(p.Kind)),
+		DataSourceName:          p.DataSourceName,
+		PipelineConfig:          generatePipelineConfig(p.PipelineFunctions),
+		RequestMappingTemplate:  aws.String(p.RequestMappingTemplate),
+		ResponseMappingTemplate: p.ResponseMappingTemplate,
+		CachingConfig:           generateCachingConfig(p.CachingConfig),
+	}
+}
+
+// GenerateUpdateResolverInput returns the input to update a resolver to the
+// desired parameters.
+func GenerateUpdateResolverInput(p v1alpha1.ResolverParameters) *appsync.UpdateResolverInput {
+	return &appsync.UpdateResolverInput{
+		ApiId:                   p.APIID,
+		TypeName:                aws.String(p.TypeName),
+		FieldName:               aws.String(p.FieldName),
+		Kind:                    appsync.ResolverKind(aws.StringValue(p.Kind)),
+		DataSourceName:          p.DataSourceName,
+		PipelineConfig:          generatePipelineConfig(p.PipelineFunctions),
+		RequestMappingTemplate:  aws.String(p.RequestMappingTemplate),
+		ResponseMappingTemplate: p.ResponseMappingTemplate,
+		CachingConfig:           generateCachingConfig(p.CachingConfig),
+	}
+}
+
+// LateInitializeResolver fills the empty fields in the supplied parameters
+// with the values observed on the resolver.
+func LateInitializeResolver(p *v1alpha1.ResolverParameters, r appsync.Resolver) {
+	if p.Kind == nil && r.Kind != "" {
+		p.Kind = aws.String(string(r.Kind))
+	}
+}
+
+// GenerateResolverObservation returns the observation of the supplied
+// resolver.
+func GenerateResolverObservation(r appsync.Resolver) v1alpha1.ResolverObservation {
+	return v1alpha1.ResolverObservation{
+		ResolverARN: aws.StringValue(r.ResolverArn),
+	}
+}
+
+// IsResolverUpToDate returns true if the supplied resolver matches the
+// desired parameters.
+func IsResolverUpToDate(p v1alpha1.ResolverParameters, r appsync.Resolver) bool {
+	desired := GenerateUpdateResolverInput(p)
+	observed := &appsync.UpdateResolverInput{
+		ApiId:                   p.APIID,
+		TypeName:                r.TypeName,
+		FieldName:               r.FieldName,
+		Kind:                    r.Kind,
+		DataSourceName:          r.DataSourceName,
+		PipelineConfig:          r.PipelineConfig,
+		RequestMappingTemplate:  r.RequestMappingTemplate,
+		ResponseMappingTemplate: r.ResponseMappingTemplate,
+		CachingConfig:           r.CachingConfig,
+	}
+	if observed.PipelineConfig != nil && len(observed.PipelineConfig.Functions) == 0 {
+		observed.PipelineConfig = nil
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(
+			appsync.UpdateResolverInput{},
+			appsync.PipelineConfig{},
+			appsync.CachingConfig{},
+		))
+}
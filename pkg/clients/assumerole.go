@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+)
+
+type assumeRoleKey struct{}
+
+// WithAssumeRole returns a copy of the supplied context that carries the ARN
+// of an IAM role. UseProviderSecret and UsePodServiceAccount make the
+// configurations they create assume the role carried by their context, using
+// the credentials they would otherwise use.
+func WithAssumeRole(ctx context.Context, arn string) context.Context {
+	if arn == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, assumeRoleKey{}, arn)
+}
+
+func assumeRole(ctx context.Context) string {
+	arn, _ := ctx.Value(assumeRoleKey{}).(string)
+	return arn
+}
+
+type webIdentityKey struct{}
+
+// WithWebIdentity returns a copy of the supplied context that carries the
+// supplied web identity configuration. UsePodServiceAccount assumes the role
+// of the configuration carried by its context with its token, rather than the
+// role and token of the AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE
+// environment variables.
+func WithWebIdentity(ctx context.Context, wi *v1alpha3.WebIdentityConfig) context.Context {
+	if wi == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, webIdentityKey{}, wi)
+}
+
+// webIdentity returns the ARN of the role UsePodServiceAccount assumes and
+// the path of the token it assumes it with.
+func webIdentity(ctx context.Context) (roleARN, tokenPath string) {
+	roleARN, tokenPath = os.Getenv("AWS_ROLE_ARN"), os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	wi, ok := ctx.Value(webIdentityKey{}).(*v1alpha3.WebIdentityConfig)
+	if !ok {
+		return roleARN, tokenPath
+	}
+	if wi.TokenPath != nil {
+		tokenPath = *wi.TokenPath
+	}
+	return wi.RoleARN, tokenPath
+}
+
+// WithProvider returns a copy of the supplied context that carries the
+// transport options, the web identity and the role to assume configured by
+// the supplied Provider, so that clients created with it connect and
+// authenticate to AWS the way the Provider asks them to. Their requests are
+// subject to circuits of their own, shared only with other clients of the
+// same Provider.
+func WithProvider(ctx context.Context, kube client.Reader, p *v1alpha3.Provider) (context.Context, error) {
+	ctx, err := WithProviderTransport(ctx, kube, p)
+	if err != nil {
+		return ctx, err
+	}
+	ctx = WithCircuitScope(ctx, p.GetName())
+	ctx = WithWebIdentity(ctx, p.Spec.WebIdentity)
+	return WithAssumeRole(ctx, aws.StringValue(p.Spec.AssumeRoleARN)), nil
+}
+
+// applyAssumeRole makes the supplied configuration assume the role carried by
+// the supplied context, if any. The role is assumed using the credentials and
+// HTTP client of the configuration, and assumed again before the credentials
+// it returned expire. Configurations created for the same Provider share the
+// credentials of each role until they expire.
+func applyAssumeRole(ctx context.Context, cfg *aws.Config) {
+	arn := assumeRole(ctx)
+	if arn == "" {
+		return
+	}
+	// The circuit scope of a context is the name of its Provider.
+	provider := circuitScope(ctx)
+	if provider == "" {
+		cfg.Credentials = stscreds.NewAssumeRoleProvider(sts.New(*cfg), arn)
+		return
+	}
+	cfg.Credentials = assumedRoles.get(provider, arn, sts.New(*cfg))
+}
+
+var assumedRoles = newRoleCache()
+
+// A roleCache holds the credentials provider of each role assumed for each
+// Provider.
+type roleCache struct {
+	mu    sync.Mutex
+	roles map[roleKey]*assumedRole
+}
+
+type roleKey struct {
+	provider string
+	arn      string
+}
+
+func newRoleCache() *roleCache {
+	return &roleCache{roles: map[roleKey]*assumedRole{}}
+}
+
+// get returns the credentials provider of the supplied role of the named
+// Provider, which assumes the role using the supplied STS client once its
+// credentials expire.
+func (c *roleCache) get(provider, arn string, client stscreds.AssumeRoler) aws.CredentialsProvider {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	k := roleKey{provider: provider, arn: arn}
+	r, ok := c.roles[k]
+	if !ok {
+		r = &assumedRole{}
+		r.AssumeRoleProvider = stscreds.NewAssumeRoleProvider(r, arn)
+		c.roles[k] = r
+	}
+	r.setClient(client)
+	return r
+}
+
+// An assumedRole assumes a role using the STS client it was last supplied, so
+// that its credentials may be shared by configurations whose own credentials
+// or HTTP client changed.
+type assumedRole struct {
+	*stscreds.AssumeRoleProvider
+
+	mu     sync.RWMutex
+	client stscreds.AssumeRoler
+}
+
+func (r *assumedRole) setClient(c stscreds.AssumeRoler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.client = c
+}
+
+// AssumeRoleRequest returns a request of the STS client r was last supplied.
+func (r *assumedRole) AssumeRoleRequest(input *sts.AssumeRoleInput) sts.AssumeRoleRequest {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.client.AssumeRoleRequest(input)
+}
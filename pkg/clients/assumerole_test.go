@@ -0,0 +1,197 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+)
+
+const (
+	staticKeyID  = "static"
+	assumedKeyID = "assumed"
+	roleARN      = "arn:aws:iam::123456789012:role/crossplane"
+)
+
+func TestApplyAssumeRole(t *testing.T) {
+	var assumed string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		assumed = r.Form.Get("RoleArn")
+		fmt.Fprintf(w, `<AssumeRoleResponse><AssumeRoleResult><Credentials>
+<AccessKeyId>%s</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken>
+<Expiration>2100-01-01T00:00:00Z</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`, assumedKeyID)
+	}))
+	defer srv.Close()
+
+	type want struct {
+		keyID   string
+		assumed string
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      *v1alpha3.Provider
+		want   want
+	}{
+		"NoRole": {
+			reason: "The credentials of a configuration should be used as they are when the Provider assumes no role.",
+			p:      &v1alpha3.Provider{},
+			want:   want{keyID: staticKeyID},
+		},
+		"AssumeRole": {
+			reason: "The role of the Provider should be assumed using the credentials of a configuration.",
+			p:      &v1alpha3.Provider{Spec: v1alpha3.ProviderSpec{AssumeRoleARN: aws.String(roleARN)}},
+			want:   want{keyID: assumedKeyID, assumed: roleARN},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assumed = ""
+			ctx, err := WithProvider(context.Background(), &test.MockClient{}, tc.p)
+			if err != nil {
+				t.Fatalf("WithProvider(...): %s", err)
+			}
+			cfg := defaults.Config()
+			cfg.Region = "us-east-1"
+			cfg.Credentials = aws.NewStaticCredentialsProvider(staticKeyID, "secret", "")
+			cfg.EndpointResolver = aws.ResolveWithEndpointURL(srv.URL)
+			applyAssumeRole(ctx, &cfg)
+
+			creds, err := cfg.Credentials.Retrieve(context.Background())
+			if err != nil {
+				t.Fatalf("Retrieve(...): %s", err)
+			}
+			got := want{keyID: creds.AccessKeyID, assumed: assumed}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\napplyAssumeRole(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAssumedRoleCache(t *testing.T) {
+	assumedRoles = newRoleCache()
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprintf(w, `<AssumeRoleResponse><AssumeRoleResult><Credentials>
+<AccessKeyId>%s</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken>
+<Expiration>2100-01-01T00:00:00Z</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`, assumedKeyID)
+	}))
+	defer srv.Close()
+
+	retrieve := func(provider string) {
+		p := &v1alpha3.Provider{Spec: v1alpha3.ProviderSpec{AssumeRoleARN: aws.String(roleARN)}}
+		p.SetName(provider)
+		ctx, err := WithProvider(context.Background(), &test.MockClient{}, p)
+		if err != nil {
+			t.Fatalf("WithProvider(...): %s", err)
+		}
+		cfg := defaults.Config()
+		cfg.Region = "us-east-1"
+		cfg.Credentials = aws.NewStaticCredentialsProvider(staticKeyID, "secret", "")
+		cfg.EndpointResolver = aws.ResolveWithEndpointURL(srv.URL)
+		applyAssumeRole(ctx, &cfg)
+		if _, err := cfg.Credentials.Retrieve(context.Background()); err != nil {
+			t.Fatalf("Retrieve(...): %s", err)
+		}
+	}
+
+	retrieve("cool-provider")
+	retrieve("cool-provider")
+	if diff := cmp.Diff(1, calls); diff != "" {
+		t.Errorf("applyAssumeRole(...): the credentials of a role should be reused by the same Provider: -want calls, +got calls:\n%s", diff)
+	}
+	retrieve("other-provider")
+	if diff := cmp.Diff(2, calls); diff != "" {
+		t.Errorf("applyAssumeRole(...): the credentials of a role should not be shared by Providers: -want calls, +got calls:\n%s", diff)
+	}
+}
+
+func TestWebIdentity(t *testing.T) {
+	const (
+		envRoleARN   = "arn:aws:iam::123456789012:role/env"
+		envTokenPath = "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"
+		tokenPath    = "/var/run/secrets/tenant/token"
+	)
+
+	type want struct {
+		roleARN   string
+		tokenPath string
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      *v1alpha3.Provider
+		want   want
+	}{
+		"Environment": {
+			reason: "The role and token injected into the environment should be used when the Provider configures no web identity.",
+			p:      &v1alpha3.Provider{},
+			want:   want{roleARN: envRoleARN, tokenPath: envTokenPath},
+		},
+		"Role": {
+			reason: "The role of the Provider should be assumed with the token injected into the environment.",
+			p: &v1alpha3.Provider{Spec: v1alpha3.ProviderSpec{
+				WebIdentity: &v1alpha3.WebIdentityConfig{RoleARN: roleARN},
+			}},
+			want: want{roleARN: roleARN, tokenPath: envTokenPath},
+		},
+		"RoleAndToken": {
+			reason: "The role of the Provider should be assumed with the token of the Provider.",
+			p: &v1alpha3.Provider{Spec: v1alpha3.ProviderSpec{
+				WebIdentity: &v1alpha3.WebIdentityConfig{RoleARN: roleARN, TokenPath: aws.String(tokenPath)},
+			}},
+			want: want{roleARN: roleARN, tokenPath: tokenPath},
+		},
+	}
+
+	for k, v := range map[string]string{"AWS_ROLE_ARN": envRoleARN, "AWS_WEB_IDENTITY_TOKEN_FILE": envTokenPath} {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatalf("Setenv(...): %s", err)
+		}
+		defer os.Unsetenv(k) // nolint:errcheck
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, err := WithProvider(context.Background(), &test.MockClient{}, tc.p)
+			if err != nil {
+				t.Fatalf("WithProvider(...): %s", err)
+			}
+			r, p := webIdentity(ctx)
+			got := want{roleARN: r, tokenPath: p}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nwebIdentity(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
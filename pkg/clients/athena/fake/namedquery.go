@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/athena"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.NamedQueryClient = (*MockNamedQueryClient)(nil)
+
+// MockNamedQueryClient is a type that implements all the methods for NamedQueryClient interface
+type MockNamedQueryClient struct {
+	MockCreateNamedQuery func(*athena.CreateNamedQueryInput) athena.CreateNamedQueryRequest
+	MockGetNamedQuery    func(*athena.GetNamedQueryInput) athena.GetNamedQueryRequest
+	MockDeleteNamedQuery func(*athena.DeleteNamedQueryInput) athena.DeleteNamedQueryRequest
+}
+
+// CreateNamedQueryRequest calls the underlying MockCreateNamedQuery method.
+func (c *MockNamedQueryClient) CreateNamedQueryRequest(i *athena.CreateNamedQueryInput) athena.CreateNamedQueryRequest {
+	return c.MockCreateNamedQuery(i)
+}
+
+// GetNamedQueryRequest calls the underlying MockGetNamedQuery method.
+func (c *MockNamedQueryClient) GetNamedQueryRequest(i *athena.GetNamedQueryInput) athena.GetNamedQueryRequest {
+	return c.MockGetNamedQuery(i)
+}
+
+// DeleteNamedQueryRequest calls the underlying MockDeleteNamedQuery method.
+func (c *MockNamedQueryClient) DeleteNamedQueryRequest(i *athena.DeleteNamedQueryInput) athena.DeleteNamedQueryRequest {
+	return c.MockDeleteNamedQuery(i)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package athena
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+
+	"github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// NamedQueryClient is the external client used for NamedQuery Custom
+// Resource
+type NamedQueryClient interface {
+	CreateNamedQueryRequest(*athena.CreateNamedQueryInput) athena.CreateNamedQueryRequest
+	GetNamedQueryRequest(*athena.GetNamedQueryInput) athena.GetNamedQueryRequest
+	DeleteNamedQueryRequest(*athena.DeleteNamedQueryInput) athena.DeleteNamedQueryRequest
+}
+
+// NewNamedQueryClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewNamedQueryClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (NamedQueryClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return athena.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the Athena resource doesn't
+// exist. Athena reports an unknown named query ID as an invalid request.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == athena.ErrCodeResourceNotFoundException ||
+			awsErr.Code() == athena.ErrCodeInvalidRequestException
+	}
+	return false
+}
+
+// GenerateCreateNamedQueryInput returns the input to create a named query from
+// the supplied parameters. The client request token makes retries of the same
+// creation idempotent.
+func GenerateCreateNamedQueryInput(token string, p v1alpha1.NamedQueryParameters) *athena.CreateNamedQueryInput {
+	return &athena.CreateNamedQueryInput{
+		ClientRequestToken: aws.String(token),
+		Database:           aws.String(p.Database),
+		Description:        p.Description,
+		Name:               aws.String(p.Name),
+		QueryString:        aws.String(p.QueryString),
+		WorkGroup:          p.WorkGroup,
+	}
+}
+
+// LateInitializeNamedQuery fills the empty fields in
+// *v1alpha1.NamedQueryParameters with the values seen in athena.NamedQuery.
+func LateInitializeNamedQuery(in *v1alpha1.NamedQueryParameters, q *athena.NamedQuery) {
+	if q == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, q.Description)
+	in.WorkGroup = awsclients.LateInitializeStringPtr(in.WorkGroup, q.WorkGroup)
+}
@@ -23,7 +23,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/url"
-	"os"
 	"strconv"
 	"time"
 
@@ -90,7 +89,7 @@ func CredentialsIDSecret(data []byte, profile string) (aws.Credentials, error) {
 type AuthMethod func(context.Context, []byte, string, string) (*aws.Config, error)
 
 // UseProviderSecret - AWS configuration which can be used to issue requests against AWS API
-func UseProviderSecret(_ context.Context, data []byte, profile, region string) (*aws.Config, error) {
+func UseProviderSecret(ctx context.Context, data []byte, profile, region string) (*aws.Config, error) {
 	creds, err := CredentialsIDSecret(data, profile)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
@@ -102,11 +101,21 @@ func UseProviderSecret(_ context.Context, data []byte, profile, region string) (
 	}
 
 	config, err := external.LoadDefaultAWSConfig(shared)
-	return &config, err
+	DefaultCircuitBreaker.ApplyScoped(&config, circuitScope(ctx))
+	if err != nil {
+		return &config, err
+	}
+	if err := applyTransportOptions(ctx, &config); err != nil {
+		return &config, err
+	}
+	applyAssumeRole(ctx, &config)
+	return &config, nil
 }
 
 // UsePodServiceAccount assumes an IAM role configured via a ServiceAccount.
 // https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
+// The role and token are those of the web identity carried by the supplied
+// context, if any, and those EKS injects into the environment otherwise.
 //
 // TODO(hasheddan): This should be replaced by the implementation of the Web
 // Identity Token Provider in the following PR after merge and subsequent
@@ -117,15 +126,18 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
 	cfg.Region = region
+	if err := applyTransportOptions(ctx, &cfg); err != nil {
+		return nil, err
+	}
 	svc := sts.New(cfg)
 
-	b, err := ioutil.ReadFile(os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"))
+	role, path := webIdentity(ctx)
+	b, err := ioutil.ReadFile(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to read web identity token file in pod")
 	}
 	token := string(b)
 	sess := strconv.FormatInt(time.Now().UnixNano(), 10)
-	role := os.Getenv("AWS_ROLE_ARN")
 	resp, err := svc.AssumeRoleWithWebIdentityRequest(
 		&sts.AssumeRoleWithWebIdentityInput{
 			RoleSessionName:  &sess,
@@ -145,7 +157,15 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 		Region:      region,
 	}
 	config, err := external.LoadDefaultAWSConfig(shared)
-	return &config, err
+	DefaultCircuitBreaker.ApplyScoped(&config, circuitScope(ctx))
+	if err != nil {
+		return &config, err
+	}
+	if err := applyTransportOptions(ctx, &config); err != nil {
+		return &config, err
+	}
+	applyAssumeRole(ctx, &config)
+	return &config, nil
 }
 
 // TODO(muvaf): All the types that use CreateJSONPatch are known during
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+)
+
+const (
+	// ErrCodeCircuitOpen is the code of the error returned for requests that
+	// are rejected because the circuit breaker of their service is open.
+	ErrCodeCircuitOpen = errorutils.ErrCodeCircuitOpen
+
+	breakerHandlerName = "crossplane.CircuitBreaker"
+)
+
+// DefaultCircuitBreaker is the circuit breaker applied to every AWS
+// configuration created by this package.
+var DefaultCircuitBreaker = NewCircuitBreaker()
+
+// A CircuitBreaker tracks the rate at which requests to each AWS service are
+// throttled or fail with a server error. When that rate exceeds a threshold
+// all requests to the service, in the same region and scope, are rejected
+// without being sent until a cool down period has passed. Configurations are
+// scoped by the Provider they were created for, so that one Provider, which
+// is usually one AWS account, being throttled does not back off the requests
+// of another. Rejected requests fail with an errorutils.CircuitOpenError,
+// which the managed resource reconciler surfaces in the Synced condition of
+// the affected resources, and errorutils.NewConnecter in their CircuitOpen
+// condition.
+type CircuitBreaker struct {
+	// Window is the period over which request outcomes are counted.
+	Window time.Duration
+
+	// MinRequests is the number of requests that must be made within a
+	// window before the circuit may open.
+	MinRequests int
+
+	// Threshold is the fraction of requests, between 0 and 1, that must be
+	// throttled or fail with a server error within a window for the circuit
+	// to open.
+	Threshold float64
+
+	// CoolDown is how long requests are rejected once the circuit opens.
+	CoolDown time.Duration
+
+	now func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+type circuit struct {
+	start     time.Time
+	requests  int
+	failures  int
+	openUntil time.Time
+}
+
+// NewCircuitBreaker returns a CircuitBreaker that opens for a minute when at
+// least half of at least 20 requests made within a minute were throttled or
+// failed with a server error.
+func NewCircuitBreaker() *CircuitBreaker {
+	return &CircuitBreaker{
+		Window:      time.Minute,
+		MinRequests: 20,
+		Threshold:   0.5,
+		CoolDown:    time.Minute,
+		now:         time.Now,
+		circuits:    map[string]*circuit{},
+	}
+}
+
+type circuitScopeKey struct{}
+
+// WithCircuitScope returns a copy of the supplied context that carries the
+// scope of a circuit breaker, such as the name of a Provider. UseProviderSecret
+// and UsePodServiceAccount apply DefaultCircuitBreaker to the configurations
+// they create in the scope carried by their context.
+func WithCircuitScope(ctx context.Context, scope string) context.Context {
+	return context.WithValue(ctx, circuitScopeKey{}, scope)
+}
+
+func circuitScope(ctx context.Context) string {
+	scope, _ := ctx.Value(circuitScopeKey{}).(string)
+	return scope
+}
+
+// Apply adds the circuit breaker's handlers to the supplied configuration.
+// Applying a circuit breaker more than once replaces its handlers.
+func (b *CircuitBreaker) Apply(cfg *aws.Config) {
+	b.ApplyScoped(cfg, "")
+}
+
+// ApplyScoped adds the circuit breaker's handlers to the supplied
+// configuration. Requests made with it share circuits only with requests made
+// with configurations of the same scope. Applying a circuit breaker more than
+// once replaces its handlers.
+func (b *CircuitBreaker) ApplyScoped(cfg *aws.Config, scope string) {
+	cfg.Handlers.Validate.RemoveByName(breakerHandlerName)
+	cfg.Handlers.Validate.PushFrontNamed(aws.NamedHandler{Name: breakerHandlerName, Fn: func(r *aws.Request) { b.reject(scope, r) }})
+	cfg.Handlers.CompleteAttempt.RemoveByName(breakerHandlerName)
+	cfg.Handlers.CompleteAttempt.PushBackNamed(aws.NamedHandler{Name: breakerHandlerName, Fn: func(r *aws.Request) { b.record(scope, r) }})
+}
+
+func circuitKey(scope string, r *aws.Request) string {
+	return scope + "/" + r.Config.Region + "/" + r.Metadata.ServiceName
+}
+
+func (b *CircuitBreaker) reject(scope string, r *aws.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.circuits[circuitKey(scope, r)]
+	if !ok || !b.now().Before(c.openUntil) {
+		return
+	}
+	r.Error = &errorutils.CircuitOpenError{Service: r.Metadata.ServiceName, Region: r.Config.Region, Until: c.openUntil}
+}
+
+func (b *CircuitBreaker) record(scope string, r *aws.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	k := circuitKey(scope, r)
+	c, ok := b.circuits[k]
+	if !ok || now.Sub(c.start) > b.Window {
+		c = &circuit{start: now, openUntil: timeOrZero(c)}
+		b.circuits[k] = c
+	}
+	c.requests++
+	if isThrottledOrServerError(r) {
+		c.failures++
+	}
+	if c.requests >= b.MinRequests && float64(c.failures) >= b.Threshold*float64(c.requests) {
+		c.openUntil = now.Add(b.CoolDown)
+		c.start, c.requests, c.failures = now, 0, 0
+	}
+}
+
+func timeOrZero(c *circuit) time.Time {
+	if c == nil {
+		return time.Time{}
+	}
+	return c.openUntil
+}
+
+func isThrottledOrServerError(r *aws.Request) bool {
+	if r.HTTPResponse != nil && (r.HTTPResponse.StatusCode == http.StatusTooManyRequests || r.HTTPResponse.StatusCode >= http.StatusInternalServerError) {
+		return true
+	}
+	return errorutils.IsThrottled(r.Error)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+)
+
+type outcome struct {
+	status int
+	err    error
+}
+
+func request(service string, o outcome) *aws.Request {
+	r := &aws.Request{
+		Config:   aws.Config{Region: "us-east-1"},
+		Metadata: aws.Metadata{ServiceName: service},
+		Error:    o.err,
+	}
+	if o.status != 0 {
+		r.HTTPResponse = &http.Response{StatusCode: o.status}
+	}
+	return r
+}
+
+func repeat(n int, o outcome) []outcome {
+	out := make([]outcome, n)
+	for i := range out {
+		out[i] = o
+	}
+	return out
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	throttled := outcome{status: http.StatusBadRequest, err: awserr.New("Throttling", "Rate exceeded", nil)}
+	unavailable := outcome{status: http.StatusServiceUnavailable}
+	ok := outcome{status: http.StatusOK}
+
+	type want struct {
+		rejected              bool
+		otherRejected         bool
+		otherProviderRejected bool
+	}
+
+	cases := map[string]struct {
+		reason   string
+		outcomes []outcome
+		elapsed  time.Duration
+		want     want
+	}{
+		"Healthy": {
+			reason:   "The circuit should stay closed when requests succeed.",
+			outcomes: repeat(50, ok),
+		},
+		"TooFewRequests": {
+			reason:   "The circuit should stay closed until enough requests were made.",
+			outcomes: repeat(19, throttled),
+		},
+		"Throttled": {
+			reason:   "The circuit should open when most requests are throttled.",
+			outcomes: repeat(20, throttled),
+			want:     want{rejected: true},
+		},
+		"ServerErrors": {
+			reason:   "The circuit should open when most requests fail with a server error.",
+			outcomes: append(repeat(10, ok), repeat(10, unavailable)...),
+			want:     want{rejected: true},
+		},
+		"BelowThreshold": {
+			reason:   "The circuit should stay closed when few requests fail.",
+			outcomes: append(repeat(15, ok), repeat(5, unavailable)...),
+		},
+		"CooledDown": {
+			reason:   "The circuit should close once the cool down period has passed.",
+			outcomes: repeat(20, throttled),
+			elapsed:  2 * time.Minute,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			b := NewCircuitBreaker()
+			b.now = func() time.Time { return now }
+
+			for _, o := range tc.outcomes {
+				b.record("provider", request("ec2", o))
+			}
+			now = now.Add(tc.elapsed)
+
+			r := request("ec2", ok)
+			b.reject("provider", r)
+			other := request("iam", ok)
+			b.reject("provider", other)
+			otherProvider := request("ec2", ok)
+			b.reject("other-provider", otherProvider)
+
+			got := want{rejected: r.Error != nil, otherRejected: other.Error != nil, otherProviderRejected: otherProvider.Error != nil}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nr: -want, +got:\n%s", tc.reason, diff)
+			}
+			if r.Error != nil {
+				if ae, ok := r.Error.(awserr.Error); !ok || ae.Code() != ErrCodeCircuitOpen {
+					t.Errorf("\n%s\nr: want %s error, got %v", tc.reason, ErrCodeCircuitOpen, r.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerApply(t *testing.T) {
+	cfg := aws.Config{}
+	b := NewCircuitBreaker()
+	b.Apply(&cfg)
+	b.Apply(&cfg)
+
+	if diff := cmp.Diff(1, cfg.Handlers.Validate.Len()); diff != "" {
+		t.Errorf("Validate handlers: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(1, cfg.Handlers.CompleteAttempt.Len()); diff != "" {
+		t.Errorf("CompleteAttempt handlers: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudhsmv2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+
+	"github.com/crossplane/provider-aws/apis/cloudhsmv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ClusterClient is the external client used for Cluster Custom Resource
+type ClusterClient interface {
+	CreateClusterRequest(*cloudhsmv2.CreateClusterInput) cloudhsmv2.CreateClusterRequest
+	DescribeClustersRequest(*cloudhsmv2.DescribeClustersInput) cloudhsmv2.DescribeClustersRequest
+	DeleteClusterRequest(*cloudhsmv2.DeleteClusterInput) cloudhsmv2.DeleteClusterRequest
+}
+
+// NewClusterClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewClusterClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ClusterClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return cloudhsmv2.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the cluster or HSM doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == cloudhsmv2.ErrCodeCloudHsmResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateDescribeClustersInput returns the input to describe the cluster
+// with the supplied ID.
+func GenerateDescribeClustersInput(id string) *cloudhsmv2.DescribeClustersInput {
+	return &cloudhsmv2.DescribeClustersInput{
+		Filters: map[string][]string{"clusterIds": {id}},
+	}
+}
+
+// GenerateCreateClusterInput returns the input to create a cluster from the
+// supplied parameters.
+func GenerateCreateClusterInput(p v1alpha1.ClusterParameters) *cloudhsmv2.CreateClusterInput {
+	in := &cloudhsmv2.CreateClusterInput{
+		HsmType:        aws.String(p.HSMType),
+		SourceBackupId: p.SourceBackupID,
+		SubnetIds:      p.SubnetIDs,
+	}
+	keys := make([]string, 0, len(p.Tags))
+	for k := range p.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		in.TagList = append(in.TagList, cloudhsmv2.Tag{Key: aws.String(k), Value: aws.String(p.Tags[k])})
+	}
+	return in
+}
+
+// GenerateClusterObservation is used to produce v1alpha1.ClusterObservation
+// from cloudhsmv2.Cluster.
+func GenerateClusterObservation(c cloudhsmv2.Cluster) v1alpha1.ClusterObservation {
+	o := v1alpha1.ClusterObservation{
+		State:         string(c.State),
+		StateMessage:  aws.StringValue(c.StateMessage),
+		SecurityGroup: aws.StringValue(c.SecurityGroup),
+		VPCID:         aws.StringValue(c.VpcId),
+		SubnetMapping: c.SubnetMapping,
+	}
+	if c.Certificates != nil {
+		o.Certificates = v1alpha1.ClusterCertificates{
+			ClusterCSR:                      aws.StringValue(c.Certificates.ClusterCsr),
+			ClusterCertificate:              aws.StringValue(c.Certificates.ClusterCertificate),
+			HSMCertificate:                  aws.StringValue(c.Certificates.HsmCertificate),
+			AWSHardwareCertificate:          aws.StringValue(c.Certificates.AwsHardwareCertificate),
+			ManufacturerHardwareCertificate: aws.StringValue(c.Certificates.ManufacturerHardwareCertificate),
+		}
+	}
+	return o
+}
+
+// LateInitializeCluster fills the empty fields in *v1alpha1.ClusterParameters
+// with the values seen in cloudhsmv2.Cluster.
+func LateInitializeCluster(in *v1alpha1.ClusterParameters, c *cloudhsmv2.Cluster) {
+	if c == nil {
+		return
+	}
+	in.SourceBackupID = awsclients.LateInitializeStringPtr(in.SourceBackupID, c.SourceBackupId)
+	if len(in.SubnetIDs) == 0 && len(c.SubnetMapping) != 0 {
+		for _, s := range c.SubnetMapping {
+			in.SubnetIDs = append(in.SubnetIDs, s)
+		}
+		sort.Strings(in.SubnetIDs)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudhsmv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/cloudhsmv2/v1alpha1"
+)
+
+func TestGenerateCreateClusterInput(t *testing.T) {
+	p := v1alpha1.ClusterParameters{
+		HSMType:   "hsm1.medium",
+		SubnetIDs: []string{"subnet-a", "subnet-b"},
+		Tags:      map[string]string{"team": "security", "env": "prod"},
+	}
+	want := &cloudhsmv2.CreateClusterInput{
+		HsmType:   aws.String("hsm1.medium"),
+		SubnetIds: []string{"subnet-a", "subnet-b"},
+		TagList: []cloudhsmv2.Tag{
+			{Key: aws.String("env"), Value: aws.String("prod")},
+			{Key: aws.String("team"), Value: aws.String("security")},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateCreateClusterInput(p)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeCluster(t *testing.T) {
+	observed := &cloudhsmv2.Cluster{
+		SourceBackupId: aws.String("backup-rtq2dwi2gq6"),
+		SubnetMapping:  map[string]string{"us-east-1b": "subnet-b", "us-east-1a": "subnet-a"},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ClusterParameters
+		want v1alpha1.ClusterParameters
+	}{
+		"Empty": {
+			want: v1alpha1.ClusterParameters{
+				SourceBackupID: aws.String("backup-rtq2dwi2gq6"),
+				SubnetIDs:      []string{"subnet-a", "subnet-b"},
+			},
+		},
+		"SubnetsSet": {
+			p: v1alpha1.ClusterParameters{
+				SubnetIDs: []string{"subnet-b"},
+			},
+			want: v1alpha1.ClusterParameters{
+				SourceBackupID: aws.String("backup-rtq2dwi2gq6"),
+				SubnetIDs:      []string{"subnet-b"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeCluster(&tc.p, observed)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudhsmv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ClusterClient = (*MockClusterClient)(nil)
+
+// MockClusterClient is a type that implements all the methods for ClusterClient interface
+type MockClusterClient struct {
+	MockCreateCluster    func(*cloudhsmv2.CreateClusterInput) cloudhsmv2.CreateClusterRequest
+	MockDescribeClusters func(*cloudhsmv2.DescribeClustersInput) cloudhsmv2.DescribeClustersRequest
+	MockDeleteCluster    func(*cloudhsmv2.DeleteClusterInput) cloudhsmv2.DeleteClusterRequest
+}
+
+// CreateClusterRequest calls the underlying MockCreateCluster method.
+func (c *MockClusterClient) CreateClusterRequest(i *cloudhsmv2.CreateClusterInput) cloudhsmv2.CreateClusterRequest {
+	return c.MockCreateCluster(i)
+}
+
+// DescribeClustersRequest calls the underlying MockDescribeClusters method.
+func (c *MockClusterClient) DescribeClustersRequest(i *cloudhsmv2.DescribeClustersInput) cloudhsmv2.DescribeClustersRequest {
+	return c.MockDescribeClusters(i)
+}
+
+// DeleteClusterRequest calls the underlying MockDeleteCluster method.
+func (c *MockClusterClient) DeleteClusterRequest(i *cloudhsmv2.DeleteClusterInput) cloudhsmv2.DeleteClusterRequest {
+	return c.MockDeleteCluster(i)
+}
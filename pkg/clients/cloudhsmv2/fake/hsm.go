@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudhsmv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.HsmClient = (*MockHsmClient)(nil)
+
+// MockHsmClient is a type that implements all the methods for HsmClient interface
+type MockHsmClient struct {
+	MockCreateHsm        func(*cloudhsmv2.CreateHsmInput) cloudhsmv2.CreateHsmRequest
+	MockDescribeClusters func(*cloudhsmv2.DescribeClustersInput) cloudhsmv2.DescribeClustersRequest
+	MockDeleteHsm        func(*cloudhsmv2.DeleteHsmInput) cloudhsmv2.DeleteHsmRequest
+}
+
+// CreateHsmRequest calls the underlying MockCreateHsm method.
+func (c *MockHsmClient) CreateHsmRequest(i *cloudhsmv2.CreateHsmInput) cloudhsmv2.CreateHsmRequest {
+	return c.MockCreateHsm(i)
+}
+
+// DescribeClustersRequest calls the underlying MockDescribeClusters method.
+func (c *MockHsmClient) DescribeClustersRequest(i *cloudhsmv2.DescribeClustersInput) cloudhsmv2.DescribeClustersRequest {
+	return c.MockDescribeClusters(i)
+}
+
+// DeleteHsmRequest calls the underlying MockDeleteHsm method.
+func (c *MockHsmClient) DeleteHsmRequest(i *cloudhsmv2.DeleteHsmInput) cloudhsmv2.DeleteHsmRequest {
+	return c.MockDeleteHsm(i)
+}
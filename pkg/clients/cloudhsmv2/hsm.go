@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudhsmv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+
+	"github.com/crossplane/provider-aws/apis/cloudhsmv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// HsmClient is the external client used for Hsm Custom Resource
+type HsmClient interface {
+	CreateHsmRequest(*cloudhsmv2.CreateHsmInput) cloudhsmv2.CreateHsmRequest
+	DescribeClustersRequest(*cloudhsmv2.DescribeClustersInput) cloudhsmv2.DescribeClustersRequest
+	DeleteHsmRequest(*cloudhsmv2.DeleteHsmInput) cloudhsmv2.DeleteHsmRequest
+}
+
+// NewHsmClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewHsmClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (HsmClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return cloudhsmv2.New(*cfg), err
+}
+
+// GenerateCreateHsmInput returns the input to create an HSM from the
+// supplied parameters.
+func GenerateCreateHsmInput(p v1alpha1.HsmParameters) *cloudhsmv2.CreateHsmInput {
+	return &cloudhsmv2.CreateHsmInput{
+		ClusterId:        p.ClusterID,
+		AvailabilityZone: aws.String(p.AvailabilityZone),
+		IpAddress:        p.IPAddress,
+	}
+}
+
+// FindHsm returns the HSM with the supplied ID, or nil if it is not one of
+// the HSMs of the supplied clusters.
+func FindHsm(id string, clusters []cloudhsmv2.Cluster) *cloudhsmv2.Hsm {
+	for _, c := range clusters {
+		for i := range c.Hsms {
+			if aws.StringValue(c.Hsms[i].HsmId) == id {
+				return &c.Hsms[i]
+			}
+		}
+	}
+	return nil
+}
+
+// GenerateHsmObservation is used to produce v1alpha1.HsmObservation from
+// cloudhsmv2.Hsm.
+func GenerateHsmObservation(h cloudhsmv2.Hsm) v1alpha1.HsmObservation {
+	return v1alpha1.HsmObservation{
+		State:        string(h.State),
+		StateMessage: aws.StringValue(h.StateMessage),
+		SubnetID:     aws.StringValue(h.SubnetId),
+		ENIID:        aws.StringValue(h.EniId),
+		ENIIP:        aws.StringValue(h.EniIp),
+	}
+}
+
+// LateInitializeHsm fills the empty fields in *v1alpha1.HsmParameters with
+// the values seen in cloudhsmv2.Hsm.
+func LateInitializeHsm(in *v1alpha1.HsmParameters, h *cloudhsmv2.Hsm) {
+	if h == nil {
+		return
+	}
+	in.IPAddress = awsclients.LateInitializeStringPtr(in.IPAddress, h.EniIp)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// CompositeAlarmClient is the external client used for CompositeAlarm Custom
+// Resource
+type CompositeAlarmClient interface {
+	PutCompositeAlarmRequest(*cloudwatch.PutCompositeAlarmInput) cloudwatch.PutCompositeAlarmRequest
+	DescribeAlarmsRequest(*cloudwatch.DescribeAlarmsInput) cloudwatch.DescribeAlarmsRequest
+	DeleteAlarmsRequest(*cloudwatch.DeleteAlarmsInput) cloudwatch.DeleteAlarmsRequest
+}
+
+// NewCompositeAlarmClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewCompositeAlarmClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (CompositeAlarmClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return cloudwatch.New(*cfg), err
+}
+
+// GeneratePutCompositeAlarmInput returns the input to create or update the
+// composite alarm with the given name from the supplied parameters.
+func GeneratePutCompositeAlarmInput(name string, p v1alpha1.CompositeAlarmParameters) *cloudwatch.PutCompositeAlarmInput {
+	return &cloudwatch.PutCompositeAlarmInput{
+		AlarmName:               aws.String(name),
+		ActionsEnabled:          p.ActionsEnabled,
+		AlarmActions:            p.AlarmActions,
+		AlarmDescription:        p.AlarmDescription,
+		AlarmRule:               aws.String(p.AlarmRule),
+		InsufficientDataActions: p.InsufficientDataActions,
+		OKActions:               p.OKActions,
+		Tags:                    GenerateTags(p.Tags),
+	}
+}
+
+// LateInitializeCompositeAlarm fills the empty fields in
+// *v1alpha1.CompositeAlarmParameters with the values seen in
+// cloudwatch.CompositeAlarm.
+func LateInitializeCompositeAlarm(in *v1alpha1.CompositeAlarmParameters, a *cloudwatch.CompositeAlarm) {
+	if a == nil {
+		return
+	}
+	in.ActionsEnabled = awsclients.LateInitializeBoolPtr(in.ActionsEnabled, a.ActionsEnabled)
+}
+
+// GenerateCompositeAlarmObservation is used to produce
+// v1alpha1.CompositeAlarmObservation from cloudwatch.CompositeAlarm.
+func GenerateCompositeAlarmObservation(a cloudwatch.CompositeAlarm) v1alpha1.CompositeAlarmObservation {
+	return v1alpha1.CompositeAlarmObservation{
+		AlarmARN:    aws.StringValue(a.AlarmArn),
+		StateValue:  string(a.StateValue),
+		StateReason: aws.StringValue(a.StateReason),
+	}
+}
+
+// IsCompositeAlarmUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsCompositeAlarmUpToDate(p v1alpha1.CompositeAlarmParameters, a cloudwatch.CompositeAlarm) bool {
+	sortSlices := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return aws.BoolValue(p.ActionsEnabled) == aws.BoolValue(a.ActionsEnabled) &&
+		cmp.Equal(p.AlarmActions, a.AlarmActions, sortSlices, cmpopts.EquateEmpty()) &&
+		aws.StringValue(p.AlarmDescription) == aws.StringValue(a.AlarmDescription) &&
+		p.AlarmRule == aws.StringValue(a.AlarmRule) &&
+		cmp.Equal(p.InsufficientDataActions, a.InsufficientDataActions, sortSlices, cmpopts.EquateEmpty()) &&
+		cmp.Equal(p.OKActions, a.OKActions, sortSlices, cmpopts.EquateEmpty())
+}
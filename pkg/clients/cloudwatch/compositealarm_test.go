@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+)
+
+var (
+	alarmRule = `ALARM("cpu-high") AND ALARM("latency-high")`
+)
+
+func compositeAlarmParams(m ...func(*v1alpha1.CompositeAlarmParameters)) v1alpha1.CompositeAlarmParameters {
+	p := v1alpha1.CompositeAlarmParameters{
+		ActionsEnabled: aws.Bool(true),
+		AlarmActions:   []string{topicARN},
+		AlarmRule:      alarmRule,
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func compositeAlarm(m ...func(*cloudwatch.CompositeAlarm)) cloudwatch.CompositeAlarm {
+	a := cloudwatch.CompositeAlarm{
+		ActionsEnabled: aws.Bool(true),
+		AlarmActions:   []string{topicARN},
+		AlarmName:      aws.String(alarmName),
+		AlarmRule:      aws.String(alarmRule),
+	}
+	for _, f := range m {
+		f(&a)
+	}
+	return a
+}
+
+func TestIsCompositeAlarmUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.CompositeAlarmParameters
+		a    cloudwatch.CompositeAlarm
+		want bool
+	}{
+		"UpToDate": {
+			p:    compositeAlarmParams(),
+			a:    compositeAlarm(),
+			want: true,
+		},
+		"RuleChanged": {
+			p: compositeAlarmParams(func(p *v1alpha1.CompositeAlarmParameters) {
+				p.AlarmRule = `ALARM("cpu-high") OR ALARM("latency-high")`
+			}),
+			a:    compositeAlarm(),
+			want: false,
+		},
+		"ActionsDisabled": {
+			p: compositeAlarmParams(func(p *v1alpha1.CompositeAlarmParameters) {
+				p.ActionsEnabled = aws.Bool(false)
+			}),
+			a:    compositeAlarm(),
+			want: false,
+		},
+		"TagsIgnored": {
+			p: compositeAlarmParams(func(p *v1alpha1.CompositeAlarmParameters) {
+				p.Tags = map[string]string{"team": "ops"}
+			}),
+			a:    compositeAlarm(),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCompositeAlarmUpToDate(tc.p, tc.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudwatch"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CompositeAlarmClient = (*MockCompositeAlarmClient)(nil)
+
+// MockCompositeAlarmClient is a type that implements all the methods for CompositeAlarmClient interface
+type MockCompositeAlarmClient struct {
+	MockPutCompositeAlarm func(*cloudwatch.PutCompositeAlarmInput) cloudwatch.PutCompositeAlarmRequest
+	MockDescribeAlarms    func(*cloudwatch.DescribeAlarmsInput) cloudwatch.DescribeAlarmsRequest
+	MockDeleteAlarms      func(*cloudwatch.DeleteAlarmsInput) cloudwatch.DeleteAlarmsRequest
+}
+
+// PutCompositeAlarmRequest calls the underlying MockPutCompositeAlarm method.
+func (c *MockCompositeAlarmClient) PutCompositeAlarmRequest(i *cloudwatch.PutCompositeAlarmInput) cloudwatch.PutCompositeAlarmRequest {
+	return c.MockPutCompositeAlarm(i)
+}
+
+// DescribeAlarmsRequest calls the underlying MockDescribeAlarms method.
+func (c *MockCompositeAlarmClient) DescribeAlarmsRequest(i *cloudwatch.DescribeAlarmsInput) cloudwatch.DescribeAlarmsRequest {
+	return c.MockDescribeAlarms(i)
+}
+
+// DeleteAlarmsRequest calls the underlying MockDeleteAlarms method.
+func (c *MockCompositeAlarmClient) DeleteAlarmsRequest(i *cloudwatch.DeleteAlarmsInput) cloudwatch.DeleteAlarmsRequest {
+	return c.MockDeleteAlarms(i)
+}
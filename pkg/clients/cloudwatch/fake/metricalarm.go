@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudwatch"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.MetricAlarmClient = (*MockMetricAlarmClient)(nil)
+
+// MockMetricAlarmClient is a type that implements all the methods for MetricAlarmClient interface
+type MockMetricAlarmClient struct {
+	MockPutMetricAlarm func(*cloudwatch.PutMetricAlarmInput) cloudwatch.PutMetricAlarmRequest
+	MockDescribeAlarms func(*cloudwatch.DescribeAlarmsInput) cloudwatch.DescribeAlarmsRequest
+	MockDeleteAlarms   func(*cloudwatch.DeleteAlarmsInput) cloudwatch.DeleteAlarmsRequest
+}
+
+// PutMetricAlarmRequest calls the underlying MockPutMetricAlarm method.
+func (c *MockMetricAlarmClient) PutMetricAlarmRequest(i *cloudwatch.PutMetricAlarmInput) cloudwatch.PutMetricAlarmRequest {
+	return c.MockPutMetricAlarm(i)
+}
+
+// DescribeAlarmsRequest calls the underlying MockDescribeAlarms method.
+func (c *MockMetricAlarmClient) DescribeAlarmsRequest(i *cloudwatch.DescribeAlarmsInput) cloudwatch.DescribeAlarmsRequest {
+	return c.MockDescribeAlarms(i)
+}
+
+// DeleteAlarmsRequest calls the underlying MockDeleteAlarms method.
+func (c *MockMetricAlarmClient) DeleteAlarmsRequest(i *cloudwatch.DeleteAlarmsInput) cloudwatch.DeleteAlarmsRequest {
+	return c.MockDeleteAlarms(i)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errInvalidThreshold = "threshold must be a decimal number"
+)
+
+// MetricAlarmClient is the external client used for MetricAlarm Custom
+// Resource
+type MetricAlarmClient interface {
+	PutMetricAlarmRequest(*cloudwatch.PutMetricAlarmInput) cloudwatch.PutMetricAlarmRequest
+	DescribeAlarmsRequest(*cloudwatch.DescribeAlarmsInput) cloudwatch.DescribeAlarmsRequest
+	DeleteAlarmsRequest(*cloudwatch.DeleteAlarmsInput) cloudwatch.DeleteAlarmsRequest
+}
+
+// NewMetricAlarmClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewMetricAlarmClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (MetricAlarmClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return cloudwatch.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the alarm does not exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == cloudwatch.ErrCodeResourceNotFound
+	}
+	return false
+}
+
+// GenerateTags returns the CloudWatch representation of the supplied tags,
+// sorted by key.
+func GenerateTags(tags map[string]string) []cloudwatch.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	out := make([]cloudwatch.Tag, 0, len(tags))
+	for k, v := range tags {
+		out = append(out, cloudwatch.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(out, func(i, j int) bool { return aws.StringValue(out[i].Key) < aws.StringValue(out[j].Key) })
+	return out
+}
+
+// GenerateDescribeAlarmsInput returns the input to find the alarm with the
+// given name and type.
+func GenerateDescribeAlarmsInput(name string, t cloudwatch.AlarmType) *cloudwatch.DescribeAlarmsInput {
+	return &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []string{name},
+		AlarmTypes: []cloudwatch.AlarmType{t},
+	}
+}
+
+func generateDimensions(in []v1alpha1.Dimension) []cloudwatch.Dimension {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]cloudwatch.Dimension, len(in))
+	for i, d := range in {
+		out[i] = cloudwatch.Dimension{Name: aws.String(d.Name), Value: aws.String(d.Value)}
+	}
+	return out
+}
+
+func generateMetricDataQueries(in []v1alpha1.MetricDataQuery) []cloudwatch.MetricDataQuery {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]cloudwatch.MetricDataQuery, len(in))
+	for i, q := range in {
+		out[i] = cloudwatch.MetricDataQuery{
+			Id:         aws.String(q.ID),
+			Expression: q.Expression,
+			Label:      q.Label,
+			Period:     q.Period,
+			ReturnData: q.ReturnData,
+		}
+		if s := q.MetricStat; s != nil {
+			out[i].MetricStat = &cloudwatch.MetricStat{
+				Metric: &cloudwatch.Metric{
+					Dimensions: generateDimensions(s.Metric.Dimensions),
+					MetricName: s.Metric.MetricName,
+					Namespace:  s.Metric.Namespace,
+				},
+				Period: aws.Int64(s.Period),
+				Stat:   aws.String(s.Stat),
+				Unit:   cloudwatch.StandardUnit(aws.StringValue(s.Unit)),
+			}
+		}
+	}
+	return out
+}
+
+// GeneratePutMetricAlarmInput returns the input to create or update the
+// metric alarm with the given name from the supplied parameters.
+func GeneratePutMetricAlarmInput(name string, p v1alpha1.MetricAlarmParameters) (*cloudwatch.PutMetricAlarmInput, error) {
+	in := &cloudwatch.PutMetricAlarmInput{
+		AlarmName:                        aws.String(name),
+		ActionsEnabled:                   p.ActionsEnabled,
+		AlarmActions:                     p.AlarmActions,
+		AlarmDescription:                 p.AlarmDescription,
+		ComparisonOperator:               cloudwatch.ComparisonOperator(p.ComparisonOperator),
+		DatapointsToAlarm:                p.DatapointsToAlarm,
+		Dimensions:                       generateDimensions(p.Dimensions),
+		EvaluateLowSampleCountPercentile: p.EvaluateLowSampleCountPercentile,
+		EvaluationPeriods:                aws.Int64(p.EvaluationPeriods),
+		ExtendedStatistic:                p.ExtendedStatistic,
+		InsufficientDataActions:          p.InsufficientDataActions,
+		MetricName:                       p.MetricName,
+		Metrics:                          generateMetricDataQueries(p.Metrics),
+		Namespace:                        p.Namespace,
+		OKActions:                        p.OKActions,
+		Period:                           p.Period,
+		Statistic:                        cloudwatch.Statistic(aws.StringValue(p.Statistic)),
+		Tags:                             GenerateTags(p.Tags),
+		ThresholdMetricId:                p.ThresholdMetricID,
+		TreatMissingData:                 p.TreatMissingData,
+		Unit:                             cloudwatch.StandardUnit(aws.StringValue(p.Unit)),
+	}
+	if p.Threshold != nil {
+		t, err := strconv.ParseFloat(*p.Threshold, 64)
+		if err != nil {
+			return nil, errors.New(errInvalidThreshold)
+		}
+		in.Threshold = aws.Float64(t)
+	}
+	return in, nil
+}
+
+// LateInitializeMetricAlarm fills the empty fields in
+// *v1alpha1.MetricAlarmParameters with the values seen in
+// cloudwatch.MetricAlarm.
+func LateInitializeMetricAlarm(in *v1alpha1.MetricAlarmParameters, a *cloudwatch.MetricAlarm) {
+	if a == nil {
+		return
+	}
+	in.ActionsEnabled = awsclients.LateInitializeBoolPtr(in.ActionsEnabled, a.ActionsEnabled)
+	in.TreatMissingData = awsclients.LateInitializeStringPtr(in.TreatMissingData, a.TreatMissingData)
+}
+
+// GenerateMetricAlarmObservation is used to produce
+// v1alpha1.MetricAlarmObservation from cloudwatch.MetricAlarm.
+func GenerateMetricAlarmObservation(a cloudwatch.MetricAlarm) v1alpha1.MetricAlarmObservation {
+	return v1alpha1.MetricAlarmObservation{
+		AlarmARN:    aws.StringValue(a.AlarmArn),
+		StateValue:  string(a.StateValue),
+		StateReason: aws.StringValue(a.StateReason),
+	}
+}
+
+// normalizeReturnData makes the default of ReturnData explicit so that
+// queries that omit it compare equal to the observed ones.
+func normalizeReturnData(in []cloudwatch.MetricDataQuery) []cloudwatch.MetricDataQuery {
+	out := make([]cloudwatch.MetricDataQuery, len(in))
+	for i, q := range in {
+		out[i] = q
+		if q.ReturnData == nil {
+			out[i].ReturnData = aws.Bool(true)
+		}
+	}
+	return out
+}
+
+// IsMetricAlarmUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsMetricAlarmUpToDate(p v1alpha1.MetricAlarmParameters, a cloudwatch.MetricAlarm) bool {
+	in, err := GeneratePutMetricAlarmInput(aws.StringValue(a.AlarmName), p)
+	if err != nil {
+		return false
+	}
+	observed := &cloudwatch.PutMetricAlarmInput{
+		AlarmName:                        a.AlarmName,
+		ActionsEnabled:                   a.ActionsEnabled,
+		AlarmActions:                     a.AlarmActions,
+		AlarmDescription:                 a.AlarmDescription,
+		ComparisonOperator:               a.ComparisonOperator,
+		DatapointsToAlarm:                a.DatapointsToAlarm,
+		Dimensions:                       a.Dimensions,
+		EvaluateLowSampleCountPercentile: a.EvaluateLowSampleCountPercentile,
+		EvaluationPeriods:                a.EvaluationPeriods,
+		ExtendedStatistic:                a.ExtendedStatistic,
+		InsufficientDataActions:          a.InsufficientDataActions,
+		MetricName:                       a.MetricName,
+		Metrics:                          normalizeReturnData(a.Metrics),
+		Namespace:                        a.Namespace,
+		OKActions:                        a.OKActions,
+		Period:                           a.Period,
+		Statistic:                        a.Statistic,
+		Threshold:                        a.Threshold,
+		ThresholdMetricId:                a.ThresholdMetricId,
+		TreatMissingData:                 a.TreatMissingData,
+		Unit:                             a.Unit,
+	}
+	// Tags are only applied when the alarm is created.
+	in.Tags = nil
+	in.Metrics = normalizeReturnData(in.Metrics)
+	return cmp.Equal(in, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.IgnoreUnexported(
+			cloudwatch.PutMetricAlarmInput{},
+			cloudwatch.Dimension{},
+			cloudwatch.MetricDataQuery{},
+			cloudwatch.MetricStat{},
+			cloudwatch.Metric{},
+		))
+}
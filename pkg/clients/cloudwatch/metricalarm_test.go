@@ -0,0 +1,241 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+)
+
+var (
+	alarmName = "example"
+	topicARN  = "arn:aws:sns:us-east-1:123456789012:alerts"
+)
+
+func metricAlarmParams(m ...func(*v1alpha1.MetricAlarmParameters)) v1alpha1.MetricAlarmParameters {
+	p := v1alpha1.MetricAlarmParameters{
+		AlarmActions:       []string{topicARN},
+		ComparisonOperator: "GreaterThanThreshold",
+		Dimensions:         []v1alpha1.Dimension{{Name: "InstanceId", Value: "i-1234"}},
+		EvaluationPeriods:  3,
+		MetricName:         aws.String("CPUUtilization"),
+		Namespace:          aws.String("AWS/EC2"),
+		Period:             aws.Int64(60),
+		Statistic:          aws.String("Average"),
+		Tags:               map[string]string{"team": "ops"},
+		Threshold:          aws.String("80.5"),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func metricAlarm(m ...func(*cloudwatch.MetricAlarm)) cloudwatch.MetricAlarm {
+	a := cloudwatch.MetricAlarm{
+		ActionsEnabled:     aws.Bool(true),
+		AlarmActions:       []string{topicARN},
+		AlarmName:          aws.String(alarmName),
+		ComparisonOperator: cloudwatch.ComparisonOperatorGreaterThanThreshold,
+		Dimensions:         []cloudwatch.Dimension{{Name: aws.String("InstanceId"), Value: aws.String("i-1234")}},
+		EvaluationPeriods:  aws.Int64(3),
+		MetricName:         aws.String("CPUUtilization"),
+		Namespace:          aws.String("AWS/EC2"),
+		Period:             aws.Int64(60),
+		Statistic:          cloudwatch.StatisticAverage,
+		Threshold:          aws.Float64(80.5),
+		TreatMissingData:   aws.String("missing"),
+	}
+	for _, f := range m {
+		f(&a)
+	}
+	return a
+}
+
+func TestGeneratePutMetricAlarmInput(t *testing.T) {
+	type want struct {
+		in  *cloudwatch.PutMetricAlarmInput
+		err error
+	}
+
+	cases := map[string]struct {
+		p v1alpha1.MetricAlarmParameters
+		want
+	}{
+		"Successful": {
+			p: metricAlarmParams(),
+			want: want{
+				in: &cloudwatch.PutMetricAlarmInput{
+					AlarmActions:       []string{topicARN},
+					AlarmName:          aws.String(alarmName),
+					ComparisonOperator: cloudwatch.ComparisonOperatorGreaterThanThreshold,
+					Dimensions:         []cloudwatch.Dimension{{Name: aws.String("InstanceId"), Value: aws.String("i-1234")}},
+					EvaluationPeriods:  aws.Int64(3),
+					MetricName:         aws.String("CPUUtilization"),
+					Namespace:          aws.String("AWS/EC2"),
+					Period:             aws.Int64(60),
+					Statistic:          cloudwatch.StatisticAverage,
+					Tags:               []cloudwatch.Tag{{Key: aws.String("team"), Value: aws.String("ops")}},
+					Threshold:          aws.Float64(80.5),
+				},
+			},
+		},
+		"InvalidThreshold": {
+			p: metricAlarmParams(func(p *v1alpha1.MetricAlarmParameters) {
+				p.Threshold = aws.String("high")
+			}),
+			want: want{
+				err: errors.New(errInvalidThreshold),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GeneratePutMetricAlarmInput(alarmName, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, got, cmpopts.IgnoreUnexported(
+				cloudwatch.PutMetricAlarmInput{},
+				cloudwatch.Dimension{},
+				cloudwatch.Tag{},
+			)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeMetricAlarm(t *testing.T) {
+	p := metricAlarmParams()
+	a := metricAlarm()
+	LateInitializeMetricAlarm(&p, &a)
+	want := metricAlarmParams(func(p *v1alpha1.MetricAlarmParameters) {
+		p.ActionsEnabled = aws.Bool(true)
+		p.TreatMissingData = aws.String("missing")
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsMetricAlarmUpToDate(t *testing.T) {
+	lateInit := func(p *v1alpha1.MetricAlarmParameters) {
+		p.ActionsEnabled = aws.Bool(true)
+		p.TreatMissingData = aws.String("missing")
+	}
+	anomaly := func(p *v1alpha1.MetricAlarmParameters) {
+		p.ComparisonOperator = "GreaterThanUpperThreshold"
+		p.Dimensions = nil
+		p.MetricName = nil
+		p.Namespace = nil
+		p.Period = nil
+		p.Statistic = nil
+		p.Threshold = nil
+		p.Metrics = []v1alpha1.MetricDataQuery{
+			{
+				ID: "m1",
+				MetricStat: &v1alpha1.MetricStat{
+					Metric: v1alpha1.Metric{MetricName: aws.String("Latency"), Namespace: aws.String("AWS/ApiGateway")},
+					Period: 300,
+					Stat:   "p90",
+				},
+			},
+			{ID: "ad1", Expression: aws.String("ANOMALY_DETECTION_BAND(m1, 2)")},
+		}
+		p.ThresholdMetricID = aws.String("ad1")
+	}
+	observedAnomaly := func(a *cloudwatch.MetricAlarm) {
+		a.ComparisonOperator = cloudwatch.ComparisonOperatorGreaterThanUpperThreshold
+		a.Dimensions = nil
+		a.MetricName = nil
+		a.Namespace = nil
+		a.Period = nil
+		a.Statistic = ""
+		a.Threshold = nil
+		a.Metrics = []cloudwatch.MetricDataQuery{
+			{
+				Id: aws.String("m1"),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{MetricName: aws.String("Latency"), Namespace: aws.String("AWS/ApiGateway")},
+					Period: aws.Int64(300),
+					Stat:   aws.String("p90"),
+				},
+				ReturnData: aws.Bool(true),
+			},
+			{Id: aws.String("ad1"), Expression: aws.String("ANOMALY_DETECTION_BAND(m1, 2)"), ReturnData: aws.Bool(true)},
+		}
+		a.ThresholdMetricId = aws.String("ad1")
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.MetricAlarmParameters
+		a    cloudwatch.MetricAlarm
+		want bool
+	}{
+		"UpToDate": {
+			p:    metricAlarmParams(lateInit),
+			a:    metricAlarm(),
+			want: true,
+		},
+		"ThresholdChanged": {
+			p: metricAlarmParams(lateInit, func(p *v1alpha1.MetricAlarmParameters) {
+				p.Threshold = aws.String("90")
+			}),
+			a:    metricAlarm(),
+			want: false,
+		},
+		"ActionsChanged": {
+			p: metricAlarmParams(lateInit, func(p *v1alpha1.MetricAlarmParameters) {
+				p.OKActions = []string{topicARN}
+			}),
+			a:    metricAlarm(),
+			want: false,
+		},
+		"AnomalyDetectionUpToDate": {
+			p:    metricAlarmParams(lateInit, anomaly),
+			a:    metricAlarm(observedAnomaly),
+			want: true,
+		},
+		"AnomalyDetectionBandChanged": {
+			p: metricAlarmParams(lateInit, anomaly, func(p *v1alpha1.MetricAlarmParameters) {
+				p.Metrics[1].Expression = aws.String("ANOMALY_DETECTION_BAND(m1, 3)")
+			}),
+			a:    metricAlarm(observedAnomaly),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMetricAlarmUpToDate(tc.p, tc.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudwatchlogs"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ResourcePolicyClient = (*MockResourcePolicyClient)(nil)
+
+// MockResourcePolicyClient is a type that implements all the methods for ResourcePolicyClient interface
+type MockResourcePolicyClient struct {
+	MockDescribeResourcePolicies func(*cloudwatchlogs.DescribeResourcePoliciesInput) cloudwatchlogs.DescribeResourcePoliciesRequest
+	MockPutResourcePolicy        func(*cloudwatchlogs.PutResourcePolicyInput) cloudwatchlogs.PutResourcePolicyRequest
+	MockDeleteResourcePolicy     func(*cloudwatchlogs.DeleteResourcePolicyInput) cloudwatchlogs.DeleteResourcePolicyRequest
+}
+
+// DescribeResourcePoliciesRequest calls the underlying MockDescribeResourcePolicies method.
+func (c *MockResourcePolicyClient) DescribeResourcePoliciesRequest(i *cloudwatchlogs.DescribeResourcePoliciesInput) cloudwatchlogs.DescribeResourcePoliciesRequest {
+	return c.MockDescribeResourcePolicies(i)
+}
+
+// PutResourcePolicyRequest calls the underlying MockPutResourcePolicy method.
+func (c *MockResourcePolicyClient) PutResourcePolicyRequest(i *cloudwatchlogs.PutResourcePolicyInput) cloudwatchlogs.PutResourcePolicyRequest {
+	return c.MockPutResourcePolicy(i)
+}
+
+// DeleteResourcePolicyRequest calls the underlying MockDeleteResourcePolicy method.
+func (c *MockResourcePolicyClient) DeleteResourcePolicyRequest(i *cloudwatchlogs.DeleteResourcePolicyInput) cloudwatchlogs.DeleteResourcePolicyRequest {
+	return c.MockDeleteResourcePolicy(i)
+}
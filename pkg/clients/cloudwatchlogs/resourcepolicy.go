@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatchlogs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ResourcePolicyClient is the external client used for ResourcePolicy Custom
+// Resource
+type ResourcePolicyClient interface {
+	DescribeResourcePoliciesRequest(*cloudwatchlogs.DescribeResourcePoliciesInput) cloudwatchlogs.DescribeResourcePoliciesRequest
+	PutResourcePolicyRequest(*cloudwatchlogs.PutResourcePolicyInput) cloudwatchlogs.PutResourcePolicyRequest
+	DeleteResourcePolicyRequest(*cloudwatchlogs.DeleteResourcePolicyInput) cloudwatchlogs.DeleteResourcePolicyRequest
+}
+
+// NewResourcePolicyClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewResourcePolicyClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ResourcePolicyClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return cloudwatchlogs.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the resource doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// FindResourcePolicy returns the resource policy with the supplied name, or
+// nil if there is none. The API cannot describe a single policy, so all pages
+// of policies of the account are searched.
+func FindResourcePolicy(ctx context.Context, c ResourcePolicyClient, name string) (*cloudwatchlogs.ResourcePolicy, error) {
+	input := &cloudwatchlogs.DescribeResourcePoliciesInput{}
+	for {
+		rsp, err := c.DescribeResourcePoliciesRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rsp.ResourcePolicies {
+			if aws.StringValue(rsp.ResourcePolicies[i].PolicyName) == name {
+				return &rsp.ResourcePolicies[i], nil
+			}
+		}
+		if aws.StringValue(rsp.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+// IsResourcePolicyUpToDate returns true if the document of the supplied
+// policy matches the parameters, ignoring differences in formatting.
+func IsResourcePolicyUpToDate(p v1alpha1.ResourcePolicyParameters, o cloudwatchlogs.ResourcePolicy) (bool, error) {
+	desired, err := awsclients.CompactAndEscapeJSON(p.PolicyDocument)
+	if err != nil {
+		return false, err
+	}
+	observed, err := awsclients.CompactAndEscapeJSON(aws.StringValue(o.PolicyDocument))
+	if err != nil {
+		return false, err
+	}
+	return desired == observed, nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatchlogs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+)
+
+var (
+	policyName = "route53-query-logging"
+	errBoom    = errors.New("boom")
+)
+
+// describer is a ResourcePolicyClient that only describes policies.
+type describer struct {
+	ResourcePolicyClient
+	describe func(*cloudwatchlogs.DescribeResourcePoliciesInput) cloudwatchlogs.DescribeResourcePoliciesRequest
+}
+
+func (d *describer) DescribeResourcePoliciesRequest(in *cloudwatchlogs.DescribeResourcePoliciesInput) cloudwatchlogs.DescribeResourcePoliciesRequest {
+	return d.describe(in)
+}
+
+func TestFindResourcePolicy(t *testing.T) {
+	found := cloudwatchlogs.ResourcePolicy{PolicyName: aws.String(policyName), PolicyDocument: aws.String("{}")}
+	pages := map[string]*cloudwatchlogs.DescribeResourcePoliciesOutput{
+		"": {
+			ResourcePolicies: []cloudwatchlogs.ResourcePolicy{{PolicyName: aws.String("other")}},
+			NextToken:        aws.String("next"),
+		},
+		"next": {
+			ResourcePolicies: []cloudwatchlogs.ResourcePolicy{found},
+		},
+	}
+	describe := func(err error) func(*cloudwatchlogs.DescribeResourcePoliciesInput) cloudwatchlogs.DescribeResourcePoliciesRequest {
+		return func(in *cloudwatchlogs.DescribeResourcePoliciesInput) cloudwatchlogs.DescribeResourcePoliciesRequest {
+			return cloudwatchlogs.DescribeResourcePoliciesRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: pages[aws.StringValue(in.NextToken)], Error: err},
+			}
+		}
+	}
+
+	type want struct {
+		policy *cloudwatchlogs.ResourcePolicy
+		err    error
+	}
+	cases := map[string]struct {
+		name   string
+		client ResourcePolicyClient
+		want   want
+	}{
+		"FoundOnSecondPage": {
+			name:   policyName,
+			client: &describer{describe: describe(nil)},
+			want:   want{policy: &found},
+		},
+		"NotFound": {
+			name:   "missing",
+			client: &describer{describe: describe(nil)},
+			want:   want{},
+		},
+		"FailedRequest": {
+			name:   policyName,
+			client: &describer{describe: describe(errBoom)},
+			want:   want{err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FindResourcePolicy(context.Background(), tc.client, tc.name)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsResourcePolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ResourcePolicyParameters
+		o    cloudwatchlogs.ResourcePolicy
+		want bool
+	}{
+		"SameDocumentDifferentFormatting": {
+			p:    v1alpha1.ResourcePolicyParameters{PolicyDocument: `{"Version": "2012-10-17", "Statement": []}`},
+			o:    cloudwatchlogs.ResourcePolicy{PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[]}`)},
+			want: true,
+		},
+		"DifferentDocument": {
+			p:    v1alpha1.ResourcePolicyParameters{PolicyDocument: `{"Version": "2012-10-17", "Statement": []}`},
+			o:    cloudwatchlogs.ResourcePolicy{PolicyDocument: aws.String(`{"Version":"2012-10-17"}`)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsResourcePolicyUpToDate(tc.p, tc.o)
+			if err != nil {
+				t.Fatalf("IsResourcePolicyUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/datasync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LocationEFSClient = (*MockLocationEFSClient)(nil)
+
+// MockLocationEFSClient is a type that implements all the methods for LocationEFSClient interface
+type MockLocationEFSClient struct {
+	MockCreateLocationEfs   func(*datasync.CreateLocationEfsInput) datasync.CreateLocationEfsRequest
+	MockDescribeLocationEfs func(*datasync.DescribeLocationEfsInput) datasync.DescribeLocationEfsRequest
+	MockDeleteLocation      func(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+}
+
+// CreateLocationEfsRequest calls the underlying MockCreateLocationEfs method.
+func (c *MockLocationEFSClient) CreateLocationEfsRequest(i *datasync.CreateLocationEfsInput) datasync.CreateLocationEfsRequest {
+	return c.MockCreateLocationEfs(i)
+}
+
+// DescribeLocationEfsRequest calls the underlying MockDescribeLocationEfs method.
+func (c *MockLocationEFSClient) DescribeLocationEfsRequest(i *datasync.DescribeLocationEfsInput) datasync.DescribeLocationEfsRequest {
+	return c.MockDescribeLocationEfs(i)
+}
+
+// DeleteLocationRequest calls the underlying MockDeleteLocation method.
+func (c *MockLocationEFSClient) DeleteLocationRequest(i *datasync.DeleteLocationInput) datasync.DeleteLocationRequest {
+	return c.MockDeleteLocation(i)
+}
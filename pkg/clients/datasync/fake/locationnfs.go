@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/datasync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LocationNFSClient = (*MockLocationNFSClient)(nil)
+
+// MockLocationNFSClient is a type that implements all the methods for LocationNFSClient interface
+type MockLocationNFSClient struct {
+	MockCreateLocationNfs   func(*datasync.CreateLocationNfsInput) datasync.CreateLocationNfsRequest
+	MockDescribeLocationNfs func(*datasync.DescribeLocationNfsInput) datasync.DescribeLocationNfsRequest
+	MockDeleteLocation      func(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+}
+
+// CreateLocationNfsRequest calls the underlying MockCreateLocationNfs method.
+func (c *MockLocationNFSClient) CreateLocationNfsRequest(i *datasync.CreateLocationNfsInput) datasync.CreateLocationNfsRequest {
+	return c.MockCreateLocationNfs(i)
+}
+
+// DescribeLocationNfsRequest calls the underlying MockDescribeLocationNfs method.
+func (c *MockLocationNFSClient) DescribeLocationNfsRequest(i *datasync.DescribeLocationNfsInput) datasync.DescribeLocationNfsRequest {
+	return c.MockDescribeLocationNfs(i)
+}
+
+// DeleteLocationRequest calls the underlying MockDeleteLocation method.
+func (c *MockLocationNFSClient) DeleteLocationRequest(i *datasync.DeleteLocationInput) datasync.DeleteLocationRequest {
+	return c.MockDeleteLocation(i)
+}
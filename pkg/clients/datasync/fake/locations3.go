@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/datasync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LocationS3Client = (*MockLocationS3Client)(nil)
+
+// MockLocationS3Client is a type that implements all the methods for LocationS3Client interface
+type MockLocationS3Client struct {
+	MockCreateLocationS3   func(*datasync.CreateLocationS3Input) datasync.CreateLocationS3Request
+	MockDescribeLocationS3 func(*datasync.DescribeLocationS3Input) datasync.DescribeLocationS3Request
+	MockDeleteLocation     func(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+}
+
+// CreateLocationS3Request calls the underlying MockCreateLocationS3 method.
+func (c *MockLocationS3Client) CreateLocationS3Request(i *datasync.CreateLocationS3Input) datasync.CreateLocationS3Request {
+	return c.MockCreateLocationS3(i)
+}
+
+// DescribeLocationS3Request calls the underlying MockDescribeLocationS3 method.
+func (c *MockLocationS3Client) DescribeLocationS3Request(i *datasync.DescribeLocationS3Input) datasync.DescribeLocationS3Request {
+	return c.MockDescribeLocationS3(i)
+}
+
+// DeleteLocationRequest calls the underlying MockDeleteLocation method.
+func (c *MockLocationS3Client) DeleteLocationRequest(i *datasync.DeleteLocationInput) datasync.DeleteLocationRequest {
+	return c.MockDeleteLocation(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/datasync"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TaskClient = (*MockTaskClient)(nil)
+
+// MockTaskClient is a type that implements all the methods for TaskClient interface
+type MockTaskClient struct {
+	MockCreateTask   func(*datasync.CreateTaskInput) datasync.CreateTaskRequest
+	MockDescribeTask func(*datasync.DescribeTaskInput) datasync.DescribeTaskRequest
+	MockUpdateTask   func(*datasync.UpdateTaskInput) datasync.UpdateTaskRequest
+	MockDeleteTask   func(*datasync.DeleteTaskInput) datasync.DeleteTaskRequest
+}
+
+// CreateTaskRequest calls the underlying MockCreateTask method.
+func (c *MockTaskClient) CreateTaskRequest(i *datasync.CreateTaskInput) datasync.CreateTaskRequest {
+	return c.MockCreateTask(i)
+}
+
+// DescribeTaskRequest calls the underlying MockDescribeTask method.
+func (c *MockTaskClient) DescribeTaskRequest(i *datasync.DescribeTaskInput) datasync.DescribeTaskRequest {
+	return c.MockDescribeTask(i)
+}
+
+// UpdateTaskRequest calls the underlying MockUpdateTask method.
+func (c *MockTaskClient) UpdateTaskRequest(i *datasync.UpdateTaskInput) datasync.UpdateTaskRequest {
+	return c.MockUpdateTask(i)
+}
+
+// DeleteTaskRequest calls the underlying MockDeleteTask method.
+func (c *MockTaskClient) DeleteTaskRequest(i *datasync.DeleteTaskInput) datasync.DeleteTaskRequest {
+	return c.MockDeleteTask(i)
+}
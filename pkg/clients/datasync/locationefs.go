@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// LocationEFSClient is the external client used for LocationEFS Custom Resource
+type LocationEFSClient interface {
+	CreateLocationEfsRequest(*datasync.CreateLocationEfsInput) datasync.CreateLocationEfsRequest
+	DescribeLocationEfsRequest(*datasync.DescribeLocationEfsInput) datasync.DescribeLocationEfsRequest
+	DeleteLocationRequest(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+}
+
+// NewLocationEFSClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewLocationEFSClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (LocationEFSClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return datasync.New(*cfg), err
+}
+
+// GenerateCreateLocationEFSInput returns the input to create an EFS location
+// from the supplied parameters.
+func GenerateCreateLocationEFSInput(p v1alpha1.LocationEFSParameters) *datasync.CreateLocationEfsInput {
+	return &datasync.CreateLocationEfsInput{
+		EfsFilesystemArn: aws.String(p.EFSFilesystemARN),
+		Ec2Config: &datasync.Ec2Config{
+			SubnetArn:         aws.String(p.SubnetARN),
+			SecurityGroupArns: p.SecurityGroupARNs,
+		},
+		Subdirectory: p.Subdirectory,
+		Tags:         generateTags(p.Tags),
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// LocationNFSClient is the external client used for LocationNFS Custom Resource
+type LocationNFSClient interface {
+	CreateLocationNfsRequest(*datasync.CreateLocationNfsInput) datasync.CreateLocationNfsRequest
+	DescribeLocationNfsRequest(*datasync.DescribeLocationNfsInput) datasync.DescribeLocationNfsRequest
+	DeleteLocationRequest(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+}
+
+// NewLocationNFSClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewLocationNFSClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (LocationNFSClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return datasync.New(*cfg), err
+}
+
+// GenerateCreateLocationNFSInput returns the input to create an NFS location
+// from the supplied parameters.
+func GenerateCreateLocationNFSInput(p v1alpha1.LocationNFSParameters) *datasync.CreateLocationNfsInput {
+	in := &datasync.CreateLocationNfsInput{
+		ServerHostname: aws.String(p.ServerHostname),
+		Subdirectory:   aws.String(p.Subdirectory),
+		OnPremConfig:   &datasync.OnPremConfig{AgentArns: p.AgentARNs},
+		Tags:           generateTags(p.Tags),
+	}
+	if p.Version != nil {
+		in.MountOptions = &datasync.NfsMountOptions{Version: datasync.NfsVersion(aws.StringValue(p.Version))}
+	}
+	return in
+}
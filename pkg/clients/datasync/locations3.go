@@ -0,0 +1,57 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// LocationS3Client is the external client used for LocationS3 Custom Resource
+type LocationS3Client interface {
+	CreateLocationS3Request(*datasync.CreateLocationS3Input) datasync.CreateLocationS3Request
+	DescribeLocationS3Request(*datasync.DescribeLocationS3Input) datasync.DescribeLocationS3Request
+	DeleteLocationRequest(*datasync.DeleteLocationInput) datasync.DeleteLocationRequest
+}
+
+// NewLocationS3Client returns a new client using AWS credentials as JSON encoded
+// data.
+func NewLocationS3Client(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (LocationS3Client, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return datasync.New(*cfg), err
+}
+
+// GenerateCreateLocationS3Input returns the input to create an S3 location
+// from the supplied parameters, referring to the bucket in the supplied
+// partition.
+func GenerateCreateLocationS3Input(partition string, p v1alpha1.LocationS3Parameters) *datasync.CreateLocationS3Input {
+	return &datasync.CreateLocationS3Input{
+		S3BucketArn:    aws.String(awsclients.S3BucketARN(partition, aws.StringValue(p.S3Bucket))),
+		S3Config:       &datasync.S3Config{BucketAccessRoleArn: p.BucketAccessRoleARN},
+		S3StorageClass: datasync.S3StorageClass(aws.StringValue(p.S3StorageClass)),
+		Subdirectory:   p.Subdirectory,
+		Tags:           generateTags(p.Tags),
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// TaskClient is the external client used for Task Custom Resource
+type TaskClient interface {
+	CreateTaskRequest(*datasync.CreateTaskInput) datasync.CreateTaskRequest
+	DescribeTaskRequest(*datasync.DescribeTaskInput) datasync.DescribeTaskRequest
+	UpdateTaskRequest(*datasync.UpdateTaskInput) datasync.UpdateTaskRequest
+	DeleteTaskRequest(*datasync.DeleteTaskInput) datasync.DeleteTaskRequest
+}
+
+// NewTaskClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewTaskClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (TaskClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return datasync.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the DataSync resource
+// doesn't exist. DataSync reports an unknown ARN as an invalid request.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == datasync.ErrCodeInvalidRequestException
+	}
+	return false
+}
+
+func generateTags(t []v1alpha1.Tag) []datasync.TagListEntry {
+	if len(t) == 0 {
+		return nil
+	}
+	tags := make([]datasync.TagListEntry, len(t))
+	for i := range t {
+		tags[i] = datasync.TagListEntry{Key: aws.String(t[i].Key), Value: t[i].Value}
+	}
+	return tags
+}
+
+func generateFilterRules(f []v1alpha1.FilterRule) []datasync.FilterRule {
+	if len(f) == 0 {
+		return nil
+	}
+	rules := make([]datasync.FilterRule, len(f))
+	for i := range f {
+		rules[i] = datasync.FilterRule{
+			FilterType: datasync.FilterType(aws.StringValue(f[i].FilterType)),
+			Value:      aws.String(f[i].Value),
+		}
+	}
+	return rules
+}
+
+func generateOptions(o *v1alpha1.TaskOptions) *datasync.Options {
+	if o == nil {
+		return nil
+	}
+	return &datasync.Options{
+		BytesPerSecond:       o.BytesPerSecond,
+		VerifyMode:           datasync.VerifyMode(aws.StringValue(o.VerifyMode)),
+		OverwriteMode:        datasync.OverwriteMode(aws.StringValue(o.OverwriteMode)),
+		PreserveDeletedFiles: datasync.PreserveDeletedFiles(aws.StringValue(o.PreserveDeletedFiles)),
+		PreserveDevices:      datasync.PreserveDevices(aws.StringValue(o.PreserveDevices)),
+		Atime:                datasync.Atime(aws.StringValue(o.Atime)),
+		Mtime:                datasync.Mtime(aws.StringValue(o.Mtime)),
+		Uid:                  datasync.Uid(aws.StringValue(o.UID)),
+		Gid:                  datasync.Gid(aws.StringValue(o.GID)),
+		PosixPermissions:     datasync.PosixPermissions(aws.StringValue(o.PosixPermissions)),
+		TaskQueueing:         datasync.TaskQueueing(aws.StringValue(o.TaskQueueing)),
+		LogLevel:             datasync.LogLevel(aws.StringValue(o.LogLevel)),
+	}
+}
+
+func generateSchedule(expr *string) *datasync.TaskSchedule {
+	if expr == nil {
+		return nil
+	}
+	return &datasync.TaskSchedule{ScheduleExpression: expr}
+}
+
+// GenerateCreateTaskInput returns the input to create a task from the
+// supplied parameters.
+func GenerateCreateTaskInput(p v1alpha1.TaskParameters) *datasync.CreateTaskInput {
+	return &datasync.CreateTaskInput{
+		Name:                   p.Name,
+		SourceLocationArn:      aws.String(p.SourceLocationARN),
+		DestinationLocationArn: aws.String(p.DestinationLocationARN),
+		CloudWatchLogGroupArn:  p.CloudWatchLogGroupARN,
+		Schedule:               generateSchedule(p.ScheduleExpression),
+		Excludes:               generateFilterRules(p.Excludes),
+		Options:                generateOptions(p.Options),
+		Tags:                   generateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateTaskInput returns the input to update the task with the
+// supplied ARN.
+func GenerateUpdateTaskInput(arn string, p v1alpha1.TaskParameters) *datasync.UpdateTaskInput {
+	return &datasync.UpdateTaskInput{
+		TaskArn:               aws.String(arn),
+		Name:                  p.Name,
+		CloudWatchLogGroupArn: p.CloudWatchLogGroupARN,
+		Schedule:              generateSchedule(p.ScheduleExpression),
+		Excludes:              generateFilterRules(p.Excludes),
+		Options:               generateOptions(p.Options),
+	}
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return aws.String(s)
+}
+
+func observeOptions(o *datasync.Options) *v1alpha1.TaskOptions {
+	if o == nil {
+		return nil
+	}
+	return &v1alpha1.TaskOptions{
+		BytesPerSecond:       o.BytesPerSecond,
+		VerifyMode:           stringOrNil(string(o.VerifyMode)),
+		OverwriteMode:        stringOrNil(string(o.OverwriteMode)),
+		PreserveDeletedFiles: stringOrNil(string(o.PreserveDeletedFiles)),
+		PreserveDevices:      stringOrNil(string(o.PreserveDevices)),
+		Atime:                stringOrNil(string(o.Atime)),
+		Mtime:                stringOrNil(string(o.Mtime)),
+		UID:                  stringOrNil(string(o.Uid)),
+		GID:                  stringOrNil(string(o.Gid)),
+		PosixPermissions:     stringOrNil(string(o.PosixPermissions)),
+		TaskQueueing:         stringOrNil(string(o.TaskQueueing)),
+		LogLevel:             stringOrNil(string(o.LogLevel)),
+	}
+}
+
+func observeFilterRules(f []datasync.FilterRule) []v1alpha1.FilterRule {
+	if len(f) == 0 {
+		return nil
+	}
+	rules := make([]v1alpha1.FilterRule, len(f))
+	for i := range f {
+		rules[i] = v1alpha1.FilterRule{
+			FilterType: stringOrNil(string(f[i].FilterType)),
+			Value:      aws.StringValue(f[i].Value),
+		}
+	}
+	return rules
+}
+
+// LateInitializeTask fills the empty fields in *v1alpha1.TaskParameters with
+// the values seen in datasync.DescribeTaskOutput.
+func LateInitializeTask(in *v1alpha1.TaskParameters, o *datasync.DescribeTaskOutput) {
+	if o == nil {
+		return
+	}
+	in.Name = awsclients.LateInitializeStringPtr(in.Name, o.Name)
+	in.CloudWatchLogGroupARN = awsclients.LateInitializeStringPtr(in.CloudWatchLogGroupARN, o.CloudWatchLogGroupArn)
+	if in.ScheduleExpression == nil && o.Schedule != nil {
+		in.ScheduleExpression = o.Schedule.ScheduleExpression
+	}
+	obs := observeOptions(o.Options)
+	if obs == nil {
+		return
+	}
+	if in.Options == nil {
+		in.Options = &v1alpha1.TaskOptions{}
+	}
+	in.Options.BytesPerSecond = awsclients.LateInitializeInt64Ptr(in.Options.BytesPerSecond, obs.BytesPerSecond)
+	in.Options.VerifyMode = awsclients.LateInitializeStringPtr(in.Options.VerifyMode, obs.VerifyMode)
+	in.Options.OverwriteMode = awsclients.LateInitializeStringPtr(in.Options.OverwriteMode, obs.OverwriteMode)
+	in.Options.PreserveDeletedFiles = awsclients.LateInitializeStringPtr(in.Options.PreserveDeletedFiles, obs.PreserveDeletedFiles)
+	in.Options.PreserveDevices = awsclients.LateInitializeStringPtr(in.Options.PreserveDevices, obs.PreserveDevices)
+	in.Options.Atime = awsclients.LateInitializeStringPtr(in.Options.Atime, obs.Atime)
+	in.Options.Mtime = awsclients.LateInitializeStringPtr(in.Options.Mtime, obs.Mtime)
+	in.Options.UID = awsclients.LateInitializeStringPtr(in.Options.UID, obs.UID)
+	in.Options.GID = awsclients.LateInitializeStringPtr(in.Options.GID, obs.GID)
+	in.Options.PosixPermissions = awsclients.LateInitializeStringPtr(in.Options.PosixPermissions, obs.PosixPermissions)
+	in.Options.TaskQueueing = awsclients.LateInitializeStringPtr(in.Options.TaskQueueing, obs.TaskQueueing)
+	in.Options.LogLevel = awsclients.LateInitializeStringPtr(in.Options.LogLevel, obs.LogLevel)
+}
+
+// GenerateTaskObservation is used to produce v1alpha1.TaskObservation from
+// datasync.DescribeTaskOutput.
+func GenerateTaskObservation(o datasync.DescribeTaskOutput) v1alpha1.TaskObservation {
+	return v1alpha1.TaskObservation{
+		Status:                  string(o.Status),
+		CurrentTaskExecutionARN: aws.StringValue(o.CurrentTaskExecutionArn),
+		ErrorCode:               aws.StringValue(o.ErrorCode),
+		ErrorDetail:             aws.StringValue(o.ErrorDetail),
+	}
+}
+
+// IsTaskUpToDate returns true if there is no update-able difference between
+// desired and observed state of the resource.
+func IsTaskUpToDate(p v1alpha1.TaskParameters, o datasync.DescribeTaskOutput) bool {
+	var schedule *string
+	if o.Schedule != nil {
+		schedule = o.Schedule.ScheduleExpression
+	}
+	return aws.StringValue(p.Name) == aws.StringValue(o.Name) &&
+		aws.StringValue(p.CloudWatchLogGroupARN) == aws.StringValue(o.CloudWatchLogGroupArn) &&
+		aws.StringValue(p.ScheduleExpression) == aws.StringValue(schedule) &&
+		cmp.Equal(p.Excludes, observeFilterRules(o.Excludes), cmpopts.EquateEmpty()) &&
+		cmp.Equal(p.Options, observeOptions(o.Options), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+)
+
+var (
+	sourceARN = "arn:aws:datasync:us-east-1:123456789012:location/loc-0123456789abcdef0"
+	destARN   = "arn:aws:datasync:us-east-1:123456789012:location/loc-0123456789abcdef1"
+)
+
+func TestGenerateCreateTaskInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TaskParameters
+		want *datasync.CreateTaskInput
+	}{
+		"Minimal": {
+			p: v1alpha1.TaskParameters{SourceLocationARN: sourceARN, DestinationLocationARN: destARN},
+			want: &datasync.CreateTaskInput{
+				SourceLocationArn:      aws.String(sourceARN),
+				DestinationLocationArn: aws.String(destARN),
+			},
+		},
+		"Full": {
+			p: v1alpha1.TaskParameters{
+				Name:                   aws.String("nightly"),
+				SourceLocationARN:      sourceARN,
+				DestinationLocationARN: destARN,
+				ScheduleExpression:     aws.String("rate(1 day)"),
+				Excludes:               []v1alpha1.FilterRule{{FilterType: aws.String("SIMPLE_PATTERN"), Value: "/tmp|*.bak"}},
+				Options: &v1alpha1.TaskOptions{
+					BytesPerSecond: aws.Int64(1048576),
+					VerifyMode:     aws.String("ONLY_FILES_TRANSFERRED"),
+				},
+				Tags: []v1alpha1.Tag{{Key: "team", Value: aws.String("storage")}},
+			},
+			want: &datasync.CreateTaskInput{
+				Name:                   aws.String("nightly"),
+				SourceLocationArn:      aws.String(sourceARN),
+				DestinationLocationArn: aws.String(destARN),
+				Schedule:               &datasync.TaskSchedule{ScheduleExpression: aws.String("rate(1 day)")},
+				Excludes:               []datasync.FilterRule{{FilterType: datasync.FilterTypeSimplePattern, Value: aws.String("/tmp|*.bak")}},
+				Options: &datasync.Options{
+					BytesPerSecond: aws.Int64(1048576),
+					VerifyMode:     datasync.VerifyModeOnlyFilesTransferred,
+				},
+				Tags: []datasync.TagListEntry{{Key: aws.String("team"), Value: aws.String("storage")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateCreateTaskInput(tc.p)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeTask(t *testing.T) {
+	observed := &datasync.DescribeTaskOutput{
+		Name:     aws.String("nightly"),
+		Schedule: &datasync.TaskSchedule{ScheduleExpression: aws.String("rate(1 day)")},
+		Options: &datasync.Options{
+			BytesPerSecond: aws.Int64(-1),
+			VerifyMode:     datasync.VerifyModePointInTimeConsistent,
+			OverwriteMode:  datasync.OverwriteModeAlways,
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.TaskParameters
+		want v1alpha1.TaskParameters
+	}{
+		"Empty": {
+			p: v1alpha1.TaskParameters{},
+			want: v1alpha1.TaskParameters{
+				Name:               aws.String("nightly"),
+				ScheduleExpression: aws.String("rate(1 day)"),
+				Options: &v1alpha1.TaskOptions{
+					BytesPerSecond: aws.Int64(-1),
+					VerifyMode:     aws.String("POINT_IN_TIME_CONSISTENT"),
+					OverwriteMode:  aws.String("ALWAYS"),
+				},
+			},
+		},
+		"KeepDesired": {
+			p: v1alpha1.TaskParameters{
+				Options: &v1alpha1.TaskOptions{BytesPerSecond: aws.Int64(1048576)},
+			},
+			want: v1alpha1.TaskParameters{
+				Name:               aws.String("nightly"),
+				ScheduleExpression: aws.String("rate(1 day)"),
+				Options: &v1alpha1.TaskOptions{
+					BytesPerSecond: aws.Int64(1048576),
+					VerifyMode:     aws.String("POINT_IN_TIME_CONSISTENT"),
+					OverwriteMode:  aws.String("ALWAYS"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeTask(&tc.p, observed)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTaskUpToDate(t *testing.T) {
+	observed := datasync.DescribeTaskOutput{
+		Name:     aws.String("nightly"),
+		Schedule: &datasync.TaskSchedule{ScheduleExpression: aws.String("rate(1 day)")},
+		Excludes: []datasync.FilterRule{{FilterType: datasync.FilterTypeSimplePattern, Value: aws.String("/tmp")}},
+		Options:  &datasync.Options{BytesPerSecond: aws.Int64(-1)},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.TaskParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.TaskParameters{
+				Name:               aws.String("nightly"),
+				ScheduleExpression: aws.String("rate(1 day)"),
+				Excludes:           []v1alpha1.FilterRule{{FilterType: aws.String("SIMPLE_PATTERN"), Value: "/tmp"}},
+				Options:            &v1alpha1.TaskOptions{BytesPerSecond: aws.Int64(-1)},
+			},
+			want: true,
+		},
+		"ScheduleChanged": {
+			p: v1alpha1.TaskParameters{
+				Name:               aws.String("nightly"),
+				ScheduleExpression: aws.String("rate(12 hours)"),
+				Excludes:           []v1alpha1.FilterRule{{FilterType: aws.String("SIMPLE_PATTERN"), Value: "/tmp"}},
+				Options:            &v1alpha1.TaskOptions{BytesPerSecond: aws.Int64(-1)},
+			},
+			want: false,
+		},
+		"ExcludesChanged": {
+			p: v1alpha1.TaskParameters{
+				Name:               aws.String("nightly"),
+				ScheduleExpression: aws.String("rate(1 day)"),
+				Options:            &v1alpha1.TaskOptions{BytesPerSecond: aws.Int64(-1)},
+			},
+			want: false,
+		},
+		"BandwidthChanged": {
+			p: v1alpha1.TaskParameters{
+				Name:               aws.String("nightly"),
+				ScheduleExpression: aws.String("rate(1 day)"),
+				Excludes:           []v1alpha1.FilterRule{{FilterType: aws.String("SIMPLE_PATTERN"), Value: "/tmp"}},
+				Options:            &v1alpha1.TaskOptions{BytesPerSecond: aws.Int64(1048576)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsTaskUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
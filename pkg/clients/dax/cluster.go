@@ -0,0 +1,212 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dax
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ClusterClient is the external client used for Cluster Custom Resource
+type ClusterClient interface {
+	CreateClusterRequest(*dax.CreateClusterInput) dax.CreateClusterRequest
+	DescribeClustersRequest(*dax.DescribeClustersInput) dax.DescribeClustersRequest
+	UpdateClusterRequest(*dax.UpdateClusterInput) dax.UpdateClusterRequest
+	IncreaseReplicationFactorRequest(*dax.IncreaseReplicationFactorInput) dax.IncreaseReplicationFactorRequest
+	DecreaseReplicationFactorRequest(*dax.DecreaseReplicationFactorInput) dax.DecreaseReplicationFactorRequest
+	DeleteClusterRequest(*dax.DeleteClusterInput) dax.DeleteClusterRequest
+}
+
+// NewClusterClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewClusterClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ClusterClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return dax.New(*cfg), err
+}
+
+// IsClusterNotFound returns true if the error is because the cluster doesn't
+// exist.
+func IsClusterNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == dax.ErrCodeClusterNotFoundFault
+	}
+	return false
+}
+
+// GenerateCreateClusterInput returns the input to create a cluster with the
+// supplied name and parameters.
+func GenerateCreateClusterInput(name string, p v1alpha1.ClusterParameters) *dax.CreateClusterInput {
+	in := &dax.CreateClusterInput{
+		ClusterName:                aws.String(name),
+		Description:                p.Description,
+		IamRoleArn:                 p.IAMRoleARN,
+		NodeType:                   aws.String(p.NodeType),
+		ReplicationFactor:          aws.Int64(p.ReplicationFactor),
+		AvailabilityZones:          p.AvailabilityZones,
+		SubnetGroupName:            p.SubnetGroupName,
+		SecurityGroupIds:           p.SecurityGroupIDs,
+		ParameterGroupName:         p.ParameterGroupName,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		NotificationTopicArn:       p.NotificationTopicARN,
+	}
+	if p.SSEEnabled != nil {
+		in.SSESpecification = &dax.SSESpecification{Enabled: p.SSEEnabled}
+	}
+	keys := make([]string, 0, len(p.Tags))
+	for k := range p.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		in.Tags = append(in.Tags, dax.Tag{Key: aws.String(k), Value: aws.String(p.Tags[k])})
+	}
+	return in
+}
+
+// GenerateUpdateClusterInput returns the input to update the modifiable
+// settings of the cluster with the supplied name.
+func GenerateUpdateClusterInput(name string, p v1alpha1.ClusterParameters) *dax.UpdateClusterInput {
+	return &dax.UpdateClusterInput{
+		ClusterName:                aws.String(name),
+		Description:                p.Description,
+		SecurityGroupIds:           p.SecurityGroupIDs,
+		ParameterGroupName:         p.ParameterGroupName,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		NotificationTopicArn:       p.NotificationTopicARN,
+	}
+}
+
+// LateInitializeCluster fills the empty fields of the supplied parameters
+// with the values of the observed cluster.
+func LateInitializeCluster(p *v1alpha1.ClusterParameters, c dax.Cluster) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, c.Description)
+	p.IAMRoleARN = awsclients.LateInitializeStringPtr(p.IAMRoleARN, c.IamRoleArn)
+	p.SubnetGroupName = awsclients.LateInitializeStringPtr(p.SubnetGroupName, c.SubnetGroup)
+	p.PreferredMaintenanceWindow = awsclients.LateInitializeStringPtr(p.PreferredMaintenanceWindow, c.PreferredMaintenanceWindow)
+	if c.ParameterGroup != nil {
+		p.ParameterGroupName = awsclients.LateInitializeStringPtr(p.ParameterGroupName, c.ParameterGroup.ParameterGroupName)
+	}
+	if c.NotificationConfiguration != nil {
+		p.NotificationTopicARN = awsclients.LateInitializeStringPtr(p.NotificationTopicARN, c.NotificationConfiguration.TopicArn)
+	}
+	if p.SSEEnabled == nil && c.SSEDescription != nil {
+		p.SSEEnabled = aws.Bool(c.SSEDescription.Status == dax.SSEStatusEnabled || c.SSEDescription.Status == dax.SSEStatusEnabling)
+	}
+	if len(p.SecurityGroupIDs) == 0 {
+		p.SecurityGroupIDs = securityGroupIDs(c.SecurityGroups)
+	}
+}
+
+func securityGroupIDs(groups []dax.SecurityGroupMembership) []string {
+	if len(groups) == 0 {
+		return nil
+	}
+	ids := make([]string, len(groups))
+	for i, g := range groups {
+		ids[i] = aws.StringValue(g.SecurityGroupIdentifier)
+	}
+	return ids
+}
+
+func generateEndpoint(e *dax.Endpoint) v1alpha1.Endpoint {
+	if e == nil {
+		return v1alpha1.Endpoint{}
+	}
+	return v1alpha1.Endpoint{Address: aws.StringValue(e.Address), Port: aws.Int64Value(e.Port)}
+}
+
+// GenerateClusterObservation returns the observation of the supplied
+// cluster.
+func GenerateClusterObservation(c dax.Cluster) v1alpha1.ClusterObservation {
+	o := v1alpha1.ClusterObservation{
+		ClusterARN:               aws.StringValue(c.ClusterArn),
+		Status:                   aws.StringValue(c.Status),
+		ClusterDiscoveryEndpoint: generateEndpoint(c.ClusterDiscoveryEndpoint),
+		ActiveNodes:              aws.Int64Value(c.ActiveNodes),
+		TotalNodes:               aws.Int64Value(c.TotalNodes),
+	}
+	if c.SSEDescription != nil {
+		o.SSEStatus = string(c.SSEDescription.Status)
+	}
+	for _, n := range c.Nodes {
+		o.Nodes = append(o.Nodes, v1alpha1.Node{
+			NodeID:           aws.StringValue(n.NodeId),
+			AvailabilityZone: aws.StringValue(n.AvailabilityZone),
+			Endpoint:         generateEndpoint(n.Endpoint),
+			NodeStatus:       aws.StringValue(n.NodeStatus),
+		})
+	}
+	return o
+}
+
+// IsReplicationFactorUpToDate returns true if the number of nodes of the
+// supplied cluster matches the desired replication factor.
+func IsReplicationFactorUpToDate(p v1alpha1.ClusterParameters, c dax.Cluster) bool {
+	return p.ReplicationFactor == aws.Int64Value(c.TotalNodes)
+}
+
+// IsClusterUpToDate returns true if the modifiable settings of the supplied
+// cluster match the parameters.
+func IsClusterUpToDate(p v1alpha1.ClusterParameters, c dax.Cluster) bool {
+	observed := v1alpha1.ClusterParameters{}
+	LateInitializeCluster(&observed, c)
+	desired := v1alpha1.ClusterParameters{
+		Description:                p.Description,
+		SecurityGroupIDs:           p.SecurityGroupIDs,
+		ParameterGroupName:         p.ParameterGroupName,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		NotificationTopicARN:       p.NotificationTopicARN,
+	}
+	current := v1alpha1.ClusterParameters{
+		Description:                observed.Description,
+		SecurityGroupIDs:           observed.SecurityGroupIDs,
+		ParameterGroupName:         observed.ParameterGroupName,
+		PreferredMaintenanceWindow: observed.PreferredMaintenanceWindow,
+		NotificationTopicARN:       observed.NotificationTopicARN,
+	}
+	return IsReplicationFactorUpToDate(p, c) && cmp.Equal(desired, current,
+		cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// GetClusterConnectionDetails returns the discovery endpoint of the supplied
+// cluster as connection details, which is empty until the cluster has
+// nodes.
+func GetClusterConnectionDetails(c dax.Cluster) managed.ConnectionDetails {
+	if c.ClusterDiscoveryEndpoint == nil || c.ClusterDiscoveryEndpoint.Address == nil {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(c.ClusterDiscoveryEndpoint.Address)),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(aws.Int64Value(c.ClusterDiscoveryEndpoint.Port)))),
+	}
+}
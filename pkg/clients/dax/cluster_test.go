@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dax
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dax"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+)
+
+func TestGenerateCreateClusterInput(t *testing.T) {
+	p := v1alpha1.ClusterParameters{
+		NodeType:          "dax.r4.large",
+		ReplicationFactor: 3,
+		IAMRoleARN:        aws.String("arn"),
+		SSEEnabled:        aws.Bool(true),
+		Tags:              map[string]string{"b": "2", "a": "1"},
+	}
+	want := &dax.CreateClusterInput{
+		ClusterName:       aws.String("cluster"),
+		NodeType:          aws.String("dax.r4.large"),
+		ReplicationFactor: aws.Int64(3),
+		IamRoleArn:        aws.String("arn"),
+		SSESpecification:  &dax.SSESpecification{Enabled: aws.Bool(true)},
+		Tags: []dax.Tag{
+			{Key: aws.String("a"), Value: aws.String("1")},
+			{Key: aws.String("b"), Value: aws.String("2")},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateCreateClusterInput("cluster", p)); diff != "" {
+		t.Errorf("GenerateCreateClusterInput(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsClusterUpToDate(t *testing.T) {
+	observed := dax.Cluster{
+		TotalNodes:     aws.Int64(3),
+		Description:    aws.String("desc"),
+		SecurityGroups: []dax.SecurityGroupMembership{{SecurityGroupIdentifier: aws.String("sg-2")}, {SecurityGroupIdentifier: aws.String("sg-1")}},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ClusterParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.ClusterParameters{
+				ReplicationFactor: 3,
+				Description:       aws.String("desc"),
+				SecurityGroupIDs:  []string{"sg-1", "sg-2"},
+			},
+			want: true,
+		},
+		"ReplicationFactorChanged": {
+			p: v1alpha1.ClusterParameters{
+				ReplicationFactor: 4,
+				Description:       aws.String("desc"),
+				SecurityGroupIDs:  []string{"sg-1", "sg-2"},
+			},
+			want: false,
+		},
+		"SecurityGroupsChanged": {
+			p: v1alpha1.ClusterParameters{
+				ReplicationFactor: 3,
+				Description:       aws.String("desc"),
+				SecurityGroupIDs:  []string{"sg-1"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsClusterUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("IsClusterUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dax"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dax"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ClusterClient = (*MockClusterClient)(nil)
+
+// MockClusterClient is a type that implements all the methods for ClusterClient interface
+type MockClusterClient struct {
+	MockCreateCluster             func(*dax.CreateClusterInput) dax.CreateClusterRequest
+	MockDescribeClusters          func(*dax.DescribeClustersInput) dax.DescribeClustersRequest
+	MockUpdateCluster             func(*dax.UpdateClusterInput) dax.UpdateClusterRequest
+	MockIncreaseReplicationFactor func(*dax.IncreaseReplicationFactorInput) dax.IncreaseReplicationFactorRequest
+	MockDecreaseReplicationFactor func(*dax.DecreaseReplicationFactorInput) dax.DecreaseReplicationFactorRequest
+	MockDeleteCluster             func(*dax.DeleteClusterInput) dax.DeleteClusterRequest
+}
+
+// CreateClusterRequest calls the underlying MockCreateCluster method.
+func (c *MockClusterClient) CreateClusterRequest(i *dax.CreateClusterInput) dax.CreateClusterRequest {
+	return c.MockCreateCluster(i)
+}
+
+// DescribeClustersRequest calls the underlying MockDescribeClusters method.
+func (c *MockClusterClient) DescribeClustersRequest(i *dax.DescribeClustersInput) dax.DescribeClustersRequest {
+	return c.MockDescribeClusters(i)
+}
+
+// UpdateClusterRequest calls the underlying MockUpdateCluster method.
+func (c *MockClusterClient) UpdateClusterRequest(i *dax.UpdateClusterInput) dax.UpdateClusterRequest {
+	return c.MockUpdateCluster(i)
+}
+
+// IncreaseReplicationFactorRequest calls the underlying MockIncreaseReplicationFactor method.
+func (c *MockClusterClient) IncreaseReplicationFactorRequest(i *dax.IncreaseReplicationFactorInput) dax.IncreaseReplicationFactorRequest {
+	return c.MockIncreaseReplicationFactor(i)
+}
+
+// DecreaseReplicationFactorRequest calls the underlying MockDecreaseReplicationFactor method.
+func (c *MockClusterClient) DecreaseReplicationFactorRequest(i *dax.DecreaseReplicationFactorInput) dax.DecreaseReplicationFactorRequest {
+	return c.MockDecreaseReplicationFactor(i)
+}
+
+// DeleteClusterRequest calls the underlying MockDeleteCluster method.
+func (c *MockClusterClient) DeleteClusterRequest(i *dax.DeleteClusterInput) dax.DeleteClusterRequest {
+	return c.MockDeleteCluster(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dax"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dax"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SubnetGroupClient = (*MockSubnetGroupClient)(nil)
+
+// MockSubnetGroupClient is a type that implements all the methods for SubnetGroupClient interface
+type MockSubnetGroupClient struct {
+	MockCreateSubnetGroup    func(*dax.CreateSubnetGroupInput) dax.CreateSubnetGroupRequest
+	MockDescribeSubnetGroups func(*dax.DescribeSubnetGroupsInput) dax.DescribeSubnetGroupsRequest
+	MockUpdateSubnetGroup    func(*dax.UpdateSubnetGroupInput) dax.UpdateSubnetGroupRequest
+	MockDeleteSubnetGroup    func(*dax.DeleteSubnetGroupInput) dax.DeleteSubnetGroupRequest
+}
+
+// CreateSubnetGroupRequest calls the underlying MockCreateSubnetGroup method.
+func (c *MockSubnetGroupClient) CreateSubnetGroupRequest(i *dax.CreateSubnetGroupInput) dax.CreateSubnetGroupRequest {
+	return c.MockCreateSubnetGroup(i)
+}
+
+// DescribeSubnetGroupsRequest calls the underlying MockDescribeSubnetGroups method.
+func (c *MockSubnetGroupClient) DescribeSubnetGroupsRequest(i *dax.DescribeSubnetGroupsInput) dax.DescribeSubnetGroupsRequest {
+	return c.MockDescribeSubnetGroups(i)
+}
+
+// UpdateSubnetGroupRequest calls the underlying MockUpdateSubnetGroup method.
+func (c *MockSubnetGroupClient) UpdateSubnetGroupRequest(i *dax.UpdateSubnetGroupInput) dax.UpdateSubnetGroupRequest {
+	return c.MockUpdateSubnetGroup(i)
+}
+
+// DeleteSubnetGroupRequest calls the underlying MockDeleteSubnetGroup method.
+func (c *MockSubnetGroupClient) DeleteSubnetGroupRequest(i *dax.DeleteSubnetGroupInput) dax.DeleteSubnetGroupRequest {
+	return c.MockDeleteSubnetGroup(i)
+}
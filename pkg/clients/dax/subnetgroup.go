@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dax
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SubnetGroupClient is the external client used for SubnetGroup Custom
+// Resource
+type SubnetGroupClient interface {
+	CreateSubnetGroupRequest(*dax.CreateSubnetGroupInput) dax.CreateSubnetGroupRequest
+	DescribeSubnetGroupsRequest(*dax.DescribeSubnetGroupsInput) dax.DescribeSubnetGroupsRequest
+	UpdateSubnetGroupRequest(*dax.UpdateSubnetGroupInput) dax.UpdateSubnetGroupRequest
+	DeleteSubnetGroupRequest(*dax.DeleteSubnetGroupInput) dax.DeleteSubnetGroupRequest
+}
+
+// NewSubnetGroupClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewSubnetGroupClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (SubnetGroupClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return dax.New(*cfg), err
+}
+
+// IsSubnetGroupNotFound returns true if the error is because the subnet group
+// doesn't exist.
+func IsSubnetGroupNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == dax.ErrCodeSubnetGroupNotFoundFault
+	}
+	return false
+}
+
+// LateInitializeSubnetGroup fills the empty fields of the supplied parameters
+// with the values of the observed subnet group.
+func LateInitializeSubnetGroup(p *v1alpha1.SubnetGroupParameters, g dax.SubnetGroup) {
+	p.Description = awsclients.LateInitializeStringPtr(p.Description, g.Description)
+	if len(p.SubnetIDs) == 0 {
+		p.SubnetIDs = subnetIDs(g.Subnets)
+	}
+}
+
+func subnetIDs(subnets []dax.Subnet) []string {
+	if len(subnets) == 0 {
+		return nil
+	}
+	ids := make([]string, len(subnets))
+	for i, s := range subnets {
+		ids[i] = aws.StringValue(s.SubnetIdentifier)
+	}
+	return ids
+}
+
+// IsSubnetGroupUpToDate returns true if the supplied subnet group matches the
+// parameters.
+func IsSubnetGroupUpToDate(p v1alpha1.SubnetGroupParameters, g dax.SubnetGroup) bool {
+	if aws.StringValue(p.Description) != aws.StringValue(g.Description) {
+		return false
+	}
+	return cmp.Equal(p.SubnetIDs, subnetIDs(g.Subnets),
+		cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/detective"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.GraphClient = (*MockGraphClient)(nil)
+
+// MockGraphClient is a type that implements all the methods for GraphClient interface
+type MockGraphClient struct {
+	MockCreateGraph func(*detective.CreateGraphInput) detective.CreateGraphRequest
+	MockListGraphs  func(*detective.ListGraphsInput) detective.ListGraphsRequest
+	MockDeleteGraph func(*detective.DeleteGraphInput) detective.DeleteGraphRequest
+}
+
+// CreateGraphRequest calls the underlying MockCreateGraph method.
+func (c *MockGraphClient) CreateGraphRequest(i *detective.CreateGraphInput) detective.CreateGraphRequest {
+	return c.MockCreateGraph(i)
+}
+
+// ListGraphsRequest calls the underlying MockListGraphs method.
+func (c *MockGraphClient) ListGraphsRequest(i *detective.ListGraphsInput) detective.ListGraphsRequest {
+	return c.MockListGraphs(i)
+}
+
+// DeleteGraphRequest calls the underlying MockDeleteGraph method.
+func (c *MockGraphClient) DeleteGraphRequest(i *detective.DeleteGraphInput) detective.DeleteGraphRequest {
+	return c.MockDeleteGraph(i)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/detective"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.MemberClient = (*MockMemberClient)(nil)
+
+// MockMemberClient is a type that implements all the methods for MemberClient interface
+type MockMemberClient struct {
+	MockCreateMembers func(*detective.CreateMembersInput) detective.CreateMembersRequest
+	MockGetMembers    func(*detective.GetMembersInput) detective.GetMembersRequest
+	MockDeleteMembers func(*detective.DeleteMembersInput) detective.DeleteMembersRequest
+}
+
+// CreateMembersRequest calls the underlying MockCreateMembers method.
+func (c *MockMemberClient) CreateMembersRequest(i *detective.CreateMembersInput) detective.CreateMembersRequest {
+	return c.MockCreateMembers(i)
+}
+
+// GetMembersRequest calls the underlying MockGetMembers method.
+func (c *MockMemberClient) GetMembersRequest(i *detective.GetMembersInput) detective.GetMembersRequest {
+	return c.MockGetMembers(i)
+}
+
+// DeleteMembersRequest calls the underlying MockDeleteMembers method.
+func (c *MockMemberClient) DeleteMembersRequest(i *detective.DeleteMembersInput) detective.DeleteMembersRequest {
+	return c.MockDeleteMembers(i)
+}
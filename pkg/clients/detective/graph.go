@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detective
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GraphClient is the external client used for Graph Custom Resource
+type GraphClient interface {
+	CreateGraphRequest(*detective.CreateGraphInput) detective.CreateGraphRequest
+	ListGraphsRequest(*detective.ListGraphsInput) detective.ListGraphsRequest
+	DeleteGraphRequest(*detective.DeleteGraphInput) detective.DeleteGraphRequest
+}
+
+// NewGraphClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewGraphClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (GraphClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return detective.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the graph or member
+// doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == detective.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GraphExists returns true if the graph with the supplied ARN is one of the
+// graphs of the calling account. Detective has no API to describe a single
+// graph, so the graphs are listed instead.
+func GraphExists(ctx context.Context, c GraphClient, arn string) (bool, error) {
+	input := &detective.ListGraphsInput{}
+	for {
+		rsp, err := c.ListGraphsRequest(input).Send(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, g := range rsp.GraphList {
+			if aws.StringValue(g.Arn) == arn {
+				return true, nil
+			}
+		}
+		if aws.StringValue(rsp.NextToken) == "" {
+			return false, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
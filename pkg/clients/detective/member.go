@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detective
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+
+	"github.com/crossplane/provider-aws/apis/detective/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// MemberClient is the external client used for Member Custom Resource
+type MemberClient interface {
+	CreateMembersRequest(*detective.CreateMembersInput) detective.CreateMembersRequest
+	GetMembersRequest(*detective.GetMembersInput) detective.GetMembersRequest
+	DeleteMembersRequest(*detective.DeleteMembersInput) detective.DeleteMembersRequest
+}
+
+// NewMemberClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewMemberClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (MemberClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return detective.New(*cfg), err
+}
+
+// GenerateCreateMembersInput returns the input to invite the account of the
+// supplied parameters to its graph.
+func GenerateCreateMembersInput(p v1alpha1.MemberParameters) *detective.CreateMembersInput {
+	return &detective.CreateMembersInput{
+		GraphArn: p.GraphARN,
+		Message:  p.Message,
+		Accounts: []detective.Account{{
+			AccountId:    aws.String(p.AccountID),
+			EmailAddress: aws.String(p.EmailAddress),
+		}},
+	}
+}
+
+// GenerateMemberObservation is used to produce v1alpha1.MemberObservation
+// from detective.MemberDetail.
+func GenerateMemberObservation(m detective.MemberDetail) v1alpha1.MemberObservation {
+	return v1alpha1.MemberObservation{
+		Status:         string(m.Status),
+		DisabledReason: string(m.DisabledReason),
+		MasterID:       aws.StringValue(m.MasterId),
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detective
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/detective/v1alpha1"
+)
+
+func TestGenerateCreateMembersInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.MemberParameters
+		want *detective.CreateMembersInput
+	}{
+		"Minimal": {
+			p: v1alpha1.MemberParameters{
+				GraphARN:     aws.String("arn:aws:detective:us-east-1:123456789012:graph:abc"),
+				AccountID:    "210987654321",
+				EmailAddress: "security@example.com",
+			},
+			want: &detective.CreateMembersInput{
+				GraphArn: aws.String("arn:aws:detective:us-east-1:123456789012:graph:abc"),
+				Accounts: []detective.Account{{
+					AccountId:    aws.String("210987654321"),
+					EmailAddress: aws.String("security@example.com"),
+				}},
+			},
+		},
+		"WithMessage": {
+			p: v1alpha1.MemberParameters{
+				GraphARN:     aws.String("arn:aws:detective:us-east-1:123456789012:graph:abc"),
+				AccountID:    "210987654321",
+				EmailAddress: "security@example.com",
+				Message:      aws.String("welcome"),
+			},
+			want: &detective.CreateMembersInput{
+				GraphArn: aws.String("arn:aws:detective:us-east-1:123456789012:graph:abc"),
+				Message:  aws.String("welcome"),
+				Accounts: []detective.Account{{
+					AccountId:    aws.String("210987654321"),
+					EmailAddress: aws.String("security@example.com"),
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateMembersInput(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateMemberObservation(t *testing.T) {
+	cases := map[string]struct {
+		m    detective.MemberDetail
+		want v1alpha1.MemberObservation
+	}{
+		"Enabled": {
+			m: detective.MemberDetail{
+				Status:   detective.MemberStatusEnabled,
+				MasterId: aws.String("123456789012"),
+			},
+			want: v1alpha1.MemberObservation{
+				Status:   "ENABLED",
+				MasterID: "123456789012",
+			},
+		},
+		"Disabled": {
+			m: detective.MemberDetail{
+				Status:         detective.MemberStatusAcceptedButDisabled,
+				DisabledReason: detective.MemberDisabledReasonVolumeTooHigh,
+			},
+			want: v1alpha1.MemberObservation{
+				Status:         "ACCEPTED_BUT_DISABLED",
+				DisabledReason: "VOLUME_TOO_HIGH",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateMemberObservation(tc.m)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directoryservice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+
+	"github.com/crossplane/provider-aws/apis/directoryservice/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DirectoryClient is the external client used for Directory Custom Resource
+type DirectoryClient interface {
+	CreateMicrosoftADRequest(*directoryservice.CreateMicrosoftADInput) directoryservice.CreateMicrosoftADRequest
+	DescribeDirectoriesRequest(*directoryservice.DescribeDirectoriesInput) directoryservice.DescribeDirectoriesRequest
+	DeleteDirectoryRequest(*directoryservice.DeleteDirectoryInput) directoryservice.DeleteDirectoryRequest
+}
+
+// NewDirectoryClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewDirectoryClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DirectoryClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return directoryservice.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the directory doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == directoryservice.ErrCodeEntityDoesNotExistException
+	}
+	return false
+}
+
+// GenerateCreateMicrosoftADInput returns the input to create a Microsoft AD
+// directory from the supplied parameters and Admin password.
+func GenerateCreateMicrosoftADInput(p v1alpha1.DirectoryParameters, password string) *directoryservice.CreateMicrosoftADInput {
+	in := &directoryservice.CreateMicrosoftADInput{
+		Name:        aws.String(p.Name),
+		ShortName:   p.ShortName,
+		Description: p.Description,
+		Edition:     directoryservice.DirectoryEdition(aws.StringValue(p.Edition)),
+		Password:    aws.String(password),
+		VpcSettings: &directoryservice.DirectoryVpcSettings{
+			VpcId:     p.VPCID,
+			SubnetIds: p.SubnetIDs,
+		},
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = make([]directoryservice.Tag, len(p.Tags))
+		for i := range p.Tags {
+			in.Tags[i] = directoryservice.Tag{Key: aws.String(p.Tags[i].Key), Value: aws.String(p.Tags[i].Value)}
+		}
+	}
+	return in
+}
+
+// LateInitializeDirectory fills the empty fields in
+// *v1alpha1.DirectoryParameters with the values seen in
+// directoryservice.DirectoryDescription.
+func LateInitializeDirectory(in *v1alpha1.DirectoryParameters, d *directoryservice.DirectoryDescription) {
+	if d == nil {
+		return
+	}
+	in.ShortName = awsclients.LateInitializeStringPtr(in.ShortName, d.ShortName)
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, d.Description)
+	if in.Edition == nil && d.Edition != "" {
+		in.Edition = aws.String(string(d.Edition))
+	}
+}
+
+// GenerateDirectoryObservation is used to produce
+// v1alpha1.DirectoryObservation from directoryservice.DirectoryDescription.
+func GenerateDirectoryObservation(d directoryservice.DirectoryDescription) v1alpha1.DirectoryObservation {
+	o := v1alpha1.DirectoryObservation{
+		Stage:       string(d.Stage),
+		StageReason: aws.StringValue(d.StageReason),
+		DNSIPAddrs:  d.DnsIpAddrs,
+		AccessURL:   aws.StringValue(d.AccessUrl),
+		Alias:       aws.StringValue(d.Alias),
+	}
+	if d.VpcSettings != nil {
+		o.SecurityGroupID = aws.StringValue(d.VpcSettings.SecurityGroupId)
+	}
+	return o
+}
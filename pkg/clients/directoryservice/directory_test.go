@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directoryservice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/directoryservice/v1alpha1"
+)
+
+func TestGenerateCreateMicrosoftADInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DirectoryParameters
+		want *directoryservice.CreateMicrosoftADInput
+	}{
+		"Minimal": {
+			p: v1alpha1.DirectoryParameters{
+				Name:      "corp.example.com",
+				VPCID:     aws.String("vpc-12345678"),
+				SubnetIDs: []string{"subnet-12345678", "subnet-87654321"},
+			},
+			want: &directoryservice.CreateMicrosoftADInput{
+				Name:     aws.String("corp.example.com"),
+				Password: aws.String("Sup3rS3cret!"),
+				VpcSettings: &directoryservice.DirectoryVpcSettings{
+					VpcId:     aws.String("vpc-12345678"),
+					SubnetIds: []string{"subnet-12345678", "subnet-87654321"},
+				},
+			},
+		},
+		"Full": {
+			p: v1alpha1.DirectoryParameters{
+				Name:      "corp.example.com",
+				ShortName: aws.String("CORP"),
+				Edition:   aws.String("Enterprise"),
+				VPCID:     aws.String("vpc-12345678"),
+				SubnetIDs: []string{"subnet-12345678", "subnet-87654321"},
+				Tags:      []v1alpha1.Tag{{Key: "team", Value: "identity"}},
+			},
+			want: &directoryservice.CreateMicrosoftADInput{
+				Name:      aws.String("corp.example.com"),
+				ShortName: aws.String("CORP"),
+				Edition:   directoryservice.DirectoryEditionEnterprise,
+				Password:  aws.String("Sup3rS3cret!"),
+				VpcSettings: &directoryservice.DirectoryVpcSettings{
+					VpcId:     aws.String("vpc-12345678"),
+					SubnetIds: []string{"subnet-12345678", "subnet-87654321"},
+				},
+				Tags: []directoryservice.Tag{{Key: aws.String("team"), Value: aws.String("identity")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateCreateMicrosoftADInput(tc.p, "Sup3rS3cret!")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateDirectoryObservation(t *testing.T) {
+	cases := map[string]struct {
+		d    directoryservice.DirectoryDescription
+		want v1alpha1.DirectoryObservation
+	}{
+		"Creating": {
+			d: directoryservice.DirectoryDescription{Stage: directoryservice.DirectoryStageCreating},
+			want: v1alpha1.DirectoryObservation{
+				Stage: "Creating",
+			},
+		},
+		"Active": {
+			d: directoryservice.DirectoryDescription{
+				Stage:       directoryservice.DirectoryStageActive,
+				DnsIpAddrs:  []string{"10.0.1.10", "10.0.2.10"},
+				AccessUrl:   aws.String("corp.awsapps.com"),
+				Alias:       aws.String("corp"),
+				VpcSettings: &directoryservice.DirectoryVpcSettingsDescription{SecurityGroupId: aws.String("sg-12345678")},
+			},
+			want: v1alpha1.DirectoryObservation{
+				Stage:           "Active",
+				DNSIPAddrs:      []string{"10.0.1.10", "10.0.2.10"},
+				AccessURL:       "corp.awsapps.com",
+				Alias:           "corp",
+				SecurityGroupID: "sg-12345678",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateDirectoryObservation(tc.d)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/directoryservice"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DirectoryClient = (*MockDirectoryClient)(nil)
+
+// MockDirectoryClient is a type that implements all the methods for DirectoryClient interface
+type MockDirectoryClient struct {
+	MockCreateMicrosoftAD   func(*directoryservice.CreateMicrosoftADInput) directoryservice.CreateMicrosoftADRequest
+	MockDescribeDirectories func(*directoryservice.DescribeDirectoriesInput) directoryservice.DescribeDirectoriesRequest
+	MockDeleteDirectory     func(*directoryservice.DeleteDirectoryInput) directoryservice.DeleteDirectoryRequest
+}
+
+// CreateMicrosoftADRequest calls the underlying MockCreateMicrosoftAD method.
+func (c *MockDirectoryClient) CreateMicrosoftADRequest(i *directoryservice.CreateMicrosoftADInput) directoryservice.CreateMicrosoftADRequest {
+	return c.MockCreateMicrosoftAD(i)
+}
+
+// DescribeDirectoriesRequest calls the underlying MockDescribeDirectories method.
+func (c *MockDirectoryClient) DescribeDirectoriesRequest(i *directoryservice.DescribeDirectoriesInput) directoryservice.DescribeDirectoriesRequest {
+	return c.MockDescribeDirectories(i)
+}
+
+// DeleteDirectoryRequest calls the underlying MockDeleteDirectory method.
+func (c *MockDirectoryClient) DeleteDirectoryRequest(i *directoryservice.DeleteDirectoryInput) directoryservice.DeleteDirectoryRequest {
+	return c.MockDeleteDirectory(i)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drift contains utilities that control how differences between the
+// desired and observed state of managed resources are handled.
+package drift
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+)
+
+// AnnotationKeyIgnoreFields is the annotation that lists, separated by commas,
+// the fields of spec.forProvider that are managed outside of the provider.
+// Nested fields are separated by dots, e.g. "scalingConfig.desiredSize".
+// Ignored fields are used when a resource is created, but they are neither
+// compared, updated nor late-initialized afterwards.
+const AnnotationKeyIgnoreFields = "aws.crossplane.io/ignore-fields"
+
+const (
+	errToUnstructured   = "cannot convert managed resource to unstructured"
+	errFromUnstructured = "cannot convert unstructured to managed resource"
+	errRestoreIgnored   = "cannot restore ignored fields of managed resource"
+)
+
+// IgnoredFields returns the paths of the fields of spec.forProvider that the
+// supplied resource asks to be ignored.
+func IgnoredFields(mg resource.Managed) [][]string {
+	var paths [][]string
+	for _, f := range strings.Split(mg.GetAnnotations()[AnnotationKeyIgnoreFields], ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		paths = append(paths, append([]string{"spec", "forProvider"}, strings.Split(f, ".")...))
+	}
+	return paths
+}
+
+// A cleared copy of a managed resource, whose ignored fields are unset.
+type cleared struct {
+	resource.Managed
+	orig  map[string]interface{}
+	paths [][]string
+}
+
+// clearCopy returns a copy of the supplied resource whose supplied fields are
+// unset. The supplied resource is left untouched.
+func clearCopy(mg resource.Managed, paths [][]string) (*cleared, error) {
+	orig, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errToUnstructured)
+	}
+	u := runtime.DeepCopyJSON(orig)
+	for _, p := range paths {
+		unstructured.RemoveNestedField(u, p...)
+	}
+	c := mg.DeepCopyObject().(resource.Managed)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, c); err != nil {
+		return nil, errors.Wrap(err, errFromUnstructured)
+	}
+	return &cleared{Managed: c, orig: orig, paths: paths}, nil
+}
+
+// restored returns a copy of the cleared resource whose ignored fields have
+// their original values.
+func (c *cleared) restored() (resource.Managed, error) {
+	r := c.DeepCopyObject().(resource.Managed)
+	return r, restore(r, c.orig, c.paths)
+}
+
+func restore(mg resource.Managed, orig map[string]interface{}, paths [][]string) error {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return errors.Wrap(err, errToUnstructured)
+	}
+	for _, p := range paths {
+		v, ok, _ := unstructured.NestedFieldCopy(orig, p...)
+		if !ok {
+			unstructured.RemoveNestedField(u, p...)
+			continue
+		}
+		if err := unstructured.SetNestedField(u, v, p...); err != nil {
+			return errors.Wrap(err, errFromUnstructured)
+		}
+	}
+	return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(u, mg), errFromUnstructured)
+}
+
+// set overwrites the supplied managed resource with the supplied value, which
+// must be of the same type.
+func set(mg, v resource.Managed) {
+	reflect.ValueOf(mg).Elem().Set(reflect.ValueOf(v).Elem())
+}
+
+// NewConnecter returns an ExternalConnecter whose ExternalClients honour the
+// fields that managed resources ask to be ignored through the
+// AnnotationKeyIgnoreFields annotation. A copy of the resource whose ignored
+// fields are cleared is observed or updated so that they are neither compared
+// with nor sent to AWS. Controllers that late-initialize save the copy while
+// observing it, in which case the ignored fields are saved again as they were
+// before the ExternalClient returns. Managed resources whose drift mode is
+// ModeReport are always reported to exist and be up to date once their drift
+// has been recorded, so that their external resources are left untouched, and
+// deleting them orphans their external resources. Resources that had their
+// drift recorded are marked with a DriftReported condition, so that only their
+// reports are deleted once they are enforced again.
+func NewConnecter(mgr manager.Manager, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c, kube: mgr.GetClient(), reporter: NewReporter(mgr.GetClient(), mgr.GetScheme())}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	kube     client.Client
+	reporter *Reporter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: e, kube: c.kube, reporter: c.reporter}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	kube     client.Client
+	reporter *Reporter
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	var o managed.ExternalObservation
+	err := e.ignoring(ctx, mg, func(ctx context.Context, mg resource.Managed) error {
+		var err error
+		o, err = e.ExternalClient.Observe(ctx, mg)
+		return err
+	})
+	if err != nil {
+		return o, err
+	}
+	if ModeOf(mg) != ModeReport {
+		return o, e.clear(ctx, mg)
+	}
+	return e.report(ctx, mg, o)
+}
+
+// clear deletes the DriftReport of a managed resource that was previously in
+// report mode. Resources that never had their drift recorded are left alone
+// so that enforcing them costs no API calls.
+func (e *external) clear(ctx context.Context, mg resource.Managed) error {
+	if mg.GetCondition(v1alpha3.TypeDriftReported).Status != corev1.ConditionTrue {
+		return nil
+	}
+	if err := e.reporter.Clear(ctx, mg); err != nil {
+		return err
+	}
+	mg.SetConditions(v1alpha3.DriftReportCleared())
+	return nil
+}
+
+// report records the drift of a managed resource in report mode and hides it
+// from the managed reconciler.
+func (e *external) report(ctx context.Context, mg resource.Managed, o managed.ExternalObservation) (managed.ExternalObservation, error) {
+	// Report mode is read-only: the external resource is orphaned regardless
+	// of the deletion policy, so the managed resource may be released
+	// straight away.
+	if meta.WasDeleted(mg) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	var reason v1alpha3.DriftReason
+	switch {
+	case !o.ResourceExists:
+		reason = v1alpha3.DriftReasonExternalResourceMissing
+	case !o.ResourceUpToDate:
+		reason = v1alpha3.DriftReasonNotUpToDate
+	}
+	reported := mg.GetCondition(v1alpha3.TypeDriftReported).Status == corev1.ConditionTrue
+	if reason != "" || reported {
+		if err := e.reporter.Record(ctx, mg, reason); err != nil {
+			return o, err
+		}
+	}
+	if reason != "" {
+		mg.SetConditions(v1alpha3.DriftReported())
+	}
+
+	o.ResourceExists = true
+	o.ResourceUpToDate = true
+	return o, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	var u managed.ExternalUpdate
+	err := e.ignoring(ctx, mg, func(ctx context.Context, mg resource.Managed) error {
+		var err error
+		u, err = e.ExternalClient.Update(ctx, mg)
+		return err
+	})
+	return u, err
+}
+
+// ignoring calls the supplied function with a copy of the supplied resource
+// whose ignored fields are cleared, then takes the changes the function made
+// to the copy except for those to the ignored fields. If the function saved
+// the copy, the ignored fields are saved again with their original values.
+func (e *external) ignoring(ctx context.Context, mg resource.Managed, fn func(ctx context.Context, mg resource.Managed) error) error {
+	paths := IgnoredFields(mg)
+	if len(paths) == 0 {
+		return fn(ctx, mg)
+	}
+	c, err := clearCopy(mg, paths)
+	if err != nil {
+		return err
+	}
+	version := mg.GetResourceVersion()
+	err = fn(ctx, c.Managed)
+	r, rerr := c.restored()
+	if rerr != nil {
+		return rerr
+	}
+	set(mg, r)
+	if mg.GetResourceVersion() == version {
+		return err
+	}
+
+	// Save a copy so that the status the function set is not overwritten by
+	// the one returned by the API server, and take only its new version.
+	u := mg.DeepCopyObject().(resource.Managed)
+	if uerr := e.kube.Update(ctx, u); uerr != nil && err == nil {
+		return errors.Wrap(uerr, errRestoreIgnored)
+	}
+	mg.SetResourceVersion(u.GetResourceVersion())
+	return err
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func vpc(ignore string) *v1beta1.VPC {
+	cr := &v1beta1.VPC{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-vpc"},
+		Spec: v1beta1.VPCSpec{
+			ForProvider: v1beta1.VPCParameters{
+				CIDRBlock:        "10.0.0.0/16",
+				EnableDNSSupport: aws.Bool(true),
+				InstanceTenancy:  aws.String("default"),
+				Tags:             []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+	if ignore != "" {
+		cr.SetAnnotations(map[string]string{AnnotationKeyIgnoreFields: ignore})
+	}
+	return cr
+}
+
+func TestIgnoredFields(t *testing.T) {
+	cases := map[string]struct {
+		ignore string
+		want   [][]string
+	}{
+		"None": {},
+		"Single": {
+			ignore: "tags",
+			want:   [][]string{{"spec", "forProvider", "tags"}},
+		},
+		"MultipleAndNested": {
+			ignore: " instanceTenancy, scalingConfig.desiredSize ,",
+			want: [][]string{
+				{"spec", "forProvider", "instanceTenancy"},
+				{"spec", "forProvider", "scalingConfig", "desiredSize"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IgnoredFields(vpc(tc.ignore))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnecter(t *testing.T) {
+	type want struct {
+		observed *v1beta1.VPC
+		updated  *v1beta1.VPC
+		after    *v1beta1.VPC
+	}
+
+	observed := func(cr *v1beta1.VPC) *v1beta1.VPC {
+		cr.Status.AtProvider.VPCState = "available"
+		cr.Spec.ForProvider.EnableDNSHostNames = aws.Bool(true)
+		return cr
+	}
+	cleared := func(cr *v1beta1.VPC) *v1beta1.VPC {
+		cr.Spec.ForProvider.Tags = nil
+		cr.Spec.ForProvider.InstanceTenancy = nil
+		return cr
+	}
+
+	cases := map[string]struct {
+		cr   *v1beta1.VPC
+		want want
+	}{
+		"NothingIgnored": {
+			cr: vpc(""),
+			want: want{
+				observed: vpc(""),
+				updated:  observed(vpc("")),
+				after:    observed(vpc("")),
+			},
+		},
+		"FieldsIgnored": {
+			cr: vpc("tags,instanceTenancy"),
+			want: want{
+				observed: cleared(vpc("tags,instanceTenancy")),
+				updated:  cleared(observed(vpc("tags,instanceTenancy"))),
+				after:    observed(vpc("tags,instanceTenancy")),
+			},
+		},
+		"LateInitializedFieldRestored": {
+			cr: vpc("enableDnsHostNames"),
+			want: want{
+				observed: vpc("enableDnsHostNames"),
+				updated: func() *v1beta1.VPC {
+					cr := vpc("enableDnsHostNames")
+					cr.Status.AtProvider.VPCState = "available"
+					return cr
+				}(),
+				after: func() *v1beta1.VPC {
+					cr := vpc("enableDnsHostNames")
+					cr.Status.AtProvider.VPCState = "available"
+					return cr
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewConnecter(testManager(&test.MockClient{MockGet: test.NewMockGetFn(notFound)}), managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						if diff := cmp.Diff(tc.want.observed, mg); diff != "" {
+							t.Errorf("Observe: -want, +got:\n%s", diff)
+						}
+						cr := mg.(*v1beta1.VPC)
+						cr.Status.AtProvider.VPCState = "available"
+						// Late initialize a field that the resource ignores.
+						cr.Spec.ForProvider.EnableDNSHostNames = aws.Bool(true)
+						return managed.ExternalObservation{ResourceExists: true}, nil
+					},
+					UpdateFn: func(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+						if diff := cmp.Diff(tc.want.updated, mg); diff != "" {
+							t.Errorf("Update: -want, +got:\n%s", diff)
+						}
+						return managed.ExternalUpdate{}, nil
+					},
+				}, nil
+			}))
+
+			e, err := c.Connect(context.Background(), tc.cr)
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Connect: -want, +got:\n%s", diff)
+			}
+			if _, err := e.Observe(context.Background(), tc.cr); err != nil {
+				t.Errorf("Observe: unexpected error %v", err)
+			}
+			if _, err := e.Update(context.Background(), tc.cr); err != nil {
+				t.Errorf("Update: unexpected error %v", err)
+			}
+			if diff := cmp.Diff(tc.want.after, tc.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRestoreSaved(t *testing.T) {
+	type want struct {
+		saved []*v1beta1.VPC
+		cr    *v1beta1.VPC
+		err   error
+	}
+
+	saved := func(version string, tenancy *string) *v1beta1.VPC {
+		cr := vpc("instanceTenancy")
+		cr.SetResourceVersion(version)
+		cr.Spec.ForProvider.InstanceTenancy = tenancy
+		return cr
+	}
+
+	cases := map[string]struct {
+		save      bool
+		updateErr error
+		want      want
+	}{
+		"NotSaved": {
+			want: want{
+				cr: saved("1", aws.String("default")),
+			},
+		},
+		"Saved": {
+			save: true,
+			want: want{
+				saved: []*v1beta1.VPC{saved("1", nil), saved("2", aws.String("default"))},
+				cr:    saved("3", aws.String("default")),
+			},
+		},
+		"RestoreError": {
+			save:      true,
+			updateErr: errBoom,
+			want: want{
+				saved: []*v1beta1.VPC{saved("1", nil), saved("2", aws.String("default"))},
+				cr:    saved("2", aws.String("default")),
+				err:   errors.Wrap(errBoom, errRestoreIgnored),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []*v1beta1.VPC
+			version := 1
+			kube := &test.MockClient{MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+				cr := obj.(*v1beta1.VPC)
+				got = append(got, cr.DeepCopy())
+				if len(got) > 1 && tc.updateErr != nil {
+					return tc.updateErr
+				}
+				version++
+				cr.SetResourceVersion(strconv.Itoa(version))
+				return nil
+			}}
+			c := NewConnecter(testManager(kube), managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						if tc.save {
+							return managed.ExternalObservation{}, kube.Update(ctx, mg)
+						}
+						return managed.ExternalObservation{}, nil
+					},
+				}, nil
+			}))
+
+			cr := saved("1", aws.String("default"))
+			e, _ := c.Connect(context.Background(), cr)
+			_, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.saved, got); diff != "" {
+				t.Errorf("Observe(...): -want saved, +got saved:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+)
+
+// AnnotationKeyMode is the annotation that sets the drift mode of a managed
+// resource, overriding DefaultMode.
+const AnnotationKeyMode = "aws.crossplane.io/drift-mode"
+
+// A Mode determines how differences between the desired state of a managed
+// resource and its external resource are handled.
+type Mode string
+
+// Drift modes.
+const (
+	// ModeEnforce creates, updates and deletes the external resource so that
+	// it matches the desired state.
+	ModeEnforce Mode = "Enforce"
+
+	// ModeReport records differences in a DriftReport instead. The external
+	// resource is never created, updated or deleted, and deleting the managed
+	// resource leaves it in place regardless of its deletion policy.
+	ModeReport Mode = "Report"
+)
+
+// DefaultMode is the drift mode of managed resources that do not set one.
+var DefaultMode = ModeEnforce
+
+const (
+	groupSuffix = ".aws.crossplane.io"
+
+	errGVK          = "cannot determine the kind of the managed resource"
+	errGetReport    = "cannot get drift report"
+	errCreateReport = "cannot create drift report"
+	errUpdateReport = "cannot update drift report"
+	errDeleteReport = "cannot delete drift report"
+)
+
+// ModeOf returns the drift mode of the supplied managed resource.
+func ModeOf(mg resource.Managed) Mode {
+	switch m := Mode(mg.GetAnnotations()[AnnotationKeyMode]); m {
+	case ModeEnforce, ModeReport:
+		return m
+	}
+	return DefaultMode
+}
+
+// ReportName returns the name of the DriftReport of the named managed
+// resource of the supplied kind, for example ec2.securitygroup.my-sg.
+func ReportName(gvk schema.GroupVersionKind, name string) string {
+	return strings.ToLower(strings.TrimSuffix(gvk.Group, groupSuffix) + "." + gvk.Kind + "." + name)
+}
+
+// A Reporter records drift of managed resources in DriftReports. A report is
+// only created once a managed resource has drifted, and is owned by the
+// managed resource so that it is garbage collected along with it.
+type Reporter struct {
+	kube   client.Client
+	scheme *runtime.Scheme
+}
+
+// NewReporter returns a Reporter that determines the kind of managed
+// resources using the supplied scheme.
+func NewReporter(c client.Client, s *runtime.Scheme) *Reporter {
+	return &Reporter{kube: c, scheme: s}
+}
+
+// Record the supplied reason the supplied managed resource differs from its
+// external resource, or that it does not if the reason is empty. Reports are
+// only written when the reason changes.
+func (r *Reporter) Record(ctx context.Context, mg resource.Managed, reason v1alpha3.DriftReason) error {
+	gvk, err := apiutil.GVKForObject(mg, r.scheme)
+	if err != nil {
+		return errors.Wrap(err, errGVK)
+	}
+
+	dr := &v1alpha3.DriftReport{}
+	err = r.kube.Get(ctx, types.NamespacedName{Name: ReportName(gvk, mg.GetName())}, dr)
+	switch {
+	case kerrors.IsNotFound(err):
+		if reason == "" {
+			return nil
+		}
+		dr = &v1alpha3.DriftReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            ReportName(gvk, mg.GetName()),
+				OwnerReferences: []metav1.OwnerReference{meta.AsOwner(meta.ReferenceTo(mg, gvk))},
+			},
+			Status: reportStatus(mg, gvk, reason),
+		}
+		return errors.Wrap(r.kube.Create(ctx, dr), errCreateReport)
+	case err != nil:
+		return errors.Wrap(err, errGetReport)
+	}
+
+	if dr.Status.Drifted == (reason != "") && dr.Status.Reason == reason {
+		return nil
+	}
+	dr.Status = reportStatus(mg, gvk, reason)
+	return errors.Wrap(r.kube.Update(ctx, dr), errUpdateReport)
+}
+
+// Clear deletes the DriftReport of the supplied managed resource, if any.
+func (r *Reporter) Clear(ctx context.Context, mg resource.Managed) error {
+	gvk, err := apiutil.GVKForObject(mg, r.scheme)
+	if err != nil {
+		return errors.Wrap(err, errGVK)
+	}
+
+	dr := &v1alpha3.DriftReport{}
+	if err := r.kube.Get(ctx, types.NamespacedName{Name: ReportName(gvk, mg.GetName())}, dr); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetReport)
+	}
+	return errors.Wrap(resource.IgnoreNotFound(r.kube.Delete(ctx, dr)), errDeleteReport)
+}
+
+func reportStatus(mg resource.Managed, gvk schema.GroupVersionKind, reason v1alpha3.DriftReason) v1alpha3.DriftReportStatus {
+	v, k := gvk.ToAPIVersionAndKind()
+	return v1alpha3.DriftReportStatus{
+		ResourceRef:        runtimev1alpha1.TypedReference{APIVersion: v, Kind: k, Name: mg.GetName(), UID: mg.GetUID()},
+		Drifted:            reason != "",
+		Reason:             reason,
+		LastTransitionTime: metav1.Now(),
+	}
+}
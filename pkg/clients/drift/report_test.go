@@ -0,0 +1,281 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+)
+
+var (
+	errBoom  = errors.New("boom")
+	notFound = kerrors.NewNotFound(schema.GroupResource{}, "report")
+)
+
+func testManager(c client.Client) manager.Manager {
+	s := runtime.NewScheme()
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+	_ = v1alpha3.SchemeBuilder.AddToScheme(s)
+	return &fake.Manager{Client: c, Scheme: s}
+}
+
+func report(drifted bool, reason v1alpha3.DriftReason) *v1alpha3.DriftReport {
+	return &v1alpha3.DriftReport{
+		ObjectMeta: metav1.ObjectMeta{Name: "ec2.vpc.some-vpc"},
+		Status:     v1alpha3.DriftReportStatus{Drifted: drifted, Reason: reason},
+	}
+}
+
+func TestModeOf(t *testing.T) {
+	cases := map[string]struct {
+		annotation string
+		want       Mode
+	}{
+		"Default":   {want: ModeEnforce},
+		"Report":    {annotation: "Report", want: ModeReport},
+		"Enforce":   {annotation: "Enforce", want: ModeEnforce},
+		"Unknown":   {annotation: "Ignore", want: ModeEnforce},
+		"Lowercase": {annotation: "report", want: ModeEnforce},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := vpc("")
+			if tc.annotation != "" {
+				cr.SetAnnotations(map[string]string{AnnotationKeyMode: tc.annotation})
+			}
+			if diff := cmp.Diff(tc.want, ModeOf(cr)); diff != "" {
+				t.Errorf("ModeOf(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReportMode(t *testing.T) {
+	type want struct {
+		o       managed.ExternalObservation
+		err     error
+		created *v1alpha3.DriftReport
+		updated *v1alpha3.DriftReport
+		deleted bool
+		cond    corev1.ConditionStatus
+	}
+
+	reportMode := func(cr *v1beta1.VPC) *v1beta1.VPC {
+		cr.SetAnnotations(map[string]string{AnnotationKeyMode: string(ModeReport)})
+		return cr
+	}
+	reported := func(cr *v1beta1.VPC) *v1beta1.VPC {
+		cr.SetConditions(v1alpha3.DriftReported())
+		return cr
+	}
+	deleted := func(cr *v1beta1.VPC) *v1beta1.VPC {
+		now := metav1.Now()
+		cr.SetDeletionTimestamp(&now)
+		return cr
+	}
+
+	cases := map[string]struct {
+		cr       *v1beta1.VPC
+		existing *v1alpha3.DriftReport
+		getErr   error
+		observed managed.ExternalObservation
+		want     want
+	}{
+		"NotUpToDate": {
+			cr:       reportMode(vpc("")),
+			observed: managed.ExternalObservation{ResourceExists: true},
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				created: report(true, v1alpha3.DriftReasonNotUpToDate),
+				cond:    corev1.ConditionTrue,
+			},
+		},
+		"Missing": {
+			cr:       reportMode(vpc("")),
+			observed: managed.ExternalObservation{},
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				created: report(true, v1alpha3.DriftReasonExternalResourceMissing),
+				cond:    corev1.ConditionTrue,
+			},
+		},
+		"InSyncWithoutReport": {
+			cr:       reportMode(vpc("")),
+			getErr:   errBoom,
+			observed: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: corev1.ConditionUnknown,
+			},
+		},
+		"BackInSync": {
+			cr:       reported(reportMode(vpc(""))),
+			existing: report(true, v1alpha3.DriftReasonNotUpToDate),
+			observed: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				updated: report(false, ""),
+				cond:    corev1.ConditionTrue,
+			},
+		},
+		"StillDrifted": {
+			cr:       reported(reportMode(vpc(""))),
+			existing: report(true, v1alpha3.DriftReasonNotUpToDate),
+			observed: managed.ExternalObservation{ResourceExists: true},
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: corev1.ConditionTrue,
+			},
+		},
+		"Deleted": {
+			cr:       deleted(reportMode(vpc(""))),
+			observed: managed.ExternalObservation{ResourceExists: true},
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: false},
+				cond: corev1.ConditionUnknown,
+			},
+		},
+		"EnforceClearsReport": {
+			cr:       reported(vpc("")),
+			existing: report(true, v1alpha3.DriftReasonNotUpToDate),
+			observed: managed.ExternalObservation{ResourceExists: true},
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true},
+				deleted: true,
+				cond:    corev1.ConditionFalse,
+			},
+		},
+		"EnforceWithoutReport": {
+			cr:       vpc(""),
+			getErr:   errBoom,
+			observed: managed.ExternalObservation{ResourceExists: true},
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: true},
+				cond: corev1.ConditionUnknown,
+			},
+		},
+		"GetReportError": {
+			cr:       reportMode(vpc("")),
+			getErr:   errBoom,
+			observed: managed.ExternalObservation{ResourceExists: true},
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: true},
+				err:  errors.Wrap(errBoom, errGetReport),
+				cond: corev1.ConditionUnknown,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created, updated *v1alpha3.DriftReport
+			del := false
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if tc.getErr != nil {
+						return tc.getErr
+					}
+					if key.Name != "ec2.vpc.some-vpc" {
+						t.Errorf("Get(...): unexpected report name %q", key.Name)
+					}
+					if tc.existing == nil {
+						return notFound
+					}
+					tc.existing.DeepCopyInto(obj.(*v1alpha3.DriftReport))
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					created = obj.(*v1alpha3.DriftReport)
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					updated = obj.(*v1alpha3.DriftReport)
+					return nil
+				},
+				MockDelete: func(_ context.Context, _ runtime.Object, _ ...client.DeleteOption) error {
+					del = true
+					return nil
+				},
+			}
+
+			c := NewConnecter(testManager(kube), managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return tc.observed, nil
+					},
+				}, nil
+			}))
+			e, _ := c.Connect(context.Background(), tc.cr)
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			ignore := []cmp.Option{
+				cmpopts.IgnoreFields(v1alpha3.DriftReportStatus{}, "ResourceRef", "LastTransitionTime"),
+				cmpopts.IgnoreFields(metav1.ObjectMeta{}, "OwnerReferences"),
+			}
+			if diff := cmp.Diff(tc.want.created, created, ignore...); diff != "" {
+				t.Errorf("Observe(...): -want created report, +got created report:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated, ignore...); diff != "" {
+				t.Errorf("Observe(...): -want updated report, +got updated report:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, del); diff != "" {
+				t.Errorf("Observe(...): -want deleted, +got deleted:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, tc.cr.GetCondition(v1alpha3.TypeDriftReported).Status); diff != "" {
+				t.Errorf("Observe(...): -want drift reported condition, +got drift reported condition:\n%s", diff)
+			}
+			if created != nil {
+				want := v1alpha3.DriftReportStatus{}
+				want.ResourceRef.APIVersion = v1beta1.SchemeGroupVersion.String()
+				want.ResourceRef.Kind = v1beta1.VPCKind
+				want.ResourceRef.Name = tc.cr.GetName()
+				if diff := cmp.Diff(want.ResourceRef, created.Status.ResourceRef); diff != "" {
+					t.Errorf("Observe(...): -want resource reference, +got resource reference:\n%s", diff)
+				}
+				if len(created.GetOwnerReferences()) != 1 {
+					t.Errorf("Observe(...): want report owned by the managed resource")
+				}
+			}
+		})
+	}
+}
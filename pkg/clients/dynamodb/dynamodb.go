@@ -35,6 +35,8 @@ type Client interface {
 	CreateTableRequest(input *dynamodb.CreateTableInput) dynamodb.CreateTableRequest
 	DeleteTableRequest(input *dynamodb.DeleteTableInput) dynamodb.DeleteTableRequest
 	UpdateTableRequest(input *dynamodb.UpdateTableInput) dynamodb.UpdateTableRequest
+	DescribeTableReplicaAutoScalingRequest(input *dynamodb.DescribeTableReplicaAutoScalingInput) dynamodb.DescribeTableReplicaAutoScalingRequest
+	UpdateTableReplicaAutoScalingRequest(input *dynamodb.UpdateTableReplicaAutoScalingInput) dynamodb.UpdateTableReplicaAutoScalingRequest
 }
 
 // NewClient creates new DynamoDB Client with provided AWS Configurations/Credentials
@@ -207,6 +209,7 @@ func GenerateObservation(t dynamodb.TableDescription) v1alpha1.DynamoTableObserv
 		TableID:                aws.StringValue(t.TableId),
 		TableStatus:            string(t.TableStatus),
 		TableName:              aws.StringValue(t.TableName),
+		Replicas:               GenerateReplicaObservations(t.Replicas),
 	}
 
 	if t.ProvisionedThroughput != nil {
@@ -221,7 +224,17 @@ func GenerateObservation(t dynamodb.TableDescription) v1alpha1.DynamoTableObserv
 
 // IsUpToDate checks whether there is a change in any of the modifiable fields.
 func IsUpToDate(p v1alpha1.DynamoTableParameters, t dynamodb.TableDescription) (bool, error) {
+	upToDate, err := IsTableUpToDate(p, t)
+	if err != nil || !upToDate {
+		return false, err
+	}
+	return GenerateReplicaUpdate(p.Replicas, t.Replicas) == nil, nil
+}
 
+// IsTableUpToDate checks whether there is a change in any of the modifiable
+// fields of the table itself, ignoring its replicas.
+func IsTableUpToDate(p v1alpha1.DynamoTableParameters, t dynamodb.TableDescription) (bool, error) {
+	p.Replicas = nil
 	patch, err := CreatePatch(&t, &p)
 	if err != nil {
 		return false, err
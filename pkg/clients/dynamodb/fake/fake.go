@@ -26,6 +26,9 @@ type MockDynamoClient struct {
 	MockCreate   func(input *dynamodb.CreateTableInput) dynamodb.CreateTableRequest
 	MockDelete   func(input *dynamodb.DeleteTableInput) dynamodb.DeleteTableRequest
 	MockUpdate   func(input *dynamodb.UpdateTableInput) dynamodb.UpdateTableRequest
+
+	MockDescribeReplicaAutoScaling func(input *dynamodb.DescribeTableReplicaAutoScalingInput) dynamodb.DescribeTableReplicaAutoScalingRequest
+	MockUpdateReplicaAutoScaling   func(input *dynamodb.UpdateTableReplicaAutoScalingInput) dynamodb.UpdateTableReplicaAutoScalingRequest
 }
 
 // DescribeTableRequest finds DynamoDB Table by name
@@ -47,3 +50,15 @@ func (m *MockDynamoClient) DeleteTableRequest(i *dynamodb.DeleteTableInput) dyna
 func (m *MockDynamoClient) UpdateTableRequest(i *dynamodb.UpdateTableInput) dynamodb.UpdateTableRequest {
 	return m.MockUpdate(i)
 }
+
+// DescribeTableReplicaAutoScalingRequest describes the auto scaling of the
+// replicas of a DynamoDB Table
+func (m *MockDynamoClient) DescribeTableReplicaAutoScalingRequest(i *dynamodb.DescribeTableReplicaAutoScalingInput) dynamodb.DescribeTableReplicaAutoScalingRequest {
+	return m.MockDescribeReplicaAutoScaling(i)
+}
+
+// UpdateTableReplicaAutoScalingRequest updates the auto scaling of the
+// replicas of a DynamoDB Table
+func (m *MockDynamoClient) UpdateTableReplicaAutoScalingRequest(i *dynamodb.UpdateTableReplicaAutoScalingInput) dynamodb.UpdateTableReplicaAutoScalingRequest {
+	return m.MockUpdateReplicaAutoScaling(i)
+}
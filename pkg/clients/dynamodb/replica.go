@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamodb
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+// GenerateReplicaObservations returns the observations of the replicas of the
+// supplied table.
+func GenerateReplicaObservations(replicas []dynamodb.ReplicaDescription) []v1alpha1.ReplicaObservation {
+	if len(replicas) == 0 {
+		return nil
+	}
+	o := make([]v1alpha1.ReplicaObservation, len(replicas))
+	for i, r := range replicas {
+		o[i] = v1alpha1.ReplicaObservation{
+			RegionName:                   aws.StringValue(r.RegionName),
+			ReplicaStatus:                string(r.ReplicaStatus),
+			ReplicaStatusDescription:     aws.StringValue(r.ReplicaStatusDescription),
+			ReplicaStatusPercentProgress: aws.StringValue(r.ReplicaStatusPercentProgress),
+		}
+	}
+	return o
+}
+
+// GenerateReplicaUpdate returns the next change needed to make the replicas
+// of a table match the desired ones, or nil if they already match. DynamoDB
+// accepts a single replica change per table update, so missing replicas are
+// created first, then differing replicas are updated and finally replicas
+// that are no longer desired are deleted.
+func GenerateReplicaUpdate(desired []v1alpha1.Replica, observed []dynamodb.ReplicaDescription) *dynamodb.ReplicationGroupUpdate {
+	current := make(map[string]dynamodb.ReplicaDescription, len(observed))
+	for _, r := range observed {
+		current[aws.StringValue(r.RegionName)] = r
+	}
+	for _, r := range desired {
+		if _, ok := current[r.RegionName]; !ok {
+			return &dynamodb.ReplicationGroupUpdate{Create: &dynamodb.CreateReplicationGroupMemberAction{
+				RegionName:                    aws.String(r.RegionName),
+				KMSMasterKeyId:                r.KMSMasterKeyID,
+				ProvisionedThroughputOverride: buildThroughputOverride(r.ReadCapacityUnitsOverride),
+				GlobalSecondaryIndexes:        buildReplicaIndexes(r.GlobalSecondaryIndexes),
+			}}
+		}
+	}
+	for _, r := range desired {
+		if !isReplicaUpToDate(r, current[r.RegionName]) {
+			return &dynamodb.ReplicationGroupUpdate{Update: &dynamodb.UpdateReplicationGroupMemberAction{
+				RegionName:                    aws.String(r.RegionName),
+				KMSMasterKeyId:                r.KMSMasterKeyID,
+				ProvisionedThroughputOverride: buildThroughputOverride(r.ReadCapacityUnitsOverride),
+				GlobalSecondaryIndexes:        buildReplicaIndexes(r.GlobalSecondaryIndexes),
+			}}
+		}
+		delete(current, r.RegionName)
+	}
+	for _, r := range observed {
+		if _, ok := current[aws.StringValue(r.RegionName)]; ok {
+			return &dynamodb.ReplicationGroupUpdate{Delete: &dynamodb.DeleteReplicationGroupMemberAction{
+				RegionName: r.RegionName,
+			}}
+		}
+	}
+	return nil
+}
+
+func isReplicaUpToDate(r v1alpha1.Replica, o dynamodb.ReplicaDescription) bool {
+	if r.KMSMasterKeyID != nil && aws.StringValue(r.KMSMasterKeyID) != aws.StringValue(o.KMSMasterKeyId) {
+		return false
+	}
+	if r.ReadCapacityUnitsOverride != nil && aws.Int64Value(r.ReadCapacityUnitsOverride) != readCapacityOverride(o.ProvisionedThroughputOverride) {
+		return false
+	}
+	indexes := make(map[string]int64, len(o.GlobalSecondaryIndexes))
+	for _, i := range o.GlobalSecondaryIndexes {
+		indexes[aws.StringValue(i.IndexName)] = readCapacityOverride(i.ProvisionedThroughputOverride)
+	}
+	for _, i := range r.GlobalSecondaryIndexes {
+		if i.ReadCapacityUnitsOverride != nil && aws.Int64Value(i.ReadCapacityUnitsOverride) != indexes[i.IndexName] {
+			return false
+		}
+	}
+	return true
+}
+
+func readCapacityOverride(o *dynamodb.ProvisionedThroughputOverride) int64 {
+	if o == nil {
+		return 0
+	}
+	return aws.Int64Value(o.ReadCapacityUnits)
+}
+
+func buildThroughputOverride(rcu *int64) *dynamodb.ProvisionedThroughputOverride {
+	if rcu == nil {
+		return nil
+	}
+	return &dynamodb.ProvisionedThroughputOverride{ReadCapacityUnits: rcu}
+}
+
+func buildReplicaIndexes(indexes []v1alpha1.ReplicaGlobalSecondaryIndex) []dynamodb.ReplicaGlobalSecondaryIndex {
+	if len(indexes) == 0 {
+		return nil
+	}
+	res := make([]dynamodb.ReplicaGlobalSecondaryIndex, len(indexes))
+	for i, val := range indexes {
+		res[i] = dynamodb.ReplicaGlobalSecondaryIndex{
+			IndexName:                     aws.String(val.IndexName),
+			ProvisionedThroughputOverride: buildThroughputOverride(val.ReadCapacityUnitsOverride),
+		}
+	}
+	return res
+}
+
+// HasReplicaAutoScaling returns true if the auto scaling of any of the
+// supplied replicas is specified.
+func HasReplicaAutoScaling(replicas []v1alpha1.Replica) bool {
+	for _, r := range replicas {
+		if r.ReadCapacityAutoScaling != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReplicaAutoScalingUpToDate returns true if the observed read capacity auto
+// scaling of the replicas matches the specified one.
+func IsReplicaAutoScalingUpToDate(replicas []v1alpha1.Replica, d *dynamodb.TableAutoScalingDescription) bool {
+	if d == nil {
+		return !HasReplicaAutoScaling(replicas)
+	}
+	observed := make(map[string]*dynamodb.AutoScalingSettingsDescription, len(d.Replicas))
+	for _, r := range d.Replicas {
+		observed[aws.StringValue(r.RegionName)] = r.ReplicaProvisionedReadCapacityAutoScalingSettings
+	}
+	for _, r := range replicas {
+		if r.ReadCapacityAutoScaling == nil {
+			continue
+		}
+		if !isAutoScalingUpToDate(*r.ReadCapacityAutoScaling, observed[r.RegionName]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAutoScalingUpToDate(a v1alpha1.ReplicaAutoScaling, s *dynamodb.AutoScalingSettingsDescription) bool {
+	if s == nil || aws.BoolValue(s.AutoScalingDisabled) {
+		return false
+	}
+	if aws.Int64Value(s.MinimumUnits) != a.MinimumUnits || aws.Int64Value(s.MaximumUnits) != a.MaximumUnits {
+		return false
+	}
+	for _, p := range s.ScalingPolicies {
+		if c := p.TargetTrackingScalingPolicyConfiguration; c != nil && aws.Float64Value(c.TargetValue) == float64(a.TargetUtilizationPercent) {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateUpdateTableReplicaAutoScalingInput returns the input to set the
+// read capacity auto scaling of the replicas of the table with the supplied
+// name.
+func GenerateUpdateTableReplicaAutoScalingInput(name string, replicas []v1alpha1.Replica) *dynamodb.UpdateTableReplicaAutoScalingInput {
+	in := &dynamodb.UpdateTableReplicaAutoScalingInput{TableName: aws.String(name)}
+	for _, r := range replicas {
+		a := r.ReadCapacityAutoScaling
+		if a == nil {
+			continue
+		}
+		in.ReplicaUpdates = append(in.ReplicaUpdates, dynamodb.ReplicaAutoScalingUpdate{
+			RegionName: aws.String(r.RegionName),
+			ReplicaProvisionedReadCapacityAutoScalingUpdate: &dynamodb.AutoScalingSettingsUpdate{
+				MinimumUnits: aws.Int64(a.MinimumUnits),
+				MaximumUnits: aws.Int64(a.MaximumUnits),
+				ScalingPolicyUpdate: &dynamodb.AutoScalingPolicyUpdate{
+					TargetTrackingScalingPolicyConfiguration: &dynamodb.AutoScalingTargetTrackingScalingPolicyConfigurationUpdate{
+						TargetValue: aws.Float64(float64(a.TargetUtilizationPercent)),
+					},
+				},
+			},
+		})
+	}
+	return in
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamodb
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+func TestGenerateReplicaUpdate(t *testing.T) {
+	cases := map[string]struct {
+		desired  []v1alpha1.Replica
+		observed []dynamodb.ReplicaDescription
+		want     *dynamodb.ReplicationGroupUpdate
+	}{
+		"UpToDate": {
+			desired: []v1alpha1.Replica{{RegionName: "eu-west-1", ReadCapacityUnitsOverride: aws.Int64(10)}},
+			observed: []dynamodb.ReplicaDescription{{
+				RegionName:                    aws.String("eu-west-1"),
+				KMSMasterKeyId:                aws.String("key"),
+				ProvisionedThroughputOverride: &dynamodb.ProvisionedThroughputOverride{ReadCapacityUnits: aws.Int64(10)},
+			}},
+		},
+		"CreateBeforeDelete": {
+			desired:  []v1alpha1.Replica{{RegionName: "eu-west-1", KMSMasterKeyID: aws.String("key")}},
+			observed: []dynamodb.ReplicaDescription{{RegionName: aws.String("us-west-2")}},
+			want: &dynamodb.ReplicationGroupUpdate{Create: &dynamodb.CreateReplicationGroupMemberAction{
+				RegionName:     aws.String("eu-west-1"),
+				KMSMasterKeyId: aws.String("key"),
+			}},
+		},
+		"UpdateIndexOverride": {
+			desired: []v1alpha1.Replica{{
+				RegionName:             "eu-west-1",
+				GlobalSecondaryIndexes: []v1alpha1.ReplicaGlobalSecondaryIndex{{IndexName: "idx", ReadCapacityUnitsOverride: aws.Int64(5)}},
+			}},
+			observed: []dynamodb.ReplicaDescription{{
+				RegionName:             aws.String("eu-west-1"),
+				GlobalSecondaryIndexes: []dynamodb.ReplicaGlobalSecondaryIndexDescription{{IndexName: aws.String("idx")}},
+			}},
+			want: &dynamodb.ReplicationGroupUpdate{Update: &dynamodb.UpdateReplicationGroupMemberAction{
+				RegionName: aws.String("eu-west-1"),
+				GlobalSecondaryIndexes: []dynamodb.ReplicaGlobalSecondaryIndex{{
+					IndexName:                     aws.String("idx"),
+					ProvisionedThroughputOverride: &dynamodb.ProvisionedThroughputOverride{ReadCapacityUnits: aws.Int64(5)},
+				}},
+			}},
+		},
+		"Delete": {
+			desired:  []v1alpha1.Replica{{RegionName: "eu-west-1"}},
+			observed: []dynamodb.ReplicaDescription{{RegionName: aws.String("eu-west-1")}, {RegionName: aws.String("us-west-2")}},
+			want: &dynamodb.ReplicationGroupUpdate{Delete: &dynamodb.DeleteReplicationGroupMemberAction{
+				RegionName: aws.String("us-west-2"),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateReplicaUpdate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateReplicaUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsReplicaAutoScalingUpToDate(t *testing.T) {
+	replicas := []v1alpha1.Replica{{
+		RegionName:              "eu-west-1",
+		ReadCapacityAutoScaling: &v1alpha1.ReplicaAutoScaling{MinimumUnits: 5, MaximumUnits: 50, TargetUtilizationPercent: 70},
+	}}
+	settings := func(target float64) *dynamodb.TableAutoScalingDescription {
+		return &dynamodb.TableAutoScalingDescription{Replicas: []dynamodb.ReplicaAutoScalingDescription{{
+			RegionName: aws.String("eu-west-1"),
+			ReplicaProvisionedReadCapacityAutoScalingSettings: &dynamodb.AutoScalingSettingsDescription{
+				MinimumUnits: aws.Int64(5),
+				MaximumUnits: aws.Int64(50),
+				ScalingPolicies: []dynamodb.AutoScalingPolicyDescription{{
+					TargetTrackingScalingPolicyConfiguration: &dynamodb.AutoScalingTargetTrackingScalingPolicyConfigurationDescription{
+						TargetValue: aws.Float64(target),
+					},
+				}},
+			},
+		}}}
+	}
+
+	cases := map[string]struct {
+		observed *dynamodb.TableAutoScalingDescription
+		want     bool
+	}{
+		"UpToDate": {
+			observed: settings(70),
+			want:     true,
+		},
+		"TargetChanged": {
+			observed: settings(50),
+			want:     false,
+		},
+		"NotConfigured": {
+			observed: &dynamodb.TableAutoScalingDescription{},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsReplicaAutoScalingUpToDate(replicas, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsReplicaAutoScalingUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
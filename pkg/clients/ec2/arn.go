@@ -0,0 +1,45 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// EC2 resource types as they appear in resource ARNs.
+const (
+	ResourceTypeVPC             = "vpc"
+	ResourceTypeRouteTable      = "route-table"
+	ResourceTypeSecurityGroup   = "security-group"
+	ResourceTypeInternetGateway = "internet-gateway"
+)
+
+// ResourceARN returns the ARN of the EC2 resource of the supplied type and ID
+// that is owned by the supplied account in the supplied region. DescribeX
+// calls do not return ARNs for most EC2 resources, so they are built from
+// their identifiers. An empty string is returned if any of them is unknown or
+// the region is not valid.
+func ResourceARN(region, ownerID, resourceType, id string) string {
+	if region == "" || ownerID == "" || id == "" {
+		return ""
+	}
+	partition, err := awsclients.PartitionForRegion(region)
+	if err != nil {
+		return ""
+	}
+	return awsclients.ARN(partition, "ec2", region, ownerID, resourceType+"/"+id)
+}
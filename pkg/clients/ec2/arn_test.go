@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResourceARN(t *testing.T) {
+	type args struct {
+		region       string
+		ownerID      string
+		resourceType string
+		id           string
+	}
+
+	cases := map[string]struct {
+		args
+		want string
+	}{
+		"Commercial": {
+			args: args{region: "us-east-1", ownerID: "123456789012", resourceType: ResourceTypeRouteTable, id: "rtb-0123456789abcdef0"},
+			want: "arn:aws:ec2:us-east-1:123456789012:route-table/rtb-0123456789abcdef0",
+		},
+		"China": {
+			args: args{region: "cn-north-1", ownerID: "123456789012", resourceType: ResourceTypeVPC, id: "vpc-0123456789abcdef0"},
+			want: "arn:aws-cn:ec2:cn-north-1:123456789012:vpc/vpc-0123456789abcdef0",
+		},
+		"GovCloud": {
+			args: args{region: "us-gov-west-1", ownerID: "123456789012", resourceType: ResourceTypeSecurityGroup, id: "sg-0123456789abcdef0"},
+			want: "arn:aws-us-gov:ec2:us-gov-west-1:123456789012:security-group/sg-0123456789abcdef0",
+		},
+		"InvalidRegion": {
+			args: args{region: "moon", ownerID: "123456789012", resourceType: ResourceTypeVPC, id: "vpc-0123456789abcdef0"},
+		},
+		"NoRegion": {
+			args: args{ownerID: "123456789012", resourceType: ResourceTypeVPC, id: "vpc-0123456789abcdef0"},
+		},
+		"NoOwner": {
+			args: args{region: "us-east-1", resourceType: ResourceTypeVPC, id: "vpc-0123456789abcdef0"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ResourceARN(tc.region, tc.ownerID, tc.resourceType, tc.id)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
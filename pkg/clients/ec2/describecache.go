@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// DefaultDescribeCache is the DescribeCache used by the Subnet, SecurityGroup
+// and RouteTable controllers. It is disabled unless its TTL is set.
+var DefaultDescribeCache = NewDescribeCache(0)
+
+// A DescribeCache serves observations of EC2 resources from a single
+// paginated Describe call per kind of resource and provider, rather than from
+// one Describe call per resource. Resources that are missing from the latest
+// listing, or that were changed since it was made, are not served from the
+// cache so that their callers describe them directly.
+type DescribeCache struct {
+	// TTL is how long a listing is served before it is refreshed. The cache
+	// is disabled when TTL is zero.
+	TTL time.Duration
+
+	now func() time.Time
+
+	mu       sync.Mutex
+	listings map[string]*listing
+}
+
+type listing struct {
+	mu          sync.Mutex
+	started     time.Time
+	items       map[string]interface{}
+	invalidated map[string]time.Time
+}
+
+// NewDescribeCache returns a DescribeCache that refreshes its listings after
+// the supplied TTL.
+func NewDescribeCache(ttl time.Duration) *DescribeCache {
+	return &DescribeCache{TTL: ttl, now: time.Now, listings: map[string]*listing{}}
+}
+
+func (c *DescribeCache) listing(kind, provider string) *listing {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	k := kind + "/" + provider
+	l, ok := c.listings[k]
+	if !ok {
+		l = &listing{invalidated: map[string]time.Time{}}
+		c.listings[k] = l
+	}
+	return l
+}
+
+// Invalidate the cached observation of the supplied resource, such that it
+// is not served until the listing has been refreshed. Controllers that
+// observe resources through the cache must invalidate a resource whenever
+// they update or delete it, because their changes are not reflected in the
+// cached listing until it is next refreshed.
+func (c *DescribeCache) Invalidate(kind, provider, id string) {
+	if c == nil || c.TTL == 0 {
+		return
+	}
+	l := c.listing(kind, provider)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.invalidated[id] = c.now()
+}
+
+// get returns the cached observation of the supplied resource, refreshing the
+// listing using the supplied function if it has expired. It returns false if
+// the resource should be described directly. Listing errors are not
+// returned; callers fall back to describing resources directly until the next
+// refresh.
+func (c *DescribeCache) get(ctx context.Context, kind, provider, id string, list func(context.Context) (map[string]interface{}, error)) (interface{}, bool) {
+	if c == nil || c.TTL == 0 {
+		return nil, false
+	}
+	l := c.listing(kind, provider)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now := c.now(); now.Sub(l.started) >= c.TTL {
+		items, err := list(ctx)
+		if err != nil {
+			items = nil
+		}
+		l.started, l.items = now, items
+		for i, t := range l.invalidated {
+			if t.Before(now) {
+				delete(l.invalidated, i)
+			}
+		}
+	}
+	if _, ok := l.invalidated[id]; ok {
+		return nil, false
+	}
+	o, ok := l.items[id]
+	return o, ok
+}
+
+// Resource kinds served by a DescribeCache.
+const (
+	CacheKindSubnet        = "Subnet"
+	CacheKindSecurityGroup = "SecurityGroup"
+	CacheKindRouteTable    = "RouteTable"
+)
+
+// Subnet returns the cached observation of the supplied subnet, or false if
+// it should be described directly.
+func (c *DescribeCache) Subnet(ctx context.Context, client SubnetClient, provider, id string) (ec2.Subnet, bool) {
+	o, ok := c.get(ctx, CacheKindSubnet, provider, id, func(ctx context.Context) (map[string]interface{}, error) {
+		items := map[string]interface{}{}
+		p := ec2.NewDescribeSubnetsPaginator(client.DescribeSubnetsRequest(&ec2.DescribeSubnetsInput{}))
+		for p.Next(ctx) {
+			for _, s := range p.CurrentPage().Subnets {
+				items[aws.StringValue(s.SubnetId)] = s
+			}
+		}
+		return items, p.Err()
+	})
+	if !ok {
+		return ec2.Subnet{}, false
+	}
+	return o.(ec2.Subnet), true
+}
+
+// SecurityGroup returns the cached observation of the supplied security
+// group, or false if it should be described directly.
+func (c *DescribeCache) SecurityGroup(ctx context.Context, client SecurityGroupClient, provider, id string) (ec2.SecurityGroup, bool) {
+	o, ok := c.get(ctx, CacheKindSecurityGroup, provider, id, func(ctx context.Context) (map[string]interface{}, error) {
+		items := map[string]interface{}{}
+		p := ec2.NewDescribeSecurityGroupsPaginator(client.DescribeSecurityGroupsRequest(&ec2.DescribeSecurityGroupsInput{}))
+		for p.Next(ctx) {
+			for _, sg := range p.CurrentPage().SecurityGroups {
+				items[aws.StringValue(sg.GroupId)] = sg
+			}
+		}
+		return items, p.Err()
+	})
+	if !ok {
+		return ec2.SecurityGroup{}, false
+	}
+	return o.(ec2.SecurityGroup), true
+}
+
+// RouteTable returns the cached observation of the supplied route table, or
+// false if it should be described directly.
+func (c *DescribeCache) RouteTable(ctx context.Context, client RouteTableClient, provider, id string) (ec2.RouteTable, bool) {
+	o, ok := c.get(ctx, CacheKindRouteTable, provider, id, func(ctx context.Context) (map[string]interface{}, error) {
+		items := map[string]interface{}{}
+		p := ec2.NewDescribeRouteTablesPaginator(client.DescribeRouteTablesRequest(&ec2.DescribeRouteTablesInput{}))
+		for p.Next(ctx) {
+			for _, rt := range p.CurrentPage().RouteTables {
+				items[aws.StringValue(rt.RouteTableId)] = rt
+			}
+		}
+		return items, p.Err()
+	})
+	if !ok {
+		return ec2.RouteTable{}, false
+	}
+	return o.(ec2.RouteTable), true
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+const (
+	cacheProvider = "aws-provider"
+	cachedSubnet  = "subnet-cached"
+)
+
+var errBoom = errors.New("boom")
+
+// listSubnetsClient lists a single subnet and counts how often it was called.
+type listSubnetsClient struct {
+	SubnetClient
+	calls int
+	err   error
+}
+
+func (c *listSubnetsClient) DescribeSubnetsRequest(_ *ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest {
+	c.calls++
+	return c.request(nil)
+}
+
+func (c *listSubnetsClient) request(_ *ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest {
+	return ec2.DescribeSubnetsRequest{
+		Request: &aws.Request{Operation: &aws.Operation{Name: "DescribeSubnets"}, HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: c.err, Data: &ec2.DescribeSubnetsOutput{
+			Subnets: []ec2.Subnet{{SubnetId: aws.String(cachedSubnet)}},
+		}},
+		Copy: c.request,
+	}
+}
+
+func TestDescribeCache(t *testing.T) {
+	type want struct {
+		served bool
+		calls  int
+	}
+
+	cases := map[string]struct {
+		reason string
+		ttl    time.Duration
+		id     string
+		prep   func(c *DescribeCache, now *time.Time, cl SubnetClient)
+		err    error
+		want   want
+	}{
+		"Disabled": {
+			reason: "A cache with no TTL should never list or serve resources.",
+			id:     cachedSubnet,
+			want:   want{served: false, calls: 0},
+		},
+		"Served": {
+			reason: "A listed resource should be served from a single listing.",
+			ttl:    time.Minute,
+			id:     cachedSubnet,
+			prep: func(c *DescribeCache, _ *time.Time, cl SubnetClient) {
+				c.Subnet(context.Background(), cl, cacheProvider, cachedSubnet)
+			},
+			want: want{served: true, calls: 1},
+		},
+		"NotListed": {
+			reason: "A resource missing from the listing should be described directly.",
+			ttl:    time.Minute,
+			id:     "subnet-new",
+			want:   want{served: false, calls: 1},
+		},
+		"ListError": {
+			reason: "Resources should be described directly when listing fails.",
+			ttl:    time.Minute,
+			id:     cachedSubnet,
+			err:    errBoom,
+			want:   want{served: false, calls: 1},
+		},
+		"Invalidated": {
+			reason: "A resource changed since it was listed should be described directly.",
+			ttl:    time.Minute,
+			id:     cachedSubnet,
+			prep: func(c *DescribeCache, now *time.Time, cl SubnetClient) {
+				c.Subnet(context.Background(), cl, cacheProvider, cachedSubnet)
+				*now = now.Add(time.Second)
+				c.Invalidate(CacheKindSubnet, cacheProvider, cachedSubnet)
+			},
+			want: want{served: false, calls: 1},
+		},
+		"Refreshed": {
+			reason: "An expired listing should be refreshed, serving resources invalidated before the refresh.",
+			ttl:    time.Minute,
+			id:     cachedSubnet,
+			prep: func(c *DescribeCache, now *time.Time, cl SubnetClient) {
+				c.Subnet(context.Background(), cl, cacheProvider, cachedSubnet)
+				*now = now.Add(time.Second)
+				c.Invalidate(CacheKindSubnet, cacheProvider, cachedSubnet)
+				*now = now.Add(time.Minute)
+			},
+			want: want{served: true, calls: 2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			c := NewDescribeCache(tc.ttl)
+			c.now = func() time.Time { return now }
+			cl := &listSubnetsClient{err: tc.err}
+			if tc.prep != nil {
+				tc.prep(c, &now, cl)
+			}
+
+			s, served := c.Subnet(context.Background(), cl, cacheProvider, tc.id)
+			got := want{served: served, calls: cl.calls}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nSubnet(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if served && aws.StringValue(s.SubnetId) != tc.id {
+				t.Errorf("\n%s\nSubnet(...): want subnet %s, got %s", tc.reason, tc.id, aws.StringValue(s.SubnetId))
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// EBSEncryptionByDefaultClient is the external client used for
+// EBSEncryptionByDefault Custom Resource
+type EBSEncryptionByDefaultClient interface {
+	GetEbsEncryptionByDefaultRequest(*ec2.GetEbsEncryptionByDefaultInput) ec2.GetEbsEncryptionByDefaultRequest
+	EnableEbsEncryptionByDefaultRequest(*ec2.EnableEbsEncryptionByDefaultInput) ec2.EnableEbsEncryptionByDefaultRequest
+	DisableEbsEncryptionByDefaultRequest(*ec2.DisableEbsEncryptionByDefaultInput) ec2.DisableEbsEncryptionByDefaultRequest
+	GetEbsDefaultKmsKeyIdRequest(*ec2.GetEbsDefaultKmsKeyIdInput) ec2.GetEbsDefaultKmsKeyIdRequest
+	ModifyEbsDefaultKmsKeyIdRequest(*ec2.ModifyEbsDefaultKmsKeyIdInput) ec2.ModifyEbsDefaultKmsKeyIdRequest
+	ResetEbsDefaultKmsKeyIdRequest(*ec2.ResetEbsDefaultKmsKeyIdInput) ec2.ResetEbsDefaultKmsKeyIdRequest
+}
+
+// NewEBSEncryptionByDefaultClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewEBSEncryptionByDefaultClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (EBSEncryptionByDefaultClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// LateInitializeEBSEncryptionByDefault fills the empty fields in
+// *v1alpha4.EBSEncryptionByDefaultParameters with the observed default KMS
+// key.
+func LateInitializeEBSEncryptionByDefault(in *v1alpha4.EBSEncryptionByDefaultParameters, kmsKeyID *string) {
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, kmsKeyID)
+}
+
+// IsEBSEncryptionByDefaultUpToDate returns true if the observed default KMS
+// key matches the desired one. Any key is accepted if none is desired.
+func IsEBSEncryptionByDefaultUpToDate(p v1alpha4.EBSEncryptionByDefaultParameters, kmsKeyID *string) bool {
+	if p.KMSKeyID == nil {
+		return true
+	}
+	return aws.StringValue(p.KMSKeyID) == aws.StringValue(kmsKeyID)
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+)
+
+func TestIsEBSEncryptionByDefaultUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *string
+		observed *string
+		want     bool
+	}{
+		"NoKeyDesired": {
+			observed: aws.String("alias/aws/ebs"),
+			want:     true,
+		},
+		"SameKey": {
+			desired:  aws.String("alias/aws/ebs"),
+			observed: aws.String("alias/aws/ebs"),
+			want:     true,
+		},
+		"DifferentKey": {
+			desired:  aws.String("arn:aws:kms:us-east-1:123456789012:key/example"),
+			observed: aws.String("alias/aws/ebs"),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha4.EBSEncryptionByDefaultParameters{KMSKeyID: tc.desired}
+			if diff := cmp.Diff(tc.want, IsEBSEncryptionByDefaultUpToDate(p, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// FleetIDNotFound is the code that is returned by ec2 when the given
+	// fleet ID is not valid
+	FleetIDNotFound = "InvalidFleetId.NotFound"
+
+	errInvalidWeightedCapacity = "weightedCapacity must be a decimal number"
+)
+
+// EC2FleetClient is the external client used for EC2Fleet Custom Resource
+type EC2FleetClient interface {
+	CreateFleetRequest(*ec2.CreateFleetInput) ec2.CreateFleetRequest
+	DescribeFleetsRequest(*ec2.DescribeFleetsInput) ec2.DescribeFleetsRequest
+	ModifyFleetRequest(*ec2.ModifyFleetInput) ec2.ModifyFleetRequest
+	DeleteFleetsRequest(*ec2.DeleteFleetsInput) ec2.DeleteFleetsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewEC2FleetClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewEC2FleetClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (EC2FleetClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsFleetNotFoundErr returns true if the error is because the item doesn't
+// exist
+func IsFleetNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == FleetIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFleetDeleted returns true if the fleet is deleted or being deleted.
+func IsFleetDeleted(f ec2.FleetData) bool {
+	switch f.FleetState {
+	case ec2.FleetStateCodeDeleted, ec2.FleetStateCodeDeletedRunning, ec2.FleetStateCodeDeletedTerminating:
+		return true
+	}
+	return false
+}
+
+func generateLaunchTemplateConfigs(in []v1alpha4.FleetLaunchTemplateConfig) ([]ec2.FleetLaunchTemplateConfigRequest, error) {
+	out := make([]ec2.FleetLaunchTemplateConfigRequest, len(in))
+	for i, c := range in {
+		out[i] = ec2.FleetLaunchTemplateConfigRequest{
+			LaunchTemplateSpecification: &ec2.FleetLaunchTemplateSpecificationRequest{
+				LaunchTemplateId:   c.LaunchTemplateSpecification.LaunchTemplateID,
+				LaunchTemplateName: c.LaunchTemplateSpecification.LaunchTemplateName,
+				Version:            aws.String(c.LaunchTemplateSpecification.Version),
+			},
+		}
+		for _, o := range c.Overrides {
+			r := ec2.FleetLaunchTemplateOverridesRequest{
+				AvailabilityZone: o.AvailabilityZone,
+				InstanceType:     ec2.InstanceType(aws.StringValue(o.InstanceType)),
+				MaxPrice:         o.MaxPrice,
+				SubnetId:         o.SubnetID,
+			}
+			if o.Priority != nil {
+				r.Priority = aws.Float64(float64(*o.Priority))
+			}
+			if o.WeightedCapacity != nil {
+				w, err := strconv.ParseFloat(*o.WeightedCapacity, 64)
+				if err != nil {
+					return nil, errors.Wrap(err, errInvalidWeightedCapacity)
+				}
+				r.WeightedCapacity = aws.Float64(w)
+			}
+			out[i].Overrides = append(out[i].Overrides, r)
+		}
+	}
+	return out, nil
+}
+
+// GenerateTargetCapacitySpecificationRequest returns the target capacity of
+// the fleet from the supplied parameters.
+func GenerateTargetCapacitySpecificationRequest(p v1alpha4.TargetCapacitySpecification) *ec2.TargetCapacitySpecificationRequest {
+	return &ec2.TargetCapacitySpecificationRequest{
+		TotalTargetCapacity:       aws.Int64(p.TotalTargetCapacity),
+		OnDemandTargetCapacity:    p.OnDemandTargetCapacity,
+		SpotTargetCapacity:        p.SpotTargetCapacity,
+		DefaultTargetCapacityType: ec2.DefaultTargetCapacityType(aws.StringValue(p.DefaultTargetCapacityType)),
+	}
+}
+
+// GenerateCreateFleetInput returns the input to create a fleet from the
+// supplied parameters. The client token makes retries of the same creation
+// idempotent.
+func GenerateCreateFleetInput(token string, p v1alpha4.EC2FleetParameters) (*ec2.CreateFleetInput, error) {
+	configs, err := generateLaunchTemplateConfigs(p.LaunchTemplateConfigs)
+	if err != nil {
+		return nil, err
+	}
+	in := &ec2.CreateFleetInput{
+		ClientToken:                      aws.String(token),
+		LaunchTemplateConfigs:            configs,
+		TargetCapacitySpecification:      GenerateTargetCapacitySpecificationRequest(p.TargetCapacitySpecification),
+		ExcessCapacityTerminationPolicy:  ec2.FleetExcessCapacityTerminationPolicy(aws.StringValue(p.ExcessCapacityTerminationPolicy)),
+		ReplaceUnhealthyInstances:        p.ReplaceUnhealthyInstances,
+		TerminateInstancesWithExpiration: p.TerminateInstancesWithExpiration,
+		Type:                             ec2.FleetType(aws.StringValue(p.Type)),
+	}
+	if o := p.SpotOptions; o != nil {
+		in.SpotOptions = &ec2.SpotOptionsRequest{
+			AllocationStrategy:           ec2.SpotAllocationStrategy(aws.StringValue(o.AllocationStrategy)),
+			InstanceInterruptionBehavior: ec2.SpotInstanceInterruptionBehavior(aws.StringValue(o.InstanceInterruptionBehavior)),
+			InstancePoolsToUseCount:      o.InstancePoolsToUseCount,
+			MaxTotalPrice:                o.MaxTotalPrice,
+			MinTargetCapacity:            o.MinTargetCapacity,
+			SingleAvailabilityZone:       o.SingleAvailabilityZone,
+			SingleInstanceType:           o.SingleInstanceType,
+		}
+	}
+	if o := p.OnDemandOptions; o != nil {
+		in.OnDemandOptions = &ec2.OnDemandOptionsRequest{
+			AllocationStrategy:     ec2.FleetOnDemandAllocationStrategy(aws.StringValue(o.AllocationStrategy)),
+			MaxTotalPrice:          o.MaxTotalPrice,
+			MinTargetCapacity:      o.MinTargetCapacity,
+			SingleAvailabilityZone: o.SingleAvailabilityZone,
+			SingleInstanceType:     o.SingleInstanceType,
+		}
+	}
+	in.TagSpecifications = GenerateTagSpecifications(ec2.ResourceTypeFleet, p.Tags)
+	return in, nil
+}
+
+func formatCapacity(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+// GenerateEC2FleetObservation is used to produce v1alpha4.EC2FleetObservation
+// from ec2.FleetData.
+func GenerateEC2FleetObservation(f ec2.FleetData) v1alpha4.EC2FleetObservation {
+	return v1alpha4.EC2FleetObservation{
+		FleetID:                   aws.StringValue(f.FleetId),
+		FleetState:                string(f.FleetState),
+		ActivityStatus:            string(f.ActivityStatus),
+		FulfilledCapacity:         formatCapacity(f.FulfilledCapacity),
+		FulfilledOnDemandCapacity: formatCapacity(f.FulfilledOnDemandCapacity),
+	}
+}
+
+// LateInitializeEC2Fleet fills the empty fields in
+// *v1alpha4.EC2FleetParameters with the values seen in ec2.FleetData.
+func LateInitializeEC2Fleet(in *v1alpha4.EC2FleetParameters, f *ec2.FleetData) {
+	if f == nil {
+		return
+	}
+	if in.ExcessCapacityTerminationPolicy == nil && f.ExcessCapacityTerminationPolicy != "" {
+		in.ExcessCapacityTerminationPolicy = aws.String(string(f.ExcessCapacityTerminationPolicy))
+	}
+	if in.Type == nil && f.Type != "" {
+		in.Type = aws.String(string(f.Type))
+	}
+	in.ReplaceUnhealthyInstances = awsclients.LateInitializeBoolPtr(in.ReplaceUnhealthyInstances, f.ReplaceUnhealthyInstances)
+	in.TerminateInstancesWithExpiration = awsclients.LateInitializeBoolPtr(in.TerminateInstancesWithExpiration, f.TerminateInstancesWithExpiration)
+	if t := f.TargetCapacitySpecification; t != nil {
+		tcs := &in.TargetCapacitySpecification
+		tcs.OnDemandTargetCapacity = awsclients.LateInitializeInt64Ptr(tcs.OnDemandTargetCapacity, t.OnDemandTargetCapacity)
+		tcs.SpotTargetCapacity = awsclients.LateInitializeInt64Ptr(tcs.SpotTargetCapacity, t.SpotTargetCapacity)
+		if tcs.DefaultTargetCapacityType == nil && t.DefaultTargetCapacityType != "" {
+			tcs.DefaultTargetCapacityType = aws.String(string(t.DefaultTargetCapacityType))
+		}
+	}
+}
+
+// IsEC2FleetUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsEC2FleetUpToDate(p v1alpha4.EC2FleetParameters, f ec2.FleetData) bool {
+	t := f.TargetCapacitySpecification
+	if t == nil {
+		t = &ec2.TargetCapacitySpecification{}
+	}
+	tcs := p.TargetCapacitySpecification
+	if tcs.TotalTargetCapacity != aws.Int64Value(t.TotalTargetCapacity) ||
+		aws.Int64Value(tcs.OnDemandTargetCapacity) != aws.Int64Value(t.OnDemandTargetCapacity) ||
+		aws.Int64Value(tcs.SpotTargetCapacity) != aws.Int64Value(t.SpotTargetCapacity) ||
+		aws.StringValue(tcs.DefaultTargetCapacityType) != string(t.DefaultTargetCapacityType) {
+		return false
+	}
+	if aws.StringValue(p.ExcessCapacityTerminationPolicy) != string(f.ExcessCapacityTerminationPolicy) {
+		return false
+	}
+	return AreTagsUpToDate(p.Tags, f.Tags)
+}
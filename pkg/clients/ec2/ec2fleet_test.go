@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+)
+
+func TestGenerateCreateFleetInput(t *testing.T) {
+	type want struct {
+		in  *ec2.CreateFleetInput
+		err error
+	}
+
+	cases := map[string]struct {
+		p    v1alpha4.EC2FleetParameters
+		want want
+	}{
+		"WeightedOverrides": {
+			p: v1alpha4.EC2FleetParameters{
+				LaunchTemplateConfigs: []v1alpha4.FleetLaunchTemplateConfig{{
+					LaunchTemplateSpecification: v1alpha4.FleetLaunchTemplateSpecification{
+						LaunchTemplateName: aws.String("workers"),
+						Version:            "$Latest",
+					},
+					Overrides: []v1alpha4.FleetLaunchTemplateOverrides{{
+						InstanceType:     aws.String("m5.large"),
+						Priority:         aws.Int64(1),
+						WeightedCapacity: aws.String("0.5"),
+					}},
+				}},
+				TargetCapacitySpecification: v1alpha4.TargetCapacitySpecification{
+					TotalTargetCapacity:       4,
+					SpotTargetCapacity:        aws.Int64(3),
+					DefaultTargetCapacityType: aws.String("spot"),
+				},
+				SpotOptions: &v1alpha4.FleetSpotOptions{AllocationStrategy: aws.String("capacity-optimized")},
+				Type:        aws.String("maintain"),
+			},
+			want: want{
+				in: &ec2.CreateFleetInput{
+					ClientToken: aws.String("token"),
+					LaunchTemplateConfigs: []ec2.FleetLaunchTemplateConfigRequest{{
+						LaunchTemplateSpecification: &ec2.FleetLaunchTemplateSpecificationRequest{
+							LaunchTemplateName: aws.String("workers"),
+							Version:            aws.String("$Latest"),
+						},
+						Overrides: []ec2.FleetLaunchTemplateOverridesRequest{{
+							InstanceType:     ec2.InstanceTypeM5Large,
+							Priority:         aws.Float64(1),
+							WeightedCapacity: aws.Float64(0.5),
+						}},
+					}},
+					TargetCapacitySpecification: &ec2.TargetCapacitySpecificationRequest{
+						TotalTargetCapacity:       aws.Int64(4),
+						SpotTargetCapacity:        aws.Int64(3),
+						DefaultTargetCapacityType: ec2.DefaultTargetCapacityTypeSpot,
+					},
+					SpotOptions: &ec2.SpotOptionsRequest{AllocationStrategy: ec2.SpotAllocationStrategyCapacityOptimized},
+					Type:        ec2.FleetTypeMaintain,
+				},
+			},
+		},
+		"InvalidWeightedCapacity": {
+			p: v1alpha4.EC2FleetParameters{
+				LaunchTemplateConfigs: []v1alpha4.FleetLaunchTemplateConfig{{
+					Overrides: []v1alpha4.FleetLaunchTemplateOverrides{{
+						WeightedCapacity: aws.String("half"),
+					}},
+				}},
+			},
+			want: want{
+				err: errors.New(errInvalidWeightedCapacity),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in, err := GenerateCreateFleetInput("token", tc.p)
+			if diff := cmp.Diff(tc.want.err != nil, err != nil); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.in, in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EBSEncryptionByDefaultClient = (*MockEBSEncryptionByDefaultClient)(nil)
+
+// MockEBSEncryptionByDefaultClient is a type that implements all the methods for EBSEncryptionByDefaultClient interface
+type MockEBSEncryptionByDefaultClient struct {
+	MockGetEbsEncryptionByDefault     func(*ec2.GetEbsEncryptionByDefaultInput) ec2.GetEbsEncryptionByDefaultRequest
+	MockEnableEbsEncryptionByDefault  func(*ec2.EnableEbsEncryptionByDefaultInput) ec2.EnableEbsEncryptionByDefaultRequest
+	MockDisableEbsEncryptionByDefault func(*ec2.DisableEbsEncryptionByDefaultInput) ec2.DisableEbsEncryptionByDefaultRequest
+	MockGetEbsDefaultKmsKeyId         func(*ec2.GetEbsDefaultKmsKeyIdInput) ec2.GetEbsDefaultKmsKeyIdRequest
+	MockModifyEbsDefaultKmsKeyId      func(*ec2.ModifyEbsDefaultKmsKeyIdInput) ec2.ModifyEbsDefaultKmsKeyIdRequest
+	MockResetEbsDefaultKmsKeyId       func(*ec2.ResetEbsDefaultKmsKeyIdInput) ec2.ResetEbsDefaultKmsKeyIdRequest
+}
+
+// GetEbsEncryptionByDefaultRequest calls the underlying MockGetEbsEncryptionByDefault method.
+func (c *MockEBSEncryptionByDefaultClient) GetEbsEncryptionByDefaultRequest(i *ec2.GetEbsEncryptionByDefaultInput) ec2.GetEbsEncryptionByDefaultRequest {
+	return c.MockGetEbsEncryptionByDefault(i)
+}
+
+// EnableEbsEncryptionByDefaultRequest calls the underlying MockEnableEbsEncryptionByDefault method.
+func (c *MockEBSEncryptionByDefaultClient) EnableEbsEncryptionByDefaultRequest(i *ec2.EnableEbsEncryptionByDefaultInput) ec2.EnableEbsEncryptionByDefaultRequest {
+	return c.MockEnableEbsEncryptionByDefault(i)
+}
+
+// DisableEbsEncryptionByDefaultRequest calls the underlying MockDisableEbsEncryptionByDefault method.
+func (c *MockEBSEncryptionByDefaultClient) DisableEbsEncryptionByDefaultRequest(i *ec2.DisableEbsEncryptionByDefaultInput) ec2.DisableEbsEncryptionByDefaultRequest {
+	return c.MockDisableEbsEncryptionByDefault(i)
+}
+
+// GetEbsDefaultKmsKeyIdRequest calls the underlying MockGetEbsDefaultKmsKeyId method.
+func (c *MockEBSEncryptionByDefaultClient) GetEbsDefaultKmsKeyIdRequest(i *ec2.GetEbsDefaultKmsKeyIdInput) ec2.GetEbsDefaultKmsKeyIdRequest {
+	return c.MockGetEbsDefaultKmsKeyId(i)
+}
+
+// ModifyEbsDefaultKmsKeyIdRequest calls the underlying MockModifyEbsDefaultKmsKeyId method.
+func (c *MockEBSEncryptionByDefaultClient) ModifyEbsDefaultKmsKeyIdRequest(i *ec2.ModifyEbsDefaultKmsKeyIdInput) ec2.ModifyEbsDefaultKmsKeyIdRequest {
+	return c.MockModifyEbsDefaultKmsKeyId(i)
+}
+
+// ResetEbsDefaultKmsKeyIdRequest calls the underlying MockResetEbsDefaultKmsKeyId method.
+func (c *MockEBSEncryptionByDefaultClient) ResetEbsDefaultKmsKeyIdRequest(i *ec2.ResetEbsDefaultKmsKeyIdInput) ec2.ResetEbsDefaultKmsKeyIdRequest {
+	return c.MockResetEbsDefaultKmsKeyId(i)
+}
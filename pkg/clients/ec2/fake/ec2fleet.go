@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EC2FleetClient = (*MockEC2FleetClient)(nil)
+
+// MockEC2FleetClient is a type that implements all the methods for EC2FleetClient interface
+type MockEC2FleetClient struct {
+	MockCreateFleet    func(*ec2.CreateFleetInput) ec2.CreateFleetRequest
+	MockDescribeFleets func(*ec2.DescribeFleetsInput) ec2.DescribeFleetsRequest
+	MockModifyFleet    func(*ec2.ModifyFleetInput) ec2.ModifyFleetRequest
+	MockDeleteFleets   func(*ec2.DeleteFleetsInput) ec2.DeleteFleetsRequest
+	MockCreateTags     func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags     func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateFleetRequest calls the underlying MockCreateFleet method.
+func (c *MockEC2FleetClient) CreateFleetRequest(i *ec2.CreateFleetInput) ec2.CreateFleetRequest {
+	return c.MockCreateFleet(i)
+}
+
+// DescribeFleetsRequest calls the underlying MockDescribeFleets method.
+func (c *MockEC2FleetClient) DescribeFleetsRequest(i *ec2.DescribeFleetsInput) ec2.DescribeFleetsRequest {
+	return c.MockDescribeFleets(i)
+}
+
+// ModifyFleetRequest calls the underlying MockModifyFleet method.
+func (c *MockEC2FleetClient) ModifyFleetRequest(i *ec2.ModifyFleetInput) ec2.ModifyFleetRequest {
+	return c.MockModifyFleet(i)
+}
+
+// DeleteFleetsRequest calls the underlying MockDeleteFleets method.
+func (c *MockEC2FleetClient) DeleteFleetsRequest(i *ec2.DeleteFleetsInput) ec2.DeleteFleetsRequest {
+	return c.MockDeleteFleets(i)
+}
+
+// CreateTagsRequest calls the underlying MockCreateTags method.
+func (c *MockEC2FleetClient) CreateTagsRequest(i *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return c.MockCreateTags(i)
+}
+
+// DeleteTagsRequest calls the underlying MockDeleteTags method.
+func (c *MockEC2FleetClient) DeleteTagsRequest(i *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return c.MockDeleteTags(i)
+}
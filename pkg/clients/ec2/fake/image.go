@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ImageClient = (*MockImageClient)(nil)
+
+// MockImageClient is a type that implements all the methods for ImageClient interface
+type MockImageClient struct {
+	MockCopyImage              func(*ec2.CopyImageInput) ec2.CopyImageRequest
+	MockDescribeImages         func(*ec2.DescribeImagesInput) ec2.DescribeImagesRequest
+	MockDescribeImageAttribute func(*ec2.DescribeImageAttributeInput) ec2.DescribeImageAttributeRequest
+	MockModifyImageAttribute   func(*ec2.ModifyImageAttributeInput) ec2.ModifyImageAttributeRequest
+	MockDeregisterImage        func(*ec2.DeregisterImageInput) ec2.DeregisterImageRequest
+	MockCreateTags             func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags             func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CopyImageRequest calls the underlying MockCopyImage method.
+func (c *MockImageClient) CopyImageRequest(i *ec2.CopyImageInput) ec2.CopyImageRequest {
+	return c.MockCopyImage(i)
+}
+
+// DescribeImagesRequest calls the underlying MockDescribeImages method.
+func (c *MockImageClient) DescribeImagesRequest(i *ec2.DescribeImagesInput) ec2.DescribeImagesRequest {
+	return c.MockDescribeImages(i)
+}
+
+// DescribeImageAttributeRequest calls the underlying MockDescribeImageAttribute method.
+func (c *MockImageClient) DescribeImageAttributeRequest(i *ec2.DescribeImageAttributeInput) ec2.DescribeImageAttributeRequest {
+	return c.MockDescribeImageAttribute(i)
+}
+
+// ModifyImageAttributeRequest calls the underlying MockModifyImageAttribute method.
+func (c *MockImageClient) ModifyImageAttributeRequest(i *ec2.ModifyImageAttributeInput) ec2.ModifyImageAttributeRequest {
+	return c.MockModifyImageAttribute(i)
+}
+
+// DeregisterImageRequest calls the underlying MockDeregisterImage method.
+func (c *MockImageClient) DeregisterImageRequest(i *ec2.DeregisterImageInput) ec2.DeregisterImageRequest {
+	return c.MockDeregisterImage(i)
+}
+
+// CreateTagsRequest calls the underlying MockCreateTags method.
+func (c *MockImageClient) CreateTagsRequest(i *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return c.MockCreateTags(i)
+}
+
+// DeleteTagsRequest calls the underlying MockDeleteTags method.
+func (c *MockImageClient) DeleteTagsRequest(i *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return c.MockDeleteTags(i)
+}
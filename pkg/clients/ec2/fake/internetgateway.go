@@ -33,6 +33,7 @@ type MockInternetGatewayClient struct {
 	MockAttach     func(*ec2.AttachInternetGatewayInput) ec2.AttachInternetGatewayRequest
 	MockDetach     func(*ec2.DetachInternetGatewayInput) ec2.DetachInternetGatewayRequest
 	MockCreateTags func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 }
 
 // CreateInternetGatewayRequest mocks CreateInternetGatewayRequest method
@@ -64,3 +65,8 @@ func (m *MockInternetGatewayClient) DetachInternetGatewayRequest(input *ec2.Deta
 func (m *MockInternetGatewayClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
 	return m.MockCreateTags(input)
 }
+
+// DeleteTagsRequest mocks DeleteTagsInput method
+func (m *MockInternetGatewayClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -34,6 +34,9 @@ type MockSecurityGroupClient struct {
 	MockAuthorizeEgress func(*ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest
 	MockRevokeEgress    func(*ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest
 	MockCreateTags      func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags      func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+
+	MockDescribeNetworkInterfaces func(*ec2.DescribeNetworkInterfacesInput) ec2.DescribeNetworkInterfacesRequest
 }
 
 // CreateSecurityGroupRequest mocks CreateSecurityGroupRequest method
@@ -70,3 +73,13 @@ func (m *MockSecurityGroupClient) RevokeSecurityGroupEgressRequest(input *ec2.Re
 func (m *MockSecurityGroupClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
 	return m.MockCreateTags(input)
 }
+
+// DeleteTagsRequest mocks DeleteTagsInput method
+func (m *MockSecurityGroupClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
+
+// DescribeNetworkInterfacesRequest mocks DescribeNetworkInterfacesRequest method
+func (m *MockSecurityGroupClient) DescribeNetworkInterfacesRequest(input *ec2.DescribeNetworkInterfacesInput) ec2.DescribeNetworkInterfacesRequest {
+	return m.MockDescribeNetworkInterfaces(input)
+}
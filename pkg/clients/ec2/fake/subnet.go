@@ -32,6 +32,7 @@ type MockSubnetClient struct {
 	MockDescribe   func(*ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest
 	MockModify     func(*ec2.ModifySubnetAttributeInput) ec2.ModifySubnetAttributeRequest
 	MockCreateTags func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 }
 
 // CreateSubnetRequest mocks CreateSubnetRequest method
@@ -58,3 +59,8 @@ func (m *MockSubnetClient) ModifySubnetAttributeRequest(input *ec2.ModifySubnetA
 func (m *MockSubnetClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
 	return m.MockCreateTags(input)
 }
+
+// DeleteTagsRequest mocks DeleteTagsInput method
+func (m *MockSubnetClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
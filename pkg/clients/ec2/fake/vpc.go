@@ -33,6 +33,7 @@ type MockVPCClient struct {
 	MockModifyAttribute             func(*ec2.ModifyVpcAttributeInput) ec2.ModifyVpcAttributeRequest
 	MockModifyTenancy               func(*ec2.ModifyVpcTenancyInput) ec2.ModifyVpcTenancyRequest
 	MockCreateTagsRequest           func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTagsRequest           func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 	MockDescribeVpcAttributeRequest func(*ec2.DescribeVpcAttributeInput) ec2.DescribeVpcAttributeRequest
 }
 
@@ -66,6 +67,11 @@ func (m *MockVPCClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.Create
 	return m.MockCreateTagsRequest(input)
 }
 
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockVPCClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTagsRequest(input)
+}
+
 // DescribeVpcAttributeRequest mocks DescribeVpcAttributeRequest method
 func (m *MockVPCClient) DescribeVpcAttributeRequest(input *ec2.DescribeVpcAttributeInput) ec2.DescribeVpcAttributeRequest {
 	return m.MockDescribeVpcAttributeRequest(input)
@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// ImageIDNotFound is the code that is returned by ec2 when the given AMI
+	// ID is not valid
+	ImageIDNotFound = "InvalidAMIID.NotFound"
+)
+
+// ImageClient is the external client used for Image Custom Resource
+type ImageClient interface {
+	CopyImageRequest(*ec2.CopyImageInput) ec2.CopyImageRequest
+	DescribeImagesRequest(*ec2.DescribeImagesInput) ec2.DescribeImagesRequest
+	DescribeImageAttributeRequest(*ec2.DescribeImageAttributeInput) ec2.DescribeImageAttributeRequest
+	ModifyImageAttributeRequest(*ec2.ModifyImageAttributeInput) ec2.ModifyImageAttributeRequest
+	DeregisterImageRequest(*ec2.DeregisterImageInput) ec2.DeregisterImageRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewImageClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewImageClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ImageClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ec2.New(*cfg), nil
+}
+
+// IsImageNotFoundErr returns true if the error is because the item doesn't
+// exist
+func IsImageNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == ImageIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCopyImageInput returns the input to copy an AMI into the region of
+// the client. The client token makes retries of the same copy idempotent.
+func GenerateCopyImageInput(token string, p v1alpha4.ImageParameters) *ec2.CopyImageInput {
+	return &ec2.CopyImageInput{
+		ClientToken:   aws.String(token),
+		SourceImageId: aws.String(p.SourceImageID),
+		SourceRegion:  aws.String(p.SourceRegion),
+		Name:          aws.String(p.Name),
+		Description:   p.Description,
+		Encrypted:     p.Encrypted,
+		KmsKeyId:      p.KMSKeyID,
+	}
+}
+
+// GenerateImageObservation is used to produce v1alpha4.ImageObservation from
+// ec2.Image.
+func GenerateImageObservation(img ec2.Image) v1alpha4.ImageObservation {
+	o := v1alpha4.ImageObservation{
+		ImageID:      aws.StringValue(img.ImageId),
+		ImageState:   string(img.State),
+		OwnerID:      aws.StringValue(img.OwnerId),
+		CreationDate: aws.StringValue(img.CreationDate),
+		Public:       aws.BoolValue(img.Public),
+		Architecture: string(img.Architecture),
+	}
+	if img.StateReason != nil {
+		o.StateReason = aws.StringValue(img.StateReason.Message)
+	}
+	for _, bdm := range img.BlockDeviceMappings {
+		if bdm.Ebs != nil && bdm.Ebs.SnapshotId != nil {
+			o.SnapshotIDs = append(o.SnapshotIDs, aws.StringValue(bdm.Ebs.SnapshotId))
+		}
+	}
+	return o
+}
+
+// LateInitializeImage fills the empty fields in *v1alpha4.ImageParameters with
+// the values seen in ec2.Image.
+func LateInitializeImage(in *v1alpha4.ImageParameters, img *ec2.Image) {
+	if img == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, img.Description)
+}
+
+func launchPermissionKey(lp ec2.LaunchPermission) string {
+	if lp.Group != "" {
+		return "group:" + string(lp.Group)
+	}
+	return "user:" + aws.StringValue(lp.UserId)
+}
+
+// DiffLaunchPermissions returns the launch permissions that need to be added
+// to and removed from the observed ones to reach the desired state.
+func DiffLaunchPermissions(desired *v1alpha4.ImageLaunchPermissions, observed []ec2.LaunchPermission) (add, remove []ec2.LaunchPermission) {
+	var want []ec2.LaunchPermission
+	if desired != nil {
+		for _, id := range desired.UserIDs {
+			want = append(want, ec2.LaunchPermission{UserId: aws.String(id)})
+		}
+		for _, g := range desired.Groups {
+			want = append(want, ec2.LaunchPermission{Group: ec2.PermissionGroup(g)})
+		}
+	}
+	wantKeys := map[string]bool{}
+	for _, lp := range want {
+		wantKeys[launchPermissionKey(lp)] = true
+	}
+	haveKeys := map[string]bool{}
+	for _, lp := range observed {
+		haveKeys[launchPermissionKey(lp)] = true
+		if !wantKeys[launchPermissionKey(lp)] {
+			remove = append(remove, lp)
+		}
+	}
+	for _, lp := range want {
+		if !haveKeys[launchPermissionKey(lp)] {
+			add = append(add, lp)
+		}
+	}
+	return add, remove
+}
+
+// IsImageUpToDate returns true if there is no update-able difference between
+// desired and observed state of the resource.
+func IsImageUpToDate(p v1alpha4.ImageParameters, img ec2.Image, perms []ec2.LaunchPermission) bool {
+	if aws.StringValue(p.Description) != aws.StringValue(img.Description) {
+		return false
+	}
+	if add, remove := DiffLaunchPermissions(p.LaunchPermissions, perms); len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	return AreTagsUpToDate(p.Tags, img.Tags)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+)
+
+func TestDiffLaunchPermissions(t *testing.T) {
+	type want struct {
+		add    []ec2.LaunchPermission
+		remove []ec2.LaunchPermission
+	}
+
+	cases := map[string]struct {
+		desired  *v1alpha4.ImageLaunchPermissions
+		observed []ec2.LaunchPermission
+		want     want
+	}{
+		"InSync": {
+			desired:  &v1alpha4.ImageLaunchPermissions{UserIDs: []string{"111111111111"}, Groups: []string{"all"}},
+			observed: []ec2.LaunchPermission{{Group: ec2.PermissionGroupAll}, {UserId: aws.String("111111111111")}},
+		},
+		"ShareAndUnshare": {
+			desired:  &v1alpha4.ImageLaunchPermissions{UserIDs: []string{"111111111111", "222222222222"}},
+			observed: []ec2.LaunchPermission{{UserId: aws.String("111111111111")}, {UserId: aws.String("333333333333")}},
+			want: want{
+				add:    []ec2.LaunchPermission{{UserId: aws.String("222222222222")}},
+				remove: []ec2.LaunchPermission{{UserId: aws.String("333333333333")}},
+			},
+		},
+		"MakePrivate": {
+			observed: []ec2.LaunchPermission{{Group: ec2.PermissionGroupAll}},
+			want: want{
+				remove: []ec2.LaunchPermission{{Group: ec2.PermissionGroupAll}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffLaunchPermissions(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
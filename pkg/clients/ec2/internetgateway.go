@@ -27,6 +27,7 @@ type InternetGatewayClient interface {
 	AttachInternetGatewayRequest(input *ec2.AttachInternetGatewayInput) ec2.AttachInternetGatewayRequest
 	DetachInternetGatewayRequest(input *ec2.DetachInternetGatewayInput) ec2.DetachInternetGatewayRequest
 	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 }
 
 // NewInternetGatewayClient returns a new client using AWS credentials as JSON encoded data.
@@ -103,7 +104,7 @@ func IsIgUpToDate(p v1beta1.InternetGatewayParameters, ig ec2.InternetGateway) b
 	// if the attachment in spec exists in ig.Attachments, compare the tags and return
 	for _, a := range ig.Attachments {
 		if aws.StringValue(p.VPCID) == aws.StringValue(a.VpcId) {
-			return v1beta1.CompareTags(p.Tags, ig.Tags)
+			return AreTagsUpToDate(p.Tags, ig.Tags)
 		}
 	}
 
@@ -40,6 +40,7 @@ type RouteTableClient interface {
 	AssociateRouteTableRequest(*ec2.AssociateRouteTableInput) ec2.AssociateRouteTableRequest
 	DisassociateRouteTableRequest(*ec2.DisassociateRouteTableInput) ec2.DisassociateRouteTableRequest
 	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 }
 
 // NewRouteTableClient returns a new client using AWS credentials as JSON encoded data.
@@ -33,6 +33,8 @@ type SecurityGroupClient interface {
 	AuthorizeSecurityGroupEgressRequest(input *ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest
 	RevokeSecurityGroupEgressRequest(input *ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest
 	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+	DescribeNetworkInterfacesRequest(input *ec2.DescribeNetworkInterfacesInput) ec2.DescribeNetworkInterfacesRequest
 }
 
 // NewSecurityGroupClient generates client for AWS Security Group API
@@ -54,6 +56,22 @@ func IsSecurityGroupNotFoundErr(err error) bool {
 	return false
 }
 
+// GetSecurityGroupDependents returns the IDs of the network interfaces that
+// use the supplied security group, and thus prevent it from being deleted.
+func GetSecurityGroupDependents(ctx context.Context, c SecurityGroupClient, id string) ([]string, error) {
+	rsp, err := c.DescribeNetworkInterfacesRequest(&ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2.Filter{{Name: aws.String("group-id"), Values: []string{id}}},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(rsp.NetworkInterfaces))
+	for i, ni := range rsp.NetworkInterfaces {
+		ids[i] = aws.StringValue(ni.NetworkInterfaceId)
+	}
+	return ids, nil
+}
+
 // IsRuleAlreadyExistsErr returns true if the error is because the rule already exists.
 func IsRuleAlreadyExistsErr(err error) bool {
 	if awsErr, ok := err.(awserr.Error); ok {
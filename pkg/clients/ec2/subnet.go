@@ -23,6 +23,7 @@ type SubnetClient interface {
 	DeleteSubnetRequest(input *ec2.DeleteSubnetInput) ec2.DeleteSubnetRequest
 	ModifySubnetAttributeRequest(input *ec2.ModifySubnetAttributeInput) ec2.ModifySubnetAttributeRequest
 	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 }
 
 // NewSubnetClient returns a new client using AWS credentials as JSON encoded data.
@@ -52,6 +53,7 @@ func GenerateSubnetObservation(subnet ec2.Subnet) v1beta1.SubnetObservation {
 		AvailableIPAddressCount: aws.Int64Value(subnet.AvailableIpAddressCount),
 		DefaultForAZ:            aws.BoolValue(subnet.DefaultForAz),
 		SubnetID:                aws.StringValue(subnet.SubnetId),
+		SubnetARN:               aws.StringValue(subnet.SubnetArn),
 		SubnetState:             string(subnet.State),
 	}
 
@@ -96,5 +98,5 @@ func IsSubnetUpToDate(p v1beta1.SubnetParameters, s ec2.Subnet) bool {
 		return false
 	}
 
-	return v1beta1.CompareTags(p.Tags, s.Tags)
+	return AreTagsUpToDate(p.Tags, s.Tags)
 }
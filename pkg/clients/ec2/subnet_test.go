@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/proptest"
 )
 
 var (
@@ -116,3 +117,46 @@ func TestGenerateSubnetObservation(t *testing.T) {
 		})
 	}
 }
+
+// reflectSubnet returns the subnet AWS reports for a subnet created and
+// updated with the supplied parameters, using its defaults for parameters
+// that are not set.
+func reflectSubnet(p v1beta1.SubnetParameters) ec2.Subnet {
+	s := ec2.Subnet{
+		AssignIpv6AddressOnCreation: aws.Bool(false),
+		AvailabilityZone:            aws.String("us-east-1a"),
+		AvailabilityZoneId:          aws.String("use1-az1"),
+		CidrBlock:                   aws.String(p.CIDRBlock),
+		MapPublicIpOnLaunch:         aws.Bool(false),
+		Tags:                        v1beta1.GenerateEC2Tags(p.Tags),
+		VpcId:                       p.VPCID,
+	}
+	if p.AssignIPv6AddressOnCreation != nil {
+		s.AssignIpv6AddressOnCreation = p.AssignIPv6AddressOnCreation
+	}
+	if p.AvailabilityZone != nil {
+		s.AvailabilityZone = p.AvailabilityZone
+	}
+	if p.AvailabilityZoneID != nil {
+		s.AvailabilityZoneId = p.AvailabilityZoneID
+	}
+	if p.MapPublicIPOnLaunch != nil {
+		s.MapPublicIpOnLaunch = p.MapPublicIPOnLaunch
+	}
+	if p.IPv6CIDRBlock != nil {
+		s.Ipv6CidrBlockAssociationSet = []ec2.SubnetIpv6CidrBlockAssociation{{Ipv6CidrBlock: p.IPv6CIDRBlock}}
+	}
+	return s
+}
+
+func TestSubnetProperties(t *testing.T) {
+	p := v1beta1.SubnetParameters{}
+	proptest.Check(t, &p, func() string {
+		s := reflectSubnet(p)
+		LateInitializeSubnet(&p, &s)
+		if !IsSubnetUpToDate(p, s) {
+			return "A subnet should be up to date once AWS reflects its parameters."
+		}
+		return ""
+	})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const (
+	// reservedTagPrefix is the prefix of tag keys that are managed by AWS
+	// and cannot be changed or deleted by users.
+	reservedTagPrefix = "aws:"
+
+	errCreateTags = "cannot create tags"
+	errDeleteTags = "cannot delete tags"
+)
+
+// ExternalTagPrefixes are the prefixes of tag keys that other systems, such as
+// Kubernetes and its node autoscalers, add to resources that may be managed by
+// Crossplane. Remote tags with these prefixes are kept even though they are
+// missing from the local tags, but are created or overwritten when they are
+// not.
+var ExternalTagPrefixes = []string{
+	"kubernetes.io/cluster/",
+	"k8s.io/cluster-autoscaler/",
+	"karpenter.sh/",
+}
+
+func isExternalTag(k string) bool {
+	for _, p := range ExternalTagPrefixes {
+		if strings.HasPrefix(k, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// TagClient is the subset of the EC2 API used to reconcile the tags of a
+// resource.
+type TagClient interface {
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// GenerateTagSpecifications returns the TagSpecifications that tag a resource
+// of the supplied type with the supplied tags when it is created, or nil if
+// there are no tags.
+func GenerateTagSpecifications(t ec2.ResourceType, tags []v1beta1.Tag) []ec2.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+	return []ec2.TagSpecification{{ResourceType: t, Tags: v1beta1.GenerateEC2Tags(tags)}}
+}
+
+// DiffEC2Tags returns the tags that must be created or overwritten, and the
+// tags that must be deleted, for the remote tags to match the local tags.
+// Tags reserved by AWS are ignored, and tags added by other systems are never
+// deleted.
+func DiffEC2Tags(local []v1beta1.Tag, remote []ec2.Tag) (add []ec2.Tag, remove []ec2.Tag) {
+	l := make(map[string]string, len(local))
+	for _, t := range local {
+		l[t.Key] = t.Value
+	}
+	r := make(map[string]string, len(remote))
+	for _, t := range remote {
+		if k := aws.StringValue(t.Key); !strings.HasPrefix(k, reservedTagPrefix) {
+			r[k] = aws.StringValue(t.Value)
+		}
+	}
+
+	// NOTE: CreateTags overwrites the value of existing tags, so changed tags
+	// need only be created rather than deleted first.
+	for k, v := range l {
+		if rv, ok := r[k]; !ok || rv != v {
+			add = append(add, ec2.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+	}
+	for k := range r {
+		if _, ok := l[k]; !ok && !isExternalTag(k) {
+			remove = append(remove, ec2.Tag{Key: aws.String(k)})
+		}
+	}
+	sort.Slice(add, func(i, j int) bool { return aws.StringValue(add[i].Key) < aws.StringValue(add[j].Key) })
+	sort.Slice(remove, func(i, j int) bool { return aws.StringValue(remove[i].Key) < aws.StringValue(remove[j].Key) })
+	return add, remove
+}
+
+// AreTagsUpToDate returns true if the remote tags match the local tags,
+// ignoring tags reserved by AWS and tags added by other systems.
+func AreTagsUpToDate(local []v1beta1.Tag, remote []ec2.Tag) bool {
+	add, remove := DiffEC2Tags(local, remote)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// UpdateTags creates, overwrites and deletes the tags of the supplied
+// resource such that its remote tags match the local tags.
+func UpdateTags(ctx context.Context, client TagClient, id string, local []v1beta1.Tag, remote []ec2.Tag) error {
+	add, remove := DiffEC2Tags(local, remote)
+	if len(remove) != 0 {
+		if _, err := client.DeleteTagsRequest(&ec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      remove,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := client.CreateTagsRequest(&ec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      add,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+type mockTagClient struct {
+	create func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	delete func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+func (m *mockTagClient) CreateTagsRequest(i *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.create(i)
+}
+
+func (m *mockTagClient) DeleteTagsRequest(i *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.delete(i)
+}
+
+func TestDiffEC2Tags(t *testing.T) {
+	type want struct {
+		add    []ec2.Tag
+		remove []ec2.Tag
+	}
+
+	cases := map[string]struct {
+		local  []v1beta1.Tag
+		remote []ec2.Tag
+		want   want
+	}{
+		"Empty": {},
+		"UpToDate": {
+			local:  []v1beta1.Tag{{Key: "k", Value: "v"}},
+			remote: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"AddChangeAndRemove": {
+			local: []v1beta1.Tag{{Key: "new", Value: "v"}, {Key: "changed", Value: "v2"}},
+			remote: []ec2.Tag{
+				{Key: aws.String("changed"), Value: aws.String("v1")},
+				{Key: aws.String("stale"), Value: aws.String("v")},
+			},
+			want: want{
+				add: []ec2.Tag{
+					{Key: aws.String("changed"), Value: aws.String("v2")},
+					{Key: aws.String("new"), Value: aws.String("v")},
+				},
+				remove: []ec2.Tag{{Key: aws.String("stale")}},
+			},
+		},
+		"IgnoreReservedTags": {
+			remote: []ec2.Tag{{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("v")}},
+		},
+		"KeepExternalTags": {
+			local: []v1beta1.Tag{{Key: "k", Value: "v"}},
+			remote: []ec2.Tag{
+				{Key: aws.String("k"), Value: aws.String("v")},
+				{Key: aws.String("kubernetes.io/cluster/x"), Value: aws.String("shared")},
+			},
+		},
+		"OverwriteExternalTags": {
+			local:  []v1beta1.Tag{{Key: "kubernetes.io/cluster/x", Value: "owned"}},
+			remote: []ec2.Tag{{Key: aws.String("kubernetes.io/cluster/x"), Value: aws.String("shared")}},
+			want: want{
+				add: []ec2.Tag{{Key: aws.String("kubernetes.io/cluster/x"), Value: aws.String("owned")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffEC2Tags(tc.local, tc.remote)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(len(add) == 0 && len(remove) == 0, AreTagsUpToDate(tc.local, tc.remote)); diff != "" {
+				t.Errorf("AreTagsUpToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateTags(t *testing.T) {
+	id := "some-id"
+	local := []v1beta1.Tag{{Key: "new", Value: "v"}}
+	remote := []ec2.Tag{
+		{Key: aws.String("stale"), Value: aws.String("v")},
+		{Key: aws.String("kubernetes.io/cluster/x"), Value: aws.String("shared")},
+	}
+
+	create := func(err error) func(*ec2.CreateTagsInput) ec2.CreateTagsRequest {
+		return func(i *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+			if diff := cmp.Diff([]ec2.Tag{{Key: aws.String("new"), Value: aws.String("v")}}, i.Tags); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return ec2.CreateTagsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &ec2.CreateTagsOutput{}, Error: err},
+			}
+		}
+	}
+	del := func(err error) func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+		return func(i *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+			if diff := cmp.Diff([]ec2.Tag{{Key: aws.String("stale")}}, i.Tags); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return ec2.DeleteTagsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &ec2.DeleteTagsOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *mockTagClient
+		want   error
+	}{
+		"Successful": {
+			client: &mockTagClient{create: create(nil), delete: del(nil)},
+		},
+		"DeleteFailed": {
+			client: &mockTagClient{create: create(nil), delete: del(errBoom)},
+			want:   errors.Wrap(errBoom, errDeleteTags),
+		},
+		"CreateFailed": {
+			client: &mockTagClient{create: create(errBoom), delete: del(nil)},
+			want:   errors.Wrap(errBoom, errCreateTags),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := UpdateTags(context.Background(), tc.client, id, local, remote)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ type VPCClient interface {
 	DescribeVpcAttributeRequest(*ec2.DescribeVpcAttributeInput) ec2.DescribeVpcAttributeRequest
 	ModifyVpcAttributeRequest(*ec2.ModifyVpcAttributeInput) ec2.ModifyVpcAttributeRequest
 	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
 	ModifyVpcTenancyRequest(*ec2.ModifyVpcTenancyInput) ec2.ModifyVpcTenancyRequest
 }
 
@@ -54,12 +55,22 @@ func IsVpcUpToDate(spec v1beta1.VPCParameters, vpc ec2.Vpc, attributes ec2.Descr
 		return false
 	}
 
-	if aws.BoolValue(spec.EnableDNSHostNames) != aws.BoolValue(attributes.EnableDnsHostnames.Value) ||
-		aws.BoolValue(spec.EnableDNSSupport) != aws.BoolValue(attributes.EnableDnsSupport.Value) {
+	// Attributes that are not set are left as AWS defaults them.
+	if spec.EnableDNSHostNames != nil && *spec.EnableDNSHostNames != attributeValue(attributes.EnableDnsHostnames) {
 		return false
 	}
+	if spec.EnableDNSSupport != nil && *spec.EnableDNSSupport != attributeValue(attributes.EnableDnsSupport) {
+		return false
+	}
+
+	return AreTagsUpToDate(spec.Tags, vpc.Tags)
+}
 
-	return v1beta1.CompareTags(spec.Tags, vpc.Tags)
+func attributeValue(v *ec2.AttributeBooleanValue) bool {
+	if v == nil {
+		return false
+	}
+	return aws.BoolValue(v.Value)
 }
 
 // GenerateVpcObservation is used to produce v1beta1.VPCObservation from
@@ -70,6 +81,7 @@ func GenerateVpcObservation(vpc ec2.Vpc) v1beta1.VPCObservation {
 		DHCPOptionsID: aws.StringValue(vpc.DhcpOptionsId),
 		OwnerID:       aws.StringValue(vpc.OwnerId),
 		VPCState:      string(vpc.State),
+		VPCID:         aws.StringValue(vpc.VpcId),
 	}
 
 	if len(vpc.CidrBlockAssociationSet) > 0 {
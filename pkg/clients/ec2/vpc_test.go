@@ -8,6 +8,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/proptest"
 )
 
 var (
@@ -32,6 +33,7 @@ func TestGenerateVPCObservation(t *testing.T) {
 				IsDefault: boolFalse,
 				OwnerID:   vpcOwner,
 				VPCState:  vpcStateAvailable,
+				VPCID:     vpcID,
 			},
 		},
 		"NoOwner": {
@@ -43,6 +45,7 @@ func TestGenerateVPCObservation(t *testing.T) {
 			out: v1beta1.VPCObservation{
 				IsDefault: boolFalse,
 				VPCState:  vpcStateAvailable,
+				VPCID:     vpcID,
 			},
 		},
 	}
@@ -56,3 +59,40 @@ func TestGenerateVPCObservation(t *testing.T) {
 		})
 	}
 }
+
+// reflectVPC returns the VPC and attributes AWS reports for a VPC created and
+// updated with the supplied parameters, using its defaults for parameters
+// that are not set.
+func reflectVPC(p v1beta1.VPCParameters) (ec2.Vpc, ec2.DescribeVpcAttributeOutput) {
+	vpc := ec2.Vpc{
+		CidrBlock:       aws.String(p.CIDRBlock),
+		InstanceTenancy: ec2.TenancyDefault,
+		Tags:            v1beta1.GenerateEC2Tags(p.Tags),
+	}
+	if p.InstanceTenancy != nil {
+		vpc.InstanceTenancy = ec2.Tenancy(*p.InstanceTenancy)
+	}
+	attrs := ec2.DescribeVpcAttributeOutput{
+		EnableDnsSupport:   &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+		EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+	}
+	if p.EnableDNSSupport != nil {
+		attrs.EnableDnsSupport.Value = p.EnableDNSSupport
+	}
+	if p.EnableDNSHostNames != nil {
+		attrs.EnableDnsHostnames.Value = p.EnableDNSHostNames
+	}
+	return vpc, attrs
+}
+
+func TestVPCProperties(t *testing.T) {
+	p := v1beta1.VPCParameters{}
+	proptest.Check(t, &p, func() string {
+		vpc, attrs := reflectVPC(p)
+		LateInitializeVPC(&p, &vpc)
+		if !IsVpcUpToDate(p, vpc, attrs) {
+			return "A VPC should be up to date once AWS reflects its parameters."
+		}
+		return ""
+	})
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// CapacityProviderClient is the external client used for CapacityProvider
+// Custom Resource
+type CapacityProviderClient interface {
+	CreateCapacityProviderRequest(*ecs.CreateCapacityProviderInput) ecs.CreateCapacityProviderRequest
+	DescribeCapacityProvidersRequest(*ecs.DescribeCapacityProvidersInput) ecs.DescribeCapacityProvidersRequest
+}
+
+// NewCapacityProviderClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewCapacityProviderClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (CapacityProviderClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ecs.New(*cfg), err
+}
+
+// IsClusterNotFound returns true if the error is because the ECS cluster
+// doesn't exist.
+func IsClusterNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == ecs.ErrCodeClusterNotFoundException
+	}
+	return false
+}
+
+// GenerateTags converts the supplied tag map to a list of ECS tags sorted by
+// key.
+func GenerateTags(tags map[string]string) []ecs.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	out := make([]ecs.Tag, 0, len(tags))
+	for k, v := range tags {
+		out = append(out, ecs.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(out, func(i, j int) bool { return aws.StringValue(out[i].Key) < aws.StringValue(out[j].Key) })
+	return out
+}
+
+// GenerateCreateCapacityProviderInput returns the input to create the
+// capacity provider with the given name from the supplied parameters.
+func GenerateCreateCapacityProviderInput(name string, p v1alpha1.CapacityProviderParameters) *ecs.CreateCapacityProviderInput {
+	asg := &ecs.AutoScalingGroupProvider{
+		AutoScalingGroupArn:          aws.String(p.AutoScalingGroupProvider.AutoScalingGroupARN),
+		ManagedTerminationProtection: ecs.ManagedTerminationProtection(aws.StringValue(p.AutoScalingGroupProvider.ManagedTerminationProtection)),
+	}
+	if s := p.AutoScalingGroupProvider.ManagedScaling; s != nil {
+		asg.ManagedScaling = &ecs.ManagedScaling{
+			Status:                 ecs.ManagedScalingStatus(aws.StringValue(s.Status)),
+			TargetCapacity:         s.TargetCapacity,
+			MinimumScalingStepSize: s.MinimumScalingStepSize,
+			MaximumScalingStepSize: s.MaximumScalingStepSize,
+		}
+	}
+	return &ecs.CreateCapacityProviderInput{
+		Name:                     aws.String(name),
+		AutoScalingGroupProvider: asg,
+		Tags:                     GenerateTags(p.Tags),
+	}
+}
+
+// LateInitializeCapacityProvider fills the empty fields in
+// *v1alpha1.CapacityProviderParameters with the values seen in
+// ecs.CapacityProvider.
+func LateInitializeCapacityProvider(in *v1alpha1.CapacityProviderParameters, cp *ecs.CapacityProvider) {
+	if cp == nil || cp.AutoScalingGroupProvider == nil {
+		return
+	}
+	o := cp.AutoScalingGroupProvider
+	if in.AutoScalingGroupProvider.ManagedTerminationProtection == nil && o.ManagedTerminationProtection != "" {
+		in.AutoScalingGroupProvider.ManagedTerminationProtection = aws.String(string(o.ManagedTerminationProtection))
+	}
+	if o.ManagedScaling == nil {
+		return
+	}
+	if in.AutoScalingGroupProvider.ManagedScaling == nil {
+		in.AutoScalingGroupProvider.ManagedScaling = &v1alpha1.ManagedScaling{}
+	}
+	s := in.AutoScalingGroupProvider.ManagedScaling
+	if s.Status == nil && o.ManagedScaling.Status != "" {
+		s.Status = aws.String(string(o.ManagedScaling.Status))
+	}
+	s.TargetCapacity = awsclients.LateInitializeInt64Ptr(s.TargetCapacity, o.ManagedScaling.TargetCapacity)
+	s.MinimumScalingStepSize = awsclients.LateInitializeInt64Ptr(s.MinimumScalingStepSize, o.ManagedScaling.MinimumScalingStepSize)
+	s.MaximumScalingStepSize = awsclients.LateInitializeInt64Ptr(s.MaximumScalingStepSize, o.ManagedScaling.MaximumScalingStepSize)
+}
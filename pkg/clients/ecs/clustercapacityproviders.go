@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ClusterStatusInactive is the status of a deleted cluster. Deleted clusters
+// can be described for a while after they are deleted.
+const ClusterStatusInactive = "INACTIVE"
+
+// ClusterCapacityProvidersClient is the external client used for
+// ClusterCapacityProviders Custom Resource
+type ClusterCapacityProvidersClient interface {
+	DescribeClustersRequest(*ecs.DescribeClustersInput) ecs.DescribeClustersRequest
+	PutClusterCapacityProvidersRequest(*ecs.PutClusterCapacityProvidersInput) ecs.PutClusterCapacityProvidersRequest
+}
+
+// NewClusterCapacityProvidersClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewClusterCapacityProvidersClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ClusterCapacityProvidersClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ecs.New(*cfg), err
+}
+
+func generateStrategy(in []v1alpha1.CapacityProviderStrategyItem) []ecs.CapacityProviderStrategyItem {
+	// PutClusterCapacityProviders requires the strategy to be present even
+	// when it is empty.
+	out := make([]ecs.CapacityProviderStrategyItem, len(in))
+	for i, s := range in {
+		out[i] = ecs.CapacityProviderStrategyItem{
+			CapacityProvider: aws.String(s.CapacityProvider),
+			Base:             s.Base,
+			Weight:           s.Weight,
+		}
+	}
+	return out
+}
+
+// GeneratePutClusterCapacityProvidersInput returns the input to set the
+// capacity providers of the cluster from the supplied parameters.
+func GeneratePutClusterCapacityProvidersInput(p v1alpha1.ClusterCapacityProvidersParameters) *ecs.PutClusterCapacityProvidersInput {
+	cps := p.CapacityProviders
+	if cps == nil {
+		cps = []string{}
+	}
+	return &ecs.PutClusterCapacityProvidersInput{
+		Cluster:                         aws.String(p.Cluster),
+		CapacityProviders:               cps,
+		DefaultCapacityProviderStrategy: generateStrategy(p.DefaultCapacityProviderStrategy),
+	}
+}
+
+// GenerateClusterCapacityProvidersObservation is used to produce
+// v1alpha1.ClusterCapacityProvidersObservation from ecs.Cluster.
+func GenerateClusterCapacityProvidersObservation(c ecs.Cluster) v1alpha1.ClusterCapacityProvidersObservation {
+	return v1alpha1.ClusterCapacityProvidersObservation{
+		ClusterARN:        aws.StringValue(c.ClusterArn),
+		Status:            aws.StringValue(c.Status),
+		AttachmentsStatus: aws.StringValue(c.AttachmentsStatus),
+	}
+}
+
+type strategyItem struct {
+	CapacityProvider string
+	Base             int64
+	Weight           int64
+}
+
+func normalizeStrategy(in []ecs.CapacityProviderStrategyItem) []strategyItem {
+	out := make([]strategyItem, len(in))
+	for i, s := range in {
+		out[i] = strategyItem{
+			CapacityProvider: aws.StringValue(s.CapacityProvider),
+			Base:             aws.Int64Value(s.Base),
+			Weight:           aws.Int64Value(s.Weight),
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CapacityProvider < out[j].CapacityProvider })
+	return out
+}
+
+// IsClusterCapacityProvidersUpToDate returns true if the capacity providers
+// and default strategy of the cluster match the supplied parameters.
+func IsClusterCapacityProvidersUpToDate(p v1alpha1.ClusterCapacityProvidersParameters, c ecs.Cluster) bool {
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if !cmp.Equal(p.CapacityProviders, c.CapacityProviders, sortStrings, cmpopts.EquateEmpty()) {
+		return false
+	}
+	return cmp.Equal(normalizeStrategy(generateStrategy(p.DefaultCapacityProviderStrategy)),
+		normalizeStrategy(c.DefaultCapacityProviderStrategy), cmpopts.EquateEmpty())
+}
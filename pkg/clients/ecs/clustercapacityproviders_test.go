@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+func TestIsClusterCapacityProvidersUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ClusterCapacityProvidersParameters
+		c    ecs.Cluster
+		want bool
+	}{
+		"Empty": {
+			want: true,
+		},
+		"SameInDifferentOrder": {
+			p: v1alpha1.ClusterCapacityProvidersParameters{
+				CapacityProviders: []string{"workers", "FARGATE"},
+				DefaultCapacityProviderStrategy: []v1alpha1.CapacityProviderStrategyItem{
+					{CapacityProvider: "workers", Base: aws.Int64(1), Weight: aws.Int64(1)},
+					{CapacityProvider: "FARGATE", Weight: aws.Int64(2)},
+				},
+			},
+			c: ecs.Cluster{
+				CapacityProviders: []string{"FARGATE", "workers"},
+				DefaultCapacityProviderStrategy: []ecs.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE"), Base: aws.Int64(0), Weight: aws.Int64(2)},
+					{CapacityProvider: aws.String("workers"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+				},
+			},
+			want: true,
+		},
+		"CapacityProviderAdded": {
+			p: v1alpha1.ClusterCapacityProvidersParameters{
+				CapacityProviders: []string{"workers", "FARGATE"},
+			},
+			c: ecs.Cluster{
+				CapacityProviders: []string{"workers"},
+			},
+			want: false,
+		},
+		"WeightChanged": {
+			p: v1alpha1.ClusterCapacityProvidersParameters{
+				CapacityProviders: []string{"workers"},
+				DefaultCapacityProviderStrategy: []v1alpha1.CapacityProviderStrategyItem{
+					{CapacityProvider: "workers", Weight: aws.Int64(2)},
+				},
+			},
+			c: ecs.Cluster{
+				CapacityProviders: []string{"workers"},
+				DefaultCapacityProviderStrategy: []ecs.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("workers"), Weight: aws.Int64(1)},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsClusterCapacityProvidersUpToDate(tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
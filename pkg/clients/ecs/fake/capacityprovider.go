@@ -0,0 +1,42 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecs"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CapacityProviderClient = (*MockCapacityProviderClient)(nil)
+
+// MockCapacityProviderClient is a type that implements all the methods for CapacityProviderClient interface
+type MockCapacityProviderClient struct {
+	MockCreateCapacityProvider    func(*ecs.CreateCapacityProviderInput) ecs.CreateCapacityProviderRequest
+	MockDescribeCapacityProviders func(*ecs.DescribeCapacityProvidersInput) ecs.DescribeCapacityProvidersRequest
+}
+
+// CreateCapacityProviderRequest calls the underlying MockCreateCapacityProvider method.
+func (c *MockCapacityProviderClient) CreateCapacityProviderRequest(i *ecs.CreateCapacityProviderInput) ecs.CreateCapacityProviderRequest {
+	return c.MockCreateCapacityProvider(i)
+}
+
+// DescribeCapacityProvidersRequest calls the underlying MockDescribeCapacityProviders method.
+func (c *MockCapacityProviderClient) DescribeCapacityProvidersRequest(i *ecs.DescribeCapacityProvidersInput) ecs.DescribeCapacityProvidersRequest {
+	return c.MockDescribeCapacityProviders(i)
+}
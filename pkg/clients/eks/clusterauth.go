@@ -0,0 +1,221 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"reflect"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+// The ConfigMap the AWS IAM Authenticator of an EKS cluster reads the IAM
+// mappings from.
+// See https://docs.aws.amazon.com/eks/latest/userguide/add-user-role.html
+const (
+	AuthConfigMapName      = "aws-auth"
+	AuthConfigMapNamespace = "kube-system"
+
+	authMapRolesKey    = "mapRoles"
+	authMapUsersKey    = "mapUsers"
+	authMapAccountsKey = "mapAccounts"
+)
+
+// NewKubernetesClient returns a Kubernetes client of the cluster described
+// by the supplied kubeconfig.
+func NewKubernetesClient(kubeconfig []byte) (kubernetes.Interface, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(cfg)
+}
+
+type authMappings struct {
+	roles    []v1alpha1.RoleMapping
+	users    []v1alpha1.UserMapping
+	accounts []string
+}
+
+func parseAuthConfigMap(cm *corev1.ConfigMap) (authMappings, error) {
+	m := authMappings{}
+	if err := yaml.Unmarshal([]byte(cm.Data[authMapRolesKey]), &m.roles); err != nil {
+		return m, err
+	}
+	if err := yaml.Unmarshal([]byte(cm.Data[authMapUsersKey]), &m.users); err != nil {
+		return m, err
+	}
+	err := yaml.Unmarshal([]byte(cm.Data[authMapAccountsKey]), &m.accounts)
+	return m, err
+}
+
+func (m authMappings) writeTo(cm *corev1.ConfigMap) error {
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	entries := map[string]interface{}{authMapRolesKey: m.roles, authMapUsersKey: m.users, authMapAccountsKey: m.accounts}
+	for k, v := range entries {
+		if reflect.ValueOf(v).Len() == 0 {
+			delete(cm.Data, k)
+			continue
+		}
+		b, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		cm.Data[k] = string(b)
+	}
+	return nil
+}
+
+// without removes the mappings of the supplied ARNs and accounts.
+func (m authMappings) without(roles, users, accounts []string) authMappings {
+	r := authMappings{}
+	for _, rm := range m.roles {
+		if !contains(roles, rm.RoleARN) {
+			r.roles = append(r.roles, rm)
+		}
+	}
+	for _, um := range m.users {
+		if !contains(users, um.UserARN) {
+			r.users = append(r.users, um)
+		}
+	}
+	for _, a := range m.accounts {
+		if !contains(accounts, a) {
+			r.accounts = append(r.accounts, a)
+		}
+	}
+	return r
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateClusterAuthObservation returns the mappings made for the supplied
+// parameters.
+func GenerateClusterAuthObservation(p v1alpha1.ClusterAuthParameters) v1alpha1.ClusterAuthObservation {
+	o := v1alpha1.ClusterAuthObservation{Accounts: p.MapAccounts}
+	for _, r := range p.MapRoles {
+		o.RoleARNs = append(o.RoleARNs, r.RoleARN)
+	}
+	for _, u := range p.MapUsers {
+		o.UserARNs = append(o.UserARNs, u.UserARN)
+	}
+	return o
+}
+
+// IsClusterAuthUpToDate returns true if the supplied aws-auth ConfigMap
+// contains the desired mappings and none of the mappings last made that are
+// no longer desired.
+func IsClusterAuthUpToDate(p v1alpha1.ClusterAuthParameters, last v1alpha1.ClusterAuthObservation, cm *corev1.ConfigMap) (bool, error) {
+	m, err := parseAuthConfigMap(cm)
+	if err != nil {
+		return false, err
+	}
+	desired := GenerateClusterAuthObservation(p)
+	stale := m.without(desired.RoleARNs, desired.UserARNs, desired.Accounts)
+	for _, r := range stale.roles {
+		if contains(last.RoleARNs, r.RoleARN) {
+			return false, nil
+		}
+	}
+	for _, u := range stale.users {
+		if contains(last.UserARNs, u.UserARN) {
+			return false, nil
+		}
+	}
+	for _, a := range stale.accounts {
+		if contains(last.Accounts, a) {
+			return false, nil
+		}
+	}
+
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	for _, want := range p.MapRoles {
+		found := false
+		for _, got := range m.roles {
+			if got.RoleARN == want.RoleARN && cmp.Equal(want, got, sortStrings, cmpopts.EquateEmpty()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	for _, want := range p.MapUsers {
+		found := false
+		for _, got := range m.users {
+			if got.UserARN == want.UserARN && cmp.Equal(want, got, sortStrings, cmpopts.EquateEmpty()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	for _, a := range p.MapAccounts {
+		if !contains(m.accounts, a) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// UpdateAuthConfigMap replaces the mappings last made in the supplied
+// aws-auth ConfigMap with the desired ones. The mappings of other IAM
+// identities are kept.
+func UpdateAuthConfigMap(p v1alpha1.ClusterAuthParameters, last v1alpha1.ClusterAuthObservation, cm *corev1.ConfigMap) error {
+	m, err := parseAuthConfigMap(cm)
+	if err != nil {
+		return err
+	}
+	desired := GenerateClusterAuthObservation(p)
+	m = m.without(last.RoleARNs, last.UserARNs, last.Accounts).
+		without(desired.RoleARNs, desired.UserARNs, desired.Accounts)
+	m.roles = append(m.roles, p.MapRoles...)
+	m.users = append(m.users, p.MapUsers...)
+	m.accounts = append(m.accounts, p.MapAccounts...)
+	return m.writeTo(cm)
+}
+
+// RemoveFromAuthConfigMap removes the desired mappings and the mappings last
+// made from the supplied aws-auth ConfigMap.
+func RemoveFromAuthConfigMap(p v1alpha1.ClusterAuthParameters, last v1alpha1.ClusterAuthObservation, cm *corev1.ConfigMap) error {
+	m, err := parseAuthConfigMap(cm)
+	if err != nil {
+		return err
+	}
+	desired := GenerateClusterAuthObservation(p)
+	m = m.without(last.RoleARNs, last.UserARNs, last.Accounts).
+		without(desired.RoleARNs, desired.UserARNs, desired.Accounts)
+	return m.writeTo(cm)
+}
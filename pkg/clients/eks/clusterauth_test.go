@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+func TestIsClusterAuthUpToDate(t *testing.T) {
+	roles := `
+- rolearn: arn:aws:iam::123456789012:role/node
+  username: system:node:{{EC2PrivateDNSName}}
+  groups: [system:bootstrappers, system:nodes]
+- rolearn: arn:aws:iam::123456789012:role/admin
+  username: admin
+  groups: [system:masters, view]
+`
+	users := `
+- userarn: arn:aws:iam::123456789012:user/ops
+  username: ops
+`
+	cm := &corev1.ConfigMap{Data: map[string]string{
+		authMapRolesKey:    roles,
+		authMapUsersKey:    users,
+		authMapAccountsKey: "- \"123456789012\"\n",
+	}}
+	admin := v1alpha1.RoleMapping{
+		RoleARN:  "arn:aws:iam::123456789012:role/admin",
+		Username: "admin",
+		Groups:   []string{"view", "system:masters"},
+	}
+
+	type want struct {
+		upToDate bool
+		err      bool
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ClusterAuthParameters
+		last v1alpha1.ClusterAuthObservation
+		cm   *corev1.ConfigMap
+		want want
+	}{
+		"UpToDate": {
+			p: v1alpha1.ClusterAuthParameters{
+				MapRoles:    []v1alpha1.RoleMapping{admin},
+				MapUsers:    []v1alpha1.UserMapping{{UserARN: "arn:aws:iam::123456789012:user/ops", Username: "ops"}},
+				MapAccounts: []string{"123456789012"},
+			},
+			cm:   cm,
+			want: want{upToDate: true},
+		},
+		"ChangedGroups": {
+			p: v1alpha1.ClusterAuthParameters{
+				MapRoles: []v1alpha1.RoleMapping{{RoleARN: admin.RoleARN, Username: "admin", Groups: []string{"view"}}},
+			},
+			cm:   cm,
+			want: want{upToDate: false},
+		},
+		"MissingUser": {
+			p: v1alpha1.ClusterAuthParameters{
+				MapUsers: []v1alpha1.UserMapping{{UserARN: "arn:aws:iam::123456789012:user/dev", Username: "dev"}},
+			},
+			cm:   cm,
+			want: want{upToDate: false},
+		},
+		"StaleMapping": {
+			last: v1alpha1.ClusterAuthObservation{RoleARNs: []string{admin.RoleARN}},
+			cm:   cm,
+			want: want{upToDate: false},
+		},
+		"ForeignMappingsIgnored": {
+			cm:   cm,
+			want: want{upToDate: true},
+		},
+		"InvalidData": {
+			cm:   &corev1.ConfigMap{Data: map[string]string{authMapRolesKey: "{"}},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsClusterAuthUpToDate(tc.p, tc.last, tc.cm)
+			if diff := cmp.Diff(tc.want, want{upToDate: got, err: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
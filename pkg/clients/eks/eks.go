@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net"
 	"strings"
 	"time"
@@ -44,8 +45,16 @@ import (
 const (
 	clusterIDHeader = "x-k8s-aws-id"
 	v1Prefix        = "k8s-aws-v1."
+
+	execAPIVersion = "client.authentication.k8s.io/v1alpha1"
+	execCommand    = "aws-iam-authenticator"
 )
 
+// ExecKubeconfigKey is the key inside the connection secret of a cluster for
+// a kubeconfig that gets a token from aws-iam-authenticator instead of
+// embedding a short-lived one.
+const ExecKubeconfigKey = "execKubeconfig"
+
 // Client defines EKS Client operations
 type Client eksiface.ClientAPI
 
@@ -315,11 +324,50 @@ func IsUpToDate(p *v1beta1.ClusterParameters, cluster *eks.Cluster) (bool, error
 		cmpopts.IgnoreFields(v1beta1.VpcConfigRequest{}, "SecurityGroupIDRefs", "SubnetIDRefs", "PublicAccessCidrs")), nil
 }
 
+// GenerateClusterUpdate returns the status representation of the supplied
+// update.
+func GenerateClusterUpdate(u *eks.Update) *v1beta1.ClusterUpdate {
+	if u == nil {
+		return nil
+	}
+	o := &v1beta1.ClusterUpdate{
+		ID:     awsclients.StringValue(u.Id),
+		Type:   string(u.Type),
+		Status: string(u.Status),
+	}
+	for _, e := range u.Errors {
+		o.Errors = append(o.Errors, fmt.Sprintf("%s: %s", e.ErrorCode, awsclients.StringValue(e.ErrorMessage)))
+	}
+	return o
+}
+
 // GetConnectionDetails extracts managed.ConnectionDetails out of eks.Cluster.
+// The details are generated from the described cluster on every observation,
+// so they are refreshed when the endpoint or the CA of the cluster change.
 func GetConnectionDetails(cluster *eks.Cluster, stsClient STSClient) managed.ConnectionDetails {
 	if cluster == nil || cluster.Name == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
 		return managed.ConnectionDetails{}
 	}
+
+	// NOTE(hasheddan): We must decode the CA data before constructing our
+	// Kubeconfig, as the raw Kubeconfig will be base64 encoded again when
+	// written as a Secret.
+	caData, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
+	if err != nil {
+		return managed.ConnectionDetails{}
+	}
+	cd := managed.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretEndpointKey: []byte(*cluster.Endpoint),
+		v1alpha1.ResourceCredentialsSecretCAKey:       caData,
+	}
+
+	// The exec kubeconfig does not expire, but requires the
+	// aws-iam-authenticator binary and AWS credentials wherever it is used.
+	execConfig, err := clientcmd.Write(generateKubeconfig(cluster, caData, generateExecAuthInfo(cluster)))
+	if err == nil {
+		cd[ExecKubeconfigKey] = execConfig
+	}
+
 	request := stsClient.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
 	request.HTTPRequest.Header.Add(clusterIDHeader, *cluster.Name)
 
@@ -332,18 +380,33 @@ func GetConnectionDetails(cluster *eks.Cluster, stsClient STSClient) managed.Con
 	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
 	presignedURLString, err := request.Presign(60 * time.Second)
 	if err != nil {
-		return managed.ConnectionDetails{}
+		return cd
 	}
 	token := v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURLString))
-
-	// NOTE(hasheddan): We must decode the CA data before constructing our
-	// Kubeconfig, as the raw Kubeconfig will be base64 encoded again when
-	// written as a Secret.
-	caData, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
+	rawConfig, err := clientcmd.Write(generateKubeconfig(cluster, caData, &clientcmdapi.AuthInfo{Token: token}))
 	if err != nil {
-		return managed.ConnectionDetails{}
+		return cd
+	}
+	cd[v1alpha1.ResourceCredentialsSecretKubeconfigKey] = rawConfig
+	return cd
+}
+
+// generateExecAuthInfo returns the auth info that gets a token for the
+// supplied cluster from aws-iam-authenticator.
+func generateExecAuthInfo(cluster *eks.Cluster) *clientcmdapi.AuthInfo {
+	return &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion: execAPIVersion,
+			Command:    execCommand,
+			Args:       []string{"token", "-i", *cluster.Name},
+		},
 	}
-	kc := clientcmdapi.Config{
+}
+
+// generateKubeconfig returns a kubeconfig for the supplied cluster that
+// authenticates with the supplied auth info.
+func generateKubeconfig(cluster *eks.Cluster, caData []byte, auth *clientcmdapi.AuthInfo) clientcmdapi.Config {
+	return clientcmdapi.Config{
 		Clusters: map[string]*clientcmdapi.Cluster{
 			*cluster.Name: {
 				Server:                   *cluster.Endpoint,
@@ -357,20 +420,8 @@ func GetConnectionDetails(cluster *eks.Cluster, stsClient STSClient) managed.Con
 			},
 		},
 		AuthInfos: map[string]*clientcmdapi.AuthInfo{
-			*cluster.Name: {
-				Token: token,
-			},
+			*cluster.Name: auth,
 		},
 		CurrentContext: *cluster.Name,
 	}
-
-	rawConfig, err := clientcmd.Write(kc)
-	if err != nil {
-		return managed.ConnectionDetails{}
-	}
-	return managed.ConnectionDetails{
-		v1alpha1.ResourceCredentialsSecretEndpointKey:   []byte(*cluster.Endpoint),
-		v1alpha1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
-		v1alpha1.ResourceCredentialsSecretCAKey:         caData,
-	}
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 
@@ -826,3 +827,32 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateKubeconfig(t *testing.T) {
+	endpoint := "https://cool.eks.amazonaws.com"
+	ca := []byte("ca")
+	cluster := &eks.Cluster{Name: &clusterName, Endpoint: &endpoint}
+
+	want := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			clusterName: {Server: endpoint, CertificateAuthorityData: ca},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			clusterName: {Cluster: clusterName, AuthInfo: clusterName},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			clusterName: {
+				Exec: &clientcmdapi.ExecConfig{
+					APIVersion: "client.authentication.k8s.io/v1alpha1",
+					Command:    "aws-iam-authenticator",
+					Args:       []string{"token", "-i", clusterName},
+				},
+			},
+		},
+		CurrentContext: clusterName,
+	}
+	got := generateKubeconfig(cluster, ca, generateExecAuthInfo(cluster))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -34,6 +34,7 @@ type MockClient struct {
 	MockTagResourceRequest          func(*eks.TagResourceInput) eks.TagResourceRequest
 	MockUntagResourceRequest        func(*eks.UntagResourceInput) eks.UntagResourceRequest
 	MockUpdateClusterVersionRequest func(*eks.UpdateClusterVersionInput) eks.UpdateClusterVersionRequest
+	MockDescribeUpdateRequest       func(*eks.DescribeUpdateInput) eks.DescribeUpdateRequest
 
 	MockDescribeNodegroupRequest      func(*eks.DescribeNodegroupInput) eks.DescribeNodegroupRequest
 	MockCreateNodegroupRequest        func(*eks.CreateNodegroupInput) eks.CreateNodegroupRequest
@@ -80,6 +81,12 @@ func (c *MockClient) UpdateClusterVersionRequest(i *eks.UpdateClusterVersionInpu
 	return c.MockUpdateClusterVersionRequest(i)
 }
 
+// DescribeUpdateRequest calls the underlying MockDescribeUpdateRequest
+// method.
+func (c *MockClient) DescribeUpdateRequest(i *eks.DescribeUpdateInput) eks.DescribeUpdateRequest {
+	return c.MockDescribeUpdateRequest(i)
+}
+
 // DescribeNodegroupRequest calls the underlying MockDescribeNodegroupRequest
 // method.
 func (c *MockClient) DescribeNodegroupRequest(i *eks.DescribeNodegroupInput) eks.DescribeNodegroupRequest {
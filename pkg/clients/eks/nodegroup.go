@@ -145,11 +145,12 @@ func LateInitializeNodeGroup(in *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup
 			SourceSecurityGroups: ng.RemoteAccess.SourceSecurityGroups,
 		}
 	}
+	// The desired size is not late-initialized, so that it is left to whatever
+	// scales the node group, e.g. the cluster autoscaler, unless it is set.
 	if in.ScalingConfig == nil && ng.ScalingConfig != nil {
 		in.ScalingConfig = &v1alpha1.NodeGroupScalingConfig{
-			DesiredSize: ng.ScalingConfig.DesiredSize,
-			MinSize:     ng.ScalingConfig.MinSize,
-			MaxSize:     ng.ScalingConfig.MaxSize,
+			MinSize: ng.ScalingConfig.MinSize,
+			MaxSize: ng.ScalingConfig.MaxSize,
 		}
 	}
 	in.ReleaseVersion = awsclients.LateInitializeStringPtr(in.ReleaseVersion, ng.ReleaseVersion)
@@ -177,7 +178,9 @@ func IsNodeGroupUpToDate(p *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup) boo
 		return true
 	}
 	if p.ScalingConfig != nil && ng.ScalingConfig != nil {
-		if !cmp.Equal(p.ScalingConfig.DesiredSize, ng.ScalingConfig.DesiredSize) {
+		// An unset desired size is left to whatever scales the node group,
+		// e.g. the cluster autoscaler.
+		if p.ScalingConfig.DesiredSize != nil && !cmp.Equal(p.ScalingConfig.DesiredSize, ng.ScalingConfig.DesiredSize) {
 			return false
 		}
 		if !cmp.Equal(p.ScalingConfig.MaxSize, ng.ScalingConfig.MaxSize) {
@@ -366,9 +366,8 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 					SourceSecurityGroups: []string{"cool-group"},
 				},
 				ScalingConfig: &v1alpha1.NodeGroupScalingConfig{
-					DesiredSize: &size,
-					MaxSize:     &size,
-					MinSize:     &size,
+					MaxSize: &size,
+					MinSize: &size,
 				},
 				Tags:    map[string]string{"cool": "tag"},
 				Version: &version,
@@ -424,6 +423,30 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"IgnoredDesiredSize": {
+			args: args{
+				p: &v1alpha1.NodeGroupParameters{
+					Tags:    map[string]string{"cool": "tag"},
+					Version: &version,
+					Labels:  map[string]string{"cool": "label"},
+					ScalingConfig: &v1alpha1.NodeGroupScalingConfig{
+						MaxSize: &size,
+						MinSize: &size,
+					},
+				},
+				n: &eks.Nodegroup{
+					Labels: map[string]string{"cool": "label"},
+					ScalingConfig: &eks.NodegroupScalingConfig{
+						DesiredSize: &size,
+						MaxSize:     &size,
+						MinSize:     &size,
+					},
+					Version: &version,
+					Tags:    map[string]string{"cool": "tag"},
+				},
+			},
+			want: true,
+		},
 		"UpdateTags": {
 			args: args{
 				p: &v1alpha1.NodeGroupParameters{
@@ -20,6 +20,7 @@ import (
 	"context"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -66,6 +67,7 @@ func NewCreateReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 		CacheSecurityGroupNames:    g.CacheSecurityGroupNames,
 		CacheSubnetGroupName:       g.CacheSubnetGroupName,
 		EngineVersion:              g.EngineVersion,
+		GlobalReplicationGroupId:   g.GlobalReplicationGroupID,
 		NotificationTopicArn:       g.NotificationTopicARN,
 		NumCacheClusters:           clients.Int64Address(g.NumCacheClusters),
 		NumNodeGroups:              clients.Int64Address(g.NumNodeGroups),
@@ -277,6 +279,7 @@ func sgNamesNeedUpdate(kube []string, cc []elasticache.CacheSecurityGroupMembers
 // received elasticache.ReplicationGroup object.
 func GenerateObservation(rg elasticache.ReplicationGroup) v1beta1.ReplicationGroupObservation {
 	o := v1beta1.ReplicationGroupObservation{
+		ARN:                   clients.StringValue(rg.ARN),
 		AutomaticFailover:     string(rg.AutomaticFailover),
 		ClusterEnabled:        aws.BoolValue(rg.ClusterEnabled),
 		ConfigurationEndpoint: newEndpoint(rg.ConfigurationEndpoint),
@@ -426,3 +429,128 @@ func IsSubnetGroupUpToDate(p cachev1alpha1.CacheSubnetGroupParameters, sg elasti
 
 	return true
 }
+
+// IsGlobalReplicationGroupNotFound returns true if the supplied error
+// indicates a Global Replication Group was not found.
+func IsGlobalReplicationGroupNotFound(err error) bool {
+	return isErrorCodeEqual(elasticache.ErrCodeGlobalReplicationGroupNotFoundFault, err)
+}
+
+// NewDescribeGlobalReplicationGroupsInput returns ElastiCache global
+// replication group describe input with member information.
+func NewDescribeGlobalReplicationGroupsInput(id string) *elasticache.DescribeGlobalReplicationGroupsInput {
+	return &elasticache.DescribeGlobalReplicationGroupsInput{
+		GlobalReplicationGroupId: aws.String(id),
+		ShowMemberInfo:           aws.Bool(true),
+	}
+}
+
+// NewCreateGlobalReplicationGroupInput returns ElastiCache global replication
+// group creation input suitable for use with the AWS API.
+func NewCreateGlobalReplicationGroupInput(p cachev1alpha1.GlobalReplicationGroupParameters) *elasticache.CreateGlobalReplicationGroupInput {
+	return &elasticache.CreateGlobalReplicationGroupInput{
+		GlobalReplicationGroupIdSuffix:    aws.String(p.GlobalReplicationGroupIDSuffix),
+		GlobalReplicationGroupDescription: p.GlobalReplicationGroupDescription,
+		PrimaryReplicationGroupId:         p.PrimaryReplicationGroupID,
+	}
+}
+
+// PrimaryMember returns the primary member of the supplied global
+// replication group, or nil if it has none.
+func PrimaryMember(g elasticache.GlobalReplicationGroup) *elasticache.GlobalReplicationGroupMember {
+	for i := range g.Members {
+		if strings.EqualFold(aws.StringValue(g.Members[i].Role), "PRIMARY") {
+			return &g.Members[i]
+		}
+	}
+	return nil
+}
+
+// LateInitializeGlobalReplicationGroup assigns the observed configuration of
+// a global replication group to the unset fields of the supplied parameters.
+func LateInitializeGlobalReplicationGroup(p *cachev1alpha1.GlobalReplicationGroupParameters, g elasticache.GlobalReplicationGroup) {
+	if p == nil {
+		return
+	}
+	p.GlobalReplicationGroupDescription = clients.LateInitializeStringPtr(p.GlobalReplicationGroupDescription, g.GlobalReplicationGroupDescription)
+	p.CacheNodeType = clients.LateInitializeStringPtr(p.CacheNodeType, g.CacheNodeType)
+	p.EngineVersion = clients.LateInitializeStringPtr(p.EngineVersion, g.EngineVersion)
+	if m := PrimaryMember(g); m != nil {
+		p.PrimaryReplicationGroupID = clients.LateInitializeStringPtr(p.PrimaryReplicationGroupID, m.ReplicationGroupId)
+		p.PrimaryRegion = clients.LateInitializeStringPtr(p.PrimaryRegion, m.ReplicationGroupRegion)
+		p.AutomaticFailoverEnabled = clients.LateInitializeBoolPtr(p.AutomaticFailoverEnabled, automaticFailoverEnabled(m.AutomaticFailover))
+	}
+}
+
+// GenerateGlobalReplicationGroupObservation produces a
+// GlobalReplicationGroupObservation from the supplied global replication
+// group.
+func GenerateGlobalReplicationGroupObservation(g elasticache.GlobalReplicationGroup) cachev1alpha1.GlobalReplicationGroupObservation {
+	o := cachev1alpha1.GlobalReplicationGroupObservation{
+		ARN:            aws.StringValue(g.ARN),
+		Status:         aws.StringValue(g.Status),
+		Engine:         aws.StringValue(g.Engine),
+		ClusterEnabled: aws.BoolValue(g.ClusterEnabled),
+	}
+	for _, m := range g.Members {
+		o.Members = append(o.Members, cachev1alpha1.GlobalReplicationGroupMember{
+			ReplicationGroupID:     aws.StringValue(m.ReplicationGroupId),
+			ReplicationGroupRegion: aws.StringValue(m.ReplicationGroupRegion),
+			Role:                   aws.StringValue(m.Role),
+			Status:                 aws.StringValue(m.Status),
+		})
+	}
+	return o
+}
+
+// IsGlobalReplicationGroupPrimaryUpToDate returns true if the desired primary
+// member of the global replication group is its current primary member.
+func IsGlobalReplicationGroupPrimaryUpToDate(p cachev1alpha1.GlobalReplicationGroupParameters, g elasticache.GlobalReplicationGroup) bool {
+	m := PrimaryMember(g)
+	if m == nil || p.PrimaryReplicationGroupID == nil {
+		return true
+	}
+	if aws.StringValue(p.PrimaryReplicationGroupID) != aws.StringValue(m.ReplicationGroupId) {
+		return false
+	}
+	return p.PrimaryRegion == nil || aws.StringValue(p.PrimaryRegion) == aws.StringValue(m.ReplicationGroupRegion)
+}
+
+// NewModifyGlobalReplicationGroupInput returns ElastiCache global replication
+// group modification input with the fields that differ between the supplied
+// parameters and global replication group, or nil if none differ.
+func NewModifyGlobalReplicationGroupInput(p cachev1alpha1.GlobalReplicationGroupParameters, g elasticache.GlobalReplicationGroup) *elasticache.ModifyGlobalReplicationGroupInput {
+	in := &elasticache.ModifyGlobalReplicationGroupInput{
+		GlobalReplicationGroupId: g.GlobalReplicationGroupId,
+		ApplyImmediately:         aws.Bool(true),
+	}
+	changed := false
+	if p.GlobalReplicationGroupDescription != nil && aws.StringValue(p.GlobalReplicationGroupDescription) != aws.StringValue(g.GlobalReplicationGroupDescription) {
+		in.GlobalReplicationGroupDescription = p.GlobalReplicationGroupDescription
+		changed = true
+	}
+	if p.CacheNodeType != nil && aws.StringValue(p.CacheNodeType) != aws.StringValue(g.CacheNodeType) {
+		in.CacheNodeType = p.CacheNodeType
+		changed = true
+	}
+	if p.EngineVersion != nil && aws.StringValue(p.EngineVersion) != aws.StringValue(g.EngineVersion) {
+		in.EngineVersion = p.EngineVersion
+		changed = true
+	}
+	if m := PrimaryMember(g); m != nil && p.AutomaticFailoverEnabled != nil {
+		if observed := automaticFailoverEnabled(m.AutomaticFailover); observed == nil || *observed != *p.AutomaticFailoverEnabled {
+			in.AutomaticFailoverEnabled = p.AutomaticFailoverEnabled
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return in
+}
+
+// IsGlobalReplicationGroupUpToDate returns true if the supplied global
+// replication group is in sync with the supplied parameters.
+func IsGlobalReplicationGroupUpToDate(p cachev1alpha1.GlobalReplicationGroupParameters, g elasticache.GlobalReplicationGroup) bool {
+	return IsGlobalReplicationGroupPrimaryUpToDate(p, g) && NewModifyGlobalReplicationGroupInput(p, g) == nil
+}
@@ -503,6 +503,7 @@ func TestGenerateObservation(t *testing.T) {
 	}
 	memberClusters := []string{"member-1", "member-2"}
 	status := "creating"
+	rgARN := "arn:aws:elasticache:us-east-1:123456789012:replicationgroup:my-group"
 	nodeGroups := []elasticache.NodeGroup{
 		{
 			NodeGroupId: aws.String("my-id"),
@@ -544,6 +545,7 @@ func TestGenerateObservation(t *testing.T) {
 		{
 			name: "AllFields",
 			rg: elasticache.ReplicationGroup{
+				ARN:                   aws.String(rgARN),
 				AutomaticFailover:     automaticFailover,
 				ClusterEnabled:        &clusterEnabled,
 				ConfigurationEndpoint: configurationEndpoint,
@@ -553,6 +555,7 @@ func TestGenerateObservation(t *testing.T) {
 				PendingModifiedValues: &rgpmdv,
 			},
 			want: v1beta1.ReplicationGroupObservation{
+				ARN:               rgARN,
 				AutomaticFailover: string(automaticFailover),
 				ClusterEnabled:    clusterEnabled,
 				ConfigurationEndpoint: v1beta1.Endpoint{
@@ -957,3 +960,96 @@ func TestIsSubnetGroupUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewModifyGlobalReplicationGroupInput(t *testing.T) {
+	observed := elasticache.GlobalReplicationGroup{
+		GlobalReplicationGroupId:          aws.String(name),
+		GlobalReplicationGroupDescription: aws.String(description),
+		CacheNodeType:                     aws.String(cacheNodeType),
+		EngineVersion:                     aws.String(engineVersion),
+		Members: []elasticache.GlobalReplicationGroupMember{
+			{ReplicationGroupId: aws.String(primaryClusterID), Role: aws.String("PRIMARY"), AutomaticFailover: elasticache.AutomaticFailoverStatusEnabled},
+		},
+	}
+
+	cases := map[string]struct {
+		params cachev1alpha1.GlobalReplicationGroupParameters
+		want   *elasticache.ModifyGlobalReplicationGroupInput
+	}{
+		"UpToDate": {
+			params: cachev1alpha1.GlobalReplicationGroupParameters{
+				GlobalReplicationGroupDescription: aws.String(description),
+				CacheNodeType:                     aws.String(cacheNodeType),
+				EngineVersion:                     aws.String(engineVersion),
+				AutomaticFailoverEnabled:          aws.Bool(true),
+			},
+		},
+		"UnsetFieldsAreIgnored": {
+			params: cachev1alpha1.GlobalReplicationGroupParameters{},
+		},
+		"ChangedFields": {
+			params: cachev1alpha1.GlobalReplicationGroupParameters{
+				GlobalReplicationGroupDescription: aws.String(description),
+				CacheNodeType:                     aws.String("cache.r5.xlarge"),
+				EngineVersion:                     aws.String(engineVersion),
+				AutomaticFailoverEnabled:          aws.Bool(false),
+			},
+			want: &elasticache.ModifyGlobalReplicationGroupInput{
+				GlobalReplicationGroupId: aws.String(name),
+				ApplyImmediately:         aws.Bool(true),
+				CacheNodeType:            aws.String("cache.r5.xlarge"),
+				AutomaticFailoverEnabled: aws.Bool(false),
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := NewModifyGlobalReplicationGroupInput(tc.params, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewModifyGlobalReplicationGroupInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsGlobalReplicationGroupPrimaryUpToDate(t *testing.T) {
+	observed := elasticache.GlobalReplicationGroup{
+		Members: []elasticache.GlobalReplicationGroupMember{
+			{ReplicationGroupId: aws.String("secondary"), ReplicationGroupRegion: aws.String("eu-west-1"), Role: aws.String("SECONDARY")},
+			{ReplicationGroupId: aws.String("primary"), ReplicationGroupRegion: aws.String("us-east-1"), Role: aws.String("primary")},
+		},
+	}
+
+	cases := map[string]struct {
+		params cachev1alpha1.GlobalReplicationGroupParameters
+		want   bool
+	}{
+		"Unset": {
+			want: true,
+		},
+		"SamePrimary": {
+			params: cachev1alpha1.GlobalReplicationGroupParameters{
+				PrimaryReplicationGroupID: aws.String("primary"),
+				PrimaryRegion:             aws.String("us-east-1"),
+			},
+			want: true,
+		},
+		"DifferentPrimary": {
+			params: cachev1alpha1.GlobalReplicationGroupParameters{
+				PrimaryReplicationGroupID: aws.String("secondary"),
+				PrimaryRegion:             aws.String("eu-west-1"),
+			},
+			want: false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := IsGlobalReplicationGroupPrimaryUpToDate(tc.params, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsGlobalReplicationGroupPrimaryUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
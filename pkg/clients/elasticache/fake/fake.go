@@ -37,6 +37,12 @@ type MockClient struct {
 	MockCreateCacheSubnetGroupRequest    func(*elasticache.CreateCacheSubnetGroupInput) elasticache.CreateCacheSubnetGroupRequest
 	MockModifyCacheSubnetGroupRequest    func(*elasticache.ModifyCacheSubnetGroupInput) elasticache.ModifyCacheSubnetGroupRequest
 	MockDeleteCacheSubnetGroupRequest    func(*elasticache.DeleteCacheSubnetGroupInput) elasticache.DeleteCacheSubnetGroupRequest
+
+	MockDescribeGlobalReplicationGroupsRequest func(*elasticache.DescribeGlobalReplicationGroupsInput) elasticache.DescribeGlobalReplicationGroupsRequest
+	MockCreateGlobalReplicationGroupRequest    func(*elasticache.CreateGlobalReplicationGroupInput) elasticache.CreateGlobalReplicationGroupRequest
+	MockModifyGlobalReplicationGroupRequest    func(*elasticache.ModifyGlobalReplicationGroupInput) elasticache.ModifyGlobalReplicationGroupRequest
+	MockFailoverGlobalReplicationGroupRequest  func(*elasticache.FailoverGlobalReplicationGroupInput) elasticache.FailoverGlobalReplicationGroupRequest
+	MockDeleteGlobalReplicationGroupRequest    func(*elasticache.DeleteGlobalReplicationGroupInput) elasticache.DeleteGlobalReplicationGroupRequest
 }
 
 // DescribeReplicationGroupsRequest calls the underlying
@@ -92,3 +98,33 @@ func (c *MockClient) ModifyCacheSubnetGroupRequest(i *elasticache.ModifyCacheSub
 func (c *MockClient) DeleteCacheSubnetGroupRequest(i *elasticache.DeleteCacheSubnetGroupInput) elasticache.DeleteCacheSubnetGroupRequest {
 	return c.MockDeleteCacheSubnetGroupRequest(i)
 }
+
+// DescribeGlobalReplicationGroupsRequest calls the underlying
+// MockDescribeGlobalReplicationGroupsRequest method.
+func (c *MockClient) DescribeGlobalReplicationGroupsRequest(i *elasticache.DescribeGlobalReplicationGroupsInput) elasticache.DescribeGlobalReplicationGroupsRequest {
+	return c.MockDescribeGlobalReplicationGroupsRequest(i)
+}
+
+// CreateGlobalReplicationGroupRequest calls the underlying
+// MockCreateGlobalReplicationGroupRequest method.
+func (c *MockClient) CreateGlobalReplicationGroupRequest(i *elasticache.CreateGlobalReplicationGroupInput) elasticache.CreateGlobalReplicationGroupRequest {
+	return c.MockCreateGlobalReplicationGroupRequest(i)
+}
+
+// ModifyGlobalReplicationGroupRequest calls the underlying
+// MockModifyGlobalReplicationGroupRequest method.
+func (c *MockClient) ModifyGlobalReplicationGroupRequest(i *elasticache.ModifyGlobalReplicationGroupInput) elasticache.ModifyGlobalReplicationGroupRequest {
+	return c.MockModifyGlobalReplicationGroupRequest(i)
+}
+
+// FailoverGlobalReplicationGroupRequest calls the underlying
+// MockFailoverGlobalReplicationGroupRequest method.
+func (c *MockClient) FailoverGlobalReplicationGroupRequest(i *elasticache.FailoverGlobalReplicationGroupInput) elasticache.FailoverGlobalReplicationGroupRequest {
+	return c.MockFailoverGlobalReplicationGroupRequest(i)
+}
+
+// DeleteGlobalReplicationGroupRequest calls the underlying
+// MockDeleteGlobalReplicationGroupRequest method.
+func (c *MockClient) DeleteGlobalReplicationGroupRequest(i *elasticache.DeleteGlobalReplicationGroupInput) elasticache.DeleteGlobalReplicationGroupRequest {
+	return c.MockDeleteGlobalReplicationGroupRequest(i)
+}
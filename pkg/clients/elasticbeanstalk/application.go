@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticbeanstalk
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ApplicationClient is the external client used for Application Custom
+// Resource
+type ApplicationClient interface {
+	CreateApplicationRequest(*elasticbeanstalk.CreateApplicationInput) elasticbeanstalk.CreateApplicationRequest
+	DescribeApplicationsRequest(*elasticbeanstalk.DescribeApplicationsInput) elasticbeanstalk.DescribeApplicationsRequest
+	UpdateApplicationRequest(*elasticbeanstalk.UpdateApplicationInput) elasticbeanstalk.UpdateApplicationRequest
+	DeleteApplicationRequest(*elasticbeanstalk.DeleteApplicationInput) elasticbeanstalk.DeleteApplicationRequest
+}
+
+// NewApplicationClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewApplicationClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ApplicationClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return elasticbeanstalk.New(*cfg), err
+}
+
+// GenerateTags converts the supplied tag map to a list of Elastic Beanstalk
+// tags sorted by key.
+func GenerateTags(tags map[string]string) []elasticbeanstalk.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	out := make([]elasticbeanstalk.Tag, 0, len(tags))
+	for k, v := range tags {
+		out = append(out, elasticbeanstalk.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(out, func(i, j int) bool { return aws.StringValue(out[i].Key) < aws.StringValue(out[j].Key) })
+	return out
+}
+
+// GenerateCreateApplicationInput returns the input to create the application
+// with the given name from the supplied parameters.
+func GenerateCreateApplicationInput(name string, p v1alpha1.ApplicationParameters) *elasticbeanstalk.CreateApplicationInput {
+	return &elasticbeanstalk.CreateApplicationInput{
+		ApplicationName: aws.String(name),
+		Description:     p.Description,
+		Tags:            GenerateTags(p.Tags),
+	}
+}
+
+// LateInitializeApplication fills the empty fields in
+// *v1alpha1.ApplicationParameters with the values seen in
+// elasticbeanstalk.ApplicationDescription.
+func LateInitializeApplication(in *v1alpha1.ApplicationParameters, a *elasticbeanstalk.ApplicationDescription) {
+	if a == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, a.Description)
+}
+
+// IsApplicationUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsApplicationUpToDate(p v1alpha1.ApplicationParameters, a elasticbeanstalk.ApplicationDescription) bool {
+	return aws.StringValue(p.Description) == aws.StringValue(a.Description)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticbeanstalk
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ApplicationVersionClient is the external client used for
+// ApplicationVersion Custom Resource
+type ApplicationVersionClient interface {
+	CreateApplicationVersionRequest(*elasticbeanstalk.CreateApplicationVersionInput) elasticbeanstalk.CreateApplicationVersionRequest
+	DescribeApplicationVersionsRequest(*elasticbeanstalk.DescribeApplicationVersionsInput) elasticbeanstalk.DescribeApplicationVersionsRequest
+	UpdateApplicationVersionRequest(*elasticbeanstalk.UpdateApplicationVersionInput) elasticbeanstalk.UpdateApplicationVersionRequest
+	DeleteApplicationVersionRequest(*elasticbeanstalk.DeleteApplicationVersionInput) elasticbeanstalk.DeleteApplicationVersionRequest
+}
+
+// NewApplicationVersionClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewApplicationVersionClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ApplicationVersionClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return elasticbeanstalk.New(*cfg), err
+}
+
+// GenerateCreateApplicationVersionInput returns the input to create the
+// application version with the given label from the supplied parameters.
+func GenerateCreateApplicationVersionInput(label string, p v1alpha1.ApplicationVersionParameters) *elasticbeanstalk.CreateApplicationVersionInput {
+	in := &elasticbeanstalk.CreateApplicationVersionInput{
+		ApplicationName: p.ApplicationName,
+		VersionLabel:    aws.String(label),
+		Description:     p.Description,
+		Process:         p.Process,
+		Tags:            GenerateTags(p.Tags),
+	}
+	if p.SourceBundle != nil {
+		in.SourceBundle = &elasticbeanstalk.S3Location{
+			S3Bucket: p.SourceBundle.S3Bucket,
+			S3Key:    aws.String(p.SourceBundle.S3Key),
+		}
+	}
+	return in
+}
+
+// LateInitializeApplicationVersion fills the empty fields in
+// *v1alpha1.ApplicationVersionParameters with the values seen in
+// elasticbeanstalk.ApplicationVersionDescription.
+func LateInitializeApplicationVersion(in *v1alpha1.ApplicationVersionParameters, v *elasticbeanstalk.ApplicationVersionDescription) {
+	if v == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, v.Description)
+}
+
+// IsApplicationVersionUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsApplicationVersionUpToDate(p v1alpha1.ApplicationVersionParameters, v elasticbeanstalk.ApplicationVersionDescription) bool {
+	return aws.StringValue(p.Description) == aws.StringValue(v.Description)
+}
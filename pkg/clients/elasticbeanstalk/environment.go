@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticbeanstalk
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// EnvironmentClient is the external client used for Environment Custom
+// Resource
+type EnvironmentClient interface {
+	CreateEnvironmentRequest(*elasticbeanstalk.CreateEnvironmentInput) elasticbeanstalk.CreateEnvironmentRequest
+	DescribeEnvironmentsRequest(*elasticbeanstalk.DescribeEnvironmentsInput) elasticbeanstalk.DescribeEnvironmentsRequest
+	DescribeConfigurationSettingsRequest(*elasticbeanstalk.DescribeConfigurationSettingsInput) elasticbeanstalk.DescribeConfigurationSettingsRequest
+	UpdateEnvironmentRequest(*elasticbeanstalk.UpdateEnvironmentInput) elasticbeanstalk.UpdateEnvironmentRequest
+	TerminateEnvironmentRequest(*elasticbeanstalk.TerminateEnvironmentInput) elasticbeanstalk.TerminateEnvironmentRequest
+}
+
+// NewEnvironmentClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewEnvironmentClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (EnvironmentClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return elasticbeanstalk.New(*cfg), err
+}
+
+// GenerateOptionSettings converts the supplied option settings to their
+// Elastic Beanstalk representation.
+func GenerateOptionSettings(in []v1alpha1.OptionSetting) []elasticbeanstalk.ConfigurationOptionSetting {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]elasticbeanstalk.ConfigurationOptionSetting, len(in))
+	for i, o := range in {
+		out[i] = elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:    aws.String(o.Namespace),
+			OptionName:   aws.String(o.OptionName),
+			ResourceName: o.ResourceName,
+			Value:        aws.String(o.Value),
+		}
+	}
+	return out
+}
+
+// GenerateCreateEnvironmentInput returns the input to create the environment
+// with the given name from the supplied parameters.
+func GenerateCreateEnvironmentInput(name string, p v1alpha1.EnvironmentParameters) *elasticbeanstalk.CreateEnvironmentInput {
+	in := &elasticbeanstalk.CreateEnvironmentInput{
+		ApplicationName:   p.ApplicationName,
+		EnvironmentName:   aws.String(name),
+		VersionLabel:      p.VersionLabel,
+		Description:       p.Description,
+		CNAMEPrefix:       p.CNAMEPrefix,
+		SolutionStackName: p.SolutionStackName,
+		PlatformArn:       p.PlatformARN,
+		TemplateName:      p.TemplateName,
+		OptionSettings:    GenerateOptionSettings(p.OptionSettings),
+		Tags:              GenerateTags(p.Tags),
+	}
+	if p.Tier != nil {
+		in.Tier = &elasticbeanstalk.EnvironmentTier{Name: aws.String(p.Tier.Name), Type: aws.String(p.Tier.Type)}
+	}
+	return in
+}
+
+// GenerateUpdateEnvironmentInput returns the input to update the environment
+// with the given name from the supplied parameters.
+func GenerateUpdateEnvironmentInput(name string, p v1alpha1.EnvironmentParameters) *elasticbeanstalk.UpdateEnvironmentInput {
+	return &elasticbeanstalk.UpdateEnvironmentInput{
+		ApplicationName:   p.ApplicationName,
+		EnvironmentName:   aws.String(name),
+		VersionLabel:      p.VersionLabel,
+		Description:       p.Description,
+		SolutionStackName: p.SolutionStackName,
+		PlatformArn:       p.PlatformARN,
+		OptionSettings:    GenerateOptionSettings(p.OptionSettings),
+	}
+}
+
+// GenerateEnvironmentObservation is used to produce
+// v1alpha1.EnvironmentObservation from elasticbeanstalk.EnvironmentDescription.
+func GenerateEnvironmentObservation(e elasticbeanstalk.EnvironmentDescription) v1alpha1.EnvironmentObservation {
+	return v1alpha1.EnvironmentObservation{
+		EnvironmentID: aws.StringValue(e.EnvironmentId),
+		ARN:           aws.StringValue(e.EnvironmentArn),
+		CNAME:         aws.StringValue(e.CNAME),
+		EndpointURL:   aws.StringValue(e.EndpointURL),
+		Status:        string(e.Status),
+		Health:        string(e.Health),
+	}
+}
+
+// LateInitializeEnvironment fills the empty fields in
+// *v1alpha1.EnvironmentParameters with the values seen in
+// elasticbeanstalk.EnvironmentDescription. The platform is not late
+// initialized since only one of the solution stack and the platform ARN may be
+// supplied.
+func LateInitializeEnvironment(in *v1alpha1.EnvironmentParameters, e *elasticbeanstalk.EnvironmentDescription) {
+	if e == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, e.Description)
+	in.VersionLabel = awsclients.LateInitializeStringPtr(in.VersionLabel, e.VersionLabel)
+	if in.Tier == nil && e.Tier != nil {
+		in.Tier = &v1alpha1.EnvironmentTier{Name: aws.StringValue(e.Tier.Name), Type: aws.StringValue(e.Tier.Type)}
+	}
+}
+
+func optionKey(namespace, name, resource *string) string {
+	return aws.StringValue(namespace) + ":" + aws.StringValue(name) + ":" + aws.StringValue(resource)
+}
+
+// IsEnvironmentUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource. Only the option
+// settings listed in the parameters are compared.
+func IsEnvironmentUpToDate(p v1alpha1.EnvironmentParameters, e elasticbeanstalk.EnvironmentDescription, settings []elasticbeanstalk.ConfigurationOptionSetting) bool {
+	if aws.StringValue(p.Description) != aws.StringValue(e.Description) ||
+		aws.StringValue(p.VersionLabel) != aws.StringValue(e.VersionLabel) {
+		return false
+	}
+	if p.SolutionStackName != nil && aws.StringValue(p.SolutionStackName) != aws.StringValue(e.SolutionStackName) {
+		return false
+	}
+	if p.PlatformARN != nil && aws.StringValue(p.PlatformARN) != aws.StringValue(e.PlatformArn) {
+		return false
+	}
+	observed := make(map[string]string, len(settings))
+	for _, s := range settings {
+		observed[optionKey(s.Namespace, s.OptionName, s.ResourceName)] = aws.StringValue(s.Value)
+	}
+	for _, o := range p.OptionSettings {
+		v, ok := observed[optionKey(aws.String(o.Namespace), aws.String(o.OptionName), o.ResourceName)]
+		if !ok || v != o.Value {
+			return false
+		}
+	}
+	return true
+}
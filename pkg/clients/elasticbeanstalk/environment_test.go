@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticbeanstalk
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+)
+
+func TestIsEnvironmentUpToDate(t *testing.T) {
+	env := elasticbeanstalk.EnvironmentDescription{
+		Description:       aws.String("web"),
+		VersionLabel:      aws.String("v1"),
+		SolutionStackName: aws.String("64bit Amazon Linux 2 v3.1.0 running Go 1"),
+		PlatformArn:       aws.String("arn:aws:elasticbeanstalk:us-east-1::platform/Go 1 running on 64bit Amazon Linux 2/3.1.0"),
+	}
+	settings := []elasticbeanstalk.ConfigurationOptionSetting{
+		{Namespace: aws.String("aws:autoscaling:asg"), OptionName: aws.String("MinSize"), Value: aws.String("1")},
+		{Namespace: aws.String("aws:autoscaling:asg"), OptionName: aws.String("MaxSize"), Value: aws.String("4")},
+		{Namespace: aws.String("aws:autoscaling:scheduledaction"), OptionName: aws.String("MinSize"), ResourceName: aws.String("night"), Value: aws.String("0")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.EnvironmentParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.EnvironmentParameters{
+				Description:       aws.String("web"),
+				VersionLabel:      aws.String("v1"),
+				SolutionStackName: aws.String("64bit Amazon Linux 2 v3.1.0 running Go 1"),
+				OptionSettings: []v1alpha1.OptionSetting{
+					{Namespace: "aws:autoscaling:asg", OptionName: "MaxSize", Value: "4"},
+					{Namespace: "aws:autoscaling:scheduledaction", OptionName: "MinSize", ResourceName: aws.String("night"), Value: "0"},
+				},
+			},
+			want: true,
+		},
+		"VersionChanged": {
+			p: v1alpha1.EnvironmentParameters{
+				Description:  aws.String("web"),
+				VersionLabel: aws.String("v2"),
+			},
+			want: false,
+		},
+		"OptionChanged": {
+			p: v1alpha1.EnvironmentParameters{
+				Description:  aws.String("web"),
+				VersionLabel: aws.String("v1"),
+				OptionSettings: []v1alpha1.OptionSetting{
+					{Namespace: "aws:autoscaling:asg", OptionName: "MaxSize", Value: "8"},
+				},
+			},
+			want: false,
+		},
+		"OptionMissing": {
+			p: v1alpha1.EnvironmentParameters{
+				Description:  aws.String("web"),
+				VersionLabel: aws.String("v1"),
+				OptionSettings: []v1alpha1.OptionSetting{
+					{Namespace: "aws:elasticbeanstalk:environment", OptionName: "LoadBalancerType", Value: "application"},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEnvironmentUpToDate(tc.p, env, settings)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ApplicationVersionClient = (*MockApplicationVersionClient)(nil)
+
+// MockApplicationVersionClient is a type that implements all the methods for ApplicationVersionClient interface
+type MockApplicationVersionClient struct {
+	MockCreateApplicationVersion    func(*elasticbeanstalk.CreateApplicationVersionInput) elasticbeanstalk.CreateApplicationVersionRequest
+	MockDescribeApplicationVersions func(*elasticbeanstalk.DescribeApplicationVersionsInput) elasticbeanstalk.DescribeApplicationVersionsRequest
+	MockUpdateApplicationVersion    func(*elasticbeanstalk.UpdateApplicationVersionInput) elasticbeanstalk.UpdateApplicationVersionRequest
+	MockDeleteApplicationVersion    func(*elasticbeanstalk.DeleteApplicationVersionInput) elasticbeanstalk.DeleteApplicationVersionRequest
+}
+
+// CreateApplicationVersionRequest calls the underlying MockCreateApplicationVersion method.
+func (c *MockApplicationVersionClient) CreateApplicationVersionRequest(i *elasticbeanstalk.CreateApplicationVersionInput) elasticbeanstalk.CreateApplicationVersionRequest {
+	return c.MockCreateApplicationVersion(i)
+}
+
+// DescribeApplicationVersionsRequest calls the underlying MockDescribeApplicationVersions method.
+func (c *MockApplicationVersionClient) DescribeApplicationVersionsRequest(i *elasticbeanstalk.DescribeApplicationVersionsInput) elasticbeanstalk.DescribeApplicationVersionsRequest {
+	return c.MockDescribeApplicationVersions(i)
+}
+
+// UpdateApplicationVersionRequest calls the underlying MockUpdateApplicationVersion method.
+func (c *MockApplicationVersionClient) UpdateApplicationVersionRequest(i *elasticbeanstalk.UpdateApplicationVersionInput) elasticbeanstalk.UpdateApplicationVersionRequest {
+	return c.MockUpdateApplicationVersion(i)
+}
+
+// DeleteApplicationVersionRequest calls the underlying MockDeleteApplicationVersion method.
+func (c *MockApplicationVersionClient) DeleteApplicationVersionRequest(i *elasticbeanstalk.DeleteApplicationVersionInput) elasticbeanstalk.DeleteApplicationVersionRequest {
+	return c.MockDeleteApplicationVersion(i)
+}
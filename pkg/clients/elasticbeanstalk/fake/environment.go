@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EnvironmentClient = (*MockEnvironmentClient)(nil)
+
+// MockEnvironmentClient is a type that implements all the methods for EnvironmentClient interface
+type MockEnvironmentClient struct {
+	MockCreateEnvironment             func(*elasticbeanstalk.CreateEnvironmentInput) elasticbeanstalk.CreateEnvironmentRequest
+	MockDescribeEnvironments          func(*elasticbeanstalk.DescribeEnvironmentsInput) elasticbeanstalk.DescribeEnvironmentsRequest
+	MockDescribeConfigurationSettings func(*elasticbeanstalk.DescribeConfigurationSettingsInput) elasticbeanstalk.DescribeConfigurationSettingsRequest
+	MockUpdateEnvironment             func(*elasticbeanstalk.UpdateEnvironmentInput) elasticbeanstalk.UpdateEnvironmentRequest
+	MockTerminateEnvironment          func(*elasticbeanstalk.TerminateEnvironmentInput) elasticbeanstalk.TerminateEnvironmentRequest
+}
+
+// CreateEnvironmentRequest calls the underlying MockCreateEnvironment method.
+func (c *MockEnvironmentClient) CreateEnvironmentRequest(i *elasticbeanstalk.CreateEnvironmentInput) elasticbeanstalk.CreateEnvironmentRequest {
+	return c.MockCreateEnvironment(i)
+}
+
+// DescribeEnvironmentsRequest calls the underlying MockDescribeEnvironments method.
+func (c *MockEnvironmentClient) DescribeEnvironmentsRequest(i *elasticbeanstalk.DescribeEnvironmentsInput) elasticbeanstalk.DescribeEnvironmentsRequest {
+	return c.MockDescribeEnvironments(i)
+}
+
+// DescribeConfigurationSettingsRequest calls the underlying MockDescribeConfigurationSettings method.
+func (c *MockEnvironmentClient) DescribeConfigurationSettingsRequest(i *elasticbeanstalk.DescribeConfigurationSettingsInput) elasticbeanstalk.DescribeConfigurationSettingsRequest {
+	return c.MockDescribeConfigurationSettings(i)
+}
+
+// UpdateEnvironmentRequest calls the underlying MockUpdateEnvironment method.
+func (c *MockEnvironmentClient) UpdateEnvironmentRequest(i *elasticbeanstalk.UpdateEnvironmentInput) elasticbeanstalk.UpdateEnvironmentRequest {
+	return c.MockUpdateEnvironment(i)
+}
+
+// TerminateEnvironmentRequest calls the underlying MockTerminateEnvironment method.
+func (c *MockEnvironmentClient) TerminateEnvironmentRequest(i *elasticbeanstalk.TerminateEnvironmentInput) elasticbeanstalk.TerminateEnvironmentRequest {
+	return c.MockTerminateEnvironment(i)
+}
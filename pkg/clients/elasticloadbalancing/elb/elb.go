@@ -185,10 +185,10 @@ func BuildELBListeners(listeners []v1alpha1.Listener) []elb.Listener {
 		elbListeners := []elb.Listener{}
 		for _, v := range listeners {
 			elbListeners = append(elbListeners, elb.Listener{
-				InstancePort:     &v.InstancePort,
+				InstancePort:     aws.Int64(v.InstancePort),
 				InstanceProtocol: v.InstanceProtocol,
-				LoadBalancerPort: &v.LoadBalancerPort,
-				Protocol:         &v.Protocol,
+				LoadBalancerPort: aws.Int64(v.LoadBalancerPort),
+				Protocol:         aws.String(v.Protocol),
 				SSLCertificateId: v.SSLCertificateID,
 			})
 		}
@@ -22,8 +22,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/proptest"
 )
 
 var (
@@ -378,3 +380,23 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestListenerProperties(t *testing.T) {
+	l := []v1alpha1.Listener{}
+	proptest.Check(t, &l, func() string {
+		got := []v1alpha1.Listener{}
+		for _, v := range BuildELBListeners(l) {
+			got = append(got, v1alpha1.Listener{
+				InstancePort:     aws.Int64Value(v.InstancePort),
+				InstanceProtocol: v.InstanceProtocol,
+				LoadBalancerPort: aws.Int64Value(v.LoadBalancerPort),
+				Protocol:         aws.StringValue(v.Protocol),
+				SSLCertificateID: v.SSLCertificateId,
+			})
+		}
+		if diff := cmp.Diff(l, got, cmpopts.EquateEmpty()); diff != "" {
+			return "Listeners should be built from the listeners of the parameters: -want, +got:\n" + diff
+		}
+		return ""
+	})
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LoadBalancerClient = (*MockLoadBalancerClient)(nil)
+
+// MockLoadBalancerClient is a type that implements all the methods for LoadBalancerClient interface
+type MockLoadBalancerClient struct {
+	MockDescribeLoadBalancers func(*elasticloadbalancingv2.DescribeLoadBalancersInput) elasticloadbalancingv2.DescribeLoadBalancersRequest
+	MockCreateLoadBalancer    func(*elasticloadbalancingv2.CreateLoadBalancerInput) elasticloadbalancingv2.CreateLoadBalancerRequest
+	MockDeleteLoadBalancer    func(*elasticloadbalancingv2.DeleteLoadBalancerInput) elasticloadbalancingv2.DeleteLoadBalancerRequest
+	MockSetSubnets            func(*elasticloadbalancingv2.SetSubnetsInput) elasticloadbalancingv2.SetSubnetsRequest
+	MockSetSecurityGroups     func(*elasticloadbalancingv2.SetSecurityGroupsInput) elasticloadbalancingv2.SetSecurityGroupsRequest
+	MockSetIpAddressType      func(*elasticloadbalancingv2.SetIpAddressTypeInput) elasticloadbalancingv2.SetIpAddressTypeRequest
+	MockDescribeListeners     func(*elasticloadbalancingv2.DescribeListenersInput) elasticloadbalancingv2.DescribeListenersRequest
+	MockCreateListener        func(*elasticloadbalancingv2.CreateListenerInput) elasticloadbalancingv2.CreateListenerRequest
+	MockModifyListener        func(*elasticloadbalancingv2.ModifyListenerInput) elasticloadbalancingv2.ModifyListenerRequest
+	MockDeleteListener        func(*elasticloadbalancingv2.DeleteListenerInput) elasticloadbalancingv2.DeleteListenerRequest
+	MockDescribeTags          func(*elasticloadbalancingv2.DescribeTagsInput) elasticloadbalancingv2.DescribeTagsRequest
+	MockAddTags               func(*elasticloadbalancingv2.AddTagsInput) elasticloadbalancingv2.AddTagsRequest
+	MockRemoveTags            func(*elasticloadbalancingv2.RemoveTagsInput) elasticloadbalancingv2.RemoveTagsRequest
+}
+
+// DescribeLoadBalancersRequest calls the underlying MockDescribeLoadBalancers method.
+func (c *MockLoadBalancerClient) DescribeLoadBalancersRequest(i *elasticloadbalancingv2.DescribeLoadBalancersInput) elasticloadbalancingv2.DescribeLoadBalancersRequest {
+	return c.MockDescribeLoadBalancers(i)
+}
+
+// CreateLoadBalancerRequest calls the underlying MockCreateLoadBalancer method.
+func (c *MockLoadBalancerClient) CreateLoadBalancerRequest(i *elasticloadbalancingv2.CreateLoadBalancerInput) elasticloadbalancingv2.CreateLoadBalancerRequest {
+	return c.MockCreateLoadBalancer(i)
+}
+
+// DeleteLoadBalancerRequest calls the underlying MockDeleteLoadBalancer method.
+func (c *MockLoadBalancerClient) DeleteLoadBalancerRequest(i *elasticloadbalancingv2.DeleteLoadBalancerInput) elasticloadbalancingv2.DeleteLoadBalancerRequest {
+	return c.MockDeleteLoadBalancer(i)
+}
+
+// SetSubnetsRequest calls the underlying MockSetSubnets method.
+func (c *MockLoadBalancerClient) SetSubnetsRequest(i *elasticloadbalancingv2.SetSubnetsInput) elasticloadbalancingv2.SetSubnetsRequest {
+	return c.MockSetSubnets(i)
+}
+
+// SetSecurityGroupsRequest calls the underlying MockSetSecurityGroups method.
+func (c *MockLoadBalancerClient) SetSecurityGroupsRequest(i *elasticloadbalancingv2.SetSecurityGroupsInput) elasticloadbalancingv2.SetSecurityGroupsRequest {
+	return c.MockSetSecurityGroups(i)
+}
+
+// SetIpAddressTypeRequest calls the underlying MockSetIpAddressType method.
+func (c *MockLoadBalancerClient) SetIpAddressTypeRequest(i *elasticloadbalancingv2.SetIpAddressTypeInput) elasticloadbalancingv2.SetIpAddressTypeRequest {
+	return c.MockSetIpAddressType(i)
+}
+
+// DescribeListenersRequest calls the underlying MockDescribeListeners method.
+func (c *MockLoadBalancerClient) DescribeListenersRequest(i *elasticloadbalancingv2.DescribeListenersInput) elasticloadbalancingv2.DescribeListenersRequest {
+	return c.MockDescribeListeners(i)
+}
+
+// CreateListenerRequest calls the underlying MockCreateListener method.
+func (c *MockLoadBalancerClient) CreateListenerRequest(i *elasticloadbalancingv2.CreateListenerInput) elasticloadbalancingv2.CreateListenerRequest {
+	return c.MockCreateListener(i)
+}
+
+// ModifyListenerRequest calls the underlying MockModifyListener method.
+func (c *MockLoadBalancerClient) ModifyListenerRequest(i *elasticloadbalancingv2.ModifyListenerInput) elasticloadbalancingv2.ModifyListenerRequest {
+	return c.MockModifyListener(i)
+}
+
+// DeleteListenerRequest calls the underlying MockDeleteListener method.
+func (c *MockLoadBalancerClient) DeleteListenerRequest(i *elasticloadbalancingv2.DeleteListenerInput) elasticloadbalancingv2.DeleteListenerRequest {
+	return c.MockDeleteListener(i)
+}
+
+// DescribeTagsRequest calls the underlying MockDescribeTags method.
+func (c *MockLoadBalancerClient) DescribeTagsRequest(i *elasticloadbalancingv2.DescribeTagsInput) elasticloadbalancingv2.DescribeTagsRequest {
+	return c.MockDescribeTags(i)
+}
+
+// AddTagsRequest calls the underlying MockAddTags method.
+func (c *MockLoadBalancerClient) AddTagsRequest(i *elasticloadbalancingv2.AddTagsInput) elasticloadbalancingv2.AddTagsRequest {
+	return c.MockAddTags(i)
+}
+
+// RemoveTagsRequest calls the underlying MockRemoveTags method.
+func (c *MockLoadBalancerClient) RemoveTagsRequest(i *elasticloadbalancingv2.RemoveTagsInput) elasticloadbalancingv2.RemoveTagsRequest {
+	return c.MockRemoveTags(i)
+}
@@ -0,0 +1,385 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// LoadBalancerClient is the external client used for LoadBalancer Custom
+// Resource
+type LoadBalancerClient interface {
+	DescribeLoadBalancersRequest(*elbv2.DescribeLoadBalancersInput) elbv2.DescribeLoadBalancersRequest
+	CreateLoadBalancerRequest(*elbv2.CreateLoadBalancerInput) elbv2.CreateLoadBalancerRequest
+	DeleteLoadBalancerRequest(*elbv2.DeleteLoadBalancerInput) elbv2.DeleteLoadBalancerRequest
+	SetSubnetsRequest(*elbv2.SetSubnetsInput) elbv2.SetSubnetsRequest
+	SetSecurityGroupsRequest(*elbv2.SetSecurityGroupsInput) elbv2.SetSecurityGroupsRequest
+	SetIpAddressTypeRequest(*elbv2.SetIpAddressTypeInput) elbv2.SetIpAddressTypeRequest
+	DescribeListenersRequest(*elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest
+	CreateListenerRequest(*elbv2.CreateListenerInput) elbv2.CreateListenerRequest
+	ModifyListenerRequest(*elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest
+	DeleteListenerRequest(*elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest
+	DescribeTagsRequest(*elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest
+	AddTagsRequest(*elbv2.AddTagsInput) elbv2.AddTagsRequest
+	RemoveTagsRequest(*elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest
+}
+
+// NewLoadBalancerClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewLoadBalancerClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (LoadBalancerClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return elbv2.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the load balancer doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == elbv2.ErrCodeLoadBalancerNotFoundException
+	}
+	return false
+}
+
+// IsListenerNotFound returns true if the error is because the listener
+// doesn't exist.
+func IsListenerNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == elbv2.ErrCodeListenerNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateLoadBalancerInput returns the input to create a load balancer
+// with the supplied name. Listeners are created once the load balancer
+// exists, since they refer to its ARN.
+func GenerateCreateLoadBalancerInput(name string, p v1alpha1.LoadBalancerParameters) *elbv2.CreateLoadBalancerInput {
+	return &elbv2.CreateLoadBalancerInput{
+		Name:           aws.String(name),
+		Type:           elbv2.LoadBalancerTypeEnum(aws.StringValue(p.Type)),
+		Scheme:         elbv2.LoadBalancerSchemeEnum(aws.StringValue(p.Scheme)),
+		IpAddressType:  elbv2.IpAddressType(aws.StringValue(p.IPAddressType)),
+		Subnets:        p.SubnetIDs,
+		SecurityGroups: p.SecurityGroupIDs,
+		Tags:           BuildTags(p.Tags),
+	}
+}
+
+// GenerateLoadBalancerObservation is used to produce
+// v1alpha1.LoadBalancerObservation from elbv2.LoadBalancer.
+func GenerateLoadBalancerObservation(lb elbv2.LoadBalancer) v1alpha1.LoadBalancerObservation {
+	o := v1alpha1.LoadBalancerObservation{
+		LoadBalancerARN:       aws.StringValue(lb.LoadBalancerArn),
+		DNSName:               aws.StringValue(lb.DNSName),
+		CanonicalHostedZoneID: aws.StringValue(lb.CanonicalHostedZoneId),
+		VPCID:                 aws.StringValue(lb.VpcId),
+	}
+	if lb.State != nil {
+		o.State = string(lb.State.Code)
+	}
+	for _, az := range lb.AvailabilityZones {
+		o.AvailabilityZones = append(o.AvailabilityZones, aws.StringValue(az.ZoneName))
+	}
+	return o
+}
+
+// LateInitializeLoadBalancer fills the empty fields in
+// *v1alpha1.LoadBalancerParameters with the values seen in elbv2.LoadBalancer,
+// its listeners and its tags.
+func LateInitializeLoadBalancer(in *v1alpha1.LoadBalancerParameters, lb *elbv2.LoadBalancer, listeners []elbv2.Listener, tags []elbv2.Tag) {
+	if lb == nil {
+		return
+	}
+
+	in.Type = awsclients.LateInitializeStringPtr(in.Type, awsclients.String(string(lb.Type)))
+	in.Scheme = awsclients.LateInitializeStringPtr(in.Scheme, awsclients.String(string(lb.Scheme)))
+	in.IPAddressType = awsclients.LateInitializeStringPtr(in.IPAddressType, awsclients.String(string(lb.IpAddressType)))
+
+	if len(in.SubnetIDs) == 0 && len(lb.AvailabilityZones) != 0 {
+		in.SubnetIDs = subnetIDs(lb.AvailabilityZones)
+	}
+
+	if len(in.SecurityGroupIDs) == 0 && len(lb.SecurityGroups) != 0 {
+		in.SecurityGroupIDs = lb.SecurityGroups
+	}
+
+	if len(in.Listeners) == 0 && len(listeners) != 0 {
+		in.Listeners = make([]v1alpha1.Listener, len(listeners))
+		for i, l := range listeners {
+			in.Listeners[i] = GenerateListener(l)
+		}
+	}
+
+	if len(in.Tags) == 0 && len(tags) != 0 {
+		in.Tags = make([]v1alpha1.Tag, len(tags))
+		for i, t := range tags {
+			in.Tags[i] = v1alpha1.Tag{Key: aws.StringValue(t.Key), Value: t.Value}
+		}
+	}
+}
+
+// IsLoadBalancerUpToDate checks whether there is a change in any of the
+// modifiable fields of the load balancer, its listeners or its tags.
+func IsLoadBalancerUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer, listeners []elbv2.Listener, tags []elbv2.Tag) bool {
+	if p.IPAddressType != nil && aws.StringValue(p.IPAddressType) != string(lb.IpAddressType) {
+		return false
+	}
+	if !IsSubnetsUpToDate(p, lb) || !IsSecurityGroupsUpToDate(p, lb) {
+		return false
+	}
+	create, modify, remove := DiffListeners(aws.StringValue(lb.LoadBalancerArn), p.Listeners, listeners)
+	if len(create) != 0 || len(modify) != 0 || len(remove) != 0 {
+		return false
+	}
+	add, removeTags := DiffTags(p.Tags, tags)
+	return len(add) == 0 && len(removeTags) == 0
+}
+
+// IsSubnetsUpToDate checks whether the load balancer is attached to the
+// desired subnets, in any order.
+func IsSubnetsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return cmp.Equal(p.SubnetIDs, subnetIDs(lb.AvailabilityZones), sortStrings, cmpopts.EquateEmpty())
+}
+
+// IsSecurityGroupsUpToDate checks whether the load balancer has the desired
+// security groups, in any order.
+func IsSecurityGroupsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return cmp.Equal(p.SecurityGroupIDs, lb.SecurityGroups, sortStrings, cmpopts.EquateEmpty())
+}
+
+// GenerateListener is used to produce v1alpha1.Listener from elbv2.Listener.
+func GenerateListener(l elbv2.Listener) v1alpha1.Listener {
+	o := v1alpha1.Listener{
+		Port:      aws.Int64Value(l.Port),
+		Protocol:  string(l.Protocol),
+		SSLPolicy: l.SslPolicy,
+	}
+	for _, c := range l.Certificates {
+		// Only the default certificate of a listener is described with it.
+		if c.IsDefault == nil || aws.BoolValue(c.IsDefault) {
+			o.CertificateARN = c.CertificateArn
+			break
+		}
+	}
+	if len(l.DefaultActions) != 0 {
+		o.DefaultActions = make([]v1alpha1.Action, len(l.DefaultActions))
+		for i, a := range l.DefaultActions {
+			o.DefaultActions[i] = generateAction(a)
+		}
+	}
+	return o
+}
+
+func generateAction(a elbv2.Action) v1alpha1.Action {
+	o := v1alpha1.Action{
+		Type:           string(a.Type),
+		Order:          a.Order,
+		TargetGroupARN: a.TargetGroupArn,
+	}
+	if c := a.FixedResponseConfig; c != nil {
+		o.FixedResponseConfig = &v1alpha1.FixedResponseConfig{
+			ContentType: c.ContentType,
+			MessageBody: c.MessageBody,
+			StatusCode:  aws.StringValue(c.StatusCode),
+		}
+	}
+	if c := a.RedirectConfig; c != nil {
+		o.RedirectConfig = &v1alpha1.RedirectConfig{
+			Host:       c.Host,
+			Path:       c.Path,
+			Port:       c.Port,
+			Protocol:   c.Protocol,
+			Query:      c.Query,
+			StatusCode: string(c.StatusCode),
+		}
+	}
+	return o
+}
+
+// BuildActions builds a list of elbv2.Action from given list of
+// v1alpha1.Action.
+func BuildActions(actions []v1alpha1.Action) []elbv2.Action {
+	if len(actions) == 0 {
+		return nil
+	}
+	res := make([]elbv2.Action, len(actions))
+	for i, a := range actions {
+		res[i] = elbv2.Action{
+			Type:           elbv2.ActionTypeEnum(a.Type),
+			Order:          a.Order,
+			TargetGroupArn: a.TargetGroupARN,
+		}
+		if c := a.FixedResponseConfig; c != nil {
+			res[i].FixedResponseConfig = &elbv2.FixedResponseActionConfig{
+				ContentType: c.ContentType,
+				MessageBody: c.MessageBody,
+				StatusCode:  aws.String(c.StatusCode),
+			}
+		}
+		if c := a.RedirectConfig; c != nil {
+			res[i].RedirectConfig = &elbv2.RedirectActionConfig{
+				Host:       c.Host,
+				Path:       c.Path,
+				Port:       c.Port,
+				Protocol:   c.Protocol,
+				Query:      c.Query,
+				StatusCode: elbv2.RedirectActionStatusCodeEnum(c.StatusCode),
+			}
+		}
+	}
+	return res
+}
+
+func buildCertificates(l v1alpha1.Listener) []elbv2.Certificate {
+	if l.CertificateARN == nil {
+		return nil
+	}
+	return []elbv2.Certificate{{CertificateArn: l.CertificateARN}}
+}
+
+// IsListenerUpToDate checks whether the observed listener matches the
+// desired one. Fields that are not set in the desired listener, such as the
+// defaults AWS fills in for redirects, are not compared.
+func IsListenerUpToDate(l v1alpha1.Listener, observed elbv2.Listener) bool {
+	o := GenerateListener(observed)
+	desired := l.DeepCopy()
+	desired.SSLPolicy = awsclients.LateInitializeStringPtr(desired.SSLPolicy, o.SSLPolicy)
+	if len(desired.DefaultActions) == len(o.DefaultActions) {
+		for i := range desired.DefaultActions {
+			lateInitializeAction(&desired.DefaultActions[i], o.DefaultActions[i])
+		}
+	}
+	return cmp.Equal(*desired, o, cmpopts.EquateEmpty())
+}
+
+func lateInitializeAction(in *v1alpha1.Action, from v1alpha1.Action) {
+	in.Order = awsclients.LateInitializeInt64Ptr(in.Order, from.Order)
+	if in.FixedResponseConfig != nil && from.FixedResponseConfig != nil {
+		in.FixedResponseConfig.ContentType = awsclients.LateInitializeStringPtr(in.FixedResponseConfig.ContentType, from.FixedResponseConfig.ContentType)
+	}
+	if c := in.RedirectConfig; c != nil && from.RedirectConfig != nil {
+		c.Host = awsclients.LateInitializeStringPtr(c.Host, from.RedirectConfig.Host)
+		c.Path = awsclients.LateInitializeStringPtr(c.Path, from.RedirectConfig.Path)
+		c.Port = awsclients.LateInitializeStringPtr(c.Port, from.RedirectConfig.Port)
+		c.Protocol = awsclients.LateInitializeStringPtr(c.Protocol, from.RedirectConfig.Protocol)
+		c.Query = awsclients.LateInitializeStringPtr(c.Query, from.RedirectConfig.Query)
+	}
+}
+
+// DiffListeners returns the inputs to create, modify and delete listeners so
+// that the load balancer with the supplied ARN has the desired listeners.
+// Listeners are told apart by their port.
+func DiffListeners(arn string, desired []v1alpha1.Listener, observed []elbv2.Listener) (create []elbv2.CreateListenerInput, modify []elbv2.ModifyListenerInput, remove []elbv2.DeleteListenerInput) {
+	byPort := make(map[int64]elbv2.Listener, len(observed))
+	for _, l := range observed {
+		byPort[aws.Int64Value(l.Port)] = l
+	}
+	for _, l := range desired {
+		o, ok := byPort[l.Port]
+		delete(byPort, l.Port)
+		switch {
+		case !ok:
+			create = append(create, elbv2.CreateListenerInput{
+				LoadBalancerArn: aws.String(arn),
+				Port:            aws.Int64(l.Port),
+				Protocol:        elbv2.ProtocolEnum(l.Protocol),
+				Certificates:    buildCertificates(l),
+				SslPolicy:       l.SSLPolicy,
+				DefaultActions:  BuildActions(l.DefaultActions),
+			})
+		case !IsListenerUpToDate(l, o):
+			modify = append(modify, elbv2.ModifyListenerInput{
+				ListenerArn:    o.ListenerArn,
+				Port:           aws.Int64(l.Port),
+				Protocol:       elbv2.ProtocolEnum(l.Protocol),
+				Certificates:   buildCertificates(l),
+				SslPolicy:      l.SSLPolicy,
+				DefaultActions: BuildActions(l.DefaultActions),
+			})
+		}
+	}
+	for _, l := range observed {
+		if _, ok := byPort[aws.Int64Value(l.Port)]; ok {
+			remove = append(remove, elbv2.DeleteListenerInput{ListenerArn: l.ListenerArn})
+		}
+	}
+	return create, modify, remove
+}
+
+// BuildTags generates a list of elbv2.Tag from given list of v1alpha1.Tag
+func BuildTags(tags []v1alpha1.Tag) []elbv2.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]elbv2.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = elbv2.Tag{Key: aws.String(t.Key), Value: t.Value}
+	}
+	return res
+}
+
+// DiffTags returns the tags to add to and the keys of the tags to remove from
+// the observed tags so that they match the desired tags. Adding a tag
+// overwrites the value of an existing tag with the same key.
+func DiffTags(desired []v1alpha1.Tag, observed []elbv2.Tag) (add []elbv2.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = aws.StringValue(t.Value)
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, removeKeys := awsclients.DiffTags(local, remote)
+	for _, k := range removeKeys {
+		if _, ok := local[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	for k, v := range addMap {
+		add = append(add, elbv2.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Strings(remove)
+	sort.Slice(add, func(i, j int) bool {
+		return aws.StringValue(add[i].Key) < aws.StringValue(add[j].Key)
+	})
+	return add, remove
+}
+
+var sortStrings = cmpopts.SortSlices(func(a, b string) bool { return a < b })
+
+func subnetIDs(zones []elbv2.AvailabilityZone) []string {
+	var ids []string
+	for _, az := range zones {
+		if az.SubnetId != nil {
+			ids = append(ids, aws.StringValue(az.SubnetId))
+		}
+	}
+	return ids
+}
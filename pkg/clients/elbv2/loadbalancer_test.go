@@ -0,0 +1,410 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/proptest"
+)
+
+var (
+	lbARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/some-lb/50dc6c495c0c9188"
+	listenerARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/some-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	targetGroup = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/some-tg/73e2d6bc24d8a067"
+	subnets     = []string{"subnet-1", "subnet-2"}
+	sgs         = []string{"sg-1"}
+
+	listener = v1alpha1.Listener{
+		Port:     80,
+		Protocol: "HTTP",
+		DefaultActions: []v1alpha1.Action{{
+			Type:           "forward",
+			TargetGroupARN: aws.String(targetGroup),
+		}},
+	}
+	awsListener = elbv2.Listener{
+		ListenerArn: aws.String(listenerARN),
+		Port:        aws.Int64(80),
+		Protocol:    elbv2.ProtocolEnumHttp,
+		DefaultActions: []elbv2.Action{{
+			Type:           elbv2.ActionTypeEnumForward,
+			Order:          aws.Int64(1),
+			TargetGroupArn: aws.String(targetGroup),
+		}},
+	}
+	tags    = []v1alpha1.Tag{{Key: "k1", Value: aws.String("v1")}}
+	awsTags = []elbv2.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}}
+)
+
+func loadBalancer() elbv2.LoadBalancer {
+	return elbv2.LoadBalancer{
+		LoadBalancerArn: aws.String(lbARN),
+		Type:            elbv2.LoadBalancerTypeEnumApplication,
+		Scheme:          elbv2.LoadBalancerSchemeEnumInternetFacing,
+		IpAddressType:   elbv2.IpAddressTypeIpv4,
+		AvailabilityZones: []elbv2.AvailabilityZone{
+			{ZoneName: aws.String("us-east-1a"), SubnetId: aws.String(subnets[1])},
+			{ZoneName: aws.String("us-east-1b"), SubnetId: aws.String(subnets[0])},
+		},
+		SecurityGroups: sgs,
+	}
+}
+
+func params() v1alpha1.LoadBalancerParameters {
+	return v1alpha1.LoadBalancerParameters{
+		Type:             aws.String("application"),
+		Scheme:           aws.String("internet-facing"),
+		IPAddressType:    aws.String("ipv4"),
+		SubnetIDs:        subnets,
+		SecurityGroupIDs: sgs,
+		Listeners:        []v1alpha1.Listener{listener},
+		Tags:             tags,
+	}
+}
+
+func TestLateInitializeLoadBalancer(t *testing.T) {
+	type args struct {
+		in        v1alpha1.LoadBalancerParameters
+		lb        elbv2.LoadBalancer
+		listeners []elbv2.Listener
+		tags      []elbv2.Tag
+	}
+	want := params()
+	want.SubnetIDs = []string{subnets[1], subnets[0]}
+	want.Listeners[0].DefaultActions = []v1alpha1.Action{{
+		Type:           "forward",
+		Order:          aws.Int64(1),
+		TargetGroupARN: aws.String(targetGroup),
+	}}
+
+	cases := map[string]struct {
+		args
+		want v1alpha1.LoadBalancerParameters
+	}{
+		"AllFilled": {
+			args: args{
+				in:        params(),
+				lb:        loadBalancer(),
+				listeners: []elbv2.Listener{awsListener},
+				tags:      awsTags,
+			},
+			want: params(),
+		},
+		"AllEmpty": {
+			args: args{
+				lb:        loadBalancer(),
+				listeners: []elbv2.Listener{awsListener},
+				tags:      awsTags,
+			},
+			want: want,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeLoadBalancer(&tc.args.in, &tc.args.lb, tc.args.listeners, tc.args.tags)
+			if diff := cmp.Diff(tc.want, tc.args.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLoadBalancerUpToDate(t *testing.T) {
+	type args struct {
+		p         v1alpha1.LoadBalancerParameters
+		listeners []elbv2.Listener
+		tags      []elbv2.Tag
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p:         params(),
+				listeners: []elbv2.Listener{awsListener},
+				tags:      awsTags,
+			},
+			want: true,
+		},
+		"IPAddressTypeChanged": {
+			args: args{
+				p: func() v1alpha1.LoadBalancerParameters {
+					p := params()
+					p.IPAddressType = aws.String("dualstack")
+					return p
+				}(),
+				listeners: []elbv2.Listener{awsListener},
+				tags:      awsTags,
+			},
+			want: false,
+		},
+		"SubnetsChanged": {
+			args: args{
+				p: func() v1alpha1.LoadBalancerParameters {
+					p := params()
+					p.SubnetIDs = []string{subnets[0]}
+					return p
+				}(),
+				listeners: []elbv2.Listener{awsListener},
+				tags:      awsTags,
+			},
+			want: false,
+		},
+		"ListenerMissing": {
+			args: args{
+				p:    params(),
+				tags: awsTags,
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			args: args{
+				p:         params(),
+				listeners: []elbv2.Listener{awsListener},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLoadBalancerUpToDate(tc.args.p, loadBalancer(), tc.args.listeners, tc.args.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsListenerUpToDate(t *testing.T) {
+	redirect := v1alpha1.Listener{
+		Port:     80,
+		Protocol: "HTTP",
+		DefaultActions: []v1alpha1.Action{{
+			Type: "redirect",
+			RedirectConfig: &v1alpha1.RedirectConfig{
+				Protocol:   aws.String("HTTPS"),
+				Port:       aws.String("443"),
+				StatusCode: "HTTP_301",
+			},
+		}},
+	}
+	awsRedirect := elbv2.Listener{
+		Port:     aws.Int64(80),
+		Protocol: elbv2.ProtocolEnumHttp,
+		DefaultActions: []elbv2.Action{{
+			Type:  elbv2.ActionTypeEnumRedirect,
+			Order: aws.Int64(1),
+			RedirectConfig: &elbv2.RedirectActionConfig{
+				Host:       aws.String("#{host}"),
+				Path:       aws.String("/#{path}"),
+				Port:       aws.String("443"),
+				Protocol:   aws.String("HTTPS"),
+				Query:      aws.String("#{query}"),
+				StatusCode: elbv2.RedirectActionStatusCodeEnumHttp301,
+			},
+		}},
+	}
+
+	cases := map[string]struct {
+		l        v1alpha1.Listener
+		observed elbv2.Listener
+		want     bool
+	}{
+		"UpToDate": {
+			l:        listener,
+			observed: awsListener,
+			want:     true,
+		},
+		"DefaultsIgnored": {
+			l:        redirect,
+			observed: awsRedirect,
+			want:     true,
+		},
+		"ProtocolChanged": {
+			l: func() v1alpha1.Listener {
+				l := *listener.DeepCopy()
+				l.Protocol = "HTTPS"
+				return l
+			}(),
+			observed: awsListener,
+			want:     false,
+		},
+		"ActionChanged": {
+			l:        redirect,
+			observed: awsListener,
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsListenerUpToDate(tc.l, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffListeners(t *testing.T) {
+	https := v1alpha1.Listener{
+		Port:           443,
+		Protocol:       "HTTPS",
+		CertificateARN: aws.String("some-cert"),
+		DefaultActions: listener.DefaultActions,
+	}
+	changed := *listener.DeepCopy()
+	changed.DefaultActions[0].TargetGroupARN = aws.String("other-tg")
+
+	type want struct {
+		create []elbv2.CreateListenerInput
+		modify []elbv2.ModifyListenerInput
+		remove []elbv2.DeleteListenerInput
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha1.Listener
+		observed []elbv2.Listener
+		want
+	}{
+		"NoChange": {
+			desired:  []v1alpha1.Listener{listener},
+			observed: []elbv2.Listener{awsListener},
+		},
+		"Create": {
+			desired:  []v1alpha1.Listener{listener, https},
+			observed: []elbv2.Listener{awsListener},
+			want: want{
+				create: []elbv2.CreateListenerInput{{
+					LoadBalancerArn: aws.String(lbARN),
+					Port:            aws.Int64(443),
+					Protocol:        elbv2.ProtocolEnumHttps,
+					Certificates:    []elbv2.Certificate{{CertificateArn: aws.String("some-cert")}},
+					DefaultActions:  []elbv2.Action{{Type: elbv2.ActionTypeEnumForward, TargetGroupArn: aws.String(targetGroup)}},
+				}},
+			},
+		},
+		"Modify": {
+			desired:  []v1alpha1.Listener{changed},
+			observed: []elbv2.Listener{awsListener},
+			want: want{
+				modify: []elbv2.ModifyListenerInput{{
+					ListenerArn:    aws.String(listenerARN),
+					Port:           aws.Int64(80),
+					Protocol:       elbv2.ProtocolEnumHttp,
+					DefaultActions: []elbv2.Action{{Type: elbv2.ActionTypeEnumForward, TargetGroupArn: aws.String("other-tg")}},
+				}},
+			},
+		},
+		"Remove": {
+			observed: []elbv2.Listener{awsListener},
+			want: want{
+				remove: []elbv2.DeleteListenerInput{{ListenerArn: aws.String(listenerARN)}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, modify, remove := DiffListeners(lbARN, tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modify, modify); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []elbv2.Tag
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []elbv2.Tag
+		want
+	}{
+		"NoChange": {
+			desired:  tags,
+			observed: awsTags,
+		},
+		"Add": {
+			desired:  append([]v1alpha1.Tag{{Key: "k2", Value: aws.String("v2")}}, tags...),
+			observed: awsTags,
+			want: want{
+				add: []elbv2.Tag{{Key: aws.String("k2"), Value: aws.String("v2")}},
+			},
+		},
+		"ValueChanged": {
+			desired:  []v1alpha1.Tag{{Key: "k1", Value: aws.String("v2")}},
+			observed: awsTags,
+			want: want{
+				add: []elbv2.Tag{{Key: aws.String("k1"), Value: aws.String("v2")}},
+			},
+		},
+		"Remove": {
+			observed: awsTags,
+			want: want{
+				remove: []string{"k1"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestActionProperties(t *testing.T) {
+	var a []v1alpha1.Action
+	proptest.Check(t, &a, func() string {
+		got := []v1alpha1.Action{}
+		for _, v := range BuildActions(a) {
+			got = append(got, generateAction(v))
+		}
+		if diff := cmp.Diff(a, got, cmpopts.EquateEmpty()); diff != "" {
+			return "Actions should be built from the actions of the listener: -want, +got:\n" + diff
+		}
+		return ""
+	})
+}
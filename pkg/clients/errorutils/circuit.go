@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorutils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ErrCodeCircuitOpen is the code of the errors returned for requests that are
+// rejected because the circuit breaker of their service is open.
+const ErrCodeCircuitOpen = "CircuitBreakerOpen"
+
+// TypeCircuitOpen resources cannot be synced until requests to an AWS service
+// they depend on are no longer backed off.
+const TypeCircuitOpen runtimev1alpha1.ConditionType = "CircuitOpen"
+
+// Reasons requests for a resource are or are not backed off.
+const (
+	ReasonBackingOff       runtimev1alpha1.ConditionReason = "BackingOff"
+	ReasonRequestSucceeded runtimev1alpha1.ConditionReason = "RequestSucceeded"
+)
+
+// A CircuitOpenError is returned for requests that are rejected without being
+// sent because too many recent requests to their service were throttled or
+// failed. It is an AWS error whose code is ErrCodeCircuitOpen.
+type CircuitOpenError struct {
+	// Service is the name of the AWS service requests are backed off from.
+	Service string
+
+	// Region is the AWS region requests are backed off from.
+	Region string
+
+	// Until is the time at which requests are sent again.
+	Until time.Time
+}
+
+// Code returns ErrCodeCircuitOpen.
+func (e *CircuitOpenError) Code() string { return ErrCodeCircuitOpen }
+
+// Message explains why the request was rejected.
+func (e *CircuitOpenError) Message() string {
+	return fmt.Sprintf("backing off requests to AWS service %s in region %s until %s because too many recent requests were throttled or failed",
+		e.Service, e.Region, e.Until.Format(time.RFC3339))
+}
+
+// OrigErr returns nil; a CircuitOpenError wraps no error.
+func (e *CircuitOpenError) OrigErr() error { return nil }
+
+func (e *CircuitOpenError) Error() string { return e.Code() + ": " + e.Message() }
+
+// CircuitOpen returns a condition that indicates requests for a resource are
+// backed off, as explained by the supplied error.
+func CircuitOpen(err *CircuitOpenError) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeCircuitOpen,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonBackingOff,
+		Message: fmt.Sprintf("Requests to AWS service %s in region %s are backed off until %s",
+			err.Service, err.Region, err.Until.Format(time.RFC3339)),
+	}
+}
+
+// CircuitClosed returns a condition that indicates requests for a resource are
+// no longer backed off.
+func CircuitClosed() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeCircuitOpen,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRequestSucceeded,
+	}
+}
+
+// circuitOpenError returns the CircuitOpenError the supplied error was caused
+// by, if any.
+func circuitOpenError(err error) (*CircuitOpenError, bool) {
+	ce, ok := errors.Cause(err).(*CircuitOpenError)
+	return ce, ok
+}
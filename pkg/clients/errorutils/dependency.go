@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorutils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TypeDeletionBlocked resources cannot be deleted until the resources that
+// depend on them are deleted.
+const TypeDeletionBlocked runtimev1alpha1.ConditionType = "DeletionBlocked"
+
+// ReasonDependencyViolation indicates that AWS refused to delete a resource
+// because other resources depend on it.
+const ReasonDependencyViolation runtimev1alpha1.ConditionReason = "DependencyViolation"
+
+// identifiers matches the ARNs and EC2 style identifiers (e.g. sg-0a1b2c3d)
+// that AWS includes in the messages of dependency errors.
+var identifiers = regexp.MustCompile(`arn:aws[a-z-]*:[^\s'",]+|\b[a-z]+-[0-9a-f]{8}(?:[0-9a-f]{9})?\b`)
+
+// DeletionBlocked returns a condition that indicates a resource cannot be
+// deleted until the supplied dependent resources are deleted.
+func DeletionBlocked(dependents []string) runtimev1alpha1.Condition {
+	msg := "Cannot be deleted until the resources that depend on it are deleted"
+	if len(dependents) > 0 {
+		msg = fmt.Sprintf("Cannot be deleted until these resources no longer depend on it: %s", strings.Join(dependents, ", "))
+	}
+	return runtimev1alpha1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDependencyViolation,
+		Message:            msg,
+	}
+}
+
+type dependencyError struct {
+	error
+	dependents []string
+}
+
+func (e *dependencyError) Cause() error { return e.error }
+
+// WithDependents annotates the supplied error with the identifiers of the
+// resources that depend on the resource the error concerns. Controllers
+// that can determine the dependent resources themselves use it to report
+// them more precisely than AWS error messages do.
+func WithDependents(err error, dependents ...string) error {
+	if err == nil || len(dependents) == 0 {
+		return err
+	}
+	return &dependencyError{error: err, dependents: dependents}
+}
+
+// Dependents returns the identifiers of the dependent resources the supplied
+// error was annotated with. If it was not annotated they are extracted from
+// its message instead.
+func Dependents(err error) []string {
+	for e := err; e != nil; {
+		if d, ok := e.(*dependencyError); ok {
+			return d.dependents
+		}
+		c, ok := e.(interface{ Cause() error })
+		if !ok {
+			break
+		}
+		e = c.Cause()
+	}
+	if err == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	var ids []string
+	for _, id := range identifiers.FindAllString(err.Error(), -1) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestDependents(t *testing.T) {
+	violation := awserr.New("DependencyViolation", "The vpc 'vpc-0a1b2c3d' has dependencies and cannot be deleted.", nil)
+
+	cases := map[string]struct {
+		err  error
+		want []string
+	}{
+		"Nil": {},
+		"NoIdentifiers": {
+			err: awserr.New("DependencyViolation", "resource has a dependent object", nil),
+		},
+		"FromMessage": {
+			err:  errors.Wrap(violation, "cannot delete"),
+			want: []string{"vpc-0a1b2c3d"},
+		},
+		"FromMessageSortedAndUnique": {
+			err:  errors.New("sg-0a1b2c3d4e5f6a7b8 is used by eni-0a1b2c3d, arn:aws:iam::123456789012:role/r and eni-0a1b2c3d"),
+			want: []string{"arn:aws:iam::123456789012:role/r", "eni-0a1b2c3d", "sg-0a1b2c3d4e5f6a7b8"},
+		},
+		"Annotated": {
+			err:  errors.Wrap(WithDependents(violation, "eni-1", "eni-2"), "cannot delete"),
+			want: []string{"eni-1", "eni-2"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Dependents(tc.err)); diff != "" {
+				t.Errorf("Dependents(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeletionBlocked(t *testing.T) {
+	violation := WithDependents(awserr.New("DependencyViolation", "", nil), "eni-1")
+
+	cases := map[string]struct {
+		err  error
+		want *fake.Managed
+	}{
+		"Blocked": {
+			err: violation,
+			want: &fake.Managed{
+				ConditionedStatus: runtimev1alpha1.ConditionedStatus{
+					Conditions: []runtimev1alpha1.Condition{DeletionBlocked([]string{"eni-1"})},
+				},
+			},
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: &fake.Managed{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					DeleteFn: func(_ context.Context, _ resource.Managed) error { return tc.err },
+				}, nil
+			})
+			e, _ := NewConnecter(c).Connect(context.Background(), mg)
+			_ = e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package errorutils classifies the errors returned by AWS APIs so that
+// controllers handle them consistently regardless of the service.
+package errorutils
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/pkg/errors"
+)
+
+// A Category groups AWS error codes that call for the same handling.
+type Category string
+
+// Error categories.
+const (
+	// Unknown errors do not belong to any other category.
+	Unknown Category = ""
+
+	// NotFound errors indicate that the requested resource does not exist.
+	NotFound Category = "NotFound"
+
+	// AlreadyExists errors indicate that a resource with the requested
+	// identifier already exists.
+	AlreadyExists Category = "AlreadyExists"
+
+	// Throttled errors indicate that the caller exceeded a request rate or
+	// quota and should try again later.
+	Throttled Category = "Throttled"
+
+	// AccessDenied errors indicate that the caller's credentials are invalid
+	// or do not grant permission to perform the request.
+	AccessDenied Category = "AccessDenied"
+
+	// InvalidParameter errors indicate that the request was rejected because
+	// of the values it was made with.
+	InvalidParameter Category = "InvalidParameter"
+
+	// DependencyViolation errors indicate that a resource cannot be deleted
+	// or changed because other resources depend on it.
+	DependencyViolation Category = "DependencyViolation"
+)
+
+const errAccessDenied = "AWS denied the request; check that the credentials of the provider grant the permissions this resource needs"
+
+// codes maps well known AWS error codes to their category. Codes that follow
+// the naming conventions checked by suffixes need not be listed here.
+var codes = map[string]Category{
+	"EntityAlreadyExists":     AlreadyExists,
+	"BucketAlreadyOwnedByYou": AlreadyExists,
+
+	"Throttling":                             Throttled,
+	"ThrottlingException":                    Throttled,
+	"ThrottledException":                     Throttled,
+	"RequestThrottled":                       Throttled,
+	"RequestThrottledException":              Throttled,
+	"TooManyRequestsException":               Throttled,
+	"ProvisionedThroughputExceededException": Throttled,
+	"RequestLimitExceeded":                   Throttled,
+	"SlowDown":                               Throttled,
+	"EC2ThrottledException":                  Throttled,
+
+	"AccessDenied":                AccessDenied,
+	"AccessDeniedException":       AccessDenied,
+	"UnauthorizedOperation":       AccessDenied,
+	"UnauthorizedAccess":          AccessDenied,
+	"AuthFailure":                 AccessDenied,
+	"Forbidden":                   AccessDenied,
+	"NotAuthorized":               AccessDenied,
+	"InvalidClientTokenId":        AccessDenied,
+	"SignatureDoesNotMatch":       AccessDenied,
+	"UnrecognizedClientException": AccessDenied,
+	"ExpiredToken":                AccessDenied,
+	"ExpiredTokenException":       AccessDenied,
+
+	"InvalidParameter":               InvalidParameter,
+	"InvalidParameterException":      InvalidParameter,
+	"InvalidParameterValue":          InvalidParameter,
+	"InvalidParameterValueException": InvalidParameter,
+	"InvalidParameterCombination":    InvalidParameter,
+	"InvalidInput":                   InvalidParameter,
+	"InvalidInputException":          InvalidParameter,
+	"InvalidArgument":                InvalidParameter,
+	"InvalidRequestException":        InvalidParameter,
+	"MissingParameter":               InvalidParameter,
+	"ValidationError":                InvalidParameter,
+	"ValidationException":            InvalidParameter,
+
+	"DependencyViolation":    DependencyViolation,
+	"DeleteConflict":         DependencyViolation,
+	"ResourceInUse":          DependencyViolation,
+	"ResourceInUseException": DependencyViolation,
+}
+
+// suffixes maps the suffixes AWS services conventionally use for error codes
+// to their category, e.g. DBInstanceNotFound, InvalidVpcID.NotFound or
+// ResourceAlreadyExistsException.
+var suffixes = []struct {
+	suffix   string
+	category Category
+}{
+	{suffix: "NotFound", category: NotFound},
+	{suffix: "NotFoundException", category: NotFound},
+	{suffix: "NotFoundFault", category: NotFound},
+	{suffix: "AlreadyExists", category: AlreadyExists},
+	{suffix: "AlreadyExistsException", category: AlreadyExists},
+	{suffix: "AlreadyExistsFault", category: AlreadyExists},
+	{suffix: ".Duplicate", category: AlreadyExists},
+}
+
+// statuses maps HTTP status codes to a category for errors whose code is
+// not recognised.
+var statuses = map[int]Category{
+	http.StatusNotFound:        NotFound,
+	http.StatusForbidden:       AccessDenied,
+	http.StatusTooManyRequests: Throttled,
+}
+
+// ClassifyCode returns the category of the supplied AWS error code.
+func ClassifyCode(code string) Category {
+	if c, ok := codes[code]; ok {
+		return c
+	}
+	if strings.HasPrefix(code, "NoSuch") {
+		return NotFound
+	}
+	for _, s := range suffixes {
+		if strings.HasSuffix(code, s.suffix) {
+			return s.category
+		}
+	}
+	return Unknown
+}
+
+// Classify returns the category of the supplied error. Errors that wrap an
+// AWS error are classified by the AWS error they wrap.
+func Classify(err error) Category {
+	ae, ok := errors.Cause(err).(awserr.Error)
+	if !ok {
+		return Unknown
+	}
+	if c := ClassifyCode(ae.Code()); c != Unknown {
+		return c
+	}
+	if rf, ok := ae.(awserr.RequestFailure); ok {
+		return statuses[rf.StatusCode()]
+	}
+	return Unknown
+}
+
+// IsNotFound returns true if the supplied error indicates that a resource
+// does not exist.
+func IsNotFound(err error) bool { return Classify(err) == NotFound }
+
+// IsAlreadyExists returns true if the supplied error indicates that a
+// resource already exists.
+func IsAlreadyExists(err error) bool { return Classify(err) == AlreadyExists }
+
+// IsThrottled returns true if the supplied error indicates that a request
+// was throttled.
+func IsThrottled(err error) bool { return Classify(err) == Throttled }
+
+// IsAccessDenied returns true if the supplied error indicates that a request
+// was not authorized.
+func IsAccessDenied(err error) bool { return Classify(err) == AccessDenied }
+
+// IsInvalidParameter returns true if the supplied error indicates that a
+// request was made with invalid values.
+func IsInvalidParameter(err error) bool { return Classify(err) == InvalidParameter }
+
+// IsDependencyViolation returns true if the supplied error indicates that
+// other resources depend on a resource.
+func IsDependencyViolation(err error) bool { return Classify(err) == DependencyViolation }
+
+// Explain wraps errors that are likely to confuse users with an explanation
+// of what they mean and how they may be resolved. Other errors are returned
+// unchanged.
+func Explain(err error) error {
+	if IsAccessDenied(err) {
+		return errors.Wrap(err, errAccessDenied)
+	}
+	return err
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestClassify(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want Category
+	}{
+		"Nil":                 {err: nil, want: Unknown},
+		"NotAWSError":         {err: errors.New("boom"), want: Unknown},
+		"UnknownCode":         {err: awserr.New("Boom", "", nil), want: Unknown},
+		"NoSuchPrefix":        {err: awserr.New("NoSuchEntity", "", nil), want: NotFound},
+		"NotFoundSuffix":      {err: awserr.New("DBInstanceNotFound", "", nil), want: NotFound},
+		"DottedNotFound":      {err: awserr.New("InvalidVpcID.NotFound", "", nil), want: NotFound},
+		"NotFoundException":   {err: awserr.New("ResourceNotFoundException", "", nil), want: NotFound},
+		"AlreadyExists":       {err: awserr.New("EntityAlreadyExists", "", nil), want: AlreadyExists},
+		"Duplicate":           {err: awserr.New("InvalidGroup.Duplicate", "", nil), want: AlreadyExists},
+		"Throttled":           {err: awserr.New("ThrottlingException", "", nil), want: Throttled},
+		"AccessDenied":        {err: awserr.New("UnauthorizedOperation", "", nil), want: AccessDenied},
+		"InvalidParameter":    {err: awserr.New("ValidationError", "", nil), want: InvalidParameter},
+		"DependencyViolation": {err: awserr.New("DependencyViolation", "", nil), want: DependencyViolation},
+		"Wrapped":             {err: errors.Wrap(awserr.New("AccessDeniedException", "", nil), "boom"), want: AccessDenied},
+		"StatusNotFound":      {err: awserr.NewRequestFailure(awserr.New("Boom", "", nil), http.StatusNotFound, ""), want: NotFound},
+		"StatusTooMany":       {err: awserr.NewRequestFailure(awserr.New("Boom", "", nil), http.StatusTooManyRequests, ""), want: Throttled},
+		"CodeBeforeStatus":    {err: awserr.NewRequestFailure(awserr.New("AccessDenied", "", nil), http.StatusNotFound, ""), want: AccessDenied},
+		"UnknownStatus":       {err: awserr.NewRequestFailure(awserr.New("Boom", "", nil), http.StatusBadRequest, ""), want: Unknown},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Classify(tc.err)); diff != "" {
+				t.Errorf("Classify(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	denied := awserr.New("AccessDenied", "", nil)
+	boom := errors.New("boom")
+
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Nil":          {err: nil, want: nil},
+		"Unchanged":    {err: boom, want: boom},
+		"AccessDenied": {err: denied, want: errors.Wrap(denied, errAccessDenied)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Explain(tc.err), test.EquateErrors()); diff != "" {
+				t.Errorf("Explain(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorutils
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/pkg/clients/usage"
+)
+
+const errInUse = "cannot delete resource while other managed resources use it"
+
+// NewConnecter returns an ExternalConnecter whose ExternalClients handle AWS
+// errors consistently. Resources that are not found when they are deleted
+// are considered deleted, resources whose deletion is blocked by dependent
+// resources report them in a DeletionBlocked condition, resources whose
+// requests are backed off by a circuit breaker report it in a CircuitOpen
+// condition until a request succeeds again, resources that other managed
+// resources use are not deleted until they are no longer used, and errors are
+// explained where possible.
+func NewConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, Explain(err)
+	}
+	return &external{ExternalClient: e}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	circuit(mg, err)
+	return o, Explain(err)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	circuit(mg, err)
+	return c, Explain(err)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	circuit(mg, err)
+	return u, Explain(err)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if usage.InUse(mg) {
+		mg.SetConditions(DeletionBlocked(nil))
+		return errors.New(errInUse)
+	}
+	err := resource.Ignore(IsNotFound, e.ExternalClient.Delete(ctx, mg))
+	if IsDependencyViolation(err) {
+		mg.SetConditions(DeletionBlocked(Dependents(err)))
+	}
+	circuit(mg, err)
+	return Explain(err)
+}
+
+// circuit sets the CircuitOpen condition of the supplied resource if the
+// supplied error was returned because requests are backed off, and clears it
+// if there was no error.
+func circuit(mg resource.Managed, err error) {
+	if ce, ok := circuitOpenError(err); ok {
+		mg.SetConditions(CircuitOpen(ce))
+		return
+	}
+	if err == nil && mg.GetCondition(TypeCircuitOpen).Status == corev1.ConditionTrue {
+		mg.SetConditions(CircuitClosed())
+	}
+}
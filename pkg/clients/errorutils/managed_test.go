@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/pkg/clients/usage"
+)
+
+func TestConnecter(t *testing.T) {
+	notFound := errors.Wrap(awserr.New("LoadBalancerNotFound", "", nil), "cannot delete")
+	denied := awserr.New("AccessDenied", "", nil)
+	boom := errors.New("boom")
+
+	connect := func(err error) managed.ExternalConnecter {
+		return managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+					return managed.ExternalObservation{}, err
+				},
+				CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+					return managed.ExternalCreation{}, err
+				},
+				UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+					return managed.ExternalUpdate{}, err
+				},
+				DeleteFn: func(_ context.Context, _ resource.Managed) error {
+					return err
+				},
+			}, nil
+		})
+	}
+
+	type want struct {
+		observe error
+		create  error
+		update  error
+		delete  error
+	}
+
+	cases := map[string]struct {
+		c    managed.ExternalConnecter
+		want want
+	}{
+		"Successful": {
+			c: connect(nil),
+		},
+		"UnknownError": {
+			c:    connect(boom),
+			want: want{observe: boom, create: boom, update: boom, delete: boom},
+		},
+		"NotFoundIgnoredOnDelete": {
+			c:    connect(notFound),
+			want: want{observe: notFound, create: notFound, update: notFound},
+		},
+		"AccessDeniedExplained": {
+			c: connect(denied),
+			want: want{
+				observe: errors.Wrap(denied, errAccessDenied),
+				create:  errors.Wrap(denied, errAccessDenied),
+				update:  errors.Wrap(denied, errAccessDenied),
+				delete:  errors.Wrap(denied, errAccessDenied),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			mg := &fake.Managed{}
+			e, err := NewConnecter(tc.c).Connect(ctx, mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			_, err = e.Observe(ctx, mg)
+			if diff := cmp.Diff(tc.want.observe, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			_, err = e.Create(ctx, mg)
+			if diff := cmp.Diff(tc.want.create, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			_, err = e.Update(ctx, mg)
+			if diff := cmp.Diff(tc.want.update, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			err = e.Delete(ctx, mg)
+			if diff := cmp.Diff(tc.want.delete, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCircuitOpen(t *testing.T) {
+	open := &CircuitOpenError{Service: "ec2", Region: "us-east-1", Until: time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)}
+
+	cases := map[string]struct {
+		reason   string
+		existing []runtimev1alpha1.Condition
+		err      error
+		want     runtimev1alpha1.Condition
+	}{
+		"BackedOff": {
+			reason: "A resource whose requests are backed off should report the service, region and deadline.",
+			err:    errors.Wrap(open, "cannot describe"),
+			want:   CircuitOpen(open),
+		},
+		"Succeeded": {
+			reason:   "A resource whose requests were backed off should report they no longer are once a request succeeds.",
+			existing: []runtimev1alpha1.Condition{CircuitOpen(open)},
+			want:     CircuitClosed(),
+		},
+		"NeverBackedOff": {
+			reason: "A resource whose requests were never backed off should not report a CircuitOpen condition.",
+			want:   runtimev1alpha1.Condition{Type: TypeCircuitOpen, Status: corev1.ConditionUnknown},
+		},
+		"OtherError": {
+			reason:   "A resource whose request failed for another reason should still report its requests are backed off.",
+			existing: []runtimev1alpha1.Condition{CircuitOpen(open)},
+			err:      errors.New("boom"),
+			want:     CircuitOpen(open),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			mg := &fake.Managed{}
+			mg.SetConditions(tc.existing...)
+			c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, tc.err
+					},
+				}, nil
+			})
+			e, _ := NewConnecter(c).Connect(ctx, mg)
+			_, _ = e.Observe(ctx, mg)
+			if diff := cmp.Diff(tc.want, mg.GetCondition(TypeCircuitOpen), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeleteInUse(t *testing.T) {
+	ctx := context.Background()
+	deleted := false
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return managed.ExternalClientFns{
+			DeleteFn: func(_ context.Context, _ resource.Managed) error {
+				deleted = true
+				return nil
+			},
+		}, nil
+	})
+	mg := &fake.Managed{}
+	mg.SetFinalizers([]string{usage.FinalizerPrefix + "some-uid"})
+
+	e, err := NewConnecter(c).Connect(ctx, mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+	err = e.Delete(ctx, mg)
+	if diff := cmp.Diff(errors.New(errInUse), err, test.EquateErrors()); diff != "" {
+		t.Errorf("Delete(...): -want, +got:\n%s", diff)
+	}
+	if deleted {
+		t.Errorf("Delete(...): deleted a resource that is in use")
+	}
+	if diff := cmp.Diff(DeletionBlocked(nil), mg.GetCondition(TypeDeletionBlocked), test.EquateConditions()); diff != "" {
+		t.Errorf("Delete(...): -want condition, +got condition:\n%s", diff)
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+)
+
+// CompositeSeparator separates the parts of composite external names.
+const CompositeSeparator = "/"
+
+const errCompositeExternalName = "external name %q must consist of %d non-empty parts separated by " + CompositeSeparator
+
+// CompositeExternalName returns the canonical external name of a resource
+// that AWS identifies by the supplied parts rather than by a single ID, such
+// as an attachment of a policy to an IAM group, which is identified by
+// group/policy-arn. Only the last part may contain the separator, so that it
+// may be an ARN.
+func CompositeExternalName(parts ...string) string {
+	return strings.Join(parts, CompositeSeparator)
+}
+
+// SplitCompositeExternalName returns the n parts of the supplied composite
+// external name, or an error if it does not have n non-empty parts.
+func SplitCompositeExternalName(name string, n int) ([]string, error) {
+	parts := strings.SplitN(name, CompositeSeparator, n)
+	if len(parts) != n {
+		return nil, errors.Errorf(errCompositeExternalName, name, n)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, errors.Errorf(errCompositeExternalName, name, n)
+		}
+	}
+	return parts, nil
+}
+
+// CompositeExternalNameOf returns the n parts of the composite external name
+// of the supplied object, or nil if its external name is not set. Earlier
+// versions of the provider defaulted the external name to the name of the
+// object, which is treated as not set.
+func CompositeExternalNameOf(o metav1.Object, n int) ([]string, error) {
+	en := meta.GetExternalName(o)
+	if en == "" || en == o.GetName() {
+		return nil, nil
+	}
+	return SplitCompositeExternalName(en, n)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestSplitCompositeExternalName(t *testing.T) {
+	type want struct {
+		parts []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		name string
+		n    int
+		want want
+	}{
+		"Pair": {
+			name: "my-elb/i-123",
+			n:    2,
+			want: want{parts: []string{"my-elb", "i-123"}},
+		},
+		"LastPartIsARN": {
+			name: "admins/arn:aws:iam::aws:policy/service-role/AmazonEC2RoleforSSM",
+			n:    2,
+			want: want{parts: []string{"admins", "arn:aws:iam::aws:policy/service-role/AmazonEC2RoleforSSM"}},
+		},
+		"TooFewParts": {
+			name: "admins",
+			n:    2,
+			want: want{err: errors.Errorf(errCompositeExternalName, "admins", 2)},
+		},
+		"EmptyPart": {
+			name: "/arn:aws:iam::aws:policy/ReadOnlyAccess",
+			n:    2,
+			want: want{err: errors.Errorf(errCompositeExternalName, "/arn:aws:iam::aws:policy/ReadOnlyAccess", 2)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			parts, err := SplitCompositeExternalName(tc.name, tc.n)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("SplitCompositeExternalName(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.parts, parts); diff != "" {
+				t.Errorf("SplitCompositeExternalName(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.name, CompositeExternalName(parts...)); diff != "" {
+					t.Errorf("CompositeExternalName(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCompositeExternalNameOf(t *testing.T) {
+	type want struct {
+		parts []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		externalName string
+		want         want
+	}{
+		"NotSet": {},
+		"DefaultedToName": {
+			externalName: "attachment",
+		},
+		"Composite": {
+			externalName: "my-elb/i-123",
+			want:         want{parts: []string{"my-elb", "i-123"}},
+		},
+		"Invalid": {
+			externalName: "my-elb",
+			want:         want{err: errors.Errorf(errCompositeExternalName, "my-elb", 2)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &metav1.ObjectMeta{Name: "attachment"}
+			if tc.externalName != "" {
+				meta.SetExternalName(o, tc.externalName)
+			}
+			parts, err := CompositeExternalNameOf(o, 2)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("CompositeExternalNameOf(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.parts, parts); diff != "" {
+				t.Errorf("CompositeExternalNameOf(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
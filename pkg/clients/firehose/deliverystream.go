@@ -0,0 +1,419 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firehose
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/firehose/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DeliveryStreamClient is the external client used for DeliveryStream Custom
+// Resource
+type DeliveryStreamClient interface {
+	CreateDeliveryStreamRequest(*firehose.CreateDeliveryStreamInput) firehose.CreateDeliveryStreamRequest
+	DescribeDeliveryStreamRequest(*firehose.DescribeDeliveryStreamInput) firehose.DescribeDeliveryStreamRequest
+	UpdateDestinationRequest(*firehose.UpdateDestinationInput) firehose.UpdateDestinationRequest
+	DeleteDeliveryStreamRequest(*firehose.DeleteDeliveryStreamInput) firehose.DeleteDeliveryStreamRequest
+}
+
+// NewDeliveryStreamClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewDeliveryStreamClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DeliveryStreamClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return firehose.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the delivery stream does
+// not exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == firehose.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+func generateBufferingHints(in *v1alpha1.BufferingHints) *firehose.BufferingHints {
+	if in == nil {
+		return nil
+	}
+	return &firehose.BufferingHints{
+		IntervalInSeconds: in.IntervalInSeconds,
+		SizeInMBs:         in.SizeInMBs,
+	}
+}
+
+func generateCloudWatchLoggingOptions(in *v1alpha1.CloudWatchLoggingOptions) *firehose.CloudWatchLoggingOptions {
+	if in == nil {
+		return nil
+	}
+	return &firehose.CloudWatchLoggingOptions{
+		Enabled:       in.Enabled,
+		LogGroupName:  in.LogGroupName,
+		LogStreamName: in.LogStreamName,
+	}
+}
+
+func generateProcessingConfiguration(in *v1alpha1.ProcessingConfiguration) *firehose.ProcessingConfiguration {
+	if in == nil {
+		return nil
+	}
+	c := &firehose.ProcessingConfiguration{Enabled: in.Enabled}
+	for _, p := range in.Processors {
+		processor := firehose.Processor{Type: firehose.ProcessorType(p.Type)}
+		for _, param := range p.Parameters {
+			processor.Parameters = append(processor.Parameters, firehose.ProcessorParameter{
+				ParameterName:  firehose.ProcessorParameterName(param.ParameterName),
+				ParameterValue: aws.String(param.ParameterValue),
+			})
+		}
+		c.Processors = append(c.Processors, processor)
+	}
+	return c
+}
+
+func generateDeserializer(in v1alpha1.Deserializer) *firehose.Deserializer {
+	d := &firehose.Deserializer{}
+	if in.HiveJSONSerDe != nil {
+		d.HiveJsonSerDe = &firehose.HiveJsonSerDe{TimestampFormats: in.HiveJSONSerDe.TimestampFormats}
+	}
+	if in.OpenXJSONSerDe != nil {
+		d.OpenXJsonSerDe = &firehose.OpenXJsonSerDe{
+			CaseInsensitive:                    in.OpenXJSONSerDe.CaseInsensitive,
+			ColumnToJsonKeyMappings:            in.OpenXJSONSerDe.ColumnToJSONKeyMappings,
+			ConvertDotsInJsonKeysToUnderscores: in.OpenXJSONSerDe.ConvertDotsInJSONKeysToUnderscores,
+		}
+	}
+	return d
+}
+
+func generateSerializer(in v1alpha1.Serializer) *firehose.Serializer {
+	s := &firehose.Serializer{}
+	if in.OrcSerDe != nil {
+		s.OrcSerDe = &firehose.OrcSerDe{
+			BlockSizeBytes:     in.OrcSerDe.BlockSizeBytes,
+			BloomFilterColumns: in.OrcSerDe.BloomFilterColumns,
+			Compression:        firehose.OrcCompression(aws.StringValue(in.OrcSerDe.Compression)),
+			EnablePadding:      in.OrcSerDe.EnablePadding,
+			FormatVersion:      firehose.OrcFormatVersion(aws.StringValue(in.OrcSerDe.FormatVersion)),
+			StripeSizeBytes:    in.OrcSerDe.StripeSizeBytes,
+		}
+	}
+	if in.ParquetSerDe != nil {
+		s.ParquetSerDe = &firehose.ParquetSerDe{
+			BlockSizeBytes:              in.ParquetSerDe.BlockSizeBytes,
+			Compression:                 firehose.ParquetCompression(aws.StringValue(in.ParquetSerDe.Compression)),
+			EnableDictionaryCompression: in.ParquetSerDe.EnableDictionaryCompression,
+			MaxPaddingBytes:             in.ParquetSerDe.MaxPaddingBytes,
+			PageSizeBytes:               in.ParquetSerDe.PageSizeBytes,
+			WriterVersion:               firehose.ParquetWriterVersion(aws.StringValue(in.ParquetSerDe.WriterVersion)),
+		}
+	}
+	return s
+}
+
+func generateDataFormatConversionConfiguration(in *v1alpha1.DataFormatConversionConfiguration) *firehose.DataFormatConversionConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &firehose.DataFormatConversionConfiguration{
+		Enabled:                   in.Enabled,
+		InputFormatConfiguration:  &firehose.InputFormatConfiguration{Deserializer: generateDeserializer(in.InputFormatConfiguration.Deserializer)},
+		OutputFormatConfiguration: &firehose.OutputFormatConfiguration{Serializer: generateSerializer(in.OutputFormatConfiguration.Serializer)},
+		SchemaConfiguration: &firehose.SchemaConfiguration{
+			CatalogId:    in.SchemaConfiguration.CatalogID,
+			DatabaseName: aws.String(in.SchemaConfiguration.DatabaseName),
+			Region:       in.SchemaConfiguration.Region,
+			RoleARN:      in.SchemaConfiguration.RoleARN,
+			TableName:    aws.String(in.SchemaConfiguration.TableName),
+			VersionId:    in.SchemaConfiguration.VersionID,
+		},
+	}
+}
+
+func generateExtendedS3DestinationConfiguration(in v1alpha1.ExtendedS3DestinationConfiguration) *firehose.ExtendedS3DestinationConfiguration {
+	return &firehose.ExtendedS3DestinationConfiguration{
+		BucketARN:                         aws.String(in.BucketARN),
+		BufferingHints:                    generateBufferingHints(in.BufferingHints),
+		CloudWatchLoggingOptions:          generateCloudWatchLoggingOptions(in.CloudWatchLoggingOptions),
+		CompressionFormat:                 firehose.CompressionFormat(aws.StringValue(in.CompressionFormat)),
+		DataFormatConversionConfiguration: generateDataFormatConversionConfiguration(in.DataFormatConversionConfiguration),
+		ErrorOutputPrefix:                 in.ErrorOutputPrefix,
+		Prefix:                            in.Prefix,
+		ProcessingConfiguration:           generateProcessingConfiguration(in.ProcessingConfiguration),
+		RoleARN:                           in.RoleARN,
+	}
+}
+
+// GenerateCreateDeliveryStreamInput returns the input to create the delivery
+// stream with the given name from the supplied parameters.
+func GenerateCreateDeliveryStreamInput(name string, p v1alpha1.DeliveryStreamParameters) *firehose.CreateDeliveryStreamInput {
+	in := &firehose.CreateDeliveryStreamInput{
+		DeliveryStreamName:                 aws.String(name),
+		DeliveryStreamType:                 firehose.DeliveryStreamType(aws.StringValue(p.DeliveryStreamType)),
+		ExtendedS3DestinationConfiguration: generateExtendedS3DestinationConfiguration(p.ExtendedS3DestinationConfiguration),
+	}
+	if p.KinesisStreamSourceConfiguration != nil {
+		in.KinesisStreamSourceConfiguration = &firehose.KinesisStreamSourceConfiguration{
+			KinesisStreamARN: aws.String(p.KinesisStreamSourceConfiguration.KinesisStreamARN),
+			RoleARN:          p.KinesisStreamSourceConfiguration.RoleARN,
+		}
+	}
+	for _, t := range p.Tags {
+		in.Tags = append(in.Tags, firehose.Tag{Key: aws.String(t.Key), Value: t.Value})
+	}
+	return in
+}
+
+// GenerateUpdateDestinationInput returns the input to update the S3
+// destination of the delivery stream with the given name. AWS rejects the
+// update unless the observed version is still the current version of the
+// delivery stream.
+func GenerateUpdateDestinationInput(name string, p v1alpha1.DeliveryStreamParameters, o v1alpha1.DeliveryStreamObservation) *firehose.UpdateDestinationInput {
+	d := generateExtendedS3DestinationConfiguration(p.ExtendedS3DestinationConfiguration)
+	return &firehose.UpdateDestinationInput{
+		CurrentDeliveryStreamVersionId: aws.String(o.VersionID),
+		DeliveryStreamName:             aws.String(name),
+		DestinationId:                  aws.String(o.DestinationID),
+		ExtendedS3DestinationUpdate: &firehose.ExtendedS3DestinationUpdate{
+			BucketARN:                         d.BucketARN,
+			BufferingHints:                    d.BufferingHints,
+			CloudWatchLoggingOptions:          d.CloudWatchLoggingOptions,
+			CompressionFormat:                 d.CompressionFormat,
+			DataFormatConversionConfiguration: d.DataFormatConversionConfiguration,
+			ErrorOutputPrefix:                 d.ErrorOutputPrefix,
+			Prefix:                            d.Prefix,
+			ProcessingConfiguration:           d.ProcessingConfiguration,
+			RoleARN:                           d.RoleARN,
+		},
+	}
+}
+
+// extendedS3Destination returns the ID and description of the S3 destination
+// of the supplied delivery stream, if it has one.
+func extendedS3Destination(ds firehose.DeliveryStreamDescription) (string, *firehose.ExtendedS3DestinationDescription) {
+	for _, d := range ds.Destinations {
+		if d.ExtendedS3DestinationDescription != nil {
+			return aws.StringValue(d.DestinationId), d.ExtendedS3DestinationDescription
+		}
+	}
+	return "", nil
+}
+
+func generateObservedProcessingConfiguration(in *firehose.ProcessingConfiguration) *v1alpha1.ProcessingConfiguration {
+	if in == nil {
+		return nil
+	}
+	c := &v1alpha1.ProcessingConfiguration{Enabled: in.Enabled}
+	for _, p := range in.Processors {
+		processor := v1alpha1.Processor{Type: string(p.Type)}
+		for _, param := range p.Parameters {
+			processor.Parameters = append(processor.Parameters, v1alpha1.ProcessorParameter{
+				ParameterName:  string(param.ParameterName),
+				ParameterValue: aws.StringValue(param.ParameterValue),
+			})
+		}
+		c.Processors = append(c.Processors, processor)
+	}
+	return c
+}
+
+func generateObservedDataFormatConversionConfiguration(in *firehose.DataFormatConversionConfiguration) *v1alpha1.DataFormatConversionConfiguration {
+	if in == nil {
+		return nil
+	}
+	c := &v1alpha1.DataFormatConversionConfiguration{Enabled: in.Enabled}
+	if in.InputFormatConfiguration != nil && in.InputFormatConfiguration.Deserializer != nil {
+		d := in.InputFormatConfiguration.Deserializer
+		if d.HiveJsonSerDe != nil {
+			c.InputFormatConfiguration.Deserializer.HiveJSONSerDe = &v1alpha1.HiveJSONSerDe{TimestampFormats: d.HiveJsonSerDe.TimestampFormats}
+		}
+		if d.OpenXJsonSerDe != nil {
+			c.InputFormatConfiguration.Deserializer.OpenXJSONSerDe = &v1alpha1.OpenXJSONSerDe{
+				CaseInsensitive:                    d.OpenXJsonSerDe.CaseInsensitive,
+				ColumnToJSONKeyMappings:            d.OpenXJsonSerDe.ColumnToJsonKeyMappings,
+				ConvertDotsInJSONKeysToUnderscores: d.OpenXJsonSerDe.ConvertDotsInJsonKeysToUnderscores,
+			}
+		}
+	}
+	if in.OutputFormatConfiguration != nil && in.OutputFormatConfiguration.Serializer != nil {
+		s := in.OutputFormatConfiguration.Serializer
+		if s.OrcSerDe != nil {
+			c.OutputFormatConfiguration.Serializer.OrcSerDe = &v1alpha1.OrcSerDe{
+				BlockSizeBytes:     s.OrcSerDe.BlockSizeBytes,
+				BloomFilterColumns: s.OrcSerDe.BloomFilterColumns,
+				Compression:        awsclients.String(string(s.OrcSerDe.Compression)),
+				EnablePadding:      s.OrcSerDe.EnablePadding,
+				FormatVersion:      awsclients.String(string(s.OrcSerDe.FormatVersion)),
+				StripeSizeBytes:    s.OrcSerDe.StripeSizeBytes,
+			}
+		}
+		if s.ParquetSerDe != nil {
+			c.OutputFormatConfiguration.Serializer.ParquetSerDe = &v1alpha1.ParquetSerDe{
+				BlockSizeBytes:              s.ParquetSerDe.BlockSizeBytes,
+				Compression:                 awsclients.String(string(s.ParquetSerDe.Compression)),
+				EnableDictionaryCompression: s.ParquetSerDe.EnableDictionaryCompression,
+				MaxPaddingBytes:             s.ParquetSerDe.MaxPaddingBytes,
+				PageSizeBytes:               s.ParquetSerDe.PageSizeBytes,
+				WriterVersion:               awsclients.String(string(s.ParquetSerDe.WriterVersion)),
+			}
+		}
+	}
+	if s := in.SchemaConfiguration; s != nil {
+		c.SchemaConfiguration = v1alpha1.SchemaConfiguration{
+			CatalogID:    s.CatalogId,
+			DatabaseName: aws.StringValue(s.DatabaseName),
+			Region:       s.Region,
+			RoleARN:      s.RoleARN,
+			TableName:    aws.StringValue(s.TableName),
+			VersionID:    s.VersionId,
+		}
+	}
+	return c
+}
+
+// generateObservedExtendedS3DestinationConfiguration returns the supplied S3
+// destination description in the shape of its desired configuration.
+func generateObservedExtendedS3DestinationConfiguration(in firehose.ExtendedS3DestinationDescription) v1alpha1.ExtendedS3DestinationConfiguration {
+	c := v1alpha1.ExtendedS3DestinationConfiguration{
+		BucketARN:                         aws.StringValue(in.BucketARN),
+		CompressionFormat:                 awsclients.String(string(in.CompressionFormat)),
+		DataFormatConversionConfiguration: generateObservedDataFormatConversionConfiguration(in.DataFormatConversionConfiguration),
+		ErrorOutputPrefix:                 in.ErrorOutputPrefix,
+		Prefix:                            in.Prefix,
+		ProcessingConfiguration:           generateObservedProcessingConfiguration(in.ProcessingConfiguration),
+		RoleARN:                           in.RoleARN,
+	}
+	if in.BufferingHints != nil {
+		c.BufferingHints = &v1alpha1.BufferingHints{
+			IntervalInSeconds: in.BufferingHints.IntervalInSeconds,
+			SizeInMBs:         in.BufferingHints.SizeInMBs,
+		}
+	}
+	if in.CloudWatchLoggingOptions != nil {
+		c.CloudWatchLoggingOptions = &v1alpha1.CloudWatchLoggingOptions{
+			Enabled:       in.CloudWatchLoggingOptions.Enabled,
+			LogGroupName:  in.CloudWatchLoggingOptions.LogGroupName,
+			LogStreamName: in.CloudWatchLoggingOptions.LogStreamName,
+		}
+	}
+	return c
+}
+
+// lateInitializeProcessors fills in the processor parameters AWS sets to
+// their defaults, e.g. NumberOfRetries, for processors whose type matches
+// the observed processor at the same position.
+func lateInitializeProcessors(in []v1alpha1.Processor, from []v1alpha1.Processor) {
+	for i := range in {
+		if i >= len(from) || in[i].Type != from[i].Type {
+			return
+		}
+		for _, fp := range from[i].Parameters {
+			found := false
+			for _, p := range in[i].Parameters {
+				if p.ParameterName == fp.ParameterName {
+					found = true
+					break
+				}
+			}
+			if !found {
+				in[i].Parameters = append(in[i].Parameters, fp)
+			}
+		}
+	}
+}
+
+// LateInitializeDeliveryStream fills the empty fields in
+// *v1alpha1.DeliveryStreamParameters with the values seen in
+// firehose.DeliveryStreamDescription.
+func LateInitializeDeliveryStream(in *v1alpha1.DeliveryStreamParameters, ds *firehose.DeliveryStreamDescription) { // nolint:gocyclo
+	if ds == nil {
+		return
+	}
+	in.DeliveryStreamType = awsclients.LateInitializeStringPtr(in.DeliveryStreamType, awsclients.String(string(ds.DeliveryStreamType)))
+
+	_, d := extendedS3Destination(*ds)
+	if d == nil {
+		return
+	}
+	from := generateObservedExtendedS3DestinationConfiguration(*d)
+	c := &in.ExtendedS3DestinationConfiguration
+	c.CompressionFormat = awsclients.LateInitializeStringPtr(c.CompressionFormat, from.CompressionFormat)
+	c.RoleARN = awsclients.LateInitializeStringPtr(c.RoleARN, from.RoleARN)
+	if c.BufferingHints == nil {
+		c.BufferingHints = from.BufferingHints
+	} else if from.BufferingHints != nil {
+		c.BufferingHints.IntervalInSeconds = awsclients.LateInitializeInt64Ptr(c.BufferingHints.IntervalInSeconds, from.BufferingHints.IntervalInSeconds)
+		c.BufferingHints.SizeInMBs = awsclients.LateInitializeInt64Ptr(c.BufferingHints.SizeInMBs, from.BufferingHints.SizeInMBs)
+	}
+	if c.CloudWatchLoggingOptions == nil {
+		c.CloudWatchLoggingOptions = from.CloudWatchLoggingOptions
+	}
+	if c.ProcessingConfiguration == nil {
+		c.ProcessingConfiguration = from.ProcessingConfiguration
+	} else if from.ProcessingConfiguration != nil {
+		c.ProcessingConfiguration.Enabled = awsclients.LateInitializeBoolPtr(c.ProcessingConfiguration.Enabled, from.ProcessingConfiguration.Enabled)
+		lateInitializeProcessors(c.ProcessingConfiguration.Processors, from.ProcessingConfiguration.Processors)
+	}
+	if c.DataFormatConversionConfiguration == nil {
+		c.DataFormatConversionConfiguration = from.DataFormatConversionConfiguration
+	} else if f := from.DataFormatConversionConfiguration; f != nil {
+		dc := c.DataFormatConversionConfiguration
+		dc.Enabled = awsclients.LateInitializeBoolPtr(dc.Enabled, f.Enabled)
+		dc.SchemaConfiguration.CatalogID = awsclients.LateInitializeStringPtr(dc.SchemaConfiguration.CatalogID, f.SchemaConfiguration.CatalogID)
+		dc.SchemaConfiguration.Region = awsclients.LateInitializeStringPtr(dc.SchemaConfiguration.Region, f.SchemaConfiguration.Region)
+		dc.SchemaConfiguration.RoleARN = awsclients.LateInitializeStringPtr(dc.SchemaConfiguration.RoleARN, f.SchemaConfiguration.RoleARN)
+		dc.SchemaConfiguration.VersionID = awsclients.LateInitializeStringPtr(dc.SchemaConfiguration.VersionID, f.SchemaConfiguration.VersionID)
+	}
+}
+
+// GenerateDeliveryStreamObservation is used to produce
+// v1alpha1.DeliveryStreamObservation from firehose.DeliveryStreamDescription.
+func GenerateDeliveryStreamObservation(ds firehose.DeliveryStreamDescription) v1alpha1.DeliveryStreamObservation {
+	id, _ := extendedS3Destination(ds)
+	return v1alpha1.DeliveryStreamObservation{
+		ARN:           aws.StringValue(ds.DeliveryStreamARN),
+		DestinationID: id,
+		Status:        string(ds.DeliveryStreamStatus),
+		VersionID:     aws.StringValue(ds.VersionId),
+	}
+}
+
+// IsDeliveryStreamUpToDate returns true if the S3 destination of the delivery
+// stream matches the desired configuration.
+func IsDeliveryStreamUpToDate(p v1alpha1.DeliveryStreamParameters, ds firehose.DeliveryStreamDescription) bool {
+	_, d := extendedS3Destination(ds)
+	if d == nil {
+		return false
+	}
+	observed := generateObservedExtendedS3DestinationConfiguration(*d)
+	return cmp.Equal(p.ExtendedS3DestinationConfiguration, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.ExtendedS3DestinationConfiguration{}, "RoleARNRef", "RoleARNSelector"),
+		cmpopts.IgnoreFields(v1alpha1.SchemaConfiguration{}, "RoleARNRef", "RoleARNSelector"),
+		cmpopts.SortSlices(func(a, b v1alpha1.ProcessorParameter) bool { return a.ParameterName < b.ParameterName }))
+}
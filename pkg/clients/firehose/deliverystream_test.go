@@ -0,0 +1,255 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firehose
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/firehose/v1alpha1"
+)
+
+var (
+	bucketARN = "arn:aws:s3:::example"
+	roleARN   = "arn:aws:iam::123456789012:role/firehose"
+	lambdaARN = "arn:aws:lambda:us-east-1:123456789012:function:transform"
+)
+
+func params(m ...func(*v1alpha1.DeliveryStreamParameters)) v1alpha1.DeliveryStreamParameters {
+	p := v1alpha1.DeliveryStreamParameters{
+		ExtendedS3DestinationConfiguration: v1alpha1.ExtendedS3DestinationConfiguration{
+			BucketARN: bucketARN,
+			RoleARN:   aws.String(roleARN),
+			ProcessingConfiguration: &v1alpha1.ProcessingConfiguration{
+				Enabled: aws.Bool(true),
+				Processors: []v1alpha1.Processor{{
+					Type:       "Lambda",
+					Parameters: []v1alpha1.ProcessorParameter{{ParameterName: "LambdaArn", ParameterValue: lambdaARN}},
+				}},
+			},
+			DataFormatConversionConfiguration: &v1alpha1.DataFormatConversionConfiguration{
+				InputFormatConfiguration: v1alpha1.InputFormatConfiguration{
+					Deserializer: v1alpha1.Deserializer{OpenXJSONSerDe: &v1alpha1.OpenXJSONSerDe{}},
+				},
+				OutputFormatConfiguration: v1alpha1.OutputFormatConfiguration{
+					Serializer: v1alpha1.Serializer{ParquetSerDe: &v1alpha1.ParquetSerDe{Compression: aws.String("SNAPPY")}},
+				},
+				SchemaConfiguration: v1alpha1.SchemaConfiguration{
+					DatabaseName: "analytics",
+					RoleARN:      aws.String(roleARN),
+					TableName:    "events",
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func description(m ...func(*firehose.ExtendedS3DestinationDescription)) firehose.DeliveryStreamDescription {
+	d := &firehose.ExtendedS3DestinationDescription{
+		BucketARN: aws.String(bucketARN),
+		BufferingHints: &firehose.BufferingHints{
+			IntervalInSeconds: aws.Int64(300),
+			SizeInMBs:         aws.Int64(128),
+		},
+		CloudWatchLoggingOptions: &firehose.CloudWatchLoggingOptions{Enabled: aws.Bool(false)},
+		CompressionFormat:        firehose.CompressionFormatUncompressed,
+		DataFormatConversionConfiguration: &firehose.DataFormatConversionConfiguration{
+			Enabled: aws.Bool(true),
+			InputFormatConfiguration: &firehose.InputFormatConfiguration{
+				Deserializer: &firehose.Deserializer{OpenXJsonSerDe: &firehose.OpenXJsonSerDe{}},
+			},
+			OutputFormatConfiguration: &firehose.OutputFormatConfiguration{
+				Serializer: &firehose.Serializer{ParquetSerDe: &firehose.ParquetSerDe{Compression: firehose.ParquetCompressionSnappy}},
+			},
+			SchemaConfiguration: &firehose.SchemaConfiguration{
+				CatalogId:    aws.String("123456789012"),
+				DatabaseName: aws.String("analytics"),
+				Region:       aws.String("us-east-1"),
+				RoleARN:      aws.String(roleARN),
+				TableName:    aws.String("events"),
+				VersionId:    aws.String("LATEST"),
+			},
+		},
+		ProcessingConfiguration: &firehose.ProcessingConfiguration{
+			Enabled: aws.Bool(true),
+			Processors: []firehose.Processor{{
+				Type: firehose.ProcessorTypeLambda,
+				Parameters: []firehose.ProcessorParameter{
+					{ParameterName: firehose.ProcessorParameterNameNumberOfRetries, ParameterValue: aws.String("3")},
+					{ParameterName: firehose.ProcessorParameterNameLambdaArn, ParameterValue: aws.String(lambdaARN)},
+				},
+			}},
+		},
+		RoleARN: aws.String(roleARN),
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return firehose.DeliveryStreamDescription{
+		DeliveryStreamType: firehose.DeliveryStreamTypeDirectPut,
+		Destinations: []firehose.DestinationDescription{{
+			DestinationId:                    aws.String("destinationId-000000000001"),
+			ExtendedS3DestinationDescription: d,
+		}},
+		VersionId: aws.String("1"),
+	}
+}
+
+func TestGenerateCreateDeliveryStreamInput(t *testing.T) {
+	got := GenerateCreateDeliveryStreamInput("example", params(func(p *v1alpha1.DeliveryStreamParameters) {
+		p.DeliveryStreamType = aws.String("KinesisStreamAsSource")
+		p.KinesisStreamSourceConfiguration = &v1alpha1.KinesisStreamSourceConfiguration{
+			KinesisStreamARN: "arn:aws:kinesis:us-east-1:123456789012:stream/example",
+			RoleARN:          aws.String(roleARN),
+		}
+		p.Tags = []v1alpha1.Tag{{Key: "k", Value: aws.String("v")}}
+	}))
+	want := &firehose.CreateDeliveryStreamInput{
+		DeliveryStreamName: aws.String("example"),
+		DeliveryStreamType: firehose.DeliveryStreamTypeKinesisStreamAsSource,
+		ExtendedS3DestinationConfiguration: &firehose.ExtendedS3DestinationConfiguration{
+			BucketARN: aws.String(bucketARN),
+			DataFormatConversionConfiguration: &firehose.DataFormatConversionConfiguration{
+				InputFormatConfiguration: &firehose.InputFormatConfiguration{
+					Deserializer: &firehose.Deserializer{OpenXJsonSerDe: &firehose.OpenXJsonSerDe{}},
+				},
+				OutputFormatConfiguration: &firehose.OutputFormatConfiguration{
+					Serializer: &firehose.Serializer{ParquetSerDe: &firehose.ParquetSerDe{Compression: firehose.ParquetCompressionSnappy}},
+				},
+				SchemaConfiguration: &firehose.SchemaConfiguration{
+					DatabaseName: aws.String("analytics"),
+					RoleARN:      aws.String(roleARN),
+					TableName:    aws.String("events"),
+				},
+			},
+			ProcessingConfiguration: &firehose.ProcessingConfiguration{
+				Enabled: aws.Bool(true),
+				Processors: []firehose.Processor{{
+					Type:       firehose.ProcessorTypeLambda,
+					Parameters: []firehose.ProcessorParameter{{ParameterName: firehose.ProcessorParameterNameLambdaArn, ParameterValue: aws.String(lambdaARN)}},
+				}},
+			},
+			RoleARN: aws.String(roleARN),
+		},
+		KinesisStreamSourceConfiguration: &firehose.KinesisStreamSourceConfiguration{
+			KinesisStreamARN: aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/example"),
+			RoleARN:          aws.String(roleARN),
+		},
+		Tags: []firehose.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateCreateDeliveryStreamInput(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeDeliveryStream(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.DeliveryStreamParameters
+		ds   *firehose.DeliveryStreamDescription
+		want v1alpha1.DeliveryStreamParameters
+	}{
+		"NilDescription": {
+			spec: params(),
+			want: params(),
+		},
+		"Defaults": {
+			spec: params(),
+			ds: func() *firehose.DeliveryStreamDescription {
+				d := description()
+				return &d
+			}(),
+			want: params(func(p *v1alpha1.DeliveryStreamParameters) {
+				p.DeliveryStreamType = aws.String("DirectPut")
+				c := &p.ExtendedS3DestinationConfiguration
+				c.BufferingHints = &v1alpha1.BufferingHints{IntervalInSeconds: aws.Int64(300), SizeInMBs: aws.Int64(128)}
+				c.CloudWatchLoggingOptions = &v1alpha1.CloudWatchLoggingOptions{Enabled: aws.Bool(false)}
+				c.CompressionFormat = aws.String("UNCOMPRESSED")
+				c.ProcessingConfiguration.Processors[0].Parameters = append(c.ProcessingConfiguration.Processors[0].Parameters,
+					v1alpha1.ProcessorParameter{ParameterName: "NumberOfRetries", ParameterValue: "3"})
+				c.DataFormatConversionConfiguration.Enabled = aws.Bool(true)
+				c.DataFormatConversionConfiguration.SchemaConfiguration.CatalogID = aws.String("123456789012")
+				c.DataFormatConversionConfiguration.SchemaConfiguration.Region = aws.String("us-east-1")
+				c.DataFormatConversionConfiguration.SchemaConfiguration.VersionID = aws.String("LATEST")
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeDeliveryStream(&tc.spec, tc.ds)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeDeliveryStream(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDeliveryStreamUpToDate(t *testing.T) {
+	lateInitialized := func() v1alpha1.DeliveryStreamParameters {
+		p := params()
+		d := description()
+		LateInitializeDeliveryStream(&p, &d)
+		return p
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.DeliveryStreamParameters
+		ds   firehose.DeliveryStreamDescription
+		want bool
+	}{
+		"UpToDate": {
+			p:    lateInitialized(),
+			ds:   description(),
+			want: true,
+		},
+		"ProcessorChanged": {
+			p: lateInitialized(),
+			ds: description(func(d *firehose.ExtendedS3DestinationDescription) {
+				d.ProcessingConfiguration.Processors[0].Parameters[1].ParameterValue = aws.String("other")
+			}),
+			want: false,
+		},
+		"SchemaChanged": {
+			p: lateInitialized(),
+			ds: description(func(d *firehose.ExtendedS3DestinationDescription) {
+				d.DataFormatConversionConfiguration.SchemaConfiguration.TableName = aws.String("other")
+			}),
+			want: false,
+		},
+		"NoS3Destination": {
+			p:    lateInitialized(),
+			ds:   firehose.DeliveryStreamDescription{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDeliveryStreamUpToDate(tc.p, tc.ds)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDeliveryStreamUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
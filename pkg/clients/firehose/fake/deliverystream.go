@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/firehose"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DeliveryStreamClient = (*MockDeliveryStreamClient)(nil)
+
+// MockDeliveryStreamClient is a type that implements all the methods for DeliveryStreamClient interface
+type MockDeliveryStreamClient struct {
+	MockCreateDeliveryStream   func(*firehose.CreateDeliveryStreamInput) firehose.CreateDeliveryStreamRequest
+	MockDescribeDeliveryStream func(*firehose.DescribeDeliveryStreamInput) firehose.DescribeDeliveryStreamRequest
+	MockUpdateDestination      func(*firehose.UpdateDestinationInput) firehose.UpdateDestinationRequest
+	MockDeleteDeliveryStream   func(*firehose.DeleteDeliveryStreamInput) firehose.DeleteDeliveryStreamRequest
+}
+
+// CreateDeliveryStreamRequest calls the underlying MockCreateDeliveryStream method.
+func (c *MockDeliveryStreamClient) CreateDeliveryStreamRequest(i *firehose.CreateDeliveryStreamInput) firehose.CreateDeliveryStreamRequest {
+	return c.MockCreateDeliveryStream(i)
+}
+
+// DescribeDeliveryStreamRequest calls the underlying MockDescribeDeliveryStream method.
+func (c *MockDeliveryStreamClient) DescribeDeliveryStreamRequest(i *firehose.DescribeDeliveryStreamInput) firehose.DescribeDeliveryStreamRequest {
+	return c.MockDescribeDeliveryStream(i)
+}
+
+// UpdateDestinationRequest calls the underlying MockUpdateDestination method.
+func (c *MockDeliveryStreamClient) UpdateDestinationRequest(i *firehose.UpdateDestinationInput) firehose.UpdateDestinationRequest {
+	return c.MockUpdateDestination(i)
+}
+
+// DeleteDeliveryStreamRequest calls the underlying MockDeleteDeliveryStream method.
+func (c *MockDeliveryStreamClient) DeleteDeliveryStreamRequest(i *firehose.DeleteDeliveryStreamInput) firehose.DeleteDeliveryStreamRequest {
+	return c.MockDeleteDeliveryStream(i)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/fms"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/fms"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PolicyClient = (*MockPolicyClient)(nil)
+
+// MockPolicyClient is a type that implements all the methods for PolicyClient interface
+type MockPolicyClient struct {
+	MockPutPolicy    func(*fms.PutPolicyInput) fms.PutPolicyRequest
+	MockGetPolicy    func(*fms.GetPolicyInput) fms.GetPolicyRequest
+	MockDeletePolicy func(*fms.DeletePolicyInput) fms.DeletePolicyRequest
+}
+
+// PutPolicyRequest calls the underlying MockPutPolicy method.
+func (c *MockPolicyClient) PutPolicyRequest(i *fms.PutPolicyInput) fms.PutPolicyRequest {
+	return c.MockPutPolicy(i)
+}
+
+// GetPolicyRequest calls the underlying MockGetPolicy method.
+func (c *MockPolicyClient) GetPolicyRequest(i *fms.GetPolicyInput) fms.GetPolicyRequest {
+	return c.MockGetPolicy(i)
+}
+
+// DeletePolicyRequest calls the underlying MockDeletePolicy method.
+func (c *MockPolicyClient) DeletePolicyRequest(i *fms.DeletePolicyInput) fms.DeletePolicyRequest {
+	return c.MockDeletePolicy(i)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/fms"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/fms/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// PolicyClient is the external client used for Policy Custom Resource
+type PolicyClient interface {
+	PutPolicyRequest(*fms.PutPolicyInput) fms.PutPolicyRequest
+	GetPolicyRequest(*fms.GetPolicyInput) fms.GetPolicyRequest
+	DeletePolicyRequest(*fms.DeletePolicyInput) fms.DeletePolicyRequest
+}
+
+// NewPolicyClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewPolicyClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (PolicyClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return fms.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the policy doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == fms.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+func generateScopeMap(s *v1alpha1.PolicyScope) map[string][]string {
+	if s == nil {
+		return nil
+	}
+	m := map[string][]string{}
+	if len(s.Accounts) != 0 {
+		m[string(fms.CustomerPolicyScopeIdTypeAccount)] = s.Accounts
+	}
+	if len(s.OrganizationalUnits) != 0 {
+		m[string(fms.CustomerPolicyScopeIdTypeOrgUnit)] = s.OrganizationalUnits
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+// GeneratePolicy returns the Firewall Manager policy described by the
+// supplied parameters. The policy ID and update token are left empty.
+func GeneratePolicy(p v1alpha1.PolicyParameters) *fms.Policy {
+	o := &fms.Policy{
+		PolicyName:          aws.String(p.Name),
+		ResourceType:        aws.String(p.ResourceType),
+		ResourceTypeList:    p.ResourceTypeList,
+		ExcludeResourceTags: aws.Bool(p.ExcludeResourceTags),
+		RemediationEnabled:  aws.Bool(p.RemediationEnabled),
+		IncludeMap:          generateScopeMap(p.IncludeMap),
+		ExcludeMap:          generateScopeMap(p.ExcludeMap),
+		SecurityServicePolicyData: &fms.SecurityServicePolicyData{
+			Type:               fms.SecurityServiceType(p.SecurityServicePolicyData.Type),
+			ManagedServiceData: p.SecurityServicePolicyData.ManagedServiceData,
+		},
+	}
+	if len(p.ResourceTags) != 0 {
+		o.ResourceTags = make([]fms.ResourceTag, len(p.ResourceTags))
+		for i, t := range p.ResourceTags {
+			o.ResourceTags[i] = fms.ResourceTag{Key: aws.String(t.Key), Value: t.Value}
+		}
+	}
+	return o
+}
+
+// GenerateUpdatePolicyInput returns the input to replace the policy with the
+// given ID and update token with the supplied parameters.
+func GenerateUpdatePolicyInput(id, token string, p v1alpha1.PolicyParameters) *fms.PutPolicyInput {
+	o := GeneratePolicy(p)
+	o.PolicyId = aws.String(id)
+	o.PolicyUpdateToken = aws.String(token)
+	return &fms.PutPolicyInput{Policy: o}
+}
+
+// GeneratePolicyObservation is used to produce v1alpha1.PolicyObservation
+// from the output of GetPolicy.
+func GeneratePolicyObservation(o fms.GetPolicyOutput) v1alpha1.PolicyObservation {
+	obs := v1alpha1.PolicyObservation{ARN: aws.StringValue(o.PolicyArn)}
+	if o.Policy != nil {
+		obs.PolicyUpdateToken = aws.StringValue(o.Policy.PolicyUpdateToken)
+	}
+	return obs
+}
+
+// IsPolicyUpToDate returns true if there is no update-able difference between
+// desired and observed state of the resource. The managed service data is
+// compared ignoring differences in formatting.
+func IsPolicyUpToDate(p v1alpha1.PolicyParameters, o fms.Policy) (bool, error) {
+	desired := GeneratePolicy(p)
+	opts := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.SortSlices(func(a, b fms.ResourceTag) bool { return aws.StringValue(a.Key) < aws.StringValue(b.Key) }),
+		cmpopts.IgnoreFields(fms.Policy{}, "PolicyId", "PolicyUpdateToken", "SecurityServicePolicyData"),
+	}
+	if !cmp.Equal(*desired, o, opts...) {
+		return false, nil
+	}
+	if o.SecurityServicePolicyData == nil || o.SecurityServicePolicyData.Type != desired.SecurityServicePolicyData.Type {
+		return false, nil
+	}
+	return isJSONEqual(aws.StringValue(desired.SecurityServicePolicyData.ManagedServiceData), aws.StringValue(o.SecurityServicePolicyData.ManagedServiceData))
+}
+
+func isJSONEqual(a, b string) (bool, error) {
+	if a == "" || b == "" {
+		return a == b, nil
+	}
+	ca, err := awsclients.CompactAndEscapeJSON(a)
+	if err != nil {
+		return false, err
+	}
+	cb, err := awsclients.CompactAndEscapeJSON(b)
+	if err != nil {
+		return false, err
+	}
+	return ca == cb, nil
+}
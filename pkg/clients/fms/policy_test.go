@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fms
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fms"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/fms/v1alpha1"
+)
+
+func params() v1alpha1.PolicyParameters {
+	return v1alpha1.PolicyParameters{
+		Name: "waf-baseline",
+		SecurityServicePolicyData: v1alpha1.SecurityServicePolicyData{
+			Type:               "WAFV2",
+			ManagedServiceData: aws.String(`{"type": "WAFV2", "defaultAction": {"type": "ALLOW"}}`),
+		},
+		ResourceType:       "AWS::ElasticLoadBalancingV2::LoadBalancer",
+		ResourceTags:       []v1alpha1.ResourceTag{{Key: "env", Value: aws.String("prod")}},
+		RemediationEnabled: true,
+		IncludeMap: &v1alpha1.PolicyScope{
+			Accounts:            []string{"111111111111", "222222222222"},
+			OrganizationalUnits: []string{"ou-abcd-12345678"},
+		},
+	}
+}
+
+func policy() fms.Policy {
+	return fms.Policy{
+		PolicyId:            aws.String("12345678-1234-1234-1234-123456789012"),
+		PolicyName:          aws.String("waf-baseline"),
+		PolicyUpdateToken:   aws.String("token"),
+		ResourceType:        aws.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+		ResourceTags:        []fms.ResourceTag{{Key: aws.String("env"), Value: aws.String("prod")}},
+		ExcludeResourceTags: aws.Bool(false),
+		RemediationEnabled:  aws.Bool(true),
+		IncludeMap: map[string][]string{
+			"ACCOUNT":  {"222222222222", "111111111111"},
+			"ORG_UNIT": {"ou-abcd-12345678"},
+		},
+		SecurityServicePolicyData: &fms.SecurityServicePolicyData{
+			Type:               fms.SecurityServiceTypeWafv2,
+			ManagedServiceData: aws.String(`{"type":"WAFV2","defaultAction":{"type":"ALLOW"}}`),
+		},
+	}
+}
+
+func TestGenerateUpdatePolicyInput(t *testing.T) {
+	want := &fms.PutPolicyInput{Policy: &fms.Policy{
+		PolicyId:            aws.String("12345678-1234-1234-1234-123456789012"),
+		PolicyName:          aws.String("waf-baseline"),
+		PolicyUpdateToken:   aws.String("token"),
+		ResourceType:        aws.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+		ResourceTags:        []fms.ResourceTag{{Key: aws.String("env"), Value: aws.String("prod")}},
+		ExcludeResourceTags: aws.Bool(false),
+		RemediationEnabled:  aws.Bool(true),
+		IncludeMap: map[string][]string{
+			"ACCOUNT":  {"111111111111", "222222222222"},
+			"ORG_UNIT": {"ou-abcd-12345678"},
+		},
+		SecurityServicePolicyData: &fms.SecurityServicePolicyData{
+			Type:               fms.SecurityServiceTypeWafv2,
+			ManagedServiceData: aws.String(`{"type": "WAFV2", "defaultAction": {"type": "ALLOW"}}`),
+		},
+	}}
+
+	got := GenerateUpdatePolicyInput("12345678-1234-1234-1234-123456789012", "token", params())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsPolicyUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      bool
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.PolicyParameters
+		o    fms.Policy
+		want want
+	}{
+		"UpToDate": {
+			p:    params(),
+			o:    policy(),
+			want: want{upToDate: true},
+		},
+		"NameChanged": {
+			p: func() v1alpha1.PolicyParameters {
+				p := params()
+				p.Name = "waf-strict"
+				return p
+			}(),
+			o: policy(),
+		},
+		"ScopeChanged": {
+			p: func() v1alpha1.PolicyParameters {
+				p := params()
+				p.IncludeMap = nil
+				p.ExcludeMap = &v1alpha1.PolicyScope{Accounts: []string{"111111111111"}}
+				return p
+			}(),
+			o: policy(),
+		},
+		"ManagedServiceDataChanged": {
+			p: func() v1alpha1.PolicyParameters {
+				p := params()
+				p.SecurityServicePolicyData.ManagedServiceData = aws.String(`{"type":"WAFV2","defaultAction":{"type":"BLOCK"}}`)
+				return p
+			}(),
+			o: policy(),
+		},
+		"InvalidManagedServiceData": {
+			p: func() v1alpha1.PolicyParameters {
+				p := params()
+				p.SecurityServicePolicyData.ManagedServiceData = aws.String(`{`)
+				return p
+			}(),
+			o:    policy(),
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsPolicyUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
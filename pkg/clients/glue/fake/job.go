@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/glue"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.JobClient = (*MockJobClient)(nil)
+
+// MockJobClient is a type that implements all the methods for JobClient interface
+type MockJobClient struct {
+	MockCreateJob func(*glue.CreateJobInput) glue.CreateJobRequest
+	MockGetJob    func(*glue.GetJobInput) glue.GetJobRequest
+	MockUpdateJob func(*glue.UpdateJobInput) glue.UpdateJobRequest
+	MockDeleteJob func(*glue.DeleteJobInput) glue.DeleteJobRequest
+}
+
+// CreateJobRequest calls the underlying MockCreateJob method.
+func (c *MockJobClient) CreateJobRequest(i *glue.CreateJobInput) glue.CreateJobRequest {
+	return c.MockCreateJob(i)
+}
+
+// GetJobRequest calls the underlying MockGetJob method.
+func (c *MockJobClient) GetJobRequest(i *glue.GetJobInput) glue.GetJobRequest {
+	return c.MockGetJob(i)
+}
+
+// UpdateJobRequest calls the underlying MockUpdateJob method.
+func (c *MockJobClient) UpdateJobRequest(i *glue.UpdateJobInput) glue.UpdateJobRequest {
+	return c.MockUpdateJob(i)
+}
+
+// DeleteJobRequest calls the underlying MockDeleteJob method.
+func (c *MockJobClient) DeleteJobRequest(i *glue.DeleteJobInput) glue.DeleteJobRequest {
+	return c.MockDeleteJob(i)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/glue"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TriggerClient = (*MockTriggerClient)(nil)
+
+// MockTriggerClient is a type that implements all the methods for TriggerClient interface
+type MockTriggerClient struct {
+	MockCreateTrigger func(*glue.CreateTriggerInput) glue.CreateTriggerRequest
+	MockGetTrigger    func(*glue.GetTriggerInput) glue.GetTriggerRequest
+	MockUpdateTrigger func(*glue.UpdateTriggerInput) glue.UpdateTriggerRequest
+	MockDeleteTrigger func(*glue.DeleteTriggerInput) glue.DeleteTriggerRequest
+	MockStartTrigger  func(*glue.StartTriggerInput) glue.StartTriggerRequest
+	MockStopTrigger   func(*glue.StopTriggerInput) glue.StopTriggerRequest
+}
+
+// CreateTriggerRequest calls the underlying MockCreateTrigger method.
+func (c *MockTriggerClient) CreateTriggerRequest(i *glue.CreateTriggerInput) glue.CreateTriggerRequest {
+	return c.MockCreateTrigger(i)
+}
+
+// GetTriggerRequest calls the underlying MockGetTrigger method.
+func (c *MockTriggerClient) GetTriggerRequest(i *glue.GetTriggerInput) glue.GetTriggerRequest {
+	return c.MockGetTrigger(i)
+}
+
+// UpdateTriggerRequest calls the underlying MockUpdateTrigger method.
+func (c *MockTriggerClient) UpdateTriggerRequest(i *glue.UpdateTriggerInput) glue.UpdateTriggerRequest {
+	return c.MockUpdateTrigger(i)
+}
+
+// DeleteTriggerRequest calls the underlying MockDeleteTrigger method.
+func (c *MockTriggerClient) DeleteTriggerRequest(i *glue.DeleteTriggerInput) glue.DeleteTriggerRequest {
+	return c.MockDeleteTrigger(i)
+}
+
+// StartTriggerRequest calls the underlying MockStartTrigger method.
+func (c *MockTriggerClient) StartTriggerRequest(i *glue.StartTriggerInput) glue.StartTriggerRequest {
+	return c.MockStartTrigger(i)
+}
+
+// StopTriggerRequest calls the underlying MockStopTrigger method.
+func (c *MockTriggerClient) StopTriggerRequest(i *glue.StopTriggerInput) glue.StopTriggerRequest {
+	return c.MockStopTrigger(i)
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// JobClient is the external client used for Job Custom Resource
+type JobClient interface {
+	CreateJobRequest(*glue.CreateJobInput) glue.CreateJobRequest
+	GetJobRequest(*glue.GetJobInput) glue.GetJobRequest
+	UpdateJobRequest(*glue.UpdateJobInput) glue.UpdateJobRequest
+	DeleteJobRequest(*glue.DeleteJobInput) glue.DeleteJobRequest
+}
+
+// NewJobClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewJobClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (JobClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return glue.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the Glue entity does not
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == glue.ErrCodeEntityNotFoundException
+	}
+	return false
+}
+
+// ScriptLocation returns the S3 URL of the script of the supplied command.
+func ScriptLocation(c v1alpha1.JobCommand) string {
+	return "s3://" + aws.StringValue(c.ScriptS3Bucket) + "/" + strings.TrimPrefix(c.ScriptS3Key, "/")
+}
+
+func generateJobCommand(c v1alpha1.JobCommand) *glue.JobCommand {
+	return &glue.JobCommand{
+		Name:           aws.String(c.Name),
+		PythonVersion:  c.PythonVersion,
+		ScriptLocation: aws.String(ScriptLocation(c)),
+	}
+}
+
+func generateConnectionsList(c []string) *glue.ConnectionsList {
+	if len(c) == 0 {
+		return nil
+	}
+	return &glue.ConnectionsList{Connections: c}
+}
+
+func generateExecutionProperty(maxConcurrentRuns *int64) *glue.ExecutionProperty {
+	if maxConcurrentRuns == nil {
+		return nil
+	}
+	return &glue.ExecutionProperty{MaxConcurrentRuns: maxConcurrentRuns}
+}
+
+func generateNotificationProperty(notifyDelayAfter *int64) *glue.NotificationProperty {
+	if notifyDelayAfter == nil {
+		return nil
+	}
+	return &glue.NotificationProperty{NotifyDelayAfter: notifyDelayAfter}
+}
+
+// GenerateCreateJobInput returns the input to create the job with the given
+// name from the supplied parameters.
+func GenerateCreateJobInput(name string, p v1alpha1.JobParameters) *glue.CreateJobInput {
+	in := &glue.CreateJobInput{
+		Command:                 generateJobCommand(p.Command),
+		Connections:             generateConnectionsList(p.Connections),
+		DefaultArguments:        p.DefaultArguments,
+		Description:             p.Description,
+		ExecutionProperty:       generateExecutionProperty(p.MaxConcurrentRuns),
+		GlueVersion:             p.GlueVersion,
+		MaxRetries:              p.MaxRetries,
+		Name:                    aws.String(name),
+		NonOverridableArguments: p.NonOverridableArguments,
+		NotificationProperty:    generateNotificationProperty(p.NotifyDelayAfter),
+		NumberOfWorkers:         p.NumberOfWorkers,
+		Role:                    p.Role,
+		SecurityConfiguration:   p.SecurityConfiguration,
+		Timeout:                 p.Timeout,
+		WorkerType:              glue.WorkerType(aws.StringValue(p.WorkerType)),
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = p.Tags
+	}
+	return in
+}
+
+// GenerateUpdateJobInput returns the input to update the job with the given
+// name from the supplied parameters. An update replaces the whole definition
+// of the job.
+func GenerateUpdateJobInput(name string, p v1alpha1.JobParameters) *glue.UpdateJobInput {
+	return &glue.UpdateJobInput{
+		JobName: aws.String(name),
+		JobUpdate: &glue.JobUpdate{
+			Command:                 generateJobCommand(p.Command),
+			Connections:             generateConnectionsList(p.Connections),
+			DefaultArguments:        p.DefaultArguments,
+			Description:             p.Description,
+			ExecutionProperty:       generateExecutionProperty(p.MaxConcurrentRuns),
+			GlueVersion:             p.GlueVersion,
+			MaxRetries:              p.MaxRetries,
+			NonOverridableArguments: p.NonOverridableArguments,
+			NotificationProperty:    generateNotificationProperty(p.NotifyDelayAfter),
+			NumberOfWorkers:         p.NumberOfWorkers,
+			Role:                    p.Role,
+			SecurityConfiguration:   p.SecurityConfiguration,
+			Timeout:                 p.Timeout,
+			WorkerType:              glue.WorkerType(aws.StringValue(p.WorkerType)),
+		},
+	}
+}
+
+// generateObservedJobParameters returns the supplied job in the shape of its
+// desired configuration.
+func generateObservedJobParameters(j glue.Job) v1alpha1.JobParameters {
+	p := v1alpha1.JobParameters{
+		DefaultArguments:        j.DefaultArguments,
+		Description:             j.Description,
+		GlueVersion:             j.GlueVersion,
+		MaxRetries:              j.MaxRetries,
+		NonOverridableArguments: j.NonOverridableArguments,
+		NumberOfWorkers:         j.NumberOfWorkers,
+		Role:                    j.Role,
+		SecurityConfiguration:   j.SecurityConfiguration,
+		Timeout:                 j.Timeout,
+		WorkerType:              awsclients.String(string(j.WorkerType)),
+	}
+	if j.Command != nil {
+		p.Command.Name = aws.StringValue(j.Command.Name)
+		p.Command.PythonVersion = j.Command.PythonVersion
+		l := strings.TrimPrefix(aws.StringValue(j.Command.ScriptLocation), "s3://")
+		if i := strings.Index(l, "/"); i >= 0 {
+			p.Command.ScriptS3Bucket = aws.String(l[:i])
+			p.Command.ScriptS3Key = l[i+1:]
+		}
+	}
+	if j.Connections != nil {
+		p.Connections = j.Connections.Connections
+	}
+	if j.ExecutionProperty != nil {
+		p.MaxConcurrentRuns = j.ExecutionProperty.MaxConcurrentRuns
+	}
+	if j.NotificationProperty != nil {
+		p.NotifyDelayAfter = j.NotificationProperty.NotifyDelayAfter
+	}
+	return p
+}
+
+// LateInitializeJob fills the empty fields in *v1alpha1.JobParameters with
+// the values seen in glue.Job.
+func LateInitializeJob(in *v1alpha1.JobParameters, j *glue.Job) {
+	if j == nil {
+		return
+	}
+	from := generateObservedJobParameters(*j)
+	in.Command.PythonVersion = awsclients.LateInitializeStringPtr(in.Command.PythonVersion, from.Command.PythonVersion)
+	in.GlueVersion = awsclients.LateInitializeStringPtr(in.GlueVersion, from.GlueVersion)
+	in.MaxConcurrentRuns = awsclients.LateInitializeInt64Ptr(in.MaxConcurrentRuns, from.MaxConcurrentRuns)
+	in.MaxRetries = awsclients.LateInitializeInt64Ptr(in.MaxRetries, from.MaxRetries)
+	in.NumberOfWorkers = awsclients.LateInitializeInt64Ptr(in.NumberOfWorkers, from.NumberOfWorkers)
+	in.Timeout = awsclients.LateInitializeInt64Ptr(in.Timeout, from.Timeout)
+	in.WorkerType = awsclients.LateInitializeStringPtr(in.WorkerType, from.WorkerType)
+}
+
+// GenerateJobObservation is used to produce v1alpha1.JobObservation from
+// glue.Job.
+func GenerateJobObservation(j glue.Job) v1alpha1.JobObservation {
+	o := v1alpha1.JobObservation{}
+	if j.CreatedOn != nil {
+		t := metav1.NewTime(*j.CreatedOn)
+		o.CreatedOn = &t
+	}
+	if j.LastModifiedOn != nil {
+		t := metav1.NewTime(*j.LastModifiedOn)
+		o.LastModifiedOn = &t
+	}
+	return o
+}
+
+// IsJobUpToDate returns true if there is no difference between the desired
+// and observed configuration of the job.
+func IsJobUpToDate(p v1alpha1.JobParameters, j glue.Job) bool {
+	observed := generateObservedJobParameters(j)
+	return ScriptLocation(p.Command) == ScriptLocation(observed.Command) &&
+		cmp.Equal(p, observed,
+			cmpopts.EquateEmpty(),
+			cmpopts.IgnoreFields(v1alpha1.JobParameters{}, "RoleRef", "RoleSelector", "Tags"),
+			cmpopts.IgnoreFields(v1alpha1.JobCommand{}, "ScriptS3Bucket", "ScriptS3BucketRef", "ScriptS3BucketSelector", "ScriptS3Key"))
+}
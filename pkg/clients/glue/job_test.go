@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+)
+
+var roleARN = "arn:aws:iam::123456789012:role/glue"
+
+func jobParams(m ...func(*v1alpha1.JobParameters)) v1alpha1.JobParameters {
+	p := v1alpha1.JobParameters{
+		Command: v1alpha1.JobCommand{
+			Name:           "glueetl",
+			ScriptS3Bucket: aws.String("scripts"),
+			ScriptS3Key:    "etl/job.py",
+		},
+		Connections:     []string{"warehouse"},
+		NumberOfWorkers: aws.Int64(10),
+		Role:            aws.String(roleARN),
+		WorkerType:      aws.String("G.1X"),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func job(m ...func(*glue.Job)) glue.Job {
+	j := glue.Job{
+		Command: &glue.JobCommand{
+			Name:           aws.String("glueetl"),
+			PythonVersion:  aws.String("3"),
+			ScriptLocation: aws.String("s3://scripts/etl/job.py"),
+		},
+		Connections:       &glue.ConnectionsList{Connections: []string{"warehouse"}},
+		ExecutionProperty: &glue.ExecutionProperty{MaxConcurrentRuns: aws.Int64(1)},
+		GlueVersion:       aws.String("2.0"),
+		MaxRetries:        aws.Int64(0),
+		NumberOfWorkers:   aws.Int64(10),
+		Role:              aws.String(roleARN),
+		Timeout:           aws.Int64(2880),
+		WorkerType:        glue.WorkerTypeG1x,
+	}
+	for _, f := range m {
+		f(&j)
+	}
+	return j
+}
+
+func TestScriptLocation(t *testing.T) {
+	cases := map[string]struct {
+		c    v1alpha1.JobCommand
+		want string
+	}{
+		"Key": {
+			c:    v1alpha1.JobCommand{ScriptS3Bucket: aws.String("scripts"), ScriptS3Key: "etl/job.py"},
+			want: "s3://scripts/etl/job.py",
+		},
+		"LeadingSlash": {
+			c:    v1alpha1.JobCommand{ScriptS3Bucket: aws.String("scripts"), ScriptS3Key: "/etl/job.py"},
+			want: "s3://scripts/etl/job.py",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ScriptLocation(tc.c)); diff != "" {
+				t.Errorf("ScriptLocation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateJobInput(t *testing.T) {
+	got := GenerateCreateJobInput("example", jobParams(func(p *v1alpha1.JobParameters) {
+		p.MaxConcurrentRuns = aws.Int64(2)
+		p.Tags = map[string]string{"k": "v"}
+	}))
+	want := &glue.CreateJobInput{
+		Command: &glue.JobCommand{
+			Name:           aws.String("glueetl"),
+			ScriptLocation: aws.String("s3://scripts/etl/job.py"),
+		},
+		Connections:       &glue.ConnectionsList{Connections: []string{"warehouse"}},
+		ExecutionProperty: &glue.ExecutionProperty{MaxConcurrentRuns: aws.Int64(2)},
+		Name:              aws.String("example"),
+		NumberOfWorkers:   aws.Int64(10),
+		Role:              aws.String(roleARN),
+		Tags:              map[string]string{"k": "v"},
+		WorkerType:        glue.WorkerTypeG1x,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateCreateJobInput(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeJob(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.JobParameters
+		j    *glue.Job
+		want v1alpha1.JobParameters
+	}{
+		"NilJob": {
+			spec: jobParams(),
+			want: jobParams(),
+		},
+		"Defaults": {
+			spec: jobParams(),
+			j: func() *glue.Job {
+				j := job()
+				return &j
+			}(),
+			want: jobParams(func(p *v1alpha1.JobParameters) {
+				p.Command.PythonVersion = aws.String("3")
+				p.GlueVersion = aws.String("2.0")
+				p.MaxConcurrentRuns = aws.Int64(1)
+				p.MaxRetries = aws.Int64(0)
+				p.Timeout = aws.Int64(2880)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeJob(&tc.spec, tc.j)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeJob(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsJobUpToDate(t *testing.T) {
+	lateInitialized := func(m ...func(*v1alpha1.JobParameters)) v1alpha1.JobParameters {
+		p := jobParams(m...)
+		j := job()
+		LateInitializeJob(&p, &j)
+		return p
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.JobParameters
+		j    glue.Job
+		want bool
+	}{
+		"UpToDate": {
+			p:    lateInitialized(),
+			j:    job(),
+			want: true,
+		},
+		"TagsIgnored": {
+			p: lateInitialized(func(p *v1alpha1.JobParameters) {
+				p.Tags = map[string]string{"k": "v"}
+			}),
+			j:    job(),
+			want: true,
+		},
+		"ScriptChanged": {
+			p: lateInitialized(),
+			j: job(func(j *glue.Job) {
+				j.Command.ScriptLocation = aws.String("s3://scripts/etl/other.py")
+			}),
+			want: false,
+		},
+		"WorkersChanged": {
+			p: lateInitialized(),
+			j: job(func(j *glue.Job) {
+				j.NumberOfWorkers = aws.Int64(2)
+			}),
+			want: false,
+		},
+		"ConnectionsChanged": {
+			p: lateInitialized(),
+			j: job(func(j *glue.Job) {
+				j.Connections = nil
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsJobUpToDate(tc.p, tc.j)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsJobUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
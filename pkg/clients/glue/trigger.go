@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// TriggerClient is the external client used for Trigger Custom Resource
+type TriggerClient interface {
+	CreateTriggerRequest(*glue.CreateTriggerInput) glue.CreateTriggerRequest
+	GetTriggerRequest(*glue.GetTriggerInput) glue.GetTriggerRequest
+	UpdateTriggerRequest(*glue.UpdateTriggerInput) glue.UpdateTriggerRequest
+	DeleteTriggerRequest(*glue.DeleteTriggerInput) glue.DeleteTriggerRequest
+	StartTriggerRequest(*glue.StartTriggerInput) glue.StartTriggerRequest
+	StopTriggerRequest(*glue.StopTriggerInput) glue.StopTriggerRequest
+}
+
+// NewTriggerClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewTriggerClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (TriggerClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return glue.New(*cfg), err
+}
+
+// IsTriggerEnabled returns true if the trigger described by the supplied
+// parameters should fire. On-demand triggers never fire by themselves.
+func IsTriggerEnabled(p v1alpha1.TriggerParameters) bool {
+	return glue.TriggerType(p.Type) != glue.TriggerTypeOnDemand && (p.Enabled == nil || *p.Enabled)
+}
+
+func generateActions(in []v1alpha1.TriggerAction) []glue.Action {
+	if in == nil {
+		return nil
+	}
+	actions := make([]glue.Action, len(in))
+	for i, a := range in {
+		actions[i] = glue.Action{
+			Arguments:             a.Arguments,
+			CrawlerName:           a.CrawlerName,
+			JobName:               a.JobName,
+			NotificationProperty:  generateNotificationProperty(a.NotifyDelayAfter),
+			SecurityConfiguration: a.SecurityConfiguration,
+			Timeout:               a.Timeout,
+		}
+	}
+	return actions
+}
+
+func generatePredicate(in *v1alpha1.TriggerPredicate) *glue.Predicate {
+	if in == nil {
+		return nil
+	}
+	p := &glue.Predicate{Logical: glue.Logical(aws.StringValue(in.Logical))}
+	for _, c := range in.Conditions {
+		p.Conditions = append(p.Conditions, glue.Condition{
+			CrawlState:      glue.CrawlState(aws.StringValue(c.CrawlState)),
+			CrawlerName:     c.CrawlerName,
+			JobName:         c.JobName,
+			LogicalOperator: glue.LogicalOperator(aws.StringValue(c.LogicalOperator)),
+			State:           glue.JobRunState(aws.StringValue(c.State)),
+		})
+	}
+	return p
+}
+
+// GenerateCreateTriggerInput returns the input to create the trigger with
+// the given name from the supplied parameters.
+func GenerateCreateTriggerInput(name string, p v1alpha1.TriggerParameters) *glue.CreateTriggerInput {
+	in := &glue.CreateTriggerInput{
+		Actions:         generateActions(p.Actions),
+		Description:     p.Description,
+		Name:            aws.String(name),
+		Predicate:       generatePredicate(p.Predicate),
+		Schedule:        p.Schedule,
+		StartOnCreation: aws.Bool(IsTriggerEnabled(p)),
+		Type:            glue.TriggerType(p.Type),
+		WorkflowName:    p.WorkflowName,
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = p.Tags
+	}
+	return in
+}
+
+// GenerateUpdateTriggerInput returns the input to update the trigger with the
+// given name from the supplied parameters.
+func GenerateUpdateTriggerInput(name string, p v1alpha1.TriggerParameters) *glue.UpdateTriggerInput {
+	return &glue.UpdateTriggerInput{
+		Name: aws.String(name),
+		TriggerUpdate: &glue.TriggerUpdate{
+			Actions:     generateActions(p.Actions),
+			Description: p.Description,
+			Name:        aws.String(name),
+			Predicate:   generatePredicate(p.Predicate),
+			Schedule:    p.Schedule,
+		},
+	}
+}
+
+// generateObservedTriggerParameters returns the update-able configuration of
+// the supplied trigger in the shape of its desired configuration.
+func generateObservedTriggerParameters(t glue.Trigger) v1alpha1.TriggerParameters {
+	p := v1alpha1.TriggerParameters{
+		Description: t.Description,
+		Schedule:    t.Schedule,
+	}
+	for _, a := range t.Actions {
+		action := v1alpha1.TriggerAction{
+			Arguments:             a.Arguments,
+			CrawlerName:           a.CrawlerName,
+			JobName:               a.JobName,
+			SecurityConfiguration: a.SecurityConfiguration,
+			Timeout:               a.Timeout,
+		}
+		if a.NotificationProperty != nil {
+			action.NotifyDelayAfter = a.NotificationProperty.NotifyDelayAfter
+		}
+		p.Actions = append(p.Actions, action)
+	}
+	if t.Predicate != nil {
+		p.Predicate = &v1alpha1.TriggerPredicate{Logical: awsclients.String(string(t.Predicate.Logical))}
+		for _, c := range t.Predicate.Conditions {
+			p.Predicate.Conditions = append(p.Predicate.Conditions, v1alpha1.TriggerCondition{
+				CrawlState:      awsclients.String(string(c.CrawlState)),
+				CrawlerName:     c.CrawlerName,
+				JobName:         c.JobName,
+				LogicalOperator: awsclients.String(string(c.LogicalOperator)),
+				State:           awsclients.String(string(c.State)),
+			})
+		}
+	}
+	return p
+}
+
+// LateInitializeTrigger fills the empty fields in *v1alpha1.TriggerParameters
+// with the values seen in glue.Trigger.
+func LateInitializeTrigger(in *v1alpha1.TriggerParameters, t *glue.Trigger) {
+	if t == nil {
+		return
+	}
+	from := generateObservedTriggerParameters(*t)
+	if in.Predicate == nil || from.Predicate == nil {
+		return
+	}
+	in.Predicate.Logical = awsclients.LateInitializeStringPtr(in.Predicate.Logical, from.Predicate.Logical)
+	for i := range in.Predicate.Conditions {
+		if i >= len(from.Predicate.Conditions) {
+			break
+		}
+		in.Predicate.Conditions[i].LogicalOperator = awsclients.LateInitializeStringPtr(in.Predicate.Conditions[i].LogicalOperator, from.Predicate.Conditions[i].LogicalOperator)
+	}
+}
+
+// GenerateTriggerObservation is used to produce v1alpha1.TriggerObservation
+// from glue.Trigger.
+func GenerateTriggerObservation(t glue.Trigger) v1alpha1.TriggerObservation {
+	return v1alpha1.TriggerObservation{
+		ID:    aws.StringValue(t.Id),
+		State: string(t.State),
+	}
+}
+
+// IsTriggerUpToDate returns true if there is no update-able difference
+// between the desired and observed configuration of the trigger.
+func IsTriggerUpToDate(p v1alpha1.TriggerParameters, t glue.Trigger) bool {
+	observed := generateObservedTriggerParameters(t)
+	desired := v1alpha1.TriggerParameters{
+		Actions:     p.Actions,
+		Description: p.Description,
+		Predicate:   p.Predicate,
+		Schedule:    p.Schedule,
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.TriggerAction{}, "JobNameRef", "JobNameSelector"),
+		cmpopts.IgnoreFields(v1alpha1.TriggerCondition{}, "JobNameRef", "JobNameSelector"))
+}
+
+// IsEnabledUpToDate returns true if the trigger is activated or deactivated
+// as desired. Triggers that are transitioning between states are considered
+// up to date.
+func IsEnabledUpToDate(p v1alpha1.TriggerParameters, state glue.TriggerState) bool {
+	if glue.TriggerType(p.Type) == glue.TriggerTypeOnDemand {
+		return true
+	}
+	switch state {
+	case glue.TriggerStateActivated:
+		return IsTriggerEnabled(p)
+	case glue.TriggerStateCreated, glue.TriggerStateDeactivated:
+		return !IsTriggerEnabled(p)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+)
+
+func triggerParams(m ...func(*v1alpha1.TriggerParameters)) v1alpha1.TriggerParameters {
+	p := v1alpha1.TriggerParameters{
+		Actions: []v1alpha1.TriggerAction{{JobName: aws.String("load")}},
+		Predicate: &v1alpha1.TriggerPredicate{
+			Conditions: []v1alpha1.TriggerCondition{{JobName: aws.String("extract"), State: aws.String("SUCCEEDED")}},
+		},
+		Type: "CONDITIONAL",
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func trigger(m ...func(*glue.Trigger)) glue.Trigger {
+	t := glue.Trigger{
+		Actions: []glue.Action{{JobName: aws.String("load")}},
+		Id:      aws.String("id"),
+		Predicate: &glue.Predicate{
+			Conditions: []glue.Condition{{
+				JobName:         aws.String("extract"),
+				LogicalOperator: glue.LogicalOperatorEquals,
+				State:           glue.JobRunStateSucceeded,
+			}},
+			Logical: glue.LogicalAnd,
+		},
+		State: glue.TriggerStateActivated,
+		Type:  glue.TriggerTypeConditional,
+	}
+	for _, f := range m {
+		f(&t)
+	}
+	return t
+}
+
+func TestGenerateCreateTriggerInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TriggerParameters
+		want *glue.CreateTriggerInput
+	}{
+		"Conditional": {
+			p: triggerParams(),
+			want: &glue.CreateTriggerInput{
+				Actions: []glue.Action{{JobName: aws.String("load")}},
+				Name:    aws.String("example"),
+				Predicate: &glue.Predicate{
+					Conditions: []glue.Condition{{JobName: aws.String("extract"), State: glue.JobRunStateSucceeded}},
+				},
+				StartOnCreation: aws.Bool(true),
+				Type:            glue.TriggerTypeConditional,
+			},
+		},
+		"ScheduledDisabled": {
+			p: v1alpha1.TriggerParameters{
+				Actions:  []v1alpha1.TriggerAction{{JobName: aws.String("load"), NotifyDelayAfter: aws.Int64(5)}},
+				Enabled:  aws.Bool(false),
+				Schedule: aws.String("cron(15 12 * * ? *)"),
+				Type:     "SCHEDULED",
+			},
+			want: &glue.CreateTriggerInput{
+				Actions: []glue.Action{{
+					JobName:              aws.String("load"),
+					NotificationProperty: &glue.NotificationProperty{NotifyDelayAfter: aws.Int64(5)},
+				}},
+				Name:            aws.String("example"),
+				Schedule:        aws.String("cron(15 12 * * ? *)"),
+				StartOnCreation: aws.Bool(false),
+				Type:            glue.TriggerTypeScheduled,
+			},
+		},
+		"OnDemand": {
+			p: v1alpha1.TriggerParameters{
+				Actions: []v1alpha1.TriggerAction{{JobName: aws.String("load")}},
+				Type:    "ON_DEMAND",
+			},
+			want: &glue.CreateTriggerInput{
+				Actions:         []glue.Action{{JobName: aws.String("load")}},
+				Name:            aws.String("example"),
+				StartOnCreation: aws.Bool(false),
+				Type:            glue.TriggerTypeOnDemand,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateTriggerInput("example", tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateCreateTriggerInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeTrigger(t *testing.T) {
+	spec := triggerParams()
+	tr := trigger()
+	LateInitializeTrigger(&spec, &tr)
+	want := triggerParams(func(p *v1alpha1.TriggerParameters) {
+		p.Predicate.Logical = aws.String("AND")
+		p.Predicate.Conditions[0].LogicalOperator = aws.String("EQUALS")
+	})
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeTrigger(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsTriggerUpToDate(t *testing.T) {
+	lateInitialized := func() v1alpha1.TriggerParameters {
+		p := triggerParams()
+		tr := trigger()
+		LateInitializeTrigger(&p, &tr)
+		return p
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.TriggerParameters
+		t    glue.Trigger
+		want bool
+	}{
+		"UpToDate": {
+			p:    lateInitialized(),
+			t:    trigger(),
+			want: true,
+		},
+		"ActionChanged": {
+			p: lateInitialized(),
+			t: trigger(func(t *glue.Trigger) {
+				t.Actions[0].JobName = aws.String("other")
+			}),
+			want: false,
+		},
+		"ConditionChanged": {
+			p: lateInitialized(),
+			t: trigger(func(t *glue.Trigger) {
+				t.Predicate.Conditions[0].State = glue.JobRunStateFailed
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTriggerUpToDate(tc.p, tc.t)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsTriggerUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEnabledUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p     v1alpha1.TriggerParameters
+		state glue.TriggerState
+		want  bool
+	}{
+		"Activated": {
+			p:     triggerParams(),
+			state: glue.TriggerStateActivated,
+			want:  true,
+		},
+		"Created": {
+			p:     triggerParams(),
+			state: glue.TriggerStateCreated,
+			want:  false,
+		},
+		"Deactivated": {
+			p: triggerParams(func(p *v1alpha1.TriggerParameters) {
+				p.Enabled = aws.Bool(false)
+			}),
+			state: glue.TriggerStateDeactivated,
+			want:  true,
+		},
+		"ShouldDeactivate": {
+			p: triggerParams(func(p *v1alpha1.TriggerParameters) {
+				p.Enabled = aws.Bool(false)
+			}),
+			state: glue.TriggerStateActivated,
+			want:  false,
+		},
+		"OnDemand": {
+			p: triggerParams(func(p *v1alpha1.TriggerParameters) {
+				p.Type = "ON_DEMAND"
+			}),
+			state: glue.TriggerStateCreated,
+			want:  true,
+		},
+		"Transitioning": {
+			p:     triggerParams(),
+			state: glue.TriggerStateActivating,
+			want:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEnabledUpToDate(tc.p, tc.state)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsEnabledUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
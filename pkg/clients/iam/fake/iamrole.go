@@ -28,10 +28,14 @@ var _ clientset.RoleClient = (*MockRoleClient)(nil)
 // MockRoleClient is a type that implements all the methods for RoleClient interface
 type MockRoleClient struct {
 	MockGetRoleRequest                func(*iam.GetRoleInput) iam.GetRoleRequest
+	MockListRolesRequest              func(*iam.ListRolesInput) iam.ListRolesRequest
 	MockCreateRoleRequest             func(*iam.CreateRoleInput) iam.CreateRoleRequest
 	MockDeleteRoleRequest             func(*iam.DeleteRoleInput) iam.DeleteRoleRequest
 	MockUpdateRoleRequest             func(*iam.UpdateRoleInput) iam.UpdateRoleRequest
 	MockUpdateAssumeRolePolicyRequest func(*iam.UpdateAssumeRolePolicyInput) iam.UpdateAssumeRolePolicyRequest
+
+	MockPutRolePermissionsBoundaryRequest func(*iam.PutRolePermissionsBoundaryInput) iam.PutRolePermissionsBoundaryRequest
+	MockSimulatePrincipalPolicyRequest    func(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest
 }
 
 // GetRoleRequest mocks GetRoleRequest method
@@ -39,6 +43,11 @@ func (m *MockRoleClient) GetRoleRequest(input *iam.GetRoleInput) iam.GetRoleRequ
 	return m.MockGetRoleRequest(input)
 }
 
+// ListRolesRequest mocks ListRolesRequest method
+func (m *MockRoleClient) ListRolesRequest(input *iam.ListRolesInput) iam.ListRolesRequest {
+	return m.MockListRolesRequest(input)
+}
+
 // CreateRoleRequest mocks CreateRoleRequest method
 func (m *MockRoleClient) CreateRoleRequest(input *iam.CreateRoleInput) iam.CreateRoleRequest {
 	return m.MockCreateRoleRequest(input)
@@ -58,3 +67,13 @@ func (m *MockRoleClient) UpdateRoleRequest(input *iam.UpdateRoleInput) iam.Updat
 func (m *MockRoleClient) UpdateAssumeRolePolicyRequest(input *iam.UpdateAssumeRolePolicyInput) iam.UpdateAssumeRolePolicyRequest {
 	return m.MockUpdateAssumeRolePolicyRequest(input)
 }
+
+// PutRolePermissionsBoundaryRequest mocks PutRolePermissionsBoundaryRequest method
+func (m *MockRoleClient) PutRolePermissionsBoundaryRequest(input *iam.PutRolePermissionsBoundaryInput) iam.PutRolePermissionsBoundaryRequest {
+	return m.MockPutRolePermissionsBoundaryRequest(input)
+}
+
+// SimulatePrincipalPolicyRequest mocks SimulatePrincipalPolicyRequest method
+func (m *MockRoleClient) SimulatePrincipalPolicyRequest(input *iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+	return m.MockSimulatePrincipalPolicyRequest(input)
+}
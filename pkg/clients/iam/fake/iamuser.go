@@ -28,9 +28,23 @@ var _ clientset.UserClient = (*MockUserClient)(nil)
 // MockUserClient is a type that implements all the methods for RoleClient interface
 type MockUserClient struct {
 	MockGetUser    func(*iam.GetUserInput) iam.GetUserRequest
+	MockListUsers  func(*iam.ListUsersInput) iam.ListUsersRequest
 	MockCreateUser func(*iam.CreateUserInput) iam.CreateUserRequest
 	MockDeleteUser func(*iam.DeleteUserInput) iam.DeleteUserRequest
 	MockUpdateUser func(*iam.UpdateUserInput) iam.UpdateUserRequest
+
+	MockGetLoginProfile    func(*iam.GetLoginProfileInput) iam.GetLoginProfileRequest
+	MockCreateLoginProfile func(*iam.CreateLoginProfileInput) iam.CreateLoginProfileRequest
+	MockDeleteLoginProfile func(*iam.DeleteLoginProfileInput) iam.DeleteLoginProfileRequest
+
+	MockListMFADevices         func(*iam.ListMFADevicesInput) iam.ListMFADevicesRequest
+	MockCreateVirtualMFADevice func(*iam.CreateVirtualMFADeviceInput) iam.CreateVirtualMFADeviceRequest
+	MockEnableMFADevice        func(*iam.EnableMFADeviceInput) iam.EnableMFADeviceRequest
+	MockDeactivateMFADevice    func(*iam.DeactivateMFADeviceInput) iam.DeactivateMFADeviceRequest
+	MockDeleteVirtualMFADevice func(*iam.DeleteVirtualMFADeviceInput) iam.DeleteVirtualMFADeviceRequest
+
+	MockPutUserPermissionsBoundary func(*iam.PutUserPermissionsBoundaryInput) iam.PutUserPermissionsBoundaryRequest
+	MockSimulatePrincipalPolicy    func(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest
 }
 
 // GetUserRequest mocks GetUserRequest method
@@ -38,6 +52,11 @@ func (m *MockUserClient) GetUserRequest(input *iam.GetUserInput) iam.GetUserRequ
 	return m.MockGetUser(input)
 }
 
+// ListUsersRequest mocks ListUsersRequest method
+func (m *MockUserClient) ListUsersRequest(input *iam.ListUsersInput) iam.ListUsersRequest {
+	return m.MockListUsers(input)
+}
+
 // CreateUserRequest mocks CreateUserRequest method
 func (m *MockUserClient) CreateUserRequest(input *iam.CreateUserInput) iam.CreateUserRequest {
 	return m.MockCreateUser(input)
@@ -52,3 +71,53 @@ func (m *MockUserClient) DeleteUserRequest(input *iam.DeleteUserInput) iam.Delet
 func (m *MockUserClient) UpdateUserRequest(input *iam.UpdateUserInput) iam.UpdateUserRequest {
 	return m.MockUpdateUser(input)
 }
+
+// GetLoginProfileRequest mocks GetLoginProfileRequest method
+func (m *MockUserClient) GetLoginProfileRequest(input *iam.GetLoginProfileInput) iam.GetLoginProfileRequest {
+	return m.MockGetLoginProfile(input)
+}
+
+// CreateLoginProfileRequest mocks CreateLoginProfileRequest method
+func (m *MockUserClient) CreateLoginProfileRequest(input *iam.CreateLoginProfileInput) iam.CreateLoginProfileRequest {
+	return m.MockCreateLoginProfile(input)
+}
+
+// DeleteLoginProfileRequest mocks DeleteLoginProfileRequest method
+func (m *MockUserClient) DeleteLoginProfileRequest(input *iam.DeleteLoginProfileInput) iam.DeleteLoginProfileRequest {
+	return m.MockDeleteLoginProfile(input)
+}
+
+// ListMFADevicesRequest mocks ListMFADevicesRequest method
+func (m *MockUserClient) ListMFADevicesRequest(input *iam.ListMFADevicesInput) iam.ListMFADevicesRequest {
+	return m.MockListMFADevices(input)
+}
+
+// CreateVirtualMFADeviceRequest mocks CreateVirtualMFADeviceRequest method
+func (m *MockUserClient) CreateVirtualMFADeviceRequest(input *iam.CreateVirtualMFADeviceInput) iam.CreateVirtualMFADeviceRequest {
+	return m.MockCreateVirtualMFADevice(input)
+}
+
+// EnableMFADeviceRequest mocks EnableMFADeviceRequest method
+func (m *MockUserClient) EnableMFADeviceRequest(input *iam.EnableMFADeviceInput) iam.EnableMFADeviceRequest {
+	return m.MockEnableMFADevice(input)
+}
+
+// DeactivateMFADeviceRequest mocks DeactivateMFADeviceRequest method
+func (m *MockUserClient) DeactivateMFADeviceRequest(input *iam.DeactivateMFADeviceInput) iam.DeactivateMFADeviceRequest {
+	return m.MockDeactivateMFADevice(input)
+}
+
+// DeleteVirtualMFADeviceRequest mocks DeleteVirtualMFADeviceRequest method
+func (m *MockUserClient) DeleteVirtualMFADeviceRequest(input *iam.DeleteVirtualMFADeviceInput) iam.DeleteVirtualMFADeviceRequest {
+	return m.MockDeleteVirtualMFADevice(input)
+}
+
+// PutUserPermissionsBoundaryRequest mocks PutUserPermissionsBoundaryRequest method
+func (m *MockUserClient) PutUserPermissionsBoundaryRequest(input *iam.PutUserPermissionsBoundaryInput) iam.PutUserPermissionsBoundaryRequest {
+	return m.MockPutUserPermissionsBoundary(input)
+}
+
+// SimulatePrincipalPolicyRequest mocks SimulatePrincipalPolicyRequest method
+func (m *MockUserClient) SimulatePrincipalPolicyRequest(input *iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+	return m.MockSimulatePrincipalPolicy(input)
+}
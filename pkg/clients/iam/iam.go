@@ -13,10 +13,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
-)
-
-const (
-	policyArn = "arn:aws:iam::%s:policy/%s"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 // Client defines IAM Client operations
@@ -31,8 +28,8 @@ type Client interface {
 }
 
 type iamClient struct {
-	accountID *string
-	iam       iamiface.ClientAPI
+	caller *arn.ARN
+	iam    iamiface.ClientAPI
 }
 
 // NewClient creates new AWS Client with provided AWS Configurations/Credentials
@@ -157,31 +154,30 @@ func (c *iamClient) DeleteUser(username string) error {
 	return resource.Ignore(IsErrorNotFound, err)
 }
 
-// getAccountID - Gets the accountID of the authenticated session.
-func (c *iamClient) getAccountID() (string, error) {
-	if c.accountID == nil {
+// getCaller - Gets the ARN of the authenticated session.
+func (c *iamClient) getCaller() (arn.ARN, error) {
+	if c.caller == nil {
 		user, err := c.iam.GetUserRequest(&iam.GetUserInput{}).Send(context.TODO())
 		if err != nil {
-			return "", err
+			return arn.ARN{}, err
 		}
 
 		arnData, err := arn.Parse(*user.User.Arn)
 		if err != nil {
-			return "", err
+			return arn.ARN{}, err
 		}
-		c.accountID = &arnData.AccountID
+		c.caller = &arnData
 	}
 
-	return aws.StringValue(c.accountID), nil
+	return *c.caller, nil
 }
 
 func (c *iamClient) getPolicyARN(policyName string) (string, error) {
-	accountID, err := c.getAccountID()
+	caller, err := c.getCaller()
 	if err != nil {
 		return "", err
 	}
-	policyARN := fmt.Sprintf(policyArn, accountID, policyName)
-	return policyARN, nil
+	return awsclients.ARN(caller.Partition, "iam", "", caller.AccountID, "policy/"+policyName), nil
 }
 
 func (c *iamClient) createUser(username string) error {
@@ -23,10 +23,13 @@ const (
 // RoleClient is the external client used for IAMRole Custom Resource
 type RoleClient interface {
 	GetRoleRequest(*iam.GetRoleInput) iam.GetRoleRequest
+	ListRolesRequest(*iam.ListRolesInput) iam.ListRolesRequest
 	CreateRoleRequest(*iam.CreateRoleInput) iam.CreateRoleRequest
 	DeleteRoleRequest(*iam.DeleteRoleInput) iam.DeleteRoleRequest
 	UpdateRoleRequest(*iam.UpdateRoleInput) iam.UpdateRoleRequest
 	UpdateAssumeRolePolicyRequest(*iam.UpdateAssumeRolePolicyInput) iam.UpdateAssumeRolePolicyRequest
+	PutRolePermissionsBoundaryRequest(*iam.PutRolePermissionsBoundaryInput) iam.PutRolePermissionsBoundaryRequest
+	SimulatePrincipalPolicyRequest(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest
 }
 
 // NewRoleClient returns a new client using AWS credentials as JSON encoded data.
@@ -49,8 +52,8 @@ func GenerateCreateRoleInput(name string, p *v1beta1.IAMRoleParameters) *iam.Cre
 		m.Tags = make([]iam.Tag, len(p.Tags))
 		for i, val := range p.Tags {
 			m.Tags[i] = iam.Tag{
-				Key:   &val.Key,
-				Value: &val.Value,
+				Key:   aws.String(val.Key),
+				Value: aws.String(val.Value),
 			}
 		}
 	}
@@ -81,12 +84,19 @@ func GenerateIAMRole(in v1beta1.IAMRoleParameters, role *iam.Role) error {
 	role.MaxSessionDuration = in.MaxSessionDuration
 	role.Path = in.Path
 
+	if in.PermissionsBoundary != nil && aws.StringValue(in.PermissionsBoundary) != PermissionsBoundaryARN(role.PermissionsBoundary) {
+		role.PermissionsBoundary = &iam.AttachedPermissionsBoundary{
+			PermissionsBoundaryArn:  in.PermissionsBoundary,
+			PermissionsBoundaryType: iam.PermissionsBoundaryAttachmentTypePermissionsBoundaryPolicy,
+		}
+	}
+
 	if len(in.Tags) != 0 {
 		role.Tags = make([]iam.Tag, len(in.Tags))
 		for i, val := range in.Tags {
 			role.Tags[i] = iam.Tag{
-				Key:   &val.Key,
-				Value: &val.Value,
+				Key:   aws.String(val.Key),
+				Value: aws.String(val.Value),
 			}
 		}
 	}
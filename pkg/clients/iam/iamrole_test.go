@@ -1,14 +1,18 @@
 package iam
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	fuzz "github.com/google/gofuzz"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/proptest"
 )
 
 var (
@@ -274,6 +278,38 @@ func TestIsRoleUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"DifferentPermissionsBoundary": {
+			args: args{
+				role: iam.Role{
+					AssumeRolePolicyDocument: escapedPolicyJSON(),
+					PermissionsBoundary: &iam.AttachedPermissionsBoundary{
+						PermissionsBoundaryArn:  aws.String("arn:aws:iam::123456789012:policy/old"),
+						PermissionsBoundaryType: iam.PermissionsBoundaryAttachmentTypePermissionsBoundaryPolicy,
+					},
+				},
+				p: v1beta1.IAMRoleParameters{
+					AssumeRolePolicyDocument: assumeRolePolicyDocument,
+					PermissionsBoundary:      aws.String("arn:aws:iam::123456789012:policy/new"),
+				},
+			},
+			want: false,
+		},
+		"SamePermissionsBoundary": {
+			args: args{
+				role: iam.Role{
+					AssumeRolePolicyDocument: escapedPolicyJSON(),
+					PermissionsBoundary: &iam.AttachedPermissionsBoundary{
+						PermissionsBoundaryArn:  aws.String("arn:aws:iam::123456789012:policy/boundary"),
+						PermissionsBoundaryType: iam.PermissionsBoundaryAttachmentTypePermissionsBoundaryPolicy,
+					},
+				},
+				p: v1beta1.IAMRoleParameters{
+					AssumeRolePolicyDocument: assumeRolePolicyDocument,
+					PermissionsBoundary:      aws.String("arn:aws:iam::123456789012:policy/boundary"),
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -285,3 +321,59 @@ func TestIsRoleUpToDate(t *testing.T) {
 		})
 	}
 }
+
+// reflectRole returns the role AWS reports for a role created with the
+// supplied input.
+func reflectRole(in *iam.CreateRoleInput) (iam.Role, error) {
+	doc, err := aws.CompactAndEscapeJSON(aws.StringValue(in.AssumeRolePolicyDocument))
+	if err != nil {
+		return iam.Role{}, err
+	}
+	r := iam.Role{
+		AssumeRolePolicyDocument: &doc,
+		Description:              in.Description,
+		MaxSessionDuration:       in.MaxSessionDuration,
+		Path:                     in.Path,
+		RoleName:                 in.RoleName,
+		Tags:                     in.Tags,
+	}
+	if in.PermissionsBoundary != nil {
+		r.PermissionsBoundary = &iam.AttachedPermissionsBoundary{
+			PermissionsBoundaryArn:  in.PermissionsBoundary,
+			PermissionsBoundaryType: iam.PermissionsBoundaryAttachmentTypePermissionsBoundaryPolicy,
+		}
+	}
+	return r, nil
+}
+
+func TestRoleProperties(t *testing.T) {
+	// AWS only accepts JSON policy documents.
+	policy := func(p *v1beta1.IAMRoleParameters, c fuzz.Continue) {
+		c.FuzzNoCustom(p)
+		b, _ := json.Marshal(map[string]string{"Sid": p.AssumeRolePolicyDocument})
+		p.AssumeRolePolicyDocument = string(b)
+	}
+
+	p := v1beta1.IAMRoleParameters{}
+	proptest.Check(t, &p, func() string {
+		r, err := reflectRole(GenerateCreateRoleInput(roleName, &p))
+		if err != nil {
+			return err.Error()
+		}
+		got := []v1beta1.Tag{}
+		for _, tag := range r.Tags {
+			got = append(got, v1beta1.Tag{Key: aws.StringValue(tag.Key), Value: aws.StringValue(tag.Value)})
+		}
+		if diff := cmp.Diff(p.Tags, got, cmpopts.EquateEmpty()); diff != "" {
+			return "A role should be created with the tags of its parameters: -want, +got:\n" + diff
+		}
+		upToDate, err := IsRoleUpToDate(p, r)
+		if err != nil {
+			return err.Error()
+		}
+		if !upToDate {
+			return "A role should be up to date once AWS reflects its parameters."
+		}
+		return ""
+	}, proptest.WithFuncs(policy))
+}
@@ -3,6 +3,7 @@ package iam
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
@@ -12,9 +13,23 @@ import (
 // UserClient is the external client used for IAM User Custom Resource
 type UserClient interface {
 	GetUserRequest(*iam.GetUserInput) iam.GetUserRequest
+	ListUsersRequest(*iam.ListUsersInput) iam.ListUsersRequest
 	CreateUserRequest(*iam.CreateUserInput) iam.CreateUserRequest
 	UpdateUserRequest(*iam.UpdateUserInput) iam.UpdateUserRequest
 	DeleteUserRequest(*iam.DeleteUserInput) iam.DeleteUserRequest
+
+	GetLoginProfileRequest(*iam.GetLoginProfileInput) iam.GetLoginProfileRequest
+	CreateLoginProfileRequest(*iam.CreateLoginProfileInput) iam.CreateLoginProfileRequest
+	DeleteLoginProfileRequest(*iam.DeleteLoginProfileInput) iam.DeleteLoginProfileRequest
+
+	ListMFADevicesRequest(*iam.ListMFADevicesInput) iam.ListMFADevicesRequest
+	CreateVirtualMFADeviceRequest(*iam.CreateVirtualMFADeviceInput) iam.CreateVirtualMFADeviceRequest
+	EnableMFADeviceRequest(*iam.EnableMFADeviceInput) iam.EnableMFADeviceRequest
+	DeactivateMFADeviceRequest(*iam.DeactivateMFADeviceInput) iam.DeactivateMFADeviceRequest
+	DeleteVirtualMFADeviceRequest(*iam.DeleteVirtualMFADeviceInput) iam.DeleteVirtualMFADeviceRequest
+
+	PutUserPermissionsBoundaryRequest(*iam.PutUserPermissionsBoundaryInput) iam.PutUserPermissionsBoundaryRequest
+	SimulatePrincipalPolicyRequest(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest
 }
 
 // NewUserClient returns a new client using AWS credentials as JSON encoded data.
@@ -44,3 +59,10 @@ func LateInitializeUser(in *v1alpha1.IAMUserParameters, user *iam.User) {
 		}
 	}
 }
+
+// IsUserUpToDate checks whether there is a change in any of the modifiable
+// fields of the supplied user.
+func IsUserUpToDate(in v1alpha1.IAMUserParameters, user iam.User) bool {
+	return aws.StringValue(in.Path) == aws.StringValue(user.Path) &&
+		aws.StringValue(in.PermissionsBoundary) == PermissionsBoundaryARN(user.PermissionsBoundary)
+}
@@ -87,3 +87,39 @@ func TestLateInitializeUser(t *testing.T) {
 		})
 	}
 }
+
+func TestIsUserUpToDate(t *testing.T) {
+	boundary := "arn:aws:iam::123456789012:policy/boundary"
+
+	cases := map[string]struct {
+		p    v1alpha1.IAMUserParameters
+		user iam.User
+		want bool
+	}{
+		"UpToDate": {
+			p: *userParams(func(p *v1alpha1.IAMUserParameters) { p.PermissionsBoundary = &boundary }),
+			user: *user(func(u *iam.User) {
+				u.PermissionsBoundary = &iam.AttachedPermissionsBoundary{PermissionsBoundaryArn: &boundary}
+			}),
+			want: true,
+		},
+		"DifferentPath": {
+			p:    *userParams(func(p *v1alpha1.IAMUserParameters) { p.Path = nil }),
+			user: *user(),
+			want: false,
+		},
+		"MissingPermissionsBoundary": {
+			p:    *userParams(func(p *v1alpha1.IAMUserParameters) { p.PermissionsBoundary = &boundary }),
+			user: *user(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUserUpToDate(tc.p, tc.user)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" // nolint:gosec
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+// Connection details of IAM users with a virtual MFA device.
+const (
+	ConnectionKeyMFASeed         = "mfaSeed"
+	ConnectionKeyMFASerialNumber = "mfaSerialNumber"
+)
+
+// totpPeriod is the period for which a virtual MFA device displays a code.
+const totpPeriod = 30 * time.Second
+
+// IsVirtualMFADevice returns true if the supplied MFA device is the virtual
+// MFA device described by the supplied parameters for the supplied user.
+// Virtual MFA devices are named after their user, and their serial number
+// is an ARN that ends with their path and name.
+func IsVirtualMFADevice(user string, p v1alpha1.VirtualMFADeviceParameters, d iam.MFADevice) bool {
+	path := aws.StringValue(p.Path)
+	if path == "" {
+		path = "/"
+	}
+	return strings.HasSuffix(aws.StringValue(d.SerialNumber), ":mfa"+path+user)
+}
+
+// GenerateTOTP returns the time-based one-time password (RFC 6238) that a
+// virtual MFA device with the supplied base32 encoded seed displays at the
+// supplied time.
+func GenerateTOTP(seed []byte, t time.Time) (string, error) {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(strings.ToUpper(string(seed)), "="))
+	if err != nil {
+		return "", err
+	}
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(t.Unix()/int64(totpPeriod/time.Second)))
+	mac := hmac.New(sha1.New, key)
+	_, _ = mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0xf
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", code%1000000), nil
+}
+
+// GenerateEnableMFADeviceInput returns the input that enables the virtual
+// MFA device with the supplied serial number and seed for the supplied user
+// at the supplied time. AWS requires two consecutive codes to enable a
+// device, so the codes of the previous and the current period are used.
+func GenerateEnableMFADeviceInput(user, serial string, seed []byte, t time.Time) (*iam.EnableMFADeviceInput, error) {
+	code1, err := GenerateTOTP(seed, t.Add(-totpPeriod))
+	if err != nil {
+		return nil, err
+	}
+	code2, err := GenerateTOTP(seed, t)
+	if err != nil {
+		return nil, err
+	}
+	return &iam.EnableMFADeviceInput{
+		UserName:            aws.String(user),
+		SerialNumber:        aws.String(serial),
+		AuthenticationCode1: aws.String(code1),
+		AuthenticationCode2: aws.String(code2),
+	}, nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+func TestIsVirtualMFADevice(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.VirtualMFADeviceParameters
+		d    iam.MFADevice
+		want bool
+	}{
+		"DefaultPath": {
+			d:    iam.MFADevice{SerialNumber: aws.String("arn:aws:iam::123456789012:mfa/alice")},
+			want: true,
+		},
+		"CustomPath": {
+			p:    v1alpha1.VirtualMFADeviceParameters{Path: aws.String("/team/")},
+			d:    iam.MFADevice{SerialNumber: aws.String("arn:aws:iam::123456789012:mfa/team/alice")},
+			want: true,
+		},
+		"OtherPath": {
+			d:    iam.MFADevice{SerialNumber: aws.String("arn:aws:iam::123456789012:mfa/team/alice")},
+			want: false,
+		},
+		"HardwareDevice": {
+			d:    iam.MFADevice{SerialNumber: aws.String("GAHT12345678")},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsVirtualMFADevice("alice", tc.p, tc.d)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTOTP(t *testing.T) {
+	// The SHA1 test vectors of RFC 6238, truncated to six digits.
+	seed := []byte("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+
+	cases := map[string]struct {
+		seed []byte
+		t    time.Time
+		want string
+		err  bool
+	}{
+		"T59":         {seed: seed, t: time.Unix(59, 0), want: "287082"},
+		"T1111111109": {seed: seed, t: time.Unix(1111111109, 0), want: "081804"},
+		"T2000000000": {seed: seed, t: time.Unix(2000000000, 0), want: "279037"},
+		"Padded":      {seed: []byte("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ===="), t: time.Unix(59, 0), want: "287082"},
+		"InvalidSeed": {seed: []byte("not base32!"), err: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateTOTP(tc.seed, tc.t)
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+// simulationMaxItems is the largest page of results SimulatePrincipalPolicy
+// returns.
+const simulationMaxItems = 1000
+
+// PolicySimulator is the subset of the IAM API used to simulate requests
+// against the policies of an IAM identity.
+type PolicySimulator interface {
+	SimulatePrincipalPolicyRequest(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest
+}
+
+// PermissionsBoundaryARN returns the ARN of the supplied permissions
+// boundary, or an empty string if there is none.
+func PermissionsBoundaryARN(b *iam.AttachedPermissionsBoundary) string {
+	if b == nil {
+		return ""
+	}
+	return aws.StringValue(b.PermissionsBoundaryArn)
+}
+
+// SimulatePolicy simulates the supplied requests against the policies of the
+// IAM identity with the supplied ARN and returns the results, or nil if no
+// requests are supplied.
+func SimulatePolicy(ctx context.Context, c PolicySimulator, arn string, p *v1alpha1.PolicySimulationParameters) ([]v1alpha1.PolicySimulationResult, error) {
+	if p == nil || len(p.ActionNames) == 0 {
+		return nil, nil
+	}
+	rsp, err := c.SimulatePrincipalPolicyRequest(&iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(arn),
+		ActionNames:     p.ActionNames,
+		ResourceArns:    p.ResourceARNs,
+		MaxItems:        aws.Int64(simulationMaxItems),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return GeneratePolicySimulationResults(rsp.EvaluationResults), nil
+}
+
+// GeneratePolicySimulationResults converts the supplied evaluation results to
+// their API representation.
+func GeneratePolicySimulationResults(in []iam.EvaluationResult) []v1alpha1.PolicySimulationResult {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]v1alpha1.PolicySimulationResult, len(in))
+	for i, r := range in {
+		out[i] = v1alpha1.PolicySimulationResult{
+			ActionName:   aws.StringValue(r.EvalActionName),
+			ResourceName: aws.StringValue(r.EvalResourceName),
+			Decision:     string(r.EvalDecision),
+		}
+	}
+	return out
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+type simulatorFn func(*iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest
+
+func (fn simulatorFn) SimulatePrincipalPolicyRequest(i *iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+	return fn(i)
+}
+
+func TestSimulatePolicy(t *testing.T) {
+	arn := "arn:aws:iam::123456789012:role/some-role"
+	errBoom := errors.New("boom")
+	params := &v1alpha1.PolicySimulationParameters{
+		ActionNames:  []string{"iam:CreateUser"},
+		ResourceARNs: []string{"*"},
+	}
+
+	type want struct {
+		results []v1alpha1.PolicySimulationResult
+		err     error
+	}
+
+	cases := map[string]struct {
+		c    simulatorFn
+		p    *v1alpha1.PolicySimulationParameters
+		want want
+	}{
+		"NoSimulation": {},
+		"Successful": {
+			c: func(i *iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+				if diff := cmp.Diff(&iam.SimulatePrincipalPolicyInput{
+					PolicySourceArn: aws.String(arn),
+					ActionNames:     params.ActionNames,
+					ResourceArns:    params.ResourceARNs,
+					MaxItems:        aws.Int64(simulationMaxItems),
+				}, i, cmpopts.IgnoreUnexported(iam.SimulatePrincipalPolicyInput{})); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				return iam.SimulatePrincipalPolicyRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &iam.SimulatePrincipalPolicyOutput{
+						EvaluationResults: []iam.EvaluationResult{{
+							EvalActionName:   aws.String("iam:CreateUser"),
+							EvalResourceName: aws.String("*"),
+							EvalDecision:     iam.PolicyEvaluationDecisionTypeImplicitDeny,
+						}},
+					}},
+				}
+			},
+			p: params,
+			want: want{
+				results: []v1alpha1.PolicySimulationResult{{
+					ActionName:   "iam:CreateUser",
+					ResourceName: "*",
+					Decision:     "implicitDeny",
+				}},
+			},
+		},
+		"Failed": {
+			c: func(i *iam.SimulatePrincipalPolicyInput) iam.SimulatePrincipalPolicyRequest {
+				return iam.SimulatePrincipalPolicyRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+				}
+			},
+			p:    params,
+			want: want{err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			results, err := SimulatePolicy(context.Background(), tc.c, arn, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.results, results); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
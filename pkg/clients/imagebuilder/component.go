@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ComponentClient is the external client used for Component Custom Resource
+type ComponentClient interface {
+	CreateComponentRequest(*imagebuilder.CreateComponentInput) imagebuilder.CreateComponentRequest
+	GetComponentRequest(*imagebuilder.GetComponentInput) imagebuilder.GetComponentRequest
+	DeleteComponentRequest(*imagebuilder.DeleteComponentInput) imagebuilder.DeleteComponentRequest
+}
+
+// NewComponentClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewComponentClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ComponentClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return imagebuilder.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the Image Builder resource
+// doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == imagebuilder.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateComponentInput returns the input to create a component from
+// the supplied parameters. The client token makes retries of the same
+// creation idempotent.
+func GenerateCreateComponentInput(token string, p v1alpha1.ComponentParameters) *imagebuilder.CreateComponentInput {
+	return &imagebuilder.CreateComponentInput{
+		ClientToken:         aws.String(token),
+		Name:                aws.String(p.Name),
+		SemanticVersion:     aws.String(p.SemanticVersion),
+		Platform:            imagebuilder.Platform(p.Platform),
+		Data:                p.Data,
+		Uri:                 p.URI,
+		Description:         p.Description,
+		ChangeDescription:   p.ChangeDescription,
+		KmsKeyId:            p.KMSKeyID,
+		SupportedOsVersions: p.SupportedOSVersions,
+		Tags:                p.Tags,
+	}
+}
+
+// GenerateComponentObservation is used to produce
+// v1alpha1.ComponentObservation from imagebuilder.Component.
+func GenerateComponentObservation(c imagebuilder.Component) v1alpha1.ComponentObservation {
+	return v1alpha1.ComponentObservation{
+		ARN:       aws.StringValue(c.Arn),
+		Owner:     aws.StringValue(c.Owner),
+		Type:      string(c.Type),
+		Encrypted: aws.BoolValue(c.Encrypted),
+	}
+}
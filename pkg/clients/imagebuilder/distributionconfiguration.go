@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DistributionConfigurationClient is the external client used for
+// DistributionConfiguration Custom Resource
+type DistributionConfigurationClient interface {
+	CreateDistributionConfigurationRequest(*imagebuilder.CreateDistributionConfigurationInput) imagebuilder.CreateDistributionConfigurationRequest
+	GetDistributionConfigurationRequest(*imagebuilder.GetDistributionConfigurationInput) imagebuilder.GetDistributionConfigurationRequest
+	UpdateDistributionConfigurationRequest(*imagebuilder.UpdateDistributionConfigurationInput) imagebuilder.UpdateDistributionConfigurationRequest
+	DeleteDistributionConfigurationRequest(*imagebuilder.DeleteDistributionConfigurationInput) imagebuilder.DeleteDistributionConfigurationRequest
+}
+
+// NewDistributionConfigurationClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewDistributionConfigurationClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DistributionConfigurationClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return imagebuilder.New(*cfg), err
+}
+
+func generateDistributions(in []v1alpha1.Distribution) []imagebuilder.Distribution {
+	out := make([]imagebuilder.Distribution, len(in))
+	for i, d := range in {
+		out[i] = imagebuilder.Distribution{
+			Region:                   aws.String(d.Region),
+			LicenseConfigurationArns: d.LicenseConfigurationARNs,
+		}
+		if a := d.AMIDistribution; a != nil {
+			out[i].AmiDistributionConfiguration = &imagebuilder.AmiDistributionConfiguration{
+				Name:        a.Name,
+				Description: a.Description,
+				AmiTags:     a.AMITags,
+			}
+			if lp := a.LaunchPermission; lp != nil {
+				out[i].AmiDistributionConfiguration.LaunchPermission = &imagebuilder.LaunchPermissionConfiguration{
+					UserIds:    lp.UserIDs,
+					UserGroups: lp.UserGroups,
+				}
+			}
+		}
+	}
+	return out
+}
+
+// GenerateCreateDistributionConfigurationInput returns the input to create a
+// distribution configuration from the supplied parameters.
+func GenerateCreateDistributionConfigurationInput(token string, p v1alpha1.DistributionConfigurationParameters) *imagebuilder.CreateDistributionConfigurationInput {
+	return &imagebuilder.CreateDistributionConfigurationInput{
+		ClientToken:   aws.String(token),
+		Name:          aws.String(p.Name),
+		Description:   p.Description,
+		Distributions: generateDistributions(p.Distributions),
+		Tags:          p.Tags,
+	}
+}
+
+// GenerateUpdateDistributionConfigurationInput returns the input to update
+// the distribution configuration with the given ARN from the supplied
+// parameters.
+func GenerateUpdateDistributionConfigurationInput(token, arn string, p v1alpha1.DistributionConfigurationParameters) *imagebuilder.UpdateDistributionConfigurationInput {
+	return &imagebuilder.UpdateDistributionConfigurationInput{
+		ClientToken:                  aws.String(token),
+		DistributionConfigurationArn: aws.String(arn),
+		Description:                  p.Description,
+		Distributions:                generateDistributions(p.Distributions),
+	}
+}
+
+// GenerateDistributionConfigurationObservation is used to produce
+// v1alpha1.DistributionConfigurationObservation from
+// imagebuilder.DistributionConfiguration.
+func GenerateDistributionConfigurationObservation(c imagebuilder.DistributionConfiguration) v1alpha1.DistributionConfigurationObservation {
+	return v1alpha1.DistributionConfigurationObservation{
+		ARN:         aws.StringValue(c.Arn),
+		DateUpdated: aws.StringValue(c.DateUpdated),
+	}
+}
+
+// IsDistributionConfigurationUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsDistributionConfigurationUpToDate(p v1alpha1.DistributionConfigurationParameters, c imagebuilder.DistributionConfiguration) bool {
+	return aws.StringValue(p.Description) == aws.StringValue(c.Description) &&
+		cmp.Equal(generateDistributions(p.Distributions), c.Distributions, cmpopts.EquateEmpty(),
+			cmpopts.IgnoreUnexported(imagebuilder.Distribution{}, imagebuilder.AmiDistributionConfiguration{}, imagebuilder.LaunchPermissionConfiguration{}),
+			cmpopts.SortSlices(func(a, b imagebuilder.Distribution) bool {
+				return aws.StringValue(a.Region) < aws.StringValue(b.Region)
+			}))
+}
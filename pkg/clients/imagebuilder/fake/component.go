@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ComponentClient = (*MockComponentClient)(nil)
+
+// MockComponentClient is a type that implements all the methods for ComponentClient interface
+type MockComponentClient struct {
+	MockCreateComponent func(*imagebuilder.CreateComponentInput) imagebuilder.CreateComponentRequest
+	MockGetComponent    func(*imagebuilder.GetComponentInput) imagebuilder.GetComponentRequest
+	MockDeleteComponent func(*imagebuilder.DeleteComponentInput) imagebuilder.DeleteComponentRequest
+}
+
+// CreateComponentRequest calls the underlying MockCreateComponent method.
+func (c *MockComponentClient) CreateComponentRequest(i *imagebuilder.CreateComponentInput) imagebuilder.CreateComponentRequest {
+	return c.MockCreateComponent(i)
+}
+
+// GetComponentRequest calls the underlying MockGetComponent method.
+func (c *MockComponentClient) GetComponentRequest(i *imagebuilder.GetComponentInput) imagebuilder.GetComponentRequest {
+	return c.MockGetComponent(i)
+}
+
+// DeleteComponentRequest calls the underlying MockDeleteComponent method.
+func (c *MockComponentClient) DeleteComponentRequest(i *imagebuilder.DeleteComponentInput) imagebuilder.DeleteComponentRequest {
+	return c.MockDeleteComponent(i)
+}
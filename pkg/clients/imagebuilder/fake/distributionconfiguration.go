@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DistributionConfigurationClient = (*MockDistributionConfigurationClient)(nil)
+
+// MockDistributionConfigurationClient is a type that implements all the methods for DistributionConfigurationClient interface
+type MockDistributionConfigurationClient struct {
+	MockCreateDistributionConfiguration func(*imagebuilder.CreateDistributionConfigurationInput) imagebuilder.CreateDistributionConfigurationRequest
+	MockGetDistributionConfiguration    func(*imagebuilder.GetDistributionConfigurationInput) imagebuilder.GetDistributionConfigurationRequest
+	MockUpdateDistributionConfiguration func(*imagebuilder.UpdateDistributionConfigurationInput) imagebuilder.UpdateDistributionConfigurationRequest
+	MockDeleteDistributionConfiguration func(*imagebuilder.DeleteDistributionConfigurationInput) imagebuilder.DeleteDistributionConfigurationRequest
+}
+
+// CreateDistributionConfigurationRequest calls the underlying MockCreateDistributionConfiguration method.
+func (c *MockDistributionConfigurationClient) CreateDistributionConfigurationRequest(i *imagebuilder.CreateDistributionConfigurationInput) imagebuilder.CreateDistributionConfigurationRequest {
+	return c.MockCreateDistributionConfiguration(i)
+}
+
+// GetDistributionConfigurationRequest calls the underlying MockGetDistributionConfiguration method.
+func (c *MockDistributionConfigurationClient) GetDistributionConfigurationRequest(i *imagebuilder.GetDistributionConfigurationInput) imagebuilder.GetDistributionConfigurationRequest {
+	return c.MockGetDistributionConfiguration(i)
+}
+
+// UpdateDistributionConfigurationRequest calls the underlying MockUpdateDistributionConfiguration method.
+func (c *MockDistributionConfigurationClient) UpdateDistributionConfigurationRequest(i *imagebuilder.UpdateDistributionConfigurationInput) imagebuilder.UpdateDistributionConfigurationRequest {
+	return c.MockUpdateDistributionConfiguration(i)
+}
+
+// DeleteDistributionConfigurationRequest calls the underlying MockDeleteDistributionConfiguration method.
+func (c *MockDistributionConfigurationClient) DeleteDistributionConfigurationRequest(i *imagebuilder.DeleteDistributionConfigurationInput) imagebuilder.DeleteDistributionConfigurationRequest {
+	return c.MockDeleteDistributionConfiguration(i)
+}
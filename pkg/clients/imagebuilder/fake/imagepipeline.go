@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ImagePipelineClient = (*MockImagePipelineClient)(nil)
+
+// MockImagePipelineClient is a type that implements all the methods for ImagePipelineClient interface
+type MockImagePipelineClient struct {
+	MockCreateImagePipeline func(*imagebuilder.CreateImagePipelineInput) imagebuilder.CreateImagePipelineRequest
+	MockGetImagePipeline    func(*imagebuilder.GetImagePipelineInput) imagebuilder.GetImagePipelineRequest
+	MockUpdateImagePipeline func(*imagebuilder.UpdateImagePipelineInput) imagebuilder.UpdateImagePipelineRequest
+	MockDeleteImagePipeline func(*imagebuilder.DeleteImagePipelineInput) imagebuilder.DeleteImagePipelineRequest
+}
+
+// CreateImagePipelineRequest calls the underlying MockCreateImagePipeline method.
+func (c *MockImagePipelineClient) CreateImagePipelineRequest(i *imagebuilder.CreateImagePipelineInput) imagebuilder.CreateImagePipelineRequest {
+	return c.MockCreateImagePipeline(i)
+}
+
+// GetImagePipelineRequest calls the underlying MockGetImagePipeline method.
+func (c *MockImagePipelineClient) GetImagePipelineRequest(i *imagebuilder.GetImagePipelineInput) imagebuilder.GetImagePipelineRequest {
+	return c.MockGetImagePipeline(i)
+}
+
+// UpdateImagePipelineRequest calls the underlying MockUpdateImagePipeline method.
+func (c *MockImagePipelineClient) UpdateImagePipelineRequest(i *imagebuilder.UpdateImagePipelineInput) imagebuilder.UpdateImagePipelineRequest {
+	return c.MockUpdateImagePipeline(i)
+}
+
+// DeleteImagePipelineRequest calls the underlying MockDeleteImagePipeline method.
+func (c *MockImagePipelineClient) DeleteImagePipelineRequest(i *imagebuilder.DeleteImagePipelineInput) imagebuilder.DeleteImagePipelineRequest {
+	return c.MockDeleteImagePipeline(i)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ImageRecipeClient = (*MockImageRecipeClient)(nil)
+
+// MockImageRecipeClient is a type that implements all the methods for ImageRecipeClient interface
+type MockImageRecipeClient struct {
+	MockCreateImageRecipe func(*imagebuilder.CreateImageRecipeInput) imagebuilder.CreateImageRecipeRequest
+	MockGetImageRecipe    func(*imagebuilder.GetImageRecipeInput) imagebuilder.GetImageRecipeRequest
+	MockDeleteImageRecipe func(*imagebuilder.DeleteImageRecipeInput) imagebuilder.DeleteImageRecipeRequest
+}
+
+// CreateImageRecipeRequest calls the underlying MockCreateImageRecipe method.
+func (c *MockImageRecipeClient) CreateImageRecipeRequest(i *imagebuilder.CreateImageRecipeInput) imagebuilder.CreateImageRecipeRequest {
+	return c.MockCreateImageRecipe(i)
+}
+
+// GetImageRecipeRequest calls the underlying MockGetImageRecipe method.
+func (c *MockImageRecipeClient) GetImageRecipeRequest(i *imagebuilder.GetImageRecipeInput) imagebuilder.GetImageRecipeRequest {
+	return c.MockGetImageRecipe(i)
+}
+
+// DeleteImageRecipeRequest calls the underlying MockDeleteImageRecipe method.
+func (c *MockImageRecipeClient) DeleteImageRecipeRequest(i *imagebuilder.DeleteImageRecipeInput) imagebuilder.DeleteImageRecipeRequest {
+	return c.MockDeleteImageRecipe(i)
+}
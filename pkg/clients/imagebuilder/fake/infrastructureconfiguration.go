@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.InfrastructureConfigurationClient = (*MockInfrastructureConfigurationClient)(nil)
+
+// MockInfrastructureConfigurationClient is a type that implements all the methods for InfrastructureConfigurationClient interface
+type MockInfrastructureConfigurationClient struct {
+	MockCreateInfrastructureConfiguration func(*imagebuilder.CreateInfrastructureConfigurationInput) imagebuilder.CreateInfrastructureConfigurationRequest
+	MockGetInfrastructureConfiguration    func(*imagebuilder.GetInfrastructureConfigurationInput) imagebuilder.GetInfrastructureConfigurationRequest
+	MockUpdateInfrastructureConfiguration func(*imagebuilder.UpdateInfrastructureConfigurationInput) imagebuilder.UpdateInfrastructureConfigurationRequest
+	MockDeleteInfrastructureConfiguration func(*imagebuilder.DeleteInfrastructureConfigurationInput) imagebuilder.DeleteInfrastructureConfigurationRequest
+}
+
+// CreateInfrastructureConfigurationRequest calls the underlying MockCreateInfrastructureConfiguration method.
+func (c *MockInfrastructureConfigurationClient) CreateInfrastructureConfigurationRequest(i *imagebuilder.CreateInfrastructureConfigurationInput) imagebuilder.CreateInfrastructureConfigurationRequest {
+	return c.MockCreateInfrastructureConfiguration(i)
+}
+
+// GetInfrastructureConfigurationRequest calls the underlying MockGetInfrastructureConfiguration method.
+func (c *MockInfrastructureConfigurationClient) GetInfrastructureConfigurationRequest(i *imagebuilder.GetInfrastructureConfigurationInput) imagebuilder.GetInfrastructureConfigurationRequest {
+	return c.MockGetInfrastructureConfiguration(i)
+}
+
+// UpdateInfrastructureConfigurationRequest calls the underlying MockUpdateInfrastructureConfiguration method.
+func (c *MockInfrastructureConfigurationClient) UpdateInfrastructureConfigurationRequest(i *imagebuilder.UpdateInfrastructureConfigurationInput) imagebuilder.UpdateInfrastructureConfigurationRequest {
+	return c.MockUpdateInfrastructureConfiguration(i)
+}
+
+// DeleteInfrastructureConfigurationRequest calls the underlying MockDeleteInfrastructureConfiguration method.
+func (c *MockInfrastructureConfigurationClient) DeleteInfrastructureConfigurationRequest(i *imagebuilder.DeleteInfrastructureConfigurationInput) imagebuilder.DeleteInfrastructureConfigurationRequest {
+	return c.MockDeleteInfrastructureConfiguration(i)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ImagePipelineClient is the external client used for ImagePipeline Custom
+// Resource
+type ImagePipelineClient interface {
+	CreateImagePipelineRequest(*imagebuilder.CreateImagePipelineInput) imagebuilder.CreateImagePipelineRequest
+	GetImagePipelineRequest(*imagebuilder.GetImagePipelineInput) imagebuilder.GetImagePipelineRequest
+	UpdateImagePipelineRequest(*imagebuilder.UpdateImagePipelineInput) imagebuilder.UpdateImagePipelineRequest
+	DeleteImagePipelineRequest(*imagebuilder.DeleteImagePipelineInput) imagebuilder.DeleteImagePipelineRequest
+}
+
+// NewImagePipelineClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewImagePipelineClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ImagePipelineClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return imagebuilder.New(*cfg), err
+}
+
+func generateSchedule(in *v1alpha1.PipelineSchedule) *imagebuilder.Schedule {
+	if in == nil {
+		return nil
+	}
+	return &imagebuilder.Schedule{
+		ScheduleExpression:              aws.String(in.ScheduleExpression),
+		PipelineExecutionStartCondition: imagebuilder.PipelineExecutionStartCondition(aws.StringValue(in.PipelineExecutionStartCondition)),
+	}
+}
+
+func generateImageTestsConfiguration(in *v1alpha1.ImageTestsConfiguration) *imagebuilder.ImageTestsConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &imagebuilder.ImageTestsConfiguration{
+		ImageTestsEnabled: in.ImageTestsEnabled,
+		TimeoutMinutes:    in.TimeoutMinutes,
+	}
+}
+
+// GenerateCreateImagePipelineInput returns the input to create an image
+// pipeline from the supplied parameters.
+func GenerateCreateImagePipelineInput(token string, p v1alpha1.ImagePipelineParameters) *imagebuilder.CreateImagePipelineInput {
+	return &imagebuilder.CreateImagePipelineInput{
+		ClientToken:                    aws.String(token),
+		Name:                           aws.String(p.Name),
+		Description:                    p.Description,
+		ImageRecipeArn:                 p.ImageRecipeARN,
+		InfrastructureConfigurationArn: p.InfrastructureConfigurationARN,
+		DistributionConfigurationArn:   p.DistributionConfigurationARN,
+		EnhancedImageMetadataEnabled:   p.EnhancedImageMetadataEnabled,
+		ImageTestsConfiguration:        generateImageTestsConfiguration(p.ImageTestsConfiguration),
+		Schedule:                       generateSchedule(p.Schedule),
+		Status:                         imagebuilder.PipelineStatus(aws.StringValue(p.Status)),
+		Tags:                           p.Tags,
+	}
+}
+
+// GenerateUpdateImagePipelineInput returns the input to update the image
+// pipeline with the given ARN from the supplied parameters.
+func GenerateUpdateImagePipelineInput(token, arn string, p v1alpha1.ImagePipelineParameters) *imagebuilder.UpdateImagePipelineInput {
+	return &imagebuilder.UpdateImagePipelineInput{
+		ClientToken:                    aws.String(token),
+		ImagePipelineArn:               aws.String(arn),
+		Description:                    p.Description,
+		ImageRecipeArn:                 p.ImageRecipeARN,
+		InfrastructureConfigurationArn: p.InfrastructureConfigurationARN,
+		DistributionConfigurationArn:   p.DistributionConfigurationARN,
+		EnhancedImageMetadataEnabled:   p.EnhancedImageMetadataEnabled,
+		ImageTestsConfiguration:        generateImageTestsConfiguration(p.ImageTestsConfiguration),
+		Schedule:                       generateSchedule(p.Schedule),
+		Status:                         imagebuilder.PipelineStatus(aws.StringValue(p.Status)),
+	}
+}
+
+// LateInitializeImagePipeline fills the empty fields in
+// *v1alpha1.ImagePipelineParameters with the values seen in
+// imagebuilder.ImagePipeline.
+func LateInitializeImagePipeline(in *v1alpha1.ImagePipelineParameters, p *imagebuilder.ImagePipeline) {
+	if p == nil {
+		return
+	}
+	in.EnhancedImageMetadataEnabled = awsclients.LateInitializeBoolPtr(in.EnhancedImageMetadataEnabled, p.EnhancedImageMetadataEnabled)
+	if in.Status == nil && p.Status != "" {
+		in.Status = aws.String(string(p.Status))
+	}
+	if in.ImageTestsConfiguration == nil && p.ImageTestsConfiguration != nil {
+		in.ImageTestsConfiguration = &v1alpha1.ImageTestsConfiguration{
+			ImageTestsEnabled: p.ImageTestsConfiguration.ImageTestsEnabled,
+			TimeoutMinutes:    p.ImageTestsConfiguration.TimeoutMinutes,
+		}
+	}
+	if in.Schedule != nil && in.Schedule.PipelineExecutionStartCondition == nil &&
+		p.Schedule != nil && p.Schedule.PipelineExecutionStartCondition != "" {
+		in.Schedule.PipelineExecutionStartCondition = aws.String(string(p.Schedule.PipelineExecutionStartCondition))
+	}
+}
+
+// GenerateImagePipelineObservation is used to produce
+// v1alpha1.ImagePipelineObservation from imagebuilder.ImagePipeline.
+func GenerateImagePipelineObservation(p imagebuilder.ImagePipeline) v1alpha1.ImagePipelineObservation {
+	return v1alpha1.ImagePipelineObservation{
+		ARN:         aws.StringValue(p.Arn),
+		Platform:    string(p.Platform),
+		DateLastRun: aws.StringValue(p.DateLastRun),
+		DateNextRun: aws.StringValue(p.DateNextRun),
+	}
+}
+
+// IsImagePipelineUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsImagePipelineUpToDate(p v1alpha1.ImagePipelineParameters, o imagebuilder.ImagePipeline) bool {
+	if !isScheduleUpToDate(p.Schedule, o.Schedule) {
+		return false
+	}
+	if t := p.ImageTestsConfiguration; t != nil {
+		if o.ImageTestsConfiguration == nil ||
+			aws.BoolValue(t.ImageTestsEnabled) != aws.BoolValue(o.ImageTestsConfiguration.ImageTestsEnabled) ||
+			aws.Int64Value(t.TimeoutMinutes) != aws.Int64Value(o.ImageTestsConfiguration.TimeoutMinutes) {
+			return false
+		}
+	}
+	return aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		aws.StringValue(p.ImageRecipeARN) == aws.StringValue(o.ImageRecipeArn) &&
+		aws.StringValue(p.InfrastructureConfigurationARN) == aws.StringValue(o.InfrastructureConfigurationArn) &&
+		aws.StringValue(p.DistributionConfigurationARN) == aws.StringValue(o.DistributionConfigurationArn) &&
+		aws.BoolValue(p.EnhancedImageMetadataEnabled) == aws.BoolValue(o.EnhancedImageMetadataEnabled) &&
+		aws.StringValue(p.Status) == string(o.Status)
+}
+
+func isScheduleUpToDate(p *v1alpha1.PipelineSchedule, o *imagebuilder.Schedule) bool {
+	if p == nil || o == nil {
+		return p == nil && (o == nil || aws.StringValue(o.ScheduleExpression) == "")
+	}
+	return p.ScheduleExpression == aws.StringValue(o.ScheduleExpression) &&
+		(p.PipelineExecutionStartCondition == nil ||
+			aws.StringValue(p.PipelineExecutionStartCondition) == string(o.PipelineExecutionStartCondition))
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+)
+
+const (
+	recipeARN = "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/example/1.0.0"
+	infraARN  = "arn:aws:imagebuilder:us-east-1:123456789012:infrastructure-configuration/example"
+	cronDaily = "cron(0 0 * * ? *)"
+)
+
+func pipelineParams(m ...func(*v1alpha1.ImagePipelineParameters)) v1alpha1.ImagePipelineParameters {
+	p := v1alpha1.ImagePipelineParameters{
+		Name:                           "example",
+		ImageRecipeARN:                 aws.String(recipeARN),
+		InfrastructureConfigurationARN: aws.String(infraARN),
+		Schedule:                       &v1alpha1.PipelineSchedule{ScheduleExpression: cronDaily},
+		Status:                         aws.String("ENABLED"),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func pipeline(m ...func(*imagebuilder.ImagePipeline)) imagebuilder.ImagePipeline {
+	o := imagebuilder.ImagePipeline{
+		Name:                           aws.String("example"),
+		ImageRecipeArn:                 aws.String(recipeARN),
+		InfrastructureConfigurationArn: aws.String(infraARN),
+		EnhancedImageMetadataEnabled:   aws.Bool(true),
+		ImageTestsConfiguration:        &imagebuilder.ImageTestsConfiguration{ImageTestsEnabled: aws.Bool(true), TimeoutMinutes: aws.Int64(720)},
+		Schedule: &imagebuilder.Schedule{
+			ScheduleExpression:              aws.String(cronDaily),
+			PipelineExecutionStartCondition: imagebuilder.PipelineExecutionStartConditionExpressionMatchAndDependencyUpdatesAvailable,
+		},
+		Status: imagebuilder.PipelineStatusEnabled,
+	}
+	for _, f := range m {
+		f(&o)
+	}
+	return o
+}
+
+func TestLateInitializeImagePipeline(t *testing.T) {
+	cases := map[string]struct {
+		params   v1alpha1.ImagePipelineParameters
+		observed imagebuilder.ImagePipeline
+		want     v1alpha1.ImagePipelineParameters
+	}{
+		"FillsDefaults": {
+			params:   pipelineParams(func(p *v1alpha1.ImagePipelineParameters) { p.Status = nil }),
+			observed: pipeline(),
+			want: pipelineParams(func(p *v1alpha1.ImagePipelineParameters) {
+				p.EnhancedImageMetadataEnabled = aws.Bool(true)
+				p.ImageTestsConfiguration = &v1alpha1.ImageTestsConfiguration{ImageTestsEnabled: aws.Bool(true), TimeoutMinutes: aws.Int64(720)}
+				p.Schedule.PipelineExecutionStartCondition = aws.String("EXPRESSION_MATCH_AND_DEPENDENCY_UPDATES_AVAILABLE")
+			}),
+		},
+		"KeepsDesired": {
+			params: pipelineParams(func(p *v1alpha1.ImagePipelineParameters) {
+				p.EnhancedImageMetadataEnabled = aws.Bool(false)
+				p.Status = aws.String("DISABLED")
+			}),
+			observed: pipeline(func(o *imagebuilder.ImagePipeline) { o.ImageTestsConfiguration = nil }),
+			want: pipelineParams(func(p *v1alpha1.ImagePipelineParameters) {
+				p.EnhancedImageMetadataEnabled = aws.Bool(false)
+				p.Status = aws.String("DISABLED")
+				p.Schedule.PipelineExecutionStartCondition = aws.String("EXPRESSION_MATCH_AND_DEPENDENCY_UPDATES_AVAILABLE")
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeImagePipeline(&tc.params, &tc.observed)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsImagePipelineUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params   v1alpha1.ImagePipelineParameters
+		observed imagebuilder.ImagePipeline
+		want     bool
+	}{
+		"UpToDate": {
+			params: pipelineParams(func(p *v1alpha1.ImagePipelineParameters) {
+				p.EnhancedImageMetadataEnabled = aws.Bool(true)
+			}),
+			observed: pipeline(),
+			want:     true,
+		},
+		"RecipeChanged": {
+			params: pipelineParams(func(p *v1alpha1.ImagePipelineParameters) {
+				p.EnhancedImageMetadataEnabled = aws.Bool(true)
+				p.ImageRecipeARN = aws.String(recipeARN + "-2")
+			}),
+			observed: pipeline(),
+			want:     false,
+		},
+		"ScheduleRemoved": {
+			params: pipelineParams(func(p *v1alpha1.ImagePipelineParameters) {
+				p.EnhancedImageMetadataEnabled = aws.Bool(true)
+				p.Schedule = nil
+			}),
+			observed: pipeline(),
+			want:     false,
+		},
+		"StartConditionChanged": {
+			params: pipelineParams(func(p *v1alpha1.ImagePipelineParameters) {
+				p.EnhancedImageMetadataEnabled = aws.Bool(true)
+				p.Schedule.PipelineExecutionStartCondition = aws.String("EXPRESSION_MATCH_ONLY")
+			}),
+			observed: pipeline(),
+			want:     false,
+		},
+		"TestsTimeoutChanged": {
+			params: pipelineParams(func(p *v1alpha1.ImagePipelineParameters) {
+				p.EnhancedImageMetadataEnabled = aws.Bool(true)
+				p.ImageTestsConfiguration = &v1alpha1.ImageTestsConfiguration{ImageTestsEnabled: aws.Bool(true), TimeoutMinutes: aws.Int64(60)}
+			}),
+			observed: pipeline(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsImagePipelineUpToDate(tc.params, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ImageRecipeClient is the external client used for ImageRecipe Custom
+// Resource
+type ImageRecipeClient interface {
+	CreateImageRecipeRequest(*imagebuilder.CreateImageRecipeInput) imagebuilder.CreateImageRecipeRequest
+	GetImageRecipeRequest(*imagebuilder.GetImageRecipeInput) imagebuilder.GetImageRecipeRequest
+	DeleteImageRecipeRequest(*imagebuilder.DeleteImageRecipeInput) imagebuilder.DeleteImageRecipeRequest
+}
+
+// NewImageRecipeClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewImageRecipeClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ImageRecipeClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return imagebuilder.New(*cfg), err
+}
+
+func generateBlockDeviceMappings(in []v1alpha1.BlockDeviceMapping) []imagebuilder.InstanceBlockDeviceMapping {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]imagebuilder.InstanceBlockDeviceMapping, len(in))
+	for i, m := range in {
+		out[i] = imagebuilder.InstanceBlockDeviceMapping{
+			DeviceName:  m.DeviceName,
+			NoDevice:    m.NoDevice,
+			VirtualName: m.VirtualName,
+		}
+		if m.EBS != nil {
+			out[i].Ebs = &imagebuilder.EbsInstanceBlockDeviceSpecification{
+				DeleteOnTermination: m.EBS.DeleteOnTermination,
+				Encrypted:           m.EBS.Encrypted,
+				Iops:                m.EBS.IOPS,
+				KmsKeyId:            m.EBS.KMSKeyID,
+				SnapshotId:          m.EBS.SnapshotID,
+				VolumeSize:          m.EBS.VolumeSize,
+				VolumeType:          imagebuilder.EbsVolumeType(aws.StringValue(m.EBS.VolumeType)),
+			}
+		}
+	}
+	return out
+}
+
+// GenerateCreateImageRecipeInput returns the input to create an image recipe
+// from the supplied parameters.
+func GenerateCreateImageRecipeInput(token string, p v1alpha1.ImageRecipeParameters) *imagebuilder.CreateImageRecipeInput {
+	components := make([]imagebuilder.ComponentConfiguration, len(p.Components))
+	for i, c := range p.Components {
+		components[i] = imagebuilder.ComponentConfiguration{ComponentArn: c.ComponentARN}
+	}
+	return &imagebuilder.CreateImageRecipeInput{
+		ClientToken:         aws.String(token),
+		Name:                aws.String(p.Name),
+		SemanticVersion:     aws.String(p.SemanticVersion),
+		ParentImage:         aws.String(p.ParentImage),
+		Components:          components,
+		BlockDeviceMappings: generateBlockDeviceMappings(p.BlockDeviceMappings),
+		Description:         p.Description,
+		Tags:                p.Tags,
+	}
+}
+
+// GenerateImageRecipeObservation is used to produce
+// v1alpha1.ImageRecipeObservation from imagebuilder.ImageRecipe.
+func GenerateImageRecipeObservation(r imagebuilder.ImageRecipe) v1alpha1.ImageRecipeObservation {
+	return v1alpha1.ImageRecipeObservation{
+		ARN:      aws.StringValue(r.Arn),
+		Owner:    aws.StringValue(r.Owner),
+		Platform: string(r.Platform),
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// InfrastructureConfigurationClient is the external client used for
+// InfrastructureConfiguration Custom Resource
+type InfrastructureConfigurationClient interface {
+	CreateInfrastructureConfigurationRequest(*imagebuilder.CreateInfrastructureConfigurationInput) imagebuilder.CreateInfrastructureConfigurationRequest
+	GetInfrastructureConfigurationRequest(*imagebuilder.GetInfrastructureConfigurationInput) imagebuilder.GetInfrastructureConfigurationRequest
+	UpdateInfrastructureConfigurationRequest(*imagebuilder.UpdateInfrastructureConfigurationInput) imagebuilder.UpdateInfrastructureConfigurationRequest
+	DeleteInfrastructureConfigurationRequest(*imagebuilder.DeleteInfrastructureConfigurationInput) imagebuilder.DeleteInfrastructureConfigurationRequest
+}
+
+// NewInfrastructureConfigurationClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewInfrastructureConfigurationClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (InfrastructureConfigurationClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return imagebuilder.New(*cfg), err
+}
+
+func generateLogging(in *v1alpha1.S3Logs) *imagebuilder.Logging {
+	if in == nil {
+		return nil
+	}
+	return &imagebuilder.Logging{S3Logs: &imagebuilder.S3Logs{
+		S3BucketName: aws.String(in.S3BucketName),
+		S3KeyPrefix:  in.S3KeyPrefix,
+	}}
+}
+
+// GenerateCreateInfrastructureConfigurationInput returns the input to create
+// an infrastructure configuration from the supplied parameters.
+func GenerateCreateInfrastructureConfigurationInput(token string, p v1alpha1.InfrastructureConfigurationParameters) *imagebuilder.CreateInfrastructureConfigurationInput {
+	return &imagebuilder.CreateInfrastructureConfigurationInput{
+		ClientToken:                aws.String(token),
+		Name:                       aws.String(p.Name),
+		Description:                p.Description,
+		InstanceProfileName:        aws.String(p.InstanceProfileName),
+		InstanceTypes:              p.InstanceTypes,
+		KeyPair:                    p.KeyPair,
+		Logging:                    generateLogging(p.S3Logs),
+		SecurityGroupIds:           p.SecurityGroupIDs,
+		SubnetId:                   p.SubnetID,
+		SnsTopicArn:                p.SNSTopicARN,
+		TerminateInstanceOnFailure: p.TerminateInstanceOnFailure,
+		Tags:                       p.Tags,
+	}
+}
+
+// GenerateUpdateInfrastructureConfigurationInput returns the input to update
+// the infrastructure configuration with the given ARN from the supplied
+// parameters.
+func GenerateUpdateInfrastructureConfigurationInput(token, arn string, p v1alpha1.InfrastructureConfigurationParameters) *imagebuilder.UpdateInfrastructureConfigurationInput {
+	return &imagebuilder.UpdateInfrastructureConfigurationInput{
+		ClientToken:                    aws.String(token),
+		InfrastructureConfigurationArn: aws.String(arn),
+		Description:                    p.Description,
+		InstanceProfileName:            aws.String(p.InstanceProfileName),
+		InstanceTypes:                  p.InstanceTypes,
+		KeyPair:                        p.KeyPair,
+		Logging:                        generateLogging(p.S3Logs),
+		SecurityGroupIds:               p.SecurityGroupIDs,
+		SubnetId:                       p.SubnetID,
+		SnsTopicArn:                    p.SNSTopicARN,
+		TerminateInstanceOnFailure:     p.TerminateInstanceOnFailure,
+	}
+}
+
+// LateInitializeInfrastructureConfiguration fills the empty fields in
+// *v1alpha1.InfrastructureConfigurationParameters with the values seen in
+// imagebuilder.InfrastructureConfiguration.
+func LateInitializeInfrastructureConfiguration(in *v1alpha1.InfrastructureConfigurationParameters, c *imagebuilder.InfrastructureConfiguration) {
+	if c == nil {
+		return
+	}
+	in.TerminateInstanceOnFailure = awsclients.LateInitializeBoolPtr(in.TerminateInstanceOnFailure, c.TerminateInstanceOnFailure)
+	if len(in.InstanceTypes) == 0 && len(c.InstanceTypes) != 0 {
+		in.InstanceTypes = c.InstanceTypes
+	}
+}
+
+// GenerateInfrastructureConfigurationObservation is used to produce
+// v1alpha1.InfrastructureConfigurationObservation from
+// imagebuilder.InfrastructureConfiguration.
+func GenerateInfrastructureConfigurationObservation(c imagebuilder.InfrastructureConfiguration) v1alpha1.InfrastructureConfigurationObservation {
+	return v1alpha1.InfrastructureConfigurationObservation{
+		ARN:         aws.StringValue(c.Arn),
+		DateUpdated: aws.StringValue(c.DateUpdated),
+	}
+}
+
+// IsInfrastructureConfigurationUpToDate returns true if there is no
+// update-able difference between desired and observed state of the resource.
+func IsInfrastructureConfigurationUpToDate(p v1alpha1.InfrastructureConfigurationParameters, c imagebuilder.InfrastructureConfiguration) bool {
+	var logs *imagebuilder.S3Logs
+	if l := generateLogging(p.S3Logs); l != nil {
+		logs = l.S3Logs
+	}
+	var observedLogs *imagebuilder.S3Logs
+	if c.Logging != nil {
+		observedLogs = c.Logging.S3Logs
+	}
+	sortSlices := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return aws.StringValue(p.Description) == aws.StringValue(c.Description) &&
+		p.InstanceProfileName == aws.StringValue(c.InstanceProfileName) &&
+		cmp.Equal(p.InstanceTypes, c.InstanceTypes, sortSlices, cmpopts.EquateEmpty()) &&
+		aws.StringValue(p.KeyPair) == aws.StringValue(c.KeyPair) &&
+		cmp.Equal(logs, observedLogs, cmpopts.IgnoreUnexported(imagebuilder.S3Logs{})) &&
+		cmp.Equal(p.SecurityGroupIDs, c.SecurityGroupIds, sortSlices, cmpopts.EquateEmpty()) &&
+		aws.StringValue(p.SubnetID) == aws.StringValue(c.SubnetId) &&
+		aws.StringValue(p.SNSTopicARN) == aws.StringValue(c.SnsTopicArn) &&
+		aws.BoolValue(p.TerminateInstanceOnFailure) == aws.BoolValue(c.TerminateInstanceOnFailure)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Keys of the connection details of a Certificate.
+const (
+	ConnectionKeyCertificatePEM = "certificatePem"
+	ConnectionKeyPrivateKey     = "privateKey"
+	ConnectionKeyPublicKey      = "publicKey"
+)
+
+// CertificateClient is the external client used for Certificate Custom
+// Resource
+type CertificateClient interface {
+	CreateKeysAndCertificateRequest(*iot.CreateKeysAndCertificateInput) iot.CreateKeysAndCertificateRequest
+	CreateCertificateFromCsrRequest(*iot.CreateCertificateFromCsrInput) iot.CreateCertificateFromCsrRequest
+	DescribeCertificateRequest(*iot.DescribeCertificateInput) iot.DescribeCertificateRequest
+	UpdateCertificateRequest(*iot.UpdateCertificateInput) iot.UpdateCertificateRequest
+	DeleteCertificateRequest(*iot.DeleteCertificateInput) iot.DeleteCertificateRequest
+	ListAttachedPoliciesRequest(*iot.ListAttachedPoliciesInput) iot.ListAttachedPoliciesRequest
+	AttachPolicyRequest(*iot.AttachPolicyInput) iot.AttachPolicyRequest
+	DetachPolicyRequest(*iot.DetachPolicyInput) iot.DetachPolicyRequest
+	ListPrincipalThingsRequest(*iot.ListPrincipalThingsInput) iot.ListPrincipalThingsRequest
+	AttachThingPrincipalRequest(*iot.AttachThingPrincipalInput) iot.AttachThingPrincipalRequest
+	DetachThingPrincipalRequest(*iot.DetachThingPrincipalInput) iot.DetachThingPrincipalRequest
+}
+
+// NewCertificateClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewCertificateClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (CertificateClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return iot.New(*cfg), err
+}
+
+// ListAttachedPolicyNames returns the names of the policies attached to the
+// supplied principal.
+func ListAttachedPolicyNames(ctx context.Context, c CertificateClient, principal string) ([]string, error) {
+	input := &iot.ListAttachedPoliciesInput{Target: aws.String(principal)}
+	var names []string
+	for {
+		rsp, err := c.ListAttachedPoliciesRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range rsp.Policies {
+			names = append(names, aws.StringValue(p.PolicyName))
+		}
+		if aws.StringValue(rsp.NextMarker) == "" {
+			return names, nil
+		}
+		input.Marker = rsp.NextMarker
+	}
+}
+
+// ListPrincipalThingNames returns the names of the things the supplied
+// principal is attached to.
+func ListPrincipalThingNames(ctx context.Context, c CertificateClient, principal string) ([]string, error) {
+	input := &iot.ListPrincipalThingsInput{Principal: aws.String(principal)}
+	var names []string
+	for {
+		rsp, err := c.ListPrincipalThingsRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, rsp.Things...)
+		if aws.StringValue(rsp.NextToken) == "" {
+			return names, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+// DiffNames returns the names that need to be attached and detached to reach
+// the desired state.
+func DiffNames(desired, observed []string) (attach, detach []string) {
+	d := make(map[string]bool, len(desired))
+	for _, n := range desired {
+		d[n] = true
+	}
+	o := make(map[string]bool, len(observed))
+	for _, n := range observed {
+		o[n] = true
+		if !d[n] {
+			detach = append(detach, n)
+		}
+	}
+	for _, n := range desired {
+		if !o[n] {
+			attach = append(attach, n)
+		}
+	}
+	return attach, detach
+}
+
+// LateInitializeCertificate fills the empty fields of the supplied
+// parameters with the values of the observed certificate.
+func LateInitializeCertificate(p *v1alpha1.CertificateParameters, d iot.CertificateDescription) {
+	if p.Status == nil && d.Status != "" {
+		p.Status = aws.String(string(d.Status))
+	}
+}
+
+// GenerateCertificateObservation returns the observation of the supplied
+// certificate.
+func GenerateCertificateObservation(d iot.CertificateDescription) v1alpha1.CertificateObservation {
+	o := v1alpha1.CertificateObservation{
+		CertificateARN: aws.StringValue(d.CertificateArn),
+		Status:         string(d.Status),
+	}
+	if d.Validity != nil && d.Validity.NotAfter != nil {
+		t := metav1.NewTime(*d.Validity.NotAfter)
+		o.NotAfter = &t
+	}
+	return o
+}
+
+// GetCertificateConnectionDetails returns the connection details of the
+// supplied certificate.
+func GetCertificateConnectionDetails(d iot.CertificateDescription) managed.ConnectionDetails {
+	if d.CertificatePem == nil {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		ConnectionKeyCertificatePEM: []byte(aws.StringValue(d.CertificatePem)),
+	}
+}
+
+// IsCertificateUpToDate returns true if the status and attachments of the
+// supplied certificate match the parameters.
+func IsCertificateUpToDate(p v1alpha1.CertificateParameters, d iot.CertificateDescription, policies, things []string) bool {
+	if aws.StringValue(p.Status) != string(d.Status) {
+		return false
+	}
+	if attach, detach := DiffNames(p.PolicyNames, policies); len(attach) != 0 || len(detach) != 0 {
+		return false
+	}
+	attach, detach := DiffNames(p.ThingNames, things)
+	return len(attach) == 0 && len(detach) == 0
+}
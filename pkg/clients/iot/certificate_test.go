@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffNames(t *testing.T) {
+	type want struct {
+		attach []string
+		detach []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"Equal": {
+			desired:  []string{"a", "b"},
+			observed: []string{"b", "a"},
+		},
+		"AttachAndDetach": {
+			desired:  []string{"a", "c"},
+			observed: []string{"a", "b"},
+			want: want{
+				attach: []string{"c"},
+				detach: []string{"b"},
+			},
+		},
+		"DetachAll": {
+			observed: []string{"a"},
+			want: want{
+				detach: []string{"a"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffNames(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.attach, attach); diff != "" {
+				t.Errorf("attach: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detach, detach); diff != "" {
+				t.Errorf("detach: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
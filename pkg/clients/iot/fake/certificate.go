@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iot"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CertificateClient = (*MockCertificateClient)(nil)
+
+// MockCertificateClient is a type that implements all the methods for CertificateClient interface
+type MockCertificateClient struct {
+	MockCreateKeysAndCertificate func(*iot.CreateKeysAndCertificateInput) iot.CreateKeysAndCertificateRequest
+	MockCreateCertificateFromCsr func(*iot.CreateCertificateFromCsrInput) iot.CreateCertificateFromCsrRequest
+	MockDescribeCertificate      func(*iot.DescribeCertificateInput) iot.DescribeCertificateRequest
+	MockUpdateCertificate        func(*iot.UpdateCertificateInput) iot.UpdateCertificateRequest
+	MockDeleteCertificate        func(*iot.DeleteCertificateInput) iot.DeleteCertificateRequest
+	MockListAttachedPolicies     func(*iot.ListAttachedPoliciesInput) iot.ListAttachedPoliciesRequest
+	MockAttachPolicy             func(*iot.AttachPolicyInput) iot.AttachPolicyRequest
+	MockDetachPolicy             func(*iot.DetachPolicyInput) iot.DetachPolicyRequest
+	MockListPrincipalThings      func(*iot.ListPrincipalThingsInput) iot.ListPrincipalThingsRequest
+	MockAttachThingPrincipal     func(*iot.AttachThingPrincipalInput) iot.AttachThingPrincipalRequest
+	MockDetachThingPrincipal     func(*iot.DetachThingPrincipalInput) iot.DetachThingPrincipalRequest
+}
+
+// CreateKeysAndCertificateRequest calls the underlying MockCreateKeysAndCertificate method.
+func (c *MockCertificateClient) CreateKeysAndCertificateRequest(i *iot.CreateKeysAndCertificateInput) iot.CreateKeysAndCertificateRequest {
+	return c.MockCreateKeysAndCertificate(i)
+}
+
+// CreateCertificateFromCsrRequest calls the underlying MockCreateCertificateFromCsr method.
+func (c *MockCertificateClient) CreateCertificateFromCsrRequest(i *iot.CreateCertificateFromCsrInput) iot.CreateCertificateFromCsrRequest {
+	return c.MockCreateCertificateFromCsr(i)
+}
+
+// DescribeCertificateRequest calls the underlying MockDescribeCertificate method.
+func (c *MockCertificateClient) DescribeCertificateRequest(i *iot.DescribeCertificateInput) iot.DescribeCertificateRequest {
+	return c.MockDescribeCertificate(i)
+}
+
+// UpdateCertificateRequest calls the underlying MockUpdateCertificate method.
+func (c *MockCertificateClient) UpdateCertificateRequest(i *iot.UpdateCertificateInput) iot.UpdateCertificateRequest {
+	return c.MockUpdateCertificate(i)
+}
+
+// DeleteCertificateRequest calls the underlying MockDeleteCertificate method.
+func (c *MockCertificateClient) DeleteCertificateRequest(i *iot.DeleteCertificateInput) iot.DeleteCertificateRequest {
+	return c.MockDeleteCertificate(i)
+}
+
+// ListAttachedPoliciesRequest calls the underlying MockListAttachedPolicies method.
+func (c *MockCertificateClient) ListAttachedPoliciesRequest(i *iot.ListAttachedPoliciesInput) iot.ListAttachedPoliciesRequest {
+	return c.MockListAttachedPolicies(i)
+}
+
+// AttachPolicyRequest calls the underlying MockAttachPolicy method.
+func (c *MockCertificateClient) AttachPolicyRequest(i *iot.AttachPolicyInput) iot.AttachPolicyRequest {
+	return c.MockAttachPolicy(i)
+}
+
+// DetachPolicyRequest calls the underlying MockDetachPolicy method.
+func (c *MockCertificateClient) DetachPolicyRequest(i *iot.DetachPolicyInput) iot.DetachPolicyRequest {
+	return c.MockDetachPolicy(i)
+}
+
+// ListPrincipalThingsRequest calls the underlying MockListPrincipalThings method.
+func (c *MockCertificateClient) ListPrincipalThingsRequest(i *iot.ListPrincipalThingsInput) iot.ListPrincipalThingsRequest {
+	return c.MockListPrincipalThings(i)
+}
+
+// AttachThingPrincipalRequest calls the underlying MockAttachThingPrincipal method.
+func (c *MockCertificateClient) AttachThingPrincipalRequest(i *iot.AttachThingPrincipalInput) iot.AttachThingPrincipalRequest {
+	return c.MockAttachThingPrincipal(i)
+}
+
+// DetachThingPrincipalRequest calls the underlying MockDetachThingPrincipal method.
+func (c *MockCertificateClient) DetachThingPrincipalRequest(i *iot.DetachThingPrincipalInput) iot.DetachThingPrincipalRequest {
+	return c.MockDetachThingPrincipal(i)
+}
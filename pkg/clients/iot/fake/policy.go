@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iot"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PolicyClient = (*MockPolicyClient)(nil)
+
+// MockPolicyClient is a type that implements all the methods for PolicyClient interface
+type MockPolicyClient struct {
+	MockCreatePolicy        func(*iot.CreatePolicyInput) iot.CreatePolicyRequest
+	MockGetPolicy           func(*iot.GetPolicyInput) iot.GetPolicyRequest
+	MockCreatePolicyVersion func(*iot.CreatePolicyVersionInput) iot.CreatePolicyVersionRequest
+	MockListPolicyVersions  func(*iot.ListPolicyVersionsInput) iot.ListPolicyVersionsRequest
+	MockDeletePolicyVersion func(*iot.DeletePolicyVersionInput) iot.DeletePolicyVersionRequest
+	MockDeletePolicy        func(*iot.DeletePolicyInput) iot.DeletePolicyRequest
+}
+
+// CreatePolicyRequest calls the underlying MockCreatePolicy method.
+func (c *MockPolicyClient) CreatePolicyRequest(i *iot.CreatePolicyInput) iot.CreatePolicyRequest {
+	return c.MockCreatePolicy(i)
+}
+
+// GetPolicyRequest calls the underlying MockGetPolicy method.
+func (c *MockPolicyClient) GetPolicyRequest(i *iot.GetPolicyInput) iot.GetPolicyRequest {
+	return c.MockGetPolicy(i)
+}
+
+// CreatePolicyVersionRequest calls the underlying MockCreatePolicyVersion method.
+func (c *MockPolicyClient) CreatePolicyVersionRequest(i *iot.CreatePolicyVersionInput) iot.CreatePolicyVersionRequest {
+	return c.MockCreatePolicyVersion(i)
+}
+
+// ListPolicyVersionsRequest calls the underlying MockListPolicyVersions method.
+func (c *MockPolicyClient) ListPolicyVersionsRequest(i *iot.ListPolicyVersionsInput) iot.ListPolicyVersionsRequest {
+	return c.MockListPolicyVersions(i)
+}
+
+// DeletePolicyVersionRequest calls the underlying MockDeletePolicyVersion method.
+func (c *MockPolicyClient) DeletePolicyVersionRequest(i *iot.DeletePolicyVersionInput) iot.DeletePolicyVersionRequest {
+	return c.MockDeletePolicyVersion(i)
+}
+
+// DeletePolicyRequest calls the underlying MockDeletePolicy method.
+func (c *MockPolicyClient) DeletePolicyRequest(i *iot.DeletePolicyInput) iot.DeletePolicyRequest {
+	return c.MockDeletePolicy(i)
+}
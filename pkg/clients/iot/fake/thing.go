@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iot"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ThingClient = (*MockThingClient)(nil)
+
+// MockThingClient is a type that implements all the methods for ThingClient interface
+type MockThingClient struct {
+	MockCreateThing   func(*iot.CreateThingInput) iot.CreateThingRequest
+	MockDescribeThing func(*iot.DescribeThingInput) iot.DescribeThingRequest
+	MockUpdateThing   func(*iot.UpdateThingInput) iot.UpdateThingRequest
+	MockDeleteThing   func(*iot.DeleteThingInput) iot.DeleteThingRequest
+}
+
+// CreateThingRequest calls the underlying MockCreateThing method.
+func (c *MockThingClient) CreateThingRequest(i *iot.CreateThingInput) iot.CreateThingRequest {
+	return c.MockCreateThing(i)
+}
+
+// DescribeThingRequest calls the underlying MockDescribeThing method.
+func (c *MockThingClient) DescribeThingRequest(i *iot.DescribeThingInput) iot.DescribeThingRequest {
+	return c.MockDescribeThing(i)
+}
+
+// UpdateThingRequest calls the underlying MockUpdateThing method.
+func (c *MockThingClient) UpdateThingRequest(i *iot.UpdateThingInput) iot.UpdateThingRequest {
+	return c.MockUpdateThing(i)
+}
+
+// DeleteThingRequest calls the underlying MockDeleteThing method.
+func (c *MockThingClient) DeleteThingRequest(i *iot.DeleteThingInput) iot.DeleteThingRequest {
+	return c.MockDeleteThing(i)
+}
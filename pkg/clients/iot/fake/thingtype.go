@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iot"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ThingTypeClient = (*MockThingTypeClient)(nil)
+
+// MockThingTypeClient is a type that implements all the methods for ThingTypeClient interface
+type MockThingTypeClient struct {
+	MockCreateThingType    func(*iot.CreateThingTypeInput) iot.CreateThingTypeRequest
+	MockDescribeThingType  func(*iot.DescribeThingTypeInput) iot.DescribeThingTypeRequest
+	MockDeprecateThingType func(*iot.DeprecateThingTypeInput) iot.DeprecateThingTypeRequest
+	MockDeleteThingType    func(*iot.DeleteThingTypeInput) iot.DeleteThingTypeRequest
+}
+
+// CreateThingTypeRequest calls the underlying MockCreateThingType method.
+func (c *MockThingTypeClient) CreateThingTypeRequest(i *iot.CreateThingTypeInput) iot.CreateThingTypeRequest {
+	return c.MockCreateThingType(i)
+}
+
+// DescribeThingTypeRequest calls the underlying MockDescribeThingType method.
+func (c *MockThingTypeClient) DescribeThingTypeRequest(i *iot.DescribeThingTypeInput) iot.DescribeThingTypeRequest {
+	return c.MockDescribeThingType(i)
+}
+
+// DeprecateThingTypeRequest calls the underlying MockDeprecateThingType method.
+func (c *MockThingTypeClient) DeprecateThingTypeRequest(i *iot.DeprecateThingTypeInput) iot.DeprecateThingTypeRequest {
+	return c.MockDeprecateThingType(i)
+}
+
+// DeleteThingTypeRequest calls the underlying MockDeleteThingType method.
+func (c *MockThingTypeClient) DeleteThingTypeRequest(i *iot.DeleteThingTypeInput) iot.DeleteThingTypeRequest {
+	return c.MockDeleteThingType(i)
+}
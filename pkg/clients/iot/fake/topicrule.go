@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iot"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TopicRuleClient = (*MockTopicRuleClient)(nil)
+
+// MockTopicRuleClient is a type that implements all the methods for TopicRuleClient interface
+type MockTopicRuleClient struct {
+	MockCreateTopicRule  func(*iot.CreateTopicRuleInput) iot.CreateTopicRuleRequest
+	MockGetTopicRule     func(*iot.GetTopicRuleInput) iot.GetTopicRuleRequest
+	MockReplaceTopicRule func(*iot.ReplaceTopicRuleInput) iot.ReplaceTopicRuleRequest
+	MockDeleteTopicRule  func(*iot.DeleteTopicRuleInput) iot.DeleteTopicRuleRequest
+}
+
+// CreateTopicRuleRequest calls the underlying MockCreateTopicRule method.
+func (c *MockTopicRuleClient) CreateTopicRuleRequest(i *iot.CreateTopicRuleInput) iot.CreateTopicRuleRequest {
+	return c.MockCreateTopicRule(i)
+}
+
+// GetTopicRuleRequest calls the underlying MockGetTopicRule method.
+func (c *MockTopicRuleClient) GetTopicRuleRequest(i *iot.GetTopicRuleInput) iot.GetTopicRuleRequest {
+	return c.MockGetTopicRule(i)
+}
+
+// ReplaceTopicRuleRequest calls the underlying MockReplaceTopicRule method.
+func (c *MockTopicRuleClient) ReplaceTopicRuleRequest(i *iot.ReplaceTopicRuleInput) iot.ReplaceTopicRuleRequest {
+	return c.MockReplaceTopicRule(i)
+}
+
+// DeleteTopicRuleRequest calls the underlying MockDeleteTopicRule method.
+func (c *MockTopicRuleClient) DeleteTopicRuleRequest(i *iot.DeleteTopicRuleInput) iot.DeleteTopicRuleRequest {
+	return c.MockDeleteTopicRule(i)
+}
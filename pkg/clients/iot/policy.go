@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// MaxPolicyVersions is the number of versions an AWS IoT policy can have.
+const MaxPolicyVersions = 5
+
+// PolicyClient is the external client used for Policy Custom Resource
+type PolicyClient interface {
+	CreatePolicyRequest(*iot.CreatePolicyInput) iot.CreatePolicyRequest
+	GetPolicyRequest(*iot.GetPolicyInput) iot.GetPolicyRequest
+	CreatePolicyVersionRequest(*iot.CreatePolicyVersionInput) iot.CreatePolicyVersionRequest
+	ListPolicyVersionsRequest(*iot.ListPolicyVersionsInput) iot.ListPolicyVersionsRequest
+	DeletePolicyVersionRequest(*iot.DeletePolicyVersionInput) iot.DeletePolicyVersionRequest
+	DeletePolicyRequest(*iot.DeletePolicyInput) iot.DeletePolicyRequest
+}
+
+// NewPolicyClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewPolicyClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (PolicyClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return iot.New(*cfg), err
+}
+
+// IsPolicyUpToDate returns true if the document of the supplied policy
+// matches the parameters, ignoring differences in formatting.
+func IsPolicyUpToDate(p v1alpha1.PolicyParameters, o iot.GetPolicyOutput) (bool, error) {
+	desired, err := awsclients.CompactAndEscapeJSON(p.Document)
+	if err != nil {
+		return false, err
+	}
+	observed, err := awsclients.CompactAndEscapeJSON(aws.StringValue(o.PolicyDocument))
+	if err != nil {
+		return false, err
+	}
+	return desired == observed, nil
+}
+
+// OldestNonDefaultPolicyVersion returns the ID of the oldest version of a
+// policy that is not in effect, or nil if there is none.
+func OldestNonDefaultPolicyVersion(versions []iot.PolicyVersion) *string {
+	var oldest *iot.PolicyVersion
+	for i := range versions {
+		v := versions[i]
+		if aws.BoolValue(v.IsDefaultVersion) {
+			continue
+		}
+		if oldest == nil || (v.CreateDate != nil && oldest.CreateDate != nil && v.CreateDate.Before(*oldest.CreateDate)) {
+			oldest = &v
+		}
+	}
+	if oldest == nil {
+		return nil
+	}
+	return oldest.VersionId
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ThingClient is the external client used for Thing Custom Resource
+type ThingClient interface {
+	CreateThingRequest(*iot.CreateThingInput) iot.CreateThingRequest
+	DescribeThingRequest(*iot.DescribeThingInput) iot.DescribeThingRequest
+	UpdateThingRequest(*iot.UpdateThingInput) iot.UpdateThingRequest
+	DeleteThingRequest(*iot.DeleteThingInput) iot.DeleteThingRequest
+}
+
+// NewThingClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewThingClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ThingClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return iot.New(*cfg), err
+}
+
+// GenerateCreateThingInput returns the input to create a thing with the
+// supplied name and parameters.
+func GenerateCreateThingInput(name string, p v1alpha1.ThingParameters) *iot.CreateThingInput {
+	in := &iot.CreateThingInput{
+		ThingName:     aws.String(name),
+		ThingTypeName: p.ThingTypeName,
+	}
+	if len(p.Attributes) != 0 {
+		in.AttributePayload = &iot.AttributePayload{Attributes: p.Attributes}
+	}
+	return in
+}
+
+// GenerateUpdateThingInput returns the input to update the supplied thing to
+// the desired parameters. The attributes of the thing are replaced rather
+// than merged, so that attributes removed from the parameters are removed
+// from the thing.
+func GenerateUpdateThingInput(p v1alpha1.ThingParameters, t iot.DescribeThingOutput) *iot.UpdateThingInput {
+	in := &iot.UpdateThingInput{
+		ThingName:       t.ThingName,
+		ExpectedVersion: t.Version,
+		ThingTypeName:   p.ThingTypeName,
+		AttributePayload: &iot.AttributePayload{
+			Attributes: p.Attributes,
+			Merge:      aws.Bool(false),
+		},
+	}
+	if in.AttributePayload.Attributes == nil {
+		in.AttributePayload.Attributes = map[string]string{}
+	}
+	if p.ThingTypeName == nil && t.ThingTypeName != nil {
+		in.RemoveThingType = aws.Bool(true)
+	}
+	return in
+}
+
+// LateInitializeThing fills the empty fields of the supplied parameters with
+// the values of the observed thing.
+func LateInitializeThing(p *v1alpha1.ThingParameters, t iot.DescribeThingOutput) {
+	p.ThingTypeName = awsclients.LateInitializeStringPtr(p.ThingTypeName, t.ThingTypeName)
+	if p.Attributes == nil && len(t.Attributes) != 0 {
+		p.Attributes = t.Attributes
+	}
+}
+
+// GenerateThingObservation returns the observation of the supplied thing.
+func GenerateThingObservation(t iot.DescribeThingOutput) v1alpha1.ThingObservation {
+	return v1alpha1.ThingObservation{
+		ThingARN: aws.StringValue(t.ThingArn),
+		ThingID:  aws.StringValue(t.ThingId),
+		Version:  aws.Int64Value(t.Version),
+	}
+}
+
+// IsThingUpToDate returns true if the thing type and attributes of the
+// supplied thing match the parameters.
+func IsThingUpToDate(p v1alpha1.ThingParameters, t iot.DescribeThingOutput) bool {
+	return aws.StringValue(p.ThingTypeName) == aws.StringValue(t.ThingTypeName) &&
+		cmp.Equal(p.Attributes, t.Attributes, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ThingTypeClient is the external client used for ThingType Custom Resource
+type ThingTypeClient interface {
+	CreateThingTypeRequest(*iot.CreateThingTypeInput) iot.CreateThingTypeRequest
+	DescribeThingTypeRequest(*iot.DescribeThingTypeInput) iot.DescribeThingTypeRequest
+	DeprecateThingTypeRequest(*iot.DeprecateThingTypeInput) iot.DeprecateThingTypeRequest
+	DeleteThingTypeRequest(*iot.DeleteThingTypeInput) iot.DeleteThingTypeRequest
+}
+
+// NewThingTypeClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewThingTypeClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ThingTypeClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return iot.New(*cfg), err
+}
+
+// GenerateCreateThingTypeInput returns the input to create a thing type with
+// the supplied name and parameters.
+func GenerateCreateThingTypeInput(name string, p v1alpha1.ThingTypeParameters) *iot.CreateThingTypeInput {
+	in := &iot.CreateThingTypeInput{
+		ThingTypeName: aws.String(name),
+		Tags:          GenerateTags(p.Tags),
+	}
+	if p.Description != nil || len(p.SearchableAttributes) != 0 {
+		in.ThingTypeProperties = &iot.ThingTypeProperties{
+			ThingTypeDescription: p.Description,
+			SearchableAttributes: p.SearchableAttributes,
+		}
+	}
+	return in
+}
+
+// LateInitializeThingType fills the empty fields of the supplied parameters
+// with the values of the observed thing type.
+func LateInitializeThingType(p *v1alpha1.ThingTypeParameters, t iot.DescribeThingTypeOutput) {
+	if t.ThingTypeProperties != nil {
+		p.Description = awsclients.LateInitializeStringPtr(p.Description, t.ThingTypeProperties.ThingTypeDescription)
+		if p.SearchableAttributes == nil {
+			p.SearchableAttributes = t.ThingTypeProperties.SearchableAttributes
+		}
+	}
+	if t.ThingTypeMetadata != nil {
+		p.Deprecated = awsclients.LateInitializeBoolPtr(p.Deprecated, t.ThingTypeMetadata.Deprecated)
+	}
+}
+
+// GenerateThingTypeObservation returns the observation of the supplied thing
+// type.
+func GenerateThingTypeObservation(t iot.DescribeThingTypeOutput) v1alpha1.ThingTypeObservation {
+	o := v1alpha1.ThingTypeObservation{
+		ThingTypeARN: aws.StringValue(t.ThingTypeArn),
+		ThingTypeID:  aws.StringValue(t.ThingTypeId),
+	}
+	if t.ThingTypeMetadata != nil && t.ThingTypeMetadata.DeprecationDate != nil {
+		d := metav1.NewTime(*t.ThingTypeMetadata.DeprecationDate)
+		o.DeprecationDate = &d
+	}
+	return o
+}
+
+// IsThingTypeDeprecated returns true if the supplied thing type is
+// deprecated.
+func IsThingTypeDeprecated(t iot.DescribeThingTypeOutput) bool {
+	return t.ThingTypeMetadata != nil && aws.BoolValue(t.ThingTypeMetadata.Deprecated)
+}
+
+// IsThingTypeUpToDate returns true if the deprecation of the supplied thing
+// type matches the parameters.
+func IsThingTypeUpToDate(p v1alpha1.ThingTypeParameters, t iot.DescribeThingTypeOutput) bool {
+	return aws.BoolValue(p.Deprecated) == IsThingTypeDeprecated(t)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// TopicRuleClient is the external client used for TopicRule Custom Resource
+type TopicRuleClient interface {
+	CreateTopicRuleRequest(*iot.CreateTopicRuleInput) iot.CreateTopicRuleRequest
+	GetTopicRuleRequest(*iot.GetTopicRuleInput) iot.GetTopicRuleRequest
+	ReplaceTopicRuleRequest(*iot.ReplaceTopicRuleInput) iot.ReplaceTopicRuleRequest
+	DeleteTopicRuleRequest(*iot.DeleteTopicRuleInput) iot.DeleteTopicRuleRequest
+}
+
+// NewTopicRuleClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewTopicRuleClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (TopicRuleClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return iot.New(*cfg), err
+}
+
+// IsTopicRuleNotFound returns true if the error is because the topic rule
+// doesn't exist. AWS IoT responds with an UnauthorizedException rather than
+// a ResourceNotFoundException when the rule is missing.
+func IsTopicRuleNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == iot.ErrCodeUnauthorizedException || awsErr.Code() == iot.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+func generateAction(a v1alpha1.TopicRuleAction) iot.Action {
+	res := iot.Action{}
+	if a.Lambda != nil {
+		res.Lambda = &iot.LambdaAction{FunctionArn: aws.String(a.Lambda.FunctionARN)}
+	}
+	if a.SNS != nil {
+		res.Sns = &iot.SnsAction{
+			TargetArn:     aws.String(a.SNS.TargetARN),
+			RoleArn:       aws.String(a.SNS.RoleARN),
+			MessageFormat: iot.MessageFormat(aws.StringValue(a.SNS.MessageFormat)),
+		}
+	}
+	if a.SQS != nil {
+		res.Sqs = &iot.SqsAction{
+			QueueUrl:  aws.String(a.SQS.QueueURL),
+			RoleArn:   aws.String(a.SQS.RoleARN),
+			UseBase64: a.SQS.UseBase64,
+		}
+	}
+	if a.Kinesis != nil {
+		res.Kinesis = &iot.KinesisAction{
+			StreamName:   aws.String(a.Kinesis.StreamName),
+			RoleArn:      aws.String(a.Kinesis.RoleARN),
+			PartitionKey: a.Kinesis.PartitionKey,
+		}
+	}
+	if a.Republish != nil {
+		res.Republish = &iot.RepublishAction{
+			Topic:   aws.String(a.Republish.Topic),
+			RoleArn: aws.String(a.Republish.RoleARN),
+			Qos:     a.Republish.QoS,
+		}
+	}
+	if a.S3 != nil {
+		res.S3 = &iot.S3Action{
+			BucketName: aws.String(a.S3.BucketName),
+			Key:        aws.String(a.S3.Key),
+			RoleArn:    aws.String(a.S3.RoleARN),
+			CannedAcl:  iot.CannedAccessControlList(aws.StringValue(a.S3.CannedACL)),
+		}
+	}
+	if a.DynamoDBv2 != nil {
+		res.DynamoDBv2 = &iot.DynamoDBv2Action{
+			PutItem: &iot.PutItemInput{TableName: aws.String(a.DynamoDBv2.TableName)},
+			RoleArn: aws.String(a.DynamoDBv2.RoleARN),
+		}
+	}
+	if a.Firehose != nil {
+		res.Firehose = &iot.FirehoseAction{
+			DeliveryStreamName: aws.String(a.Firehose.DeliveryStreamName),
+			RoleArn:            aws.String(a.Firehose.RoleARN),
+			Separator:          a.Firehose.Separator,
+		}
+	}
+	if a.CloudWatchLogs != nil {
+		res.CloudwatchLogs = &iot.CloudwatchLogsAction{
+			LogGroupName: aws.String(a.CloudWatchLogs.LogGroupName),
+			RoleArn:      aws.String(a.CloudWatchLogs.RoleARN),
+		}
+	}
+	return res
+}
+
+// GenerateTopicRulePayload returns the payload of a topic rule with the
+// supplied parameters.
+func GenerateTopicRulePayload(p v1alpha1.TopicRuleParameters) *iot.TopicRulePayload {
+	res := &iot.TopicRulePayload{
+		Sql:              aws.String(p.SQL),
+		Description:      p.Description,
+		AwsIotSqlVersion: p.AWSIoTSQLVersion,
+		RuleDisabled:     p.RuleDisabled,
+		Actions:          make([]iot.Action, len(p.Actions)),
+	}
+	for i, a := range p.Actions {
+		res.Actions[i] = generateAction(a)
+	}
+	if p.ErrorAction != nil {
+		a := generateAction(*p.ErrorAction)
+		res.ErrorAction = &a
+	}
+	return res
+}
+
+// LateInitializeTopicRule fills the empty fields of the supplied parameters
+// with the values of the observed topic rule.
+func LateInitializeTopicRule(p *v1alpha1.TopicRuleParameters, r iot.TopicRule) {
+	p.AWSIoTSQLVersion = awsclients.LateInitializeStringPtr(p.AWSIoTSQLVersion, r.AwsIotSqlVersion)
+	p.RuleDisabled = awsclients.LateInitializeBoolPtr(p.RuleDisabled, r.RuleDisabled)
+}
+
+// IsTopicRuleUpToDate returns true if the supplied topic rule matches the
+// parameters.
+func IsTopicRuleUpToDate(p v1alpha1.TopicRuleParameters, r iot.TopicRule) bool {
+	desired := GenerateTopicRulePayload(p)
+	observed := &iot.TopicRulePayload{
+		Sql:              r.Sql,
+		Description:      r.Description,
+		AwsIotSqlVersion: r.AwsIotSqlVersion,
+		RuleDisabled:     r.RuleDisabled,
+		Actions:          r.Actions,
+		ErrorAction:      r.ErrorAction,
+	}
+	// AWS IoT returns an empty description when none was set.
+	if aws.StringValue(observed.Description) == "" {
+		observed.Description = nil
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(
+		iot.TopicRulePayload{}, iot.Action{}, iot.LambdaAction{}, iot.SnsAction{}, iot.SqsAction{},
+		iot.KinesisAction{}, iot.RepublishAction{}, iot.S3Action{}, iot.DynamoDBv2Action{},
+		iot.PutItemInput{}, iot.FirehoseAction{}, iot.CloudwatchLogsAction{}))
+}
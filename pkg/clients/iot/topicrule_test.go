@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+)
+
+func TestIsTopicRuleUpToDate(t *testing.T) {
+	params := v1alpha1.TopicRuleParameters{
+		SQL: "SELECT * FROM 'sensors/#'",
+		Actions: []v1alpha1.TopicRuleAction{
+			{SQS: &v1alpha1.SQSAction{QueueURL: "url", RoleARN: "role"}},
+		},
+		ErrorAction: &v1alpha1.TopicRuleAction{
+			CloudWatchLogs: &v1alpha1.CloudWatchLogsAction{LogGroupName: "errors", RoleARN: "role"},
+		},
+	}
+	observed := iot.TopicRule{
+		Sql:         aws.String("SELECT * FROM 'sensors/#'"),
+		Description: aws.String(""),
+		Actions: []iot.Action{
+			{Sqs: &iot.SqsAction{QueueUrl: aws.String("url"), RoleArn: aws.String("role")}},
+		},
+		ErrorAction: &iot.Action{
+			CloudwatchLogs: &iot.CloudwatchLogsAction{LogGroupName: aws.String("errors"), RoleArn: aws.String("role")},
+		},
+	}
+	changed := observed
+	changed.Actions = []iot.Action{
+		{Sqs: &iot.SqsAction{QueueUrl: aws.String("other"), RoleArn: aws.String("role")}},
+	}
+
+	cases := map[string]struct {
+		r    iot.TopicRule
+		want bool
+	}{
+		"UpToDate": {
+			r:    observed,
+			want: true,
+		},
+		"ActionChanged": {
+			r:    changed,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTopicRuleUpToDate(params, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/kinesisvideo"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SignalingChannelClient = (*MockSignalingChannelClient)(nil)
+
+// MockSignalingChannelClient is a type that implements all the methods for SignalingChannelClient interface
+type MockSignalingChannelClient struct {
+	MockCreateSignalingChannel   func(*kinesisvideo.CreateSignalingChannelInput) kinesisvideo.CreateSignalingChannelRequest
+	MockDescribeSignalingChannel func(*kinesisvideo.DescribeSignalingChannelInput) kinesisvideo.DescribeSignalingChannelRequest
+	MockUpdateSignalingChannel   func(*kinesisvideo.UpdateSignalingChannelInput) kinesisvideo.UpdateSignalingChannelRequest
+	MockDeleteSignalingChannel   func(*kinesisvideo.DeleteSignalingChannelInput) kinesisvideo.DeleteSignalingChannelRequest
+}
+
+// CreateSignalingChannelRequest calls the underlying MockCreateSignalingChannel method.
+func (c *MockSignalingChannelClient) CreateSignalingChannelRequest(i *kinesisvideo.CreateSignalingChannelInput) kinesisvideo.CreateSignalingChannelRequest {
+	return c.MockCreateSignalingChannel(i)
+}
+
+// DescribeSignalingChannelRequest calls the underlying MockDescribeSignalingChannel method.
+func (c *MockSignalingChannelClient) DescribeSignalingChannelRequest(i *kinesisvideo.DescribeSignalingChannelInput) kinesisvideo.DescribeSignalingChannelRequest {
+	return c.MockDescribeSignalingChannel(i)
+}
+
+// UpdateSignalingChannelRequest calls the underlying MockUpdateSignalingChannel method.
+func (c *MockSignalingChannelClient) UpdateSignalingChannelRequest(i *kinesisvideo.UpdateSignalingChannelInput) kinesisvideo.UpdateSignalingChannelRequest {
+	return c.MockUpdateSignalingChannel(i)
+}
+
+// DeleteSignalingChannelRequest calls the underlying MockDeleteSignalingChannel method.
+func (c *MockSignalingChannelClient) DeleteSignalingChannelRequest(i *kinesisvideo.DeleteSignalingChannelInput) kinesisvideo.DeleteSignalingChannelRequest {
+	return c.MockDeleteSignalingChannel(i)
+}
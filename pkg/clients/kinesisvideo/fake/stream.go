@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/kinesisvideo"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.StreamClient = (*MockStreamClient)(nil)
+
+// MockStreamClient is a type that implements all the methods for StreamClient interface
+type MockStreamClient struct {
+	MockCreateStream        func(*kinesisvideo.CreateStreamInput) kinesisvideo.CreateStreamRequest
+	MockDescribeStream      func(*kinesisvideo.DescribeStreamInput) kinesisvideo.DescribeStreamRequest
+	MockUpdateStream        func(*kinesisvideo.UpdateStreamInput) kinesisvideo.UpdateStreamRequest
+	MockUpdateDataRetention func(*kinesisvideo.UpdateDataRetentionInput) kinesisvideo.UpdateDataRetentionRequest
+	MockDeleteStream        func(*kinesisvideo.DeleteStreamInput) kinesisvideo.DeleteStreamRequest
+}
+
+// CreateStreamRequest calls the underlying MockCreateStream method.
+func (c *MockStreamClient) CreateStreamRequest(i *kinesisvideo.CreateStreamInput) kinesisvideo.CreateStreamRequest {
+	return c.MockCreateStream(i)
+}
+
+// DescribeStreamRequest calls the underlying MockDescribeStream method.
+func (c *MockStreamClient) DescribeStreamRequest(i *kinesisvideo.DescribeStreamInput) kinesisvideo.DescribeStreamRequest {
+	return c.MockDescribeStream(i)
+}
+
+// UpdateStreamRequest calls the underlying MockUpdateStream method.
+func (c *MockStreamClient) UpdateStreamRequest(i *kinesisvideo.UpdateStreamInput) kinesisvideo.UpdateStreamRequest {
+	return c.MockUpdateStream(i)
+}
+
+// UpdateDataRetentionRequest calls the underlying MockUpdateDataRetention method.
+func (c *MockStreamClient) UpdateDataRetentionRequest(i *kinesisvideo.UpdateDataRetentionInput) kinesisvideo.UpdateDataRetentionRequest {
+	return c.MockUpdateDataRetention(i)
+}
+
+// DeleteStreamRequest calls the underlying MockDeleteStream method.
+func (c *MockStreamClient) DeleteStreamRequest(i *kinesisvideo.DeleteStreamInput) kinesisvideo.DeleteStreamRequest {
+	return c.MockDeleteStream(i)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kinesisvideo
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+
+	"github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SignalingChannelClient is the external client used for SignalingChannel
+// Custom Resource
+type SignalingChannelClient interface {
+	CreateSignalingChannelRequest(*kinesisvideo.CreateSignalingChannelInput) kinesisvideo.CreateSignalingChannelRequest
+	DescribeSignalingChannelRequest(*kinesisvideo.DescribeSignalingChannelInput) kinesisvideo.DescribeSignalingChannelRequest
+	UpdateSignalingChannelRequest(*kinesisvideo.UpdateSignalingChannelInput) kinesisvideo.UpdateSignalingChannelRequest
+	DeleteSignalingChannelRequest(*kinesisvideo.DeleteSignalingChannelInput) kinesisvideo.DeleteSignalingChannelRequest
+}
+
+// NewSignalingChannelClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewSignalingChannelClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (SignalingChannelClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return kinesisvideo.New(*cfg), err
+}
+
+// GenerateCreateSignalingChannelInput returns the input to create a signaling
+// channel with the supplied name and parameters.
+func GenerateCreateSignalingChannelInput(name string, p v1alpha1.SignalingChannelParameters) *kinesisvideo.CreateSignalingChannelInput {
+	in := &kinesisvideo.CreateSignalingChannelInput{
+		ChannelName: aws.String(name),
+		ChannelType: kinesisvideo.ChannelType(aws.StringValue(p.ChannelType)),
+	}
+	if p.MessageTTLSeconds != nil {
+		in.SingleMasterConfiguration = &kinesisvideo.SingleMasterConfiguration{MessageTtlSeconds: p.MessageTTLSeconds}
+	}
+	keys := make([]string, 0, len(p.Tags))
+	for k := range p.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		in.Tags = append(in.Tags, kinesisvideo.Tag{Key: aws.String(k), Value: aws.String(p.Tags[k])})
+	}
+	return in
+}
+
+// LateInitializeSignalingChannel fills the empty fields of the supplied
+// parameters with the values of the observed signaling channel.
+func LateInitializeSignalingChannel(p *v1alpha1.SignalingChannelParameters, c kinesisvideo.ChannelInfo) {
+	if p.ChannelType == nil && c.ChannelType != "" {
+		p.ChannelType = aws.String(string(c.ChannelType))
+	}
+	if c.SingleMasterConfiguration != nil {
+		p.MessageTTLSeconds = awsclients.LateInitializeInt64Ptr(p.MessageTTLSeconds, c.SingleMasterConfiguration.MessageTtlSeconds)
+	}
+}
+
+// GenerateSignalingChannelObservation returns the observation of the
+// supplied signaling channel.
+func GenerateSignalingChannelObservation(c kinesisvideo.ChannelInfo) v1alpha1.SignalingChannelObservation {
+	return v1alpha1.SignalingChannelObservation{
+		ChannelARN:    aws.StringValue(c.ChannelARN),
+		ChannelStatus: string(c.ChannelStatus),
+		Version:       aws.StringValue(c.Version),
+	}
+}
+
+// IsSignalingChannelUpToDate returns true if the message TTL of the supplied
+// signaling channel matches the parameters.
+func IsSignalingChannelUpToDate(p v1alpha1.SignalingChannelParameters, c kinesisvideo.ChannelInfo) bool {
+	if p.MessageTTLSeconds == nil {
+		return true
+	}
+	return c.SingleMasterConfiguration != nil && aws.Int64Value(p.MessageTTLSeconds) == aws.Int64Value(c.SingleMasterConfiguration.MessageTtlSeconds)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kinesisvideo
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+
+	"github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// StreamClient is the external client used for Stream Custom Resource
+type StreamClient interface {
+	CreateStreamRequest(*kinesisvideo.CreateStreamInput) kinesisvideo.CreateStreamRequest
+	DescribeStreamRequest(*kinesisvideo.DescribeStreamInput) kinesisvideo.DescribeStreamRequest
+	UpdateStreamRequest(*kinesisvideo.UpdateStreamInput) kinesisvideo.UpdateStreamRequest
+	UpdateDataRetentionRequest(*kinesisvideo.UpdateDataRetentionInput) kinesisvideo.UpdateDataRetentionRequest
+	DeleteStreamRequest(*kinesisvideo.DeleteStreamInput) kinesisvideo.DeleteStreamRequest
+}
+
+// NewStreamClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewStreamClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (StreamClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return kinesisvideo.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the stream or signaling
+// channel doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == kinesisvideo.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateStreamInput returns the input to create a stream with the
+// supplied name and parameters.
+func GenerateCreateStreamInput(name string, p v1alpha1.StreamParameters) *kinesisvideo.CreateStreamInput {
+	return &kinesisvideo.CreateStreamInput{
+		StreamName:           aws.String(name),
+		DeviceName:           p.DeviceName,
+		MediaType:            p.MediaType,
+		KmsKeyId:             p.KMSKeyID,
+		DataRetentionInHours: p.DataRetentionInHours,
+		Tags:                 p.Tags,
+	}
+}
+
+// LateInitializeStream fills the empty fields of the supplied parameters with
+// the values of the observed stream.
+func LateInitializeStream(p *v1alpha1.StreamParameters, s kinesisvideo.StreamInfo) {
+	p.DeviceName = awsclients.LateInitializeStringPtr(p.DeviceName, s.DeviceName)
+	p.MediaType = awsclients.LateInitializeStringPtr(p.MediaType, s.MediaType)
+	p.KMSKeyID = awsclients.LateInitializeStringPtr(p.KMSKeyID, s.KmsKeyId)
+	p.DataRetentionInHours = awsclients.LateInitializeInt64Ptr(p.DataRetentionInHours, s.DataRetentionInHours)
+}
+
+// GenerateStreamObservation returns the observation of the supplied stream.
+func GenerateStreamObservation(s kinesisvideo.StreamInfo) v1alpha1.StreamObservation {
+	return v1alpha1.StreamObservation{
+		StreamARN: aws.StringValue(s.StreamARN),
+		Status:    string(s.Status),
+		Version:   aws.StringValue(s.Version),
+	}
+}
+
+// GenerateUpdateDataRetentionInput returns the input to change the data
+// retention of the supplied stream to the desired one, or nil if it does not
+// differ.
+func GenerateUpdateDataRetentionInput(p v1alpha1.StreamParameters, s kinesisvideo.StreamInfo) *kinesisvideo.UpdateDataRetentionInput {
+	if p.DataRetentionInHours == nil {
+		return nil
+	}
+	change := aws.Int64Value(p.DataRetentionInHours) - aws.Int64Value(s.DataRetentionInHours)
+	in := &kinesisvideo.UpdateDataRetentionInput{
+		StreamARN:                  s.StreamARN,
+		CurrentVersion:             s.Version,
+		Operation:                  kinesisvideo.UpdateDataRetentionOperationIncreaseDataRetention,
+		DataRetentionChangeInHours: aws.Int64(change),
+	}
+	switch {
+	case change == 0:
+		return nil
+	case change < 0:
+		in.Operation = kinesisvideo.UpdateDataRetentionOperationDecreaseDataRetention
+		in.DataRetentionChangeInHours = aws.Int64(-change)
+	}
+	return in
+}
+
+// IsStreamUpToDate returns true if the modifiable settings of the supplied
+// stream match the parameters.
+func IsStreamUpToDate(p v1alpha1.StreamParameters, s kinesisvideo.StreamInfo) bool {
+	if GenerateUpdateDataRetentionInput(p, s) != nil {
+		return false
+	}
+	return aws.StringValue(p.DeviceName) == aws.StringValue(s.DeviceName) &&
+		aws.StringValue(p.MediaType) == aws.StringValue(s.MediaType)
+}
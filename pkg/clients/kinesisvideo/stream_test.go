@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kinesisvideo
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+)
+
+func TestGenerateUpdateDataRetentionInput(t *testing.T) {
+	observed := kinesisvideo.StreamInfo{
+		StreamARN:            aws.String("arn"),
+		DataRetentionInHours: aws.Int64(24),
+		Version:              aws.String("v1"),
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.StreamParameters
+		want *kinesisvideo.UpdateDataRetentionInput
+	}{
+		"Unset": {
+			p: v1alpha1.StreamParameters{},
+		},
+		"Unchanged": {
+			p: v1alpha1.StreamParameters{DataRetentionInHours: aws.Int64(24)},
+		},
+		"Increase": {
+			p: v1alpha1.StreamParameters{DataRetentionInHours: aws.Int64(72)},
+			want: &kinesisvideo.UpdateDataRetentionInput{
+				StreamARN:                  aws.String("arn"),
+				CurrentVersion:             aws.String("v1"),
+				Operation:                  kinesisvideo.UpdateDataRetentionOperationIncreaseDataRetention,
+				DataRetentionChangeInHours: aws.Int64(48),
+			},
+		},
+		"Decrease": {
+			p: v1alpha1.StreamParameters{DataRetentionInHours: aws.Int64(0)},
+			want: &kinesisvideo.UpdateDataRetentionInput{
+				StreamARN:                  aws.String("arn"),
+				CurrentVersion:             aws.String("v1"),
+				Operation:                  kinesisvideo.UpdateDataRetentionOperationDecreaseDataRetention,
+				DataRetentionChangeInHours: aws.Int64(24),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateDataRetentionInput(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateSignalingChannelInput(t *testing.T) {
+	p := v1alpha1.SignalingChannelParameters{
+		ChannelType:       aws.String("SINGLE_MASTER"),
+		MessageTTLSeconds: aws.Int64(60),
+		Tags:              map[string]string{"b": "2", "a": "1"},
+	}
+	want := &kinesisvideo.CreateSignalingChannelInput{
+		ChannelName:               aws.String("name"),
+		ChannelType:               kinesisvideo.ChannelTypeSingleMaster,
+		SingleMasterConfiguration: &kinesisvideo.SingleMasterConfiguration{MessageTtlSeconds: aws.Int64(60)},
+		Tags:                      []kinesisvideo.Tag{{Key: aws.String("a"), Value: aws.String("1")}, {Key: aws.String("b"), Value: aws.String("2")}},
+	}
+	if diff := cmp.Diff(want, GenerateCreateSignalingChannelInput("name", p)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
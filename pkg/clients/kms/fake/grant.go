@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/kms"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.GrantClient = (*MockGrantClient)(nil)
+
+// MockGrantClient is a type that implements all the methods for GrantClient interface
+type MockGrantClient struct {
+	MockCreateGrant func(*kms.CreateGrantInput) kms.CreateGrantRequest
+	MockListGrants  func(*kms.ListGrantsInput) kms.ListGrantsRequest
+	MockRevokeGrant func(*kms.RevokeGrantInput) kms.RevokeGrantRequest
+}
+
+// CreateGrantRequest calls the underlying MockCreateGrant method.
+func (c *MockGrantClient) CreateGrantRequest(i *kms.CreateGrantInput) kms.CreateGrantRequest {
+	return c.MockCreateGrant(i)
+}
+
+// ListGrantsRequest calls the underlying MockListGrants method.
+func (c *MockGrantClient) ListGrantsRequest(i *kms.ListGrantsInput) kms.ListGrantsRequest {
+	return c.MockListGrants(i)
+}
+
+// RevokeGrantRequest calls the underlying MockRevokeGrant method.
+func (c *MockGrantClient) RevokeGrantRequest(i *kms.RevokeGrantInput) kms.RevokeGrantRequest {
+	return c.MockRevokeGrant(i)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GrantClient is the external client used for Grant Custom Resource
+type GrantClient interface {
+	CreateGrantRequest(*kms.CreateGrantInput) kms.CreateGrantRequest
+	ListGrantsRequest(*kms.ListGrantsInput) kms.ListGrantsRequest
+	RevokeGrantRequest(*kms.RevokeGrantInput) kms.RevokeGrantRequest
+}
+
+// NewGrantClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewGrantClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (GrantClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return kms.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the key or grant doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == kms.ErrCodeNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateGrantInput returns the input to create a grant from the
+// supplied parameters.
+func GenerateCreateGrantInput(p v1alpha1.GrantParameters) *kms.CreateGrantInput {
+	in := &kms.CreateGrantInput{
+		KeyId:             aws.String(p.KeyID),
+		GranteePrincipal:  p.GranteePrincipal,
+		RetiringPrincipal: p.RetiringPrincipal,
+		Name:              p.Name,
+	}
+	for _, o := range p.Operations {
+		in.Operations = append(in.Operations, kms.GrantOperation(o))
+	}
+	if p.Constraints != nil {
+		in.Constraints = &kms.GrantConstraints{
+			EncryptionContextEquals: p.Constraints.EncryptionContextEquals,
+			EncryptionContextSubset: p.Constraints.EncryptionContextSubset,
+		}
+	}
+	return in
+}
+
+// FindGrant returns the grant with the supplied ID, or nil if it is not in
+// the supplied list.
+func FindGrant(id string, grants []kms.GrantListEntry) *kms.GrantListEntry {
+	for i := range grants {
+		if aws.StringValue(grants[i].GrantId) == id {
+			return &grants[i]
+		}
+	}
+	return nil
+}
+
+// GenerateGrantObservation is used to produce v1alpha1.GrantObservation from
+// kms.GrantListEntry.
+func GenerateGrantObservation(g kms.GrantListEntry) v1alpha1.GrantObservation {
+	o := v1alpha1.GrantObservation{
+		IssuingAccount: aws.StringValue(g.IssuingAccount),
+	}
+	if g.CreationDate != nil {
+		t := metav1.NewTime(*g.CreationDate)
+		o.CreationDate = &t
+	}
+	return o
+}
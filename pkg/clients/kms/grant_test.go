@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+)
+
+func TestGenerateCreateGrantInput(t *testing.T) {
+	keyID := "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	grantee := "arn:aws:iam::210987654321:role/reader"
+
+	cases := map[string]struct {
+		p    v1alpha1.GrantParameters
+		want *kms.CreateGrantInput
+	}{
+		"Minimal": {
+			p: v1alpha1.GrantParameters{
+				KeyID:            keyID,
+				GranteePrincipal: aws.String(grantee),
+				Operations:       []v1alpha1.GrantOperation{"Decrypt"},
+			},
+			want: &kms.CreateGrantInput{
+				KeyId:            aws.String(keyID),
+				GranteePrincipal: aws.String(grantee),
+				Operations:       []kms.GrantOperation{kms.GrantOperationDecrypt},
+			},
+		},
+		"Constrained": {
+			p: v1alpha1.GrantParameters{
+				KeyID:             keyID,
+				GranteePrincipal:  aws.String(grantee),
+				Operations:        []v1alpha1.GrantOperation{"Encrypt", "GenerateDataKey"},
+				RetiringPrincipal: aws.String("arn:aws:iam::123456789012:role/admin"),
+				Constraints:       &v1alpha1.GrantConstraints{EncryptionContextSubset: map[string]string{"team": "data"}},
+				Name:              aws.String("reader"),
+			},
+			want: &kms.CreateGrantInput{
+				KeyId:             aws.String(keyID),
+				GranteePrincipal:  aws.String(grantee),
+				Operations:        []kms.GrantOperation{kms.GrantOperationEncrypt, kms.GrantOperationGenerateDataKey},
+				RetiringPrincipal: aws.String("arn:aws:iam::123456789012:role/admin"),
+				Constraints:       &kms.GrantConstraints{EncryptionContextSubset: map[string]string{"team": "data"}},
+				Name:              aws.String("reader"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateGrantInput(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
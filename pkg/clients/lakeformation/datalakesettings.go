@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lakeformation
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// IAMAllowedPrincipals is the principal that defers access control to IAM
+// policies.
+const IAMAllowedPrincipals = "IAM_ALLOWED_PRINCIPALS"
+
+// DataLakeSettingsClient is the external client used for DataLakeSettings
+// Custom Resource
+type DataLakeSettingsClient interface {
+	GetDataLakeSettingsRequest(*lakeformation.GetDataLakeSettingsInput) lakeformation.GetDataLakeSettingsRequest
+	PutDataLakeSettingsRequest(*lakeformation.PutDataLakeSettingsInput) lakeformation.PutDataLakeSettingsRequest
+}
+
+// NewDataLakeSettingsClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewDataLakeSettingsClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DataLakeSettingsClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return lakeformation.New(*cfg), err
+}
+
+// DefaultDataLakeSettings returns the settings of a Data Catalog that has
+// not been configured yet.
+func DefaultDataLakeSettings() *lakeformation.DataLakeSettings {
+	all := []lakeformation.PrincipalPermissions{{
+		Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(IAMAllowedPrincipals)},
+		Permissions: []lakeformation.Permission{lakeformation.PermissionAll},
+	}}
+	return &lakeformation.DataLakeSettings{
+		DataLakeAdmins:                   []lakeformation.DataLakePrincipal{},
+		CreateDatabaseDefaultPermissions: all,
+		CreateTableDefaultPermissions:    all,
+	}
+}
+
+// GeneratePermissions converts the supplied permissions to Lake Formation
+// permissions.
+func GeneratePermissions(in []v1alpha1.Permission) []lakeformation.Permission {
+	if in == nil {
+		return nil
+	}
+	out := make([]lakeformation.Permission, len(in))
+	for i, p := range in {
+		out[i] = lakeformation.Permission(p)
+	}
+	return out
+}
+
+func generatePrincipalPermissions(in []v1alpha1.PrincipalPermissions) []lakeformation.PrincipalPermissions {
+	out := make([]lakeformation.PrincipalPermissions, len(in))
+	for i, pp := range in {
+		out[i] = lakeformation.PrincipalPermissions{
+			Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(pp.Principal)},
+			Permissions: GeneratePermissions(pp.Permissions),
+		}
+	}
+	return out
+}
+
+func lateInitializePrincipalPermissions(in []v1alpha1.PrincipalPermissions, from []lakeformation.PrincipalPermissions) []v1alpha1.PrincipalPermissions {
+	if in != nil || from == nil {
+		return in
+	}
+	out := make([]v1alpha1.PrincipalPermissions, len(from))
+	for i, pp := range from {
+		out[i].Permissions = make([]v1alpha1.Permission, len(pp.Permissions))
+		for j, p := range pp.Permissions {
+			out[i].Permissions[j] = v1alpha1.Permission(p)
+		}
+		if pp.Principal != nil {
+			out[i].Principal = aws.StringValue(pp.Principal.DataLakePrincipalIdentifier)
+		}
+	}
+	return out
+}
+
+// GeneratePutDataLakeSettingsInput returns the input to put the data lake
+// settings from the supplied parameters.
+func GeneratePutDataLakeSettingsInput(p v1alpha1.DataLakeSettingsParameters) *lakeformation.PutDataLakeSettingsInput {
+	s := &lakeformation.DataLakeSettings{
+		DataLakeAdmins:                   make([]lakeformation.DataLakePrincipal, len(p.DataLakeAdmins)),
+		CreateDatabaseDefaultPermissions: generatePrincipalPermissions(p.CreateDatabaseDefaultPermissions),
+		CreateTableDefaultPermissions:    generatePrincipalPermissions(p.CreateTableDefaultPermissions),
+	}
+	for i, a := range p.DataLakeAdmins {
+		s.DataLakeAdmins[i] = lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(a)}
+	}
+	return &lakeformation.PutDataLakeSettingsInput{
+		CatalogId:        p.CatalogID,
+		DataLakeSettings: s,
+	}
+}
+
+// LateInitializeDataLakeSettings fills the empty fields in
+// *v1alpha1.DataLakeSettingsParameters with the values seen in
+// lakeformation.DataLakeSettings.
+func LateInitializeDataLakeSettings(in *v1alpha1.DataLakeSettingsParameters, s *lakeformation.DataLakeSettings) {
+	if s == nil {
+		return
+	}
+	if in.DataLakeAdmins == nil && len(s.DataLakeAdmins) > 0 {
+		in.DataLakeAdmins = make([]string, len(s.DataLakeAdmins))
+		for i, a := range s.DataLakeAdmins {
+			in.DataLakeAdmins[i] = aws.StringValue(a.DataLakePrincipalIdentifier)
+		}
+	}
+	in.CreateDatabaseDefaultPermissions = lateInitializePrincipalPermissions(in.CreateDatabaseDefaultPermissions, s.CreateDatabaseDefaultPermissions)
+	in.CreateTableDefaultPermissions = lateInitializePrincipalPermissions(in.CreateTableDefaultPermissions, s.CreateTableDefaultPermissions)
+}
+
+// principalPermissionsKeys returns a sorted list of principal and permission
+// pairs so that permissions can be compared regardless of their order.
+func principalPermissionsKeys(in []lakeformation.PrincipalPermissions) []string {
+	var keys []string
+	for _, pp := range in {
+		principal := ""
+		if pp.Principal != nil {
+			principal = aws.StringValue(pp.Principal.DataLakePrincipalIdentifier)
+		}
+		for _, p := range pp.Permissions {
+			keys = append(keys, principal+"/"+string(p))
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func principalKeys(in []lakeformation.DataLakePrincipal) []string {
+	var keys []string
+	for _, p := range in {
+		keys = append(keys, aws.StringValue(p.DataLakePrincipalIdentifier))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsDataLakeSettingsEqual returns true if both settings have the same
+// administrators and default permissions regardless of their order.
+func IsDataLakeSettingsEqual(a, b lakeformation.DataLakeSettings) bool {
+	return cmp.Equal(principalKeys(a.DataLakeAdmins), principalKeys(b.DataLakeAdmins)) &&
+		cmp.Equal(principalPermissionsKeys(a.CreateDatabaseDefaultPermissions), principalPermissionsKeys(b.CreateDatabaseDefaultPermissions)) &&
+		cmp.Equal(principalPermissionsKeys(a.CreateTableDefaultPermissions), principalPermissionsKeys(b.CreateTableDefaultPermissions))
+}
+
+// IsDataLakeSettingsUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsDataLakeSettingsUpToDate(p v1alpha1.DataLakeSettingsParameters, s lakeformation.DataLakeSettings) bool {
+	return IsDataLakeSettingsEqual(*GeneratePutDataLakeSettingsInput(p).DataLakeSettings, s)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lakeformation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+)
+
+func TestIsDataLakeSettingsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DataLakeSettingsParameters
+		s    lakeformation.DataLakeSettings
+		want bool
+	}{
+		"Default": {
+			p: v1alpha1.DataLakeSettingsParameters{
+				CreateDatabaseDefaultPermissions: []v1alpha1.PrincipalPermissions{{Principal: IAMAllowedPrincipals, Permissions: []v1alpha1.Permission{"ALL"}}},
+				CreateTableDefaultPermissions:    []v1alpha1.PrincipalPermissions{{Principal: IAMAllowedPrincipals, Permissions: []v1alpha1.Permission{"ALL"}}},
+			},
+			s:    *DefaultDataLakeSettings(),
+			want: true,
+		},
+		"AdminsInDifferentOrder": {
+			p: v1alpha1.DataLakeSettingsParameters{
+				DataLakeAdmins: []string{"arn:aws:iam::123456789012:role/b", "arn:aws:iam::123456789012:role/a"},
+			},
+			s: lakeformation.DataLakeSettings{
+				DataLakeAdmins: []lakeformation.DataLakePrincipal{
+					{DataLakePrincipalIdentifier: aws.String("arn:aws:iam::123456789012:role/a")},
+					{DataLakePrincipalIdentifier: aws.String("arn:aws:iam::123456789012:role/b")},
+				},
+			},
+			want: true,
+		},
+		"DefaultPermissionsRemoved": {
+			p: v1alpha1.DataLakeSettingsParameters{
+				CreateDatabaseDefaultPermissions: []v1alpha1.PrincipalPermissions{},
+				CreateTableDefaultPermissions:    []v1alpha1.PrincipalPermissions{},
+			},
+			s:    *DefaultDataLakeSettings(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDataLakeSettingsUpToDate(tc.p, tc.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DataLakeSettingsClient = (*MockDataLakeSettingsClient)(nil)
+
+// MockDataLakeSettingsClient is a type that implements all the methods for DataLakeSettingsClient interface
+type MockDataLakeSettingsClient struct {
+	MockGetDataLakeSettings func(*lakeformation.GetDataLakeSettingsInput) lakeformation.GetDataLakeSettingsRequest
+	MockPutDataLakeSettings func(*lakeformation.PutDataLakeSettingsInput) lakeformation.PutDataLakeSettingsRequest
+}
+
+// GetDataLakeSettingsRequest calls the underlying MockGetDataLakeSettings method.
+func (c *MockDataLakeSettingsClient) GetDataLakeSettingsRequest(i *lakeformation.GetDataLakeSettingsInput) lakeformation.GetDataLakeSettingsRequest {
+	return c.MockGetDataLakeSettings(i)
+}
+
+// PutDataLakeSettingsRequest calls the underlying MockPutDataLakeSettings method.
+func (c *MockDataLakeSettingsClient) PutDataLakeSettingsRequest(i *lakeformation.PutDataLakeSettingsInput) lakeformation.PutDataLakeSettingsRequest {
+	return c.MockPutDataLakeSettings(i)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PermissionsClient = (*MockPermissionsClient)(nil)
+
+// MockPermissionsClient is a type that implements all the methods for PermissionsClient interface
+type MockPermissionsClient struct {
+	MockGrantPermissions  func(*lakeformation.GrantPermissionsInput) lakeformation.GrantPermissionsRequest
+	MockRevokePermissions func(*lakeformation.RevokePermissionsInput) lakeformation.RevokePermissionsRequest
+	MockListPermissions   func(*lakeformation.ListPermissionsInput) lakeformation.ListPermissionsRequest
+}
+
+// GrantPermissionsRequest calls the underlying MockGrantPermissions method.
+func (c *MockPermissionsClient) GrantPermissionsRequest(i *lakeformation.GrantPermissionsInput) lakeformation.GrantPermissionsRequest {
+	return c.MockGrantPermissions(i)
+}
+
+// RevokePermissionsRequest calls the underlying MockRevokePermissions method.
+func (c *MockPermissionsClient) RevokePermissionsRequest(i *lakeformation.RevokePermissionsInput) lakeformation.RevokePermissionsRequest {
+	return c.MockRevokePermissions(i)
+}
+
+// ListPermissionsRequest calls the underlying MockListPermissions method.
+func (c *MockPermissionsClient) ListPermissionsRequest(i *lakeformation.ListPermissionsInput) lakeformation.ListPermissionsRequest {
+	return c.MockListPermissions(i)
+}
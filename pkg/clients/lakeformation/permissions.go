@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lakeformation
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// PermissionsClient is the external client used for Permissions Custom
+// Resource
+type PermissionsClient interface {
+	GrantPermissionsRequest(*lakeformation.GrantPermissionsInput) lakeformation.GrantPermissionsRequest
+	RevokePermissionsRequest(*lakeformation.RevokePermissionsInput) lakeformation.RevokePermissionsRequest
+	ListPermissionsRequest(*lakeformation.ListPermissionsInput) lakeformation.ListPermissionsRequest
+}
+
+// NewPermissionsClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewPermissionsClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (PermissionsClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return lakeformation.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the Lake Formation entity
+// doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == lakeformation.ErrCodeEntityNotFoundException
+	}
+	return false
+}
+
+// GenerateResource returns the Lake Formation resource of the supplied
+// parameters.
+func GenerateResource(r v1alpha1.PermissionsResource) *lakeformation.Resource {
+	out := &lakeformation.Resource{}
+	if r.Catalog != nil {
+		out.Catalog = &lakeformation.CatalogResource{}
+	}
+	if r.Database != nil {
+		out.Database = &lakeformation.DatabaseResource{Name: aws.String(r.Database.Name)}
+	}
+	if r.Table != nil {
+		out.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(r.Table.DatabaseName),
+			Name:         aws.String(r.Table.Name),
+		}
+	}
+	if r.TableWithColumns != nil {
+		out.TableWithColumns = &lakeformation.TableWithColumnsResource{
+			DatabaseName: aws.String(r.TableWithColumns.DatabaseName),
+			Name:         aws.String(r.TableWithColumns.Name),
+			ColumnNames:  r.TableWithColumns.ColumnNames,
+		}
+		if r.TableWithColumns.ColumnWildcard != nil {
+			out.TableWithColumns.ColumnWildcard = &lakeformation.ColumnWildcard{
+				ExcludedColumnNames: r.TableWithColumns.ColumnWildcard.ExcludedColumnNames,
+			}
+		}
+	}
+	if r.DataLocation != nil {
+		out.DataLocation = &lakeformation.DataLocationResource{ResourceArn: aws.String(r.DataLocation.ResourceARN)}
+	}
+	return out
+}
+
+// GenerateListPermissionsInput returns the input to list the permissions of
+// the principal on the resource of the supplied parameters.
+func GenerateListPermissionsInput(p v1alpha1.PermissionsParameters) *lakeformation.ListPermissionsInput {
+	return &lakeformation.ListPermissionsInput{
+		CatalogId: p.CatalogID,
+		Principal: &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: p.Principal},
+		Resource:  GenerateResource(p.Resource),
+	}
+}
+
+// GenerateGrantPermissionsInput returns the input to grant the permissions of
+// the supplied parameters.
+func GenerateGrantPermissionsInput(p v1alpha1.PermissionsParameters) *lakeformation.GrantPermissionsInput {
+	return &lakeformation.GrantPermissionsInput{
+		CatalogId:                  p.CatalogID,
+		Principal:                  &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: p.Principal},
+		Resource:                   GenerateResource(p.Resource),
+		Permissions:                GeneratePermissions(p.Permissions),
+		PermissionsWithGrantOption: GeneratePermissions(p.PermissionsWithGrantOption),
+	}
+}
+
+// GenerateRevokePermissionsInput returns the input to revoke the supplied
+// permissions from the principal on the resource of the supplied parameters.
+func GenerateRevokePermissionsInput(p v1alpha1.PermissionsParameters, permissions, grantable []lakeformation.Permission) *lakeformation.RevokePermissionsInput {
+	return &lakeformation.RevokePermissionsInput{
+		CatalogId:                  p.CatalogID,
+		Principal:                  &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: p.Principal},
+		Resource:                   GenerateResource(p.Resource),
+		Permissions:                permissions,
+		PermissionsWithGrantOption: grantable,
+	}
+}
+
+// ObservedPermissions are the permissions a principal holds on a resource.
+type ObservedPermissions struct {
+	Permissions                []lakeformation.Permission
+	PermissionsWithGrantOption []lakeformation.Permission
+}
+
+// GenerateObservedPermissions merges the supplied permissions into a single
+// sorted set of permissions and grantable permissions.
+func GenerateObservedPermissions(in []lakeformation.PrincipalResourcePermissions) ObservedPermissions {
+	perms := map[lakeformation.Permission]bool{}
+	grantable := map[lakeformation.Permission]bool{}
+	for _, prp := range in {
+		for _, p := range prp.Permissions {
+			perms[p] = true
+		}
+		for _, p := range prp.PermissionsWithGrantOption {
+			grantable[p] = true
+		}
+	}
+	return ObservedPermissions{
+		Permissions:                sortedPermissions(perms),
+		PermissionsWithGrantOption: sortedPermissions(grantable),
+	}
+}
+
+func sortedPermissions(in map[lakeformation.Permission]bool) []lakeformation.Permission {
+	var out []lakeformation.Permission
+	for p := range in {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func permissionSet(in []v1alpha1.Permission) map[lakeformation.Permission]bool {
+	out := map[lakeformation.Permission]bool{}
+	for _, p := range in {
+		out[lakeformation.Permission(p)] = true
+	}
+	return out
+}
+
+// RevokedPermissions returns the observed permissions and grantable
+// permissions that are not desired anymore.
+func RevokedPermissions(p v1alpha1.PermissionsParameters, o ObservedPermissions) ObservedPermissions {
+	desired := permissionSet(p.Permissions)
+	desiredGrantable := permissionSet(p.PermissionsWithGrantOption)
+	revoked := ObservedPermissions{}
+	for _, perm := range o.Permissions {
+		if !desired[perm] {
+			revoked.Permissions = append(revoked.Permissions, perm)
+		}
+	}
+	for _, perm := range o.PermissionsWithGrantOption {
+		if !desiredGrantable[perm] {
+			revoked.PermissionsWithGrantOption = append(revoked.PermissionsWithGrantOption, perm)
+		}
+	}
+	return revoked
+}
+
+// IsPermissionsUpToDate returns true if the principal holds exactly the
+// desired permissions.
+func IsPermissionsUpToDate(p v1alpha1.PermissionsParameters, o ObservedPermissions) bool {
+	return cmp.Equal(sortedPermissions(permissionSet(p.Permissions)), o.Permissions) &&
+		cmp.Equal(sortedPermissions(permissionSet(p.PermissionsWithGrantOption)), o.PermissionsWithGrantOption)
+}
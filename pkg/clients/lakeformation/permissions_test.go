@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lakeformation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+)
+
+var roleARN = "arn:aws:iam::123456789012:role/analyst"
+
+func TestGenerateObservedPermissions(t *testing.T) {
+	in := []lakeformation.PrincipalResourcePermissions{
+		{
+			Permissions:                []lakeformation.Permission{lakeformation.PermissionSelect},
+			PermissionsWithGrantOption: []lakeformation.Permission{lakeformation.PermissionSelect},
+		},
+		{
+			Permissions: []lakeformation.Permission{lakeformation.PermissionSelect, lakeformation.PermissionAlter},
+		},
+	}
+	want := ObservedPermissions{
+		Permissions:                []lakeformation.Permission{lakeformation.PermissionAlter, lakeformation.PermissionSelect},
+		PermissionsWithGrantOption: []lakeformation.Permission{lakeformation.PermissionSelect},
+	}
+	if diff := cmp.Diff(want, GenerateObservedPermissions(in)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestRevokedPermissions(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.PermissionsParameters
+		o    ObservedPermissions
+		want ObservedPermissions
+	}{
+		"NothingToRevoke": {
+			p: v1alpha1.PermissionsParameters{
+				Principal:   aws.String(roleARN),
+				Permissions: []v1alpha1.Permission{"SELECT", "ALTER"},
+			},
+			o: ObservedPermissions{
+				Permissions: []lakeformation.Permission{lakeformation.PermissionSelect},
+			},
+			want: ObservedPermissions{},
+		},
+		"RevokeRemoved": {
+			p: v1alpha1.PermissionsParameters{
+				Principal:   aws.String(roleARN),
+				Permissions: []v1alpha1.Permission{"SELECT"},
+			},
+			o: ObservedPermissions{
+				Permissions:                []lakeformation.Permission{lakeformation.PermissionDrop, lakeformation.PermissionSelect},
+				PermissionsWithGrantOption: []lakeformation.Permission{lakeformation.PermissionSelect},
+			},
+			want: ObservedPermissions{
+				Permissions:                []lakeformation.Permission{lakeformation.PermissionDrop},
+				PermissionsWithGrantOption: []lakeformation.Permission{lakeformation.PermissionSelect},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := RevokedPermissions(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPermissionsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.PermissionsParameters
+		o    ObservedPermissions
+		want bool
+	}{
+		"SameInDifferentOrder": {
+			p: v1alpha1.PermissionsParameters{
+				Permissions:                []v1alpha1.Permission{"SELECT", "ALTER"},
+				PermissionsWithGrantOption: []v1alpha1.Permission{"SELECT"},
+			},
+			o: ObservedPermissions{
+				Permissions:                []lakeformation.Permission{lakeformation.PermissionAlter, lakeformation.PermissionSelect},
+				PermissionsWithGrantOption: []lakeformation.Permission{lakeformation.PermissionSelect},
+			},
+			want: true,
+		},
+		"GrantOptionMissing": {
+			p: v1alpha1.PermissionsParameters{
+				Permissions:                []v1alpha1.Permission{"SELECT"},
+				PermissionsWithGrantOption: []v1alpha1.Permission{"SELECT"},
+			},
+			o: ObservedPermissions{
+				Permissions: []lakeformation.Permission{lakeformation.PermissionSelect},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPermissionsUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package licensemanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AssociationClient is the external client used for Association Custom
+// Resource
+type AssociationClient interface {
+	ListLicenseSpecificationsForResourceRequest(*licensemanager.ListLicenseSpecificationsForResourceInput) licensemanager.ListLicenseSpecificationsForResourceRequest
+	UpdateLicenseSpecificationsForResourceRequest(*licensemanager.UpdateLicenseSpecificationsForResourceInput) licensemanager.UpdateLicenseSpecificationsForResourceRequest
+}
+
+// NewAssociationClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewAssociationClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (AssociationClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return licensemanager.New(*cfg), err
+}
+
+// IsAssociated returns true if the resource with the supplied ARN is
+// associated with the license configuration with the supplied ARN.
+func IsAssociated(ctx context.Context, c AssociationClient, resourceARN, licenseConfigurationARN string) (bool, error) {
+	input := &licensemanager.ListLicenseSpecificationsForResourceInput{ResourceArn: aws.String(resourceARN)}
+	for {
+		rsp, err := c.ListLicenseSpecificationsForResourceRequest(input).Send(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, s := range rsp.LicenseSpecifications {
+			if aws.StringValue(s.LicenseConfigurationArn) == licenseConfigurationARN {
+				return true, nil
+			}
+		}
+		if aws.StringValue(rsp.NextToken) == "" {
+			return false, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/licensemanager"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AssociationClient = (*MockAssociationClient)(nil)
+
+// MockAssociationClient is a type that implements all the methods for AssociationClient interface
+type MockAssociationClient struct {
+	MockListLicenseSpecificationsForResource   func(*licensemanager.ListLicenseSpecificationsForResourceInput) licensemanager.ListLicenseSpecificationsForResourceRequest
+	MockUpdateLicenseSpecificationsForResource func(*licensemanager.UpdateLicenseSpecificationsForResourceInput) licensemanager.UpdateLicenseSpecificationsForResourceRequest
+}
+
+// ListLicenseSpecificationsForResourceRequest calls the underlying MockListLicenseSpecificationsForResource method.
+func (c *MockAssociationClient) ListLicenseSpecificationsForResourceRequest(i *licensemanager.ListLicenseSpecificationsForResourceInput) licensemanager.ListLicenseSpecificationsForResourceRequest {
+	return c.MockListLicenseSpecificationsForResource(i)
+}
+
+// UpdateLicenseSpecificationsForResourceRequest calls the underlying MockUpdateLicenseSpecificationsForResource method.
+func (c *MockAssociationClient) UpdateLicenseSpecificationsForResourceRequest(i *licensemanager.UpdateLicenseSpecificationsForResourceInput) licensemanager.UpdateLicenseSpecificationsForResourceRequest {
+	return c.MockUpdateLicenseSpecificationsForResource(i)
+}
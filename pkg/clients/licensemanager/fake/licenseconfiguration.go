@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/licensemanager"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LicenseConfigurationClient = (*MockLicenseConfigurationClient)(nil)
+
+// MockLicenseConfigurationClient is a type that implements all the methods for LicenseConfigurationClient interface
+type MockLicenseConfigurationClient struct {
+	MockCreateLicenseConfiguration func(*licensemanager.CreateLicenseConfigurationInput) licensemanager.CreateLicenseConfigurationRequest
+	MockGetLicenseConfiguration    func(*licensemanager.GetLicenseConfigurationInput) licensemanager.GetLicenseConfigurationRequest
+	MockUpdateLicenseConfiguration func(*licensemanager.UpdateLicenseConfigurationInput) licensemanager.UpdateLicenseConfigurationRequest
+	MockDeleteLicenseConfiguration func(*licensemanager.DeleteLicenseConfigurationInput) licensemanager.DeleteLicenseConfigurationRequest
+}
+
+// CreateLicenseConfigurationRequest calls the underlying MockCreateLicenseConfiguration method.
+func (c *MockLicenseConfigurationClient) CreateLicenseConfigurationRequest(i *licensemanager.CreateLicenseConfigurationInput) licensemanager.CreateLicenseConfigurationRequest {
+	return c.MockCreateLicenseConfiguration(i)
+}
+
+// GetLicenseConfigurationRequest calls the underlying MockGetLicenseConfiguration method.
+func (c *MockLicenseConfigurationClient) GetLicenseConfigurationRequest(i *licensemanager.GetLicenseConfigurationInput) licensemanager.GetLicenseConfigurationRequest {
+	return c.MockGetLicenseConfiguration(i)
+}
+
+// UpdateLicenseConfigurationRequest calls the underlying MockUpdateLicenseConfiguration method.
+func (c *MockLicenseConfigurationClient) UpdateLicenseConfigurationRequest(i *licensemanager.UpdateLicenseConfigurationInput) licensemanager.UpdateLicenseConfigurationRequest {
+	return c.MockUpdateLicenseConfiguration(i)
+}
+
+// DeleteLicenseConfigurationRequest calls the underlying MockDeleteLicenseConfiguration method.
+func (c *MockLicenseConfigurationClient) DeleteLicenseConfigurationRequest(i *licensemanager.DeleteLicenseConfigurationInput) licensemanager.DeleteLicenseConfigurationRequest {
+	return c.MockDeleteLicenseConfiguration(i)
+}
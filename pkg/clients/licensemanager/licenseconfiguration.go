@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package licensemanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/licensemanager/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// LicenseConfigurationClient is the external client used for
+// LicenseConfiguration Custom Resource
+type LicenseConfigurationClient interface {
+	CreateLicenseConfigurationRequest(*licensemanager.CreateLicenseConfigurationInput) licensemanager.CreateLicenseConfigurationRequest
+	GetLicenseConfigurationRequest(*licensemanager.GetLicenseConfigurationInput) licensemanager.GetLicenseConfigurationRequest
+	UpdateLicenseConfigurationRequest(*licensemanager.UpdateLicenseConfigurationInput) licensemanager.UpdateLicenseConfigurationRequest
+	DeleteLicenseConfigurationRequest(*licensemanager.DeleteLicenseConfigurationInput) licensemanager.DeleteLicenseConfigurationRequest
+}
+
+// NewLicenseConfigurationClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewLicenseConfigurationClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (LicenseConfigurationClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return licensemanager.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the License Manager
+// resource doesn't exist. License Manager reports an unknown license
+// configuration ARN as an invalid parameter value.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == licensemanager.ErrCodeInvalidParameterValueException
+	}
+	return false
+}
+
+func generateTags(t []v1alpha1.Tag) []licensemanager.Tag {
+	if len(t) == 0 {
+		return nil
+	}
+	tags := make([]licensemanager.Tag, len(t))
+	for i := range t {
+		tags[i] = licensemanager.Tag{Key: aws.String(t[i].Key), Value: aws.String(t[i].Value)}
+	}
+	return tags
+}
+
+// GenerateCreateLicenseConfigurationInput returns the input to create a
+// license configuration from the supplied parameters.
+func GenerateCreateLicenseConfigurationInput(p v1alpha1.LicenseConfigurationParameters) *licensemanager.CreateLicenseConfigurationInput {
+	return &licensemanager.CreateLicenseConfigurationInput{
+		Name:                  aws.String(p.Name),
+		Description:           p.Description,
+		LicenseCountingType:   licensemanager.LicenseCountingType(p.LicenseCountingType),
+		LicenseCount:          p.LicenseCount,
+		LicenseCountHardLimit: p.LicenseCountHardLimit,
+		LicenseRules:          p.LicenseRules,
+		Tags:                  generateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateLicenseConfigurationInput returns the input to update the
+// license configuration with the supplied ARN.
+func GenerateUpdateLicenseConfigurationInput(arn string, p v1alpha1.LicenseConfigurationParameters) *licensemanager.UpdateLicenseConfigurationInput {
+	return &licensemanager.UpdateLicenseConfigurationInput{
+		LicenseConfigurationArn: aws.String(arn),
+		Name:                    aws.String(p.Name),
+		Description:             p.Description,
+		LicenseCount:            p.LicenseCount,
+		LicenseCountHardLimit:   p.LicenseCountHardLimit,
+		LicenseRules:            p.LicenseRules,
+	}
+}
+
+// LateInitializeLicenseConfiguration fills the empty fields in
+// *v1alpha1.LicenseConfigurationParameters with the values seen in
+// licensemanager.GetLicenseConfigurationOutput.
+func LateInitializeLicenseConfiguration(in *v1alpha1.LicenseConfigurationParameters, o *licensemanager.GetLicenseConfigurationOutput) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+	in.LicenseCount = awsclients.LateInitializeInt64Ptr(in.LicenseCount, o.LicenseCount)
+	in.LicenseCountHardLimit = awsclients.LateInitializeBoolPtr(in.LicenseCountHardLimit, o.LicenseCountHardLimit)
+	if len(in.LicenseRules) == 0 && len(o.LicenseRules) != 0 {
+		in.LicenseRules = o.LicenseRules
+	}
+}
+
+// GenerateLicenseConfigurationObservation is used to produce
+// v1alpha1.LicenseConfigurationObservation from
+// licensemanager.GetLicenseConfigurationOutput.
+func GenerateLicenseConfigurationObservation(o licensemanager.GetLicenseConfigurationOutput) v1alpha1.LicenseConfigurationObservation {
+	return v1alpha1.LicenseConfigurationObservation{
+		ARN:                    aws.StringValue(o.LicenseConfigurationArn),
+		LicenseConfigurationID: aws.StringValue(o.LicenseConfigurationId),
+		ConsumedLicenses:       aws.Int64Value(o.ConsumedLicenses),
+		OwnerAccountID:         aws.StringValue(o.OwnerAccountId),
+		Status:                 aws.StringValue(o.Status),
+	}
+}
+
+// IsLicenseConfigurationUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsLicenseConfigurationUpToDate(p v1alpha1.LicenseConfigurationParameters, o licensemanager.GetLicenseConfigurationOutput) bool {
+	return p.Name == aws.StringValue(o.Name) &&
+		aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		aws.Int64Value(p.LicenseCount) == aws.Int64Value(o.LicenseCount) &&
+		aws.BoolValue(p.LicenseCountHardLimit) == aws.BoolValue(o.LicenseCountHardLimit) &&
+		cmp.Equal(p.LicenseRules, o.LicenseRules, cmpopts.EquateEmpty())
+}
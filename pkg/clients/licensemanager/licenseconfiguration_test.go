@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package licensemanager
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/licensemanager/v1alpha1"
+)
+
+func TestGenerateCreateLicenseConfigurationInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.LicenseConfigurationParameters
+		want *licensemanager.CreateLicenseConfigurationInput
+	}{
+		"Minimal": {
+			p: v1alpha1.LicenseConfigurationParameters{Name: "sql-server", LicenseCountingType: "Core"},
+			want: &licensemanager.CreateLicenseConfigurationInput{
+				Name:                aws.String("sql-server"),
+				LicenseCountingType: licensemanager.LicenseCountingTypeCore,
+			},
+		},
+		"Full": {
+			p: v1alpha1.LicenseConfigurationParameters{
+				Name:                  "windows-server",
+				Description:           aws.String("BYOL Windows Server"),
+				LicenseCountingType:   "Socket",
+				LicenseCount:          aws.Int64(8),
+				LicenseCountHardLimit: aws.Bool(true),
+				LicenseRules:          []string{"#allowedTenancy=EC2-DedicatedHost"},
+				Tags:                  []v1alpha1.Tag{{Key: "team", Value: "platform"}},
+			},
+			want: &licensemanager.CreateLicenseConfigurationInput{
+				Name:                  aws.String("windows-server"),
+				Description:           aws.String("BYOL Windows Server"),
+				LicenseCountingType:   licensemanager.LicenseCountingTypeSocket,
+				LicenseCount:          aws.Int64(8),
+				LicenseCountHardLimit: aws.Bool(true),
+				LicenseRules:          []string{"#allowedTenancy=EC2-DedicatedHost"},
+				Tags:                  []licensemanager.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateCreateLicenseConfigurationInput(tc.p)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLicenseConfigurationUpToDate(t *testing.T) {
+	observed := licensemanager.GetLicenseConfigurationOutput{
+		Name:                  aws.String("windows-server"),
+		LicenseCount:          aws.Int64(8),
+		LicenseCountHardLimit: aws.Bool(true),
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.LicenseConfigurationParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.LicenseConfigurationParameters{Name: "windows-server", LicenseCount: aws.Int64(8), LicenseCountHardLimit: aws.Bool(true), LicenseRules: []string{}},
+			want: true,
+		},
+		"CountChanged": {
+			p:    v1alpha1.LicenseConfigurationParameters{Name: "windows-server", LicenseCount: aws.Int64(16), LicenseCountHardLimit: aws.Bool(true)},
+			want: false,
+		},
+		"RulesChanged": {
+			p:    v1alpha1.LicenseConfigurationParameters{Name: "windows-server", LicenseCount: aws.Int64(8), LicenseCountHardLimit: aws.Bool(true), LicenseRules: []string{"#minimumSockets=2"}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsLicenseConfigurationUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
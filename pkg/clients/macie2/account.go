@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package macie2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AccountClient is the external client used for Account Custom Resource
+type AccountClient interface {
+	EnableMacieRequest(*macie2.EnableMacieInput) macie2.EnableMacieRequest
+	GetMacieSessionRequest(*macie2.GetMacieSessionInput) macie2.GetMacieSessionRequest
+	UpdateMacieSessionRequest(*macie2.UpdateMacieSessionInput) macie2.UpdateMacieSessionRequest
+	DisableMacieRequest(*macie2.DisableMacieInput) macie2.DisableMacieRequest
+}
+
+// NewAccountClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewAccountClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (AccountClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return macie2.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the Macie resource doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == macie2.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// IsNotEnabled returns true if the error is because Macie is not enabled for
+// the account. Macie reports this as an access denied error.
+func IsNotEnabled(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == macie2.ErrCodeAccessDeniedException && strings.Contains(awsErr.Message(), "Macie is not enabled")
+	}
+	return false
+}
+
+// GenerateEnableMacieInput returns the input to enable Macie from the
+// supplied parameters.
+func GenerateEnableMacieInput(token string, p v1alpha1.AccountParameters) *macie2.EnableMacieInput {
+	return &macie2.EnableMacieInput{
+		ClientToken:                aws.String(token),
+		FindingPublishingFrequency: macie2.FindingPublishingFrequency(aws.StringValue(p.FindingPublishingFrequency)),
+		Status:                     macie2.MacieStatus(aws.StringValue(p.Status)),
+	}
+}
+
+// GenerateAccountObservation is used to produce v1alpha1.AccountObservation
+// from macie2.GetMacieSessionOutput.
+func GenerateAccountObservation(s macie2.GetMacieSessionOutput) v1alpha1.AccountObservation {
+	o := v1alpha1.AccountObservation{
+		ServiceRole: aws.StringValue(s.ServiceRole),
+	}
+	if s.CreatedAt != nil {
+		t := metav1.NewTime(*s.CreatedAt)
+		o.CreatedAt = &t
+	}
+	return o
+}
+
+// LateInitializeAccount fills the empty fields in *v1alpha1.AccountParameters
+// with the values seen in macie2.GetMacieSessionOutput.
+func LateInitializeAccount(in *v1alpha1.AccountParameters, s *macie2.GetMacieSessionOutput) {
+	if s == nil {
+		return
+	}
+	in.FindingPublishingFrequency = awsclients.LateInitializeStringPtr(in.FindingPublishingFrequency, awsclients.String(string(s.FindingPublishingFrequency)))
+	in.Status = awsclients.LateInitializeStringPtr(in.Status, awsclients.String(string(s.Status)))
+}
+
+// IsAccountUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsAccountUpToDate(p v1alpha1.AccountParameters, s macie2.GetMacieSessionOutput) bool {
+	return aws.StringValue(p.FindingPublishingFrequency) == string(s.FindingPublishingFrequency) &&
+		aws.StringValue(p.Status) == string(s.Status)
+}
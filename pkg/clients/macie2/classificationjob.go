@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package macie2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ClassificationJobClient is the external client used for ClassificationJob
+// Custom Resource
+type ClassificationJobClient interface {
+	CreateClassificationJobRequest(*macie2.CreateClassificationJobInput) macie2.CreateClassificationJobRequest
+	DescribeClassificationJobRequest(*macie2.DescribeClassificationJobInput) macie2.DescribeClassificationJobRequest
+	UpdateClassificationJobRequest(*macie2.UpdateClassificationJobInput) macie2.UpdateClassificationJobRequest
+}
+
+// NewClassificationJobClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewClassificationJobClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ClassificationJobClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return macie2.New(*cfg), err
+}
+
+// GenerateCreateClassificationJobInput returns the input to create a
+// classification job from the supplied parameters.
+func GenerateCreateClassificationJobInput(token string, p v1alpha1.ClassificationJobParameters) *macie2.CreateClassificationJobInput {
+	in := &macie2.CreateClassificationJobInput{
+		ClientToken:             aws.String(token),
+		Name:                    aws.String(p.Name),
+		Description:             p.Description,
+		JobType:                 macie2.JobType(p.JobType),
+		InitialRun:              p.InitialRun,
+		SamplingPercentage:      p.SamplingPercentage,
+		CustomDataIdentifierIds: p.CustomDataIdentifierIDs,
+		S3JobDefinition:         &macie2.S3JobDefinition{},
+		Tags:                    p.Tags,
+	}
+	for _, bd := range p.S3JobDefinition.BucketDefinitions {
+		in.S3JobDefinition.BucketDefinitions = append(in.S3JobDefinition.BucketDefinitions, macie2.S3BucketDefinitionForJob{
+			AccountId: aws.String(bd.AccountID),
+			Buckets:   bd.Buckets,
+		})
+	}
+	if sf := p.ScheduleFrequency; sf != nil {
+		in.ScheduleFrequency = &macie2.JobScheduleFrequency{}
+		if sf.DailySchedule != nil {
+			in.ScheduleFrequency.DailySchedule = &macie2.DailySchedule{}
+		}
+		if sf.WeeklySchedule != nil {
+			in.ScheduleFrequency.WeeklySchedule = &macie2.WeeklySchedule{DayOfWeek: macie2.DayOfWeek(sf.WeeklySchedule.DayOfWeek)}
+		}
+		if sf.MonthlySchedule != nil {
+			in.ScheduleFrequency.MonthlySchedule = &macie2.MonthlySchedule{DayOfMonth: aws.Int64(sf.MonthlySchedule.DayOfMonth)}
+		}
+	}
+	return in
+}
+
+// GenerateClassificationJobObservation is used to produce
+// v1alpha1.ClassificationJobObservation from
+// macie2.DescribeClassificationJobOutput.
+func GenerateClassificationJobObservation(j macie2.DescribeClassificationJobOutput) v1alpha1.ClassificationJobObservation {
+	o := v1alpha1.ClassificationJobObservation{
+		JobARN:    aws.StringValue(j.JobArn),
+		JobStatus: string(j.JobStatus),
+	}
+	if j.LastRunTime != nil {
+		t := metav1.NewTime(*j.LastRunTime)
+		o.LastRunTime = &t
+	}
+	return o
+}
+
+// IsClassificationJobUpToDate returns true if the job is in the desired
+// status. A job that is not paused is considered running, including idle
+// scheduled jobs and completed one-time jobs.
+func IsClassificationJobUpToDate(p v1alpha1.ClassificationJobParameters, j macie2.DescribeClassificationJobOutput) bool {
+	switch aws.StringValue(p.JobStatus) {
+	case string(macie2.JobStatusPaused):
+		return j.JobStatus == macie2.JobStatusPaused || j.JobStatus == macie2.JobStatusComplete
+	case string(macie2.JobStatusRunning):
+		return j.JobStatus != macie2.JobStatusPaused
+	}
+	return true
+}
+
+// LateInitializeClassificationJob fills the empty fields in
+// *v1alpha1.ClassificationJobParameters with the values seen in
+// macie2.DescribeClassificationJobOutput.
+func LateInitializeClassificationJob(in *v1alpha1.ClassificationJobParameters, j *macie2.DescribeClassificationJobOutput) {
+	if j == nil {
+		return
+	}
+	in.InitialRun = awsclients.LateInitializeBoolPtr(in.InitialRun, j.InitialRun)
+	in.SamplingPercentage = awsclients.LateInitializeInt64Ptr(in.SamplingPercentage, j.SamplingPercentage)
+}
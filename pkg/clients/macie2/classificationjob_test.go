@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package macie2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+)
+
+func TestGenerateCreateClassificationJobInput(t *testing.T) {
+	buckets := v1alpha1.S3JobDefinition{
+		BucketDefinitions: []v1alpha1.S3BucketDefinition{{AccountID: "123456789012", Buckets: []string{"customer-data"}}},
+	}
+	s3 := &macie2.S3JobDefinition{
+		BucketDefinitions: []macie2.S3BucketDefinitionForJob{{AccountId: aws.String("123456789012"), Buckets: []string{"customer-data"}}},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ClassificationJobParameters
+		want *macie2.CreateClassificationJobInput
+	}{
+		"OneTime": {
+			p: v1alpha1.ClassificationJobParameters{
+				Name:            "pii-scan",
+				JobType:         "ONE_TIME",
+				S3JobDefinition: buckets,
+			},
+			want: &macie2.CreateClassificationJobInput{
+				ClientToken:     aws.String("token"),
+				Name:            aws.String("pii-scan"),
+				JobType:         macie2.JobTypeOneTime,
+				S3JobDefinition: s3,
+			},
+		},
+		"Scheduled": {
+			p: v1alpha1.ClassificationJobParameters{
+				Name:                    "pii-scan",
+				JobType:                 "SCHEDULED",
+				ScheduleFrequency:       &v1alpha1.JobScheduleFrequency{WeeklySchedule: &v1alpha1.WeeklySchedule{DayOfWeek: "MONDAY"}},
+				InitialRun:              aws.Bool(true),
+				SamplingPercentage:      aws.Int64(50),
+				S3JobDefinition:         buckets,
+				CustomDataIdentifierIDs: []string{"a8d2e9f4"},
+				Tags:                    map[string]string{"team": "security"},
+			},
+			want: &macie2.CreateClassificationJobInput{
+				ClientToken:             aws.String("token"),
+				Name:                    aws.String("pii-scan"),
+				JobType:                 macie2.JobTypeScheduled,
+				ScheduleFrequency:       &macie2.JobScheduleFrequency{WeeklySchedule: &macie2.WeeklySchedule{DayOfWeek: macie2.DayOfWeekMonday}},
+				InitialRun:              aws.Bool(true),
+				SamplingPercentage:      aws.Int64(50),
+				S3JobDefinition:         s3,
+				CustomDataIdentifierIds: []string{"a8d2e9f4"},
+				Tags:                    map[string]string{"team": "security"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateClassificationJobInput("token", tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsClassificationJobUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *string
+		observed macie2.JobStatus
+		want     bool
+	}{
+		"Unset": {
+			observed: macie2.JobStatusPaused,
+			want:     true,
+		},
+		"RunningIdle": {
+			desired:  aws.String("RUNNING"),
+			observed: macie2.JobStatusIdle,
+			want:     true,
+		},
+		"ResumePaused": {
+			desired:  aws.String("RUNNING"),
+			observed: macie2.JobStatusPaused,
+			want:     false,
+		},
+		"PauseRunning": {
+			desired:  aws.String("PAUSED"),
+			observed: macie2.JobStatusRunning,
+			want:     false,
+		},
+		"PauseComplete": {
+			desired:  aws.String("PAUSED"),
+			observed: macie2.JobStatusComplete,
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsClassificationJobUpToDate(v1alpha1.ClassificationJobParameters{JobStatus: tc.desired}, macie2.DescribeClassificationJobOutput{JobStatus: tc.observed})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
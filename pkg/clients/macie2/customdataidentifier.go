@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package macie2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// CustomDataIdentifierClient is the external client used for
+// CustomDataIdentifier Custom Resource
+type CustomDataIdentifierClient interface {
+	CreateCustomDataIdentifierRequest(*macie2.CreateCustomDataIdentifierInput) macie2.CreateCustomDataIdentifierRequest
+	GetCustomDataIdentifierRequest(*macie2.GetCustomDataIdentifierInput) macie2.GetCustomDataIdentifierRequest
+	DeleteCustomDataIdentifierRequest(*macie2.DeleteCustomDataIdentifierInput) macie2.DeleteCustomDataIdentifierRequest
+}
+
+// NewCustomDataIdentifierClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewCustomDataIdentifierClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (CustomDataIdentifierClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return macie2.New(*cfg), err
+}
+
+// GenerateCreateCustomDataIdentifierInput returns the input to create a
+// custom data identifier from the supplied parameters.
+func GenerateCreateCustomDataIdentifierInput(token string, p v1alpha1.CustomDataIdentifierParameters) *macie2.CreateCustomDataIdentifierInput {
+	return &macie2.CreateCustomDataIdentifierInput{
+		ClientToken:          aws.String(token),
+		Name:                 aws.String(p.Name),
+		Description:          p.Description,
+		Regex:                aws.String(p.Regex),
+		Keywords:             p.Keywords,
+		IgnoreWords:          p.IgnoreWords,
+		MaximumMatchDistance: p.MaximumMatchDistance,
+		Tags:                 p.Tags,
+	}
+}
+
+// LateInitializeCustomDataIdentifier fills the empty fields in
+// *v1alpha1.CustomDataIdentifierParameters with the values seen in
+// macie2.GetCustomDataIdentifierOutput.
+func LateInitializeCustomDataIdentifier(in *v1alpha1.CustomDataIdentifierParameters, c *macie2.GetCustomDataIdentifierOutput) {
+	if c == nil {
+		return
+	}
+	in.MaximumMatchDistance = awsclients.LateInitializeInt64Ptr(in.MaximumMatchDistance, c.MaximumMatchDistance)
+}
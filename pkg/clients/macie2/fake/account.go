@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/macie2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccountClient = (*MockAccountClient)(nil)
+
+// MockAccountClient is a type that implements all the methods for AccountClient interface
+type MockAccountClient struct {
+	MockEnableMacie        func(*macie2.EnableMacieInput) macie2.EnableMacieRequest
+	MockGetMacieSession    func(*macie2.GetMacieSessionInput) macie2.GetMacieSessionRequest
+	MockUpdateMacieSession func(*macie2.UpdateMacieSessionInput) macie2.UpdateMacieSessionRequest
+	MockDisableMacie       func(*macie2.DisableMacieInput) macie2.DisableMacieRequest
+}
+
+// EnableMacieRequest calls the underlying MockEnableMacie method.
+func (c *MockAccountClient) EnableMacieRequest(i *macie2.EnableMacieInput) macie2.EnableMacieRequest {
+	return c.MockEnableMacie(i)
+}
+
+// GetMacieSessionRequest calls the underlying MockGetMacieSession method.
+func (c *MockAccountClient) GetMacieSessionRequest(i *macie2.GetMacieSessionInput) macie2.GetMacieSessionRequest {
+	return c.MockGetMacieSession(i)
+}
+
+// UpdateMacieSessionRequest calls the underlying MockUpdateMacieSession method.
+func (c *MockAccountClient) UpdateMacieSessionRequest(i *macie2.UpdateMacieSessionInput) macie2.UpdateMacieSessionRequest {
+	return c.MockUpdateMacieSession(i)
+}
+
+// DisableMacieRequest calls the underlying MockDisableMacie method.
+func (c *MockAccountClient) DisableMacieRequest(i *macie2.DisableMacieInput) macie2.DisableMacieRequest {
+	return c.MockDisableMacie(i)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/macie2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CustomDataIdentifierClient = (*MockCustomDataIdentifierClient)(nil)
+
+// MockCustomDataIdentifierClient is a type that implements all the methods for CustomDataIdentifierClient interface
+type MockCustomDataIdentifierClient struct {
+	MockCreateCustomDataIdentifier func(*macie2.CreateCustomDataIdentifierInput) macie2.CreateCustomDataIdentifierRequest
+	MockGetCustomDataIdentifier    func(*macie2.GetCustomDataIdentifierInput) macie2.GetCustomDataIdentifierRequest
+	MockDeleteCustomDataIdentifier func(*macie2.DeleteCustomDataIdentifierInput) macie2.DeleteCustomDataIdentifierRequest
+}
+
+// CreateCustomDataIdentifierRequest calls the underlying MockCreateCustomDataIdentifier method.
+func (c *MockCustomDataIdentifierClient) CreateCustomDataIdentifierRequest(i *macie2.CreateCustomDataIdentifierInput) macie2.CreateCustomDataIdentifierRequest {
+	return c.MockCreateCustomDataIdentifier(i)
+}
+
+// GetCustomDataIdentifierRequest calls the underlying MockGetCustomDataIdentifier method.
+func (c *MockCustomDataIdentifierClient) GetCustomDataIdentifierRequest(i *macie2.GetCustomDataIdentifierInput) macie2.GetCustomDataIdentifierRequest {
+	return c.MockGetCustomDataIdentifier(i)
+}
+
+// DeleteCustomDataIdentifierRequest calls the underlying MockDeleteCustomDataIdentifier method.
+func (c *MockCustomDataIdentifierClient) DeleteCustomDataIdentifierRequest(i *macie2.DeleteCustomDataIdentifierInput) macie2.DeleteCustomDataIdentifierRequest {
+	return c.MockDeleteCustomDataIdentifier(i)
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics records how long managed resources take to sync with AWS
+// and when they were last synced.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TypeLastSync resources report when they were last observed successfully
+// and how long the observation took.
+const TypeLastSync runtimev1alpha1.ConditionType = "LastSync"
+
+// ReasonObserved indicates that a resource was observed successfully.
+const ReasonObserved runtimev1alpha1.ConditionReason = "Observed"
+
+// Operations of external clients.
+const (
+	OperationObserve = "observe"
+	OperationCreate  = "create"
+	OperationUpdate  = "update"
+	OperationDelete  = "delete"
+)
+
+// Results of operations of external clients.
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
+
+// LastSyncInterval is how often the LastSync condition of a resource is
+// refreshed. Every change to the status of a resource queues it again, so
+// refreshing the condition on each observation would reconcile resources in a
+// tight loop.
+const LastSyncInterval = 5 * time.Minute
+
+var (
+	// OperationDuration is a histogram of how long the operations of external
+	// clients take, by kind of managed resource.
+	OperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "crossplane_aws",
+		Name:      "external_operation_duration_seconds",
+		Help:      "How long operations against the AWS API take, by kind of managed resource, operation and result.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"kind", "operation", "result"})
+
+	// LastSyncTime is the Unix time at which a managed resource of each kind
+	// was last observed successfully.
+	LastSyncTime = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "crossplane_aws",
+		Name:      "last_sync_timestamp_seconds",
+		Help:      "Unix time at which a managed resource of a kind was last observed successfully.",
+	}, []string{"kind"})
+
+	// ResourceLastSyncTime is the Unix time at which each managed resource was
+	// last observed successfully. It is only recorded if PerResource is true.
+	ResourceLastSyncTime = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "crossplane_aws",
+		Name:      "resource_last_sync_timestamp_seconds",
+		Help:      "Unix time at which a managed resource was last observed successfully.",
+	}, []string{"kind", "name"})
+)
+
+// PerResource determines whether ResourceLastSyncTime is recorded. It has a
+// series for every managed resource, which may be too many to scrape.
+var PerResource = false
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(OperationDuration, LastSyncTime, ResourceLastSyncTime)
+}
+
+// LastSync returns a condition that indicates a resource was observed
+// successfully at the supplied time, which took the supplied duration.
+func LastSync(at time.Time, d time.Duration) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeLastSync,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(at),
+		Reason:             ReasonObserved,
+		Message:            fmt.Sprintf("Observed in %s", d.Round(time.Millisecond)),
+	}
+}
+
+// Kind returns the kind and API group of the supplied managed resource, such
+// as VPC.ec2.aws.crossplane.io, or the name of its Go type if the supplied
+// scheme does not know it.
+func Kind(s *runtime.Scheme, mg resource.Managed) string {
+	gvk, err := apiutil.GVKForObject(mg, s)
+	if err != nil {
+		return reflect.Indirect(reflect.ValueOf(mg)).Type().Name()
+	}
+	return gvk.GroupKind().String()
+}
+
+// NewConnecter returns an ExternalConnecter whose ExternalClients record how
+// long their operations take and when they last observed their resource. The
+// time of the last successful observation is reported by the LastSync
+// condition of the resource, refreshed at most every LastSyncInterval.
+func NewConnecter(mgr manager.Manager, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c, scheme: mgr.GetScheme(), now: time.Now}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	scheme *runtime.Scheme
+	now    func() time.Time
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: e, kind: Kind(c.scheme, mg), now: c.now}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	kind string
+	now  func() time.Time
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	start := e.now()
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	d := e.record(OperationObserve, start, err)
+	if err != nil {
+		return o, err
+	}
+	LastSyncTime.WithLabelValues(e.kind).Set(float64(start.Unix()))
+	if PerResource {
+		ResourceLastSyncTime.WithLabelValues(e.kind, mg.GetName()).Set(float64(start.Unix()))
+	}
+	if c := mg.GetCondition(TypeLastSync); c.Status != corev1.ConditionTrue || start.Sub(c.LastTransitionTime.Time) >= LastSyncInterval {
+		mg.SetConditions(LastSync(start, d))
+	}
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	start := e.now()
+	c, err := e.ExternalClient.Create(ctx, mg)
+	e.record(OperationCreate, start, err)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	start := e.now()
+	u, err := e.ExternalClient.Update(ctx, mg)
+	e.record(OperationUpdate, start, err)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	start := e.now()
+	err := e.ExternalClient.Delete(ctx, mg)
+	e.record(OperationDelete, start, err)
+	if err == nil {
+		ResourceLastSyncTime.DeleteLabelValues(e.kind, mg.GetName())
+	}
+	return err
+}
+
+// record observes how long the supplied operation took and returns it.
+func (e *external) record(op string, start time.Time, err error) time.Duration {
+	d := e.now().Sub(start)
+	result := ResultSuccess
+	if err != nil {
+		result = ResultError
+	}
+	OperationDuration.WithLabelValues(e.kind, op, result).Observe(d.Seconds())
+	return d
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	start := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	connect := func(err error) managed.ExternalConnecter {
+		return managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+					return managed.ExternalObservation{}, err
+				},
+			}, nil
+		})
+	}
+
+	type want struct {
+		err       error
+		condition runtimev1alpha1.Condition
+	}
+
+	cases := map[string]struct {
+		c        managed.ExternalConnecter
+		existing []runtimev1alpha1.Condition
+		want     want
+	}{
+		"FirstSync": {
+			c:    connect(nil),
+			want: want{condition: LastSync(start, 2*time.Second)},
+		},
+		"RecentSync": {
+			c:        connect(nil),
+			existing: []runtimev1alpha1.Condition{LastSync(start.Add(-time.Minute), time.Second)},
+			want:     want{condition: LastSync(start.Add(-time.Minute), time.Second)},
+		},
+		"StaleSync": {
+			c:        connect(nil),
+			existing: []runtimev1alpha1.Condition{LastSync(start.Add(-LastSyncInterval), time.Second)},
+			want:     want{condition: LastSync(start, 2*time.Second)},
+		},
+		"FailedSync": {
+			c:        connect(errBoom),
+			existing: []runtimev1alpha1.Condition{LastSync(start.Add(-LastSyncInterval), time.Second)},
+			want: want{
+				err:       errBoom,
+				condition: LastSync(start.Add(-LastSyncInterval), time.Second),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			mg := &fake.Managed{}
+			mg.SetName(name)
+			mg.SetConditions(tc.existing...)
+
+			// Each call to the clock advances it by two seconds.
+			calls := 0
+			now := func() time.Time {
+				calls++
+				return start.Add(time.Duration(calls-1) * 2 * time.Second)
+			}
+			e, err := (&connecter{ExternalConnecter: tc.c, scheme: runtime.NewScheme(), now: now}).Connect(ctx, mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			_, err = e.Observe(ctx, mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, mg.GetCondition(TypeLastSync)); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLastSyncTime(t *testing.T) {
+	cases := map[string]struct {
+		perResource bool
+	}{
+		"PerKind":     {},
+		"PerResource": {perResource: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer func(p bool) { PerResource = p }(PerResource)
+			PerResource = tc.perResource
+
+			ctx := context.Background()
+			mg := &fake.Managed{}
+			mg.SetName(name)
+			c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{ResourceExists: true}, nil
+					},
+					DeleteFn: func(_ context.Context, _ resource.Managed) error { return nil },
+				}, nil
+			})
+			at := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+			e, _ := (&connecter{ExternalConnecter: c, scheme: runtime.NewScheme(), now: func() time.Time { return at }}).Connect(ctx, mg)
+
+			if _, err := e.Observe(ctx, mg); err != nil {
+				t.Fatalf("Observe(...): %s", err)
+			}
+			got := testutil.ToFloat64(LastSyncTime.WithLabelValues("Managed"))
+			if diff := cmp.Diff(float64(at.Unix()), got); diff != "" {
+				t.Errorf("Observe(...): -want last sync time, +got last sync time:\n%s", diff)
+			}
+			recorded := ResourceLastSyncTime.DeleteLabelValues("Managed", mg.GetName())
+			if diff := cmp.Diff(tc.perResource, recorded); diff != "" {
+				t.Errorf("Observe(...): -want resource last sync time recorded, +got:\n%s", diff)
+			}
+
+			if tc.perResource {
+				if _, err := e.Observe(ctx, mg); err != nil {
+					t.Fatalf("Observe(...): %s", err)
+				}
+				if err := e.Delete(ctx, mg); err != nil {
+					t.Fatalf("Delete(...): %s", err)
+				}
+				if deleted := ResourceLastSyncTime.DeleteLabelValues("Managed", mg.GetName()); deleted {
+					t.Errorf("Delete(...): last sync time of deleted resource was not removed")
+				}
+			}
+		})
+	}
+}
+
+func TestKind(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want string
+	}{
+		"Known":   {mg: &v1beta1.VPC{}, want: "VPC.ec2.aws.crossplane.io"},
+		"Unknown": {mg: &fake.Managed{}, want: "Managed"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Kind(s, tc.mg)); diff != "" {
+				t.Errorf("Kind(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
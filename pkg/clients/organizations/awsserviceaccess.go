@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AWSServiceAccessClient is the external client used for AWSServiceAccess
+// Custom Resource
+type AWSServiceAccessClient interface {
+	EnableAWSServiceAccessRequest(*organizations.EnableAWSServiceAccessInput) organizations.EnableAWSServiceAccessRequest
+	ListAWSServiceAccessForOrganizationRequest(*organizations.ListAWSServiceAccessForOrganizationInput) organizations.ListAWSServiceAccessForOrganizationRequest
+	DisableAWSServiceAccessRequest(*organizations.DisableAWSServiceAccessInput) organizations.DisableAWSServiceAccessRequest
+}
+
+// NewAWSServiceAccessClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewAWSServiceAccessClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (AWSServiceAccessClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return organizations.New(*cfg), err
+}
+
+// FindEnabledServicePrincipal returns the service principal with the given
+// name if trusted access is enabled for it in the organization, or nil if it
+// isn't.
+func FindEnabledServicePrincipal(ctx context.Context, c AWSServiceAccessClient, servicePrincipal string) (*organizations.EnabledServicePrincipal, error) {
+	input := &organizations.ListAWSServiceAccessForOrganizationInput{}
+	for {
+		rsp, err := c.ListAWSServiceAccessForOrganizationRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rsp.EnabledServicePrincipals {
+			if aws.StringValue(rsp.EnabledServicePrincipals[i].ServicePrincipal) == servicePrincipal {
+				return &rsp.EnabledServicePrincipals[i], nil
+			}
+		}
+		if aws.StringValue(rsp.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+// GenerateAWSServiceAccessObservation is used to produce
+// v1alpha1.AWSServiceAccessObservation from
+// organizations.EnabledServicePrincipal.
+func GenerateAWSServiceAccessObservation(p organizations.EnabledServicePrincipal) v1alpha1.AWSServiceAccessObservation {
+	o := v1alpha1.AWSServiceAccessObservation{}
+	if p.DateEnabled != nil {
+		t := metav1.NewTime(*p.DateEnabled)
+		o.DateEnabled = &t
+	}
+	return o
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DelegatedAdministratorClient is the external client used for
+// DelegatedAdministrator Custom Resource
+type DelegatedAdministratorClient interface {
+	RegisterDelegatedAdministratorRequest(*organizations.RegisterDelegatedAdministratorInput) organizations.RegisterDelegatedAdministratorRequest
+	ListDelegatedServicesForAccountRequest(*organizations.ListDelegatedServicesForAccountInput) organizations.ListDelegatedServicesForAccountRequest
+	DeregisterDelegatedAdministratorRequest(*organizations.DeregisterDelegatedAdministratorInput) organizations.DeregisterDelegatedAdministratorRequest
+}
+
+// NewDelegatedAdministratorClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewDelegatedAdministratorClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DelegatedAdministratorClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return organizations.New(*cfg), err
+}
+
+// IsDelegatedAdministratorNotFound returns true if the error is because the
+// account is not a delegated administrator, or doesn't exist.
+func IsDelegatedAdministratorNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case organizations.ErrCodeAccountNotRegisteredException,
+			organizations.ErrCodeAccountNotFoundException:
+			return true
+		}
+	}
+	return false
+}
+
+// FindDelegatedService returns the service with the given principal that the
+// supplied account is a delegated administrator for, or nil if it isn't.
+func FindDelegatedService(ctx context.Context, c DelegatedAdministratorClient, accountID, servicePrincipal string) (*organizations.DelegatedService, error) {
+	input := &organizations.ListDelegatedServicesForAccountInput{AccountId: aws.String(accountID)}
+	for {
+		rsp, err := c.ListDelegatedServicesForAccountRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rsp.DelegatedServices {
+			if aws.StringValue(rsp.DelegatedServices[i].ServicePrincipal) == servicePrincipal {
+				return &rsp.DelegatedServices[i], nil
+			}
+		}
+		if aws.StringValue(rsp.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+// GenerateDelegatedAdministratorObservation is used to produce
+// v1alpha1.DelegatedAdministratorObservation from
+// organizations.DelegatedService.
+func GenerateDelegatedAdministratorObservation(s organizations.DelegatedService) v1alpha1.DelegatedAdministratorObservation {
+	o := v1alpha1.DelegatedAdministratorObservation{}
+	if s.DelegationEnabledDate != nil {
+		t := metav1.NewTime(*s.DelegationEnabledDate)
+		o.DelegationEnabledDate = &t
+	}
+	return o
+}
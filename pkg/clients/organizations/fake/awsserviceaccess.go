@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AWSServiceAccessClient = (*MockAWSServiceAccessClient)(nil)
+
+// MockAWSServiceAccessClient is a type that implements all the methods for AWSServiceAccessClient interface
+type MockAWSServiceAccessClient struct {
+	MockEnableAWSServiceAccess              func(*organizations.EnableAWSServiceAccessInput) organizations.EnableAWSServiceAccessRequest
+	MockListAWSServiceAccessForOrganization func(*organizations.ListAWSServiceAccessForOrganizationInput) organizations.ListAWSServiceAccessForOrganizationRequest
+	MockDisableAWSServiceAccess             func(*organizations.DisableAWSServiceAccessInput) organizations.DisableAWSServiceAccessRequest
+}
+
+// EnableAWSServiceAccessRequest calls the underlying MockEnableAWSServiceAccess method.
+func (c *MockAWSServiceAccessClient) EnableAWSServiceAccessRequest(i *organizations.EnableAWSServiceAccessInput) organizations.EnableAWSServiceAccessRequest {
+	return c.MockEnableAWSServiceAccess(i)
+}
+
+// ListAWSServiceAccessForOrganizationRequest calls the underlying MockListAWSServiceAccessForOrganization method.
+func (c *MockAWSServiceAccessClient) ListAWSServiceAccessForOrganizationRequest(i *organizations.ListAWSServiceAccessForOrganizationInput) organizations.ListAWSServiceAccessForOrganizationRequest {
+	return c.MockListAWSServiceAccessForOrganization(i)
+}
+
+// DisableAWSServiceAccessRequest calls the underlying MockDisableAWSServiceAccess method.
+func (c *MockAWSServiceAccessClient) DisableAWSServiceAccessRequest(i *organizations.DisableAWSServiceAccessInput) organizations.DisableAWSServiceAccessRequest {
+	return c.MockDisableAWSServiceAccess(i)
+}
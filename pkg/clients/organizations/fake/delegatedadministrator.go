@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DelegatedAdministratorClient = (*MockDelegatedAdministratorClient)(nil)
+
+// MockDelegatedAdministratorClient is a type that implements all the methods for DelegatedAdministratorClient interface
+type MockDelegatedAdministratorClient struct {
+	MockRegisterDelegatedAdministrator   func(*organizations.RegisterDelegatedAdministratorInput) organizations.RegisterDelegatedAdministratorRequest
+	MockListDelegatedServicesForAccount  func(*organizations.ListDelegatedServicesForAccountInput) organizations.ListDelegatedServicesForAccountRequest
+	MockDeregisterDelegatedAdministrator func(*organizations.DeregisterDelegatedAdministratorInput) organizations.DeregisterDelegatedAdministratorRequest
+}
+
+// RegisterDelegatedAdministratorRequest calls the underlying MockRegisterDelegatedAdministrator method.
+func (c *MockDelegatedAdministratorClient) RegisterDelegatedAdministratorRequest(i *organizations.RegisterDelegatedAdministratorInput) organizations.RegisterDelegatedAdministratorRequest {
+	return c.MockRegisterDelegatedAdministrator(i)
+}
+
+// ListDelegatedServicesForAccountRequest calls the underlying MockListDelegatedServicesForAccount method.
+func (c *MockDelegatedAdministratorClient) ListDelegatedServicesForAccountRequest(i *organizations.ListDelegatedServicesForAccountInput) organizations.ListDelegatedServicesForAccountRequest {
+	return c.MockListDelegatedServicesForAccount(i)
+}
+
+// DeregisterDelegatedAdministratorRequest calls the underlying MockDeregisterDelegatedAdministrator method.
+func (c *MockDelegatedAdministratorClient) DeregisterDelegatedAdministratorRequest(i *organizations.DeregisterDelegatedAdministratorInput) organizations.DeregisterDelegatedAdministratorRequest {
+	return c.MockDeregisterDelegatedAdministrator(i)
+}
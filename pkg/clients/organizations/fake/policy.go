@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PolicyClient = (*MockPolicyClient)(nil)
+
+// MockPolicyClient is a type that implements all the methods for PolicyClient interface
+type MockPolicyClient struct {
+	MockCreatePolicy   func(*organizations.CreatePolicyInput) organizations.CreatePolicyRequest
+	MockDescribePolicy func(*organizations.DescribePolicyInput) organizations.DescribePolicyRequest
+	MockUpdatePolicy   func(*organizations.UpdatePolicyInput) organizations.UpdatePolicyRequest
+	MockDeletePolicy   func(*organizations.DeletePolicyInput) organizations.DeletePolicyRequest
+}
+
+// CreatePolicyRequest calls the underlying MockCreatePolicy method.
+func (c *MockPolicyClient) CreatePolicyRequest(i *organizations.CreatePolicyInput) organizations.CreatePolicyRequest {
+	return c.MockCreatePolicy(i)
+}
+
+// DescribePolicyRequest calls the underlying MockDescribePolicy method.
+func (c *MockPolicyClient) DescribePolicyRequest(i *organizations.DescribePolicyInput) organizations.DescribePolicyRequest {
+	return c.MockDescribePolicy(i)
+}
+
+// UpdatePolicyRequest calls the underlying MockUpdatePolicy method.
+func (c *MockPolicyClient) UpdatePolicyRequest(i *organizations.UpdatePolicyInput) organizations.UpdatePolicyRequest {
+	return c.MockUpdatePolicy(i)
+}
+
+// DeletePolicyRequest calls the underlying MockDeletePolicy method.
+func (c *MockPolicyClient) DeletePolicyRequest(i *organizations.DeletePolicyInput) organizations.DeletePolicyRequest {
+	return c.MockDeletePolicy(i)
+}
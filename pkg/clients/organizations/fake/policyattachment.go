@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/organizations"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PolicyAttachmentClient = (*MockPolicyAttachmentClient)(nil)
+
+// MockPolicyAttachmentClient is a type that implements all the methods for PolicyAttachmentClient interface
+type MockPolicyAttachmentClient struct {
+	MockAttachPolicy         func(*organizations.AttachPolicyInput) organizations.AttachPolicyRequest
+	MockListTargetsForPolicy func(*organizations.ListTargetsForPolicyInput) organizations.ListTargetsForPolicyRequest
+	MockDetachPolicy         func(*organizations.DetachPolicyInput) organizations.DetachPolicyRequest
+}
+
+// AttachPolicyRequest calls the underlying MockAttachPolicy method.
+func (c *MockPolicyAttachmentClient) AttachPolicyRequest(i *organizations.AttachPolicyInput) organizations.AttachPolicyRequest {
+	return c.MockAttachPolicy(i)
+}
+
+// ListTargetsForPolicyRequest calls the underlying MockListTargetsForPolicy method.
+func (c *MockPolicyAttachmentClient) ListTargetsForPolicyRequest(i *organizations.ListTargetsForPolicyInput) organizations.ListTargetsForPolicyRequest {
+	return c.MockListTargetsForPolicy(i)
+}
+
+// DetachPolicyRequest calls the underlying MockDetachPolicy method.
+func (c *MockPolicyAttachmentClient) DetachPolicyRequest(i *organizations.DetachPolicyInput) organizations.DetachPolicyRequest {
+	return c.MockDetachPolicy(i)
+}
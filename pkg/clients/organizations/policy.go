@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// PolicyClient is the external client used for Policy Custom Resource
+type PolicyClient interface {
+	CreatePolicyRequest(*organizations.CreatePolicyInput) organizations.CreatePolicyRequest
+	DescribePolicyRequest(*organizations.DescribePolicyInput) organizations.DescribePolicyRequest
+	UpdatePolicyRequest(*organizations.UpdatePolicyInput) organizations.UpdatePolicyRequest
+	DeletePolicyRequest(*organizations.DeletePolicyInput) organizations.DeletePolicyRequest
+}
+
+// NewPolicyClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewPolicyClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (PolicyClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return organizations.New(*cfg), err
+}
+
+// IsPolicyNotFound returns true if the error is because the policy doesn't
+// exist.
+func IsPolicyNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == organizations.ErrCodePolicyNotFoundException
+	}
+	return false
+}
+
+// GenerateCreatePolicyInput returns the input to create a policy from the
+// supplied parameters. Organizations requires a description, so an empty one
+// is sent if none is supplied.
+func GenerateCreatePolicyInput(p v1alpha1.PolicyParameters) *organizations.CreatePolicyInput {
+	return &organizations.CreatePolicyInput{
+		Content:     aws.String(p.Content),
+		Description: aws.String(aws.StringValue(p.Description)),
+		Name:        aws.String(p.Name),
+		Type:        organizations.PolicyType(p.Type),
+	}
+}
+
+// GenerateUpdatePolicyInput returns the input to update the policy with the
+// given ID from the supplied parameters.
+func GenerateUpdatePolicyInput(id string, p v1alpha1.PolicyParameters) *organizations.UpdatePolicyInput {
+	return &organizations.UpdatePolicyInput{
+		Content:     aws.String(p.Content),
+		Description: aws.String(aws.StringValue(p.Description)),
+		Name:        aws.String(p.Name),
+		PolicyId:    aws.String(id),
+	}
+}
+
+// LateInitializePolicy fills the empty fields in *v1alpha1.PolicyParameters
+// with the values seen in organizations.Policy.
+func LateInitializePolicy(in *v1alpha1.PolicyParameters, p *organizations.Policy) {
+	if p == nil || p.PolicySummary == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, p.PolicySummary.Description)
+}
+
+// GeneratePolicyObservation is used to produce v1alpha1.PolicyObservation
+// from organizations.Policy.
+func GeneratePolicyObservation(p organizations.Policy) v1alpha1.PolicyObservation {
+	if p.PolicySummary == nil {
+		return v1alpha1.PolicyObservation{}
+	}
+	return v1alpha1.PolicyObservation{
+		ARN:        aws.StringValue(p.PolicySummary.Arn),
+		AWSManaged: aws.BoolValue(p.PolicySummary.AwsManaged),
+	}
+}
+
+// IsPolicyUpToDate returns true if there is no update-able difference between
+// desired and observed state of the resource. Policy content is compared
+// ignoring differences in formatting.
+func IsPolicyUpToDate(p v1alpha1.PolicyParameters, o organizations.Policy) (bool, error) {
+	if o.PolicySummary == nil {
+		return false, nil
+	}
+	if p.Name != aws.StringValue(o.PolicySummary.Name) ||
+		aws.StringValue(p.Description) != aws.StringValue(o.PolicySummary.Description) {
+		return false, nil
+	}
+	desired, err := awsclients.CompactAndEscapeJSON(p.Content)
+	if err != nil {
+		return false, err
+	}
+	observed, err := awsclients.CompactAndEscapeJSON(aws.StringValue(o.Content))
+	if err != nil {
+		return false, err
+	}
+	return desired == observed, nil
+}
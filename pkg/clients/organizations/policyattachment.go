@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// PolicyAttachmentClient is the external client used for PolicyAttachment
+// Custom Resource
+type PolicyAttachmentClient interface {
+	AttachPolicyRequest(*organizations.AttachPolicyInput) organizations.AttachPolicyRequest
+	ListTargetsForPolicyRequest(*organizations.ListTargetsForPolicyInput) organizations.ListTargetsForPolicyRequest
+	DetachPolicyRequest(*organizations.DetachPolicyInput) organizations.DetachPolicyRequest
+}
+
+// NewPolicyAttachmentClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewPolicyAttachmentClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (PolicyAttachmentClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return organizations.New(*cfg), err
+}
+
+// IsAttachmentNotFound returns true if the error is because the policy is not
+// attached to the target, or either of them doesn't exist.
+func IsAttachmentNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case organizations.ErrCodePolicyNotAttachedException,
+			organizations.ErrCodePolicyNotFoundException,
+			organizations.ErrCodeTargetNotFoundException:
+			return true
+		}
+	}
+	return false
+}
+
+// FindPolicyTarget returns the target with the given ID that the supplied
+// policy is attached to, or nil if the policy is not attached to it.
+func FindPolicyTarget(ctx context.Context, c PolicyAttachmentClient, policyID, targetID string) (*organizations.PolicyTargetSummary, error) {
+	input := &organizations.ListTargetsForPolicyInput{PolicyId: aws.String(policyID)}
+	for {
+		rsp, err := c.ListTargetsForPolicyRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rsp.Targets {
+			if aws.StringValue(rsp.Targets[i].TargetId) == targetID {
+				return &rsp.Targets[i], nil
+			}
+		}
+		if aws.StringValue(rsp.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+// GeneratePolicyAttachmentObservation is used to produce
+// v1alpha1.PolicyAttachmentObservation from
+// organizations.PolicyTargetSummary.
+func GeneratePolicyAttachmentObservation(t organizations.PolicyTargetSummary) v1alpha1.PolicyAttachmentObservation {
+	return v1alpha1.PolicyAttachmentObservation{
+		TargetARN:  aws.StringValue(t.Arn),
+		TargetName: aws.StringValue(t.Name),
+		TargetType: string(t.Type),
+	}
+}
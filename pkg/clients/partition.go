@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/aws/endpoints"
+	"github.com/pkg/errors"
+)
+
+// AWS partitions that regions may belong to.
+const (
+	PartitionAWS      = "aws"
+	PartitionChina    = "aws-cn"
+	PartitionGovCloud = "aws-us-gov"
+)
+
+const errInvalidRegion = "region %q does not belong to any known partition"
+
+// regionFormat matches the names of AWS regions, such as eu-west-1 or
+// us-gov-west-1. The endpoints resolver places any other name in the standard
+// partition, so names that could not be regions are rejected up front.
+var regionFormat = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`)
+
+// PartitionForRegion returns the partition the supplied region belongs to, or
+// an error if the region is not valid in any partition. Partitions are looked
+// up by the endpoints resolver of the SDK, which places regions it does not
+// know yet by their prefix.
+func PartitionForRegion(region string) (string, error) {
+	if !regionFormat.MatchString(region) {
+		return "", errors.Errorf(errInvalidRegion, region)
+	}
+	e, err := endpoints.NewDefaultResolver().ResolveEndpoint("ec2", region)
+	if err != nil || e.PartitionID == "" {
+		return "", errors.Errorf(errInvalidRegion, region)
+	}
+	return e.PartitionID, nil
+}
+
+// ValidateRegion returns an error if the supplied region is not valid in any
+// partition.
+func ValidateRegion(region string) error {
+	_, err := PartitionForRegion(region)
+	return err
+}
+
+// ARN returns the ARN of a resource in the supplied partition. Region and
+// account ID may be empty for global resources.
+func ARN(partition, service, region, accountID, resource string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resource,
+	}.String()
+}
+
+// S3BucketARN returns the ARN of the S3 bucket with the supplied name in the
+// supplied partition.
+func S3BucketARN(partition, bucket string) string {
+	return ARN(partition, "s3", "", "", bucket)
+}
+
+// ARNResource returns the resource part of the supplied ARN, or the ARN
+// itself if it cannot be parsed.
+func ARNResource(s string) string {
+	a, err := arn.Parse(s)
+	if err != nil {
+		return s
+	}
+	return a.Resource
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestPartitionForRegion(t *testing.T) {
+	type want struct {
+		partition string
+		err       error
+	}
+
+	cases := map[string]struct {
+		region string
+		want   want
+	}{
+		"Standard": {
+			region: "us-east-1",
+			want:   want{partition: PartitionAWS},
+		},
+		"China": {
+			region: "cn-north-1",
+			want:   want{partition: PartitionChina},
+		},
+		"GovCloud": {
+			region: "us-gov-west-1",
+			want:   want{partition: PartitionGovCloud},
+		},
+		"NewRegion": {
+			region: "il-central-1",
+			want:   want{partition: PartitionAWS},
+		},
+		"Invalid": {
+			region: "moon-central-1",
+			want:   want{err: errors.Errorf(errInvalidRegion, "moon-central-1")},
+		},
+		"Empty": {
+			region: "",
+			want:   want{err: errors.Errorf(errInvalidRegion, "")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := PartitionForRegion(tc.region)
+			if diff := cmp.Diff(tc.want.partition, got); diff != "" {
+				t.Errorf("PartitionForRegion(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("PartitionForRegion(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestARN(t *testing.T) {
+	cases := map[string]struct {
+		got  string
+		want string
+	}{
+		"Regional": {
+			got:  ARN(PartitionGovCloud, "sns", "us-gov-west-1", "123456789012", "topic"),
+			want: "arn:aws-us-gov:sns:us-gov-west-1:123456789012:topic",
+		},
+		"S3Bucket": {
+			got:  S3BucketARN(PartitionChina, "bucket"),
+			want: "arn:aws-cn:s3:::bucket",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.got); diff != "" {
+				t.Errorf("ARN(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestARNResource(t *testing.T) {
+	cases := map[string]struct {
+		arn  string
+		want string
+	}{
+		"Bucket": {
+			arn:  "arn:aws-us-gov:s3:::bucket",
+			want: "bucket",
+		},
+		"NotAnARN": {
+			arn:  "bucket",
+			want: "bucket",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ARNResource(tc.arn)); diff != "" {
+				t.Errorf("ARNResource(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pinpoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+
+	"github.com/crossplane/provider-aws/apis/pinpoint/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AppClient is the external client used for App Custom Resource
+type AppClient interface {
+	CreateAppRequest(*pinpoint.CreateAppInput) pinpoint.CreateAppRequest
+	GetAppRequest(*pinpoint.GetAppInput) pinpoint.GetAppRequest
+	DeleteAppRequest(*pinpoint.DeleteAppInput) pinpoint.DeleteAppRequest
+	GetApplicationSettingsRequest(*pinpoint.GetApplicationSettingsInput) pinpoint.GetApplicationSettingsRequest
+	UpdateApplicationSettingsRequest(*pinpoint.UpdateApplicationSettingsInput) pinpoint.UpdateApplicationSettingsRequest
+	GetGcmChannelRequest(*pinpoint.GetGcmChannelInput) pinpoint.GetGcmChannelRequest
+	UpdateGcmChannelRequest(*pinpoint.UpdateGcmChannelInput) pinpoint.UpdateGcmChannelRequest
+	DeleteGcmChannelRequest(*pinpoint.DeleteGcmChannelInput) pinpoint.DeleteGcmChannelRequest
+	GetApnsChannelRequest(*pinpoint.GetApnsChannelInput) pinpoint.GetApnsChannelRequest
+	UpdateApnsChannelRequest(*pinpoint.UpdateApnsChannelInput) pinpoint.UpdateApnsChannelRequest
+	DeleteApnsChannelRequest(*pinpoint.DeleteApnsChannelInput) pinpoint.DeleteApnsChannelRequest
+}
+
+// NewAppClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewAppClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (AppClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return pinpoint.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == pinpoint.ErrCodeNotFoundException {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateAppInput returns the input to create an app with the
+// supplied parameters.
+func GenerateCreateAppInput(p v1alpha1.AppParameters) *pinpoint.CreateAppInput {
+	in := &pinpoint.CreateAppInput{
+		CreateApplicationRequest: &pinpoint.CreateApplicationRequest{
+			Name: aws.String(p.Name),
+		},
+	}
+	if len(p.Tags) != 0 {
+		in.CreateApplicationRequest.Tags = p.Tags
+	}
+	return in
+}
+
+// GenerateWriteApplicationSettingsRequest returns the settings of the app
+// with the supplied parameters.
+func GenerateWriteApplicationSettingsRequest(p v1alpha1.AppParameters) *pinpoint.WriteApplicationSettingsRequest {
+	r := &pinpoint.WriteApplicationSettingsRequest{}
+	if p.QuietTime != nil {
+		r.QuietTime = &pinpoint.QuietTime{
+			Start: aws.String(p.QuietTime.Start),
+			End:   aws.String(p.QuietTime.End),
+		}
+	}
+	if p.Limits != nil {
+		r.Limits = &pinpoint.CampaignLimits{
+			Daily:             p.Limits.Daily,
+			MaximumDuration:   p.Limits.MaximumDuration,
+			MessagesPerSecond: p.Limits.MessagesPerSecond,
+			Total:             p.Limits.Total,
+		}
+	}
+	return r
+}
+
+// GenerateGCMChannelRequest returns the GCM channel with the supplied
+// parameters and API key.
+func GenerateGCMChannelRequest(c v1alpha1.GCMChannel, apiKey string) *pinpoint.GCMChannelRequest {
+	return &pinpoint.GCMChannelRequest{
+		ApiKey:  aws.String(apiKey),
+		Enabled: c.Enabled,
+	}
+}
+
+// GenerateAPNSChannelRequest returns the APNs channel with the supplied
+// parameters and credentials.
+func GenerateAPNSChannelRequest(c v1alpha1.APNSChannel, certificate, privateKey, tokenKey *string) *pinpoint.APNSChannelRequest {
+	return &pinpoint.APNSChannelRequest{
+		Enabled:                     c.Enabled,
+		DefaultAuthenticationMethod: c.DefaultAuthenticationMethod,
+		Certificate:                 certificate,
+		PrivateKey:                  privateKey,
+		TokenKey:                    tokenKey,
+		TokenKeyId:                  c.TokenKeyID,
+		BundleId:                    c.BundleID,
+		TeamId:                      c.TeamID,
+	}
+}
+
+// LateInitializeApp fills the empty fields in the supplied parameters with
+// the values observed on the app. Channels are only late initialized if they
+// are configured in the parameters.
+func LateInitializeApp(p *v1alpha1.AppParameters, s pinpoint.ApplicationSettingsResource, gcm *pinpoint.GCMChannelResponse, apns *pinpoint.APNSChannelResponse) {
+	if p.QuietTime == nil && s.QuietTime != nil && aws.StringValue(s.QuietTime.Start) != "" {
+		p.QuietTime = &v1alpha1.QuietTime{
+			Start: aws.StringValue(s.QuietTime.Start),
+			End:   aws.StringValue(s.QuietTime.End),
+		}
+	}
+	if p.Limits != nil && s.Limits != nil {
+		p.Limits.Daily = awsclients.LateInitializeInt64Ptr(p.Limits.Daily, s.Limits.Daily)
+		p.Limits.MaximumDuration = awsclients.LateInitializeInt64Ptr(p.Limits.MaximumDuration, s.Limits.MaximumDuration)
+		p.Limits.MessagesPerSecond = awsclients.LateInitializeInt64Ptr(p.Limits.MessagesPerSecond, s.Limits.MessagesPerSecond)
+		p.Limits.Total = awsclients.LateInitializeInt64Ptr(p.Limits.Total, s.Limits.Total)
+	}
+	if p.GCMChannel != nil && gcm != nil {
+		p.GCMChannel.Enabled = awsclients.LateInitializeBoolPtr(p.GCMChannel.Enabled, gcm.Enabled)
+	}
+	if p.APNSChannel != nil && apns != nil {
+		p.APNSChannel.Enabled = awsclients.LateInitializeBoolPtr(p.APNSChannel.Enabled, apns.Enabled)
+		p.APNSChannel.DefaultAuthenticationMethod = awsclients.LateInitializeStringPtr(p.APNSChannel.DefaultAuthenticationMethod, apns.DefaultAuthenticationMethod)
+	}
+}
+
+// GenerateAppObservation returns the observation of the supplied app and
+// channels.
+func GenerateAppObservation(app pinpoint.ApplicationResponse, gcm *pinpoint.GCMChannelResponse, apns *pinpoint.APNSChannelResponse) v1alpha1.AppObservation {
+	o := v1alpha1.AppObservation{ARN: aws.StringValue(app.Arn)}
+	if gcm != nil {
+		o.GCMChannel = &v1alpha1.ChannelObservation{
+			Enabled:          aws.BoolValue(gcm.Enabled),
+			HasCredential:    aws.BoolValue(gcm.HasCredential),
+			LastModifiedDate: aws.StringValue(gcm.LastModifiedDate),
+		}
+	}
+	if apns != nil {
+		o.APNSChannel = &v1alpha1.ChannelObservation{
+			Enabled:          aws.BoolValue(apns.Enabled),
+			HasCredential:    aws.BoolValue(apns.HasCredential) || aws.BoolValue(apns.HasTokenKey),
+			LastModifiedDate: aws.StringValue(apns.LastModifiedDate),
+		}
+	}
+	return o
+}
+
+// IsSettingsUpToDate returns true if the supplied settings match the desired
+// parameters.
+func IsSettingsUpToDate(p v1alpha1.AppParameters, s pinpoint.ApplicationSettingsResource) bool {
+	if p.QuietTime != nil {
+		if s.QuietTime == nil ||
+			p.QuietTime.Start != aws.StringValue(s.QuietTime.Start) ||
+			p.QuietTime.End != aws.StringValue(s.QuietTime.End) {
+			return false
+		}
+	}
+	if p.Limits != nil {
+		l := s.Limits
+		if l == nil {
+			l = &pinpoint.CampaignLimits{}
+		}
+		if aws.Int64Value(p.Limits.Daily) != aws.Int64Value(l.Daily) ||
+			aws.Int64Value(p.Limits.MaximumDuration) != aws.Int64Value(l.MaximumDuration) ||
+			aws.Int64Value(p.Limits.MessagesPerSecond) != aws.Int64Value(l.MessagesPerSecond) ||
+			aws.Int64Value(p.Limits.Total) != aws.Int64Value(l.Total) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsGCMChannelUpToDate returns true if the supplied GCM channel matches the
+// desired one. The API key cannot be observed and is not compared.
+func IsGCMChannelUpToDate(c *v1alpha1.GCMChannel, gcm *pinpoint.GCMChannelResponse) bool {
+	if c == nil || gcm == nil {
+		return c == nil && gcm == nil
+	}
+	return aws.BoolValue(c.Enabled) == aws.BoolValue(gcm.Enabled)
+}
+
+// IsAPNSChannelUpToDate returns true if the supplied APNs channel matches
+// the desired one. The credentials cannot be observed and are not compared.
+func IsAPNSChannelUpToDate(c *v1alpha1.APNSChannel, apns *pinpoint.APNSChannelResponse) bool {
+	if c == nil || apns == nil {
+		return c == nil && apns == nil
+	}
+	return aws.BoolValue(c.Enabled) == aws.BoolValue(apns.Enabled) &&
+		aws.StringValue(c.DefaultAuthenticationMethod) == aws.StringValue(apns.DefaultAuthenticationMethod)
+}
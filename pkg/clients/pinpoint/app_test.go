@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pinpoint
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/pinpoint/v1alpha1"
+)
+
+func TestIsSettingsUpToDate(t *testing.T) {
+	params := v1alpha1.AppParameters{
+		Name:      "app",
+		QuietTime: &v1alpha1.QuietTime{Start: "22:00", End: "07:00"},
+		Limits:    &v1alpha1.CampaignLimits{Daily: aws.Int64(10)},
+	}
+	observed := pinpoint.ApplicationSettingsResource{
+		QuietTime: &pinpoint.QuietTime{Start: aws.String("22:00"), End: aws.String("07:00")},
+		Limits:    &pinpoint.CampaignLimits{Daily: aws.Int64(10)},
+	}
+
+	cases := map[string]struct {
+		params func(*v1alpha1.AppParameters)
+		want   bool
+	}{
+		"UpToDate": {
+			params: func(*v1alpha1.AppParameters) {},
+			want:   true,
+		},
+		"Unmanaged": {
+			params: func(p *v1alpha1.AppParameters) { p.QuietTime, p.Limits = nil, nil },
+			want:   true,
+		},
+		"QuietTimeChanged": {
+			params: func(p *v1alpha1.AppParameters) { p.QuietTime.End = "08:00" },
+			want:   false,
+		},
+		"LimitAdded": {
+			params: func(p *v1alpha1.AppParameters) { p.Limits.Total = aws.Int64(100) },
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := *params.DeepCopy()
+			tc.params(&p)
+			if diff := cmp.Diff(tc.want, IsSettingsUpToDate(p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsGCMChannelUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		channel  *v1alpha1.GCMChannel
+		observed *pinpoint.GCMChannelResponse
+		want     bool
+	}{
+		"BothAbsent": {
+			want: true,
+		},
+		"Missing": {
+			channel: &v1alpha1.GCMChannel{Enabled: aws.Bool(true)},
+			want:    false,
+		},
+		"Unwanted": {
+			observed: &pinpoint.GCMChannelResponse{Enabled: aws.Bool(true)},
+			want:     false,
+		},
+		"Disabled": {
+			channel:  &v1alpha1.GCMChannel{Enabled: aws.Bool(true)},
+			observed: &pinpoint.GCMChannelResponse{Enabled: aws.Bool(false)},
+			want:     false,
+		},
+		"UpToDate": {
+			channel:  &v1alpha1.GCMChannel{Enabled: aws.Bool(true)},
+			observed: &pinpoint.GCMChannelResponse{Enabled: aws.Bool(true)},
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsGCMChannelUpToDate(tc.channel, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/pinpoint"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AppClient = (*MockAppClient)(nil)
+
+// MockAppClient is a type that implements all the methods for AppClient interface
+type MockAppClient struct {
+	MockCreateApp                 func(*pinpoint.CreateAppInput) pinpoint.CreateAppRequest
+	MockGetApp                    func(*pinpoint.GetAppInput) pinpoint.GetAppRequest
+	MockDeleteApp                 func(*pinpoint.DeleteAppInput) pinpoint.DeleteAppRequest
+	MockGetApplicationSettings    func(*pinpoint.GetApplicationSettingsInput) pinpoint.GetApplicationSettingsRequest
+	MockUpdateApplicationSettings func(*pinpoint.UpdateApplicationSettingsInput) pinpoint.UpdateApplicationSettingsRequest
+	MockGetGcmChannel             func(*pinpoint.GetGcmChannelInput) pinpoint.GetGcmChannelRequest
+	MockUpdateGcmChannel          func(*pinpoint.UpdateGcmChannelInput) pinpoint.UpdateGcmChannelRequest
+	MockDeleteGcmChannel          func(*pinpoint.DeleteGcmChannelInput) pinpoint.DeleteGcmChannelRequest
+	MockGetApnsChannel            func(*pinpoint.GetApnsChannelInput) pinpoint.GetApnsChannelRequest
+	MockUpdateApnsChannel         func(*pinpoint.UpdateApnsChannelInput) pinpoint.UpdateApnsChannelRequest
+	MockDeleteApnsChannel         func(*pinpoint.DeleteApnsChannelInput) pinpoint.DeleteApnsChannelRequest
+}
+
+// CreateAppRequest calls the underlying MockCreateApp method.
+func (c *MockAppClient) CreateAppRequest(i *pinpoint.CreateAppInput) pinpoint.CreateAppRequest {
+	return c.MockCreateApp(i)
+}
+
+// GetAppRequest calls the underlying MockGetApp method.
+func (c *MockAppClient) GetAppRequest(i *pinpoint.GetAppInput) pinpoint.GetAppRequest {
+	return c.MockGetApp(i)
+}
+
+// DeleteAppRequest calls the underlying MockDeleteApp method.
+func (c *MockAppClient) DeleteAppRequest(i *pinpoint.DeleteAppInput) pinpoint.DeleteAppRequest {
+	return c.MockDeleteApp(i)
+}
+
+// GetApplicationSettingsRequest calls the underlying MockGetApplicationSettings method.
+func (c *MockAppClient) GetApplicationSettingsRequest(i *pinpoint.GetApplicationSettingsInput) pinpoint.GetApplicationSettingsRequest {
+	return c.MockGetApplicationSettings(i)
+}
+
+// UpdateApplicationSettingsRequest calls the underlying MockUpdateApplicationSettings method.
+func (c *MockAppClient) UpdateApplicationSettingsRequest(i *pinpoint.UpdateApplicationSettingsInput) pinpoint.UpdateApplicationSettingsRequest {
+	return c.MockUpdateApplicationSettings(i)
+}
+
+// GetGcmChannelRequest calls the underlying MockGetGcmChannel method.
+func (c *MockAppClient) GetGcmChannelRequest(i *pinpoint.GetGcmChannelInput) pinpoint.GetGcmChannelRequest {
+	return c.MockGetGcmChannel(i)
+}
+
+// UpdateGcmChannelRequest calls the underlying MockUpdateGcmChannel method.
+func (c *MockAppClient) UpdateGcmChannelRequest(i *pinpoint.UpdateGcmChannelInput) pinpoint.UpdateGcmChannelRequest {
+	return c.MockUpdateGcmChannel(i)
+}
+
+// DeleteGcmChannelRequest calls the underlying MockDeleteGcmChannel method.
+func (c *MockAppClient) DeleteGcmChannelRequest(i *pinpoint.DeleteGcmChannelInput) pinpoint.DeleteGcmChannelRequest {
+	return c.MockDeleteGcmChannel(i)
+}
+
+// GetApnsChannelRequest calls the underlying MockGetApnsChannel method.
+func (c *MockAppClient) GetApnsChannelRequest(i *pinpoint.GetApnsChannelInput) pinpoint.GetApnsChannelRequest {
+	return c.MockGetApnsChannel(i)
+}
+
+// UpdateApnsChannelRequest calls the underlying MockUpdateApnsChannel method.
+func (c *MockAppClient) UpdateApnsChannelRequest(i *pinpoint.UpdateApnsChannelInput) pinpoint.UpdateApnsChannelRequest {
+	return c.MockUpdateApnsChannel(i)
+}
+
+// DeleteApnsChannelRequest calls the underlying MockDeleteApnsChannel method.
+func (c *MockAppClient) DeleteApnsChannelRequest(i *pinpoint.DeleteApnsChannelInput) pinpoint.DeleteApnsChannelRequest {
+	return c.MockDeleteApnsChannel(i)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package propagation tolerates AWS APIs that are eventually consistent, which
+// may report a resource they have just created as not found.
+package propagation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+)
+
+// TypePropagating resources were created recently and may not be reported
+// by the AWS API yet.
+const TypePropagating runtimev1alpha1.ConditionType = "Propagating"
+
+// Reasons a resource is or is not propagating.
+const (
+	ReasonWaitingForPropagation runtimev1alpha1.ConditionReason = "WaitingForPropagation"
+	ReasonPropagated            runtimev1alpha1.ConditionReason = "Propagated"
+	ReasonNotPropagated         runtimev1alpha1.ConditionReason = "NotPropagated"
+)
+
+const groupSuffix = ".aws.crossplane.io"
+
+// DefaultWindow is how long after a resource was created it may be reported
+// as not found without being created again, unless its API group has a
+// window of its own.
+var DefaultWindow time.Duration
+
+// Windows overrides DefaultWindow by API group, such as identity or ec2.
+var Windows = map[string]time.Duration{
+	// IAM replicates changes across regions, which may take several
+	// seconds or, rarely, minutes.
+	"identity": 2 * time.Minute,
+}
+
+// WindowFor returns the propagation window of the supplied API group.
+func WindowFor(group string) time.Duration {
+	if w, ok := Windows[strings.TrimSuffix(group, groupSuffix)]; ok {
+		return w
+	}
+	return DefaultWindow
+}
+
+// WaitingForPropagation returns a condition that indicates a resource was
+// created at the supplied time and may not be reported by the AWS API until
+// the supplied window has passed.
+func WaitingForPropagation(at time.Time, window time.Duration) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypePropagating,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(at),
+		Reason:             ReasonWaitingForPropagation,
+		Message:            fmt.Sprintf("Waiting up to %s for the created resource to be reported", window),
+	}
+}
+
+// Propagated returns a condition that indicates a resource that was created
+// has been reported by the AWS API.
+func Propagated() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypePropagating,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPropagated,
+	}
+}
+
+// NotPropagated returns a condition that indicates a resource that was
+// created was not reported by the AWS API within the supplied window.
+func NotPropagated(window time.Duration) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypePropagating,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotPropagated,
+		Message:            fmt.Sprintf("The created resource was not reported within %s", window),
+	}
+}
+
+// NewConnecter returns an ExternalConnecter whose ExternalClients tolerate
+// the AWS API not reporting a resource they have just created. A resource
+// that is not found during the propagation window of its API group after it
+// was created is reported to exist and be up to date rather than being
+// created again. The Propagating condition of the resource tells whether it
+// is still within its window.
+func NewConnecter(mgr manager.Manager, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c, scheme: mgr.GetScheme(), now: time.Now}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	scheme *runtime.Scheme
+	now    func() time.Time
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: e, scheme: c.scheme, now: c.now}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	scheme *runtime.Scheme
+	now    func() time.Time
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	c := mg.GetCondition(TypePropagating)
+	if c.Status != corev1.ConditionTrue {
+		return o, err
+	}
+
+	missing := errorutils.IsNotFound(err) || (err == nil && !o.ResourceExists)
+	switch {
+	case !missing && err != nil:
+		return o, err
+	case !missing:
+		mg.SetConditions(Propagated())
+		return o, nil
+	case meta.WasDeleted(mg):
+		// A resource that was deleted is expected to be gone.
+		mg.SetConditions(Propagated())
+		return o, err
+	}
+
+	w := e.window(mg)
+	if e.now().Sub(c.LastTransitionTime.Time) < w {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	mg.SetConditions(NotPropagated(w))
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	if err != nil {
+		return c, err
+	}
+	if w := e.window(mg); w > 0 {
+		mg.SetConditions(WaitingForPropagation(e.now(), w))
+	}
+	return c, nil
+}
+
+// window returns the propagation window of the API group of the supplied
+// resource.
+func (e *external) window(mg resource.Managed) time.Duration {
+	gvk, err := apiutil.GVKForObject(mg, e.scheme)
+	if err != nil {
+		return DefaultWindow
+	}
+	return WindowFor(gvk.Group)
+}
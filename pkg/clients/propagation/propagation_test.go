@@ -0,0 +1,253 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var (
+	identity = schema.GroupVersionKind{Group: "identity.aws.crossplane.io", Version: "v1beta1", Kind: "IAMRole"}
+	ec2      = schema.GroupVersionKind{Group: "ec2.aws.crossplane.io", Version: "v1beta1", Kind: "VPC"}
+)
+
+func scheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	s.AddKnownTypeWithName(identity, &fake.Managed{})
+	return s
+}
+
+func TestWindowFor(t *testing.T) {
+	cases := map[string]struct {
+		group string
+		want  time.Duration
+	}{
+		"Override": {group: "identity.aws.crossplane.io", want: Windows["identity"]},
+		"Default":  {group: "ec2.aws.crossplane.io", want: DefaultWindow},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, WindowFor(tc.group)); diff != "" {
+				t.Errorf("WindowFor(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	created := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	window := Windows["identity"]
+	deleted := metav1.NewTime(created)
+
+	connect := func(o managed.ExternalObservation, err error) managed.ExternalConnecter {
+		return managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+					return o, err
+				},
+			}, nil
+		})
+	}
+
+	type want struct {
+		o         managed.ExternalObservation
+		err       error
+		condition runtimev1alpha1.Condition
+	}
+
+	cases := map[string]struct {
+		c         managed.ExternalConnecter
+		now       time.Time
+		deletedAt *metav1.Time
+		existing  []runtimev1alpha1.Condition
+		want      want
+	}{
+		"NotCreated": {
+			c:   connect(managed.ExternalObservation{}, nil),
+			now: created,
+			want: want{
+				condition: runtimev1alpha1.Condition{Type: TypePropagating, Status: corev1.ConditionUnknown},
+			},
+		},
+		"WithinWindow": {
+			c:        connect(managed.ExternalObservation{}, nil),
+			now:      created.Add(window / 2),
+			existing: []runtimev1alpha1.Condition{WaitingForPropagation(created, window)},
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: WaitingForPropagation(created, window),
+			},
+		},
+		"NotFoundWithinWindow": {
+			c:        connect(managed.ExternalObservation{}, awserr.New("NoSuchEntity", "", nil)),
+			now:      created.Add(window / 2),
+			existing: []runtimev1alpha1.Condition{WaitingForPropagation(created, window)},
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: WaitingForPropagation(created, window),
+			},
+		},
+		"WindowExpired": {
+			c:        connect(managed.ExternalObservation{}, nil),
+			now:      created.Add(window),
+			existing: []runtimev1alpha1.Condition{WaitingForPropagation(created, window)},
+			want: want{
+				condition: NotPropagated(window),
+			},
+		},
+		"Deleted": {
+			c:         connect(managed.ExternalObservation{}, nil),
+			now:       created.Add(window / 2),
+			deletedAt: &deleted,
+			existing:  []runtimev1alpha1.Condition{WaitingForPropagation(created, window)},
+			want: want{
+				condition: Propagated(),
+			},
+		},
+		"Propagated": {
+			c:        connect(managed.ExternalObservation{ResourceExists: true}, nil),
+			now:      created.Add(window / 2),
+			existing: []runtimev1alpha1.Condition{WaitingForPropagation(created, window)},
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true},
+				condition: Propagated(),
+			},
+		},
+		"FailedObserve": {
+			c:        connect(managed.ExternalObservation{}, errBoom),
+			now:      created.Add(window / 2),
+			existing: []runtimev1alpha1.Condition{WaitingForPropagation(created, window)},
+			want: want{
+				err:       errBoom,
+				condition: WaitingForPropagation(created, window),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			mg := &fake.Managed{}
+			mg.SetName(name)
+			mg.SetDeletionTimestamp(tc.deletedAt)
+			mg.SetConditions(tc.existing...)
+
+			now := tc.now
+			e, err := (&connecter{ExternalConnecter: tc.c, scheme: scheme(t), now: func() time.Time { return now }}).Connect(ctx, mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			o, err := e.Observe(ctx, mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, mg.GetCondition(TypePropagating), cmpopts.IgnoreFields(runtimev1alpha1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+	created := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	connect := func(err error) managed.ExternalConnecter {
+		return managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return managed.ExternalClientFns{
+				CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+					return managed.ExternalCreation{}, err
+				},
+			}, nil
+		})
+	}
+
+	type want struct {
+		err       error
+		condition runtimev1alpha1.Condition
+	}
+
+	cases := map[string]struct {
+		c    managed.ExternalConnecter
+		gvk  schema.GroupVersionKind
+		want want
+	}{
+		"Created": {
+			c:    connect(nil),
+			gvk:  identity,
+			want: want{condition: WaitingForPropagation(created, Windows["identity"])},
+		},
+		"NoWindow": {
+			c:    connect(nil),
+			gvk:  ec2,
+			want: want{condition: runtimev1alpha1.Condition{Type: TypePropagating, Status: corev1.ConditionUnknown}},
+		},
+		"FailedCreate": {
+			c:   connect(errBoom),
+			gvk: identity,
+			want: want{
+				err:       errBoom,
+				condition: runtimev1alpha1.Condition{Type: TypePropagating, Status: corev1.ConditionUnknown},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := runtime.NewScheme()
+			s.AddKnownTypeWithName(tc.gvk, &fake.Managed{})
+			mg := &fake.Managed{}
+			mg.SetName(name)
+
+			e, err := (&connecter{ExternalConnecter: tc.c, scheme: s, now: func() time.Time { return created }}).Connect(ctx, mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			_, err = e.Create(ctx, mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, mg.GetCondition(TypePropagating)); diff != "" {
+				t.Errorf("Create(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package proptest checks properties of the functions that convert between
+// the parameters of managed resources and the types of the AWS API, such as
+// that a resource is up to date once AWS reflects its parameters, for many
+// randomly generated parameters. Conversions that are not symmetric cause
+// controllers to update resources on every reconcile, which table driven
+// tests with a handful of hand written parameters rarely catch.
+package proptest
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+)
+
+// EnvSeed is the environment variable that overrides the seed of the random
+// values, for example to reproduce a failure reported with another seed.
+const EnvSeed = "PROPTEST_SEED"
+
+// Defaults of Check.
+const (
+	DefaultIterations  = 500
+	DefaultSeed        = 1
+	DefaultNilChance   = 0.2
+	DefaultMaxElements = 3
+)
+
+// A Property of the value filled by Check. It returns a description of how
+// the value violates the property, or an empty string if the property holds.
+type Property func() string
+
+type config struct {
+	iterations int
+	seed       int64
+	funcs      []interface{}
+}
+
+// An Option configures Check.
+type Option func(*config)
+
+// WithIterations makes Check check the property for n values.
+func WithIterations(n int) Option {
+	return func(c *config) {
+		c.iterations = n
+	}
+}
+
+// WithFuncs makes Check generate values of some types using the supplied
+// custom fuzz functions, as accepted by gofuzz. Tests use them to restrict
+// fields to the values AWS accepts, such as valid JSON documents.
+func WithFuncs(fns ...interface{}) Option {
+	return func(c *config) {
+		c.funcs = append(c.funcs, fns...)
+	}
+}
+
+// Check fills the value target points to with random values and checks that
+// the supplied property holds for each of them. It reports the first value
+// that violates the property, along with the seed that reproduces it.
+func Check(t *testing.T, target interface{}, p Property, o ...Option) {
+	t.Helper()
+
+	c := &config{iterations: DefaultIterations, seed: DefaultSeed}
+	if s, err := strconv.ParseInt(os.Getenv(EnvSeed), 10, 64); err == nil {
+		c.seed = s
+	}
+	for _, fn := range o {
+		fn(c)
+	}
+
+	f := fuzz.NewWithSeed(c.seed).NilChance(DefaultNilChance).NumElements(0, DefaultMaxElements).Funcs(c.funcs...)
+	for i := 0; i < c.iterations; i++ {
+		f.Fuzz(target)
+		if reason := p(); reason != "" {
+			t.Errorf("\n%s\nvalue %d of seed %d:\n%s", reason, i, c.seed, dump(target))
+			return
+		}
+	}
+}
+
+func dump(v interface{}) string {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
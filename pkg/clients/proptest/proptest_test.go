@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proptest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	fuzz "github.com/google/gofuzz"
+)
+
+type value struct {
+	Name  string
+	Count *int
+	Tags  []string
+}
+
+func values(o ...Option) []value {
+	var got []value
+	v := value{}
+	Check(&testing.T{}, &v, func() string {
+		got = append(got, v)
+		return ""
+	}, o...)
+	return got
+}
+
+func TestCheck(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      []Option
+		check  func(got []value) string
+	}{
+		"Iterations": {
+			reason: "The property should be checked for the configured number of values.",
+			o:      []Option{WithIterations(10)},
+			check: func(got []value) string {
+				return cmp.Diff(10, len(got))
+			},
+		},
+		"Deterministic": {
+			reason: "The same seed should generate the same values.",
+			check: func(got []value) string {
+				return cmp.Diff(values(), got)
+			},
+		},
+		"Funcs": {
+			reason: "Custom fuzz functions should generate the values of their type.",
+			o: []Option{WithFuncs(func(s *string, _ fuzz.Continue) {
+				*s = "custom"
+			})},
+			check: func(got []value) string {
+				for _, v := range got {
+					if v.Name != "custom" {
+						return cmp.Diff("custom", v.Name)
+					}
+				}
+				return ""
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := tc.check(values(tc.o...)); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
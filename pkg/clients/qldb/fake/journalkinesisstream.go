@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/qldb"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.JournalKinesisStreamClient = (*MockJournalKinesisStreamClient)(nil)
+
+// MockJournalKinesisStreamClient is a type that implements all the methods for JournalKinesisStreamClient interface
+type MockJournalKinesisStreamClient struct {
+	MockStreamJournalToKinesis       func(*qldb.StreamJournalToKinesisInput) qldb.StreamJournalToKinesisRequest
+	MockDescribeJournalKinesisStream func(*qldb.DescribeJournalKinesisStreamInput) qldb.DescribeJournalKinesisStreamRequest
+	MockCancelJournalKinesisStream   func(*qldb.CancelJournalKinesisStreamInput) qldb.CancelJournalKinesisStreamRequest
+}
+
+// StreamJournalToKinesisRequest calls the underlying MockStreamJournalToKinesis method.
+func (c *MockJournalKinesisStreamClient) StreamJournalToKinesisRequest(i *qldb.StreamJournalToKinesisInput) qldb.StreamJournalToKinesisRequest {
+	return c.MockStreamJournalToKinesis(i)
+}
+
+// DescribeJournalKinesisStreamRequest calls the underlying MockDescribeJournalKinesisStream method.
+func (c *MockJournalKinesisStreamClient) DescribeJournalKinesisStreamRequest(i *qldb.DescribeJournalKinesisStreamInput) qldb.DescribeJournalKinesisStreamRequest {
+	return c.MockDescribeJournalKinesisStream(i)
+}
+
+// CancelJournalKinesisStreamRequest calls the underlying MockCancelJournalKinesisStream method.
+func (c *MockJournalKinesisStreamClient) CancelJournalKinesisStreamRequest(i *qldb.CancelJournalKinesisStreamInput) qldb.CancelJournalKinesisStreamRequest {
+	return c.MockCancelJournalKinesisStream(i)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qldb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+
+	"github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// JournalKinesisStreamClient is the external client used for
+// JournalKinesisStream Custom Resource
+type JournalKinesisStreamClient interface {
+	StreamJournalToKinesisRequest(*qldb.StreamJournalToKinesisInput) qldb.StreamJournalToKinesisRequest
+	DescribeJournalKinesisStreamRequest(*qldb.DescribeJournalKinesisStreamInput) qldb.DescribeJournalKinesisStreamRequest
+	CancelJournalKinesisStreamRequest(*qldb.CancelJournalKinesisStreamInput) qldb.CancelJournalKinesisStreamRequest
+}
+
+// NewJournalKinesisStreamClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewJournalKinesisStreamClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (JournalKinesisStreamClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return qldb.New(*cfg), err
+}
+
+// GenerateStreamJournalToKinesisInput returns the input to start streaming
+// the journal of a ledger from the supplied parameters.
+func GenerateStreamJournalToKinesisInput(p v1alpha1.JournalKinesisStreamParameters) *qldb.StreamJournalToKinesisInput {
+	in := &qldb.StreamJournalToKinesisInput{
+		LedgerName: p.LedgerName,
+		StreamName: aws.String(p.StreamName),
+		RoleArn:    p.RoleARN,
+		KinesisConfiguration: &qldb.KinesisConfiguration{
+			StreamArn:          aws.String(p.KinesisConfiguration.StreamARN),
+			AggregationEnabled: p.KinesisConfiguration.AggregationEnabled,
+		},
+		InclusiveStartTime: &p.InclusiveStartTime.Time,
+		Tags:               p.Tags,
+	}
+	if p.ExclusiveEndTime != nil {
+		in.ExclusiveEndTime = &p.ExclusiveEndTime.Time
+	}
+	return in
+}
+
+// GenerateJournalKinesisStreamObservation is used to produce
+// v1alpha1.JournalKinesisStreamObservation from
+// qldb.JournalKinesisStreamDescription.
+func GenerateJournalKinesisStreamObservation(s qldb.JournalKinesisStreamDescription) v1alpha1.JournalKinesisStreamObservation {
+	return v1alpha1.JournalKinesisStreamObservation{
+		ARN:        aws.StringValue(s.Arn),
+		Status:     string(s.Status),
+		ErrorCause: string(s.ErrorCause),
+	}
+}
+
+// LateInitializeJournalKinesisStream fills the empty fields in
+// *v1alpha1.JournalKinesisStreamParameters with the values seen in
+// qldb.JournalKinesisStreamDescription.
+func LateInitializeJournalKinesisStream(in *v1alpha1.JournalKinesisStreamParameters, s *qldb.JournalKinesisStreamDescription) {
+	if s == nil || s.KinesisConfiguration == nil {
+		return
+	}
+	in.KinesisConfiguration.AggregationEnabled = awsclients.LateInitializeBoolPtr(in.KinesisConfiguration.AggregationEnabled, s.KinesisConfiguration.AggregationEnabled)
+}
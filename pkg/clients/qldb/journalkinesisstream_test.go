@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+)
+
+func TestGenerateStreamJournalToKinesisInput(t *testing.T) {
+	start := time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	cases := map[string]struct {
+		p    v1alpha1.JournalKinesisStreamParameters
+		want *qldb.StreamJournalToKinesisInput
+	}{
+		"OpenEnded": {
+			p: v1alpha1.JournalKinesisStreamParameters{
+				LedgerName:           aws.String("audit"),
+				StreamName:           "audit-stream",
+				RoleARN:              aws.String("arn:aws:iam::123456789012:role/qldb"),
+				KinesisConfiguration: v1alpha1.KinesisConfiguration{StreamARN: "arn:aws:kinesis:us-east-1:123456789012:stream/audit"},
+				InclusiveStartTime:   metav1.NewTime(start),
+			},
+			want: &qldb.StreamJournalToKinesisInput{
+				LedgerName:           aws.String("audit"),
+				StreamName:           aws.String("audit-stream"),
+				RoleArn:              aws.String("arn:aws:iam::123456789012:role/qldb"),
+				KinesisConfiguration: &qldb.KinesisConfiguration{StreamArn: aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/audit")},
+				InclusiveStartTime:   &start,
+			},
+		},
+		"Bounded": {
+			p: v1alpha1.JournalKinesisStreamParameters{
+				LedgerName: aws.String("audit"),
+				StreamName: "audit-stream",
+				RoleARN:    aws.String("arn:aws:iam::123456789012:role/qldb"),
+				KinesisConfiguration: v1alpha1.KinesisConfiguration{
+					StreamARN:          "arn:aws:kinesis:us-east-1:123456789012:stream/audit",
+					AggregationEnabled: aws.Bool(false),
+				},
+				InclusiveStartTime: metav1.NewTime(start),
+				ExclusiveEndTime:   &metav1.Time{Time: end},
+				Tags:               map[string]string{"team": "audit"},
+			},
+			want: &qldb.StreamJournalToKinesisInput{
+				LedgerName: aws.String("audit"),
+				StreamName: aws.String("audit-stream"),
+				RoleArn:    aws.String("arn:aws:iam::123456789012:role/qldb"),
+				KinesisConfiguration: &qldb.KinesisConfiguration{
+					StreamArn:          aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/audit"),
+					AggregationEnabled: aws.Bool(false),
+				},
+				InclusiveStartTime: &start,
+				ExclusiveEndTime:   &end,
+				Tags:               map[string]string{"team": "audit"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateStreamJournalToKinesisInput(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
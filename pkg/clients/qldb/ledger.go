@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qldb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// LedgerClient is the external client used for Ledger Custom Resource
+type LedgerClient interface {
+	CreateLedgerRequest(*qldb.CreateLedgerInput) qldb.CreateLedgerRequest
+	DescribeLedgerRequest(*qldb.DescribeLedgerInput) qldb.DescribeLedgerRequest
+	UpdateLedgerRequest(*qldb.UpdateLedgerInput) qldb.UpdateLedgerRequest
+	DeleteLedgerRequest(*qldb.DeleteLedgerInput) qldb.DeleteLedgerRequest
+}
+
+// NewLedgerClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewLedgerClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (LedgerClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return qldb.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the QLDB resource doesn't
+// exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == qldb.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateCreateLedgerInput returns the input to create the ledger with the
+// given name from the supplied parameters.
+func GenerateCreateLedgerInput(name string, p v1alpha1.LedgerParameters) *qldb.CreateLedgerInput {
+	return &qldb.CreateLedgerInput{
+		Name:               aws.String(name),
+		PermissionsMode:    qldb.PermissionsMode(p.PermissionsMode),
+		DeletionProtection: p.DeletionProtection,
+		Tags:               p.Tags,
+	}
+}
+
+// GenerateLedgerObservation is used to produce v1alpha1.LedgerObservation
+// from qldb.DescribeLedgerOutput.
+func GenerateLedgerObservation(l qldb.DescribeLedgerOutput) v1alpha1.LedgerObservation {
+	o := v1alpha1.LedgerObservation{
+		ARN:   aws.StringValue(l.Arn),
+		State: string(l.State),
+	}
+	if l.CreationDateTime != nil {
+		t := metav1.NewTime(*l.CreationDateTime)
+		o.CreationDateTime = &t
+	}
+	return o
+}
+
+// LateInitializeLedger fills the empty fields in *v1alpha1.LedgerParameters
+// with the values seen in qldb.DescribeLedgerOutput.
+func LateInitializeLedger(in *v1alpha1.LedgerParameters, l *qldb.DescribeLedgerOutput) {
+	if l == nil {
+		return
+	}
+	in.DeletionProtection = awsclients.LateInitializeBoolPtr(in.DeletionProtection, l.DeletionProtection)
+}
+
+// IsLedgerUpToDate returns true if there is no update-able difference between
+// desired and observed state of the resource.
+func IsLedgerUpToDate(p v1alpha1.LedgerParameters, l qldb.DescribeLedgerOutput) bool {
+	return aws.BoolValue(p.DeletionProtection) == aws.BoolValue(l.DeletionProtection)
+}
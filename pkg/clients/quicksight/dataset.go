@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quicksight
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/quicksight/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DataSetClient is the external client used for DataSet Custom Resource
+type DataSetClient interface {
+	CreateDataSetRequest(*quicksight.CreateDataSetInput) quicksight.CreateDataSetRequest
+	DescribeDataSetRequest(*quicksight.DescribeDataSetInput) quicksight.DescribeDataSetRequest
+	UpdateDataSetRequest(*quicksight.UpdateDataSetInput) quicksight.UpdateDataSetRequest
+	DeleteDataSetRequest(*quicksight.DeleteDataSetInput) quicksight.DeleteDataSetRequest
+}
+
+// NewDataSetClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewDataSetClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DataSetClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return quicksight.New(*cfg), err
+}
+
+func generateInputColumns(c []v1alpha1.InputColumn) []quicksight.InputColumn {
+	if len(c) == 0 {
+		return nil
+	}
+	out := make([]quicksight.InputColumn, len(c))
+	for i, col := range c {
+		out[i] = quicksight.InputColumn{Name: aws.String(col.Name), Type: quicksight.InputColumnDataType(col.Type)}
+	}
+	return out
+}
+
+func generateObservedInputColumns(c []quicksight.InputColumn) []v1alpha1.InputColumn {
+	if len(c) == 0 {
+		return nil
+	}
+	out := make([]v1alpha1.InputColumn, len(c))
+	for i, col := range c {
+		out[i] = v1alpha1.InputColumn{Name: aws.StringValue(col.Name), Type: string(col.Type)}
+	}
+	return out
+}
+
+// GeneratePhysicalTableMap returns the physical table map of a data set keyed
+// by table ID.
+func GeneratePhysicalTableMap(tables []v1alpha1.PhysicalTable) map[string]quicksight.PhysicalTable {
+	m := make(map[string]quicksight.PhysicalTable, len(tables))
+	for _, t := range tables {
+		pt := quicksight.PhysicalTable{}
+		if t.RelationalTable != nil {
+			pt.RelationalTable = &quicksight.RelationalTable{
+				DataSourceArn: t.RelationalTable.DataSourceARN,
+				InputColumns:  generateInputColumns(t.RelationalTable.InputColumns),
+				Name:          aws.String(t.RelationalTable.Name),
+				Schema:        t.RelationalTable.Schema,
+			}
+		}
+		if t.CustomSQL != nil {
+			pt.CustomSql = &quicksight.CustomSql{
+				Columns:       generateInputColumns(t.CustomSQL.Columns),
+				DataSourceArn: t.CustomSQL.DataSourceARN,
+				Name:          aws.String(t.CustomSQL.Name),
+				SqlQuery:      aws.String(t.CustomSQL.SQLQuery),
+			}
+		}
+		m[t.ID] = pt
+	}
+	return m
+}
+
+func generateObservedPhysicalTables(m map[string]quicksight.PhysicalTable) []v1alpha1.PhysicalTable {
+	out := make([]v1alpha1.PhysicalTable, 0, len(m))
+	for id, pt := range m {
+		t := v1alpha1.PhysicalTable{ID: id}
+		if pt.RelationalTable != nil {
+			t.RelationalTable = &v1alpha1.RelationalTable{
+				DataSourceARN: pt.RelationalTable.DataSourceArn,
+				InputColumns:  generateObservedInputColumns(pt.RelationalTable.InputColumns),
+				Name:          aws.StringValue(pt.RelationalTable.Name),
+				Schema:        pt.RelationalTable.Schema,
+			}
+		}
+		if pt.CustomSql != nil {
+			t.CustomSQL = &v1alpha1.CustomSQL{
+				Columns:       generateObservedInputColumns(pt.CustomSql.Columns),
+				DataSourceARN: pt.CustomSql.DataSourceArn,
+				Name:          aws.StringValue(pt.CustomSql.Name),
+				SQLQuery:      aws.StringValue(pt.CustomSql.SqlQuery),
+			}
+		}
+		out = append(out, t)
+	}
+	sortPhysicalTables(out)
+	return out
+}
+
+func sortPhysicalTables(t []v1alpha1.PhysicalTable) {
+	sort.Slice(t, func(i, j int) bool { return t[i].ID < t[j].ID })
+}
+
+// GenerateCreateDataSetInput returns the input to create a data set with the
+// given ID from the supplied parameters.
+func GenerateCreateDataSetInput(id string, p v1alpha1.DataSetParameters) *quicksight.CreateDataSetInput {
+	return &quicksight.CreateDataSetInput{
+		AwsAccountId:     aws.String(p.AccountID),
+		DataSetId:        aws.String(id),
+		ImportMode:       quicksight.DataSetImportMode(p.ImportMode),
+		Name:             aws.String(p.Name),
+		Permissions:      generatePermissions(p.Permissions),
+		PhysicalTableMap: GeneratePhysicalTableMap(p.PhysicalTables),
+		Tags:             generateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateDataSetInput returns the input to update the data set with the
+// given ID from the supplied parameters.
+func GenerateUpdateDataSetInput(id string, p v1alpha1.DataSetParameters) *quicksight.UpdateDataSetInput {
+	return &quicksight.UpdateDataSetInput{
+		AwsAccountId:     aws.String(p.AccountID),
+		DataSetId:        aws.String(id),
+		ImportMode:       quicksight.DataSetImportMode(p.ImportMode),
+		Name:             aws.String(p.Name),
+		PhysicalTableMap: GeneratePhysicalTableMap(p.PhysicalTables),
+	}
+}
+
+// LateInitializeDataSet fills the empty fields in
+// *v1alpha1.DataSetParameters with the values seen in quicksight.DataSet.
+func LateInitializeDataSet(in *v1alpha1.DataSetParameters, ds *quicksight.DataSet) {
+	if ds == nil {
+		return
+	}
+	for i := range in.PhysicalTables {
+		t := &in.PhysicalTables[i]
+		pt, ok := ds.PhysicalTableMap[t.ID]
+		if !ok || t.RelationalTable == nil || pt.RelationalTable == nil {
+			continue
+		}
+		t.RelationalTable.Schema = awsclients.LateInitializeStringPtr(t.RelationalTable.Schema, pt.RelationalTable.Schema)
+	}
+}
+
+// GenerateDataSetObservation is used to produce v1alpha1.DataSetObservation
+// from quicksight.DataSet.
+func GenerateDataSetObservation(ds quicksight.DataSet) v1alpha1.DataSetObservation {
+	return v1alpha1.DataSetObservation{
+		ARN:                          aws.StringValue(ds.Arn),
+		ConsumedSPICECapacityInBytes: aws.Int64Value(ds.ConsumedSpiceCapacityInBytes),
+	}
+}
+
+// IsDataSetUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsDataSetUpToDate(p v1alpha1.DataSetParameters, ds quicksight.DataSet) bool {
+	if p.Name != aws.StringValue(ds.Name) || p.ImportMode != string(ds.ImportMode) {
+		return false
+	}
+	desired := make([]v1alpha1.PhysicalTable, len(p.PhysicalTables))
+	copy(desired, p.PhysicalTables)
+	sortPhysicalTables(desired)
+	return cmp.Equal(desired, generateObservedPhysicalTables(ds.PhysicalTableMap),
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.RelationalTable{}, "DataSourceARNRef", "DataSourceARNSelector"),
+		cmpopts.IgnoreFields(v1alpha1.CustomSQL{}, "DataSourceARNRef", "DataSourceARNSelector"))
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quicksight
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/quicksight/v1alpha1"
+)
+
+var dataSourceARN = "arn:aws:quicksight:us-east-1:123456789012:datasource/analytics"
+
+func dataSetParams(m ...func(*v1alpha1.DataSetParameters)) v1alpha1.DataSetParameters {
+	p := v1alpha1.DataSetParameters{
+		AccountID:  "123456789012",
+		Name:       "events",
+		ImportMode: "SPICE",
+		PhysicalTables: []v1alpha1.PhysicalTable{
+			{
+				ID: "users",
+				RelationalTable: &v1alpha1.RelationalTable{
+					DataSourceARN:    aws.String(dataSourceARN),
+					DataSourceARNRef: &runtimev1alpha1.Reference{Name: "analytics"},
+					Name:             "users",
+					InputColumns:     []v1alpha1.InputColumn{{Name: "id", Type: "STRING"}},
+				},
+			},
+			{
+				ID: "events",
+				CustomSQL: &v1alpha1.CustomSQL{
+					DataSourceARN: aws.String(dataSourceARN),
+					Name:          "recent-events",
+					SQLQuery:      "SELECT * FROM events",
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func dataSet(m ...func(*quicksight.DataSet)) quicksight.DataSet {
+	ds := quicksight.DataSet{
+		Name:       aws.String("events"),
+		ImportMode: quicksight.DataSetImportModeSpice,
+		PhysicalTableMap: map[string]quicksight.PhysicalTable{
+			"events": {
+				CustomSql: &quicksight.CustomSql{
+					DataSourceArn: aws.String(dataSourceARN),
+					Name:          aws.String("recent-events"),
+					SqlQuery:      aws.String("SELECT * FROM events"),
+				},
+			},
+			"users": {
+				RelationalTable: &quicksight.RelationalTable{
+					DataSourceArn: aws.String(dataSourceARN),
+					Name:          aws.String("users"),
+					Schema:        aws.String("public"),
+					InputColumns:  []quicksight.InputColumn{{Name: aws.String("id"), Type: quicksight.InputColumnDataTypeString}},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&ds)
+	}
+	return ds
+}
+
+func TestLateInitializeDataSet(t *testing.T) {
+	got := dataSetParams()
+	LateInitializeDataSet(&got, func() *quicksight.DataSet { ds := dataSet(); return &ds }())
+
+	want := dataSetParams(func(p *v1alpha1.DataSetParameters) {
+		p.PhysicalTables[0].RelationalTable.Schema = aws.String("public")
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeDataSet(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsDataSetUpToDate(t *testing.T) {
+	lateInitialized := func(m ...func(*v1alpha1.DataSetParameters)) v1alpha1.DataSetParameters {
+		return dataSetParams(append([]func(*v1alpha1.DataSetParameters){func(p *v1alpha1.DataSetParameters) {
+			p.PhysicalTables[0].RelationalTable.Schema = aws.String("public")
+		}}, m...)...)
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.DataSetParameters
+		ds   quicksight.DataSet
+		want bool
+	}{
+		"UpToDate": {
+			p:    lateInitialized(),
+			ds:   dataSet(),
+			want: true,
+		},
+		"ImportModeChanged": {
+			p: lateInitialized(),
+			ds: dataSet(func(ds *quicksight.DataSet) {
+				ds.ImportMode = quicksight.DataSetImportModeDirectQuery
+			}),
+			want: false,
+		},
+		"QueryChanged": {
+			p: lateInitialized(func(p *v1alpha1.DataSetParameters) {
+				p.PhysicalTables[1].CustomSQL.SQLQuery = "SELECT id FROM events"
+			}),
+			ds:   dataSet(),
+			want: false,
+		},
+		"TableRemoved": {
+			p: lateInitialized(func(p *v1alpha1.DataSetParameters) {
+				p.PhysicalTables = p.PhysicalTables[:1]
+			}),
+			ds:   dataSet(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDataSetUpToDate(tc.p, tc.ds)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDataSetUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
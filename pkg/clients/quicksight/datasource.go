@@ -0,0 +1,214 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+
+	"github.com/crossplane/provider-aws/apis/quicksight/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DataSourceClient is the external client used for DataSource Custom Resource
+type DataSourceClient interface {
+	CreateDataSourceRequest(*quicksight.CreateDataSourceInput) quicksight.CreateDataSourceRequest
+	DescribeDataSourceRequest(*quicksight.DescribeDataSourceInput) quicksight.DescribeDataSourceRequest
+	UpdateDataSourceRequest(*quicksight.UpdateDataSourceInput) quicksight.UpdateDataSourceRequest
+	DeleteDataSourceRequest(*quicksight.DeleteDataSourceInput) quicksight.DeleteDataSourceRequest
+}
+
+// NewDataSourceClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewDataSourceClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DataSourceClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return quicksight.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the QuickSight resource
+// doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == quicksight.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// GenerateCredentials returns the credentials QuickSight uses to connect to a
+// data source, or nil if no username is supplied.
+func GenerateCredentials(username, password string) *quicksight.DataSourceCredentials {
+	if username == "" {
+		return nil
+	}
+	return &quicksight.DataSourceCredentials{
+		CredentialPair: &quicksight.CredentialPair{
+			Username: aws.String(username),
+			Password: aws.String(password),
+		},
+	}
+}
+
+func generatePermissions(p []v1alpha1.ResourcePermission) []quicksight.ResourcePermission {
+	if len(p) == 0 {
+		return nil
+	}
+	out := make([]quicksight.ResourcePermission, len(p))
+	for i, rp := range p {
+		out[i] = quicksight.ResourcePermission{Principal: aws.String(rp.Principal), Actions: rp.Actions}
+	}
+	return out
+}
+
+func generateTags(t []v1alpha1.Tag) []quicksight.Tag {
+	if len(t) == 0 {
+		return nil
+	}
+	out := make([]quicksight.Tag, len(t))
+	for i, tag := range t {
+		out[i] = quicksight.Tag{Key: aws.String(tag.Key), Value: aws.String(tag.Value)}
+	}
+	return out
+}
+
+func generateDataSourceParameters(p v1alpha1.DataSourceParameters) *quicksight.DataSourceParameters {
+	switch {
+	case p.AthenaParameters != nil:
+		return &quicksight.DataSourceParameters{
+			AthenaParameters: &quicksight.AthenaParameters{WorkGroup: p.AthenaParameters.WorkGroup},
+		}
+	case p.RDSParameters != nil:
+		return &quicksight.DataSourceParameters{
+			RdsParameters: &quicksight.RdsParameters{
+				Database:   aws.String(p.RDSParameters.Database),
+				InstanceId: p.RDSParameters.InstanceID,
+			},
+		}
+	}
+	return nil
+}
+
+func generateSSLProperties(p v1alpha1.DataSourceParameters) *quicksight.SslProperties {
+	if p.DisableSSL == nil {
+		return nil
+	}
+	return &quicksight.SslProperties{DisableSsl: p.DisableSSL}
+}
+
+func generateVPCConnectionProperties(p v1alpha1.DataSourceParameters) *quicksight.VpcConnectionProperties {
+	if p.VPCConnectionARN == nil {
+		return nil
+	}
+	return &quicksight.VpcConnectionProperties{VpcConnectionArn: p.VPCConnectionARN}
+}
+
+// GenerateCreateDataSourceInput returns the input to create a data source
+// with the given ID from the supplied parameters and credentials.
+func GenerateCreateDataSourceInput(id string, p v1alpha1.DataSourceParameters, c *quicksight.DataSourceCredentials) *quicksight.CreateDataSourceInput {
+	return &quicksight.CreateDataSourceInput{
+		AwsAccountId:            aws.String(p.AccountID),
+		Credentials:             c,
+		DataSourceId:            aws.String(id),
+		DataSourceParameters:    generateDataSourceParameters(p),
+		Name:                    aws.String(p.Name),
+		Permissions:             generatePermissions(p.Permissions),
+		SslProperties:           generateSSLProperties(p),
+		Tags:                    generateTags(p.Tags),
+		Type:                    quicksight.DataSourceType(p.Type),
+		VpcConnectionProperties: generateVPCConnectionProperties(p),
+	}
+}
+
+// GenerateUpdateDataSourceInput returns the input to update the data source
+// with the given ID from the supplied parameters and credentials.
+func GenerateUpdateDataSourceInput(id string, p v1alpha1.DataSourceParameters, c *quicksight.DataSourceCredentials) *quicksight.UpdateDataSourceInput {
+	return &quicksight.UpdateDataSourceInput{
+		AwsAccountId:            aws.String(p.AccountID),
+		Credentials:             c,
+		DataSourceId:            aws.String(id),
+		DataSourceParameters:    generateDataSourceParameters(p),
+		Name:                    aws.String(p.Name),
+		SslProperties:           generateSSLProperties(p),
+		VpcConnectionProperties: generateVPCConnectionProperties(p),
+	}
+}
+
+// LateInitializeDataSource fills the empty fields in
+// *v1alpha1.DataSourceParameters with the values seen in
+// quicksight.DataSource.
+func LateInitializeDataSource(in *v1alpha1.DataSourceParameters, ds *quicksight.DataSource) {
+	if ds == nil {
+		return
+	}
+	if ds.SslProperties != nil {
+		in.DisableSSL = awsclients.LateInitializeBoolPtr(in.DisableSSL, ds.SslProperties.DisableSsl)
+	}
+	if in.AthenaParameters != nil && ds.DataSourceParameters != nil && ds.DataSourceParameters.AthenaParameters != nil {
+		in.AthenaParameters.WorkGroup = awsclients.LateInitializeStringPtr(in.AthenaParameters.WorkGroup, ds.DataSourceParameters.AthenaParameters.WorkGroup)
+	}
+}
+
+// GenerateDataSourceObservation is used to produce
+// v1alpha1.DataSourceObservation from quicksight.DataSource.
+func GenerateDataSourceObservation(ds quicksight.DataSource) v1alpha1.DataSourceObservation {
+	o := v1alpha1.DataSourceObservation{
+		ARN:    aws.StringValue(ds.Arn),
+		Status: string(ds.Status),
+	}
+	if ds.ErrorInfo != nil {
+		o.ErrorMessage = aws.StringValue(ds.ErrorInfo.Message)
+	}
+	return o
+}
+
+// IsDataSourceUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource. Credentials cannot be
+// observed, so they are not compared.
+func IsDataSourceUpToDate(p v1alpha1.DataSourceParameters, ds quicksight.DataSource) bool {
+	if p.Name != aws.StringValue(ds.Name) {
+		return false
+	}
+	if aws.BoolValue(p.DisableSSL) != (ds.SslProperties != nil && aws.BoolValue(ds.SslProperties.DisableSsl)) {
+		return false
+	}
+	vpc := ""
+	if ds.VpcConnectionProperties != nil {
+		vpc = aws.StringValue(ds.VpcConnectionProperties.VpcConnectionArn)
+	}
+	if aws.StringValue(p.VPCConnectionARN) != vpc {
+		return false
+	}
+	observed := ds.DataSourceParameters
+	if observed == nil {
+		observed = &quicksight.DataSourceParameters{}
+	}
+	switch {
+	case p.AthenaParameters != nil:
+		return observed.AthenaParameters != nil &&
+			aws.StringValue(p.AthenaParameters.WorkGroup) == aws.StringValue(observed.AthenaParameters.WorkGroup)
+	case p.RDSParameters != nil:
+		return observed.RdsParameters != nil &&
+			p.RDSParameters.Database == aws.StringValue(observed.RdsParameters.Database) &&
+			aws.StringValue(p.RDSParameters.InstanceID) == aws.StringValue(observed.RdsParameters.InstanceId)
+	}
+	return true
+}
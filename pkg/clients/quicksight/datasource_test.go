@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quicksight
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/quicksight/v1alpha1"
+)
+
+func TestGenerateCreateDataSourceInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DataSourceParameters
+		c    *quicksight.DataSourceCredentials
+		want *quicksight.CreateDataSourceInput
+	}{
+		"Athena": {
+			p: v1alpha1.DataSourceParameters{
+				AccountID:        "123456789012",
+				Name:             "analytics",
+				Type:             "ATHENA",
+				AthenaParameters: &v1alpha1.AthenaParameters{WorkGroup: aws.String("primary")},
+				Permissions: []v1alpha1.ResourcePermission{{
+					Principal: "arn:aws:quicksight:us-east-1:123456789012:group/default/analysts",
+					Actions:   []string{"quicksight:DescribeDataSource"},
+				}},
+			},
+			want: &quicksight.CreateDataSourceInput{
+				AwsAccountId: aws.String("123456789012"),
+				DataSourceId: aws.String("analytics"),
+				DataSourceParameters: &quicksight.DataSourceParameters{
+					AthenaParameters: &quicksight.AthenaParameters{WorkGroup: aws.String("primary")},
+				},
+				Name: aws.String("analytics"),
+				Permissions: []quicksight.ResourcePermission{{
+					Principal: aws.String("arn:aws:quicksight:us-east-1:123456789012:group/default/analysts"),
+					Actions:   []string{"quicksight:DescribeDataSource"},
+				}},
+				Type: quicksight.DataSourceTypeAthena,
+			},
+		},
+		"RDSWithCredentials": {
+			p: v1alpha1.DataSourceParameters{
+				AccountID:     "123456789012",
+				Name:          "analytics",
+				Type:          "POSTGRESQL",
+				RDSParameters: &v1alpha1.RDSParameters{Database: "app", InstanceID: aws.String("app-db")},
+				DisableSSL:    aws.Bool(false),
+			},
+			c: GenerateCredentials("admin", "secret"),
+			want: &quicksight.CreateDataSourceInput{
+				AwsAccountId: aws.String("123456789012"),
+				Credentials: &quicksight.DataSourceCredentials{
+					CredentialPair: &quicksight.CredentialPair{Username: aws.String("admin"), Password: aws.String("secret")},
+				},
+				DataSourceId: aws.String("analytics"),
+				DataSourceParameters: &quicksight.DataSourceParameters{
+					RdsParameters: &quicksight.RdsParameters{Database: aws.String("app"), InstanceId: aws.String("app-db")},
+				},
+				Name:          aws.String("analytics"),
+				SslProperties: &quicksight.SslProperties{DisableSsl: aws.Bool(false)},
+				Type:          quicksight.DataSourceTypePostgresql,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateDataSourceInput("analytics", tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateCreateDataSourceInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDataSourceUpToDate(t *testing.T) {
+	params := v1alpha1.DataSourceParameters{
+		Name:          "analytics",
+		Type:          "POSTGRESQL",
+		RDSParameters: &v1alpha1.RDSParameters{Database: "app", InstanceID: aws.String("app-db")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.DataSourceParameters
+		ds   quicksight.DataSource
+		want bool
+	}{
+		"UpToDate": {
+			p: params,
+			ds: quicksight.DataSource{
+				Name: aws.String("analytics"),
+				DataSourceParameters: &quicksight.DataSourceParameters{
+					RdsParameters: &quicksight.RdsParameters{Database: aws.String("app"), InstanceId: aws.String("app-db")},
+				},
+				SslProperties: &quicksight.SslProperties{DisableSsl: aws.Bool(false)},
+			},
+			want: true,
+		},
+		"DatabaseChanged": {
+			p: params,
+			ds: quicksight.DataSource{
+				Name: aws.String("analytics"),
+				DataSourceParameters: &quicksight.DataSourceParameters{
+					RdsParameters: &quicksight.RdsParameters{Database: aws.String("reporting"), InstanceId: aws.String("app-db")},
+				},
+			},
+			want: false,
+		},
+		"NameChanged": {
+			p: params,
+			ds: quicksight.DataSource{
+				Name: aws.String("old"),
+				DataSourceParameters: &quicksight.DataSourceParameters{
+					RdsParameters: &quicksight.RdsParameters{Database: aws.String("app"), InstanceId: aws.String("app-db")},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDataSourceUpToDate(tc.p, tc.ds)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDataSourceUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/quicksight"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DataSetClient = (*MockDataSetClient)(nil)
+
+// MockDataSetClient is a type that implements all the methods for DataSetClient interface
+type MockDataSetClient struct {
+	MockCreateDataSet   func(*quicksight.CreateDataSetInput) quicksight.CreateDataSetRequest
+	MockDescribeDataSet func(*quicksight.DescribeDataSetInput) quicksight.DescribeDataSetRequest
+	MockUpdateDataSet   func(*quicksight.UpdateDataSetInput) quicksight.UpdateDataSetRequest
+	MockDeleteDataSet   func(*quicksight.DeleteDataSetInput) quicksight.DeleteDataSetRequest
+}
+
+// CreateDataSetRequest calls the underlying MockCreateDataSet method.
+func (c *MockDataSetClient) CreateDataSetRequest(i *quicksight.CreateDataSetInput) quicksight.CreateDataSetRequest {
+	return c.MockCreateDataSet(i)
+}
+
+// DescribeDataSetRequest calls the underlying MockDescribeDataSet method.
+func (c *MockDataSetClient) DescribeDataSetRequest(i *quicksight.DescribeDataSetInput) quicksight.DescribeDataSetRequest {
+	return c.MockDescribeDataSet(i)
+}
+
+// UpdateDataSetRequest calls the underlying MockUpdateDataSet method.
+func (c *MockDataSetClient) UpdateDataSetRequest(i *quicksight.UpdateDataSetInput) quicksight.UpdateDataSetRequest {
+	return c.MockUpdateDataSet(i)
+}
+
+// DeleteDataSetRequest calls the underlying MockDeleteDataSet method.
+func (c *MockDataSetClient) DeleteDataSetRequest(i *quicksight.DeleteDataSetInput) quicksight.DeleteDataSetRequest {
+	return c.MockDeleteDataSet(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/quicksight"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DataSourceClient = (*MockDataSourceClient)(nil)
+
+// MockDataSourceClient is a type that implements all the methods for DataSourceClient interface
+type MockDataSourceClient struct {
+	MockCreateDataSource   func(*quicksight.CreateDataSourceInput) quicksight.CreateDataSourceRequest
+	MockDescribeDataSource func(*quicksight.DescribeDataSourceInput) quicksight.DescribeDataSourceRequest
+	MockUpdateDataSource   func(*quicksight.UpdateDataSourceInput) quicksight.UpdateDataSourceRequest
+	MockDeleteDataSource   func(*quicksight.DeleteDataSourceInput) quicksight.DeleteDataSourceRequest
+}
+
+// CreateDataSourceRequest calls the underlying MockCreateDataSource method.
+func (c *MockDataSourceClient) CreateDataSourceRequest(i *quicksight.CreateDataSourceInput) quicksight.CreateDataSourceRequest {
+	return c.MockCreateDataSource(i)
+}
+
+// DescribeDataSourceRequest calls the underlying MockDescribeDataSource method.
+func (c *MockDataSourceClient) DescribeDataSourceRequest(i *quicksight.DescribeDataSourceInput) quicksight.DescribeDataSourceRequest {
+	return c.MockDescribeDataSource(i)
+}
+
+// UpdateDataSourceRequest calls the underlying MockUpdateDataSource method.
+func (c *MockDataSourceClient) UpdateDataSourceRequest(i *quicksight.UpdateDataSourceInput) quicksight.UpdateDataSourceRequest {
+	return c.MockUpdateDataSource(i)
+}
+
+// DeleteDataSourceRequest calls the underlying MockDeleteDataSource method.
+func (c *MockDataSourceClient) DeleteDataSourceRequest(i *quicksight.DeleteDataSourceInput) quicksight.DeleteDataSourceRequest {
+	return c.MockDeleteDataSource(i)
+}
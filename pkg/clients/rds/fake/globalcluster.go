@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/rds"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.GlobalClusterClient = (*MockGlobalClusterClient)(nil)
+
+// MockGlobalClusterClient is a type that implements all the methods for GlobalClusterClient interface
+type MockGlobalClusterClient struct {
+	MockCreateGlobalCluster    func(*rds.CreateGlobalClusterInput) rds.CreateGlobalClusterRequest
+	MockDescribeGlobalClusters func(*rds.DescribeGlobalClustersInput) rds.DescribeGlobalClustersRequest
+	MockModifyGlobalCluster    func(*rds.ModifyGlobalClusterInput) rds.ModifyGlobalClusterRequest
+	MockDeleteGlobalCluster    func(*rds.DeleteGlobalClusterInput) rds.DeleteGlobalClusterRequest
+}
+
+// CreateGlobalClusterRequest calls the underlying MockCreateGlobalCluster method.
+func (c *MockGlobalClusterClient) CreateGlobalClusterRequest(i *rds.CreateGlobalClusterInput) rds.CreateGlobalClusterRequest {
+	return c.MockCreateGlobalCluster(i)
+}
+
+// DescribeGlobalClustersRequest calls the underlying MockDescribeGlobalClusters method.
+func (c *MockGlobalClusterClient) DescribeGlobalClustersRequest(i *rds.DescribeGlobalClustersInput) rds.DescribeGlobalClustersRequest {
+	return c.MockDescribeGlobalClusters(i)
+}
+
+// ModifyGlobalClusterRequest calls the underlying MockModifyGlobalCluster method.
+func (c *MockGlobalClusterClient) ModifyGlobalClusterRequest(i *rds.ModifyGlobalClusterInput) rds.ModifyGlobalClusterRequest {
+	return c.MockModifyGlobalCluster(i)
+}
+
+// DeleteGlobalClusterRequest calls the underlying MockDeleteGlobalCluster method.
+func (c *MockGlobalClusterClient) DeleteGlobalClusterRequest(i *rds.DeleteGlobalClusterInput) rds.DeleteGlobalClusterRequest {
+	return c.MockDeleteGlobalCluster(i)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GlobalClusterClient is the external client used for GlobalCluster Custom
+// Resource
+type GlobalClusterClient interface {
+	CreateGlobalClusterRequest(*rds.CreateGlobalClusterInput) rds.CreateGlobalClusterRequest
+	DescribeGlobalClustersRequest(*rds.DescribeGlobalClustersInput) rds.DescribeGlobalClustersRequest
+	ModifyGlobalClusterRequest(*rds.ModifyGlobalClusterInput) rds.ModifyGlobalClusterRequest
+	DeleteGlobalClusterRequest(*rds.DeleteGlobalClusterInput) rds.DeleteGlobalClusterRequest
+}
+
+// NewGlobalClusterClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewGlobalClusterClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (GlobalClusterClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return rds.New(*cfg), err
+}
+
+// IsGlobalClusterNotFound returns true if the error is because the global
+// cluster doesn't exist.
+func IsGlobalClusterNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == rds.ErrCodeGlobalClusterNotFoundFault
+	}
+	return false
+}
+
+// GenerateCreateGlobalClusterInput returns the input to create a global
+// cluster with the supplied identifier and parameters.
+func GenerateCreateGlobalClusterInput(id string, p v1alpha1.GlobalClusterParameters) *rds.CreateGlobalClusterInput {
+	return &rds.CreateGlobalClusterInput{
+		GlobalClusterIdentifier:   aws.String(id),
+		SourceDBClusterIdentifier: p.SourceDBClusterIdentifier,
+		Engine:                    p.Engine,
+		EngineVersion:             p.EngineVersion,
+		DatabaseName:              p.DatabaseName,
+		StorageEncrypted:          p.StorageEncrypted,
+		DeletionProtection:        p.DeletionProtection,
+	}
+}
+
+// LateInitializeGlobalCluster fills the empty fields of the supplied
+// parameters with the values of the observed global cluster. The settings
+// inherited from a source DB cluster are left empty as they may not be set
+// along with it.
+func LateInitializeGlobalCluster(p *v1alpha1.GlobalClusterParameters, g rds.GlobalCluster) {
+	p.DeletionProtection = awsclients.LateInitializeBoolPtr(p.DeletionProtection, g.DeletionProtection)
+	if p.SourceDBClusterIdentifier != nil {
+		return
+	}
+	p.Engine = awsclients.LateInitializeStringPtr(p.Engine, g.Engine)
+	p.EngineVersion = awsclients.LateInitializeStringPtr(p.EngineVersion, g.EngineVersion)
+	p.DatabaseName = awsclients.LateInitializeStringPtr(p.DatabaseName, g.DatabaseName)
+	p.StorageEncrypted = awsclients.LateInitializeBoolPtr(p.StorageEncrypted, g.StorageEncrypted)
+}
+
+// GenerateGlobalClusterObservation returns the observation of the supplied
+// global cluster.
+func GenerateGlobalClusterObservation(g rds.GlobalCluster) v1alpha1.GlobalClusterObservation {
+	o := v1alpha1.GlobalClusterObservation{
+		ARN:        aws.StringValue(g.GlobalClusterArn),
+		ResourceID: aws.StringValue(g.GlobalClusterResourceId),
+		Status:     aws.StringValue(g.Status),
+	}
+	for _, m := range g.GlobalClusterMembers {
+		o.Members = append(o.Members, v1alpha1.GlobalClusterMember{
+			DBClusterARN: aws.StringValue(m.DBClusterArn),
+			IsWriter:     aws.BoolValue(m.IsWriter),
+			Readers:      m.Readers,
+		})
+	}
+	return o
+}
+
+// IsGlobalClusterUpToDate returns true if the modifiable settings of the
+// supplied global cluster match the parameters.
+func IsGlobalClusterUpToDate(p v1alpha1.GlobalClusterParameters, g rds.GlobalCluster) bool {
+	return p.DeletionProtection == nil || aws.BoolValue(p.DeletionProtection) == aws.BoolValue(g.DeletionProtection)
+}
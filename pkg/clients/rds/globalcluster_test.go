@@ -0,0 +1,65 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+func TestGenerateGlobalClusterObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   rds.GlobalCluster
+		want v1alpha1.GlobalClusterObservation
+	}{
+		"Empty": {},
+		"Members": {
+			in: rds.GlobalCluster{
+				GlobalClusterArn:        aws.String("arn"),
+				GlobalClusterResourceId: aws.String("cluster-1"),
+				Status:                  aws.String("available"),
+				GlobalClusterMembers: []rds.GlobalClusterMember{
+					{DBClusterArn: aws.String("primary"), IsWriter: aws.Bool(true), Readers: []string{"secondary"}},
+					{DBClusterArn: aws.String("secondary")},
+				},
+			},
+			want: v1alpha1.GlobalClusterObservation{
+				ARN:        "arn",
+				ResourceID: "cluster-1",
+				Status:     "available",
+				Members: []v1alpha1.GlobalClusterMember{
+					{DBClusterARN: "primary", IsWriter: true, Readers: []string{"secondary"}},
+					{DBClusterARN: "secondary"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateGlobalClusterObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateGlobalClusterObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
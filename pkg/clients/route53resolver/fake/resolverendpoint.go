@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ResolverEndpointClient = (*MockResolverEndpointClient)(nil)
+
+// MockResolverEndpointClient is a type that implements all the methods for ResolverEndpointClient interface
+type MockResolverEndpointClient struct {
+	MockCreateResolverEndpoint                func(*route53resolver.CreateResolverEndpointInput) route53resolver.CreateResolverEndpointRequest
+	MockGetResolverEndpoint                   func(*route53resolver.GetResolverEndpointInput) route53resolver.GetResolverEndpointRequest
+	MockUpdateResolverEndpoint                func(*route53resolver.UpdateResolverEndpointInput) route53resolver.UpdateResolverEndpointRequest
+	MockDeleteResolverEndpoint                func(*route53resolver.DeleteResolverEndpointInput) route53resolver.DeleteResolverEndpointRequest
+	MockListResolverEndpointIpAddresses       func(*route53resolver.ListResolverEndpointIpAddressesInput) route53resolver.ListResolverEndpointIpAddressesRequest
+	MockAssociateResolverEndpointIpAddress    func(*route53resolver.AssociateResolverEndpointIpAddressInput) route53resolver.AssociateResolverEndpointIpAddressRequest
+	MockDisassociateResolverEndpointIpAddress func(*route53resolver.DisassociateResolverEndpointIpAddressInput) route53resolver.DisassociateResolverEndpointIpAddressRequest
+}
+
+// CreateResolverEndpointRequest calls the underlying MockCreateResolverEndpoint method.
+func (c *MockResolverEndpointClient) CreateResolverEndpointRequest(i *route53resolver.CreateResolverEndpointInput) route53resolver.CreateResolverEndpointRequest {
+	return c.MockCreateResolverEndpoint(i)
+}
+
+// GetResolverEndpointRequest calls the underlying MockGetResolverEndpoint method.
+func (c *MockResolverEndpointClient) GetResolverEndpointRequest(i *route53resolver.GetResolverEndpointInput) route53resolver.GetResolverEndpointRequest {
+	return c.MockGetResolverEndpoint(i)
+}
+
+// UpdateResolverEndpointRequest calls the underlying MockUpdateResolverEndpoint method.
+func (c *MockResolverEndpointClient) UpdateResolverEndpointRequest(i *route53resolver.UpdateResolverEndpointInput) route53resolver.UpdateResolverEndpointRequest {
+	return c.MockUpdateResolverEndpoint(i)
+}
+
+// DeleteResolverEndpointRequest calls the underlying MockDeleteResolverEndpoint method.
+func (c *MockResolverEndpointClient) DeleteResolverEndpointRequest(i *route53resolver.DeleteResolverEndpointInput) route53resolver.DeleteResolverEndpointRequest {
+	return c.MockDeleteResolverEndpoint(i)
+}
+
+// ListResolverEndpointIpAddressesRequest calls the underlying MockListResolverEndpointIpAddresses method.
+func (c *MockResolverEndpointClient) ListResolverEndpointIpAddressesRequest(i *route53resolver.ListResolverEndpointIpAddressesInput) route53resolver.ListResolverEndpointIpAddressesRequest {
+	return c.MockListResolverEndpointIpAddresses(i)
+}
+
+// AssociateResolverEndpointIpAddressRequest calls the underlying MockAssociateResolverEndpointIpAddress method.
+func (c *MockResolverEndpointClient) AssociateResolverEndpointIpAddressRequest(i *route53resolver.AssociateResolverEndpointIpAddressInput) route53resolver.AssociateResolverEndpointIpAddressRequest {
+	return c.MockAssociateResolverEndpointIpAddress(i)
+}
+
+// DisassociateResolverEndpointIpAddressRequest calls the underlying MockDisassociateResolverEndpointIpAddress method.
+func (c *MockResolverEndpointClient) DisassociateResolverEndpointIpAddressRequest(i *route53resolver.DisassociateResolverEndpointIpAddressInput) route53resolver.DisassociateResolverEndpointIpAddressRequest {
+	return c.MockDisassociateResolverEndpointIpAddress(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ResolverRuleClient = (*MockResolverRuleClient)(nil)
+
+// MockResolverRuleClient is a type that implements all the methods for ResolverRuleClient interface
+type MockResolverRuleClient struct {
+	MockCreateResolverRule func(*route53resolver.CreateResolverRuleInput) route53resolver.CreateResolverRuleRequest
+	MockGetResolverRule    func(*route53resolver.GetResolverRuleInput) route53resolver.GetResolverRuleRequest
+	MockUpdateResolverRule func(*route53resolver.UpdateResolverRuleInput) route53resolver.UpdateResolverRuleRequest
+	MockDeleteResolverRule func(*route53resolver.DeleteResolverRuleInput) route53resolver.DeleteResolverRuleRequest
+}
+
+// CreateResolverRuleRequest calls the underlying MockCreateResolverRule method.
+func (c *MockResolverRuleClient) CreateResolverRuleRequest(i *route53resolver.CreateResolverRuleInput) route53resolver.CreateResolverRuleRequest {
+	return c.MockCreateResolverRule(i)
+}
+
+// GetResolverRuleRequest calls the underlying MockGetResolverRule method.
+func (c *MockResolverRuleClient) GetResolverRuleRequest(i *route53resolver.GetResolverRuleInput) route53resolver.GetResolverRuleRequest {
+	return c.MockGetResolverRule(i)
+}
+
+// UpdateResolverRuleRequest calls the underlying MockUpdateResolverRule method.
+func (c *MockResolverRuleClient) UpdateResolverRuleRequest(i *route53resolver.UpdateResolverRuleInput) route53resolver.UpdateResolverRuleRequest {
+	return c.MockUpdateResolverRule(i)
+}
+
+// DeleteResolverRuleRequest calls the underlying MockDeleteResolverRule method.
+func (c *MockResolverRuleClient) DeleteResolverRuleRequest(i *route53resolver.DeleteResolverRuleInput) route53resolver.DeleteResolverRuleRequest {
+	return c.MockDeleteResolverRule(i)
+}
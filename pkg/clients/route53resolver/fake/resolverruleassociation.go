@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ResolverRuleAssociationClient = (*MockResolverRuleAssociationClient)(nil)
+
+// MockResolverRuleAssociationClient is a type that implements all the methods for ResolverRuleAssociationClient interface
+type MockResolverRuleAssociationClient struct {
+	MockAssociateResolverRule      func(*route53resolver.AssociateResolverRuleInput) route53resolver.AssociateResolverRuleRequest
+	MockGetResolverRuleAssociation func(*route53resolver.GetResolverRuleAssociationInput) route53resolver.GetResolverRuleAssociationRequest
+	MockDisassociateResolverRule   func(*route53resolver.DisassociateResolverRuleInput) route53resolver.DisassociateResolverRuleRequest
+}
+
+// AssociateResolverRuleRequest calls the underlying MockAssociateResolverRule method.
+func (c *MockResolverRuleAssociationClient) AssociateResolverRuleRequest(i *route53resolver.AssociateResolverRuleInput) route53resolver.AssociateResolverRuleRequest {
+	return c.MockAssociateResolverRule(i)
+}
+
+// GetResolverRuleAssociationRequest calls the underlying MockGetResolverRuleAssociation method.
+func (c *MockResolverRuleAssociationClient) GetResolverRuleAssociationRequest(i *route53resolver.GetResolverRuleAssociationInput) route53resolver.GetResolverRuleAssociationRequest {
+	return c.MockGetResolverRuleAssociation(i)
+}
+
+// DisassociateResolverRuleRequest calls the underlying MockDisassociateResolverRule method.
+func (c *MockResolverRuleAssociationClient) DisassociateResolverRuleRequest(i *route53resolver.DisassociateResolverRuleInput) route53resolver.DisassociateResolverRuleRequest {
+	return c.MockDisassociateResolverRule(i)
+}
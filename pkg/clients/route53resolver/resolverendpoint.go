@@ -0,0 +1,150 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53resolver
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ResolverEndpointClient is the external client used for ResolverEndpoint
+// Custom Resource
+type ResolverEndpointClient interface {
+	CreateResolverEndpointRequest(*route53resolver.CreateResolverEndpointInput) route53resolver.CreateResolverEndpointRequest
+	GetResolverEndpointRequest(*route53resolver.GetResolverEndpointInput) route53resolver.GetResolverEndpointRequest
+	UpdateResolverEndpointRequest(*route53resolver.UpdateResolverEndpointInput) route53resolver.UpdateResolverEndpointRequest
+	DeleteResolverEndpointRequest(*route53resolver.DeleteResolverEndpointInput) route53resolver.DeleteResolverEndpointRequest
+	ListResolverEndpointIpAddressesRequest(*route53resolver.ListResolverEndpointIpAddressesInput) route53resolver.ListResolverEndpointIpAddressesRequest
+	AssociateResolverEndpointIpAddressRequest(*route53resolver.AssociateResolverEndpointIpAddressInput) route53resolver.AssociateResolverEndpointIpAddressRequest
+	DisassociateResolverEndpointIpAddressRequest(*route53resolver.DisassociateResolverEndpointIpAddressInput) route53resolver.DisassociateResolverEndpointIpAddressRequest
+}
+
+// NewResolverEndpointClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewResolverEndpointClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ResolverEndpointClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return route53resolver.New(*cfg), err
+}
+
+// GenerateCreateResolverEndpointInput returns the input to create a resolver
+// endpoint with the supplied parameters and creator request ID.
+func GenerateCreateResolverEndpointInput(requestID string, p v1alpha1.ResolverEndpointParameters) *route53resolver.CreateResolverEndpointInput {
+	in := &route53resolver.CreateResolverEndpointInput{
+		CreatorRequestId: aws.String(requestID),
+		Direction:        route53resolver.ResolverEndpointDirection(p.Direction),
+		Name:             p.Name,
+		SecurityGroupIds: p.SecurityGroupIDs,
+		Tags:             GenerateTags(p.Tags),
+	}
+	for _, a := range p.IPAddresses {
+		in.IpAddresses = append(in.IpAddresses, route53resolver.IpAddressRequest{
+			Ip:       a.IP,
+			SubnetId: a.SubnetID,
+		})
+	}
+	return in
+}
+
+// LateInitializeResolverEndpoint fills the empty fields in the supplied
+// parameters with the values observed on the resolver endpoint.
+func LateInitializeResolverEndpoint(p *v1alpha1.ResolverEndpointParameters, e route53resolver.ResolverEndpoint) {
+	p.Name = awsclients.LateInitializeStringPtr(p.Name, e.Name)
+	if len(p.SecurityGroupIDs) == 0 && len(e.SecurityGroupIds) != 0 {
+		p.SecurityGroupIDs = e.SecurityGroupIds
+	}
+}
+
+// GenerateResolverEndpointObservation returns the observation of the
+// supplied resolver endpoint and its IP addresses.
+func GenerateResolverEndpointObservation(e route53resolver.ResolverEndpoint, addrs []route53resolver.IpAddressResponse) v1alpha1.ResolverEndpointObservation {
+	o := v1alpha1.ResolverEndpointObservation{
+		ARN:           aws.StringValue(e.Arn),
+		HostVPCID:     aws.StringValue(e.HostVPCId),
+		Status:        string(e.Status),
+		StatusMessage: aws.StringValue(e.StatusMessage),
+	}
+	for _, a := range addrs {
+		o.IPAddresses = append(o.IPAddresses, v1alpha1.IPAddressObservation{
+			IPID:     aws.StringValue(a.IpId),
+			IP:       aws.StringValue(a.Ip),
+			SubnetID: aws.StringValue(a.SubnetId),
+			Status:   string(a.Status),
+		})
+	}
+	return o
+}
+
+// DiffIPAddresses returns the IP addresses that have to be associated with
+// and disassociated from a resolver endpoint so that its observed addresses
+// match the desired ones. A desired address without an IP matches any
+// observed address in its subnet that is not claimed by another one.
+func DiffIPAddresses(desired []v1alpha1.IPAddress, observed []route53resolver.IpAddressResponse) (add, remove []route53resolver.IpAddressUpdate) {
+	matched := make([]bool, len(observed))
+	pending := make([]v1alpha1.IPAddress, 0, len(desired))
+	for _, d := range desired {
+		if d.IP == nil {
+			pending = append(pending, d)
+			continue
+		}
+		found := false
+		for i, o := range observed {
+			if !matched[i] && aws.StringValue(o.SubnetId) == aws.StringValue(d.SubnetID) && aws.StringValue(o.Ip) == aws.StringValue(d.IP) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			add = append(add, route53resolver.IpAddressUpdate{Ip: d.IP, SubnetId: d.SubnetID})
+		}
+	}
+	for _, d := range pending {
+		found := false
+		for i, o := range observed {
+			if !matched[i] && aws.StringValue(o.SubnetId) == aws.StringValue(d.SubnetID) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			add = append(add, route53resolver.IpAddressUpdate{SubnetId: d.SubnetID})
+		}
+	}
+	for i, o := range observed {
+		if !matched[i] {
+			remove = append(remove, route53resolver.IpAddressUpdate{IpId: o.IpId, Ip: o.Ip, SubnetId: o.SubnetId})
+		}
+	}
+	return add, remove
+}
+
+// IsResolverEndpointUpToDate returns true if the supplied resolver endpoint
+// and its IP addresses match the desired parameters.
+func IsResolverEndpointUpToDate(p v1alpha1.ResolverEndpointParameters, e route53resolver.ResolverEndpoint, addrs []route53resolver.IpAddressResponse) bool {
+	if aws.StringValue(p.Name) != aws.StringValue(e.Name) {
+		return false
+	}
+	add, remove := DiffIPAddresses(p.IPAddresses, addrs)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53resolver
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ResolverRuleClient is the external client used for ResolverRule Custom
+// Resource
+type ResolverRuleClient interface {
+	CreateResolverRuleRequest(*route53resolver.CreateResolverRuleInput) route53resolver.CreateResolverRuleRequest
+	GetResolverRuleRequest(*route53resolver.GetResolverRuleInput) route53resolver.GetResolverRuleRequest
+	UpdateResolverRuleRequest(*route53resolver.UpdateResolverRuleInput) route53resolver.UpdateResolverRuleRequest
+	DeleteResolverRuleRequest(*route53resolver.DeleteResolverRuleInput) route53resolver.DeleteResolverRuleRequest
+}
+
+// NewResolverRuleClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewResolverRuleClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ResolverRuleClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return route53resolver.New(*cfg), err
+}
+
+func generateTargetAddresses(targets []v1alpha1.TargetAddress) []route53resolver.TargetAddress {
+	if len(targets) == 0 {
+		return nil
+	}
+	res := make([]route53resolver.TargetAddress, len(targets))
+	for i, t := range targets {
+		res[i] = route53resolver.TargetAddress{
+			Ip:   aws.String(t.IP),
+			Port: t.Port,
+		}
+	}
+	return res
+}
+
+// GenerateCreateResolverRuleInput returns the input to create a resolver
+// rule with the supplied parameters and creator request ID.
+func GenerateCreateResolverRuleInput(requestID string, p v1alpha1.ResolverRuleParameters) *route53resolver.CreateResolverRuleInput {
+	return &route53resolver.CreateResolverRuleInput{
+		CreatorRequestId:   aws.String(requestID),
+		DomainName:         aws.String(p.DomainName),
+		Name:               p.Name,
+		ResolverEndpointId: p.ResolverEndpointID,
+		RuleType:           route53resolver.RuleTypeOption(p.RuleType),
+		Tags:               GenerateTags(p.Tags),
+		TargetIps:          generateTargetAddresses(p.TargetIPs),
+	}
+}
+
+// GenerateUpdateResolverRuleInput returns the input to update the supplied
+// resolver rule to the desired parameters.
+func GenerateUpdateResolverRuleInput(id string, p v1alpha1.ResolverRuleParameters) *route53resolver.UpdateResolverRuleInput {
+	return &route53resolver.UpdateResolverRuleInput{
+		ResolverRuleId: aws.String(id),
+		Config: &route53resolver.ResolverRuleConfig{
+			Name:               p.Name,
+			ResolverEndpointId: p.ResolverEndpointID,
+			TargetIps:          generateTargetAddresses(p.TargetIPs),
+		},
+	}
+}
+
+// LateInitializeResolverRule fills the empty fields in the supplied
+// parameters with the values observed on the resolver rule. The ports of the
+// target IPs are only late initialized if the targets are in the same order.
+func LateInitializeResolverRule(p *v1alpha1.ResolverRuleParameters, r route53resolver.ResolverRule) {
+	p.Name = awsclients.LateInitializeStringPtr(p.Name, r.Name)
+	p.ResolverEndpointID = awsclients.LateInitializeStringPtr(p.ResolverEndpointID, r.ResolverEndpointId)
+	if len(p.TargetIPs) != len(r.TargetIps) {
+		return
+	}
+	for i := range p.TargetIPs {
+		if p.TargetIPs[i].IP == aws.StringValue(r.TargetIps[i].Ip) {
+			p.TargetIPs[i].Port = awsclients.LateInitializeInt64Ptr(p.TargetIPs[i].Port, r.TargetIps[i].Port)
+		}
+	}
+}
+
+// GenerateResolverRuleObservation returns the observation of the supplied
+// resolver rule.
+func GenerateResolverRuleObservation(r route53resolver.ResolverRule) v1alpha1.ResolverRuleObservation {
+	return v1alpha1.ResolverRuleObservation{
+		ARN:           aws.StringValue(r.Arn),
+		OwnerID:       aws.StringValue(r.OwnerId),
+		ShareStatus:   string(r.ShareStatus),
+		Status:        string(r.Status),
+		StatusMessage: aws.StringValue(r.StatusMessage),
+	}
+}
+
+// IsResolverRuleUpToDate returns true if the supplied resolver rule matches
+// the desired parameters.
+func IsResolverRuleUpToDate(p v1alpha1.ResolverRuleParameters, r route53resolver.ResolverRule) bool {
+	if aws.StringValue(p.Name) != aws.StringValue(r.Name) ||
+		aws.StringValue(p.ResolverEndpointID) != aws.StringValue(r.ResolverEndpointId) {
+		return false
+	}
+	return cmp.Equal(generateTargetAddresses(p.TargetIPs), r.TargetIps, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53resolver
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ResolverRuleAssociationClient is the external client used for
+// ResolverRuleAssociation Custom Resource
+type ResolverRuleAssociationClient interface {
+	AssociateResolverRuleRequest(*route53resolver.AssociateResolverRuleInput) route53resolver.AssociateResolverRuleRequest
+	GetResolverRuleAssociationRequest(*route53resolver.GetResolverRuleAssociationInput) route53resolver.GetResolverRuleAssociationRequest
+	DisassociateResolverRuleRequest(*route53resolver.DisassociateResolverRuleInput) route53resolver.DisassociateResolverRuleRequest
+}
+
+// NewResolverRuleAssociationClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewResolverRuleAssociationClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ResolverRuleAssociationClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return route53resolver.New(*cfg), err
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53resolver
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+)
+
+func TestDiffIPAddresses(t *testing.T) {
+	observed := []route53resolver.IpAddressResponse{
+		{IpId: aws.String("rni-a"), Ip: aws.String("10.0.0.10"), SubnetId: aws.String("subnet-a")},
+		{IpId: aws.String("rni-b"), Ip: aws.String("10.0.1.10"), SubnetId: aws.String("subnet-b")},
+	}
+
+	type want struct {
+		add    []route53resolver.IpAddressUpdate
+		remove []route53resolver.IpAddressUpdate
+	}
+
+	cases := map[string]struct {
+		desired []v1alpha1.IPAddress
+		want    want
+	}{
+		"UpToDate": {
+			desired: []v1alpha1.IPAddress{
+				{SubnetID: aws.String("subnet-b"), IP: aws.String("10.0.1.10")},
+				{SubnetID: aws.String("subnet-a")},
+			},
+		},
+		"AddressAdded": {
+			desired: []v1alpha1.IPAddress{
+				{SubnetID: aws.String("subnet-a")},
+				{SubnetID: aws.String("subnet-b")},
+				{SubnetID: aws.String("subnet-c")},
+			},
+			want: want{
+				add: []route53resolver.IpAddressUpdate{{SubnetId: aws.String("subnet-c")}},
+			},
+		},
+		"AddressRemoved": {
+			desired: []v1alpha1.IPAddress{
+				{SubnetID: aws.String("subnet-a")},
+			},
+			want: want{
+				remove: []route53resolver.IpAddressUpdate{{IpId: aws.String("rni-b"), Ip: aws.String("10.0.1.10"), SubnetId: aws.String("subnet-b")}},
+			},
+		},
+		"IPChanged": {
+			desired: []v1alpha1.IPAddress{
+				{SubnetID: aws.String("subnet-a"), IP: aws.String("10.0.0.20")},
+				{SubnetID: aws.String("subnet-b")},
+			},
+			want: want{
+				add:    []route53resolver.IpAddressUpdate{{SubnetId: aws.String("subnet-a"), Ip: aws.String("10.0.0.20")}},
+				remove: []route53resolver.IpAddressUpdate{{IpId: aws.String("rni-a"), Ip: aws.String("10.0.0.10"), SubnetId: aws.String("subnet-a")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffIPAddresses(tc.desired, observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsResolverRuleUpToDate(t *testing.T) {
+	params := v1alpha1.ResolverRuleParameters{
+		Name:               aws.String("corp"),
+		DomainName:         "corp.example.com",
+		RuleType:           "FORWARD",
+		ResolverEndpointID: aws.String("rslvr-out-1"),
+		TargetIPs:          []v1alpha1.TargetAddress{{IP: "192.168.0.2", Port: aws.Int64(53)}},
+	}
+	observed := route53resolver.ResolverRule{
+		Name:               aws.String("corp"),
+		DomainName:         aws.String("corp.example.com."),
+		ResolverEndpointId: aws.String("rslvr-out-1"),
+		TargetIps:          []route53resolver.TargetAddress{{Ip: aws.String("192.168.0.2"), Port: aws.Int64(53)}},
+	}
+
+	cases := map[string]struct {
+		params func(*v1alpha1.ResolverRuleParameters)
+		want   bool
+	}{
+		"UpToDate": {
+			params: func(*v1alpha1.ResolverRuleParameters) {},
+			want:   true,
+		},
+		"NameChanged": {
+			params: func(p *v1alpha1.ResolverRuleParameters) { p.Name = aws.String("other") },
+			want:   false,
+		},
+		"EndpointChanged": {
+			params: func(p *v1alpha1.ResolverRuleParameters) { p.ResolverEndpointID = aws.String("rslvr-out-2") },
+			want:   false,
+		},
+		"PortChanged": {
+			params: func(p *v1alpha1.ResolverRuleParameters) { p.TargetIPs[0].Port = aws.Int64(5353) },
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := *params.DeepCopy()
+			tc.params(&p)
+			if diff := cmp.Diff(tc.want, IsResolverRuleUpToDate(p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
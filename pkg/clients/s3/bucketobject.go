@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // nolint:gosec
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// HEAD requests have no body, so S3 returns this code instead of NoSuchKey
+// for missing objects.
+const errCodeNotFound = "NotFound"
+
+// BucketObjectClient is the external client used for BucketObject Custom
+// Resource
+type BucketObjectClient interface {
+	HeadObjectRequest(*s3.HeadObjectInput) s3.HeadObjectRequest
+	PutObjectRequest(*s3.PutObjectInput) s3.PutObjectRequest
+	DeleteObjectRequest(*s3.DeleteObjectInput) s3.DeleteObjectRequest
+}
+
+// NewBucketObjectClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewBucketObjectClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (BucketObjectClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return s3.New(*cfg), err
+}
+
+// IsObjectNotFound returns true if the error is because the object doesn't
+// exist.
+func IsObjectNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == errCodeNotFound || awsErr.Code() == s3.ErrCodeNoSuchKey
+	}
+	return false
+}
+
+// ContentMD5 returns the hex encoded MD5 digest of the supplied content.
+func ContentMD5(content []byte) string {
+	sum := md5.Sum(content) // nolint:gosec
+	return hex.EncodeToString(sum[:])
+}
+
+// GeneratePutObjectInput returns the input to upload the supplied content
+// with the supplied parameters.
+func GeneratePutObjectInput(p v1alpha1.BucketObjectParameters, content []byte) *s3.PutObjectInput {
+	sum := md5.Sum(content) // nolint:gosec
+	in := &s3.PutObjectInput{
+		Bucket:      p.Bucket,
+		Key:         aws.String(p.Key),
+		Body:        bytes.NewReader(content),
+		ContentMD5:  aws.String(base64.StdEncoding.EncodeToString(sum[:])),
+		ContentType: p.ContentType,
+		SSEKMSKeyId: p.SSEKMSKeyID,
+		Metadata:    p.Metadata,
+	}
+	if p.ACL != nil {
+		in.ACL = s3.ObjectCannedACL(*p.ACL)
+	}
+	if p.ServerSideEncryption != nil {
+		in.ServerSideEncryption = s3.ServerSideEncryption(*p.ServerSideEncryption)
+	}
+	return in
+}
+
+// LateInitializeBucketObject fills the empty fields in the supplied
+// parameters with the values observed on the object, e.g. the default
+// encryption of the bucket.
+func LateInitializeBucketObject(p *v1alpha1.BucketObjectParameters, o s3.HeadObjectOutput) {
+	p.ContentType = awsclients.LateInitializeStringPtr(p.ContentType, o.ContentType)
+	if p.ServerSideEncryption == nil && o.ServerSideEncryption != "" {
+		p.ServerSideEncryption = aws.String(string(o.ServerSideEncryption))
+	}
+	p.SSEKMSKeyID = awsclients.LateInitializeStringPtr(p.SSEKMSKeyID, o.SSEKMSKeyId)
+}
+
+// GenerateBucketObjectObservation returns the observation of the supplied
+// object. The MD5 digest of the last upload is kept from the supplied
+// observation.
+func GenerateBucketObjectObservation(last v1alpha1.BucketObjectObservation, o s3.HeadObjectOutput) v1alpha1.BucketObjectObservation {
+	return v1alpha1.BucketObjectObservation{
+		ETag:          aws.StringValue(o.ETag),
+		ContentMD5:    last.ContentMD5,
+		VersionID:     aws.StringValue(o.VersionId),
+		ContentLength: aws.Int64Value(o.ContentLength),
+	}
+}
+
+// IsBucketObjectUpToDate returns true if the supplied object matches the
+// desired parameters and content. The content matches if the ETag of the
+// object is its MD5 digest, or if the object was last uploaded with the same
+// content by the controller and has not changed since.
+func IsBucketObjectUpToDate(p v1alpha1.BucketObjectParameters, last v1alpha1.BucketObjectObservation, contentMD5 string, o s3.HeadObjectOutput) bool {
+	etag := aws.StringValue(o.ETag)
+	if strings.Trim(etag, `"`) != contentMD5 && (last.ContentMD5 != contentMD5 || last.ETag != etag) {
+		return false
+	}
+	if aws.StringValue(p.ContentType) != aws.StringValue(o.ContentType) {
+		return false
+	}
+	// S3 returns the keys of the user defined metadata in canonical header
+	// form, so they are compared case insensitively.
+	if len(p.Metadata) != len(o.Metadata) {
+		return false
+	}
+	observed := make(map[string]string, len(o.Metadata))
+	for k, v := range o.Metadata {
+		observed[strings.ToLower(k)] = v
+	}
+	for k, v := range p.Metadata {
+		if ov, ok := observed[strings.ToLower(k)]; !ok || ov != v {
+			return false
+		}
+	}
+	return true
+}
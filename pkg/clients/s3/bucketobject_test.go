@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+)
+
+func TestIsBucketObjectUpToDate(t *testing.T) {
+	content := ContentMD5([]byte("hello"))
+	etag := `"` + content + `"`
+
+	cases := map[string]struct {
+		p        v1alpha1.BucketObjectParameters
+		last     v1alpha1.BucketObjectObservation
+		observed s3.HeadObjectOutput
+		want     bool
+	}{
+		"SameContent": {
+			observed: s3.HeadObjectOutput{ETag: aws.String(etag)},
+			want:     true,
+		},
+		"ChangedContent": {
+			observed: s3.HeadObjectOutput{ETag: aws.String(`"other"`)},
+			want:     false,
+		},
+		"EncryptedContentUnchanged": {
+			last:     v1alpha1.BucketObjectObservation{ETag: `"kms"`, ContentMD5: content},
+			observed: s3.HeadObjectOutput{ETag: aws.String(`"kms"`)},
+			want:     true,
+		},
+		"EncryptedContentReplaced": {
+			last:     v1alpha1.BucketObjectObservation{ETag: `"kms"`, ContentMD5: content},
+			observed: s3.HeadObjectOutput{ETag: aws.String(`"replaced"`)},
+			want:     false,
+		},
+		"ChangedContentType": {
+			p:        v1alpha1.BucketObjectParameters{ContentType: aws.String("text/plain")},
+			observed: s3.HeadObjectOutput{ETag: aws.String(etag), ContentType: aws.String("binary/octet-stream")},
+			want:     false,
+		},
+		"MetadataCase": {
+			p:        v1alpha1.BucketObjectParameters{Metadata: map[string]string{"owner": "team"}},
+			observed: s3.HeadObjectOutput{ETag: aws.String(etag), Metadata: map[string]string{"Owner": "team"}},
+			want:     true,
+		},
+		"ChangedMetadata": {
+			p:        v1alpha1.BucketObjectParameters{Metadata: map[string]string{"owner": "team"}},
+			observed: s3.HeadObjectOutput{ETag: aws.String(etag), Metadata: map[string]string{"Owner": "other"}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsBucketObjectUpToDate(tc.p, tc.last, content, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
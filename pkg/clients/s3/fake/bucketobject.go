@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BucketObjectClient = (*MockBucketObjectClient)(nil)
+
+// MockBucketObjectClient is a type that implements all the methods for BucketObjectClient interface
+type MockBucketObjectClient struct {
+	MockHeadObject   func(*s3.HeadObjectInput) s3.HeadObjectRequest
+	MockPutObject    func(*s3.PutObjectInput) s3.PutObjectRequest
+	MockDeleteObject func(*s3.DeleteObjectInput) s3.DeleteObjectRequest
+}
+
+// HeadObjectRequest calls the underlying MockHeadObject method.
+func (c *MockBucketObjectClient) HeadObjectRequest(i *s3.HeadObjectInput) s3.HeadObjectRequest {
+	return c.MockHeadObject(i)
+}
+
+// PutObjectRequest calls the underlying MockPutObject method.
+func (c *MockBucketObjectClient) PutObjectRequest(i *s3.PutObjectInput) s3.PutObjectRequest {
+	return c.MockPutObject(i)
+}
+
+// DeleteObjectRequest calls the underlying MockDeleteObject method.
+func (c *MockBucketObjectClient) DeleteObjectRequest(i *s3.DeleteObjectInput) s3.DeleteObjectRequest {
+	return c.MockDeleteObject(i)
+}
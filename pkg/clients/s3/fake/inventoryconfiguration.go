@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.InventoryConfigurationClient = (*MockInventoryConfigurationClient)(nil)
+
+// MockInventoryConfigurationClient is a type that implements all the methods for InventoryConfigurationClient interface
+type MockInventoryConfigurationClient struct {
+	MockGetBucketInventoryConfiguration    func(*s3.GetBucketInventoryConfigurationInput) s3.GetBucketInventoryConfigurationRequest
+	MockPutBucketInventoryConfiguration    func(*s3.PutBucketInventoryConfigurationInput) s3.PutBucketInventoryConfigurationRequest
+	MockDeleteBucketInventoryConfiguration func(*s3.DeleteBucketInventoryConfigurationInput) s3.DeleteBucketInventoryConfigurationRequest
+}
+
+// GetBucketInventoryConfigurationRequest calls the underlying MockGetBucketInventoryConfiguration method.
+func (c *MockInventoryConfigurationClient) GetBucketInventoryConfigurationRequest(i *s3.GetBucketInventoryConfigurationInput) s3.GetBucketInventoryConfigurationRequest {
+	return c.MockGetBucketInventoryConfiguration(i)
+}
+
+// PutBucketInventoryConfigurationRequest calls the underlying MockPutBucketInventoryConfiguration method.
+func (c *MockInventoryConfigurationClient) PutBucketInventoryConfigurationRequest(i *s3.PutBucketInventoryConfigurationInput) s3.PutBucketInventoryConfigurationRequest {
+	return c.MockPutBucketInventoryConfiguration(i)
+}
+
+// DeleteBucketInventoryConfigurationRequest calls the underlying MockDeleteBucketInventoryConfiguration method.
+func (c *MockInventoryConfigurationClient) DeleteBucketInventoryConfigurationRequest(i *s3.DeleteBucketInventoryConfigurationInput) s3.DeleteBucketInventoryConfigurationRequest {
+	return c.MockDeleteBucketInventoryConfiguration(i)
+}
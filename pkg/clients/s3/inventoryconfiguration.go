@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// S3 returns this code for missing bucket configurations. It is not
+	// part of the service model of the SDK.
+	errCodeNoSuchConfiguration = "NoSuchConfiguration"
+
+	inventoryEncryptionSSES3  = "SSE-S3"
+	inventoryEncryptionSSEKMS = "SSE-KMS"
+)
+
+// InventoryConfigurationClient is the external client used for
+// InventoryConfiguration Custom Resource
+type InventoryConfigurationClient interface {
+	GetBucketInventoryConfigurationRequest(*s3.GetBucketInventoryConfigurationInput) s3.GetBucketInventoryConfigurationRequest
+	PutBucketInventoryConfigurationRequest(*s3.PutBucketInventoryConfigurationInput) s3.PutBucketInventoryConfigurationRequest
+	DeleteBucketInventoryConfigurationRequest(*s3.DeleteBucketInventoryConfigurationInput) s3.DeleteBucketInventoryConfigurationRequest
+}
+
+// NewInventoryConfigurationClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewInventoryConfigurationClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (InventoryConfigurationClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return s3.New(*cfg), err
+}
+
+// IsInventoryConfigurationNotFound returns true if the error is because the
+// inventory configuration or its bucket doesn't exist.
+func IsInventoryConfigurationNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == errCodeNoSuchConfiguration || awsErr.Code() == s3.ErrCodeNoSuchBucket
+	}
+	return false
+}
+
+// GenerateInventoryConfiguration returns the inventory configuration with the
+// supplied ID and parameters, referring to the destination bucket in the
+// supplied partition.
+func GenerateInventoryConfiguration(id, partition string, p v1alpha1.InventoryConfigurationParameters) *s3.InventoryConfiguration {
+	d := &s3.InventoryS3BucketDestination{
+		AccountId: p.Destination.AccountID,
+		Bucket:    aws.String(awsclients.S3BucketARN(partition, aws.StringValue(p.Destination.Bucket))),
+		Format:    s3.InventoryFormat(p.Destination.Format),
+		Prefix:    p.Destination.Prefix,
+	}
+	if e := p.Destination.Encryption; e != nil {
+		d.Encryption = &s3.InventoryEncryption{}
+		switch e.Type {
+		case inventoryEncryptionSSES3:
+			d.Encryption.SSES3 = &s3.SSES3{}
+		case inventoryEncryptionSSEKMS:
+			d.Encryption.SSEKMS = &s3.SSEKMS{KeyId: e.KMSKeyID}
+		}
+	}
+	c := &s3.InventoryConfiguration{
+		Id:                     aws.String(id),
+		Destination:            &s3.InventoryDestination{S3BucketDestination: d},
+		IncludedObjectVersions: s3.InventoryIncludedObjectVersions(p.IncludedObjectVersions),
+		IsEnabled:              aws.Bool(p.IsEnabled),
+		Schedule:               &s3.InventorySchedule{Frequency: s3.InventoryFrequency(p.Schedule.Frequency)},
+	}
+	if p.Prefix != nil {
+		c.Filter = &s3.InventoryFilter{Prefix: p.Prefix}
+	}
+	for _, f := range p.OptionalFields {
+		c.OptionalFields = append(c.OptionalFields, s3.InventoryOptionalField(f))
+	}
+	return c
+}
+
+// generateInventoryConfigurationParameters returns the parameters of the
+// supplied inventory configuration. The references and selectors are copied
+// from the supplied parameters.
+func generateInventoryConfigurationParameters(p v1alpha1.InventoryConfigurationParameters, c s3.InventoryConfiguration) v1alpha1.InventoryConfigurationParameters {
+	o := v1alpha1.InventoryConfigurationParameters{
+		Bucket:                 p.Bucket,
+		BucketRef:              p.BucketRef,
+		BucketSelector:         p.BucketSelector,
+		IncludedObjectVersions: string(c.IncludedObjectVersions),
+		IsEnabled:              aws.BoolValue(c.IsEnabled),
+		Destination: v1alpha1.InventoryDestination{
+			BucketRef:      p.Destination.BucketRef,
+			BucketSelector: p.Destination.BucketSelector,
+		},
+	}
+	if c.Filter != nil {
+		o.Prefix = c.Filter.Prefix
+	}
+	if c.Schedule != nil {
+		o.Schedule.Frequency = string(c.Schedule.Frequency)
+	}
+	for _, f := range c.OptionalFields {
+		o.OptionalFields = append(o.OptionalFields, string(f))
+	}
+	if c.Destination == nil || c.Destination.S3BucketDestination == nil {
+		return o
+	}
+	d := c.Destination.S3BucketDestination
+	// The destination is returned as an ARN, e.g. arn:aws:s3:::bucket.
+	// Bucket names cannot contain colons.
+	arn := aws.StringValue(d.Bucket)
+	o.Destination.Bucket = aws.String(arn[strings.LastIndex(arn, ":")+1:])
+	o.Destination.AccountID = d.AccountId
+	o.Destination.Format = string(d.Format)
+	o.Destination.Prefix = d.Prefix
+	if e := d.Encryption; e != nil {
+		switch {
+		case e.SSES3 != nil:
+			o.Destination.Encryption = &v1alpha1.InventoryEncryption{Type: inventoryEncryptionSSES3}
+		case e.SSEKMS != nil:
+			o.Destination.Encryption = &v1alpha1.InventoryEncryption{Type: inventoryEncryptionSSEKMS, KMSKeyID: e.SSEKMS.KeyId}
+		}
+	}
+	return o
+}
+
+// IsInventoryConfigurationUpToDate returns true if the supplied inventory
+// configuration matches the desired parameters.
+func IsInventoryConfigurationUpToDate(p v1alpha1.InventoryConfigurationParameters, c s3.InventoryConfiguration) bool {
+	o := generateInventoryConfigurationParameters(p, c)
+	return cmp.Equal(p, o, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.IgnoreTypes(&runtimev1alpha1.Reference{}, &runtimev1alpha1.Selector{}))
+}
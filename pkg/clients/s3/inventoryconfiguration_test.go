@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func inventoryParameters(m ...func(*v1alpha1.InventoryConfigurationParameters)) v1alpha1.InventoryConfigurationParameters {
+	p := v1alpha1.InventoryConfigurationParameters{
+		Bucket:    aws.String("logs"),
+		BucketRef: &runtimev1alpha1.Reference{Name: "logs"},
+		Destination: v1alpha1.InventoryDestination{
+			Bucket:     aws.String("reports"),
+			Format:     "Parquet",
+			Prefix:     aws.String("inventory"),
+			Encryption: &v1alpha1.InventoryEncryption{Type: "SSE-KMS", KMSKeyID: aws.String("key")},
+		},
+		Prefix:                 aws.String("data/"),
+		IncludedObjectVersions: "All",
+		IsEnabled:              true,
+		OptionalFields:         []string{"Size", "StorageClass"},
+		Schedule:               v1alpha1.InventorySchedule{Frequency: "Weekly"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func TestGenerateInventoryConfiguration(t *testing.T) {
+	want := &s3.InventoryConfiguration{
+		Id: aws.String("weekly"),
+		Destination: &s3.InventoryDestination{S3BucketDestination: &s3.InventoryS3BucketDestination{
+			Bucket:     aws.String("arn:aws:s3:::reports"),
+			Format:     s3.InventoryFormatParquet,
+			Prefix:     aws.String("inventory"),
+			Encryption: &s3.InventoryEncryption{SSEKMS: &s3.SSEKMS{KeyId: aws.String("key")}},
+		}},
+		Filter:                 &s3.InventoryFilter{Prefix: aws.String("data/")},
+		IncludedObjectVersions: s3.InventoryIncludedObjectVersionsAll,
+		IsEnabled:              aws.Bool(true),
+		OptionalFields:         []s3.InventoryOptionalField{s3.InventoryOptionalFieldSize, s3.InventoryOptionalFieldStorageClass},
+		Schedule:               &s3.InventorySchedule{Frequency: s3.InventoryFrequencyWeekly},
+	}
+	got := GenerateInventoryConfiguration("weekly", awsclients.PartitionAWS, inventoryParameters())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsInventoryConfigurationUpToDate(t *testing.T) {
+	observed := *GenerateInventoryConfiguration("weekly", awsclients.PartitionAWS, inventoryParameters())
+
+	cases := map[string]struct {
+		p    v1alpha1.InventoryConfigurationParameters
+		c    s3.InventoryConfiguration
+		want bool
+	}{
+		"UpToDate": {
+			p:    inventoryParameters(),
+			c:    observed,
+			want: true,
+		},
+		"OptionalFieldsOrder": {
+			p: inventoryParameters(func(p *v1alpha1.InventoryConfigurationParameters) {
+				p.OptionalFields = []string{"StorageClass", "Size"}
+			}),
+			c:    observed,
+			want: true,
+		},
+		"ChangedDestination": {
+			p: inventoryParameters(func(p *v1alpha1.InventoryConfigurationParameters) {
+				p.Destination.Bucket = aws.String("other")
+			}),
+			c:    observed,
+			want: false,
+		},
+		"RemovedEncryption": {
+			p: inventoryParameters(func(p *v1alpha1.InventoryConfigurationParameters) {
+				p.Destination.Encryption = nil
+			}),
+			c:    observed,
+			want: false,
+		},
+		"Disabled": {
+			p: inventoryParameters(func(p *v1alpha1.InventoryConfigurationParameters) {
+				p.IsEnabled = false
+			}),
+			c:    observed,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInventoryConfigurationUpToDate(tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -32,13 +32,13 @@ import (
 	storage "github.com/crossplane/crossplane/apis/storage/v1alpha1"
 
 	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 	iamc "github.com/crossplane/provider-aws/pkg/clients/iam"
 	"github.com/crossplane/provider-aws/pkg/clients/s3/operations"
 )
 
 const (
 	bucketUser           = "crossplane-bucket-%s"
-	bucketObjectARN      = "arn:aws:s3:::%s"
 	maxIAMUsernameLength = 64
 	// https://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region
 	regionWithNoConstraint = "us-east-1"
@@ -230,7 +230,11 @@ func GenerateBucketUsername(bucket *v1alpha3.S3Bucket) string {
 }
 
 func newPolicyDocument(bucket *v1alpha3.S3Bucket) (string, error) {
-	bucketARN := fmt.Sprintf(bucketObjectARN, meta.GetExternalName(bucket))
+	partition, err := awsclients.PartitionForRegion(bucket.Spec.Region)
+	if err != nil {
+		return "", err
+	}
+	bucketARN := awsclients.S3BucketARN(partition, meta.GetExternalName(bucket))
 	read := iamc.StatementEntry{
 		Sid:    "crossplaneRead",
 		Effect: "Allow",
@@ -172,7 +172,7 @@ func TestClient_CreateUser(t *testing.T) {
 		ret             []types.GomegaMatcher
 	}{
 		"HappyPath": {
-			s3Bucket:        &awsstorage.S3Bucket{},
+			s3Bucket:        &awsstorage.S3Bucket{Spec: awsstorage.S3BucketSpec{S3BucketParameters: awsstorage.S3BucketParameters{Region: regionWithNoConstraint}}},
 			createUserRet:   []interface{}{key, nil},
 			createPolicyRet: []interface{}{version, nil},
 			ret:             []types.GomegaMatcher{gomega.Equal(key), gomega.Equal(version), gomega.BeNil()},
@@ -181,6 +181,7 @@ func TestClient_CreateUser(t *testing.T) {
 			s3Bucket: &awsstorage.S3Bucket{
 				Spec: awsstorage.S3BucketSpec{
 					S3BucketParameters: awsstorage.S3BucketParameters{
+						Region:          regionWithNoConstraint,
 						LocalPermission: &fakePerm,
 					},
 				},
@@ -190,13 +191,13 @@ func TestClient_CreateUser(t *testing.T) {
 			ret:             []types.GomegaMatcher{gomega.BeNil(), gomega.Equal(""), gomega.Equal(errors.New("could not update policy, unknown permission, fake"))},
 		},
 		"IAMCreateUserError": {
-			s3Bucket:        &awsstorage.S3Bucket{},
+			s3Bucket:        &awsstorage.S3Bucket{Spec: awsstorage.S3BucketSpec{S3BucketParameters: awsstorage.S3BucketParameters{Region: regionWithNoConstraint}}},
 			createUserRet:   []interface{}{nil, boom},
 			createPolicyRet: []interface{}{version, nil},
 			ret:             []types.GomegaMatcher{gomega.BeNil(), gomega.Equal(""), gomega.Equal(errors.New("could not create user boom"))},
 		},
 		"IAMCreatePolicyError": {
-			s3Bucket:        &awsstorage.S3Bucket{},
+			s3Bucket:        &awsstorage.S3Bucket{Spec: awsstorage.S3BucketSpec{S3BucketParameters: awsstorage.S3BucketParameters{Region: regionWithNoConstraint}}},
 			createUserRet:   []interface{}{key, nil},
 			createPolicyRet: []interface{}{"", boom},
 			ret:             []types.GomegaMatcher{gomega.BeNil(), gomega.Equal(""), gomega.Equal(errors.New("could not create policy boom"))},
@@ -339,7 +340,7 @@ func TestClient_UpdatePolicyDocument(t *testing.T) {
 		ret       []types.GomegaMatcher
 	}{
 		"HappyPath": {
-			bucket:    &awsstorage.S3Bucket{},
+			bucket:    &awsstorage.S3Bucket{Spec: awsstorage.S3BucketSpec{S3BucketParameters: awsstorage.S3BucketParameters{Region: regionWithNoConstraint}}},
 			updateRet: []interface{}{ver, nil},
 			ret:       []types.GomegaMatcher{gomega.Equal(ver), gomega.BeNil()},
 		},
@@ -347,6 +348,7 @@ func TestClient_UpdatePolicyDocument(t *testing.T) {
 			bucket: &awsstorage.S3Bucket{
 				Spec: awsstorage.S3BucketSpec{
 					S3BucketParameters: awsstorage.S3BucketParameters{
+						Region:          regionWithNoConstraint,
 						LocalPermission: &fakePerm,
 					},
 				},
@@ -354,8 +356,13 @@ func TestClient_UpdatePolicyDocument(t *testing.T) {
 			updateRet: []interface{}{ver, nil},
 			ret:       []types.GomegaMatcher{gomega.Equal(""), gomega.Equal(errors.New("could not generate policy, unknown permission, fake"))},
 		},
+		"InvalidRegion": {
+			bucket:    &awsstorage.S3Bucket{Spec: awsstorage.S3BucketSpec{S3BucketParameters: awsstorage.S3BucketParameters{Region: "moon-central-1"}}},
+			updateRet: []interface{}{ver, nil},
+			ret:       []types.GomegaMatcher{gomega.Equal(""), gomega.MatchError(`could not generate policy, region "moon-central-1" does not belong to any known partition`)},
+		},
 		"IAMUpdateError": {
-			bucket:    &awsstorage.S3Bucket{},
+			bucket:    &awsstorage.S3Bucket{Spec: awsstorage.S3BucketSpec{S3BucketParameters: awsstorage.S3BucketParameters{Region: regionWithNoConstraint}}},
 			updateRet: []interface{}{"", boom},
 			ret:       []types.GomegaMatcher{gomega.Equal(""), gomega.Equal(errors.New("could not update policy, boom"))},
 		},
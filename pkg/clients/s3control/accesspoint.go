@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// The S3 Control API returns these codes for missing access points and
+// policies. They are not part of the service model of the SDK.
+const (
+	errCodeNoSuchAccessPoint       = "NoSuchAccessPoint"
+	errCodeNoSuchAccessPointPolicy = "NoSuchAccessPointPolicy"
+)
+
+// AccessPointClient is the external client used for AccessPoint Custom
+// Resource
+type AccessPointClient interface {
+	CreateAccessPointRequest(*s3control.CreateAccessPointInput) s3control.CreateAccessPointRequest
+	GetAccessPointRequest(*s3control.GetAccessPointInput) s3control.GetAccessPointRequest
+	DeleteAccessPointRequest(*s3control.DeleteAccessPointInput) s3control.DeleteAccessPointRequest
+	GetAccessPointPolicyRequest(*s3control.GetAccessPointPolicyInput) s3control.GetAccessPointPolicyRequest
+	PutAccessPointPolicyRequest(*s3control.PutAccessPointPolicyInput) s3control.PutAccessPointPolicyRequest
+	DeleteAccessPointPolicyRequest(*s3control.DeleteAccessPointPolicyInput) s3control.DeleteAccessPointPolicyRequest
+}
+
+// NewAccessPointClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewAccessPointClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (AccessPointClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return s3control.New(*cfg), err
+}
+
+// IsAccessPointNotFound returns true if the error is because the access
+// point doesn't exist.
+func IsAccessPointNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == errCodeNoSuchAccessPoint
+	}
+	return false
+}
+
+// IsAccessPointPolicyNotFound returns true if the error is because the access
+// point has no policy.
+func IsAccessPointPolicyNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == errCodeNoSuchAccessPointPolicy
+	}
+	return false
+}
+
+// GenerateCreateAccessPointInput returns the input to create an access point
+// with the supplied name and parameters.
+func GenerateCreateAccessPointInput(name string, p v1alpha1.AccessPointParameters) *s3control.CreateAccessPointInput {
+	in := &s3control.CreateAccessPointInput{
+		AccountId: aws.String(p.AccountID),
+		Bucket:    p.Bucket,
+		Name:      aws.String(name),
+	}
+	if p.VPCID != nil {
+		in.VpcConfiguration = &s3control.VpcConfiguration{VpcId: p.VPCID}
+	}
+	if c := p.PublicAccessBlockConfiguration; c != nil {
+		in.PublicAccessBlockConfiguration = &s3control.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       c.BlockPublicACLs,
+			IgnorePublicAcls:      c.IgnorePublicACLs,
+			BlockPublicPolicy:     c.BlockPublicPolicy,
+			RestrictPublicBuckets: c.RestrictPublicBuckets,
+		}
+	}
+	return in
+}
+
+// LateInitializeAccessPoint fills the empty fields in the supplied parameters
+// with the values observed on the access point.
+func LateInitializeAccessPoint(p *v1alpha1.AccessPointParameters, ap s3control.GetAccessPointOutput) {
+	if c := ap.PublicAccessBlockConfiguration; c != nil {
+		if p.PublicAccessBlockConfiguration == nil {
+			p.PublicAccessBlockConfiguration = &v1alpha1.PublicAccessBlockConfiguration{}
+		}
+		pc := p.PublicAccessBlockConfiguration
+		pc.BlockPublicACLs = awsclients.LateInitializeBoolPtr(pc.BlockPublicACLs, c.BlockPublicAcls)
+		pc.IgnorePublicACLs = awsclients.LateInitializeBoolPtr(pc.IgnorePublicACLs, c.IgnorePublicAcls)
+		pc.BlockPublicPolicy = awsclients.LateInitializeBoolPtr(pc.BlockPublicPolicy, c.BlockPublicPolicy)
+		pc.RestrictPublicBuckets = awsclients.LateInitializeBoolPtr(pc.RestrictPublicBuckets, c.RestrictPublicBuckets)
+	}
+}
+
+// IsAccessPointPolicyUpToDate returns true if the supplied policy matches the
+// desired one. Both policies are compacted before they are compared.
+func IsAccessPointPolicyUpToDate(desired, observed *string) (bool, error) {
+	if desired == nil || observed == nil {
+		return desired == nil && observed == nil, nil
+	}
+	d, err := awsclients.CompactAndEscapeJSON(*desired)
+	if err != nil {
+		return false, err
+	}
+	o, err := awsclients.CompactAndEscapeJSON(*observed)
+	if err != nil {
+		return false, err
+	}
+	return d == o, nil
+}
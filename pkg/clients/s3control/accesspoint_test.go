@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsAccessPointPolicyUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      bool
+	}
+
+	cases := map[string]struct {
+		desired  *string
+		observed *string
+		want     want
+	}{
+		"BothEmpty": {
+			want: want{upToDate: true},
+		},
+		"Formatting": {
+			desired:  aws.String(`{"Version": "2012-10-17",  "Statement": []}`),
+			observed: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+			want:     want{upToDate: true},
+		},
+		"Changed": {
+			desired:  aws.String(`{"Version":"2012-10-17","Statement":[{}]}`),
+			observed: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+			want:     want{upToDate: false},
+		},
+		"Missing": {
+			desired: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+			want:    want{upToDate: false},
+		},
+		"InvalidJSON": {
+			desired:  aws.String(`{`),
+			observed: aws.String(`{}`),
+			want:     want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsAccessPointPolicyUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, want{upToDate: got, err: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
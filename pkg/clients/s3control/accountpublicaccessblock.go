@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AccountPublicAccessBlockClient is the external client used for
+// AccountPublicAccessBlock Custom Resource
+type AccountPublicAccessBlockClient interface {
+	GetPublicAccessBlockRequest(*s3control.GetPublicAccessBlockInput) s3control.GetPublicAccessBlockRequest
+	PutPublicAccessBlockRequest(*s3control.PutPublicAccessBlockInput) s3control.PutPublicAccessBlockRequest
+	DeletePublicAccessBlockRequest(*s3control.DeletePublicAccessBlockInput) s3control.DeletePublicAccessBlockRequest
+}
+
+// NewAccountPublicAccessBlockClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewAccountPublicAccessBlockClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (AccountPublicAccessBlockClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return s3control.New(*cfg), err
+}
+
+// IsPublicAccessBlockNotFound returns true if the error is because the account
+// has no public access block.
+func IsPublicAccessBlockNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == s3control.ErrCodeNoSuchPublicAccessBlockConfiguration
+	}
+	return false
+}
+
+// GeneratePutPublicAccessBlockInput returns the input to put the public
+// access block of an account from the supplied parameters.
+func GeneratePutPublicAccessBlockInput(p v1alpha1.AccountPublicAccessBlockParameters) *s3control.PutPublicAccessBlockInput {
+	c := p.PublicAccessBlockConfiguration
+	return &s3control.PutPublicAccessBlockInput{
+		AccountId: aws.String(p.AccountID),
+		PublicAccessBlockConfiguration: &s3control.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       c.BlockPublicACLs,
+			IgnorePublicAcls:      c.IgnorePublicACLs,
+			BlockPublicPolicy:     c.BlockPublicPolicy,
+			RestrictPublicBuckets: c.RestrictPublicBuckets,
+		},
+	}
+}
+
+// LateInitializeAccountPublicAccessBlock fills the empty fields in the
+// supplied parameters with the values observed on the account.
+func LateInitializeAccountPublicAccessBlock(p *v1alpha1.AccountPublicAccessBlockParameters, c *s3control.PublicAccessBlockConfiguration) {
+	if c == nil {
+		return
+	}
+	pc := &p.PublicAccessBlockConfiguration
+	pc.BlockPublicACLs = awsclients.LateInitializeBoolPtr(pc.BlockPublicACLs, c.BlockPublicAcls)
+	pc.IgnorePublicACLs = awsclients.LateInitializeBoolPtr(pc.IgnorePublicACLs, c.IgnorePublicAcls)
+	pc.BlockPublicPolicy = awsclients.LateInitializeBoolPtr(pc.BlockPublicPolicy, c.BlockPublicPolicy)
+	pc.RestrictPublicBuckets = awsclients.LateInitializeBoolPtr(pc.RestrictPublicBuckets, c.RestrictPublicBuckets)
+}
+
+// IsAccountPublicAccessBlockUpToDate returns true if the observed public
+// access block of the account matches the desired one.
+func IsAccountPublicAccessBlockUpToDate(p v1alpha1.AccountPublicAccessBlockParameters, c *s3control.PublicAccessBlockConfiguration) bool {
+	if c == nil {
+		return false
+	}
+	pc := p.PublicAccessBlockConfiguration
+	return aws.BoolValue(pc.BlockPublicACLs) == aws.BoolValue(c.BlockPublicAcls) &&
+		aws.BoolValue(pc.IgnorePublicACLs) == aws.BoolValue(c.IgnorePublicAcls) &&
+		aws.BoolValue(pc.BlockPublicPolicy) == aws.BoolValue(c.BlockPublicPolicy) &&
+		aws.BoolValue(pc.RestrictPublicBuckets) == aws.BoolValue(c.RestrictPublicBuckets)
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+)
+
+func TestIsAccountPublicAccessBlockUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  v1alpha1.PublicAccessBlockConfiguration
+		observed *s3control.PublicAccessBlockConfiguration
+		want     bool
+	}{
+		"Missing": {
+			want: false,
+		},
+		"UnsetIsFalse": {
+			desired:  v1alpha1.PublicAccessBlockConfiguration{BlockPublicACLs: aws.Bool(true)},
+			observed: &s3control.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true), IgnorePublicAcls: aws.Bool(false)},
+			want:     true,
+		},
+		"Changed": {
+			desired:  v1alpha1.PublicAccessBlockConfiguration{BlockPublicPolicy: aws.Bool(true)},
+			observed: &s3control.PublicAccessBlockConfiguration{BlockPublicPolicy: aws.Bool(false)},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.AccountPublicAccessBlockParameters{PublicAccessBlockConfiguration: tc.desired}
+			if diff := cmp.Diff(tc.want, IsAccountPublicAccessBlockUpToDate(p, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
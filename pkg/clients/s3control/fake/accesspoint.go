@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3control"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccessPointClient = (*MockAccessPointClient)(nil)
+
+// MockAccessPointClient is a type that implements all the methods for AccessPointClient interface
+type MockAccessPointClient struct {
+	MockCreateAccessPoint       func(*s3control.CreateAccessPointInput) s3control.CreateAccessPointRequest
+	MockGetAccessPoint          func(*s3control.GetAccessPointInput) s3control.GetAccessPointRequest
+	MockDeleteAccessPoint       func(*s3control.DeleteAccessPointInput) s3control.DeleteAccessPointRequest
+	MockGetAccessPointPolicy    func(*s3control.GetAccessPointPolicyInput) s3control.GetAccessPointPolicyRequest
+	MockPutAccessPointPolicy    func(*s3control.PutAccessPointPolicyInput) s3control.PutAccessPointPolicyRequest
+	MockDeleteAccessPointPolicy func(*s3control.DeleteAccessPointPolicyInput) s3control.DeleteAccessPointPolicyRequest
+}
+
+// CreateAccessPointRequest calls the underlying MockCreateAccessPoint method.
+func (c *MockAccessPointClient) CreateAccessPointRequest(i *s3control.CreateAccessPointInput) s3control.CreateAccessPointRequest {
+	return c.MockCreateAccessPoint(i)
+}
+
+// GetAccessPointRequest calls the underlying MockGetAccessPoint method.
+func (c *MockAccessPointClient) GetAccessPointRequest(i *s3control.GetAccessPointInput) s3control.GetAccessPointRequest {
+	return c.MockGetAccessPoint(i)
+}
+
+// DeleteAccessPointRequest calls the underlying MockDeleteAccessPoint method.
+func (c *MockAccessPointClient) DeleteAccessPointRequest(i *s3control.DeleteAccessPointInput) s3control.DeleteAccessPointRequest {
+	return c.MockDeleteAccessPoint(i)
+}
+
+// GetAccessPointPolicyRequest calls the underlying MockGetAccessPointPolicy method.
+func (c *MockAccessPointClient) GetAccessPointPolicyRequest(i *s3control.GetAccessPointPolicyInput) s3control.GetAccessPointPolicyRequest {
+	return c.MockGetAccessPointPolicy(i)
+}
+
+// PutAccessPointPolicyRequest calls the underlying MockPutAccessPointPolicy method.
+func (c *MockAccessPointClient) PutAccessPointPolicyRequest(i *s3control.PutAccessPointPolicyInput) s3control.PutAccessPointPolicyRequest {
+	return c.MockPutAccessPointPolicy(i)
+}
+
+// DeleteAccessPointPolicyRequest calls the underlying MockDeleteAccessPointPolicy method.
+func (c *MockAccessPointClient) DeleteAccessPointPolicyRequest(i *s3control.DeleteAccessPointPolicyInput) s3control.DeleteAccessPointPolicyRequest {
+	return c.MockDeleteAccessPointPolicy(i)
+}
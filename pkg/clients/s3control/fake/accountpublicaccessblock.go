@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3control"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccountPublicAccessBlockClient = (*MockAccountPublicAccessBlockClient)(nil)
+
+// MockAccountPublicAccessBlockClient is a type that implements all the methods for AccountPublicAccessBlockClient interface
+type MockAccountPublicAccessBlockClient struct {
+	MockGetPublicAccessBlock    func(*s3control.GetPublicAccessBlockInput) s3control.GetPublicAccessBlockRequest
+	MockPutPublicAccessBlock    func(*s3control.PutPublicAccessBlockInput) s3control.PutPublicAccessBlockRequest
+	MockDeletePublicAccessBlock func(*s3control.DeletePublicAccessBlockInput) s3control.DeletePublicAccessBlockRequest
+}
+
+// GetPublicAccessBlockRequest calls the underlying MockGetPublicAccessBlock method.
+func (c *MockAccountPublicAccessBlockClient) GetPublicAccessBlockRequest(i *s3control.GetPublicAccessBlockInput) s3control.GetPublicAccessBlockRequest {
+	return c.MockGetPublicAccessBlock(i)
+}
+
+// PutPublicAccessBlockRequest calls the underlying MockPutPublicAccessBlock method.
+func (c *MockAccountPublicAccessBlockClient) PutPublicAccessBlockRequest(i *s3control.PutPublicAccessBlockInput) s3control.PutPublicAccessBlockRequest {
+	return c.MockPutPublicAccessBlock(i)
+}
+
+// DeletePublicAccessBlockRequest calls the underlying MockDeletePublicAccessBlock method.
+func (c *MockAccountPublicAccessBlockClient) DeletePublicAccessBlockRequest(i *s3control.DeletePublicAccessBlockInput) s3control.DeletePublicAccessBlockRequest {
+	return c.MockDeletePublicAccessBlock(i)
+}
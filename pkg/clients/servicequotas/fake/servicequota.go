@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/servicequotas"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ServiceQuotaClient = (*MockServiceQuotaClient)(nil)
+
+// MockServiceQuotaClient is a type that implements all the methods for ServiceQuotaClient interface
+type MockServiceQuotaClient struct {
+	MockGetServiceQuota                func(*servicequotas.GetServiceQuotaInput) servicequotas.GetServiceQuotaRequest
+	MockGetRequestedServiceQuotaChange func(*servicequotas.GetRequestedServiceQuotaChangeInput) servicequotas.GetRequestedServiceQuotaChangeRequest
+	MockRequestServiceQuotaIncrease    func(*servicequotas.RequestServiceQuotaIncreaseInput) servicequotas.RequestServiceQuotaIncreaseRequest
+}
+
+// GetServiceQuotaRequest calls the underlying MockGetServiceQuota method.
+func (c *MockServiceQuotaClient) GetServiceQuotaRequest(i *servicequotas.GetServiceQuotaInput) servicequotas.GetServiceQuotaRequest {
+	return c.MockGetServiceQuota(i)
+}
+
+// GetRequestedServiceQuotaChangeRequest calls the underlying MockGetRequestedServiceQuotaChange method.
+func (c *MockServiceQuotaClient) GetRequestedServiceQuotaChangeRequest(i *servicequotas.GetRequestedServiceQuotaChangeInput) servicequotas.GetRequestedServiceQuotaChangeRequest {
+	return c.MockGetRequestedServiceQuotaChange(i)
+}
+
+// RequestServiceQuotaIncreaseRequest calls the underlying MockRequestServiceQuotaIncrease method.
+func (c *MockServiceQuotaClient) RequestServiceQuotaIncreaseRequest(i *servicequotas.RequestServiceQuotaIncreaseInput) servicequotas.RequestServiceQuotaIncreaseRequest {
+	return c.MockRequestServiceQuotaIncrease(i)
+}
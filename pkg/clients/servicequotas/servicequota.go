@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+
+	"github.com/crossplane/provider-aws/apis/servicequotas/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ServiceQuotaClient is the external client used for ServiceQuota Custom
+// Resource
+type ServiceQuotaClient interface {
+	GetServiceQuotaRequest(*servicequotas.GetServiceQuotaInput) servicequotas.GetServiceQuotaRequest
+	GetRequestedServiceQuotaChangeRequest(*servicequotas.GetRequestedServiceQuotaChangeInput) servicequotas.GetRequestedServiceQuotaChangeRequest
+	RequestServiceQuotaIncreaseRequest(*servicequotas.RequestServiceQuotaIncreaseInput) servicequotas.RequestServiceQuotaIncreaseRequest
+}
+
+// NewServiceQuotaClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewServiceQuotaClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ServiceQuotaClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return servicequotas.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the quota or the increase
+// request doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == servicequotas.ErrCodeNoSuchResourceException
+	}
+	return false
+}
+
+// IsRequestPending returns true if AWS has not decided on the increase
+// request yet.
+func IsRequestPending(r *servicequotas.RequestedServiceQuotaChange) bool {
+	return r != nil && (r.Status == servicequotas.RequestStatusPending || r.Status == servicequotas.RequestStatusCaseOpened)
+}
+
+// GenerateRequestServiceQuotaIncreaseInput returns the input to request an
+// increase of the quota to the desired value.
+func GenerateRequestServiceQuotaIncreaseInput(p v1alpha1.ServiceQuotaParameters) *servicequotas.RequestServiceQuotaIncreaseInput {
+	return &servicequotas.RequestServiceQuotaIncreaseInput{
+		ServiceCode:  aws.String(p.ServiceCode),
+		QuotaCode:    aws.String(p.QuotaCode),
+		DesiredValue: aws.Float64(float64(p.DesiredValue)),
+	}
+}
+
+// GenerateServiceQuotaObservation returns the observation of the quota and
+// of its last increase request, which may be nil.
+func GenerateServiceQuotaObservation(q *servicequotas.ServiceQuota, r *servicequotas.RequestedServiceQuotaChange) v1alpha1.ServiceQuotaObservation {
+	o := v1alpha1.ServiceQuotaObservation{}
+	if q != nil {
+		o.QuotaARN = aws.StringValue(q.QuotaArn)
+		o.QuotaName = aws.StringValue(q.QuotaName)
+		o.Value = int64(aws.Float64Value(q.Value))
+	}
+	if r != nil {
+		o.RequestedValue = int64(aws.Float64Value(r.DesiredValue))
+		o.RequestStatus = string(r.Status)
+		o.CaseID = aws.StringValue(r.CaseId)
+	}
+	return o
+}
+
+// IsServiceQuotaUpToDate returns true if no new increase request is needed to
+// reach the desired value. That is the case while the last request is
+// pending, once the quota reaches the desired value, or if the last request
+// already asked for at least the desired value.
+func IsServiceQuotaUpToDate(p v1alpha1.ServiceQuotaParameters, q *servicequotas.ServiceQuota, r *servicequotas.RequestedServiceQuotaChange) bool {
+	if IsRequestPending(r) {
+		return true
+	}
+	if q != nil && aws.Float64Value(q.Value) >= float64(p.DesiredValue) {
+		return true
+	}
+	return r != nil && aws.Float64Value(r.DesiredValue) >= float64(p.DesiredValue)
+}
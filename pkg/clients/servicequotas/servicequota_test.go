@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/servicequotas/v1alpha1"
+)
+
+func TestIsServiceQuotaUpToDate(t *testing.T) {
+	p := v1alpha1.ServiceQuotaParameters{ServiceCode: "ec2", QuotaCode: "L-1216C47A", DesiredValue: 256}
+
+	cases := map[string]struct {
+		quota   *servicequotas.ServiceQuota
+		request *servicequotas.RequestedServiceQuotaChange
+		want    bool
+	}{
+		"QuotaHighEnough": {
+			quota: &servicequotas.ServiceQuota{Value: aws.Float64(256)},
+			want:  true,
+		},
+		"QuotaTooLow": {
+			quota: &servicequotas.ServiceQuota{Value: aws.Float64(64)},
+			want:  false,
+		},
+		"RequestPending": {
+			quota:   &servicequotas.ServiceQuota{Value: aws.Float64(64)},
+			request: &servicequotas.RequestedServiceQuotaChange{DesiredValue: aws.Float64(128), Status: servicequotas.RequestStatusPending},
+			want:    true,
+		},
+		"SameValueDenied": {
+			quota:   &servicequotas.ServiceQuota{Value: aws.Float64(64)},
+			request: &servicequotas.RequestedServiceQuotaChange{DesiredValue: aws.Float64(256), Status: servicequotas.RequestStatusDenied},
+			want:    true,
+		},
+		"HigherValueDesired": {
+			quota:   &servicequotas.ServiceQuota{Value: aws.Float64(128)},
+			request: &servicequotas.RequestedServiceQuotaChange{DesiredValue: aws.Float64(128), Status: servicequotas.RequestStatusApproved},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsServiceQuotaUpToDate(p, tc.quota, tc.request)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/snowball"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/snowball"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SnowballJobClient = (*MockSnowballJobClient)(nil)
+
+// MockSnowballJobClient is a type that implements all the methods for SnowballJobClient interface
+type MockSnowballJobClient struct {
+	MockCreateJob   func(*snowball.CreateJobInput) snowball.CreateJobRequest
+	MockDescribeJob func(*snowball.DescribeJobInput) snowball.DescribeJobRequest
+	MockUpdateJob   func(*snowball.UpdateJobInput) snowball.UpdateJobRequest
+	MockCancelJob   func(*snowball.CancelJobInput) snowball.CancelJobRequest
+}
+
+// CreateJobRequest calls the underlying MockCreateJob method.
+func (c *MockSnowballJobClient) CreateJobRequest(i *snowball.CreateJobInput) snowball.CreateJobRequest {
+	return c.MockCreateJob(i)
+}
+
+// DescribeJobRequest calls the underlying MockDescribeJob method.
+func (c *MockSnowballJobClient) DescribeJobRequest(i *snowball.DescribeJobInput) snowball.DescribeJobRequest {
+	return c.MockDescribeJob(i)
+}
+
+// UpdateJobRequest calls the underlying MockUpdateJob method.
+func (c *MockSnowballJobClient) UpdateJobRequest(i *snowball.UpdateJobInput) snowball.UpdateJobRequest {
+	return c.MockUpdateJob(i)
+}
+
+// CancelJobRequest calls the underlying MockCancelJob method.
+func (c *MockSnowballJobClient) CancelJobRequest(i *snowball.CancelJobInput) snowball.CancelJobRequest {
+	return c.MockCancelJob(i)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowball
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/snowball"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/snowball/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SnowballJobClient is the external client used for SnowballJob Custom
+// Resource
+type SnowballJobClient interface {
+	CreateJobRequest(*snowball.CreateJobInput) snowball.CreateJobRequest
+	DescribeJobRequest(*snowball.DescribeJobInput) snowball.DescribeJobRequest
+	UpdateJobRequest(*snowball.UpdateJobInput) snowball.UpdateJobRequest
+	CancelJobRequest(*snowball.CancelJobInput) snowball.CancelJobRequest
+}
+
+// NewSnowballJobClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewSnowballJobClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (SnowballJobClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return snowball.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the job doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == snowball.ErrCodeInvalidResourceException
+	}
+	return false
+}
+
+// IsJobFinished returns true if the job reached a state it never leaves.
+func IsJobFinished(s snowball.JobState) bool {
+	return s == snowball.JobStateComplete || s == snowball.JobStateCancelled
+}
+
+func generateResources(partition string, r []v1alpha1.S3Resource) *snowball.JobResource {
+	if len(r) == 0 {
+		return nil
+	}
+	res := &snowball.JobResource{S3Resources: make([]snowball.S3Resource, len(r))}
+	for i := range r {
+		res.S3Resources[i] = snowball.S3Resource{
+			BucketArn: aws.String(awsclients.S3BucketARN(partition, aws.StringValue(r[i].Bucket))),
+		}
+		if r[i].KeyRange != nil {
+			res.S3Resources[i].KeyRange = &snowball.KeyRange{
+				BeginMarker: r[i].KeyRange.BeginMarker,
+				EndMarker:   r[i].KeyRange.EndMarker,
+			}
+		}
+	}
+	return res
+}
+
+func generateNotification(n *v1alpha1.Notification) *snowball.Notification {
+	if n == nil {
+		return nil
+	}
+	states := make([]snowball.JobState, len(n.JobStatesToNotify))
+	for i := range n.JobStatesToNotify {
+		states[i] = snowball.JobState(n.JobStatesToNotify[i])
+	}
+	return &snowball.Notification{
+		SnsTopicARN:       n.SNSTopicARN,
+		JobStatesToNotify: states,
+		NotifyAll:         n.NotifyAll,
+	}
+}
+
+// GenerateCreateJobInput returns the input to create a job from the supplied
+// parameters, referring to buckets in the supplied partition.
+func GenerateCreateJobInput(partition string, p v1alpha1.SnowballJobParameters) *snowball.CreateJobInput {
+	return &snowball.CreateJobInput{
+		JobType:                    snowball.JobType(p.JobType),
+		AddressId:                  aws.String(p.AddressID),
+		ForwardingAddressId:        p.ForwardingAddressID,
+		Description:                p.Description,
+		Resources:                  generateResources(partition, p.S3Resources),
+		RoleARN:                    p.RoleARN,
+		KmsKeyARN:                  p.KMSKeyARN,
+		ShippingOption:             snowball.ShippingOption(aws.StringValue(p.ShippingOption)),
+		SnowballType:               snowball.SnowballType(aws.StringValue(p.SnowballType)),
+		SnowballCapacityPreference: snowball.SnowballCapacity(aws.StringValue(p.SnowballCapacityPreference)),
+		Notification:               generateNotification(p.Notification),
+	}
+}
+
+// GenerateUpdateJobInput returns the input to update the job with the
+// supplied ID, referring to buckets in the supplied partition.
+func GenerateUpdateJobInput(id, partition string, p v1alpha1.SnowballJobParameters) *snowball.UpdateJobInput {
+	return &snowball.UpdateJobInput{
+		JobId:                      aws.String(id),
+		AddressId:                  aws.String(p.AddressID),
+		ForwardingAddressId:        p.ForwardingAddressID,
+		Description:                p.Description,
+		Resources:                  generateResources(partition, p.S3Resources),
+		RoleARN:                    p.RoleARN,
+		ShippingOption:             snowball.ShippingOption(aws.StringValue(p.ShippingOption)),
+		SnowballCapacityPreference: snowball.SnowballCapacity(aws.StringValue(p.SnowballCapacityPreference)),
+		Notification:               generateNotification(p.Notification),
+	}
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return aws.String(s)
+}
+
+// LateInitializeSnowballJob fills the empty fields in
+// *v1alpha1.SnowballJobParameters with the values seen in
+// snowball.JobMetadata.
+func LateInitializeSnowballJob(in *v1alpha1.SnowballJobParameters, m *snowball.JobMetadata) {
+	if m == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, m.Description)
+	in.KMSKeyARN = awsclients.LateInitializeStringPtr(in.KMSKeyARN, m.KmsKeyARN)
+	in.SnowballType = awsclients.LateInitializeStringPtr(in.SnowballType, stringOrNil(string(m.SnowballType)))
+	in.SnowballCapacityPreference = awsclients.LateInitializeStringPtr(in.SnowballCapacityPreference, stringOrNil(string(m.SnowballCapacityPreference)))
+	if m.ShippingDetails != nil {
+		in.ShippingOption = awsclients.LateInitializeStringPtr(in.ShippingOption, stringOrNil(string(m.ShippingDetails.ShippingOption)))
+	}
+}
+
+func generateShipment(s *snowball.Shipment) *v1alpha1.Shipment {
+	if s == nil {
+		return nil
+	}
+	return &v1alpha1.Shipment{
+		Status:         aws.StringValue(s.Status),
+		TrackingNumber: aws.StringValue(s.TrackingNumber),
+	}
+}
+
+// GenerateSnowballJobObservation is used to produce
+// v1alpha1.SnowballJobObservation from snowball.JobMetadata.
+func GenerateSnowballJobObservation(m snowball.JobMetadata) v1alpha1.SnowballJobObservation {
+	o := v1alpha1.SnowballJobObservation{
+		JobState: string(m.JobState),
+	}
+	if m.ShippingDetails != nil {
+		o.InboundShipment = generateShipment(m.ShippingDetails.InboundShipment)
+		o.OutboundShipment = generateShipment(m.ShippingDetails.OutboundShipment)
+	}
+	return o
+}
+
+type s3Resource struct {
+	Bucket      string
+	BeginMarker string
+	EndMarker   string
+}
+
+func desiredResources(r []v1alpha1.S3Resource) []s3Resource {
+	res := make([]s3Resource, len(r))
+	for i := range r {
+		res[i] = s3Resource{Bucket: aws.StringValue(r[i].Bucket)}
+		if r[i].KeyRange != nil {
+			res[i].BeginMarker = aws.StringValue(r[i].KeyRange.BeginMarker)
+			res[i].EndMarker = aws.StringValue(r[i].KeyRange.EndMarker)
+		}
+	}
+	return res
+}
+
+func observedResources(r *snowball.JobResource) []s3Resource {
+	if r == nil {
+		return nil
+	}
+	res := make([]s3Resource, len(r.S3Resources))
+	for i, s := range r.S3Resources {
+		res[i] = s3Resource{Bucket: awsclients.ARNResource(aws.StringValue(s.BucketArn))}
+		if s.KeyRange != nil {
+			res[i].BeginMarker = aws.StringValue(s.KeyRange.BeginMarker)
+			res[i].EndMarker = aws.StringValue(s.KeyRange.EndMarker)
+		}
+	}
+	return res
+}
+
+// IsSnowballJobUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsSnowballJobUpToDate(p v1alpha1.SnowballJobParameters, m snowball.JobMetadata) bool {
+	var shipping snowball.ShippingOption
+	if m.ShippingDetails != nil {
+		shipping = m.ShippingDetails.ShippingOption
+	}
+	var topic *string
+	if m.Notification != nil {
+		topic = m.Notification.SnsTopicARN
+	}
+	var desiredTopic *string
+	if p.Notification != nil {
+		desiredTopic = p.Notification.SNSTopicARN
+	}
+	return p.AddressID == aws.StringValue(m.AddressId) &&
+		aws.StringValue(p.ForwardingAddressID) == aws.StringValue(m.ForwardingAddressId) &&
+		aws.StringValue(p.Description) == aws.StringValue(m.Description) &&
+		aws.StringValue(p.RoleARN) == aws.StringValue(m.RoleARN) &&
+		aws.StringValue(p.ShippingOption) == string(shipping) &&
+		aws.StringValue(p.SnowballCapacityPreference) == string(m.SnowballCapacityPreference) &&
+		aws.StringValue(desiredTopic) == aws.StringValue(topic) &&
+		cmp.Equal(desiredResources(p.S3Resources), observedResources(m.Resources), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowball
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/snowball"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/snowball/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var addressID = "ADID1234ab12-3eec-4eb3-9be6-9374c10eb51b"
+
+func TestGenerateCreateJobInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.SnowballJobParameters
+		want *snowball.CreateJobInput
+	}{
+		"Minimal": {
+			p: v1alpha1.SnowballJobParameters{JobType: "EXPORT", AddressID: addressID},
+			want: &snowball.CreateJobInput{
+				JobType:   snowball.JobTypeExport,
+				AddressId: aws.String(addressID),
+			},
+		},
+		"Full": {
+			p: v1alpha1.SnowballJobParameters{
+				JobType:   "IMPORT",
+				AddressID: addressID,
+				S3Resources: []v1alpha1.S3Resource{
+					{Bucket: aws.String("archive"), KeyRange: &v1alpha1.KeyRange{BeginMarker: aws.String("2019/")}},
+				},
+				RoleARN:        aws.String("arn:aws:iam::123456789012:role/snowball"),
+				ShippingOption: aws.String("NEXT_DAY"),
+				SnowballType:   aws.String("EDGE"),
+				Notification: &v1alpha1.Notification{
+					SNSTopicARN:       aws.String("arn:aws:sns:us-east-1:123456789012:snowball"),
+					JobStatesToNotify: []string{"InTransitToCustomer", "Complete"},
+				},
+			},
+			want: &snowball.CreateJobInput{
+				JobType:   snowball.JobTypeImport,
+				AddressId: aws.String(addressID),
+				Resources: &snowball.JobResource{S3Resources: []snowball.S3Resource{
+					{BucketArn: aws.String("arn:aws:s3:::archive"), KeyRange: &snowball.KeyRange{BeginMarker: aws.String("2019/")}},
+				}},
+				RoleARN:        aws.String("arn:aws:iam::123456789012:role/snowball"),
+				ShippingOption: snowball.ShippingOptionNextDay,
+				SnowballType:   snowball.SnowballTypeEdge,
+				Notification: &snowball.Notification{
+					SnsTopicARN:       aws.String("arn:aws:sns:us-east-1:123456789012:snowball"),
+					JobStatesToNotify: []snowball.JobState{snowball.JobStateInTransitToCustomer, snowball.JobStateComplete},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateCreateJobInput(awsclients.PartitionAWS, tc.p)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSnowballJobUpToDate(t *testing.T) {
+	observed := snowball.JobMetadata{
+		AddressId: aws.String(addressID),
+		Resources: &snowball.JobResource{S3Resources: []snowball.S3Resource{
+			{BucketArn: aws.String("arn:aws:s3:::archive")},
+		}},
+		ShippingDetails: &snowball.ShippingDetails{ShippingOption: snowball.ShippingOptionStandard},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.SnowballJobParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.SnowballJobParameters{
+				AddressID:      addressID,
+				S3Resources:    []v1alpha1.S3Resource{{Bucket: aws.String("archive")}},
+				ShippingOption: aws.String("STANDARD"),
+			},
+			want: true,
+		},
+		"ShippingChanged": {
+			p: v1alpha1.SnowballJobParameters{
+				AddressID:      addressID,
+				S3Resources:    []v1alpha1.S3Resource{{Bucket: aws.String("archive")}},
+				ShippingOption: aws.String("EXPRESS"),
+			},
+			want: false,
+		},
+		"BucketChanged": {
+			p: v1alpha1.SnowballJobParameters{
+				AddressID:      addressID,
+				S3Resources:    []v1alpha1.S3Resource{{Bucket: aws.String("backup")}},
+				ShippingOption: aws.String("STANDARD"),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsSnowballJobUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+)
+
+// MockPlatformApplicationClient is a type that implements all the methods for PlatformApplicationClient interface
+type MockPlatformApplicationClient struct {
+	MockCreatePlatformApplicationRequest        func(*sns.CreatePlatformApplicationInput) sns.CreatePlatformApplicationRequest
+	MockDeletePlatformApplicationRequest        func(*sns.DeletePlatformApplicationInput) sns.DeletePlatformApplicationRequest
+	MockGetPlatformApplicationAttributesRequest func(*sns.GetPlatformApplicationAttributesInput) sns.GetPlatformApplicationAttributesRequest
+	MockSetPlatformApplicationAttributesRequest func(*sns.SetPlatformApplicationAttributesInput) sns.SetPlatformApplicationAttributesRequest
+}
+
+// CreatePlatformApplicationRequest mocks CreatePlatformApplicationRequest method
+func (m *MockPlatformApplicationClient) CreatePlatformApplicationRequest(input *sns.CreatePlatformApplicationInput) sns.CreatePlatformApplicationRequest {
+	return m.MockCreatePlatformApplicationRequest(input)
+}
+
+// DeletePlatformApplicationRequest mocks DeletePlatformApplicationRequest method
+func (m *MockPlatformApplicationClient) DeletePlatformApplicationRequest(input *sns.DeletePlatformApplicationInput) sns.DeletePlatformApplicationRequest {
+	return m.MockDeletePlatformApplicationRequest(input)
+}
+
+// GetPlatformApplicationAttributesRequest mocks GetPlatformApplicationAttributesRequest method
+func (m *MockPlatformApplicationClient) GetPlatformApplicationAttributesRequest(input *sns.GetPlatformApplicationAttributesInput) sns.GetPlatformApplicationAttributesRequest {
+	return m.MockGetPlatformApplicationAttributesRequest(input)
+}
+
+// SetPlatformApplicationAttributesRequest mocks SetPlatformApplicationAttributesRequest method
+func (m *MockPlatformApplicationClient) SetPlatformApplicationAttributesRequest(input *sns.SetPlatformApplicationAttributesInput) sns.SetPlatformApplicationAttributesRequest {
+	return m.MockSetPlatformApplicationAttributesRequest(input)
+}
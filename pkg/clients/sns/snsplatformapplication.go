@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sns
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+
+	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// PlatformApplicationAttributes refers to AWS SNS Platform Application
+// Attributes List
+// ref: https://docs.aws.amazon.com/sns/latest/api/API_SetPlatformApplicationAttributes.html
+type PlatformApplicationAttributes string
+
+const (
+	// PlatformCredential is the credential of the platform
+	PlatformCredential PlatformApplicationAttributes = "PlatformCredential"
+	// PlatformPrincipal is the principal of the platform
+	PlatformPrincipal PlatformApplicationAttributes = "PlatformPrincipal"
+	// EventEndpointCreated is the topic notified of created endpoints
+	EventEndpointCreated PlatformApplicationAttributes = "EventEndpointCreated"
+	// EventEndpointDeleted is the topic notified of deleted endpoints
+	EventEndpointDeleted PlatformApplicationAttributes = "EventEndpointDeleted"
+	// EventEndpointUpdated is the topic notified of updated endpoints
+	EventEndpointUpdated PlatformApplicationAttributes = "EventEndpointUpdated"
+	// EventDeliveryFailure is the topic notified of failed deliveries
+	EventDeliveryFailure PlatformApplicationAttributes = "EventDeliveryFailure"
+	// SuccessFeedbackRoleArn is the role used to log successful deliveries
+	SuccessFeedbackRoleArn PlatformApplicationAttributes = "SuccessFeedbackRoleArn"
+	// FailureFeedbackRoleArn is the role used to log failed deliveries
+	FailureFeedbackRoleArn PlatformApplicationAttributes = "FailureFeedbackRoleArn"
+	// SuccessFeedbackSampleRate is the percentage of successful deliveries
+	// that are logged
+	SuccessFeedbackSampleRate PlatformApplicationAttributes = "SuccessFeedbackSampleRate"
+	// PlatformApplicationEnabled is whether the platform application is
+	// enabled
+	PlatformApplicationEnabled PlatformApplicationAttributes = "Enabled"
+	// AppleCertificateExpirationDate is the expiration date of the APNS
+	// certificate
+	AppleCertificateExpirationDate PlatformApplicationAttributes = "AppleCertificateExpirationDate"
+)
+
+// PlatformApplicationClient is the external client used for AWS
+// SNSPlatformApplication
+type PlatformApplicationClient interface {
+	CreatePlatformApplicationRequest(*sns.CreatePlatformApplicationInput) sns.CreatePlatformApplicationRequest
+	DeletePlatformApplicationRequest(*sns.DeletePlatformApplicationInput) sns.DeletePlatformApplicationRequest
+	GetPlatformApplicationAttributesRequest(*sns.GetPlatformApplicationAttributesInput) sns.GetPlatformApplicationAttributesRequest
+	SetPlatformApplicationAttributesRequest(*sns.SetPlatformApplicationAttributesInput) sns.SetPlatformApplicationAttributesRequest
+}
+
+// NewPlatformApplicationClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewPlatformApplicationClient(conf *aws.Config) (PlatformApplicationClient, error) {
+	return sns.New(*conf), nil
+}
+
+// getPlatformApplicationAttributes returns the attributes of the supplied
+// parameters that can be observed and updated.
+func getPlatformApplicationAttributes(p v1alpha1.SNSPlatformApplicationParameters) map[string]string {
+	return map[string]string{
+		string(EventEndpointCreated):      aws.StringValue(p.EventEndpointCreated),
+		string(EventEndpointDeleted):      aws.StringValue(p.EventEndpointDeleted),
+		string(EventEndpointUpdated):      aws.StringValue(p.EventEndpointUpdated),
+		string(EventDeliveryFailure):      aws.StringValue(p.EventDeliveryFailure),
+		string(SuccessFeedbackRoleArn):    aws.StringValue(p.SuccessFeedbackRoleARN),
+		string(FailureFeedbackRoleArn):    aws.StringValue(p.FailureFeedbackRoleARN),
+		string(SuccessFeedbackSampleRate): aws.StringValue(p.SuccessFeedbackSampleRate),
+	}
+}
+
+// GenerateCreatePlatformApplicationInput prepares input for
+// CreatePlatformApplicationRequest with the supplied platform credential and
+// principal.
+func GenerateCreatePlatformApplicationInput(p *v1alpha1.SNSPlatformApplicationParameters, credential string, principal *string) *sns.CreatePlatformApplicationInput {
+	attrs := make(map[string]string)
+	for k, v := range getPlatformApplicationAttributes(*p) {
+		if v != "" {
+			attrs[k] = v
+		}
+	}
+	attrs[string(PlatformCredential)] = credential
+	if principal != nil {
+		attrs[string(PlatformPrincipal)] = *principal
+	}
+	return &sns.CreatePlatformApplicationInput{
+		Name:       aws.String(p.Name),
+		Platform:   aws.String(p.Platform),
+		Attributes: attrs,
+	}
+}
+
+// LateInitializePlatformApplicationAttr fills the empty fields in
+// *v1alpha1.SNSPlatformApplicationParameters with the values seen in the
+// attributes of the platform application.
+func LateInitializePlatformApplicationAttr(in *v1alpha1.SNSPlatformApplicationParameters, attrs map[string]string) {
+	in.EventEndpointCreated = awsclients.LateInitializeStringPtr(in.EventEndpointCreated, awsclients.String(attrs[string(EventEndpointCreated)]))
+	in.EventEndpointDeleted = awsclients.LateInitializeStringPtr(in.EventEndpointDeleted, awsclients.String(attrs[string(EventEndpointDeleted)]))
+	in.EventEndpointUpdated = awsclients.LateInitializeStringPtr(in.EventEndpointUpdated, awsclients.String(attrs[string(EventEndpointUpdated)]))
+	in.EventDeliveryFailure = awsclients.LateInitializeStringPtr(in.EventDeliveryFailure, awsclients.String(attrs[string(EventDeliveryFailure)]))
+	in.SuccessFeedbackRoleARN = awsclients.LateInitializeStringPtr(in.SuccessFeedbackRoleARN, awsclients.String(attrs[string(SuccessFeedbackRoleArn)]))
+	in.FailureFeedbackRoleARN = awsclients.LateInitializeStringPtr(in.FailureFeedbackRoleARN, awsclients.String(attrs[string(FailureFeedbackRoleArn)]))
+	in.SuccessFeedbackSampleRate = awsclients.LateInitializeStringPtr(in.SuccessFeedbackSampleRate, awsclients.String(attrs[string(SuccessFeedbackSampleRate)]))
+}
+
+// GetChangedPlatformApplicationAttributes returns the attributes of the
+// platform application that differ from the desired parameters.
+func GetChangedPlatformApplicationAttributes(p v1alpha1.SNSPlatformApplicationParameters, attrs map[string]string) map[string]string {
+	changed := make(map[string]string)
+	for k, v := range getPlatformApplicationAttributes(p) {
+		if v != attrs[k] {
+			changed[k] = v
+		}
+	}
+	return changed
+}
+
+// GeneratePlatformApplicationObservation is used to produce
+// SNSPlatformApplicationObservation from attributes
+func GeneratePlatformApplicationObservation(attrs map[string]string) v1alpha1.SNSPlatformApplicationObservation {
+	o := v1alpha1.SNSPlatformApplicationObservation{
+		AppleCertificateExpirationDate: awsclients.String(attrs[string(AppleCertificateExpirationDate)]),
+	}
+	if b, err := strconv.ParseBool(attrs[string(PlatformApplicationEnabled)]); err == nil {
+		o.Enabled = aws.Bool(b)
+	}
+	return o
+}
+
+// IsSNSPlatformApplicationUpToDate checks if object is up to date
+func IsSNSPlatformApplicationUpToDate(p v1alpha1.SNSPlatformApplicationParameters, attrs map[string]string) bool {
+	return len(GetChangedPlatformApplicationAttributes(p, attrs)) == 0
+}
+
+// IsPlatformApplicationNotFound returns true if the error code indicates that
+// the item was not found
+func IsPlatformApplicationNotFound(err error) bool {
+	if appErr, ok := err.(awserr.Error); ok && appErr.Code() == sns.ErrCodeNotFoundException {
+		return true
+	}
+	return false
+}
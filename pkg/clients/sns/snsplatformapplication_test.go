@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+)
+
+func TestGetChangedPlatformApplicationAttributes(t *testing.T) {
+	topic := "arn:aws:sns:us-east-1:123456789012:failures"
+	role := "arn:aws:iam::123456789012:role/sns-logs"
+
+	cases := map[string]struct {
+		params v1alpha1.SNSPlatformApplicationParameters
+		attrs  map[string]string
+		want   map[string]string
+	}{
+		"NoChange": {
+			params: v1alpha1.SNSPlatformApplicationParameters{EventDeliveryFailure: aws.String(topic)},
+			attrs: map[string]string{
+				string(EventDeliveryFailure):       topic,
+				string(PlatformApplicationEnabled): "true",
+			},
+			want: map[string]string{},
+		},
+		"Changed": {
+			params: v1alpha1.SNSPlatformApplicationParameters{
+				EventDeliveryFailure:   aws.String(topic),
+				SuccessFeedbackRoleARN: aws.String(role),
+			},
+			attrs: map[string]string{
+				string(EventDeliveryFailure): topic,
+			},
+			want: map[string]string{string(SuccessFeedbackRoleArn): role},
+		},
+		"Removed": {
+			params: v1alpha1.SNSPlatformApplicationParameters{},
+			attrs: map[string]string{
+				string(EventDeliveryFailure): topic,
+			},
+			want: map[string]string{string(EventDeliveryFailure): ""},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetChangedPlatformApplicationAttributes(tc.params, tc.attrs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePlatformApplicationObservation(t *testing.T) {
+	cases := map[string]struct {
+		attrs map[string]string
+		want  v1alpha1.SNSPlatformApplicationObservation
+	}{
+		"Enabled": {
+			attrs: map[string]string{
+				string(PlatformApplicationEnabled):     "true",
+				string(AppleCertificateExpirationDate): "2021-01-01T00:00:00Z",
+			},
+			want: v1alpha1.SNSPlatformApplicationObservation{
+				Enabled:                        aws.Bool(true),
+				AppleCertificateExpirationDate: aws.String("2021-01-01T00:00:00Z"),
+			},
+		},
+		"Empty": {
+			attrs: map[string]string{},
+			want:  v1alpha1.SNSPlatformApplicationObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePlatformApplicationObservation(tc.attrs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package sns
 
 import (
+	"encoding/json"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -104,7 +105,23 @@ func LateInitializeSubscription(in *v1alpha1.SNSSubscriptionParameters, subAttri
 	in.DeliveryPolicy = awsclients.LateInitializeStringPtr(in.DeliveryPolicy, aws.String(subAttributes[string(SubscriptionDeliveryPolicy)]))
 	in.FilterPolicy = awsclients.LateInitializeStringPtr(in.FilterPolicy, aws.String(subAttributes[string(SubscriptionFilterPolicy)]))
 	in.RawMessageDelivery = awsclients.LateInitializeStringPtr(in.RawMessageDelivery, aws.String(subAttributes[string(SubscriptionRawMessageDelivery)]))
-	in.RedrivePolicy = awsclients.LateInitializeStringPtr(in.RedrivePolicy, aws.String(subAttributes[string(SubscriptionRedrivePolicy)]))
+	// The redrive policy is built from DeadLetterQueueARN when it is given.
+	if in.DeadLetterQueueARN == nil {
+		in.RedrivePolicy = awsclients.LateInitializeStringPtr(in.RedrivePolicy, aws.String(subAttributes[string(SubscriptionRedrivePolicy)]))
+	}
+}
+
+// redrivePolicy returns the RedrivePolicy of the subscription, or one that
+// sends undeliverable messages to DeadLetterQueueARN if it is not set.
+func redrivePolicy(p v1alpha1.SNSSubscriptionParameters) string {
+	if p.RedrivePolicy != nil || p.DeadLetterQueueARN == nil {
+		return aws.StringValue(p.RedrivePolicy)
+	}
+	val, err := json.Marshal(map[string]string{"deadLetterTargetArn": aws.StringValue(p.DeadLetterQueueARN)})
+	if err != nil {
+		return ""
+	}
+	return string(val)
 }
 
 // getSubAttributes returns map of SNS Sunscription Attributes
@@ -115,7 +132,7 @@ func getSubAttributes(p v1alpha1.SNSSubscriptionParameters) map[string]string {
 	attr[string(SubscriptionDeliveryPolicy)] = aws.StringValue(p.DeliveryPolicy)
 	attr[string(SubscriptionFilterPolicy)] = aws.StringValue(p.FilterPolicy)
 	attr[string(SubscriptionRawMessageDelivery)] = aws.StringValue(p.RawMessageDelivery)
-	attr[string(SubscriptionRedrivePolicy)] = aws.StringValue(p.RedrivePolicy)
+	attr[string(SubscriptionRedrivePolicy)] = redrivePolicy(p)
 
 	return attr
 }
@@ -139,7 +156,7 @@ func IsSNSSubscriptionAttributesUpToDate(p v1alpha1.SNSSubscriptionParameters, s
 	return aws.StringValue(p.DeliveryPolicy) == subAttributes[string(SubscriptionDeliveryPolicy)] &&
 		aws.StringValue(p.FilterPolicy) == subAttributes[string(SubscriptionFilterPolicy)] &&
 		aws.StringValue(p.RawMessageDelivery) == subAttributes[string(SubscriptionRawMessageDelivery)] &&
-		aws.StringValue(p.RedrivePolicy) == subAttributes[string(SubscriptionRedrivePolicy)]
+		redrivePolicy(p) == subAttributes[string(SubscriptionRedrivePolicy)]
 }
 
 // IsSubscriptionNotFound returns true if the error code indicates that the item was not found
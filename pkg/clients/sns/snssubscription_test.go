@@ -5,7 +5,7 @@ Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
-	http://www.apache.org/licenses/LICENSE-2.0
+    http://www.apache.org/licenses/LICENSE-2.0
 
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
@@ -33,6 +33,7 @@ var (
 	subRawMessageDelivery  = "raw-message"
 	subFilterPolicy        = "filter-policy"
 	subRedrivePolicy       = "redrive-policy"
+	subDeadLetterQueueARN  = "arn:aws:sqs:us-east-1:123456789012:dlq"
 	subDeliveryPolicy      = "delivery-policy"
 	subConfirmationPending = v1alpha1.ConfirmationPending
 	withSubConfirmed       = v1alpha1.ConfirmationSuccessful
@@ -212,6 +213,20 @@ func TestLateInitializeSubscription(t *testing.T) {
 				sub.Endpoint = subEmailEndpoint
 				sub.Protocol = subEmailProtocol
 			}),
+		}, "DeadLetterQueueARN": {
+			args: args{
+				spec: subParams(func(sub *v1alpha1.SNSSubscriptionParameters) {
+					sub.RedrivePolicy = nil
+					sub.DeadLetterQueueARN = &subDeadLetterQueueARN
+				}),
+				attr: *subAttributes(
+					withSubRedrivePolicy(&subRedrivePolicy),
+				),
+			},
+			want: subParams(func(sub *v1alpha1.SNSSubscriptionParameters) {
+				sub.RedrivePolicy = nil
+				sub.DeadLetterQueueARN = &subDeadLetterQueueARN
+			}),
 		},
 	}
 
@@ -255,6 +270,18 @@ func TestGetChangedSubAttributes(t *testing.T) {
 				attr: subAttributes(),
 			},
 			want: subAttributes(),
+		}, "DeadLetterQueueARN": {
+			args: args{
+				p: v1alpha1.SNSSubscriptionParameters{
+					Protocol:           subEmailProtocol,
+					Endpoint:           subEmailEndpoint,
+					DeadLetterQueueARN: &subDeadLetterQueueARN,
+				},
+				attr: subAttributes(),
+			},
+			want: subAttributes(
+				withSubRedrivePolicy(aws.String(`{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:dlq"}`)),
+			),
 		},
 	}
 
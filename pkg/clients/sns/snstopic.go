@@ -40,6 +40,8 @@ const (
 	TopicKmsMasterKeyID TopicAttributes = "KmsMasterKeyId"
 	// TopicPolicy is Policy of SNS Topic
 	TopicPolicy TopicAttributes = "Policy"
+	// TopicArn is ARN of SNS Topic
+	TopicArn TopicAttributes = "TopicArn"
 	// TopicOwner is Owner of SNS Topic
 	TopicOwner TopicAttributes = "Owner"
 	// TopicSubscriptionsConfirmed is status of SNS Topic Subscription Confirmation
@@ -88,8 +90,7 @@ func LateInitializeTopicAttr(in *v1alpha1.SNSTopicParameters, attrs map[string]s
 	in.DisplayName = awsclients.LateInitializeStringPtr(in.DisplayName, aws.String(attrs[string(TopicDisplayName)]))
 	in.DeliveryPolicy = awsclients.LateInitializeStringPtr(in.DeliveryPolicy, aws.String(attrs[string(TopicDeliveryPolicy)]))
 	in.KMSMasterKeyID = awsclients.LateInitializeStringPtr(in.KMSMasterKeyID, aws.String(attrs[string(TopicKmsMasterKeyID)]))
-	in.Policy = awsclients.LateInitializeStringPtr(in.Policy, aws.String(attrs[string(TopicPolicy)]))
-
+	// Policy is not late initialized; it may be managed by a SNSTopicPolicy.
 }
 
 // GetChangedAttributes will return the changed attributes for a topic in AWS side.
@@ -114,6 +115,7 @@ func GetChangedAttributes(p v1alpha1.SNSTopicParameters, attrs map[string]string
 func GenerateTopicObservation(attr map[string]string) v1alpha1.SNSTopicObservation {
 	o := v1alpha1.SNSTopicObservation{}
 
+	o.ARN = aws.String(attr[string(TopicArn)])
 	o.Owner = aws.String(attr[string(TopicOwner)])
 
 	if s, err := strconv.ParseInt(attr[string(TopicSubscriptionsConfirmed)], 10, 64); err == nil {
@@ -136,7 +138,7 @@ func IsSNSTopicUpToDate(p v1alpha1.SNSTopicParameters, attr map[string]string) b
 	return aws.StringValue(p.DeliveryPolicy) == attr[string(TopicDeliveryPolicy)] &&
 		aws.StringValue(p.DisplayName) == attr[string(TopicDisplayName)] &&
 		aws.StringValue(p.KMSMasterKeyID) == attr[string(TopicKmsMasterKeyID)] &&
-		aws.StringValue(p.Policy) == attr[string(TopicPolicy)]
+		(p.Policy == nil || *p.Policy == attr[string(TopicPolicy)])
 }
 
 func getTopicAttributes(p v1alpha1.SNSTopicParameters) map[string]string {
@@ -146,7 +148,9 @@ func getTopicAttributes(p v1alpha1.SNSTopicParameters) map[string]string {
 	topicAttr[string(TopicDeliveryPolicy)] = aws.StringValue(p.DeliveryPolicy)
 	topicAttr[string(TopicDisplayName)] = aws.StringValue(p.DisplayName)
 	topicAttr[string(TopicKmsMasterKeyID)] = aws.StringValue(p.KMSMasterKeyID)
-	topicAttr[string(TopicPolicy)] = aws.StringValue(p.Policy)
+	if p.Policy != nil {
+		topicAttr[string(TopicPolicy)] = *p.Policy
+	}
 
 	return topicAttr
 }
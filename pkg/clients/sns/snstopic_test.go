@@ -25,6 +25,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/proptest"
 )
 
 var (
@@ -33,6 +34,7 @@ var (
 	topicDisplayName  = "some-topic-01"
 	topicDisplayName2 = "some-topic-02"
 	topicArn          = "sometopicArn"
+	topicPolicy       = `{"Version":"2012-10-17","Statement":[]}`
 	confirmedSubs     = "1"
 	pendingSubs       = "11"
 	deletedSubs       = "12"
@@ -61,6 +63,12 @@ func withOwner(s *string) topicAttrModifier {
 	}
 }
 
+func withTopicArn(s *string) topicAttrModifier {
+	return func(attr *map[string]string) {
+		(*attr)[string(TopicArn)] = *s
+	}
+}
+
 func withTopicSubs(confirmed, pending, deleted string) topicAttrModifier {
 	return func(attr *map[string]string) {
 		a := *attr
@@ -76,6 +84,12 @@ func withAttrDisplayName(s *string) topicAttrModifier {
 	}
 }
 
+func withAttrPolicy(s *string) topicAttrModifier {
+	return func(attr *map[string]string) {
+		(*attr)[string(TopicPolicy)] = *s
+	}
+}
+
 // topic Observation Modifier
 type topicObservationModifier func(*v1alpha1.SNSTopicObservation)
 
@@ -94,6 +108,12 @@ func withObservationOwner(s *string) topicObservationModifier {
 		o.Owner = s
 	}
 }
+func withObservationArn(s *string) topicObservationModifier {
+	return func(o *v1alpha1.SNSTopicObservation) {
+		o.ARN = s
+	}
+}
+
 func withObservationSubs(confirmed, pending, deleted string) topicObservationModifier {
 	return func(o *v1alpha1.SNSTopicObservation) {
 		if s, err := strconv.ParseInt(confirmed, 10, 64); err == nil {
@@ -217,25 +237,30 @@ func TestGenerateTopicObservation(t *testing.T) {
 	}{
 		"AllFilled": {
 			in: topicAttributes(
+				withTopicArn(&topicArn),
 				withOwner(&subOwner),
 				withTopicSubs(confirmedSubs, pendingSubs, deletedSubs),
 			),
 			out: topicObservation(
+				withObservationArn(&topicArn),
 				withObservationOwner(&subOwner),
 				withObservationSubs(confirmedSubs, pendingSubs, deletedSubs),
 			),
 		},
 		"NoSubscriptions": {
 			in: topicAttributes(
+				withTopicArn(&topicArn),
 				withOwner(&subOwner),
 			),
 			out: topicObservation(
+				withObservationArn(&topicArn),
 				withObservationOwner(&subOwner),
 			),
 		},
 		"Empty": {
 			in: topicAttributes(),
 			out: topicObservation(
+				withObservationArn(&empty),
 				withObservationOwner(&empty),
 			),
 		},
@@ -281,6 +306,31 @@ func TestIsSNSTopicUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"PolicyNotManaged": {
+			args: args{
+				attr: topicAttributes(
+					withAttrDisplayName(&topicDisplayName),
+					withAttrPolicy(&topicPolicy),
+				),
+				p: v1alpha1.SNSTopicParameters{
+					DisplayName: &topicDisplayName,
+				},
+			},
+			want: true,
+		},
+		"DifferentPolicy": {
+			args: args{
+				attr: topicAttributes(
+					withAttrDisplayName(&topicDisplayName),
+					withAttrPolicy(&topicPolicy),
+				),
+				p: v1alpha1.SNSTopicParameters{
+					DisplayName: &topicDisplayName,
+					Policy:      &empty,
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -292,3 +342,27 @@ func TestIsSNSTopicUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestTopicProperties(t *testing.T) {
+	v := struct {
+		Parameters v1alpha1.SNSTopicParameters
+		Attributes map[string]string
+	}{}
+	proptest.Check(t, &v, func() string {
+		attrs := map[string]string{}
+		for k, a := range v.Attributes {
+			attrs[k] = a
+		}
+		for k, a := range GetChangedAttributes(v.Parameters, v.Attributes) {
+			attrs[k] = a
+		}
+		if !IsSNSTopicUpToDate(v.Parameters, attrs) {
+			return "A topic should be up to date once its changed attributes are set."
+		}
+		LateInitializeTopicAttr(&v.Parameters, attrs)
+		if !IsSNSTopicUpToDate(v.Parameters, attrs) {
+			return "A topic should be up to date once its parameters are late initialized."
+		}
+		return ""
+	})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sns
+
+import (
+	"encoding/json"
+
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// defaultTopicPolicyActions are the actions the default policy of a topic
+// allows its owner to perform.
+var defaultTopicPolicyActions = []string{
+	"SNS:GetTopicAttributes",
+	"SNS:SetTopicAttributes",
+	"SNS:AddPermission",
+	"SNS:RemovePermission",
+	"SNS:DeleteTopic",
+	"SNS:Subscribe",
+	"SNS:ListSubscriptionsByTopic",
+	"SNS:Publish",
+}
+
+type policyStatement struct {
+	Sid       string                       `json:"Sid"`
+	Effect    string                       `json:"Effect"`
+	Principal map[string]string            `json:"Principal"`
+	Action    []string                     `json:"Action"`
+	Resource  string                       `json:"Resource"`
+	Condition map[string]map[string]string `json:"Condition"`
+}
+
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	ID        string            `json:"Id"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+// DefaultTopicPolicy returns the policy AWS attaches to a new topic with the
+// supplied ARN, which only grants access to the account that owns the topic.
+func DefaultTopicPolicy(topicARN string) string {
+	owner := ""
+	if a, err := awsarn.Parse(topicARN); err == nil {
+		owner = a.AccountID
+	}
+	b, _ := json.Marshal(policyDocument{ // Marshalling a struct of strings cannot fail.
+		Version: "2008-10-17",
+		ID:      "__default_policy_ID",
+		Statement: []policyStatement{{
+			Sid:       "__default_statement_ID",
+			Effect:    "Allow",
+			Principal: map[string]string{"AWS": "*"},
+			Action:    defaultTopicPolicyActions,
+			Resource:  topicARN,
+			Condition: map[string]map[string]string{"StringEquals": {"AWS:SourceOwner": owner}},
+		}},
+	})
+	return string(b)
+}
+
+// IsDefaultTopicPolicy returns true if the policy in the supplied topic
+// attributes is the one DefaultTopicPolicy returns for the topic.
+func IsDefaultTopicPolicy(attrs map[string]string) bool {
+	return isSamePolicy(attrs[string(TopicPolicy)], DefaultTopicPolicy(attrs[string(TopicArn)]))
+}
+
+// IsSNSTopicPolicyUpToDate returns true if the policy in the supplied topic
+// attributes matches the parameters, ignoring differences in formatting.
+func IsSNSTopicPolicyUpToDate(p v1alpha1.SNSTopicPolicyParameters, attrs map[string]string) bool {
+	return isSamePolicy(attrs[string(TopicPolicy)], p.Policy)
+}
+
+func isSamePolicy(a, b string) bool {
+	ca, err := awsclients.CompactAndEscapeJSON(a)
+	if err != nil {
+		return false
+	}
+	cb, err := awsclients.CompactAndEscapeJSON(b)
+	if err != nil {
+		return false
+	}
+	return ca == cb
+}
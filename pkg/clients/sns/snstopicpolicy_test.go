@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+)
+
+var (
+	policyTopicARN = "arn:aws:sns:us-east-1:123456789012:some-topic"
+)
+
+func TestDefaultTopicPolicy(t *testing.T) {
+	want := `{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":"arn:aws:sns:us-east-1:123456789012:some-topic","Condition":{"StringEquals":{"AWS:SourceOwner":"123456789012"}}}]}`
+	if diff := cmp.Diff(want, DefaultTopicPolicy(policyTopicARN)); diff != "" {
+		t.Errorf("DefaultTopicPolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsDefaultTopicPolicy(t *testing.T) {
+	cases := map[string]struct {
+		attrs map[string]string
+		want  bool
+	}{
+		"Default": {
+			attrs: map[string]string{
+				string(TopicArn):    policyTopicARN,
+				string(TopicPolicy): DefaultTopicPolicy(policyTopicARN),
+			},
+			want: true,
+		},
+		"Custom": {
+			attrs: map[string]string{
+				string(TopicArn):    policyTopicARN,
+				string(TopicPolicy): `{"Version":"2012-10-17","Statement":[]}`,
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDefaultTopicPolicy(tc.attrs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDefaultTopicPolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSNSTopicPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p     v1alpha1.SNSTopicPolicyParameters
+		attrs map[string]string
+		want  bool
+	}{
+		"SamePolicyDifferentFormatting": {
+			p:     v1alpha1.SNSTopicPolicyParameters{Policy: `{"Version": "2012-10-17", "Statement": []}`},
+			attrs: map[string]string{string(TopicPolicy): `{"Version":"2012-10-17","Statement":[]}`},
+			want:  true,
+		},
+		"DifferentPolicy": {
+			p:     v1alpha1.SNSTopicPolicyParameters{Policy: `{"Version": "2012-10-17", "Statement": []}`},
+			attrs: map[string]string{string(TopicPolicy): DefaultTopicPolicy(policyTopicARN)},
+			want:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSNSTopicPolicyUpToDate(tc.p, tc.attrs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsSNSTopicPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -68,3 +68,19 @@ func (m *MockSQSClient) SetQueueAttributesRequest(i *sqs.SetQueueAttributesInput
 func (m *MockSQSClient) GetQueueUrlRequest(i *sqs.GetQueueUrlInput) sqs.GetQueueUrlRequest { //nolint:golint
 	return m.MockGetQueueURLRequest(i)
 }
+
+// MockMessageClient for testing.
+type MockMessageClient struct {
+	MockReceiveMessageRequest     func(input *sqs.ReceiveMessageInput) sqs.ReceiveMessageRequest
+	MockDeleteMessageBatchRequest func(input *sqs.DeleteMessageBatchInput) sqs.DeleteMessageBatchRequest
+}
+
+// ReceiveMessageRequest mocks ReceiveMessageRequest
+func (m *MockMessageClient) ReceiveMessageRequest(i *sqs.ReceiveMessageInput) sqs.ReceiveMessageRequest {
+	return m.MockReceiveMessageRequest(i)
+}
+
+// DeleteMessageBatchRequest mocks DeleteMessageBatchRequest
+func (m *MockMessageClient) DeleteMessageBatchRequest(i *sqs.DeleteMessageBatchInput) sqs.DeleteMessageBatchRequest {
+	return m.MockDeleteMessageBatchRequest(i)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"github.com/crossplane/provider-aws/apis/applicationintegration/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// IsQueuePolicyUpToDate returns true if the policy in the supplied queue
+// attributes matches the parameters, ignoring differences in formatting.
+func IsQueuePolicyUpToDate(p v1alpha1.QueuePolicyParameters, attributes map[string]string) (bool, error) {
+	observed := attributes[v1alpha1.AttributePolicy]
+	if observed == "" {
+		return false, nil
+	}
+	o, err := awsclients.CompactAndEscapeJSON(observed)
+	if err != nil {
+		return false, err
+	}
+	d, err := awsclients.CompactAndEscapeJSON(p.Policy)
+	if err != nil {
+		return false, err
+	}
+	return o == d, nil
+}
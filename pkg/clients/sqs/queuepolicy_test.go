@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/applicationintegration/v1alpha1"
+)
+
+func TestIsQueuePolicyUpToDate(t *testing.T) {
+	policy := `{"Version": "2012-10-17", "Statement": []}`
+	cases := map[string]struct {
+		p     v1alpha1.QueuePolicyParameters
+		attrs map[string]string
+		want  bool
+	}{
+		"SamePolicyDifferentFormatting": {
+			p:     v1alpha1.QueuePolicyParameters{Policy: policy},
+			attrs: map[string]string{v1alpha1.AttributePolicy: `{"Version":"2012-10-17","Statement":[]}`},
+			want:  true,
+		},
+		"DifferentPolicy": {
+			p:     v1alpha1.QueuePolicyParameters{Policy: policy},
+			attrs: map[string]string{v1alpha1.AttributePolicy: `{"Version":"2012-10-17"}`},
+			want:  false,
+		},
+		"NoPolicy": {
+			p:     v1alpha1.QueuePolicyParameters{Policy: policy},
+			attrs: map[string]string{},
+			want:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsQueuePolicyUpToDate(tc.p, tc.attrs)
+			if err != nil {
+				t.Fatalf("IsQueuePolicyUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsQueuePolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
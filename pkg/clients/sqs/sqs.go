@@ -46,6 +46,13 @@ type Client interface {
 	GetQueueUrlRequest(input *sqs.GetQueueUrlInput) sqs.GetQueueUrlRequest
 }
 
+// MessageClient defines the operations used to consume messages from a
+// queue.
+type MessageClient interface {
+	ReceiveMessageRequest(input *sqs.ReceiveMessageInput) sqs.ReceiveMessageRequest
+	DeleteMessageBatchRequest(input *sqs.DeleteMessageBatchInput) sqs.DeleteMessageBatchRequest
+}
+
 // NewClient creates new Queue Client with provided AWS Configurations/Credentials
 func NewClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (Client, error) {
 	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
@@ -98,7 +105,10 @@ func GenerateQueueAttributes(p *v1alpha1.QueueParameters) map[string]string { //
 	}
 
 	if p.RedrivePolicy != nil && aws.StringValue(p.RedrivePolicy.DeadLetterQueueARN) != "" {
-		val, err := json.Marshal(p.RedrivePolicy)
+		val, err := json.Marshal(redrivePolicy{
+			DeadLetterTargetARN: aws.StringValue(p.RedrivePolicy.DeadLetterQueueARN),
+			MaxReceiveCount:     json.Number(strconv.FormatInt(aws.Int64Value(p.RedrivePolicy.MaxReceiveCount), 10)),
+		})
 		if err == nil {
 			m[v1alpha1.AttributeRedrivePolicy] = string(val)
 		}
@@ -106,6 +116,26 @@ func GenerateQueueAttributes(p *v1alpha1.QueueParameters) map[string]string { //
 	return m
 }
 
+// redrivePolicy is the RedrivePolicy attribute of a queue as it is sent to and
+// returned by AWS.
+type redrivePolicy struct {
+	DeadLetterTargetARN string      `json:"deadLetterTargetArn"`
+	MaxReceiveCount     json.Number `json:"maxReceiveCount"`
+}
+
+// parseRedrivePolicy returns the RedrivePolicy attribute of a queue, or nil
+// if it is not set or cannot be parsed.
+func parseRedrivePolicy(attributes map[string]string) *redrivePolicy {
+	if attributes[v1alpha1.AttributeRedrivePolicy] == "" {
+		return nil
+	}
+	r := &redrivePolicy{}
+	if err := json.Unmarshal([]byte(attributes[v1alpha1.AttributeRedrivePolicy]), r); err != nil {
+		return nil
+	}
+	return r
+}
+
 // GenerateQueueTags returns a map of queue tags
 func GenerateQueueTags(tags []v1alpha1.Tag) map[string]string {
 	if len(tags) != 0 {
@@ -151,10 +181,12 @@ func LateInitialize(in *v1alpha1.QueueParameters, attributes map[string]string,
 		in.KMSMasterKeyID = aws.String(attributes[v1alpha1.AttributeKmsMasterKeyID])
 	}
 
-	if attributes[v1alpha1.AttributeDeadLetterQueueARN] != "" || attributes[v1alpha1.AttributeMaxReceiveCount] != "" {
-		in.RedrivePolicy = &v1alpha1.RedrivePolicy{}
-		in.RedrivePolicy.MaxReceiveCount = awsclients.LateInitializeInt64Ptr(in.RedrivePolicy.MaxReceiveCount, int64Ptr(attributes[v1alpha1.AttributeMaxReceiveCount]))
-		in.RedrivePolicy.DeadLetterQueueARN = awsclients.LateInitializeStringPtr(in.RedrivePolicy.DeadLetterQueueARN, aws.String(attributes[v1alpha1.AttributeDeadLetterQueueARN]))
+	if r := parseRedrivePolicy(attributes); r != nil {
+		if in.RedrivePolicy == nil {
+			in.RedrivePolicy = &v1alpha1.RedrivePolicy{}
+		}
+		in.RedrivePolicy.MaxReceiveCount = awsclients.LateInitializeInt64Ptr(in.RedrivePolicy.MaxReceiveCount, int64Ptr(r.MaxReceiveCount.String()))
+		in.RedrivePolicy.DeadLetterQueueARN = awsclients.LateInitializeStringPtr(in.RedrivePolicy.DeadLetterQueueARN, aws.String(r.DeadLetterTargetARN))
 	}
 }
 
@@ -194,10 +226,14 @@ func IsUpToDate(p v1alpha1.QueueParameters, attributes map[string]string, tags m
 	}
 
 	if p.RedrivePolicy != nil {
-		if !cmp.Equal(aws.StringValue(p.RedrivePolicy.DeadLetterQueueARN), attributes[v1alpha1.AttributeDeadLetterQueueARN]) {
+		r := parseRedrivePolicy(attributes)
+		if r == nil {
+			r = &redrivePolicy{}
+		}
+		if !cmp.Equal(aws.StringValue(p.RedrivePolicy.DeadLetterQueueARN), r.DeadLetterTargetARN) {
 			return false
 		}
-		if aws.Int64Value(p.RedrivePolicy.MaxReceiveCount) != int64Value(attributes[v1alpha1.AttributeMaxReceiveCount]) {
+		if aws.Int64Value(p.RedrivePolicy.MaxReceiveCount) != int64Value(r.MaxReceiveCount.String()) {
 			return false
 		}
 	}
@@ -110,6 +110,19 @@ func TestLateInitialize(t *testing.T) {
 					},
 				}
 			}),
+		}, "RedrivePolicy": {
+			args: args{
+				spec: sqsParams(),
+				in: attributes(map[string]string{
+					v1alpha1.AttributeRedrivePolicy: `{"deadLetterTargetArn":"arn","maxReceiveCount":"5"}`,
+				}),
+			},
+			want: sqsParams(func(p *v1alpha1.QueueParameters) {
+				p.RedrivePolicy = &v1alpha1.RedrivePolicy{
+					DeadLetterQueueARN: &arn,
+					MaxReceiveCount:    &maxReceiveCount,
+				}
+			}),
 		},
 	}
 
@@ -169,6 +182,33 @@ func TestIsUpToDate(t *testing.T) {
 				},
 			},
 			want: true,
+		}, "SameRedrivePolicy": {
+			args: args{
+				p: v1alpha1.QueueParameters{
+					RedrivePolicy: &v1alpha1.RedrivePolicy{
+						DeadLetterQueueARN: &arn,
+						MaxReceiveCount:    &maxReceiveCount,
+					},
+				},
+				attributes: map[string]string{
+					v1alpha1.AttributeRedrivePolicy: `{"deadLetterTargetArn":"arn","maxReceiveCount":5}`,
+				},
+			},
+			want: true,
+		},
+		"DifferentRedrivePolicy": {
+			args: args{
+				p: v1alpha1.QueueParameters{
+					RedrivePolicy: &v1alpha1.RedrivePolicy{
+						DeadLetterQueueARN: &arn,
+						MaxReceiveCount:    &maxReceiveCount,
+					},
+				},
+				attributes: map[string]string{
+					v1alpha1.AttributeRedrivePolicy: `{"deadLetterTargetArn":"other","maxReceiveCount":5}`,
+				},
+			},
+			want: false,
 		},
 	}
 
@@ -203,7 +243,7 @@ func TestGenerateQueueAttributes(t *testing.T) {
 			}),
 			out: map[string]string{
 				v1alpha1.AttributeDelaySeconds:   strconv.FormatInt(delaySeconds, 10),
-				v1alpha1.AttributeRedrivePolicy:  `{"deadLetterQueueARN":"arn","maxReceiveCount":5}`,
+				v1alpha1.AttributeRedrivePolicy:  `{"deadLetterTargetArn":"arn","maxReceiveCount":5}`,
 				v1alpha1.AttributeKmsMasterKeyID: kmsMasterKeyID,
 			},
 		},
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AssociationClient is the external client used for Association Custom
+// Resource
+type AssociationClient interface {
+	CreateAssociationRequest(*ssm.CreateAssociationInput) ssm.CreateAssociationRequest
+	DescribeAssociationRequest(*ssm.DescribeAssociationInput) ssm.DescribeAssociationRequest
+	UpdateAssociationRequest(*ssm.UpdateAssociationInput) ssm.UpdateAssociationRequest
+	DeleteAssociationRequest(*ssm.DeleteAssociationInput) ssm.DeleteAssociationRequest
+}
+
+// NewAssociationClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewAssociationClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (AssociationClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ssm.New(*cfg), err
+}
+
+// IsAssociationNotFound returns true if the error is because the association
+// doesn't exist.
+func IsAssociationNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == ssm.ErrCodeAssociationDoesNotExist
+	}
+	return false
+}
+
+func generateOutputLocation(in *v1alpha1.AssociationOutputLocation) *ssm.InstanceAssociationOutputLocation {
+	if in == nil {
+		return nil
+	}
+	return &ssm.InstanceAssociationOutputLocation{
+		S3Location: &ssm.S3OutputLocation{
+			OutputS3BucketName: aws.String(in.S3BucketName),
+			OutputS3KeyPrefix:  in.S3KeyPrefix,
+			OutputS3Region:     in.S3Region,
+		},
+	}
+}
+
+func generateOutputLocationSpec(in *ssm.InstanceAssociationOutputLocation) *v1alpha1.AssociationOutputLocation {
+	if in == nil || in.S3Location == nil {
+		return nil
+	}
+	return &v1alpha1.AssociationOutputLocation{
+		S3BucketName: aws.StringValue(in.S3Location.OutputS3BucketName),
+		S3KeyPrefix:  in.S3Location.OutputS3KeyPrefix,
+		S3Region:     in.S3Location.OutputS3Region,
+	}
+}
+
+// GenerateCreateAssociationInput returns the input to create an association
+// from the supplied parameters.
+func GenerateCreateAssociationInput(p v1alpha1.AssociationParameters) *ssm.CreateAssociationInput {
+	return &ssm.CreateAssociationInput{
+		Name:                          aws.String(p.DocumentName),
+		DocumentVersion:               p.DocumentVersion,
+		AssociationName:               p.AssociationName,
+		Targets:                       generateTargets(p.Targets),
+		Parameters:                    p.Parameters,
+		ScheduleExpression:            p.ScheduleExpression,
+		MaxConcurrency:                p.MaxConcurrency,
+		MaxErrors:                     p.MaxErrors,
+		ComplianceSeverity:            ssm.AssociationComplianceSeverity(aws.StringValue(p.ComplianceSeverity)),
+		SyncCompliance:                ssm.AssociationSyncCompliance(aws.StringValue(p.SyncCompliance)),
+		AutomationTargetParameterName: p.AutomationTargetParameterName,
+		OutputLocation:                generateOutputLocation(p.OutputLocation),
+	}
+}
+
+// GenerateUpdateAssociationInput returns the input to update the association
+// with the given ID from the supplied parameters.
+func GenerateUpdateAssociationInput(id string, p v1alpha1.AssociationParameters) *ssm.UpdateAssociationInput {
+	return &ssm.UpdateAssociationInput{
+		AssociationId:                 aws.String(id),
+		Name:                          aws.String(p.DocumentName),
+		DocumentVersion:               p.DocumentVersion,
+		AssociationName:               p.AssociationName,
+		Targets:                       generateTargets(p.Targets),
+		Parameters:                    p.Parameters,
+		ScheduleExpression:            p.ScheduleExpression,
+		MaxConcurrency:                p.MaxConcurrency,
+		MaxErrors:                     p.MaxErrors,
+		ComplianceSeverity:            ssm.AssociationComplianceSeverity(aws.StringValue(p.ComplianceSeverity)),
+		SyncCompliance:                ssm.AssociationSyncCompliance(aws.StringValue(p.SyncCompliance)),
+		AutomationTargetParameterName: p.AutomationTargetParameterName,
+		OutputLocation:                generateOutputLocation(p.OutputLocation),
+	}
+}
+
+// GenerateAssociationObservation is used to produce
+// v1alpha1.AssociationObservation from ssm.AssociationDescription.
+func GenerateAssociationObservation(o ssm.AssociationDescription) v1alpha1.AssociationObservation {
+	obs := v1alpha1.AssociationObservation{
+		AssociationID:      aws.StringValue(o.AssociationId),
+		AssociationVersion: aws.StringValue(o.AssociationVersion),
+	}
+	if o.Overview != nil {
+		obs.Status = aws.StringValue(o.Overview.Status)
+	}
+	if o.LastExecutionDate != nil {
+		t := metav1.NewTime(*o.LastExecutionDate)
+		obs.LastExecutionDate = &t
+	}
+	return obs
+}
+
+// LateInitializeAssociation fills the empty fields in
+// *v1alpha1.AssociationParameters with the values seen in
+// ssm.AssociationDescription.
+func LateInitializeAssociation(in *v1alpha1.AssociationParameters, o *ssm.AssociationDescription) {
+	if o == nil {
+		return
+	}
+	in.DocumentVersion = awsclients.LateInitializeStringPtr(in.DocumentVersion, o.DocumentVersion)
+	in.AssociationName = awsclients.LateInitializeStringPtr(in.AssociationName, o.AssociationName)
+	in.ScheduleExpression = awsclients.LateInitializeStringPtr(in.ScheduleExpression, o.ScheduleExpression)
+	in.MaxConcurrency = awsclients.LateInitializeStringPtr(in.MaxConcurrency, o.MaxConcurrency)
+	in.MaxErrors = awsclients.LateInitializeStringPtr(in.MaxErrors, o.MaxErrors)
+	in.AutomationTargetParameterName = awsclients.LateInitializeStringPtr(in.AutomationTargetParameterName, o.AutomationTargetParameterName)
+	if in.ComplianceSeverity == nil && o.ComplianceSeverity != "" {
+		in.ComplianceSeverity = aws.String(string(o.ComplianceSeverity))
+	}
+	if in.SyncCompliance == nil && o.SyncCompliance != "" {
+		in.SyncCompliance = aws.String(string(o.SyncCompliance))
+	}
+	if in.OutputLocation == nil {
+		in.OutputLocation = generateOutputLocationSpec(o.OutputLocation)
+	}
+}
+
+// IsAssociationUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsAssociationUpToDate(p v1alpha1.AssociationParameters, o ssm.AssociationDescription) bool {
+	return aws.StringValue(p.DocumentVersion) == aws.StringValue(o.DocumentVersion) &&
+		aws.StringValue(p.AssociationName) == aws.StringValue(o.AssociationName) &&
+		aws.StringValue(p.ScheduleExpression) == aws.StringValue(o.ScheduleExpression) &&
+		aws.StringValue(p.MaxConcurrency) == aws.StringValue(o.MaxConcurrency) &&
+		aws.StringValue(p.MaxErrors) == aws.StringValue(o.MaxErrors) &&
+		aws.StringValue(p.ComplianceSeverity) == string(o.ComplianceSeverity) &&
+		aws.StringValue(p.SyncCompliance) == string(o.SyncCompliance) &&
+		aws.StringValue(p.AutomationTargetParameterName) == aws.StringValue(o.AutomationTargetParameterName) &&
+		cmp.Equal(p.OutputLocation, generateOutputLocationSpec(o.OutputLocation)) &&
+		cmp.Equal(generateTargets(p.Targets), o.Targets, cmpopts.EquateEmpty()) &&
+		cmp.Equal(p.Parameters, o.Parameters, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/ghodss/yaml"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errParseDesiredContent  = "cannot parse desired document content"
+	errParseObservedContent = "cannot parse observed document content"
+
+	// DocumentVersionLatest refers to the most recent version of a document.
+	DocumentVersionLatest = "$LATEST"
+)
+
+// DocumentClient is the external client used for Document Custom Resource
+type DocumentClient interface {
+	CreateDocumentRequest(*ssm.CreateDocumentInput) ssm.CreateDocumentRequest
+	DescribeDocumentRequest(*ssm.DescribeDocumentInput) ssm.DescribeDocumentRequest
+	GetDocumentRequest(*ssm.GetDocumentInput) ssm.GetDocumentRequest
+	UpdateDocumentRequest(*ssm.UpdateDocumentInput) ssm.UpdateDocumentRequest
+	UpdateDocumentDefaultVersionRequest(*ssm.UpdateDocumentDefaultVersionInput) ssm.UpdateDocumentDefaultVersionRequest
+	DeleteDocumentRequest(*ssm.DeleteDocumentInput) ssm.DeleteDocumentRequest
+	DescribeDocumentPermissionRequest(*ssm.DescribeDocumentPermissionInput) ssm.DescribeDocumentPermissionRequest
+	ModifyDocumentPermissionRequest(*ssm.ModifyDocumentPermissionInput) ssm.ModifyDocumentPermissionRequest
+}
+
+// NewDocumentClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewDocumentClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (DocumentClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ssm.New(*cfg), err
+}
+
+// IsDocumentNotFound returns true if the error is because the document
+// doesn't exist.
+func IsDocumentNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == ssm.ErrCodeInvalidDocument
+	}
+	return false
+}
+
+// GenerateCreateDocumentInput returns the input to create a document with the
+// given name from the supplied parameters.
+func GenerateCreateDocumentInput(name string, p v1alpha1.DocumentParameters) *ssm.CreateDocumentInput {
+	return &ssm.CreateDocumentInput{
+		Name:           aws.String(name),
+		Content:        aws.String(p.Content),
+		DocumentFormat: ssm.DocumentFormat(aws.StringValue(p.DocumentFormat)),
+		DocumentType:   ssm.DocumentType(aws.StringValue(p.DocumentType)),
+		TargetType:     p.TargetType,
+		VersionName:    p.VersionName,
+		Tags:           generateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateDocumentInput returns the input to create a new version of
+// the document with the given name from the supplied parameters.
+func GenerateUpdateDocumentInput(name string, p v1alpha1.DocumentParameters) *ssm.UpdateDocumentInput {
+	return &ssm.UpdateDocumentInput{
+		Name:            aws.String(name),
+		Content:         aws.String(p.Content),
+		DocumentFormat:  ssm.DocumentFormat(aws.StringValue(p.DocumentFormat)),
+		DocumentVersion: aws.String(DocumentVersionLatest),
+		TargetType:      p.TargetType,
+		VersionName:     p.VersionName,
+	}
+}
+
+// GenerateDocumentObservation is used to produce v1alpha1.DocumentObservation
+// from ssm.DocumentDescription.
+func GenerateDocumentObservation(o ssm.DocumentDescription) v1alpha1.DocumentObservation {
+	obs := v1alpha1.DocumentObservation{
+		DefaultVersion:    aws.StringValue(o.DefaultVersion),
+		LatestVersion:     aws.StringValue(o.LatestVersion),
+		Status:            string(o.Status),
+		StatusInformation: aws.StringValue(o.StatusInformation),
+		Hash:              aws.StringValue(o.Hash),
+		Owner:             aws.StringValue(o.Owner),
+		SchemaVersion:     aws.StringValue(o.SchemaVersion),
+	}
+	for _, pt := range o.PlatformTypes {
+		obs.PlatformTypes = append(obs.PlatformTypes, string(pt))
+	}
+	return obs
+}
+
+// LateInitializeDocument fills the empty fields in *v1alpha1.DocumentParameters
+// with the values seen in ssm.DocumentDescription.
+func LateInitializeDocument(in *v1alpha1.DocumentParameters, o *ssm.DocumentDescription) {
+	if o == nil {
+		return
+	}
+	if in.DocumentFormat == nil && o.DocumentFormat != "" {
+		in.DocumentFormat = aws.String(string(o.DocumentFormat))
+	}
+	if in.DocumentType == nil && o.DocumentType != "" {
+		in.DocumentType = aws.String(string(o.DocumentType))
+	}
+	in.TargetType = awsclients.LateInitializeStringPtr(in.TargetType, o.TargetType)
+}
+
+// IsDocumentContentEqual compares the desired content with the observed one.
+// JSON and YAML content is compared semantically, so differences in
+// formatting and key order are ignored.
+func IsDocumentContentEqual(format, desired, observed string) (bool, error) {
+	if format == string(ssm.DocumentFormatText) {
+		return strings.TrimSpace(desired) == strings.TrimSpace(observed), nil
+	}
+	var d, o interface{}
+	if err := yaml.Unmarshal([]byte(desired), &d); err != nil {
+		return false, errors.Wrap(err, errParseDesiredContent)
+	}
+	if err := yaml.Unmarshal([]byte(observed), &o); err != nil {
+		return false, errors.Wrap(err, errParseObservedContent)
+	}
+	return cmp.Equal(d, o), nil
+}
+
+// IsDocumentVersionUpToDate returns true if the default version of the
+// document has the desired content and version name.
+func IsDocumentVersionUpToDate(p v1alpha1.DocumentParameters, o ssm.GetDocumentOutput) (bool, error) {
+	if p.VersionName != nil && aws.StringValue(p.VersionName) != aws.StringValue(o.VersionName) {
+		return false, nil
+	}
+	return IsDocumentContentEqual(aws.StringValue(p.DocumentFormat), p.Content, aws.StringValue(o.Content))
+}
+
+// DiffDocumentAccountIDs returns the account IDs the document needs to be
+// shared with and unshared from to reach the desired state.
+func DiffDocumentAccountIDs(desired, observed []string) (add, remove []string) {
+	d := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		d[id] = true
+	}
+	o := make(map[string]bool, len(observed))
+	for _, id := range observed {
+		o[id] = true
+		if !d[id] {
+			remove = append(remove, id)
+		}
+	}
+	for _, id := range desired {
+		if !o[id] {
+			add = append(add, id)
+		}
+	}
+	return add, remove
+}
+
+// IsDocumentUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsDocumentUpToDate(p v1alpha1.DocumentParameters, d ssm.DocumentDescription, c ssm.GetDocumentOutput, accountIDs []string) (bool, error) {
+	if aws.StringValue(p.TargetType) != aws.StringValue(d.TargetType) {
+		return false, nil
+	}
+	if add, remove := DiffDocumentAccountIDs(p.AccountIDs, accountIDs); len(add) != 0 || len(remove) != 0 {
+		return false, nil
+	}
+	return IsDocumentVersionUpToDate(p, c)
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestIsDocumentContentEqual(t *testing.T) {
+	type args struct {
+		format   string
+		desired  string
+		observed string
+	}
+	type want struct {
+		equal bool
+		err   bool
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"JSONKeyOrderAndWhitespace": {
+			args: args{
+				format:   "JSON",
+				desired:  `{"schemaVersion": "2.2", "mainSteps": [{"name": "a", "action": "aws:runShellScript"}]}`,
+				observed: "{\n  \"mainSteps\": [\n    {\"action\": \"aws:runShellScript\", \"name\": \"a\"}\n  ],\n  \"schemaVersion\": \"2.2\"\n}",
+			},
+			want: want{equal: true},
+		},
+		"JSONDifferentValues": {
+			args: args{
+				format:   "JSON",
+				desired:  `{"schemaVersion": "2.2"}`,
+				observed: `{"schemaVersion": "0.3"}`,
+			},
+			want: want{equal: false},
+		},
+		"YAMLComments": {
+			args: args{
+				format:   "YAML",
+				desired:  "# Restart the app\nschemaVersion: '2.2'\nmainSteps:\n  - name: a\n    action: aws:runShellScript\n",
+				observed: "mainSteps:\n- action: aws:runShellScript\n  name: a\nschemaVersion: '2.2'\n",
+			},
+			want: want{equal: true},
+		},
+		"YAMLListOrderMatters": {
+			args: args{
+				format:   "YAML",
+				desired:  "steps: [a, b]",
+				observed: "steps: [b, a]",
+			},
+			want: want{equal: false},
+		},
+		"TextTrailingNewline": {
+			args: args{
+				format:   "TEXT",
+				desired:  "echo hello\n",
+				observed: "echo hello",
+			},
+			want: want{equal: true},
+		},
+		"InvalidDesiredContent": {
+			args: args{
+				format:   "JSON",
+				desired:  `{"schemaVersion": `,
+				observed: `{"schemaVersion": "2.2"}`,
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			equal, err := IsDocumentContentEqual(tc.args.format, tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.equal, equal); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffDocumentAccountIDs(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"NoChange": {
+			desired:  []string{"123456789012", "210987654321"},
+			observed: []string{"210987654321", "123456789012"},
+		},
+		"Share": {
+			desired: []string{"123456789012"},
+			want:    want{add: []string{"123456789012"}},
+		},
+		"Unshare": {
+			observed: []string{"All"},
+			want:     want{remove: []string{"All"}},
+		},
+		"Replace": {
+			desired:  []string{"123456789012"},
+			observed: []string{"210987654321"},
+			want:     want{add: []string{"123456789012"}, remove: []string{"210987654321"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffDocumentAccountIDs(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ssm"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AssociationClient = (*MockAssociationClient)(nil)
+
+// MockAssociationClient is a type that implements all the methods for AssociationClient interface
+type MockAssociationClient struct {
+	MockCreateAssociation   func(*ssm.CreateAssociationInput) ssm.CreateAssociationRequest
+	MockDescribeAssociation func(*ssm.DescribeAssociationInput) ssm.DescribeAssociationRequest
+	MockUpdateAssociation   func(*ssm.UpdateAssociationInput) ssm.UpdateAssociationRequest
+	MockDeleteAssociation   func(*ssm.DeleteAssociationInput) ssm.DeleteAssociationRequest
+}
+
+// CreateAssociationRequest calls the underlying MockCreateAssociation method.
+func (c *MockAssociationClient) CreateAssociationRequest(i *ssm.CreateAssociationInput) ssm.CreateAssociationRequest {
+	return c.MockCreateAssociation(i)
+}
+
+// DescribeAssociationRequest calls the underlying MockDescribeAssociation method.
+func (c *MockAssociationClient) DescribeAssociationRequest(i *ssm.DescribeAssociationInput) ssm.DescribeAssociationRequest {
+	return c.MockDescribeAssociation(i)
+}
+
+// UpdateAssociationRequest calls the underlying MockUpdateAssociation method.
+func (c *MockAssociationClient) UpdateAssociationRequest(i *ssm.UpdateAssociationInput) ssm.UpdateAssociationRequest {
+	return c.MockUpdateAssociation(i)
+}
+
+// DeleteAssociationRequest calls the underlying MockDeleteAssociation method.
+func (c *MockAssociationClient) DeleteAssociationRequest(i *ssm.DeleteAssociationInput) ssm.DeleteAssociationRequest {
+	return c.MockDeleteAssociation(i)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ssm"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DocumentClient = (*MockDocumentClient)(nil)
+
+// MockDocumentClient is a type that implements all the methods for DocumentClient interface
+type MockDocumentClient struct {
+	MockCreateDocument               func(*ssm.CreateDocumentInput) ssm.CreateDocumentRequest
+	MockDescribeDocument             func(*ssm.DescribeDocumentInput) ssm.DescribeDocumentRequest
+	MockGetDocument                  func(*ssm.GetDocumentInput) ssm.GetDocumentRequest
+	MockUpdateDocument               func(*ssm.UpdateDocumentInput) ssm.UpdateDocumentRequest
+	MockUpdateDocumentDefaultVersion func(*ssm.UpdateDocumentDefaultVersionInput) ssm.UpdateDocumentDefaultVersionRequest
+	MockDeleteDocument               func(*ssm.DeleteDocumentInput) ssm.DeleteDocumentRequest
+	MockDescribeDocumentPermission   func(*ssm.DescribeDocumentPermissionInput) ssm.DescribeDocumentPermissionRequest
+	MockModifyDocumentPermission     func(*ssm.ModifyDocumentPermissionInput) ssm.ModifyDocumentPermissionRequest
+}
+
+// CreateDocumentRequest calls the underlying MockCreateDocument method.
+func (c *MockDocumentClient) CreateDocumentRequest(i *ssm.CreateDocumentInput) ssm.CreateDocumentRequest {
+	return c.MockCreateDocument(i)
+}
+
+// DescribeDocumentRequest calls the underlying MockDescribeDocument method.
+func (c *MockDocumentClient) DescribeDocumentRequest(i *ssm.DescribeDocumentInput) ssm.DescribeDocumentRequest {
+	return c.MockDescribeDocument(i)
+}
+
+// GetDocumentRequest calls the underlying MockGetDocument method.
+func (c *MockDocumentClient) GetDocumentRequest(i *ssm.GetDocumentInput) ssm.GetDocumentRequest {
+	return c.MockGetDocument(i)
+}
+
+// UpdateDocumentRequest calls the underlying MockUpdateDocument method.
+func (c *MockDocumentClient) UpdateDocumentRequest(i *ssm.UpdateDocumentInput) ssm.UpdateDocumentRequest {
+	return c.MockUpdateDocument(i)
+}
+
+// UpdateDocumentDefaultVersionRequest calls the underlying MockUpdateDocumentDefaultVersion method.
+func (c *MockDocumentClient) UpdateDocumentDefaultVersionRequest(i *ssm.UpdateDocumentDefaultVersionInput) ssm.UpdateDocumentDefaultVersionRequest {
+	return c.MockUpdateDocumentDefaultVersion(i)
+}
+
+// DeleteDocumentRequest calls the underlying MockDeleteDocument method.
+func (c *MockDocumentClient) DeleteDocumentRequest(i *ssm.DeleteDocumentInput) ssm.DeleteDocumentRequest {
+	return c.MockDeleteDocument(i)
+}
+
+// DescribeDocumentPermissionRequest calls the underlying MockDescribeDocumentPermission method.
+func (c *MockDocumentClient) DescribeDocumentPermissionRequest(i *ssm.DescribeDocumentPermissionInput) ssm.DescribeDocumentPermissionRequest {
+	return c.MockDescribeDocumentPermission(i)
+}
+
+// ModifyDocumentPermissionRequest calls the underlying MockModifyDocumentPermission method.
+func (c *MockDocumentClient) ModifyDocumentPermissionRequest(i *ssm.ModifyDocumentPermissionInput) ssm.ModifyDocumentPermissionRequest {
+	return c.MockModifyDocumentPermission(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ssm"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.MaintenanceWindowClient = (*MockMaintenanceWindowClient)(nil)
+
+// MockMaintenanceWindowClient is a type that implements all the methods for MaintenanceWindowClient interface
+type MockMaintenanceWindowClient struct {
+	MockCreateMaintenanceWindow func(*ssm.CreateMaintenanceWindowInput) ssm.CreateMaintenanceWindowRequest
+	MockGetMaintenanceWindow    func(*ssm.GetMaintenanceWindowInput) ssm.GetMaintenanceWindowRequest
+	MockUpdateMaintenanceWindow func(*ssm.UpdateMaintenanceWindowInput) ssm.UpdateMaintenanceWindowRequest
+	MockDeleteMaintenanceWindow func(*ssm.DeleteMaintenanceWindowInput) ssm.DeleteMaintenanceWindowRequest
+}
+
+// CreateMaintenanceWindowRequest calls the underlying MockCreateMaintenanceWindow method.
+func (c *MockMaintenanceWindowClient) CreateMaintenanceWindowRequest(i *ssm.CreateMaintenanceWindowInput) ssm.CreateMaintenanceWindowRequest {
+	return c.MockCreateMaintenanceWindow(i)
+}
+
+// GetMaintenanceWindowRequest calls the underlying MockGetMaintenanceWindow method.
+func (c *MockMaintenanceWindowClient) GetMaintenanceWindowRequest(i *ssm.GetMaintenanceWindowInput) ssm.GetMaintenanceWindowRequest {
+	return c.MockGetMaintenanceWindow(i)
+}
+
+// UpdateMaintenanceWindowRequest calls the underlying MockUpdateMaintenanceWindow method.
+func (c *MockMaintenanceWindowClient) UpdateMaintenanceWindowRequest(i *ssm.UpdateMaintenanceWindowInput) ssm.UpdateMaintenanceWindowRequest {
+	return c.MockUpdateMaintenanceWindow(i)
+}
+
+// DeleteMaintenanceWindowRequest calls the underlying MockDeleteMaintenanceWindow method.
+func (c *MockMaintenanceWindowClient) DeleteMaintenanceWindowRequest(i *ssm.DeleteMaintenanceWindowInput) ssm.DeleteMaintenanceWindowRequest {
+	return c.MockDeleteMaintenanceWindow(i)
+}
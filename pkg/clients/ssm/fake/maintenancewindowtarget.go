@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ssm"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.MaintenanceWindowTargetClient = (*MockMaintenanceWindowTargetClient)(nil)
+
+// MockMaintenanceWindowTargetClient is a type that implements all the methods for MaintenanceWindowTargetClient interface
+type MockMaintenanceWindowTargetClient struct {
+	MockRegisterTargetWithMaintenanceWindow   func(*ssm.RegisterTargetWithMaintenanceWindowInput) ssm.RegisterTargetWithMaintenanceWindowRequest
+	MockDescribeMaintenanceWindowTargets      func(*ssm.DescribeMaintenanceWindowTargetsInput) ssm.DescribeMaintenanceWindowTargetsRequest
+	MockUpdateMaintenanceWindowTarget         func(*ssm.UpdateMaintenanceWindowTargetInput) ssm.UpdateMaintenanceWindowTargetRequest
+	MockDeregisterTargetFromMaintenanceWindow func(*ssm.DeregisterTargetFromMaintenanceWindowInput) ssm.DeregisterTargetFromMaintenanceWindowRequest
+}
+
+// RegisterTargetWithMaintenanceWindowRequest calls the underlying MockRegisterTargetWithMaintenanceWindow method.
+func (c *MockMaintenanceWindowTargetClient) RegisterTargetWithMaintenanceWindowRequest(i *ssm.RegisterTargetWithMaintenanceWindowInput) ssm.RegisterTargetWithMaintenanceWindowRequest {
+	return c.MockRegisterTargetWithMaintenanceWindow(i)
+}
+
+// DescribeMaintenanceWindowTargetsRequest calls the underlying MockDescribeMaintenanceWindowTargets method.
+func (c *MockMaintenanceWindowTargetClient) DescribeMaintenanceWindowTargetsRequest(i *ssm.DescribeMaintenanceWindowTargetsInput) ssm.DescribeMaintenanceWindowTargetsRequest {
+	return c.MockDescribeMaintenanceWindowTargets(i)
+}
+
+// UpdateMaintenanceWindowTargetRequest calls the underlying MockUpdateMaintenanceWindowTarget method.
+func (c *MockMaintenanceWindowTargetClient) UpdateMaintenanceWindowTargetRequest(i *ssm.UpdateMaintenanceWindowTargetInput) ssm.UpdateMaintenanceWindowTargetRequest {
+	return c.MockUpdateMaintenanceWindowTarget(i)
+}
+
+// DeregisterTargetFromMaintenanceWindowRequest calls the underlying MockDeregisterTargetFromMaintenanceWindow method.
+func (c *MockMaintenanceWindowTargetClient) DeregisterTargetFromMaintenanceWindowRequest(i *ssm.DeregisterTargetFromMaintenanceWindowInput) ssm.DeregisterTargetFromMaintenanceWindowRequest {
+	return c.MockDeregisterTargetFromMaintenanceWindow(i)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ssm"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.MaintenanceWindowTaskClient = (*MockMaintenanceWindowTaskClient)(nil)
+
+// MockMaintenanceWindowTaskClient is a type that implements all the methods for MaintenanceWindowTaskClient interface
+type MockMaintenanceWindowTaskClient struct {
+	MockRegisterTaskWithMaintenanceWindow   func(*ssm.RegisterTaskWithMaintenanceWindowInput) ssm.RegisterTaskWithMaintenanceWindowRequest
+	MockGetMaintenanceWindowTask            func(*ssm.GetMaintenanceWindowTaskInput) ssm.GetMaintenanceWindowTaskRequest
+	MockUpdateMaintenanceWindowTask         func(*ssm.UpdateMaintenanceWindowTaskInput) ssm.UpdateMaintenanceWindowTaskRequest
+	MockDeregisterTaskFromMaintenanceWindow func(*ssm.DeregisterTaskFromMaintenanceWindowInput) ssm.DeregisterTaskFromMaintenanceWindowRequest
+}
+
+// RegisterTaskWithMaintenanceWindowRequest calls the underlying MockRegisterTaskWithMaintenanceWindow method.
+func (c *MockMaintenanceWindowTaskClient) RegisterTaskWithMaintenanceWindowRequest(i *ssm.RegisterTaskWithMaintenanceWindowInput) ssm.RegisterTaskWithMaintenanceWindowRequest {
+	return c.MockRegisterTaskWithMaintenanceWindow(i)
+}
+
+// GetMaintenanceWindowTaskRequest calls the underlying MockGetMaintenanceWindowTask method.
+func (c *MockMaintenanceWindowTaskClient) GetMaintenanceWindowTaskRequest(i *ssm.GetMaintenanceWindowTaskInput) ssm.GetMaintenanceWindowTaskRequest {
+	return c.MockGetMaintenanceWindowTask(i)
+}
+
+// UpdateMaintenanceWindowTaskRequest calls the underlying MockUpdateMaintenanceWindowTask method.
+func (c *MockMaintenanceWindowTaskClient) UpdateMaintenanceWindowTaskRequest(i *ssm.UpdateMaintenanceWindowTaskInput) ssm.UpdateMaintenanceWindowTaskRequest {
+	return c.MockUpdateMaintenanceWindowTask(i)
+}
+
+// DeregisterTaskFromMaintenanceWindowRequest calls the underlying MockDeregisterTaskFromMaintenanceWindow method.
+func (c *MockMaintenanceWindowTaskClient) DeregisterTaskFromMaintenanceWindowRequest(i *ssm.DeregisterTaskFromMaintenanceWindowInput) ssm.DeregisterTaskFromMaintenanceWindowRequest {
+	return c.MockDeregisterTaskFromMaintenanceWindow(i)
+}
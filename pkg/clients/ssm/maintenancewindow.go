@@ -0,0 +1,142 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// MaintenanceWindowClient is the external client used for MaintenanceWindow
+// Custom Resource
+type MaintenanceWindowClient interface {
+	CreateMaintenanceWindowRequest(*ssm.CreateMaintenanceWindowInput) ssm.CreateMaintenanceWindowRequest
+	GetMaintenanceWindowRequest(*ssm.GetMaintenanceWindowInput) ssm.GetMaintenanceWindowRequest
+	UpdateMaintenanceWindowRequest(*ssm.UpdateMaintenanceWindowInput) ssm.UpdateMaintenanceWindowRequest
+	DeleteMaintenanceWindowRequest(*ssm.DeleteMaintenanceWindowInput) ssm.DeleteMaintenanceWindowRequest
+}
+
+// NewMaintenanceWindowClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewMaintenanceWindowClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (MaintenanceWindowClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ssm.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the maintenance window, or
+// the target or task registered with it, doesn't exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == ssm.ErrCodeDoesNotExistException
+	}
+	return false
+}
+
+func generateTags(in []v1alpha1.Tag) []ssm.Tag {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]ssm.Tag, len(in))
+	for i, t := range in {
+		out[i] = ssm.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return out
+}
+
+// GenerateCreateMaintenanceWindowInput returns the input to create a
+// maintenance window from the supplied parameters.
+func GenerateCreateMaintenanceWindowInput(p v1alpha1.MaintenanceWindowParameters) *ssm.CreateMaintenanceWindowInput {
+	return &ssm.CreateMaintenanceWindowInput{
+		Name:                     aws.String(p.Name),
+		Description:              p.Description,
+		Schedule:                 aws.String(p.Schedule),
+		ScheduleTimezone:         p.ScheduleTimezone,
+		Duration:                 aws.Int64(p.Duration),
+		Cutoff:                   aws.Int64(p.Cutoff),
+		AllowUnassociatedTargets: aws.Bool(p.AllowUnassociatedTargets),
+		StartDate:                p.StartDate,
+		EndDate:                  p.EndDate,
+		Tags:                     generateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateMaintenanceWindowInput returns the input to update the
+// maintenance window with the given ID from the supplied parameters. All
+// fields are replaced so that unset optional fields are cleared.
+func GenerateUpdateMaintenanceWindowInput(id string, p v1alpha1.MaintenanceWindowParameters) *ssm.UpdateMaintenanceWindowInput {
+	return &ssm.UpdateMaintenanceWindowInput{
+		WindowId:                 aws.String(id),
+		Name:                     aws.String(p.Name),
+		Description:              p.Description,
+		Schedule:                 aws.String(p.Schedule),
+		ScheduleTimezone:         p.ScheduleTimezone,
+		Duration:                 aws.Int64(p.Duration),
+		Cutoff:                   aws.Int64(p.Cutoff),
+		AllowUnassociatedTargets: aws.Bool(p.AllowUnassociatedTargets),
+		Enabled:                  p.Enabled,
+		StartDate:                p.StartDate,
+		EndDate:                  p.EndDate,
+		Replace:                  aws.Bool(true),
+	}
+}
+
+// GenerateMaintenanceWindowObservation is used to produce
+// v1alpha1.MaintenanceWindowObservation from ssm.GetMaintenanceWindowOutput.
+func GenerateMaintenanceWindowObservation(o ssm.GetMaintenanceWindowOutput) v1alpha1.MaintenanceWindowObservation {
+	return v1alpha1.MaintenanceWindowObservation{
+		WindowID:          aws.StringValue(o.WindowId),
+		NextExecutionTime: aws.StringValue(o.NextExecutionTime),
+	}
+}
+
+// LateInitializeMaintenanceWindow fills the empty fields in
+// *v1alpha1.MaintenanceWindowParameters with the values seen in
+// ssm.GetMaintenanceWindowOutput.
+func LateInitializeMaintenanceWindow(in *v1alpha1.MaintenanceWindowParameters, o *ssm.GetMaintenanceWindowOutput) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+	in.ScheduleTimezone = awsclients.LateInitializeStringPtr(in.ScheduleTimezone, o.ScheduleTimezone)
+	in.Enabled = awsclients.LateInitializeBoolPtr(in.Enabled, o.Enabled)
+	in.StartDate = awsclients.LateInitializeStringPtr(in.StartDate, o.StartDate)
+	in.EndDate = awsclients.LateInitializeStringPtr(in.EndDate, o.EndDate)
+}
+
+// IsMaintenanceWindowUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsMaintenanceWindowUpToDate(p v1alpha1.MaintenanceWindowParameters, o ssm.GetMaintenanceWindowOutput) bool {
+	return p.Name == aws.StringValue(o.Name) &&
+		aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		p.Schedule == aws.StringValue(o.Schedule) &&
+		aws.StringValue(p.ScheduleTimezone) == aws.StringValue(o.ScheduleTimezone) &&
+		p.Duration == aws.Int64Value(o.Duration) &&
+		p.Cutoff == aws.Int64Value(o.Cutoff) &&
+		p.AllowUnassociatedTargets == aws.BoolValue(o.AllowUnassociatedTargets) &&
+		aws.BoolValue(p.Enabled) == aws.BoolValue(o.Enabled) &&
+		aws.StringValue(p.StartDate) == aws.StringValue(o.StartDate) &&
+		aws.StringValue(p.EndDate) == aws.StringValue(o.EndDate)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// MaintenanceWindowTargetClient is the external client used for
+// MaintenanceWindowTarget Custom Resource
+type MaintenanceWindowTargetClient interface {
+	RegisterTargetWithMaintenanceWindowRequest(*ssm.RegisterTargetWithMaintenanceWindowInput) ssm.RegisterTargetWithMaintenanceWindowRequest
+	DescribeMaintenanceWindowTargetsRequest(*ssm.DescribeMaintenanceWindowTargetsInput) ssm.DescribeMaintenanceWindowTargetsRequest
+	UpdateMaintenanceWindowTargetRequest(*ssm.UpdateMaintenanceWindowTargetInput) ssm.UpdateMaintenanceWindowTargetRequest
+	DeregisterTargetFromMaintenanceWindowRequest(*ssm.DeregisterTargetFromMaintenanceWindowInput) ssm.DeregisterTargetFromMaintenanceWindowRequest
+}
+
+// NewMaintenanceWindowTargetClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewMaintenanceWindowTargetClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (MaintenanceWindowTargetClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ssm.New(*cfg), err
+}
+
+func generateTargets(in []v1alpha1.Target) []ssm.Target {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]ssm.Target, len(in))
+	for i, t := range in {
+		out[i] = ssm.Target{Key: aws.String(t.Key), Values: t.Values}
+	}
+	return out
+}
+
+// GenerateDescribeMaintenanceWindowTargetsInput returns the input to look up
+// the target registration with the given ID.
+func GenerateDescribeMaintenanceWindowTargetsInput(id string, p v1alpha1.MaintenanceWindowTargetParameters) *ssm.DescribeMaintenanceWindowTargetsInput {
+	return &ssm.DescribeMaintenanceWindowTargetsInput{
+		WindowId: p.WindowID,
+		Filters: []ssm.MaintenanceWindowFilter{{
+			Key:    aws.String("WindowTargetId"),
+			Values: []string{id},
+		}},
+	}
+}
+
+// GenerateRegisterTargetWithMaintenanceWindowInput returns the input to
+// register a target from the supplied parameters.
+func GenerateRegisterTargetWithMaintenanceWindowInput(p v1alpha1.MaintenanceWindowTargetParameters) *ssm.RegisterTargetWithMaintenanceWindowInput {
+	return &ssm.RegisterTargetWithMaintenanceWindowInput{
+		WindowId:         p.WindowID,
+		ResourceType:     ssm.MaintenanceWindowResourceType(p.ResourceType),
+		Targets:          generateTargets(p.Targets),
+		Name:             p.Name,
+		Description:      p.Description,
+		OwnerInformation: p.OwnerInformation,
+	}
+}
+
+// GenerateUpdateMaintenanceWindowTargetInput returns the input to update the
+// target registration with the given ID from the supplied parameters.
+func GenerateUpdateMaintenanceWindowTargetInput(id string, p v1alpha1.MaintenanceWindowTargetParameters) *ssm.UpdateMaintenanceWindowTargetInput {
+	return &ssm.UpdateMaintenanceWindowTargetInput{
+		WindowId:         p.WindowID,
+		WindowTargetId:   aws.String(id),
+		Targets:          generateTargets(p.Targets),
+		Name:             p.Name,
+		Description:      p.Description,
+		OwnerInformation: p.OwnerInformation,
+		Replace:          aws.Bool(true),
+	}
+}
+
+// LateInitializeMaintenanceWindowTarget fills the empty fields in
+// *v1alpha1.MaintenanceWindowTargetParameters with the values seen in
+// ssm.MaintenanceWindowTarget.
+func LateInitializeMaintenanceWindowTarget(in *v1alpha1.MaintenanceWindowTargetParameters, o *ssm.MaintenanceWindowTarget) {
+	if o == nil {
+		return
+	}
+	in.Name = awsclients.LateInitializeStringPtr(in.Name, o.Name)
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+	in.OwnerInformation = awsclients.LateInitializeStringPtr(in.OwnerInformation, o.OwnerInformation)
+}
+
+// IsMaintenanceWindowTargetUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsMaintenanceWindowTargetUpToDate(p v1alpha1.MaintenanceWindowTargetParameters, o ssm.MaintenanceWindowTarget) bool {
+	return aws.StringValue(p.Name) == aws.StringValue(o.Name) &&
+		aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		aws.StringValue(p.OwnerInformation) == aws.StringValue(o.OwnerInformation) &&
+		cmp.Equal(generateTargets(p.Targets), o.Targets, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// MaintenanceWindowTaskClient is the external client used for
+// MaintenanceWindowTask Custom Resource
+type MaintenanceWindowTaskClient interface {
+	RegisterTaskWithMaintenanceWindowRequest(*ssm.RegisterTaskWithMaintenanceWindowInput) ssm.RegisterTaskWithMaintenanceWindowRequest
+	GetMaintenanceWindowTaskRequest(*ssm.GetMaintenanceWindowTaskInput) ssm.GetMaintenanceWindowTaskRequest
+	UpdateMaintenanceWindowTaskRequest(*ssm.UpdateMaintenanceWindowTaskInput) ssm.UpdateMaintenanceWindowTaskRequest
+	DeregisterTaskFromMaintenanceWindowRequest(*ssm.DeregisterTaskFromMaintenanceWindowInput) ssm.DeregisterTaskFromMaintenanceWindowRequest
+}
+
+// NewMaintenanceWindowTaskClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewMaintenanceWindowTaskClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (MaintenanceWindowTaskClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return ssm.New(*cfg), err
+}
+
+func generateTaskInvocationParameters(in *v1alpha1.TaskInvocationParameters) *ssm.MaintenanceWindowTaskInvocationParameters { // nolint:gocyclo
+	if in == nil {
+		return nil
+	}
+	out := &ssm.MaintenanceWindowTaskInvocationParameters{}
+	if rc := in.RunCommand; rc != nil {
+		out.RunCommand = &ssm.MaintenanceWindowRunCommandParameters{
+			Comment:            rc.Comment,
+			DocumentVersion:    rc.DocumentVersion,
+			OutputS3BucketName: rc.OutputS3BucketName,
+			OutputS3KeyPrefix:  rc.OutputS3KeyPrefix,
+			Parameters:         rc.Parameters,
+			ServiceRoleArn:     rc.ServiceRoleARN,
+			TimeoutSeconds:     rc.TimeoutSeconds,
+		}
+		if cw := rc.CloudWatchOutputConfig; cw != nil {
+			out.RunCommand.CloudWatchOutputConfig = &ssm.CloudWatchOutputConfig{
+				CloudWatchLogGroupName:  cw.CloudWatchLogGroupName,
+				CloudWatchOutputEnabled: cw.CloudWatchOutputEnabled,
+			}
+		}
+	}
+	if a := in.Automation; a != nil {
+		out.Automation = &ssm.MaintenanceWindowAutomationParameters{
+			DocumentVersion: a.DocumentVersion,
+			Parameters:      a.Parameters,
+		}
+	}
+	if l := in.Lambda; l != nil {
+		out.Lambda = &ssm.MaintenanceWindowLambdaParameters{
+			ClientContext: l.ClientContext,
+			Qualifier:     l.Qualifier,
+		}
+		if l.Payload != nil {
+			out.Lambda.Payload = []byte(aws.StringValue(l.Payload))
+		}
+	}
+	if sf := in.StepFunctions; sf != nil {
+		out.StepFunctions = &ssm.MaintenanceWindowStepFunctionsParameters{
+			Input: sf.Input,
+			Name:  sf.Name,
+		}
+	}
+	return out
+}
+
+func generateTaskInvocationParametersSpec(in *ssm.MaintenanceWindowTaskInvocationParameters) *v1alpha1.TaskInvocationParameters { // nolint:gocyclo
+	if in == nil {
+		return nil
+	}
+	out := &v1alpha1.TaskInvocationParameters{}
+	if rc := in.RunCommand; rc != nil {
+		out.RunCommand = &v1alpha1.RunCommandParameters{
+			Comment:            rc.Comment,
+			DocumentVersion:    rc.DocumentVersion,
+			OutputS3BucketName: rc.OutputS3BucketName,
+			OutputS3KeyPrefix:  rc.OutputS3KeyPrefix,
+			Parameters:         rc.Parameters,
+			ServiceRoleARN:     rc.ServiceRoleArn,
+			TimeoutSeconds:     rc.TimeoutSeconds,
+		}
+		if cw := rc.CloudWatchOutputConfig; cw != nil {
+			out.RunCommand.CloudWatchOutputConfig = &v1alpha1.CloudWatchOutputConfig{
+				CloudWatchLogGroupName:  cw.CloudWatchLogGroupName,
+				CloudWatchOutputEnabled: cw.CloudWatchOutputEnabled,
+			}
+		}
+	}
+	if a := in.Automation; a != nil {
+		out.Automation = &v1alpha1.AutomationParameters{
+			DocumentVersion: a.DocumentVersion,
+			Parameters:      a.Parameters,
+		}
+	}
+	if l := in.Lambda; l != nil {
+		out.Lambda = &v1alpha1.LambdaParameters{
+			ClientContext: l.ClientContext,
+			Qualifier:     l.Qualifier,
+		}
+		if l.Payload != nil {
+			out.Lambda.Payload = aws.String(string(l.Payload))
+		}
+	}
+	if sf := in.StepFunctions; sf != nil {
+		out.StepFunctions = &v1alpha1.StepFunctionsParameters{
+			Input: sf.Input,
+			Name:  sf.Name,
+		}
+	}
+	return out
+}
+
+// GenerateRegisterTaskWithMaintenanceWindowInput returns the input to
+// register a task from the supplied parameters.
+func GenerateRegisterTaskWithMaintenanceWindowInput(p v1alpha1.MaintenanceWindowTaskParameters) *ssm.RegisterTaskWithMaintenanceWindowInput {
+	return &ssm.RegisterTaskWithMaintenanceWindowInput{
+		WindowId:                 p.WindowID,
+		TaskType:                 ssm.MaintenanceWindowTaskType(p.TaskType),
+		TaskArn:                  aws.String(p.TaskARN),
+		Targets:                  generateTargets(p.Targets),
+		MaxConcurrency:           aws.String(p.MaxConcurrency),
+		MaxErrors:                aws.String(p.MaxErrors),
+		Priority:                 p.Priority,
+		ServiceRoleArn:           p.ServiceRoleARN,
+		Name:                     p.Name,
+		Description:              p.Description,
+		TaskInvocationParameters: generateTaskInvocationParameters(p.TaskInvocationParameters),
+	}
+}
+
+// GenerateUpdateMaintenanceWindowTaskInput returns the input to update the
+// task registration with the given ID from the supplied parameters.
+func GenerateUpdateMaintenanceWindowTaskInput(id string, p v1alpha1.MaintenanceWindowTaskParameters) *ssm.UpdateMaintenanceWindowTaskInput {
+	return &ssm.UpdateMaintenanceWindowTaskInput{
+		WindowId:                 p.WindowID,
+		WindowTaskId:             aws.String(id),
+		TaskArn:                  aws.String(p.TaskARN),
+		Targets:                  generateTargets(p.Targets),
+		MaxConcurrency:           aws.String(p.MaxConcurrency),
+		MaxErrors:                aws.String(p.MaxErrors),
+		Priority:                 p.Priority,
+		ServiceRoleArn:           p.ServiceRoleARN,
+		Name:                     p.Name,
+		Description:              p.Description,
+		TaskInvocationParameters: generateTaskInvocationParameters(p.TaskInvocationParameters),
+		Replace:                  aws.Bool(true),
+	}
+}
+
+// LateInitializeMaintenanceWindowTask fills the empty fields in
+// *v1alpha1.MaintenanceWindowTaskParameters with the values seen in
+// ssm.GetMaintenanceWindowTaskOutput.
+func LateInitializeMaintenanceWindowTask(in *v1alpha1.MaintenanceWindowTaskParameters, o *ssm.GetMaintenanceWindowTaskOutput) {
+	if o == nil {
+		return
+	}
+	in.Priority = awsclients.LateInitializeInt64Ptr(in.Priority, o.Priority)
+	in.ServiceRoleARN = awsclients.LateInitializeStringPtr(in.ServiceRoleARN, o.ServiceRoleArn)
+	in.Name = awsclients.LateInitializeStringPtr(in.Name, o.Name)
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+	if in.TaskInvocationParameters == nil {
+		in.TaskInvocationParameters = generateTaskInvocationParametersSpec(o.TaskInvocationParameters)
+		return
+	}
+	if in.TaskInvocationParameters.RunCommand != nil && o.TaskInvocationParameters != nil && o.TaskInvocationParameters.RunCommand != nil {
+		rc := o.TaskInvocationParameters.RunCommand
+		in.TaskInvocationParameters.RunCommand.DocumentVersion = awsclients.LateInitializeStringPtr(in.TaskInvocationParameters.RunCommand.DocumentVersion, rc.DocumentVersion)
+		in.TaskInvocationParameters.RunCommand.TimeoutSeconds = awsclients.LateInitializeInt64Ptr(in.TaskInvocationParameters.RunCommand.TimeoutSeconds, rc.TimeoutSeconds)
+	}
+}
+
+// IsMaintenanceWindowTaskUpToDate returns true if there is no update-able
+// difference between desired and observed state of the resource.
+func IsMaintenanceWindowTaskUpToDate(p v1alpha1.MaintenanceWindowTaskParameters, o ssm.GetMaintenanceWindowTaskOutput) bool {
+	return p.TaskARN == aws.StringValue(o.TaskArn) &&
+		p.MaxConcurrency == aws.StringValue(o.MaxConcurrency) &&
+		p.MaxErrors == aws.StringValue(o.MaxErrors) &&
+		aws.Int64Value(p.Priority) == aws.Int64Value(o.Priority) &&
+		aws.StringValue(p.ServiceRoleARN) == aws.StringValue(o.ServiceRoleArn) &&
+		aws.StringValue(p.Name) == aws.StringValue(o.Name) &&
+		aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		cmp.Equal(generateTargets(p.Targets), o.Targets, cmpopts.EquateEmpty()) &&
+		cmp.Equal(p.TaskInvocationParameters, generateTaskInvocationParametersSpec(o.TaskInvocationParameters), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+)
+
+func taskParams(m ...func(*v1alpha1.MaintenanceWindowTaskParameters)) v1alpha1.MaintenanceWindowTaskParameters {
+	p := v1alpha1.MaintenanceWindowTaskParameters{
+		WindowID:       aws.String("mw-0c50858d01EXAMPLE"),
+		TaskType:       "RUN_COMMAND",
+		TaskARN:        "AWS-RunPatchBaseline",
+		Targets:        []v1alpha1.Target{{Key: "WindowTargetIds", Values: []string{"e32eecb2"}}},
+		MaxConcurrency: "2",
+		MaxErrors:      "1",
+		Priority:       aws.Int64(1),
+		TaskInvocationParameters: &v1alpha1.TaskInvocationParameters{
+			RunCommand: &v1alpha1.RunCommandParameters{
+				Parameters:     map[string][]string{"Operation": {"Install"}},
+				TimeoutSeconds: aws.Int64(600),
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func task(m ...func(*ssm.GetMaintenanceWindowTaskOutput)) ssm.GetMaintenanceWindowTaskOutput {
+	o := ssm.GetMaintenanceWindowTaskOutput{
+		WindowId:       aws.String("mw-0c50858d01EXAMPLE"),
+		TaskType:       ssm.MaintenanceWindowTaskTypeRunCommand,
+		TaskArn:        aws.String("AWS-RunPatchBaseline"),
+		Targets:        []ssm.Target{{Key: aws.String("WindowTargetIds"), Values: []string{"e32eecb2"}}},
+		MaxConcurrency: aws.String("2"),
+		MaxErrors:      aws.String("1"),
+		Priority:       aws.Int64(1),
+		TaskInvocationParameters: &ssm.MaintenanceWindowTaskInvocationParameters{
+			RunCommand: &ssm.MaintenanceWindowRunCommandParameters{
+				Parameters:     map[string][]string{"Operation": {"Install"}},
+				TimeoutSeconds: aws.Int64(600),
+			},
+		},
+	}
+	for _, f := range m {
+		f(&o)
+	}
+	return o
+}
+
+func TestGenerateTaskInvocationParameters(t *testing.T) {
+	cases := map[string]struct {
+		in *v1alpha1.TaskInvocationParameters
+	}{
+		"Nil": {},
+		"RunCommand": {
+			in: taskParams().TaskInvocationParameters,
+		},
+		"Lambda": {
+			in: &v1alpha1.TaskInvocationParameters{
+				Lambda: &v1alpha1.LambdaParameters{
+					Payload:   aws.String(`{"key": "value"}`),
+					Qualifier: aws.String("live"),
+				},
+			},
+		},
+		"StepFunctions": {
+			in: &v1alpha1.TaskInvocationParameters{
+				StepFunctions: &v1alpha1.StepFunctionsParameters{
+					Input: aws.String("{}"),
+					Name:  aws.String("patch"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateTaskInvocationParametersSpec(generateTaskInvocationParameters(tc.in))
+			if diff := cmp.Diff(tc.in, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMaintenanceWindowTaskUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params   v1alpha1.MaintenanceWindowTaskParameters
+		observed ssm.GetMaintenanceWindowTaskOutput
+		want     bool
+	}{
+		"SameFields": {
+			params:   taskParams(),
+			observed: task(),
+			want:     true,
+		},
+		"DifferentMaxErrors": {
+			params:   taskParams(func(p *v1alpha1.MaintenanceWindowTaskParameters) { p.MaxErrors = "10%" }),
+			observed: task(),
+			want:     false,
+		},
+		"DifferentTargets": {
+			params: taskParams(func(p *v1alpha1.MaintenanceWindowTaskParameters) {
+				p.Targets = []v1alpha1.Target{{Key: "InstanceIds", Values: []string{"i-1234567890abcdef0"}}}
+			}),
+			observed: task(),
+			want:     false,
+		},
+		"DifferentInvocationParameters": {
+			params: taskParams(),
+			observed: task(func(o *ssm.GetMaintenanceWindowTaskOutput) {
+				o.TaskInvocationParameters.RunCommand.Parameters = map[string][]string{"Operation": {"Scan"}}
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMaintenanceWindowTaskUpToDate(tc.params, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeMaintenanceWindowTask(t *testing.T) {
+	cases := map[string]struct {
+		params   v1alpha1.MaintenanceWindowTaskParameters
+		observed ssm.GetMaintenanceWindowTaskOutput
+		want     v1alpha1.MaintenanceWindowTaskParameters
+	}{
+		"AllFilled": {
+			params:   taskParams(),
+			observed: task(),
+			want:     taskParams(),
+		},
+		"DefaultTimeout": {
+			params: taskParams(func(p *v1alpha1.MaintenanceWindowTaskParameters) {
+				p.TaskInvocationParameters.RunCommand.TimeoutSeconds = nil
+			}),
+			observed: task(),
+			want:     taskParams(),
+		},
+		"InvocationParametersFromAWS": {
+			params: taskParams(func(p *v1alpha1.MaintenanceWindowTaskParameters) {
+				p.TaskInvocationParameters = nil
+				p.Priority = nil
+			}),
+			observed: task(),
+			want:     taskParams(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeMaintenanceWindowTask(&tc.params, &tc.observed)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
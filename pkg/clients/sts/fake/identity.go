@@ -0,0 +1,36 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/sts"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CallerIdentityClient = (*MockCallerIdentityClient)(nil)
+
+// MockCallerIdentityClient is a type that implements all the methods for CallerIdentityClient interface
+type MockCallerIdentityClient struct {
+	MockGetCallerIdentity func(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+}
+
+// GetCallerIdentityRequest calls the underlying MockGetCallerIdentity method.
+func (c *MockCallerIdentityClient) GetCallerIdentityRequest(i *sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest {
+	return c.MockGetCallerIdentity(i)
+}
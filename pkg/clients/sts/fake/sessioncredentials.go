@@ -0,0 +1,36 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/sts"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SessionCredentialsClient = (*MockSessionCredentialsClient)(nil)
+
+// MockSessionCredentialsClient is a type that implements all the methods for SessionCredentialsClient interface
+type MockSessionCredentialsClient struct {
+	MockAssumeRole func(*sts.AssumeRoleInput) sts.AssumeRoleRequest
+}
+
+// AssumeRoleRequest calls the underlying MockAssumeRole method.
+func (c *MockSessionCredentialsClient) AssumeRoleRequest(i *sts.AssumeRoleInput) sts.AssumeRoleRequest {
+	return c.MockAssumeRole(i)
+}
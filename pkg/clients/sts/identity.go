@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// CallerIdentityClient is the client used to identify the credentials of a
+// Provider.
+type CallerIdentityClient interface {
+	GetCallerIdentityRequest(*sts.GetCallerIdentityInput) sts.GetCallerIdentityRequest
+}
+
+// NewCallerIdentityClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewCallerIdentityClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (CallerIdentityClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return sts.New(*cfg), err
+}
+
+// GenerateProviderIdentity fills the identity fields of the supplied
+// v1alpha3.ProviderStatus from the output of GetCallerIdentity.
+func GenerateProviderIdentity(o sts.GetCallerIdentityOutput, s *v1alpha3.ProviderStatus) {
+	s.AccountID = aws.StringValue(o.Account)
+	s.ARN = aws.StringValue(o.Arn)
+	s.Partition = ""
+	if a, err := arn.Parse(s.ARN); err == nil {
+		s.Partition = a.Partition
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+)
+
+func TestGenerateProviderIdentity(t *testing.T) {
+	cases := map[string]struct {
+		o    sts.GetCallerIdentityOutput
+		s    v1alpha3.ProviderStatus
+		want v1alpha3.ProviderStatus
+	}{
+		"Commercial": {
+			o: sts.GetCallerIdentityOutput{
+				Account: aws.String("123456789012"),
+				Arn:     aws.String("arn:aws:iam::123456789012:user/crossplane"),
+			},
+			want: v1alpha3.ProviderStatus{
+				AccountID: "123456789012",
+				ARN:       "arn:aws:iam::123456789012:user/crossplane",
+				Partition: "aws",
+			},
+		},
+		"GovCloud": {
+			o: sts.GetCallerIdentityOutput{
+				Account: aws.String("123456789012"),
+				Arn:     aws.String("arn:aws-us-gov:sts::123456789012:assumed-role/crossplane/session"),
+			},
+			want: v1alpha3.ProviderStatus{
+				AccountID: "123456789012",
+				ARN:       "arn:aws-us-gov:sts::123456789012:assumed-role/crossplane/session",
+				Partition: "aws-us-gov",
+			},
+		},
+		"ReplacesStaleIdentity": {
+			o: sts.GetCallerIdentityOutput{
+				Account: aws.String("210987654321"),
+			},
+			s: v1alpha3.ProviderStatus{
+				AccountID: "123456789012",
+				ARN:       "arn:aws:iam::123456789012:user/crossplane",
+				Partition: "aws",
+			},
+			want: v1alpha3.ProviderStatus{
+				AccountID: "210987654321",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			GenerateProviderIdentity(tc.o, &tc.s)
+			if diff := cmp.Diff(tc.want, tc.s); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/sts/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Connection secret keys of a SessionCredentials.
+const (
+	ConnectionKeyAccessKeyID     = "accessKeyId"
+	ConnectionKeySecretAccessKey = "secretAccessKey"
+	ConnectionKeySessionToken    = "sessionToken"
+	ConnectionKeyExpiration      = "expiration"
+
+	// ConnectionKeyCredentials holds the credentials as a shared credentials
+	// file that can be mounted and pointed at by AWS_SHARED_CREDENTIALS_FILE.
+	ConnectionKeyCredentials = "credentials"
+)
+
+// DefaultRefreshBefore is how long before their expiration credentials are
+// replaced when a SessionCredentials does not specify it.
+const DefaultRefreshBefore = 5 * time.Minute
+
+// SessionCredentialsClient is the external client used for SessionCredentials
+// Custom Resource
+type SessionCredentialsClient interface {
+	AssumeRoleRequest(*sts.AssumeRoleInput) sts.AssumeRoleRequest
+}
+
+// NewSessionCredentialsClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewSessionCredentialsClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (SessionCredentialsClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return sts.New(*cfg), err
+}
+
+// GenerateAssumeRoleInput returns the input of the AssumeRole call that issues
+// credentials for the supplied parameters. The session name defaults to the
+// supplied name.
+func GenerateAssumeRoleInput(name string, p v1alpha1.SessionCredentialsParameters) *sts.AssumeRoleInput {
+	in := &sts.AssumeRoleInput{
+		RoleArn:         p.RoleARN,
+		RoleSessionName: aws.String(name),
+		DurationSeconds: p.DurationSeconds,
+		ExternalId:      p.ExternalID,
+		Policy:          p.Policy,
+	}
+	if p.RoleSessionName != nil {
+		in.RoleSessionName = p.RoleSessionName
+	}
+	for _, arn := range p.PolicyARNs {
+		in.PolicyArns = append(in.PolicyArns, sts.PolicyDescriptorType{Arn: aws.String(arn)})
+	}
+	return in
+}
+
+// GenerateObservation is used to produce v1alpha1.SessionCredentialsObservation
+// from the output of an AssumeRole call for the supplied role.
+func GenerateObservation(roleARN string, out sts.AssumeRoleOutput) v1alpha1.SessionCredentialsObservation {
+	o := v1alpha1.SessionCredentialsObservation{RoleARN: roleARN}
+	if out.AssumedRoleUser != nil {
+		o.AssumedRoleARN = aws.StringValue(out.AssumedRoleUser.Arn)
+		o.AssumedRoleID = aws.StringValue(out.AssumedRoleUser.AssumedRoleId)
+	}
+	if out.Credentials != nil && out.Credentials.Expiration != nil {
+		t := metav1.NewTime(*out.Credentials.Expiration)
+		o.Expiration = &t
+	}
+	return o
+}
+
+// GetConnectionDetails returns the connection details of the supplied
+// credentials.
+func GetConnectionDetails(c *sts.Credentials) managed.ConnectionDetails {
+	if c == nil {
+		return nil
+	}
+	cd := managed.ConnectionDetails{
+		ConnectionKeyAccessKeyID:     []byte(aws.StringValue(c.AccessKeyId)),
+		ConnectionKeySecretAccessKey: []byte(aws.StringValue(c.SecretAccessKey)),
+		ConnectionKeySessionToken:    []byte(aws.StringValue(c.SessionToken)),
+		ConnectionKeyCredentials: []byte(fmt.Sprintf("[default]\naws_access_key_id = %s\naws_secret_access_key = %s\naws_session_token = %s\n",
+			aws.StringValue(c.AccessKeyId), aws.StringValue(c.SecretAccessKey), aws.StringValue(c.SessionToken))),
+	}
+	if c.Expiration != nil {
+		cd[ConnectionKeyExpiration] = []byte(c.Expiration.UTC().Format(time.RFC3339))
+	}
+	return cd
+}
+
+// IsSessionCredentialsUpToDate returns true if the credentials described by
+// the supplied observation were issued for the desired role and are not due
+// for a refresh at the supplied time.
+func IsSessionCredentialsUpToDate(p v1alpha1.SessionCredentialsParameters, o v1alpha1.SessionCredentialsObservation, now time.Time) bool {
+	if o.Expiration == nil || o.RoleARN != aws.StringValue(p.RoleARN) {
+		return false
+	}
+	refreshBefore := DefaultRefreshBefore
+	if p.RefreshBefore != nil {
+		refreshBefore = p.RefreshBefore.Duration
+	}
+	return now.Add(refreshBefore).Before(o.Expiration.Time)
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/sts/v1alpha1"
+)
+
+var (
+	credentialsName = "some-credentials"
+	roleARN         = "arn:aws:iam::123456789012:role/some-role"
+	policyARN       = "arn:aws:iam::123456789012:policy/some-policy"
+	expiration      = time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func TestGenerateAssumeRoleInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.SessionCredentialsParameters
+		want *sts.AssumeRoleInput
+	}{
+		"DefaultSessionName": {
+			p: v1alpha1.SessionCredentialsParameters{RoleARN: aws.String(roleARN)},
+			want: &sts.AssumeRoleInput{
+				RoleArn:         aws.String(roleARN),
+				RoleSessionName: aws.String(credentialsName),
+			},
+		},
+		"AllFields": {
+			p: v1alpha1.SessionCredentialsParameters{
+				RoleARN:         aws.String(roleARN),
+				RoleSessionName: aws.String("session"),
+				DurationSeconds: aws.Int64(900),
+				ExternalID:      aws.String("external"),
+				Policy:          aws.String("{}"),
+				PolicyARNs:      []string{policyARN},
+			},
+			want: &sts.AssumeRoleInput{
+				RoleArn:         aws.String(roleARN),
+				RoleSessionName: aws.String("session"),
+				DurationSeconds: aws.Int64(900),
+				ExternalId:      aws.String("external"),
+				Policy:          aws.String("{}"),
+				PolicyArns:      []sts.PolicyDescriptorType{{Arn: aws.String(policyARN)}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAssumeRoleInput(credentialsName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	exp := metav1.NewTime(expiration)
+	cases := map[string]struct {
+		out  sts.AssumeRoleOutput
+		want v1alpha1.SessionCredentialsObservation
+	}{
+		"Empty": {
+			want: v1alpha1.SessionCredentialsObservation{RoleARN: roleARN},
+		},
+		"Full": {
+			out: sts.AssumeRoleOutput{
+				AssumedRoleUser: &sts.AssumedRoleUser{
+					Arn:           aws.String("arn:aws:sts::123456789012:assumed-role/some-role/session"),
+					AssumedRoleId: aws.String("AROAEXAMPLE:session"),
+				},
+				Credentials: &sts.Credentials{Expiration: &expiration},
+			},
+			want: v1alpha1.SessionCredentialsObservation{
+				RoleARN:        roleARN,
+				AssumedRoleARN: "arn:aws:sts::123456789012:assumed-role/some-role/session",
+				AssumedRoleID:  "AROAEXAMPLE:session",
+				Expiration:     &exp,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateObservation(roleARN, tc.out)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		c    *sts.Credentials
+		want managed.ConnectionDetails
+	}{
+		"Nil": {},
+		"Credentials": {
+			c: &sts.Credentials{
+				AccessKeyId:     aws.String("ASIAEXAMPLE"),
+				SecretAccessKey: aws.String("secret"),
+				SessionToken:    aws.String("token"),
+				Expiration:      &expiration,
+			},
+			want: managed.ConnectionDetails{
+				ConnectionKeyAccessKeyID:     []byte("ASIAEXAMPLE"),
+				ConnectionKeySecretAccessKey: []byte("secret"),
+				ConnectionKeySessionToken:    []byte("token"),
+				ConnectionKeyExpiration:      []byte("2020-07-01T12:00:00Z"),
+				ConnectionKeyCredentials:     []byte("[default]\naws_access_key_id = ASIAEXAMPLE\naws_secret_access_key = secret\naws_session_token = token\n"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetConnectionDetails(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSessionCredentialsUpToDate(t *testing.T) {
+	exp := metav1.NewTime(expiration)
+	type args struct {
+		p   v1alpha1.SessionCredentialsParameters
+		o   v1alpha1.SessionCredentialsObservation
+		now time.Time
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotIssued": {
+			args: args{
+				p:   v1alpha1.SessionCredentialsParameters{RoleARN: aws.String(roleARN)},
+				now: expiration.Add(-time.Hour),
+			},
+			want: false,
+		},
+		"Valid": {
+			args: args{
+				p:   v1alpha1.SessionCredentialsParameters{RoleARN: aws.String(roleARN)},
+				o:   v1alpha1.SessionCredentialsObservation{RoleARN: roleARN, Expiration: &exp},
+				now: expiration.Add(-time.Hour),
+			},
+			want: true,
+		},
+		"DueForRefresh": {
+			args: args{
+				p:   v1alpha1.SessionCredentialsParameters{RoleARN: aws.String(roleARN)},
+				o:   v1alpha1.SessionCredentialsObservation{RoleARN: roleARN, Expiration: &exp},
+				now: expiration.Add(-time.Minute),
+			},
+			want: false,
+		},
+		"CustomRefreshBefore": {
+			args: args{
+				p: v1alpha1.SessionCredentialsParameters{
+					RoleARN:       aws.String(roleARN),
+					RefreshBefore: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+				o:   v1alpha1.SessionCredentialsObservation{RoleARN: roleARN, Expiration: &exp},
+				now: expiration.Add(-time.Hour),
+			},
+			want: false,
+		},
+		"RoleChanged": {
+			args: args{
+				p:   v1alpha1.SessionCredentialsParameters{RoleARN: aws.String("arn:aws:iam::123456789012:role/other")},
+				o:   v1alpha1.SessionCredentialsObservation{RoleARN: roleARN, Expiration: &exp},
+				now: expiration.Add(-time.Hour),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSessionCredentialsUpToDate(tc.args.p, tc.args.o, tc.args.now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
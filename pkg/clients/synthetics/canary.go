@@ -0,0 +1,333 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetics
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errPackageScript = "cannot package the inline script"
+
+	pythonRuntimePrefix = "syn-python"
+)
+
+// CanaryClient is the external client used for Canary Custom Resource
+type CanaryClient interface {
+	CreateCanaryRequest(*synthetics.CreateCanaryInput) synthetics.CreateCanaryRequest
+	GetCanaryRequest(*synthetics.GetCanaryInput) synthetics.GetCanaryRequest
+	UpdateCanaryRequest(*synthetics.UpdateCanaryInput) synthetics.UpdateCanaryRequest
+	DeleteCanaryRequest(*synthetics.DeleteCanaryInput) synthetics.DeleteCanaryRequest
+	StartCanaryRequest(*synthetics.StartCanaryInput) synthetics.StartCanaryRequest
+	StopCanaryRequest(*synthetics.StopCanaryInput) synthetics.StopCanaryRequest
+}
+
+// NewCanaryClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewCanaryClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (CanaryClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return synthetics.New(*cfg), err
+}
+
+// IsNotFound returns true if the error is because the canary does not exist.
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == synthetics.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// IsConflict returns true if the error is because the canary is not in a
+// state that allows the operation, e.g. stopping a canary that is not
+// running.
+func IsConflict(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == synthetics.ErrCodeConflictException
+	}
+	return false
+}
+
+// CodeChecksum returns the SHA-256 checksum of the supplied code
+// configuration.
+func CodeChecksum(c v1alpha1.CanaryCode) string {
+	b, _ := json.Marshal(c) // Marshalling a struct of strings cannot fail.
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// scriptPath returns the path within the zip file at which the runtime
+// expects the file that contains the handler.
+func scriptPath(runtime, handler string) string {
+	file := handler
+	if i := strings.LastIndex(handler, "."); i > 0 {
+		file = handler[:i]
+	}
+	if strings.HasPrefix(runtime, pythonRuntimePrefix) {
+		return "python/" + file + ".py"
+	}
+	return "nodejs/node_modules/" + file + ".js"
+}
+
+// packageScript returns a zip file that contains the supplied script at the
+// location the runtime loads the handler from.
+func packageScript(runtime, handler, script string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	f, err := w.Create(scriptPath(runtime, handler))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write([]byte(script)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GenerateCanaryCodeInput returns the code input of the canary described by
+// the supplied parameters, packaging an inline script if necessary.
+func GenerateCanaryCodeInput(p v1alpha1.CanaryParameters) (*synthetics.CanaryCodeInput, error) {
+	c := &synthetics.CanaryCodeInput{
+		Handler:   aws.String(p.Code.Handler),
+		S3Bucket:  p.Code.S3Bucket,
+		S3Key:     p.Code.S3Key,
+		S3Version: p.Code.S3Version,
+	}
+	if p.Code.Script != nil {
+		z, err := packageScript(p.RuntimeVersion, p.Code.Handler, *p.Code.Script)
+		if err != nil {
+			return nil, errors.Wrap(err, errPackageScript)
+		}
+		c.ZipFile = z
+	}
+	return c, nil
+}
+
+// ArtifactS3Location returns the S3 location run results of the canary
+// described by the supplied parameters are stored at.
+func ArtifactS3Location(p v1alpha1.CanaryParameters) string {
+	l := "s3://" + aws.StringValue(p.ArtifactS3Bucket)
+	if p.ArtifactS3Prefix != nil {
+		l += "/" + strings.Trim(*p.ArtifactS3Prefix, "/")
+	}
+	return l
+}
+
+func generateRunConfig(in *v1alpha1.CanaryRunConfig) *synthetics.CanaryRunConfigInput {
+	if in == nil {
+		return nil
+	}
+	return &synthetics.CanaryRunConfigInput{
+		MemoryInMB:       in.MemoryInMB,
+		TimeoutInSeconds: aws.Int64(in.TimeoutInSeconds),
+	}
+}
+
+func generateSchedule(in v1alpha1.CanarySchedule) *synthetics.CanaryScheduleInput {
+	return &synthetics.CanaryScheduleInput{
+		DurationInSeconds: in.DurationInSeconds,
+		Expression:        aws.String(in.Expression),
+	}
+}
+
+func generateVPCConfig(in *v1alpha1.VPCConfig) *synthetics.VpcConfigInput {
+	if in == nil {
+		return nil
+	}
+	return &synthetics.VpcConfigInput{
+		SecurityGroupIds: in.SecurityGroupIDs,
+		SubnetIds:        in.SubnetIDs,
+	}
+}
+
+// GenerateCreateCanaryInput returns the input to create the canary with the
+// given name from the supplied parameters.
+func GenerateCreateCanaryInput(name string, p v1alpha1.CanaryParameters) (*synthetics.CreateCanaryInput, error) {
+	code, err := GenerateCanaryCodeInput(p)
+	if err != nil {
+		return nil, err
+	}
+	in := &synthetics.CreateCanaryInput{
+		ArtifactS3Location:           aws.String(ArtifactS3Location(p)),
+		Code:                         code,
+		ExecutionRoleArn:             p.ExecutionRoleARN,
+		FailureRetentionPeriodInDays: p.FailureRetentionPeriodInDays,
+		Name:                         aws.String(name),
+		RunConfig:                    generateRunConfig(p.RunConfig),
+		RuntimeVersion:               aws.String(p.RuntimeVersion),
+		Schedule:                     generateSchedule(p.Schedule),
+		SuccessRetentionPeriodInDays: p.SuccessRetentionPeriodInDays,
+		VpcConfig:                    generateVPCConfig(p.VPCConfig),
+	}
+	if len(p.Tags) != 0 {
+		in.Tags = p.Tags
+	}
+	return in, nil
+}
+
+// GenerateUpdateCanaryInput returns the input to update the canary with the
+// given name from the supplied parameters. The code is only sent if it has
+// changed since it was last applied.
+func GenerateUpdateCanaryInput(name string, p v1alpha1.CanaryParameters, appliedChecksum string) (*synthetics.UpdateCanaryInput, error) {
+	in := &synthetics.UpdateCanaryInput{
+		ExecutionRoleArn:             p.ExecutionRoleARN,
+		FailureRetentionPeriodInDays: p.FailureRetentionPeriodInDays,
+		Name:                         aws.String(name),
+		RunConfig:                    generateRunConfig(p.RunConfig),
+		RuntimeVersion:               aws.String(p.RuntimeVersion),
+		Schedule:                     generateSchedule(p.Schedule),
+		SuccessRetentionPeriodInDays: p.SuccessRetentionPeriodInDays,
+		VpcConfig:                    generateVPCConfig(p.VPCConfig),
+	}
+	if CodeChecksum(p.Code) != appliedChecksum {
+		code, err := GenerateCanaryCodeInput(p)
+		if err != nil {
+			return nil, err
+		}
+		in.Code = code
+	}
+	return in, nil
+}
+
+// LateInitializeCanary fills the empty fields in *v1alpha1.CanaryParameters
+// with the values seen in synthetics.Canary.
+func LateInitializeCanary(in *v1alpha1.CanaryParameters, c *synthetics.Canary) {
+	if c == nil {
+		return
+	}
+	in.ExecutionRoleARN = awsclients.LateInitializeStringPtr(in.ExecutionRoleARN, c.ExecutionRoleArn)
+	in.FailureRetentionPeriodInDays = awsclients.LateInitializeInt64Ptr(in.FailureRetentionPeriodInDays, c.FailureRetentionPeriodInDays)
+	in.SuccessRetentionPeriodInDays = awsclients.LateInitializeInt64Ptr(in.SuccessRetentionPeriodInDays, c.SuccessRetentionPeriodInDays)
+	if in.RunConfig == nil && c.RunConfig != nil {
+		in.RunConfig = &v1alpha1.CanaryRunConfig{
+			MemoryInMB:       c.RunConfig.MemoryInMB,
+			TimeoutInSeconds: aws.Int64Value(c.RunConfig.TimeoutInSeconds),
+		}
+	}
+	if in.RunConfig != nil && c.RunConfig != nil {
+		in.RunConfig.MemoryInMB = awsclients.LateInitializeInt64Ptr(in.RunConfig.MemoryInMB, c.RunConfig.MemoryInMB)
+	}
+	if c.Schedule != nil {
+		in.Schedule.DurationInSeconds = awsclients.LateInitializeInt64Ptr(in.Schedule.DurationInSeconds, c.Schedule.DurationInSeconds)
+	}
+}
+
+// GenerateCanaryObservation is used to produce v1alpha1.CanaryObservation
+// from synthetics.Canary.
+func GenerateCanaryObservation(c synthetics.Canary) v1alpha1.CanaryObservation {
+	o := v1alpha1.CanaryObservation{
+		ID:        aws.StringValue(c.Id),
+		EngineARN: aws.StringValue(c.EngineArn),
+	}
+	if c.Status != nil {
+		o.State = string(c.Status.State)
+		o.StateReason = aws.StringValue(c.Status.StateReason)
+	}
+	if c.VpcConfig != nil {
+		o.VPCID = aws.StringValue(c.VpcConfig.VpcId)
+	}
+	return o
+}
+
+func trimLocation(l string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(l, "s3://"), "/")
+}
+
+// IsCanaryUpToDate returns true if there is no update-able difference
+// between desired and observed configuration of the canary. Changes to the
+// code are detected by comparing the checksum of the desired code with the
+// one that was last applied.
+func IsCanaryUpToDate(p v1alpha1.CanaryParameters, c synthetics.Canary, appliedChecksum string) bool {
+	if CodeChecksum(p.Code) != appliedChecksum {
+		return false
+	}
+	var observedHandler *string
+	if c.Code != nil {
+		observedHandler = c.Code.Handler
+	}
+	var runConfig, observedRunConfig v1alpha1.CanaryRunConfig
+	if p.RunConfig != nil {
+		runConfig = *p.RunConfig
+	}
+	if c.RunConfig != nil {
+		observedRunConfig = v1alpha1.CanaryRunConfig{
+			MemoryInMB:       c.RunConfig.MemoryInMB,
+			TimeoutInSeconds: aws.Int64Value(c.RunConfig.TimeoutInSeconds),
+		}
+	}
+	var schedule v1alpha1.CanarySchedule
+	if c.Schedule != nil {
+		schedule = v1alpha1.CanarySchedule{
+			DurationInSeconds: c.Schedule.DurationInSeconds,
+			Expression:        aws.StringValue(c.Schedule.Expression),
+		}
+	}
+	var vpc v1alpha1.VPCConfig
+	if c.VpcConfig != nil {
+		vpc = v1alpha1.VPCConfig{SecurityGroupIDs: c.VpcConfig.SecurityGroupIds, SubnetIDs: c.VpcConfig.SubnetIds}
+	}
+	desiredVPC := v1alpha1.VPCConfig{}
+	if p.VPCConfig != nil {
+		desiredVPC = *p.VPCConfig
+	}
+	sortSlices := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return trimLocation(ArtifactS3Location(p)) == trimLocation(aws.StringValue(c.ArtifactS3Location)) &&
+		p.Code.Handler == aws.StringValue(observedHandler) &&
+		aws.StringValue(p.ExecutionRoleARN) == aws.StringValue(c.ExecutionRoleArn) &&
+		aws.Int64Value(p.FailureRetentionPeriodInDays) == aws.Int64Value(c.FailureRetentionPeriodInDays) &&
+		aws.Int64Value(p.SuccessRetentionPeriodInDays) == aws.Int64Value(c.SuccessRetentionPeriodInDays) &&
+		cmp.Equal(runConfig, observedRunConfig) &&
+		p.RuntimeVersion == aws.StringValue(c.RuntimeVersion) &&
+		cmp.Equal(p.Schedule, schedule) &&
+		cmp.Equal(desiredVPC, vpc, sortSlices, cmpopts.EquateEmpty())
+}
+
+// IsRunningUpToDate returns true if the canary is running or stopped as
+// desired. Canaries that are transitioning between states are considered up
+// to date.
+func IsRunningUpToDate(p v1alpha1.CanaryParameters, state synthetics.CanaryState) bool {
+	running := p.Running == nil || *p.Running
+	switch state {
+	case synthetics.CanaryStateReady, synthetics.CanaryStateStopped:
+		return !running
+	case synthetics.CanaryStateRunning:
+		return running
+	default:
+		return true
+	}
+}
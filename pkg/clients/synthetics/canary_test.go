@@ -0,0 +1,290 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetics
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
+)
+
+var (
+	roleARN = "arn:aws:iam::123456789012:role/canary"
+	script  = "exports.handler = async () => {};"
+)
+
+func canaryParams(m ...func(*v1alpha1.CanaryParameters)) v1alpha1.CanaryParameters {
+	p := v1alpha1.CanaryParameters{
+		ArtifactS3Bucket: aws.String("artifacts"),
+		ArtifactS3Prefix: aws.String("/runs/"),
+		Code: v1alpha1.CanaryCode{
+			Handler: "index.handler",
+			Script:  aws.String(script),
+		},
+		ExecutionRoleARN:             aws.String(roleARN),
+		FailureRetentionPeriodInDays: aws.Int64(31),
+		RuntimeVersion:               "syn-nodejs-2.0",
+		Schedule: v1alpha1.CanarySchedule{
+			DurationInSeconds: aws.Int64(0),
+			Expression:        "rate(5 minutes)",
+		},
+		SuccessRetentionPeriodInDays: aws.Int64(31),
+		VPCConfig: &v1alpha1.VPCConfig{
+			SecurityGroupIDs: []string{"sg-2", "sg-1"},
+			SubnetIDs:        []string{"subnet-1"},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func canary(m ...func(*synthetics.Canary)) synthetics.Canary {
+	c := synthetics.Canary{
+		ArtifactS3Location:           aws.String("artifacts/runs/"),
+		Code:                         &synthetics.CanaryCodeOutput{Handler: aws.String("index.handler")},
+		ExecutionRoleArn:             aws.String(roleARN),
+		FailureRetentionPeriodInDays: aws.Int64(31),
+		RunConfig:                    &synthetics.CanaryRunConfigOutput{MemoryInMB: aws.Int64(960), TimeoutInSeconds: aws.Int64(60)},
+		RuntimeVersion:               aws.String("syn-nodejs-2.0"),
+		Schedule: &synthetics.CanaryScheduleOutput{
+			DurationInSeconds: aws.Int64(0),
+			Expression:        aws.String("rate(5 minutes)"),
+		},
+		SuccessRetentionPeriodInDays: aws.Int64(31),
+		VpcConfig: &synthetics.VpcConfigOutput{
+			SecurityGroupIds: []string{"sg-1", "sg-2"},
+			SubnetIds:        []string{"subnet-1"},
+			VpcId:            aws.String("vpc-1"),
+		},
+	}
+	for _, f := range m {
+		f(&c)
+	}
+	return c
+}
+
+func TestScriptPath(t *testing.T) {
+	cases := map[string]struct {
+		runtime string
+		handler string
+		want    string
+	}{
+		"NodeJS": {
+			runtime: "syn-nodejs-puppeteer-3.0",
+			handler: "index.handler",
+			want:    "nodejs/node_modules/index.js",
+		},
+		"Python": {
+			runtime: "syn-python-selenium-1.0",
+			handler: "canary.handler",
+			want:    "python/canary.py",
+		},
+		"NoFunction": {
+			runtime: "syn-nodejs-2.0",
+			handler: "index",
+			want:    "nodejs/node_modules/index.js",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := scriptPath(tc.runtime, tc.handler)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateCanaryInput(t *testing.T) {
+	in, err := GenerateCreateCanaryInput("example", canaryParams())
+	if err != nil {
+		t.Fatalf("GenerateCreateCanaryInput(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff("s3://artifacts/runs", aws.StringValue(in.ArtifactS3Location)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(in.Code.ZipFile), int64(len(in.Code.ZipFile)))
+	if err != nil {
+		t.Fatalf("zip.NewReader(...): unexpected error: %s", err)
+	}
+	if len(r.File) != 1 {
+		t.Fatalf("expected one file in the package, got %d", len(r.File))
+	}
+	if diff := cmp.Diff("nodejs/node_modules/index.js", r.File[0].Name); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	f, err := r.File[0].Open()
+	if err != nil {
+		t.Fatalf("Open(): unexpected error: %s", err)
+	}
+	defer f.Close() // nolint:errcheck
+	b, _ := ioutil.ReadAll(f)
+	if diff := cmp.Diff(script, string(b)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateCanaryInput(t *testing.T) {
+	p := canaryParams()
+	cases := map[string]struct {
+		checksum string
+		wantCode bool
+	}{
+		"CodeUnchanged": {
+			checksum: CodeChecksum(p.Code),
+			wantCode: false,
+		},
+		"CodeChanged": {
+			checksum: "old",
+			wantCode: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in, err := GenerateUpdateCanaryInput("example", p, tc.checksum)
+			if err != nil {
+				t.Fatalf("GenerateUpdateCanaryInput(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.wantCode, in.Code != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsCanaryUpToDate(t *testing.T) {
+	checksum := CodeChecksum(canaryParams().Code)
+	cases := map[string]struct {
+		p        v1alpha1.CanaryParameters
+		c        synthetics.Canary
+		checksum string
+		want     bool
+	}{
+		"UpToDate": {
+			p: canaryParams(func(p *v1alpha1.CanaryParameters) {
+				p.RunConfig = &v1alpha1.CanaryRunConfig{MemoryInMB: aws.Int64(960), TimeoutInSeconds: 60}
+			}),
+			c:        canary(),
+			checksum: checksum,
+			want:     true,
+		},
+		"CodeChanged": {
+			p: canaryParams(func(p *v1alpha1.CanaryParameters) {
+				p.RunConfig = &v1alpha1.CanaryRunConfig{MemoryInMB: aws.Int64(960), TimeoutInSeconds: 60}
+			}),
+			c:        canary(),
+			checksum: "old",
+			want:     false,
+		},
+		"ScheduleChanged": {
+			p: canaryParams(func(p *v1alpha1.CanaryParameters) {
+				p.RunConfig = &v1alpha1.CanaryRunConfig{MemoryInMB: aws.Int64(960), TimeoutInSeconds: 60}
+				p.Schedule.Expression = "rate(1 minute)"
+			}),
+			c:        canary(),
+			checksum: checksum,
+			want:     false,
+		},
+		"VPCRemoved": {
+			p: canaryParams(func(p *v1alpha1.CanaryParameters) {
+				p.RunConfig = &v1alpha1.CanaryRunConfig{MemoryInMB: aws.Int64(960), TimeoutInSeconds: 60}
+				p.VPCConfig = nil
+			}),
+			c:        canary(),
+			checksum: checksum,
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCanaryUpToDate(tc.p, tc.c, tc.checksum)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRunningUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		running *bool
+		state   synthetics.CanaryState
+		want    bool
+	}{
+		"ReadyToStart": {
+			state: synthetics.CanaryStateReady,
+			want:  false,
+		},
+		"Running": {
+			state: synthetics.CanaryStateRunning,
+			want:  true,
+		},
+		"RunningToStop": {
+			running: aws.Bool(false),
+			state:   synthetics.CanaryStateRunning,
+			want:    false,
+		},
+		"Stopped": {
+			running: aws.Bool(false),
+			state:   synthetics.CanaryStateStopped,
+			want:    true,
+		},
+		"Transitioning": {
+			state: synthetics.CanaryStateStopping,
+			want:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := canaryParams(func(p *v1alpha1.CanaryParameters) { p.Running = tc.running })
+			got := IsRunningUpToDate(p, tc.state)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeCanary(t *testing.T) {
+	p := canaryParams(func(p *v1alpha1.CanaryParameters) {
+		p.ExecutionRoleARN = nil
+		p.FailureRetentionPeriodInDays = nil
+		p.Schedule.DurationInSeconds = nil
+	})
+	c := canary()
+	LateInitializeCanary(&p, &c)
+	want := canaryParams(func(p *v1alpha1.CanaryParameters) {
+		p.RunConfig = &v1alpha1.CanaryRunConfig{MemoryInMB: aws.Int64(960), TimeoutInSeconds: 60}
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
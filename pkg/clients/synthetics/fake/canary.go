@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/synthetics"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CanaryClient = (*MockCanaryClient)(nil)
+
+// MockCanaryClient is a type that implements all the methods for CanaryClient interface
+type MockCanaryClient struct {
+	MockCreateCanary func(*synthetics.CreateCanaryInput) synthetics.CreateCanaryRequest
+	MockGetCanary    func(*synthetics.GetCanaryInput) synthetics.GetCanaryRequest
+	MockUpdateCanary func(*synthetics.UpdateCanaryInput) synthetics.UpdateCanaryRequest
+	MockDeleteCanary func(*synthetics.DeleteCanaryInput) synthetics.DeleteCanaryRequest
+	MockStartCanary  func(*synthetics.StartCanaryInput) synthetics.StartCanaryRequest
+	MockStopCanary   func(*synthetics.StopCanaryInput) synthetics.StopCanaryRequest
+}
+
+// CreateCanaryRequest calls the underlying MockCreateCanary method.
+func (c *MockCanaryClient) CreateCanaryRequest(i *synthetics.CreateCanaryInput) synthetics.CreateCanaryRequest {
+	return c.MockCreateCanary(i)
+}
+
+// GetCanaryRequest calls the underlying MockGetCanary method.
+func (c *MockCanaryClient) GetCanaryRequest(i *synthetics.GetCanaryInput) synthetics.GetCanaryRequest {
+	return c.MockGetCanary(i)
+}
+
+// UpdateCanaryRequest calls the underlying MockUpdateCanary method.
+func (c *MockCanaryClient) UpdateCanaryRequest(i *synthetics.UpdateCanaryInput) synthetics.UpdateCanaryRequest {
+	return c.MockUpdateCanary(i)
+}
+
+// DeleteCanaryRequest calls the underlying MockDeleteCanary method.
+func (c *MockCanaryClient) DeleteCanaryRequest(i *synthetics.DeleteCanaryInput) synthetics.DeleteCanaryRequest {
+	return c.MockDeleteCanary(i)
+}
+
+// StartCanaryRequest calls the underlying MockStartCanary method.
+func (c *MockCanaryClient) StartCanaryRequest(i *synthetics.StartCanaryInput) synthetics.StartCanaryRequest {
+	return c.MockStartCanary(i)
+}
+
+// StopCanaryRequest calls the underlying MockStopCanary method.
+func (c *MockCanaryClient) StopCanaryRequest(i *synthetics.StopCanaryInput) synthetics.StopCanaryRequest {
+	return c.MockStopCanary(i)
+}
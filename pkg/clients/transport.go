@@ -0,0 +1,239 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+)
+
+const (
+	errGetCABundle   = "cannot get CA bundle secret of provider"
+	errParseCABundle = "cannot parse CA bundle of provider: no PEM encoded certificates found"
+	errNoTransport   = "cannot configure HTTP transport: HTTP client of AWS configuration does not support transport options"
+	errParseProxyURL = "cannot parse proxy URL of provider"
+	errGetProxyCreds = "cannot get proxy credentials secret of provider"
+	errProxyCreds    = "cannot parse proxy credentials of provider: expected username:password"
+)
+
+// A TransportOption configures the HTTP transport of AWS configurations.
+type TransportOption func(*http.Transport)
+
+type transportOptionsKey struct{}
+
+// WithTransportOptions returns a copy of the supplied context that carries the
+// supplied transport options. UseProviderSecret and UsePodServiceAccount apply
+// the options carried by their context to the configurations they create.
+func WithTransportOptions(ctx context.Context, o ...TransportOption) context.Context {
+	if len(o) == 0 {
+		return ctx
+	}
+	opts := append(append([]TransportOption{}, transportOptions(ctx)...), o...)
+	return context.WithValue(ctx, transportOptionsKey{}, opts)
+}
+
+func transportOptions(ctx context.Context) []TransportOption {
+	o, _ := ctx.Value(transportOptionsKey{}).([]TransportOption)
+	return o
+}
+
+// WithProviderTransport returns a copy of the supplied context that carries
+// the HTTP client configured by the supplied Provider, so that clients created
+// with it connect to AWS endpoints the way the Provider asks them to. HTTP
+// clients are cached per Provider until it or its Secrets change, so that
+// their connections are reused.
+func WithProviderTransport(ctx context.Context, kube client.Reader, p *v1alpha3.Provider) (context.Context, error) {
+	if p.Spec.CABundleSecretRef == nil && !aws.BoolValue(p.Spec.InsecureSkipVerify) && p.Spec.Proxy == nil {
+		return ctx, nil
+	}
+	ca, creds := &corev1.Secret{}, &corev1.Secret{}
+	if ref := p.Spec.CABundleSecretRef; ref != nil {
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, ca); err != nil {
+			return ctx, errors.Wrap(err, errGetCABundle)
+		}
+	}
+	if p.Spec.Proxy != nil {
+		if ref := p.Spec.Proxy.CredentialsSecretRef; ref != nil {
+			if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, creds); err != nil {
+				return ctx, errors.Wrap(err, errGetProxyCreds)
+			}
+		}
+	}
+
+	version := fmt.Sprintf("%d/%s/%s", p.GetGeneration(), ca.GetResourceVersion(), creds.GetResourceVersion())
+	c, err := providerTransports.get(p.GetName(), version, func() (aws.HTTPClient, error) {
+		o, err := providerTransportOptions(p, ca, creds)
+		if err != nil {
+			return nil, err
+		}
+		return aws.NewBuildableHTTPClient().WithTransportOptions(o...), nil
+	})
+	if err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, httpClientKey{}, c), nil
+}
+
+type httpClientKey struct{}
+
+// providerTransportOptions returns the transport options configured by the
+// supplied Provider, whose CA bundle and proxy credentials are those of the
+// supplied Secrets.
+func providerTransportOptions(p *v1alpha3.Provider, ca, creds *corev1.Secret) ([]func(*http.Transport), error) {
+	var o []func(*http.Transport)
+	if ref := p.Spec.CABundleSecretRef; ref != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(ca.Data[ref.Key]) {
+			return nil, errors.New(errParseCABundle)
+		}
+		o = append(o, WithRootCAs(pool))
+	}
+	if aws.BoolValue(p.Spec.InsecureSkipVerify) {
+		o = append(o, WithInsecureSkipVerify())
+	}
+	if p.Spec.Proxy != nil {
+		u, err := proxyURL(p.Spec.Proxy, creds)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, WithProxy(u))
+	}
+	return o, nil
+}
+
+func proxyURL(p *v1alpha3.ProxyConfig, s *corev1.Secret) (*url.URL, error) {
+	u, err := url.Parse(p.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseProxyURL)
+	}
+	ref := p.CredentialsSecretRef
+	if ref == nil {
+		return u, nil
+	}
+	creds := strings.SplitN(strings.TrimSpace(string(s.Data[ref.Key])), ":", 2)
+	if len(creds) != 2 {
+		return nil, errors.New(errProxyCreds)
+	}
+	u.User = url.UserPassword(creds[0], creds[1])
+	return u, nil
+}
+
+var providerTransports = newTransportCache()
+
+// A transportCache holds the HTTP client built for each Provider, along with
+// the version of the Provider and Secrets it was built from.
+type transportCache struct {
+	mu      sync.Mutex
+	clients map[string]cachedTransport
+}
+
+type cachedTransport struct {
+	version string
+	client  aws.HTTPClient
+}
+
+func newTransportCache() *transportCache {
+	return &transportCache{clients: map[string]cachedTransport{}}
+}
+
+// get returns the HTTP client of the named Provider if it was built from the
+// supplied version, and builds and caches a new one otherwise.
+func (c *transportCache) get(name, version string, build func() (aws.HTTPClient, error)) (aws.HTTPClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if t, ok := c.clients[name]; ok && t.version == version {
+		return t.client, nil
+	}
+	hc, err := build()
+	if err != nil {
+		return nil, err
+	}
+	c.clients[name] = cachedTransport{version: version, client: hc}
+	return hc, nil
+}
+
+// WithProxy makes the transport send requests through the proxy at the
+// supplied URL, regardless of the proxy environment variables.
+func WithProxy(u *url.URL) TransportOption {
+	return func(t *http.Transport) {
+		t.Proxy = http.ProxyURL(u)
+	}
+}
+
+// WithRootCAs makes the transport trust the certificates of the supplied pool.
+func WithRootCAs(pool *x509.CertPool) TransportOption {
+	return func(t *http.Transport) {
+		tlsClientConfig(t).RootCAs = pool
+	}
+}
+
+// WithInsecureSkipVerify makes the transport accept any certificate presented
+// by the server.
+func WithInsecureSkipVerify() TransportOption {
+	return func(t *http.Transport) {
+		tlsClientConfig(t).InsecureSkipVerify = true // nolint:gosec
+	}
+}
+
+func tlsClientConfig(t *http.Transport) *tls.Config {
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = &tls.Config{}
+	}
+	return t.TLSClientConfig
+}
+
+// applyTransportOptions makes the supplied configuration use the HTTP client
+// carried by the supplied context, if any, then applies the transport options
+// carried by the context to it.
+func applyTransportOptions(ctx context.Context, cfg *aws.Config) error {
+	if c, ok := ctx.Value(httpClientKey{}).(aws.HTTPClient); ok {
+		cfg.HTTPClient = c
+	}
+	o := transportOptions(ctx)
+	if len(o) == 0 {
+		return nil
+	}
+	c, ok := cfg.HTTPClient.(interface {
+		WithTransportOptions(...func(*http.Transport)) aws.HTTPClient
+	})
+	if !ok {
+		return errors.New(errNoTransport)
+	}
+	fns := make([]func(*http.Transport), len(o))
+	for i := range o {
+		fns[i] = o[i]
+	}
+	cfg.HTTPClient = c.WithTransportOptions(fns...)
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+)
+
+const (
+	caBundleKey         = "ca.crt"
+	proxyCredentialsKey = "credentials"
+)
+
+func providerWithTLS(insecure bool) *v1alpha3.Provider {
+	return &v1alpha3.Provider{
+		Spec: v1alpha3.ProviderSpec{
+			CABundleSecretRef: &runtimev1alpha1.SecretKeySelector{
+				SecretReference: runtimev1alpha1.SecretReference{Namespace: "crossplane-system", Name: "ca"},
+				Key:             caBundleKey,
+			},
+			InsecureSkipVerify: aws.Bool(insecure),
+		},
+	}
+}
+
+func providerWithProxy(u string) *v1alpha3.Provider {
+	return &v1alpha3.Provider{
+		Spec: v1alpha3.ProviderSpec{
+			Proxy: &v1alpha3.ProxyConfig{
+				URL: u,
+				CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+					SecretReference: runtimev1alpha1.SecretReference{Namespace: "crossplane-system", Name: "proxy"},
+					Key:             proxyCredentialsKey,
+				},
+			},
+		},
+	}
+}
+
+func getSecret(key string, data []byte) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{key: data}
+		return nil
+	}
+}
+
+func TestWithProviderTransport(t *testing.T) {
+	errBoom := errors.New("boom")
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	type want struct {
+		client bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		p    *v1alpha3.Provider
+		want want
+	}{
+		"NoTLSSettings": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    &v1alpha3.Provider{},
+			want: want{client: false},
+		},
+		"CABundleAndInsecureSkipVerify": {
+			kube: &test.MockClient{MockGet: getSecret(caBundleKey, ca)},
+			p:    providerWithTLS(true),
+			want: want{client: true},
+		},
+		"GetCABundleError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    providerWithTLS(false),
+			want: want{err: errors.Wrap(errBoom, errGetCABundle)},
+		},
+		"InvalidCABundle": {
+			kube: &test.MockClient{MockGet: getSecret(caBundleKey, []byte("not a certificate"))},
+			p:    providerWithTLS(false),
+			want: want{err: errors.New(errParseCABundle)},
+		},
+		"Proxy": {
+			kube: &test.MockClient{MockGet: getSecret(proxyCredentialsKey, []byte("user:pass"))},
+			p:    providerWithProxy("http://proxy.example.com:3128"),
+			want: want{client: true},
+		},
+		"GetProxyCredentialsError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    providerWithProxy("http://proxy.example.com:3128"),
+			want: want{err: errors.Wrap(errBoom, errGetProxyCreds)},
+		},
+		"InvalidProxyCredentials": {
+			kube: &test.MockClient{MockGet: getSecret(proxyCredentialsKey, []byte("user"))},
+			p:    providerWithProxy("http://proxy.example.com:3128"),
+			want: want{err: errors.New(errProxyCreds)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			providerTransports = newTransportCache()
+			ctx, err := WithProviderTransport(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("WithProviderTransport(...): -want error, +got error:\n%s", diff)
+			}
+			_, ok := ctx.Value(httpClientKey{}).(aws.HTTPClient)
+			if diff := cmp.Diff(tc.want.client, ok); diff != "" {
+				t.Errorf("WithProviderTransport(...): -want HTTP client, +got HTTP client:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyTransportOptions(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	kube := &test.MockClient{MockGet: getSecret(caBundleKey, ca)}
+
+	cases := map[string]struct {
+		p       *v1alpha3.Provider
+		trusted bool
+	}{
+		"Default": {
+			p:       &v1alpha3.Provider{},
+			trusted: false,
+		},
+		"CABundle": {
+			p:       providerWithTLS(false),
+			trusted: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			providerTransports = newTransportCache()
+			ctx, err := WithProviderTransport(context.Background(), kube, tc.p)
+			if err != nil {
+				t.Fatalf("WithProviderTransport(...): %s", err)
+			}
+			cfg := aws.Config{HTTPClient: aws.NewBuildableHTTPClient()}
+			if err := applyTransportOptions(ctx, &cfg); err != nil {
+				t.Fatalf("applyTransportOptions(...): %s", err)
+			}
+			req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+			_, err = cfg.HTTPClient.Do(req)
+			if diff := cmp.Diff(tc.trusted, err == nil); diff != "" {
+				t.Errorf("Do(...): -want trusted, +got trusted:\n%s\nerror: %v", diff, err)
+			}
+		})
+	}
+}
+
+func TestProxy(t *testing.T) {
+	var auth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Proxy-Authorization")
+	}))
+	defer proxy.Close()
+
+	providerTransports = newTransportCache()
+	kube := &test.MockClient{MockGet: getSecret(proxyCredentialsKey, []byte("user:pass\n"))}
+	ctx, err := WithProviderTransport(context.Background(), kube, providerWithProxy(proxy.URL))
+	if err != nil {
+		t.Fatalf("WithProviderTransport(...): %s", err)
+	}
+	cfg := aws.Config{HTTPClient: aws.NewBuildableHTTPClient()}
+	if err := applyTransportOptions(ctx, &cfg); err != nil {
+		t.Fatalf("applyTransportOptions(...): %s", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "http://ec2.us-east-1.amazonaws.com", nil)
+	if _, err := cfg.HTTPClient.Do(req); err != nil {
+		t.Fatalf("Do(...): %s", err)
+	}
+
+	// Basic base64("user:pass").
+	if diff := cmp.Diff("Basic dXNlcjpwYXNz", auth); diff != "" {
+		t.Errorf("Proxy-Authorization: -want, +got:\n%s", diff)
+	}
+}
+
+func TestProviderTransportCache(t *testing.T) {
+	providerTransports = newTransportCache()
+	version := "1"
+	kube := &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		obj.(*corev1.Secret).SetResourceVersion(version)
+		obj.(*corev1.Secret).Data = map[string][]byte{proxyCredentialsKey: []byte("user:pass")}
+		return nil
+	}}
+	p := providerWithProxy("http://proxy.example.com:3128")
+	p.SetName("cool-provider")
+	p.SetGeneration(1)
+
+	httpClient := func() aws.HTTPClient {
+		ctx, err := WithProviderTransport(context.Background(), kube, p)
+		if err != nil {
+			t.Fatalf("WithProviderTransport(...): %s", err)
+		}
+		return ctx.Value(httpClientKey{}).(aws.HTTPClient)
+	}
+
+	first := httpClient()
+	if httpClient() != first {
+		t.Errorf("WithProviderTransport(...): want HTTP client of unchanged provider reused")
+	}
+	p.SetGeneration(2)
+	second := httpClient()
+	if second == first {
+		t.Errorf("WithProviderTransport(...): want new HTTP client for new provider generation")
+	}
+	version = "2"
+	if httpClient() == second {
+		t.Errorf("WithProviderTransport(...): want new HTTP client for new secret version")
+	}
+}
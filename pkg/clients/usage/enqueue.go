@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// EnqueueUsers returns an event handler that enqueues the managed resources
+// that use the object of each event, according to the supplied UsesFn. Users
+// are listed using the supplied list, and only those for which the supplied
+// owns function returns true are enqueued, so that a shard does not reconcile
+// the users owned by another shard. Watching the resources that managed
+// resources reference with this handler reconciles them as soon as their
+// references can be resolved, rather than at their next poll.
+func EnqueueUsers(c client.Reader, owns func(obj interface{}) bool, l resource.ManagedList, uses UsesFn) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{ToRequests: mapUsers(c, owns, l, uses)}
+}
+
+func mapUsers(c client.Reader, owns func(obj interface{}) bool, l resource.ManagedList, uses UsesFn) handler.ToRequestsFunc {
+	return func(o handler.MapObject) []reconcile.Request {
+		users := l.DeepCopyObject().(resource.ManagedList)
+		if err := c.List(context.TODO(), users); err != nil {
+			return nil
+		}
+		var reqs []reconcile.Request
+		for _, mg := range users.GetItems() {
+			if owns(mg) && refers(mg, uses(mg), o.Meta, o.Object) {
+				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: mg.GetName()}})
+			}
+		}
+		return reqs
+	}
+}
+
+// refers returns true if any of the supplied uses of the supplied user refers
+// to, or selects, the supplied object.
+func refers(user metav1.Object, uses []Use, m metav1.Object, o runtime.Object) bool {
+	for _, u := range uses {
+		if reflect.TypeOf(u.To) != reflect.TypeOf(o) {
+			continue
+		}
+		if u.Reference != nil && u.Reference.Name == m.GetName() {
+			return true
+		}
+		if selects(u.Selector, user, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// selects returns true if the supplied selector of the supplied user selects
+// the supplied object.
+func selects(s *runtimev1alpha1.Selector, user, m metav1.Object) bool {
+	if s == nil {
+		return false
+	}
+	if !labels.SelectorFromSet(s.MatchLabels).Matches(labels.Set(m.GetLabels())) {
+		return false
+	}
+	return !aws.BoolValue(s.MatchControllerRef) || meta.HaveSameController(user, m)
+}
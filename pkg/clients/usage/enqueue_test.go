@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func subnetUses(mg resource.Managed) []Use {
+	cr := mg.(*v1beta1.Subnet)
+	return []Use{{Reference: cr.Spec.ForProvider.VPCIDRef, Selector: cr.Spec.ForProvider.VPCIDSelector, To: &v1beta1.VPC{}}}
+}
+
+func TestMapUsers(t *testing.T) {
+	controller := metav1.OwnerReference{UID: types.UID("composite"), Controller: aws.Bool(true)}
+
+	vpc := &v1beta1.VPC{ObjectMeta: metav1.ObjectMeta{
+		Name:            "vpc",
+		Labels:          map[string]string{"network": "a"},
+		OwnerReferences: []metav1.OwnerReference{controller},
+	}}
+
+	subnet := func(name string, ref *runtimev1alpha1.Reference, sel *runtimev1alpha1.Selector) v1beta1.Subnet {
+		s := v1beta1.Subnet{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		s.Spec.ForProvider.VPCIDRef = ref
+		s.Spec.ForProvider.VPCIDSelector = sel
+		return s
+	}
+
+	kube := &test.MockClient{
+		MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+			obj.(*v1beta1.SubnetList).Items = []v1beta1.Subnet{
+				subnet("referenced", &runtimev1alpha1.Reference{Name: "vpc"}, nil),
+				subnet("other-reference", &runtimev1alpha1.Reference{Name: "other"}, nil),
+				subnet("selected", nil, &runtimev1alpha1.Selector{MatchLabels: map[string]string{"network": "a"}}),
+				subnet("not-selected", nil, &runtimev1alpha1.Selector{MatchLabels: map[string]string{"network": "b"}}),
+				subnet("other-controller", nil, &runtimev1alpha1.Selector{MatchControllerRef: aws.Bool(true)}),
+			}
+			return nil
+		},
+	}
+
+	all := func(_ interface{}) bool { return true }
+
+	cases := map[string]struct {
+		o    runtime.Object
+		m    metav1.Object
+		owns func(obj interface{}) bool
+		want []reconcile.Request
+	}{
+		"VPC": {
+			o:    vpc,
+			m:    vpc,
+			owns: all,
+			want: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "referenced"}},
+				{NamespacedName: types.NamespacedName{Name: "selected"}},
+			},
+		},
+		"OtherKind": {
+			o:    &v1beta1.SecurityGroup{ObjectMeta: metav1.ObjectMeta{Name: "vpc"}},
+			m:    &v1beta1.SecurityGroup{ObjectMeta: metav1.ObjectMeta{Name: "vpc"}},
+			owns: all,
+			want: nil,
+		},
+		"OwnedByOtherShard": {
+			o: vpc,
+			m: vpc,
+			owns: func(obj interface{}) bool {
+				return obj.(metav1.Object).GetName() != "selected"
+			},
+			want: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "referenced"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := mapUsers(kube, tc.owns, &v1beta1.SubnetList{}, subnetUses)(handler.MapObject{Meta: tc.m, Object: tc.o})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("mapUsers(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
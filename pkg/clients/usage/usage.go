@@ -0,0 +1,260 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package usage tracks which managed resources use which, so that used
+// resources are not deleted while they are in use and users are reconciled as
+// soon as the resources they use change.
+package usage
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// FinalizerPrefix prefixes the finalizers that managed resources add to the
+// managed resources they use. The rest of each finalizer is the UID of the
+// using resource.
+const FinalizerPrefix = "in-use.aws.crossplane.io/"
+
+// managedFinalizerName is the finalizer that the managed reconciler adds to
+// the resources it reconciles by default.
+const managedFinalizerName = "finalizer.managedresource.crossplane.io"
+
+// AnnotationKeyUses is the annotation in which a managed resource records the
+// resources it marked as in use, as a comma separated list of Kind/name.
+const AnnotationKeyUses = "aws.crossplane.io/uses"
+
+const (
+	errGetUsed    = "cannot get used resource"
+	errUpdateUsed = "cannot update used resource"
+	errUpdateUser = "cannot record used resources"
+)
+
+// A Use of a managed resource by another.
+type Use struct {
+	// Reference to the used resource. Uses with a nil reference are ignored
+	// when finalizers are added and removed.
+	Reference *runtimev1alpha1.Reference
+
+	// Selector of the used resource, if its reference is not yet resolved.
+	Selector *runtimev1alpha1.Selector
+
+	// To is an empty object of the kind of the used resource.
+	To resource.Managed
+}
+
+// A UsesFn returns the managed resources that the supplied managed resource
+// uses.
+type UsesFn func(mg resource.Managed) []Use
+
+// InUse returns true if other managed resources use the supplied object.
+func InUse(o metav1.Object) bool {
+	for _, f := range o.GetFinalizers() {
+		if strings.HasPrefix(f, FinalizerPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewFinalizer returns a Finalizer that, in addition to adding and removing
+// the finalizer of the managed reconciler, adds a finalizer to each of the
+// resources returned by the supplied UsesFn for as long as the finalized
+// resource exists. The finalized resource records the resources it marked as
+// in use in its AnnotationKeyUses annotation, so that a resource it no longer
+// refers to is released as soon as its reference is moved.
+func NewFinalizer(c client.Client, uses UsesFn) resource.Finalizer {
+	return &Finalizer{
+		client:  c,
+		uses:    uses,
+		managed: resource.NewAPIFinalizer(c, managedFinalizerName),
+	}
+}
+
+// A Finalizer marks the managed resources used by a managed resource as in
+// use.
+type Finalizer struct {
+	client  client.Client
+	uses    UsesFn
+	managed resource.Finalizer
+}
+
+// AddFinalizer to the supplied managed resource and to the resources it uses,
+// and remove it from the resources it used before.
+func (f *Finalizer) AddFinalizer(ctx context.Context, obj resource.Object) error {
+	mg, ok := obj.(resource.Managed)
+	if !ok {
+		return f.managed.AddFinalizer(ctx, obj)
+	}
+	uses := f.uses(mg)
+	current := referenced(uses)
+	if err := f.forEach(ctx, mg, current, add); err != nil {
+		return err
+	}
+	if err := f.forEach(ctx, mg, without(recorded(mg, uses), current), remove); err != nil {
+		return err
+	}
+	if err := f.record(ctx, mg, current); err != nil {
+		return err
+	}
+	return f.managed.AddFinalizer(ctx, obj)
+}
+
+// RemoveFinalizer from the supplied managed resource and from the resources it
+// uses or used before.
+func (f *Finalizer) RemoveFinalizer(ctx context.Context, obj resource.Object) error {
+	mg, ok := obj.(resource.Managed)
+	if !ok {
+		return f.managed.RemoveFinalizer(ctx, obj)
+	}
+	uses := f.uses(mg)
+	current := referenced(uses)
+	if err := f.forEach(ctx, mg, append(current, without(recorded(mg, uses), current)...), remove); err != nil {
+		return err
+	}
+	return f.managed.RemoveFinalizer(ctx, obj)
+}
+
+func add(used resource.Managed, name string) bool {
+	if meta.FinalizerExists(used, name) || meta.WasDeleted(used) {
+		return false
+	}
+	meta.AddFinalizer(used, name)
+	return true
+}
+
+func remove(used resource.Managed, name string) bool {
+	if !meta.FinalizerExists(used, name) {
+		return false
+	}
+	meta.RemoveFinalizer(used, name)
+	return true
+}
+
+// forEach calls fn with each of the supplied resources that exists, and
+// updates the resources for which fn returns true.
+func (f *Finalizer) forEach(ctx context.Context, mg resource.Managed, refs []ref, fn func(used resource.Managed, name string) bool) error {
+	name := FinalizerPrefix + string(mg.GetUID())
+	for _, r := range refs {
+		used := r.to.DeepCopyObject().(resource.Managed)
+		err := f.client.Get(ctx, types.NamespacedName{Name: r.name}, used)
+		if resource.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errGetUsed)
+		}
+		if err != nil || !fn(used, name) {
+			continue
+		}
+		if err := f.client.Update(ctx, used); resource.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errUpdateUsed)
+		}
+	}
+	return nil
+}
+
+// record the supplied resources in the AnnotationKeyUses annotation of the
+// supplied managed resource.
+func (f *Finalizer) record(ctx context.Context, mg resource.Managed, refs []ref) error {
+	entries := make([]string, len(refs))
+	for i, r := range refs {
+		entries[i] = r.String()
+	}
+	v := strings.Join(entries, ",")
+	if mg.GetAnnotations()[AnnotationKeyUses] == v {
+		return nil
+	}
+	if v == "" {
+		meta.RemoveAnnotations(mg, AnnotationKeyUses)
+	} else {
+		meta.AddAnnotations(mg, map[string]string{AnnotationKeyUses: v})
+	}
+	return errors.Wrap(f.client.Update(ctx, mg), errUpdateUser)
+}
+
+// A ref to a used resource.
+type ref struct {
+	to   resource.Managed
+	name string
+}
+
+func (r ref) String() string {
+	return kind(r.to) + "/" + r.name
+}
+
+// kind returns the name of the Go type of the supplied resource, which is
+// all that tells the kinds of the resources returned by a UsesFn apart.
+func kind(mg resource.Managed) string {
+	return reflect.Indirect(reflect.ValueOf(mg)).Type().Name()
+}
+
+// referenced returns the resources the supplied uses refer to.
+func referenced(uses []Use) []ref {
+	var refs []ref
+	for _, u := range uses {
+		if u.Reference == nil {
+			continue
+		}
+		refs = append(refs, ref{to: u.To, name: u.Reference.Name})
+	}
+	return refs
+}
+
+// recorded returns the resources recorded in the AnnotationKeyUses annotation
+// of the supplied managed resource, whose kinds are among the supplied uses.
+func recorded(mg resource.Managed, uses []Use) []ref {
+	kinds := map[string]resource.Managed{}
+	for _, u := range uses {
+		kinds[kind(u.To)] = u.To
+	}
+	var refs []ref
+	for _, e := range strings.Split(mg.GetAnnotations()[AnnotationKeyUses], ",") {
+		parts := strings.SplitN(e, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if to, ok := kinds[parts[0]]; ok {
+			refs = append(refs, ref{to: to, name: parts[1]})
+		}
+	}
+	return refs
+}
+
+// without returns the supplied resources that are not among the excluded.
+func without(refs, excluded []ref) []ref {
+	var out []ref
+	for _, r := range refs {
+		found := false
+		for _, e := range excluded {
+			if r.String() == e.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, r)
+		}
+	}
+	return out
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const (
+	userUID  = types.UID("some-uid")
+	usedName = "used"
+)
+
+var errBoom = errors.New("boom")
+
+func user() *fake.Managed {
+	return &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "user", UID: userUID}}
+}
+
+func usesFn(ref *runtimev1alpha1.Reference) UsesFn {
+	return func(_ resource.Managed) []Use {
+		return []Use{{Reference: ref, To: &fake.Managed{}}}
+	}
+}
+
+func used(finalizers ...string) *fake.Managed {
+	return &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: usedName, Finalizers: finalizers}}
+}
+
+func TestAddFinalizer(t *testing.T) {
+	type want struct {
+		err        error
+		finalizers []string
+	}
+
+	cases := map[string]struct {
+		uses UsesFn
+		used *fake.Managed
+		get  error
+		want want
+	}{
+		"AddedToUsed": {
+			uses: usesFn(&runtimev1alpha1.Reference{Name: usedName}),
+			used: used(),
+			want: want{finalizers: []string{FinalizerPrefix + string(userUID)}},
+		},
+		"AlreadyAdded": {
+			uses: usesFn(&runtimev1alpha1.Reference{Name: usedName}),
+			used: used(FinalizerPrefix + string(userUID)),
+			want: want{},
+		},
+		"NilReference": {
+			uses: usesFn(nil),
+			want: want{},
+		},
+		"UsedNotFound": {
+			uses: usesFn(&runtimev1alpha1.Reference{Name: usedName}),
+			get:  kerrors.NewNotFound(schema.GroupResource{}, usedName),
+			want: want{},
+		},
+		"GetUsedError": {
+			uses: usesFn(&runtimev1alpha1.Reference{Name: usedName}),
+			get:  errBoom,
+			want: want{err: errors.Wrap(errBoom, errGetUsed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updated []string
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+					if tc.get != nil {
+						return tc.get
+					}
+					*obj.(*fake.Managed) = *tc.used.DeepCopyObject().(*fake.Managed)
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					if mg := obj.(*fake.Managed); mg.GetName() == usedName {
+						updated = mg.GetFinalizers()
+					}
+					return nil
+				},
+			}
+			err := NewFinalizer(kube, tc.uses).AddFinalizer(context.Background(), user())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("AddFinalizer(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.finalizers, updated); diff != "" {
+				t.Errorf("AddFinalizer(...): -want finalizers, +got finalizers:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveFinalizer(t *testing.T) {
+	var updated []string
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+			*obj.(*fake.Managed) = *used("other", FinalizerPrefix+string(userUID))
+			return nil
+		},
+		MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+			if mg := obj.(*fake.Managed); mg.GetName() == usedName {
+				updated = mg.GetFinalizers()
+			}
+			return nil
+		},
+	}
+	f := NewFinalizer(kube, usesFn(&runtimev1alpha1.Reference{Name: usedName}))
+	if err := f.RemoveFinalizer(context.Background(), user()); err != nil {
+		t.Fatalf("RemoveFinalizer(...): %s", err)
+	}
+	if diff := cmp.Diff([]string{"other"}, updated); diff != "" {
+		t.Errorf("RemoveFinalizer(...): -want finalizers, +got finalizers:\n%s", diff)
+	}
+}
+
+func TestReferenceMoved(t *testing.T) {
+	cases := map[string]struct {
+		reconcileMoved bool
+	}{
+		"ReconciledBeforeDeletion": {reconcileMoved: true},
+		"DeletedStraightAway":      {reconcileMoved: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			store := map[string]*fake.Managed{
+				"old": {ObjectMeta: metav1.ObjectMeta{Name: "old"}},
+				"new": {ObjectMeta: metav1.ObjectMeta{Name: "new"}},
+			}
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*fake.Managed) = *store[key.Name].DeepCopyObject().(*fake.Managed)
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					if mg := obj.(*fake.Managed); mg.GetName() != "user" {
+						store[mg.GetName()] = mg.DeepCopyObject().(*fake.Managed)
+					}
+					return nil
+				},
+			}
+			u := user()
+			ref := &runtimev1alpha1.Reference{Name: "old"}
+			f := NewFinalizer(kube, usesFn(ref))
+			ctx := context.Background()
+
+			if err := f.AddFinalizer(ctx, u); err != nil {
+				t.Fatalf("AddFinalizer(...): %s", err)
+			}
+			if !InUse(store["old"]) {
+				t.Errorf("AddFinalizer(...): old reference is not in use")
+			}
+
+			ref.Name = "new"
+			if tc.reconcileMoved {
+				if err := f.AddFinalizer(ctx, u); err != nil {
+					t.Fatalf("AddFinalizer(...): %s", err)
+				}
+				if InUse(store["old"]) {
+					t.Errorf("AddFinalizer(...): old reference is still in use")
+				}
+				if !InUse(store["new"]) {
+					t.Errorf("AddFinalizer(...): new reference is not in use")
+				}
+			}
+
+			if err := f.RemoveFinalizer(ctx, u); err != nil {
+				t.Fatalf("RemoveFinalizer(...): %s", err)
+			}
+			for n, mg := range store {
+				if InUse(mg) {
+					t.Errorf("RemoveFinalizer(...): %s is still in use", n)
+				}
+			}
+		})
+	}
+}
+
+func TestInUse(t *testing.T) {
+	cases := map[string]struct {
+		o    *fake.Managed
+		want bool
+	}{
+		"InUse": {
+			o:    used("other", FinalizerPrefix+string(userUID)),
+			want: true,
+		},
+		"NotInUse": {
+			o:    used("other"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, InUse(tc.o)); diff != "" {
+				t.Errorf("InUse(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/xray"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.GroupClient = (*MockGroupClient)(nil)
+
+// MockGroupClient is a type that implements all the methods for GroupClient interface
+type MockGroupClient struct {
+	MockCreateGroup func(*xray.CreateGroupInput) xray.CreateGroupRequest
+	MockGetGroup    func(*xray.GetGroupInput) xray.GetGroupRequest
+	MockUpdateGroup func(*xray.UpdateGroupInput) xray.UpdateGroupRequest
+	MockDeleteGroup func(*xray.DeleteGroupInput) xray.DeleteGroupRequest
+}
+
+// CreateGroupRequest calls the underlying MockCreateGroup method.
+func (c *MockGroupClient) CreateGroupRequest(i *xray.CreateGroupInput) xray.CreateGroupRequest {
+	return c.MockCreateGroup(i)
+}
+
+// GetGroupRequest calls the underlying MockGetGroup method.
+func (c *MockGroupClient) GetGroupRequest(i *xray.GetGroupInput) xray.GetGroupRequest {
+	return c.MockGetGroup(i)
+}
+
+// UpdateGroupRequest calls the underlying MockUpdateGroup method.
+func (c *MockGroupClient) UpdateGroupRequest(i *xray.UpdateGroupInput) xray.UpdateGroupRequest {
+	return c.MockUpdateGroup(i)
+}
+
+// DeleteGroupRequest calls the underlying MockDeleteGroup method.
+func (c *MockGroupClient) DeleteGroupRequest(i *xray.DeleteGroupInput) xray.DeleteGroupRequest {
+	return c.MockDeleteGroup(i)
+}
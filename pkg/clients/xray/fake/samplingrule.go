@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/xray"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SamplingRuleClient = (*MockSamplingRuleClient)(nil)
+
+// MockSamplingRuleClient is a type that implements all the methods for SamplingRuleClient interface
+type MockSamplingRuleClient struct {
+	MockCreateSamplingRule func(*xray.CreateSamplingRuleInput) xray.CreateSamplingRuleRequest
+	MockGetSamplingRules   func(*xray.GetSamplingRulesInput) xray.GetSamplingRulesRequest
+	MockUpdateSamplingRule func(*xray.UpdateSamplingRuleInput) xray.UpdateSamplingRuleRequest
+	MockDeleteSamplingRule func(*xray.DeleteSamplingRuleInput) xray.DeleteSamplingRuleRequest
+}
+
+// CreateSamplingRuleRequest calls the underlying MockCreateSamplingRule method.
+func (c *MockSamplingRuleClient) CreateSamplingRuleRequest(i *xray.CreateSamplingRuleInput) xray.CreateSamplingRuleRequest {
+	return c.MockCreateSamplingRule(i)
+}
+
+// GetSamplingRulesRequest calls the underlying MockGetSamplingRules method.
+func (c *MockSamplingRuleClient) GetSamplingRulesRequest(i *xray.GetSamplingRulesInput) xray.GetSamplingRulesRequest {
+	return c.MockGetSamplingRules(i)
+}
+
+// UpdateSamplingRuleRequest calls the underlying MockUpdateSamplingRule method.
+func (c *MockSamplingRuleClient) UpdateSamplingRuleRequest(i *xray.UpdateSamplingRuleInput) xray.UpdateSamplingRuleRequest {
+	return c.MockUpdateSamplingRule(i)
+}
+
+// DeleteSamplingRuleRequest calls the underlying MockDeleteSamplingRule method.
+func (c *MockSamplingRuleClient) DeleteSamplingRuleRequest(i *xray.DeleteSamplingRuleInput) xray.DeleteSamplingRuleRequest {
+	return c.MockDeleteSamplingRule(i)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xray
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+
+	"github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GroupClient is the external client used for XRayGroup Custom Resource
+type GroupClient interface {
+	CreateGroupRequest(*xray.CreateGroupInput) xray.CreateGroupRequest
+	GetGroupRequest(*xray.GetGroupInput) xray.GetGroupRequest
+	UpdateGroupRequest(*xray.UpdateGroupInput) xray.UpdateGroupRequest
+	DeleteGroupRequest(*xray.DeleteGroupInput) xray.DeleteGroupRequest
+}
+
+// NewGroupClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewGroupClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (GroupClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return xray.New(*cfg), err
+}
+
+// IsGroupNotFound returns true if the error is because the group doesn't
+// exist. X-Ray reports missing groups as invalid requests.
+func IsGroupNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == xray.ErrCodeInvalidRequestException &&
+			strings.Contains(strings.ToLower(awsErr.Message()), "not found")
+	}
+	return false
+}
+
+// GenerateGroupObservation is used to produce v1alpha1.XRayGroupObservation
+// from xray.Group.
+func GenerateGroupObservation(g xray.Group) v1alpha1.XRayGroupObservation {
+	return v1alpha1.XRayGroupObservation{
+		GroupARN: aws.StringValue(g.GroupARN),
+	}
+}
+
+// LateInitializeGroup fills the empty fields in *v1alpha1.XRayGroupParameters
+// with the values seen in xray.Group.
+func LateInitializeGroup(in *v1alpha1.XRayGroupParameters, g *xray.Group) {
+	if g == nil {
+		return
+	}
+	in.FilterExpression = awsclients.LateInitializeStringPtr(in.FilterExpression, g.FilterExpression)
+}
+
+// IsGroupUpToDate returns true if there is no update-able difference between
+// desired and observed state of the resource.
+func IsGroupUpToDate(p v1alpha1.XRayGroupParameters, g xray.Group) bool {
+	return aws.StringValue(p.FilterExpression) == aws.StringValue(g.FilterExpression)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xray
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errInvalidFixedRate = "fixedRate must be a decimal number between 0 and 1"
+
+	// samplingRuleVersion is the only sampling rule format version X-Ray
+	// currently supports.
+	samplingRuleVersion = 1
+)
+
+// SamplingRuleClient is the external client used for SamplingRule Custom
+// Resource
+type SamplingRuleClient interface {
+	CreateSamplingRuleRequest(*xray.CreateSamplingRuleInput) xray.CreateSamplingRuleRequest
+	GetSamplingRulesRequest(*xray.GetSamplingRulesInput) xray.GetSamplingRulesRequest
+	UpdateSamplingRuleRequest(*xray.UpdateSamplingRuleInput) xray.UpdateSamplingRuleRequest
+	DeleteSamplingRuleRequest(*xray.DeleteSamplingRuleInput) xray.DeleteSamplingRuleRequest
+}
+
+// NewSamplingRuleClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewSamplingRuleClient(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (SamplingRuleClient, error) {
+	cfg, err := auth(ctx, credentials, awsclients.DefaultSection, region)
+	if cfg == nil {
+		return nil, err
+	}
+	return xray.New(*cfg), err
+}
+
+// IsSamplingRuleNotFound returns true if the error is because the sampling
+// rule doesn't exist. X-Ray does not have a dedicated error code for missing
+// rules, it reports them as invalid requests.
+func IsSamplingRuleNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == xray.ErrCodeInvalidRequestException
+	}
+	return false
+}
+
+// FindSamplingRule returns the sampling rule record with the given name, or
+// nil if there isn't one.
+func FindSamplingRule(ctx context.Context, c SamplingRuleClient, name string) (*xray.SamplingRuleRecord, error) {
+	input := &xray.GetSamplingRulesInput{}
+	for {
+		rsp, err := c.GetSamplingRulesRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rsp.SamplingRuleRecords {
+			r := rsp.SamplingRuleRecords[i]
+			if r.SamplingRule != nil && aws.StringValue(r.SamplingRule.RuleName) == name {
+				return &r, nil
+			}
+		}
+		if aws.StringValue(rsp.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+func parseFixedRate(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 0 || f > 1 {
+		return 0, errors.New(errInvalidFixedRate)
+	}
+	return f, nil
+}
+
+// GenerateSamplingRule returns the X-Ray sampling rule described by the
+// supplied parameters.
+func GenerateSamplingRule(name string, p v1alpha1.SamplingRuleParameters) (*xray.SamplingRule, error) {
+	rate, err := parseFixedRate(p.FixedRate)
+	if err != nil {
+		return nil, err
+	}
+	r := &xray.SamplingRule{
+		Attributes:    p.Attributes,
+		FixedRate:     aws.Float64(rate),
+		HTTPMethod:    aws.String(p.HTTPMethod),
+		Host:          aws.String(p.Host),
+		Priority:      aws.Int64(p.Priority),
+		ReservoirSize: aws.Int64(p.ReservoirSize),
+		ResourceARN:   aws.String(p.ResourceARN),
+		RuleName:      aws.String(name),
+		ServiceName:   aws.String(p.ServiceName),
+		ServiceType:   aws.String(p.ServiceType),
+		URLPath:       aws.String(p.URLPath),
+		Version:       p.Version,
+	}
+	if r.Version == nil {
+		r.Version = aws.Int64(samplingRuleVersion)
+	}
+	return r, nil
+}
+
+// GenerateSamplingRuleUpdate returns the update that brings the X-Ray
+// sampling rule with the given name to the state described by the supplied
+// parameters.
+func GenerateSamplingRuleUpdate(name string, p v1alpha1.SamplingRuleParameters) (*xray.SamplingRuleUpdate, error) {
+	rate, err := parseFixedRate(p.FixedRate)
+	if err != nil {
+		return nil, err
+	}
+	return &xray.SamplingRuleUpdate{
+		Attributes:    p.Attributes,
+		FixedRate:     aws.Float64(rate),
+		HTTPMethod:    aws.String(p.HTTPMethod),
+		Host:          aws.String(p.Host),
+		Priority:      aws.Int64(p.Priority),
+		ReservoirSize: aws.Int64(p.ReservoirSize),
+		ResourceARN:   aws.String(p.ResourceARN),
+		RuleName:      aws.String(name),
+		ServiceName:   aws.String(p.ServiceName),
+		ServiceType:   aws.String(p.ServiceType),
+		URLPath:       aws.String(p.URLPath),
+	}, nil
+}
+
+// GenerateSamplingRuleObservation is used to produce
+// v1alpha1.SamplingRuleObservation from xray.SamplingRuleRecord.
+func GenerateSamplingRuleObservation(r xray.SamplingRuleRecord) v1alpha1.SamplingRuleObservation {
+	o := v1alpha1.SamplingRuleObservation{}
+	if r.SamplingRule != nil {
+		o.RuleARN = aws.StringValue(r.SamplingRule.RuleARN)
+	}
+	if r.CreatedAt != nil {
+		t := metav1.NewTime(*r.CreatedAt)
+		o.CreatedAt = &t
+	}
+	if r.ModifiedAt != nil {
+		t := metav1.NewTime(*r.ModifiedAt)
+		o.ModifiedAt = &t
+	}
+	return o
+}
+
+// LateInitializeSamplingRule fills the empty fields in
+// *v1alpha1.SamplingRuleParameters with the values seen in xray.SamplingRule.
+func LateInitializeSamplingRule(in *v1alpha1.SamplingRuleParameters, r *xray.SamplingRule) {
+	if r == nil {
+		return
+	}
+	in.Version = awsclients.LateInitializeInt64Ptr(in.Version, r.Version)
+	if len(in.Attributes) == 0 && len(r.Attributes) != 0 {
+		in.Attributes = r.Attributes
+	}
+}
+
+// IsSamplingRuleUpToDate returns true if there is no update-able difference
+// between desired and observed state of the resource.
+func IsSamplingRuleUpToDate(p v1alpha1.SamplingRuleParameters, r xray.SamplingRule) bool {
+	rate, err := parseFixedRate(p.FixedRate)
+	if err != nil || rate != aws.Float64Value(r.FixedRate) {
+		return false
+	}
+	return cmp.Equal(p.Attributes, r.Attributes, cmpopts.EquateEmpty()) &&
+		p.HTTPMethod == aws.StringValue(r.HTTPMethod) &&
+		p.Host == aws.StringValue(r.Host) &&
+		p.Priority == aws.Int64Value(r.Priority) &&
+		p.ReservoirSize == aws.Int64Value(r.ReservoirSize) &&
+		p.ResourceARN == aws.StringValue(r.ResourceARN) &&
+		p.ServiceName == aws.StringValue(r.ServiceName) &&
+		p.ServiceType == aws.StringValue(r.ServiceType) &&
+		p.URLPath == aws.StringValue(r.URLPath)
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xray
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+)
+
+var (
+	ruleName = "some-rule"
+)
+
+func ruleParams(m ...func(*v1alpha1.SamplingRuleParameters)) v1alpha1.SamplingRuleParameters {
+	p := v1alpha1.SamplingRuleParameters{
+		FixedRate:     "0.05",
+		HTTPMethod:    "GET",
+		Host:          "*",
+		Priority:      10,
+		ReservoirSize: 1,
+		ResourceARN:   "*",
+		ServiceName:   "example",
+		ServiceType:   "*",
+		URLPath:       "/api/*",
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func rule(m ...func(*xray.SamplingRule)) xray.SamplingRule {
+	r := xray.SamplingRule{
+		FixedRate:     aws.Float64(0.05),
+		HTTPMethod:    aws.String("GET"),
+		Host:          aws.String("*"),
+		Priority:      aws.Int64(10),
+		ReservoirSize: aws.Int64(1),
+		ResourceARN:   aws.String("*"),
+		RuleName:      aws.String(ruleName),
+		ServiceName:   aws.String("example"),
+		ServiceType:   aws.String("*"),
+		URLPath:       aws.String("/api/*"),
+		Version:       aws.Int64(1),
+	}
+	for _, f := range m {
+		f(&r)
+	}
+	return r
+}
+
+type fakeRulesClient struct {
+	SamplingRuleClient
+	pages [][]xray.SamplingRuleRecord
+}
+
+func (c *fakeRulesClient) GetSamplingRulesRequest(i *xray.GetSamplingRulesInput) xray.GetSamplingRulesRequest {
+	page := 0
+	if i.NextToken != nil {
+		page = 1
+	}
+	out := &xray.GetSamplingRulesOutput{SamplingRuleRecords: c.pages[page]}
+	if page+1 < len(c.pages) {
+		out.NextToken = aws.String("next")
+	}
+	return xray.GetSamplingRulesRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+	}
+}
+
+func TestFindSamplingRule(t *testing.T) {
+	found := rule()
+	other := rule(func(r *xray.SamplingRule) { r.RuleName = aws.String("other") })
+
+	cases := map[string]struct {
+		pages [][]xray.SamplingRuleRecord
+		want  *xray.SamplingRuleRecord
+	}{
+		"FirstPage": {
+			pages: [][]xray.SamplingRuleRecord{{{SamplingRule: &other}, {SamplingRule: &found}}},
+			want:  &xray.SamplingRuleRecord{SamplingRule: &found},
+		},
+		"SecondPage": {
+			pages: [][]xray.SamplingRuleRecord{{{SamplingRule: &other}}, {{SamplingRule: &found}}},
+			want:  &xray.SamplingRuleRecord{SamplingRule: &found},
+		},
+		"Missing": {
+			pages: [][]xray.SamplingRuleRecord{{{SamplingRule: &other}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FindSamplingRule(context.Background(), &fakeRulesClient{pages: tc.pages}, ruleName)
+			if err != nil {
+				t.Fatalf("FindSamplingRule(...): unexpected error %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSamplingRule(t *testing.T) {
+	type want struct {
+		rule *xray.SamplingRule
+		err  error
+	}
+
+	cases := map[string]struct {
+		params v1alpha1.SamplingRuleParameters
+		want   want
+	}{
+		"DefaultVersion": {
+			params: ruleParams(),
+			want:   want{rule: func() *xray.SamplingRule { r := rule(); return &r }()},
+		},
+		"InvalidFixedRate": {
+			params: ruleParams(func(p *v1alpha1.SamplingRuleParameters) { p.FixedRate = "1.5" }),
+			want:   want{err: errors.New(errInvalidFixedRate)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateSamplingRule(ruleName, tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rule, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSamplingRule(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.SamplingRuleParameters
+		rule   xray.SamplingRule
+		want   v1alpha1.SamplingRuleParameters
+	}{
+		"AllFilled": {
+			params: ruleParams(func(p *v1alpha1.SamplingRuleParameters) { p.Version = aws.Int64(1) }),
+			rule:   rule(),
+			want:   ruleParams(func(p *v1alpha1.SamplingRuleParameters) { p.Version = aws.Int64(1) }),
+		},
+		"PartialFilled": {
+			params: ruleParams(),
+			rule: rule(func(r *xray.SamplingRule) {
+				r.Attributes = map[string]string{"env": "prod"}
+			}),
+			want: ruleParams(func(p *v1alpha1.SamplingRuleParameters) {
+				p.Version = aws.Int64(1)
+				p.Attributes = map[string]string{"env": "prod"}
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSamplingRule(&tc.params, &tc.rule)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSamplingRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.SamplingRuleParameters
+		rule   xray.SamplingRule
+		want   bool
+	}{
+		"SameFields": {
+			params: ruleParams(),
+			rule:   rule(),
+			want:   true,
+		},
+		"EmptyAttributes": {
+			params: ruleParams(func(p *v1alpha1.SamplingRuleParameters) { p.Attributes = map[string]string{} }),
+			rule:   rule(),
+			want:   true,
+		},
+		"DifferentFixedRate": {
+			params: ruleParams(func(p *v1alpha1.SamplingRuleParameters) { p.FixedRate = "0.1" }),
+			rule:   rule(),
+			want:   false,
+		},
+		"DifferentPriority": {
+			params: ruleParams(),
+			rule:   rule(func(r *xray.SamplingRule) { r.Priority = aws.Int64(20) }),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSamplingRuleUpToDate(tc.params, tc.rule)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
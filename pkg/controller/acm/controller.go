@@ -36,6 +36,11 @@ import (
 
 	v1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	acm "github.com/crossplane/provider-aws/pkg/clients/acm"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 	"github.com/crossplane/provider-aws/pkg/controller/utils"
 )
 
@@ -64,10 +69,11 @@ func SetupCertificate(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha1.Certificate{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}))))),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
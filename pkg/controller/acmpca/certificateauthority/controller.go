@@ -35,6 +35,11 @@ import (
 
 	v1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 	acmpca "github.com/crossplane/provider-aws/pkg/clients/acmpca"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 	"github.com/crossplane/provider-aws/pkg/controller/utils"
 )
 
@@ -62,10 +67,11 @@ func SetupCertificateAuthority(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha1.CertificateAuthority{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateAuthorityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}))))),
 			managed.WithConnectionPublishers(),
 
 			// TODO: implement tag initializer
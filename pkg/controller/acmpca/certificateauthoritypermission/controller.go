@@ -33,6 +33,11 @@ import (
 
 	v1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 	acmpca "github.com/crossplane/provider-aws/pkg/clients/acmpca"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 	"github.com/crossplane/provider-aws/pkg/controller/utils"
 )
 
@@ -52,10 +57,11 @@ func SetupCertificateAuthorityPermission(mgr ctrl.Manager, l logging.Logger) err
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha1.CertificateAuthorityPermission{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateAuthorityPermissionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}))))),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
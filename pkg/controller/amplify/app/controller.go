@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsamplify "github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an Amplify App resource"
+	errCreateClient      = "cannot create Amplify client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the Amplify App custom resource"
+
+	errDescribe = "cannot describe Amplify App"
+	errCreate   = "cannot create Amplify App"
+	errUpdate   = "cannot update Amplify App"
+	errDelete   = "cannot delete Amplify App"
+
+	errGetTokenSecret = "cannot get repository token secret"
+)
+
+// SetupApp adds a controller that reconciles Amplify Apps.
+func SetupApp(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AppGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.App{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AppGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: amplify.NewAppClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (amplify.AppClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.App)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client amplify.AppClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.App)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.GetAppRequest(&awsamplify.GetAppInput{
+		AppId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(amplify.IsNotFound, err), errDescribe)
+	}
+	observed := *rsp.App
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	amplify.LateInitializeApp(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = amplify.GenerateAppObservation(observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: amplify.IsAppUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.App)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	accessToken, err := e.getToken(ctx, cr.Spec.ForProvider.AccessTokenSecretRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetTokenSecret)
+	}
+	oauthToken, err := e.getToken(ctx, cr.Spec.ForProvider.OAuthTokenSecretRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetTokenSecret)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateAppRequest(amplify.GenerateCreateAppInput(cr.Spec.ForProvider, accessToken, oauthToken)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.App.AppId))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.App)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.UpdateAppRequest(amplify.GenerateUpdateAppInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.App)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteAppRequest(&awsamplify.DeleteAppInput{
+		AppId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(amplify.IsNotFound, err), errDelete)
+}
+
+// getToken returns the value of the referenced secret key, or nil if no
+// secret is referenced.
+func (e *external) getToken(ctx context.Context, ref *runtimev1alpha1.SecretKeySelector) (*string, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, err
+	}
+	return aws.String(string(s.Data[ref.Key])), nil
+}
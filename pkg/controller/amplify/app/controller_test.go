@@ -0,0 +1,624 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsamplify "github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	appID   = "d1abcdefgh"
+	appName = "my-app"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client amplify.AppClient
+	kube   client.Client
+	cr     *v1alpha1.App
+}
+
+type appModifier func(*v1alpha1.App)
+
+func withExternalName(n string) appModifier {
+	return func(r *v1alpha1.App) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) appModifier {
+	return func(r *v1alpha1.App) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.AppObservation) appModifier {
+	return func(r *v1alpha1.App) { r.Status.AtProvider = o }
+}
+
+func app(m ...appModifier) *v1alpha1.App {
+	cr := &v1alpha1.App{
+		Spec: v1alpha1.AppSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.AppParameters{
+				Name:        appName,
+				Description: aws.String("front-end"),
+				Repository:  aws.String("https://github.com/example/app"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(o *awsamplify.GetAppOutput, err error) func(*awsamplify.GetAppInput) awsamplify.GetAppRequest {
+	return func(*awsamplify.GetAppInput) awsamplify.GetAppRequest {
+		return awsamplify.GetAppRequest{Request: request(o, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (amplify.AppClient, error)
+		cr          *v1alpha1.App
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i amplify.AppClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: app(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i amplify.AppClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: app(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.App
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awsamplify.App{
+		AppId:         aws.String(appID),
+		AppArn:        aws.String("arn"),
+		Name:          aws.String(appName),
+		Description:   aws.String("front-end"),
+		Repository:    aws.String("https://github.com/example/app"),
+		DefaultDomain: aws.String("d1abcdefgh.amplifyapp.com"),
+	}
+	status := v1alpha1.AppObservation{AppARN: "arn", DefaultDomain: "d1abcdefgh.amplifyapp.com"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: app(),
+			},
+			want: want{
+				cr: app(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp: get(&awsamplify.GetAppOutput{App: observed}, nil),
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(
+					withExternalName(appID),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockAppClient{
+					MockGetApp: get(&awsamplify.GetAppOutput{App: observed}, nil),
+				},
+				cr: app(withExternalName(appID), func(r *v1alpha1.App) { r.Spec.ForProvider.Description = nil }),
+			},
+			want: want{
+				cr: app(
+					withExternalName(appID),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DescriptionChanged": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp: get(&awsamplify.GetAppOutput{App: observed}, nil),
+				},
+				cr: app(withExternalName(appID), func(r *v1alpha1.App) { r.Spec.ForProvider.Description = aws.String("other") }),
+			},
+			want: want{
+				cr: app(
+					withExternalName(appID),
+					func(r *v1alpha1.App) { r.Spec.ForProvider.Description = aws.String("other") },
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp: get(nil, awserr.New(awsamplify.ErrCodeNotFoundException, "", nil)),
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp: get(nil, errBoom),
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr:  app(withExternalName(appID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.App
+		result managed.ExternalCreation
+		err    error
+	}
+
+	tokenRef := &runtimev1alpha1.SecretKeySelector{
+		SecretReference: runtimev1alpha1.SecretReference{Namespace: secretNamespace, Name: "github"},
+		Key:             "token",
+	}
+	withToken := func(r *v1alpha1.App) { r.Spec.ForProvider.AccessTokenSecretRef = tokenRef }
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key != (client.ObjectKey{Namespace: secretNamespace, Name: "github"}) {
+							return errBoom
+						}
+						obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte("s3cr3t")}
+						return nil
+					},
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockAppClient{
+					MockCreateApp: func(in *awsamplify.CreateAppInput) awsamplify.CreateAppRequest {
+						if diff := cmp.Diff("s3cr3t", aws.StringValue(in.AccessToken)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.CreateAppRequest{Request: request(&awsamplify.CreateAppOutput{
+							App: &awsamplify.App{AppId: aws.String(appID)},
+						}, nil)}
+					},
+				},
+				cr: app(withToken),
+			},
+			want: want{
+				cr: app(withToken, withExternalName(appID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedTokenSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: app(withToken),
+			},
+			want: want{
+				cr:  app(withToken),
+				err: errors.Wrap(errBoom, errGetTokenSecret),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockCreateApp: func(*awsamplify.CreateAppInput) awsamplify.CreateAppRequest {
+						return awsamplify.CreateAppRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				cr:  app(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.App
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockUpdateApp: func(in *awsamplify.UpdateAppInput) awsamplify.UpdateAppRequest {
+						if diff := cmp.Diff(appID, aws.StringValue(in.AppId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.UpdateAppRequest{Request: request(&awsamplify.UpdateAppOutput{}, nil)}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockUpdateApp: func(*awsamplify.UpdateAppInput) awsamplify.UpdateAppRequest {
+						return awsamplify.UpdateAppRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr:  app(withExternalName(appID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.App
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockDeleteApp: func(*awsamplify.DeleteAppInput) awsamplify.DeleteAppRequest {
+						return awsamplify.DeleteAppRequest{Request: request(&awsamplify.DeleteAppOutput{}, nil)}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockDeleteApp: func(*awsamplify.DeleteAppInput) awsamplify.DeleteAppRequest {
+						return awsamplify.DeleteAppRequest{Request: request(nil, awserr.New(awsamplify.ErrCodeNotFoundException, "", nil))}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockDeleteApp: func(*awsamplify.DeleteAppInput) awsamplify.DeleteAppRequest {
+						return awsamplify.DeleteAppRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr:  app(withExternalName(appID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,561 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branch
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsamplify "github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	appID      = "d1abcdefgh"
+	branchName = "main"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client amplify.BranchClient
+	kube   client.Client
+	cr     *v1alpha1.Branch
+}
+
+type branchModifier func(*v1alpha1.Branch)
+
+func withConditions(c ...runtimev1alpha1.Condition) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.BranchObservation) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Status.AtProvider = o }
+}
+
+func branch(m ...branchModifier) *v1alpha1.Branch {
+	cr := &v1alpha1.Branch{
+		Spec: v1alpha1.BranchSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.BranchParameters{
+				AppID:           aws.String(appID),
+				Stage:           aws.String("PRODUCTION"),
+				EnableAutoBuild: aws.Bool(true),
+			},
+		},
+	}
+	meta.SetExternalName(cr, branchName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(o *awsamplify.GetBranchOutput, err error) func(*awsamplify.GetBranchInput) awsamplify.GetBranchRequest {
+	return func(*awsamplify.GetBranchInput) awsamplify.GetBranchRequest {
+		return awsamplify.GetBranchRequest{Request: request(o, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (amplify.BranchClient, error)
+		cr          *v1alpha1.Branch
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i amplify.BranchClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: branch(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i amplify.BranchClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: branch(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Branch
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awsamplify.Branch{
+		BranchArn:       aws.String("arn"),
+		BranchName:      aws.String(branchName),
+		Stage:           awsamplify.StageProduction,
+		Framework:       aws.String("React"),
+		EnableAutoBuild: aws.Bool(true),
+	}
+	withFramework := func(r *v1alpha1.Branch) { r.Spec.ForProvider.Framework = aws.String("React") }
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockBranchClient{
+					MockGetBranch: get(&awsamplify.GetBranchOutput{Branch: observed}, nil),
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr: branch(
+					withFramework,
+					withStatus(v1alpha1.BranchObservation{BranchARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"StageChanged": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockGetBranch: get(&awsamplify.GetBranchOutput{Branch: observed}, nil),
+				},
+				cr: branch(withFramework, func(r *v1alpha1.Branch) { r.Spec.ForProvider.Stage = aws.String("BETA") }),
+			},
+			want: want{
+				cr: branch(
+					withFramework,
+					func(r *v1alpha1.Branch) { r.Spec.ForProvider.Stage = aws.String("BETA") },
+					withStatus(v1alpha1.BranchObservation{BranchARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockGetBranch: get(nil, awserr.New(awsamplify.ErrCodeNotFoundException, "", nil)),
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr: branch(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockGetBranch: get(nil, errBoom),
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr:  branch(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Branch
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockCreateBranch: func(in *awsamplify.CreateBranchInput) awsamplify.CreateBranchRequest {
+						if diff := cmp.Diff(branchName, aws.StringValue(in.BranchName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.CreateBranchRequest{Request: request(&awsamplify.CreateBranchOutput{}, nil)}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr: branch(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockCreateBranch: func(*awsamplify.CreateBranchInput) awsamplify.CreateBranchRequest {
+						return awsamplify.CreateBranchRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr:  branch(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Branch
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockUpdateBranch: func(*awsamplify.UpdateBranchInput) awsamplify.UpdateBranchRequest {
+						return awsamplify.UpdateBranchRequest{Request: request(&awsamplify.UpdateBranchOutput{}, nil)}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr: branch(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockUpdateBranch: func(*awsamplify.UpdateBranchInput) awsamplify.UpdateBranchRequest {
+						return awsamplify.UpdateBranchRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr:  branch(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Branch
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockDeleteBranch: func(*awsamplify.DeleteBranchInput) awsamplify.DeleteBranchRequest {
+						return awsamplify.DeleteBranchRequest{Request: request(&awsamplify.DeleteBranchOutput{}, nil)}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr: branch(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockDeleteBranch: func(*awsamplify.DeleteBranchInput) awsamplify.DeleteBranchRequest {
+						return awsamplify.DeleteBranchRequest{Request: request(nil, awserr.New(awsamplify.ErrCodeNotFoundException, "", nil))}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr: branch(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockBranchClient{
+					MockDeleteBranch: func(*awsamplify.DeleteBranchInput) awsamplify.DeleteBranchRequest {
+						return awsamplify.DeleteBranchRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: branch(),
+			},
+			want: want{
+				cr:  branch(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
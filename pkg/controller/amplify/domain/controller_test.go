@@ -0,0 +1,621 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsamplify "github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/amplify/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify"
+	"github.com/crossplane/provider-aws/pkg/clients/amplify/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	appID      = "d1abcdefgh"
+	domainName = "example.com"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client amplify.DomainClient
+	kube   client.Client
+	cr     *v1alpha1.Domain
+}
+
+type domainModifier func(*v1alpha1.Domain)
+
+func withConditions(c ...runtimev1alpha1.Condition) domainModifier {
+	return func(r *v1alpha1.Domain) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.DomainObservation) domainModifier {
+	return func(r *v1alpha1.Domain) { r.Status.AtProvider = o }
+}
+
+func domain(m ...domainModifier) *v1alpha1.Domain {
+	cr := &v1alpha1.Domain{
+		Spec: v1alpha1.DomainSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DomainParameters{
+				AppID: aws.String(appID),
+				SubDomainSettings: []v1alpha1.SubDomainSetting{
+					{Prefix: "www", BranchName: "main"},
+					{Prefix: "", BranchName: "main"},
+				},
+				EnableAutoSubDomain: aws.Bool(false),
+			},
+		},
+	}
+	meta.SetExternalName(cr, domainName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(o *awsamplify.GetDomainAssociationOutput, err error) func(*awsamplify.GetDomainAssociationInput) awsamplify.GetDomainAssociationRequest {
+	return func(*awsamplify.GetDomainAssociationInput) awsamplify.GetDomainAssociationRequest {
+		return awsamplify.GetDomainAssociationRequest{Request: request(o, err)}
+	}
+}
+
+func association(status awsamplify.DomainStatus) *awsamplify.DomainAssociation {
+	return &awsamplify.DomainAssociation{
+		DomainAssociationArn: aws.String("arn"),
+		DomainName:           aws.String(domainName),
+		DomainStatus:         status,
+		EnableAutoSubDomain:  aws.Bool(false),
+		SubDomains: []awsamplify.SubDomain{
+			{
+				SubDomainSetting: &awsamplify.SubDomainSetting{Prefix: aws.String(""), BranchName: aws.String("main")},
+				Verified:         aws.Bool(true),
+			},
+			{
+				SubDomainSetting: &awsamplify.SubDomainSetting{Prefix: aws.String("www"), BranchName: aws.String("main")},
+				Verified:         aws.Bool(true),
+			},
+		},
+	}
+}
+
+func observation(status string) v1alpha1.DomainObservation {
+	return v1alpha1.DomainObservation{
+		DomainAssociationARN: "arn",
+		DomainStatus:         status,
+		SubDomains: []v1alpha1.SubDomain{
+			{Prefix: "", BranchName: "main", Verified: true},
+			{Prefix: "www", BranchName: "main", Verified: true},
+		},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (amplify.DomainClient, error)
+		cr          *v1alpha1.Domain
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i amplify.DomainClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: domain(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i amplify.DomainClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: domain(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Domain
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockGetDomainAssociation: get(&awsamplify.GetDomainAssociationOutput{
+						DomainAssociation: association(awsamplify.DomainStatusAvailable),
+					}, nil),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(
+					withStatus(observation("AVAILABLE")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingVerification": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockGetDomainAssociation: get(&awsamplify.GetDomainAssociationOutput{
+						DomainAssociation: association(awsamplify.DomainStatusPendingVerification),
+					}, nil),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(
+					withStatus(observation("PENDING_VERIFICATION")),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockGetDomainAssociation: get(&awsamplify.GetDomainAssociationOutput{
+						DomainAssociation: association(awsamplify.DomainStatusFailed),
+					}, nil),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(
+					withStatus(observation("FAILED")),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SubDomainChanged": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockGetDomainAssociation: get(&awsamplify.GetDomainAssociationOutput{
+						DomainAssociation: association(awsamplify.DomainStatusAvailable),
+					}, nil),
+				},
+				cr: domain(func(r *v1alpha1.Domain) { r.Spec.ForProvider.SubDomainSettings[0].BranchName = "dev" }),
+			},
+			want: want{
+				cr: domain(
+					func(r *v1alpha1.Domain) { r.Spec.ForProvider.SubDomainSettings[0].BranchName = "dev" },
+					withStatus(observation("AVAILABLE")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockGetDomainAssociation: get(nil, awserr.New(awsamplify.ErrCodeNotFoundException, "", nil)),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockGetDomainAssociation: get(nil, errBoom),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Domain
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockCreateDomainAssociation: func(in *awsamplify.CreateDomainAssociationInput) awsamplify.CreateDomainAssociationRequest {
+						if diff := cmp.Diff(domainName, aws.StringValue(in.DomainName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsamplify.CreateDomainAssociationRequest{Request: request(&awsamplify.CreateDomainAssociationOutput{}, nil)}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockCreateDomainAssociation: func(*awsamplify.CreateDomainAssociationInput) awsamplify.CreateDomainAssociationRequest {
+						return awsamplify.CreateDomainAssociationRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Domain
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockUpdateDomainAssociation: func(*awsamplify.UpdateDomainAssociationInput) awsamplify.UpdateDomainAssociationRequest {
+						return awsamplify.UpdateDomainAssociationRequest{Request: request(&awsamplify.UpdateDomainAssociationOutput{}, nil)}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockUpdateDomainAssociation: func(*awsamplify.UpdateDomainAssociationInput) awsamplify.UpdateDomainAssociationRequest {
+						return awsamplify.UpdateDomainAssociationRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Domain
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockDeleteDomainAssociation: func(*awsamplify.DeleteDomainAssociationInput) awsamplify.DeleteDomainAssociationRequest {
+						return awsamplify.DeleteDomainAssociationRequest{Request: request(&awsamplify.DeleteDomainAssociationOutput{}, nil)}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockDeleteDomainAssociation: func(*awsamplify.DeleteDomainAssociationInput) awsamplify.DeleteDomainAssociationRequest {
+						return awsamplify.DeleteDomainAssociationRequest{Request: request(nil, awserr.New(awsamplify.ErrCodeNotFoundException, "", nil))}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDomainClient{
+					MockDeleteDomainAssociation: func(*awsamplify.DeleteDomainAssociationInput) awsamplify.DeleteDomainAssociationRequest {
+						return awsamplify.DeleteDomainAssociationRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,650 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configurationprofile
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappconfig "github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appconfig/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/appconfig"
+	"github.com/crossplane/provider-aws/pkg/clients/appconfig/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	resourceID  = "cfg1234"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsappconfig.ErrCodeResourceNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client appconfig.ConfigurationProfileClient
+	kube   client.Client
+	cr     *v1alpha1.ConfigurationProfile
+}
+
+type configurationProfileModifier func(*v1alpha1.ConfigurationProfile)
+
+func withConditions(c ...runtimev1alpha1.Condition) configurationProfileModifier {
+	return func(r *v1alpha1.ConfigurationProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) configurationProfileModifier {
+	return func(r *v1alpha1.ConfigurationProfile) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha1.ConfigurationProfileObservation) configurationProfileModifier {
+	return func(r *v1alpha1.ConfigurationProfile) { r.Status.AtProvider = o }
+}
+
+func withDescription(s string) configurationProfileModifier {
+	return func(r *v1alpha1.ConfigurationProfile) { r.Spec.ForProvider.Description = aws.String(s) }
+}
+
+func configurationProfile(m ...configurationProfileModifier) *v1alpha1.ConfigurationProfile {
+	cr := &v1alpha1.ConfigurationProfile{
+		Spec: v1alpha1.ConfigurationProfileSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ConfigurationProfileParameters{
+				ApplicationID:    aws.String("abc1234"),
+				Name:             "flags",
+				LocationURI:      "hosted",
+				RetrievalRoleARN: aws.String("arn:aws:iam::123456789012:role/appconfig"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *awsappconfig.GetConfigurationProfileOutput {
+	return &awsappconfig.GetConfigurationProfileOutput{
+		ApplicationId:    aws.String("abc1234"),
+		Id:               aws.String(resourceID),
+		Name:             aws.String("flags"),
+		Description:      aws.String("desc"),
+		LocationUri:      aws.String("hosted"),
+		RetrievalRoleArn: aws.String("arn:aws:iam::123456789012:role/appconfig"),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (appconfig.ConfigurationProfileClient, error)
+		cr          *v1alpha1.ConfigurationProfile
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appconfig.ConfigurationProfileClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: configurationProfile(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appconfig.ConfigurationProfileClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: configurationProfile(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: configurationProfile(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: configurationProfile(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: configurationProfile(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ConfigurationProfile
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: configurationProfile(),
+			},
+			want: want{
+				cr: configurationProfile(),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockGetConfigurationProfile: func(input *awsappconfig.GetConfigurationProfileInput) awsappconfig.GetConfigurationProfileRequest {
+						return awsappconfig.GetConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID), withDescription("desc")),
+			},
+			want: want{
+				cr: configurationProfile(
+					withExternalName(resourceID),
+					withDescription("desc"),
+					withObservation(v1alpha1.ConfigurationProfileObservation{ConfigurationProfileID: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitDescription": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockConfigurationProfileClient{
+					MockGetConfigurationProfile: func(input *awsappconfig.GetConfigurationProfileInput) awsappconfig.GetConfigurationProfileRequest {
+						return awsappconfig.GetConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: configurationProfile(
+					withExternalName(resourceID),
+					withDescription("desc"),
+					withObservation(v1alpha1.ConfigurationProfileObservation{ConfigurationProfileID: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockGetConfigurationProfile: func(input *awsappconfig.GetConfigurationProfileInput) awsappconfig.GetConfigurationProfileRequest {
+						return awsappconfig.GetConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID), withDescription("new desc")),
+			},
+			want: want{
+				cr: configurationProfile(
+					withExternalName(resourceID),
+					withDescription("new desc"),
+					withObservation(v1alpha1.ConfigurationProfileObservation{ConfigurationProfileID: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockGetConfigurationProfile: func(input *awsappconfig.GetConfigurationProfileInput) awsappconfig.GetConfigurationProfileRequest {
+						return awsappconfig.GetConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockGetConfigurationProfile: func(input *awsappconfig.GetConfigurationProfileInput) awsappconfig.GetConfigurationProfileRequest {
+						return awsappconfig.GetConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  configurationProfile(withExternalName(resourceID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ConfigurationProfile
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockConfigurationProfileClient{
+					MockCreateConfigurationProfile: func(input *awsappconfig.CreateConfigurationProfileInput) awsappconfig.CreateConfigurationProfileRequest {
+						return awsappconfig.CreateConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappconfig.CreateConfigurationProfileOutput{
+								Id: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: configurationProfile(),
+			},
+			want: want{
+				cr: configurationProfile(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockCreateConfigurationProfile: func(input *awsappconfig.CreateConfigurationProfileInput) awsappconfig.CreateConfigurationProfileRequest {
+						return awsappconfig.CreateConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: configurationProfile(),
+			},
+			want: want{
+				cr:  configurationProfile(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"FailedSpecUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &fake.MockConfigurationProfileClient{
+					MockCreateConfigurationProfile: func(input *awsappconfig.CreateConfigurationProfileInput) awsappconfig.CreateConfigurationProfileRequest {
+						return awsappconfig.CreateConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappconfig.CreateConfigurationProfileOutput{
+								Id: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: configurationProfile(),
+			},
+			want: want{
+				cr: configurationProfile(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ConfigurationProfile
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockUpdateConfigurationProfile: func(input *awsappconfig.UpdateConfigurationProfileInput) awsappconfig.UpdateConfigurationProfileRequest {
+						return awsappconfig.UpdateConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappconfig.UpdateConfigurationProfileOutput{}},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockUpdateConfigurationProfile: func(input *awsappconfig.UpdateConfigurationProfileInput) awsappconfig.UpdateConfigurationProfileRequest {
+						return awsappconfig.UpdateConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  configurationProfile(withExternalName(resourceID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ConfigurationProfile
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockDeleteConfigurationProfile: func(input *awsappconfig.DeleteConfigurationProfileInput) awsappconfig.DeleteConfigurationProfileRequest {
+						return awsappconfig.DeleteConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappconfig.DeleteConfigurationProfileOutput{}},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: configurationProfile(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockDeleteConfigurationProfile: func(input *awsappconfig.DeleteConfigurationProfileInput) awsappconfig.DeleteConfigurationProfileRequest {
+						return awsappconfig.DeleteConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: configurationProfile(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockConfigurationProfileClient{
+					MockDeleteConfigurationProfile: func(input *awsappconfig.DeleteConfigurationProfileInput) awsappconfig.DeleteConfigurationProfileRequest {
+						return awsappconfig.DeleteConfigurationProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: configurationProfile(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  configurationProfile(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,654 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploymentstrategy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappconfig "github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appconfig/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/appconfig"
+	"github.com/crossplane/provider-aws/pkg/clients/appconfig/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	resourceID  = "ds12345"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsappconfig.ErrCodeResourceNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client appconfig.DeploymentStrategyClient
+	kube   client.Client
+	cr     *v1alpha1.DeploymentStrategy
+}
+
+type deploymentStrategyModifier func(*v1alpha1.DeploymentStrategy)
+
+func withConditions(c ...runtimev1alpha1.Condition) deploymentStrategyModifier {
+	return func(r *v1alpha1.DeploymentStrategy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) deploymentStrategyModifier {
+	return func(r *v1alpha1.DeploymentStrategy) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha1.DeploymentStrategyObservation) deploymentStrategyModifier {
+	return func(r *v1alpha1.DeploymentStrategy) { r.Status.AtProvider = o }
+}
+
+func withDescription(s string) deploymentStrategyModifier {
+	return func(r *v1alpha1.DeploymentStrategy) { r.Spec.ForProvider.Description = aws.String(s) }
+}
+
+func deploymentStrategy(m ...deploymentStrategyModifier) *v1alpha1.DeploymentStrategy {
+	cr := &v1alpha1.DeploymentStrategy{
+		Spec: v1alpha1.DeploymentStrategySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DeploymentStrategyParameters{
+				Name:                        "linear",
+				DeploymentDurationInMinutes: 10,
+				FinalBakeTimeInMinutes:      aws.Int64(5),
+				GrowthFactor:                "20",
+				GrowthType:                  aws.String("LINEAR"),
+				ReplicateTo:                 "NONE",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *awsappconfig.GetDeploymentStrategyOutput {
+	return &awsappconfig.GetDeploymentStrategyOutput{
+		Id:                          aws.String(resourceID),
+		Name:                        aws.String("linear"),
+		Description:                 aws.String("desc"),
+		DeploymentDurationInMinutes: aws.Int64(10),
+		FinalBakeTimeInMinutes:      aws.Int64(5),
+		GrowthFactor:                aws.Float64(20),
+		GrowthType:                  awsappconfig.GrowthTypeLinear,
+		ReplicateTo:                 awsappconfig.ReplicateToNone,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (appconfig.DeploymentStrategyClient, error)
+		cr          *v1alpha1.DeploymentStrategy
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appconfig.DeploymentStrategyClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: deploymentStrategy(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appconfig.DeploymentStrategyClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: deploymentStrategy(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: deploymentStrategy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: deploymentStrategy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: deploymentStrategy(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DeploymentStrategy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: deploymentStrategy(),
+			},
+			want: want{
+				cr: deploymentStrategy(),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockGetDeploymentStrategy: func(input *awsappconfig.GetDeploymentStrategyInput) awsappconfig.GetDeploymentStrategyRequest {
+						return awsappconfig.GetDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID), withDescription("desc")),
+			},
+			want: want{
+				cr: deploymentStrategy(
+					withExternalName(resourceID),
+					withDescription("desc"),
+					withObservation(v1alpha1.DeploymentStrategyObservation{DeploymentStrategyID: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitDescription": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockDeploymentStrategyClient{
+					MockGetDeploymentStrategy: func(input *awsappconfig.GetDeploymentStrategyInput) awsappconfig.GetDeploymentStrategyRequest {
+						return awsappconfig.GetDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: deploymentStrategy(
+					withExternalName(resourceID),
+					withDescription("desc"),
+					withObservation(v1alpha1.DeploymentStrategyObservation{DeploymentStrategyID: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockGetDeploymentStrategy: func(input *awsappconfig.GetDeploymentStrategyInput) awsappconfig.GetDeploymentStrategyRequest {
+						return awsappconfig.GetDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID), withDescription("new desc")),
+			},
+			want: want{
+				cr: deploymentStrategy(
+					withExternalName(resourceID),
+					withDescription("new desc"),
+					withObservation(v1alpha1.DeploymentStrategyObservation{DeploymentStrategyID: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockGetDeploymentStrategy: func(input *awsappconfig.GetDeploymentStrategyInput) awsappconfig.GetDeploymentStrategyRequest {
+						return awsappconfig.GetDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockGetDeploymentStrategy: func(input *awsappconfig.GetDeploymentStrategyInput) awsappconfig.GetDeploymentStrategyRequest {
+						return awsappconfig.GetDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  deploymentStrategy(withExternalName(resourceID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DeploymentStrategy
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockDeploymentStrategyClient{
+					MockCreateDeploymentStrategy: func(input *awsappconfig.CreateDeploymentStrategyInput) awsappconfig.CreateDeploymentStrategyRequest {
+						return awsappconfig.CreateDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappconfig.CreateDeploymentStrategyOutput{
+								Id: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: deploymentStrategy(),
+			},
+			want: want{
+				cr: deploymentStrategy(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockCreateDeploymentStrategy: func(input *awsappconfig.CreateDeploymentStrategyInput) awsappconfig.CreateDeploymentStrategyRequest {
+						return awsappconfig.CreateDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: deploymentStrategy(),
+			},
+			want: want{
+				cr:  deploymentStrategy(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"FailedSpecUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &fake.MockDeploymentStrategyClient{
+					MockCreateDeploymentStrategy: func(input *awsappconfig.CreateDeploymentStrategyInput) awsappconfig.CreateDeploymentStrategyRequest {
+						return awsappconfig.CreateDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappconfig.CreateDeploymentStrategyOutput{
+								Id: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: deploymentStrategy(),
+			},
+			want: want{
+				cr: deploymentStrategy(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DeploymentStrategy
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockUpdateDeploymentStrategy: func(input *awsappconfig.UpdateDeploymentStrategyInput) awsappconfig.UpdateDeploymentStrategyRequest {
+						return awsappconfig.UpdateDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappconfig.UpdateDeploymentStrategyOutput{}},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockUpdateDeploymentStrategy: func(input *awsappconfig.UpdateDeploymentStrategyInput) awsappconfig.UpdateDeploymentStrategyRequest {
+						return awsappconfig.UpdateDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  deploymentStrategy(withExternalName(resourceID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DeploymentStrategy
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockDeleteDeploymentStrategy: func(input *awsappconfig.DeleteDeploymentStrategyInput) awsappconfig.DeleteDeploymentStrategyRequest {
+						return awsappconfig.DeleteDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsappconfig.DeleteDeploymentStrategyOutput{}},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: deploymentStrategy(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockDeleteDeploymentStrategy: func(input *awsappconfig.DeleteDeploymentStrategyInput) awsappconfig.DeleteDeploymentStrategyRequest {
+						return awsappconfig.DeleteDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: deploymentStrategy(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDeploymentStrategyClient{
+					MockDeleteDeploymentStrategy: func(input *awsappconfig.DeleteDeploymentStrategyInput) awsappconfig.DeleteDeploymentStrategyRequest {
+						return awsappconfig.DeleteDeploymentStrategyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: deploymentStrategy(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  deploymentStrategy(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,618 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalabletarget
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsapplicationautoscaling "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling"
+	"github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	resourceID  = "table/example"
+	roleARN     = "arn:aws:iam::123456789012:role/aws-service-role/dynamodb.application-autoscaling.amazonaws.com/AWSServiceRoleForApplicationAutoScaling_DynamoDBTable"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsapplicationautoscaling.ErrCodeObjectNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client applicationautoscaling.ScalableTargetClient
+	kube   client.Client
+	cr     *v1alpha1.ScalableTarget
+}
+
+type scalableTargetModifier func(*v1alpha1.ScalableTarget)
+
+func withConditions(c ...runtimev1alpha1.Condition) scalableTargetModifier {
+	return func(r *v1alpha1.ScalableTarget) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRoleARN(s string) scalableTargetModifier {
+	return func(r *v1alpha1.ScalableTarget) { r.Spec.ForProvider.RoleARN = aws.String(s) }
+}
+
+func withMaxCapacity(n int64) scalableTargetModifier {
+	return func(r *v1alpha1.ScalableTarget) { r.Spec.ForProvider.MaxCapacity = n }
+}
+
+func withObservation(o v1alpha1.ScalableTargetObservation) scalableTargetModifier {
+	return func(r *v1alpha1.ScalableTarget) { r.Status.AtProvider = o }
+}
+
+func scalableTarget(m ...scalableTargetModifier) *v1alpha1.ScalableTarget {
+	cr := &v1alpha1.ScalableTarget{
+		Spec: v1alpha1.ScalableTargetSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ScalableTargetParameters{
+				ServiceNamespace:  "dynamodb",
+				ResourceID:        resourceID,
+				ScalableDimension: "dynamodb:table:ReadCapacityUnits",
+				MinCapacity:       5,
+				MaxCapacity:       100,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(t ...awsapplicationautoscaling.ScalableTarget) *awsapplicationautoscaling.DescribeScalableTargetsOutput {
+	return &awsapplicationautoscaling.DescribeScalableTargetsOutput{ScalableTargets: t}
+}
+
+func target() awsapplicationautoscaling.ScalableTarget {
+	return awsapplicationautoscaling.ScalableTarget{
+		ServiceNamespace:  awsapplicationautoscaling.ServiceNamespaceDynamodb,
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: awsapplicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits,
+		MinCapacity:       aws.Int64(5),
+		MaxCapacity:       aws.Int64(100),
+		RoleARN:           aws.String(roleARN),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (applicationautoscaling.ScalableTargetClient, error)
+		cr          *v1alpha1.ScalableTarget
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i applicationautoscaling.ScalableTargetClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: scalableTarget(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i applicationautoscaling.ScalableTargetClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: scalableTarget(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ScalableTarget
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockDescribeScalableTargets: func(input *awsapplicationautoscaling.DescribeScalableTargetsInput) awsapplicationautoscaling.DescribeScalableTargetsRequest {
+						return awsapplicationautoscaling.DescribeScalableTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(target())},
+						}
+					},
+				},
+				cr: scalableTarget(withRoleARN(roleARN)),
+			},
+			want: want{
+				cr: scalableTarget(
+					withRoleARN(roleARN),
+					withObservation(v1alpha1.ScalableTargetObservation{RoleARN: roleARN}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitRoleARN": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockDescribeScalableTargets: func(input *awsapplicationautoscaling.DescribeScalableTargetsInput) awsapplicationautoscaling.DescribeScalableTargetsRequest {
+						return awsapplicationautoscaling.DescribeScalableTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(target())},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				cr: scalableTarget(
+					withRoleARN(roleARN),
+					withObservation(v1alpha1.ScalableTargetObservation{RoleARN: roleARN}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockDescribeScalableTargets: func(input *awsapplicationautoscaling.DescribeScalableTargetsInput) awsapplicationautoscaling.DescribeScalableTargetsRequest {
+						return awsapplicationautoscaling.DescribeScalableTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(target())},
+						}
+					},
+				},
+				cr: scalableTarget(withRoleARN(roleARN), withMaxCapacity(200)),
+			},
+			want: want{
+				cr: scalableTarget(
+					withRoleARN(roleARN),
+					withMaxCapacity(200),
+					withObservation(v1alpha1.ScalableTargetObservation{RoleARN: roleARN}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotRegistered": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockDescribeScalableTargets: func(input *awsapplicationautoscaling.DescribeScalableTargetsInput) awsapplicationautoscaling.DescribeScalableTargetsRequest {
+						return awsapplicationautoscaling.DescribeScalableTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				cr: scalableTarget(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockDescribeScalableTargets: func(input *awsapplicationautoscaling.DescribeScalableTargetsInput) awsapplicationautoscaling.DescribeScalableTargetsRequest {
+						return awsapplicationautoscaling.DescribeScalableTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				cr:  scalableTarget(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ScalableTarget
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockRegisterScalableTarget: func(input *awsapplicationautoscaling.RegisterScalableTargetInput) awsapplicationautoscaling.RegisterScalableTargetRequest {
+						return awsapplicationautoscaling.RegisterScalableTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapplicationautoscaling.RegisterScalableTargetOutput{}},
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				cr: scalableTarget(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockRegisterScalableTarget: func(input *awsapplicationautoscaling.RegisterScalableTargetInput) awsapplicationautoscaling.RegisterScalableTargetRequest {
+						return awsapplicationautoscaling.RegisterScalableTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				cr:  scalableTarget(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errRegister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ScalableTarget
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockRegisterScalableTarget: func(input *awsapplicationautoscaling.RegisterScalableTargetInput) awsapplicationautoscaling.RegisterScalableTargetRequest {
+						if diff := cmp.Diff(int64(200), aws.Int64Value(input.MaxCapacity)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsapplicationautoscaling.RegisterScalableTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapplicationautoscaling.RegisterScalableTargetOutput{}},
+						}
+					},
+				},
+				cr: scalableTarget(withMaxCapacity(200)),
+			},
+			want: want{
+				cr: scalableTarget(withMaxCapacity(200)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockRegisterScalableTarget: func(input *awsapplicationautoscaling.RegisterScalableTargetInput) awsapplicationautoscaling.RegisterScalableTargetRequest {
+						return awsapplicationautoscaling.RegisterScalableTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				cr:  scalableTarget(),
+				err: errors.Wrap(errBoom, errRegister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ScalableTarget
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockDeregisterScalableTarget: func(input *awsapplicationautoscaling.DeregisterScalableTargetInput) awsapplicationautoscaling.DeregisterScalableTargetRequest {
+						return awsapplicationautoscaling.DeregisterScalableTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapplicationautoscaling.DeregisterScalableTargetOutput{}},
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				cr: scalableTarget(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeregistered": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockDeregisterScalableTarget: func(input *awsapplicationautoscaling.DeregisterScalableTargetInput) awsapplicationautoscaling.DeregisterScalableTargetRequest {
+						return awsapplicationautoscaling.DeregisterScalableTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				cr: scalableTarget(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockScalableTargetClient{
+					MockDeregisterScalableTarget: func(input *awsapplicationautoscaling.DeregisterScalableTargetInput) awsapplicationautoscaling.DeregisterScalableTargetRequest {
+						return awsapplicationautoscaling.DeregisterScalableTargetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: scalableTarget(),
+			},
+			want: want{
+				cr:  scalableTarget(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeregister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,649 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalingpolicy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsapplicationautoscaling "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/applicationautoscaling/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling"
+	"github.com/crossplane/provider-aws/pkg/clients/applicationautoscaling/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	policyName  = "example"
+	policyARN   = "arn:aws:autoscaling:us-east-1:123456789012:scalingPolicy:0c3b0d1e-1111-2222-3333-444455556666:resource/dynamodb/table/example:policyName/example"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsapplicationautoscaling.ErrCodeObjectNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client applicationautoscaling.ScalingPolicyClient
+	kube   client.Client
+	cr     *v1alpha1.ScalingPolicy
+}
+
+type scalingPolicyModifier func(*v1alpha1.ScalingPolicy)
+
+func withConditions(c ...runtimev1alpha1.Condition) scalingPolicyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) scalingPolicyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { meta.SetExternalName(r, s) }
+}
+
+func withTargetValue(s string) scalingPolicyModifier {
+	return func(r *v1alpha1.ScalingPolicy) {
+		r.Spec.ForProvider.TargetTrackingScalingPolicyConfiguration.TargetValue = s
+	}
+}
+
+func withDisableScaleIn(b bool) scalingPolicyModifier {
+	return func(r *v1alpha1.ScalingPolicy) {
+		r.Spec.ForProvider.TargetTrackingScalingPolicyConfiguration.DisableScaleIn = aws.Bool(b)
+	}
+}
+
+func withObservation(o v1alpha1.ScalingPolicyObservation) scalingPolicyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { r.Status.AtProvider = o }
+}
+
+func scalingPolicy(m ...scalingPolicyModifier) *v1alpha1.ScalingPolicy {
+	cr := &v1alpha1.ScalingPolicy{
+		Spec: v1alpha1.ScalingPolicySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ScalingPolicyParameters{
+				ServiceNamespace:  "dynamodb",
+				ResourceID:        aws.String("table/example"),
+				ScalableDimension: "dynamodb:table:ReadCapacityUnits",
+				PolicyType:        "TargetTrackingScaling",
+				TargetTrackingScalingPolicyConfiguration: &v1alpha1.TargetTrackingScalingPolicyConfiguration{
+					PredefinedMetricSpecification: &v1alpha1.PredefinedMetricSpecification{
+						PredefinedMetricType: "DynamoDBReadCapacityUtilization",
+					},
+					TargetValue: "70",
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func policy() awsapplicationautoscaling.ScalingPolicy {
+	return awsapplicationautoscaling.ScalingPolicy{
+		PolicyARN:         aws.String(policyARN),
+		PolicyName:        aws.String(policyName),
+		PolicyType:        awsapplicationautoscaling.PolicyTypeTargetTrackingScaling,
+		ServiceNamespace:  awsapplicationautoscaling.ServiceNamespaceDynamodb,
+		ResourceId:        aws.String("table/example"),
+		ScalableDimension: awsapplicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits,
+		TargetTrackingScalingPolicyConfiguration: &awsapplicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			DisableScaleIn: aws.Bool(false),
+			PredefinedMetricSpecification: &awsapplicationautoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: awsapplicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization,
+			},
+			TargetValue: aws.Float64(70),
+		},
+	}
+}
+
+func observed(p ...awsapplicationautoscaling.ScalingPolicy) *awsapplicationautoscaling.DescribeScalingPoliciesOutput {
+	return &awsapplicationautoscaling.DescribeScalingPoliciesOutput{ScalingPolicies: p}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (applicationautoscaling.ScalingPolicyClient, error)
+		cr          *v1alpha1.ScalingPolicy
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i applicationautoscaling.ScalingPolicyClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: scalingPolicy(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i applicationautoscaling.ScalingPolicyClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: scalingPolicy(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: scalingPolicy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: scalingPolicy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: scalingPolicy(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ScalingPolicy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockDescribeScalingPolicies: func(input *awsapplicationautoscaling.DescribeScalingPoliciesInput) awsapplicationautoscaling.DescribeScalingPoliciesRequest {
+						return awsapplicationautoscaling.DescribeScalingPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(policy())},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName), withDisableScaleIn(false)),
+			},
+			want: want{
+				cr: scalingPolicy(withExternalName(policyName),
+					withDisableScaleIn(false),
+					withObservation(v1alpha1.ScalingPolicyObservation{PolicyARN: policyARN}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitDisableScaleIn": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockDescribeScalingPolicies: func(input *awsapplicationautoscaling.DescribeScalingPoliciesInput) awsapplicationautoscaling.DescribeScalingPoliciesRequest {
+						return awsapplicationautoscaling.DescribeScalingPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(policy())},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+			want: want{
+				cr: scalingPolicy(withExternalName(policyName),
+					withDisableScaleIn(false),
+					withObservation(v1alpha1.ScalingPolicyObservation{PolicyARN: policyARN}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockDescribeScalingPolicies: func(input *awsapplicationautoscaling.DescribeScalingPoliciesInput) awsapplicationautoscaling.DescribeScalingPoliciesRequest {
+						return awsapplicationautoscaling.DescribeScalingPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(policy())},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName), withDisableScaleIn(false), withTargetValue("50")),
+			},
+			want: want{
+				cr: scalingPolicy(withExternalName(policyName),
+					withDisableScaleIn(false),
+					withTargetValue("50"),
+					withObservation(v1alpha1.ScalingPolicyObservation{PolicyARN: policyARN}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockDescribeScalingPolicies: func(input *awsapplicationautoscaling.DescribeScalingPoliciesInput) awsapplicationautoscaling.DescribeScalingPoliciesRequest {
+						return awsapplicationautoscaling.DescribeScalingPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+			want: want{
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockDescribeScalingPolicies: func(input *awsapplicationautoscaling.DescribeScalingPoliciesInput) awsapplicationautoscaling.DescribeScalingPoliciesRequest {
+						return awsapplicationautoscaling.DescribeScalingPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+			want: want{
+				cr:  scalingPolicy(withExternalName(policyName)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ScalingPolicy
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockPutScalingPolicy: func(input *awsapplicationautoscaling.PutScalingPolicyInput) awsapplicationautoscaling.PutScalingPolicyRequest {
+						return awsapplicationautoscaling.PutScalingPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapplicationautoscaling.PutScalingPolicyOutput{PolicyARN: aws.String(policyARN)}},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+			want: want{
+				cr: scalingPolicy(withExternalName(policyName), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InvalidTargetValue": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{},
+				cr:     scalingPolicy(withExternalName(policyName), withTargetValue("seventy")),
+			},
+			want: want{
+				cr:  scalingPolicy(withExternalName(policyName), withTargetValue("seventy"), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errors.New("targetValue must be a decimal number"), errCreate),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockPutScalingPolicy: func(input *awsapplicationautoscaling.PutScalingPolicyInput) awsapplicationautoscaling.PutScalingPolicyRequest {
+						return awsapplicationautoscaling.PutScalingPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+			want: want{
+				cr:  scalingPolicy(withExternalName(policyName), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ScalingPolicy
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockPutScalingPolicy: func(input *awsapplicationautoscaling.PutScalingPolicyInput) awsapplicationautoscaling.PutScalingPolicyRequest {
+						if diff := cmp.Diff(50.0, aws.Float64Value(input.TargetTrackingScalingPolicyConfiguration.TargetValue)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsapplicationautoscaling.PutScalingPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapplicationautoscaling.PutScalingPolicyOutput{PolicyARN: aws.String(policyARN)}},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName), withTargetValue("50")),
+			},
+			want: want{
+				cr: scalingPolicy(withExternalName(policyName), withTargetValue("50")),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockPutScalingPolicy: func(input *awsapplicationautoscaling.PutScalingPolicyInput) awsapplicationautoscaling.PutScalingPolicyRequest {
+						return awsapplicationautoscaling.PutScalingPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+			want: want{
+				cr:  scalingPolicy(withExternalName(policyName)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ScalingPolicy
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockDeleteScalingPolicy: func(input *awsapplicationautoscaling.DeleteScalingPolicyInput) awsapplicationautoscaling.DeleteScalingPolicyRequest {
+						return awsapplicationautoscaling.DeleteScalingPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsapplicationautoscaling.DeleteScalingPolicyOutput{}},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+			want: want{
+				cr: scalingPolicy(withExternalName(policyName), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockDeleteScalingPolicy: func(input *awsapplicationautoscaling.DeleteScalingPolicyInput) awsapplicationautoscaling.DeleteScalingPolicyRequest {
+						return awsapplicationautoscaling.DeleteScalingPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+			want: want{
+				cr: scalingPolicy(withExternalName(policyName), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockScalingPolicyClient{
+					MockDeleteScalingPolicy: func(input *awsapplicationautoscaling.DeleteScalingPolicyInput) awsapplicationautoscaling.DeleteScalingPolicyRequest {
+						return awsapplicationautoscaling.DeleteScalingPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: scalingPolicy(withExternalName(policyName)),
+			},
+			want: want{
+				cr:  scalingPolicy(withExternalName(policyName), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2019 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queuepolicy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/applicationintegration/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errNotQueuePolicy           = "managed resource is not a QueuePolicy custom resource"
+	errQueueClient              = "cannot create Queue client"
+	errGetProvider              = "cannot get provider"
+	errGetProviderSecret        = "cannot get provider secret"
+	errCreateFailed             = "cannot create QueuePolicy"
+	errDeleteFailed             = "cannot delete QueuePolicy"
+	errUpdateFailed             = "failed to update the QueuePolicy resource"
+	errGetQueueAttributesFailed = "cannot get Queue attributes"
+	errUpToDateFailed           = "cannot check whether QueuePolicy is up to date"
+)
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (sqs.Client, error)
+}
+
+type external struct {
+	client sqs.Client
+	kube   client.Client
+}
+
+// SetupQueuePolicy adds a controller that reconciles QueuePolicy.
+func SetupQueuePolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.QueuePolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.QueuePolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.QueuePolicyGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return nil, errors.New(errNotQueuePolicy)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		queueClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: queueClient, kube: c.kube}, errors.Wrap(err, errQueueClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	queueClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: queueClient, kube: c.kube}, errors.Wrap(err, errQueueClient)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotQueuePolicy)
+	}
+
+	res, err := e.client.GetQueueAttributesRequest(&awssqs.GetQueueAttributesInput{
+		QueueUrl:       cr.Spec.ForProvider.QueueURL,
+		AttributeNames: []awssqs.QueueAttributeName{awssqs.QueueAttributeNamePolicy},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(sqs.IsNotFound, err), errGetQueueAttributesFailed)
+	}
+
+	if res.Attributes[v1alpha1.AttributePolicy] == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+
+	upToDate, err := sqs.IsQueuePolicyUpToDate(cr.Spec.ForProvider, res.Attributes)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotQueuePolicy)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	return managed.ExternalCreation{}, errors.Wrap(e.setPolicy(ctx, cr, cr.Spec.ForProvider.Policy), errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotQueuePolicy)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.setPolicy(ctx, cr, cr.Spec.ForProvider.Policy), errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return errors.New(errNotQueuePolicy)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	// An empty policy removes the policy from the queue.
+	return errors.Wrap(resource.Ignore(sqs.IsNotFound, e.setPolicy(ctx, cr, "")), errDeleteFailed)
+}
+
+func (e *external) setPolicy(ctx context.Context, cr *v1alpha1.QueuePolicy, policy string) error {
+	_, err := e.client.SetQueueAttributesRequest(&awssqs.SetQueueAttributesInput{
+		QueueUrl:   cr.Spec.ForProvider.QueueURL,
+		Attributes: map[string]string{v1alpha1.AttributePolicy: policy},
+	}).Send(ctx)
+	return err
+}
@@ -36,7 +36,12 @@ import (
 	"github.com/crossplane/provider-aws/apis/applicationintegration/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
 	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 const (
@@ -71,10 +76,12 @@ func SetupQueue(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha1.Queue{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.QueueGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -91,6 +98,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		queueClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
 		return &external{client: queueClient, kube: c.kube}, errors.Wrap(err, errQueueClient)
@@ -0,0 +1,628 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappsync "github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/appsync"
+	"github.com/crossplane/provider-aws/pkg/clients/appsync/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	apiID          = "api-id"
+	dataSourceName = "my_data_source"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	client appsync.DataSourceClient
+	kube   client.Client
+	cr     *v1alpha1.DataSource
+}
+
+type dataSourceModifier func(*v1alpha1.DataSource)
+
+func withConditions(c ...runtimev1alpha1.Condition) dataSourceModifier {
+	return func(r *v1alpha1.DataSource) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.DataSourceParameters) dataSourceModifier {
+	return func(r *v1alpha1.DataSource) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.DataSourceObservation) dataSourceModifier {
+	return func(r *v1alpha1.DataSource) { r.Status.AtProvider = o }
+}
+
+func dataSource(m ...dataSourceModifier) *v1alpha1.DataSource {
+	cr := &v1alpha1.DataSource{
+		Spec: v1alpha1.DataSourceSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DataSourceParameters{
+				APIID:       aws.String(apiID),
+				Type:        "AWS_LAMBDA",
+				Description: aws.String("resolves things"),
+				LambdaConfig: &v1alpha1.LambdaDataSourceConfig{
+					FunctionARN: "arn:aws:lambda:us-east-1:123456789012:function:resolve",
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, dataSourceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(o *awsappsync.GetDataSourceOutput, err error) func(*awsappsync.GetDataSourceInput) awsappsync.GetDataSourceRequest {
+	return func(*awsappsync.GetDataSourceInput) awsappsync.GetDataSourceRequest {
+		return awsappsync.GetDataSourceRequest{Request: request(o, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (appsync.DataSourceClient, error)
+		cr          *v1alpha1.DataSource
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appsync.DataSourceClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: dataSource(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appsync.DataSourceClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: dataSource(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataSource
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awsappsync.DataSource{
+		DataSourceArn: aws.String("arn"),
+		Name:          aws.String(dataSourceName),
+		Type:          awsappsync.DataSourceTypeAwsLambda,
+		Description:   aws.String("resolves things"),
+		LambdaConfig: &awsappsync.LambdaDataSourceConfig{
+			LambdaFunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:resolve"),
+		},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockGetDataSource: get(&awsappsync.GetDataSourceOutput{DataSource: observed}, nil),
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr: dataSource(
+					withStatus(v1alpha1.DataSourceObservation{DataSourceARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockDataSourceClient{
+					MockGetDataSource: get(&awsappsync.GetDataSourceOutput{DataSource: observed}, nil),
+				},
+				cr: dataSource(func(r *v1alpha1.DataSource) { r.Spec.ForProvider.Description = nil }),
+			},
+			want: want{
+				cr: dataSource(
+					withStatus(v1alpha1.DataSourceObservation{DataSourceARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FunctionChanged": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockGetDataSource: get(&awsappsync.GetDataSourceOutput{DataSource: observed}, nil),
+				},
+				cr: dataSource(func(r *v1alpha1.DataSource) { r.Spec.ForProvider.LambdaConfig.FunctionARN = "other" }),
+			},
+			want: want{
+				cr: dataSource(
+					func(r *v1alpha1.DataSource) { r.Spec.ForProvider.LambdaConfig.FunctionARN = "other" },
+					withStatus(v1alpha1.DataSourceObservation{DataSourceARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DynamoDBDefaults": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockGetDataSource: get(&awsappsync.GetDataSourceOutput{DataSource: &awsappsync.DataSource{
+						DataSourceArn: aws.String("arn"),
+						Name:          aws.String(dataSourceName),
+						Type:          awsappsync.DataSourceTypeAmazonDynamodb,
+						DynamodbConfig: &awsappsync.DynamodbDataSourceConfig{
+							TableName:            aws.String("table"),
+							AwsRegion:            aws.String("us-east-1"),
+							UseCallerCredentials: aws.Bool(false),
+							Versioned:            aws.Bool(false),
+						},
+					}}, nil),
+				},
+				cr: dataSource(withSpec(v1alpha1.DataSourceParameters{
+					APIID: aws.String(apiID),
+					Type:  "AMAZON_DYNAMODB",
+					DynamoDBConfig: &v1alpha1.DynamoDBDataSourceConfig{
+						TableName:            aws.String("table"),
+						AWSRegion:            "us-east-1",
+						UseCallerCredentials: aws.Bool(false),
+					},
+				})),
+			},
+			want: want{
+				cr: dataSource(
+					withSpec(v1alpha1.DataSourceParameters{
+						APIID: aws.String(apiID),
+						Type:  "AMAZON_DYNAMODB",
+						DynamoDBConfig: &v1alpha1.DynamoDBDataSourceConfig{
+							TableName:            aws.String("table"),
+							AWSRegion:            "us-east-1",
+							UseCallerCredentials: aws.Bool(false),
+						},
+					}),
+					withStatus(v1alpha1.DataSourceObservation{DataSourceARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockGetDataSource: get(nil, awserr.New(awsappsync.ErrCodeNotFoundException, "", nil)),
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr: dataSource(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockGetDataSource: get(nil, errBoom),
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr:  dataSource(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataSource
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockCreateDataSource: func(in *awsappsync.CreateDataSourceInput) awsappsync.CreateDataSourceRequest {
+						if diff := cmp.Diff(dataSourceName, aws.StringValue(in.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappsync.CreateDataSourceRequest{Request: request(&awsappsync.CreateDataSourceOutput{}, nil)}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr: dataSource(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockCreateDataSource: func(*awsappsync.CreateDataSourceInput) awsappsync.CreateDataSourceRequest {
+						return awsappsync.CreateDataSourceRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr:  dataSource(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataSource
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockUpdateDataSource: func(*awsappsync.UpdateDataSourceInput) awsappsync.UpdateDataSourceRequest {
+						return awsappsync.UpdateDataSourceRequest{Request: request(&awsappsync.UpdateDataSourceOutput{}, nil)}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr: dataSource(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockUpdateDataSource: func(*awsappsync.UpdateDataSourceInput) awsappsync.UpdateDataSourceRequest {
+						return awsappsync.UpdateDataSourceRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr:  dataSource(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DataSource
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockDeleteDataSource: func(*awsappsync.DeleteDataSourceInput) awsappsync.DeleteDataSourceRequest {
+						return awsappsync.DeleteDataSourceRequest{Request: request(&awsappsync.DeleteDataSourceOutput{}, nil)}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr: dataSource(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockDeleteDataSource: func(*awsappsync.DeleteDataSourceInput) awsappsync.DeleteDataSourceRequest {
+						return awsappsync.DeleteDataSourceRequest{Request: request(nil, awserr.New(awsappsync.ErrCodeNotFoundException, "", nil))}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr: dataSource(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockDeleteDataSource: func(*awsappsync.DeleteDataSourceInput) awsappsync.DeleteDataSourceRequest {
+						return awsappsync.DeleteDataSourceRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr:  dataSource(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
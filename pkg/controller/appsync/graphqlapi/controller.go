@@ -0,0 +1,298 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsappsync "github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/appsync"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an AppSync GraphQLAPI resource"
+	errCreateClient      = "cannot create AppSync client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the AppSync GraphQLAPI custom resource"
+
+	errDescribe = "cannot describe AppSync GraphQLAPI"
+	errCreate   = "cannot create AppSync GraphQLAPI"
+	errUpdate   = "cannot update AppSync GraphQLAPI"
+	errDelete   = "cannot delete AppSync GraphQLAPI"
+
+	errGetSchema       = "cannot get schema of AppSync GraphQLAPI from ConfigMap"
+	errStartSchema     = "cannot start schema creation of AppSync GraphQLAPI"
+	errGetSchemaStatus = "cannot get schema creation status of AppSync GraphQLAPI"
+	errListAPIKeys     = "cannot list API keys of AppSync GraphQLAPI"
+	errCreateAPIKey    = "cannot create API key of AppSync GraphQLAPI"
+)
+
+// SetupGraphQLAPI adds a controller that reconciles AppSync GraphQLAPIs.
+func SetupGraphQLAPI(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.GraphQLAPIGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.GraphQLAPI{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.GraphQLAPIGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: appsync.NewGraphQLAPIClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (appsync.GraphQLAPIClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GraphQLAPI)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client appsync.GraphQLAPIClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.GraphQLAPI)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.GetGraphqlApiRequest(&awsappsync.GetGraphqlApiInput{
+		ApiId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(appsync.IsNotFound, err), errDescribe)
+	}
+	observed := *rsp.GraphqlApi
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	appsync.LateInitializeGraphQLAPI(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	obs := appsync.GenerateGraphQLAPIObservation(observed)
+	obs.SchemaChecksum = cr.Status.AtProvider.SchemaChecksum
+	upToDate := appsync.IsGraphQLAPIUpToDate(cr.Spec.ForProvider, observed)
+
+	if cr.Spec.ForProvider.SchemaConfigMapRef != nil {
+		status, err := e.client.GetSchemaCreationStatusRequest(&awsappsync.GetSchemaCreationStatusInput{
+			ApiId: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetSchemaStatus)
+		}
+		obs.SchemaStatus = string(status.Status)
+
+		schema, err := e.getSchema(ctx, cr.Spec.ForProvider.SchemaConfigMapRef)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetSchema)
+		}
+		upToDate = upToDate && appsync.SchemaChecksum(schema) == obs.SchemaChecksum
+	}
+	cr.Status.AtProvider = obs
+
+	conn := appsync.GetGraphQLAPIConnectionDetails(observed)
+	if appsync.UsesAPIKey(cr.Spec.ForProvider) {
+		keys, err := appsync.ListAPIKeys(ctx, e.client, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListAPIKeys)
+		}
+		key := appsync.ValidAPIKey(keys, time.Now())
+		if key != nil {
+			conn[appsync.ConnectionKeyAPIKey] = []byte(aws.StringValue(key.Id))
+		}
+		upToDate = upToDate && key != nil
+	}
+
+	switch awsappsync.SchemaStatus(obs.SchemaStatus) {
+	case awsappsync.SchemaStatusFailed, awsappsync.SchemaStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	default:
+		cr.SetConditions(runtimev1alpha1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.GraphQLAPI)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateGraphqlApiRequest(appsync.GenerateCreateGraphqlAPIInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.GraphqlApi.ApiId))
+	return managed.ExternalCreation{
+		ConnectionDetails: appsync.GetGraphQLAPIConnectionDetails(*rsp.GraphqlApi),
+	}, errors.Wrap(e.kube.Update(ctx, cr), errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.GraphQLAPI)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := meta.GetExternalName(cr)
+	if _, err := e.client.UpdateGraphqlApiRequest(appsync.GenerateUpdateGraphqlAPIInput(id, cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	if ref := cr.Spec.ForProvider.SchemaConfigMapRef; ref != nil {
+		schema, err := e.getSchema(ctx, ref)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGetSchema)
+		}
+		if sum := appsync.SchemaChecksum(schema); sum != cr.Status.AtProvider.SchemaChecksum {
+			if _, err := e.client.StartSchemaCreationRequest(&awsappsync.StartSchemaCreationInput{
+				ApiId:      aws.String(id),
+				Definition: []byte(schema),
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errStartSchema)
+			}
+			cr.Status.AtProvider.SchemaChecksum = sum
+		}
+	}
+
+	if !appsync.UsesAPIKey(cr.Spec.ForProvider) {
+		return managed.ExternalUpdate{}, nil
+	}
+	keys, err := appsync.ListAPIKeys(ctx, e.client, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListAPIKeys)
+	}
+	if appsync.ValidAPIKey(keys, time.Now()) != nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	rsp, err := e.client.CreateApiKeyRequest(&awsappsync.CreateApiKeyInput{
+		ApiId:       aws.String(id),
+		Description: aws.String(fmt.Sprintf("Managed by %s", cr.GetName())),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateAPIKey)
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			appsync.ConnectionKeyAPIKey: []byte(aws.StringValue(rsp.ApiKey.Id)),
+		},
+	}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.GraphQLAPI)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteGraphqlApiRequest(&awsappsync.DeleteGraphqlApiInput{
+		ApiId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(appsync.IsNotFound, err), errDelete)
+}
+
+func (e *external) getSchema(ctx context.Context, ref *v1alpha1.ConfigMapKeySelector) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return "", err
+	}
+	schema, ok := cm.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf("key %s not found in ConfigMap %s/%s", ref.Key, ref.Namespace, ref.Name)
+	}
+	return schema, nil
+}
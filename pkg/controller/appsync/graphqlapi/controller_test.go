@@ -0,0 +1,828 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphqlapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappsync "github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/appsync"
+	"github.com/crossplane/provider-aws/pkg/clients/appsync/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	apiID   = "api-id"
+	errBoom = errors.New("boom")
+
+	schemaRef = &v1alpha1.ConfigMapKeySelector{Namespace: "default", Name: "schema", Key: "schema.graphql"}
+	schema    = "type Query { hello: String }"
+)
+
+type args struct {
+	client appsync.GraphQLAPIClient
+	kube   client.Client
+	cr     *v1alpha1.GraphQLAPI
+}
+
+type apiModifier func(*v1alpha1.GraphQLAPI)
+
+func withConditions(c ...runtimev1alpha1.Condition) apiModifier {
+	return func(r *v1alpha1.GraphQLAPI) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.GraphQLAPIParameters) apiModifier {
+	return func(r *v1alpha1.GraphQLAPI) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.GraphQLAPIObservation) apiModifier {
+	return func(r *v1alpha1.GraphQLAPI) { r.Status.AtProvider = o }
+}
+
+func withExternalName(n string) apiModifier {
+	return func(r *v1alpha1.GraphQLAPI) { meta.SetExternalName(r, n) }
+}
+
+func graphqlAPI(m ...apiModifier) *v1alpha1.GraphQLAPI {
+	cr := &v1alpha1.GraphQLAPI{
+		Spec: v1alpha1.GraphQLAPISpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.GraphQLAPIParameters{
+				Name:               "my-api",
+				AuthenticationType: "AWS_IAM",
+				XRayEnabled:        aws.Bool(false),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(o *awsappsync.GetGraphqlApiOutput, err error) func(*awsappsync.GetGraphqlApiInput) awsappsync.GetGraphqlApiRequest {
+	return func(*awsappsync.GetGraphqlApiInput) awsappsync.GetGraphqlApiRequest {
+		return awsappsync.GetGraphqlApiRequest{Request: request(o, err)}
+	}
+}
+
+func schemaStatus(s awsappsync.SchemaStatus) func(*awsappsync.GetSchemaCreationStatusInput) awsappsync.GetSchemaCreationStatusRequest {
+	return func(*awsappsync.GetSchemaCreationStatusInput) awsappsync.GetSchemaCreationStatusRequest {
+		return awsappsync.GetSchemaCreationStatusRequest{Request: request(&awsappsync.GetSchemaCreationStatusOutput{Status: s}, nil)}
+	}
+}
+
+func listKeys(keys []awsappsync.ApiKey) func(*awsappsync.ListApiKeysInput) awsappsync.ListApiKeysRequest {
+	return func(*awsappsync.ListApiKeysInput) awsappsync.ListApiKeysRequest {
+		return awsappsync.ListApiKeysRequest{Request: request(&awsappsync.ListApiKeysOutput{ApiKeys: keys}, nil)}
+	}
+}
+
+func getConfigMap(data map[string]string) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		if key != (client.ObjectKey{Namespace: schemaRef.Namespace, Name: schemaRef.Name}) {
+			return errBoom
+		}
+		obj.(*corev1.ConfigMap).Data = data
+		return nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (appsync.GraphQLAPIClient, error)
+		cr          *v1alpha1.GraphQLAPI
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appsync.GraphQLAPIClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: graphqlAPI(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appsync.GraphQLAPIClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: graphqlAPI(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: graphqlAPI(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: graphqlAPI(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: graphqlAPI(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GraphQLAPI
+		result managed.ExternalObservation
+		err    error
+	}
+
+	uris := map[string]string{"GRAPHQL": "https://example.com/graphql", "REALTIME": "wss://example.com/graphql"}
+	observed := awsappsync.GraphqlApi{
+		ApiId:              aws.String(apiID),
+		Arn:                aws.String("arn"),
+		Name:               aws.String("my-api"),
+		AuthenticationType: awsappsync.AuthenticationTypeAwsIam,
+		XrayEnabled:        aws.Bool(false),
+		Uris:               uris,
+	}
+	withAPIKey := observed
+	withAPIKey.AuthenticationType = awsappsync.AuthenticationTypeApiKey
+	conn := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(uris["GRAPHQL"]),
+		appsync.ConnectionKeyRealtimeEndpoint:                []byte(uris["REALTIME"]),
+	}
+	connWithKey := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(uris["GRAPHQL"]),
+		appsync.ConnectionKeyRealtimeEndpoint:                []byte(uris["REALTIME"]),
+		appsync.ConnectionKeyAPIKey:                          []byte("da2-key"),
+	}
+	apiKeySpec := func(r *v1alpha1.GraphQLAPI) { r.Spec.ForProvider.AuthenticationType = "API_KEY" }
+	schemaSpec := func(r *v1alpha1.GraphQLAPI) { r.Spec.ForProvider.SchemaConfigMapRef = schemaRef }
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: graphqlAPI(),
+			},
+			want: want{
+				cr: graphqlAPI(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi: get(&awsappsync.GetGraphqlApiOutput{GraphqlApi: &observed}, nil),
+				},
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr: graphqlAPI(
+					withExternalName(apiID),
+					withStatus(v1alpha1.GraphQLAPIObservation{ARN: "arn", URIs: uris}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi: get(&awsappsync.GetGraphqlApiOutput{GraphqlApi: &observed}, nil),
+				},
+				cr: graphqlAPI(withExternalName(apiID), withSpec(v1alpha1.GraphQLAPIParameters{Name: "my-api", AuthenticationType: "AWS_IAM"})),
+			},
+			want: want{
+				cr: graphqlAPI(
+					withExternalName(apiID),
+					withStatus(v1alpha1.GraphQLAPIObservation{ARN: "arn", URIs: uris}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"AuthenticationTypeChanged": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi: get(&awsappsync.GetGraphqlApiOutput{GraphqlApi: &observed}, nil),
+				},
+				cr: graphqlAPI(withExternalName(apiID), func(r *v1alpha1.GraphQLAPI) { r.Spec.ForProvider.AuthenticationType = "OPENID_CONNECT" }),
+			},
+			want: want{
+				cr: graphqlAPI(
+					withExternalName(apiID),
+					func(r *v1alpha1.GraphQLAPI) { r.Spec.ForProvider.AuthenticationType = "OPENID_CONNECT" },
+					withStatus(v1alpha1.GraphQLAPIObservation{ARN: "arn", URIs: uris}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"SchemaChanged": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{schemaRef.Key: schema}),
+				},
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi:           get(&awsappsync.GetGraphqlApiOutput{GraphqlApi: &observed}, nil),
+					MockGetSchemaCreationStatus: schemaStatus(awsappsync.SchemaStatusSuccess),
+				},
+				cr: graphqlAPI(withExternalName(apiID), schemaSpec, withStatus(v1alpha1.GraphQLAPIObservation{SchemaChecksum: "old"})),
+			},
+			want: want{
+				cr: graphqlAPI(
+					withExternalName(apiID),
+					schemaSpec,
+					withStatus(v1alpha1.GraphQLAPIObservation{ARN: "arn", URIs: uris, SchemaStatus: "SUCCESS", SchemaChecksum: "old"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"SchemaFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{schemaRef.Key: schema}),
+				},
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi:           get(&awsappsync.GetGraphqlApiOutput{GraphqlApi: &observed}, nil),
+					MockGetSchemaCreationStatus: schemaStatus(awsappsync.SchemaStatusFailed),
+				},
+				cr: graphqlAPI(withExternalName(apiID), schemaSpec, withStatus(v1alpha1.GraphQLAPIObservation{SchemaChecksum: appsync.SchemaChecksum(schema)})),
+			},
+			want: want{
+				cr: graphqlAPI(
+					withExternalName(apiID),
+					schemaSpec,
+					withStatus(v1alpha1.GraphQLAPIObservation{ARN: "arn", URIs: uris, SchemaStatus: "FAILED", SchemaChecksum: appsync.SchemaChecksum(schema)}),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"SchemaKeyMissing": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{}),
+				},
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi:           get(&awsappsync.GetGraphqlApiOutput{GraphqlApi: &observed}, nil),
+					MockGetSchemaCreationStatus: schemaStatus(awsappsync.SchemaStatusSuccess),
+				},
+				cr: graphqlAPI(withExternalName(apiID), schemaSpec),
+			},
+			want: want{
+				cr:  graphqlAPI(withExternalName(apiID), schemaSpec),
+				err: errors.Wrap(errors.Errorf("key %s not found in ConfigMap %s/%s", schemaRef.Key, schemaRef.Namespace, schemaRef.Name), errGetSchema),
+			},
+		},
+		"ValidAPIKey": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi: get(&awsappsync.GetGraphqlApiOutput{GraphqlApi: &withAPIKey}, nil),
+					MockListApiKeys: listKeys([]awsappsync.ApiKey{
+						{Id: aws.String("da2-expired"), Expires: aws.Int64(time.Now().Add(-time.Hour).Unix())},
+						{Id: aws.String("da2-key"), Expires: aws.Int64(time.Now().Add(time.Hour).Unix())},
+					}),
+				},
+				cr: graphqlAPI(withExternalName(apiID), apiKeySpec),
+			},
+			want: want{
+				cr: graphqlAPI(
+					withExternalName(apiID),
+					apiKeySpec,
+					withStatus(v1alpha1.GraphQLAPIObservation{ARN: "arn", URIs: uris}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connWithKey,
+				},
+			},
+		},
+		"APIKeyExpired": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi: get(&awsappsync.GetGraphqlApiOutput{GraphqlApi: &withAPIKey}, nil),
+					MockListApiKeys: listKeys([]awsappsync.ApiKey{
+						{Id: aws.String("da2-expired"), Expires: aws.Int64(time.Now().Add(-time.Hour).Unix())},
+					}),
+				},
+				cr: graphqlAPI(withExternalName(apiID), apiKeySpec),
+			},
+			want: want{
+				cr: graphqlAPI(
+					withExternalName(apiID),
+					apiKeySpec,
+					withStatus(v1alpha1.GraphQLAPIObservation{ARN: "arn", URIs: uris}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi: get(nil, awserr.New(awsappsync.ErrCodeNotFoundException, "", nil)),
+				},
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockGetGraphqlApi: get(nil, errBoom),
+				},
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr:  graphqlAPI(withExternalName(apiID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GraphQLAPI
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockGraphQLAPIClient{
+					MockCreateGraphqlApi: func(*awsappsync.CreateGraphqlApiInput) awsappsync.CreateGraphqlApiRequest {
+						return awsappsync.CreateGraphqlApiRequest{Request: request(&awsappsync.CreateGraphqlApiOutput{
+							GraphqlApi: &awsappsync.GraphqlApi{ApiId: aws.String(apiID), Uris: map[string]string{"GRAPHQL": "https://example.com/graphql"}},
+						}, nil)}
+					},
+				},
+				cr: graphqlAPI(),
+			},
+			want: want{
+				cr: graphqlAPI(withExternalName(apiID), withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte("https://example.com/graphql"),
+					},
+				},
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockCreateGraphqlApi: func(*awsappsync.CreateGraphqlApiInput) awsappsync.CreateGraphqlApiRequest {
+						return awsappsync.CreateGraphqlApiRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: graphqlAPI(),
+			},
+			want: want{
+				cr:  graphqlAPI(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GraphQLAPI
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	update := func(*awsappsync.UpdateGraphqlApiInput) awsappsync.UpdateGraphqlApiRequest {
+		return awsappsync.UpdateGraphqlApiRequest{Request: request(&awsappsync.UpdateGraphqlApiOutput{}, nil)}
+	}
+	apiKeySpec := func(r *v1alpha1.GraphQLAPI) { r.Spec.ForProvider.AuthenticationType = "API_KEY" }
+	schemaSpec := func(r *v1alpha1.GraphQLAPI) { r.Spec.ForProvider.SchemaConfigMapRef = schemaRef }
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockUpdateGraphqlApi: update,
+				},
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+		},
+		"SchemaApplied": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{schemaRef.Key: schema}),
+				},
+				client: &fake.MockGraphQLAPIClient{
+					MockUpdateGraphqlApi: update,
+					MockStartSchemaCreation: func(in *awsappsync.StartSchemaCreationInput) awsappsync.StartSchemaCreationRequest {
+						if diff := cmp.Diff(schema, string(in.Definition)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsappsync.StartSchemaCreationRequest{Request: request(&awsappsync.StartSchemaCreationOutput{}, nil)}
+					},
+				},
+				cr: graphqlAPI(withExternalName(apiID), schemaSpec),
+			},
+			want: want{
+				cr: graphqlAPI(withExternalName(apiID), schemaSpec, withStatus(v1alpha1.GraphQLAPIObservation{SchemaChecksum: appsync.SchemaChecksum(schema)})),
+			},
+		},
+		"SchemaUnchanged": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{schemaRef.Key: schema}),
+				},
+				client: &fake.MockGraphQLAPIClient{
+					MockUpdateGraphqlApi: update,
+				},
+				cr: graphqlAPI(withExternalName(apiID), schemaSpec, withStatus(v1alpha1.GraphQLAPIObservation{SchemaChecksum: appsync.SchemaChecksum(schema)})),
+			},
+			want: want{
+				cr: graphqlAPI(withExternalName(apiID), schemaSpec, withStatus(v1alpha1.GraphQLAPIObservation{SchemaChecksum: appsync.SchemaChecksum(schema)})),
+			},
+		},
+		"APIKeyCreated": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockUpdateGraphqlApi: update,
+					MockListApiKeys:      listKeys(nil),
+					MockCreateApiKey: func(*awsappsync.CreateApiKeyInput) awsappsync.CreateApiKeyRequest {
+						return awsappsync.CreateApiKeyRequest{Request: request(&awsappsync.CreateApiKeyOutput{ApiKey: &awsappsync.ApiKey{Id: aws.String("da2-key")}}, nil)}
+					},
+				},
+				cr: graphqlAPI(withExternalName(apiID), apiKeySpec),
+			},
+			want: want{
+				cr: graphqlAPI(withExternalName(apiID), apiKeySpec),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{appsync.ConnectionKeyAPIKey: []byte("da2-key")},
+				},
+			},
+		},
+		"FailedStartSchemaCreation": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: getConfigMap(map[string]string{schemaRef.Key: schema}),
+				},
+				client: &fake.MockGraphQLAPIClient{
+					MockUpdateGraphqlApi: update,
+					MockStartSchemaCreation: func(*awsappsync.StartSchemaCreationInput) awsappsync.StartSchemaCreationRequest {
+						return awsappsync.StartSchemaCreationRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: graphqlAPI(withExternalName(apiID), schemaSpec),
+			},
+			want: want{
+				cr:  graphqlAPI(withExternalName(apiID), schemaSpec),
+				err: errors.Wrap(errBoom, errStartSchema),
+			},
+		},
+		"FailedUpdateRequest": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockUpdateGraphqlApi: func(*awsappsync.UpdateGraphqlApiInput) awsappsync.UpdateGraphqlApiRequest {
+						return awsappsync.UpdateGraphqlApiRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr:  graphqlAPI(withExternalName(apiID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.GraphQLAPI
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockDeleteGraphqlApi: func(*awsappsync.DeleteGraphqlApiInput) awsappsync.DeleteGraphqlApiRequest {
+						return awsappsync.DeleteGraphqlApiRequest{Request: request(&awsappsync.DeleteGraphqlApiOutput{}, nil)}
+					},
+				},
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr: graphqlAPI(withExternalName(apiID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockDeleteGraphqlApi: func(*awsappsync.DeleteGraphqlApiInput) awsappsync.DeleteGraphqlApiRequest {
+						return awsappsync.DeleteGraphqlApiRequest{Request: request(nil, awserr.New(awsappsync.ErrCodeNotFoundException, "", nil))}
+					},
+				},
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr: graphqlAPI(withExternalName(apiID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockGraphQLAPIClient{
+					MockDeleteGraphqlApi: func(*awsappsync.DeleteGraphqlApiInput) awsappsync.DeleteGraphqlApiRequest {
+						return awsappsync.DeleteGraphqlApiRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: graphqlAPI(withExternalName(apiID)),
+			},
+			want: want{
+				cr:  graphqlAPI(withExternalName(apiID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,580 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsappsync "github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/appsync"
+	"github.com/crossplane/provider-aws/pkg/clients/appsync/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	apiID   = "api-id"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client appsync.ResolverClient
+	kube   client.Client
+	cr     *v1alpha1.Resolver
+}
+
+type resolverModifier func(*v1alpha1.Resolver)
+
+func withConditions(c ...runtimev1alpha1.Condition) resolverModifier {
+	return func(r *v1alpha1.Resolver) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.ResolverObservation) resolverModifier {
+	return func(r *v1alpha1.Resolver) { r.Status.AtProvider = o }
+}
+
+func resolver(m ...resolverModifier) *v1alpha1.Resolver {
+	cr := &v1alpha1.Resolver{
+		Spec: v1alpha1.ResolverSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ResolverParameters{
+				APIID:                   aws.String(apiID),
+				TypeName:                "Query",
+				FieldName:               "hello",
+				Kind:                    aws.String("UNIT"),
+				DataSourceName:          aws.String("my_data_source"),
+				RequestMappingTemplate:  "{}",
+				ResponseMappingTemplate: aws.String("$util.toJson($ctx.result)"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(o *awsappsync.GetResolverOutput, err error) func(*awsappsync.GetResolverInput) awsappsync.GetResolverRequest {
+	return func(*awsappsync.GetResolverInput) awsappsync.GetResolverRequest {
+		return awsappsync.GetResolverRequest{Request: request(o, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (appsync.ResolverClient, error)
+		cr          *v1alpha1.Resolver
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appsync.ResolverClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: resolver(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i appsync.ResolverClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: resolver(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Resolver
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awsappsync.Resolver{
+		ResolverArn:             aws.String("arn"),
+		TypeName:                aws.String("Query"),
+		FieldName:               aws.String("hello"),
+		Kind:                    awsappsync.ResolverKindUnit,
+		DataSourceName:          aws.String("my_data_source"),
+		RequestMappingTemplate:  aws.String("{}"),
+		ResponseMappingTemplate: aws.String("$util.toJson($ctx.result)"),
+		PipelineConfig:          &awsappsync.PipelineConfig{},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockGetResolver: get(&awsappsync.GetResolverOutput{Resolver: observed}, nil),
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr: resolver(
+					withStatus(v1alpha1.ResolverObservation{ResolverARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockResolverClient{
+					MockGetResolver: get(&awsappsync.GetResolverOutput{Resolver: observed}, nil),
+				},
+				cr: resolver(func(r *v1alpha1.Resolver) { r.Spec.ForProvider.Kind = nil }),
+			},
+			want: want{
+				cr: resolver(
+					withStatus(v1alpha1.ResolverObservation{ResolverARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TemplateChanged": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockGetResolver: get(&awsappsync.GetResolverOutput{Resolver: observed}, nil),
+				},
+				cr: resolver(func(r *v1alpha1.Resolver) {
+					r.Spec.ForProvider.RequestMappingTemplate = "{\"version\": \"2018-05-29\"}"
+				}),
+			},
+			want: want{
+				cr: resolver(
+					func(r *v1alpha1.Resolver) {
+						r.Spec.ForProvider.RequestMappingTemplate = "{\"version\": \"2018-05-29\"}"
+					},
+					withStatus(v1alpha1.ResolverObservation{ResolverARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockGetResolver: get(nil, awserr.New(awsappsync.ErrCodeNotFoundException, "", nil)),
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr: resolver(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockGetResolver: get(nil, errBoom),
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr:  resolver(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Resolver
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockCreateResolver: func(*awsappsync.CreateResolverInput) awsappsync.CreateResolverRequest {
+						return awsappsync.CreateResolverRequest{Request: request(&awsappsync.CreateResolverOutput{}, nil)}
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr: resolver(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockCreateResolver: func(*awsappsync.CreateResolverInput) awsappsync.CreateResolverRequest {
+						return awsappsync.CreateResolverRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr:  resolver(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Resolver
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockUpdateResolver: func(*awsappsync.UpdateResolverInput) awsappsync.UpdateResolverRequest {
+						return awsappsync.UpdateResolverRequest{Request: request(&awsappsync.UpdateResolverOutput{}, nil)}
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr: resolver(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockUpdateResolver: func(*awsappsync.UpdateResolverInput) awsappsync.UpdateResolverRequest {
+						return awsappsync.UpdateResolverRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr:  resolver(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Resolver
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockDeleteResolver: func(*awsappsync.DeleteResolverInput) awsappsync.DeleteResolverRequest {
+						return awsappsync.DeleteResolverRequest{Request: request(&awsappsync.DeleteResolverOutput{}, nil)}
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr: resolver(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockDeleteResolver: func(*awsappsync.DeleteResolverInput) awsappsync.DeleteResolverRequest {
+						return awsappsync.DeleteResolverRequest{Request: request(nil, awserr.New(awsappsync.ErrCodeNotFoundException, "", nil))}
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr: resolver(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverClient{
+					MockDeleteResolver: func(*awsappsync.DeleteResolverInput) awsappsync.DeleteResolverRequest {
+						return awsappsync.DeleteResolverRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: resolver(),
+			},
+			want: want{
+				cr:  resolver(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
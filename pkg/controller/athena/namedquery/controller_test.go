@@ -0,0 +1,571 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namedquery
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsathena "github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/athena"
+	"github.com/crossplane/provider-aws/pkg/clients/athena/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	resourceID  = "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+	query       = "SELECT count(DISTINCT user_id) FROM events"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsathena.ErrCodeInvalidRequestException, "not found", nil)
+)
+
+type args struct {
+	client athena.NamedQueryClient
+	kube   client.Client
+	cr     *v1alpha1.NamedQuery
+}
+
+type namedQueryModifier func(*v1alpha1.NamedQuery)
+
+func withConditions(c ...runtimev1alpha1.Condition) namedQueryModifier {
+	return func(r *v1alpha1.NamedQuery) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) namedQueryModifier {
+	return func(r *v1alpha1.NamedQuery) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha1.NamedQueryObservation) namedQueryModifier {
+	return func(r *v1alpha1.NamedQuery) { r.Status.AtProvider = o }
+}
+
+func withDescription(s string) namedQueryModifier {
+	return func(r *v1alpha1.NamedQuery) { r.Spec.ForProvider.Description = aws.String(s) }
+}
+
+func withWorkGroup(s string) namedQueryModifier {
+	return func(r *v1alpha1.NamedQuery) { r.Spec.ForProvider.WorkGroup = aws.String(s) }
+}
+
+func namedQuery(m ...namedQueryModifier) *v1alpha1.NamedQuery {
+	cr := &v1alpha1.NamedQuery{
+		Spec: v1alpha1.NamedQuerySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.NamedQueryParameters{
+				Name:        "daily-active-users",
+				Database:    "analytics",
+				QueryString: query,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *awsathena.GetNamedQueryOutput {
+	return &awsathena.GetNamedQueryOutput{NamedQuery: &awsathena.NamedQuery{
+		Database:     aws.String("analytics"),
+		Description:  aws.String("desc"),
+		Name:         aws.String("daily-active-users"),
+		NamedQueryId: aws.String(resourceID),
+		QueryString:  aws.String(query),
+		WorkGroup:    aws.String("primary"),
+	}}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (athena.NamedQueryClient, error)
+		cr          *v1alpha1.NamedQuery
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i athena.NamedQueryClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: namedQuery(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i athena.NamedQueryClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: namedQuery(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: namedQuery(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: namedQuery(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: namedQuery(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.NamedQuery
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: namedQuery(),
+			},
+			want: want{
+				cr: namedQuery(),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockNamedQueryClient{
+					MockGetNamedQuery: func(input *awsathena.GetNamedQueryInput) awsathena.GetNamedQueryRequest {
+						return awsathena.GetNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(resourceID), withDescription("desc"), withWorkGroup("primary")),
+			},
+			want: want{
+				cr: namedQuery(
+					withExternalName(resourceID),
+					withDescription("desc"),
+					withWorkGroup("primary"),
+					withObservation(v1alpha1.NamedQueryObservation{NamedQueryID: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInit": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockNamedQueryClient{
+					MockGetNamedQuery: func(input *awsathena.GetNamedQueryInput) awsathena.GetNamedQueryRequest {
+						return awsathena.GetNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: namedQuery(
+					withExternalName(resourceID),
+					withDescription("desc"),
+					withWorkGroup("primary"),
+					withObservation(v1alpha1.NamedQueryObservation{NamedQueryID: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockNamedQueryClient{
+					MockGetNamedQuery: func(input *awsathena.GetNamedQueryInput) awsathena.GetNamedQueryRequest {
+						return awsathena.GetNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: namedQuery(withExternalName(resourceID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockNamedQueryClient{
+					MockGetNamedQuery: func(input *awsathena.GetNamedQueryInput) awsathena.GetNamedQueryRequest {
+						return awsathena.GetNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  namedQuery(withExternalName(resourceID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.NamedQuery
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockNamedQueryClient{
+					MockCreateNamedQuery: func(input *awsathena.CreateNamedQueryInput) awsathena.CreateNamedQueryRequest {
+						return awsathena.CreateNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.CreateNamedQueryOutput{
+								NamedQueryId: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: namedQuery(),
+			},
+			want: want{
+				cr: namedQuery(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockNamedQueryClient{
+					MockCreateNamedQuery: func(input *awsathena.CreateNamedQueryInput) awsathena.CreateNamedQueryRequest {
+						return awsathena.CreateNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: namedQuery(),
+			},
+			want: want{
+				cr:  namedQuery(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"FailedSpecUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &fake.MockNamedQueryClient{
+					MockCreateNamedQuery: func(input *awsathena.CreateNamedQueryInput) awsathena.CreateNamedQueryRequest {
+						return awsathena.CreateNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.CreateNamedQueryOutput{
+								NamedQueryId: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: namedQuery(),
+			},
+			want: want{
+				cr: namedQuery(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.NamedQuery
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNamedQueryClient{
+					MockDeleteNamedQuery: func(input *awsathena.DeleteNamedQueryInput) awsathena.DeleteNamedQueryRequest {
+						return awsathena.DeleteNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsathena.DeleteNamedQueryOutput{}},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: namedQuery(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockNamedQueryClient{
+					MockDeleteNamedQuery: func(input *awsathena.DeleteNamedQueryInput) awsathena.DeleteNamedQueryRequest {
+						return awsathena.DeleteNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: namedQuery(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockNamedQueryClient{
+					MockDeleteNamedQuery: func(input *awsathena.DeleteNamedQueryInput) awsathena.DeleteNamedQueryRequest {
+						return awsathena.DeleteNamedQueryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: namedQuery(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  namedQuery(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,10 @@ limitations under the License.
 package controller
 
 import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
@@ -24,22 +28,66 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
+	"github.com/crossplane/provider-aws/pkg/controller/amplify/app"
+	"github.com/crossplane/provider-aws/pkg/controller/amplify/branch"
+	"github.com/crossplane/provider-aws/pkg/controller/amplify/domain"
+	"github.com/crossplane/provider-aws/pkg/controller/appconfig/application"
+	"github.com/crossplane/provider-aws/pkg/controller/appconfig/configurationprofile"
+	"github.com/crossplane/provider-aws/pkg/controller/appconfig/deploymentstrategy"
+	"github.com/crossplane/provider-aws/pkg/controller/appconfig/environment"
+	"github.com/crossplane/provider-aws/pkg/controller/applicationautoscaling/scalabletarget"
+	"github.com/crossplane/provider-aws/pkg/controller/applicationautoscaling/scalingpolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/applicationintegration/queuepolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/applicationintegration/sqs"
+	"github.com/crossplane/provider-aws/pkg/controller/appsync/datasource"
+	"github.com/crossplane/provider-aws/pkg/controller/appsync/graphqlapi"
+	"github.com/crossplane/provider-aws/pkg/controller/appsync/resolver"
+	"github.com/crossplane/provider-aws/pkg/controller/athena/namedquery"
 	"github.com/crossplane/provider-aws/pkg/controller/cache"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesubnetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/globalreplicationgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudhsmv2/cluster"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudhsmv2/hsm"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudwatch/compositealarm"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudwatch/metricalarm"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudwatchlogs/resourcepolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/compute"
 	"github.com/crossplane/provider-aws/pkg/controller/database"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dynamodb"
+	"github.com/crossplane/provider-aws/pkg/controller/database/globalcluster"
+	"github.com/crossplane/provider-aws/pkg/controller/datasync/locationefs"
+	"github.com/crossplane/provider-aws/pkg/controller/datasync/locationnfs"
+	"github.com/crossplane/provider-aws/pkg/controller/datasync/locations3"
+	datasynctask "github.com/crossplane/provider-aws/pkg/controller/datasync/task"
+	daxcluster "github.com/crossplane/provider-aws/pkg/controller/dax/cluster"
+	daxsubnetgroup "github.com/crossplane/provider-aws/pkg/controller/dax/subnetgroup"
+	detectivegraph "github.com/crossplane/provider-aws/pkg/controller/detective/graph"
+	detectivemember "github.com/crossplane/provider-aws/pkg/controller/detective/member"
+	"github.com/crossplane/provider-aws/pkg/controller/directoryservice/directory"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/ebsencryptionbydefault"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/ec2fleet"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/image"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/internetgateway"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpc"
+	"github.com/crossplane/provider-aws/pkg/controller/ecs/capacityprovider"
+	"github.com/crossplane/provider-aws/pkg/controller/ecs/clustercapacityproviders"
 	"github.com/crossplane/provider-aws/pkg/controller/eks"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/clusterauth"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/nodegroup"
+	ebapplication "github.com/crossplane/provider-aws/pkg/controller/elasticbeanstalk/application"
+	"github.com/crossplane/provider-aws/pkg/controller/elasticbeanstalk/applicationversion"
+	ebenvironment "github.com/crossplane/provider-aws/pkg/controller/elasticbeanstalk/environment"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elb"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elbattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/loadbalancer"
+	"github.com/crossplane/provider-aws/pkg/controller/firehose/deliverystream"
+	fmspolicy "github.com/crossplane/provider-aws/pkg/controller/fms/policy"
+	"github.com/crossplane/provider-aws/pkg/controller/glue/job"
+	"github.com/crossplane/provider-aws/pkg/controller/glue/trigger"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgrouppolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroupusermembership"
@@ -48,75 +96,425 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrolepolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuser"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuserpolicyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/imagebuilder/component"
+	"github.com/crossplane/provider-aws/pkg/controller/imagebuilder/distributionconfiguration"
+	"github.com/crossplane/provider-aws/pkg/controller/imagebuilder/imagepipeline"
+	"github.com/crossplane/provider-aws/pkg/controller/imagebuilder/imagerecipe"
+	"github.com/crossplane/provider-aws/pkg/controller/imagebuilder/infrastructureconfiguration"
+	"github.com/crossplane/provider-aws/pkg/controller/iot/certificate"
+	"github.com/crossplane/provider-aws/pkg/controller/iot/policy"
+	"github.com/crossplane/provider-aws/pkg/controller/iot/thing"
+	"github.com/crossplane/provider-aws/pkg/controller/iot/thingtype"
+	"github.com/crossplane/provider-aws/pkg/controller/iot/topicrule"
+	"github.com/crossplane/provider-aws/pkg/controller/kinesisvideo/signalingchannel"
+	"github.com/crossplane/provider-aws/pkg/controller/kinesisvideo/stream"
+	"github.com/crossplane/provider-aws/pkg/controller/kms/grant"
+	"github.com/crossplane/provider-aws/pkg/controller/lakeformation/datalakesettings"
+	"github.com/crossplane/provider-aws/pkg/controller/lakeformation/permissions"
+	licensemanagerassociation "github.com/crossplane/provider-aws/pkg/controller/licensemanager/association"
+	"github.com/crossplane/provider-aws/pkg/controller/licensemanager/licenseconfiguration"
+	"github.com/crossplane/provider-aws/pkg/controller/macie2/account"
+	"github.com/crossplane/provider-aws/pkg/controller/macie2/classificationjob"
+	"github.com/crossplane/provider-aws/pkg/controller/macie2/customdataidentifier"
+	"github.com/crossplane/provider-aws/pkg/controller/notification/snsplatformapplication"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snssubscription"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snstopic"
+	"github.com/crossplane/provider-aws/pkg/controller/notification/snstopicpolicy"
+	orgawsserviceaccess "github.com/crossplane/provider-aws/pkg/controller/organizations/awsserviceaccess"
+	orgdelegatedadministrator "github.com/crossplane/provider-aws/pkg/controller/organizations/delegatedadministrator"
+	orgpolicy "github.com/crossplane/provider-aws/pkg/controller/organizations/policy"
+	orgpolicyattachment "github.com/crossplane/provider-aws/pkg/controller/organizations/policyattachment"
+	pinpointapp "github.com/crossplane/provider-aws/pkg/controller/pinpoint/app"
+	awsprovider "github.com/crossplane/provider-aws/pkg/controller/provider"
+	"github.com/crossplane/provider-aws/pkg/controller/qldb/journalkinesisstream"
+	"github.com/crossplane/provider-aws/pkg/controller/qldb/ledger"
+	"github.com/crossplane/provider-aws/pkg/controller/quicksight/dataset"
+	qsdatasource "github.com/crossplane/provider-aws/pkg/controller/quicksight/datasource"
 	"github.com/crossplane/provider-aws/pkg/controller/redshift"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/hostedzone"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/resourcerecordset"
+	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverrule"
+	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverruleassociation"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketobject"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/inventoryconfiguration"
+	"github.com/crossplane/provider-aws/pkg/controller/s3control/accesspoint"
+	"github.com/crossplane/provider-aws/pkg/controller/s3control/accountpublicaccessblock"
+	"github.com/crossplane/provider-aws/pkg/controller/servicequotas/servicequota"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
+	"github.com/crossplane/provider-aws/pkg/controller/snowball/snowballjob"
+	"github.com/crossplane/provider-aws/pkg/controller/ssm/association"
+	"github.com/crossplane/provider-aws/pkg/controller/ssm/document"
+	"github.com/crossplane/provider-aws/pkg/controller/ssm/maintenancewindow"
+	"github.com/crossplane/provider-aws/pkg/controller/ssm/maintenancewindowtarget"
+	"github.com/crossplane/provider-aws/pkg/controller/ssm/maintenancewindowtask"
+	"github.com/crossplane/provider-aws/pkg/controller/sts/sessioncredentials"
+	"github.com/crossplane/provider-aws/pkg/controller/synthetics/canary"
+	xraygroup "github.com/crossplane/provider-aws/pkg/controller/xray/group"
+	"github.com/crossplane/provider-aws/pkg/controller/xray/samplingrule"
 )
 
-// Setup creates all AWS controllers with the supplied logger and adds them to
-// the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger) error{
+const errUnknownGroup = "unknown API group %q, must be one of %s"
+
+// setups maps each API group, identified by the name of the package that
+// contains its controllers, to the functions that set up those controllers.
+var setups = map[string][]func(ctrl.Manager, logging.Logger) error{
+	"acm": {
+		acm.SetupCertificate,
+	},
+	"acmpca": {
+		certificateauthority.SetupCertificateAuthority,
+		certificateauthoritypermission.SetupCertificateAuthorityPermission,
+	},
+	"amplify": {
+		app.SetupApp,
+		branch.SetupBranch,
+		domain.SetupDomain,
+	},
+	"appconfig": {
+		application.SetupApplication,
+		environment.SetupEnvironment,
+		configurationprofile.SetupConfigurationProfile,
+		deploymentstrategy.SetupDeploymentStrategy,
+	},
+	"applicationautoscaling": {
+		scalabletarget.SetupScalableTarget,
+		scalingpolicy.SetupScalingPolicy,
+	},
+	"applicationintegration": {
+		sqs.SetupQueue,
+		queuepolicy.SetupQueuePolicy,
+	},
+	"appsync": {
+		graphqlapi.SetupGraphQLAPI,
+		datasource.SetupDataSource,
+		resolver.SetupResolver,
+	},
+	"athena": {
+		namedquery.SetupNamedQuery,
+	},
+	"cache": {
+		cache.SetupReplicationGroup,
+		cachesubnetgroup.SetupCacheSubnetGroup,
+		globalreplicationgroup.SetupGlobalReplicationGroup,
+	},
+	"cloudhsmv2": {
+		cluster.SetupCluster,
+		hsm.SetupHsm,
+	},
+	"cloudwatch": {
+		compositealarm.SetupCompositeAlarm,
+		metricalarm.SetupMetricAlarm,
+	},
+	"cloudwatchlogs": {
+		resourcepolicy.SetupResourcePolicy,
+	},
+	"compute": {
+		compute.SetupEKSCluster,
+	},
+	"database": {
+		database.SetupRDSInstance,
+		dbsubnetgroup.SetupDBSubnetGroup,
+		dynamodb.SetupDynamoTable,
+		globalcluster.SetupGlobalCluster,
+	},
+	"datasync": {
+		locations3.SetupLocationS3,
+		locationefs.SetupLocationEFS,
+		locationnfs.SetupLocationNFS,
+		datasynctask.SetupTask,
+	},
+	"dax": {
+		daxcluster.SetupCluster,
+		daxsubnetgroup.SetupSubnetGroup,
+	},
+	"detective": {
+		detectivegraph.SetupGraph,
+		detectivemember.SetupMember,
+	},
+	"directoryservice": {
+		directory.SetupDirectory,
+	},
+	"ec2": {
+		vpc.SetupVPC,
+		subnet.SetupSubnet,
+		securitygroup.SetupSecurityGroup,
+		internetgateway.SetupInternetGateway,
+		routetable.SetupRouteTable,
+		image.SetupImage,
+		ec2fleet.SetupEC2Fleet,
+		ebsencryptionbydefault.SetupEBSEncryptionByDefault,
+	},
+	"ecs": {
+		capacityprovider.SetupCapacityProvider,
+		clustercapacityproviders.SetupClusterCapacityProviders,
+	},
+	"eks": {
+		eks.SetupCluster,
+		nodegroup.SetupNodeGroup,
+		clusterauth.SetupClusterAuth,
+	},
+	"elasticbeanstalk": {
+		ebapplication.SetupApplication,
+		applicationversion.SetupApplicationVersion,
+		ebenvironment.SetupEnvironment,
+	},
+	"elasticloadbalancing": {
+		elb.SetupELB,
+		elbattachment.SetupELBAttachment,
+	},
+	"elbv2": {
+		loadbalancer.SetupLoadBalancer,
+	},
+	"firehose": {
+		deliverystream.SetupDeliveryStream,
+	},
+	"fms": {
+		fmspolicy.SetupPolicy,
+	},
+	"glue": {
+		job.SetupJob,
+		trigger.SetupTrigger,
+	},
+	"identity": {
+		iamuser.SetupIAMUser,
+		iamgroup.SetupIAMGroup,
+		iampolicy.SetupIAMPolicy,
+		iamrole.SetupIAMRole,
+		iamgroupusermembership.SetupIAMGroupUserMembership,
+		iamuserpolicyattachment.SetupIAMUserPolicyAttachment,
+		iamgrouppolicyattachment.SetupIAMGroupPolicyAttachment,
+		iamrolepolicyattachment.SetupIAMRolePolicyAttachment,
+	},
+	"imagebuilder": {
+		component.SetupComponent,
+		imagerecipe.SetupImageRecipe,
+		infrastructureconfiguration.SetupInfrastructureConfiguration,
+		distributionconfiguration.SetupDistributionConfiguration,
+		imagepipeline.SetupImagePipeline,
+	},
+	"iot": {
+		thing.SetupThing,
+		thingtype.SetupThingType,
+		policy.SetupPolicy,
+		certificate.SetupCertificate,
+		topicrule.SetupTopicRule,
+	},
+	"kinesisvideo": {
+		stream.SetupStream,
+		signalingchannel.SetupSignalingChannel,
+	},
+	"kms": {
+		grant.SetupGrant,
+	},
+	"lakeformation": {
+		datalakesettings.SetupDataLakeSettings,
+		permissions.SetupPermissions,
+	},
+	"licensemanager": {
+		licenseconfiguration.SetupLicenseConfiguration,
+		licensemanagerassociation.SetupAssociation,
+	},
+	"macie2": {
+		account.SetupAccount,
+		classificationjob.SetupClassificationJob,
+		customdataidentifier.SetupCustomDataIdentifier,
+	},
+	"notification": {
+		snstopic.SetupSNSTopic,
+		snssubscription.SetupSubscription,
+		snsplatformapplication.SetupSNSPlatformApplication,
+		snstopicpolicy.SetupSNSTopicPolicy,
+	},
+	"organizations": {
+		orgpolicy.SetupPolicy,
+		orgpolicyattachment.SetupPolicyAttachment,
+		orgdelegatedadministrator.SetupDelegatedAdministrator,
+		orgawsserviceaccess.SetupAWSServiceAccess,
+	},
+	"pinpoint": {
+		pinpointapp.SetupApp,
+	},
+	"qldb": {
+		ledger.SetupLedger,
+		journalkinesisstream.SetupJournalKinesisStream,
+	},
+	"quicksight": {
+		qsdatasource.SetupDataSource,
+		dataset.SetupDataSet,
+	},
+	"redshift": {
+		redshift.SetupCluster,
+	},
+	"route53": {
+		resourcerecordset.SetupResourceRecordSet,
+		hostedzone.SetupHostedZone,
+	},
+	"route53resolver": {
+		resolverendpoint.SetupResolverEndpoint,
+		resolverrule.SetupResolverRule,
+		resolverruleassociation.SetupResolverRuleAssociation,
+	},
+	"s3": {
+		s3.SetupS3Bucket,
+		bucketobject.SetupBucketObject,
+		inventoryconfiguration.SetupInventoryConfiguration,
+	},
+	"s3control": {
+		accesspoint.SetupAccessPoint,
+		accountpublicaccessblock.SetupAccountPublicAccessBlock,
+	},
+	"servicequotas": {
+		servicequota.SetupServiceQuota,
+	},
+	"snowball": {
+		snowballjob.SetupSnowballJob,
+	},
+	"ssm": {
+		maintenancewindow.SetupMaintenanceWindow,
+		maintenancewindowtarget.SetupMaintenanceWindowTarget,
+		maintenancewindowtask.SetupMaintenanceWindowTask,
+		association.SetupAssociation,
+		document.SetupDocument,
+	},
+	"sts": {
+		sessioncredentials.SetupSessionCredentials,
+	},
+	"synthetics": {
+		canary.SetupCanary,
+	},
+	"xray": {
+		samplingrule.SetupSamplingRule,
+		xraygroup.SetupGroup,
+	},
+}
+
+// leaderSetups maps API groups, like setups, to the functions that set up
+// their controllers that do not reconcile managed resources. These act on
+// objects that belong to every shard, such as resource claims and connection
+// secrets, so they are set up only in the leading shard to avoid binding a
+// claim to, or provisioning, more than one managed resource.
+var leaderSetups = map[string][]func(ctrl.Manager, logging.Logger) error{
+	"cache": {
 		cache.SetupReplicationGroupClaimScheduling,
 		cache.SetupReplicationGroupClaimDefaulting,
 		cache.SetupReplicationGroupClaimBinding,
-		cache.SetupReplicationGroup,
-		cachesubnetgroup.SetupCacheSubnetGroup,
+	},
+	"compute": {
 		compute.SetupEKSClusterClaimScheduling,
 		compute.SetupEKSClusterClaimDefaulting,
 		compute.SetupEKSClusterClaimBinding,
 		compute.SetupEKSClusterSecret,
 		compute.SetupEKSClusterTarget,
-		compute.SetupEKSCluster,
+	},
+	"database": {
 		database.SetupPostgreSQLInstanceClaimScheduling,
 		database.SetupPostgreSQLInstanceClaimDefaulting,
 		database.SetupPostgreSQLInstanceClaimBinding,
 		database.SetupMySQLInstanceClaimScheduling,
 		database.SetupMySQLInstanceClaimDefaulting,
 		database.SetupMySQLInstanceClaimBinding,
-		database.SetupRDSInstance,
-		eks.SetupCluster,
+	},
+	"eks": {
 		eks.SetupClusterSecret,
 		eks.SetupClusterTarget,
-		elb.SetupELB,
-		elbattachment.SetupELBAttachment,
-		nodegroup.SetupNodeGroup,
+	},
+	"s3": {
 		s3.SetupBucketClaimScheduling,
 		s3.SetupBucketClaimDefaulting,
 		s3.SetupBucketClaimBinding,
-		s3.SetupS3Bucket,
-		iamuser.SetupIAMUser,
-		iamgroup.SetupIAMGroup,
-		iampolicy.SetupIAMPolicy,
-		iamrole.SetupIAMRole,
-		iamgroupusermembership.SetupIAMGroupUserMembership,
-		iamuserpolicyattachment.SetupIAMUserPolicyAttachment,
-		iamgrouppolicyattachment.SetupIAMGroupPolicyAttachment,
-		iamrolepolicyattachment.SetupIAMRolePolicyAttachment,
-		vpc.SetupVPC,
-		subnet.SetupSubnet,
-		securitygroup.SetupSecurityGroup,
-		internetgateway.SetupInternetGateway,
-		routetable.SetupRouteTable,
-		dbsubnetgroup.SetupDBSubnetGroup,
-		certificateauthority.SetupCertificateAuthority,
-		certificateauthoritypermission.SetupCertificateAuthorityPermission,
-		acm.SetupCertificate,
-		dynamodb.SetupDynamoTable,
-		resourcerecordset.SetupResourceRecordSet,
-		hostedzone.SetupHostedZone,
-		snstopic.SetupSNSTopic,
-		snssubscription.SetupSubscription,
-		sqs.SetupQueue,
-		redshift.SetupCluster,
-	} {
-		if err := setup(mgr, l); err != nil {
+	},
+}
+
+// apiGroups maps API groups, identified by their first label, to the group of
+// setups whose controllers reconcile their managed resources, where the two
+// differ.
+var apiGroups = map[string]string{
+	"storage": "s3",
+}
+
+// GroupOf returns the group of controllers, as accepted by a GroupFilter, that
+// reconcile the managed resources of the supplied API group, such as s3 for
+// storage.aws.crossplane.io.
+func GroupOf(apiGroup string) string {
+	g := strings.SplitN(apiGroup, ".", 2)[0]
+	if s, ok := apiGroups[g]; ok {
+		return s
+	}
+	return g
+}
+
+// Groups returns the API groups for which controllers may be set up.
+func Groups() []string {
+	groups := make([]string, 0, len(setups))
+	for g := range setups {
+		groups = append(groups, g)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// A GroupFilter determines whether the controllers of an API group should be
+// set up.
+type GroupFilter func(group string) bool
+
+// AllGroups is a GroupFilter that enables every API group.
+func AllGroups(_ string) bool { return true }
+
+// NewGroupFilter returns a GroupFilter that enables the supplied API groups,
+// or every API group if none are supplied, except for those that are
+// disabled. It returns an error if an unknown API group is supplied.
+func NewGroupFilter(enabled, disabled []string) (GroupFilter, error) {
+	for _, g := range append(append([]string{}, enabled...), disabled...) {
+		if _, ok := setups[g]; !ok {
+			return nil, errors.Errorf(errUnknownGroup, g, strings.Join(Groups(), ", "))
+		}
+	}
+	en := map[string]bool{}
+	for _, g := range enabled {
+		en[g] = true
+	}
+	dis := map[string]bool{}
+	for _, g := range disabled {
+		dis[g] = true
+	}
+	return func(group string) bool {
+		return (len(en) == 0 || en[group]) && !dis[group]
+	}, nil
+}
+
+// Setup creates the controller of AWS Providers and the AWS controllers of
+// every API group accepted by the supplied filter with the supplied logger
+// and adds them to the supplied manager. Only the leading shard creates the
+// controllers that do not reconcile managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, include GroupFilter, s shard.Shard) error {
+	if s.Leads() {
+		if err := awsprovider.SetupProvider(mgr, l); err != nil {
 			return err
 		}
 	}
 
+	for _, g := range Groups() {
+		if !include(g) {
+			l.Debug("Skipping disabled API group", "group", g)
+			continue
+		}
+		for _, setup := range setupsOf(g, s) {
+			if err := setup(mgr, l); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
+
+// setupsOf returns the functions that set up the controllers of the supplied
+// API group in the supplied shard.
+func setupsOf(group string, s shard.Shard) []func(ctrl.Manager, logging.Logger) error {
+	if !s.Leads() {
+		return setups[group]
+	}
+	return append(append([]func(ctrl.Manager, logging.Logger) error{}, leaderSetups[group]...), setups[group]...)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis"
+	"github.com/crossplane/provider-aws/pkg/controller/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
+)
+
+func TestNewGroupFilter(t *testing.T) {
+	type want struct {
+		included []string
+		excluded []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		enabled  []string
+		disabled []string
+		want     want
+	}{
+		"AllGroups": {
+			want: want{included: Groups()},
+		},
+		"OnlyEnabled": {
+			enabled: []string{"ec2", "identity"},
+			want: want{
+				included: []string{"ec2", "identity"},
+				excluded: []string{"s3", "eks"},
+			},
+		},
+		"AllButDisabled": {
+			disabled: []string{"s3"},
+			want: want{
+				included: []string{"ec2", "identity"},
+				excluded: []string{"s3"},
+			},
+		},
+		"DisabledWinsOverEnabled": {
+			enabled:  []string{"ec2", "s3"},
+			disabled: []string{"s3"},
+			want: want{
+				included: []string{"ec2"},
+				excluded: []string{"s3", "identity"},
+			},
+		},
+		"UnknownGroup": {
+			enabled: []string{"ec3"},
+			want: want{
+				err: errors.Errorf(errUnknownGroup, "ec3", strings.Join(Groups(), ", ")),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			include, err := NewGroupFilter(tc.enabled, tc.disabled)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			for _, g := range tc.want.included {
+				if !include(g) {
+					t.Errorf("%s: want included, got excluded", g)
+				}
+			}
+			for _, g := range tc.want.excluded {
+				if include(g) {
+					t.Errorf("%s: want excluded, got included", g)
+				}
+			}
+		})
+	}
+}
+
+func TestSetupsOf(t *testing.T) {
+	total := uint32(3)
+	count := func(fn interface{}) int {
+		n := 0
+		for i := uint32(0); i < total; i++ {
+			for _, setup := range setupsOf("s3", shard.Shard{Index: i, Total: total}) {
+				if reflect.ValueOf(setup).Pointer() == reflect.ValueOf(fn).Pointer() {
+					n++
+				}
+			}
+		}
+		return n
+	}
+
+	cases := map[string]struct {
+		setup interface{}
+		want  int
+	}{
+		"ClaimSchedulingInOneShard": {setup: s3.SetupBucketClaimScheduling, want: 1},
+		"ClaimDefaultingInOneShard": {setup: s3.SetupBucketClaimDefaulting, want: 1},
+		"ClaimBindingInOneShard":    {setup: s3.SetupBucketClaimBinding, want: 1},
+		"ManagedInEveryShard":       {setup: s3.SetupS3Bucket, want: int(total)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, count(tc.setup)); diff != "" {
+				t.Errorf("shards that set up the controller: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroupOf(t *testing.T) {
+	cases := map[string]struct {
+		apiGroup string
+		want     string
+	}{
+		"SameGroup":  {apiGroup: "ec2.aws.crossplane.io", want: "ec2"},
+		"OtherGroup": {apiGroup: "storage.aws.crossplane.io", want: "s3"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GroupOf(tc.apiGroup)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GroupOf(...): -want, +got:\n%s", diff)
+			}
+			if _, ok := setups[got]; !ok {
+				t.Errorf("GroupOf(...): %s is not a group of setups", got)
+			}
+		})
+	}
+}
+
+func TestGroupOfEveryAPIGroup(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	for gvk := range s.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Group, ".aws.crossplane.io") {
+			continue
+		}
+		if _, ok := setups[GroupOf(gvk.Group)]; !ok {
+			t.Errorf("GroupOf(%q): %s is not a group of setups", gvk.Group, GroupOf(gvk.Group))
+		}
+	}
+}
@@ -22,7 +22,6 @@ import (
 
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -35,6 +34,8 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 // SetupReplicationGroupClaimScheduling adds a controller that reconciles
@@ -46,6 +47,7 @@ func SetupReplicationGroupClaimScheduling(mgr ctrl.Manager, l logging.Logger) er
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&cachev1alpha1.RedisCluster{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasClassSelector(),
@@ -68,6 +70,7 @@ func SetupReplicationGroupClaimDefaulting(mgr ctrl.Manager, l logging.Logger) er
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&cachev1alpha1.RedisCluster{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasNoClassSelector(),
@@ -108,7 +111,8 @@ func SetupReplicationGroupClaimBinding(mgr ctrl.Manager, l logging.Logger) error
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		Watches(&source.Kind{Type: &v1beta1.ReplicationGroup{}}, &resource.EnqueueRequestForClaim{}).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		Watches(shard.NewKind(mgr.GetCache(), &v1beta1.ReplicationGroup{}), &resource.EnqueueRequestForClaim{}).
 		For(&cachev1alpha1.RedisCluster{}).
 		WithEventFilter(p).
 		Complete(r)
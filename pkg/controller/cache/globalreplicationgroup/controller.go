@@ -0,0 +1,252 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalreplicationgroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+// Global replication group statuses.
+const (
+	statusAvailable   = "available"
+	statusPrimaryOnly = "primary-only"
+	statusCreating    = "creating"
+	statusDeleting    = "deleting"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a GlobalReplicationGroup resource"
+	errCreateClient      = "cannot create ElastiCache client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the GlobalReplicationGroup custom resource"
+
+	errDescribe = "cannot describe GlobalReplicationGroup"
+	errCreate   = "cannot create GlobalReplicationGroup"
+	errModify   = "cannot modify GlobalReplicationGroup"
+	errFailover = "cannot fail over GlobalReplicationGroup"
+	errDelete   = "cannot delete GlobalReplicationGroup"
+)
+
+// SetupGlobalReplicationGroup adds a controller that reconciles
+// GlobalReplicationGroups.
+func SetupGlobalReplicationGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.GlobalReplicationGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.GlobalReplicationGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.GlobalReplicationGroupGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elasticache.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client elasticache.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.GlobalReplicationGroup) (*awscache.GlobalReplicationGroup, error) {
+	rsp, err := e.client.DescribeGlobalReplicationGroupsRequest(elasticache.NewDescribeGlobalReplicationGroupsInput(meta.GetExternalName(cr))).Send(ctx)
+	if err != nil || len(rsp.GlobalReplicationGroups) == 0 {
+		return nil, err
+	}
+	return &rsp.GlobalReplicationGroups[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elasticache.IsGlobalReplicationGroupNotFound, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elasticache.LateInitializeGlobalReplicationGroup(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elasticache.GenerateGlobalReplicationGroupObservation(*observed)
+
+	switch aws.StringValue(observed.Status) {
+	case statusAvailable, statusPrimaryOnly:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case statusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case statusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elasticache.IsGlobalReplicationGroupUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CreateGlobalReplicationGroupRequest(elasticache.NewCreateGlobalReplicationGroupInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.GlobalReplicationGroup.GlobalReplicationGroupId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	// A failover is a change of its own and other modifications are not
+	// accepted until it completes, so they are made in a later reconcile.
+	if !elasticache.IsGlobalReplicationGroupPrimaryUpToDate(cr.Spec.ForProvider, *observed) {
+		_, err := e.client.FailoverGlobalReplicationGroupRequest(&awscache.FailoverGlobalReplicationGroupInput{
+			GlobalReplicationGroupId:  aws.String(meta.GetExternalName(cr)),
+			PrimaryRegion:             cr.Spec.ForProvider.PrimaryRegion,
+			PrimaryReplicationGroupId: cr.Spec.ForProvider.PrimaryReplicationGroupID,
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFailover)
+	}
+
+	in := elasticache.NewModifyGlobalReplicationGroupInput(cr.Spec.ForProvider, *observed)
+	if in == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ModifyGlobalReplicationGroupRequest(in).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.GlobalReplicationGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.Status == statusDeleting {
+		return nil
+	}
+
+	// The primary member is a ReplicationGroup of its own and is retained.
+	_, err := e.client.DeleteGlobalReplicationGroupRequest(&awscache.DeleteGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:      aws.String(meta.GetExternalName(cr)),
+		RetainPrimaryReplicationGroup: aws.Bool(true),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(elasticache.IsGlobalReplicationGroupNotFound, err), errDelete)
+}
@@ -0,0 +1,671 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalreplicationgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	groupID   = "ldgnf-my-datastore"
+	primaryID = "my-redis-us-east-1"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client elasticache.Client
+	kube   client.Client
+	cr     *v1alpha1.GlobalReplicationGroup
+}
+
+type groupModifier func(*v1alpha1.GlobalReplicationGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) groupModifier {
+	return func(r *v1alpha1.GlobalReplicationGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) groupModifier {
+	return func(r *v1alpha1.GlobalReplicationGroup) { meta.SetExternalName(r, s) }
+}
+
+func withSpec(p v1alpha1.GlobalReplicationGroupParameters) groupModifier {
+	return func(r *v1alpha1.GlobalReplicationGroup) { r.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.GlobalReplicationGroupObservation) groupModifier {
+	return func(r *v1alpha1.GlobalReplicationGroup) { r.Status.AtProvider = o }
+}
+
+func group(m ...groupModifier) *v1alpha1.GlobalReplicationGroup {
+	cr := &v1alpha1.GlobalReplicationGroup{
+		Spec: v1alpha1.GlobalReplicationGroupSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.GlobalReplicationGroupParameters{
+				GlobalReplicationGroupIDSuffix: "my-datastore",
+				PrimaryReplicationGroupID:      aws.String(primaryID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// fullSpec is the spec of the group after late initialization from observed.
+func fullSpec() v1alpha1.GlobalReplicationGroupParameters {
+	return v1alpha1.GlobalReplicationGroupParameters{
+		GlobalReplicationGroupIDSuffix:    "my-datastore",
+		GlobalReplicationGroupDescription: aws.String("datastore"),
+		PrimaryReplicationGroupID:         aws.String(primaryID),
+		PrimaryRegion:                     aws.String("us-east-1"),
+		AutomaticFailoverEnabled:          aws.Bool(true),
+		CacheNodeType:                     aws.String("cache.r5.large"),
+		EngineVersion:                     aws.String("5.0.6"),
+	}
+}
+
+func observed(status string) awscache.GlobalReplicationGroup {
+	return awscache.GlobalReplicationGroup{
+		GlobalReplicationGroupId:          aws.String(groupID),
+		GlobalReplicationGroupDescription: aws.String("datastore"),
+		CacheNodeType:                     aws.String("cache.r5.large"),
+		EngineVersion:                     aws.String("5.0.6"),
+		Engine:                            aws.String("redis"),
+		Status:                            aws.String(status),
+		Members: []awscache.GlobalReplicationGroupMember{
+			{ReplicationGroupId: aws.String(primaryID), ReplicationGroupRegion: aws.String("us-east-1"), Role: aws.String("PRIMARY"), AutomaticFailover: awscache.AutomaticFailoverStatusEnabled},
+			{ReplicationGroupId: aws.String("my-redis-eu-west-1"), ReplicationGroupRegion: aws.String("eu-west-1"), Role: aws.String("SECONDARY"), AutomaticFailover: awscache.AutomaticFailoverStatusEnabled},
+		},
+	}
+}
+
+func observation(status string) v1alpha1.GlobalReplicationGroupObservation {
+	return v1alpha1.GlobalReplicationGroupObservation{
+		Status: status,
+		Engine: "redis",
+		Members: []v1alpha1.GlobalReplicationGroupMember{
+			{ReplicationGroupID: primaryID, ReplicationGroupRegion: "us-east-1", Role: "PRIMARY"},
+			{ReplicationGroupID: "my-redis-eu-west-1", ReplicationGroupRegion: "eu-west-1", Role: "SECONDARY"},
+		},
+	}
+}
+
+func describe(g ...awscache.GlobalReplicationGroup) func(*awscache.DescribeGlobalReplicationGroupsInput) awscache.DescribeGlobalReplicationGroupsRequest {
+	return func(*awscache.DescribeGlobalReplicationGroupsInput) awscache.DescribeGlobalReplicationGroupsRequest {
+		return awscache.DescribeGlobalReplicationGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DescribeGlobalReplicationGroupsOutput{GlobalReplicationGroups: g}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elasticache.Client, error)
+		cr          *v1alpha1.GlobalReplicationGroup
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i elasticache.Client, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: group(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i elasticache.Client, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: group(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GlobalReplicationGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockClient{
+					MockDescribeGlobalReplicationGroupsRequest: describe(observed("available")),
+				},
+				cr: group(withExternalName(groupID)),
+			},
+			want: want{
+				cr: group(
+					withExternalName(groupID),
+					withSpec(fullSpec()),
+					withObservation(observation("available")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FailoverRequested": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeGlobalReplicationGroupsRequest: describe(observed("available")),
+				},
+				cr: group(withExternalName(groupID), withSpec(func() v1alpha1.GlobalReplicationGroupParameters {
+					p := fullSpec()
+					p.PrimaryReplicationGroupID = aws.String("my-redis-eu-west-1")
+					p.PrimaryRegion = aws.String("eu-west-1")
+					return p
+				}())),
+			},
+			want: want{
+				cr: group(withExternalName(groupID), withSpec(func() v1alpha1.GlobalReplicationGroupParameters {
+					p := fullSpec()
+					p.PrimaryReplicationGroupID = aws.String("my-redis-eu-west-1")
+					p.PrimaryRegion = aws.String("eu-west-1")
+					return p
+				}()),
+					withObservation(observation("available")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeGlobalReplicationGroupsRequest: func(*awscache.DescribeGlobalReplicationGroupsInput) awscache.DescribeGlobalReplicationGroupsRequest {
+						return awscache.DescribeGlobalReplicationGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awscache.ErrCodeGlobalReplicationGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: group(withExternalName(groupID)),
+			},
+			want: want{
+				cr: group(withExternalName(groupID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeGlobalReplicationGroupsRequest: func(*awscache.DescribeGlobalReplicationGroupsInput) awscache.DescribeGlobalReplicationGroupsRequest {
+						return awscache.DescribeGlobalReplicationGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withExternalName(groupID)),
+			},
+			want: want{
+				cr:  group(withExternalName(groupID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GlobalReplicationGroup
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockClient{
+					MockCreateGlobalReplicationGroupRequest: func(*awscache.CreateGlobalReplicationGroupInput) awscache.CreateGlobalReplicationGroupRequest {
+						return awscache.CreateGlobalReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.CreateGlobalReplicationGroupOutput{
+								GlobalReplicationGroup: &awscache.GlobalReplicationGroup{GlobalReplicationGroupId: aws.String(groupID)},
+							}},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withExternalName(groupID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateGlobalReplicationGroupRequest: func(*awscache.CreateGlobalReplicationGroupInput) awscache.CreateGlobalReplicationGroupRequest {
+						return awscache.CreateGlobalReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GlobalReplicationGroup
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	failover := fullSpec()
+	failover.PrimaryReplicationGroupID = aws.String("my-redis-eu-west-1")
+	failover.PrimaryRegion = aws.String("eu-west-1")
+
+	upgrade := fullSpec()
+	upgrade.EngineVersion = aws.String("6.0.5")
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Failover": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeGlobalReplicationGroupsRequest: describe(observed("available")),
+					MockFailoverGlobalReplicationGroupRequest: func(in *awscache.FailoverGlobalReplicationGroupInput) awscache.FailoverGlobalReplicationGroupRequest {
+						if diff := cmp.Diff("eu-west-1", aws.StringValue(in.PrimaryRegion)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscache.FailoverGlobalReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.FailoverGlobalReplicationGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withExternalName(groupID), withSpec(failover)),
+			},
+			want: want{
+				cr: group(withExternalName(groupID), withSpec(failover)),
+			},
+		},
+		"Modify": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeGlobalReplicationGroupsRequest: describe(observed("available")),
+					MockModifyGlobalReplicationGroupRequest: func(in *awscache.ModifyGlobalReplicationGroupInput) awscache.ModifyGlobalReplicationGroupRequest {
+						want := &awscache.ModifyGlobalReplicationGroupInput{
+							GlobalReplicationGroupId: aws.String(groupID),
+							ApplyImmediately:         aws.Bool(true),
+							EngineVersion:            aws.String("6.0.5"),
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscache.ModifyGlobalReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.ModifyGlobalReplicationGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withExternalName(groupID), withSpec(upgrade)),
+			},
+			want: want{
+				cr: group(withExternalName(groupID), withSpec(upgrade)),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeGlobalReplicationGroupsRequest: describe(observed("available")),
+					MockModifyGlobalReplicationGroupRequest: func(*awscache.ModifyGlobalReplicationGroupInput) awscache.ModifyGlobalReplicationGroupRequest {
+						return awscache.ModifyGlobalReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withExternalName(groupID), withSpec(upgrade)),
+			},
+			want: want{
+				cr:  group(withExternalName(groupID), withSpec(upgrade)),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.GlobalReplicationGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGlobalReplicationGroupRequest: func(in *awscache.DeleteGlobalReplicationGroupInput) awscache.DeleteGlobalReplicationGroupRequest {
+						if !aws.BoolValue(in.RetainPrimaryReplicationGroup) {
+							t.Errorf("primary replication group is not retained")
+						}
+						return awscache.DeleteGlobalReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscache.DeleteGlobalReplicationGroupOutput{}},
+						}
+					},
+				},
+				cr: group(withExternalName(groupID)),
+			},
+			want: want{
+				cr: group(withExternalName(groupID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: group(withExternalName(groupID), withObservation(v1alpha1.GlobalReplicationGroupObservation{Status: "deleting"})),
+			},
+			want: want{
+				cr: group(
+					withExternalName(groupID),
+					withObservation(v1alpha1.GlobalReplicationGroupObservation{Status: "deleting"}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteGlobalReplicationGroupRequest: func(*awscache.DeleteGlobalReplicationGroupInput) awscache.DeleteGlobalReplicationGroupRequest {
+						return awscache.DeleteGlobalReplicationGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: group(withExternalName(groupID)),
+			},
+			want: want{
+				cr:  group(withExternalName(groupID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
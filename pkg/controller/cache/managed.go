@@ -40,7 +40,13 @@ import (
 	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/requeue"
 )
 
 // Error strings.
@@ -63,17 +69,19 @@ const (
 func SetupReplicationGroup(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1beta1.ReplicationGroupGroupKind)
 
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.ReplicationGroupGroupVersionKind),
+		managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connecter{client: mgr.GetClient(), newClientFn: elasticache.NewClient}))))),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1beta1.ReplicationGroup{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.ReplicationGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newClientFn: elasticache.NewClient}),
-			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		))
+		Complete(requeue.NewReconciler(mgr, resource.ManagedKind(v1beta1.ReplicationGroupGroupVersionKind), requeue.TypicalReplicationGroupDuration, r))
 }
 
 type connecter struct {
@@ -92,6 +100,11 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.client, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if commonaws.BoolValue(p.Spec.UseServiceAccount) {
 		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
 		return &external{client: awsClient, kube: c.client}, errors.Wrap(err, errNewClient)
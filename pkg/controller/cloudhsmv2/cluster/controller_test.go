@@ -0,0 +1,546 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscloudhsmv2 "github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudhsmv2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudhsmv2"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudhsmv2/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	clusterID = "cluster-igklspoyj5v"
+	subnetID  = "subnet-0e358c43"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client cloudhsmv2.ClusterClient
+	kube   client.Client
+	cr     *v1alpha1.Cluster
+}
+
+type clusterModifier func(*v1alpha1.Cluster)
+
+func withConditions(c ...runtimev1alpha1.Condition) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) clusterModifier {
+	return func(r *v1alpha1.Cluster) { meta.SetExternalName(r, s) }
+}
+
+func withSubnetIDs(s ...string) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.SubnetIDs = s }
+}
+
+func withObservation(o v1alpha1.ClusterObservation) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Status.AtProvider = o }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.Cluster {
+	cr := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ClusterParameters{
+				HSMType: "hsm1.medium",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeClusters(c ...awscloudhsmv2.Cluster) func(*awscloudhsmv2.DescribeClustersInput) awscloudhsmv2.DescribeClustersRequest {
+	return func(*awscloudhsmv2.DescribeClustersInput) awscloudhsmv2.DescribeClustersRequest {
+		return awscloudhsmv2.DescribeClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudhsmv2.DescribeClustersOutput{Clusters: c}},
+		}
+	}
+}
+
+func observedCluster(state awscloudhsmv2.ClusterState) awscloudhsmv2.Cluster {
+	return awscloudhsmv2.Cluster{
+		ClusterId:     aws.String(clusterID),
+		HsmType:       aws.String("hsm1.medium"),
+		State:         state,
+		SubnetMapping: map[string]string{"us-east-1a": subnetID},
+		Certificates:  &awscloudhsmv2.Certificates{ClusterCsr: aws.String("csr")},
+	}
+}
+
+func observation(state string) v1alpha1.ClusterObservation {
+	return v1alpha1.ClusterObservation{
+		State:         state,
+		SubnetMapping: map[string]string{"us-east-1a": subnetID},
+		Certificates:  v1alpha1.ClusterCertificates{ClusterCSR: "csr"},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (cloudhsmv2.ClusterClient, error)
+		cr          *v1alpha1.Cluster
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i cloudhsmv2.ClusterClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: cluster(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i cloudhsmv2.ClusterClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: cluster(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Cluster
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Uninitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockClusterClient{
+					MockDescribeClusters: describeClusters(observedCluster(awscloudhsmv2.ClusterStateUninitialized)),
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr: cluster(
+					withExternalName(clusterID),
+					withSubnetIDs(subnetID),
+					withObservation(observation("UNINITIALIZED")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDescribeClusters: describeClusters(observedCluster(awscloudhsmv2.ClusterStateCreateInProgress)),
+				},
+				cr: cluster(withExternalName(clusterID), withSubnetIDs(subnetID)),
+			},
+			want: want{
+				cr: cluster(
+					withExternalName(clusterID),
+					withSubnetIDs(subnetID),
+					withObservation(observation("CREATE_IN_PROGRESS")),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDescribeClusters: describeClusters(),
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID)),
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDescribeClusters: describeClusters(observedCluster(awscloudhsmv2.ClusterStateDeleted)),
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDescribeClusters: func(*awscloudhsmv2.DescribeClustersInput) awscloudhsmv2.DescribeClustersRequest {
+						return awscloudhsmv2.DescribeClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr:  cluster(withExternalName(clusterID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Cluster
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockClusterClient{
+					MockCreateCluster: func(*awscloudhsmv2.CreateClusterInput) awscloudhsmv2.CreateClusterRequest {
+						return awscloudhsmv2.CreateClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudhsmv2.CreateClusterOutput{
+								Cluster: &awscloudhsmv2.Cluster{ClusterId: aws.String(clusterID)},
+							}},
+						}
+					},
+				},
+				cr: cluster(withSubnetIDs(subnetID)),
+			},
+			want: want{
+				cr: cluster(withSubnetIDs(subnetID), withExternalName(clusterID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockCreateCluster: func(*awscloudhsmv2.CreateClusterInput) awscloudhsmv2.CreateClusterRequest {
+						return awscloudhsmv2.CreateClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(withSubnetIDs(subnetID)),
+			},
+			want: want{
+				cr:  cluster(withSubnetIDs(subnetID), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Cluster
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDeleteCluster: func(*awscloudhsmv2.DeleteClusterInput) awscloudhsmv2.DeleteClusterRequest {
+						return awscloudhsmv2.DeleteClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudhsmv2.DeleteClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr: cluster(withExternalName(clusterID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: cluster(withExternalName(clusterID), withObservation(v1alpha1.ClusterObservation{State: "DELETE_IN_PROGRESS"})),
+			},
+			want: want{
+				cr: cluster(
+					withExternalName(clusterID),
+					withObservation(v1alpha1.ClusterObservation{State: "DELETE_IN_PROGRESS"}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDeleteCluster: func(*awscloudhsmv2.DeleteClusterInput) awscloudhsmv2.DeleteClusterRequest {
+						return awscloudhsmv2.DeleteClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(withExternalName(clusterID)),
+			},
+			want: want{
+				cr:  cluster(withExternalName(clusterID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
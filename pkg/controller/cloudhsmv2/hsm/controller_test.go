@@ -0,0 +1,533 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hsm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscloudhsmv2 "github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudhsmv2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudhsmv2"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudhsmv2/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	clusterID = "cluster-igklspoyj5v"
+	hsmID     = "hsm-ucg4sqrzgpu"
+	eniIP     = "10.0.0.12"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client cloudhsmv2.HsmClient
+	kube   client.Client
+	cr     *v1alpha1.Hsm
+}
+
+type hsmModifier func(*v1alpha1.Hsm)
+
+func withConditions(c ...runtimev1alpha1.Condition) hsmModifier {
+	return func(r *v1alpha1.Hsm) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) hsmModifier {
+	return func(r *v1alpha1.Hsm) { meta.SetExternalName(r, s) }
+}
+
+func withIPAddress(s string) hsmModifier {
+	return func(r *v1alpha1.Hsm) { r.Spec.ForProvider.IPAddress = aws.String(s) }
+}
+
+func withState(s string) hsmModifier {
+	return func(r *v1alpha1.Hsm) { r.Status.AtProvider.State = s }
+}
+
+func withENI(ip string) hsmModifier {
+	return func(r *v1alpha1.Hsm) { r.Status.AtProvider.ENIIP = ip }
+}
+
+func hsm(m ...hsmModifier) *v1alpha1.Hsm {
+	cr := &v1alpha1.Hsm{
+		Spec: v1alpha1.HsmSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.HsmParameters{
+				ClusterID:        aws.String(clusterID),
+				AvailabilityZone: "us-east-1a",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeClusters(hsms ...awscloudhsmv2.Hsm) func(*awscloudhsmv2.DescribeClustersInput) awscloudhsmv2.DescribeClustersRequest {
+	return func(*awscloudhsmv2.DescribeClustersInput) awscloudhsmv2.DescribeClustersRequest {
+		return awscloudhsmv2.DescribeClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudhsmv2.DescribeClustersOutput{
+				Clusters: []awscloudhsmv2.Cluster{{ClusterId: aws.String(clusterID), Hsms: hsms}},
+			}},
+		}
+	}
+}
+
+func observedHsm(state awscloudhsmv2.HsmState) awscloudhsmv2.Hsm {
+	return awscloudhsmv2.Hsm{
+		HsmId:     aws.String(hsmID),
+		ClusterId: aws.String(clusterID),
+		EniIp:     aws.String(eniIP),
+		State:     state,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (cloudhsmv2.HsmClient, error)
+		cr          *v1alpha1.Hsm
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i cloudhsmv2.HsmClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: hsm(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i cloudhsmv2.HsmClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: hsm(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: hsm(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: hsm(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: hsm(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Hsm
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockHsmClient{
+					MockDescribeClusters: describeClusters(observedHsm(awscloudhsmv2.HsmStateActive)),
+				},
+				cr: hsm(withExternalName(hsmID)),
+			},
+			want: want{
+				cr: hsm(
+					withExternalName(hsmID),
+					withIPAddress(eniIP),
+					withState("ACTIVE"),
+					withENI(eniIP),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Degraded": {
+			args: args{
+				client: &fake.MockHsmClient{
+					MockDescribeClusters: describeClusters(observedHsm(awscloudhsmv2.HsmStateDegraded)),
+				},
+				cr: hsm(withExternalName(hsmID), withIPAddress(eniIP)),
+			},
+			want: want{
+				cr: hsm(
+					withExternalName(hsmID),
+					withIPAddress(eniIP),
+					withState("DEGRADED"),
+					withENI(eniIP),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: hsm(),
+			},
+			want: want{
+				cr: hsm(),
+			},
+		},
+		"NotInCluster": {
+			args: args{
+				client: &fake.MockHsmClient{
+					MockDescribeClusters: describeClusters(),
+				},
+				cr: hsm(withExternalName(hsmID)),
+			},
+			want: want{
+				cr: hsm(withExternalName(hsmID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockHsmClient{
+					MockDescribeClusters: func(*awscloudhsmv2.DescribeClustersInput) awscloudhsmv2.DescribeClustersRequest {
+						return awscloudhsmv2.DescribeClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: hsm(withExternalName(hsmID)),
+			},
+			want: want{
+				cr:  hsm(withExternalName(hsmID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Hsm
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockHsmClient{
+					MockCreateHsm: func(*awscloudhsmv2.CreateHsmInput) awscloudhsmv2.CreateHsmRequest {
+						return awscloudhsmv2.CreateHsmRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudhsmv2.CreateHsmOutput{
+								Hsm: &awscloudhsmv2.Hsm{HsmId: aws.String(hsmID)},
+							}},
+						}
+					},
+				},
+				cr: hsm(),
+			},
+			want: want{
+				cr: hsm(withExternalName(hsmID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockHsmClient{
+					MockCreateHsm: func(*awscloudhsmv2.CreateHsmInput) awscloudhsmv2.CreateHsmRequest {
+						return awscloudhsmv2.CreateHsmRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: hsm(),
+			},
+			want: want{
+				cr:  hsm(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Hsm
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockHsmClient{
+					MockDeleteHsm: func(*awscloudhsmv2.DeleteHsmInput) awscloudhsmv2.DeleteHsmRequest {
+						return awscloudhsmv2.DeleteHsmRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudhsmv2.DeleteHsmOutput{}},
+						}
+					},
+				},
+				cr: hsm(withExternalName(hsmID)),
+			},
+			want: want{
+				cr: hsm(withExternalName(hsmID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: hsm(withExternalName(hsmID), withState("DELETE_IN_PROGRESS")),
+			},
+			want: want{
+				cr: hsm(withExternalName(hsmID), withState("DELETE_IN_PROGRESS"), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockHsmClient{
+					MockDeleteHsm: func(*awscloudhsmv2.DeleteHsmInput) awscloudhsmv2.DeleteHsmRequest {
+						return awscloudhsmv2.DeleteHsmRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: hsm(withExternalName(hsmID)),
+			},
+			want: want{
+				cr:  hsm(withExternalName(hsmID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
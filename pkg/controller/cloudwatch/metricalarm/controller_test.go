@@ -0,0 +1,668 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricalarm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscloudwatch "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	alarmName   = "example"
+	alarmARN    = "arn:aws:cloudwatch:us-east-1:123456789012:alarm:example"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awscloudwatch.ErrCodeResourceNotFound, "not found", nil)
+)
+
+type args struct {
+	client cloudwatch.MetricAlarmClient
+	kube   client.Client
+	cr     *v1alpha1.MetricAlarm
+}
+
+type metricAlarmModifier func(*v1alpha1.MetricAlarm)
+
+func withConditions(c ...runtimev1alpha1.Condition) metricAlarmModifier {
+	return func(r *v1alpha1.MetricAlarm) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) metricAlarmModifier {
+	return func(r *v1alpha1.MetricAlarm) { meta.SetExternalName(r, s) }
+}
+
+func withThreshold(s string) metricAlarmModifier {
+	return func(r *v1alpha1.MetricAlarm) { r.Spec.ForProvider.Threshold = aws.String(s) }
+}
+
+func withActionsEnabled(b bool) metricAlarmModifier {
+	return func(r *v1alpha1.MetricAlarm) { r.Spec.ForProvider.ActionsEnabled = aws.Bool(b) }
+}
+
+func withObservation(o v1alpha1.MetricAlarmObservation) metricAlarmModifier {
+	return func(r *v1alpha1.MetricAlarm) { r.Status.AtProvider = o }
+}
+
+func metricAlarm(m ...metricAlarmModifier) *v1alpha1.MetricAlarm {
+	cr := &v1alpha1.MetricAlarm{
+		Spec: v1alpha1.MetricAlarmSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.MetricAlarmParameters{
+				ComparisonOperator: "LessThanLowerOrGreaterThanUpperThreshold",
+				EvaluationPeriods:  2,
+				Metrics: []v1alpha1.MetricDataQuery{
+					{
+						ID: "m1",
+						MetricStat: &v1alpha1.MetricStat{
+							Metric: v1alpha1.Metric{
+								MetricName: aws.String("CPUUtilization"),
+								Namespace:  aws.String("AWS/EC2"),
+							},
+							Period: 300,
+							Stat:   "Average",
+						},
+					},
+					{
+						ID:         "ad1",
+						Expression: aws.String("ANOMALY_DETECTION_BAND(m1, 2)"),
+					},
+				},
+				ThresholdMetricID: aws.String("ad1"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func alarm() awscloudwatch.MetricAlarm {
+	return awscloudwatch.MetricAlarm{
+		ActionsEnabled:     aws.Bool(true),
+		AlarmArn:           aws.String(alarmARN),
+		AlarmName:          aws.String(alarmName),
+		ComparisonOperator: awscloudwatch.ComparisonOperatorLessThanLowerOrGreaterThanUpperThreshold,
+		EvaluationPeriods:  aws.Int64(2),
+		Metrics: []awscloudwatch.MetricDataQuery{
+			{
+				Id: aws.String("m1"),
+				MetricStat: &awscloudwatch.MetricStat{
+					Metric: &awscloudwatch.Metric{
+						MetricName: aws.String("CPUUtilization"),
+						Namespace:  aws.String("AWS/EC2"),
+					},
+					Period: aws.Int64(300),
+					Stat:   aws.String("Average"),
+				},
+				ReturnData: aws.Bool(true),
+			},
+			{
+				Id:         aws.String("ad1"),
+				Expression: aws.String("ANOMALY_DETECTION_BAND(m1, 2)"),
+				ReturnData: aws.Bool(true),
+			},
+		},
+		StateValue:        awscloudwatch.StateValueOk,
+		ThresholdMetricId: aws.String("ad1"),
+	}
+}
+
+func observed(a ...awscloudwatch.MetricAlarm) *awscloudwatch.DescribeAlarmsOutput {
+	return &awscloudwatch.DescribeAlarmsOutput{MetricAlarms: a}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (cloudwatch.MetricAlarmClient, error)
+		cr          *v1alpha1.MetricAlarm
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i cloudwatch.MetricAlarmClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: metricAlarm(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i cloudwatch.MetricAlarmClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: metricAlarm(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: metricAlarm(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: metricAlarm(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: metricAlarm(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.MetricAlarm
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockDescribeAlarms: func(input *awscloudwatch.DescribeAlarmsInput) awscloudwatch.DescribeAlarmsRequest {
+						return awscloudwatch.DescribeAlarmsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(alarm())},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName), withActionsEnabled(true)),
+			},
+			want: want{
+				cr: metricAlarm(withExternalName(alarmName),
+					withActionsEnabled(true),
+					withObservation(v1alpha1.MetricAlarmObservation{AlarmARN: alarmARN, StateValue: "OK"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitActionsEnabled": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockDescribeAlarms: func(input *awscloudwatch.DescribeAlarmsInput) awscloudwatch.DescribeAlarmsRequest {
+						return awscloudwatch.DescribeAlarmsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(alarm())},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+			want: want{
+				cr: metricAlarm(withExternalName(alarmName),
+					withActionsEnabled(true),
+					withObservation(v1alpha1.MetricAlarmObservation{AlarmARN: alarmARN, StateValue: "OK"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockDescribeAlarms: func(input *awscloudwatch.DescribeAlarmsInput) awscloudwatch.DescribeAlarmsRequest {
+						return awscloudwatch.DescribeAlarmsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(alarm())},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName), withActionsEnabled(true), withThreshold("50")),
+			},
+			want: want{
+				cr: metricAlarm(withExternalName(alarmName),
+					withActionsEnabled(true),
+					withThreshold("50"),
+					withObservation(v1alpha1.MetricAlarmObservation{AlarmARN: alarmARN, StateValue: "OK"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockDescribeAlarms: func(input *awscloudwatch.DescribeAlarmsInput) awscloudwatch.DescribeAlarmsRequest {
+						return awscloudwatch.DescribeAlarmsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+			want: want{
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockDescribeAlarms: func(input *awscloudwatch.DescribeAlarmsInput) awscloudwatch.DescribeAlarmsRequest {
+						return awscloudwatch.DescribeAlarmsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+			want: want{
+				cr:  metricAlarm(withExternalName(alarmName)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.MetricAlarm
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockPutMetricAlarm: func(input *awscloudwatch.PutMetricAlarmInput) awscloudwatch.PutMetricAlarmRequest {
+						return awscloudwatch.PutMetricAlarmRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.PutMetricAlarmOutput{}},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+			want: want{
+				cr: metricAlarm(withExternalName(alarmName), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InvalidThreshold": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{},
+				cr:     metricAlarm(withExternalName(alarmName), withThreshold("seventy")),
+			},
+			want: want{
+				cr:  metricAlarm(withExternalName(alarmName), withThreshold("seventy"), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errors.New("threshold must be a decimal number"), errCreate),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockPutMetricAlarm: func(input *awscloudwatch.PutMetricAlarmInput) awscloudwatch.PutMetricAlarmRequest {
+						return awscloudwatch.PutMetricAlarmRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+			want: want{
+				cr:  metricAlarm(withExternalName(alarmName), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.MetricAlarm
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockPutMetricAlarm: func(input *awscloudwatch.PutMetricAlarmInput) awscloudwatch.PutMetricAlarmRequest {
+						if diff := cmp.Diff(50.0, aws.Float64Value(input.Threshold)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awscloudwatch.PutMetricAlarmRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.PutMetricAlarmOutput{}},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName), withThreshold("50")),
+			},
+			want: want{
+				cr: metricAlarm(withExternalName(alarmName), withThreshold("50")),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockPutMetricAlarm: func(input *awscloudwatch.PutMetricAlarmInput) awscloudwatch.PutMetricAlarmRequest {
+						return awscloudwatch.PutMetricAlarmRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+			want: want{
+				cr:  metricAlarm(withExternalName(alarmName)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.MetricAlarm
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockDeleteAlarms: func(input *awscloudwatch.DeleteAlarmsInput) awscloudwatch.DeleteAlarmsRequest {
+						return awscloudwatch.DeleteAlarmsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatch.DeleteAlarmsOutput{}},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+			want: want{
+				cr: metricAlarm(withExternalName(alarmName), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockDeleteAlarms: func(input *awscloudwatch.DeleteAlarmsInput) awscloudwatch.DeleteAlarmsRequest {
+						return awscloudwatch.DeleteAlarmsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+			want: want{
+				cr: metricAlarm(withExternalName(alarmName), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockMetricAlarmClient{
+					MockDeleteAlarms: func(input *awscloudwatch.DeleteAlarmsInput) awscloudwatch.DeleteAlarmsRequest {
+						return awscloudwatch.DeleteAlarmsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: metricAlarm(withExternalName(alarmName)),
+			},
+			want: want{
+				cr:  metricAlarm(withExternalName(alarmName), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,537 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awscloudwatchlogs "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatchlogs"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatchlogs/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	policyName = "route53-query-logging"
+	document   = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"Service": "route53.amazonaws.com"}, "Action": "logs:PutLogEvents", "Resource": "*"}]}`
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client cloudwatchlogs.ResourcePolicyClient
+	kube   client.Client
+	cr     *v1alpha1.ResourcePolicy
+}
+
+type resourcePolicyModifier func(*v1alpha1.ResourcePolicy)
+
+func withConditions(c ...runtimev1alpha1.Condition) resourcePolicyModifier {
+	return func(r *v1alpha1.ResourcePolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDocument(d string) resourcePolicyModifier {
+	return func(r *v1alpha1.ResourcePolicy) { r.Spec.ForProvider.PolicyDocument = d }
+}
+
+func withStatus(o v1alpha1.ResourcePolicyObservation) resourcePolicyModifier {
+	return func(r *v1alpha1.ResourcePolicy) { r.Status.AtProvider = o }
+}
+
+func resourcePolicy(m ...resourcePolicyModifier) *v1alpha1.ResourcePolicy {
+	cr := &v1alpha1.ResourcePolicy{
+		Spec: v1alpha1.ResourcePolicySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, policyName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(err error, p ...awscloudwatchlogs.ResourcePolicy) func(*awscloudwatchlogs.DescribeResourcePoliciesInput) awscloudwatchlogs.DescribeResourcePoliciesRequest {
+	return func(*awscloudwatchlogs.DescribeResourcePoliciesInput) awscloudwatchlogs.DescribeResourcePoliciesRequest {
+		return awscloudwatchlogs.DescribeResourcePoliciesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatchlogs.DescribeResourcePoliciesOutput{ResourcePolicies: p}, Error: err},
+		}
+	}
+}
+
+func put(err error) func(*awscloudwatchlogs.PutResourcePolicyInput) awscloudwatchlogs.PutResourcePolicyRequest {
+	return func(*awscloudwatchlogs.PutResourcePolicyInput) awscloudwatchlogs.PutResourcePolicyRequest {
+		return awscloudwatchlogs.PutResourcePolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatchlogs.PutResourcePolicyOutput{}, Error: err},
+		}
+	}
+}
+
+func del(err error) func(*awscloudwatchlogs.DeleteResourcePolicyInput) awscloudwatchlogs.DeleteResourcePolicyRequest {
+	return func(*awscloudwatchlogs.DeleteResourcePolicyInput) awscloudwatchlogs.DeleteResourcePolicyRequest {
+		return awscloudwatchlogs.DeleteResourcePolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awscloudwatchlogs.DeleteResourcePolicyOutput{}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (cloudwatchlogs.ResourcePolicyClient, error)
+		cr          *v1alpha1.ResourcePolicy
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i cloudwatchlogs.ResourcePolicyClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: resourcePolicy(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i cloudwatchlogs.ResourcePolicyClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: resourcePolicy(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: resourcePolicy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: resourcePolicy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: resourcePolicy(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResourcePolicy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{
+					MockDescribeResourcePolicies: describe(nil,
+						awscloudwatchlogs.ResourcePolicy{PolicyName: aws.String("other"), PolicyDocument: aws.String("{}")},
+						awscloudwatchlogs.ResourcePolicy{
+							PolicyName:      aws.String(policyName),
+							PolicyDocument:  aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"route53.amazonaws.com"},"Action":"logs:PutLogEvents","Resource":"*"}]}`),
+							LastUpdatedTime: aws.Int64(1000),
+						}),
+				},
+				cr: resourcePolicy(withDocument(document)),
+			},
+			want: want{
+				cr: resourcePolicy(withDocument(document),
+					withStatus(v1alpha1.ResourcePolicyObservation{LastUpdatedTime: 1000}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DocumentChanged": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{
+					MockDescribeResourcePolicies: describe(nil, awscloudwatchlogs.ResourcePolicy{
+						PolicyName:     aws.String(policyName),
+						PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+					}),
+				},
+				cr: resourcePolicy(withDocument(document)),
+			},
+			want: want{
+				cr: resourcePolicy(withDocument(document), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{
+					MockDescribeResourcePolicies: describe(nil, awscloudwatchlogs.ResourcePolicy{PolicyName: aws.String("other")}),
+				},
+				cr: resourcePolicy(),
+			},
+			want: want{
+				cr: resourcePolicy(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{
+					MockDescribeResourcePolicies: describe(errBoom),
+				},
+				cr: resourcePolicy(),
+			},
+			want: want{
+				cr:  resourcePolicy(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResourcePolicy
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{MockPutResourcePolicy: put(nil)},
+				cr:     resourcePolicy(withDocument(document)),
+			},
+			want: want{
+				cr: resourcePolicy(withDocument(document), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{MockPutResourcePolicy: put(errBoom)},
+				cr:     resourcePolicy(withDocument(document)),
+			},
+			want: want{
+				cr:  resourcePolicy(withDocument(document), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResourcePolicy
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{MockPutResourcePolicy: put(nil)},
+				cr:     resourcePolicy(withDocument(document)),
+			},
+			want: want{
+				cr: resourcePolicy(withDocument(document)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{MockPutResourcePolicy: put(errBoom)},
+				cr:     resourcePolicy(withDocument(document)),
+			},
+			want: want{
+				cr:  resourcePolicy(withDocument(document)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ResourcePolicy
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{MockDeleteResourcePolicy: del(nil)},
+				cr:     resourcePolicy(),
+			},
+			want: want{
+				cr: resourcePolicy(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{
+					MockDeleteResourcePolicy: del(awserr.New(awscloudwatchlogs.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: resourcePolicy(),
+			},
+			want: want{
+				cr: resourcePolicy(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResourcePolicyClient{MockDeleteResourcePolicy: del(errBoom)},
+				cr:     resourcePolicy(),
+			},
+			want: want{
+				cr:  resourcePolicy(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,7 +21,6 @@ import (
 
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -33,6 +32,8 @@ import (
 	computev1alpha1 "github.com/crossplane/crossplane/apis/compute/v1alpha1"
 
 	"github.com/crossplane/provider-aws/apis/compute/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 // SetupEKSClusterClaimScheduling adds a controller that reconciles
@@ -43,6 +44,7 @@ func SetupEKSClusterClaimScheduling(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&computev1alpha1.KubernetesCluster{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasClassSelector(),
@@ -65,6 +67,7 @@ func SetupEKSClusterClaimDefaulting(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&computev1alpha1.KubernetesCluster{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasNoClassSelector(),
@@ -106,7 +109,8 @@ func SetupEKSClusterClaimBinding(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		Watches(&source.Kind{Type: &v1alpha3.EKSCluster{}}, &resource.EnqueueRequestForClaim{}).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		Watches(shard.NewKind(mgr.GetCache(), &v1alpha3.EKSCluster{}), &resource.EnqueueRequestForClaim{}).
 		For(&computev1alpha1.KubernetesCluster{}).
 		WithEventFilter(p).
 		Complete(r)
@@ -45,6 +45,7 @@ import (
 	awscomputev1alpha3 "github.com/crossplane/provider-aws/apis/compute/v1alpha3"
 	cloudformationclient "github.com/crossplane/provider-aws/pkg/clients/cloudformation"
 	eks "github.com/crossplane/provider-aws/pkg/clients/legacyeks"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 	"github.com/crossplane/provider-aws/pkg/controller/utils"
 )
 
@@ -125,6 +126,7 @@ func SetupEKSCluster(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&awscomputev1alpha3.EKSCluster{}).
 		Complete(r)
 }
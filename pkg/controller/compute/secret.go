@@ -29,6 +29,7 @@ import (
 	workloadv1alpha1 "github.com/crossplane/crossplane/apis/workload/v1alpha1"
 
 	"github.com/crossplane/provider-aws/apis/compute/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 // SetupEKSClusterSecret adds a controller that propagates EKSCluster connection
@@ -38,6 +39,7 @@ func SetupEKSClusterSecret(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		Watches(&source.Kind{Type: &corev1.Secret{}}, &resource.EnqueueRequestForPropagated{}).
 		For(&corev1.Secret{}).
 		WithEventFilter(resource.NewPredicates(resource.AnyOf(
@@ -29,6 +29,7 @@ import (
 	"github.com/crossplane/crossplane/apis/workload/v1alpha1"
 
 	"github.com/crossplane/provider-aws/apis/compute/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 // SetupEKSClusterTarget adds a controller that propagates EKSCluster connection
@@ -40,6 +41,7 @@ func SetupEKSClusterTarget(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(strings.ToLower(fmt.Sprintf("kubernetestarget.%s.%s", v1alpha3.EKSClusterKind, v1alpha3.Group))).
 		For(&v1alpha1.KubernetesTarget{}).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		WithEventFilter(p).
 		Complete(target.NewReconciler(mgr,
 			resource.TargetKind(v1alpha1.KubernetesTargetGroupVersionKind),
@@ -22,7 +22,6 @@ import (
 
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -35,6 +34,8 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/database/v1beta1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 // SetupPostgreSQLInstanceClaimScheduling adds a controller that reconciles
@@ -46,6 +47,7 @@ func SetupPostgreSQLInstanceClaimScheduling(mgr ctrl.Manager, l logging.Logger)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&databasev1alpha1.PostgreSQLInstance{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasClassSelector(),
@@ -68,6 +70,7 @@ func SetupPostgreSQLInstanceClaimDefaulting(mgr ctrl.Manager, l logging.Logger)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&databasev1alpha1.PostgreSQLInstance{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasNoClassSelector(),
@@ -108,7 +111,8 @@ func SetupPostgreSQLInstanceClaimBinding(mgr ctrl.Manager, l logging.Logger) err
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		Watches(&source.Kind{Type: &v1beta1.RDSInstance{}}, &resource.EnqueueRequestForClaim{}).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		Watches(shard.NewKind(mgr.GetCache(), &v1beta1.RDSInstance{}), &resource.EnqueueRequestForClaim{}).
 		For(&databasev1alpha1.PostgreSQLInstance{}).
 		WithEventFilter(p).
 		Complete(r)
@@ -166,6 +170,7 @@ func SetupMySQLInstanceClaimScheduling(mgr ctrl.Manager, l logging.Logger) error
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&databasev1alpha1.MySQLInstance{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasClassSelector(),
@@ -188,6 +193,7 @@ func SetupMySQLInstanceClaimDefaulting(mgr ctrl.Manager, l logging.Logger) error
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&databasev1alpha1.MySQLInstance{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasNoClassSelector(),
@@ -228,7 +234,8 @@ func SetupMySQLInstanceClaimBinding(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		Watches(&source.Kind{Type: &v1beta1.RDSInstance{}}, &resource.EnqueueRequestForClaim{}).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		Watches(shard.NewKind(mgr.GetCache(), &v1beta1.RDSInstance{}), &resource.EnqueueRequestForClaim{}).
 		For(&databasev1alpha1.MySQLInstance{}).
 		WithEventFilter(p).
 		Complete(r)
@@ -38,7 +38,12 @@ import (
 	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/dynamodb"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 const (
@@ -54,6 +59,10 @@ const (
 	errDescribeFailed = "cannot describe DynamoDB table"
 	errUpdateFailed   = "cannot update DynamoDB table"
 	errUpToDateFailed = "cannot check whether object is up-to-date"
+
+	errDescribeAutoScalingFailed = "cannot describe auto scaling of DynamoDB table replicas"
+	errUpdateReplicaFailed       = "cannot update DynamoDB table replica"
+	errUpdateAutoScalingFailed   = "cannot update auto scaling of DynamoDB table replicas"
 )
 
 // SetupDynamoTable adds a controller that reconciles DynamoTable.
@@ -62,10 +71,11 @@ func SetupDynamoTable(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha1.DynamoTable{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.DynamoTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: dynamodb.NewClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: dynamodb.NewClient}))))),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -86,6 +96,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		dynamoClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
 		return &external{client: dynamoClient, kube: c.kube}, errors.Wrap(err, errCreateDynamoClient)
@@ -151,6 +166,12 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
+	if upToDate && dynamodb.HasReplicaAutoScaling(cr.Spec.ForProvider.Replicas) {
+		upToDate, err = e.isReplicaAutoScalingUpToDate(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -184,11 +205,64 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
+	if len(cr.Spec.ForProvider.Replicas) != 0 || len(cr.Status.AtProvider.Replicas) != 0 {
+		return e.updateGlobalTable(ctx, cr)
+	}
+
 	_, err := e.client.UpdateTableRequest(dynamodb.GenerateUpdateTableInput(cr.Status.AtProvider.TableName, &cr.Spec.ForProvider)).Send(ctx)
 
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 }
 
+// updateGlobalTable makes a single change to a table with replicas, as
+// DynamoDB does not accept changes to replicas along with other changes.
+func (e *external) updateGlobalTable(ctx context.Context, cr *v1alpha1.DynamoTable) (managed.ExternalUpdate, error) {
+	rsp, err := e.client.DescribeTableRequest(&awsdynamo.DescribeTableInput{
+		TableName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeFailed)
+	}
+	table := rsp.DescribeTableOutput.Table
+
+	if u := dynamodb.GenerateReplicaUpdate(cr.Spec.ForProvider.Replicas, table.Replicas); u != nil {
+		_, err := e.client.UpdateTableRequest(&awsdynamo.UpdateTableInput{
+			TableName:      aws.String(meta.GetExternalName(cr)),
+			ReplicaUpdates: []awsdynamo.ReplicationGroupUpdate{*u},
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateReplicaFailed)
+	}
+
+	upToDate, err := dynamodb.IsTableUpToDate(cr.Spec.ForProvider, *table)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpToDateFailed)
+	}
+	if !upToDate {
+		_, err := e.client.UpdateTableRequest(dynamodb.GenerateUpdateTableInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	if !dynamodb.HasReplicaAutoScaling(cr.Spec.ForProvider.Replicas) {
+		return managed.ExternalUpdate{}, nil
+	}
+	upToDate, err = e.isReplicaAutoScalingUpToDate(ctx, cr)
+	if err != nil || upToDate {
+		return managed.ExternalUpdate{}, err
+	}
+	_, err = e.client.UpdateTableReplicaAutoScalingRequest(dynamodb.GenerateUpdateTableReplicaAutoScalingInput(meta.GetExternalName(cr), cr.Spec.ForProvider.Replicas)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAutoScalingFailed)
+}
+
+func (e *external) isReplicaAutoScalingUpToDate(ctx context.Context, cr *v1alpha1.DynamoTable) (bool, error) {
+	rsp, err := e.client.DescribeTableReplicaAutoScalingRequest(&awsdynamo.DescribeTableReplicaAutoScalingInput{
+		TableName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, errDescribeAutoScalingFailed)
+	}
+	return dynamodb.IsReplicaAutoScalingUpToDate(cr.Spec.ForProvider.Replicas, rsp.TableAutoScalingDescription), nil
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.DynamoTable)
 	if !ok {
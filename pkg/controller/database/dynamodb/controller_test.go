@@ -73,6 +73,10 @@ func withStatus(s v1alpha1.DynamoTableObservation) tableModifier {
 	return func(r *v1alpha1.DynamoTable) { r.Status.AtProvider = s }
 }
 
+func withReplicas(r ...v1alpha1.Replica) tableModifier {
+	return func(t *v1alpha1.DynamoTable) { t.Spec.ForProvider.Replicas = r }
+}
+
 func table(m ...tableModifier) *v1alpha1.DynamoTable {
 	cr := &v1alpha1.DynamoTable{
 		Spec: v1alpha1.DynamoTableSpec{
@@ -487,6 +491,72 @@ func TestUpdate(t *testing.T) {
 				})),
 			},
 		},
+		"AddReplica": {
+			args: args{
+				dynamo: &fake.MockDynamoClient{
+					MockDescribe: func(input *awsdynamo.DescribeTableInput) awsdynamo.DescribeTableRequest {
+						return awsdynamo.DescribeTableRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdynamo.DescribeTableOutput{
+								Table: &awsdynamo.TableDescription{},
+							}},
+						}
+					},
+					MockUpdate: func(input *awsdynamo.UpdateTableInput) awsdynamo.UpdateTableRequest {
+						want := []awsdynamo.ReplicationGroupUpdate{{Create: &awsdynamo.CreateReplicationGroupMemberAction{RegionName: aws.String("eu-west-1")}}}
+						if diff := cmp.Diff(want, input.ReplicaUpdates); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdynamo.UpdateTableRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdynamo.UpdateTableOutput{}},
+						}
+					},
+				},
+				cr: table(withReplicas(v1alpha1.Replica{RegionName: "eu-west-1"})),
+			},
+			want: want{
+				cr: table(withReplicas(v1alpha1.Replica{RegionName: "eu-west-1"})),
+			},
+		},
+		"UpdateReplicaAutoScaling": {
+			args: args{
+				dynamo: &fake.MockDynamoClient{
+					MockDescribe: func(input *awsdynamo.DescribeTableInput) awsdynamo.DescribeTableRequest {
+						return awsdynamo.DescribeTableRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdynamo.DescribeTableOutput{
+								Table: &awsdynamo.TableDescription{
+									Replicas: []awsdynamo.ReplicaDescription{{RegionName: aws.String("eu-west-1")}},
+								},
+							}},
+						}
+					},
+					MockDescribeReplicaAutoScaling: func(input *awsdynamo.DescribeTableReplicaAutoScalingInput) awsdynamo.DescribeTableReplicaAutoScalingRequest {
+						return awsdynamo.DescribeTableReplicaAutoScalingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdynamo.DescribeTableReplicaAutoScalingOutput{
+								TableAutoScalingDescription: &awsdynamo.TableAutoScalingDescription{},
+							}},
+						}
+					},
+					MockUpdateReplicaAutoScaling: func(input *awsdynamo.UpdateTableReplicaAutoScalingInput) awsdynamo.UpdateTableReplicaAutoScalingRequest {
+						if len(input.ReplicaUpdates) != 1 {
+							t.Errorf("r: want 1 replica update, got %d", len(input.ReplicaUpdates))
+						}
+						return awsdynamo.UpdateTableReplicaAutoScalingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdynamo.UpdateTableReplicaAutoScalingOutput{}},
+						}
+					},
+				},
+				cr: table(withReplicas(v1alpha1.Replica{
+					RegionName:              "eu-west-1",
+					ReadCapacityAutoScaling: &v1alpha1.ReplicaAutoScaling{MinimumUnits: 5, MaximumUnits: 50, TargetUtilizationPercent: 70},
+				})),
+			},
+			want: want{
+				cr: table(withReplicas(v1alpha1.Replica{
+					RegionName:              "eu-west-1",
+					ReadCapacityAutoScaling: &v1alpha1.ReplicaAutoScaling{MinimumUnits: 5, MaximumUnits: 50, TargetUtilizationPercent: 70},
+				})),
+			},
+		},
 		"FailedModify": {
 			args: args{
 				dynamo: &fake.MockDynamoClient{
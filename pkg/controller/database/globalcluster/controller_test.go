@@ -0,0 +1,637 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalcluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
+	"github.com/crossplane/provider-aws/pkg/clients/rds/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	clusterID  = "my-global-database"
+	clusterARN = "arn:aws:rds::123456789012:global-cluster:my-global-database"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client rds.GlobalClusterClient
+	kube   client.Client
+	cr     *v1alpha1.GlobalCluster
+}
+
+type clusterModifier func(*v1alpha1.GlobalCluster)
+
+func withConditions(c ...runtimev1alpha1.Condition) clusterModifier {
+	return func(r *v1alpha1.GlobalCluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.GlobalClusterParameters) clusterModifier {
+	return func(r *v1alpha1.GlobalCluster) { r.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.GlobalClusterObservation) clusterModifier {
+	return func(r *v1alpha1.GlobalCluster) { r.Status.AtProvider = o }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.GlobalCluster {
+	cr := &v1alpha1.GlobalCluster{
+		Spec: v1alpha1.GlobalClusterSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.GlobalClusterParameters{
+				Engine: aws.String("aurora-postgresql"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, clusterID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func fullSpec() v1alpha1.GlobalClusterParameters {
+	return v1alpha1.GlobalClusterParameters{
+		Engine:             aws.String("aurora-postgresql"),
+		EngineVersion:      aws.String("11.7"),
+		DatabaseName:       aws.String("app"),
+		StorageEncrypted:   aws.Bool(true),
+		DeletionProtection: aws.Bool(false),
+	}
+}
+
+func observed(status string) awsrds.GlobalCluster {
+	return awsrds.GlobalCluster{
+		GlobalClusterIdentifier: aws.String(clusterID),
+		GlobalClusterArn:        aws.String(clusterARN),
+		Engine:                  aws.String("aurora-postgresql"),
+		EngineVersion:           aws.String("11.7"),
+		DatabaseName:            aws.String("app"),
+		StorageEncrypted:        aws.Bool(true),
+		DeletionProtection:      aws.Bool(false),
+		Status:                  aws.String(status),
+	}
+}
+
+func describe(g ...awsrds.GlobalCluster) func(*awsrds.DescribeGlobalClustersInput) awsrds.DescribeGlobalClustersRequest {
+	return func(*awsrds.DescribeGlobalClustersInput) awsrds.DescribeGlobalClustersRequest {
+		return awsrds.DescribeGlobalClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeGlobalClustersOutput{GlobalClusters: g}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (rds.GlobalClusterClient, error)
+		cr          *v1alpha1.GlobalCluster
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i rds.GlobalClusterClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: cluster(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i rds.GlobalClusterClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: cluster(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GlobalCluster
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockGlobalClusterClient{
+					MockDescribeGlobalClusters: describe(observed("available")),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(
+					withSpec(fullSpec()),
+					withObservation(v1alpha1.GlobalClusterObservation{ARN: clusterARN, Status: "available"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SourceClusterSettingsNotLateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockGlobalClusterClient{
+					MockDescribeGlobalClusters: describe(observed("creating")),
+				},
+				cr: cluster(withSpec(v1alpha1.GlobalClusterParameters{SourceDBClusterIdentifier: aws.String("arn")})),
+			},
+			want: want{
+				cr: cluster(
+					withSpec(v1alpha1.GlobalClusterParameters{SourceDBClusterIdentifier: aws.String("arn"), DeletionProtection: aws.Bool(false)}),
+					withObservation(v1alpha1.GlobalClusterObservation{ARN: clusterARN, Status: "creating"}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeletionProtectionChanged": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockDescribeGlobalClusters: describe(observed("available")),
+				},
+				cr: cluster(withSpec(func() v1alpha1.GlobalClusterParameters {
+					p := fullSpec()
+					p.DeletionProtection = aws.Bool(true)
+					return p
+				}())),
+			},
+			want: want{
+				cr: cluster(withSpec(func() v1alpha1.GlobalClusterParameters {
+					p := fullSpec()
+					p.DeletionProtection = aws.Bool(true)
+					return p
+				}()),
+					withObservation(v1alpha1.GlobalClusterObservation{ARN: clusterARN, Status: "available"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockDescribeGlobalClusters: func(*awsrds.DescribeGlobalClustersInput) awsrds.DescribeGlobalClustersRequest {
+						return awsrds.DescribeGlobalClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsrds.ErrCodeGlobalClusterNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockDescribeGlobalClusters: func(*awsrds.DescribeGlobalClustersInput) awsrds.DescribeGlobalClustersRequest {
+						return awsrds.DescribeGlobalClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GlobalCluster
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockCreateGlobalCluster: func(in *awsrds.CreateGlobalClusterInput) awsrds.CreateGlobalClusterRequest {
+						if diff := cmp.Diff(clusterID, aws.StringValue(in.GlobalClusterIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.CreateGlobalClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateGlobalClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockCreateGlobalCluster: func(*awsrds.CreateGlobalClusterInput) awsrds.CreateGlobalClusterRequest {
+						return awsrds.CreateGlobalClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.GlobalCluster
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockModifyGlobalCluster: func(in *awsrds.ModifyGlobalClusterInput) awsrds.ModifyGlobalClusterRequest {
+						if !aws.BoolValue(in.DeletionProtection) {
+							t.Errorf("deletion protection is not enabled")
+						}
+						return awsrds.ModifyGlobalClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyGlobalClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(withSpec(v1alpha1.GlobalClusterParameters{DeletionProtection: aws.Bool(true)})),
+			},
+			want: want{
+				cr: cluster(withSpec(v1alpha1.GlobalClusterParameters{DeletionProtection: aws.Bool(true)})),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockModifyGlobalCluster: func(*awsrds.ModifyGlobalClusterInput) awsrds.ModifyGlobalClusterRequest {
+						return awsrds.ModifyGlobalClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.GlobalCluster
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockDeleteGlobalCluster: func(*awsrds.DeleteGlobalClusterInput) awsrds.DeleteGlobalClusterRequest {
+						return awsrds.DeleteGlobalClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteGlobalClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: cluster(withObservation(v1alpha1.GlobalClusterObservation{Status: "deleting"})),
+			},
+			want: want{
+				cr: cluster(
+					withObservation(v1alpha1.GlobalClusterObservation{Status: "deleting"}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockDeleteGlobalCluster: func(*awsrds.DeleteGlobalClusterInput) awsrds.DeleteGlobalClusterRequest {
+						return awsrds.DeleteGlobalClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsrds.ErrCodeGlobalClusterNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockGlobalClusterClient{
+					MockDeleteGlobalCluster: func(*awsrds.DeleteGlobalClusterInput) awsrds.DeleteGlobalClusterRequest {
+						return awsrds.DeleteGlobalClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
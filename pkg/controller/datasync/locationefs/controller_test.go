@@ -0,0 +1,510 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locationefs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdatasync "github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	locationARN = "arn:aws:datasync:us-east-1:123456789012:location/loc-0123456789abcdef0"
+	locationURI = "efs://us-east-1.fs-12345678/"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsdatasync.ErrCodeInvalidRequestException, "not found", nil)
+)
+
+type args struct {
+	client datasync.LocationEFSClient
+	kube   client.Client
+	cr     *v1alpha1.LocationEFS
+}
+
+type locationModifier func(*v1alpha1.LocationEFS)
+
+func withConditions(c ...runtimev1alpha1.Condition) locationModifier {
+	return func(r *v1alpha1.LocationEFS) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) locationModifier {
+	return func(r *v1alpha1.LocationEFS) { meta.SetExternalName(r, s) }
+}
+
+func withLocationURI(s string) locationModifier {
+	return func(r *v1alpha1.LocationEFS) { r.Status.AtProvider.LocationURI = s }
+}
+
+func locationEFS(m ...locationModifier) *v1alpha1.LocationEFS {
+	cr := &v1alpha1.LocationEFS{
+		Spec: v1alpha1.LocationEFSSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.LocationEFSParameters{
+				EFSFilesystemARN:  "arn:aws:elasticfilesystem:us-east-1:123456789012:file-system/fs-12345678",
+				SubnetARN:         "arn:aws:ec2:us-east-1:123456789012:subnet/subnet-12345678",
+				SecurityGroupARNs: []string{"arn:aws:ec2:us-east-1:123456789012:security-group/sg-12345678"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (datasync.LocationEFSClient, error)
+		cr          *v1alpha1.LocationEFS
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i datasync.LocationEFSClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: locationEFS(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i datasync.LocationEFSClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: locationEFS(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: locationEFS(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: locationEFS(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: locationEFS(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LocationEFS
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: locationEFS(),
+			},
+			want: want{
+				cr: locationEFS(),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockLocationEFSClient{
+					MockDescribeLocationEfs: func(input *awsdatasync.DescribeLocationEfsInput) awsdatasync.DescribeLocationEfsRequest {
+						if diff := cmp.Diff(locationARN, aws.StringValue(input.LocationArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.DescribeLocationEfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DescribeLocationEfsOutput{
+								LocationArn: aws.String(locationARN),
+								LocationUri: aws.String(locationURI),
+							}},
+						}
+					},
+				},
+				cr: locationEFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: locationEFS(
+					withExternalName(locationARN),
+					withLocationURI(locationURI),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockLocationEFSClient{
+					MockDescribeLocationEfs: func(*awsdatasync.DescribeLocationEfsInput) awsdatasync.DescribeLocationEfsRequest {
+						return awsdatasync.DescribeLocationEfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: locationEFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: locationEFS(withExternalName(locationARN)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockLocationEFSClient{
+					MockDescribeLocationEfs: func(*awsdatasync.DescribeLocationEfsInput) awsdatasync.DescribeLocationEfsRequest {
+						return awsdatasync.DescribeLocationEfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: locationEFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr:  locationEFS(withExternalName(locationARN)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LocationEFS
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockLocationEFSClient{
+					MockCreateLocationEfs: func(input *awsdatasync.CreateLocationEfsInput) awsdatasync.CreateLocationEfsRequest {
+						if diff := cmp.Diff("arn:aws:ec2:us-east-1:123456789012:subnet/subnet-12345678", aws.StringValue(input.Ec2Config.SubnetArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.CreateLocationEfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.CreateLocationEfsOutput{
+								LocationArn: aws.String(locationARN),
+							}},
+						}
+					},
+				},
+				cr: locationEFS(),
+			},
+			want: want{
+				cr: locationEFS(withExternalName(locationARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLocationEFSClient{
+					MockCreateLocationEfs: func(*awsdatasync.CreateLocationEfsInput) awsdatasync.CreateLocationEfsRequest {
+						return awsdatasync.CreateLocationEfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: locationEFS(),
+			},
+			want: want{
+				cr:  locationEFS(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LocationEFS
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLocationEFSClient{
+					MockDeleteLocation: func(input *awsdatasync.DeleteLocationInput) awsdatasync.DeleteLocationRequest {
+						if diff := cmp.Diff(locationARN, aws.StringValue(input.LocationArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.DeleteLocationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DeleteLocationOutput{}},
+						}
+					},
+				},
+				cr: locationEFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: locationEFS(withExternalName(locationARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockLocationEFSClient{
+					MockDeleteLocation: func(*awsdatasync.DeleteLocationInput) awsdatasync.DeleteLocationRequest {
+						return awsdatasync.DeleteLocationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: locationEFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: locationEFS(withExternalName(locationARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLocationEFSClient{
+					MockDeleteLocation: func(*awsdatasync.DeleteLocationInput) awsdatasync.DeleteLocationRequest {
+						return awsdatasync.DeleteLocationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: locationEFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr:  locationEFS(withExternalName(locationARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,510 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locationnfs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdatasync "github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	locationARN = "arn:aws:datasync:us-east-1:123456789012:location/loc-0123456789abcdef0"
+	locationURI = "nfs://10.0.0.10/exports/"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsdatasync.ErrCodeInvalidRequestException, "not found", nil)
+)
+
+type args struct {
+	client datasync.LocationNFSClient
+	kube   client.Client
+	cr     *v1alpha1.LocationNFS
+}
+
+type locationModifier func(*v1alpha1.LocationNFS)
+
+func withConditions(c ...runtimev1alpha1.Condition) locationModifier {
+	return func(r *v1alpha1.LocationNFS) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) locationModifier {
+	return func(r *v1alpha1.LocationNFS) { meta.SetExternalName(r, s) }
+}
+
+func withLocationURI(s string) locationModifier {
+	return func(r *v1alpha1.LocationNFS) { r.Status.AtProvider.LocationURI = s }
+}
+
+func locationNFS(m ...locationModifier) *v1alpha1.LocationNFS {
+	cr := &v1alpha1.LocationNFS{
+		Spec: v1alpha1.LocationNFSSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.LocationNFSParameters{
+				ServerHostname: "10.0.0.10",
+				Subdirectory:   "/exports",
+				AgentARNs:      []string{"arn:aws:datasync:us-east-1:123456789012:agent/agent-0123456789abcdef0"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (datasync.LocationNFSClient, error)
+		cr          *v1alpha1.LocationNFS
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i datasync.LocationNFSClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: locationNFS(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i datasync.LocationNFSClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: locationNFS(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: locationNFS(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: locationNFS(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: locationNFS(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LocationNFS
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: locationNFS(),
+			},
+			want: want{
+				cr: locationNFS(),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockLocationNFSClient{
+					MockDescribeLocationNfs: func(input *awsdatasync.DescribeLocationNfsInput) awsdatasync.DescribeLocationNfsRequest {
+						if diff := cmp.Diff(locationARN, aws.StringValue(input.LocationArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.DescribeLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DescribeLocationNfsOutput{
+								LocationArn: aws.String(locationARN),
+								LocationUri: aws.String(locationURI),
+							}},
+						}
+					},
+				},
+				cr: locationNFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: locationNFS(
+					withExternalName(locationARN),
+					withLocationURI(locationURI),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockLocationNFSClient{
+					MockDescribeLocationNfs: func(*awsdatasync.DescribeLocationNfsInput) awsdatasync.DescribeLocationNfsRequest {
+						return awsdatasync.DescribeLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: locationNFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: locationNFS(withExternalName(locationARN)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockLocationNFSClient{
+					MockDescribeLocationNfs: func(*awsdatasync.DescribeLocationNfsInput) awsdatasync.DescribeLocationNfsRequest {
+						return awsdatasync.DescribeLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: locationNFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr:  locationNFS(withExternalName(locationARN)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LocationNFS
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockLocationNFSClient{
+					MockCreateLocationNfs: func(input *awsdatasync.CreateLocationNfsInput) awsdatasync.CreateLocationNfsRequest {
+						if diff := cmp.Diff("/exports", aws.StringValue(input.Subdirectory)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.CreateLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.CreateLocationNfsOutput{
+								LocationArn: aws.String(locationARN),
+							}},
+						}
+					},
+				},
+				cr: locationNFS(),
+			},
+			want: want{
+				cr: locationNFS(withExternalName(locationARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLocationNFSClient{
+					MockCreateLocationNfs: func(*awsdatasync.CreateLocationNfsInput) awsdatasync.CreateLocationNfsRequest {
+						return awsdatasync.CreateLocationNfsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: locationNFS(),
+			},
+			want: want{
+				cr:  locationNFS(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LocationNFS
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLocationNFSClient{
+					MockDeleteLocation: func(input *awsdatasync.DeleteLocationInput) awsdatasync.DeleteLocationRequest {
+						if diff := cmp.Diff(locationARN, aws.StringValue(input.LocationArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.DeleteLocationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DeleteLocationOutput{}},
+						}
+					},
+				},
+				cr: locationNFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: locationNFS(withExternalName(locationARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockLocationNFSClient{
+					MockDeleteLocation: func(*awsdatasync.DeleteLocationInput) awsdatasync.DeleteLocationRequest {
+						return awsdatasync.DeleteLocationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: locationNFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr: locationNFS(withExternalName(locationARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLocationNFSClient{
+					MockDeleteLocation: func(*awsdatasync.DeleteLocationInput) awsdatasync.DeleteLocationRequest {
+						return awsdatasync.DeleteLocationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: locationNFS(withExternalName(locationARN)),
+			},
+			want: want{
+				cr:  locationNFS(withExternalName(locationARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,661 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package task
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdatasync "github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/datasync/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync"
+	"github.com/crossplane/provider-aws/pkg/clients/datasync/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	taskARN     = "arn:aws:datasync:us-east-1:123456789012:task/task-0123456789abcdef0"
+	sourceARN   = "arn:aws:datasync:us-east-1:123456789012:location/loc-0123456789abcdef0"
+	destARN     = "arn:aws:datasync:us-east-1:123456789012:location/loc-0123456789abcdef1"
+	schedule    = "rate(1 day)"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsdatasync.ErrCodeInvalidRequestException, "not found", nil)
+)
+
+type args struct {
+	client datasync.TaskClient
+	kube   client.Client
+	cr     *v1alpha1.Task
+}
+
+type taskModifier func(*v1alpha1.Task)
+
+func withConditions(c ...runtimev1alpha1.Condition) taskModifier {
+	return func(r *v1alpha1.Task) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) taskModifier {
+	return func(r *v1alpha1.Task) { meta.SetExternalName(r, s) }
+}
+
+func withStatus(s string) taskModifier {
+	return func(r *v1alpha1.Task) { r.Status.AtProvider.Status = s }
+}
+
+func withSchedule(s string) taskModifier {
+	return func(r *v1alpha1.Task) { r.Spec.ForProvider.ScheduleExpression = &s }
+}
+
+func withBytesPerSecond(b int64) taskModifier {
+	return func(r *v1alpha1.Task) {
+		if r.Spec.ForProvider.Options == nil {
+			r.Spec.ForProvider.Options = &v1alpha1.TaskOptions{}
+		}
+		r.Spec.ForProvider.Options.BytesPerSecond = &b
+	}
+}
+
+func task(m ...taskModifier) *v1alpha1.Task {
+	cr := &v1alpha1.Task{
+		Spec: v1alpha1.TaskSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.TaskParameters{
+				Name:                   aws.String("nightly"),
+				SourceLocationARN:      sourceARN,
+				DestinationLocationARN: destARN,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeTask(status awsdatasync.TaskStatus, bytesPerSecond int64) func(*awsdatasync.DescribeTaskInput) awsdatasync.DescribeTaskRequest {
+	return func(*awsdatasync.DescribeTaskInput) awsdatasync.DescribeTaskRequest {
+		return awsdatasync.DescribeTaskRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DescribeTaskOutput{
+				TaskArn:                aws.String(taskARN),
+				Name:                   aws.String("nightly"),
+				SourceLocationArn:      aws.String(sourceARN),
+				DestinationLocationArn: aws.String(destARN),
+				Schedule:               &awsdatasync.TaskSchedule{ScheduleExpression: aws.String(schedule)},
+				Options:                &awsdatasync.Options{BytesPerSecond: aws.Int64(bytesPerSecond)},
+				Status:                 status,
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (datasync.TaskClient, error)
+		cr          *v1alpha1.Task
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i datasync.TaskClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: task(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i datasync.TaskClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: task(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: task(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: task(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: task(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Task
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: task(),
+			},
+			want: want{
+				cr: task(),
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockTaskClient{
+					MockDescribeTask: describeTask(awsdatasync.TaskStatusAvailable, -1),
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(
+					withExternalName(taskARN),
+					withSchedule(schedule),
+					withBytesPerSecond(-1),
+					withStatus(string(awsdatasync.TaskStatusAvailable)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Running": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockDescribeTask: describeTask(awsdatasync.TaskStatusRunning, -1),
+				},
+				cr: task(withExternalName(taskARN), withSchedule(schedule), withBytesPerSecond(-1)),
+			},
+			want: want{
+				cr: task(
+					withExternalName(taskARN),
+					withSchedule(schedule),
+					withBytesPerSecond(-1),
+					withStatus(string(awsdatasync.TaskStatusRunning)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockDescribeTask: describeTask(awsdatasync.TaskStatusAvailable, -1),
+				},
+				cr: task(withExternalName(taskARN), withSchedule(schedule), withBytesPerSecond(1048576)),
+			},
+			want: want{
+				cr: task(
+					withExternalName(taskARN),
+					withSchedule(schedule),
+					withBytesPerSecond(1048576),
+					withStatus(string(awsdatasync.TaskStatusAvailable)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Unavailable": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockDescribeTask: describeTask(awsdatasync.TaskStatusUnavailable, -1),
+				},
+				cr: task(withExternalName(taskARN), withSchedule(schedule), withBytesPerSecond(-1)),
+			},
+			want: want{
+				cr: task(
+					withExternalName(taskARN),
+					withSchedule(schedule),
+					withBytesPerSecond(-1),
+					withStatus(string(awsdatasync.TaskStatusUnavailable)),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockDescribeTask: func(*awsdatasync.DescribeTaskInput) awsdatasync.DescribeTaskRequest {
+						return awsdatasync.DescribeTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockDescribeTask: func(*awsdatasync.DescribeTaskInput) awsdatasync.DescribeTaskRequest {
+						return awsdatasync.DescribeTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr:  task(withExternalName(taskARN)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Task
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockTaskClient{
+					MockCreateTask: func(input *awsdatasync.CreateTaskInput) awsdatasync.CreateTaskRequest {
+						if diff := cmp.Diff(schedule, aws.StringValue(input.Schedule.ScheduleExpression)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.CreateTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.CreateTaskOutput{
+								TaskArn: aws.String(taskARN),
+							}},
+						}
+					},
+				},
+				cr: task(withSchedule(schedule)),
+			},
+			want: want{
+				cr: task(withSchedule(schedule), withExternalName(taskARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockCreateTask: func(*awsdatasync.CreateTaskInput) awsdatasync.CreateTaskRequest {
+						return awsdatasync.CreateTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: task(),
+			},
+			want: want{
+				cr:  task(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Task
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockUpdateTask: func(input *awsdatasync.UpdateTaskInput) awsdatasync.UpdateTaskRequest {
+						if diff := cmp.Diff(taskARN, aws.StringValue(input.TaskArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(int64(1048576), aws.Int64Value(input.Options.BytesPerSecond)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdatasync.UpdateTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.UpdateTaskOutput{}},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN), withBytesPerSecond(1048576)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withBytesPerSecond(1048576)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockUpdateTask: func(*awsdatasync.UpdateTaskInput) awsdatasync.UpdateTaskRequest {
+						return awsdatasync.UpdateTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr:  task(withExternalName(taskARN)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Task
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockDeleteTask: func(*awsdatasync.DeleteTaskInput) awsdatasync.DeleteTaskRequest {
+						return awsdatasync.DeleteTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdatasync.DeleteTaskOutput{}},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockDeleteTask: func(*awsdatasync.DeleteTaskInput) awsdatasync.DeleteTaskRequest {
+						return awsdatasync.DeleteTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr: task(withExternalName(taskARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockTaskClient{
+					MockDeleteTask: func(*awsdatasync.DeleteTaskInput) awsdatasync.DeleteTaskRequest {
+						return awsdatasync.DeleteTaskRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: task(withExternalName(taskARN)),
+			},
+			want: want{
+				cr:  task(withExternalName(taskARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
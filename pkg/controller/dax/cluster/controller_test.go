@@ -0,0 +1,652 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdax "github.com/aws/aws-sdk-go-v2/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dax"
+	"github.com/crossplane/provider-aws/pkg/clients/dax/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	clusterName = "my-cluster"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	client dax.ClusterClient
+	kube   client.Client
+	cr     *v1alpha1.Cluster
+}
+
+type clusterModifier func(*v1alpha1.Cluster)
+
+func withConditions(c ...runtimev1alpha1.Condition) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ClusterParameters) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.ClusterObservation) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Status.AtProvider = o }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.Cluster {
+	cr := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ClusterParameters{
+				NodeType:          "dax.r4.large",
+				ReplicationFactor: 3,
+				IAMRoleARN:        aws.String("arn:aws:iam::123456789012:role/dax"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, clusterName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func fullSpec() v1alpha1.ClusterParameters {
+	return v1alpha1.ClusterParameters{
+		NodeType:                   "dax.r4.large",
+		ReplicationFactor:          3,
+		IAMRoleARN:                 aws.String("arn:aws:iam::123456789012:role/dax"),
+		SubnetGroupName:            aws.String("default"),
+		ParameterGroupName:         aws.String("default.dax1.0"),
+		PreferredMaintenanceWindow: aws.String("sun:05:00-sun:06:00"),
+		SecurityGroupIDs:           []string{"sg-1"},
+		SSEEnabled:                 aws.Bool(false),
+	}
+}
+
+func observed(status string, nodes int64) awsdax.Cluster {
+	return awsdax.Cluster{
+		ClusterName:                aws.String(clusterName),
+		ClusterArn:                 aws.String("arn"),
+		Status:                     aws.String(status),
+		NodeType:                   aws.String("dax.r4.large"),
+		IamRoleArn:                 aws.String("arn:aws:iam::123456789012:role/dax"),
+		SubnetGroup:                aws.String("default"),
+		ParameterGroup:             &awsdax.ParameterGroupStatus{ParameterGroupName: aws.String("default.dax1.0")},
+		PreferredMaintenanceWindow: aws.String("sun:05:00-sun:06:00"),
+		SecurityGroups:             []awsdax.SecurityGroupMembership{{SecurityGroupIdentifier: aws.String("sg-1")}},
+		SSEDescription:             &awsdax.SSEDescription{Status: awsdax.SSEStatusDisabled},
+		ClusterDiscoveryEndpoint:   &awsdax.Endpoint{Address: aws.String("my-cluster.dax.amazonaws.com"), Port: aws.Int64(8111)},
+		TotalNodes:                 aws.Int64(nodes),
+		ActiveNodes:                aws.Int64(nodes),
+	}
+}
+
+func observation(status string, nodes int64) v1alpha1.ClusterObservation {
+	return v1alpha1.ClusterObservation{
+		ClusterARN:               "arn",
+		Status:                   status,
+		ClusterDiscoveryEndpoint: v1alpha1.Endpoint{Address: "my-cluster.dax.amazonaws.com", Port: 8111},
+		TotalNodes:               nodes,
+		ActiveNodes:              nodes,
+		SSEStatus:                string(awsdax.SSEStatusDisabled),
+	}
+}
+
+func describe(c ...awsdax.Cluster) func(*awsdax.DescribeClustersInput) awsdax.DescribeClustersRequest {
+	return func(*awsdax.DescribeClustersInput) awsdax.DescribeClustersRequest {
+		return awsdax.DescribeClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdax.DescribeClustersOutput{Clusters: c}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (dax.ClusterClient, error)
+		cr          *v1alpha1.Cluster
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i dax.ClusterClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: cluster(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i dax.ClusterClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: cluster(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Cluster
+		result managed.ExternalObservation
+		err    error
+	}
+
+	connection := managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte("my-cluster.dax.amazonaws.com"),
+		runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte("8111"),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockClusterClient{
+					MockDescribeClusters: describe(observed("available", 3)),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(
+					withSpec(fullSpec()),
+					withObservation(observation("available", 3)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"ReplicationFactorChanged": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDescribeClusters: describe(observed("available", 2)),
+				},
+				cr: cluster(withSpec(fullSpec())),
+			},
+			want: want{
+				cr: cluster(
+					withSpec(fullSpec()),
+					withObservation(observation("available", 2)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDescribeClusters: func(*awsdax.DescribeClustersInput) awsdax.DescribeClustersRequest {
+						return awsdax.DescribeClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsdax.ErrCodeClusterNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDescribeClusters: func(*awsdax.DescribeClustersInput) awsdax.DescribeClustersRequest {
+						return awsdax.DescribeClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Cluster
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockCreateCluster: func(in *awsdax.CreateClusterInput) awsdax.CreateClusterRequest {
+						if diff := cmp.Diff(clusterName, aws.StringValue(in.ClusterName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdax.CreateClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdax.CreateClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockCreateCluster: func(*awsdax.CreateClusterInput) awsdax.CreateClusterRequest {
+						return awsdax.CreateClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Cluster
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"IncreaseReplicationFactor": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockIncreaseReplicationFactor: func(in *awsdax.IncreaseReplicationFactorInput) awsdax.IncreaseReplicationFactorRequest {
+						if diff := cmp.Diff(int64(3), aws.Int64Value(in.NewReplicationFactor)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdax.IncreaseReplicationFactorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdax.IncreaseReplicationFactorOutput{}},
+						}
+					},
+				},
+				cr: cluster(withObservation(v1alpha1.ClusterObservation{TotalNodes: 2})),
+			},
+			want: want{
+				cr: cluster(withObservation(v1alpha1.ClusterObservation{TotalNodes: 2})),
+			},
+		},
+		"DecreaseReplicationFactor": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDecreaseReplicationFactor: func(in *awsdax.DecreaseReplicationFactorInput) awsdax.DecreaseReplicationFactorRequest {
+						return awsdax.DecreaseReplicationFactorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(withObservation(v1alpha1.ClusterObservation{TotalNodes: 5})),
+			},
+			want: want{
+				cr:  cluster(withObservation(v1alpha1.ClusterObservation{TotalNodes: 5})),
+				err: errors.Wrap(errBoom, errReplicationFactor),
+			},
+		},
+		"UpdateSettings": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockUpdateCluster: func(in *awsdax.UpdateClusterInput) awsdax.UpdateClusterRequest {
+						return awsdax.UpdateClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdax.UpdateClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(withObservation(v1alpha1.ClusterObservation{TotalNodes: 3})),
+			},
+			want: want{
+				cr: cluster(withObservation(v1alpha1.ClusterObservation{TotalNodes: 3})),
+			},
+		},
+		"FailedUpdateSettings": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockUpdateCluster: func(in *awsdax.UpdateClusterInput) awsdax.UpdateClusterRequest {
+						return awsdax.UpdateClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(withObservation(v1alpha1.ClusterObservation{TotalNodes: 3})),
+			},
+			want: want{
+				cr:  cluster(withObservation(v1alpha1.ClusterObservation{TotalNodes: 3})),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Cluster
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDeleteCluster: func(*awsdax.DeleteClusterInput) awsdax.DeleteClusterRequest {
+						return awsdax.DeleteClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdax.DeleteClusterOutput{}},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: cluster(withObservation(v1alpha1.ClusterObservation{Status: "deleting"})),
+			},
+			want: want{
+				cr: cluster(
+					withObservation(v1alpha1.ClusterObservation{Status: "deleting"}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClusterClient{
+					MockDeleteCluster: func(*awsdax.DeleteClusterInput) awsdax.DeleteClusterRequest {
+						return awsdax.DeleteClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,573 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnetgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdax "github.com/aws/aws-sdk-go-v2/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dax"
+	"github.com/crossplane/provider-aws/pkg/clients/dax/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	groupName = "my-subnet-group"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client dax.SubnetGroupClient
+	kube   client.Client
+	cr     *v1alpha1.SubnetGroup
+}
+
+type groupModifier func(*v1alpha1.SubnetGroup)
+
+func withConditions(c ...runtimev1alpha1.Condition) groupModifier {
+	return func(r *v1alpha1.SubnetGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.SubnetGroupParameters) groupModifier {
+	return func(r *v1alpha1.SubnetGroup) { r.Spec.ForProvider = p }
+}
+
+func withVPC(id string) groupModifier {
+	return func(r *v1alpha1.SubnetGroup) { r.Status.AtProvider.VPCID = id }
+}
+
+func subnetGroup(m ...groupModifier) *v1alpha1.SubnetGroup {
+	cr := &v1alpha1.SubnetGroup{
+		Spec: v1alpha1.SubnetGroupSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(g ...awsdax.SubnetGroup) func(*awsdax.DescribeSubnetGroupsInput) awsdax.DescribeSubnetGroupsRequest {
+	return func(*awsdax.DescribeSubnetGroupsInput) awsdax.DescribeSubnetGroupsRequest {
+		return awsdax.DescribeSubnetGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdax.DescribeSubnetGroupsOutput{SubnetGroups: g}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (dax.SubnetGroupClient, error)
+		cr          *v1alpha1.SubnetGroup
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i dax.SubnetGroupClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: subnetGroup(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i dax.SubnetGroupClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: subnetGroup(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SubnetGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := awsdax.SubnetGroup{
+		SubnetGroupName: aws.String(groupName),
+		Description:     aws.String("desc"),
+		VpcId:           aws.String("vpc-1"),
+		Subnets:         []awsdax.Subnet{{SubnetIdentifier: aws.String("subnet-2")}, {SubnetIdentifier: aws.String("subnet-1")}},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockSubnetGroupClient{
+					MockDescribeSubnetGroups: describe(observed),
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr: subnetGroup(
+					withSpec(v1alpha1.SubnetGroupParameters{Description: aws.String("desc"), SubnetIDs: []string{"subnet-2", "subnet-1"}}),
+					withVPC("vpc-1"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SubnetsChanged": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockDescribeSubnetGroups: describe(observed),
+				},
+				cr: subnetGroup(withSpec(v1alpha1.SubnetGroupParameters{Description: aws.String("desc"), SubnetIDs: []string{"subnet-1"}})),
+			},
+			want: want{
+				cr: subnetGroup(
+					withSpec(v1alpha1.SubnetGroupParameters{Description: aws.String("desc"), SubnetIDs: []string{"subnet-1"}}),
+					withVPC("vpc-1"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockDescribeSubnetGroups: func(*awsdax.DescribeSubnetGroupsInput) awsdax.DescribeSubnetGroupsRequest {
+						return awsdax.DescribeSubnetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsdax.ErrCodeSubnetGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr: subnetGroup(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockDescribeSubnetGroups: func(*awsdax.DescribeSubnetGroupsInput) awsdax.DescribeSubnetGroupsRequest {
+						return awsdax.DescribeSubnetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr:  subnetGroup(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SubnetGroup
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockCreateSubnetGroup: func(*awsdax.CreateSubnetGroupInput) awsdax.CreateSubnetGroupRequest {
+						return awsdax.CreateSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdax.CreateSubnetGroupOutput{}},
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr: subnetGroup(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockCreateSubnetGroup: func(*awsdax.CreateSubnetGroupInput) awsdax.CreateSubnetGroupRequest {
+						return awsdax.CreateSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr:  subnetGroup(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SubnetGroup
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockUpdateSubnetGroup: func(*awsdax.UpdateSubnetGroupInput) awsdax.UpdateSubnetGroupRequest {
+						return awsdax.UpdateSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdax.UpdateSubnetGroupOutput{}},
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr: subnetGroup(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockUpdateSubnetGroup: func(*awsdax.UpdateSubnetGroupInput) awsdax.UpdateSubnetGroupRequest {
+						return awsdax.UpdateSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr:  subnetGroup(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SubnetGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockDeleteSubnetGroup: func(*awsdax.DeleteSubnetGroupInput) awsdax.DeleteSubnetGroupRequest {
+						return awsdax.DeleteSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdax.DeleteSubnetGroupOutput{}},
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr: subnetGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockDeleteSubnetGroup: func(*awsdax.DeleteSubnetGroupInput) awsdax.DeleteSubnetGroupRequest {
+						return awsdax.DeleteSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsdax.ErrCodeSubnetGroupNotFoundFault, "", nil)},
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr: subnetGroup(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockSubnetGroupClient{
+					MockDeleteSubnetGroup: func(*awsdax.DeleteSubnetGroupInput) awsdax.DeleteSubnetGroupRequest {
+						return awsdax.DeleteSubnetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: subnetGroup(),
+			},
+			want: want{
+				cr:  subnetGroup(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
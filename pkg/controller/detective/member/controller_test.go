@@ -0,0 +1,570 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdetective "github.com/aws/aws-sdk-go-v2/service/detective"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/detective/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/detective"
+	"github.com/crossplane/provider-aws/pkg/clients/detective/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	graphARN    = "arn:aws:detective:us-east-1:123456789012:graph:027c7c4610ea4aacaf0b883093cab899"
+	accountID   = "210987654321"
+	email       = "security@example.com"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsdetective.ErrCodeResourceNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client detective.MemberClient
+	kube   client.Client
+	cr     *v1alpha1.Member
+}
+
+type memberModifier func(*v1alpha1.Member)
+
+func withConditions(c ...runtimev1alpha1.Condition) memberModifier {
+	return func(r *v1alpha1.Member) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.MemberObservation) memberModifier {
+	return func(r *v1alpha1.Member) { r.Status.AtProvider = o }
+}
+
+func member(m ...memberModifier) *v1alpha1.Member {
+	cr := &v1alpha1.Member{
+		Spec: v1alpha1.MemberSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.MemberParameters{
+				GraphARN:     aws.String(graphARN),
+				AccountID:    accountID,
+				EmailAddress: email,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getMember(status awsdetective.MemberStatus) func(*awsdetective.GetMembersInput) awsdetective.GetMembersRequest {
+	return func(*awsdetective.GetMembersInput) awsdetective.GetMembersRequest {
+		return awsdetective.GetMembersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdetective.GetMembersOutput{
+				MemberDetails: []awsdetective.MemberDetail{{
+					AccountId: aws.String(accountID),
+					GraphArn:  aws.String(graphARN),
+					MasterId:  aws.String("123456789012"),
+					Status:    status,
+				}},
+			}},
+		}
+	}
+}
+
+func observation(status awsdetective.MemberStatus) v1alpha1.MemberObservation {
+	return v1alpha1.MemberObservation{Status: string(status), MasterID: "123456789012"}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (detective.MemberClient, error)
+		cr          *v1alpha1.Member
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i detective.MemberClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: member(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i detective.MemberClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: member(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Member
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Enabled": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockGetMembers: getMember(awsdetective.MemberStatusEnabled),
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(
+					withObservation(observation(awsdetective.MemberStatusEnabled)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Invited": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockGetMembers: getMember(awsdetective.MemberStatusInvited),
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(
+					withObservation(observation(awsdetective.MemberStatusInvited)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"VerificationFailed": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockGetMembers: getMember(awsdetective.MemberStatusVerificationFailed),
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(
+					withObservation(observation(awsdetective.MemberStatusVerificationFailed)),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Unprocessed": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockGetMembers: func(*awsdetective.GetMembersInput) awsdetective.GetMembersRequest {
+						return awsdetective.GetMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdetective.GetMembersOutput{
+								UnprocessedAccounts: []awsdetective.UnprocessedAccount{{AccountId: aws.String(accountID)}},
+							}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(),
+			},
+		},
+		"GraphNotFound": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockGetMembers: func(*awsdetective.GetMembersInput) awsdetective.GetMembersRequest {
+						return awsdetective.GetMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockGetMembers: func(*awsdetective.GetMembersInput) awsdetective.GetMembersRequest {
+						return awsdetective.GetMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr:  member(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Member
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockCreateMembers: func(input *awsdetective.CreateMembersInput) awsdetective.CreateMembersRequest {
+						if diff := cmp.Diff(detective.GenerateCreateMembersInput(member().Spec.ForProvider), input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdetective.CreateMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdetective.CreateMembersOutput{}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Unprocessed": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockCreateMembers: func(*awsdetective.CreateMembersInput) awsdetective.CreateMembersRequest {
+						return awsdetective.CreateMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdetective.CreateMembersOutput{
+								UnprocessedAccounts: []awsdetective.UnprocessedAccount{{AccountId: aws.String(accountID), Reason: aws.String("boom")}},
+							}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr:  member(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errUnprocessed),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockCreateMembers: func(*awsdetective.CreateMembersInput) awsdetective.CreateMembersRequest {
+						return awsdetective.CreateMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr:  member(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Member
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockDeleteMembers: func(input *awsdetective.DeleteMembersInput) awsdetective.DeleteMembersRequest {
+						if diff := cmp.Diff([]string{accountID}, input.AccountIds); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdetective.DeleteMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdetective.DeleteMembersOutput{}},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"GraphNotFound": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockDeleteMembers: func(*awsdetective.DeleteMembersInput) awsdetective.DeleteMembersRequest {
+						return awsdetective.DeleteMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockMemberClient{
+					MockDeleteMembers: func(*awsdetective.DeleteMembersInput) awsdetective.DeleteMembersRequest {
+						return awsdetective.DeleteMembersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr:  member(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,595 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directory
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsdirectoryservice "github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/directoryservice/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/directoryservice"
+	"github.com/crossplane/provider-aws/pkg/clients/directoryservice/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	directoryID   = "d-1234567890"
+	adminPassword = "Sup3rS3cret!"
+	errBoom       = errors.New("boom")
+	errNotFound   = awserr.New(awsdirectoryservice.ErrCodeEntityDoesNotExistException, "not found", nil)
+)
+
+type args struct {
+	client directoryservice.DirectoryClient
+	kube   client.Client
+	cr     *v1alpha1.Directory
+}
+
+type directoryModifier func(*v1alpha1.Directory)
+
+func withConditions(c ...runtimev1alpha1.Condition) directoryModifier {
+	return func(r *v1alpha1.Directory) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) directoryModifier {
+	return func(r *v1alpha1.Directory) { meta.SetExternalName(r, s) }
+}
+
+func withEdition(s string) directoryModifier {
+	return func(r *v1alpha1.Directory) { r.Spec.ForProvider.Edition = &s }
+}
+
+func withObservation(o v1alpha1.DirectoryObservation) directoryModifier {
+	return func(r *v1alpha1.Directory) { r.Status.AtProvider = o }
+}
+
+func directory(m ...directoryModifier) *v1alpha1.Directory {
+	cr := &v1alpha1.Directory{
+		Spec: v1alpha1.DirectorySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DirectoryParameters{
+				Name: "corp.example.com",
+				PasswordSecretRef: runtimev1alpha1.SecretKeySelector{
+					SecretReference: runtimev1alpha1.SecretReference{Namespace: secretNamespace, Name: "admin-password"},
+					Key:             "password",
+				},
+				VPCID:     aws.String("vpc-12345678"),
+				SubnetIDs: []string{"subnet-12345678", "subnet-87654321"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeDirectory(stage awsdirectoryservice.DirectoryStage) func(*awsdirectoryservice.DescribeDirectoriesInput) awsdirectoryservice.DescribeDirectoriesRequest {
+	return func(*awsdirectoryservice.DescribeDirectoriesInput) awsdirectoryservice.DescribeDirectoriesRequest {
+		return awsdirectoryservice.DescribeDirectoriesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdirectoryservice.DescribeDirectoriesOutput{
+				DirectoryDescriptions: []awsdirectoryservice.DirectoryDescription{{
+					DirectoryId: aws.String(directoryID),
+					Name:        aws.String("corp.example.com"),
+					Edition:     awsdirectoryservice.DirectoryEditionStandard,
+					Stage:       stage,
+					DnsIpAddrs:  []string{"10.0.1.10", "10.0.2.10"},
+				}},
+			}},
+		}
+	}
+}
+
+func observation(stage awsdirectoryservice.DirectoryStage) v1alpha1.DirectoryObservation {
+	return v1alpha1.DirectoryObservation{Stage: string(stage), DNSIPAddrs: []string{"10.0.1.10", "10.0.2.10"}}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (directoryservice.DirectoryClient, error)
+		cr          *v1alpha1.Directory
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i directoryservice.DirectoryClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: directory(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i directoryservice.DirectoryClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: directory(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: directory(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: directory(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: directory(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Directory
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: directory(),
+			},
+			want: want{
+				cr: directory(),
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockDirectoryClient{
+					MockDescribeDirectories: describeDirectory(awsdirectoryservice.DirectoryStageActive),
+				},
+				cr: directory(withExternalName(directoryID)),
+			},
+			want: want{
+				cr: directory(
+					withExternalName(directoryID),
+					withEdition("Standard"),
+					withObservation(observation(awsdirectoryservice.DirectoryStageActive)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockDirectoryClient{
+					MockDescribeDirectories: describeDirectory(awsdirectoryservice.DirectoryStageCreating),
+				},
+				cr: directory(withExternalName(directoryID), withEdition("Standard")),
+			},
+			want: want{
+				cr: directory(
+					withExternalName(directoryID),
+					withEdition("Standard"),
+					withObservation(observation(awsdirectoryservice.DirectoryStageCreating)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockDirectoryClient{
+					MockDescribeDirectories: describeDirectory(awsdirectoryservice.DirectoryStageDeleted),
+				},
+				cr: directory(withExternalName(directoryID)),
+			},
+			want: want{
+				cr: directory(withExternalName(directoryID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDirectoryClient{
+					MockDescribeDirectories: func(*awsdirectoryservice.DescribeDirectoriesInput) awsdirectoryservice.DescribeDirectoriesRequest {
+						return awsdirectoryservice.DescribeDirectoriesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: directory(withExternalName(directoryID)),
+			},
+			want: want{
+				cr: directory(withExternalName(directoryID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockDirectoryClient{
+					MockDescribeDirectories: func(*awsdirectoryservice.DescribeDirectoriesInput) awsdirectoryservice.DescribeDirectoriesRequest {
+						return awsdirectoryservice.DescribeDirectoriesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: directory(withExternalName(directoryID)),
+			},
+			want: want{
+				cr:  directory(withExternalName(directoryID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Directory
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key != (client.ObjectKey{Namespace: secretNamespace, Name: "admin-password"}) {
+							return errBoom
+						}
+						obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte(adminPassword)}
+						return nil
+					},
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockDirectoryClient{
+					MockCreateMicrosoftAD: func(input *awsdirectoryservice.CreateMicrosoftADInput) awsdirectoryservice.CreateMicrosoftADRequest {
+						if diff := cmp.Diff(adminPassword, aws.StringValue(input.Password)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdirectoryservice.CreateMicrosoftADRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdirectoryservice.CreateMicrosoftADOutput{
+								DirectoryId: aws.String(directoryID),
+							}},
+						}
+					},
+				},
+				cr: directory(),
+			},
+			want: want{
+				cr: directory(withExternalName(directoryID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedGetPassword": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: directory(),
+			},
+			want: want{
+				cr:  directory(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errGetPassword),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				client: &fake.MockDirectoryClient{
+					MockCreateMicrosoftAD: func(*awsdirectoryservice.CreateMicrosoftADInput) awsdirectoryservice.CreateMicrosoftADRequest {
+						return awsdirectoryservice.CreateMicrosoftADRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: directory(),
+			},
+			want: want{
+				cr:  directory(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Directory
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDirectoryClient{
+					MockDeleteDirectory: func(input *awsdirectoryservice.DeleteDirectoryInput) awsdirectoryservice.DeleteDirectoryRequest {
+						if diff := cmp.Diff(directoryID, aws.StringValue(input.DirectoryId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsdirectoryservice.DeleteDirectoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsdirectoryservice.DeleteDirectoryOutput{}},
+						}
+					},
+				},
+				cr: directory(withExternalName(directoryID)),
+			},
+			want: want{
+				cr: directory(withExternalName(directoryID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: directory(withExternalName(directoryID), withObservation(v1alpha1.DirectoryObservation{Stage: string(awsdirectoryservice.DirectoryStageDeleting)})),
+			},
+			want: want{
+				cr: directory(
+					withExternalName(directoryID),
+					withObservation(v1alpha1.DirectoryObservation{Stage: string(awsdirectoryservice.DirectoryStageDeleting)}),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDirectoryClient{
+					MockDeleteDirectory: func(*awsdirectoryservice.DeleteDirectoryInput) awsdirectoryservice.DeleteDirectoryRequest {
+						return awsdirectoryservice.DeleteDirectoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: directory(withExternalName(directoryID)),
+			},
+			want: want{
+				cr: directory(withExternalName(directoryID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDirectoryClient{
+					MockDeleteDirectory: func(*awsdirectoryservice.DeleteDirectoryInput) awsdirectoryservice.DeleteDirectoryRequest {
+						return awsdirectoryservice.DeleteDirectoryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: directory(withExternalName(directoryID)),
+			},
+			want: want{
+				cr:  directory(withExternalName(directoryID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ebsencryptionbydefault
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an EBSEncryptionByDefault resource"
+	errCreateClient      = "cannot create EC2 client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the EBSEncryptionByDefault custom resource"
+
+	errGet       = "failed to get EBS encryption by default"
+	errGetKey    = "failed to get the default KMS key for EBS encryption"
+	errEnable    = "failed to enable EBS encryption by default"
+	errModifyKey = "failed to modify the default KMS key for EBS encryption"
+	errDisable   = "failed to disable EBS encryption by default"
+	errResetKey  = "failed to reset the default KMS key for EBS encryption"
+)
+
+// SetupEBSEncryptionByDefault adds a controller that reconciles
+// EBSEncryptionByDefaults.
+func SetupEBSEncryptionByDefault(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.EBSEncryptionByDefaultGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha4.EBSEncryptionByDefault{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.EBSEncryptionByDefaultGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewEBSEncryptionByDefaultClient}))))),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.EBSEncryptionByDefaultClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.EBSEncryptionByDefault)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.EBSEncryptionByDefaultClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.EBSEncryptionByDefault)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetEbsEncryptionByDefaultRequest(&awsec2.GetEbsEncryptionByDefaultInput{}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+	}
+	if !aws.BoolValue(rsp.EbsEncryptionByDefault) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	key, err := e.client.GetEbsDefaultKmsKeyIdRequest(&awsec2.GetEbsDefaultKmsKeyIdInput{}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetKey)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeEBSEncryptionByDefault(&cr.Spec.ForProvider, key.KmsKeyId)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsEBSEncryptionByDefaultUpToDate(cr.Spec.ForProvider, key.KmsKeyId),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.EBSEncryptionByDefault)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	if _, err := e.client.EnableEbsEncryptionByDefaultRequest(&awsec2.EnableEbsEncryptionByDefaultInput{}).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errEnable)
+	}
+	if cr.Spec.ForProvider.KMSKeyID == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err := e.client.ModifyEbsDefaultKmsKeyIdRequest(&awsec2.ModifyEbsDefaultKmsKeyIdInput{
+		KmsKeyId: cr.Spec.ForProvider.KMSKeyID,
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errModifyKey)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha4.EBSEncryptionByDefault)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.ModifyEbsDefaultKmsKeyIdRequest(&awsec2.ModifyEbsDefaultKmsKeyIdInput{
+		KmsKeyId: cr.Spec.ForProvider.KMSKeyID,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModifyKey)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.EBSEncryptionByDefault)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	if _, err := e.client.DisableEbsEncryptionByDefaultRequest(&awsec2.DisableEbsEncryptionByDefaultInput{}).Send(ctx); err != nil {
+		return errors.Wrap(err, errDisable)
+	}
+	_, err := e.client.ResetEbsDefaultKmsKeyIdRequest(&awsec2.ResetEbsDefaultKmsKeyIdInput{}).Send(ctx)
+	return errors.Wrap(err, errResetKey)
+}
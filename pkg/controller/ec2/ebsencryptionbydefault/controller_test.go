@@ -0,0 +1,607 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ebsencryptionbydefault
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	keyARN     = "arn:aws:kms:us-east-1:123456789012:key/example"
+	managedKey = "alias/aws/ebs"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client ec2.EBSEncryptionByDefaultClient
+	kube   client.Client
+	cr     *v1alpha4.EBSEncryptionByDefault
+}
+
+type encryptionModifier func(*v1alpha4.EBSEncryptionByDefault)
+
+func withConditions(c ...runtimev1alpha1.Condition) encryptionModifier {
+	return func(r *v1alpha4.EBSEncryptionByDefault) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withKMSKeyID(k string) encryptionModifier {
+	return func(r *v1alpha4.EBSEncryptionByDefault) { r.Spec.ForProvider.KMSKeyID = &k }
+}
+
+func encryption(m ...encryptionModifier) *v1alpha4.EBSEncryptionByDefault {
+	cr := &v1alpha4.EBSEncryptionByDefault{
+		Spec: v1alpha4.EBSEncryptionByDefaultSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getEncryption(enabled bool) func(*awsec2.GetEbsEncryptionByDefaultInput) awsec2.GetEbsEncryptionByDefaultRequest {
+	return func(*awsec2.GetEbsEncryptionByDefaultInput) awsec2.GetEbsEncryptionByDefaultRequest {
+		return awsec2.GetEbsEncryptionByDefaultRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.GetEbsEncryptionByDefaultOutput{EbsEncryptionByDefault: aws.Bool(enabled)}},
+		}
+	}
+}
+
+func getKey(k string) func(*awsec2.GetEbsDefaultKmsKeyIdInput) awsec2.GetEbsDefaultKmsKeyIdRequest {
+	return func(*awsec2.GetEbsDefaultKmsKeyIdInput) awsec2.GetEbsDefaultKmsKeyIdRequest {
+		return awsec2.GetEbsDefaultKmsKeyIdRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.GetEbsDefaultKmsKeyIdOutput{KmsKeyId: aws.String(k)}},
+		}
+	}
+}
+
+func modifyKey(t *testing.T, want string) func(*awsec2.ModifyEbsDefaultKmsKeyIdInput) awsec2.ModifyEbsDefaultKmsKeyIdRequest {
+	return func(input *awsec2.ModifyEbsDefaultKmsKeyIdInput) awsec2.ModifyEbsDefaultKmsKeyIdRequest {
+		if diff := cmp.Diff(want, aws.StringValue(input.KmsKeyId)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsec2.ModifyEbsDefaultKmsKeyIdRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyEbsDefaultKmsKeyIdOutput{}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.EBSEncryptionByDefaultClient, error)
+		cr          *v1alpha4.EBSEncryptionByDefault
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ec2.EBSEncryptionByDefaultClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: encryption(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ec2.EBSEncryptionByDefaultClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: encryption(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: encryption(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: encryption(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: encryption(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.EBSEncryptionByDefault
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Disabled": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockGetEbsEncryptionByDefault: getEncryption(false),
+				},
+				cr: encryption(),
+			},
+			want: want{
+				cr: encryption(),
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockGetEbsEncryptionByDefault: getEncryption(true),
+					MockGetEbsDefaultKmsKeyId:     getKey(managedKey),
+				},
+				cr: encryption(),
+			},
+			want: want{
+				cr: encryption(withKMSKeyID(managedKey), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"KeyChanged": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockGetEbsEncryptionByDefault: getEncryption(true),
+					MockGetEbsDefaultKmsKeyId:     getKey(managedKey),
+				},
+				cr: encryption(withKMSKeyID(keyARN)),
+			},
+			want: want{
+				cr: encryption(withKMSKeyID(keyARN), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockGetEbsEncryptionByDefault: func(*awsec2.GetEbsEncryptionByDefaultInput) awsec2.GetEbsEncryptionByDefaultRequest {
+						return awsec2.GetEbsEncryptionByDefaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: encryption(),
+			},
+			want: want{
+				cr:  encryption(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+		"FailedGetKeyRequest": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockGetEbsEncryptionByDefault: getEncryption(true),
+					MockGetEbsDefaultKmsKeyId: func(*awsec2.GetEbsDefaultKmsKeyIdInput) awsec2.GetEbsDefaultKmsKeyIdRequest {
+						return awsec2.GetEbsDefaultKmsKeyIdRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: encryption(),
+			},
+			want: want{
+				cr:  encryption(),
+				err: errors.Wrap(errBoom, errGetKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	enable := func(*awsec2.EnableEbsEncryptionByDefaultInput) awsec2.EnableEbsEncryptionByDefaultRequest {
+		return awsec2.EnableEbsEncryptionByDefaultRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.EnableEbsEncryptionByDefaultOutput{}},
+		}
+	}
+
+	type want struct {
+		cr     *v1alpha4.EBSEncryptionByDefault
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockEnableEbsEncryptionByDefault: enable,
+				},
+				cr: encryption(),
+			},
+			want: want{
+				cr: encryption(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"SuccessfulWithKey": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockEnableEbsEncryptionByDefault: enable,
+					MockModifyEbsDefaultKmsKeyId:     modifyKey(t, keyARN),
+				},
+				cr: encryption(withKMSKeyID(keyARN)),
+			},
+			want: want{
+				cr: encryption(withKMSKeyID(keyARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockEnableEbsEncryptionByDefault: func(*awsec2.EnableEbsEncryptionByDefaultInput) awsec2.EnableEbsEncryptionByDefaultRequest {
+						return awsec2.EnableEbsEncryptionByDefaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: encryption(),
+			},
+			want: want{
+				cr:  encryption(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errEnable),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.EBSEncryptionByDefault
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockModifyEbsDefaultKmsKeyId: modifyKey(t, keyARN),
+				},
+				cr: encryption(withKMSKeyID(keyARN)),
+			},
+			want: want{
+				cr: encryption(withKMSKeyID(keyARN)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockModifyEbsDefaultKmsKeyId: func(*awsec2.ModifyEbsDefaultKmsKeyIdInput) awsec2.ModifyEbsDefaultKmsKeyIdRequest {
+						return awsec2.ModifyEbsDefaultKmsKeyIdRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: encryption(withKMSKeyID(keyARN)),
+			},
+			want: want{
+				cr:  encryption(withKMSKeyID(keyARN)),
+				err: errors.Wrap(errBoom, errModifyKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	disable := func(*awsec2.DisableEbsEncryptionByDefaultInput) awsec2.DisableEbsEncryptionByDefaultRequest {
+		return awsec2.DisableEbsEncryptionByDefaultRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DisableEbsEncryptionByDefaultOutput{}},
+		}
+	}
+
+	type want struct {
+		cr  *v1alpha4.EBSEncryptionByDefault
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockDisableEbsEncryptionByDefault: disable,
+					MockResetEbsDefaultKmsKeyId: func(*awsec2.ResetEbsDefaultKmsKeyIdInput) awsec2.ResetEbsDefaultKmsKeyIdRequest {
+						return awsec2.ResetEbsDefaultKmsKeyIdRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ResetEbsDefaultKmsKeyIdOutput{}},
+						}
+					},
+				},
+				cr: encryption(withKMSKeyID(keyARN)),
+			},
+			want: want{
+				cr: encryption(withKMSKeyID(keyARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockDisableEbsEncryptionByDefault: func(*awsec2.DisableEbsEncryptionByDefaultInput) awsec2.DisableEbsEncryptionByDefaultRequest {
+						return awsec2.DisableEbsEncryptionByDefaultRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: encryption(),
+			},
+			want: want{
+				cr:  encryption(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDisable),
+			},
+		},
+		"FailedResetRequest": {
+			args: args{
+				client: &fake.MockEBSEncryptionByDefaultClient{
+					MockDisableEbsEncryptionByDefault: disable,
+					MockResetEbsDefaultKmsKeyId: func(*awsec2.ResetEbsDefaultKmsKeyIdInput) awsec2.ResetEbsDefaultKmsKeyIdRequest {
+						return awsec2.ResetEbsDefaultKmsKeyIdRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: encryption(),
+			},
+			want: want{
+				cr:  encryption(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errResetKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2fleet
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an EC2Fleet resource"
+	errCreateClient      = "cannot create EC2Fleet client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the EC2Fleet custom resource"
+
+	errDescribe      = "failed to describe EC2Fleet"
+	errMultipleItems = "retrieved multiple EC2Fleets"
+	errCreate        = "failed to create the EC2Fleet resource"
+	errUpdate        = "failed to update the EC2Fleet resource"
+	errUpdateTags    = "failed to update tags for the EC2Fleet resource"
+	errDelete        = "failed to delete the EC2Fleet resource"
+)
+
+// SetupEC2Fleet adds a controller that reconciles EC2Fleets.
+func SetupEC2Fleet(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.EC2FleetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha4.EC2Fleet{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.EC2FleetGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewEC2FleetClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.EC2FleetClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.EC2Fleet)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.EC2FleetClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.EC2Fleet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.DescribeFleetsRequest(&awsec2.DescribeFleetsInput{
+		FleetIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsFleetNotFoundErr, err), errDescribe)
+	}
+	// Deleted fleets stay visible for a while after deletion.
+	if len(rsp.Fleets) == 0 || ec2.IsFleetDeleted(rsp.Fleets[0]) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if len(rsp.Fleets) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+	observed := rsp.Fleets[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeEC2Fleet(&cr.Spec.ForProvider, &observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateEC2FleetObservation(observed)
+
+	switch observed.FleetState {
+	case awsec2.FleetStateCodeActive, awsec2.FleetStateCodeModifying:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.FleetStateCodeSubmitted:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsEC2FleetUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.EC2Fleet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	input, err := ec2.GenerateCreateFleetInput(string(cr.GetUID()), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	rsp, err := e.client.CreateFleetRequest(input).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.FleetId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha4.EC2Fleet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// Only fleets of type maintain can be modified; AWS rejects the request
+	// otherwise and the error is surfaced on the resource.
+	if _, err := e.client.ModifyFleetRequest(&awsec2.ModifyFleetInput{
+		FleetId:                         aws.String(meta.GetExternalName(cr)),
+		TargetCapacitySpecification:     ec2.GenerateTargetCapacitySpecificationRequest(cr.Spec.ForProvider.TargetCapacitySpecification),
+		ExcessCapacityTerminationPolicy: awsec2.FleetExcessCapacityTerminationPolicy(aws.StringValue(cr.Spec.ForProvider.ExcessCapacityTerminationPolicy)),
+	}).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	rsp, err := e.client.DescribeFleetsRequest(&awsec2.DescribeFleetsInput{
+		FleetIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(rsp.Fleets) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	if err := ec2.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, rsp.Fleets[0].Tags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.EC2Fleet)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	terminate := cr.Spec.ForProvider.TerminateInstancesOnDeletion
+	if terminate == nil {
+		terminate = aws.Bool(true)
+	}
+	rsp, err := e.client.DeleteFleetsRequest(&awsec2.DeleteFleetsInput{
+		FleetIds:           []string{meta.GetExternalName(cr)},
+		TerminateInstances: terminate,
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ec2.IsFleetNotFoundErr, err), errDelete)
+	}
+	for _, u := range rsp.UnsuccessfulFleetDeletions {
+		if u.Error == nil || u.Error.Code == awsec2.DeleteFleetErrorCodeFleetIdDoesNotExist {
+			continue
+		}
+		return errors.Wrap(errors.New(aws.StringValue(u.Error.Message)), errDelete)
+	}
+	return nil
+}
@@ -0,0 +1,682 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2fleet
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	fleetID     = "fleet-0123456789abcdef0"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(ec2.FleetIDNotFound, "not found", nil)
+)
+
+type args struct {
+	client ec2.EC2FleetClient
+	kube   client.Client
+	cr     *v1alpha4.EC2Fleet
+}
+
+type fleetModifier func(*v1alpha4.EC2Fleet)
+
+func withConditions(c ...runtimev1alpha1.Condition) fleetModifier {
+	return func(r *v1alpha4.EC2Fleet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) fleetModifier {
+	return func(r *v1alpha4.EC2Fleet) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha4.EC2FleetObservation) fleetModifier {
+	return func(r *v1alpha4.EC2Fleet) { r.Status.AtProvider = o }
+}
+
+func withTotalTargetCapacity(c int64) fleetModifier {
+	return func(r *v1alpha4.EC2Fleet) { r.Spec.ForProvider.TargetCapacitySpecification.TotalTargetCapacity = c }
+}
+
+func withTerminateInstancesOnDeletion(b bool) fleetModifier {
+	return func(r *v1alpha4.EC2Fleet) { r.Spec.ForProvider.TerminateInstancesOnDeletion = aws.Bool(b) }
+}
+
+func fleet(m ...fleetModifier) *v1alpha4.EC2Fleet {
+	cr := &v1alpha4.EC2Fleet{
+		Spec: v1alpha4.EC2FleetSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha4.EC2FleetParameters{
+				LaunchTemplateConfigs: []v1alpha4.FleetLaunchTemplateConfig{{
+					LaunchTemplateSpecification: v1alpha4.FleetLaunchTemplateSpecification{
+						LaunchTemplateName: aws.String("workers"),
+						Version:            "$Latest",
+					},
+				}},
+				TargetCapacitySpecification: v1alpha4.TargetCapacitySpecification{
+					TotalTargetCapacity:       4,
+					OnDemandTargetCapacity:    aws.Int64(1),
+					SpotTargetCapacity:        aws.Int64(3),
+					DefaultTargetCapacityType: aws.String("spot"),
+				},
+				ExcessCapacityTerminationPolicy:  aws.String("termination"),
+				ReplaceUnhealthyInstances:        aws.Bool(false),
+				TerminateInstancesWithExpiration: aws.Bool(false),
+				Type:                             aws.String("maintain"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(state awsec2.FleetStateCode) awsec2.FleetData {
+	return awsec2.FleetData{
+		FleetId:    aws.String(fleetID),
+		FleetState: state,
+		TargetCapacitySpecification: &awsec2.TargetCapacitySpecification{
+			TotalTargetCapacity:       aws.Int64(4),
+			OnDemandTargetCapacity:    aws.Int64(1),
+			SpotTargetCapacity:        aws.Int64(3),
+			DefaultTargetCapacityType: awsec2.DefaultTargetCapacityTypeSpot,
+		},
+		ExcessCapacityTerminationPolicy:  awsec2.FleetExcessCapacityTerminationPolicyTermination,
+		ReplaceUnhealthyInstances:        aws.Bool(false),
+		TerminateInstancesWithExpiration: aws.Bool(false),
+		Type:                             awsec2.FleetTypeMaintain,
+		FulfilledCapacity:                aws.Float64(4),
+	}
+}
+
+func describeFleets(fleets ...awsec2.FleetData) func(*awsec2.DescribeFleetsInput) awsec2.DescribeFleetsRequest {
+	return func(*awsec2.DescribeFleetsInput) awsec2.DescribeFleetsRequest {
+		return awsec2.DescribeFleetsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeFleetsOutput{Fleets: fleets}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.EC2FleetClient, error)
+		cr          *v1alpha4.EC2Fleet
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ec2.EC2FleetClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: fleet(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ec2.EC2FleetClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: fleet(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: fleet(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: fleet(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: fleet(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.EC2Fleet
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: fleet(),
+			},
+			want: want{
+				cr: fleet(),
+			},
+		},
+		"Active": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribeFleets: describeFleets(observed(awsec2.FleetStateCodeActive)),
+				},
+				cr: fleet(withExternalName(fleetID)),
+			},
+			want: want{
+				cr: fleet(
+					withExternalName(fleetID),
+					withObservation(v1alpha4.EC2FleetObservation{FleetID: fleetID, FleetState: "active", FulfilledCapacity: "4"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TargetCapacityChanged": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribeFleets: describeFleets(observed(awsec2.FleetStateCodeActive)),
+				},
+				cr: fleet(withExternalName(fleetID), withTotalTargetCapacity(6)),
+			},
+			want: want{
+				cr: fleet(
+					withExternalName(fleetID),
+					withTotalTargetCapacity(6),
+					withObservation(v1alpha4.EC2FleetObservation{FleetID: fleetID, FleetState: "active", FulfilledCapacity: "4"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Submitted": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribeFleets: describeFleets(observed(awsec2.FleetStateCodeSubmitted)),
+				},
+				cr: fleet(withExternalName(fleetID)),
+			},
+			want: want{
+				cr: fleet(
+					withExternalName(fleetID),
+					withObservation(v1alpha4.EC2FleetObservation{FleetID: fleetID, FleetState: "submitted", FulfilledCapacity: "4"}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribeFleets: describeFleets(observed(awsec2.FleetStateCodeDeletedTerminating)),
+				},
+				cr: fleet(withExternalName(fleetID)),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribeFleets: func(*awsec2.DescribeFleetsInput) awsec2.DescribeFleetsRequest {
+						return awsec2.DescribeFleetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: fleet(withExternalName(fleetID)),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribeFleets: func(*awsec2.DescribeFleetsInput) awsec2.DescribeFleetsRequest {
+						return awsec2.DescribeFleetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: fleet(withExternalName(fleetID)),
+			},
+			want: want{
+				cr:  fleet(withExternalName(fleetID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.EC2Fleet
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockEC2FleetClient{
+					MockCreateFleet: func(input *awsec2.CreateFleetInput) awsec2.CreateFleetRequest {
+						return awsec2.CreateFleetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateFleetOutput{
+								FleetId: aws.String(fleetID),
+							}},
+						}
+					},
+				},
+				cr: fleet(),
+			},
+			want: want{
+				cr: fleet(
+					withExternalName(fleetID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockCreateFleet: func(input *awsec2.CreateFleetInput) awsec2.CreateFleetRequest {
+						return awsec2.CreateFleetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: fleet(),
+			},
+			want: want{
+				cr:  fleet(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.EC2Fleet
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockModifyFleet: func(input *awsec2.ModifyFleetInput) awsec2.ModifyFleetRequest {
+						if diff := cmp.Diff(aws.Int64(6), input.TargetCapacitySpecification.TotalTargetCapacity); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyFleetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyFleetOutput{}},
+						}
+					},
+					MockDescribeFleets: func(input *awsec2.DescribeFleetsInput) awsec2.DescribeFleetsRequest {
+						return awsec2.DescribeFleetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeFleetsOutput{
+								Fleets: []awsec2.FleetData{{FleetId: aws.String(fleetID)}},
+							}},
+						}
+					},
+				},
+				cr: fleet(withExternalName(fleetID), withTotalTargetCapacity(6)),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withTotalTargetCapacity(6)),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockModifyFleet: func(input *awsec2.ModifyFleetInput) awsec2.ModifyFleetRequest {
+						return awsec2.ModifyFleetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: fleet(withExternalName(fleetID)),
+			},
+			want: want{
+				cr:  fleet(withExternalName(fleetID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.EC2Fleet
+		err error
+	}
+
+	deleteFleets := func(terminate bool, out *awsec2.DeleteFleetsOutput) func(*awsec2.DeleteFleetsInput) awsec2.DeleteFleetsRequest {
+		return func(input *awsec2.DeleteFleetsInput) awsec2.DeleteFleetsRequest {
+			if diff := cmp.Diff(aws.Bool(terminate), input.TerminateInstances); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			return awsec2.DeleteFleetsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TerminatesInstancesByDefault": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDeleteFleets: deleteFleets(true, &awsec2.DeleteFleetsOutput{}),
+				},
+				cr: fleet(withExternalName(fleetID)),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"KeepInstances": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDeleteFleets: deleteFleets(false, &awsec2.DeleteFleetsOutput{}),
+				},
+				cr: fleet(withExternalName(fleetID), withTerminateInstancesOnDeletion(false)),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withTerminateInstancesOnDeletion(false), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDeleteFleets: deleteFleets(true, &awsec2.DeleteFleetsOutput{
+						UnsuccessfulFleetDeletions: []awsec2.DeleteFleetErrorItem{{
+							FleetId: aws.String(fleetID),
+							Error:   &awsec2.DeleteFleetError{Code: awsec2.DeleteFleetErrorCodeFleetIdDoesNotExist},
+						}},
+					}),
+				},
+				cr: fleet(withExternalName(fleetID)),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"UnsuccessfulDeletion": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDeleteFleets: deleteFleets(true, &awsec2.DeleteFleetsOutput{
+						UnsuccessfulFleetDeletions: []awsec2.DeleteFleetErrorItem{{
+							FleetId: aws.String(fleetID),
+							Error:   &awsec2.DeleteFleetError{Code: awsec2.DeleteFleetErrorCodeUnexpectedError, Message: aws.String(errBoom.Error())},
+						}},
+					}),
+				},
+				cr: fleet(withExternalName(fleetID)),
+			},
+			want: want{
+				cr:  fleet(withExternalName(fleetID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an Image resource"
+	errCreateClient      = "cannot create Image client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the Image custom resource"
+
+	errDescribe          = "failed to describe Image"
+	errMultipleItems     = "retrieved multiple Images"
+	errDescribeAttribute = "failed to describe launch permissions of the Image"
+	errCreate            = "failed to copy the Image"
+	errUpdate            = "failed to update the Image resource"
+	errModifyPermissions = "failed to modify launch permissions of the Image"
+	errUpdateTags        = "failed to update tags for the Image resource"
+	errDelete            = "failed to deregister the Image"
+)
+
+// SetupImage adds a controller that reconciles Images.
+func SetupImage(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.ImageGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha4.Image{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.ImageGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewImageClient}))))),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.ImageClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.Image)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.ImageClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.Image)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.DescribeImagesRequest(&awsec2.DescribeImagesInput{
+		ImageIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsImageNotFoundErr, err), errDescribe)
+	}
+	// Deregistered AMIs stay visible for a while after deletion.
+	if len(rsp.Images) == 0 || rsp.Images[0].State == awsec2.ImageStateDeregistered {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if len(rsp.Images) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+	observed := rsp.Images[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeImage(&cr.Spec.ForProvider, &observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateImageObservation(observed)
+
+	switch observed.State {
+	case awsec2.ImageStateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.ImageStatePending:
+		// Launch permissions and attributes can only be changed once the
+		// copy has completed.
+		cr.SetConditions(runtimev1alpha1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	attr, err := e.client.DescribeImageAttributeRequest(&awsec2.DescribeImageAttributeInput{
+		Attribute: awsec2.ImageAttributeNameLaunchPermission,
+		ImageId:   aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeAttribute)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsImageUpToDate(cr.Spec.ForProvider, observed, attr.LaunchPermissions),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.Image)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	rsp, err := e.client.CopyImageRequest(ec2.GenerateCopyImageInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.ImageId))
+
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha4.Image)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeImagesRequest(&awsec2.DescribeImagesInput{
+		ImageIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(rsp.Images) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	observed := rsp.Images[0]
+
+	if aws.StringValue(cr.Spec.ForProvider.Description) != aws.StringValue(observed.Description) {
+		if _, err := e.client.ModifyImageAttributeRequest(&awsec2.ModifyImageAttributeInput{
+			ImageId:     aws.String(meta.GetExternalName(cr)),
+			Description: &awsec2.AttributeValue{Value: cr.Spec.ForProvider.Description},
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	attr, err := e.client.DescribeImageAttributeRequest(&awsec2.DescribeImageAttributeInput{
+		Attribute: awsec2.ImageAttributeNameLaunchPermission,
+		ImageId:   aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeAttribute)
+	}
+	if add, remove := ec2.DiffLaunchPermissions(cr.Spec.ForProvider.LaunchPermissions, attr.LaunchPermissions); len(add) != 0 || len(remove) != 0 {
+		if _, err := e.client.ModifyImageAttributeRequest(&awsec2.ModifyImageAttributeInput{
+			ImageId:          aws.String(meta.GetExternalName(cr)),
+			LaunchPermission: &awsec2.LaunchPermissionModifications{Add: add, Remove: remove},
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyPermissions)
+		}
+	}
+
+	if err := ec2.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, observed.Tags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.Image)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	// Deregistering does not delete the snapshots backing the AMI.
+	_, err := e.client.DeregisterImageRequest(&awsec2.DeregisterImageInput{
+		ImageId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ec2.IsImageNotFoundErr, err), errDelete)
+}
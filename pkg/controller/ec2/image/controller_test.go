@@ -0,0 +1,652 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	imageID     = "ami-0123456789abcdef0"
+	accountID   = "123456789012"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(ec2.ImageIDNotFound, "not found", nil)
+)
+
+type args struct {
+	client ec2.ImageClient
+	kube   client.Client
+	cr     *v1alpha4.Image
+}
+
+type imageModifier func(*v1alpha4.Image)
+
+func withConditions(c ...runtimev1alpha1.Condition) imageModifier {
+	return func(r *v1alpha4.Image) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) imageModifier {
+	return func(r *v1alpha4.Image) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha4.ImageObservation) imageModifier {
+	return func(r *v1alpha4.Image) { r.Status.AtProvider = o }
+}
+
+func withSharedAccounts(ids ...string) imageModifier {
+	return func(r *v1alpha4.Image) {
+		r.Spec.ForProvider.LaunchPermissions = &v1alpha4.ImageLaunchPermissions{UserIDs: ids}
+	}
+}
+
+func image(m ...imageModifier) *v1alpha4.Image {
+	cr := &v1alpha4.Image{
+		Spec: v1alpha4.ImageSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha4.ImageParameters{
+				SourceImageID: "ami-source",
+				SourceRegion:  "us-west-2",
+				Name:          "golden",
+				Description:   aws.String("golden image"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(state awsec2.ImageState) awsec2.Image {
+	return awsec2.Image{
+		ImageId:     aws.String(imageID),
+		Description: aws.String("golden image"),
+		State:       state,
+	}
+}
+
+func describeImages(images ...awsec2.Image) func(*awsec2.DescribeImagesInput) awsec2.DescribeImagesRequest {
+	return func(*awsec2.DescribeImagesInput) awsec2.DescribeImagesRequest {
+		return awsec2.DescribeImagesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeImagesOutput{Images: images}},
+		}
+	}
+}
+
+func describeLaunchPermissions(perms ...awsec2.LaunchPermission) func(*awsec2.DescribeImageAttributeInput) awsec2.DescribeImageAttributeRequest {
+	return func(*awsec2.DescribeImageAttributeInput) awsec2.DescribeImageAttributeRequest {
+		return awsec2.DescribeImageAttributeRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeImageAttributeOutput{LaunchPermissions: perms}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.ImageClient, error)
+		cr          *v1alpha4.Image
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ec2.ImageClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: image(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ec2.ImageClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: image(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: image(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: image(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: image(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.Image
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: image(),
+			},
+			want: want{
+				cr: image(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribeImages:         describeImages(observed(awsec2.ImageStateAvailable)),
+					MockDescribeImageAttribute: describeLaunchPermissions(awsec2.LaunchPermission{UserId: aws.String(accountID)}),
+				},
+				cr: image(withExternalName(imageID), withSharedAccounts(accountID)),
+			},
+			want: want{
+				cr: image(
+					withExternalName(imageID),
+					withSharedAccounts(accountID),
+					withObservation(v1alpha4.ImageObservation{ImageID: imageID, ImageState: "available"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LaunchPermissionsChanged": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribeImages:         describeImages(observed(awsec2.ImageStateAvailable)),
+					MockDescribeImageAttribute: describeLaunchPermissions(),
+				},
+				cr: image(withExternalName(imageID), withSharedAccounts(accountID)),
+			},
+			want: want{
+				cr: image(
+					withExternalName(imageID),
+					withSharedAccounts(accountID),
+					withObservation(v1alpha4.ImageObservation{ImageID: imageID, ImageState: "available"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribeImages: describeImages(observed(awsec2.ImageStatePending)),
+				},
+				cr: image(withExternalName(imageID), withSharedAccounts(accountID)),
+			},
+			want: want{
+				cr: image(
+					withExternalName(imageID),
+					withSharedAccounts(accountID),
+					withObservation(v1alpha4.ImageObservation{ImageID: imageID, ImageState: "pending"}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deregistered": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribeImages: describeImages(observed(awsec2.ImageStateDeregistered)),
+				},
+				cr: image(withExternalName(imageID)),
+			},
+			want: want{
+				cr: image(withExternalName(imageID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribeImages: func(*awsec2.DescribeImagesInput) awsec2.DescribeImagesRequest {
+						return awsec2.DescribeImagesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: image(withExternalName(imageID)),
+			},
+			want: want{
+				cr: image(withExternalName(imageID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribeImages: func(*awsec2.DescribeImagesInput) awsec2.DescribeImagesRequest {
+						return awsec2.DescribeImagesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: image(withExternalName(imageID)),
+			},
+			want: want{
+				cr:  image(withExternalName(imageID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.Image
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockImageClient{
+					MockCopyImage: func(input *awsec2.CopyImageInput) awsec2.CopyImageRequest {
+						return awsec2.CopyImageRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CopyImageOutput{
+								ImageId: aws.String(imageID),
+							}},
+						}
+					},
+				},
+				cr: image(),
+			},
+			want: want{
+				cr: image(
+					withExternalName(imageID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockCopyImage: func(input *awsec2.CopyImageInput) awsec2.CopyImageRequest {
+						return awsec2.CopyImageRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: image(),
+			},
+			want: want{
+				cr:  image(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.Image
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ShareWithAccount": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribeImages:         describeImages(observed(awsec2.ImageStateAvailable)),
+					MockDescribeImageAttribute: describeLaunchPermissions(awsec2.LaunchPermission{Group: awsec2.PermissionGroupAll}),
+					MockModifyImageAttribute: func(input *awsec2.ModifyImageAttributeInput) awsec2.ModifyImageAttributeRequest {
+						want := &awsec2.LaunchPermissionModifications{
+							Add:    []awsec2.LaunchPermission{{UserId: aws.String(accountID)}},
+							Remove: []awsec2.LaunchPermission{{Group: awsec2.PermissionGroupAll}},
+						}
+						if diff := cmp.Diff(want, input.LaunchPermission); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyImageAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyImageAttributeOutput{}},
+						}
+					},
+				},
+				cr: image(withExternalName(imageID), withSharedAccounts(accountID)),
+			},
+			want: want{
+				cr: image(withExternalName(imageID), withSharedAccounts(accountID)),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribeImages:         describeImages(observed(awsec2.ImageStateAvailable)),
+					MockDescribeImageAttribute: describeLaunchPermissions(),
+					MockModifyImageAttribute: func(input *awsec2.ModifyImageAttributeInput) awsec2.ModifyImageAttributeRequest {
+						return awsec2.ModifyImageAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: image(withExternalName(imageID), withSharedAccounts(accountID)),
+			},
+			want: want{
+				cr:  image(withExternalName(imageID), withSharedAccounts(accountID)),
+				err: errors.Wrap(errBoom, errModifyPermissions),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.Image
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDeregisterImage: func(input *awsec2.DeregisterImageInput) awsec2.DeregisterImageRequest {
+						return awsec2.DeregisterImageRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeregisterImageOutput{}},
+						}
+					},
+				},
+				cr: image(withExternalName(imageID)),
+			},
+			want: want{
+				cr: image(withExternalName(imageID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDeregisterImage: func(input *awsec2.DeregisterImageInput) awsec2.DeregisterImageRequest {
+						return awsec2.DeregisterImageRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: image(withExternalName(imageID)),
+			},
+			want: want{
+				cr: image(withExternalName(imageID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDeregisterImage: func(input *awsec2.DeregisterImageInput) awsec2.DeregisterImageRequest {
+						return awsec2.DeregisterImageRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: image(withExternalName(imageID)),
+			},
+			want: want{
+				cr:  image(withExternalName(imageID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -38,7 +38,14 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/usage"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 const (
@@ -56,7 +63,7 @@ const (
 	errUpdate              = "failed to update the InternetGateway resource"
 	errSpecUpdate          = "cannot update spec of the InternetGateway resource"
 	errStatusUpdate        = "cannot update status of the InternetGateway resource"
-	errCreateTags          = "failed to create tags for the InternetGateway resource"
+	errUpdateTags          = "failed to update tags for the InternetGateway resource"
 )
 
 // SetupInternetGateway adds a controller that reconciles InternetGateways.
@@ -65,17 +72,30 @@ func SetupInternetGateway(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1beta1.InternetGateway{}).
+		Watches(shard.NewKind(mgr.GetCache(), &v1beta1.VPC{}), usage.EnqueueUsers(mgr.GetClient(), shard.OwnerOf(mgr.GetCache()), &v1beta1.InternetGatewayList{}, uses)).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.InternetGatewayGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewInternetGatewayClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewInternetGatewayClient}))))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient(), uses)),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// uses returns the VPC that the supplied InternetGateway uses, which must not be
+// deleted before it.
+func uses(mg resource.Managed) []usage.Use {
+	cr, ok := mg.(*v1beta1.InternetGateway)
+	if !ok {
+		return nil
+	}
+	return []usage.Use{{Reference: cr.Spec.ForProvider.VPCIDRef, Selector: cr.Spec.ForProvider.VPCIDSelector, To: &v1beta1.VPC{}}}
+}
+
 type connector struct {
 	client      client.Client
 	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.InternetGatewayClient, error)
@@ -92,9 +112,14 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (manag
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, conn.client, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		igClient, err := conn.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
-		return &external{client: igClient, kube: conn.client}, errors.Wrap(err, errClient)
+		return &external{client: igClient, kube: conn.client, region: p.Spec.Region}, errors.Wrap(err, errClient)
 	}
 
 	if p.GetCredentialsSecretReference() == nil {
@@ -108,12 +133,13 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (manag
 	}
 
 	igClient, err := conn.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
-	return &external{client: igClient, kube: conn.client}, errors.Wrap(err, errClient)
+	return &external{client: igClient, kube: conn.client, region: p.Spec.Region}, errors.Wrap(err, errClient)
 }
 
 type external struct {
 	kube   client.Client
 	client ec2.InternetGatewayClient
+	region string
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -153,6 +179,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	cr.SetConditions(runtimev1alpha1.Available())
 
 	cr.Status.AtProvider = ec2.GenerateIGObservation(observed)
+	cr.Status.AtProvider.InternetGatewayARN = ec2.ResourceARN(e.region, cr.Status.AtProvider.OwnerID, ec2.ResourceTypeInternetGateway, cr.Status.AtProvider.InternetGatewayID)
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -187,16 +214,6 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	// Tagging the created InternetGateway
-	if len(cr.Spec.ForProvider.Tags) > 0 {
-		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
-			Resources: []string{meta.GetExternalName(cr)},
-			Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-		}).Send(ctx); err != nil {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
-		}
-	}
-
 	response, err := e.client.DescribeInternetGatewaysRequest(&awsec2.DescribeInternetGatewaysInput{
 		InternetGatewayIds: []string{meta.GetExternalName(cr)},
 	}).Send(ctx)
@@ -210,6 +227,10 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	observed := response.InternetGateways[0]
 
+	if err := ec2.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, observed.Tags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+	}
+
 	// There can only be one attachment and if that is attached to
 	// spec.VpcID, no action is required.
 	if len(observed.Attachments) > 1 {
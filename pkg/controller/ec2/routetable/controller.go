@@ -39,7 +39,14 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/usage"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 const (
@@ -61,7 +68,7 @@ const (
 	errDisassociateSubnet = "failed to disassociate subnet %v from the RouteTable resource"
 	errSpecUpdate         = "cannot update spec of the RouteTable custom resource"
 	errStatusUpdate       = "cannot update status of the RouteTable custom resource"
-	errCreateTags         = "failed to create tags for the RouteTable resource"
+	errUpdateTags         = "failed to update tags for the RouteTable resource"
 )
 
 // SetupRouteTable adds a controller that reconciles RouteTables.
@@ -70,17 +77,30 @@ func SetupRouteTable(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha4.RouteTable{}).
+		Watches(shard.NewKind(mgr.GetCache(), &v1beta1.VPC{}), usage.EnqueueUsers(mgr.GetClient(), shard.OwnerOf(mgr.GetCache()), &v1alpha4.RouteTableList{}, uses)).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha4.RouteTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient}))))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient(), uses)),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// uses returns the VPC that the supplied RouteTable uses, which must not be
+// deleted before it.
+func uses(mg resource.Managed) []usage.Use {
+	cr, ok := mg.(*v1alpha4.RouteTable)
+	if !ok {
+		return nil
+	}
+	return []usage.Use{{Reference: cr.Spec.ForProvider.VPCIDRef, Selector: cr.Spec.ForProvider.VPCIDSelector, To: &v1beta1.VPC{}}}
+}
+
 type connector struct {
 	client      client.Client
 	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.RouteTableClient, error)
@@ -97,9 +117,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.client, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		rtClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
-		return &external{client: rtClient, kube: c.client}, errors.Wrap(err, errUnexpectedObject)
+		return &external{client: rtClient, kube: c.client, cache: ec2.DefaultDescribeCache, region: p.Spec.Region}, errors.Wrap(err, errUnexpectedObject)
 	}
 
 	if p.GetCredentialsSecretReference() == nil {
@@ -113,12 +138,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	rtClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
-	return &external{client: rtClient, kube: c.client}, errors.Wrap(err, errClient)
+	return &external{client: rtClient, kube: c.client, cache: ec2.DefaultDescribeCache, region: p.Spec.Region}, errors.Wrap(err, errClient)
 }
 
 type external struct {
 	kube   client.Client
 	client ec2.RouteTableClient
+	cache  *ec2.DescribeCache
+	region string
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -136,22 +163,26 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}, nil
 	}
 
-	response, err := e.client.DescribeRouteTablesRequest(&awsec2.DescribeRouteTablesInput{
-		RouteTableIds: []string{meta.GetExternalName(cr)},
-	}).Send(ctx)
+	observed, ok := e.cache.RouteTable(ctx, e.client, cr.Spec.ProviderReference.Name, meta.GetExternalName(cr))
+	if !ok {
+		response, err := e.client.DescribeRouteTablesRequest(&awsec2.DescribeRouteTablesInput{
+			RouteTableIds: []string{meta.GetExternalName(cr)},
+		}).Send(ctx)
 
-	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrapf(resource.Ignore(ec2.IsRouteTableNotFoundErr, err), errDescribe)
-	}
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrapf(resource.Ignore(ec2.IsRouteTableNotFoundErr, err), errDescribe)
+		}
 
-	// in a successful response, there should be one and only one object
-	if len(response.RouteTables) != 1 {
-		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+		// in a successful response, there should be one and only one object
+		if len(response.RouteTables) != 1 {
+			return managed.ExternalObservation{}, errors.New(errMultipleItems)
+		}
+
+		observed = response.RouteTables[0]
 	}
 
-	observed := response.RouteTables[0]
 	current := cr.Spec.ForProvider.DeepCopy()
-	ec2.LateInitializeRT(&cr.Spec.ForProvider, &response.RouteTables[0])
+	ec2.LateInitializeRT(&cr.Spec.ForProvider, &observed)
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
@@ -170,6 +201,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	cr.Status.AtProvider = ec2.GenerateRTObservation(observed)
+	cr.Status.AtProvider.RouteTableARN = ec2.ResourceARN(e.region, cr.Status.AtProvider.OwnerID, ec2.ResourceTypeRouteTable, cr.Status.AtProvider.RouteTableID)
 
 	upToDate, err := ec2.IsRtUpToDate(cr.Spec.ForProvider, observed)
 	if err != nil {
@@ -216,6 +248,8 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	defer e.cache.Invalidate(ec2.CacheKindRouteTable, cr.Spec.ProviderReference.Name, meta.GetExternalName(cr))
+
 	response, err := e.client.DescribeRouteTablesRequest(&awsec2.DescribeRouteTablesInput{
 		RouteTableIds: []string{meta.GetExternalName(cr)},
 	}).Send(ctx)
@@ -235,14 +269,8 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
 
-	if len(patch.Tags) != 0 {
-		// tagging the RouteTable
-		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
-			Resources: []string{meta.GetExternalName(cr)},
-			Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-		}).Send(ctx); err != nil {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
-		}
+	if err := ec2.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, table.Tags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
 	}
 
 	if patch.Routes != nil {
@@ -268,6 +296,8 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 		return errors.New(errUnexpectedObject)
 	}
 
+	defer e.cache.Invalidate(ec2.CacheKindRouteTable, cr.Spec.ProviderReference.Name, meta.GetExternalName(cr))
+
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
 
 	// the subnet associations have to be deleted before deleting the route table.
@@ -57,6 +57,7 @@ var (
 	vpcID    = "some vpc"
 	igID     = "some ig"
 	subnetID = "some subnet"
+	ownerID  = "123456789012"
 
 	errBoom = errors.New("boom")
 )
@@ -304,6 +305,40 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ReportsARN": {
+			args: args{
+				rt: &fake.MockRouteTableClient{
+					MockDescribe: func(input *awsec2.DescribeRouteTablesInput) awsec2.DescribeRouteTablesRequest {
+						return awsec2.DescribeRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeRouteTablesOutput{
+								RouteTables: []awsec2.RouteTable{{
+									OwnerId:      aws.String(ownerID),
+									RouteTableId: aws.String(rtID),
+									VpcId:        aws.String(vpcID),
+								}},
+							}},
+						}
+					},
+				},
+				cr: rt(withSpec(v1alpha4.RouteTableParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(rtID)),
+			},
+			want: want{
+				cr: rt(withSpec(v1alpha4.RouteTableParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(rtID), withConditions(runtimev1alpha1.Available()),
+					withStatus(v1alpha4.RouteTableObservation{
+						OwnerID:       ownerID,
+						RouteTableID:  rtID,
+						RouteTableARN: "arn:aws:ec2:us-east-1:123456789012:route-table/" + rtID,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"MulitpleTables": {
 			args: args{
 				rt: &fake.MockRouteTableClient{
@@ -342,7 +377,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rt}
+			e := &external{kube: tc.kube, client: tc.rt, region: testRegion}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -38,7 +38,14 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/usage"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 const (
@@ -59,7 +66,7 @@ const (
 	errRevokeEgress     = "cannot remove the default egress rule"
 	errStatusUpdate     = "cannot update status of the SecurityGroup custom resource"
 	errUpdate           = "failed to update the SecurityGroup resource"
-	errCreateTags       = "failed to create tags for the Security Group resource"
+	errUpdateTags       = "failed to update tags for the Security Group resource"
 )
 
 // SetupSecurityGroup adds a controller that reconciles SecurityGroups.
@@ -68,17 +75,30 @@ func SetupSecurityGroup(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1beta1.SecurityGroup{}).
+		Watches(shard.NewKind(mgr.GetCache(), &v1beta1.VPC{}), usage.EnqueueUsers(mgr.GetClient(), shard.OwnerOf(mgr.GetCache()), &v1beta1.SecurityGroupList{}, uses)).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.SecurityGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupClient}))))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient(), uses)),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// uses returns the VPC that the supplied SecurityGroup uses, which must not be
+// deleted before it.
+func uses(mg resource.Managed) []usage.Use {
+	cr, ok := mg.(*v1beta1.SecurityGroup)
+	if !ok {
+		return nil
+	}
+	return []usage.Use{{Reference: cr.Spec.ForProvider.VPCIDRef, Selector: cr.Spec.ForProvider.VPCIDSelector, To: &v1beta1.VPC{}}}
+}
+
 type connector struct {
 	kube        client.Client
 	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.SecurityGroupClient, error)
@@ -95,9 +115,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		sgClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
-		return &external{sg: sgClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+		return &external{sg: sgClient, kube: c.kube, cache: ec2.DefaultDescribeCache, region: p.Spec.Region}, errors.Wrap(err, errCreateClient)
 	}
 
 	if p.GetCredentialsSecretReference() == nil {
@@ -111,12 +136,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	sgClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
-	return &external{sg: sgClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	return &external{sg: sgClient, kube: c.kube, cache: ec2.DefaultDescribeCache, region: p.Spec.Region}, errors.Wrap(err, errCreateClient)
 }
 
 type external struct {
-	sg   ec2.SecurityGroupClient
-	kube client.Client
+	sg     ec2.SecurityGroupClient
+	kube   client.Client
+	cache  *ec2.DescribeCache
+	region string
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -129,19 +156,22 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, nil
 	}
 
-	response, err := e.sg.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
-		GroupIds: []string{meta.GetExternalName(cr)},
-	}).Send(ctx)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsSecurityGroupNotFoundErr, err), errDescribe)
-	}
+	observed, ok := e.cache.SecurityGroup(ctx, e.sg, cr.Spec.ProviderReference.Name, meta.GetExternalName(cr))
+	if !ok {
+		response, err := e.sg.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
+			GroupIds: []string{meta.GetExternalName(cr)},
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsSecurityGroupNotFoundErr, err), errDescribe)
+		}
 
-	// in a successful response, there should be one and only one object
-	if len(response.SecurityGroups) != 1 {
-		return managed.ExternalObservation{}, errors.New(errMultipleItems)
-	}
+		// in a successful response, there should be one and only one object
+		if len(response.SecurityGroups) != 1 {
+			return managed.ExternalObservation{}, errors.New(errMultipleItems)
+		}
 
-	observed := response.SecurityGroups[0]
+		observed = response.SecurityGroups[0]
+	}
 
 	current := cr.Spec.ForProvider.DeepCopy()
 	ec2.LateInitializeSG(&cr.Spec.ForProvider, &observed)
@@ -152,6 +182,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	cr.Status.AtProvider = ec2.GenerateSGObservation(observed)
+	cr.Status.AtProvider.SecurityGroupARN = ec2.ResourceARN(e.region, cr.Status.AtProvider.OwnerID, ec2.ResourceTypeSecurityGroup, cr.Status.AtProvider.SecurityGroupID)
 
 	upToDate, err := ec2.IsSGUpToDate(cr.Spec.ForProvider, observed)
 	if err != nil {
@@ -217,6 +248,8 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	defer e.cache.Invalidate(ec2.CacheKindSecurityGroup, cr.Spec.ProviderReference.Name, meta.GetExternalName(cr))
+
 	response, err := e.sg.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
 		GroupIds: []string{meta.GetExternalName(cr)},
 	}).Send(ctx)
@@ -229,13 +262,8 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUpdate)
 	}
 
-	if len(patch.Tags) != 0 {
-		if _, err := e.sg.CreateTagsRequest(&awsec2.CreateTagsInput{
-			Resources: []string{meta.GetExternalName(cr)},
-			Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-		}).Send(ctx); err != nil {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
-		}
+	if err := ec2.UpdateTags(ctx, e.sg, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, response.SecurityGroups[0].Tags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
 	}
 
 	if patch.Ingress != nil {
@@ -265,11 +293,20 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 		return errors.New(errUnexpectedObject)
 	}
 
+	defer e.cache.Invalidate(ec2.CacheKindSecurityGroup, cr.Spec.ProviderReference.Name, meta.GetExternalName(cr))
+
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
 
 	_, err := e.sg.DeleteSecurityGroupRequest(&awsec2.DeleteSecurityGroupInput{
 		GroupId: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
+	if errorutils.IsDependencyViolation(err) {
+		// AWS does not say which resources use the group, so we look them up
+		// to report them. Failing to do so is not worth failing the delete.
+		if ids, derr := ec2.GetSecurityGroupDependents(ctx, e.sg, meta.GetExternalName(cr)); derr == nil {
+			err = errorutils.WithDependents(err, ids...)
+		}
+	}
 
 	return errors.Wrap(resource.Ignore(ec2.IsSecurityGroupNotFoundErr, err), errDelete)
 }
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -40,6 +41,7 @@ import (
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
 )
 
 const (
@@ -58,8 +60,10 @@ var (
 	port100     int64 = 100
 	cidr              = "192.168.0.0/32"
 	tcpProtocol       = "tcp"
+	eniID             = "eni-0a1b2c3d"
 
-	errBoom = errors.New("boom")
+	errBoom       = errors.New("boom")
+	errDependency = awserr.New("DependencyViolation", "resource has a dependent object", nil)
 )
 
 type args struct {
@@ -676,6 +680,29 @@ func TestDelete(t *testing.T) {
 				err: errors.Wrap(errBoom, errDelete),
 			},
 		},
+		"DependencyViolation": {
+			args: args{
+				sg: &fake.MockSecurityGroupClient{
+					MockDelete: func(input *awsec2.DeleteSecurityGroupInput) awsec2.DeleteSecurityGroupRequest {
+						return awsec2.DeleteSecurityGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errDependency},
+						}
+					},
+					MockDescribeNetworkInterfaces: func(input *awsec2.DescribeNetworkInterfacesInput) awsec2.DescribeNetworkInterfacesRequest {
+						return awsec2.DescribeNetworkInterfacesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeNetworkInterfacesOutput{
+								NetworkInterfaces: []awsec2.NetworkInterface{{NetworkInterfaceId: aws.String(eniID)}},
+							}},
+						}
+					},
+				},
+				cr: sg(withExternalName(sgID)),
+			},
+			want: want{
+				cr:  sg(withExternalName(sgID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errorutils.WithDependents(errDependency, eniID), errDelete),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -38,7 +38,14 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/usage"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 const (
@@ -56,7 +63,7 @@ const (
 	errUpdate        = "failed to update the Subnet resource"
 	errSpecUpdate    = "cannot update spec of the Subnet custom resource"
 	errStatusUpdate  = "cannot update status of the Subnet custom resource"
-	errCreateTags    = "failed to create tags for the Subnet resource"
+	errUpdateTags    = "failed to update tags for the Subnet resource"
 )
 
 // SetupSubnet adds a controller that reconciles Subnets.
@@ -64,17 +71,30 @@ func SetupSubnet(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1beta1.SubnetGroupKind)
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1beta1.Subnet{}).
+		Watches(shard.NewKind(mgr.GetCache(), &v1beta1.VPC{}), usage.EnqueueUsers(mgr.GetClient(), shard.OwnerOf(mgr.GetCache()), &v1beta1.SubnetList{}, uses)).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.SubnetGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewSubnetClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewSubnetClient}))))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient(), uses)),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// uses returns the VPC that the supplied Subnet uses, which must not be
+// deleted before it.
+func uses(mg resource.Managed) []usage.Use {
+	cr, ok := mg.(*v1beta1.Subnet)
+	if !ok {
+		return nil
+	}
+	return []usage.Use{{Reference: cr.Spec.ForProvider.VPCIDRef, Selector: cr.Spec.ForProvider.VPCIDSelector, To: &v1beta1.VPC{}}}
+}
+
 type connector struct {
 	client      client.Client
 	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ec2.SubnetClient, error)
@@ -91,9 +111,14 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (manag
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, conn.client, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		subnetClient, err := conn.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
-		return &external{client: subnetClient, kube: conn.client}, errors.Wrap(err, errCreateSubnetClient)
+		return &external{client: subnetClient, kube: conn.client, cache: ec2.DefaultDescribeCache}, errors.Wrap(err, errCreateSubnetClient)
 	}
 
 	if p.GetCredentialsSecretReference() == nil {
@@ -107,12 +132,13 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (manag
 	}
 
 	subnetClient, err := conn.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
-	return &external{client: subnetClient, kube: conn.client}, errors.Wrap(err, errCreateSubnetClient)
+	return &external{client: subnetClient, kube: conn.client, cache: ec2.DefaultDescribeCache}, errors.Wrap(err, errCreateSubnetClient)
 }
 
 type external struct {
 	kube   client.Client
 	client ec2.SubnetClient
+	cache  *ec2.DescribeCache
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -127,20 +153,23 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}, nil
 	}
 
-	response, err := e.client.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{
-		SubnetIds: []string{meta.GetExternalName(cr)},
-	}).Send(ctx)
+	observed, ok := e.cache.Subnet(ctx, e.client, cr.Spec.ProviderReference.Name, meta.GetExternalName(cr))
+	if !ok {
+		response, err := e.client.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{
+			SubnetIds: []string{meta.GetExternalName(cr)},
+		}).Send(ctx)
 
-	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsSubnetNotFoundErr, err), errDescribe)
-	}
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsSubnetNotFoundErr, err), errDescribe)
+		}
 
-	// in a successful response, there should be one and only one object
-	if len(response.Subnets) != 1 {
-		return managed.ExternalObservation{}, errors.New(errMultipleItems)
-	}
+		// in a successful response, there should be one and only one object
+		if len(response.Subnets) != 1 {
+			return managed.ExternalObservation{}, errors.New(errMultipleItems)
+		}
 
-	observed := response.Subnets[0]
+		observed = response.Subnets[0]
+	}
 
 	// update CRD spec for any new values from provider
 	current := cr.Spec.ForProvider.DeepCopy()
@@ -200,6 +229,8 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	defer e.cache.Invalidate(ec2.CacheKindSubnet, cr.Spec.ProviderReference.Name, meta.GetExternalName(cr))
+
 	response, err := e.client.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{
 		SubnetIds: []string{meta.GetExternalName(cr)},
 	}).Send(ctx)
@@ -214,13 +245,8 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	subnet := response.Subnets[0]
 
-	if !v1beta1.CompareTags(cr.Spec.ForProvider.Tags, subnet.Tags) {
-		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
-			Resources: []string{meta.GetExternalName(cr)},
-			Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-		}).Send(ctx); err != nil {
-			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
-		}
+	if err := ec2.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, subnet.Tags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
 	}
 
 	if subnet.MapPublicIpOnLaunch != cr.Spec.ForProvider.MapPublicIPOnLaunch {
@@ -253,6 +279,8 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 		return errors.New(errUnexpectedObject)
 	}
 
+	defer e.cache.Invalidate(ec2.CacheKindSubnet, cr.Spec.ProviderReference.Name, meta.GetExternalName(cr))
+
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
 
 	_, err := e.client.DeleteSubnetRequest(&awsec2.DeleteSubnetInput{
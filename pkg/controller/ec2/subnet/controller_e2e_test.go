@@ -0,0 +1,82 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnet
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/e2e"
+)
+
+func TestLifecycle(t *testing.T) {
+	h := e2e.NewHarness(t)
+
+	// Subnets are served from listings of all subnets when the cache is
+	// enabled, which the siblings make span several subnets.
+	ttl := ec2.DefaultDescribeCache.TTL
+	ec2.DefaultDescribeCache.TTL = time.Minute
+	defer func() { ec2.DefaultDescribeCache.TTL = ttl }()
+
+	client := awsec2.New(*h.Config(t))
+	vpc, err := client.CreateVpcRequest(&awsec2.CreateVpcInput{CidrBlock: aws.String("10.0.0.0/16")}).Send(context.Background())
+	if err != nil {
+		t.Fatalf("cannot create VPC: %v", err)
+	}
+	defer func() {
+		if _, err := client.DeleteVpcRequest(&awsec2.DeleteVpcInput{VpcId: vpc.Vpc.VpcId}).Send(context.Background()); err != nil {
+			t.Errorf("cannot delete VPC: %v", err)
+		}
+	}()
+
+	n := 0
+	h.Run(t, e2e.Lifecycle{
+		Name:      "subnet",
+		Connecter: &connector{client: h.Kube, newClientFn: ec2.NewSubnetClient},
+		Resource: func(name string) resource.Managed {
+			n++
+			return &v1beta1.Subnet{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: v1beta1.SubnetSpec{
+					ResourceSpec: runtimev1alpha1.ResourceSpec{ProviderReference: h.ProviderReference()},
+					ForProvider: v1beta1.SubnetParameters{
+						CIDRBlock: fmt.Sprintf("10.0.%d.0/24", n),
+						VPCID:     vpc.Vpc.VpcId,
+					},
+				},
+			}
+		},
+		Update: func(mg resource.Managed) {
+			mg.(*v1beta1.Subnet).Spec.ForProvider.MapPublicIPOnLaunch = aws.Bool(true)
+		},
+		Siblings: 3,
+	})
+}
@@ -39,7 +39,12 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 const (
@@ -55,7 +60,7 @@ const (
 	errCreate              = "failed to create the VPC resource"
 	errUpdate              = "failed to update VPC resource"
 	errModifyVPCAttributes = "failed to modify the VPC resource attributes"
-	errCreateTags          = "failed to create tags for the VPC resource"
+	errUpdateTags          = "failed to update tags for the VPC resource"
 	errDelete              = "failed to delete the VPC resource"
 	errSpecUpdate          = "cannot update spec of VPC custom resource"
 	errStatusUpdate        = "cannot update status of VPC custom resource"
@@ -66,10 +71,11 @@ func SetupVPC(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1beta1.VPCGroupKind)
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1beta1.VPC{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.VPCGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVpcClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVpcClient}))))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
@@ -93,9 +99,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		vpcClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
-		return &external{client: vpcClient, kube: c.kube}, errors.Wrap(err, errCreateVpcClient)
+		return &external{client: vpcClient, kube: c.kube, region: p.Spec.Region}, errors.Wrap(err, errCreateVpcClient)
 	}
 
 	if p.GetCredentialsSecretReference() == nil {
@@ -109,12 +120,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	vpcClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
-	return &external{client: vpcClient, kube: c.kube}, errors.Wrap(err, errCreateVpcClient)
+	return &external{client: vpcClient, kube: c.kube, region: p.Spec.Region}, errors.Wrap(err, errCreateVpcClient)
 }
 
 type external struct {
 	kube   client.Client
 	client ec2.VPCClient
+	region string
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -160,6 +172,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	cr.Status.AtProvider = ec2.GenerateVpcObservation(observed)
+	cr.Status.AtProvider.VPCARN = ec2.ResourceARN(e.region, cr.Status.AtProvider.OwnerID, ec2.ResourceTypeVPC, cr.Status.AtProvider.VPCID)
 
 	o := awsec2.DescribeVpcAttributeOutput{}
 
@@ -221,31 +234,41 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	for _, input := range []*awsec2.ModifyVpcAttributeInput{
-		{
+	// Attributes that are not set are left as AWS defaults them.
+	var inputs []*awsec2.ModifyVpcAttributeInput
+	if cr.Spec.ForProvider.EnableDNSSupport != nil {
+		inputs = append(inputs, &awsec2.ModifyVpcAttributeInput{
 			VpcId:            aws.String(meta.GetExternalName(cr)),
 			EnableDnsSupport: &awsec2.AttributeBooleanValue{Value: cr.Spec.ForProvider.EnableDNSSupport},
-		},
-		{
+		})
+	}
+	if cr.Spec.ForProvider.EnableDNSHostNames != nil {
+		inputs = append(inputs, &awsec2.ModifyVpcAttributeInput{
 			VpcId:              aws.String(meta.GetExternalName(cr)),
 			EnableDnsHostnames: &awsec2.AttributeBooleanValue{Value: cr.Spec.ForProvider.EnableDNSHostNames},
-		},
-	} {
+		})
+	}
+	for _, input := range inputs {
 		if _, err := e.client.ModifyVpcAttributeRequest(input).Send(ctx); err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyVPCAttributes)
 		}
 	}
 
-	// NOTE(muvaf): VPCs can only be tagged after the creation and this request
-	// is idempotent.
-	if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
-		Resources: []string{meta.GetExternalName(cr)},
-		Tags:      v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-	}).Send(ctx); err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+	// NOTE(muvaf): VPCs can only be tagged after the creation.
+	rsp, err := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{
+		VpcIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(rsp.Vpcs) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	if err := ec2.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, rsp.Vpcs[0].Tags); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
 	}
 
-	_, err := e.client.ModifyVpcTenancyRequest(&awsec2.ModifyVpcTenancyInput{
+	_, err = e.client.ModifyVpcTenancyRequest(&awsec2.ModifyVpcTenancyInput{
 		InstanceTenancy: awsec2.VpcTenancy(aws.StringValue(cr.Spec.ForProvider.InstanceTenancy)),
 		VpcId:           aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
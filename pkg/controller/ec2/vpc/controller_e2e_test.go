@@ -0,0 +1,61 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/e2e"
+)
+
+func TestLifecycle(t *testing.T) {
+	h := e2e.NewHarness(t)
+	h.Run(t, e2e.Lifecycle{
+		Name:         "vpc",
+		Connecter:    &connector{kube: h.Kube, newClientFn: ec2.NewVpcClient},
+		Initializers: []managed.Initializer{&tagger{kube: h.Kube}},
+		Resource: func(name string) resource.Managed {
+			return &v1beta1.VPC{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: v1beta1.VPCSpec{
+					ResourceSpec: runtimev1alpha1.ResourceSpec{ProviderReference: h.ProviderReference()},
+					ForProvider: v1beta1.VPCParameters{
+						CIDRBlock:        "10.0.0.0/16",
+						EnableDNSSupport: aws.Bool(true),
+					},
+				},
+			}
+		},
+		Update: func(mg resource.Managed) {
+			cr := mg.(*v1beta1.VPC)
+			cr.Spec.ForProvider.EnableDNSHostNames = aws.Bool(true)
+			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, v1beta1.Tag{Key: "e2e", Value: "updated"})
+		},
+	})
+}
@@ -19,9 +19,11 @@ package vpc
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	crfake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -41,6 +43,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
 )
@@ -405,6 +408,77 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+func TestObserveIgnoredFields(t *testing.T) {
+	var saved *v1beta1.VPC
+	version := 0
+	kube := &test.MockClient{
+		MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+			version++
+			obj.(*v1beta1.VPC).SetResourceVersion(strconv.Itoa(version))
+			saved = obj.DeepCopyObject().(*v1beta1.VPC)
+			return nil
+		},
+	}
+	vpcClient := &fake.MockVPCClient{
+		MockDescribe: func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+			return awsec2.DescribeVpcsRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcsOutput{
+					Vpcs: []awsec2.Vpc{{
+						CidrBlock:       aws.String(cidr),
+						InstanceTenancy: awsec2.TenancyDedicated,
+						State:           awsec2.VpcStateAvailable,
+					}},
+				}},
+			}
+		},
+		MockDescribeVpcAttributeRequest: func(input *awsec2.DescribeVpcAttributeInput) awsec2.DescribeVpcAttributeRequest {
+			return awsec2.DescribeVpcAttributeRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcAttributeOutput{
+					EnableDnsHostnames: &awsec2.AttributeBooleanValue{},
+					EnableDnsSupport:   &awsec2.AttributeBooleanValue{},
+				}},
+			}
+		},
+	}
+	s := runtime.NewScheme()
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+	mgr := &crfake.Manager{Client: kube, Scheme: s}
+	c := drift.NewConnecter(mgr, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &external{kube: kube, client: vpcClient}, nil
+	}))
+
+	cr := vpc(withSpec(v1beta1.VPCParameters{CIDRBlock: cidr}), withExternalName(vpcID))
+	meta.AddAnnotations(cr, map[string]string{drift.AnnotationKeyIgnoreFields: "instanceTenancy"})
+	cr.Spec.ForProvider.InstanceTenancy = aws.String(tenancyDefault)
+
+	e, err := c.Connect(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+
+	// The ignored tenancy differs from the one observed in AWS, but is
+	// neither compared nor overwritten by the late-initialized value.
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, o); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+	// The late-initialized spec was saved with the ignored tenancy cleared,
+	// then saved again with the tenancy restored.
+	if saved == nil {
+		t.Fatal("Observe(...): the late-initialized spec was not saved")
+	}
+	want := v1beta1.VPCParameters{CIDRBlock: cidr, InstanceTenancy: aws.String(tenancyDefault)}
+	if diff := cmp.Diff(want, saved.Spec.ForProvider); diff != "" {
+		t.Errorf("saved spec: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(want, cr.Spec.ForProvider); diff != "" {
+		t.Errorf("spec: -want, +got:\n%s", diff)
+	}
+}
+
 func TestCreate(t *testing.T) {
 	type want struct {
 		cr     *v1beta1.VPC
@@ -510,6 +584,18 @@ func TestUpdate(t *testing.T) {
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
 						}
 					},
+					MockDescribe: func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+						return awsec2.DescribeVpcsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcsOutput{
+								Vpcs: []awsec2.Vpc{{Tags: []awsec2.Tag{{Key: aws.String("stale"), Value: aws.String("tag")}}}},
+							}},
+						}
+					},
+					MockDeleteTagsRequest: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
 					MockModifyAttribute: func(input *awsec2.ModifyVpcAttributeInput) awsec2.ModifyVpcAttributeRequest {
 						return awsec2.ModifyVpcAttributeRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcAttributeOutput{}},
@@ -539,6 +625,18 @@ func TestUpdate(t *testing.T) {
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
 						}
 					},
+					MockDescribe: func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+						return awsec2.DescribeVpcsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcsOutput{
+								Vpcs: []awsec2.Vpc{{Tags: []awsec2.Tag{{Key: aws.String("stale"), Value: aws.String("tag")}}}},
+							}},
+						}
+					},
+					MockDeleteTagsRequest: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
 					MockModifyAttribute: func(input *awsec2.ModifyVpcAttributeInput) awsec2.ModifyVpcAttributeRequest {
 						return awsec2.ModifyVpcAttributeRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcAttributeOutput{}},
@@ -0,0 +1,442 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityprovider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsecs "github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	deletedAt = metav1.Now()
+
+	cpName  = "workers"
+	cpARN   = "arn:aws:ecs:us-east-1:123456789012:capacity-provider/workers"
+	asgARN  = "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:uuid:autoScalingGroupName/workers"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ecs.CapacityProviderClient
+	kube   client.Client
+	cr     *v1alpha1.CapacityProvider
+}
+
+type capacityProviderModifier func(*v1alpha1.CapacityProvider)
+
+func withConditions(c ...runtimev1alpha1.Condition) capacityProviderModifier {
+	return func(r *v1alpha1.CapacityProvider) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.CapacityProviderObservation) capacityProviderModifier {
+	return func(r *v1alpha1.CapacityProvider) { r.Status.AtProvider = o }
+}
+
+func withTerminationProtection(s string) capacityProviderModifier {
+	return func(r *v1alpha1.CapacityProvider) {
+		r.Spec.ForProvider.AutoScalingGroupProvider.ManagedTerminationProtection = aws.String(s)
+	}
+}
+
+func withDeletionTimestamp() capacityProviderModifier {
+	return func(r *v1alpha1.CapacityProvider) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func capacityProvider(m ...capacityProviderModifier) *v1alpha1.CapacityProvider {
+	cr := &v1alpha1.CapacityProvider{
+		Spec: v1alpha1.CapacityProviderSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.CapacityProviderParameters{
+				AutoScalingGroupProvider: v1alpha1.AutoScalingGroupProvider{
+					AutoScalingGroupARN: asgARN,
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, cpName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeCapacityProviders(cps ...awsecs.CapacityProvider) func(*awsecs.DescribeCapacityProvidersInput) awsecs.DescribeCapacityProvidersRequest {
+	return func(*awsecs.DescribeCapacityProvidersInput) awsecs.DescribeCapacityProvidersRequest {
+		return awsecs.DescribeCapacityProvidersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.DescribeCapacityProvidersOutput{CapacityProviders: cps}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ecs.CapacityProviderClient, error)
+		cr          *v1alpha1.CapacityProvider
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ecs.CapacityProviderClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: capacityProvider(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ecs.CapacityProviderClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: capacityProvider(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: capacityProvider(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: capacityProvider(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: capacityProvider(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CapacityProvider
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockCapacityProviderClient{
+					MockDescribeCapacityProviders: describeCapacityProviders(awsecs.CapacityProvider{
+						CapacityProviderArn: aws.String(cpARN),
+						Name:                aws.String(cpName),
+						Status:              awsecs.CapacityProviderStatusActive,
+						AutoScalingGroupProvider: &awsecs.AutoScalingGroupProvider{
+							AutoScalingGroupArn:          aws.String(asgARN),
+							ManagedTerminationProtection: awsecs.ManagedTerminationProtectionDisabled,
+						},
+					}),
+				},
+				cr: capacityProvider(),
+			},
+			want: want{
+				cr: capacityProvider(
+					withTerminationProtection("DISABLED"),
+					withObservation(v1alpha1.CapacityProviderObservation{ARN: cpARN, Status: "ACTIVE"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockCapacityProviderClient{
+					MockDescribeCapacityProviders: describeCapacityProviders(),
+				},
+				cr: capacityProvider(),
+			},
+			want: want{
+				cr: capacityProvider(),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: capacityProvider(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: capacityProvider(withDeletionTimestamp()),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockCapacityProviderClient{
+					MockDescribeCapacityProviders: func(*awsecs.DescribeCapacityProvidersInput) awsecs.DescribeCapacityProvidersRequest {
+						return awsecs.DescribeCapacityProvidersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: capacityProvider(),
+			},
+			want: want{
+				cr:  capacityProvider(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CapacityProvider
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCapacityProviderClient{
+					MockCreateCapacityProvider: func(input *awsecs.CreateCapacityProviderInput) awsecs.CreateCapacityProviderRequest {
+						if diff := cmp.Diff(cpName, aws.StringValue(input.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsecs.CreateCapacityProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.CreateCapacityProviderOutput{}},
+						}
+					},
+				},
+				cr: capacityProvider(),
+			},
+			want: want{
+				cr: capacityProvider(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockCapacityProviderClient{
+					MockCreateCapacityProvider: func(input *awsecs.CreateCapacityProviderInput) awsecs.CreateCapacityProviderRequest {
+						return awsecs.CreateCapacityProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: capacityProvider(),
+			},
+			want: want{
+				cr:  capacityProvider(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
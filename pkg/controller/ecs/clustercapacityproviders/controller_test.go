@@ -0,0 +1,544 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustercapacityproviders
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsecs "github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	clusterName = "apps"
+	clusterARN  = "arn:aws:ecs:us-east-1:123456789012:cluster/apps"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsecs.ErrCodeClusterNotFoundException, "not found", nil)
+	deletedAt   = metav1.Now()
+)
+
+type args struct {
+	client ecs.ClusterCapacityProvidersClient
+	kube   client.Client
+	cr     *v1alpha1.ClusterCapacityProviders
+}
+
+type clusterCapacityProvidersModifier func(*v1alpha1.ClusterCapacityProviders)
+
+func withConditions(c ...runtimev1alpha1.Condition) clusterCapacityProvidersModifier {
+	return func(r *v1alpha1.ClusterCapacityProviders) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) clusterCapacityProvidersModifier {
+	return func(r *v1alpha1.ClusterCapacityProviders) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha1.ClusterCapacityProvidersObservation) clusterCapacityProvidersModifier {
+	return func(r *v1alpha1.ClusterCapacityProviders) { r.Status.AtProvider = o }
+}
+
+func withCapacityProviders(cps ...string) clusterCapacityProvidersModifier {
+	return func(r *v1alpha1.ClusterCapacityProviders) { r.Spec.ForProvider.CapacityProviders = cps }
+}
+
+func withDeletionTimestamp() clusterCapacityProvidersModifier {
+	return func(r *v1alpha1.ClusterCapacityProviders) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func clusterCapacityProviders(m ...clusterCapacityProvidersModifier) *v1alpha1.ClusterCapacityProviders {
+	cr := &v1alpha1.ClusterCapacityProviders{
+		Spec: v1alpha1.ClusterCapacityProvidersSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ClusterCapacityProvidersParameters{
+				Cluster: clusterName,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func cluster(cps ...string) awsecs.Cluster {
+	return awsecs.Cluster{
+		ClusterArn:        aws.String(clusterARN),
+		ClusterName:       aws.String(clusterName),
+		Status:            aws.String("ACTIVE"),
+		CapacityProviders: cps,
+	}
+}
+
+func describeClusters(clusters ...awsecs.Cluster) func(*awsecs.DescribeClustersInput) awsecs.DescribeClustersRequest {
+	return func(*awsecs.DescribeClustersInput) awsecs.DescribeClustersRequest {
+		return awsecs.DescribeClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsecs.DescribeClustersOutput{Clusters: clusters}},
+		}
+	}
+}
+
+func putClusterCapacityProviders(err error) func(*awsecs.PutClusterCapacityProvidersInput) awsecs.PutClusterCapacityProvidersRequest {
+	return func(input *awsecs.PutClusterCapacityProvidersInput) awsecs.PutClusterCapacityProvidersRequest {
+		c := cluster(input.CapacityProviders...)
+		return awsecs.PutClusterCapacityProvidersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsecs.PutClusterCapacityProvidersOutput{Cluster: &c}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ecs.ClusterCapacityProvidersClient, error)
+		cr          *v1alpha1.ClusterCapacityProviders
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ecs.ClusterCapacityProvidersClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: clusterCapacityProviders(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ecs.ClusterCapacityProvidersClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: clusterCapacityProviders(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: clusterCapacityProviders(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: clusterCapacityProviders(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: clusterCapacityProviders(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClusterCapacityProviders
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: clusterCapacityProviders(),
+			},
+			want: want{
+				cr: clusterCapacityProviders(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockDescribeClusters: describeClusters(cluster("workers")),
+				},
+				cr: clusterCapacityProviders(withExternalName(clusterName), withCapacityProviders("workers")),
+			},
+			want: want{
+				cr: clusterCapacityProviders(
+					withExternalName(clusterName),
+					withCapacityProviders("workers"),
+					withObservation(v1alpha1.ClusterCapacityProvidersObservation{ClusterARN: clusterARN, Status: "ACTIVE"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockDescribeClusters: describeClusters(cluster()),
+				},
+				cr: clusterCapacityProviders(withExternalName(clusterName), withCapacityProviders("workers")),
+			},
+			want: want{
+				cr: clusterCapacityProviders(
+					withExternalName(clusterName),
+					withCapacityProviders("workers"),
+					withObservation(v1alpha1.ClusterCapacityProvidersObservation{ClusterARN: clusterARN, Status: "ACTIVE"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ProvidersRemovedOnDeletion": {
+			args: args{
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockDescribeClusters: describeClusters(cluster()),
+				},
+				cr: clusterCapacityProviders(withExternalName(clusterName), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: clusterCapacityProviders(withExternalName(clusterName), withDeletionTimestamp()),
+			},
+		},
+		"ClusterNotFound": {
+			args: args{
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockDescribeClusters: func(*awsecs.DescribeClustersInput) awsecs.DescribeClustersRequest {
+						return awsecs.DescribeClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: clusterCapacityProviders(withExternalName(clusterName)),
+			},
+			want: want{
+				cr: clusterCapacityProviders(withExternalName(clusterName)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockDescribeClusters: func(*awsecs.DescribeClustersInput) awsecs.DescribeClustersRequest {
+						return awsecs.DescribeClustersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: clusterCapacityProviders(withExternalName(clusterName)),
+			},
+			want: want{
+				cr:  clusterCapacityProviders(withExternalName(clusterName)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClusterCapacityProviders
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockPutClusterCapacityProviders: putClusterCapacityProviders(nil),
+				},
+				cr: clusterCapacityProviders(withCapacityProviders("workers")),
+			},
+			want: want{
+				cr: clusterCapacityProviders(
+					withCapacityProviders("workers"),
+					withExternalName(clusterName),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockPutClusterCapacityProviders: putClusterCapacityProviders(errBoom),
+				},
+				cr: clusterCapacityProviders(),
+			},
+			want: want{
+				cr:  clusterCapacityProviders(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ClusterCapacityProviders
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockPutClusterCapacityProviders: func(input *awsecs.PutClusterCapacityProvidersInput) awsecs.PutClusterCapacityProvidersRequest {
+						if len(input.CapacityProviders) != 0 || len(input.DefaultCapacityProviderStrategy) != 0 {
+							t.Errorf("expected capacity providers to be removed, got %v", input)
+						}
+						return putClusterCapacityProviders(nil)(input)
+					},
+				},
+				cr: clusterCapacityProviders(withExternalName(clusterName), withCapacityProviders("workers")),
+			},
+			want: want{
+				cr: clusterCapacityProviders(withExternalName(clusterName), withCapacityProviders("workers"), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClusterNotFound": {
+			args: args{
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockPutClusterCapacityProviders: putClusterCapacityProviders(errNotFound),
+				},
+				cr: clusterCapacityProviders(withExternalName(clusterName)),
+			},
+			want: want{
+				cr: clusterCapacityProviders(withExternalName(clusterName), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClusterCapacityProvidersClient{
+					MockPutClusterCapacityProviders: putClusterCapacityProviders(errBoom),
+				},
+				cr: clusterCapacityProviders(withExternalName(clusterName)),
+			},
+			want: want{
+				cr:  clusterCapacityProviders(withExternalName(clusterName), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
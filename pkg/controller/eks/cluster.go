@@ -18,7 +18,9 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
@@ -35,10 +37,20 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/usage"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/requeue"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 const (
@@ -49,30 +61,53 @@ const (
 	errGetProvider       = "cannot get provider"
 	errGetProviderSecret = "cannot get provider secret"
 
-	errCreateFailed        = "cannot create EKS cluster"
-	errUpdateConfigFailed  = "cannot update EKS cluster configuration"
-	errUpdateVersionFailed = "cannot update EKS cluster version"
-	errAddTagsFailed       = "cannot add tags to EKS cluster"
-	errDeleteFailed        = "cannot delete EKS cluster"
-	errDescribeFailed      = "cannot describe EKS cluster"
-	errPatchCreationFailed = "cannot create a patch object"
-	errUpToDateFailed      = "cannot check whether object is up-to-date"
+	errCreateFailed         = "cannot create EKS cluster"
+	errUpdateConfigFailed   = "cannot update EKS cluster configuration"
+	errUpdateVersionFailed  = "cannot update EKS cluster version"
+	errAddTagsFailed        = "cannot add tags to EKS cluster"
+	errDeleteFailed         = "cannot delete EKS cluster"
+	errDescribeFailed       = "cannot describe EKS cluster"
+	errDescribeUpdateFailed = "cannot describe EKS cluster update"
+	errPatchCreationFailed  = "cannot create a patch object"
+	errUpToDateFailed       = "cannot check whether object is up-to-date"
 )
 
 // SetupCluster adds a controller that reconciles Clusters.
 func SetupCluster(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1beta1.ClusterGroupKind)
 
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.ClusterGroupVersionKind),
+		managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewClient}))))),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1beta1.Cluster{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewClient}),
-			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+		Watches(shard.NewKind(mgr.GetCache(), &iamv1beta1.IAMRole{}), usage.EnqueueUsers(mgr.GetClient(), shard.OwnerOf(mgr.GetCache()), &v1beta1.ClusterList{}, uses)).
+		Watches(shard.NewKind(mgr.GetCache(), &ec2v1beta1.Subnet{}), usage.EnqueueUsers(mgr.GetClient(), shard.OwnerOf(mgr.GetCache()), &v1beta1.ClusterList{}, uses)).
+		Complete(requeue.NewReconciler(mgr, resource.ManagedKind(v1beta1.ClusterGroupVersionKind), requeue.TypicalEKSClusterDuration, r))
+}
+
+// uses returns the IAMRole and Subnets that the supplied Cluster uses.
+func uses(mg resource.Managed) []usage.Use {
+	cr, ok := mg.(*v1beta1.Cluster)
+	if !ok {
+		return nil
+	}
+	p := cr.Spec.ForProvider
+	u := []usage.Use{
+		{Reference: p.RoleArnRef, Selector: p.RoleArnSelector, To: &iamv1beta1.IAMRole{}},
+		{Selector: p.ResourcesVpcConfig.SubnetIDSelector, To: &ec2v1beta1.Subnet{}},
+	}
+	for i := range p.ResourcesVpcConfig.SubnetIDRefs {
+		u = append(u, usage.Use{Reference: &p.ResourcesVpcConfig.SubnetIDRefs[i], To: &ec2v1beta1.Subnet{}})
+	}
+	return u
 }
 
 type connector struct {
@@ -91,6 +126,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		eksClient, stsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
 		return &external{client: eksClient, sts: stsClient, kube: c.kube}, errors.Wrap(err, errCreateEKSClient)
@@ -135,10 +175,18 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
+	update, err := e.observeUpdate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeUpdateFailed)
+	}
+
 	cr.Status.AtProvider = eks.GenerateObservation(rsp.Cluster)
+	cr.Status.AtProvider.Update = update
+	// NOTE: The control plane keeps serving requests while it is updated, so
+	// an updating cluster is still available.
 	switch cr.Status.AtProvider.Status {
-	case v1beta1.ClusterStatusActive:
-		cr.Status.SetConditions(runtimev1alpha1.Available())
+	case v1beta1.ClusterStatusActive, v1beta1.ClusterStatusUpdating:
+		cr.Status.SetConditions(available(update))
 		resource.SetBindable(cr)
 	case v1beta1.ClusterStatusCreating:
 		cr.Status.SetConditions(runtimev1alpha1.Creating())
@@ -159,6 +207,20 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}, nil
 }
 
+// observeUpdate returns the current state of the last update of the cluster
+// if it was still in progress when it was last observed.
+func (e *external) observeUpdate(ctx context.Context, cr *v1beta1.Cluster) (*v1beta1.ClusterUpdate, error) {
+	update := cr.Status.AtProvider.Update
+	if update == nil || update.Status != string(awseks.UpdateStatusInProgress) {
+		return update, nil
+	}
+	rsp, err := e.client.DescribeUpdateRequest(&awseks.DescribeUpdateInput{Name: aws.String(meta.GetExternalName(cr)), UpdateId: aws.String(update.ID)}).Send(ctx)
+	if err != nil {
+		return nil, resource.Ignore(eks.IsErrorNotFound, err)
+	}
+	return eks.GenerateClusterUpdate(rsp.Update), nil
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.Cluster)
 	if !ok {
@@ -204,11 +266,35 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Wrap(err, errPatchCreationFailed)
 	}
 	if patch.Version != nil {
-		_, err := e.client.UpdateClusterVersionRequest(&awseks.UpdateClusterVersionInput{Name: awsclients.String(meta.GetExternalName(cr)), Version: patch.Version}).Send(ctx)
-		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+		rsp, err := e.client.UpdateClusterVersionRequest(&awseks.UpdateClusterVersionInput{Name: awsclients.String(meta.GetExternalName(cr)), Version: patch.Version}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+		}
+		cr.Status.AtProvider.Update = eks.GenerateClusterUpdate(rsp.Update)
+		return managed.ExternalUpdate{}, nil
+	}
+	crsp, err := e.client.UpdateClusterConfigRequest(eks.GenerateUpdateClusterConfigInput(meta.GetExternalName(cr), patch)).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
+	}
+	cr.Status.AtProvider.Update = eks.GenerateClusterUpdate(crsp.Update)
+	return managed.ExternalUpdate{}, nil
+}
+
+// available returns the Available condition with a message about the
+// supplied update if it is in progress or did not succeed.
+func available(u *v1beta1.ClusterUpdate) runtimev1alpha1.Condition {
+	c := runtimev1alpha1.Available()
+	if u == nil {
+		return c
+	}
+	switch awseks.UpdateStatus(u.Status) {
+	case awseks.UpdateStatusInProgress:
+		return c.WithMessage(fmt.Sprintf("%s %s is in progress", u.Type, u.ID))
+	case awseks.UpdateStatusFailed, awseks.UpdateStatusCancelled:
+		return c.WithMessage(fmt.Sprintf("%s %s is %s: %s", u.Type, u.ID, strings.ToLower(u.Status), strings.Join(u.Errors, "; ")))
 	}
-	_, err = e.client.UpdateClusterConfigRequest(eks.GenerateUpdateClusterConfigInput(meta.GetExternalName(cr), patch)).Send(ctx)
-	return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
+	return c
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
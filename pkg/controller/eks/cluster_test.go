@@ -54,7 +54,8 @@ const (
 )
 
 var (
-	version = "1.16"
+	version  = "1.16"
+	updateID = "b5f0ba18-9a87-4450-b5a0-825e6e84496f"
 
 	errBoom = errors.New("boom")
 )
@@ -93,6 +94,10 @@ func withStatus(s v1beta1.ClusterStatusType) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Status.AtProvider.Status = s }
 }
 
+func withUpdate(u *v1beta1.ClusterUpdate) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.Status.AtProvider.Update = u }
+}
+
 func withConfig(c v1beta1.VpcConfigRequest) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.ResourcesVpcConfig = c }
 }
@@ -316,6 +321,106 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"UpdateInProgress": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(_ *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{
+									Status: awseks.ClusterStatusUpdating,
+								},
+							}},
+						}
+					},
+					MockDescribeUpdateRequest: func(_ *awseks.DescribeUpdateInput) awseks.DescribeUpdateRequest {
+						return awseks.DescribeUpdateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeUpdateOutput{
+								Update: &awseks.Update{Id: aws.String(updateID), Type: awseks.UpdateTypeVersionUpdate, Status: awseks.UpdateStatusInProgress},
+							}},
+						}
+					},
+				},
+				cr: cluster(withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Type: "VersionUpdate", Status: "InProgress"})),
+			},
+			want: want{
+				cr: cluster(
+					withConditions(runtimev1alpha1.Available().WithMessage("VersionUpdate "+updateID+" is in progress")),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withStatus(v1beta1.ClusterStatusUpdating),
+					withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Type: "VersionUpdate", Status: "InProgress"})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: eks.GetConnectionDetails(&awseks.Cluster{}, &sts.Client{}),
+				},
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(_ *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{
+									Status: awseks.ClusterStatusActive,
+								},
+							}},
+						}
+					},
+					MockDescribeUpdateRequest: func(_ *awseks.DescribeUpdateInput) awseks.DescribeUpdateRequest {
+						return awseks.DescribeUpdateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeUpdateOutput{
+								Update: &awseks.Update{
+									Id:     aws.String(updateID),
+									Type:   awseks.UpdateTypeVersionUpdate,
+									Status: awseks.UpdateStatusFailed,
+									Errors: []awseks.ErrorDetail{{ErrorCode: awseks.ErrorCodeIpNotAvailable, ErrorMessage: aws.String("no free IPs")}},
+								},
+							}},
+						}
+					},
+				},
+				cr: cluster(withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Type: "VersionUpdate", Status: "InProgress"})),
+			},
+			want: want{
+				cr: cluster(
+					withConditions(runtimev1alpha1.Available().WithMessage("VersionUpdate "+updateID+" is failed: IpNotAvailable: no free IPs")),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withStatus(v1beta1.ClusterStatusActive),
+					withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Type: "VersionUpdate", Status: "Failed", Errors: []string{"IpNotAvailable: no free IPs"}})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: eks.GetConnectionDetails(&awseks.Cluster{}, &sts.Client{}),
+				},
+			},
+		},
+		"FailedDescribeUpdateRequest": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(_ *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{
+									Status: awseks.ClusterStatusUpdating,
+								},
+							}},
+						}
+					},
+					MockDescribeUpdateRequest: func(_ *awseks.DescribeUpdateInput) awseks.DescribeUpdateRequest {
+						return awseks.DescribeUpdateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Status: "InProgress"})),
+			},
+			want: want{
+				cr:  cluster(withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Status: "InProgress"})),
+				err: errors.Wrap(errBoom, errDescribeUpdateFailed),
+			},
+		},
 		"DeletingState": {
 			args: args{
 				eks: &fake.MockClient{
@@ -622,7 +727,9 @@ func TestUpdate(t *testing.T) {
 				eks: &fake.MockClient{
 					MockUpdateClusterVersionRequest: func(input *awseks.UpdateClusterVersionInput) awseks.UpdateClusterVersionRequest {
 						return awseks.UpdateClusterVersionRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateClusterVersionOutput{}},
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateClusterVersionOutput{
+								Update: &awseks.Update{Id: aws.String(updateID), Type: awseks.UpdateTypeVersionUpdate, Status: awseks.UpdateStatusInProgress},
+							}},
 						}
 					},
 					MockDescribeClusterRequest: func(input *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
@@ -636,7 +743,7 @@ func TestUpdate(t *testing.T) {
 				cr: cluster(withVersion(&version)),
 			},
 			want: want{
-				cr: cluster(withVersion(&version)),
+				cr: cluster(withVersion(&version), withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Type: "VersionUpdate", Status: "InProgress"})),
 			},
 		},
 		"SuccessfulUpdateCluster": {
@@ -0,0 +1,218 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterauth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errNotClusterAuth = "managed resource is not an EKS cluster auth custom resource"
+
+	errNoClusterRef         = "clusterNameRef or clusterNameSelector must be set"
+	errGetCluster           = "cannot get referenced EKS cluster"
+	errNoConnectionSecret   = "referenced EKS cluster does not publish a kubeconfig"
+	errGetConnectionSecret  = "cannot get connection secret of referenced EKS cluster"
+	errCreateKubeClient     = "cannot create Kubernetes client of EKS cluster"
+	errGetAuthConfigMap     = "cannot get aws-auth ConfigMap"
+	errCompareAuthConfigMap = "cannot compare aws-auth ConfigMap"
+	errCreateAuthConfigMap  = "cannot create aws-auth ConfigMap"
+	errUpdateAuthConfigMap  = "cannot update aws-auth ConfigMap"
+)
+
+// SetupClusterAuth adds a controller that reconciles ClusterAuths.
+func SetupClusterAuth(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ClusterAuthGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.ClusterAuth{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ClusterAuthGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewKubernetesClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(kubeconfig []byte) (kubernetes.Interface, error)
+}
+
+// Connect does not connect to the EKS cluster yet, because references are
+// resolved after Connect and the cluster is found by its reference.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.ClusterAuth); !ok {
+		return nil, errors.New(errNotClusterAuth)
+	}
+	return &external{kube: c.kube, newClientFn: c.newClientFn}, nil
+}
+
+type external struct {
+	kube        client.Client
+	newClientFn func(kubeconfig []byte) (kubernetes.Interface, error)
+}
+
+// connect returns a client of the EKS cluster using the kubeconfig that the
+// referenced Cluster publishes to its connection secret.
+func (e *external) connect(ctx context.Context, cr *v1alpha1.ClusterAuth) (kubernetes.Interface, error) {
+	if cr.Spec.ForProvider.ClusterNameRef == nil {
+		return nil, errors.New(errNoClusterRef)
+	}
+	cluster := &v1beta1.Cluster{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ForProvider.ClusterNameRef.Name}, cluster); err != nil {
+		return nil, errors.Wrap(err, errGetCluster)
+	}
+	ref := cluster.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return nil, errors.New(errNoConnectionSecret)
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetConnectionSecret)
+	}
+	kc, err := e.newClientFn(s.Data[runtimev1alpha1.ResourceCredentialsSecretKubeconfigKey])
+	return kc, errors.Wrap(err, errCreateKubeClient)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAuth)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClusterAuth)
+	}
+
+	kc, err := e.connect(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cm, err := kc.CoreV1().ConfigMaps(eks.AuthConfigMapNamespace).Get(ctx, eks.AuthConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.IgnoreNotFound(err), errGetAuthConfigMap)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	upToDate, err := eks.IsClusterAuthUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider, cm)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompareAuthConfigMap)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAuth)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClusterAuth)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	kc, err := e.connect(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: eks.AuthConfigMapNamespace, Name: eks.AuthConfigMapName}}
+	if err := eks.UpdateAuthConfigMap(cr.Spec.ForProvider, cr.Status.AtProvider, cm); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAuthConfigMap)
+	}
+	if _, err := kc.CoreV1().ConfigMaps(eks.AuthConfigMapNamespace).Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAuthConfigMap)
+	}
+	cr.Status.AtProvider = eks.GenerateClusterAuthObservation(cr.Spec.ForProvider)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAuth)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClusterAuth)
+	}
+
+	kc, err := e.connect(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	cm, err := kc.CoreV1().ConfigMaps(eks.AuthConfigMapNamespace).Get(ctx, eks.AuthConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetAuthConfigMap)
+	}
+	if err := eks.UpdateAuthConfigMap(cr.Spec.ForProvider, cr.Status.AtProvider, cm); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAuthConfigMap)
+	}
+	if _, err := kc.CoreV1().ConfigMaps(eks.AuthConfigMapNamespace).Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAuthConfigMap)
+	}
+	cr.Status.AtProvider = eks.GenerateClusterAuthObservation(cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterAuth)
+	if !ok {
+		return errors.New(errNotClusterAuth)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	kc, err := e.connect(ctx, cr)
+	// There is nothing to remove the mappings from if the cluster or its
+	// connection secret are already gone.
+	if kerrors.IsNotFound(errors.Cause(err)) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	cm, err := kc.CoreV1().ConfigMaps(eks.AuthConfigMapNamespace).Get(ctx, eks.AuthConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetAuthConfigMap)
+	}
+	if err := eks.RemoveFromAuthConfigMap(cr.Spec.ForProvider, cr.Status.AtProvider, cm); err != nil {
+		return errors.Wrap(err, errUpdateAuthConfigMap)
+	}
+	_, err = kc.CoreV1().ConfigMaps(eks.AuthConfigMapNamespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return errors.Wrap(err, errUpdateAuthConfigMap)
+}
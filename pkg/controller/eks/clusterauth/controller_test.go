@@ -0,0 +1,402 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	kfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+const (
+	clusterName = "cool-cluster"
+	secretName  = "cool-cluster-conn"
+	secretNS    = "crossplane-system"
+	kubeconfig  = "apiVersion: v1\nkind: Config\n"
+	nodeRoleARN = "arn:aws:iam::123456789012:role/node"
+	adminARN    = "arn:aws:iam::123456789012:role/admin"
+)
+
+var errBoom = errors.New("boom")
+
+type args struct {
+	kube   client.Client
+	remote *corev1.ConfigMap
+	cr     *v1alpha1.ClusterAuth
+}
+
+type clusterAuthModifier func(*v1alpha1.ClusterAuth)
+
+func withConditions(c ...runtimev1alpha1.Condition) clusterAuthModifier {
+	return func(r *v1alpha1.ClusterAuth) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.ClusterAuthObservation) clusterAuthModifier {
+	return func(r *v1alpha1.ClusterAuth) { r.Status.AtProvider = o }
+}
+
+func withoutClusterRef() clusterAuthModifier {
+	return func(r *v1alpha1.ClusterAuth) { r.Spec.ForProvider.ClusterNameRef = nil }
+}
+
+func clusterAuth(m ...clusterAuthModifier) *v1alpha1.ClusterAuth {
+	cr := &v1alpha1.ClusterAuth{
+		Spec: v1alpha1.ClusterAuthSpec{
+			ForProvider: v1alpha1.ClusterAuthParameters{
+				ClusterNameRef: &runtimev1alpha1.Reference{Name: clusterName},
+				MapRoles: []v1alpha1.RoleMapping{{
+					RoleARN:  adminARN,
+					Username: "admin",
+					Groups:   []string{"system:masters"},
+				}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// authConfigMap returns an aws-auth ConfigMap with the node role that EKS
+// maps for managed node groups and the supplied role mappings.
+func authConfigMap(roles ...string) *corev1.ConfigMap {
+	data := "- groups:\n  - system:nodes\n  rolearn: " + nodeRoleARN + "\n  username: system:node:{{EC2PrivateDNSName}}\n"
+	for _, r := range roles {
+		data += r
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: eks.AuthConfigMapNamespace, Name: eks.AuthConfigMapName},
+		Data:       map[string]string{"mapRoles": data},
+	}
+}
+
+const adminRole = "- groups:\n  - system:masters\n  rolearn: " + adminARN + "\n  username: admin\n"
+
+// kube returns a client that serves the referenced cluster and its
+// connection secret.
+func kube() client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.Cluster:
+				o.Spec.WriteConnectionSecretToReference = &runtimev1alpha1.SecretReference{Namespace: secretNS, Name: secretName}
+			case *corev1.Secret:
+				if key != (client.ObjectKey{Namespace: secretNS, Name: secretName}) {
+					return errBoom
+				}
+				o.Data = map[string][]byte{runtimev1alpha1.ResourceCredentialsSecretKubeconfigKey: []byte(kubeconfig)}
+			}
+			return nil
+		},
+	}
+}
+
+func newExternal(a args) (*external, kubernetes.Interface) {
+	objs := []runtime.Object{}
+	if a.remote != nil {
+		objs = append(objs, a.remote)
+	}
+	remote := kfake.NewSimpleClientset(objs...)
+	return &external{
+		kube: a.kube,
+		newClientFn: func(kc []byte) (kubernetes.Interface, error) {
+			if string(kc) != kubeconfig {
+				return nil, errBoom
+			}
+			return remote, nil
+		},
+	}, remote
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClusterAuth
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				kube:   kube(),
+				remote: authConfigMap(adminRole),
+				cr:     clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"MissingMapping": {
+			args: args{
+				kube:   kube(),
+				remote: authConfigMap(),
+				cr:     clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoConfigMap": {
+			args: args{
+				kube: kube(),
+				cr:   clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(),
+			},
+		},
+		"NoClusterRef": {
+			args: args{
+				kube: kube(),
+				cr:   clusterAuth(withoutClusterRef()),
+			},
+			want: want{
+				cr:  clusterAuth(withoutClusterRef()),
+				err: errors.New(errNoClusterRef),
+			},
+		},
+		"FailedGetCluster": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   clusterAuth(),
+			},
+			want: want{
+				cr:  clusterAuth(),
+				err: errors.Wrap(errBoom, errGetCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, _ := newExternal(tc.args)
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr   *v1alpha1.ClusterAuth
+		data map[string]string
+		err  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: kube(),
+				cr:   clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(
+					withConditions(runtimev1alpha1.Creating()),
+					withStatus(v1alpha1.ClusterAuthObservation{RoleARNs: []string{adminARN}})),
+				data: map[string]string{"mapRoles": adminRole},
+			},
+		},
+		"AlreadyExists": {
+			args: args{
+				kube:   kube(),
+				remote: authConfigMap(),
+				cr:     clusterAuth(),
+			},
+			want: want{
+				cr:   clusterAuth(withConditions(runtimev1alpha1.Creating())),
+				data: map[string]string{"mapRoles": authConfigMap().Data["mapRoles"]},
+				err:  errors.Wrap(kerrors.NewAlreadyExists(schema.GroupResource{Resource: "configmaps"}, eks.AuthConfigMapName), errCreateAuthConfigMap),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, remote := newExternal(tc.args)
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			cm, _ := remote.CoreV1().ConfigMaps(eks.AuthConfigMapNamespace).Get(context.Background(), eks.AuthConfigMapName, metav1.GetOptions{})
+			if diff := cmp.Diff(tc.want.data, cm.Data); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr   *v1alpha1.ClusterAuth
+		data map[string]string
+		err  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AddMapping": {
+			args: args{
+				kube:   kube(),
+				remote: authConfigMap(),
+				cr:     clusterAuth(),
+			},
+			want: want{
+				cr:   clusterAuth(withStatus(v1alpha1.ClusterAuthObservation{RoleARNs: []string{adminARN}})),
+				data: authConfigMap(adminRole).Data,
+			},
+		},
+		"RemoveStaleMapping": {
+			args: args{
+				kube:   kube(),
+				remote: authConfigMap(adminRole),
+				cr: clusterAuth(
+					func(r *v1alpha1.ClusterAuth) { r.Spec.ForProvider.MapRoles = nil },
+					withStatus(v1alpha1.ClusterAuthObservation{RoleARNs: []string{adminARN}})),
+			},
+			want: want{
+				cr:   clusterAuth(func(r *v1alpha1.ClusterAuth) { r.Spec.ForProvider.MapRoles = nil }),
+				data: authConfigMap().Data,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, remote := newExternal(tc.args)
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			cm, _ := remote.CoreV1().ConfigMaps(eks.AuthConfigMapNamespace).Get(context.Background(), eks.AuthConfigMapName, metav1.GetOptions{})
+			if diff := cmp.Diff(tc.want.data, cm.Data); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr   *v1alpha1.ClusterAuth
+		data map[string]string
+		err  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube:   kube(),
+				remote: authConfigMap(adminRole),
+				cr:     clusterAuth(withStatus(v1alpha1.ClusterAuthObservation{RoleARNs: []string{adminARN}})),
+			},
+			want: want{
+				cr: clusterAuth(
+					withConditions(runtimev1alpha1.Deleting()),
+					withStatus(v1alpha1.ClusterAuthObservation{RoleARNs: []string{adminARN}})),
+				data: authConfigMap().Data,
+			},
+		},
+		"ClusterGone": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, clusterName))},
+				cr:   clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, remote := newExternal(tc.args)
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.data == nil {
+				return
+			}
+			cm, _ := remote.CoreV1().ConfigMaps(eks.AuthConfigMapNamespace).Get(context.Background(), eks.AuthConfigMapName, metav1.GetOptions{})
+			if diff := cmp.Diff(tc.want.data, cm.Data); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
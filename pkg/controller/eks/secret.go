@@ -29,6 +29,7 @@ import (
 	workloadv1alpha1 "github.com/crossplane/crossplane/apis/workload/v1alpha1"
 
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 // SetupClusterSecret adds a controller that propagates EKS Cluster connection
@@ -38,6 +39,7 @@ func SetupClusterSecret(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		Watches(&source.Kind{Type: &corev1.Secret{}}, &resource.EnqueueRequestForPropagated{}).
 		For(&corev1.Secret{}).
 		WithEventFilter(resource.NewPredicates(resource.AnyOf(
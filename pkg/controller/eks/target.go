@@ -29,6 +29,7 @@ import (
 	"github.com/crossplane/crossplane/apis/workload/v1alpha1"
 
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 // SetupClusterTarget adds a controller that propagates EKS Cluster connection
@@ -40,6 +41,7 @@ func SetupClusterTarget(mgr ctrl.Manager, l logging.Logger) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(strings.ToLower(fmt.Sprintf("kubernetestarget.%s.%s", v1beta1.ClusterKind, v1beta1.Group))).
 		For(&v1alpha1.KubernetesTarget{}).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		WithEventFilter(p).
 		Complete(target.NewReconciler(mgr,
 			resource.TargetKind(v1alpha1.KubernetesTargetGroupVersionKind),
@@ -0,0 +1,534 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationversion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselasticbeanstalk "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	appName = "app"
+	label   = "v1"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client elasticbeanstalk.ApplicationVersionClient
+	kube   client.Client
+	cr     *v1alpha1.ApplicationVersion
+}
+
+type versionModifier func(*v1alpha1.ApplicationVersion)
+
+func withConditions(c ...runtimev1alpha1.Condition) versionModifier {
+	return func(r *v1alpha1.ApplicationVersion) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) versionModifier {
+	return func(r *v1alpha1.ApplicationVersion) { r.Status.AtProvider.Status = s }
+}
+
+func withDescription(s string) versionModifier {
+	return func(r *v1alpha1.ApplicationVersion) { r.Spec.ForProvider.Description = aws.String(s) }
+}
+
+func applicationVersion(m ...versionModifier) *v1alpha1.ApplicationVersion {
+	cr := &v1alpha1.ApplicationVersion{
+		Spec: v1alpha1.ApplicationVersionSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ApplicationVersionParameters{
+				ApplicationName: aws.String(appName),
+				SourceBundle: &v1alpha1.SourceBundle{
+					S3Bucket: aws.String("bundles"),
+					S3Key:    "app.zip",
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, label)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeVersions(versions ...awselasticbeanstalk.ApplicationVersionDescription) func(*awselasticbeanstalk.DescribeApplicationVersionsInput) awselasticbeanstalk.DescribeApplicationVersionsRequest {
+	return func(*awselasticbeanstalk.DescribeApplicationVersionsInput) awselasticbeanstalk.DescribeApplicationVersionsRequest {
+		return awselasticbeanstalk.DescribeApplicationVersionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.DescribeApplicationVersionsOutput{ApplicationVersions: versions}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elasticbeanstalk.ApplicationVersionClient, error)
+		cr          *v1alpha1.ApplicationVersion
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i elasticbeanstalk.ApplicationVersionClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: applicationVersion(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i elasticbeanstalk.ApplicationVersionClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: applicationVersion(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApplicationVersion
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Processed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockApplicationVersionClient{
+					MockDescribeApplicationVersions: describeVersions(awselasticbeanstalk.ApplicationVersionDescription{
+						ApplicationName: aws.String(appName),
+						VersionLabel:    aws.String(label),
+						Description:     aws.String("first"),
+						Status:          awselasticbeanstalk.ApplicationVersionStatusProcessed,
+					}),
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				cr: applicationVersion(
+					withDescription("first"),
+					withStatus("Processed"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Processing": {
+			args: args{
+				client: &fake.MockApplicationVersionClient{
+					MockDescribeApplicationVersions: describeVersions(awselasticbeanstalk.ApplicationVersionDescription{
+						ApplicationName: aws.String(appName),
+						VersionLabel:    aws.String(label),
+						Status:          awselasticbeanstalk.ApplicationVersionStatusProcessing,
+					}),
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				cr: applicationVersion(
+					withStatus("Processing"),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockApplicationVersionClient{
+					MockDescribeApplicationVersions: describeVersions(awselasticbeanstalk.ApplicationVersionDescription{
+						ApplicationName: aws.String(appName),
+						VersionLabel:    aws.String(label),
+						Description:     aws.String("first"),
+						Status:          awselasticbeanstalk.ApplicationVersionStatusFailed,
+					}),
+				},
+				cr: applicationVersion(withDescription("second")),
+			},
+			want: want{
+				cr: applicationVersion(
+					withDescription("second"),
+					withStatus("Failed"),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockApplicationVersionClient{
+					MockDescribeApplicationVersions: describeVersions(),
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				cr: applicationVersion(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockApplicationVersionClient{
+					MockDescribeApplicationVersions: func(*awselasticbeanstalk.DescribeApplicationVersionsInput) awselasticbeanstalk.DescribeApplicationVersionsRequest {
+						return awselasticbeanstalk.DescribeApplicationVersionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				cr:  applicationVersion(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApplicationVersion
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockApplicationVersionClient{
+					MockCreateApplicationVersion: func(input *awselasticbeanstalk.CreateApplicationVersionInput) awselasticbeanstalk.CreateApplicationVersionRequest {
+						want := &awselasticbeanstalk.CreateApplicationVersionInput{
+							ApplicationName: aws.String(appName),
+							VersionLabel:    aws.String(label),
+							SourceBundle:    &awselasticbeanstalk.S3Location{S3Bucket: aws.String("bundles"), S3Key: aws.String("app.zip")},
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselasticbeanstalk.CreateApplicationVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.CreateApplicationVersionOutput{}},
+						}
+					},
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				cr: applicationVersion(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockApplicationVersionClient{
+					MockCreateApplicationVersion: func(input *awselasticbeanstalk.CreateApplicationVersionInput) awselasticbeanstalk.CreateApplicationVersionRequest {
+						return awselasticbeanstalk.CreateApplicationVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				cr:  applicationVersion(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ApplicationVersion
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockApplicationVersionClient{
+					MockDeleteApplicationVersion: func(input *awselasticbeanstalk.DeleteApplicationVersionInput) awselasticbeanstalk.DeleteApplicationVersionRequest {
+						if aws.BoolValue(input.DeleteSourceBundle) {
+							t.Errorf("source bundle must be retained")
+						}
+						return awselasticbeanstalk.DeleteApplicationVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.DeleteApplicationVersionOutput{}},
+						}
+					},
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				cr: applicationVersion(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockApplicationVersionClient{
+					MockDeleteApplicationVersion: func(input *awselasticbeanstalk.DeleteApplicationVersionInput) awselasticbeanstalk.DeleteApplicationVersionRequest {
+						return awselasticbeanstalk.DeleteApplicationVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: applicationVersion(),
+			},
+			want: want{
+				cr:  applicationVersion(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,646 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselasticbeanstalk "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elasticbeanstalk/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticbeanstalk/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	envName = "web"
+	appName = "app"
+	cname   = "web.us-east-1.elasticbeanstalk.com"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client elasticbeanstalk.EnvironmentClient
+	kube   client.Client
+	cr     *v1alpha1.Environment
+}
+
+type environmentModifier func(*v1alpha1.Environment)
+
+func withConditions(c ...runtimev1alpha1.Condition) environmentModifier {
+	return func(r *v1alpha1.Environment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.EnvironmentObservation) environmentModifier {
+	return func(r *v1alpha1.Environment) { r.Status.AtProvider = o }
+}
+
+func withVersionLabel(s string) environmentModifier {
+	return func(r *v1alpha1.Environment) { r.Spec.ForProvider.VersionLabel = aws.String(s) }
+}
+
+func withOptionSettings(o ...v1alpha1.OptionSetting) environmentModifier {
+	return func(r *v1alpha1.Environment) { r.Spec.ForProvider.OptionSettings = o }
+}
+
+func environment(m ...environmentModifier) *v1alpha1.Environment {
+	cr := &v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.EnvironmentParameters{
+				ApplicationName:   aws.String(appName),
+				Description:       aws.String("web"),
+				SolutionStackName: aws.String("64bit Amazon Linux 2 v3.1.0 running Go 1"),
+				Tier:              &v1alpha1.EnvironmentTier{Name: "WebServer", Type: "Standard"},
+			},
+		},
+	}
+	meta.SetExternalName(cr, envName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(status awselasticbeanstalk.EnvironmentStatus) awselasticbeanstalk.EnvironmentDescription {
+	return awselasticbeanstalk.EnvironmentDescription{
+		ApplicationName:   aws.String(appName),
+		EnvironmentName:   aws.String(envName),
+		EnvironmentId:     aws.String("e-1234"),
+		CNAME:             aws.String(cname),
+		Description:       aws.String("web"),
+		VersionLabel:      aws.String("v1"),
+		SolutionStackName: aws.String("64bit Amazon Linux 2 v3.1.0 running Go 1"),
+		Tier:              &awselasticbeanstalk.EnvironmentTier{Name: aws.String("WebServer"), Type: aws.String("Standard")},
+		Status:            status,
+		Health:            awselasticbeanstalk.EnvironmentHealthGreen,
+	}
+}
+
+func observation(status string) v1alpha1.EnvironmentObservation {
+	return v1alpha1.EnvironmentObservation{EnvironmentID: "e-1234", CNAME: cname, Status: status, Health: "Green"}
+}
+
+func connection() managed.ConnectionDetails {
+	return managed.ConnectionDetails{runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cname)}
+}
+
+func describeEnvironments(envs ...awselasticbeanstalk.EnvironmentDescription) func(*awselasticbeanstalk.DescribeEnvironmentsInput) awselasticbeanstalk.DescribeEnvironmentsRequest {
+	return func(*awselasticbeanstalk.DescribeEnvironmentsInput) awselasticbeanstalk.DescribeEnvironmentsRequest {
+		return awselasticbeanstalk.DescribeEnvironmentsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.DescribeEnvironmentsOutput{Environments: envs}},
+		}
+	}
+}
+
+func describeSettings(settings ...awselasticbeanstalk.ConfigurationOptionSetting) func(*awselasticbeanstalk.DescribeConfigurationSettingsInput) awselasticbeanstalk.DescribeConfigurationSettingsRequest {
+	return func(*awselasticbeanstalk.DescribeConfigurationSettingsInput) awselasticbeanstalk.DescribeConfigurationSettingsRequest {
+		return awselasticbeanstalk.DescribeConfigurationSettingsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.DescribeConfigurationSettingsOutput{
+				ConfigurationSettings: []awselasticbeanstalk.ConfigurationSettingsDescription{{OptionSettings: settings}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elasticbeanstalk.EnvironmentClient, error)
+		cr          *v1alpha1.Environment
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i elasticbeanstalk.EnvironmentClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: environment(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i elasticbeanstalk.EnvironmentClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: environment(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: environment(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: environment(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: environment(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Environment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	maxSize := v1alpha1.OptionSetting{Namespace: "aws:autoscaling:asg", OptionName: "MaxSize", Value: "4"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Ready": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments: describeEnvironments(observed(awselasticbeanstalk.EnvironmentStatusReady)),
+					MockDescribeConfigurationSettings: describeSettings(awselasticbeanstalk.ConfigurationOptionSetting{
+						Namespace: aws.String(maxSize.Namespace), OptionName: aws.String(maxSize.OptionName), Value: aws.String("4"),
+					}),
+				},
+				cr: environment(withOptionSettings(maxSize)),
+			},
+			want: want{
+				cr: environment(
+					withOptionSettings(maxSize),
+					withVersionLabel("v1"),
+					withObservation(observation("Ready")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection(),
+				},
+			},
+		},
+		"OptionSettingChanged": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments:          describeEnvironments(observed(awselasticbeanstalk.EnvironmentStatusReady)),
+					MockDescribeConfigurationSettings: describeSettings(),
+				},
+				cr: environment(withVersionLabel("v1"), withOptionSettings(maxSize)),
+			},
+			want: want{
+				cr: environment(
+					withVersionLabel("v1"),
+					withOptionSettings(maxSize),
+					withObservation(observation("Ready")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connection(),
+				},
+			},
+		},
+		"Launching": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments: describeEnvironments(observed(awselasticbeanstalk.EnvironmentStatusLaunching)),
+				},
+				cr: environment(withVersionLabel("v2")),
+			},
+			want: want{
+				cr: environment(
+					withVersionLabel("v2"),
+					withObservation(observation("Launching")),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection(),
+				},
+			},
+		},
+		"Terminated": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments: describeEnvironments(observed(awselasticbeanstalk.EnvironmentStatusTerminated)),
+				},
+				cr: environment(),
+			},
+			want: want{
+				cr: environment(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments: describeEnvironments(),
+				},
+				cr: environment(),
+			},
+			want: want{
+				cr: environment(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockDescribeEnvironments: func(*awselasticbeanstalk.DescribeEnvironmentsInput) awselasticbeanstalk.DescribeEnvironmentsRequest {
+						return awselasticbeanstalk.DescribeEnvironmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: environment(),
+			},
+			want: want{
+				cr:  environment(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Environment
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockCreateEnvironment: func(input *awselasticbeanstalk.CreateEnvironmentInput) awselasticbeanstalk.CreateEnvironmentRequest {
+						if diff := cmp.Diff(envName, aws.StringValue(input.EnvironmentName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselasticbeanstalk.CreateEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.CreateEnvironmentOutput{}},
+						}
+					},
+				},
+				cr: environment(),
+			},
+			want: want{
+				cr: environment(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockCreateEnvironment: func(input *awselasticbeanstalk.CreateEnvironmentInput) awselasticbeanstalk.CreateEnvironmentRequest {
+						return awselasticbeanstalk.CreateEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: environment(),
+			},
+			want: want{
+				cr:  environment(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Environment
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockUpdateEnvironment: func(input *awselasticbeanstalk.UpdateEnvironmentInput) awselasticbeanstalk.UpdateEnvironmentRequest {
+						if diff := cmp.Diff("v2", aws.StringValue(input.VersionLabel)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselasticbeanstalk.UpdateEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.UpdateEnvironmentOutput{}},
+						}
+					},
+				},
+				cr: environment(withVersionLabel("v2")),
+			},
+			want: want{
+				cr: environment(withVersionLabel("v2")),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockUpdateEnvironment: func(input *awselasticbeanstalk.UpdateEnvironmentInput) awselasticbeanstalk.UpdateEnvironmentRequest {
+						return awselasticbeanstalk.UpdateEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: environment(),
+			},
+			want: want{
+				cr:  environment(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Environment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockTerminateEnvironment: func(input *awselasticbeanstalk.TerminateEnvironmentInput) awselasticbeanstalk.TerminateEnvironmentRequest {
+						return awselasticbeanstalk.TerminateEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselasticbeanstalk.TerminateEnvironmentOutput{}},
+						}
+					},
+				},
+				cr: environment(),
+			},
+			want: want{
+				cr: environment(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyTerminating": {
+			args: args{
+				client: &fake.MockEnvironmentClient{},
+				cr:     environment(withObservation(observation("Terminating"))),
+			},
+			want: want{
+				cr: environment(withObservation(observation("Terminating")), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockEnvironmentClient{
+					MockTerminateEnvironment: func(input *awselasticbeanstalk.TerminateEnvironmentInput) awselasticbeanstalk.TerminateEnvironmentRequest {
+						return awselasticbeanstalk.TerminateEnvironmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: environment(),
+			},
+			want: want{
+				cr:  environment(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awselb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,14 +31,21 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	v1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
-	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticloadbalancing/elb"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/usage"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 )
 
 const (
@@ -51,6 +59,7 @@ const (
 	errMultipleItems = "retrieved multiple ELBs for the given name"
 	errCreate        = "failed to register instance to ELB"
 	errDelete        = "failed to deregister instance from the ELB"
+	errUpdate        = "cannot update ELBAttachment custom resource"
 )
 
 // SetupELBAttachment adds a controller that reconciles ELBAttachmets.
@@ -58,16 +67,30 @@ func SetupELBAttachment(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(v1alpha1.ELBAttachmentGroupKind)
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha1.ELBAttachment{}).
+		Watches(shard.NewKind(mgr.GetCache(), &v1alpha1.ELB{}), usage.EnqueueUsers(mgr.GetClient(), shard.OwnerOf(mgr.GetCache()), &v1alpha1.ELBAttachmentList{}, uses)).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ELBAttachmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}))))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient(), uses)),
 			managed.WithConnectionPublishers(),
+			managed.WithInitializers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// uses returns the ELB that the supplied ELBAttachment uses, which must not be
+// deleted before it.
+func uses(mg resource.Managed) []usage.Use {
+	cr, ok := mg.(*v1alpha1.ELBAttachment)
+	if !ok {
+		return nil
+	}
+	return []usage.Use{{Reference: cr.Spec.ForProvider.ELBNameRef, Selector: cr.Spec.ForProvider.ELBNameSelector, To: &v1alpha1.ELB{}}}
+}
+
 type connector struct {
 	kube        client.Client
 	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elb.Client, error)
@@ -84,6 +107,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		elbClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
 		return &external{client: elbClient, kube: c.kube}, errors.Wrap(err, errCreateELBClient)
@@ -114,8 +142,13 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
+	elbName, instanceID, err := attachment(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	response, err := e.client.DescribeLoadBalancersRequest(&awselb.DescribeLoadBalancersInput{
-		LoadBalancerNames: []string{cr.Spec.ForProvider.ELBName},
+		LoadBalancerNames: []string{elbName},
 	}).Send(ctx)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elb.IsELBNotFound, err), errDescribe)
@@ -130,7 +163,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	var instance string
 	for k, v := range observed.Instances {
-		if *v.InstanceId == cr.Spec.ForProvider.InstanceID {
+		if aws.StringValue(v.InstanceId) == instanceID {
 			instance = aws.StringValue(observed.Instances[k].InstanceId)
 		}
 	}
@@ -139,11 +172,22 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, nil
 	}
 
+	current := cr.Spec.ForProvider.DeepCopy()
+	cr.Spec.ForProvider.ELBName = awsclients.LateInitializeString(cr.Spec.ForProvider.ELBName, aws.String(elbName))
+	cr.Spec.ForProvider.InstanceID = awsclients.LateInitializeString(cr.Spec.ForProvider.InstanceID, aws.String(instanceID))
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
 	cr.Status.SetConditions(runtimev1alpha1.Available())
 
+	// The external name records the registration that exists, which must be
+	// moved once the ELB or instance of the spec changes.
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceUpToDate: cr.Spec.ForProvider.ELBName == elbName && cr.Spec.ForProvider.InstanceID == instanceID,
 	}, nil
 }
 
@@ -159,12 +203,51 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		Instances:        []awselb.Instance{{InstanceId: aws.String(cr.Spec.ForProvider.InstanceID)}},
 		LoadBalancerName: aws.String(cr.Spec.ForProvider.ELBName),
 	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, awsclients.CompositeExternalName(cr.Spec.ForProvider.ELBName, cr.Spec.ForProvider.InstanceID))
 
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errUpdate)
 }
 
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
-	return managed.ExternalUpdate{}, nil
+	cr, ok := mgd.(*v1alpha1.ELBAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	elbName, instanceID, err := attachment(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if cr.Spec.ForProvider.ELBName == elbName && cr.Spec.ForProvider.InstanceID == instanceID {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// The instance of the spec is registered before the stale one is
+	// deregistered, so that the ELB keeps serving while it is replaced.
+	_, err = e.client.RegisterInstancesWithLoadBalancerRequest(&awselb.RegisterInstancesWithLoadBalancerInput{
+		Instances:        []awselb.Instance{{InstanceId: aws.String(cr.Spec.ForProvider.InstanceID)}},
+		LoadBalancerName: aws.String(cr.Spec.ForProvider.ELBName),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCreate)
+	}
+
+	_, err = e.client.DeregisterInstancesFromLoadBalancerRequest(&awselb.DeregisterInstancesFromLoadBalancerInput{
+		Instances:        []awselb.Instance{{InstanceId: aws.String(instanceID)}},
+		LoadBalancerName: aws.String(elbName),
+	}).Send(ctx)
+	if resource.Ignore(errorutils.IsNotFound, err) != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDelete)
+	}
+
+	meta.SetExternalName(cr, awsclients.CompositeExternalName(cr.Spec.ForProvider.ELBName, cr.Spec.ForProvider.InstanceID))
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.kube.Update(ctx, cr), errUpdate)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -175,10 +258,27 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
 
-	_, err := e.client.DeregisterInstancesFromLoadBalancerRequest(&awselb.DeregisterInstancesFromLoadBalancerInput{
-		Instances:        []awselb.Instance{{InstanceId: aws.String(cr.Spec.ForProvider.InstanceID)}},
-		LoadBalancerName: aws.String(cr.Spec.ForProvider.ELBName),
+	elbName, instanceID, err := attachment(cr)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.client.DeregisterInstancesFromLoadBalancerRequest(&awselb.DeregisterInstancesFromLoadBalancerInput{
+		Instances:        []awselb.Instance{{InstanceId: aws.String(instanceID)}},
+		LoadBalancerName: aws.String(elbName),
 	}).Send(ctx)
 
-	return errors.Wrap(resource.Ignore(ec2.IsVPCNotFoundErr, err), errDelete)
+	return errors.Wrap(resource.Ignore(errorutils.IsNotFound, err), errDelete)
+}
+
+// attachment returns the ELB name and instance ID that identify the supplied
+// attachment. They are read from its external name, which has the form
+// elb-name/instance-id, falling back to its spec until the external name is
+// set.
+func attachment(cr *v1alpha1.ELBAttachment) (string, string, error) {
+	parts, err := awsclients.CompositeExternalNameOf(cr, 2)
+	if err != nil || parts == nil {
+		return cr.Spec.ForProvider.ELBName, cr.Spec.ForProvider.InstanceID, err
+	}
+	return parts[0], parts[1], nil
 }
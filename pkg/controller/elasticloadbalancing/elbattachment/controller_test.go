@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awselb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -46,8 +47,11 @@ import (
 )
 
 var (
-	elbName    = "some-elb"
-	instanceID = "someID"
+	attachmentName = "some-attachment"
+	elbName        = "some-elb"
+	otherELBName   = "other-elb"
+	instanceID     = "someID"
+	otherID        = "otherID"
 
 	errBoom = errors.New("boom")
 
@@ -71,8 +75,9 @@ const (
 )
 
 type args struct {
-	elb elb.Client
-	cr  resource.Managed
+	elb  elb.Client
+	kube client.Client
+	cr   resource.Managed
 }
 
 type elbAttachmentModifier func(*v1alpha1.ELBAttachment)
@@ -91,6 +96,7 @@ func withExternalName(name string) elbAttachmentModifier {
 
 func elbAttachmentResource(m ...elbAttachmentModifier) *v1alpha1.ELBAttachment {
 	cr := &v1alpha1.ELBAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: attachmentName},
 		Spec: v1alpha1.ELBAttachmentSpec{
 			ResourceSpec: corev1alpha1.ResourceSpec{
 				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
@@ -290,7 +296,7 @@ func TestObserve(t *testing.T) {
 						}
 					},
 				},
-				cr: elbAttachmentResource(withExternalName(elbName),
+				cr: elbAttachmentResource(withExternalName(attachmentName),
 					withSpec(v1alpha1.ELBAttachmentParameters{
 						ELBName:    elbName,
 						InstanceID: instanceID,
@@ -301,7 +307,7 @@ func TestObserve(t *testing.T) {
 					ELBName:    elbName,
 					InstanceID: instanceID,
 				}),
-					withExternalName(elbName),
+					withExternalName(attachmentName),
 					withConditions(corev1alpha1.Available())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -309,6 +315,74 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ImportedByExternalName": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
+						return awselb.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
+								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{loadBalancer},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: elbAttachmentResource(withExternalName(elbName + "/" + instanceID)),
+			},
+			want: want{
+				cr: elbAttachmentResource(withSpec(v1alpha1.ELBAttachmentParameters{
+					ELBName:    elbName,
+					InstanceID: instanceID,
+				}),
+					withExternalName(elbName+"/"+instanceID),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"StaleInstance": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(input *awselb.DescribeLoadBalancersInput) awselb.DescribeLoadBalancersRequest {
+						return awselb.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DescribeLoadBalancersOutput{
+								LoadBalancerDescriptions: []awselb.LoadBalancerDescription{loadBalancer},
+							}},
+						}
+					},
+				},
+				cr: elbAttachmentResource(withExternalName(elbName+"/"+instanceID),
+					withSpec(v1alpha1.ELBAttachmentParameters{
+						ELBName:    elbName,
+						InstanceID: otherID,
+					})),
+			},
+			want: want{
+				cr: elbAttachmentResource(withExternalName(elbName+"/"+instanceID),
+					withSpec(v1alpha1.ELBAttachmentParameters{
+						ELBName:    elbName,
+						InstanceID: otherID,
+					}),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"InvalidExternalName": {
+			args: args{
+				cr: elbAttachmentResource(withExternalName(elbName)),
+			},
+			want: want{
+				cr:  elbAttachmentResource(withExternalName(elbName)),
+				err: errors.Errorf("external name %q must consist of %d non-empty parts separated by /", elbName, 2),
+			},
+		},
 		"NoAttachment": {
 			args: args{
 				elb: &fake.MockClient{
@@ -360,7 +434,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.elb}
+			e := &external{client: tc.elb, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -397,14 +471,17 @@ func TestCreate(t *testing.T) {
 						}
 					},
 				},
-				cr: elbAttachmentResource(withExternalName(elbName),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: elbAttachmentResource(withExternalName(attachmentName),
 					withSpec(v1alpha1.ELBAttachmentParameters{
 						ELBName:    elbName,
 						InstanceID: instanceID,
 					})),
 			},
 			want: want{
-				cr: elbAttachmentResource(withExternalName(elbName),
+				cr: elbAttachmentResource(withExternalName(elbName+"/"+instanceID),
 					withSpec(v1alpha1.ELBAttachmentParameters{
 						ELBName:    elbName,
 						InstanceID: instanceID,
@@ -421,14 +498,14 @@ func TestCreate(t *testing.T) {
 						}
 					},
 				},
-				cr: elbAttachmentResource(withExternalName(elbName),
+				cr: elbAttachmentResource(withExternalName(attachmentName),
 					withSpec(v1alpha1.ELBAttachmentParameters{
 						ELBName:    elbName,
 						InstanceID: instanceID,
 					})),
 			},
 			want: want{
-				cr: elbAttachmentResource(withExternalName(elbName),
+				cr: elbAttachmentResource(withExternalName(attachmentName),
 					withSpec(v1alpha1.ELBAttachmentParameters{
 						ELBName:    elbName,
 						InstanceID: instanceID,
@@ -441,7 +518,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.elb}
+			e := &external{client: tc.elb, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -457,6 +534,128 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	register := func(err error) func(*awselb.RegisterInstancesWithLoadBalancerInput) awselb.RegisterInstancesWithLoadBalancerRequest {
+		return func(input *awselb.RegisterInstancesWithLoadBalancerInput) awselb.RegisterInstancesWithLoadBalancerRequest {
+			return awselb.RegisterInstancesWithLoadBalancerRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.RegisterInstancesWithLoadBalancerOutput{}, Error: err},
+			}
+		}
+	}
+	deregister := func(err error) func(*awselb.DeregisterInstancesFromLoadBalancerInput) awselb.DeregisterInstancesFromLoadBalancerRequest {
+		return func(input *awselb.DeregisterInstancesFromLoadBalancerInput) awselb.DeregisterInstancesFromLoadBalancerRequest {
+			return awselb.DeregisterInstancesFromLoadBalancerRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselb.DeregisterInstancesFromLoadBalancerOutput{}, Error: err},
+			}
+		}
+	}
+	spec := withSpec(v1alpha1.ELBAttachmentParameters{
+		ELBName:    otherELBName,
+		InstanceID: otherID,
+	})
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				elb: &fake.MockClient{
+					MockRegisterInstancesWithLoadBalancerRequest:   register(nil),
+					MockDeregisterInstancesFromLoadBalancerRequest: deregister(nil),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: elbAttachmentResource(withExternalName(elbName+"/"+instanceID), spec),
+			},
+			want: want{
+				cr: elbAttachmentResource(withExternalName(otherELBName+"/"+otherID), spec),
+			},
+		},
+		"AlreadyDeregistered": {
+			args: args{
+				elb: &fake.MockClient{
+					MockRegisterInstancesWithLoadBalancerRequest:   register(nil),
+					MockDeregisterInstancesFromLoadBalancerRequest: deregister(awserr.New(awselb.ErrCodeAccessPointNotFoundException, "", nil)),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: elbAttachmentResource(withExternalName(elbName+"/"+instanceID), spec),
+			},
+			want: want{
+				cr: elbAttachmentResource(withExternalName(otherELBName+"/"+otherID), spec),
+			},
+		},
+		"RegisterError": {
+			args: args{
+				elb: &fake.MockClient{
+					MockRegisterInstancesWithLoadBalancerRequest: register(errBoom),
+				},
+				cr: elbAttachmentResource(withExternalName(elbName+"/"+instanceID), spec),
+			},
+			want: want{
+				cr:  elbAttachmentResource(withExternalName(elbName+"/"+instanceID), spec),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"DeregisterError": {
+			args: args{
+				elb: &fake.MockClient{
+					MockRegisterInstancesWithLoadBalancerRequest:   register(nil),
+					MockDeregisterInstancesFromLoadBalancerRequest: deregister(errBoom),
+				},
+				cr: elbAttachmentResource(withExternalName(elbName+"/"+instanceID), spec),
+			},
+			want: want{
+				cr:  elbAttachmentResource(withExternalName(elbName+"/"+instanceID), spec),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				elb: &fake.MockClient{
+					MockRegisterInstancesWithLoadBalancerRequest:   register(nil),
+					MockDeregisterInstancesFromLoadBalancerRequest: deregister(nil),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: elbAttachmentResource(withExternalName(elbName+"/"+instanceID), spec),
+			},
+			want: want{
+				cr:  elbAttachmentResource(withExternalName(otherELBName+"/"+otherID), spec),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.elb, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 
 	type want struct {
@@ -477,10 +676,10 @@ func TestDelete(t *testing.T) {
 						}
 					},
 				},
-				cr: elbAttachmentResource(withExternalName(elbName)),
+				cr: elbAttachmentResource(withExternalName(attachmentName)),
 			},
 			want: want{
-				cr: elbAttachmentResource(withExternalName(elbName),
+				cr: elbAttachmentResource(withExternalName(attachmentName),
 					withConditions(corev1alpha1.Deleting())),
 			},
 		},
@@ -493,14 +692,30 @@ func TestDelete(t *testing.T) {
 						}
 					},
 				},
-				cr: elbAttachmentResource(withExternalName(elbName)),
+				cr: elbAttachmentResource(withExternalName(attachmentName)),
 			},
 			want: want{
-				cr: elbAttachmentResource(withExternalName(elbName),
+				cr: elbAttachmentResource(withExternalName(attachmentName),
 					withConditions(corev1alpha1.Deleting())),
 				err: errors.Wrap(errBoom, errDelete),
 			},
 		},
+		"AlreadyDeregistered": {
+			args: args{
+				elb: &fake.MockClient{
+					MockDeregisterInstancesFromLoadBalancerRequest: func(input *awselb.DeregisterInstancesFromLoadBalancerInput) awselb.DeregisterInstancesFromLoadBalancerRequest {
+						return awselb.DeregisterInstancesFromLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awselb.ErrCodeAccessPointNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: elbAttachmentResource(withExternalName(attachmentName)),
+			},
+			want: want{
+				cr: elbAttachmentResource(withExternalName(attachmentName),
+					withConditions(corev1alpha1.Deleting())),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,327 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a LoadBalancer resource"
+	errCreateClient      = "cannot create ELBv2 client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errDescribe          = "cannot describe LoadBalancer with given name"
+	errDescribeListeners = "cannot describe listeners of LoadBalancer"
+	errDescribeTags      = "cannot describe tags of LoadBalancer"
+	errNotSingleItem     = "retrieved more than one LoadBalancer for the given name"
+	errCreate            = "cannot create the LoadBalancer resource"
+	errUpdate            = "cannot update the LoadBalancer resource"
+	errUpdateListeners   = "cannot update listeners of the LoadBalancer resource"
+	errUpdateTags        = "cannot update tags of the LoadBalancer resource"
+	errDelete            = "cannot delete the LoadBalancer resource"
+	errSpecUpdate        = "cannot update spec of LoadBalancer custom resource"
+)
+
+// SetupLoadBalancer adds a controller that reconciles LoadBalancers.
+func SetupLoadBalancer(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LoadBalancerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.LoadBalancer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LoadBalancerGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewLoadBalancerClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elbv2.LoadBalancerClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client elbv2.LoadBalancerClient
+}
+
+// describe returns the load balancer with the supplied name, its listeners
+// and its tags.
+func (e *external) describe(ctx context.Context, name string) (*awselbv2.LoadBalancer, []awselbv2.Listener, []awselbv2.Tag, error) {
+	rsp, err := e.client.DescribeLoadBalancersRequest(&awselbv2.DescribeLoadBalancersInput{
+		Names: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, errDescribe)
+	}
+	if len(rsp.LoadBalancers) != 1 {
+		return nil, nil, nil, errors.New(errNotSingleItem)
+	}
+	lb := rsp.LoadBalancers[0]
+
+	// A load balancer has at most 50 listeners, which fit in a single page.
+	lrsp, err := e.client.DescribeListenersRequest(&awselbv2.DescribeListenersInput{
+		LoadBalancerArn: lb.LoadBalancerArn,
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, errDescribeListeners)
+	}
+
+	trsp, err := e.client.DescribeTagsRequest(&awselbv2.DescribeTagsInput{
+		ResourceArns: []string{aws.StringValue(lb.LoadBalancerArn)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, errDescribeTags)
+	}
+	var tags []awselbv2.Tag
+	if len(trsp.TagDescriptions) != 0 {
+		tags = trsp.TagDescriptions[0].Tags
+	}
+
+	return &lb, lrsp.Listeners, tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	lb, listeners, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if elbv2.IsNotFound(errors.Cause(err)) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeLoadBalancer(&cr.Spec.ForProvider, lb, listeners, tags)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = elbv2.GenerateLoadBalancerObservation(*lb)
+
+	switch cr.Status.AtProvider.State {
+	case string(awselbv2.LoadBalancerStateEnumActive):
+		cr.SetConditions(runtimev1alpha1.Available())
+	case string(awselbv2.LoadBalancerStateEnumProvisioning):
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: elbv2.IsLoadBalancerUpToDate(cr.Spec.ForProvider, *lb, listeners, tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	// The listeners are created by the first update, once the load balancer
+	// is observed and its ARN is known.
+	_, err := e.client.CreateLoadBalancerRequest(elbv2.GenerateCreateLoadBalancerInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	lb, listeners, tags, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p := cr.Spec.ForProvider
+
+	if p.IPAddressType != nil && aws.StringValue(p.IPAddressType) != string(lb.IpAddressType) {
+		if _, err := e.client.SetIpAddressTypeRequest(&awselbv2.SetIpAddressTypeInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+			IpAddressType:   awselbv2.IpAddressType(aws.StringValue(p.IPAddressType)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	if len(p.SubnetIDs) != 0 && !elbv2.IsSubnetsUpToDate(p, *lb) {
+		if _, err := e.client.SetSubnetsRequest(&awselbv2.SetSubnetsInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+			Subnets:         p.SubnetIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	if len(p.SecurityGroupIDs) != 0 && !elbv2.IsSecurityGroupsUpToDate(p, *lb) {
+		if _, err := e.client.SetSecurityGroupsRequest(&awselbv2.SetSecurityGroupsInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+			SecurityGroups:  p.SecurityGroupIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	if err := e.updateListeners(ctx, aws.StringValue(lb.LoadBalancerArn), p.Listeners, listeners); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateListeners)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.updateTags(ctx, aws.StringValue(lb.LoadBalancerArn), p.Tags, tags), errUpdateTags)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	// The listeners of a load balancer are deleted with it.
+	_, err := e.client.DeleteLoadBalancerRequest(&awselbv2.DeleteLoadBalancerInput{
+		LoadBalancerArn: aws.String(cr.Status.AtProvider.LoadBalancerARN),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(elbv2.IsNotFound, err), errDelete)
+}
+
+// updateListeners deletes the listeners on ports that are no longer desired
+// before it modifies and creates listeners, so that the load balancer never
+// has more listeners than it will end up with.
+func (e *external) updateListeners(ctx context.Context, arn string, desired []v1alpha1.Listener, observed []awselbv2.Listener) error {
+	create, modify, remove := elbv2.DiffListeners(arn, desired, observed)
+
+	for i := range remove {
+		if _, err := e.client.DeleteListenerRequest(&remove[i]).Send(ctx); resource.Ignore(elbv2.IsListenerNotFound, err) != nil {
+			return err
+		}
+	}
+	for i := range modify {
+		if _, err := e.client.ModifyListenerRequest(&modify[i]).Send(ctx); err != nil {
+			return err
+		}
+	}
+	for i := range create {
+		if _, err := e.client.CreateListenerRequest(&create[i]).Send(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *external) updateTags(ctx context.Context, arn string, desired []v1alpha1.Tag, observed []awselbv2.Tag) error {
+	add, remove := elbv2.DiffTags(desired, observed)
+
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveTagsRequest(&awselbv2.RemoveTagsInput{
+			ResourceArns: []string{arn},
+			TagKeys:      remove,
+		}).Send(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(add) != 0 {
+		if _, err := e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+			ResourceArns: []string{arn},
+			Tags:         add,
+		}).Send(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,778 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	lbName      = "some-lb"
+	lbARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/some-lb/50dc6c495c0c9188"
+	listenerARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/some-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	targetGroup = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/some-tg/73e2d6bc24d8a067"
+	dnsName     = "some-lb-1234567890.us-east-1.elb.amazonaws.com"
+	subnetID    = "subnet-1"
+	sgID        = "sg-1"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awselbv2.ErrCodeLoadBalancerNotFoundException, "not found", nil)
+
+	listener = v1alpha1.Listener{
+		Port:     80,
+		Protocol: "HTTP",
+		DefaultActions: []v1alpha1.Action{{
+			Type:           "forward",
+			Order:          aws.Int64(1),
+			TargetGroupARN: aws.String(targetGroup),
+		}},
+	}
+	awsListener = awselbv2.Listener{
+		ListenerArn: aws.String(listenerARN),
+		Port:        aws.Int64(80),
+		Protocol:    awselbv2.ProtocolEnumHttp,
+		DefaultActions: []awselbv2.Action{{
+			Type:           awselbv2.ActionTypeEnumForward,
+			Order:          aws.Int64(1),
+			TargetGroupArn: aws.String(targetGroup),
+		}},
+	}
+)
+
+type args struct {
+	client elbv2.LoadBalancerClient
+	kube   client.Client
+	cr     *v1alpha1.LoadBalancer
+}
+
+type lbModifier func(*v1alpha1.LoadBalancer)
+
+func withConditions(c ...runtimev1alpha1.Condition) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.LoadBalancerParameters) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.LoadBalancerObservation) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.AtProvider = o }
+}
+
+func loadBalancer(m ...lbModifier) *v1alpha1.LoadBalancer {
+	cr := &v1alpha1.LoadBalancer{
+		Spec: v1alpha1.LoadBalancerSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: params(),
+		},
+	}
+	meta.SetExternalName(cr, lbName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.LoadBalancerParameters {
+	return v1alpha1.LoadBalancerParameters{
+		Type:             aws.String("application"),
+		Scheme:           aws.String("internet-facing"),
+		IPAddressType:    aws.String("ipv4"),
+		SubnetIDs:        []string{subnetID},
+		SecurityGroupIDs: []string{sgID},
+		Listeners:        []v1alpha1.Listener{listener},
+		Tags:             []v1alpha1.Tag{{Key: "k1", Value: aws.String("v1")}},
+	}
+}
+
+func observation(state string) v1alpha1.LoadBalancerObservation {
+	return v1alpha1.LoadBalancerObservation{
+		LoadBalancerARN:   lbARN,
+		DNSName:           dnsName,
+		State:             state,
+		AvailabilityZones: []string{"us-east-1a"},
+	}
+}
+
+func describeLoadBalancers(state awselbv2.LoadBalancerStateEnum) func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+	return func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+		return awselbv2.DescribeLoadBalancersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeLoadBalancersOutput{
+				LoadBalancers: []awselbv2.LoadBalancer{{
+					LoadBalancerArn:   aws.String(lbARN),
+					DNSName:           aws.String(dnsName),
+					Type:              awselbv2.LoadBalancerTypeEnumApplication,
+					Scheme:            awselbv2.LoadBalancerSchemeEnumInternetFacing,
+					IpAddressType:     awselbv2.IpAddressTypeIpv4,
+					AvailabilityZones: []awselbv2.AvailabilityZone{{ZoneName: aws.String("us-east-1a"), SubnetId: aws.String(subnetID)}},
+					SecurityGroups:    []string{sgID},
+					State:             &awselbv2.LoadBalancerState{Code: state},
+				}},
+			}},
+		}
+	}
+}
+
+func describeListeners(l ...awselbv2.Listener) func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+	return func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+		return awselbv2.DescribeListenersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeListenersOutput{Listeners: l}},
+		}
+	}
+}
+
+func describeTags(t ...awselbv2.Tag) func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+	return func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTagsOutput{
+				TagDescriptions: []awselbv2.TagDescription{{ResourceArn: aws.String(lbARN), Tags: t}},
+			}},
+		}
+	}
+}
+
+var k1v1 = awselbv2.Tag{Key: aws.String("k1"), Value: aws.String("v1")}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (elbv2.LoadBalancerClient, error)
+		cr          *v1alpha1.LoadBalancer
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (elbv2.LoadBalancerClient, error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: loadBalancer(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (elbv2.LoadBalancerClient, error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: loadBalancer(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LoadBalancer
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeLoadBalancers(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeListeners:     describeListeners(awsListener),
+					MockDescribeTags:          describeTags(k1v1),
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr: loadBalancer(
+					withObservation(observation("active")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Provisioning": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeLoadBalancers(awselbv2.LoadBalancerStateEnumProvisioning),
+					MockDescribeListeners:     describeListeners(),
+					MockDescribeTags:          describeTags(k1v1),
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr: loadBalancer(
+					withObservation(observation("provisioning")),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeLoadBalancers(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeListeners:     describeListeners(awsListener),
+					MockDescribeTags:          describeTags(k1v1),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: loadBalancer(withSpec(v1alpha1.LoadBalancerParameters{})),
+			},
+			want: want{
+				cr: loadBalancer(
+					withObservation(observation("active")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr: loadBalancer(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr:  loadBalancer(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"DescribeListenersFailed": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeLoadBalancers(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeListeners: func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+						return awselbv2.DescribeListenersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr:  loadBalancer(),
+				err: errors.Wrap(errBoom, errDescribeListeners),
+			},
+		},
+		"SpecUpdateFailed": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeLoadBalancers(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeListeners:     describeListeners(awsListener),
+					MockDescribeTags:          describeTags(k1v1),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: loadBalancer(withSpec(v1alpha1.LoadBalancerParameters{})),
+			},
+			want: want{
+				cr:  loadBalancer(),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LoadBalancer
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockCreateLoadBalancer: func(input *awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						if diff := cmp.Diff(lbName, aws.StringValue(input.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateLoadBalancerOutput{}},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr: loadBalancer(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockCreateLoadBalancer: func(*awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr:  loadBalancer(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LoadBalancer
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	https := v1alpha1.Listener{
+		Port:           443,
+		Protocol:       "HTTPS",
+		CertificateARN: aws.String("some-cert"),
+		DefaultActions: listener.DefaultActions,
+	}
+	changed := params()
+	changed.SubnetIDs = []string{subnetID, "subnet-2"}
+	changed.Listeners = []v1alpha1.Listener{https}
+	changed.Tags = []v1alpha1.Tag{{Key: "k2", Value: aws.String("v2")}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeLoadBalancers(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeListeners:     describeListeners(awsListener),
+					MockDescribeTags:          describeTags(k1v1),
+					MockSetSubnets: func(input *awselbv2.SetSubnetsInput) awselbv2.SetSubnetsRequest {
+						if diff := cmp.Diff(changed.SubnetIDs, input.Subnets); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.SetSubnetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetSubnetsOutput{}},
+						}
+					},
+					MockDeleteListener: func(input *awselbv2.DeleteListenerInput) awselbv2.DeleteListenerRequest {
+						if diff := cmp.Diff(listenerARN, aws.StringValue(input.ListenerArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteListenerOutput{}},
+						}
+					},
+					MockCreateListener: func(input *awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						if diff := cmp.Diff(int64(443), aws.Int64Value(input.Port)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateListenerOutput{}},
+						}
+					},
+					MockRemoveTags: func(input *awselbv2.RemoveTagsInput) awselbv2.RemoveTagsRequest {
+						if diff := cmp.Diff([]string{"k1"}, input.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.RemoveTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.RemoveTagsOutput{}},
+						}
+					},
+					MockAddTags: func(input *awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+						if diff := cmp.Diff("k2", aws.StringValue(input.Tags[0].Key)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(changed)),
+			},
+			want: want{
+				cr: loadBalancer(withSpec(changed)),
+			},
+		},
+		"SetSubnetsFailed": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeLoadBalancers(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeListeners:     describeListeners(awsListener),
+					MockDescribeTags:          describeTags(k1v1),
+					MockSetSubnets: func(*awselbv2.SetSubnetsInput) awselbv2.SetSubnetsRequest {
+						return awselbv2.SetSubnetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(withSpec(changed)),
+			},
+			want: want{
+				cr:  loadBalancer(withSpec(changed)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+		"CreateListenerFailed": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeLoadBalancers(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeListeners:     describeListeners(),
+					MockDescribeTags:          describeTags(k1v1),
+					MockCreateListener: func(*awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr:  loadBalancer(),
+				err: errors.Wrap(errBoom, errUpdateListeners),
+			},
+		},
+		"AddTagsFailed": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDescribeLoadBalancers: describeLoadBalancers(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeListeners:     describeListeners(awsListener),
+					MockDescribeTags:          describeTags(),
+					MockAddTags: func(*awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+						return awselbv2.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(),
+			},
+			want: want{
+				cr:  loadBalancer(),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LoadBalancer
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDeleteLoadBalancer: func(input *awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						if diff := cmp.Diff(lbARN, aws.StringValue(input.LoadBalancerArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteLoadBalancerOutput{}},
+						}
+					},
+				},
+				cr: loadBalancer(withObservation(observation("active"))),
+			},
+			want: want{
+				cr: loadBalancer(withObservation(observation("active")),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDeleteLoadBalancer: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: loadBalancer(withObservation(observation("active"))),
+			},
+			want: want{
+				cr: loadBalancer(withObservation(observation("active")),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockLoadBalancerClient{
+					MockDeleteLoadBalancer: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: loadBalancer(withObservation(observation("active"))),
+			},
+			want: want{
+				cr: loadBalancer(withObservation(observation("active")),
+					withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
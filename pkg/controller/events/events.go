@@ -0,0 +1,312 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events reconciles managed resources as soon as CloudTrail reports a
+// change to their external resources, rather than waiting for them to be
+// polled.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+	"github.com/crossplane/provider-aws/pkg/controller"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+// AnnotationKeyLastEvent is set to the ID of the latest event that concerned
+// the external resource of a managed resource. Updating it causes the managed
+// resource to be reconciled.
+const AnnotationKeyLastEvent = "aws.crossplane.io/last-event"
+
+const (
+	groupSuffix = ".aws.crossplane.io"
+
+	// Messages are long polled, so that an idle queue costs few requests.
+	maxMessages     = 10
+	waitTimeSeconds = 20
+	errorBackoff    = 10 * time.Second
+
+	// Longer strings are not resource identifiers, but documents such as
+	// policies.
+	maxIdentifierLength = 2048
+
+	errNewQueue = "cannot create event queue client"
+	errReceive  = "cannot receive messages from event queue"
+	errDelete   = "cannot delete messages from event queue"
+)
+
+// An Event is an EventBridge event, as delivered to an SQS queue by an
+// EventBridge rule.
+type Event struct {
+	ID         string   `json:"id"`
+	DetailType string   `json:"detail-type"`
+	Source     string   `json:"source"`
+	Resources  []string `json:"resources"`
+	Detail     Detail   `json:"detail"`
+}
+
+// Detail of an event that CloudTrail recorded for an AWS API call.
+type Detail struct {
+	EventName         string      `json:"eventName"`
+	ReadOnly          bool        `json:"readOnly"`
+	ErrorCode         string      `json:"errorCode"`
+	RequestParameters interface{} `json:"requestParameters"`
+	ResponseElements  interface{} `json:"responseElements"`
+}
+
+// Identifiers returns every string in the event that may identify a changed
+// external resource. Events of calls that failed or did not change anything
+// identify no resources. Matching these against external names may produce
+// false positives, which only cost an extra reconcile.
+func (e Event) Identifiers() []string {
+	if e.Detail.ReadOnly || e.Detail.ErrorCode != "" {
+		return nil
+	}
+	ids := map[string]bool{}
+	for _, r := range e.Resources {
+		addIdentifier(ids, r)
+	}
+	collect(ids, e.Detail.RequestParameters)
+	collect(ids, e.Detail.ResponseElements)
+
+	out := make([]string, 0, len(ids))
+	for id := range ids {
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// collect adds every string value within the supplied JSON value.
+func collect(ids map[string]bool, v interface{}) {
+	switch v := v.(type) {
+	case string:
+		addIdentifier(ids, v)
+	case []interface{}:
+		for _, e := range v {
+			collect(ids, e)
+		}
+	case map[string]interface{}:
+		for _, e := range v {
+			collect(ids, e)
+		}
+	}
+}
+
+// addIdentifier adds the supplied string. The external names of resources
+// identified by an ARN are usually its resource part or the last segment
+// thereof, so those are added too.
+func addIdentifier(ids map[string]bool, s string) {
+	if s == "" || len(s) > maxIdentifierLength || strings.ContainsAny(s, " \t\n") {
+		return
+	}
+	ids[s] = true
+	if !arn.IsARN(s) {
+		return
+	}
+	r := awsclients.ARNResource(s)
+	ids[r] = true
+	if i := strings.LastIndexAny(r, "/:"); i >= 0 && i < len(r)-1 {
+		ids[r[i+1:]] = true
+	}
+}
+
+// A Poller receives events from an SQS queue and annotates the managed
+// resources whose external name they mention, so that they are reconciled.
+type Poller struct {
+	kube     client.Client
+	newQueue func(ctx context.Context) (sqs.MessageClient, error)
+	url      string
+	lists    []resource.ManagedList
+	log      logging.Logger
+}
+
+// NewPoller returns a Poller that receives events from the queue at the
+// supplied URL and looks them up among managed resources of the supplied
+// kinds.
+func NewPoller(kube client.Client, newQueue func(ctx context.Context) (sqs.MessageClient, error), url string, lists []resource.ManagedList, l logging.Logger) *Poller {
+	return &Poller{kube: kube, newQueue: newQueue, url: url, lists: lists, log: l}
+}
+
+// Start polls the queue until the supplied channel is closed.
+func (p *Poller) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	for {
+		err := p.Poll(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil {
+			continue
+		}
+		p.log.Info("Cannot process events", "error", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(errorBackoff):
+		}
+	}
+}
+
+// Poll receives a batch of messages from the queue, annotates the managed
+// resources they concern and deletes them. Messages are deleted even if some
+// managed resources cannot be annotated, since receiving them again would
+// likely fail the same way; those resources are reconciled when next polled.
+// Messages that are not events are discarded.
+func (p *Poller) Poll(ctx context.Context) error {
+	q, err := p.newQueue(ctx)
+	if err != nil {
+		return errors.Wrap(err, errNewQueue)
+	}
+	rsp, err := q.ReceiveMessageRequest(&awssqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(p.url),
+		MaxNumberOfMessages: aws.Int64(maxMessages),
+		WaitTimeSeconds:     aws.Int64(waitTimeSeconds),
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errReceive)
+	}
+	if len(rsp.Messages) == 0 {
+		return nil
+	}
+
+	// The ID of the latest event that mentions each identifier.
+	events := map[string]string{}
+	entries := make([]awssqs.DeleteMessageBatchRequestEntry, len(rsp.Messages))
+	for i, m := range rsp.Messages {
+		entries[i] = awssqs.DeleteMessageBatchRequestEntry{Id: m.MessageId, ReceiptHandle: m.ReceiptHandle}
+
+		e := Event{}
+		if err := json.Unmarshal([]byte(aws.StringValue(m.Body)), &e); err != nil || e.ID == "" {
+			p.log.Debug("Discarding message that is not an event", "message-id", aws.StringValue(m.MessageId))
+			continue
+		}
+		for _, id := range e.Identifiers() {
+			events[id] = e.ID
+		}
+	}
+
+	p.Trigger(ctx, events)
+
+	_, err = q.DeleteMessageBatchRequest(&awssqs.DeleteMessageBatchInput{
+		QueueUrl: aws.String(p.url),
+		Entries:  entries,
+	}).Send(ctx)
+	return errors.Wrap(err, errDelete)
+}
+
+// Trigger annotates every managed resource whose external name is a key of
+// the supplied map with the event ID it maps to. Kinds of managed resources
+// that cannot be listed, for example because their CRD is not installed, and
+// managed resources that cannot be annotated are logged and skipped.
+func (p *Poller) Trigger(ctx context.Context, events map[string]string) {
+	if len(events) == 0 {
+		return
+	}
+	for _, proto := range p.lists {
+		l := proto.DeepCopyObject().(resource.ManagedList)
+		if err := p.kube.List(ctx, l); err != nil {
+			p.log.Info("Cannot list managed resources, skipping their events", "kind", reflect.TypeOf(proto).Elem().Name(), "error", err)
+			continue
+		}
+		for _, mg := range l.GetItems() {
+			id, ok := events[meta.GetExternalName(mg)]
+			if !ok || mg.GetAnnotations()[AnnotationKeyLastEvent] == id {
+				continue
+			}
+			patch := client.MergeFrom(mg.DeepCopyObject())
+			meta.AddAnnotations(mg, map[string]string{AnnotationKeyLastEvent: id})
+			if err := p.kube.Patch(ctx, mg, patch); resource.IgnoreNotFound(err) != nil {
+				p.log.Info("Cannot annotate managed resource, skipping its event", "name", mg.GetName(), "event-id", id, "error", err)
+				continue
+			}
+			p.log.Debug("Reconciling managed resource in response to event", "name", mg.GetName(), "event-id", id)
+		}
+	}
+}
+
+// ManagedLists returns an empty list of every kind of AWS managed resource
+// known to the supplied scheme whose controllers are accepted by the supplied
+// filter. The filter is passed the same groups of controllers as that of
+// controller.Setup, such as s3 for S3Buckets of the storage API group.
+func ManagedLists(s *runtime.Scheme, include controller.GroupFilter) []resource.ManagedList {
+	gvks := []schema.GroupVersionKind{}
+	for gvk := range s.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Group, groupSuffix) || !strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		if include(controller.GroupOf(gvk.Group)) {
+			gvks = append(gvks, gvk)
+		}
+	}
+	sort.Slice(gvks, func(i, j int) bool { return gvks[i].String() < gvks[j].String() })
+
+	lists := []resource.ManagedList{}
+	for _, gvk := range gvks {
+		o, err := s.New(gvk)
+		if err != nil {
+			continue
+		}
+		if l, ok := o.(resource.ManagedList); ok {
+			lists = append(lists, l)
+		}
+	}
+	return lists
+}
+
+// Setup adds a Poller to the supplied manager that receives events from the
+// queue at the supplied URL using the credentials and region of the named
+// Provider. Only managed resources of API groups accepted by the supplied
+// filter are reconciled. Like controllers, the Poller only runs while the
+// manager holds the leader lease.
+func Setup(mgr ctrl.Manager, l logging.Logger, url, provider string, include controller.GroupFilter) error {
+	newQueue := func(ctx context.Context) (sqs.MessageClient, error) {
+		cfg, err := utils.RetrieveAwsConfigFromProvider(ctx, mgr.GetClient(), runtimev1alpha1.Reference{Name: provider})
+		if err != nil {
+			return nil, err
+		}
+		return awssqs.New(*cfg), nil
+	}
+	lists := ManagedLists(mgr.GetScheme(), include)
+	return mgr.Add(NewPoller(mgr.GetClient(), newQueue, url, lists, l.WithValues("queue", url)))
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs/fake"
+)
+
+const (
+	queueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/events"
+
+	authorizeIngress = `{
+  "id": "event-1",
+  "detail-type": "AWS API Call via CloudTrail",
+  "source": "aws.ec2",
+  "resources": [],
+  "detail": {
+    "eventName": "AuthorizeSecurityGroupIngress",
+    "readOnly": false,
+    "requestParameters": {"groupId": "sg-123", "ipPermissions": {"items": [{"ipProtocol": "tcp", "fromPort": 22}]}},
+    "responseElements": {"_return": true}
+  }
+}`
+)
+
+var errBoom = errors.New("boom")
+
+func TestIdentifiers(t *testing.T) {
+	cases := map[string]struct {
+		e    Event
+		want []string
+	}{
+		"Nested": {
+			e: Event{Detail: Detail{
+				RequestParameters: map[string]interface{}{
+					"vpcId": "vpc-123",
+					"tagSpecificationSet": map[string]interface{}{
+						"items": []interface{}{map[string]interface{}{"key": "Name", "value": "my vpc"}},
+					},
+				},
+				ResponseElements: map[string]interface{}{"_return": true},
+			}},
+			want: []string{"Name", "vpc-123"},
+		},
+		"ARN": {
+			e: Event{
+				Resources: []string{"arn:aws:iam::123456789012:role/service/my-role"},
+			},
+			want: []string{"arn:aws:iam::123456789012:role/service/my-role", "my-role", "role/service/my-role"},
+		},
+		"ReadOnly": {
+			e: Event{Detail: Detail{ReadOnly: true, RequestParameters: map[string]interface{}{"vpcId": "vpc-123"}}},
+		},
+		"Failed": {
+			e: Event{Detail: Detail{ErrorCode: "AccessDenied", RequestParameters: map[string]interface{}{"vpcId": "vpc-123"}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.e.Identifiers()
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Identifiers(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func securityGroup(name, externalName string) v1beta1.SecurityGroup {
+	sg := v1beta1.SecurityGroup{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	meta.SetExternalName(&sg, externalName)
+	return sg
+}
+
+func TestPoll(t *testing.T) {
+	type args struct {
+		kube  client.Client
+		queue sqs.MessageClient
+	}
+	type want struct {
+		err       error
+		patched   []string
+		deleted   bool
+		failPatch bool
+	}
+
+	receive := func(bodies ...string) func(*awssqs.ReceiveMessageInput) awssqs.ReceiveMessageRequest {
+		return func(*awssqs.ReceiveMessageInput) awssqs.ReceiveMessageRequest {
+			msgs := make([]awssqs.Message, len(bodies))
+			for i, b := range bodies {
+				msgs[i] = awssqs.Message{MessageId: aws.String(string(rune('a' + i))), ReceiptHandle: aws.String("handle"), Body: aws.String(b)}
+			}
+			return awssqs.ReceiveMessageRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssqs.ReceiveMessageOutput{Messages: msgs}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Triggered": {
+			args: args{
+				kube: &test.MockClient{
+					MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+						obj.(*v1beta1.SecurityGroupList).Items = []v1beta1.SecurityGroup{
+							securityGroup("changed", "sg-123"),
+							securityGroup("unchanged", "sg-456"),
+						}
+						return nil
+					},
+				},
+				queue: &fake.MockMessageClient{
+					MockReceiveMessageRequest: receive(authorizeIngress, "not an event"),
+				},
+			},
+			want: want{
+				patched: []string{"changed"},
+				deleted: true,
+			},
+		},
+		"ReceiveError": {
+			args: args{
+				kube: &test.MockClient{},
+				queue: &fake.MockMessageClient{
+					MockReceiveMessageRequest: func(*awssqs.ReceiveMessageInput) awssqs.ReceiveMessageRequest {
+						return awssqs.ReceiveMessageRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errReceive),
+			},
+		},
+		"ListError": {
+			args: args{
+				kube: &test.MockClient{
+					MockList: test.NewMockListFn(errBoom),
+				},
+				queue: &fake.MockMessageClient{
+					MockReceiveMessageRequest: receive(authorizeIngress),
+				},
+			},
+			want: want{
+				deleted: true,
+			},
+		},
+		"PatchError": {
+			args: args{
+				kube: &test.MockClient{
+					MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+						obj.(*v1beta1.SecurityGroupList).Items = []v1beta1.SecurityGroup{securityGroup("changed", "sg-123")}
+						return nil
+					},
+				},
+				queue: &fake.MockMessageClient{
+					MockReceiveMessageRequest: receive(authorizeIngress),
+				},
+			},
+			want: want{
+				patched:   []string{"changed"},
+				deleted:   true,
+				failPatch: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched := []string{}
+			if mc, ok := tc.args.kube.(*test.MockClient); ok {
+				mc.MockPatch = func(_ context.Context, obj runtime.Object, _ client.Patch, _ ...client.PatchOption) error {
+					mg := obj.(resource.Managed)
+					if mg.GetAnnotations()[AnnotationKeyLastEvent] != "event-1" {
+						t.Errorf("Patch(...): %s was not annotated with the event ID", mg.GetName())
+					}
+					patched = append(patched, mg.GetName())
+					if tc.want.failPatch {
+						return errBoom
+					}
+					return nil
+				}
+			}
+			deleted := false
+			q := tc.args.queue.(*fake.MockMessageClient)
+			q.MockDeleteMessageBatchRequest = func(in *awssqs.DeleteMessageBatchInput) awssqs.DeleteMessageBatchRequest {
+				deleted = true
+				return awssqs.DeleteMessageBatchRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssqs.DeleteMessageBatchOutput{}},
+				}
+			}
+
+			newQueue := func(context.Context) (sqs.MessageClient, error) { return tc.args.queue, nil }
+			p := NewPoller(tc.args.kube, newQueue, queueURL, []resource.ManagedList{&v1beta1.SecurityGroupList{}}, logging.NewNopLogger())
+			err := p.Poll(context.Background())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Poll(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.patched, patched, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Poll(...): -want patched, +got patched:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("Poll(...): -want deleted, +got deleted:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManagedLists(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := v1alpha3.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	got := ManagedLists(s, func(group string) bool { return group == "ec2" })
+	for _, l := range got {
+		if _, ok := l.(*v1alpha1.IAMGroupList); ok {
+			t.Errorf("ManagedLists(...): included a list of a filtered API group")
+		}
+	}
+	if len(got) == 0 {
+		t.Errorf("ManagedLists(...): want lists of the ec2 API group, got none")
+	}
+
+	got = ManagedLists(s, func(group string) bool { return group == "s3" })
+	if diff := cmp.Diff([]resource.ManagedList{&v1alpha3.S3BucketList{}}, got); diff != "" {
+		t.Errorf("ManagedLists(...): -want lists of the controllers of the s3 group, +got:\n%s", diff)
+	}
+}
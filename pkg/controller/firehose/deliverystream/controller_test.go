@@ -0,0 +1,653 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deliverystream
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsfirehose "github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/firehose/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/firehose"
+	"github.com/crossplane/provider-aws/pkg/clients/firehose/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	streamName  = "example"
+	streamARN   = "arn:aws:firehose:us-east-1:123456789012:deliverystream/example"
+	bucketARN   = "arn:aws:s3:::example"
+	roleARN     = "arn:aws:iam::123456789012:role/firehose"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsfirehose.ErrCodeResourceNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client firehose.DeliveryStreamClient
+	kube   client.Client
+	cr     *v1alpha1.DeliveryStream
+}
+
+type deliveryStreamModifier func(*v1alpha1.DeliveryStream)
+
+func withConditions(c ...runtimev1alpha1.Condition) deliveryStreamModifier {
+	return func(r *v1alpha1.DeliveryStream) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPrefix(s string) deliveryStreamModifier {
+	return func(r *v1alpha1.DeliveryStream) {
+		r.Spec.ForProvider.ExtendedS3DestinationConfiguration.Prefix = aws.String(s)
+	}
+}
+
+func withObservation(o v1alpha1.DeliveryStreamObservation) deliveryStreamModifier {
+	return func(r *v1alpha1.DeliveryStream) { r.Status.AtProvider = o }
+}
+
+func params() v1alpha1.DeliveryStreamParameters {
+	return v1alpha1.DeliveryStreamParameters{
+		DeliveryStreamType: aws.String("DirectPut"),
+		ExtendedS3DestinationConfiguration: v1alpha1.ExtendedS3DestinationConfiguration{
+			BucketARN: bucketARN,
+			BufferingHints: &v1alpha1.BufferingHints{
+				IntervalInSeconds: aws.Int64(300),
+				SizeInMBs:         aws.Int64(5),
+			},
+			CompressionFormat: aws.String("UNCOMPRESSED"),
+			Prefix:            aws.String("data/"),
+			RoleARN:           aws.String(roleARN),
+		},
+	}
+}
+
+func deliveryStream(m ...deliveryStreamModifier) *v1alpha1.DeliveryStream {
+	cr := &v1alpha1.DeliveryStream{
+		Spec: v1alpha1.DeliveryStreamSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: params(),
+		},
+	}
+	meta.SetExternalName(cr, streamName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observation(status string) v1alpha1.DeliveryStreamObservation {
+	return v1alpha1.DeliveryStreamObservation{
+		ARN:           streamARN,
+		DestinationID: "destinationId-000000000001",
+		Status:        status,
+		VersionID:     "1",
+	}
+}
+
+func describe(status awsfirehose.DeliveryStreamStatus) func(*awsfirehose.DescribeDeliveryStreamInput) awsfirehose.DescribeDeliveryStreamRequest {
+	return func(*awsfirehose.DescribeDeliveryStreamInput) awsfirehose.DescribeDeliveryStreamRequest {
+		return awsfirehose.DescribeDeliveryStreamRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfirehose.DescribeDeliveryStreamOutput{
+				DeliveryStreamDescription: &awsfirehose.DeliveryStreamDescription{
+					DeliveryStreamARN:    aws.String(streamARN),
+					DeliveryStreamName:   aws.String(streamName),
+					DeliveryStreamStatus: status,
+					DeliveryStreamType:   awsfirehose.DeliveryStreamTypeDirectPut,
+					Destinations: []awsfirehose.DestinationDescription{{
+						DestinationId: aws.String("destinationId-000000000001"),
+						ExtendedS3DestinationDescription: &awsfirehose.ExtendedS3DestinationDescription{
+							BucketARN: aws.String(bucketARN),
+							BufferingHints: &awsfirehose.BufferingHints{
+								IntervalInSeconds: aws.Int64(300),
+								SizeInMBs:         aws.Int64(5),
+							},
+							CompressionFormat: awsfirehose.CompressionFormatUncompressed,
+							Prefix:            aws.String("data/"),
+							RoleARN:           aws.String(roleARN),
+						},
+					}},
+					VersionId: aws.String("1"),
+				},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (firehose.DeliveryStreamClient, error)
+		cr          *v1alpha1.DeliveryStream
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i firehose.DeliveryStreamClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: deliveryStream(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i firehose.DeliveryStreamClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: deliveryStream(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: deliveryStream(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: deliveryStream(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: deliveryStream(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DeliveryStream
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{MockDescribeDeliveryStream: describe(awsfirehose.DeliveryStreamStatusActive)},
+				cr:     deliveryStream(),
+			},
+			want: want{
+				cr: deliveryStream(
+					withObservation(observation("ACTIVE")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DestinationChanged": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{MockDescribeDeliveryStream: describe(awsfirehose.DeliveryStreamStatusActive)},
+				cr:     deliveryStream(withPrefix("other/")),
+			},
+			want: want{
+				cr: deliveryStream(
+					withPrefix("other/"),
+					withObservation(observation("ACTIVE")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{MockDescribeDeliveryStream: describe(awsfirehose.DeliveryStreamStatusCreating)},
+				cr:     deliveryStream(withPrefix("other/")),
+			},
+			want: want{
+				cr: deliveryStream(
+					withPrefix("other/"),
+					withObservation(observation("CREATING")),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreatingFailed": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{MockDescribeDeliveryStream: describe(awsfirehose.DeliveryStreamStatusCreatingFailed)},
+				cr:     deliveryStream(),
+			},
+			want: want{
+				cr: deliveryStream(
+					withObservation(observation("CREATING_FAILED")),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{
+					MockDescribeDeliveryStream: func(*awsfirehose.DescribeDeliveryStreamInput) awsfirehose.DescribeDeliveryStreamRequest {
+						return awsfirehose.DescribeDeliveryStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: deliveryStream(),
+			},
+			want: want{
+				cr: deliveryStream(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{
+					MockDescribeDeliveryStream: func(*awsfirehose.DescribeDeliveryStreamInput) awsfirehose.DescribeDeliveryStreamRequest {
+						return awsfirehose.DescribeDeliveryStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: deliveryStream(),
+			},
+			want: want{
+				cr:  deliveryStream(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DeliveryStream
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{
+					MockCreateDeliveryStream: func(input *awsfirehose.CreateDeliveryStreamInput) awsfirehose.CreateDeliveryStreamRequest {
+						if diff := cmp.Diff(streamName, aws.StringValue(input.DeliveryStreamName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsfirehose.CreateDeliveryStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfirehose.CreateDeliveryStreamOutput{}},
+						}
+					},
+				},
+				cr: deliveryStream(),
+			},
+			want: want{
+				cr: deliveryStream(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{
+					MockCreateDeliveryStream: func(input *awsfirehose.CreateDeliveryStreamInput) awsfirehose.CreateDeliveryStreamRequest {
+						return awsfirehose.CreateDeliveryStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: deliveryStream(),
+			},
+			want: want{
+				cr:  deliveryStream(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DeliveryStream
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{
+					MockUpdateDestination: func(input *awsfirehose.UpdateDestinationInput) awsfirehose.UpdateDestinationRequest {
+						if diff := cmp.Diff("1", aws.StringValue(input.CurrentDeliveryStreamVersionId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("destinationId-000000000001", aws.StringValue(input.DestinationId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("other/", aws.StringValue(input.ExtendedS3DestinationUpdate.Prefix)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsfirehose.UpdateDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfirehose.UpdateDestinationOutput{}},
+						}
+					},
+				},
+				cr: deliveryStream(withPrefix("other/"), withObservation(observation("ACTIVE"))),
+			},
+			want: want{
+				cr: deliveryStream(withPrefix("other/"), withObservation(observation("ACTIVE"))),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{
+					MockUpdateDestination: func(input *awsfirehose.UpdateDestinationInput) awsfirehose.UpdateDestinationRequest {
+						return awsfirehose.UpdateDestinationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: deliveryStream(withObservation(observation("ACTIVE"))),
+			},
+			want: want{
+				cr:  deliveryStream(withObservation(observation("ACTIVE"))),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DeliveryStream
+		err error
+	}
+
+	del := func(err error) func(*awsfirehose.DeleteDeliveryStreamInput) awsfirehose.DeleteDeliveryStreamRequest {
+		return func(*awsfirehose.DeleteDeliveryStreamInput) awsfirehose.DeleteDeliveryStreamRequest {
+			return awsfirehose.DeleteDeliveryStreamRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfirehose.DeleteDeliveryStreamOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{MockDeleteDeliveryStream: del(nil)},
+				cr:     deliveryStream(),
+			},
+			want: want{
+				cr: deliveryStream(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{},
+				cr:     deliveryStream(withObservation(observation("DELETING"))),
+			},
+			want: want{
+				cr: deliveryStream(withObservation(observation("DELETING")), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{MockDeleteDeliveryStream: del(errNotFound)},
+				cr:     deliveryStream(),
+			},
+			want: want{
+				cr: deliveryStream(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDeliveryStreamClient{MockDeleteDeliveryStream: del(errBoom)},
+				cr:     deliveryStream(),
+			},
+			want: want{
+				cr:  deliveryStream(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,629 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsfms "github.com/aws/aws-sdk-go-v2/service/fms"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/fms/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/fms"
+	"github.com/crossplane/provider-aws/pkg/clients/fms/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	policyID    = "12345678-1234-1234-1234-123456789012"
+	policyARN   = "arn:aws:fms:us-east-1:123456789012:policy/12345678-1234-1234-1234-123456789012"
+	updateToken = "1:abcdefghijklmnopqrstuvwx"
+	serviceData = `{"type":"WAFV2","defaultAction":{"type":"ALLOW"},"overrideCustomerWebACLAssociation":false}`
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsfms.ErrCodeResourceNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client fms.PolicyClient
+	kube   client.Client
+	cr     *v1alpha1.Policy
+}
+
+type policyModifier func(*v1alpha1.Policy)
+
+func withConditions(c ...runtimev1alpha1.Condition) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) policyModifier {
+	return func(r *v1alpha1.Policy) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha1.PolicyObservation) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Status.AtProvider = o }
+}
+
+func withManagedServiceData(d string) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Spec.ForProvider.SecurityServicePolicyData.ManagedServiceData = &d }
+}
+
+func policy(m ...policyModifier) *v1alpha1.Policy {
+	cr := &v1alpha1.Policy{
+		Spec: v1alpha1.PolicySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.PolicyParameters{
+				Name: "waf-baseline",
+				SecurityServicePolicyData: v1alpha1.SecurityServicePolicyData{
+					Type:               "WAFV2",
+					ManagedServiceData: aws.String(serviceData),
+				},
+				ResourceType:       "AWS::ElasticLoadBalancingV2::LoadBalancer",
+				RemediationEnabled: true,
+				IncludeMap:         &v1alpha1.PolicyScope{OrganizationalUnits: []string{"ou-abcd-12345678"}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func get(*awsfms.GetPolicyInput) awsfms.GetPolicyRequest {
+	return awsfms.GetPolicyRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfms.GetPolicyOutput{
+			PolicyArn: aws.String(policyARN),
+			Policy: &awsfms.Policy{
+				PolicyId:            aws.String(policyID),
+				PolicyName:          aws.String("waf-baseline"),
+				PolicyUpdateToken:   aws.String(updateToken),
+				ResourceType:        aws.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+				ExcludeResourceTags: aws.Bool(false),
+				RemediationEnabled:  aws.Bool(true),
+				IncludeMap:          map[string][]string{"ORG_UNIT": {"ou-abcd-12345678"}},
+				SecurityServicePolicyData: &awsfms.SecurityServicePolicyData{
+					Type:               awsfms.SecurityServiceTypeWafv2,
+					ManagedServiceData: aws.String(`{"type": "WAFV2", "defaultAction": {"type": "ALLOW"}, "overrideCustomerWebACLAssociation": false}`),
+				},
+			},
+		}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (fms.PolicyClient, error)
+		cr          *v1alpha1.Policy
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i fms.PolicyClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: policy(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i fms.PolicyClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: policy(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Policy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockPolicyClient{MockGetPolicy: get},
+				cr:     policy(withExternalName(policyID)),
+			},
+			want: want{
+				cr: policy(
+					withExternalName(policyID),
+					withObservation(v1alpha1.PolicyObservation{ARN: policyARN, PolicyUpdateToken: updateToken}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ManagedServiceDataChanged": {
+			args: args{
+				client: &fake.MockPolicyClient{MockGetPolicy: get},
+				cr:     policy(withExternalName(policyID), withManagedServiceData(`{"type":"WAFV2","defaultAction":{"type":"BLOCK"}}`)),
+			},
+			want: want{
+				cr: policy(
+					withExternalName(policyID),
+					withManagedServiceData(`{"type":"WAFV2","defaultAction":{"type":"BLOCK"}}`),
+					withObservation(v1alpha1.PolicyObservation{ARN: policyARN, PolicyUpdateToken: updateToken}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockGetPolicy: func(*awsfms.GetPolicyInput) awsfms.GetPolicyRequest {
+						return awsfms.GetPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: policy(withExternalName(policyID)),
+			},
+			want: want{
+				cr: policy(withExternalName(policyID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockGetPolicy: func(*awsfms.GetPolicyInput) awsfms.GetPolicyRequest {
+						return awsfms.GetPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: policy(withExternalName(policyID)),
+			},
+			want: want{
+				cr:  policy(withExternalName(policyID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Policy
+		result managed.ExternalCreation
+		err    error
+	}
+
+	created := &awsfms.PutPolicyOutput{Policy: &awsfms.Policy{PolicyId: aws.String(policyID)}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockPolicyClient{
+					MockPutPolicy: func(*awsfms.PutPolicyInput) awsfms.PutPolicyRequest {
+						return awsfms.PutPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: created},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(
+					withExternalName(policyID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockPutPolicy: func(*awsfms.PutPolicyInput) awsfms.PutPolicyRequest {
+						return awsfms.PutPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr:  policy(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"FailedSpecUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &fake.MockPolicyClient{
+					MockPutPolicy: func(*awsfms.PutPolicyInput) awsfms.PutPolicyRequest {
+						return awsfms.PutPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: created},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(
+					withExternalName(policyID),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Policy
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockPutPolicy: func(input *awsfms.PutPolicyInput) awsfms.PutPolicyRequest {
+						if diff := cmp.Diff(updateToken, aws.StringValue(input.Policy.PolicyUpdateToken)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsfms.PutPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfms.PutPolicyOutput{}},
+						}
+					},
+				},
+				cr: policy(withExternalName(policyID), withObservation(v1alpha1.PolicyObservation{PolicyUpdateToken: updateToken})),
+			},
+			want: want{
+				cr: policy(withExternalName(policyID), withObservation(v1alpha1.PolicyObservation{PolicyUpdateToken: updateToken})),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockPutPolicy: func(*awsfms.PutPolicyInput) awsfms.PutPolicyRequest {
+						return awsfms.PutPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: policy(withExternalName(policyID)),
+			},
+			want: want{
+				cr:  policy(withExternalName(policyID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Policy
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockDeletePolicy: func(*awsfms.DeletePolicyInput) awsfms.DeletePolicyRequest {
+						return awsfms.DeletePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsfms.DeletePolicyOutput{}},
+						}
+					},
+				},
+				cr: policy(withExternalName(policyID)),
+			},
+			want: want{
+				cr: policy(withExternalName(policyID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockDeletePolicy: func(*awsfms.DeletePolicyInput) awsfms.DeletePolicyRequest {
+						return awsfms.DeletePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: policy(withExternalName(policyID)),
+			},
+			want: want{
+				cr: policy(withExternalName(policyID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockDeletePolicy: func(*awsfms.DeletePolicyInput) awsfms.DeletePolicyRequest {
+						return awsfms.DeletePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: policy(withExternalName(policyID)),
+			},
+			want: want{
+				cr:  policy(withExternalName(policyID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
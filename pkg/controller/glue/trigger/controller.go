@@ -0,0 +1,236 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsglue "github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/glue"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a Trigger resource"
+	errCreateClient      = "cannot create Glue client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the Trigger custom resource"
+
+	errDescribe = "failed to describe Trigger"
+	errCreate   = "failed to create the Trigger resource"
+	errUpdate   = "failed to update the Trigger resource"
+	errDelete   = "failed to delete the Trigger resource"
+	errStart    = "failed to start the Trigger"
+	errStop     = "failed to stop the Trigger"
+)
+
+// SetupTrigger adds a controller that reconciles Triggers.
+func SetupTrigger(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TriggerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.Trigger{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TriggerGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: glue.NewTriggerClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (glue.TriggerClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Trigger)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client glue.TriggerClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Trigger)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(glue.IsNotFound, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	glue.LateInitializeTrigger(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = glue.GenerateTriggerObservation(*observed)
+
+	switch observed.State {
+	case awsglue.TriggerStateCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsglue.TriggerStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: isTransitioning(observed.State) ||
+			(glue.IsTriggerUpToDate(cr.Spec.ForProvider, *observed) &&
+				glue.IsEnabledUpToDate(cr.Spec.ForProvider, observed.State)),
+	}, nil
+}
+
+// isTransitioning returns true if the trigger is in a state in which it
+// cannot be updated, started or stopped.
+func isTransitioning(s awsglue.TriggerState) bool {
+	switch s {
+	case awsglue.TriggerStateCreating, awsglue.TriggerStateActivating,
+		awsglue.TriggerStateDeactivating, awsglue.TriggerStateUpdating,
+		awsglue.TriggerStateDeleting:
+		return true
+	}
+	return false
+}
+
+func (e *external) get(ctx context.Context, name string) (*awsglue.Trigger, error) {
+	rsp, err := e.client.GetTriggerRequest(&awsglue.GetTriggerInput{Name: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Trigger, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Trigger)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.CreateTriggerRequest(glue.GenerateCreateTriggerInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+// Update either applies configuration changes or activates or deactivates
+// the trigger. The trigger is busy for a while after each of these
+// operations, so only one of them is performed per reconcile.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Trigger)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if !glue.IsTriggerUpToDate(cr.Spec.ForProvider, *observed) {
+		_, err := e.client.UpdateTriggerRequest(glue.GenerateUpdateTriggerInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	if glue.IsTriggerEnabled(cr.Spec.ForProvider) {
+		_, err := e.client.StartTriggerRequest(&awsglue.StartTriggerInput{Name: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errStart)
+	}
+	_, err = e.client.StopTriggerRequest(&awsglue.StopTriggerInput{Name: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errStop)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Trigger)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	// DeleteTrigger succeeds even if the trigger does not exist.
+	_, err := e.client.DeleteTriggerRequest(&awsglue.DeleteTriggerInput{Name: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return errors.Wrap(err, errDelete)
+}
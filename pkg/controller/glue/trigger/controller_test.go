@@ -0,0 +1,644 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsglue "github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/glue"
+	"github.com/crossplane/provider-aws/pkg/clients/glue/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	triggerName = "example"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsglue.ErrCodeEntityNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client glue.TriggerClient
+	kube   client.Client
+	cr     *v1alpha1.Trigger
+}
+
+type triggerModifier func(*v1alpha1.Trigger)
+
+func withConditions(c ...runtimev1alpha1.Condition) triggerModifier {
+	return func(r *v1alpha1.Trigger) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withEnabled(b bool) triggerModifier {
+	return func(r *v1alpha1.Trigger) { r.Spec.ForProvider.Enabled = aws.Bool(b) }
+}
+
+func withSchedule(s string) triggerModifier {
+	return func(r *v1alpha1.Trigger) { r.Spec.ForProvider.Schedule = aws.String(s) }
+}
+
+func withObservation(o v1alpha1.TriggerObservation) triggerModifier {
+	return func(r *v1alpha1.Trigger) { r.Status.AtProvider = o }
+}
+
+func trigger(m ...triggerModifier) *v1alpha1.Trigger {
+	cr := &v1alpha1.Trigger{
+		Spec: v1alpha1.TriggerSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.TriggerParameters{
+				Actions:  []v1alpha1.TriggerAction{{JobName: aws.String("load")}},
+				Schedule: aws.String("cron(15 12 * * ? *)"),
+				Type:     "SCHEDULED",
+			},
+		},
+	}
+	meta.SetExternalName(cr, triggerName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getTrigger(state awsglue.TriggerState) func(*awsglue.GetTriggerInput) awsglue.GetTriggerRequest {
+	return func(*awsglue.GetTriggerInput) awsglue.GetTriggerRequest {
+		return awsglue.GetTriggerRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.GetTriggerOutput{Trigger: &awsglue.Trigger{
+				Actions:  []awsglue.Action{{JobName: aws.String("load")}},
+				Id:       aws.String("id"),
+				Name:     aws.String(triggerName),
+				Schedule: aws.String("cron(15 12 * * ? *)"),
+				State:    state,
+				Type:     awsglue.TriggerTypeScheduled,
+			}}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (glue.TriggerClient, error)
+		cr          *v1alpha1.Trigger
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i glue.TriggerClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: trigger(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i glue.TriggerClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: trigger(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: trigger(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: trigger(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: trigger(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Trigger
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Activated": {
+			args: args{
+				client: &fake.MockTriggerClient{MockGetTrigger: getTrigger(awsglue.TriggerStateActivated)},
+				cr:     trigger(),
+			},
+			want: want{
+				cr: trigger(
+					withObservation(v1alpha1.TriggerObservation{ID: "id", State: "ACTIVATED"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ReadyToActivate": {
+			args: args{
+				client: &fake.MockTriggerClient{MockGetTrigger: getTrigger(awsglue.TriggerStateCreated)},
+				cr:     trigger(),
+			},
+			want: want{
+				cr: trigger(
+					withObservation(v1alpha1.TriggerObservation{ID: "id", State: "CREATED"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deactivated": {
+			args: args{
+				client: &fake.MockTriggerClient{MockGetTrigger: getTrigger(awsglue.TriggerStateDeactivated)},
+				cr:     trigger(withEnabled(false)),
+			},
+			want: want{
+				cr: trigger(
+					withEnabled(false),
+					withObservation(v1alpha1.TriggerObservation{ID: "id", State: "DEACTIVATED"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ScheduleChanged": {
+			args: args{
+				client: &fake.MockTriggerClient{MockGetTrigger: getTrigger(awsglue.TriggerStateActivated)},
+				cr:     trigger(withSchedule("cron(0 0 * * ? *)")),
+			},
+			want: want{
+				cr: trigger(
+					withSchedule("cron(0 0 * * ? *)"),
+					withObservation(v1alpha1.TriggerObservation{ID: "id", State: "ACTIVATED"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockTriggerClient{MockGetTrigger: getTrigger(awsglue.TriggerStateCreating)},
+				cr:     trigger(withSchedule("cron(0 0 * * ? *)")),
+			},
+			want: want{
+				cr: trigger(
+					withSchedule("cron(0 0 * * ? *)"),
+					withObservation(v1alpha1.TriggerObservation{ID: "id", State: "CREATING"}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTriggerClient{
+					MockGetTrigger: func(*awsglue.GetTriggerInput) awsglue.GetTriggerRequest {
+						return awsglue.GetTriggerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: trigger(),
+			},
+			want: want{
+				cr: trigger(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockTriggerClient{
+					MockGetTrigger: func(*awsglue.GetTriggerInput) awsglue.GetTriggerRequest {
+						return awsglue.GetTriggerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: trigger(),
+			},
+			want: want{
+				cr:  trigger(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Trigger
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTriggerClient{
+					MockCreateTrigger: func(input *awsglue.CreateTriggerInput) awsglue.CreateTriggerRequest {
+						if !aws.BoolValue(input.StartOnCreation) {
+							t.Errorf("expected an enabled trigger to be started on creation")
+						}
+						return awsglue.CreateTriggerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.CreateTriggerOutput{}},
+						}
+					},
+				},
+				cr: trigger(),
+			},
+			want: want{
+				cr: trigger(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockTriggerClient{
+					MockCreateTrigger: func(input *awsglue.CreateTriggerInput) awsglue.CreateTriggerRequest {
+						return awsglue.CreateTriggerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: trigger(),
+			},
+			want: want{
+				cr:  trigger(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Trigger
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateConfiguration": {
+			args: args{
+				client: &fake.MockTriggerClient{
+					MockGetTrigger: getTrigger(awsglue.TriggerStateActivated),
+					MockUpdateTrigger: func(input *awsglue.UpdateTriggerInput) awsglue.UpdateTriggerRequest {
+						if diff := cmp.Diff("cron(0 0 * * ? *)", aws.StringValue(input.TriggerUpdate.Schedule)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsglue.UpdateTriggerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.UpdateTriggerOutput{}},
+						}
+					},
+				},
+				cr: trigger(withSchedule("cron(0 0 * * ? *)")),
+			},
+			want: want{
+				cr: trigger(withSchedule("cron(0 0 * * ? *)")),
+			},
+		},
+		"Start": {
+			args: args{
+				client: &fake.MockTriggerClient{
+					MockGetTrigger: getTrigger(awsglue.TriggerStateCreated),
+					MockStartTrigger: func(input *awsglue.StartTriggerInput) awsglue.StartTriggerRequest {
+						return awsglue.StartTriggerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.StartTriggerOutput{}},
+						}
+					},
+				},
+				cr: trigger(),
+			},
+			want: want{
+				cr: trigger(),
+			},
+		},
+		"Stop": {
+			args: args{
+				client: &fake.MockTriggerClient{
+					MockGetTrigger: getTrigger(awsglue.TriggerStateActivated),
+					MockStopTrigger: func(input *awsglue.StopTriggerInput) awsglue.StopTriggerRequest {
+						return awsglue.StopTriggerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: trigger(withEnabled(false)),
+			},
+			want: want{
+				cr:  trigger(withEnabled(false)),
+				err: errors.Wrap(errBoom, errStop),
+			},
+		},
+		"FailedUpdateRequest": {
+			args: args{
+				client: &fake.MockTriggerClient{
+					MockGetTrigger: getTrigger(awsglue.TriggerStateActivated),
+					MockUpdateTrigger: func(input *awsglue.UpdateTriggerInput) awsglue.UpdateTriggerRequest {
+						return awsglue.UpdateTriggerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: trigger(withSchedule("cron(0 0 * * ? *)")),
+			},
+			want: want{
+				cr:  trigger(withSchedule("cron(0 0 * * ? *)")),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Trigger
+		err error
+	}
+
+	del := func(err error) func(*awsglue.DeleteTriggerInput) awsglue.DeleteTriggerRequest {
+		return func(*awsglue.DeleteTriggerInput) awsglue.DeleteTriggerRequest {
+			return awsglue.DeleteTriggerRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsglue.DeleteTriggerOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTriggerClient{MockDeleteTrigger: del(nil)},
+				cr:     trigger(),
+			},
+			want: want{
+				cr: trigger(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockTriggerClient{MockDeleteTrigger: del(errBoom)},
+				cr:     trigger(),
+			},
+			want: want{
+				cr:  trigger(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
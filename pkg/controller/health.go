@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	credentialsCheckTimeout = 10 * time.Second
+
+	envWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+	errListProviders       = "cannot list providers"
+	errNoUsableProvider    = "no provider has usable credentials"
+	errNoSecretRef         = "provider %s does not have a secret reference"
+	errGetSecret           = "cannot get credentials secret of provider %s"
+	errParseCredentials    = "cannot parse credentials of provider %s"
+	errEmptyCredentials    = "credentials of provider %s do not contain an access key ID and secret access key"
+	errNoWebIdentityToken  = "provider %s uses a service account but " + envWebIdentityTokenFile + " is not set"
+	errReadWebIdentityFile = "provider %s uses a service account but its web identity token cannot be read"
+)
+
+// ProviderCredentialsCheck returns a readiness check that validates the
+// credentials of AWS Providers without calling the AWS API, so that a
+// deployment none of whose Providers has usable credentials never becomes
+// ready. A deployment with at least one usable Provider, or none at all, is
+// ready; the problems of individual Providers are reported by the Healthy
+// condition of each Provider instead. The supplied reader should not be backed
+// by the manager's cache; readiness is reported regardless of whether this
+// replica holds the leader lease, so that standby replicas are not restarted.
+func ProviderCredentialsCheck(r client.Reader) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), credentialsCheckTimeout)
+		defer cancel()
+
+		l := &awsv1alpha3.ProviderList{}
+		if err := r.List(ctx, l); err != nil {
+			return errors.Wrap(err, errListProviders)
+		}
+		var first error
+		for i := range l.Items {
+			err := checkProviderCredentials(ctx, r, &l.Items[i])
+			if err == nil {
+				return nil
+			}
+			if first == nil {
+				first = err
+			}
+		}
+		return errors.Wrap(first, errNoUsableProvider)
+	}
+}
+
+func checkProviderCredentials(ctx context.Context, r client.Reader, p *awsv1alpha3.Provider) error {
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		f := os.Getenv(envWebIdentityTokenFile)
+		if wi := p.Spec.WebIdentity; wi != nil && wi.TokenPath != nil {
+			f = *wi.TokenPath
+		}
+		if f == "" {
+			return errors.Errorf(errNoWebIdentityToken, p.GetName())
+		}
+		_, err := os.Stat(f)
+		return errors.Wrapf(err, errReadWebIdentityFile, p.GetName())
+	}
+
+	ref := p.GetCredentialsSecretReference()
+	if ref == nil {
+		return errors.Errorf(errNoSecretRef, p.GetName())
+	}
+	s := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return errors.Wrapf(err, errGetSecret, p.GetName())
+	}
+	creds, err := awsclients.CredentialsIDSecret(s.Data[ref.Key], awsclients.DefaultSection)
+	if err != nil {
+		return errors.Wrapf(err, errParseCredentials, p.GetName())
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return errors.Errorf(errEmptyCredentials, p.GetName())
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+)
+
+const (
+	providerName = "aws-provider"
+	secretKey    = "credentials"
+)
+
+var errBoom = errors.New("boom")
+
+func provider(sa bool) awsv1alpha3.Provider {
+	p := awsv1alpha3.Provider{}
+	p.SetName(providerName)
+	if sa {
+		p.Spec.UseServiceAccount = aws.Bool(true)
+		return p
+	}
+	p.Spec.CredentialsSecretRef = &runtimev1alpha1.SecretKeySelector{
+		SecretReference: runtimev1alpha1.SecretReference{Namespace: "crossplane-system", Name: "aws-creds"},
+		Key:             secretKey,
+	}
+	return p
+}
+
+func withTokenPath(p awsv1alpha3.Provider, path string) awsv1alpha3.Provider {
+	p.Spec.WebIdentity = &awsv1alpha3.WebIdentityConfig{RoleARN: "arn:aws:iam::123456789012:role/crossplane", TokenPath: aws.String(path)}
+	return p
+}
+
+func withProviders(p ...awsv1alpha3.Provider) test.MockListFn {
+	return test.NewMockListFn(nil, func(o runtime.Object) error {
+		o.(*awsv1alpha3.ProviderList).Items = p
+		return nil
+	})
+}
+
+func withSecret(data string) test.MockGetFn {
+	return test.NewMockGetFn(nil, func(o runtime.Object) error {
+		o.(*corev1.Secret).Data = map[string][]byte{secretKey: []byte(data)}
+		return nil
+	})
+}
+
+func TestProviderCredentialsCheck(t *testing.T) {
+	os.Unsetenv(envWebIdentityTokenFile)
+
+	noSecretRef := provider(false)
+	noSecretRef.Spec.CredentialsSecretRef = nil
+
+	_, errStat := os.Stat("/nonexistent/token")
+	errReadToken := errors.Wrap(errors.Wrapf(errStat, errReadWebIdentityFile, providerName), errNoUsableProvider)
+
+	cases := map[string]struct {
+		kube *test.MockClient
+		err  error
+	}{
+		"NoProviders": {
+			kube: &test.MockClient{MockList: withProviders()},
+		},
+		"ValidCredentials": {
+			kube: &test.MockClient{
+				MockList: withProviders(provider(false)),
+				MockGet:  withSecret("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n"),
+			},
+		},
+		"OneUsableProvider": {
+			kube: &test.MockClient{
+				MockList: withProviders(noSecretRef, provider(false)),
+				MockGet:  withSecret("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n"),
+			},
+		},
+		"NoUsableProvider": {
+			kube: &test.MockClient{MockList: withProviders(noSecretRef, provider(true))},
+			err:  errors.Wrap(errors.Errorf(errNoSecretRef, providerName), errNoUsableProvider),
+		},
+		"ListError": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			err:  errors.Wrap(errBoom, errListProviders),
+		},
+		"NoSecretRef": {
+			kube: &test.MockClient{MockList: withProviders(noSecretRef)},
+			err:  errors.Wrap(errors.Errorf(errNoSecretRef, providerName), errNoUsableProvider),
+		},
+		"GetSecretError": {
+			kube: &test.MockClient{
+				MockList: withProviders(provider(false)),
+				MockGet:  test.NewMockGetFn(errBoom),
+			},
+			err: errors.Wrap(errors.Wrapf(errBoom, errGetSecret, providerName), errNoUsableProvider),
+		},
+		"EmptyCredentials": {
+			kube: &test.MockClient{
+				MockList: withProviders(provider(false)),
+				MockGet:  withSecret("[default]\naws_access_key_id = id\n"),
+			},
+			err: errors.Wrap(errors.Errorf(errEmptyCredentials, providerName), errNoUsableProvider),
+		},
+		"ServiceAccountWithTokenPath": {
+			kube: &test.MockClient{MockList: withProviders(withTokenPath(provider(true), os.Args[0]))},
+		},
+		"ServiceAccountWithMissingTokenPath": {
+			kube: &test.MockClient{MockList: withProviders(withTokenPath(provider(true), "/nonexistent/token"))},
+			err:  errReadToken,
+		},
+		"ServiceAccountWithoutToken": {
+			kube: &test.MockClient{MockList: withProviders(provider(true))},
+			err:  errors.Wrap(errors.Errorf(errNoWebIdentityToken, providerName), errNoUsableProvider),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodGet, "/readyz", nil)
+			err := ProviderCredentialsCheck(tc.kube)(r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
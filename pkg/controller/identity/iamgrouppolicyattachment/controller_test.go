@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -61,8 +62,9 @@ var (
 )
 
 type args struct {
-	iam iam.GroupPolicyAttachmentClient
-	cr  resource.Managed
+	iam  iam.GroupPolicyAttachmentClient
+	kube client.Client
+	cr   resource.Managed
 }
 
 type groupPolicyModifier func(*v1alpha1.IAMGroupPolicyAttachment)
@@ -79,6 +81,10 @@ func withSpecPolicyArn(s string) groupPolicyModifier {
 	return func(r *v1alpha1.IAMGroupPolicyAttachment) { r.Spec.ForProvider.PolicyARN = &s }
 }
 
+func withExternalName(s string) groupPolicyModifier {
+	return func(r *v1alpha1.IAMGroupPolicyAttachment) { meta.SetExternalName(r, s) }
+}
+
 func withStatusPolicyArn(s string) groupPolicyModifier {
 	return func(r *v1alpha1.IAMGroupPolicyAttachment) { r.Status.AtProvider.AttachedPolicyARN = s }
 }
@@ -302,6 +308,47 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ImportedByExternalName": {
+			args: args{
+				iam: &fake.MockGroupPolicyAttachmentClient{
+					MockListAttachedGroupPolicies: func(input *awsiam.ListAttachedGroupPoliciesInput) awsiam.ListAttachedGroupPoliciesRequest {
+						return awsiam.ListAttachedGroupPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAttachedGroupPoliciesOutput{
+								AttachedPolicies: []awsiam.AttachedPolicy{
+									{
+										PolicyArn: &policyArn,
+									},
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: groupPolicy(withExternalName(groupName + "/" + policyArn)),
+			},
+			want: want{
+				cr: groupPolicy(withExternalName(groupName+"/"+policyArn),
+					withGroupName(&groupName),
+					withSpecPolicyArn(policyArn),
+					withConditions(runtimev1alpha1.Available()),
+					withStatusPolicyArn(policyArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InvalidExternalName": {
+			args: args{
+				cr: groupPolicy(withExternalName(groupName)),
+			},
+			want: want{
+				cr:  groupPolicy(withExternalName(groupName)),
+				err: errors.Errorf("external name %q must consist of %d non-empty parts separated by /", groupName, 2),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -346,7 +393,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -383,6 +430,9 @@ func TestCreate(t *testing.T) {
 						}
 					},
 				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
 				cr: groupPolicy(withGroupName(&groupName),
 					withSpecPolicyArn(policyArn)),
 			},
@@ -390,6 +440,7 @@ func TestCreate(t *testing.T) {
 				cr: groupPolicy(
 					withGroupName(&groupName),
 					withSpecPolicyArn(policyArn),
+					withExternalName(groupName+"/"+policyArn),
 					withConditions(runtimev1alpha1.Creating())),
 			},
 		},
@@ -425,7 +476,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -499,6 +550,27 @@ func TestDelete(t *testing.T) {
 				err: errors.Wrap(errBoom, errDetach),
 			},
 		},
+		"ImportedByExternalName": {
+			args: args{
+				iam: &fake.MockGroupPolicyAttachmentClient{
+					MockDetachGroupPolicy: func(input *awsiam.DetachGroupPolicyInput) awsiam.DetachGroupPolicyRequest {
+						if aws.StringValue(input.GroupName) != groupName || aws.StringValue(input.PolicyArn) != policyArn {
+							return awsiam.DetachGroupPolicyRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+							}
+						}
+						return awsiam.DetachGroupPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DetachGroupPolicyOutput{}},
+						}
+					},
+				},
+				cr: groupPolicy(withExternalName(groupName + "/" + policyArn)),
+			},
+			want: want{
+				cr: groupPolicy(withExternalName(groupName+"/"+policyArn),
+					withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
 		"ResourceDoesNotExist": {
 			args: args{
 				iam: &fake.MockGroupPolicyAttachmentClient{
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,13 +31,19 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 const (
@@ -50,6 +57,7 @@ const (
 	errGet              = "failed to get groups for user"
 	errAdd              = "failed to add the user to group"
 	errRemove           = "failed to remove the user to group"
+	errKubeUpdateFailed = "cannot update IAMGroupUserMembership custom resource"
 )
 
 // SetupIAMGroupUserMembership adds a controller that reconciles
@@ -59,10 +67,11 @@ func SetupIAMGroupUserMembership(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha1.IAMGroupUserMembership{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.IAMGroupUserMembershipGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupUserMembershipClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupUserMembershipClient}))))),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
@@ -86,6 +95,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		userGroupClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
 		return &external{client: userGroupClient, kube: c.kube}, errors.Wrap(err, errCreateGroupClient)
@@ -116,16 +130,21 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
+	group, user, err := membership(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	observed, err := e.client.ListGroupsForUserRequest(&awsiam.ListGroupsForUserInput{
-		UserName: cr.Spec.ForProvider.UserName,
+		UserName: aws.String(user),
 	}).Send(ctx)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
 	}
 
 	var attachedGroupObject *awsiam.Group
-	for i, group := range observed.Groups {
-		if aws.StringValue(cr.Spec.ForProvider.GroupName) == aws.StringValue(group.GroupName) {
+	for i, g := range observed.Groups {
+		if group == aws.StringValue(g.GroupName) {
 			attachedGroupObject = &observed.Groups[i]
 			break
 		}
@@ -137,6 +156,15 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}, nil
 	}
 
+	current := cr.Spec.ForProvider.DeepCopy()
+	cr.Spec.ForProvider.GroupName = awsclients.LateInitializeStringPtr(cr.Spec.ForProvider.GroupName, aws.String(group))
+	cr.Spec.ForProvider.UserName = awsclients.LateInitializeStringPtr(cr.Spec.ForProvider.UserName, aws.String(user))
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
 	cr.Status.AtProvider = v1alpha1.IAMGroupUserMembershipObservation{
 		AttachedGroupARN: aws.StringValue(attachedGroupObject.Arn),
 	}
@@ -161,8 +189,13 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		GroupName: cr.Spec.ForProvider.GroupName,
 		UserName:  cr.Spec.ForProvider.UserName,
 	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAdd)
+	}
+
+	meta.SetExternalName(cr, awsclients.CompositeExternalName(aws.StringValue(cr.Spec.ForProvider.GroupName), aws.StringValue(cr.Spec.ForProvider.UserName)))
 
-	return managed.ExternalCreation{}, errors.Wrap(err, errAdd)
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
 
 func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
@@ -180,10 +213,26 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
 
-	_, err := e.client.RemoveUserFromGroupRequest(&awsiam.RemoveUserFromGroupInput{
-		GroupName: cr.Spec.ForProvider.GroupName,
-		UserName:  cr.Spec.ForProvider.UserName,
+	group, user, err := membership(cr)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.client.RemoveUserFromGroupRequest(&awsiam.RemoveUserFromGroupInput{
+		GroupName: aws.String(group),
+		UserName:  aws.String(user),
 	}).Send(ctx)
 
 	return errors.Wrap(err, errRemove)
 }
+
+// membership returns the group and user names that identify the supplied
+// membership. They are read from its external name, which has the form
+// group/user, falling back to its spec until the external name is set.
+func membership(cr *v1alpha1.IAMGroupUserMembership) (string, string, error) {
+	parts, err := awsclients.CompositeExternalNameOf(cr, 2)
+	if err != nil || parts == nil {
+		return aws.StringValue(cr.Spec.ForProvider.GroupName), aws.StringValue(cr.Spec.ForProvider.UserName), err
+	}
+	return parts[0], parts[1], nil
+}
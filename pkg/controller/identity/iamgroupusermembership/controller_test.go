@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -61,8 +62,9 @@ var (
 )
 
 type args struct {
-	iam iam.GroupUserMembershipClient
-	cr  resource.Managed
+	iam  iam.GroupUserMembershipClient
+	kube client.Client
+	cr   resource.Managed
 }
 
 type userGroupModifier func(*v1alpha1.IAMGroupUserMembership)
@@ -79,6 +81,10 @@ func withSpecUserName(s *string) userGroupModifier {
 	return func(r *v1alpha1.IAMGroupUserMembership) { r.Spec.ForProvider.UserName = s }
 }
 
+func withExternalName(s string) userGroupModifier {
+	return func(r *v1alpha1.IAMGroupUserMembership) { meta.SetExternalName(r, s) }
+}
+
 func withStatusGroupArn(s string) userGroupModifier {
 	return func(r *v1alpha1.IAMGroupUserMembership) { r.Status.AtProvider.AttachedGroupARN = s }
 }
@@ -303,6 +309,48 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ImportedByExternalName": {
+			args: args{
+				iam: &fake.MockGroupUserMembershipClient{
+					MockListGroupsForUser: func(input *awsiam.ListGroupsForUserInput) awsiam.ListGroupsForUserRequest {
+						return awsiam.ListGroupsForUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListGroupsForUserOutput{
+								Groups: []awsiam.Group{
+									{
+										Arn:       &groupArn,
+										GroupName: &groupName,
+									},
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: userGroup(withExternalName(groupName + "/" + userName)),
+			},
+			want: want{
+				cr: userGroup(withExternalName(groupName+"/"+userName),
+					withGroupName(&groupName),
+					withSpecUserName(&userName),
+					withConditions(runtimev1alpha1.Available()),
+					withStatusGroupArn(groupArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InvalidExternalName": {
+			args: args{
+				cr: userGroup(withExternalName(groupName)),
+			},
+			want: want{
+				cr:  userGroup(withExternalName(groupName)),
+				err: errors.Errorf("external name %q must consist of %d non-empty parts separated by /", groupName, 2),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -347,7 +395,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -384,6 +432,9 @@ func TestCreate(t *testing.T) {
 						}
 					},
 				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
 				cr: userGroup(withGroupName(&groupName),
 					withSpecUserName(&userName)),
 			},
@@ -391,6 +442,7 @@ func TestCreate(t *testing.T) {
 				cr: userGroup(
 					withGroupName(&groupName),
 					withSpecUserName(&userName),
+					withExternalName(groupName+"/"+userName),
 					withConditions(runtimev1alpha1.Creating())),
 			},
 		},
@@ -426,7 +478,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -34,7 +34,12 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	v1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 	"github.com/crossplane/provider-aws/pkg/controller/utils"
 )
 
@@ -46,6 +51,7 @@ const (
 	errDelete           = "failed to delete the IAMRole resource"
 	errUpdate           = "failed to update the IAMRole resource"
 	errSDK              = "empty IAMRole received from IAM API"
+	errSimulate         = "failed to simulate the policies of the IAMRole"
 
 	errKubeUpdateFailed = "cannot late initialize IAMRole"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
@@ -57,10 +63,11 @@ func SetupIAMRole(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1beta1.IAMRole{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.IAMRoleGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: iam.NewRoleClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: iam.NewRoleClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}))))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -132,6 +139,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
 
+	results, err := iam.SimulatePolicy(ctx, e.client, cr.Status.AtProvider.ARN, cr.Spec.ForProvider.PolicySimulation)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSimulate)
+	}
+	cr.Status.AtProvider.PolicySimulationResults = results
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
 		ResourceUpToDate: upToDate,
@@ -187,6 +200,17 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 			PolicyDocument: &cr.Spec.ForProvider.AssumeRolePolicyDocument,
 			RoleName:       aws.String(meta.GetExternalName(cr)),
 		}).Send(ctx)
+
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	if patch.PermissionsBoundary != nil {
+		_, err = e.client.PutRolePermissionsBoundaryRequest(&awsiam.PutRolePermissionsBoundaryInput{
+			PermissionsBoundary: cr.Spec.ForProvider.PermissionsBoundary,
+			RoleName:            aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
 	}
 
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
@@ -0,0 +1,62 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamrole
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+	"github.com/crossplane/provider-aws/pkg/e2e"
+)
+
+const e2ePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+func TestLifecycle(t *testing.T) {
+	h := e2e.NewHarness(t)
+	h.Run(t, e2e.Lifecycle{
+		Name:         "role",
+		Connecter:    &connector{client: h.Kube, newClientFn: iam.NewRoleClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider},
+		Initializers: []managed.Initializer{managed.NewNameAsExternalName(h.Kube)},
+		Resource: func(name string) resource.Managed {
+			return &v1beta1.IAMRole{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: v1beta1.IAMRoleSpec{
+					ResourceSpec: runtimev1alpha1.ResourceSpec{ProviderReference: h.ProviderReference()},
+					ForProvider: v1beta1.IAMRoleParameters{
+						AssumeRolePolicyDocument: e2ePolicy,
+						Description:              aws.String("created"),
+					},
+				},
+			}
+		},
+		Update: func(mg resource.Managed) {
+			mg.(*v1beta1.IAMRole).Spec.ForProvider.Description = aws.String("updated")
+		},
+	})
+}
@@ -50,6 +50,7 @@ var (
 	unexpecedItem resource.Managed
 	roleName      = "some arbitrary name"
 	description   = "some description"
+	boundaryARN   = "arn:aws:iam::123456789012:policy/boundary"
 	policy        = `{
 		"Version": "2012-10-17",
 		"Statement": [
@@ -97,6 +98,12 @@ func withDescription() roleModifier {
 	}
 }
 
+func withPermissionsBoundary(arn string) roleModifier {
+	return func(r *v1beta1.IAMRole) {
+		r.Spec.ForProvider.PermissionsBoundary = aws.String(arn)
+	}
+}
+
 func role(m ...roleModifier) *v1beta1.IAMRole {
 	cr := &v1beta1.IAMRole{
 		Spec: v1beta1.IAMRoleSpec{
@@ -387,6 +394,54 @@ func TestUpdate(t *testing.T) {
 				cr: role(withRoleName(&roleName)),
 			},
 		},
+		"PutPermissionsBoundary": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{},
+							}},
+						}
+					},
+					MockPutRolePermissionsBoundaryRequest: func(input *awsiam.PutRolePermissionsBoundaryInput) awsiam.PutRolePermissionsBoundaryRequest {
+						if diff := cmp.Diff(boundaryARN, aws.StringValue(input.PermissionsBoundary)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.PutRolePermissionsBoundaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.PutRolePermissionsBoundaryOutput{}},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withPermissionsBoundary(boundaryARN)),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName), withPermissionsBoundary(boundaryARN)),
+			},
+		},
+		"PutPermissionsBoundaryError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{},
+							}},
+						}
+					},
+					MockPutRolePermissionsBoundaryRequest: func(input *awsiam.PutRolePermissionsBoundaryInput) awsiam.PutRolePermissionsBoundaryRequest {
+						return awsiam.PutRolePermissionsBoundaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withPermissionsBoundary(boundaryARN)),
+			},
+			want: want{
+				cr:  role(withRoleName(&roleName), withPermissionsBoundary(boundaryARN)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -29,11 +29,18 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	v1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 	"github.com/crossplane/provider-aws/pkg/controller/utils"
 )
 
@@ -44,7 +51,8 @@ const (
 	errAttach           = "failed to attach the policy to role"
 	errDetach           = "failed to detach the policy to role"
 
-	errKubeUpdateFailed = "cannot late initialize IAMRolePolicyAttachment"
+	errKubeUpdateFailed   = "cannot late initialize IAMRolePolicyAttachment"
+	errExternalNameUpdate = "cannot update external name of IAMRolePolicyAttachment"
 )
 
 // SetupIAMRolePolicyAttachment adds a controller that reconciles
@@ -54,12 +62,14 @@ func SetupIAMRolePolicyAttachment(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1beta1.IAMRolePolicyAttachment{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.IAMRolePolicyAttachmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: iam.NewRolePolicyAttachmentClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{client: mgr.GetClient(), newClientFn: iam.NewRolePolicyAttachmentClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}))))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
+			managed.WithInitializers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -100,16 +110,21 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
+	role, policy, err := attachment(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	observed, err := e.client.ListAttachedRolePoliciesRequest(&awsiam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(cr.Spec.ForProvider.RoleName),
+		RoleName: aws.String(role),
 	}).Send(ctx)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
 	}
 
 	var attachedPolicyObject *awsiam.AttachedPolicy
-	for i, policy := range observed.AttachedPolicies {
-		if cr.Spec.ForProvider.PolicyARN == aws.StringValue(policy.PolicyArn) {
+	for i, p := range observed.AttachedPolicies {
+		if policy == aws.StringValue(p.PolicyArn) {
 			attachedPolicyObject = &observed.AttachedPolicies[i]
 			break
 		}
@@ -122,6 +137,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	current := cr.Spec.ForProvider.DeepCopy()
+	cr.Spec.ForProvider.RoleName = awsclients.LateInitializeString(cr.Spec.ForProvider.RoleName, aws.String(role))
 	iam.LateInitializePolicy(&cr.Spec.ForProvider, attachedPolicyObject)
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
@@ -151,8 +167,13 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		PolicyArn: aws.String(cr.Spec.ForProvider.PolicyARN),
 		RoleName:  aws.String(cr.Spec.ForProvider.RoleName),
 	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAttach)
+	}
+
+	meta.SetExternalName(cr, awsclients.CompositeExternalName(cr.Spec.ForProvider.RoleName, cr.Spec.ForProvider.PolicyARN))
 
-	return managed.ExternalCreation{}, errors.Wrap(err, errAttach)
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errExternalNameUpdate)
 }
 
 func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
@@ -168,9 +189,14 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
 
-	_, err := e.client.DetachRolePolicyRequest(&awsiam.DetachRolePolicyInput{
-		PolicyArn: aws.String(cr.Spec.ForProvider.PolicyARN),
-		RoleName:  aws.String(cr.Spec.ForProvider.RoleName),
+	role, policy, err := attachment(cr)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.client.DetachRolePolicyRequest(&awsiam.DetachRolePolicyInput{
+		PolicyArn: aws.String(policy),
+		RoleName:  aws.String(role),
 	}).Send(ctx)
 
 	if iam.IsErrorNotFound(err) {
@@ -179,3 +205,14 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	return errors.Wrap(err, errDetach)
 }
+
+// attachment returns the role name and policy ARN that identify the supplied
+// attachment. They are read from its external name, which has the form
+// role/policy-arn, falling back to its spec until the external name is set.
+func attachment(cr *v1beta1.IAMRolePolicyAttachment) (string, string, error) {
+	parts, err := awsclients.CompositeExternalNameOf(cr, 2)
+	if err != nil || parts == nil {
+		return cr.Spec.ForProvider.RoleName, cr.Spec.ForProvider.PolicyARN, err
+	}
+	return parts[0], parts[1], nil
+}
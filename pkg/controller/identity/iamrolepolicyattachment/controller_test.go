@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -53,8 +54,9 @@ var (
 )
 
 type args struct {
-	iam iam.RolePolicyAttachmentClient
-	cr  resource.Managed
+	iam  iam.RolePolicyAttachmentClient
+	kube client.Client
+	cr   resource.Managed
 }
 
 type rolePolicyModifier func(*v1beta1.IAMRolePolicyAttachment)
@@ -71,6 +73,10 @@ func withSpecPolicyArn(s *string) rolePolicyModifier {
 	return func(r *v1beta1.IAMRolePolicyAttachment) { r.Spec.ForProvider.PolicyARN = *s }
 }
 
+func withExternalName(s string) rolePolicyModifier {
+	return func(r *v1beta1.IAMRolePolicyAttachment) { meta.SetExternalName(r, s) }
+}
+
 func withStatusPolicyArn(s *string) rolePolicyModifier {
 	return func(r *v1beta1.IAMRolePolicyAttachment) { r.Status.AtProvider.AttachedPolicyARN = *s }
 }
@@ -201,6 +207,47 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ImportedByExternalName": {
+			args: args{
+				iam: &fake.MockRolePolicyAttachmentClient{
+					MockListAttachedRolePoliciesRequest: func(input *awsiam.ListAttachedRolePoliciesInput) awsiam.ListAttachedRolePoliciesRequest {
+						return awsiam.ListAttachedRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiam.AttachedPolicy{
+									{
+										PolicyArn: &specPolicyArn,
+									},
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: rolePolicy(withExternalName(roleName + "/" + specPolicyArn)),
+			},
+			want: want{
+				cr: rolePolicy(withExternalName(roleName+"/"+specPolicyArn),
+					withRoleName(&roleName),
+					withSpecPolicyArn(&specPolicyArn),
+					withConditions(corev1alpha1.Available()),
+					withStatusPolicyArn(&specPolicyArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InvalidExternalName": {
+			args: args{
+				cr: rolePolicy(withExternalName(roleName)),
+			},
+			want: want{
+				cr:  rolePolicy(withExternalName(roleName)),
+				err: errors.Errorf("external name %q must consist of %d non-empty parts separated by /", roleName, 2),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -245,7 +292,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -282,6 +329,9 @@ func TestCreate(t *testing.T) {
 						}
 					},
 				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
 				cr: rolePolicy(withRoleName(&roleName),
 					withSpecPolicyArn(&specPolicyArn)),
 			},
@@ -289,6 +339,7 @@ func TestCreate(t *testing.T) {
 				cr: rolePolicy(
 					withRoleName(&roleName),
 					withSpecPolicyArn(&specPolicyArn),
+					withExternalName(roleName+"/"+specPolicyArn),
 					withConditions(corev1alpha1.Creating())),
 			},
 		},
@@ -324,7 +375,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
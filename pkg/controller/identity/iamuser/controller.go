@@ -18,6 +18,7 @@ package iamuser
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
@@ -32,13 +33,19 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 const (
@@ -55,6 +62,17 @@ const (
 	errUpdate           = "failed to update the IAM User resource"
 	errSDK              = "empty IAM User received from IAM API"
 
+	errGetLoginProfile    = "failed to get the login profile of the IAM User"
+	errCreateLoginProfile = "failed to create the login profile of the IAM User"
+	errDeleteLoginProfile = "failed to delete the login profile of the IAM User"
+	errGetPassword        = "cannot get the password of the IAM User login profile"
+	errListMFADevices     = "failed to list the MFA devices of the IAM User"
+	errCreateMFADevice    = "failed to create the virtual MFA device of the IAM User"
+	errEnableMFADevice    = "failed to enable the virtual MFA device of the IAM User"
+	errDeleteMFADevice    = "failed to delete the virtual MFA device of the IAM User"
+	errPutBoundary        = "failed to put the permissions boundary of the IAM User"
+	errSimulate           = "failed to simulate the policies of the IAM User"
+
 	errKubeUpdateFailed = "cannot late initialize IAM User"
 )
 
@@ -64,10 +82,12 @@ func SetupIAMUser(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha1.IAMUser{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.IAMUserGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -89,6 +109,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		userClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
 		return &external{client: userClient, kube: c.kube}, errors.Wrap(err, errCreateUserClient)
@@ -147,9 +172,28 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		UserID: aws.StringValue(user.UserId),
 	}
 
+	lp, err := e.getLoginProfile(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetLoginProfile)
+	}
+	mfa, err := e.getMFADevice(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListMFADevices)
+	}
+	if mfa != nil {
+		cr.Status.AtProvider.MFADeviceSerialNumber = aws.StringValue(mfa.SerialNumber)
+	}
+	results, err := iam.SimulatePolicy(ctx, e.client, cr.Status.AtProvider.ARN, cr.Spec.ForProvider.PolicySimulation)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSimulate)
+	}
+	cr.Status.AtProvider.PolicySimulationResults = results
+
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: aws.StringValue(cr.Spec.ForProvider.Path) == aws.StringValue(user.Path),
+		ResourceExists: true,
+		ResourceUpToDate: iam.IsUserUpToDate(cr.Spec.ForProvider, user) &&
+			(cr.Spec.ForProvider.LoginProfile == nil || lp != nil) &&
+			(cr.Spec.ForProvider.VirtualMFADevice == nil || mfa != nil),
 	}, nil
 }
 
@@ -176,12 +220,37 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	_, err := e.client.UpdateUserRequest(&awsiam.UpdateUserInput{
+	if _, err := e.client.UpdateUserRequest(&awsiam.UpdateUserInput{
 		NewPath:  cr.Spec.ForProvider.Path,
 		UserName: aws.String(meta.GetExternalName(cr)),
-	}).Send(ctx)
+	}).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	if cr.Spec.ForProvider.PermissionsBoundary != nil {
+		if _, err := e.client.PutUserPermissionsBoundaryRequest(&awsiam.PutUserPermissionsBoundaryInput{
+			PermissionsBoundary: cr.Spec.ForProvider.PermissionsBoundary,
+			UserName:            aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPutBoundary)
+		}
+	}
+
+	conn, err := e.createLoginProfile(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	mfa, err := e.createMFADevice(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if conn == nil {
+		return managed.ExternalUpdate{ConnectionDetails: mfa}, nil
+	}
+	for k, v := range mfa {
+		conn[k] = v
+	}
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -192,9 +261,153 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
 
+	// IAM refuses to delete users that have a login profile or MFA device,
+	// so we delete those we manage first.
+	if cr.Spec.ForProvider.LoginProfile != nil {
+		_, err := e.client.DeleteLoginProfileRequest(&awsiam.DeleteLoginProfileInput{
+			UserName: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errDeleteLoginProfile)
+		}
+	}
+	if err := e.deleteMFADevice(ctx, cr); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return errors.Wrap(err, errDeleteMFADevice)
+	}
+
 	_, err := e.client.DeleteUserRequest(&awsiam.DeleteUserInput{
 		UserName: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
 
 	return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
+
+// getLoginProfile returns the login profile of the supplied user, or nil if
+// it does not have one or its login profile is not managed.
+func (e *external) getLoginProfile(ctx context.Context, cr *v1alpha1.IAMUser) (*awsiam.LoginProfile, error) {
+	if cr.Spec.ForProvider.LoginProfile == nil {
+		return nil, nil
+	}
+	rsp, err := e.client.GetLoginProfileRequest(&awsiam.GetLoginProfileInput{
+		UserName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, resource.Ignore(iam.IsErrorNotFound, err)
+	}
+	return rsp.LoginProfile, nil
+}
+
+// createLoginProfile creates the login profile of the supplied user if it
+// does not have one, and returns its credentials.
+func (e *external) createLoginProfile(ctx context.Context, cr *v1alpha1.IAMUser) (managed.ConnectionDetails, error) {
+	lp, err := e.getLoginProfile(ctx, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetLoginProfile)
+	}
+	if cr.Spec.ForProvider.LoginProfile == nil || lp != nil {
+		return nil, nil
+	}
+	pw, err := e.getPassword(ctx, cr.Spec.ForProvider.LoginProfile.PasswordSecretRef)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPassword)
+	}
+	if _, err := e.client.CreateLoginProfileRequest(&awsiam.CreateLoginProfileInput{
+		UserName:              aws.String(meta.GetExternalName(cr)),
+		Password:              aws.String(pw),
+		PasswordResetRequired: cr.Spec.ForProvider.LoginProfile.PasswordResetRequired,
+	}).Send(ctx); err != nil {
+		return nil, errors.Wrap(err, errCreateLoginProfile)
+	}
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(meta.GetExternalName(cr)),
+		runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	}, nil
+}
+
+// getPassword returns the value of the referenced secret key, or a generated
+// password if no secret is referenced.
+func (e *external) getPassword(ctx context.Context, ref *runtimev1alpha1.SecretKeySelector) (string, error) {
+	if ref == nil {
+		return password.Generate()
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", err
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+// getMFADevice returns the virtual MFA device of the supplied user, or nil if
+// it does not have one or its virtual MFA device is not managed.
+func (e *external) getMFADevice(ctx context.Context, cr *v1alpha1.IAMUser) (*awsiam.MFADevice, error) {
+	if cr.Spec.ForProvider.VirtualMFADevice == nil {
+		return nil, nil
+	}
+	rsp, err := e.client.ListMFADevicesRequest(&awsiam.ListMFADevicesInput{
+		UserName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rsp.MFADevices {
+		if iam.IsVirtualMFADevice(meta.GetExternalName(cr), *cr.Spec.ForProvider.VirtualMFADevice, rsp.MFADevices[i]) {
+			return &rsp.MFADevices[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// createMFADevice creates and enables the virtual MFA device of the supplied
+// user if it does not have one, and returns its seed.
+func (e *external) createMFADevice(ctx context.Context, cr *v1alpha1.IAMUser) (managed.ConnectionDetails, error) {
+	d, err := e.getMFADevice(ctx, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errListMFADevices)
+	}
+	if cr.Spec.ForProvider.VirtualMFADevice == nil || d != nil {
+		return nil, nil
+	}
+	rsp, err := e.client.CreateVirtualMFADeviceRequest(&awsiam.CreateVirtualMFADeviceInput{
+		Path:                 cr.Spec.ForProvider.VirtualMFADevice.Path,
+		VirtualMFADeviceName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateMFADevice)
+	}
+	serial := aws.StringValue(rsp.VirtualMFADevice.SerialNumber)
+	seed := rsp.VirtualMFADevice.Base32StringSeed
+
+	input, err := iam.GenerateEnableMFADeviceInput(meta.GetExternalName(cr), serial, seed, time.Now())
+	if err == nil {
+		_, err = e.client.EnableMFADeviceRequest(input).Send(ctx)
+	}
+	if err != nil {
+		// The seed of an unassigned device cannot be retrieved, so there is
+		// no point in keeping it around.
+		_, _ = e.client.DeleteVirtualMFADeviceRequest(&awsiam.DeleteVirtualMFADeviceInput{SerialNumber: aws.String(serial)}).Send(ctx)
+		return nil, errors.Wrap(err, errEnableMFADevice)
+	}
+	return managed.ConnectionDetails{
+		iam.ConnectionKeyMFASeed:         seed,
+		iam.ConnectionKeyMFASerialNumber: []byte(serial),
+	}, nil
+}
+
+// deleteMFADevice deactivates and deletes the virtual MFA device of the
+// supplied user, if it has one.
+func (e *external) deleteMFADevice(ctx context.Context, cr *v1alpha1.IAMUser) error {
+	d, err := e.getMFADevice(ctx, cr)
+	if err != nil || d == nil {
+		return err
+	}
+	if _, err := e.client.DeactivateMFADeviceRequest(&awsiam.DeactivateMFADeviceInput{
+		UserName:     aws.String(meta.GetExternalName(cr)),
+		SerialNumber: d.SerialNumber,
+	}).Send(ctx); err != nil {
+		return err
+	}
+	_, err = e.client.DeleteVirtualMFADeviceRequest(&awsiam.DeleteVirtualMFADeviceInput{
+		SerialNumber: d.SerialNumber,
+	}).Send(ctx)
+	return err
+}
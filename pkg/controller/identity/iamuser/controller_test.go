@@ -25,11 +25,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,10 +50,69 @@ import (
 var (
 	unexpecedItem resource.Managed
 	userName      = "some user"
+	mfaSerial     = "arn:aws:iam::123456789012:mfa/some user"
+	mfaSeed       = []byte("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	userPassword  = "hunter2"
 
-	errBoom = errors.New("boom")
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil)
 )
 
+func getUser(input *awsiam.GetUserInput) awsiam.GetUserRequest {
+	return awsiam.GetUserRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetUserOutput{
+			User: &awsiam.User{},
+		}},
+	}
+}
+
+func getLoginProfile(err error) func(*awsiam.GetLoginProfileInput) awsiam.GetLoginProfileRequest {
+	return func(input *awsiam.GetLoginProfileInput) awsiam.GetLoginProfileRequest {
+		return awsiam.GetLoginProfileRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsiam.GetLoginProfileOutput{
+				LoginProfile: &awsiam.LoginProfile{UserName: aws.String(userName)},
+			}},
+		}
+	}
+}
+
+func updateUser(input *awsiam.UpdateUserInput) awsiam.UpdateUserRequest {
+	return awsiam.UpdateUserRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateUserOutput{}},
+	}
+}
+
+func createVirtualMFADevice(input *awsiam.CreateVirtualMFADeviceInput) awsiam.CreateVirtualMFADeviceRequest {
+	return awsiam.CreateVirtualMFADeviceRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateVirtualMFADeviceOutput{
+			VirtualMFADevice: &awsiam.VirtualMFADevice{SerialNumber: aws.String(mfaSerial), Base32StringSeed: mfaSeed},
+		}},
+	}
+}
+
+func enableMFADevice(t *testing.T, err error) func(*awsiam.EnableMFADeviceInput) awsiam.EnableMFADeviceRequest {
+	return func(input *awsiam.EnableMFADeviceInput) awsiam.EnableMFADeviceRequest {
+		if len(aws.StringValue(input.AuthenticationCode1)) != 6 || len(aws.StringValue(input.AuthenticationCode2)) != 6 {
+			t.Errorf("expected two six digit authentication codes, got %q and %q", aws.StringValue(input.AuthenticationCode1), aws.StringValue(input.AuthenticationCode2))
+		}
+		return awsiam.EnableMFADeviceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsiam.EnableMFADeviceOutput{}},
+		}
+	}
+}
+
+func listMFADevices(serials ...string) func(*awsiam.ListMFADevicesInput) awsiam.ListMFADevicesRequest {
+	return func(input *awsiam.ListMFADevicesInput) awsiam.ListMFADevicesRequest {
+		out := &awsiam.ListMFADevicesOutput{}
+		for _, s := range serials {
+			out.MFADevices = append(out.MFADevices, awsiam.MFADevice{SerialNumber: aws.String(s), UserName: aws.String(userName)})
+		}
+		return awsiam.ListMFADevicesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
 const (
 	providerName    = "aws-creds"
 	secretNamespace = "crossplane-system"
@@ -63,8 +124,9 @@ const (
 )
 
 type args struct {
-	iam iam.UserClient
-	cr  resource.Managed
+	kube client.Client
+	iam  iam.UserClient
+	cr   resource.Managed
 }
 
 type userModifier func(*v1alpha1.IAMUser)
@@ -77,6 +139,18 @@ func withExternalName(name string) userModifier {
 	return func(r *v1alpha1.IAMUser) { meta.SetExternalName(r, name) }
 }
 
+func withLoginProfile(p *v1alpha1.LoginProfileParameters) userModifier {
+	return func(r *v1alpha1.IAMUser) { r.Spec.ForProvider.LoginProfile = p }
+}
+
+func withVirtualMFADevice(p *v1alpha1.VirtualMFADeviceParameters) userModifier {
+	return func(r *v1alpha1.IAMUser) { r.Spec.ForProvider.VirtualMFADevice = p }
+}
+
+func withMFADeviceSerialNumber(s string) userModifier {
+	return func(r *v1alpha1.IAMUser) { r.Status.AtProvider.MFADeviceSerialNumber = s }
+}
+
 func user(m ...userModifier) *v1alpha1.IAMUser {
 	cr := &v1alpha1.IAMUser{
 		Spec: v1alpha1.IAMUserSpec{
@@ -289,6 +363,77 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"LoginProfileMissing": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser:         getUser,
+					MockGetLoginProfile: getLoginProfile(errNotFound),
+				},
+				cr: user(withExternalName(userName), withLoginProfile(&v1alpha1.LoginProfileParameters{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withLoginProfile(&v1alpha1.LoginProfileParameters{}),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"GetLoginProfileError": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser:         getUser,
+					MockGetLoginProfile: getLoginProfile(errBoom),
+				},
+				cr: user(withExternalName(userName), withLoginProfile(&v1alpha1.LoginProfileParameters{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withLoginProfile(&v1alpha1.LoginProfileParameters{}),
+					withConditions(corev1alpha1.Available())),
+				err: errors.Wrap(errBoom, errGetLoginProfile),
+			},
+		},
+		"MFADeviceMissing": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser:        getUser,
+					MockListMFADevices: listMFADevices("arn:aws:iam::123456789012:mfa/someone else"),
+				},
+				cr: user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{}),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LoginProfileAndMFADeviceExist": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser:         getUser,
+					MockGetLoginProfile: getLoginProfile(nil),
+					MockListMFADevices:  listMFADevices(mfaSerial),
+				},
+				cr: user(withExternalName(userName),
+					withLoginProfile(&v1alpha1.LoginProfileParameters{}),
+					withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName),
+					withLoginProfile(&v1alpha1.LoginProfileParameters{}),
+					withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{}),
+					withMFADeviceSerialNumber(mfaSerial),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -409,6 +554,13 @@ func TestCreate(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
+	loginProfile := &v1alpha1.LoginProfileParameters{
+		PasswordSecretRef: &runtimev1alpha1.SecretKeySelector{
+			SecretReference: runtimev1alpha1.SecretReference{Namespace: secretNamespace, Name: connectionSecretName},
+			Key:             secretKey,
+		},
+		PasswordResetRequired: aws.Bool(true),
+	}
 
 	type want struct {
 		cr     resource.Managed
@@ -435,6 +587,102 @@ func TestUpdate(t *testing.T) {
 				cr: user(withExternalName(userName)),
 			},
 		},
+		"CreateLoginProfileFromSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if diff := cmp.Diff(client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}, key); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						obj.(*corev1.Secret).Data = map[string][]byte{secretKey: []byte(userPassword)}
+						return nil
+					},
+				},
+				iam: &fake.MockUserClient{
+					MockUpdateUser:      updateUser,
+					MockGetLoginProfile: getLoginProfile(errNotFound),
+					MockCreateLoginProfile: func(input *awsiam.CreateLoginProfileInput) awsiam.CreateLoginProfileRequest {
+						if diff := cmp.Diff(&awsiam.CreateLoginProfileInput{
+							UserName:              aws.String(userName),
+							Password:              aws.String(userPassword),
+							PasswordResetRequired: aws.Bool(true),
+						}, input, cmpopts.IgnoreUnexported(awsiam.CreateLoginProfileInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.CreateLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateLoginProfileOutput{}},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withLoginProfile(loginProfile)),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withLoginProfile(loginProfile)),
+				result: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(userName),
+					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(userPassword),
+				}},
+			},
+		},
+		"CreateLoginProfileError": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockUpdateUser:      updateUser,
+					MockGetLoginProfile: getLoginProfile(errNotFound),
+					MockCreateLoginProfile: func(input *awsiam.CreateLoginProfileInput) awsiam.CreateLoginProfileRequest {
+						return awsiam.CreateLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withLoginProfile(&v1alpha1.LoginProfileParameters{})),
+			},
+			want: want{
+				cr:  user(withExternalName(userName), withLoginProfile(&v1alpha1.LoginProfileParameters{})),
+				err: errors.Wrap(errBoom, errCreateLoginProfile),
+			},
+		},
+		"CreateMFADevice": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockUpdateUser:             updateUser,
+					MockListMFADevices:         listMFADevices(),
+					MockCreateVirtualMFADevice: createVirtualMFADevice,
+					MockEnableMFADevice:        enableMFADevice(t, nil),
+				},
+				cr: user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{})),
+				result: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					iam.ConnectionKeyMFASeed:         mfaSeed,
+					iam.ConnectionKeyMFASerialNumber: []byte(mfaSerial),
+				}},
+			},
+		},
+		"EnableMFADeviceError": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockUpdateUser:             updateUser,
+					MockListMFADevices:         listMFADevices(),
+					MockCreateVirtualMFADevice: createVirtualMFADevice,
+					MockEnableMFADevice:        enableMFADevice(t, errBoom),
+					MockDeleteVirtualMFADevice: func(input *awsiam.DeleteVirtualMFADeviceInput) awsiam.DeleteVirtualMFADeviceRequest {
+						if diff := cmp.Diff(mfaSerial, aws.StringValue(input.SerialNumber)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.DeleteVirtualMFADeviceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteVirtualMFADeviceOutput{}},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{})),
+			},
+			want: want{
+				cr:  user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{})),
+				err: errors.Wrap(errBoom, errEnableMFADevice),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -448,7 +696,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{kube: tc.kube, client: tc.iam}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -491,6 +739,100 @@ func TestDelete(t *testing.T) {
 					withConditions(corev1alpha1.Deleting())),
 			},
 		},
+		"DeleteLoginProfileAndMFADevice": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockDeleteLoginProfile: func(input *awsiam.DeleteLoginProfileInput) awsiam.DeleteLoginProfileRequest {
+						return awsiam.DeleteLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteLoginProfileOutput{}},
+						}
+					},
+					MockListMFADevices: listMFADevices(mfaSerial),
+					MockDeactivateMFADevice: func(input *awsiam.DeactivateMFADeviceInput) awsiam.DeactivateMFADeviceRequest {
+						return awsiam.DeactivateMFADeviceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeactivateMFADeviceOutput{}},
+						}
+					},
+					MockDeleteVirtualMFADevice: func(input *awsiam.DeleteVirtualMFADeviceInput) awsiam.DeleteVirtualMFADeviceRequest {
+						return awsiam.DeleteVirtualMFADeviceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteVirtualMFADeviceOutput{}},
+						}
+					},
+					MockDeleteUser: func(input *awsiam.DeleteUserInput) awsiam.DeleteUserRequest {
+						return awsiam.DeleteUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteUserOutput{}},
+						}
+					},
+				},
+				cr: user(withExternalName(userName),
+					withLoginProfile(&v1alpha1.LoginProfileParameters{}),
+					withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName),
+					withLoginProfile(&v1alpha1.LoginProfileParameters{}),
+					withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{}),
+					withConditions(corev1alpha1.Deleting())),
+			},
+		},
+		"DeleteLoginProfileError": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockDeleteLoginProfile: func(input *awsiam.DeleteLoginProfileInput) awsiam.DeleteLoginProfileRequest {
+						return awsiam.DeleteLoginProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withLoginProfile(&v1alpha1.LoginProfileParameters{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withLoginProfile(&v1alpha1.LoginProfileParameters{}),
+					withConditions(corev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteLoginProfile),
+			},
+		},
+		"MFADeviceNotFound": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockListMFADevices: listMFADevices(mfaSerial),
+					MockDeactivateMFADevice: func(input *awsiam.DeactivateMFADeviceInput) awsiam.DeactivateMFADeviceRequest {
+						return awsiam.DeactivateMFADeviceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+					MockDeleteUser: func(input *awsiam.DeleteUserInput) awsiam.DeleteUserRequest {
+						return awsiam.DeleteUserRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{}),
+					withConditions(corev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+		"DeleteMFADeviceError": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockListMFADevices: listMFADevices(mfaSerial),
+					MockDeactivateMFADevice: func(input *awsiam.DeactivateMFADeviceInput) awsiam.DeactivateMFADeviceRequest {
+						return awsiam.DeactivateMFADeviceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{})),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withVirtualMFADevice(&v1alpha1.VirtualMFADeviceParameters{}),
+					withConditions(corev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteMFADevice),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
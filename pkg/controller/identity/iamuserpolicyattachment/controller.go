@@ -31,13 +31,19 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 )
 
 const (
@@ -52,7 +58,8 @@ const (
 	errAttach           = "failed to attach the policy to user"
 	errDetach           = "failed to detach the policy to user"
 
-	errKubeUpdateFailed = "cannot late initialize UserPolicyAttachment"
+	errKubeUpdateFailed   = "cannot late initialize UserPolicyAttachment"
+	errExternalNameUpdate = "cannot update external name of UserPolicyAttachment"
 )
 
 // SetupIAMUserPolicyAttachment adds a controller that reconciles
@@ -62,12 +69,14 @@ func SetupIAMUserPolicyAttachment(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&v1alpha1.IAMUserPolicyAttachment{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.IAMUserPolicyAttachmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserPolicyAttachmentClient}),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserPolicyAttachmentClient}))))),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -88,6 +97,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetProvider)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		userPolicyClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
 		return &external{client: userPolicyClient, kube: c.kube}, errors.Wrap(err, errCreateUserClient)
@@ -118,16 +132,21 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
+	user, policy, err := attachment(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	observed, err := e.client.ListAttachedUserPoliciesRequest(&awsiam.ListAttachedUserPoliciesInput{
-		UserName: aws.String(cr.Spec.ForProvider.UserName),
+		UserName: aws.String(user),
 	}).Send(ctx)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
 	}
 
 	var attachedPolicyObject *awsiam.AttachedPolicy
-	for i, policy := range observed.AttachedPolicies {
-		if cr.Spec.ForProvider.PolicyARN == aws.StringValue(policy.PolicyArn) {
+	for i, p := range observed.AttachedPolicies {
+		if policy == aws.StringValue(p.PolicyArn) {
 			attachedPolicyObject = &observed.AttachedPolicies[i]
 			break
 		}
@@ -140,6 +159,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	current := cr.Spec.ForProvider.DeepCopy()
+	cr.Spec.ForProvider.UserName = awsclients.LateInitializeString(cr.Spec.ForProvider.UserName, aws.String(user))
 	iam.LateInitializeUserPolicy(&cr.Spec.ForProvider, attachedPolicyObject)
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
@@ -171,8 +191,13 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		PolicyArn: aws.String(cr.Spec.ForProvider.PolicyARN),
 		UserName:  aws.String(cr.Spec.ForProvider.UserName),
 	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAttach)
+	}
+
+	meta.SetExternalName(cr, awsclients.CompositeExternalName(cr.Spec.ForProvider.UserName, cr.Spec.ForProvider.PolicyARN))
 
-	return managed.ExternalCreation{}, errors.Wrap(err, errAttach)
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errExternalNameUpdate)
 }
 
 func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
@@ -190,10 +215,26 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(runtimev1alpha1.Deleting())
 
-	_, err := e.client.DetachUserPolicyRequest(&awsiam.DetachUserPolicyInput{
-		PolicyArn: aws.String(cr.Spec.ForProvider.PolicyARN),
-		UserName:  aws.String(cr.Spec.ForProvider.UserName),
+	user, policy, err := attachment(cr)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.client.DetachUserPolicyRequest(&awsiam.DetachUserPolicyInput{
+		PolicyArn: aws.String(policy),
+		UserName:  aws.String(user),
 	}).Send(ctx)
 
 	return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDetach)
 }
+
+// attachment returns the user name and policy ARN that identify the supplied
+// attachment. They are read from its external name, which has the form
+// user/policy-arn, falling back to its spec until the external name is set.
+func attachment(cr *v1alpha1.IAMUserPolicyAttachment) (string, string, error) {
+	parts, err := awsclients.CompositeExternalNameOf(cr, 2)
+	if err != nil || parts == nil {
+		return cr.Spec.ForProvider.UserName, cr.Spec.ForProvider.PolicyARN, err
+	}
+	return parts[0], parts[1], nil
+}
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -62,8 +63,9 @@ var (
 )
 
 type args struct {
-	iam iam.UserPolicyAttachmentClient
-	cr  resource.Managed
+	iam  iam.UserPolicyAttachmentClient
+	kube client.Client
+	cr   resource.Managed
 }
 
 type userPolicyModifier func(*v1alpha1.IAMUserPolicyAttachment)
@@ -80,6 +82,10 @@ func withSpecPolicyArn(s string) userPolicyModifier {
 	return func(r *v1alpha1.IAMUserPolicyAttachment) { r.Spec.ForProvider.PolicyARN = s }
 }
 
+func withExternalName(s string) userPolicyModifier {
+	return func(r *v1alpha1.IAMUserPolicyAttachment) { meta.SetExternalName(r, s) }
+}
+
 func withStatusPolicyArn(s string) userPolicyModifier {
 	return func(r *v1alpha1.IAMUserPolicyAttachment) { r.Status.AtProvider.AttachedPolicyARN = s }
 }
@@ -303,6 +309,47 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ImportedByExternalName": {
+			args: args{
+				iam: &fake.MockUserPolicyAttachmentClient{
+					MockListAttachedUserPolicies: func(input *awsiam.ListAttachedUserPoliciesInput) awsiam.ListAttachedUserPoliciesRequest {
+						return awsiam.ListAttachedUserPoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAttachedUserPoliciesOutput{
+								AttachedPolicies: []awsiam.AttachedPolicy{
+									{
+										PolicyArn: &policyArn,
+									},
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: userPolicy(withExternalName(userName + "/" + policyArn)),
+			},
+			want: want{
+				cr: userPolicy(withExternalName(userName+"/"+policyArn),
+					withUserName(userName),
+					withSpecPolicyArn(policyArn),
+					withConditions(runtimev1alpha1.Available()),
+					withStatusPolicyArn(policyArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InvalidExternalName": {
+			args: args{
+				cr: userPolicy(withExternalName(userName)),
+			},
+			want: want{
+				cr:  userPolicy(withExternalName(userName)),
+				err: errors.Errorf("external name %q must consist of %d non-empty parts separated by /", userName, 2),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -347,7 +394,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -384,6 +431,9 @@ func TestCreate(t *testing.T) {
 						}
 					},
 				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
 				cr: userPolicy(withUserName(userName),
 					withSpecPolicyArn(policyArn)),
 			},
@@ -391,6 +441,7 @@ func TestCreate(t *testing.T) {
 				cr: userPolicy(
 					withUserName(userName),
 					withSpecPolicyArn(policyArn),
+					withExternalName(userName+"/"+policyArn),
 					withConditions(runtimev1alpha1.Creating())),
 			},
 		},
@@ -426,7 +477,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,556 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagerecipe
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsimagebuilder "github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+	"github.com/crossplane/provider-aws/pkg/clients/imagebuilder/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	resourceID  = "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/example/1.0.0"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsimagebuilder.ErrCodeResourceNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client imagebuilder.ImageRecipeClient
+	kube   client.Client
+	cr     *v1alpha1.ImageRecipe
+}
+
+type imageRecipeModifier func(*v1alpha1.ImageRecipe)
+
+func withConditions(c ...runtimev1alpha1.Condition) imageRecipeModifier {
+	return func(r *v1alpha1.ImageRecipe) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) imageRecipeModifier {
+	return func(r *v1alpha1.ImageRecipe) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha1.ImageRecipeObservation) imageRecipeModifier {
+	return func(r *v1alpha1.ImageRecipe) { r.Status.AtProvider = o }
+}
+
+func withDescription(s string) imageRecipeModifier {
+	return func(r *v1alpha1.ImageRecipe) { r.Spec.ForProvider.Description = aws.String(s) }
+}
+
+func imageRecipe(m ...imageRecipeModifier) *v1alpha1.ImageRecipe {
+	cr := &v1alpha1.ImageRecipe{
+		Spec: v1alpha1.ImageRecipeSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ImageRecipeParameters{
+				Name:            "example",
+				SemanticVersion: "1.0.0",
+				ParentImage:     "ami-0123456789",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *awsimagebuilder.GetImageRecipeOutput {
+	return &awsimagebuilder.GetImageRecipeOutput{
+		ImageRecipe: &awsimagebuilder.ImageRecipe{Arn: aws.String(resourceID), Description: aws.String("desc")},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (imagebuilder.ImageRecipeClient, error)
+		cr          *v1alpha1.ImageRecipe
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i imagebuilder.ImageRecipeClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: imageRecipe(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i imagebuilder.ImageRecipeClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: imageRecipe(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: imageRecipe(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: imageRecipe(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: imageRecipe(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ImageRecipe
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: imageRecipe(),
+			},
+			want: want{
+				cr: imageRecipe(),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockImageRecipeClient{
+					MockGetImageRecipe: func(input *awsimagebuilder.GetImageRecipeInput) awsimagebuilder.GetImageRecipeRequest {
+						return awsimagebuilder.GetImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: imageRecipe(withExternalName(resourceID), withDescription("desc")),
+			},
+			want: want{
+				cr: imageRecipe(
+					withExternalName(resourceID),
+					withDescription("desc"),
+					withObservation(v1alpha1.ImageRecipeObservation{ARN: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"IgnoresChanges": {
+			args: args{
+				client: &fake.MockImageRecipeClient{
+					MockGetImageRecipe: func(input *awsimagebuilder.GetImageRecipeInput) awsimagebuilder.GetImageRecipeRequest {
+						return awsimagebuilder.GetImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: imageRecipe(withExternalName(resourceID), withDescription("new desc")),
+			},
+			want: want{
+				cr: imageRecipe(
+					withExternalName(resourceID),
+					withDescription("new desc"),
+					withObservation(v1alpha1.ImageRecipeObservation{ARN: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockImageRecipeClient{
+					MockGetImageRecipe: func(input *awsimagebuilder.GetImageRecipeInput) awsimagebuilder.GetImageRecipeRequest {
+						return awsimagebuilder.GetImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: imageRecipe(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: imageRecipe(withExternalName(resourceID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockImageRecipeClient{
+					MockGetImageRecipe: func(input *awsimagebuilder.GetImageRecipeInput) awsimagebuilder.GetImageRecipeRequest {
+						return awsimagebuilder.GetImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: imageRecipe(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  imageRecipe(withExternalName(resourceID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ImageRecipe
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockImageRecipeClient{
+					MockCreateImageRecipe: func(input *awsimagebuilder.CreateImageRecipeInput) awsimagebuilder.CreateImageRecipeRequest {
+						return awsimagebuilder.CreateImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsimagebuilder.CreateImageRecipeOutput{
+								ImageRecipeArn: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: imageRecipe(),
+			},
+			want: want{
+				cr: imageRecipe(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockImageRecipeClient{
+					MockCreateImageRecipe: func(input *awsimagebuilder.CreateImageRecipeInput) awsimagebuilder.CreateImageRecipeRequest {
+						return awsimagebuilder.CreateImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: imageRecipe(),
+			},
+			want: want{
+				cr:  imageRecipe(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"FailedSpecUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &fake.MockImageRecipeClient{
+					MockCreateImageRecipe: func(input *awsimagebuilder.CreateImageRecipeInput) awsimagebuilder.CreateImageRecipeRequest {
+						return awsimagebuilder.CreateImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsimagebuilder.CreateImageRecipeOutput{
+								ImageRecipeArn: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: imageRecipe(),
+			},
+			want: want{
+				cr: imageRecipe(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ImageRecipe
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockImageRecipeClient{
+					MockDeleteImageRecipe: func(input *awsimagebuilder.DeleteImageRecipeInput) awsimagebuilder.DeleteImageRecipeRequest {
+						return awsimagebuilder.DeleteImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsimagebuilder.DeleteImageRecipeOutput{}},
+						}
+					},
+				},
+				cr: imageRecipe(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: imageRecipe(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockImageRecipeClient{
+					MockDeleteImageRecipe: func(input *awsimagebuilder.DeleteImageRecipeInput) awsimagebuilder.DeleteImageRecipeRequest {
+						return awsimagebuilder.DeleteImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: imageRecipe(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: imageRecipe(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockImageRecipeClient{
+					MockDeleteImageRecipe: func(input *awsimagebuilder.DeleteImageRecipeInput) awsimagebuilder.DeleteImageRecipeRequest {
+						return awsimagebuilder.DeleteImageRecipeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: imageRecipe(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  imageRecipe(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,617 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructureconfiguration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsimagebuilder "github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+	"github.com/crossplane/provider-aws/pkg/clients/imagebuilder/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	resourceID  = "arn:aws:imagebuilder:us-east-1:123456789012:infrastructure-configuration/example"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsimagebuilder.ErrCodeResourceNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client imagebuilder.InfrastructureConfigurationClient
+	kube   client.Client
+	cr     *v1alpha1.InfrastructureConfiguration
+}
+
+type infrastructureConfigurationModifier func(*v1alpha1.InfrastructureConfiguration)
+
+func withConditions(c ...runtimev1alpha1.Condition) infrastructureConfigurationModifier {
+	return func(r *v1alpha1.InfrastructureConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) infrastructureConfigurationModifier {
+	return func(r *v1alpha1.InfrastructureConfiguration) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha1.InfrastructureConfigurationObservation) infrastructureConfigurationModifier {
+	return func(r *v1alpha1.InfrastructureConfiguration) { r.Status.AtProvider = o }
+}
+
+func withDescription(s string) infrastructureConfigurationModifier {
+	return func(r *v1alpha1.InfrastructureConfiguration) { r.Spec.ForProvider.Description = aws.String(s) }
+}
+
+func infrastructureConfiguration(m ...infrastructureConfigurationModifier) *v1alpha1.InfrastructureConfiguration {
+	cr := &v1alpha1.InfrastructureConfiguration{
+		Spec: v1alpha1.InfrastructureConfigurationSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.InfrastructureConfigurationParameters{
+				Name:                "example",
+				InstanceProfileName: "profile",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *awsimagebuilder.GetInfrastructureConfigurationOutput {
+	return &awsimagebuilder.GetInfrastructureConfigurationOutput{
+		InfrastructureConfiguration: &awsimagebuilder.InfrastructureConfiguration{Arn: aws.String(resourceID), Description: aws.String("desc"), InstanceProfileName: aws.String("profile")},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (imagebuilder.InfrastructureConfigurationClient, error)
+		cr          *v1alpha1.InfrastructureConfiguration
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i imagebuilder.InfrastructureConfigurationClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: infrastructureConfiguration(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i imagebuilder.InfrastructureConfigurationClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: infrastructureConfiguration(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: infrastructureConfiguration(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: infrastructureConfiguration(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: infrastructureConfiguration(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.InfrastructureConfiguration
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: infrastructureConfiguration(),
+			},
+			want: want{
+				cr: infrastructureConfiguration(),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockGetInfrastructureConfiguration: func(input *awsimagebuilder.GetInfrastructureConfigurationInput) awsimagebuilder.GetInfrastructureConfigurationRequest {
+						return awsimagebuilder.GetInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(withExternalName(resourceID), withDescription("desc")),
+			},
+			want: want{
+				cr: infrastructureConfiguration(
+					withExternalName(resourceID),
+					withDescription("desc"),
+					withObservation(v1alpha1.InfrastructureConfigurationObservation{ARN: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockGetInfrastructureConfiguration: func(input *awsimagebuilder.GetInfrastructureConfigurationInput) awsimagebuilder.GetInfrastructureConfigurationRequest {
+						return awsimagebuilder.GetInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed()},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(withExternalName(resourceID), withDescription("new desc")),
+			},
+			want: want{
+				cr: infrastructureConfiguration(
+					withExternalName(resourceID),
+					withDescription("new desc"),
+					withObservation(v1alpha1.InfrastructureConfigurationObservation{ARN: resourceID}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockGetInfrastructureConfiguration: func(input *awsimagebuilder.GetInfrastructureConfigurationInput) awsimagebuilder.GetInfrastructureConfigurationRequest {
+						return awsimagebuilder.GetInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(resourceID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockGetInfrastructureConfiguration: func(input *awsimagebuilder.GetInfrastructureConfigurationInput) awsimagebuilder.GetInfrastructureConfigurationRequest {
+						return awsimagebuilder.GetInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  infrastructureConfiguration(withExternalName(resourceID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.InfrastructureConfiguration
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockCreateInfrastructureConfiguration: func(input *awsimagebuilder.CreateInfrastructureConfigurationInput) awsimagebuilder.CreateInfrastructureConfigurationRequest {
+						return awsimagebuilder.CreateInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsimagebuilder.CreateInfrastructureConfigurationOutput{
+								InfrastructureConfigurationArn: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(),
+			},
+			want: want{
+				cr: infrastructureConfiguration(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockCreateInfrastructureConfiguration: func(input *awsimagebuilder.CreateInfrastructureConfigurationInput) awsimagebuilder.CreateInfrastructureConfigurationRequest {
+						return awsimagebuilder.CreateInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(),
+			},
+			want: want{
+				cr:  infrastructureConfiguration(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"FailedSpecUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockCreateInfrastructureConfiguration: func(input *awsimagebuilder.CreateInfrastructureConfigurationInput) awsimagebuilder.CreateInfrastructureConfigurationRequest {
+						return awsimagebuilder.CreateInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsimagebuilder.CreateInfrastructureConfigurationOutput{
+								InfrastructureConfigurationArn: aws.String(resourceID),
+							}},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(),
+			},
+			want: want{
+				cr: infrastructureConfiguration(
+					withExternalName(resourceID),
+					withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.InfrastructureConfiguration
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockUpdateInfrastructureConfiguration: func(input *awsimagebuilder.UpdateInfrastructureConfigurationInput) awsimagebuilder.UpdateInfrastructureConfigurationRequest {
+						return awsimagebuilder.UpdateInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsimagebuilder.UpdateInfrastructureConfigurationOutput{}},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(resourceID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockUpdateInfrastructureConfiguration: func(input *awsimagebuilder.UpdateInfrastructureConfigurationInput) awsimagebuilder.UpdateInfrastructureConfigurationRequest {
+						return awsimagebuilder.UpdateInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  infrastructureConfiguration(withExternalName(resourceID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.InfrastructureConfiguration
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockDeleteInfrastructureConfiguration: func(input *awsimagebuilder.DeleteInfrastructureConfigurationInput) awsimagebuilder.DeleteInfrastructureConfigurationRequest {
+						return awsimagebuilder.DeleteInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsimagebuilder.DeleteInfrastructureConfigurationOutput{}},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockDeleteInfrastructureConfiguration: func(input *awsimagebuilder.DeleteInfrastructureConfigurationInput) awsimagebuilder.DeleteInfrastructureConfigurationRequest {
+						return awsimagebuilder.DeleteInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(withExternalName(resourceID)),
+			},
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockInfrastructureConfigurationClient{
+					MockDeleteInfrastructureConfiguration: func(input *awsimagebuilder.DeleteInfrastructureConfigurationInput) awsimagebuilder.DeleteInfrastructureConfigurationRequest {
+						return awsimagebuilder.DeleteInfrastructureConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: infrastructureConfiguration(withExternalName(resourceID)),
+			},
+			want: want{
+				cr:  infrastructureConfiguration(withExternalName(resourceID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiot "github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/iot"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an IoT Certificate resource"
+	errCreateClient      = "cannot create IoT client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the IoT Certificate custom resource"
+
+	errDescribe = "cannot describe IoT Certificate"
+	errCreate   = "cannot create IoT Certificate"
+	errUpdate   = "cannot update IoT Certificate"
+	errDelete   = "cannot delete IoT Certificate"
+
+	errListPolicies = "cannot list policies attached to IoT Certificate"
+	errListThings   = "cannot list things attached to IoT Certificate"
+	errAttachPolicy = "cannot attach policy to IoT Certificate"
+	errDetachPolicy = "cannot detach policy from IoT Certificate"
+	errAttachThing  = "cannot attach IoT Certificate to thing"
+	errDetachThing  = "cannot detach IoT Certificate from thing"
+)
+
+// SetupCertificate adds a controller that reconciles IoT Certificates.
+func SetupCertificate(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.CertificateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.Certificate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: iot.NewCertificateClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (iot.CertificateClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client iot.CertificateClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.DescribeCertificateRequest(&awsiot.DescribeCertificateInput{
+		CertificateId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(iot.IsNotFound, err), errDescribe)
+	}
+	observed := *rsp.CertificateDescription
+
+	policies, err := iot.ListAttachedPolicyNames(ctx, e.client, aws.StringValue(observed.CertificateArn))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListPolicies)
+	}
+	things, err := iot.ListPrincipalThingNames(ctx, e.client, aws.StringValue(observed.CertificateArn))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListThings)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	iot.LateInitializeCertificate(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = iot.GenerateCertificateObservation(observed)
+
+	switch observed.Status {
+	case awsiot.CertificateStatusActive, awsiot.CertificateStatusInactive:
+		cr.SetConditions(runtimev1alpha1.Available())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  iot.IsCertificateUpToDate(cr.Spec.ForProvider, observed, policies, things),
+		ConnectionDetails: iot.GetCertificateConnectionDetails(observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	active := aws.Bool(aws.StringValue(cr.Spec.ForProvider.Status) == string(awsiot.CertificateStatusActive))
+
+	conn := managed.ConnectionDetails{}
+
+	if cr.Spec.ForProvider.CertificateSigningRequest != nil {
+		rsp, err := e.client.CreateCertificateFromCsrRequest(&awsiot.CreateCertificateFromCsrInput{
+			CertificateSigningRequest: cr.Spec.ForProvider.CertificateSigningRequest,
+			SetAsActive:               active,
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+		}
+		meta.SetExternalName(cr, aws.StringValue(rsp.CertificateId))
+		conn[iot.ConnectionKeyCertificatePEM] = []byte(aws.StringValue(rsp.CertificatePem))
+		return managed.ExternalCreation{ConnectionDetails: conn}, errors.Wrap(e.kube.Update(ctx, cr), errCreate)
+	}
+
+	// The private key of a certificate generated by AWS IoT is only returned
+	// when it is created.
+	rsp, err := e.client.CreateKeysAndCertificateRequest(&awsiot.CreateKeysAndCertificateInput{
+		SetAsActive: active,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.CertificateId))
+	conn[iot.ConnectionKeyCertificatePEM] = []byte(aws.StringValue(rsp.CertificatePem))
+	if rsp.KeyPair != nil {
+		conn[iot.ConnectionKeyPrivateKey] = []byte(aws.StringValue(rsp.KeyPair.PrivateKey))
+		conn[iot.ConnectionKeyPublicKey] = []byte(aws.StringValue(rsp.KeyPair.PublicKey))
+	}
+	return managed.ExternalCreation{ConnectionDetails: conn}, errors.Wrap(e.kube.Update(ctx, cr), errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	p := cr.Spec.ForProvider
+	arn := cr.Status.AtProvider.CertificateARN
+
+	if p.Status != nil && aws.StringValue(p.Status) != cr.Status.AtProvider.Status {
+		if _, err := e.client.UpdateCertificateRequest(&awsiot.UpdateCertificateInput{
+			CertificateId: aws.String(meta.GetExternalName(cr)),
+			NewStatus:     awsiot.CertificateStatus(aws.StringValue(p.Status)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	policies, err := iot.ListAttachedPolicyNames(ctx, e.client, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListPolicies)
+	}
+	attach, detach := iot.DiffNames(p.PolicyNames, policies)
+	for _, n := range attach {
+		if _, err := e.client.AttachPolicyRequest(&awsiot.AttachPolicyInput{PolicyName: aws.String(n), Target: aws.String(arn)}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttachPolicy)
+		}
+	}
+	for _, n := range detach {
+		if _, err := e.client.DetachPolicyRequest(&awsiot.DetachPolicyInput{PolicyName: aws.String(n), Target: aws.String(arn)}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetachPolicy)
+		}
+	}
+
+	things, err := iot.ListPrincipalThingNames(ctx, e.client, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListThings)
+	}
+	attach, detach = iot.DiffNames(p.ThingNames, things)
+	for _, n := range attach {
+		if _, err := e.client.AttachThingPrincipalRequest(&awsiot.AttachThingPrincipalInput{ThingName: aws.String(n), Principal: aws.String(arn)}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttachThing)
+		}
+	}
+	for _, n := range detach {
+		if _, err := e.client.DetachThingPrincipalRequest(&awsiot.DetachThingPrincipalInput{ThingName: aws.String(n), Principal: aws.String(arn)}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetachThing)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Certificate)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	arn := cr.Status.AtProvider.CertificateARN
+
+	// A certificate can only be deleted once it is inactive and detached
+	// from all things and policies.
+	things, err := iot.ListPrincipalThingNames(ctx, e.client, arn)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(iot.IsNotFound, err), errListThings)
+	}
+	for _, n := range things {
+		if _, err := e.client.DetachThingPrincipalRequest(&awsiot.DetachThingPrincipalInput{ThingName: aws.String(n), Principal: aws.String(arn)}).Send(ctx); err != nil {
+			return errors.Wrap(resource.Ignore(iot.IsNotFound, err), errDetachThing)
+		}
+	}
+	policies, err := iot.ListAttachedPolicyNames(ctx, e.client, arn)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(iot.IsNotFound, err), errListPolicies)
+	}
+	for _, n := range policies {
+		if _, err := e.client.DetachPolicyRequest(&awsiot.DetachPolicyInput{PolicyName: aws.String(n), Target: aws.String(arn)}).Send(ctx); err != nil {
+			return errors.Wrap(resource.Ignore(iot.IsNotFound, err), errDetachPolicy)
+		}
+	}
+	if cr.Status.AtProvider.Status == string(awsiot.CertificateStatusActive) {
+		if _, err := e.client.UpdateCertificateRequest(&awsiot.UpdateCertificateInput{
+			CertificateId: aws.String(meta.GetExternalName(cr)),
+			NewStatus:     awsiot.CertificateStatusInactive,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(resource.Ignore(iot.IsNotFound, err), errDelete)
+		}
+	}
+
+	_, err = e.client.DeleteCertificateRequest(&awsiot.DeleteCertificateInput{
+		CertificateId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(iot.IsNotFound, err), errDelete)
+}
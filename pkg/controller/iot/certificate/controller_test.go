@@ -0,0 +1,700 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiot "github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iot"
+	"github.com/crossplane/provider-aws/pkg/clients/iot/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	certificateID  = "cert-id"
+	certificateARN = "arn:aws:iot:us-east-1:123456789012:cert/cert-id"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	client iot.CertificateClient
+	kube   client.Client
+	cr     *v1alpha1.Certificate
+}
+
+type certificateModifier func(*v1alpha1.Certificate)
+
+func withConditions(c ...runtimev1alpha1.Condition) certificateModifier {
+	return func(r *v1alpha1.Certificate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.CertificateParameters) certificateModifier {
+	return func(r *v1alpha1.Certificate) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.CertificateObservation) certificateModifier {
+	return func(r *v1alpha1.Certificate) { r.Status.AtProvider = o }
+}
+
+func withExternalName(n string) certificateModifier {
+	return func(r *v1alpha1.Certificate) { meta.SetExternalName(r, n) }
+}
+
+func certificate(m ...certificateModifier) *v1alpha1.Certificate {
+	cr := &v1alpha1.Certificate{
+		Spec: v1alpha1.CertificateSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(d *awsiot.CertificateDescription, err error) func(*awsiot.DescribeCertificateInput) awsiot.DescribeCertificateRequest {
+	return func(*awsiot.DescribeCertificateInput) awsiot.DescribeCertificateRequest {
+		return awsiot.DescribeCertificateRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DescribeCertificateOutput{CertificateDescription: d}, Error: err},
+		}
+	}
+}
+
+func listPolicies(names ...string) func(*awsiot.ListAttachedPoliciesInput) awsiot.ListAttachedPoliciesRequest {
+	p := make([]awsiot.Policy, len(names))
+	for i, n := range names {
+		p[i] = awsiot.Policy{PolicyName: aws.String(n)}
+	}
+	return func(*awsiot.ListAttachedPoliciesInput) awsiot.ListAttachedPoliciesRequest {
+		return awsiot.ListAttachedPoliciesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.ListAttachedPoliciesOutput{Policies: p}},
+		}
+	}
+}
+
+func listThings(names ...string) func(*awsiot.ListPrincipalThingsInput) awsiot.ListPrincipalThingsRequest {
+	return func(*awsiot.ListPrincipalThingsInput) awsiot.ListPrincipalThingsRequest {
+		return awsiot.ListPrincipalThingsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.ListPrincipalThingsOutput{Things: names}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (iot.CertificateClient, error)
+		cr          *v1alpha1.Certificate
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i iot.CertificateClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: certificate(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i iot.CertificateClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: certificate(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Certificate
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awsiot.CertificateDescription{
+		CertificateId:  aws.String(certificateID),
+		CertificateArn: aws.String(certificateARN),
+		CertificatePem: aws.String("pem"),
+		Status:         awsiot.CertificateStatusActive,
+	}
+	status := v1alpha1.CertificateObservation{CertificateARN: certificateARN, Status: "ACTIVE"}
+	conn := managed.ConnectionDetails{iot.ConnectionKeyCertificatePEM: []byte("pem")}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: certificate(),
+			},
+			want: want{
+				cr: certificate(),
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockCertificateClient{
+					MockDescribeCertificate:  describe(observed, nil),
+					MockListAttachedPolicies: listPolicies(),
+					MockListPrincipalThings:  listThings(),
+				},
+				cr: certificate(withExternalName(certificateID)),
+			},
+			want: want{
+				cr: certificate(
+					withExternalName(certificateID),
+					withSpec(v1alpha1.CertificateParameters{Status: aws.String("ACTIVE")}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"AttachmentsChanged": {
+			args: args{
+				client: &fake.MockCertificateClient{
+					MockDescribeCertificate:  describe(observed, nil),
+					MockListAttachedPolicies: listPolicies("device"),
+					MockListPrincipalThings:  listThings(),
+				},
+				cr: certificate(
+					withExternalName(certificateID),
+					withSpec(v1alpha1.CertificateParameters{Status: aws.String("ACTIVE"), ThingNames: []string{"my-thing"}})),
+			},
+			want: want{
+				cr: certificate(
+					withExternalName(certificateID),
+					withSpec(v1alpha1.CertificateParameters{Status: aws.String("ACTIVE"), ThingNames: []string{"my-thing"}}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: conn,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockCertificateClient{
+					MockDescribeCertificate: describe(nil, awserr.New(awsiot.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: certificate(withExternalName(certificateID)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockCertificateClient{
+					MockDescribeCertificate: describe(nil, errBoom),
+				},
+				cr: certificate(withExternalName(certificateID)),
+			},
+			want: want{
+				cr:  certificate(withExternalName(certificateID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Certificate
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"KeysAndCertificate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockCertificateClient{
+					MockCreateKeysAndCertificate: func(in *awsiot.CreateKeysAndCertificateInput) awsiot.CreateKeysAndCertificateRequest {
+						if diff := cmp.Diff(&awsiot.CreateKeysAndCertificateInput{SetAsActive: aws.Bool(true)}, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiot.CreateKeysAndCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.CreateKeysAndCertificateOutput{
+								CertificateId:  aws.String(certificateID),
+								CertificatePem: aws.String("pem"),
+								KeyPair:        &awsiot.KeyPair{PrivateKey: aws.String("private"), PublicKey: aws.String("public")},
+							}},
+						}
+					},
+				},
+				cr: certificate(withSpec(v1alpha1.CertificateParameters{Status: aws.String("ACTIVE")})),
+			},
+			want: want{
+				cr: certificate(
+					withExternalName(certificateID),
+					withSpec(v1alpha1.CertificateParameters{Status: aws.String("ACTIVE")}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						iot.ConnectionKeyCertificatePEM: []byte("pem"),
+						iot.ConnectionKeyPrivateKey:     []byte("private"),
+						iot.ConnectionKeyPublicKey:      []byte("public"),
+					},
+				},
+			},
+		},
+		"CertificateFromCsr": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockCertificateClient{
+					MockCreateCertificateFromCsr: func(*awsiot.CreateCertificateFromCsrInput) awsiot.CreateCertificateFromCsrRequest {
+						return awsiot.CreateCertificateFromCsrRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.CreateCertificateFromCsrOutput{
+								CertificateId:  aws.String(certificateID),
+								CertificatePem: aws.String("pem"),
+							}},
+						}
+					},
+				},
+				cr: certificate(withSpec(v1alpha1.CertificateParameters{CertificateSigningRequest: aws.String("csr")})),
+			},
+			want: want{
+				cr: certificate(
+					withExternalName(certificateID),
+					withSpec(v1alpha1.CertificateParameters{CertificateSigningRequest: aws.String("csr")}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{iot.ConnectionKeyCertificatePEM: []byte("pem")},
+				},
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockCertificateClient{
+					MockCreateKeysAndCertificate: func(*awsiot.CreateKeysAndCertificateInput) awsiot.CreateKeysAndCertificateRequest {
+						return awsiot.CreateKeysAndCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr:  certificate(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Certificate
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	status := v1alpha1.CertificateObservation{CertificateARN: certificateARN, Status: "ACTIVE"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Attachments": {
+			args: args{
+				client: &fake.MockCertificateClient{
+					MockListAttachedPolicies: listPolicies("old"),
+					MockListPrincipalThings:  listThings(),
+					MockAttachPolicy: func(in *awsiot.AttachPolicyInput) awsiot.AttachPolicyRequest {
+						if diff := cmp.Diff(&awsiot.AttachPolicyInput{PolicyName: aws.String("device"), Target: aws.String(certificateARN)}, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiot.AttachPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.AttachPolicyOutput{}},
+						}
+					},
+					MockDetachPolicy: func(in *awsiot.DetachPolicyInput) awsiot.DetachPolicyRequest {
+						if diff := cmp.Diff(&awsiot.DetachPolicyInput{PolicyName: aws.String("old"), Target: aws.String(certificateARN)}, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiot.DetachPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DetachPolicyOutput{}},
+						}
+					},
+					MockAttachThingPrincipal: func(in *awsiot.AttachThingPrincipalInput) awsiot.AttachThingPrincipalRequest {
+						if diff := cmp.Diff(&awsiot.AttachThingPrincipalInput{ThingName: aws.String("my-thing"), Principal: aws.String(certificateARN)}, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiot.AttachThingPrincipalRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.AttachThingPrincipalOutput{}},
+						}
+					},
+				},
+				cr: certificate(
+					withExternalName(certificateID),
+					withSpec(v1alpha1.CertificateParameters{Status: aws.String("ACTIVE"), PolicyNames: []string{"device"}, ThingNames: []string{"my-thing"}}),
+					withStatus(status)),
+			},
+			want: want{
+				cr: certificate(
+					withExternalName(certificateID),
+					withSpec(v1alpha1.CertificateParameters{Status: aws.String("ACTIVE"), PolicyNames: []string{"device"}, ThingNames: []string{"my-thing"}}),
+					withStatus(status)),
+			},
+		},
+		"FailedStatusUpdate": {
+			args: args{
+				client: &fake.MockCertificateClient{
+					MockUpdateCertificate: func(*awsiot.UpdateCertificateInput) awsiot.UpdateCertificateRequest {
+						return awsiot.UpdateCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: certificate(
+					withExternalName(certificateID),
+					withSpec(v1alpha1.CertificateParameters{Status: aws.String("INACTIVE")}),
+					withStatus(status)),
+			},
+			want: want{
+				cr: certificate(
+					withExternalName(certificateID),
+					withSpec(v1alpha1.CertificateParameters{Status: aws.String("INACTIVE")}),
+					withStatus(status)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Certificate
+		err error
+	}
+
+	status := v1alpha1.CertificateObservation{CertificateARN: certificateARN, Status: "ACTIVE"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCertificateClient{
+					MockListPrincipalThings:  listThings("my-thing"),
+					MockListAttachedPolicies: listPolicies("device"),
+					MockDetachThingPrincipal: func(*awsiot.DetachThingPrincipalInput) awsiot.DetachThingPrincipalRequest {
+						return awsiot.DetachThingPrincipalRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DetachThingPrincipalOutput{}},
+						}
+					},
+					MockDetachPolicy: func(*awsiot.DetachPolicyInput) awsiot.DetachPolicyRequest {
+						return awsiot.DetachPolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DetachPolicyOutput{}},
+						}
+					},
+					MockUpdateCertificate: func(in *awsiot.UpdateCertificateInput) awsiot.UpdateCertificateRequest {
+						if in.NewStatus != awsiot.CertificateStatusInactive {
+							t.Errorf("expected certificate to be deactivated, got %s", in.NewStatus)
+						}
+						return awsiot.UpdateCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.UpdateCertificateOutput{}},
+						}
+					},
+					MockDeleteCertificate: func(*awsiot.DeleteCertificateInput) awsiot.DeleteCertificateRequest {
+						return awsiot.DeleteCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DeleteCertificateOutput{}},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateID), withStatus(status)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateID), withStatus(status), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockCertificateClient{
+					MockListPrincipalThings:  listThings(),
+					MockListAttachedPolicies: listPolicies(),
+					MockDeleteCertificate: func(*awsiot.DeleteCertificateInput) awsiot.DeleteCertificateRequest {
+						return awsiot.DeleteCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateID)),
+			},
+			want: want{
+				cr:  certificate(withExternalName(certificateID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiot "github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/iot"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an IoT Policy resource"
+	errCreateClient      = "cannot create IoT client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errDescribe = "cannot describe IoT Policy"
+	errCreate   = "cannot create IoT Policy"
+	errUpdate   = "cannot update IoT Policy"
+	errDelete   = "cannot delete IoT Policy"
+	errUpToDate = "cannot check whether IoT Policy is up to date"
+)
+
+// SetupPolicy adds a controller that reconciles IoT Policies.
+func SetupPolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.PolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.Policy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PolicyGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: iot.NewPolicyClient}))))),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (iot.PolicyClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client iot.PolicyClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.client.GetPolicyRequest(&awsiot.GetPolicyInput{
+		PolicyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(iot.IsNotFound, err), errDescribe)
+	}
+
+	cr.Status.AtProvider = v1alpha1.PolicyObservation{
+		PolicyARN:        aws.StringValue(observed.PolicyArn),
+		DefaultVersionID: aws.StringValue(observed.DefaultVersionId),
+	}
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	upToDate, err := iot.IsPolicyUpToDate(cr.Spec.ForProvider, *observed.GetPolicyOutput)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.CreatePolicyRequest(&awsiot.CreatePolicyInput{
+		PolicyName:     aws.String(meta.GetExternalName(cr)),
+		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+		Tags:           iot.GenerateTags(cr.Spec.ForProvider.Tags),
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// A change to the document is a new version of the policy. The oldest
+	// version is removed first when the policy has as many versions as it
+	// may have.
+	rsp, err := e.client.ListPolicyVersionsRequest(&awsiot.ListPolicyVersionsInput{
+		PolicyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	if len(rsp.PolicyVersions) >= iot.MaxPolicyVersions {
+		if _, err := e.client.DeletePolicyVersionRequest(&awsiot.DeletePolicyVersionInput{
+			PolicyName:      aws.String(meta.GetExternalName(cr)),
+			PolicyVersionId: iot.OldestNonDefaultPolicyVersion(rsp.PolicyVersions),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	_, err = e.client.CreatePolicyVersionRequest(&awsiot.CreatePolicyVersionInput{
+		PolicyName:     aws.String(meta.GetExternalName(cr)),
+		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+		SetAsDefault:   aws.Bool(true),
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Policy)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	// A policy can only be deleted once all of its versions but the default
+	// one have been deleted.
+	rsp, err := e.client.ListPolicyVersionsRequest(&awsiot.ListPolicyVersionsInput{
+		PolicyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(iot.IsNotFound, err), errDelete)
+	}
+	for _, v := range rsp.PolicyVersions {
+		if aws.BoolValue(v.IsDefaultVersion) {
+			continue
+		}
+		if _, err := e.client.DeletePolicyVersionRequest(&awsiot.DeletePolicyVersionInput{
+			PolicyName:      aws.String(meta.GetExternalName(cr)),
+			PolicyVersionId: v.VersionId,
+		}).Send(ctx); err != nil {
+			return errors.Wrap(resource.Ignore(iot.IsNotFound, err), errDelete)
+		}
+	}
+
+	_, err = e.client.DeletePolicyRequest(&awsiot.DeletePolicyInput{
+		PolicyName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(iot.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,607 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiot "github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iot"
+	"github.com/crossplane/provider-aws/pkg/clients/iot/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	policyName = "my-policy"
+	document   = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "iot:Connect", "Resource": "*"}]}`
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client iot.PolicyClient
+	kube   client.Client
+	cr     *v1alpha1.Policy
+}
+
+type policyModifier func(*v1alpha1.Policy)
+
+func withConditions(c ...runtimev1alpha1.Condition) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDocument(d string) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Spec.ForProvider.Document = d }
+}
+
+func withStatus(o v1alpha1.PolicyObservation) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Status.AtProvider = o }
+}
+
+func policy(m ...policyModifier) *v1alpha1.Policy {
+	cr := &v1alpha1.Policy{
+		Spec: v1alpha1.PolicySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, policyName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func get(o *awsiot.GetPolicyOutput, err error) func(*awsiot.GetPolicyInput) awsiot.GetPolicyRequest {
+	return func(*awsiot.GetPolicyInput) awsiot.GetPolicyRequest {
+		return awsiot.GetPolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: o, Error: err},
+		}
+	}
+}
+
+func listVersions(v ...awsiot.PolicyVersion) func(*awsiot.ListPolicyVersionsInput) awsiot.ListPolicyVersionsRequest {
+	return func(*awsiot.ListPolicyVersionsInput) awsiot.ListPolicyVersionsRequest {
+		return awsiot.ListPolicyVersionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.ListPolicyVersionsOutput{PolicyVersions: v}},
+		}
+	}
+}
+
+func deleteVersion(t *testing.T, id *string) func(*awsiot.DeletePolicyVersionInput) awsiot.DeletePolicyVersionRequest {
+	return func(in *awsiot.DeletePolicyVersionInput) awsiot.DeletePolicyVersionRequest {
+		if diff := cmp.Diff(id, in.PolicyVersionId); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsiot.DeletePolicyVersionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DeletePolicyVersionOutput{}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (iot.PolicyClient, error)
+		cr          *v1alpha1.Policy
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i iot.PolicyClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: policy(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i iot.PolicyClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: policy(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Policy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awsiot.GetPolicyOutput{
+		PolicyArn:        aws.String("arn"),
+		PolicyDocument:   aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"iot:Connect","Resource":"*"}]}`),
+		DefaultVersionId: aws.String("1"),
+	}
+	status := v1alpha1.PolicyObservation{PolicyARN: "arn", DefaultVersionID: "1"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockGetPolicy: get(observed, nil),
+				},
+				cr: policy(withDocument(document)),
+			},
+			want: want{
+				cr: policy(withDocument(document), withStatus(status), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DocumentChanged": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockGetPolicy: get(observed, nil),
+				},
+				cr: policy(withDocument(`{"Version": "2012-10-17", "Statement": []}`)),
+			},
+			want: want{
+				cr: policy(withDocument(`{"Version": "2012-10-17", "Statement": []}`), withStatus(status), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockGetPolicy: get(nil, awserr.New(awsiot.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockGetPolicy: get(nil, errBoom),
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr:  policy(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Policy
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockCreatePolicy: func(*awsiot.CreatePolicyInput) awsiot.CreatePolicyRequest {
+						return awsiot.CreatePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.CreatePolicyOutput{}},
+						}
+					},
+				},
+				cr: policy(withDocument(document)),
+			},
+			want: want{
+				cr: policy(withDocument(document), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockCreatePolicy: func(*awsiot.CreatePolicyInput) awsiot.CreatePolicyRequest {
+						return awsiot.CreatePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: policy(withDocument(document)),
+			},
+			want: want{
+				cr:  policy(withDocument(document), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Policy
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	now := time.Now()
+	createVersion := func(*awsiot.CreatePolicyVersionInput) awsiot.CreatePolicyVersionRequest {
+		return awsiot.CreatePolicyVersionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.CreatePolicyVersionOutput{}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NewVersion": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockListPolicyVersions:  listVersions(awsiot.PolicyVersion{VersionId: aws.String("1"), IsDefaultVersion: aws.Bool(true)}),
+					MockCreatePolicyVersion: createVersion,
+				},
+				cr: policy(withDocument(document)),
+			},
+			want: want{
+				cr: policy(withDocument(document)),
+			},
+		},
+		"DeleteOldestVersion": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockListPolicyVersions: listVersions(
+						awsiot.PolicyVersion{VersionId: aws.String("1"), CreateDate: aws.Time(now.Add(-5 * time.Hour)), IsDefaultVersion: aws.Bool(true)},
+						awsiot.PolicyVersion{VersionId: aws.String("2"), CreateDate: aws.Time(now.Add(-4 * time.Hour)), IsDefaultVersion: aws.Bool(false)},
+						awsiot.PolicyVersion{VersionId: aws.String("3"), CreateDate: aws.Time(now.Add(-3 * time.Hour)), IsDefaultVersion: aws.Bool(false)},
+						awsiot.PolicyVersion{VersionId: aws.String("4"), CreateDate: aws.Time(now.Add(-2 * time.Hour)), IsDefaultVersion: aws.Bool(false)},
+						awsiot.PolicyVersion{VersionId: aws.String("5"), CreateDate: aws.Time(now.Add(-1 * time.Hour)), IsDefaultVersion: aws.Bool(false)},
+					),
+					MockDeletePolicyVersion: deleteVersion(t, aws.String("2")),
+					MockCreatePolicyVersion: createVersion,
+				},
+				cr: policy(withDocument(document)),
+			},
+			want: want{
+				cr: policy(withDocument(document)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockListPolicyVersions: listVersions(),
+					MockCreatePolicyVersion: func(*awsiot.CreatePolicyVersionInput) awsiot.CreatePolicyVersionRequest {
+						return awsiot.CreatePolicyVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: policy(withDocument(document)),
+			},
+			want: want{
+				cr:  policy(withDocument(document)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Policy
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockListPolicyVersions: listVersions(
+						awsiot.PolicyVersion{VersionId: aws.String("1"), IsDefaultVersion: aws.Bool(false)},
+						awsiot.PolicyVersion{VersionId: aws.String("2"), IsDefaultVersion: aws.Bool(true)},
+					),
+					MockDeletePolicyVersion: deleteVersion(t, aws.String("1")),
+					MockDeletePolicy: func(*awsiot.DeletePolicyInput) awsiot.DeletePolicyRequest {
+						return awsiot.DeletePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DeletePolicyOutput{}},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockListPolicyVersions: func(*awsiot.ListPolicyVersionsInput) awsiot.ListPolicyVersionsRequest {
+						return awsiot.ListPolicyVersionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiot.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockPolicyClient{
+					MockListPolicyVersions: listVersions(),
+					MockDeletePolicy: func(*awsiot.DeletePolicyInput) awsiot.DeletePolicyRequest {
+						return awsiot.DeletePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr:  policy(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
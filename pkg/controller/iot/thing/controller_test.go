@@ -0,0 +1,598 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiot "github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iot"
+	"github.com/crossplane/provider-aws/pkg/clients/iot/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	thingName = "my-thing"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client iot.ThingClient
+	kube   client.Client
+	cr     *v1alpha1.Thing
+}
+
+type thingModifier func(*v1alpha1.Thing)
+
+func withConditions(c ...runtimev1alpha1.Condition) thingModifier {
+	return func(r *v1alpha1.Thing) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ThingParameters) thingModifier {
+	return func(r *v1alpha1.Thing) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.ThingObservation) thingModifier {
+	return func(r *v1alpha1.Thing) { r.Status.AtProvider = o }
+}
+
+func thing(m ...thingModifier) *v1alpha1.Thing {
+	cr := &v1alpha1.Thing{
+		Spec: v1alpha1.ThingSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, thingName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(o *awsiot.DescribeThingOutput, err error) func(*awsiot.DescribeThingInput) awsiot.DescribeThingRequest {
+	return func(*awsiot.DescribeThingInput) awsiot.DescribeThingRequest {
+		return awsiot.DescribeThingRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: o, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (iot.ThingClient, error)
+		cr          *v1alpha1.Thing
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i iot.ThingClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: thing(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i iot.ThingClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: thing(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: thing(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: thing(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: thing(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Thing
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awsiot.DescribeThingOutput{
+		ThingName:     aws.String(thingName),
+		ThingArn:      aws.String("arn"),
+		ThingId:       aws.String("id"),
+		ThingTypeName: aws.String("sensor"),
+		Attributes:    map[string]string{"model": "x1"},
+		Version:       aws.Int64(2),
+	}
+	status := v1alpha1.ThingObservation{ThingARN: "arn", ThingID: "id", Version: 2}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockThingClient{
+					MockDescribeThing: describe(observed, nil),
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr: thing(
+					withSpec(v1alpha1.ThingParameters{ThingTypeName: aws.String("sensor"), Attributes: map[string]string{"model": "x1"}}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AttributesChanged": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockDescribeThing: describe(observed, nil),
+				},
+				cr: thing(withSpec(v1alpha1.ThingParameters{ThingTypeName: aws.String("sensor"), Attributes: map[string]string{"model": "x2"}})),
+			},
+			want: want{
+				cr: thing(
+					withSpec(v1alpha1.ThingParameters{ThingTypeName: aws.String("sensor"), Attributes: map[string]string{"model": "x2"}}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockDescribeThing: describe(nil, awserr.New(awsiot.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr: thing(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockDescribeThing: describe(nil, errBoom),
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr:  thing(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Thing
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockCreateThing: func(*awsiot.CreateThingInput) awsiot.CreateThingRequest {
+						return awsiot.CreateThingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.CreateThingOutput{}},
+						}
+					},
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr: thing(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockCreateThing: func(*awsiot.CreateThingInput) awsiot.CreateThingRequest {
+						return awsiot.CreateThingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr:  thing(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Thing
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	observed := &awsiot.DescribeThingOutput{
+		ThingName:     aws.String(thingName),
+		ThingTypeName: aws.String("sensor"),
+		Attributes:    map[string]string{"model": "x1"},
+		Version:       aws.Int64(2),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockDescribeThing: describe(observed, nil),
+					MockUpdateThing: func(in *awsiot.UpdateThingInput) awsiot.UpdateThingRequest {
+						want := &awsiot.UpdateThingInput{
+							ThingName:        aws.String(thingName),
+							ExpectedVersion:  aws.Int64(2),
+							RemoveThingType:  aws.Bool(true),
+							AttributePayload: &awsiot.AttributePayload{Attributes: map[string]string{"model": "x2"}, Merge: aws.Bool(false)},
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiot.UpdateThingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.UpdateThingOutput{}},
+						}
+					},
+				},
+				cr: thing(withSpec(v1alpha1.ThingParameters{Attributes: map[string]string{"model": "x2"}})),
+			},
+			want: want{
+				cr: thing(withSpec(v1alpha1.ThingParameters{Attributes: map[string]string{"model": "x2"}})),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockDescribeThing: describe(nil, errBoom),
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr:  thing(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedUpdateRequest": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockDescribeThing: describe(observed, nil),
+					MockUpdateThing: func(*awsiot.UpdateThingInput) awsiot.UpdateThingRequest {
+						return awsiot.UpdateThingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr:  thing(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Thing
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockDeleteThing: func(*awsiot.DeleteThingInput) awsiot.DeleteThingRequest {
+						return awsiot.DeleteThingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DeleteThingOutput{}},
+						}
+					},
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr: thing(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockDeleteThing: func(*awsiot.DeleteThingInput) awsiot.DeleteThingRequest {
+						return awsiot.DeleteThingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiot.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr: thing(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockThingClient{
+					MockDeleteThing: func(*awsiot.DeleteThingInput) awsiot.DeleteThingRequest {
+						return awsiot.DeleteThingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: thing(),
+			},
+			want: want{
+				cr:  thing(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,590 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thingtype
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiot "github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iot"
+	"github.com/crossplane/provider-aws/pkg/clients/iot/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	thingTypeName = "sensor"
+	errBoom       = errors.New("boom")
+)
+
+type args struct {
+	client iot.ThingTypeClient
+	kube   client.Client
+	cr     *v1alpha1.ThingType
+}
+
+type thingTypeModifier func(*v1alpha1.ThingType)
+
+func withConditions(c ...runtimev1alpha1.Condition) thingTypeModifier {
+	return func(r *v1alpha1.ThingType) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ThingTypeParameters) thingTypeModifier {
+	return func(r *v1alpha1.ThingType) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.ThingTypeObservation) thingTypeModifier {
+	return func(r *v1alpha1.ThingType) { r.Status.AtProvider = o }
+}
+
+func thingType(m ...thingTypeModifier) *v1alpha1.ThingType {
+	cr := &v1alpha1.ThingType{
+		Spec: v1alpha1.ThingTypeSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, thingTypeName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(deprecated bool, err error) func(*awsiot.DescribeThingTypeInput) awsiot.DescribeThingTypeRequest {
+	return func(*awsiot.DescribeThingTypeInput) awsiot.DescribeThingTypeRequest {
+		return awsiot.DescribeThingTypeRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DescribeThingTypeOutput{
+				ThingTypeName:       aws.String(thingTypeName),
+				ThingTypeArn:        aws.String("arn"),
+				ThingTypeId:         aws.String("id"),
+				ThingTypeProperties: &awsiot.ThingTypeProperties{ThingTypeDescription: aws.String("desc")},
+				ThingTypeMetadata:   &awsiot.ThingTypeMetadata{Deprecated: aws.Bool(deprecated)},
+			}, Error: err},
+		}
+	}
+}
+
+func deprecate(t *testing.T, undo bool, err error) func(*awsiot.DeprecateThingTypeInput) awsiot.DeprecateThingTypeRequest {
+	return func(in *awsiot.DeprecateThingTypeInput) awsiot.DeprecateThingTypeRequest {
+		if diff := cmp.Diff(undo, aws.BoolValue(in.UndoDeprecate)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsiot.DeprecateThingTypeRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DeprecateThingTypeOutput{}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (iot.ThingTypeClient, error)
+		cr          *v1alpha1.ThingType
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i iot.ThingTypeClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: thingType(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i iot.ThingTypeClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: thingType(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: thingType(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: thingType(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: thingType(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ThingType
+		result managed.ExternalObservation
+		err    error
+	}
+
+	status := v1alpha1.ThingTypeObservation{ThingTypeARN: "arn", ThingTypeID: "id"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockThingTypeClient{
+					MockDescribeThingType: describe(false, nil),
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr: thingType(
+					withSpec(v1alpha1.ThingTypeParameters{Description: aws.String("desc"), Deprecated: aws.Bool(false)}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeprecationChanged": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDescribeThingType: describe(false, nil),
+				},
+				cr: thingType(withSpec(v1alpha1.ThingTypeParameters{Description: aws.String("desc"), Deprecated: aws.Bool(true)})),
+			},
+			want: want{
+				cr: thingType(
+					withSpec(v1alpha1.ThingTypeParameters{Description: aws.String("desc"), Deprecated: aws.Bool(true)}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDescribeThingType: describe(false, awserr.New(awsiot.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr: thingType(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDescribeThingType: describe(false, errBoom),
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr:  thingType(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ThingType
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockCreateThingType: func(*awsiot.CreateThingTypeInput) awsiot.CreateThingTypeRequest {
+						return awsiot.CreateThingTypeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.CreateThingTypeOutput{}},
+						}
+					},
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr: thingType(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockCreateThingType: func(*awsiot.CreateThingTypeInput) awsiot.CreateThingTypeRequest {
+						return awsiot.CreateThingTypeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr:  thingType(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ThingType
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Deprecate": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDeprecateThingType: deprecate(t, false, nil),
+				},
+				cr: thingType(withSpec(v1alpha1.ThingTypeParameters{Deprecated: aws.Bool(true)})),
+			},
+			want: want{
+				cr: thingType(withSpec(v1alpha1.ThingTypeParameters{Deprecated: aws.Bool(true)})),
+			},
+		},
+		"UndoDeprecate": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDeprecateThingType: deprecate(t, true, nil),
+				},
+				cr: thingType(withSpec(v1alpha1.ThingTypeParameters{Deprecated: aws.Bool(false)})),
+			},
+			want: want{
+				cr: thingType(withSpec(v1alpha1.ThingTypeParameters{Deprecated: aws.Bool(false)})),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDeprecateThingType: deprecate(t, true, errBoom),
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr:  thingType(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ThingType
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DeprecateFirst": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDescribeThingType:  describe(false, nil),
+					MockDeprecateThingType: deprecate(t, false, nil),
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr: thingType(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDescribeThingType: describe(true, nil),
+					MockDeleteThingType: func(*awsiot.DeleteThingTypeInput) awsiot.DeleteThingTypeRequest {
+						return awsiot.DeleteThingTypeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiot.DeleteThingTypeOutput{}},
+						}
+					},
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr: thingType(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDescribeThingType: describe(false, awserr.New(awsiot.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr: thingType(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockThingTypeClient{
+					MockDescribeThingType: describe(true, nil),
+					MockDeleteThingType: func(*awsiot.DeleteThingTypeInput) awsiot.DeleteThingTypeRequest {
+						return awsiot.DeleteThingTypeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: thingType(),
+			},
+			want: want{
+				cr:  thingType(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
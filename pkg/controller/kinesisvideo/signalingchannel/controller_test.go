@@ -0,0 +1,579 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signalingchannel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awskinesisvideo "github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/kinesisvideo"
+	"github.com/crossplane/provider-aws/pkg/clients/kinesisvideo/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	channelName = "my-channel"
+	channelARN  = "arn:aws:kinesisvideo:us-east-1:123456789012:channel/my-channel/1"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	client kinesisvideo.SignalingChannelClient
+	kube   client.Client
+	cr     *v1alpha1.SignalingChannel
+}
+
+type channelModifier func(*v1alpha1.SignalingChannel)
+
+func withConditions(c ...runtimev1alpha1.Condition) channelModifier {
+	return func(r *v1alpha1.SignalingChannel) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.SignalingChannelParameters) channelModifier {
+	return func(r *v1alpha1.SignalingChannel) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.SignalingChannelObservation) channelModifier {
+	return func(r *v1alpha1.SignalingChannel) { r.Status.AtProvider = o }
+}
+
+func channel(m ...channelModifier) *v1alpha1.SignalingChannel {
+	cr := &v1alpha1.SignalingChannel{
+		Spec: v1alpha1.SignalingChannelSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, channelName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(c *awskinesisvideo.ChannelInfo, err error) func(*awskinesisvideo.DescribeSignalingChannelInput) awskinesisvideo.DescribeSignalingChannelRequest {
+	return func(*awskinesisvideo.DescribeSignalingChannelInput) awskinesisvideo.DescribeSignalingChannelRequest {
+		return awskinesisvideo.DescribeSignalingChannelRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesisvideo.DescribeSignalingChannelOutput{ChannelInfo: c}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (kinesisvideo.SignalingChannelClient, error)
+		cr          *v1alpha1.SignalingChannel
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i kinesisvideo.SignalingChannelClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: channel(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i kinesisvideo.SignalingChannelClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: channel(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SignalingChannel
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awskinesisvideo.ChannelInfo{
+		ChannelName:               aws.String(channelName),
+		ChannelARN:                aws.String(channelARN),
+		ChannelType:               awskinesisvideo.ChannelTypeSingleMaster,
+		ChannelStatus:             awskinesisvideo.StatusActive,
+		SingleMasterConfiguration: &awskinesisvideo.SingleMasterConfiguration{MessageTtlSeconds: aws.Int64(60)},
+		Version:                   aws.String("v1"),
+	}
+	status := v1alpha1.SignalingChannelObservation{ChannelARN: channelARN, ChannelStatus: "ACTIVE", Version: "v1"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockSignalingChannelClient{
+					MockDescribeSignalingChannel: describe(observed, nil),
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(
+					withSpec(v1alpha1.SignalingChannelParameters{ChannelType: aws.String("SINGLE_MASTER"), MessageTTLSeconds: aws.Int64(60)}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TTLChanged": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockDescribeSignalingChannel: describe(observed, nil),
+				},
+				cr: channel(withSpec(v1alpha1.SignalingChannelParameters{ChannelType: aws.String("SINGLE_MASTER"), MessageTTLSeconds: aws.Int64(30)})),
+			},
+			want: want{
+				cr: channel(
+					withSpec(v1alpha1.SignalingChannelParameters{ChannelType: aws.String("SINGLE_MASTER"), MessageTTLSeconds: aws.Int64(30)}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockDescribeSignalingChannel: describe(nil, awserr.New(awskinesisvideo.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockDescribeSignalingChannel: describe(nil, errBoom),
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr:  channel(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SignalingChannel
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockCreateSignalingChannel: func(*awskinesisvideo.CreateSignalingChannelInput) awskinesisvideo.CreateSignalingChannelRequest {
+						return awskinesisvideo.CreateSignalingChannelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesisvideo.CreateSignalingChannelOutput{}},
+						}
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockCreateSignalingChannel: func(*awskinesisvideo.CreateSignalingChannelInput) awskinesisvideo.CreateSignalingChannelRequest {
+						return awskinesisvideo.CreateSignalingChannelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr:  channel(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SignalingChannel
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	status := v1alpha1.SignalingChannelObservation{ChannelARN: channelARN, Version: "v1"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockUpdateSignalingChannel: func(in *awskinesisvideo.UpdateSignalingChannelInput) awskinesisvideo.UpdateSignalingChannelRequest {
+						want := &awskinesisvideo.UpdateSignalingChannelInput{
+							ChannelARN:                aws.String(channelARN),
+							CurrentVersion:            aws.String("v1"),
+							SingleMasterConfiguration: &awskinesisvideo.SingleMasterConfiguration{MessageTtlSeconds: aws.Int64(30)},
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awskinesisvideo.UpdateSignalingChannelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesisvideo.UpdateSignalingChannelOutput{}},
+						}
+					},
+				},
+				cr: channel(withSpec(v1alpha1.SignalingChannelParameters{MessageTTLSeconds: aws.Int64(30)}), withStatus(status)),
+			},
+			want: want{
+				cr: channel(withSpec(v1alpha1.SignalingChannelParameters{MessageTTLSeconds: aws.Int64(30)}), withStatus(status)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockUpdateSignalingChannel: func(*awskinesisvideo.UpdateSignalingChannelInput) awskinesisvideo.UpdateSignalingChannelRequest {
+						return awskinesisvideo.UpdateSignalingChannelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: channel(withStatus(status)),
+			},
+			want: want{
+				cr:  channel(withStatus(status)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SignalingChannel
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockDeleteSignalingChannel: func(*awskinesisvideo.DeleteSignalingChannelInput) awskinesisvideo.DeleteSignalingChannelRequest {
+						return awskinesisvideo.DeleteSignalingChannelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesisvideo.DeleteSignalingChannelOutput{}},
+						}
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockDeleteSignalingChannel: func(*awskinesisvideo.DeleteSignalingChannelInput) awskinesisvideo.DeleteSignalingChannelRequest {
+						return awskinesisvideo.DeleteSignalingChannelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awskinesisvideo.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockSignalingChannelClient{
+					MockDeleteSignalingChannel: func(*awskinesisvideo.DeleteSignalingChannelInput) awskinesisvideo.DeleteSignalingChannelRequest {
+						return awskinesisvideo.DeleteSignalingChannelRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr:  channel(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
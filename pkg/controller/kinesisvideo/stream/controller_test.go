@@ -0,0 +1,630 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awskinesisvideo "github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/kinesisvideo"
+	"github.com/crossplane/provider-aws/pkg/clients/kinesisvideo/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	streamName = "my-stream"
+	streamARN  = "arn:aws:kinesisvideo:us-east-1:123456789012:stream/my-stream/1"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client kinesisvideo.StreamClient
+	kube   client.Client
+	cr     *v1alpha1.Stream
+}
+
+type streamModifier func(*v1alpha1.Stream)
+
+func withConditions(c ...runtimev1alpha1.Condition) streamModifier {
+	return func(r *v1alpha1.Stream) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.StreamParameters) streamModifier {
+	return func(r *v1alpha1.Stream) { r.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.StreamObservation) streamModifier {
+	return func(r *v1alpha1.Stream) { r.Status.AtProvider = o }
+}
+
+func stream(m ...streamModifier) *v1alpha1.Stream {
+	cr := &v1alpha1.Stream{
+		Spec: v1alpha1.StreamSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	meta.SetExternalName(cr, streamName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(s *awskinesisvideo.StreamInfo, err error) func(*awskinesisvideo.DescribeStreamInput) awskinesisvideo.DescribeStreamRequest {
+	return func(*awskinesisvideo.DescribeStreamInput) awskinesisvideo.DescribeStreamRequest {
+		return awskinesisvideo.DescribeStreamRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesisvideo.DescribeStreamOutput{StreamInfo: s}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (kinesisvideo.StreamClient, error)
+		cr          *v1alpha1.Stream
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i kinesisvideo.StreamClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: stream(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i kinesisvideo.StreamClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: stream(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Stream
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awskinesisvideo.StreamInfo{
+		StreamName:           aws.String(streamName),
+		StreamARN:            aws.String(streamARN),
+		MediaType:            aws.String("video/h264"),
+		DataRetentionInHours: aws.Int64(24),
+		Status:               awskinesisvideo.StatusActive,
+		Version:              aws.String("v1"),
+	}
+	status := v1alpha1.StreamObservation{StreamARN: streamARN, Status: "ACTIVE", Version: "v1"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockStreamClient{
+					MockDescribeStream: describe(observed, nil),
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr: stream(
+					withSpec(v1alpha1.StreamParameters{MediaType: aws.String("video/h264"), DataRetentionInHours: aws.Int64(24)}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RetentionChanged": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDescribeStream: describe(observed, nil),
+				},
+				cr: stream(withSpec(v1alpha1.StreamParameters{MediaType: aws.String("video/h264"), DataRetentionInHours: aws.Int64(48)})),
+			},
+			want: want{
+				cr: stream(
+					withSpec(v1alpha1.StreamParameters{MediaType: aws.String("video/h264"), DataRetentionInHours: aws.Int64(48)}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDescribeStream: describe(nil, awserr.New(awskinesisvideo.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr: stream(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDescribeStream: describe(nil, errBoom),
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr:  stream(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Stream
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockCreateStream: func(*awskinesisvideo.CreateStreamInput) awskinesisvideo.CreateStreamRequest {
+						return awskinesisvideo.CreateStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesisvideo.CreateStreamOutput{}},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr: stream(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockCreateStream: func(*awskinesisvideo.CreateStreamInput) awskinesisvideo.CreateStreamRequest {
+						return awskinesisvideo.CreateStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr:  stream(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Stream
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	observed := &awskinesisvideo.StreamInfo{
+		StreamARN:            aws.String(streamARN),
+		DataRetentionInHours: aws.Int64(24),
+		Version:              aws.String("v1"),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DecreaseDataRetention": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDescribeStream: describe(observed, nil),
+					MockUpdateDataRetention: func(in *awskinesisvideo.UpdateDataRetentionInput) awskinesisvideo.UpdateDataRetentionRequest {
+						want := &awskinesisvideo.UpdateDataRetentionInput{
+							StreamARN:                  aws.String(streamARN),
+							CurrentVersion:             aws.String("v1"),
+							Operation:                  awskinesisvideo.UpdateDataRetentionOperationDecreaseDataRetention,
+							DataRetentionChangeInHours: aws.Int64(12),
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awskinesisvideo.UpdateDataRetentionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesisvideo.UpdateDataRetentionOutput{}},
+						}
+					},
+				},
+				cr: stream(withSpec(v1alpha1.StreamParameters{DataRetentionInHours: aws.Int64(12)})),
+			},
+			want: want{
+				cr: stream(withSpec(v1alpha1.StreamParameters{DataRetentionInHours: aws.Int64(12)})),
+			},
+		},
+		"UpdateStream": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDescribeStream: describe(observed, nil),
+					MockUpdateStream: func(in *awskinesisvideo.UpdateStreamInput) awskinesisvideo.UpdateStreamRequest {
+						want := &awskinesisvideo.UpdateStreamInput{
+							StreamARN:      aws.String(streamARN),
+							CurrentVersion: aws.String("v1"),
+							MediaType:      aws.String("video/h265"),
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awskinesisvideo.UpdateStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesisvideo.UpdateStreamOutput{}},
+						}
+					},
+				},
+				cr: stream(withSpec(v1alpha1.StreamParameters{MediaType: aws.String("video/h265"), DataRetentionInHours: aws.Int64(24)})),
+			},
+			want: want{
+				cr: stream(withSpec(v1alpha1.StreamParameters{MediaType: aws.String("video/h265"), DataRetentionInHours: aws.Int64(24)})),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDescribeStream: describe(nil, errBoom),
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr:  stream(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedUpdateRequest": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDescribeStream: describe(observed, nil),
+					MockUpdateStream: func(*awskinesisvideo.UpdateStreamInput) awskinesisvideo.UpdateStreamRequest {
+						return awskinesisvideo.UpdateStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr:  stream(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Stream
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDeleteStream: func(*awskinesisvideo.DeleteStreamInput) awskinesisvideo.DeleteStreamRequest {
+						return awskinesisvideo.DeleteStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskinesisvideo.DeleteStreamOutput{}},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr: stream(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: stream(withStatus(v1alpha1.StreamObservation{Status: "DELETING"})),
+			},
+			want: want{
+				cr: stream(withStatus(v1alpha1.StreamObservation{Status: "DELETING"}), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDeleteStream: func(*awskinesisvideo.DeleteStreamInput) awskinesisvideo.DeleteStreamRequest {
+						return awskinesisvideo.DeleteStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awskinesisvideo.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr: stream(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockStreamClient{
+					MockDeleteStream: func(*awskinesisvideo.DeleteStreamInput) awskinesisvideo.DeleteStreamRequest {
+						return awskinesisvideo.DeleteStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr:  stream(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
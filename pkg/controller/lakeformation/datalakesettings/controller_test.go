@@ -0,0 +1,502 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalakesettings
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslakeformation "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	adminARN  = "arn:aws:iam::123456789012:role/admin"
+	deletedAt = metav1.Now()
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client lakeformation.DataLakeSettingsClient
+	kube   client.Client
+	cr     *v1alpha1.DataLakeSettings
+}
+
+type settingsModifier func(*v1alpha1.DataLakeSettings)
+
+func withConditions(c ...runtimev1alpha1.Condition) settingsModifier {
+	return func(r *v1alpha1.DataLakeSettings) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAdmins(a ...string) settingsModifier {
+	return func(r *v1alpha1.DataLakeSettings) { r.Spec.ForProvider.DataLakeAdmins = a }
+}
+
+func withDefaultPermissions() settingsModifier {
+	return func(r *v1alpha1.DataLakeSettings) {
+		all := []v1alpha1.PrincipalPermissions{{Principal: lakeformation.IAMAllowedPrincipals, Permissions: []v1alpha1.Permission{"ALL"}}}
+		r.Spec.ForProvider.CreateDatabaseDefaultPermissions = all
+		r.Spec.ForProvider.CreateTableDefaultPermissions = all
+	}
+}
+
+func withDeletionTimestamp() settingsModifier {
+	return func(r *v1alpha1.DataLakeSettings) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func settings(m ...settingsModifier) *v1alpha1.DataLakeSettings {
+	cr := &v1alpha1.DataLakeSettings{
+		Spec: v1alpha1.DataLakeSettingsSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getSettings(s *awslakeformation.DataLakeSettings) func(*awslakeformation.GetDataLakeSettingsInput) awslakeformation.GetDataLakeSettingsRequest {
+	return func(*awslakeformation.GetDataLakeSettingsInput) awslakeformation.GetDataLakeSettingsRequest {
+		return awslakeformation.GetDataLakeSettingsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.GetDataLakeSettingsOutput{DataLakeSettings: s}},
+		}
+	}
+}
+
+func withAdmin(s *awslakeformation.DataLakeSettings, arn string) *awslakeformation.DataLakeSettings {
+	s.DataLakeAdmins = append(s.DataLakeAdmins, awslakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(arn)})
+	return s
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (lakeformation.DataLakeSettingsClient, error)
+		cr          *v1alpha1.DataLakeSettings
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i lakeformation.DataLakeSettingsClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: settings(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i lakeformation.DataLakeSettingsClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: settings(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: settings(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: settings(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: settings(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataLakeSettings
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialize": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockDataLakeSettingsClient{
+					MockGetDataLakeSettings: getSettings(lakeformation.DefaultDataLakeSettings()),
+				},
+				cr: settings(withAdmins(adminARN)),
+			},
+			want: want{
+				cr: settings(
+					withAdmins(adminARN),
+					withDefaultPermissions(),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockDataLakeSettingsClient{
+					MockGetDataLakeSettings: getSettings(withAdmin(lakeformation.DefaultDataLakeSettings(), adminARN)),
+				},
+				cr: settings(withAdmins(adminARN), withDefaultPermissions()),
+			},
+			want: want{
+				cr: settings(
+					withAdmins(adminARN),
+					withDefaultPermissions(),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DefaultsRestored": {
+			args: args{
+				client: &fake.MockDataLakeSettingsClient{
+					MockGetDataLakeSettings: getSettings(lakeformation.DefaultDataLakeSettings()),
+				},
+				cr: settings(withAdmins(adminARN), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: settings(withAdmins(adminARN), withDeletionTimestamp()),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockDataLakeSettingsClient{
+					MockGetDataLakeSettings: func(*awslakeformation.GetDataLakeSettingsInput) awslakeformation.GetDataLakeSettingsRequest {
+						return awslakeformation.GetDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: settings(),
+			},
+			want: want{
+				cr:  settings(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataLakeSettings
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDataLakeSettingsClient{
+					MockPutDataLakeSettings: func(input *awslakeformation.PutDataLakeSettingsInput) awslakeformation.PutDataLakeSettingsRequest {
+						if diff := cmp.Diff(adminARN, aws.StringValue(input.DataLakeSettings.DataLakeAdmins[0].DataLakePrincipalIdentifier)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslakeformation.PutDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.PutDataLakeSettingsOutput{}},
+						}
+					},
+				},
+				cr: settings(withAdmins(adminARN)),
+			},
+			want: want{
+				cr: settings(withAdmins(adminARN)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDataLakeSettingsClient{
+					MockPutDataLakeSettings: func(input *awslakeformation.PutDataLakeSettingsInput) awslakeformation.PutDataLakeSettingsRequest {
+						return awslakeformation.PutDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: settings(),
+			},
+			want: want{
+				cr:  settings(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DataLakeSettings
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDataLakeSettingsClient{
+					MockPutDataLakeSettings: func(input *awslakeformation.PutDataLakeSettingsInput) awslakeformation.PutDataLakeSettingsRequest {
+						if diff := cmp.Diff(lakeformation.DefaultDataLakeSettings(), input.DataLakeSettings); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslakeformation.PutDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.PutDataLakeSettingsOutput{}},
+						}
+					},
+				},
+				cr: settings(withAdmins(adminARN)),
+			},
+			want: want{
+				cr: settings(withAdmins(adminARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDataLakeSettingsClient{
+					MockPutDataLakeSettings: func(input *awslakeformation.PutDataLakeSettingsInput) awslakeformation.PutDataLakeSettingsRequest {
+						return awslakeformation.PutDataLakeSettingsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: settings(),
+			},
+			want: want{
+				cr:  settings(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
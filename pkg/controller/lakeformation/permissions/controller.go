@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslakeformation "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a Permissions resource"
+	errCreateClient      = "cannot create Lake Formation client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+
+	errList   = "failed to list Permissions"
+	errCreate = "failed to grant the Permissions resource"
+	errRevoke = "failed to revoke removed permissions of the Permissions resource"
+	errUpdate = "failed to update the Permissions resource"
+	errDelete = "failed to revoke the Permissions resource"
+)
+
+// SetupPermissions adds a controller that reconciles Permissions.
+func SetupPermissions(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.PermissionsGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.Permissions{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PermissionsGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: lakeformation.NewPermissionsClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (lakeformation.PermissionsClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Permissions)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client lakeformation.PermissionsClient
+}
+
+// list returns the permissions the principal holds on the resource across all
+// pages.
+func (e *external) list(ctx context.Context, p v1alpha1.PermissionsParameters) (lakeformation.ObservedPermissions, error) {
+	input := lakeformation.GenerateListPermissionsInput(p)
+	var all []awslakeformation.PrincipalResourcePermissions
+	for {
+		rsp, err := e.client.ListPermissionsRequest(input).Send(ctx)
+		if err != nil {
+			return lakeformation.ObservedPermissions{}, err
+		}
+		all = append(all, rsp.PrincipalResourcePermissions...)
+		if aws.StringValue(rsp.NextToken) == "" {
+			return lakeformation.GenerateObservedPermissions(all), nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Permissions)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.list(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(lakeformation.IsNotFound, err), errList)
+	}
+	if len(observed.Permissions) == 0 && len(observed.PermissionsWithGrantOption) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: lakeformation.IsPermissionsUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Permissions)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.GrantPermissionsRequest(lakeformation.GenerateGrantPermissionsInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Permissions)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.list(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errList)
+	}
+
+	// Granting permissions is additive, so permissions that were removed
+	// from the spec are revoked first.
+	revoked := lakeformation.RevokedPermissions(cr.Spec.ForProvider, observed)
+	if len(revoked.Permissions) > 0 || len(revoked.PermissionsWithGrantOption) > 0 {
+		if _, err := e.client.RevokePermissionsRequest(lakeformation.GenerateRevokePermissionsInput(cr.Spec.ForProvider, revoked.Permissions, revoked.PermissionsWithGrantOption)).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRevoke)
+		}
+	}
+
+	_, err = e.client.GrantPermissionsRequest(lakeformation.GenerateGrantPermissionsInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Permissions)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	p := cr.Spec.ForProvider
+	_, err := e.client.RevokePermissionsRequest(lakeformation.GenerateRevokePermissionsInput(p,
+		lakeformation.GeneratePermissions(p.Permissions),
+		lakeformation.GeneratePermissions(p.PermissionsWithGrantOption))).Send(ctx)
+	return errors.Wrap(resource.Ignore(lakeformation.IsNotFound, err), errDelete)
+}
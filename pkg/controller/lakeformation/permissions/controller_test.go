@@ -0,0 +1,567 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslakeformation "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lakeformation/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation"
+	"github.com/crossplane/provider-aws/pkg/clients/lakeformation/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	roleARN = "arn:aws:iam::123456789012:role/analyst"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client lakeformation.PermissionsClient
+	kube   client.Client
+	cr     *v1alpha1.Permissions
+}
+
+type permissionsModifier func(*v1alpha1.Permissions)
+
+func withConditions(c ...runtimev1alpha1.Condition) permissionsModifier {
+	return func(r *v1alpha1.Permissions) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPermissions(p ...v1alpha1.Permission) permissionsModifier {
+	return func(r *v1alpha1.Permissions) { r.Spec.ForProvider.Permissions = p }
+}
+
+func permissions(m ...permissionsModifier) *v1alpha1.Permissions {
+	cr := &v1alpha1.Permissions{
+		Spec: v1alpha1.PermissionsSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.PermissionsParameters{
+				Principal: aws.String(roleARN),
+				Resource: v1alpha1.PermissionsResource{
+					Table: &v1alpha1.TableResource{DatabaseName: "sales", Name: "orders"},
+				},
+				Permissions: []v1alpha1.Permission{"SELECT"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listPermissions(perms ...awslakeformation.Permission) func(*awslakeformation.ListPermissionsInput) awslakeformation.ListPermissionsRequest {
+	return func(*awslakeformation.ListPermissionsInput) awslakeformation.ListPermissionsRequest {
+		out := &awslakeformation.ListPermissionsOutput{}
+		if len(perms) > 0 {
+			out.PrincipalResourcePermissions = []awslakeformation.PrincipalResourcePermissions{{Permissions: perms}}
+		}
+		return awslakeformation.ListPermissionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+func grant(t *testing.T, want ...awslakeformation.Permission) func(*awslakeformation.GrantPermissionsInput) awslakeformation.GrantPermissionsRequest {
+	return func(input *awslakeformation.GrantPermissionsInput) awslakeformation.GrantPermissionsRequest {
+		if diff := cmp.Diff(want, input.Permissions); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awslakeformation.GrantPermissionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.GrantPermissionsOutput{}},
+		}
+	}
+}
+
+func revoke(t *testing.T, want ...awslakeformation.Permission) func(*awslakeformation.RevokePermissionsInput) awslakeformation.RevokePermissionsRequest {
+	return func(input *awslakeformation.RevokePermissionsInput) awslakeformation.RevokePermissionsRequest {
+		if diff := cmp.Diff(want, input.Permissions); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awslakeformation.RevokePermissionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslakeformation.RevokePermissionsOutput{}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (lakeformation.PermissionsClient, error)
+		cr          *v1alpha1.Permissions
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i lakeformation.PermissionsClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: permissions(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i lakeformation.PermissionsClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: permissions(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Permissions
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockListPermissions: listPermissions(awslakeformation.PermissionSelect),
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr: permissions(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PermissionAdded": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockListPermissions: listPermissions(awslakeformation.PermissionSelect),
+				},
+				cr: permissions(withPermissions("SELECT", "INSERT")),
+			},
+			want: want{
+				cr: permissions(withPermissions("SELECT", "INSERT"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotGranted": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockListPermissions: listPermissions(),
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr: permissions(),
+			},
+		},
+		"FailedListRequest": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockListPermissions: func(*awslakeformation.ListPermissionsInput) awslakeformation.ListPermissionsRequest {
+						return awslakeformation.ListPermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr:  permissions(),
+				err: errors.Wrap(errBoom, errList),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Permissions
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockGrantPermissions: grant(t, awslakeformation.PermissionSelect),
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr: permissions(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockGrantPermissions: func(input *awslakeformation.GrantPermissionsInput) awslakeformation.GrantPermissionsRequest {
+						return awslakeformation.GrantPermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr:  permissions(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Permissions
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"GrantAdded": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockListPermissions:  listPermissions(awslakeformation.PermissionSelect),
+					MockGrantPermissions: grant(t, awslakeformation.PermissionSelect, awslakeformation.PermissionInsert),
+				},
+				cr: permissions(withPermissions("SELECT", "INSERT")),
+			},
+			want: want{
+				cr: permissions(withPermissions("SELECT", "INSERT")),
+			},
+		},
+		"RevokeRemoved": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockListPermissions:   listPermissions(awslakeformation.PermissionSelect, awslakeformation.PermissionDrop),
+					MockRevokePermissions: revoke(t, awslakeformation.PermissionDrop),
+					MockGrantPermissions:  grant(t, awslakeformation.PermissionSelect),
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr: permissions(),
+			},
+		},
+		"FailedRevokeRequest": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockListPermissions: listPermissions(awslakeformation.PermissionSelect, awslakeformation.PermissionDrop),
+					MockRevokePermissions: func(input *awslakeformation.RevokePermissionsInput) awslakeformation.RevokePermissionsRequest {
+						return awslakeformation.RevokePermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr:  permissions(),
+				err: errors.Wrap(errBoom, errRevoke),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Permissions
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockRevokePermissions: revoke(t, awslakeformation.PermissionSelect),
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr: permissions(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockPermissionsClient{
+					MockRevokePermissions: func(input *awslakeformation.RevokePermissionsInput) awslakeformation.RevokePermissionsRequest {
+						return awslakeformation.RevokePermissionsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: permissions(),
+			},
+			want: want{
+				cr:  permissions(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,614 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package licenseconfiguration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslicensemanager "github.com/aws/aws-sdk-go-v2/service/licensemanager"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/licensemanager/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/licensemanager"
+	"github.com/crossplane/provider-aws/pkg/clients/licensemanager/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	configARN   = "arn:aws:license-manager:us-east-1:123456789012:license-configuration:lic-0123456789abcdef0123456789abcdef"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awslicensemanager.ErrCodeInvalidParameterValueException, "not found", nil)
+)
+
+type args struct {
+	client licensemanager.LicenseConfigurationClient
+	kube   client.Client
+	cr     *v1alpha1.LicenseConfiguration
+}
+
+type configModifier func(*v1alpha1.LicenseConfiguration)
+
+func withConditions(c ...runtimev1alpha1.Condition) configModifier {
+	return func(r *v1alpha1.LicenseConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) configModifier {
+	return func(r *v1alpha1.LicenseConfiguration) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha1.LicenseConfigurationObservation) configModifier {
+	return func(r *v1alpha1.LicenseConfiguration) { r.Status.AtProvider = o }
+}
+
+func withLicenseCount(c int64) configModifier {
+	return func(r *v1alpha1.LicenseConfiguration) { r.Spec.ForProvider.LicenseCount = &c }
+}
+
+func withHardLimit(b bool) configModifier {
+	return func(r *v1alpha1.LicenseConfiguration) { r.Spec.ForProvider.LicenseCountHardLimit = &b }
+}
+
+func licenseConfiguration(m ...configModifier) *v1alpha1.LicenseConfiguration {
+	cr := &v1alpha1.LicenseConfiguration{
+		Spec: v1alpha1.LicenseConfigurationSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.LicenseConfigurationParameters{
+				Name:                "windows-server",
+				LicenseCountingType: "vCPU",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getConfig(count int64) func(*awslicensemanager.GetLicenseConfigurationInput) awslicensemanager.GetLicenseConfigurationRequest {
+	return func(*awslicensemanager.GetLicenseConfigurationInput) awslicensemanager.GetLicenseConfigurationRequest {
+		return awslicensemanager.GetLicenseConfigurationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslicensemanager.GetLicenseConfigurationOutput{
+				Name:                    aws.String("windows-server"),
+				LicenseConfigurationArn: aws.String(configARN),
+				LicenseCountingType:     awslicensemanager.LicenseCountingTypeVCpu,
+				LicenseCount:            aws.Int64(count),
+				LicenseCountHardLimit:   aws.Bool(false),
+				ConsumedLicenses:        aws.Int64(4),
+				Status:                  aws.String("AVAILABLE"),
+			}},
+		}
+	}
+}
+
+var observation = v1alpha1.LicenseConfigurationObservation{ARN: configARN, ConsumedLicenses: 4, Status: "AVAILABLE"}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (licensemanager.LicenseConfigurationClient, error)
+		cr          *v1alpha1.LicenseConfiguration
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i licensemanager.LicenseConfigurationClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: licenseConfiguration(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i licensemanager.LicenseConfigurationClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: licenseConfiguration(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: licenseConfiguration(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: licenseConfiguration(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: licenseConfiguration(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LicenseConfiguration
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: licenseConfiguration(),
+			},
+			want: want{
+				cr: licenseConfiguration(),
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockLicenseConfigurationClient{
+					MockGetLicenseConfiguration: getConfig(16),
+				},
+				cr: licenseConfiguration(withExternalName(configARN)),
+			},
+			want: want{
+				cr: licenseConfiguration(
+					withExternalName(configARN),
+					withLicenseCount(16),
+					withHardLimit(false),
+					withObservation(observation),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockLicenseConfigurationClient{
+					MockGetLicenseConfiguration: getConfig(16),
+				},
+				cr: licenseConfiguration(withExternalName(configARN), withLicenseCount(32), withHardLimit(false)),
+			},
+			want: want{
+				cr: licenseConfiguration(
+					withExternalName(configARN),
+					withLicenseCount(32),
+					withHardLimit(false),
+					withObservation(observation),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockLicenseConfigurationClient{
+					MockGetLicenseConfiguration: func(*awslicensemanager.GetLicenseConfigurationInput) awslicensemanager.GetLicenseConfigurationRequest {
+						return awslicensemanager.GetLicenseConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: licenseConfiguration(withExternalName(configARN)),
+			},
+			want: want{
+				cr: licenseConfiguration(withExternalName(configARN)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockLicenseConfigurationClient{
+					MockGetLicenseConfiguration: func(*awslicensemanager.GetLicenseConfigurationInput) awslicensemanager.GetLicenseConfigurationRequest {
+						return awslicensemanager.GetLicenseConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: licenseConfiguration(withExternalName(configARN)),
+			},
+			want: want{
+				cr:  licenseConfiguration(withExternalName(configARN)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LicenseConfiguration
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockLicenseConfigurationClient{
+					MockCreateLicenseConfiguration: func(input *awslicensemanager.CreateLicenseConfigurationInput) awslicensemanager.CreateLicenseConfigurationRequest {
+						if diff := cmp.Diff(awslicensemanager.LicenseCountingTypeVCpu, input.LicenseCountingType); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslicensemanager.CreateLicenseConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslicensemanager.CreateLicenseConfigurationOutput{
+								LicenseConfigurationArn: aws.String(configARN),
+							}},
+						}
+					},
+				},
+				cr: licenseConfiguration(),
+			},
+			want: want{
+				cr: licenseConfiguration(withExternalName(configARN), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLicenseConfigurationClient{
+					MockCreateLicenseConfiguration: func(*awslicensemanager.CreateLicenseConfigurationInput) awslicensemanager.CreateLicenseConfigurationRequest {
+						return awslicensemanager.CreateLicenseConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: licenseConfiguration(),
+			},
+			want: want{
+				cr:  licenseConfiguration(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LicenseConfiguration
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLicenseConfigurationClient{
+					MockUpdateLicenseConfiguration: func(input *awslicensemanager.UpdateLicenseConfigurationInput) awslicensemanager.UpdateLicenseConfigurationRequest {
+						if diff := cmp.Diff(configARN, aws.StringValue(input.LicenseConfigurationArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(int64(32), aws.Int64Value(input.LicenseCount)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awslicensemanager.UpdateLicenseConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslicensemanager.UpdateLicenseConfigurationOutput{}},
+						}
+					},
+				},
+				cr: licenseConfiguration(withExternalName(configARN), withLicenseCount(32)),
+			},
+			want: want{
+				cr: licenseConfiguration(withExternalName(configARN), withLicenseCount(32)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLicenseConfigurationClient{
+					MockUpdateLicenseConfiguration: func(*awslicensemanager.UpdateLicenseConfigurationInput) awslicensemanager.UpdateLicenseConfigurationRequest {
+						return awslicensemanager.UpdateLicenseConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: licenseConfiguration(withExternalName(configARN)),
+			},
+			want: want{
+				cr:  licenseConfiguration(withExternalName(configARN)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LicenseConfiguration
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLicenseConfigurationClient{
+					MockDeleteLicenseConfiguration: func(*awslicensemanager.DeleteLicenseConfigurationInput) awslicensemanager.DeleteLicenseConfigurationRequest {
+						return awslicensemanager.DeleteLicenseConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslicensemanager.DeleteLicenseConfigurationOutput{}},
+						}
+					},
+				},
+				cr: licenseConfiguration(withExternalName(configARN)),
+			},
+			want: want{
+				cr: licenseConfiguration(withExternalName(configARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockLicenseConfigurationClient{
+					MockDeleteLicenseConfiguration: func(*awslicensemanager.DeleteLicenseConfigurationInput) awslicensemanager.DeleteLicenseConfigurationRequest {
+						return awslicensemanager.DeleteLicenseConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: licenseConfiguration(withExternalName(configARN)),
+			},
+			want: want{
+				cr: licenseConfiguration(withExternalName(configARN), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLicenseConfigurationClient{
+					MockDeleteLicenseConfiguration: func(*awslicensemanager.DeleteLicenseConfigurationInput) awslicensemanager.DeleteLicenseConfigurationRequest {
+						return awslicensemanager.DeleteLicenseConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: licenseConfiguration(withExternalName(configARN)),
+			},
+			want: want{
+				cr:  licenseConfiguration(withExternalName(configARN), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,577 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsmacie2 "github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	serviceRole = "arn:aws:iam::123456789012:role/aws-service-role/macie.amazonaws.com/AWSServiceRoleForAmazonMacie"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	client macie2.AccountClient
+	kube   client.Client
+	cr     *v1alpha1.Account
+}
+
+type accountModifier func(*v1alpha1.Account)
+
+func withConditions(c ...runtimev1alpha1.Condition) accountModifier {
+	return func(r *v1alpha1.Account) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s string) accountModifier {
+	return func(r *v1alpha1.Account) { r.Spec.ForProvider.Status = aws.String(s) }
+}
+
+func withFrequency(s string) accountModifier {
+	return func(r *v1alpha1.Account) { r.Spec.ForProvider.FindingPublishingFrequency = aws.String(s) }
+}
+
+func withServiceRole(s string) accountModifier {
+	return func(r *v1alpha1.Account) { r.Status.AtProvider.ServiceRole = s }
+}
+
+func account(m ...accountModifier) *v1alpha1.Account {
+	cr := &v1alpha1.Account{
+		Spec: v1alpha1.AccountSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getSession(status awsmacie2.MacieStatus) func(*awsmacie2.GetMacieSessionInput) awsmacie2.GetMacieSessionRequest {
+	return func(*awsmacie2.GetMacieSessionInput) awsmacie2.GetMacieSessionRequest {
+		return awsmacie2.GetMacieSessionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.GetMacieSessionOutput{
+				FindingPublishingFrequency: awsmacie2.FindingPublishingFrequencySixHours,
+				ServiceRole:                aws.String(serviceRole),
+				Status:                     status,
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (macie2.AccountClient, error)
+		cr          *v1alpha1.Account
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i macie2.AccountClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: account(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i macie2.AccountClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: account(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Account
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockAccountClient{
+					MockGetMacieSession: getSession(awsmacie2.MacieStatusEnabled),
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(
+					withFrequency("SIX_HOURS"),
+					withStatus("ENABLED"),
+					withServiceRole(serviceRole),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockGetMacieSession: getSession(awsmacie2.MacieStatusEnabled),
+				},
+				cr: account(withFrequency("SIX_HOURS"), withStatus("PAUSED")),
+			},
+			want: want{
+				cr: account(
+					withFrequency("SIX_HOURS"),
+					withStatus("PAUSED"),
+					withServiceRole(serviceRole),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotEnabled": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockGetMacieSession: func(*awsmacie2.GetMacieSessionInput) awsmacie2.GetMacieSessionRequest {
+						return awsmacie2.GetMacieSessionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsmacie2.ErrCodeAccessDeniedException, "Macie is not enabled", nil)},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockGetMacieSession: func(*awsmacie2.GetMacieSessionInput) awsmacie2.GetMacieSessionRequest {
+						return awsmacie2.GetMacieSessionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr:  account(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Account
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockEnableMacie: func(*awsmacie2.EnableMacieInput) awsmacie2.EnableMacieRequest {
+						return awsmacie2.EnableMacieRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.EnableMacieOutput{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockEnableMacie: func(*awsmacie2.EnableMacieInput) awsmacie2.EnableMacieRequest {
+						return awsmacie2.EnableMacieRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr:  account(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errEnable),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Account
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockUpdateMacieSession: func(in *awsmacie2.UpdateMacieSessionInput) awsmacie2.UpdateMacieSessionRequest {
+						if diff := cmp.Diff(awsmacie2.MacieStatusPaused, in.Status); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsmacie2.UpdateMacieSessionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.UpdateMacieSessionOutput{}},
+						}
+					},
+				},
+				cr: account(withStatus("PAUSED")),
+			},
+			want: want{
+				cr: account(withStatus("PAUSED")),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockUpdateMacieSession: func(*awsmacie2.UpdateMacieSessionInput) awsmacie2.UpdateMacieSessionRequest {
+						return awsmacie2.UpdateMacieSessionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: account(withStatus("PAUSED")),
+			},
+			want: want{
+				cr:  account(withStatus("PAUSED")),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Account
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockDisableMacie: func(*awsmacie2.DisableMacieInput) awsmacie2.DisableMacieRequest {
+						return awsmacie2.DisableMacieRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.DisableMacieOutput{}},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDisabled": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockDisableMacie: func(*awsmacie2.DisableMacieInput) awsmacie2.DisableMacieRequest {
+						return awsmacie2.DisableMacieRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsmacie2.ErrCodeAccessDeniedException, "Macie is not enabled", nil)},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAccountClient{
+					MockDisableMacie: func(*awsmacie2.DisableMacieInput) awsmacie2.DisableMacieRequest {
+						return awsmacie2.DisableMacieRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr:  account(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDisable),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,619 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classificationjob
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmacie2 "github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	jobID     = "3ce0a5f7d7d5e8c0a23ce38e0b2f8d1a"
+	jobARN    = "arn:aws:macie2:us-east-1:123456789012:classification-job/" + jobID
+	deletedAt = metav1.Now()
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client macie2.ClassificationJobClient
+	kube   client.Client
+	cr     *v1alpha1.ClassificationJob
+}
+
+type jobModifier func(*v1alpha1.ClassificationJob)
+
+func withConditions(c ...runtimev1alpha1.Condition) jobModifier {
+	return func(r *v1alpha1.ClassificationJob) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) jobModifier {
+	return func(r *v1alpha1.ClassificationJob) { meta.SetExternalName(r, s) }
+}
+
+func withJobStatus(s string) jobModifier {
+	return func(r *v1alpha1.ClassificationJob) { r.Spec.ForProvider.JobStatus = aws.String(s) }
+}
+
+func withSampling(p int64) jobModifier {
+	return func(r *v1alpha1.ClassificationJob) { r.Spec.ForProvider.SamplingPercentage = aws.Int64(p) }
+}
+
+func withObservation(s string) jobModifier {
+	return func(r *v1alpha1.ClassificationJob) {
+		r.Status.AtProvider = v1alpha1.ClassificationJobObservation{JobARN: jobARN, JobStatus: s}
+	}
+}
+
+func withDeletionTimestamp() jobModifier {
+	return func(r *v1alpha1.ClassificationJob) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func job(m ...jobModifier) *v1alpha1.ClassificationJob {
+	cr := &v1alpha1.ClassificationJob{
+		Spec: v1alpha1.ClassificationJobSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ClassificationJobParameters{
+				Name:    "pii-scan",
+				JobType: "ONE_TIME",
+				S3JobDefinition: v1alpha1.S3JobDefinition{
+					BucketDefinitions: []v1alpha1.S3BucketDefinition{{
+						AccountID: "123456789012",
+						Buckets:   []string{"customer-data"},
+					}},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeJob(status awsmacie2.JobStatus) func(*awsmacie2.DescribeClassificationJobInput) awsmacie2.DescribeClassificationJobRequest {
+	return func(*awsmacie2.DescribeClassificationJobInput) awsmacie2.DescribeClassificationJobRequest {
+		return awsmacie2.DescribeClassificationJobRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.DescribeClassificationJobOutput{
+				JobId:              aws.String(jobID),
+				JobArn:             aws.String(jobARN),
+				JobStatus:          status,
+				SamplingPercentage: aws.Int64(100),
+			}},
+		}
+	}
+}
+
+func updateJob(want awsmacie2.JobStatus, err error) func(*awsmacie2.UpdateClassificationJobInput) awsmacie2.UpdateClassificationJobRequest {
+	return func(in *awsmacie2.UpdateClassificationJobInput) awsmacie2.UpdateClassificationJobRequest {
+		if in.JobStatus != want {
+			err = errors.Errorf("unexpected job status %s", in.JobStatus)
+		}
+		return awsmacie2.UpdateClassificationJobRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.UpdateClassificationJobOutput{}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (macie2.ClassificationJobClient, error)
+		cr          *v1alpha1.ClassificationJob
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i macie2.ClassificationJobClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: job(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i macie2.ClassificationJobClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: job(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClassificationJob
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Running": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockClassificationJobClient{
+					MockDescribeClassificationJob: describeJob(awsmacie2.JobStatusRunning),
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr: job(
+					withExternalName(jobID),
+					withSampling(100),
+					withObservation("RUNNING"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsPause": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockDescribeClassificationJob: describeJob(awsmacie2.JobStatusRunning),
+				},
+				cr: job(withExternalName(jobID), withSampling(100), withJobStatus("PAUSED")),
+			},
+			want: want{
+				cr: job(
+					withExternalName(jobID),
+					withSampling(100),
+					withJobStatus("PAUSED"),
+					withObservation("RUNNING"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Paused": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockDescribeClassificationJob: describeJob(awsmacie2.JobStatusPaused),
+				},
+				cr: job(withExternalName(jobID), withSampling(100), withJobStatus("PAUSED")),
+			},
+			want: want{
+				cr: job(
+					withExternalName(jobID),
+					withSampling(100),
+					withJobStatus("PAUSED"),
+					withObservation("PAUSED"),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: job(),
+			},
+			want: want{
+				cr: job(),
+			},
+		},
+		"Cancelled": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockDescribeClassificationJob: describeJob(awsmacie2.JobStatusCancelled),
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr: job(withExternalName(jobID)),
+			},
+		},
+		"CompleteAndDeleted": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockDescribeClassificationJob: describeJob(awsmacie2.JobStatusComplete),
+				},
+				cr: job(withExternalName(jobID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withDeletionTimestamp()),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockDescribeClassificationJob: func(*awsmacie2.DescribeClassificationJobInput) awsmacie2.DescribeClassificationJobRequest {
+						return awsmacie2.DescribeClassificationJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr:  job(withExternalName(jobID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClassificationJob
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockClassificationJobClient{
+					MockCreateClassificationJob: func(*awsmacie2.CreateClassificationJobInput) awsmacie2.CreateClassificationJobRequest {
+						return awsmacie2.CreateClassificationJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.CreateClassificationJobOutput{
+								JobId:  aws.String(jobID),
+								JobArn: aws.String(jobARN),
+							}},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockCreateClassificationJob: func(*awsmacie2.CreateClassificationJobInput) awsmacie2.CreateClassificationJobRequest {
+						return awsmacie2.CreateClassificationJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr:  job(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClassificationJob
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockUpdateClassificationJob: updateJob(awsmacie2.JobStatusPaused, nil),
+				},
+				cr: job(withExternalName(jobID), withJobStatus("PAUSED")),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withJobStatus("PAUSED")),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockUpdateClassificationJob: updateJob(awsmacie2.JobStatusPaused, errBoom),
+				},
+				cr: job(withExternalName(jobID), withJobStatus("PAUSED")),
+			},
+			want: want{
+				cr:  job(withExternalName(jobID), withJobStatus("PAUSED")),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ClassificationJob
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockUpdateClassificationJob: updateJob(awsmacie2.JobStatusCancelled, nil),
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClassificationJobClient{
+					MockUpdateClassificationJob: updateJob(awsmacie2.JobStatusCancelled, errBoom),
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr:  job(withExternalName(jobID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
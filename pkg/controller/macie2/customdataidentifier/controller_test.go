@@ -0,0 +1,510 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customdataidentifier
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsmacie2 "github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/macie2/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2"
+	"github.com/crossplane/provider-aws/pkg/clients/macie2/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	identifierID  = "a8d2e9f4-1c3b-4b5a-9e7f-0d6c2b1a3e4f"
+	identifierARN = "arn:aws:macie2:us-east-1:123456789012:custom-data-identifier/" + identifierID
+	errBoom       = errors.New("boom")
+)
+
+type args struct {
+	client macie2.CustomDataIdentifierClient
+	kube   client.Client
+	cr     *v1alpha1.CustomDataIdentifier
+}
+
+type identifierModifier func(*v1alpha1.CustomDataIdentifier)
+
+func withConditions(c ...runtimev1alpha1.Condition) identifierModifier {
+	return func(r *v1alpha1.CustomDataIdentifier) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) identifierModifier {
+	return func(r *v1alpha1.CustomDataIdentifier) { meta.SetExternalName(r, s) }
+}
+
+func withMaximumMatchDistance(d int64) identifierModifier {
+	return func(r *v1alpha1.CustomDataIdentifier) { r.Spec.ForProvider.MaximumMatchDistance = aws.Int64(d) }
+}
+
+func withARN(s string) identifierModifier {
+	return func(r *v1alpha1.CustomDataIdentifier) { r.Status.AtProvider.ARN = s }
+}
+
+func identifier(m ...identifierModifier) *v1alpha1.CustomDataIdentifier {
+	cr := &v1alpha1.CustomDataIdentifier{
+		Spec: v1alpha1.CustomDataIdentifierSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.CustomDataIdentifierParameters{
+				Name:     "employee-id",
+				Regex:    "EMP-[0-9]{6}",
+				Keywords: []string{"employee"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getIdentifier(deleted bool) func(*awsmacie2.GetCustomDataIdentifierInput) awsmacie2.GetCustomDataIdentifierRequest {
+	return func(*awsmacie2.GetCustomDataIdentifierInput) awsmacie2.GetCustomDataIdentifierRequest {
+		return awsmacie2.GetCustomDataIdentifierRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.GetCustomDataIdentifierOutput{
+				Id:                   aws.String(identifierID),
+				Arn:                  aws.String(identifierARN),
+				Deleted:              aws.Bool(deleted),
+				MaximumMatchDistance: aws.Int64(50),
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (macie2.CustomDataIdentifierClient, error)
+		cr          *v1alpha1.CustomDataIdentifier
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i macie2.CustomDataIdentifierClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: identifier(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i macie2.CustomDataIdentifierClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: identifier(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: identifier(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: identifier(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: identifier(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CustomDataIdentifier
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockCustomDataIdentifierClient{
+					MockGetCustomDataIdentifier: getIdentifier(false),
+				},
+				cr: identifier(withExternalName(identifierID)),
+			},
+			want: want{
+				cr: identifier(
+					withExternalName(identifierID),
+					withMaximumMatchDistance(50),
+					withARN(identifierARN),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: identifier(),
+			},
+			want: want{
+				cr: identifier(),
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockCustomDataIdentifierClient{
+					MockGetCustomDataIdentifier: getIdentifier(true),
+				},
+				cr: identifier(withExternalName(identifierID)),
+			},
+			want: want{
+				cr: identifier(withExternalName(identifierID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockCustomDataIdentifierClient{
+					MockGetCustomDataIdentifier: func(*awsmacie2.GetCustomDataIdentifierInput) awsmacie2.GetCustomDataIdentifierRequest {
+						return awsmacie2.GetCustomDataIdentifierRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsmacie2.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: identifier(withExternalName(identifierID)),
+			},
+			want: want{
+				cr: identifier(withExternalName(identifierID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockCustomDataIdentifierClient{
+					MockGetCustomDataIdentifier: func(*awsmacie2.GetCustomDataIdentifierInput) awsmacie2.GetCustomDataIdentifierRequest {
+						return awsmacie2.GetCustomDataIdentifierRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: identifier(withExternalName(identifierID)),
+			},
+			want: want{
+				cr:  identifier(withExternalName(identifierID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CustomDataIdentifier
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockCustomDataIdentifierClient{
+					MockCreateCustomDataIdentifier: func(*awsmacie2.CreateCustomDataIdentifierInput) awsmacie2.CreateCustomDataIdentifierRequest {
+						return awsmacie2.CreateCustomDataIdentifierRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.CreateCustomDataIdentifierOutput{
+								CustomDataIdentifierId: aws.String(identifierID),
+							}},
+						}
+					},
+				},
+				cr: identifier(),
+			},
+			want: want{
+				cr: identifier(withExternalName(identifierID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockCustomDataIdentifierClient{
+					MockCreateCustomDataIdentifier: func(*awsmacie2.CreateCustomDataIdentifierInput) awsmacie2.CreateCustomDataIdentifierRequest {
+						return awsmacie2.CreateCustomDataIdentifierRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: identifier(),
+			},
+			want: want{
+				cr:  identifier(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CustomDataIdentifier
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCustomDataIdentifierClient{
+					MockDeleteCustomDataIdentifier: func(*awsmacie2.DeleteCustomDataIdentifierInput) awsmacie2.DeleteCustomDataIdentifierRequest {
+						return awsmacie2.DeleteCustomDataIdentifierRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsmacie2.DeleteCustomDataIdentifierOutput{}},
+						}
+					},
+				},
+				cr: identifier(withExternalName(identifierID)),
+			},
+			want: want{
+				cr: identifier(withExternalName(identifierID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockCustomDataIdentifierClient{
+					MockDeleteCustomDataIdentifier: func(*awsmacie2.DeleteCustomDataIdentifierInput) awsmacie2.DeleteCustomDataIdentifierRequest {
+						return awsmacie2.DeleteCustomDataIdentifierRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: identifier(withExternalName(identifierID)),
+			},
+			want: want{
+				cr:  identifier(withExternalName(identifierID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snsplatformapplication
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
+	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	snsclient "github.com/crossplane/provider-aws/pkg/clients/sns"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errKubeUpdateFailed     = "cannot update SNSPlatformApplication custom resource"
+	errClient               = "cannot create a new SNSPlatformApplication client"
+	errUnexpectedObject     = "the managed resource is not a SNSPlatformApplication resource"
+	errGetAttr              = "failed to get SNS Platform Application Attribute"
+	errGetCredentialsSecret = "failed to get platform credentials secret"
+	errCreate               = "failed to create the SNS Platform Application"
+	errDelete               = "failed to delete the SNS Platform Application"
+	errUpdate               = "failed to update the SNS Platform Application"
+)
+
+// SetupSNSPlatformApplication adds a controller that reconciles
+// SNSPlatformApplication.
+func SetupSNSPlatformApplication(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.SNSPlatformApplicationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.SNSPlatformApplication{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SNSPlatformApplicationGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{
+				kube:        mgr.GetClient(),
+				newClientFn: snsclient.NewPlatformApplicationClient,
+				awsConfigFn: utils.RetrieveAwsConfigFromProvider,
+			}))))),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*aws.Config) (snsclient.PlatformApplicationClient, error)
+	awsConfigFn func(context.Context, client.Reader, runtimev1alpha1.Reference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha1.SNSPlatformApplication)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.kube, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+	return &external{c, conn.kube}, nil
+}
+
+type external struct {
+	client snsclient.PlatformApplicationClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.SNSPlatformApplication)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// Like topics, platform applications are identified by an ARN that is
+	// returned on create time.
+	if !awsarn.IsARN(meta.GetExternalName(cr)) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	res, err := e.client.GetPlatformApplicationAttributesRequest(&awssns.GetPlatformApplicationAttributesInput{
+		PlatformApplicationArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{},
+			errors.Wrap(resource.Ignore(snsclient.IsPlatformApplicationNotFound, err), errGetAttr)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	snsclient.LateInitializePlatformApplicationAttr(&cr.Spec.ForProvider, res.Attributes)
+	if !reflect.DeepEqual(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = snsclient.GeneratePlatformApplicationObservation(res.Attributes)
+	if aws.BoolValue(cr.Status.AtProvider.Enabled) {
+		cr.SetConditions(runtimev1alpha1.Available())
+	} else {
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: snsclient.IsSNSPlatformApplicationUpToDate(cr.Spec.ForProvider, res.Attributes),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.SNSPlatformApplication)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	credential, err := e.getSecretValue(ctx, cr.Spec.ForProvider.PlatformCredentialSecretRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetCredentialsSecret)
+	}
+	var principal *string
+	if ref := cr.Spec.ForProvider.PlatformPrincipalSecretRef; ref != nil {
+		v, err := e.getSecretValue(ctx, *ref)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetCredentialsSecret)
+		}
+		principal = aws.String(v)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	resp, err := e.client.CreatePlatformApplicationRequest(snsclient.GenerateCreatePlatformApplicationInput(&cr.Spec.ForProvider, credential, principal)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(resp.PlatformApplicationArn))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.SNSPlatformApplication)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.GetPlatformApplicationAttributesRequest(&awssns.GetPlatformApplicationAttributesInput{
+		PlatformApplicationArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetAttr)
+	}
+
+	// Unlike topics, all attributes of a platform application can be set in
+	// a single request.
+	_, err = e.client.SetPlatformApplicationAttributesRequest(&awssns.SetPlatformApplicationAttributesInput{
+		PlatformApplicationArn: aws.String(meta.GetExternalName(cr)),
+		Attributes:             snsclient.GetChangedPlatformApplicationAttributes(cr.Spec.ForProvider, resp.Attributes),
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.SNSPlatformApplication)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeletePlatformApplicationRequest(&awssns.DeletePlatformApplicationInput{
+		PlatformApplicationArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(snsclient.IsPlatformApplicationNotFound, err), errDelete)
+}
+
+func (e *external) getSecretValue(ctx context.Context, ref runtimev1alpha1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", err
+	}
+	return string(s.Data[ref.Key]), nil
+}
@@ -0,0 +1,431 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snsplatformapplication
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/sns"
+	"github.com/crossplane/provider-aws/pkg/clients/sns/fake"
+)
+
+var (
+	appName         = "some-app"
+	appARN          = "arn:aws:sns:ap-south-1:862356124505:app/GCM/some-app"
+	topicARN        = "arn:aws:sns:ap-south-1:862356124505:some-topic"
+	secretNamespace = "crossplane-system"
+	secretName      = "fcm"
+	errBoom         = errors.New("boom")
+)
+
+type args struct {
+	client sns.PlatformApplicationClient
+	kube   client.Client
+	cr     *v1alpha1.SNSPlatformApplication
+}
+
+type appModifier func(*v1alpha1.SNSPlatformApplication)
+
+func withARN(r *v1alpha1.SNSPlatformApplication) { meta.SetExternalName(r, appARN) }
+
+func withConditions(c ...corev1alpha1.Condition) appModifier {
+	return func(r *v1alpha1.SNSPlatformApplication) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.SNSPlatformApplicationObservation) appModifier {
+	return func(r *v1alpha1.SNSPlatformApplication) { r.Status.AtProvider = o }
+}
+
+func platformApplication(m ...appModifier) *v1alpha1.SNSPlatformApplication {
+	cr := &v1alpha1.SNSPlatformApplication{
+		Spec: v1alpha1.SNSPlatformApplicationSpec{
+			ForProvider: v1alpha1.SNSPlatformApplicationParameters{
+				Name:     appName,
+				Platform: "GCM",
+				PlatformCredentialSecretRef: corev1alpha1.SecretKeySelector{
+					SecretReference: corev1alpha1.SecretReference{Namespace: secretNamespace, Name: secretName},
+					Key:             "serverKey",
+				},
+				EventDeliveryFailure: aws.String(topicARN),
+			},
+		},
+	}
+	meta.SetExternalName(cr, appName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func getAttributes(attrs map[string]string, err error) func(*awssns.GetPlatformApplicationAttributesInput) awssns.GetPlatformApplicationAttributesRequest {
+	return func(*awssns.GetPlatformApplicationAttributesInput) awssns.GetPlatformApplicationAttributesRequest {
+		return awssns.GetPlatformApplicationAttributesRequest{
+			Request: request(&awssns.GetPlatformApplicationAttributesOutput{Attributes: attrs}, err),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SNSPlatformApplication
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotCreated": {
+			args: args{
+				cr: platformApplication(),
+			},
+			want: want{
+				cr: platformApplication(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockGetPlatformApplicationAttributesRequest: getAttributes(map[string]string{
+						string(sns.PlatformApplicationEnabled): "true",
+						string(sns.EventDeliveryFailure):       topicARN,
+					}, nil),
+				},
+				cr: platformApplication(withARN),
+			},
+			want: want{
+				cr: platformApplication(withARN,
+					withObservation(v1alpha1.SNSPlatformApplicationObservation{Enabled: aws.Bool(true)}),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Disabled": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockGetPlatformApplicationAttributesRequest: getAttributes(map[string]string{
+						string(sns.PlatformApplicationEnabled): "false",
+						string(sns.EventDeliveryFailure):       topicARN,
+					}, nil),
+				},
+				cr: platformApplication(withARN),
+			},
+			want: want{
+				cr: platformApplication(withARN,
+					withObservation(v1alpha1.SNSPlatformApplicationObservation{Enabled: aws.Bool(false)}),
+					withConditions(corev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AttributeChanged": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockGetPlatformApplicationAttributesRequest: getAttributes(map[string]string{
+						string(sns.PlatformApplicationEnabled): "true",
+					}, nil),
+				},
+				cr: platformApplication(withARN),
+			},
+			want: want{
+				cr: platformApplication(withARN,
+					withObservation(v1alpha1.SNSPlatformApplicationObservation{Enabled: aws.Bool(true)}),
+					withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockGetPlatformApplicationAttributesRequest: getAttributes(nil, awserr.New(awssns.ErrCodeNotFoundException, "", nil)),
+				},
+				cr: platformApplication(withARN),
+			},
+			want: want{
+				cr: platformApplication(withARN),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockGetPlatformApplicationAttributesRequest: getAttributes(nil, errBoom),
+				},
+				cr: platformApplication(withARN),
+			},
+			want: want{
+				cr:  platformApplication(withARN),
+				err: errors.Wrap(errBoom, errGetAttr),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SNSPlatformApplication
+		err error
+	}
+
+	secretGet := func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		if key != (client.ObjectKey{Namespace: secretNamespace, Name: secretName}) {
+			return errBoom
+		}
+		obj.(*corev1.Secret).Data = map[string][]byte{"serverKey": []byte("s3cr3t")}
+		return nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    secretGet,
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockPlatformApplicationClient{
+					MockCreatePlatformApplicationRequest: func(in *awssns.CreatePlatformApplicationInput) awssns.CreatePlatformApplicationRequest {
+						want := map[string]string{
+							string(sns.PlatformCredential):   "s3cr3t",
+							string(sns.EventDeliveryFailure): topicARN,
+						}
+						if diff := cmp.Diff(want, in.Attributes); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssns.CreatePlatformApplicationRequest{Request: request(&awssns.CreatePlatformApplicationOutput{
+							PlatformApplicationArn: aws.String(appARN),
+						}, nil)}
+					},
+				},
+				cr: platformApplication(),
+			},
+			want: want{
+				cr: platformApplication(withARN, withConditions(corev1alpha1.Creating())),
+			},
+		},
+		"FailedSecretGet": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: platformApplication(),
+			},
+			want: want{
+				cr:  platformApplication(),
+				err: errors.Wrap(errBoom, errGetCredentialsSecret),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: secretGet,
+				},
+				client: &fake.MockPlatformApplicationClient{
+					MockCreatePlatformApplicationRequest: func(*awssns.CreatePlatformApplicationInput) awssns.CreatePlatformApplicationRequest {
+						return awssns.CreatePlatformApplicationRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: platformApplication(),
+			},
+			want: want{
+				cr:  platformApplication(withConditions(corev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockGetPlatformApplicationAttributesRequest: getAttributes(map[string]string{}, nil),
+					MockSetPlatformApplicationAttributesRequest: func(in *awssns.SetPlatformApplicationAttributesInput) awssns.SetPlatformApplicationAttributesRequest {
+						if diff := cmp.Diff(map[string]string{string(sns.EventDeliveryFailure): topicARN}, in.Attributes); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssns.SetPlatformApplicationAttributesRequest{Request: request(&awssns.SetPlatformApplicationAttributesOutput{}, nil)}
+					},
+				},
+				cr: platformApplication(withARN),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockGetPlatformApplicationAttributesRequest: getAttributes(nil, errBoom),
+				},
+				cr: platformApplication(withARN),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetAttr),
+			},
+		},
+		"FailedSetRequest": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockGetPlatformApplicationAttributesRequest: getAttributes(map[string]string{}, nil),
+					MockSetPlatformApplicationAttributesRequest: func(*awssns.SetPlatformApplicationAttributesInput) awssns.SetPlatformApplicationAttributesRequest {
+						return awssns.SetPlatformApplicationAttributesRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: platformApplication(withARN),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SNSPlatformApplication
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockDeletePlatformApplicationRequest: func(*awssns.DeletePlatformApplicationInput) awssns.DeletePlatformApplicationRequest {
+						return awssns.DeletePlatformApplicationRequest{Request: request(&awssns.DeletePlatformApplicationOutput{}, nil)}
+					},
+				},
+				cr: platformApplication(withARN),
+			},
+			want: want{
+				cr: platformApplication(withARN, withConditions(corev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockPlatformApplicationClient{
+					MockDeletePlatformApplicationRequest: func(*awssns.DeletePlatformApplicationInput) awssns.DeletePlatformApplicationRequest {
+						return awssns.DeletePlatformApplicationRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: platformApplication(withARN),
+			},
+			want: want{
+				cr:  platformApplication(withARN, withConditions(corev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
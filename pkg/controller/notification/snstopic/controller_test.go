@@ -84,6 +84,10 @@ func withDeliveryPolicy(s *string) topicModifier {
 	return func(t *v1alpha1.SNSTopic) { t.Spec.ForProvider.DeliveryPolicy = s }
 }
 
+func withObservationARN(s string) topicModifier {
+	return func(t *v1alpha1.SNSTopic) { t.Status.AtProvider.ARN = &s }
+}
+
 func withObservationOwner(s *string) topicModifier {
 	return func(t *v1alpha1.SNSTopic) { t.Status.AtProvider.Owner = s }
 }
@@ -328,10 +332,10 @@ func TestObserve(t *testing.T) {
 				cr: topic(
 					withDisplayName(&topicDisplayName),
 					withTopicARN(&topicName),
-					withPolicy(&empty),
 					withDeliveryPolicy(&empty),
 					withKmsMasterKeyID(&empty),
 					withConditions(corev1alpha1.Available()),
+					withObservationARN(makeARN(topicName)),
 					withObservationOwner(&empty),
 				),
 				result: managed.ExternalObservation{
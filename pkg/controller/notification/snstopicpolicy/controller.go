@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snstopicpolicy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/sns"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+const (
+	errClient           = "cannot create a new SNSTopicPolicy client"
+	errUnexpectedObject = "the managed resource is not a SNSTopicPolicy resource"
+	errGetTopicAttr     = "failed to get SNS Topic Attribute"
+	errCreate           = "failed to create the SNS Topic Policy"
+	errDelete           = "failed to delete the SNS Topic Policy"
+	errUpdate           = "failed to update the SNS Topic Policy"
+)
+
+// SetupSNSTopicPolicy adds a controller that reconciles SNSTopicPolicy.
+func SetupSNSTopicPolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.SNSTopicPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.SNSTopicPolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SNSTopicPolicyGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{
+				kube:        mgr.GetClient(),
+				newClientFn: sns.NewTopicClient,
+				awsConfigFn: utils.RetrieveAwsConfigFromProvider,
+			}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*aws.Config) (sns.TopicClient, error)
+	awsConfigFn func(context.Context, client.Reader, runtimev1alpha1.Reference) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha1.SNSTopicPolicy)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.kube, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+	return &external{c, conn.kube}, nil
+}
+
+type external struct {
+	client sns.TopicClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.SNSTopicPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.GetTopicAttributesRequest(&awssns.GetTopicAttributesInput{
+		TopicArn: cr.Spec.ForProvider.TopicARN,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{},
+			errors.Wrap(resource.Ignore(sns.IsTopicNotFound, err), errGetTopicAttr)
+	}
+
+	// Every topic has a policy; the topic policy is considered to not exist
+	// as long as the topic has its default policy.
+	if sns.IsDefaultTopicPolicy(res.Attributes) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: sns.IsSNSTopicPolicyUpToDate(cr.Spec.ForProvider, res.Attributes),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.SNSTopicPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	return managed.ExternalCreation{}, errors.Wrap(e.setPolicy(ctx, cr, cr.Spec.ForProvider.Policy), errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.SNSTopicPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.setPolicy(ctx, cr, cr.Spec.ForProvider.Policy), errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.SNSTopicPolicy)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// A topic cannot be without a policy, so its default policy is restored.
+	err := e.setPolicy(ctx, cr, sns.DefaultTopicPolicy(aws.StringValue(cr.Spec.ForProvider.TopicARN)))
+	return errors.Wrap(resource.Ignore(sns.IsTopicNotFound, err), errDelete)
+}
+
+func (e *external) setPolicy(ctx context.Context, cr *v1alpha1.SNSTopicPolicy, policy string) error {
+	_, err := e.client.SetTopicAttributesRequest(&awssns.SetTopicAttributesInput{
+		AttributeName:  aws.String(string(sns.TopicPolicy)),
+		AttributeValue: aws.String(policy),
+		TopicArn:       cr.Spec.ForProvider.TopicARN,
+	}).Send(ctx)
+	return err
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snstopicpolicy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/sns"
+	"github.com/crossplane/provider-aws/pkg/clients/sns/fake"
+)
+
+const (
+	providerName = "some-topic"
+	testRegion   = "ap-south-1"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+	topicARN      = "arn:aws:sns:ap-south-1:123456789012:some-topic"
+	policy        = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::210987654321:root"}, "Action": "SNS:Subscribe", "Resource": "*"}]}`
+	errBoom       = errors.New("boom")
+	errNotFound   = awserr.New(awssns.ErrCodeNotFoundException, "not found", nil)
+)
+
+type args struct {
+	topic sns.TopicClient
+	kube  client.Client
+	cr    *v1alpha1.SNSTopicPolicy
+}
+
+type topicPolicyModifier func(*v1alpha1.SNSTopicPolicy)
+
+func withConditions(c ...corev1alpha1.Condition) topicPolicyModifier {
+	return func(r *v1alpha1.SNSTopicPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func topicPolicy(m ...topicPolicyModifier) *v1alpha1.SNSTopicPolicy {
+	cr := &v1alpha1.SNSTopicPolicy{
+		Spec: v1alpha1.SNSTopicPolicySpec{
+			ResourceSpec: corev1alpha1.ResourceSpec{
+				ProviderReference: corev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.SNSTopicPolicyParameters{
+				TopicARN: aws.String(topicARN),
+				Policy:   policy,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getAttributes(policy string, err error) func(*awssns.GetTopicAttributesInput) awssns.GetTopicAttributesRequest {
+	return func(*awssns.GetTopicAttributesInput) awssns.GetTopicAttributesRequest {
+		return awssns.GetTopicAttributesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awssns.GetTopicAttributesOutput{
+				Attributes: map[string]string{
+					string(sns.TopicArn):    topicARN,
+					string(sns.TopicPolicy): policy,
+				},
+			}},
+		}
+	}
+}
+
+func setAttributes(t *testing.T, want string, err error) func(*awssns.SetTopicAttributesInput) awssns.SetTopicAttributesRequest {
+	return func(in *awssns.SetTopicAttributesInput) awssns.SetTopicAttributesRequest {
+		if diff := cmp.Diff(want, aws.StringValue(in.AttributeValue)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awssns.SetTopicAttributesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssns.SetTopicAttributesOutput{}, Error: err},
+		}
+	}
+}
+
+func TestConnect(t *testing.T) {
+	type args struct {
+		newClientFn func(*aws.Config) (sns.TopicClient, error)
+		awsConfigFn func(context.Context, client.Reader, corev1alpha1.Reference) (*aws.Config, error)
+		cr          resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				newClientFn: func(config *aws.Config) (sns.TopicClient, error) {
+					if diff := cmp.Diff(testRegion, config.Region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				awsConfigFn: func(_ context.Context, _ client.Reader, p corev1alpha1.Reference) (*aws.Config, error) {
+					if diff := cmp.Diff(providerName, p.Name); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return &aws.Config{Region: testRegion}, nil
+				},
+				cr: topicPolicy(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ProviderFailure": {
+			args: args{
+				newClientFn: func(config *aws.Config) (sns.TopicClient, error) {
+					if diff := cmp.Diff(testRegion, config.Region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, errBoom
+				},
+				awsConfigFn: func(_ context.Context, _ client.Reader, p corev1alpha1.Reference) (*aws.Config, error) {
+					if diff := cmp.Diff(providerName, p.Name); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return &aws.Config{Region: testRegion}, nil
+				},
+				cr: topicPolicy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errClient),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{
+				newClientFn: tc.newClientFn,
+				awsConfigFn: tc.awsConfigFn,
+			}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got\n%s", diff)
+			}
+		})
+	}
+
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SNSTopicPolicy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				topic: &fake.MockTopicClient{
+					MockGetTopicAttributesRequest: getAttributes(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"SNS:Subscribe","Resource":"*"}]}`, nil),
+				},
+				cr: topicPolicy(),
+			},
+			want: want{
+				cr: topicPolicy(withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PolicyChanged": {
+			args: args{
+				topic: &fake.MockTopicClient{
+					MockGetTopicAttributesRequest: getAttributes(`{"Version":"2012-10-17","Statement":[]}`, nil),
+				},
+				cr: topicPolicy(),
+			},
+			want: want{
+				cr: topicPolicy(withConditions(corev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DefaultPolicy": {
+			args: args{
+				topic: &fake.MockTopicClient{
+					MockGetTopicAttributesRequest: getAttributes(sns.DefaultTopicPolicy(topicARN), nil),
+				},
+				cr: topicPolicy(),
+			},
+			want: want{
+				cr: topicPolicy(),
+			},
+		},
+		"TopicNotFound": {
+			args: args{
+				topic: &fake.MockTopicClient{
+					MockGetTopicAttributesRequest: getAttributes("", errNotFound),
+				},
+				cr: topicPolicy(),
+			},
+			want: want{
+				cr: topicPolicy(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				topic: &fake.MockTopicClient{
+					MockGetTopicAttributesRequest: getAttributes("", errBoom),
+				},
+				cr: topicPolicy(),
+			},
+			want: want{
+				cr:  topicPolicy(),
+				err: errors.Wrap(errBoom, errGetTopicAttr),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.topic, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SNSTopicPolicy
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				topic: &fake.MockTopicClient{MockSetTopicAttributesRequest: setAttributes(t, policy, nil)},
+				cr:    topicPolicy(),
+			},
+			want: want{
+				cr: topicPolicy(withConditions(corev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				topic: &fake.MockTopicClient{MockSetTopicAttributesRequest: setAttributes(t, policy, errBoom)},
+				cr:    topicPolicy(),
+			},
+			want: want{
+				cr:  topicPolicy(withConditions(corev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.topic, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SNSTopicPolicy
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				topic: &fake.MockTopicClient{MockSetTopicAttributesRequest: setAttributes(t, policy, nil)},
+				cr:    topicPolicy(),
+			},
+			want: want{
+				cr: topicPolicy(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				topic: &fake.MockTopicClient{MockSetTopicAttributesRequest: setAttributes(t, policy, errBoom)},
+				cr:    topicPolicy(),
+			},
+			want: want{
+				cr:  topicPolicy(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.topic, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SNSTopicPolicy
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				topic: &fake.MockTopicClient{MockSetTopicAttributesRequest: setAttributes(t, sns.DefaultTopicPolicy(topicARN), nil)},
+				cr:    topicPolicy(),
+			},
+			want: want{
+				cr: topicPolicy(withConditions(corev1alpha1.Deleting())),
+			},
+		},
+		"TopicNotFound": {
+			args: args{
+				topic: &fake.MockTopicClient{MockSetTopicAttributesRequest: setAttributes(t, sns.DefaultTopicPolicy(topicARN), errNotFound)},
+				cr:    topicPolicy(),
+			},
+			want: want{
+				cr: topicPolicy(withConditions(corev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				topic: &fake.MockTopicClient{MockSetTopicAttributesRequest: setAttributes(t, sns.DefaultTopicPolicy(topicARN), errBoom)},
+				cr:    topicPolicy(),
+			},
+			want: want{
+				cr:  topicPolicy(withConditions(corev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.topic, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
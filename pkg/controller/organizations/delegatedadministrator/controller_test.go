@@ -0,0 +1,518 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delegatedadministrator
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsorganizations "github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	accountID        = "123456789012"
+	servicePrincipal = "securityhub.amazonaws.com"
+	enabled          = time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+	errBoom          = errors.New("boom")
+	errNotFound      = awserr.New(awsorganizations.ErrCodeAccountNotRegisteredException, "not registered", nil)
+)
+
+type args struct {
+	client organizations.DelegatedAdministratorClient
+	kube   client.Client
+	cr     *v1alpha1.DelegatedAdministrator
+}
+
+type administratorModifier func(*v1alpha1.DelegatedAdministrator)
+
+func withConditions(c ...runtimev1alpha1.Condition) administratorModifier {
+	return func(r *v1alpha1.DelegatedAdministrator) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.DelegatedAdministratorObservation) administratorModifier {
+	return func(r *v1alpha1.DelegatedAdministrator) { r.Status.AtProvider = o }
+}
+
+func administrator(m ...administratorModifier) *v1alpha1.DelegatedAdministrator {
+	cr := &v1alpha1.DelegatedAdministrator{
+		Spec: v1alpha1.DelegatedAdministratorSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DelegatedAdministratorParameters{
+				AccountID:        accountID,
+				ServicePrincipal: servicePrincipal,
+			},
+		},
+	}
+	meta.SetExternalName(cr, "administrator")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listServices(services ...awsorganizations.DelegatedService) func(*awsorganizations.ListDelegatedServicesForAccountInput) awsorganizations.ListDelegatedServicesForAccountRequest {
+	return func(in *awsorganizations.ListDelegatedServicesForAccountInput) awsorganizations.ListDelegatedServicesForAccountRequest {
+		// Return one service per page to exercise pagination.
+		out := &awsorganizations.ListDelegatedServicesForAccountOutput{}
+		i := 0
+		if in.NextToken != nil {
+			i = len(aws.StringValue(in.NextToken))
+		}
+		if i < len(services) {
+			out.DelegatedServices = services[i : i+1]
+		}
+		if i+1 < len(services) {
+			out.NextToken = aws.String(strings.Repeat("n", i+1))
+		}
+		return awsorganizations.ListDelegatedServicesForAccountRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: out},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (organizations.DelegatedAdministratorClient, error)
+		cr          *v1alpha1.DelegatedAdministrator
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i organizations.DelegatedAdministratorClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: administrator(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i organizations.DelegatedAdministratorClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: administrator(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DelegatedAdministrator
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Registered": {
+			args: args{
+				client: &fake.MockDelegatedAdministratorClient{
+					MockListDelegatedServicesForAccount: listServices(
+						awsorganizations.DelegatedService{ServicePrincipal: aws.String("guardduty.amazonaws.com")},
+						awsorganizations.DelegatedService{
+							DelegationEnabledDate: &enabled,
+							ServicePrincipal:      aws.String(servicePrincipal),
+						},
+					),
+				},
+				cr: administrator(),
+			},
+			want: want{
+				cr: administrator(
+					withObservation(v1alpha1.DelegatedAdministratorObservation{DelegationEnabledDate: &metav1.Time{Time: enabled}}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotDelegatedForService": {
+			args: args{
+				client: &fake.MockDelegatedAdministratorClient{
+					MockListDelegatedServicesForAccount: listServices(
+						awsorganizations.DelegatedService{ServicePrincipal: aws.String("guardduty.amazonaws.com")},
+					),
+				},
+				cr: administrator(),
+			},
+			want: want{
+				cr: administrator(),
+			},
+		},
+		"NotRegistered": {
+			args: args{
+				client: &fake.MockDelegatedAdministratorClient{
+					MockListDelegatedServicesForAccount: func(*awsorganizations.ListDelegatedServicesForAccountInput) awsorganizations.ListDelegatedServicesForAccountRequest {
+						return awsorganizations.ListDelegatedServicesForAccountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				cr: administrator(),
+			},
+		},
+		"FailedListRequest": {
+			args: args{
+				client: &fake.MockDelegatedAdministratorClient{
+					MockListDelegatedServicesForAccount: func(*awsorganizations.ListDelegatedServicesForAccountInput) awsorganizations.ListDelegatedServicesForAccountRequest {
+						return awsorganizations.ListDelegatedServicesForAccountRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				cr:  administrator(),
+				err: errors.Wrap(errBoom, errList),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DelegatedAdministrator
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDelegatedAdministratorClient{
+					MockRegisterDelegatedAdministrator: func(*awsorganizations.RegisterDelegatedAdministratorInput) awsorganizations.RegisterDelegatedAdministratorRequest {
+						return awsorganizations.RegisterDelegatedAdministratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.RegisterDelegatedAdministratorOutput{}},
+						}
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				cr: administrator(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDelegatedAdministratorClient{
+					MockRegisterDelegatedAdministrator: func(*awsorganizations.RegisterDelegatedAdministratorInput) awsorganizations.RegisterDelegatedAdministratorRequest {
+						return awsorganizations.RegisterDelegatedAdministratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				cr:  administrator(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errRegister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DelegatedAdministrator
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDelegatedAdministratorClient{
+					MockDeregisterDelegatedAdministrator: func(*awsorganizations.DeregisterDelegatedAdministratorInput) awsorganizations.DeregisterDelegatedAdministratorRequest {
+						return awsorganizations.DeregisterDelegatedAdministratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsorganizations.DeregisterDelegatedAdministratorOutput{}},
+						}
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				cr: administrator(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotRegistered": {
+			args: args{
+				client: &fake.MockDelegatedAdministratorClient{
+					MockDeregisterDelegatedAdministrator: func(*awsorganizations.DeregisterDelegatedAdministratorInput) awsorganizations.DeregisterDelegatedAdministratorRequest {
+						return awsorganizations.DeregisterDelegatedAdministratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				cr: administrator(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDelegatedAdministratorClient{
+					MockDeregisterDelegatedAdministrator: func(*awsorganizations.DeregisterDelegatedAdministratorInput) awsorganizations.DeregisterDelegatedAdministratorRequest {
+						return awsorganizations.DeregisterDelegatedAdministratorRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: administrator(),
+			},
+			want: want{
+				cr:  administrator(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDeregister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
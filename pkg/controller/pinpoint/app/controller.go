@@ -0,0 +1,304 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awspinpoint "github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/pinpoint/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/pinpoint"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an Pinpoint App resource"
+	errCreateClient      = "cannot create Pinpoint client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the Pinpoint App custom resource"
+
+	errDescribe = "cannot describe Pinpoint App"
+	errCreate   = "cannot create Pinpoint App"
+	errUpdate   = "cannot update Pinpoint App"
+	errDelete   = "cannot delete Pinpoint App"
+
+	errGetSettings          = "cannot get Pinpoint App settings"
+	errGetGCM               = "cannot get Pinpoint App GCM channel"
+	errGetAPNS              = "cannot get Pinpoint App APNs channel"
+	errUpdateGCM            = "cannot update Pinpoint App GCM channel"
+	errUpdateAPNS           = "cannot update Pinpoint App APNs channel"
+	errGetCredentialsSecret = "cannot get channel credentials secret"
+)
+
+// SetupApp adds a controller that reconciles Pinpoint Apps.
+func SetupApp(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AppGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.App{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AppGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: pinpoint.NewAppClient}))))),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (pinpoint.AppClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.App)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client pinpoint.AppClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.App)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.GetAppRequest(&awspinpoint.GetAppInput{
+		ApplicationId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(pinpoint.IsNotFound, err), errDescribe)
+	}
+	settings, err := e.client.GetApplicationSettingsRequest(&awspinpoint.GetApplicationSettingsInput{
+		ApplicationId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSettings)
+	}
+	gcm, apns, err := e.getChannels(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	pinpoint.LateInitializeApp(&cr.Spec.ForProvider, *settings.ApplicationSettingsResource, gcm, apns)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = pinpoint.GenerateAppObservation(*rsp.ApplicationResponse, gcm, apns)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: pinpoint.IsSettingsUpToDate(cr.Spec.ForProvider, *settings.ApplicationSettingsResource) &&
+			pinpoint.IsGCMChannelUpToDate(cr.Spec.ForProvider.GCMChannel, gcm) &&
+			pinpoint.IsAPNSChannelUpToDate(cr.Spec.ForProvider.APNSChannel, apns),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.App)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The settings and channels of the app are applied by the first Update.
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateAppRequest(pinpoint.GenerateCreateAppInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.ApplicationResponse.Id))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.App)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := aws.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider
+
+	if p.QuietTime != nil || p.Limits != nil {
+		if _, err := e.client.UpdateApplicationSettingsRequest(&awspinpoint.UpdateApplicationSettingsInput{
+			ApplicationId:                   id,
+			WriteApplicationSettingsRequest: pinpoint.GenerateWriteApplicationSettingsRequest(p),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	gcm, apns, err := e.getChannels(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	switch {
+	case p.GCMChannel == nil && gcm != nil:
+		if _, err := e.client.DeleteGcmChannelRequest(&awspinpoint.DeleteGcmChannelInput{ApplicationId: id}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(pinpoint.IsNotFound, err), errUpdateGCM)
+		}
+	case !pinpoint.IsGCMChannelUpToDate(p.GCMChannel, gcm):
+		key, err := e.getSecretValue(ctx, &p.GCMChannel.APIKeySecretRef)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGetCredentialsSecret)
+		}
+		if _, err := e.client.UpdateGcmChannelRequest(&awspinpoint.UpdateGcmChannelInput{
+			ApplicationId:     id,
+			GCMChannelRequest: pinpoint.GenerateGCMChannelRequest(*p.GCMChannel, aws.StringValue(key)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateGCM)
+		}
+	}
+
+	switch {
+	case p.APNSChannel == nil && apns != nil:
+		if _, err := e.client.DeleteApnsChannelRequest(&awspinpoint.DeleteApnsChannelInput{ApplicationId: id}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(pinpoint.IsNotFound, err), errUpdateAPNS)
+		}
+	case !pinpoint.IsAPNSChannelUpToDate(p.APNSChannel, apns):
+		c := p.APNSChannel
+		var creds [3]*string
+		for i, ref := range []*runtimev1alpha1.SecretKeySelector{c.CertificateSecretRef, c.PrivateKeySecretRef, c.TokenKeySecretRef} {
+			if creds[i], err = e.getSecretValue(ctx, ref); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errGetCredentialsSecret)
+			}
+		}
+		if _, err := e.client.UpdateApnsChannelRequest(&awspinpoint.UpdateApnsChannelInput{
+			ApplicationId:      id,
+			APNSChannelRequest: pinpoint.GenerateAPNSChannelRequest(*c, creds[0], creds[1], creds[2]),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAPNS)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.App)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteAppRequest(&awspinpoint.DeleteAppInput{
+		ApplicationId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(pinpoint.IsNotFound, err), errDelete)
+}
+
+// getChannels returns the push notification channels of the app with the
+// supplied ID. A channel that does not exist is returned as nil.
+func (e *external) getChannels(ctx context.Context, id string) (*awspinpoint.GCMChannelResponse, *awspinpoint.APNSChannelResponse, error) {
+	var gcm *awspinpoint.GCMChannelResponse
+	g, err := e.client.GetGcmChannelRequest(&awspinpoint.GetGcmChannelInput{ApplicationId: aws.String(id)}).Send(ctx)
+	if resource.Ignore(pinpoint.IsNotFound, err) != nil {
+		return nil, nil, errors.Wrap(err, errGetGCM)
+	}
+	if err == nil && !aws.BoolValue(g.GCMChannelResponse.IsArchived) {
+		gcm = g.GCMChannelResponse
+	}
+
+	var apns *awspinpoint.APNSChannelResponse
+	a, err := e.client.GetApnsChannelRequest(&awspinpoint.GetApnsChannelInput{ApplicationId: aws.String(id)}).Send(ctx)
+	if resource.Ignore(pinpoint.IsNotFound, err) != nil {
+		return nil, nil, errors.Wrap(err, errGetAPNS)
+	}
+	if err == nil && !aws.BoolValue(a.APNSChannelResponse.IsArchived) {
+		apns = a.APNSChannelResponse
+	}
+	return gcm, apns, nil
+}
+
+// getSecretValue returns the value of the referenced secret key, or nil if
+// no secret is referenced.
+func (e *external) getSecretValue(ctx context.Context, ref *runtimev1alpha1.SecretKeySelector) (*string, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, err
+	}
+	return aws.String(string(s.Data[ref.Key])), nil
+}
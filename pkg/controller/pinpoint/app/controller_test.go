@@ -0,0 +1,723 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awspinpoint "github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/pinpoint/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/pinpoint"
+	"github.com/crossplane/provider-aws/pkg/clients/pinpoint/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	appID   = "6e8b4c3a5d1f"
+	appName = "my-mobile-app"
+	errBoom = errors.New("boom")
+
+	errNotFound = awserr.New(awspinpoint.ErrCodeNotFoundException, "", nil)
+)
+
+type args struct {
+	client pinpoint.AppClient
+	kube   client.Client
+	cr     *v1alpha1.App
+}
+
+type appModifier func(*v1alpha1.App)
+
+func withExternalName(n string) appModifier {
+	return func(r *v1alpha1.App) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) appModifier {
+	return func(r *v1alpha1.App) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.AppObservation) appModifier {
+	return func(r *v1alpha1.App) { r.Status.AtProvider = o }
+}
+
+func withGCMChannel(r *v1alpha1.App) {
+	r.Spec.ForProvider.GCMChannel = &v1alpha1.GCMChannel{
+		Enabled: aws.Bool(true),
+		APIKeySecretRef: runtimev1alpha1.SecretKeySelector{
+			SecretReference: runtimev1alpha1.SecretReference{Namespace: secretNamespace, Name: "fcm"},
+			Key:             "serverKey",
+		},
+	}
+}
+
+func app(m ...appModifier) *v1alpha1.App {
+	cr := &v1alpha1.App{
+		Spec: v1alpha1.AppSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.AppParameters{
+				Name:      appName,
+				QuietTime: &v1alpha1.QuietTime{Start: "22:00", End: "07:00"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(o *awspinpoint.GetAppOutput, err error) func(*awspinpoint.GetAppInput) awspinpoint.GetAppRequest {
+	return func(*awspinpoint.GetAppInput) awspinpoint.GetAppRequest {
+		return awspinpoint.GetAppRequest{Request: request(o, err)}
+	}
+}
+
+func getSettings(s *awspinpoint.ApplicationSettingsResource) func(*awspinpoint.GetApplicationSettingsInput) awspinpoint.GetApplicationSettingsRequest {
+	return func(*awspinpoint.GetApplicationSettingsInput) awspinpoint.GetApplicationSettingsRequest {
+		return awspinpoint.GetApplicationSettingsRequest{Request: request(&awspinpoint.GetApplicationSettingsOutput{ApplicationSettingsResource: s}, nil)}
+	}
+}
+
+func getGCM(c *awspinpoint.GCMChannelResponse, err error) func(*awspinpoint.GetGcmChannelInput) awspinpoint.GetGcmChannelRequest {
+	return func(*awspinpoint.GetGcmChannelInput) awspinpoint.GetGcmChannelRequest {
+		return awspinpoint.GetGcmChannelRequest{Request: request(&awspinpoint.GetGcmChannelOutput{GCMChannelResponse: c}, err)}
+	}
+}
+
+func getAPNS(c *awspinpoint.APNSChannelResponse, err error) func(*awspinpoint.GetApnsChannelInput) awspinpoint.GetApnsChannelRequest {
+	return func(*awspinpoint.GetApnsChannelInput) awspinpoint.GetApnsChannelRequest {
+		return awspinpoint.GetApnsChannelRequest{Request: request(&awspinpoint.GetApnsChannelOutput{APNSChannelResponse: c}, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (pinpoint.AppClient, error)
+		cr          *v1alpha1.App
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i pinpoint.AppClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: app(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i pinpoint.AppClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: app(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.App
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awspinpoint.ApplicationResponse{
+		Id:   aws.String(appID),
+		Arn:  aws.String("arn"),
+		Name: aws.String(appName),
+	}
+	settings := &awspinpoint.ApplicationSettingsResource{
+		ApplicationId: aws.String(appID),
+		QuietTime:     &awspinpoint.QuietTime{Start: aws.String("22:00"), End: aws.String("07:00")},
+	}
+	gcm := &awspinpoint.GCMChannelResponse{Enabled: aws.Bool(true), HasCredential: aws.Bool(true)}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: app(),
+			},
+			want: want{
+				cr: app(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp:                 get(&awspinpoint.GetAppOutput{ApplicationResponse: observed}, nil),
+					MockGetApplicationSettings: getSettings(settings),
+					MockGetGcmChannel:          getGCM(nil, errNotFound),
+					MockGetApnsChannel:         getAPNS(nil, errNotFound),
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(
+					withExternalName(appID),
+					withStatus(v1alpha1.AppObservation{ARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"GCMChannelEnabled": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp:                 get(&awspinpoint.GetAppOutput{ApplicationResponse: observed}, nil),
+					MockGetApplicationSettings: getSettings(settings),
+					MockGetGcmChannel:          getGCM(gcm, nil),
+					MockGetApnsChannel:         getAPNS(nil, errNotFound),
+				},
+				cr: app(withExternalName(appID), withGCMChannel),
+			},
+			want: want{
+				cr: app(
+					withExternalName(appID),
+					withGCMChannel,
+					withStatus(v1alpha1.AppObservation{
+						ARN:        "arn",
+						GCMChannel: &v1alpha1.ChannelObservation{Enabled: true, HasCredential: true},
+					}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"GCMChannelMissing": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp:                 get(&awspinpoint.GetAppOutput{ApplicationResponse: observed}, nil),
+					MockGetApplicationSettings: getSettings(settings),
+					MockGetGcmChannel:          getGCM(nil, errNotFound),
+					MockGetApnsChannel:         getAPNS(nil, errNotFound),
+				},
+				cr: app(withExternalName(appID), withGCMChannel),
+			},
+			want: want{
+				cr: app(
+					withExternalName(appID),
+					withGCMChannel,
+					withStatus(v1alpha1.AppObservation{ARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"QuietTimeChanged": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp:                 get(&awspinpoint.GetAppOutput{ApplicationResponse: observed}, nil),
+					MockGetApplicationSettings: getSettings(&awspinpoint.ApplicationSettingsResource{ApplicationId: aws.String(appID)}),
+					MockGetGcmChannel:          getGCM(nil, errNotFound),
+					MockGetApnsChannel:         getAPNS(nil, errNotFound),
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(
+					withExternalName(appID),
+					withStatus(v1alpha1.AppObservation{ARN: "arn"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp: get(nil, errNotFound),
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp: get(nil, errBoom),
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr:  app(withExternalName(appID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedGetChannel": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockGetApp:                 get(&awspinpoint.GetAppOutput{ApplicationResponse: observed}, nil),
+					MockGetApplicationSettings: getSettings(settings),
+					MockGetGcmChannel:          getGCM(nil, errBoom),
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr:  app(withExternalName(appID)),
+				err: errors.Wrap(errBoom, errGetGCM),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.App
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockAppClient{
+					MockCreateApp: func(in *awspinpoint.CreateAppInput) awspinpoint.CreateAppRequest {
+						if diff := cmp.Diff(appName, aws.StringValue(in.CreateApplicationRequest.Name)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awspinpoint.CreateAppRequest{Request: request(&awspinpoint.CreateAppOutput{
+							ApplicationResponse: &awspinpoint.ApplicationResponse{Id: aws.String(appID)},
+						}, nil)}
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockCreateApp: func(*awspinpoint.CreateAppInput) awspinpoint.CreateAppRequest {
+						return awspinpoint.CreateAppRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: app(),
+			},
+			want: want{
+				cr:  app(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.App
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	updateSettings := func(*awspinpoint.UpdateApplicationSettingsInput) awspinpoint.UpdateApplicationSettingsRequest {
+		return awspinpoint.UpdateApplicationSettingsRequest{Request: request(&awspinpoint.UpdateApplicationSettingsOutput{}, nil)}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"CreateGCMChannel": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key != (client.ObjectKey{Namespace: secretNamespace, Name: "fcm"}) {
+							return errBoom
+						}
+						obj.(*corev1.Secret).Data = map[string][]byte{"serverKey": []byte("s3cr3t")}
+						return nil
+					},
+				},
+				client: &fake.MockAppClient{
+					MockUpdateApplicationSettings: updateSettings,
+					MockGetGcmChannel:             getGCM(nil, errNotFound),
+					MockGetApnsChannel:            getAPNS(nil, errNotFound),
+					MockUpdateGcmChannel: func(in *awspinpoint.UpdateGcmChannelInput) awspinpoint.UpdateGcmChannelRequest {
+						if diff := cmp.Diff("s3cr3t", aws.StringValue(in.GCMChannelRequest.ApiKey)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awspinpoint.UpdateGcmChannelRequest{Request: request(&awspinpoint.UpdateGcmChannelOutput{}, nil)}
+					},
+				},
+				cr: app(withExternalName(appID), withGCMChannel),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withGCMChannel),
+			},
+		},
+		"DeleteAPNSChannel": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockUpdateApplicationSettings: updateSettings,
+					MockGetGcmChannel:             getGCM(nil, errNotFound),
+					MockGetApnsChannel:            getAPNS(&awspinpoint.APNSChannelResponse{Enabled: aws.Bool(true)}, nil),
+					MockDeleteApnsChannel: func(*awspinpoint.DeleteApnsChannelInput) awspinpoint.DeleteApnsChannelRequest {
+						return awspinpoint.DeleteApnsChannelRequest{Request: request(&awspinpoint.DeleteApnsChannelOutput{}, nil)}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID)),
+			},
+		},
+		"FailedSecretGet": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				client: &fake.MockAppClient{
+					MockUpdateApplicationSettings: updateSettings,
+					MockGetGcmChannel:             getGCM(nil, errNotFound),
+					MockGetApnsChannel:            getAPNS(nil, errNotFound),
+				},
+				cr: app(withExternalName(appID), withGCMChannel),
+			},
+			want: want{
+				cr:  app(withExternalName(appID), withGCMChannel),
+				err: errors.Wrap(errBoom, errGetCredentialsSecret),
+			},
+		},
+		"FailedSettingsRequest": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockUpdateApplicationSettings: func(*awspinpoint.UpdateApplicationSettingsInput) awspinpoint.UpdateApplicationSettingsRequest {
+						return awspinpoint.UpdateApplicationSettingsRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr:  app(withExternalName(appID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.App
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockDeleteApp: func(*awspinpoint.DeleteAppInput) awspinpoint.DeleteAppRequest {
+						return awspinpoint.DeleteAppRequest{Request: request(&awspinpoint.DeleteAppOutput{}, nil)}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockDeleteApp: func(*awspinpoint.DeleteAppInput) awspinpoint.DeleteAppRequest {
+						return awspinpoint.DeleteAppRequest{Request: request(nil, errNotFound)}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr: app(withExternalName(appID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAppClient{
+					MockDeleteApp: func(*awspinpoint.DeleteAppInput) awspinpoint.DeleteAppRequest {
+						return awspinpoint.DeleteAppRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: app(withExternalName(appID)),
+			},
+			want: want{
+				cr:  app(withExternalName(appID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package provider reconciles the status of AWS Providers.
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sts"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	reconcileTimeout = 1 * time.Minute
+
+	// Credentials are checked again periodically because secrets may be
+	// rotated and roles may be changed without the Provider changing.
+	shortWait = 30 * time.Second
+	longWait  = 10 * time.Minute
+
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errRegion            = "invalid provider region"
+	errCreateClient      = "cannot create STS client"
+	errIdentify          = "cannot identify the credentials of the provider"
+	errUpdateStatus      = "cannot update status of the provider"
+)
+
+// SetupProvider adds a controller that publishes the AWS account identity of
+// the credentials of each Provider, and whether AWS accepts them, to its
+// status.
+func SetupProvider(mgr ctrl.Manager, l logging.Logger) error {
+	name := "provider/" + strings.ToLower(awsv1alpha3.ProviderGroupKind)
+
+	r := &Reconciler{
+		kube:        mgr.GetClient(),
+		newClientFn: sts.NewCallerIdentityClient,
+		log:         l.WithValues("controller", name),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&awsv1alpha3.Provider{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// A Reconciler identifies the credentials of Providers.
+type Reconciler struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (sts.CallerIdentityClient, error)
+	log         logging.Logger
+}
+
+// Reconcile calls GetCallerIdentity with the credentials of a Provider and
+// records the result in its status.
+func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+
+	p := &awsv1alpha3.Provider{}
+	if err := r.kube.Get(ctx, req.NamespacedName, p); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProvider)
+	}
+
+	o, err := r.identify(ctx, p)
+	if err != nil {
+		log.Debug("Cannot identify the credentials of the provider", "error", err)
+		p.Status.SetConditions(awsv1alpha3.Unhealthy(err))
+		return reconcile.Result{RequeueAfter: shortWait}, errors.Wrap(r.kube.Status().Update(ctx, p), errUpdateStatus)
+	}
+
+	sts.GenerateProviderIdentity(*o, &p.Status)
+	p.Status.SetConditions(awsv1alpha3.Healthy())
+	return reconcile.Result{RequeueAfter: longWait}, errors.Wrap(r.kube.Status().Update(ctx, p), errUpdateStatus)
+}
+
+func (r *Reconciler) identify(ctx context.Context, p *awsv1alpha3.Provider) (*awssts.GetCallerIdentityOutput, error) {
+	if err := awsclients.ValidateRegion(p.Spec.Region); err != nil {
+		return nil, errors.Wrap(err, errRegion)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, r.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	var c sts.CallerIdentityClient
+	switch {
+	case aws.BoolValue(p.Spec.UseServiceAccount):
+		c, err = r.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+	case p.GetCredentialsSecretReference() == nil:
+		return nil, errors.New(errGetProviderSecret)
+	default:
+		ref := p.Spec.CredentialsSecretRef
+		s := &corev1.Secret{}
+		if err := r.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, errors.Wrap(err, errGetProviderSecret)
+		}
+		c, err = r.newClientFn(ctx, s.Data[ref.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateClient)
+	}
+
+	rsp, err := c.GetCallerIdentityRequest(&awssts.GetCallerIdentityInput{}).Send(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errIdentify)
+	}
+	return rsp.GetCallerIdentityOutput, nil
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sts"
+	"github.com/crossplane/provider-aws/pkg/clients/sts/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+
+	accountID = "123456789012"
+	arn       = "arn:aws-cn:sts::123456789012:assumed-role/crossplane/session"
+)
+
+var errBoom = errors.New("boom")
+
+type providerModifier func(*awsv1alpha3.Provider)
+
+func withServiceAccount() providerModifier {
+	return func(p *awsv1alpha3.Provider) { p.Spec.UseServiceAccount = aws.Bool(true) }
+}
+
+func withoutSecretRef() providerModifier {
+	return func(p *awsv1alpha3.Provider) { p.SetCredentialsSecretReference(nil) }
+}
+
+func withRegion(r string) providerModifier {
+	return func(p *awsv1alpha3.Provider) { p.Spec.Region = r }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) providerModifier {
+	return func(p *awsv1alpha3.Provider) { p.Status.SetConditions(c...) }
+}
+
+func withIdentity(account, arn, partition string) providerModifier {
+	return func(p *awsv1alpha3.Provider) {
+		p.Status.AccountID = account
+		p.Status.ARN = arn
+		p.Status.Partition = partition
+	}
+}
+
+func provider(m ...providerModifier) *awsv1alpha3.Provider {
+	p := &awsv1alpha3.Provider{
+		ObjectMeta: metav1.ObjectMeta{Name: providerName},
+		Spec: awsv1alpha3.ProviderSpec{
+			Region: testRegion,
+			ProviderSpec: runtimev1alpha1.ProviderSpec{
+				CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+					SecretReference: runtimev1alpha1.SecretReference{
+						Namespace: secretNamespace,
+						Name:      connectionSecretName,
+					},
+					Key: secretKey,
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func getProvider(p *awsv1alpha3.Provider) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		switch key {
+		case client.ObjectKey{Name: providerName}:
+			p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+			return nil
+		case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+			obj.(*corev1.Secret).Data = map[string][]byte{secretKey: []byte(credData)}
+			return nil
+		}
+		return errBoom
+	}
+}
+
+func newClient(wantCreds string, o *awssts.GetCallerIdentityOutput, err error) func(context.Context, []byte, string, awsclients.AuthMethod) (sts.CallerIdentityClient, error) {
+	return func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (sts.CallerIdentityClient, error) {
+		if string(credentials) != wantCreds || region != testRegion {
+			return nil, errBoom
+		}
+		return &fake.MockCallerIdentityClient{
+			MockGetCallerIdentity: func(*awssts.GetCallerIdentityInput) awssts.GetCallerIdentityRequest {
+				return awssts.GetCallerIdentityRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: o, Error: err},
+				}
+			},
+		}, nil
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	identity := &awssts.GetCallerIdentityOutput{Account: aws.String(accountID), Arn: aws.String(arn)}
+
+	type args struct {
+		kube        client.Client
+		newClientFn func(context.Context, []byte, string, awsclients.AuthMethod) (sts.CallerIdentityClient, error)
+	}
+	type want struct {
+		p      *awsv1alpha3.Provider
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Healthy": {
+			args: args{
+				kube:        &test.MockClient{MockGet: getProvider(provider())},
+				newClientFn: newClient(credData, identity, nil),
+			},
+			want: want{
+				p:      provider(withIdentity(accountID, arn, "aws-cn"), withConditions(awsv1alpha3.Healthy())),
+				result: reconcile.Result{RequeueAfter: longWait},
+			},
+		},
+		"HealthyUseServiceAccount": {
+			args: args{
+				kube:        &test.MockClient{MockGet: getProvider(provider(withServiceAccount()))},
+				newClientFn: newClient("", identity, nil),
+			},
+			want: want{
+				p:      provider(withServiceAccount(), withIdentity(accountID, arn, "aws-cn"), withConditions(awsv1alpha3.Healthy())),
+				result: reconcile.Result{RequeueAfter: longWait},
+			},
+		},
+		"InvalidCredentials": {
+			args: args{
+				kube:        &test.MockClient{MockGet: getProvider(provider())},
+				newClientFn: newClient(credData, nil, errBoom),
+			},
+			want: want{
+				p:      provider(withConditions(awsv1alpha3.Unhealthy(errors.Wrap(errBoom, errIdentify)))),
+				result: reconcile.Result{RequeueAfter: shortWait},
+			},
+		},
+		"InvalidRegion": {
+			args: args{
+				kube: &test.MockClient{MockGet: getProvider(provider(withRegion("moon-central-1")))},
+			},
+			want: want{
+				p:      provider(withRegion("moon-central-1"), withConditions(awsv1alpha3.Unhealthy(errors.Wrap(awsclients.ValidateRegion("moon-central-1"), errRegion)))),
+				result: reconcile.Result{RequeueAfter: shortWait},
+			},
+		},
+		"NoSecretRef": {
+			args: args{
+				kube: &test.MockClient{MockGet: getProvider(provider(withoutSecretRef()))},
+			},
+			want: want{
+				p:      provider(withoutSecretRef(), withConditions(awsv1alpha3.Unhealthy(errors.New(errGetProviderSecret)))),
+				result: reconcile.Result{RequeueAfter: shortWait},
+			},
+		},
+		"ProviderNotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, providerName))},
+			},
+			want: want{},
+		},
+		"FailedGetProvider": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *awsv1alpha3.Provider
+			if mc, ok := tc.kube.(*test.MockClient); ok {
+				mc.MockStatusUpdate = func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					got = obj.(*awsv1alpha3.Provider)
+					return nil
+				}
+			}
+			r := &Reconciler{kube: tc.kube, newClientFn: tc.newClientFn, log: logging.NewNopLogger()}
+			result, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: providerName}})
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.p, got, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,540 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package journalkinesisstream
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsqldb "github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/qldb"
+	"github.com/crossplane/provider-aws/pkg/clients/qldb/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	ledgerName = "audit"
+	streamID   = "7ISCkqwe4y25YyHLzYUFAf"
+	deletedAt  = metav1.Now()
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client qldb.JournalKinesisStreamClient
+	kube   client.Client
+	cr     *v1alpha1.JournalKinesisStream
+}
+
+type streamModifier func(*v1alpha1.JournalKinesisStream)
+
+func withConditions(c ...runtimev1alpha1.Condition) streamModifier {
+	return func(r *v1alpha1.JournalKinesisStream) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) streamModifier {
+	return func(r *v1alpha1.JournalKinesisStream) { meta.SetExternalName(r, s) }
+}
+
+func withStatus(s string) streamModifier {
+	return func(r *v1alpha1.JournalKinesisStream) { r.Status.AtProvider.Status = s }
+}
+
+func withAggregation(b bool) streamModifier {
+	return func(r *v1alpha1.JournalKinesisStream) {
+		r.Spec.ForProvider.KinesisConfiguration.AggregationEnabled = aws.Bool(b)
+	}
+}
+
+func withDeletionTimestamp() streamModifier {
+	return func(r *v1alpha1.JournalKinesisStream) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func stream(m ...streamModifier) *v1alpha1.JournalKinesisStream {
+	cr := &v1alpha1.JournalKinesisStream{
+		Spec: v1alpha1.JournalKinesisStreamSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.JournalKinesisStreamParameters{
+				LedgerName: aws.String(ledgerName),
+				StreamName: "audit-stream",
+				RoleARN:    aws.String("arn:aws:iam::123456789012:role/qldb"),
+				KinesisConfiguration: v1alpha1.KinesisConfiguration{
+					StreamARN: "arn:aws:kinesis:us-east-1:123456789012:stream/audit",
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeStream(status awsqldb.StreamStatus) func(*awsqldb.DescribeJournalKinesisStreamInput) awsqldb.DescribeJournalKinesisStreamRequest {
+	return func(*awsqldb.DescribeJournalKinesisStreamInput) awsqldb.DescribeJournalKinesisStreamRequest {
+		return awsqldb.DescribeJournalKinesisStreamRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.DescribeJournalKinesisStreamOutput{
+				Stream: &awsqldb.JournalKinesisStreamDescription{
+					LedgerName: aws.String(ledgerName),
+					StreamId:   aws.String(streamID),
+					Status:     status,
+					KinesisConfiguration: &awsqldb.KinesisConfiguration{
+						StreamArn:          aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/audit"),
+						AggregationEnabled: aws.Bool(true),
+					},
+				},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (qldb.JournalKinesisStreamClient, error)
+		cr          *v1alpha1.JournalKinesisStream
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i qldb.JournalKinesisStreamClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: stream(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i qldb.JournalKinesisStreamClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: stream(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.JournalKinesisStream
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockJournalKinesisStreamClient{
+					MockDescribeJournalKinesisStream: describeStream(awsqldb.StreamStatusActive),
+				},
+				cr: stream(withExternalName(streamID)),
+			},
+			want: want{
+				cr: stream(
+					withExternalName(streamID),
+					withAggregation(true),
+					withStatus("ACTIVE"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Impaired": {
+			args: args{
+				client: &fake.MockJournalKinesisStreamClient{
+					MockDescribeJournalKinesisStream: describeStream(awsqldb.StreamStatusImpaired),
+				},
+				cr: stream(withExternalName(streamID), withAggregation(true)),
+			},
+			want: want{
+				cr: stream(
+					withExternalName(streamID),
+					withAggregation(true),
+					withStatus("IMPAIRED"),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: stream(),
+			},
+			want: want{
+				cr: stream(),
+			},
+		},
+		"Canceled": {
+			args: args{
+				client: &fake.MockJournalKinesisStreamClient{
+					MockDescribeJournalKinesisStream: describeStream(awsqldb.StreamStatusCanceled),
+				},
+				cr: stream(withExternalName(streamID)),
+			},
+			want: want{
+				cr: stream(withExternalName(streamID)),
+			},
+		},
+		"CompletedAndDeleted": {
+			args: args{
+				client: &fake.MockJournalKinesisStreamClient{
+					MockDescribeJournalKinesisStream: describeStream(awsqldb.StreamStatusCompleted),
+				},
+				cr: stream(withExternalName(streamID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: stream(withExternalName(streamID), withDeletionTimestamp()),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockJournalKinesisStreamClient{
+					MockDescribeJournalKinesisStream: func(*awsqldb.DescribeJournalKinesisStreamInput) awsqldb.DescribeJournalKinesisStreamRequest {
+						return awsqldb.DescribeJournalKinesisStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(withExternalName(streamID)),
+			},
+			want: want{
+				cr:  stream(withExternalName(streamID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.JournalKinesisStream
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockJournalKinesisStreamClient{
+					MockStreamJournalToKinesis: func(input *awsqldb.StreamJournalToKinesisInput) awsqldb.StreamJournalToKinesisRequest {
+						return awsqldb.StreamJournalToKinesisRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.StreamJournalToKinesisOutput{StreamId: aws.String(streamID)}},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr: stream(withExternalName(streamID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockJournalKinesisStreamClient{
+					MockStreamJournalToKinesis: func(input *awsqldb.StreamJournalToKinesisInput) awsqldb.StreamJournalToKinesisRequest {
+						return awsqldb.StreamJournalToKinesisRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(),
+			},
+			want: want{
+				cr:  stream(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.JournalKinesisStream
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockJournalKinesisStreamClient{
+					MockCancelJournalKinesisStream: func(input *awsqldb.CancelJournalKinesisStreamInput) awsqldb.CancelJournalKinesisStreamRequest {
+						if diff := cmp.Diff(streamID, aws.StringValue(input.StreamId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsqldb.CancelJournalKinesisStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.CancelJournalKinesisStreamOutput{}},
+						}
+					},
+				},
+				cr: stream(withExternalName(streamID)),
+			},
+			want: want{
+				cr: stream(withExternalName(streamID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockJournalKinesisStreamClient{
+					MockCancelJournalKinesisStream: func(input *awsqldb.CancelJournalKinesisStreamInput) awsqldb.CancelJournalKinesisStreamRequest {
+						return awsqldb.CancelJournalKinesisStreamRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: stream(withExternalName(streamID)),
+			},
+			want: want{
+				cr:  stream(withExternalName(streamID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
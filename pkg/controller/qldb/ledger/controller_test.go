@@ -0,0 +1,609 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ledger
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsqldb "github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/qldb/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/qldb"
+	"github.com/crossplane/provider-aws/pkg/clients/qldb/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	ledgerName = "audit"
+	arn        = "arn:aws:qldb:us-east-1:123456789012:ledger/audit"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client qldb.LedgerClient
+	kube   client.Client
+	cr     *v1alpha1.Ledger
+}
+
+type ledgerModifier func(*v1alpha1.Ledger)
+
+func withConditions(c ...runtimev1alpha1.Condition) ledgerModifier {
+	return func(r *v1alpha1.Ledger) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withState(s string) ledgerModifier {
+	return func(r *v1alpha1.Ledger) { r.Status.AtProvider = v1alpha1.LedgerObservation{ARN: arn, State: s} }
+}
+
+func withDeletionProtection(b bool) ledgerModifier {
+	return func(r *v1alpha1.Ledger) { r.Spec.ForProvider.DeletionProtection = aws.Bool(b) }
+}
+
+func ledger(m ...ledgerModifier) *v1alpha1.Ledger {
+	cr := &v1alpha1.Ledger{
+		Spec: v1alpha1.LedgerSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.LedgerParameters{
+				PermissionsMode: "ALLOW_ALL",
+			},
+		},
+	}
+	meta.SetExternalName(cr, ledgerName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeLedger(state awsqldb.LedgerState, deletionProtection bool) func(*awsqldb.DescribeLedgerInput) awsqldb.DescribeLedgerRequest {
+	return func(*awsqldb.DescribeLedgerInput) awsqldb.DescribeLedgerRequest {
+		return awsqldb.DescribeLedgerRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.DescribeLedgerOutput{
+				Name:               aws.String(ledgerName),
+				Arn:                aws.String(arn),
+				State:              state,
+				DeletionProtection: aws.Bool(deletionProtection),
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (qldb.LedgerClient, error)
+		cr          *v1alpha1.Ledger
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i qldb.LedgerClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: ledger(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i qldb.LedgerClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: ledger(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Ledger
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockLedgerClient{
+					MockDescribeLedger: describeLedger(awsqldb.LedgerStateActive, true),
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr: ledger(
+					withDeletionProtection(true),
+					withState("ACTIVE"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockDescribeLedger: describeLedger(awsqldb.LedgerStateCreating, true),
+				},
+				cr: ledger(withDeletionProtection(true)),
+			},
+			want: want{
+				cr: ledger(
+					withDeletionProtection(true),
+					withState("CREATING"),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeletionProtectionChanged": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockDescribeLedger: describeLedger(awsqldb.LedgerStateActive, true),
+				},
+				cr: ledger(withDeletionProtection(false)),
+			},
+			want: want{
+				cr: ledger(
+					withDeletionProtection(false),
+					withState("ACTIVE"),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockDescribeLedger: describeLedger(awsqldb.LedgerStateDeleted, false),
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr: ledger(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockDescribeLedger: func(*awsqldb.DescribeLedgerInput) awsqldb.DescribeLedgerRequest {
+						return awsqldb.DescribeLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsqldb.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr: ledger(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockDescribeLedger: func(*awsqldb.DescribeLedgerInput) awsqldb.DescribeLedgerRequest {
+						return awsqldb.DescribeLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr:  ledger(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Ledger
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockCreateLedger: func(input *awsqldb.CreateLedgerInput) awsqldb.CreateLedgerRequest {
+						want := &awsqldb.CreateLedgerInput{
+							Name:               aws.String(ledgerName),
+							PermissionsMode:    awsqldb.PermissionsModeAllowAll,
+							DeletionProtection: aws.Bool(false),
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsqldb.CreateLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.CreateLedgerOutput{}},
+						}
+					},
+				},
+				cr: ledger(withDeletionProtection(false)),
+			},
+			want: want{
+				cr: ledger(withDeletionProtection(false), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockCreateLedger: func(input *awsqldb.CreateLedgerInput) awsqldb.CreateLedgerRequest {
+						return awsqldb.CreateLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr:  ledger(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Ledger
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockUpdateLedger: func(input *awsqldb.UpdateLedgerInput) awsqldb.UpdateLedgerRequest {
+						if diff := cmp.Diff(aws.Bool(false), input.DeletionProtection); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsqldb.UpdateLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.UpdateLedgerOutput{}},
+						}
+					},
+				},
+				cr: ledger(withDeletionProtection(false)),
+			},
+			want: want{
+				cr: ledger(withDeletionProtection(false)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockUpdateLedger: func(input *awsqldb.UpdateLedgerInput) awsqldb.UpdateLedgerRequest {
+						return awsqldb.UpdateLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr:  ledger(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Ledger
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockDeleteLedger: func(input *awsqldb.DeleteLedgerInput) awsqldb.DeleteLedgerRequest {
+						return awsqldb.DeleteLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsqldb.DeleteLedgerOutput{}},
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr: ledger(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockLedgerClient{},
+				cr:     ledger(withState("DELETING")),
+			},
+			want: want{
+				cr: ledger(withState("DELETING"), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockLedgerClient{
+					MockDeleteLedger: func(input *awsqldb.DeleteLedgerInput) awsqldb.DeleteLedgerRequest {
+						return awsqldb.DeleteLedgerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: ledger(),
+			},
+			want: want{
+				cr:  ledger(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
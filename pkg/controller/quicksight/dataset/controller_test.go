@@ -0,0 +1,582 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataset
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsquicksight "github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/quicksight/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/quicksight"
+	"github.com/crossplane/provider-aws/pkg/clients/quicksight/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	accountID     = "123456789012"
+	dataSetID     = "events"
+	dataSetARN    = "arn:aws:quicksight:us-east-1:123456789012:dataset/events"
+	dataSourceARN = "arn:aws:quicksight:us-east-1:123456789012:datasource/analytics"
+	errBoom       = errors.New("boom")
+	errNotFound   = awserr.New(awsquicksight.ErrCodeResourceNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client quicksight.DataSetClient
+	kube   client.Client
+	cr     *v1alpha1.DataSet
+}
+
+type dataSetModifier func(*v1alpha1.DataSet)
+
+func withConditions(c ...runtimev1alpha1.Condition) dataSetModifier {
+	return func(r *v1alpha1.DataSet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.DataSetObservation) dataSetModifier {
+	return func(r *v1alpha1.DataSet) { r.Status.AtProvider = o }
+}
+
+func withImportMode(m string) dataSetModifier {
+	return func(r *v1alpha1.DataSet) { r.Spec.ForProvider.ImportMode = m }
+}
+
+func dataSet(m ...dataSetModifier) *v1alpha1.DataSet {
+	cr := &v1alpha1.DataSet{
+		Spec: v1alpha1.DataSetSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DataSetParameters{
+				AccountID:  accountID,
+				Name:       "Events",
+				ImportMode: "SPICE",
+				PhysicalTables: []v1alpha1.PhysicalTable{{
+					ID: "events",
+					CustomSQL: &v1alpha1.CustomSQL{
+						DataSourceARN: aws.String(dataSourceARN),
+						Name:          "recent-events",
+						SQLQuery:      "SELECT * FROM events",
+					},
+				}},
+			},
+		},
+	}
+	meta.SetExternalName(cr, dataSetID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(*awsquicksight.DescribeDataSetInput) awsquicksight.DescribeDataSetRequest {
+	return awsquicksight.DescribeDataSetRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsquicksight.DescribeDataSetOutput{DataSet: &awsquicksight.DataSet{
+			Arn:                          aws.String(dataSetARN),
+			ConsumedSpiceCapacityInBytes: aws.Int64(1024),
+			DataSetId:                    aws.String(dataSetID),
+			ImportMode:                   awsquicksight.DataSetImportModeSpice,
+			Name:                         aws.String("Events"),
+			PhysicalTableMap: map[string]awsquicksight.PhysicalTable{
+				"events": {CustomSql: &awsquicksight.CustomSql{
+					DataSourceArn: aws.String(dataSourceARN),
+					Name:          aws.String("recent-events"),
+					SqlQuery:      aws.String("SELECT * FROM events"),
+				}},
+			},
+		}}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (quicksight.DataSetClient, error)
+		cr          *v1alpha1.DataSet
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i quicksight.DataSetClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: dataSet(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i quicksight.DataSetClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: dataSet(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: dataSet(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: dataSet(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: dataSet(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataSet
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockDataSetClient{MockDescribeDataSet: describe},
+				cr:     dataSet(),
+			},
+			want: want{
+				cr: dataSet(
+					withObservation(v1alpha1.DataSetObservation{ARN: dataSetARN, ConsumedSPICECapacityInBytes: 1024}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockDataSetClient{MockDescribeDataSet: describe},
+				cr:     dataSet(withImportMode("DIRECT_QUERY")),
+			},
+			want: want{
+				cr: dataSet(
+					withImportMode("DIRECT_QUERY"),
+					withObservation(v1alpha1.DataSetObservation{ARN: dataSetARN, ConsumedSPICECapacityInBytes: 1024}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDataSetClient{
+					MockDescribeDataSet: func(*awsquicksight.DescribeDataSetInput) awsquicksight.DescribeDataSetRequest {
+						return awsquicksight.DescribeDataSetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: dataSet(),
+			},
+			want: want{
+				cr: dataSet(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockDataSetClient{
+					MockDescribeDataSet: func(*awsquicksight.DescribeDataSetInput) awsquicksight.DescribeDataSetRequest {
+						return awsquicksight.DescribeDataSetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dataSet(),
+			},
+			want: want{
+				cr:  dataSet(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataSet
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDataSetClient{
+					MockCreateDataSet: func(input *awsquicksight.CreateDataSetInput) awsquicksight.CreateDataSetRequest {
+						if _, ok := input.PhysicalTableMap["events"]; !ok {
+							t.Errorf("expected physical table events in %v", input.PhysicalTableMap)
+						}
+						return awsquicksight.CreateDataSetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsquicksight.CreateDataSetOutput{}},
+						}
+					},
+				},
+				cr: dataSet(),
+			},
+			want: want{
+				cr: dataSet(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDataSetClient{
+					MockCreateDataSet: func(*awsquicksight.CreateDataSetInput) awsquicksight.CreateDataSetRequest {
+						return awsquicksight.CreateDataSetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dataSet(),
+			},
+			want: want{
+				cr:  dataSet(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataSet
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDataSetClient{
+					MockUpdateDataSet: func(input *awsquicksight.UpdateDataSetInput) awsquicksight.UpdateDataSetRequest {
+						if diff := cmp.Diff(awsquicksight.DataSetImportModeDirectQuery, input.ImportMode); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsquicksight.UpdateDataSetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsquicksight.UpdateDataSetOutput{}},
+						}
+					},
+				},
+				cr: dataSet(withImportMode("DIRECT_QUERY")),
+			},
+			want: want{
+				cr: dataSet(withImportMode("DIRECT_QUERY")),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDataSetClient{
+					MockUpdateDataSet: func(*awsquicksight.UpdateDataSetInput) awsquicksight.UpdateDataSetRequest {
+						return awsquicksight.UpdateDataSetRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dataSet(),
+			},
+			want: want{
+				cr:  dataSet(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DataSet
+		err error
+	}
+
+	del := func(err error) func(*awsquicksight.DeleteDataSetInput) awsquicksight.DeleteDataSetRequest {
+		return func(*awsquicksight.DeleteDataSetInput) awsquicksight.DeleteDataSetRequest {
+			return awsquicksight.DeleteDataSetRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsquicksight.DeleteDataSetOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDataSetClient{MockDeleteDataSet: del(nil)},
+				cr:     dataSet(),
+			},
+			want: want{
+				cr: dataSet(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDataSetClient{MockDeleteDataSet: del(errNotFound)},
+				cr:     dataSet(),
+			},
+			want: want{
+				cr: dataSet(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDataSetClient{MockDeleteDataSet: del(errBoom)},
+				cr:     dataSet(),
+			},
+			want: want{
+				cr:  dataSet(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
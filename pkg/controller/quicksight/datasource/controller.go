@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsquicksight "github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/quicksight/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/quicksight"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a DataSource resource"
+	errCreateClient      = "cannot create QuickSight client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the DataSource custom resource"
+
+	errDescribe = "failed to describe DataSource"
+	errCreate   = "failed to create the DataSource resource"
+	errUpdate   = "failed to update the DataSource resource"
+	errDelete   = "failed to delete the DataSource resource"
+
+	errGetCredentials = "cannot get data source credentials secret"
+)
+
+// SetupDataSource adds a controller that reconciles DataSources.
+func SetupDataSource(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DataSourceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.DataSource{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DataSourceGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: quicksight.NewDataSourceClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (quicksight.DataSourceClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DataSource)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client quicksight.DataSourceClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.DataSource)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeDataSourceRequest(&awsquicksight.DescribeDataSourceInput{
+		AwsAccountId: aws.String(cr.Spec.ForProvider.AccountID),
+		DataSourceId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(quicksight.IsNotFound, err), errDescribe)
+	}
+	if rsp.DataSource == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed := rsp.DataSource
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	quicksight.LateInitializeDataSource(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = quicksight.GenerateDataSourceObservation(*observed)
+
+	switch observed.Status {
+	case awsquicksight.ResourceStatusCreationInProgress:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsquicksight.ResourceStatusCreationFailed:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	default:
+		cr.SetConditions(runtimev1alpha1.Available())
+	}
+
+	// Wait for an in-flight creation or update to settle before issuing
+	// another update.
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: observed.Status == awsquicksight.ResourceStatusCreationInProgress ||
+			observed.Status == awsquicksight.ResourceStatusUpdateInProgress ||
+			quicksight.IsDataSourceUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.DataSource)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	creds, err := e.getCredentials(ctx, cr.Spec.ForProvider.CredentialsSecretRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetCredentials)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err = e.client.CreateDataSourceRequest(quicksight.GenerateCreateDataSourceInput(meta.GetExternalName(cr), cr.Spec.ForProvider, creds)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.DataSource)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	creds, err := e.getCredentials(ctx, cr.Spec.ForProvider.CredentialsSecretRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCredentials)
+	}
+
+	_, err = e.client.UpdateDataSourceRequest(quicksight.GenerateUpdateDataSourceInput(meta.GetExternalName(cr), cr.Spec.ForProvider, creds)).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.DataSource)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteDataSourceRequest(&awsquicksight.DeleteDataSourceInput{
+		AwsAccountId: aws.String(cr.Spec.ForProvider.AccountID),
+		DataSourceId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(quicksight.IsNotFound, err), errDelete)
+}
+
+// getCredentials returns the credentials stored under the username and
+// password keys of the referenced secret, or nil if no secret is referenced.
+func (e *external) getCredentials(ctx context.Context, ref *runtimev1alpha1.SecretReference) (*awsquicksight.DataSourceCredentials, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, err
+	}
+	return quicksight.GenerateCredentials(
+		string(s.Data[runtimev1alpha1.ResourceCredentialsSecretUserKey]),
+		string(s.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey])), nil
+}
@@ -0,0 +1,637 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasource
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsquicksight "github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/quicksight/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/quicksight"
+	"github.com/crossplane/provider-aws/pkg/clients/quicksight/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	accountID      = "123456789012"
+	dataSourceID   = "analytics"
+	dataSourceARN  = "arn:aws:quicksight:us-east-1:123456789012:datasource/analytics"
+	credsSecretRef = &runtimev1alpha1.SecretReference{Name: "app-db-conn", Namespace: secretNamespace}
+	errBoom        = errors.New("boom")
+	errNotFound    = awserr.New(awsquicksight.ErrCodeResourceNotFoundException, "not found", nil)
+)
+
+type args struct {
+	client quicksight.DataSourceClient
+	kube   client.Client
+	cr     *v1alpha1.DataSource
+}
+
+type dataSourceModifier func(*v1alpha1.DataSource)
+
+func withConditions(c ...runtimev1alpha1.Condition) dataSourceModifier {
+	return func(r *v1alpha1.DataSource) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.DataSourceObservation) dataSourceModifier {
+	return func(r *v1alpha1.DataSource) { r.Status.AtProvider = o }
+}
+
+func withDatabase(db string) dataSourceModifier {
+	return func(r *v1alpha1.DataSource) { r.Spec.ForProvider.RDSParameters.Database = db }
+}
+
+func dataSource(m ...dataSourceModifier) *v1alpha1.DataSource {
+	cr := &v1alpha1.DataSource{
+		Spec: v1alpha1.DataSourceSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DataSourceParameters{
+				AccountID:            accountID,
+				Name:                 "Analytics",
+				Type:                 "POSTGRESQL",
+				RDSParameters:        &v1alpha1.RDSParameters{Database: "app", InstanceID: aws.String("app-db")},
+				CredentialsSecretRef: credsSecretRef,
+				DisableSSL:           aws.Bool(false),
+			},
+		},
+	}
+	meta.SetExternalName(cr, dataSourceID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(status awsquicksight.ResourceStatus) func(*awsquicksight.DescribeDataSourceInput) awsquicksight.DescribeDataSourceRequest {
+	return func(*awsquicksight.DescribeDataSourceInput) awsquicksight.DescribeDataSourceRequest {
+		return awsquicksight.DescribeDataSourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsquicksight.DescribeDataSourceOutput{DataSource: &awsquicksight.DataSource{
+				Arn:          aws.String(dataSourceARN),
+				DataSourceId: aws.String(dataSourceID),
+				DataSourceParameters: &awsquicksight.DataSourceParameters{
+					RdsParameters: &awsquicksight.RdsParameters{Database: aws.String("app"), InstanceId: aws.String("app-db")},
+				},
+				Name:          aws.String("Analytics"),
+				SslProperties: &awsquicksight.SslProperties{DisableSsl: aws.Bool(false)},
+				Status:        status,
+				Type:          awsquicksight.DataSourceTypePostgresql,
+			}}},
+		}
+	}
+}
+
+func credentialsSecret() *test.MockClient {
+	return &test.MockClient{
+		MockGet: func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+			s := obj.(*corev1.Secret)
+			s.Data = map[string][]byte{
+				runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+				runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte("secret"),
+			}
+			return nil
+		},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (quicksight.DataSourceClient, error)
+		cr          *v1alpha1.DataSource
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i quicksight.DataSourceClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: dataSource(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i quicksight.DataSourceClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: dataSource(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataSource
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockDataSourceClient{MockDescribeDataSource: describe(awsquicksight.ResourceStatusCreationSuccessful)},
+				cr:     dataSource(),
+			},
+			want: want{
+				cr: dataSource(
+					withObservation(v1alpha1.DataSourceObservation{ARN: dataSourceARN, Status: "CREATION_SUCCESSFUL"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockDataSourceClient{MockDescribeDataSource: describe(awsquicksight.ResourceStatusUpdateSuccessful)},
+				cr:     dataSource(withDatabase("reporting")),
+			},
+			want: want{
+				cr: dataSource(
+					withDatabase("reporting"),
+					withObservation(v1alpha1.DataSourceObservation{ARN: dataSourceARN, Status: "UPDATE_SUCCESSFUL"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockDataSourceClient{MockDescribeDataSource: describe(awsquicksight.ResourceStatusCreationInProgress)},
+				cr:     dataSource(withDatabase("reporting")),
+			},
+			want: want{
+				cr: dataSource(
+					withDatabase("reporting"),
+					withObservation(v1alpha1.DataSourceObservation{ARN: dataSourceARN, Status: "CREATION_IN_PROGRESS"}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreationFailed": {
+			args: args{
+				client: &fake.MockDataSourceClient{MockDescribeDataSource: describe(awsquicksight.ResourceStatusCreationFailed)},
+				cr:     dataSource(),
+			},
+			want: want{
+				cr: dataSource(
+					withObservation(v1alpha1.DataSourceObservation{ARN: dataSourceARN, Status: "CREATION_FAILED"}),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockDescribeDataSource: func(*awsquicksight.DescribeDataSourceInput) awsquicksight.DescribeDataSourceRequest {
+						return awsquicksight.DescribeDataSourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr: dataSource(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockDataSourceClient{
+					MockDescribeDataSource: func(*awsquicksight.DescribeDataSourceInput) awsquicksight.DescribeDataSourceRequest {
+						return awsquicksight.DescribeDataSourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr:  dataSource(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataSource
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: credentialsSecret(),
+				client: &fake.MockDataSourceClient{
+					MockCreateDataSource: func(input *awsquicksight.CreateDataSourceInput) awsquicksight.CreateDataSourceRequest {
+						want := quicksight.GenerateCredentials("admin", "secret")
+						if diff := cmp.Diff(want, input.Credentials); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsquicksight.CreateDataSourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsquicksight.CreateDataSourceOutput{}},
+						}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr: dataSource(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedGetCredentials": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr:  dataSource(),
+				err: errors.Wrap(errBoom, errGetCredentials),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kube: credentialsSecret(),
+				client: &fake.MockDataSourceClient{
+					MockCreateDataSource: func(*awsquicksight.CreateDataSourceInput) awsquicksight.CreateDataSourceRequest {
+						return awsquicksight.CreateDataSourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr:  dataSource(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DataSource
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: credentialsSecret(),
+				client: &fake.MockDataSourceClient{
+					MockUpdateDataSource: func(input *awsquicksight.UpdateDataSourceInput) awsquicksight.UpdateDataSourceRequest {
+						if diff := cmp.Diff("reporting", aws.StringValue(input.DataSourceParameters.RdsParameters.Database)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsquicksight.UpdateDataSourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsquicksight.UpdateDataSourceOutput{}},
+						}
+					},
+				},
+				cr: dataSource(withDatabase("reporting")),
+			},
+			want: want{
+				cr: dataSource(withDatabase("reporting")),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				kube: credentialsSecret(),
+				client: &fake.MockDataSourceClient{
+					MockUpdateDataSource: func(*awsquicksight.UpdateDataSourceInput) awsquicksight.UpdateDataSourceRequest {
+						return awsquicksight.UpdateDataSourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: dataSource(),
+			},
+			want: want{
+				cr:  dataSource(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DataSource
+		err error
+	}
+
+	del := func(err error) func(*awsquicksight.DeleteDataSourceInput) awsquicksight.DeleteDataSourceRequest {
+		return func(*awsquicksight.DeleteDataSourceInput) awsquicksight.DeleteDataSourceRequest {
+			return awsquicksight.DeleteDataSourceRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsquicksight.DeleteDataSourceOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDataSourceClient{MockDeleteDataSource: del(nil)},
+				cr:     dataSource(),
+			},
+			want: want{
+				cr: dataSource(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDataSourceClient{MockDeleteDataSource: del(errNotFound)},
+				cr:     dataSource(),
+			},
+			want: want{
+				cr: dataSource(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDataSourceClient{MockDeleteDataSource: del(errBoom)},
+				cr:     dataSource(),
+			},
+			want: want{
+				cr:  dataSource(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
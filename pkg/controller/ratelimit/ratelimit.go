@@ -0,0 +1,250 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit configures how quickly controllers retry managed
+// resources whose reconciliation failed.
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+const (
+	errParseOption       = "cannot parse rate limit option %q"
+	errUnknownKey        = "unknown rate limit option %q"
+	errUnknownController = "unknown controller %q"
+	errParseOverride     = "invalid rate limit of controller %q"
+)
+
+// Options configure the rate limiter of a controller. Requests that failed
+// are retried after a delay that starts at BaseDelay and doubles with each
+// consecutive failure up to MaxDelay. Each delay is randomly lengthened by up
+// to the Jitter fraction of itself, so that many requests failing at once,
+// for example during an AWS outage, are not all retried at once. Across all
+// requests of a controller, retries are limited to QPS per second with bursts
+// of up to BucketSize.
+type Options struct {
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	Jitter     float64
+	QPS        float64
+	BucketSize int
+}
+
+// DefaultOptions are used by controllers without an override. They match the
+// rate limiter that controllers use unless configured otherwise.
+var DefaultOptions = Options{
+	BaseDelay:  5 * time.Millisecond,
+	MaxDelay:   1000 * time.Second,
+	QPS:        10,
+	BucketSize: 100,
+}
+
+// Overrides of DefaultOptions, keyed by the name of the controller they
+// apply to, such as managed/vpc.ec2.aws.crossplane.io.
+var Overrides = map[string]Options{}
+
+// controllerTypes are the prefixes of the names of the controllers of this
+// provider. Each is followed by a slash and the lowercase kind and API group
+// of the resources the controller is named after.
+var controllerTypes = map[string]bool{
+	"managed":           true,
+	"claimbinding":      true,
+	"claimdefaulting":   true,
+	"claimscheduling":   true,
+	"secretpropagating": true,
+	"target":            true,
+	"provider":          true,
+}
+
+// ParseOverrides parses the options of the named controllers, each in the
+// form accepted by ParseOptions, that override the supplied options. Names
+// whose controller type or kind is unknown, the latter because it is not
+// registered with the supplied scheme, are rejected.
+func ParseOverrides(overrides map[string]string, o Options, s *runtime.Scheme) (map[string]Options, error) {
+	kinds := map[string]bool{}
+	for gvk := range s.AllKnownTypes() {
+		kinds[strings.ToLower(gvk.GroupKind().String())] = true
+	}
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parsed := make(map[string]Options, len(overrides))
+	for _, name := range names {
+		parts := strings.SplitN(name, "/", 2)
+		if len(parts) != 2 || !controllerTypes[parts[0]] || !kinds[parts[1]] {
+			return nil, errors.Errorf(errUnknownController, name)
+		}
+		po, err := ParseOptions(overrides[name], o)
+		if err != nil {
+			return nil, errors.Wrapf(err, errParseOverride, name)
+		}
+		parsed[name] = po
+	}
+	return parsed, nil
+}
+
+// OptionsFor returns the options of the named controller.
+func OptionsFor(name string) Options {
+	if o, ok := Overrides[name]; ok {
+		return o
+	}
+	return DefaultOptions
+}
+
+// ControllerOptions returns controller options that rate limit the named
+// controller according to its options.
+func ControllerOptions(name string) controller.Options {
+	return controller.Options{RateLimiter: NewRateLimiter(OptionsFor(name))}
+}
+
+// NewRateLimiter returns a rate limiter configured by the supplied options.
+func NewRateLimiter(o Options) workqueue.RateLimiter {
+	return workqueue.NewMaxOfRateLimiter(
+		NewExponentialJitterRateLimiter(o.BaseDelay, o.MaxDelay, o.Jitter),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(o.QPS), o.BucketSize)},
+	)
+}
+
+// ParseOptions parses comma separated key=value pairs, such as
+// base-delay=1s,max-delay=5m, that override the supplied options. Valid keys
+// are base-delay, max-delay, jitter, qps and bucket-size.
+func ParseOptions(s string, o Options) (Options, error) {
+	for _, kv := range strings.Split(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return Options{}, errors.Errorf(errParseOption, kv)
+		}
+		var err error
+		switch k, v := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]); k {
+		case "base-delay":
+			o.BaseDelay, err = time.ParseDuration(v)
+		case "max-delay":
+			o.MaxDelay, err = time.ParseDuration(v)
+		case "jitter":
+			o.Jitter, err = strconv.ParseFloat(v, 64)
+		case "qps":
+			o.QPS, err = strconv.ParseFloat(v, 64)
+		case "bucket-size":
+			o.BucketSize, err = strconv.Atoi(v)
+		default:
+			return Options{}, errors.Errorf(errUnknownKey, k)
+		}
+		if err != nil {
+			return Options{}, errors.Wrapf(err, errParseOption, kv)
+		}
+	}
+	return o, o.Validate()
+}
+
+// Validate returns an error if the options cannot configure a rate limiter.
+func (o Options) Validate() error {
+	switch {
+	case o.BaseDelay <= 0:
+		return errors.New("base delay must be positive")
+	case o.MaxDelay < o.BaseDelay:
+		return errors.New("max delay must not be shorter than base delay")
+	case o.Jitter < 0 || o.Jitter > 1:
+		return errors.New("jitter must be between 0 and 1")
+	case o.QPS <= 0:
+		return errors.New("qps must be positive")
+	case o.BucketSize <= 0:
+		return errors.New("bucket size must be positive")
+	}
+	return nil
+}
+
+// String returns the options in the form accepted by ParseOptions.
+func (o Options) String() string {
+	return fmt.Sprintf("base-delay=%s,max-delay=%s,jitter=%s,qps=%s,bucket-size=%d",
+		o.BaseDelay, o.MaxDelay, strconv.FormatFloat(o.Jitter, 'f', -1, 64), strconv.FormatFloat(o.QPS, 'f', -1, 64), o.BucketSize)
+}
+
+// An ExponentialJitterRateLimiter delays each request exponentially longer
+// the more often it failed, randomly lengthening each delay.
+type ExponentialJitterRateLimiter struct {
+	base   time.Duration
+	max    time.Duration
+	jitter float64
+	random func() float64
+
+	mu       sync.Mutex
+	failures map[interface{}]int
+}
+
+// NewExponentialJitterRateLimiter returns a rate limiter whose delays start
+// at the supplied base delay and double with each failure up to the supplied
+// max delay, before being lengthened by up to the jitter fraction of
+// themselves.
+func NewExponentialJitterRateLimiter(base, max time.Duration, jitter float64) *ExponentialJitterRateLimiter {
+	return &ExponentialJitterRateLimiter{
+		base:     base,
+		max:      max,
+		jitter:   jitter,
+		random:   rand.Float64,
+		failures: map[interface{}]int{},
+	}
+}
+
+// When returns how long to wait before processing the supplied item again.
+func (r *ExponentialJitterRateLimiter) When(item interface{}) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	exp := r.failures[item]
+	r.failures[item]++
+
+	d := float64(r.base) * math.Pow(2, float64(exp))
+	if d > float64(r.max) {
+		d = float64(r.max)
+	}
+	return time.Duration(d + d*r.jitter*r.random())
+}
+
+// NumRequeues returns how often the supplied item failed.
+func (r *ExponentialJitterRateLimiter) NumRequeues(item interface{}) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failures[item]
+}
+
+// Forget the failures of the supplied item.
+func (r *ExponentialJitterRateLimiter) Forget(item interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.failures, item)
+}
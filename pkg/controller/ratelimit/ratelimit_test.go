@@ -0,0 +1,227 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func TestParseOptions(t *testing.T) {
+	type want struct {
+		o   Options
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		s      string
+		want   want
+	}{
+		"Empty": {
+			reason: "No pairs should leave the supplied options unchanged.",
+			want:   want{o: DefaultOptions},
+		},
+		"Overrides": {
+			reason: "Each pair should override the corresponding option.",
+			s:      "base-delay=1s, max-delay=5m,jitter=0.2,qps=5,bucket-size=50",
+			want: want{o: Options{
+				BaseDelay:  time.Second,
+				MaxDelay:   5 * time.Minute,
+				Jitter:     0.2,
+				QPS:        5,
+				BucketSize: 50,
+			}},
+		},
+		"Partial": {
+			reason: "Options without a pair should keep their supplied value.",
+			s:      "max-delay=1m",
+			want: want{o: Options{
+				BaseDelay:  DefaultOptions.BaseDelay,
+				MaxDelay:   time.Minute,
+				QPS:        DefaultOptions.QPS,
+				BucketSize: DefaultOptions.BucketSize,
+			}},
+		},
+		"NotAPair": {
+			reason: "A pair without a value should be rejected.",
+			s:      "base-delay",
+			want:   want{err: errors.Errorf(errParseOption, "base-delay")},
+		},
+		"UnknownKey": {
+			reason: "An unknown key should be rejected.",
+			s:      "delay=1s",
+			want:   want{err: errors.Errorf(errUnknownKey, "delay")},
+		},
+		"InvalidValue": {
+			reason: "A value that cannot be parsed should be rejected.",
+			s:      "bucket-size=many",
+			want:   want{err: errors.Wrapf(errors.New(`strconv.Atoi: parsing "many": invalid syntax`), errParseOption, "bucket-size=many")},
+		},
+		"Invalid": {
+			reason: "Options that cannot configure a rate limiter should be rejected.",
+			s:      "max-delay=1ms",
+			want:   want{o: Options{BaseDelay: DefaultOptions.BaseDelay, MaxDelay: time.Millisecond, QPS: DefaultOptions.QPS, BucketSize: DefaultOptions.BucketSize}, err: errors.New("max delay must not be shorter than base delay")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := ParseOptions(tc.s, DefaultOptions)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nParseOptions(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\nParseOptions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParseOverrides(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+	defaults := Options{BaseDelay: time.Second, MaxDelay: time.Minute, QPS: 10, BucketSize: 100}
+
+	type want struct {
+		o   map[string]Options
+		err error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		overrides map[string]string
+		want      want
+	}{
+		"Known": {
+			reason:    "Overrides of known controllers should be parsed.",
+			overrides: map[string]string{"managed/vpc.ec2.aws.crossplane.io": "qps=2"},
+			want: want{o: map[string]Options{
+				"managed/vpc.ec2.aws.crossplane.io": {BaseDelay: time.Second, MaxDelay: time.Minute, QPS: 2, BucketSize: 100},
+			}},
+		},
+		"UnknownKind": {
+			reason:    "Overrides of controllers of unknown kinds should be rejected.",
+			overrides: map[string]string{"managed/vcp.ec2.aws.crossplane.io": "qps=2"},
+			want:      want{err: errors.Errorf(errUnknownController, "managed/vcp.ec2.aws.crossplane.io")},
+		},
+		"UnknownType": {
+			reason:    "Overrides of controllers of unknown types should be rejected.",
+			overrides: map[string]string{"manged/vpc.ec2.aws.crossplane.io": "qps=2"},
+			want:      want{err: errors.Errorf(errUnknownController, "manged/vpc.ec2.aws.crossplane.io")},
+		},
+		"NoType": {
+			reason:    "Overrides of controllers named only by kind should be rejected.",
+			overrides: map[string]string{"vpc.ec2.aws.crossplane.io": "qps=2"},
+			want:      want{err: errors.Errorf(errUnknownController, "vpc.ec2.aws.crossplane.io")},
+		},
+		"InvalidOptions": {
+			reason:    "Overrides whose options are invalid should be rejected.",
+			overrides: map[string]string{"managed/vpc.ec2.aws.crossplane.io": "qps=0"},
+			want:      want{err: errors.Wrapf(errors.New("qps must be positive"), errParseOverride, "managed/vpc.ec2.aws.crossplane.io")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := ParseOverrides(tc.overrides, defaults, s)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nParseOverrides(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\nParseOverrides(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestOptionsString(t *testing.T) {
+	o := Options{BaseDelay: time.Second, MaxDelay: time.Minute, Jitter: 0.5, QPS: 2.5, BucketSize: 10}
+	got, err := ParseOptions(o.String(), DefaultOptions)
+	if err != nil {
+		t.Fatalf("ParseOptions(o.String()): %s", err)
+	}
+	if diff := cmp.Diff(o, got); diff != "" {
+		t.Errorf("ParseOptions(o.String()): -want, +got:\n%s", diff)
+	}
+}
+
+func TestExponentialJitterRateLimiter(t *testing.T) {
+	type want struct {
+		delays   []time.Duration
+		requeues int
+	}
+
+	cases := map[string]struct {
+		reason string
+		jitter float64
+		random float64
+		forget bool
+		want   want
+	}{
+		"Exponential": {
+			reason: "Delays should double with each failure up to the max delay.",
+			want: want{
+				delays:   []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second},
+				requeues: 4,
+			},
+		},
+		"Jitter": {
+			reason: "Delays should be lengthened by the jitter fraction scaled by a random factor.",
+			jitter: 0.5,
+			random: 0.5,
+			want: want{
+				delays:   []time.Duration{1250 * time.Millisecond, 2500 * time.Millisecond, 5 * time.Second, 6250 * time.Millisecond},
+				requeues: 4,
+			},
+		},
+		"Forget": {
+			reason: "Forgetting an item should reset its delay.",
+			forget: true,
+			want: want{
+				delays: []time.Duration{time.Second, time.Second, time.Second, time.Second},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewExponentialJitterRateLimiter(time.Second, 5*time.Second, tc.jitter)
+			r.random = func() float64 { return tc.random }
+
+			delays := make([]time.Duration, 0, len(tc.want.delays))
+			for range tc.want.delays {
+				delays = append(delays, r.When("item"))
+				if tc.forget {
+					r.Forget("item")
+				}
+			}
+			got := want{delays: delays, requeues: r.NumRequeues("item")}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nWhen(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package requeue spaces out polls of managed resources whose external
+// resources take a long time to create or delete.
+package requeue
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// MinDelay is the shortest delay returned while an operation is in
+	// progress. It matches the short wait of the managed resource reconciler.
+	MinDelay = 30 * time.Second
+
+	getTimeout = 10 * time.Second
+)
+
+// Typical durations of long running AWS operations.
+const (
+	TypicalRDSInstanceDuration      = 20 * time.Minute
+	TypicalEKSClusterDuration       = 15 * time.Minute
+	TypicalEKSNodeGroupDuration     = 10 * time.Minute
+	TypicalReplicationGroupDuration = 15 * time.Minute
+)
+
+// Delay returns how long to wait before polling an operation that has been
+// in progress for the supplied elapsed time and that typically takes the
+// supplied duration. The delay is half of the elapsed time, so successive
+// polls are spaced exponentially further apart, bounded by MinDelay and a
+// quarter of the typical duration.
+func Delay(elapsed, typical time.Duration) time.Duration {
+	d := elapsed / 2
+	if max := typical / 4; d > max {
+		d = max
+	}
+	if d < MinDelay {
+		d = MinDelay
+	}
+	return d
+}
+
+// A Reconciler wraps a managed resource reconciler. It lengthens the requeue
+// delay of managed resources whose external resource is being created or
+// deleted, according to Delay.
+type Reconciler struct {
+	wrapped    reconcile.Reconciler
+	client     client.Reader
+	newManaged func() resource.Managed
+	typical    time.Duration
+	now        func() time.Time
+}
+
+// NewReconciler returns a Reconciler that wraps the supplied reconciler of
+// the supplied kind of managed resource, whose external resources typically
+// take the supplied duration to create or delete.
+func NewReconciler(m manager.Manager, of resource.ManagedKind, typical time.Duration, r reconcile.Reconciler) *Reconciler {
+	return &Reconciler{
+		wrapped: r,
+		client:  m.GetClient(),
+		newManaged: func() resource.Managed {
+			return resource.MustCreateObject(schema.GroupVersionKind(of), m.GetScheme()).(resource.Managed)
+		},
+		typical: typical,
+		now:     time.Now,
+	}
+}
+
+// Reconcile the supplied request using the wrapped reconciler, then adjust
+// its requeue delay.
+func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	result, err := r.wrapped.Reconcile(req)
+	if err != nil || result.RequeueAfter == 0 {
+		return result, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), getTimeout)
+	defer cancel()
+
+	mg := r.newManaged()
+	if r.client.Get(ctx, req.NamespacedName, mg) != nil {
+		return result, nil
+	}
+
+	c := mg.GetCondition(runtimev1alpha1.TypeReady)
+	if c.Reason != runtimev1alpha1.ReasonCreating && c.Reason != runtimev1alpha1.ReasonDeleting {
+		return result, nil
+	}
+	if d := Delay(r.now().Sub(c.LastTransitionTime.Time), r.typical); d > result.RequeueAfter {
+		result.RequeueAfter = d
+	}
+	return result, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requeue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var errBoom = errors.New("boom")
+
+type reconcileFn func(reconcile.Request) (reconcile.Result, error)
+
+func (fn reconcileFn) Reconcile(req reconcile.Request) (reconcile.Result, error) { return fn(req) }
+
+func TestDelay(t *testing.T) {
+	cases := map[string]struct {
+		elapsed time.Duration
+		typical time.Duration
+		want    time.Duration
+	}{
+		"JustStarted": {
+			elapsed: 10 * time.Second,
+			typical: 20 * time.Minute,
+			want:    MinDelay,
+		},
+		"InProgress": {
+			elapsed: 4 * time.Minute,
+			typical: 20 * time.Minute,
+			want:    2 * time.Minute,
+		},
+		"TakingLongerThanTypical": {
+			elapsed: time.Hour,
+			typical: 20 * time.Minute,
+			want:    5 * time.Minute,
+		},
+		"ShortOperation": {
+			elapsed: time.Hour,
+			typical: time.Minute,
+			want:    MinDelay,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Delay(tc.elapsed, tc.typical)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now()
+	withCondition := func(c runtimev1alpha1.Condition, since time.Duration) test.MockGetFn {
+		return test.NewMockGetFn(nil, func(o runtime.Object) error {
+			c.LastTransitionTime = metav1.NewTime(now.Add(-since))
+			o.(resource.Managed).SetConditions(c)
+			return nil
+		})
+	}
+	result := func(after time.Duration) reconcileFn {
+		return func(reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{RequeueAfter: after}, nil
+		}
+	}
+
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		wrapped reconcile.Reconciler
+		get     test.MockGetFn
+		want    want
+	}{
+		"WrappedError": {
+			wrapped: reconcileFn(func(reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{RequeueAfter: time.Second}, errBoom
+			}),
+			want: want{result: reconcile.Result{RequeueAfter: time.Second}, err: errBoom},
+		},
+		"NoRequeue": {
+			wrapped: result(0),
+			want:    want{result: reconcile.Result{}},
+		},
+		"GetError": {
+			wrapped: result(time.Minute),
+			get:     test.NewMockGetFn(errBoom),
+			want:    want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"Available": {
+			wrapped: result(time.Minute),
+			get:     withCondition(runtimev1alpha1.Available(), time.Hour),
+			want:    want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"Creating": {
+			wrapped: result(time.Minute),
+			get:     withCondition(runtimev1alpha1.Creating(), 8*time.Minute),
+			want:    want{result: reconcile.Result{RequeueAfter: 4 * time.Minute}},
+		},
+		"Deleting": {
+			wrapped: result(time.Minute),
+			get:     withCondition(runtimev1alpha1.Deleting(), time.Hour),
+			want:    want{result: reconcile.Result{RequeueAfter: 5 * time.Minute}},
+		},
+		"NeverShortened": {
+			wrapped: result(time.Minute),
+			get:     withCondition(runtimev1alpha1.Creating(), time.Second),
+			want:    want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &Reconciler{
+				wrapped:    tc.wrapped,
+				client:     &test.MockClient{MockGet: tc.get},
+				newManaged: func() resource.Managed { return &fake.Managed{} },
+				typical:    20 * time.Minute,
+				now:        func() time.Time { return now },
+			}
+			got, err := r.Reconcile(reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolverendpoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsroute53resolver "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a Route 53 Resolver ResolverEndpoint resource"
+	errCreateClient      = "cannot create Route 53 Resolver client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the Route 53 Resolver ResolverEndpoint custom resource"
+
+	errDescribe = "cannot describe Route 53 Resolver ResolverEndpoint"
+	errCreate   = "cannot create Route 53 Resolver ResolverEndpoint"
+	errUpdate   = "cannot update Route 53 Resolver ResolverEndpoint"
+	errDelete   = "cannot delete Route 53 Resolver ResolverEndpoint"
+
+	errListIPAddresses       = "cannot list IP addresses of Route 53 Resolver ResolverEndpoint"
+	errAssociateIPAddress    = "cannot associate IP address with Route 53 Resolver ResolverEndpoint"
+	errDisassociateIPAddress = "cannot disassociate IP address from Route 53 Resolver ResolverEndpoint"
+)
+
+// SetupResolverEndpoint adds a controller that reconciles Route 53 Resolver endpoints.
+func SetupResolverEndpoint(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ResolverEndpointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.ResolverEndpoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ResolverEndpointGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: route53resolver.NewResolverEndpointClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (route53resolver.ResolverEndpointClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client route53resolver.ResolverEndpointClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.GetResolverEndpointRequest(&awsroute53resolver.GetResolverEndpointInput{
+		ResolverEndpointId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(route53resolver.IsNotFound, err), errDescribe)
+	}
+	observed := *rsp.ResolverEndpoint
+
+	addrs, err := e.getIPAddresses(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListIPAddresses)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	route53resolver.LateInitializeResolverEndpoint(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = route53resolver.GenerateResolverEndpointObservation(observed, addrs)
+	switch observed.Status {
+	case awsroute53resolver.ResolverEndpointStatusOperational, awsroute53resolver.ResolverEndpointStatusUpdating:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsroute53resolver.ResolverEndpointStatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsroute53resolver.ResolverEndpointStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: route53resolver.IsResolverEndpointUpToDate(cr.Spec.ForProvider, observed, addrs),
+	}, nil
+}
+
+func (e *external) getIPAddresses(ctx context.Context, id string) ([]awsroute53resolver.IpAddressResponse, error) {
+	var res []awsroute53resolver.IpAddressResponse
+	in := &awsroute53resolver.ListResolverEndpointIpAddressesInput{ResolverEndpointId: aws.String(id)}
+	for {
+		rsp, err := e.client.ListResolverEndpointIpAddressesRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rsp.IpAddresses...)
+		if aws.StringValue(rsp.NextToken) == "" {
+			return res, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	rsp, err := e.client.CreateResolverEndpointRequest(route53resolver.GenerateCreateResolverEndpointInput(string(cr.GetUID()), cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.ResolverEndpoint.Id))
+	return managed.ExternalCreation{}, errors.Wrap(e.kube.Update(ctx, cr), errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := aws.String(meta.GetExternalName(cr))
+
+	if _, err := e.client.UpdateResolverEndpointRequest(&awsroute53resolver.UpdateResolverEndpointInput{
+		ResolverEndpointId: id,
+		Name:               cr.Spec.ForProvider.Name,
+	}).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	addrs, err := e.getIPAddresses(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListIPAddresses)
+	}
+
+	// An endpoint needs at least two IP addresses, so the new addresses are
+	// associated before the stale ones are removed.
+	add, remove := route53resolver.DiffIPAddresses(cr.Spec.ForProvider.IPAddresses, addrs)
+	for i := range add {
+		if _, err := e.client.AssociateResolverEndpointIpAddressRequest(&awsroute53resolver.AssociateResolverEndpointIpAddressInput{
+			ResolverEndpointId: id,
+			IpAddress:          &add[i],
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssociateIPAddress)
+		}
+	}
+	for i := range remove {
+		if _, err := e.client.DisassociateResolverEndpointIpAddressRequest(&awsroute53resolver.DisassociateResolverEndpointIpAddressInput{
+			ResolverEndpointId: id,
+			IpAddress:          &remove[i],
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(route53resolver.IsNotFound, err), errDisassociateIPAddress)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ResolverEndpoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteResolverEndpointRequest(&awsroute53resolver.DeleteResolverEndpointInput{
+		ResolverEndpointId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(route53resolver.IsNotFound, err), errDelete)
+}
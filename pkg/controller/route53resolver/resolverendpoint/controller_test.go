@@ -0,0 +1,703 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolverendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsroute53resolver "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	endpointID = "rslvr-in-1234567890"
+	subnetA    = "subnet-a"
+	subnetB    = "subnet-b"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client route53resolver.ResolverEndpointClient
+	kube   client.Client
+	cr     *v1alpha1.ResolverEndpoint
+}
+
+type endpointModifier func(*v1alpha1.ResolverEndpoint)
+
+func withExternalName(n string) endpointModifier {
+	return func(r *v1alpha1.ResolverEndpoint) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) endpointModifier {
+	return func(r *v1alpha1.ResolverEndpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.ResolverEndpointObservation) endpointModifier {
+	return func(r *v1alpha1.ResolverEndpoint) { r.Status.AtProvider = o }
+}
+
+func withName(n string) endpointModifier {
+	return func(r *v1alpha1.ResolverEndpoint) { r.Spec.ForProvider.Name = aws.String(n) }
+}
+
+func endpoint(m ...endpointModifier) *v1alpha1.ResolverEndpoint {
+	cr := &v1alpha1.ResolverEndpoint{
+		Spec: v1alpha1.ResolverEndpointSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ResolverEndpointParameters{
+				Name:      aws.String("inbound"),
+				Direction: "INBOUND",
+				IPAddresses: []v1alpha1.IPAddress{
+					{SubnetID: aws.String(subnetA)},
+					{SubnetID: aws.String(subnetB), IP: aws.String("10.0.1.10")},
+				},
+				SecurityGroupIDs: []string{"sg-1"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(o *awsroute53resolver.GetResolverEndpointOutput, err error) func(*awsroute53resolver.GetResolverEndpointInput) awsroute53resolver.GetResolverEndpointRequest {
+	return func(*awsroute53resolver.GetResolverEndpointInput) awsroute53resolver.GetResolverEndpointRequest {
+		return awsroute53resolver.GetResolverEndpointRequest{Request: request(o, err)}
+	}
+}
+
+func list(addrs []awsroute53resolver.IpAddressResponse, err error) func(*awsroute53resolver.ListResolverEndpointIpAddressesInput) awsroute53resolver.ListResolverEndpointIpAddressesRequest {
+	return func(*awsroute53resolver.ListResolverEndpointIpAddressesInput) awsroute53resolver.ListResolverEndpointIpAddressesRequest {
+		return awsroute53resolver.ListResolverEndpointIpAddressesRequest{Request: request(&awsroute53resolver.ListResolverEndpointIpAddressesOutput{IpAddresses: addrs}, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (route53resolver.ResolverEndpointClient, error)
+		cr          *v1alpha1.ResolverEndpoint
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i route53resolver.ResolverEndpointClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: endpoint(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i route53resolver.ResolverEndpointClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: endpoint(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResolverEndpoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := func(s awsroute53resolver.ResolverEndpointStatus) *awsroute53resolver.GetResolverEndpointOutput {
+		return &awsroute53resolver.GetResolverEndpointOutput{ResolverEndpoint: &awsroute53resolver.ResolverEndpoint{
+			Id:               aws.String(endpointID),
+			Arn:              aws.String("arn"),
+			Name:             aws.String("inbound"),
+			Direction:        awsroute53resolver.ResolverEndpointDirectionInbound,
+			HostVPCId:        aws.String("vpc-1"),
+			SecurityGroupIds: []string{"sg-1"},
+			Status:           s,
+		}}
+	}
+	addrs := []awsroute53resolver.IpAddressResponse{
+		{IpId: aws.String("rni-a"), Ip: aws.String("10.0.0.10"), SubnetId: aws.String(subnetA), Status: awsroute53resolver.IpAddressStatusAttached},
+		{IpId: aws.String("rni-b"), Ip: aws.String("10.0.1.10"), SubnetId: aws.String(subnetB), Status: awsroute53resolver.IpAddressStatusAttached},
+	}
+	status := func(s string) v1alpha1.ResolverEndpointObservation {
+		return v1alpha1.ResolverEndpointObservation{
+			ARN:       "arn",
+			HostVPCID: "vpc-1",
+			Status:    s,
+			IPAddresses: []v1alpha1.IPAddressObservation{
+				{IPID: "rni-a", IP: "10.0.0.10", SubnetID: subnetA, Status: "ATTACHED"},
+				{IPID: "rni-b", IP: "10.0.1.10", SubnetID: subnetB, Status: "ATTACHED"},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: endpoint(),
+			},
+			want: want{
+				cr: endpoint(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpoint:             get(observed(awsroute53resolver.ResolverEndpointStatusOperational), nil),
+					MockListResolverEndpointIpAddresses: list(addrs, nil),
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(
+					withExternalName(endpointID),
+					withStatus(status("OPERATIONAL")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpoint:             get(observed(awsroute53resolver.ResolverEndpointStatusCreating), nil),
+					MockListResolverEndpointIpAddresses: list(addrs, nil),
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(
+					withExternalName(endpointID),
+					withStatus(status("CREATING")),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"IPAddressRemoved": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpoint:             get(observed(awsroute53resolver.ResolverEndpointStatusOperational), nil),
+					MockListResolverEndpointIpAddresses: list(addrs, nil),
+				},
+				cr: endpoint(withExternalName(endpointID), func(r *v1alpha1.ResolverEndpoint) {
+					r.Spec.ForProvider.IPAddresses = r.Spec.ForProvider.IPAddresses[1:]
+				}),
+			},
+			want: want{
+				cr: endpoint(
+					withExternalName(endpointID),
+					func(r *v1alpha1.ResolverEndpoint) {
+						r.Spec.ForProvider.IPAddresses = r.Spec.ForProvider.IPAddresses[1:]
+					},
+					withStatus(status("OPERATIONAL")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpoint:             get(observed(awsroute53resolver.ResolverEndpointStatusOperational), nil),
+					MockListResolverEndpointIpAddresses: list(addrs, nil),
+				},
+				cr: endpoint(withExternalName(endpointID), func(r *v1alpha1.ResolverEndpoint) { r.Spec.ForProvider.Name = nil }),
+			},
+			want: want{
+				cr: endpoint(
+					withExternalName(endpointID),
+					withStatus(status("OPERATIONAL")),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpoint: get(nil, awserr.New(awsroute53resolver.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpoint: get(nil, errBoom),
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedListRequest": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockGetResolverEndpoint:             get(observed(awsroute53resolver.ResolverEndpointStatusOperational), nil),
+					MockListResolverEndpointIpAddresses: list(nil, errBoom),
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID)),
+				err: errors.Wrap(errBoom, errListIPAddresses),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResolverEndpoint
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockResolverEndpointClient{
+					MockCreateResolverEndpoint: func(*awsroute53resolver.CreateResolverEndpointInput) awsroute53resolver.CreateResolverEndpointRequest {
+						return awsroute53resolver.CreateResolverEndpointRequest{Request: request(&awsroute53resolver.CreateResolverEndpointOutput{
+							ResolverEndpoint: &awsroute53resolver.ResolverEndpoint{Id: aws.String(endpointID)},
+						}, nil)}
+					},
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				cr: endpoint(
+					withExternalName(endpointID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockCreateResolverEndpoint: func(*awsroute53resolver.CreateResolverEndpointInput) awsroute53resolver.CreateResolverEndpointRequest {
+						return awsroute53resolver.CreateResolverEndpointRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: endpoint(),
+			},
+			want: want{
+				cr:  endpoint(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResolverEndpoint
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	update := func(err error) func(*awsroute53resolver.UpdateResolverEndpointInput) awsroute53resolver.UpdateResolverEndpointRequest {
+		return func(*awsroute53resolver.UpdateResolverEndpointInput) awsroute53resolver.UpdateResolverEndpointRequest {
+			return awsroute53resolver.UpdateResolverEndpointRequest{Request: request(&awsroute53resolver.UpdateResolverEndpointOutput{}, err)}
+		}
+	}
+	// The address in subnet-b moved to another IP.
+	addrs := []awsroute53resolver.IpAddressResponse{
+		{IpId: aws.String("rni-a"), Ip: aws.String("10.0.0.10"), SubnetId: aws.String(subnetA)},
+		{IpId: aws.String("rni-b"), Ip: aws.String("10.0.1.20"), SubnetId: aws.String(subnetB)},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockUpdateResolverEndpoint:          update(nil),
+					MockListResolverEndpointIpAddresses: list(addrs, nil),
+					MockAssociateResolverEndpointIpAddress: func(i *awsroute53resolver.AssociateResolverEndpointIpAddressInput) awsroute53resolver.AssociateResolverEndpointIpAddressRequest {
+						want := &awsroute53resolver.IpAddressUpdate{Ip: aws.String("10.0.1.10"), SubnetId: aws.String(subnetB)}
+						if diff := cmp.Diff(want, i.IpAddress); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsroute53resolver.AssociateResolverEndpointIpAddressRequest{Request: request(&awsroute53resolver.AssociateResolverEndpointIpAddressOutput{}, nil)}
+					},
+					MockDisassociateResolverEndpointIpAddress: func(i *awsroute53resolver.DisassociateResolverEndpointIpAddressInput) awsroute53resolver.DisassociateResolverEndpointIpAddressRequest {
+						if diff := cmp.Diff(aws.String("rni-b"), i.IpAddress.IpId); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsroute53resolver.DisassociateResolverEndpointIpAddressRequest{Request: request(&awsroute53resolver.DisassociateResolverEndpointIpAddressOutput{}, nil)}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID), withName("renamed")),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withName("renamed")),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockUpdateResolverEndpoint: update(errBoom),
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+		"FailedAssociate": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockUpdateResolverEndpoint:          update(nil),
+					MockListResolverEndpointIpAddresses: list(addrs, nil),
+					MockAssociateResolverEndpointIpAddress: func(*awsroute53resolver.AssociateResolverEndpointIpAddressInput) awsroute53resolver.AssociateResolverEndpointIpAddressRequest {
+						return awsroute53resolver.AssociateResolverEndpointIpAddressRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID)),
+				err: errors.Wrap(errBoom, errAssociateIPAddress),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ResolverEndpoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockDeleteResolverEndpoint: func(*awsroute53resolver.DeleteResolverEndpointInput) awsroute53resolver.DeleteResolverEndpointRequest {
+						return awsroute53resolver.DeleteResolverEndpointRequest{Request: request(&awsroute53resolver.DeleteResolverEndpointOutput{}, nil)}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockDeleteResolverEndpoint: func(*awsroute53resolver.DeleteResolverEndpointInput) awsroute53resolver.DeleteResolverEndpointRequest {
+						return awsroute53resolver.DeleteResolverEndpointRequest{Request: request(nil, awserr.New(awsroute53resolver.ErrCodeResourceNotFoundException, "", nil))}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverEndpointClient{
+					MockDeleteResolverEndpoint: func(*awsroute53resolver.DeleteResolverEndpointInput) awsroute53resolver.DeleteResolverEndpointRequest {
+						return awsroute53resolver.DeleteResolverEndpointRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,597 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolverrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsroute53resolver "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	ruleID     = "rslvr-rr-1234567890"
+	endpointID = "rslvr-out-1234567890"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client route53resolver.ResolverRuleClient
+	kube   client.Client
+	cr     *v1alpha1.ResolverRule
+}
+
+type ruleModifier func(*v1alpha1.ResolverRule)
+
+func withExternalName(n string) ruleModifier {
+	return func(r *v1alpha1.ResolverRule) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) ruleModifier {
+	return func(r *v1alpha1.ResolverRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.ResolverRuleObservation) ruleModifier {
+	return func(r *v1alpha1.ResolverRule) { r.Status.AtProvider = o }
+}
+
+func withTargetIPs(t ...v1alpha1.TargetAddress) ruleModifier {
+	return func(r *v1alpha1.ResolverRule) { r.Spec.ForProvider.TargetIPs = t }
+}
+
+func rule(m ...ruleModifier) *v1alpha1.ResolverRule {
+	cr := &v1alpha1.ResolverRule{
+		Spec: v1alpha1.ResolverRuleSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ResolverRuleParameters{
+				Name:               aws.String("corp"),
+				DomainName:         "corp.example.com",
+				RuleType:           "FORWARD",
+				ResolverEndpointID: aws.String(endpointID),
+				TargetIPs:          []v1alpha1.TargetAddress{{IP: "192.168.0.2", Port: aws.Int64(53)}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(o *awsroute53resolver.GetResolverRuleOutput, err error) func(*awsroute53resolver.GetResolverRuleInput) awsroute53resolver.GetResolverRuleRequest {
+	return func(*awsroute53resolver.GetResolverRuleInput) awsroute53resolver.GetResolverRuleRequest {
+		return awsroute53resolver.GetResolverRuleRequest{Request: request(o, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (route53resolver.ResolverRuleClient, error)
+		cr          *v1alpha1.ResolverRule
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i route53resolver.ResolverRuleClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: rule(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i route53resolver.ResolverRuleClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: rule(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResolverRule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := &awsroute53resolver.GetResolverRuleOutput{ResolverRule: &awsroute53resolver.ResolverRule{
+		Id:                 aws.String(ruleID),
+		Arn:                aws.String("arn"),
+		Name:               aws.String("corp"),
+		DomainName:         aws.String("corp.example.com."),
+		RuleType:           awsroute53resolver.RuleTypeOptionForward,
+		ResolverEndpointId: aws.String(endpointID),
+		TargetIps:          []awsroute53resolver.TargetAddress{{Ip: aws.String("192.168.0.2"), Port: aws.Int64(53)}},
+		OwnerId:            aws.String("123456789012"),
+		ShareStatus:        awsroute53resolver.ShareStatusNotShared,
+		Status:             awsroute53resolver.ResolverRuleStatusComplete,
+	}}
+	status := v1alpha1.ResolverRuleObservation{
+		ARN:         "arn",
+		OwnerID:     "123456789012",
+		ShareStatus: "NOT_SHARED",
+		Status:      "COMPLETE",
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockResolverRuleClient{
+					MockGetResolverRule: get(observed, nil),
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(
+					withExternalName(ruleID),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockResolverRuleClient{
+					MockGetResolverRule: get(observed, nil),
+				},
+				cr: rule(withExternalName(ruleID), withTargetIPs(v1alpha1.TargetAddress{IP: "192.168.0.2"})),
+			},
+			want: want{
+				cr: rule(
+					withExternalName(ruleID),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TargetIPsChanged": {
+			args: args{
+				client: &fake.MockResolverRuleClient{
+					MockGetResolverRule: get(observed, nil),
+				},
+				cr: rule(withExternalName(ruleID), withTargetIPs(v1alpha1.TargetAddress{IP: "192.168.0.3", Port: aws.Int64(53)})),
+			},
+			want: want{
+				cr: rule(
+					withExternalName(ruleID),
+					withTargetIPs(v1alpha1.TargetAddress{IP: "192.168.0.3", Port: aws.Int64(53)}),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockResolverRuleClient{
+					MockGetResolverRule: get(nil, awserr.New(awsroute53resolver.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockResolverRuleClient{
+					MockGetResolverRule: get(nil, errBoom),
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr:  rule(withExternalName(ruleID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResolverRule
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockResolverRuleClient{
+					MockCreateResolverRule: func(*awsroute53resolver.CreateResolverRuleInput) awsroute53resolver.CreateResolverRuleRequest {
+						return awsroute53resolver.CreateResolverRuleRequest{Request: request(&awsroute53resolver.CreateResolverRuleOutput{
+							ResolverRule: &awsroute53resolver.ResolverRule{Id: aws.String(ruleID)},
+						}, nil)}
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(
+					withExternalName(ruleID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverRuleClient{
+					MockCreateResolverRule: func(*awsroute53resolver.CreateResolverRuleInput) awsroute53resolver.CreateResolverRuleRequest {
+						return awsroute53resolver.CreateResolverRuleRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResolverRule
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverRuleClient{
+					MockUpdateResolverRule: func(*awsroute53resolver.UpdateResolverRuleInput) awsroute53resolver.UpdateResolverRuleRequest {
+						return awsroute53resolver.UpdateResolverRuleRequest{Request: request(&awsroute53resolver.UpdateResolverRuleOutput{}, nil)}
+					},
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverRuleClient{
+					MockUpdateResolverRule: func(*awsroute53resolver.UpdateResolverRuleInput) awsroute53resolver.UpdateResolverRuleRequest {
+						return awsroute53resolver.UpdateResolverRuleRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr:  rule(withExternalName(ruleID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ResolverRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverRuleClient{
+					MockDeleteResolverRule: func(*awsroute53resolver.DeleteResolverRuleInput) awsroute53resolver.DeleteResolverRuleRequest {
+						return awsroute53resolver.DeleteResolverRuleRequest{Request: request(&awsroute53resolver.DeleteResolverRuleOutput{}, nil)}
+					},
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverRuleClient{
+					MockDeleteResolverRule: func(*awsroute53resolver.DeleteResolverRuleInput) awsroute53resolver.DeleteResolverRuleRequest {
+						return awsroute53resolver.DeleteResolverRuleRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr:  rule(withExternalName(ruleID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
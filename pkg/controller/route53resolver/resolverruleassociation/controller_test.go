@@ -0,0 +1,499 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolverruleassociation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsroute53resolver "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver"
+	"github.com/crossplane/provider-aws/pkg/clients/route53resolver/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	associationID = "rslvr-rrassoc-1234567890"
+	ruleID        = "rslvr-rr-1234567890"
+	vpcID         = "vpc-1"
+	errBoom       = errors.New("boom")
+)
+
+type args struct {
+	client route53resolver.ResolverRuleAssociationClient
+	kube   client.Client
+	cr     *v1alpha1.ResolverRuleAssociation
+}
+
+type associationModifier func(*v1alpha1.ResolverRuleAssociation)
+
+func withExternalName(n string) associationModifier {
+	return func(r *v1alpha1.ResolverRuleAssociation) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) associationModifier {
+	return func(r *v1alpha1.ResolverRuleAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.ResolverRuleAssociationObservation) associationModifier {
+	return func(r *v1alpha1.ResolverRuleAssociation) { r.Status.AtProvider = o }
+}
+
+func association(m ...associationModifier) *v1alpha1.ResolverRuleAssociation {
+	cr := &v1alpha1.ResolverRuleAssociation{
+		Spec: v1alpha1.ResolverRuleAssociationSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ResolverRuleAssociationParameters{
+				ResolverRuleID: aws.String(ruleID),
+				VPCID:          aws.String(vpcID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(s awsroute53resolver.ResolverRuleAssociationStatus, err error) func(*awsroute53resolver.GetResolverRuleAssociationInput) awsroute53resolver.GetResolverRuleAssociationRequest {
+	return func(*awsroute53resolver.GetResolverRuleAssociationInput) awsroute53resolver.GetResolverRuleAssociationRequest {
+		return awsroute53resolver.GetResolverRuleAssociationRequest{Request: request(&awsroute53resolver.GetResolverRuleAssociationOutput{
+			ResolverRuleAssociation: &awsroute53resolver.ResolverRuleAssociation{
+				Id:             aws.String(associationID),
+				ResolverRuleId: aws.String(ruleID),
+				VPCId:          aws.String(vpcID),
+				Status:         s,
+			},
+		}, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (route53resolver.ResolverRuleAssociationClient, error)
+		cr          *v1alpha1.ResolverRuleAssociation
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i route53resolver.ResolverRuleAssociationClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: association(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i route53resolver.ResolverRuleAssociationClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: association(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResolverRuleAssociation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociation: get(awsroute53resolver.ResolverRuleAssociationStatusComplete, nil),
+				},
+				cr: association(withExternalName(associationID)),
+			},
+			want: want{
+				cr: association(
+					withExternalName(associationID),
+					withStatus(v1alpha1.ResolverRuleAssociationObservation{Status: "COMPLETE"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociation: get(awsroute53resolver.ResolverRuleAssociationStatusFailed, nil),
+				},
+				cr: association(withExternalName(associationID)),
+			},
+			want: want{
+				cr: association(
+					withExternalName(associationID),
+					withStatus(v1alpha1.ResolverRuleAssociationObservation{Status: "FAILED"}),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociation: get("", awserr.New(awsroute53resolver.ErrCodeResourceNotFoundException, "", nil)),
+				},
+				cr: association(withExternalName(associationID)),
+			},
+			want: want{
+				cr: association(withExternalName(associationID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociation: get("", errBoom),
+				},
+				cr: association(withExternalName(associationID)),
+			},
+			want: want{
+				cr:  association(withExternalName(associationID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ResolverRuleAssociation
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockResolverRuleAssociationClient{
+					MockAssociateResolverRule: func(*awsroute53resolver.AssociateResolverRuleInput) awsroute53resolver.AssociateResolverRuleRequest {
+						return awsroute53resolver.AssociateResolverRuleRequest{Request: request(&awsroute53resolver.AssociateResolverRuleOutput{
+							ResolverRuleAssociation: &awsroute53resolver.ResolverRuleAssociation{Id: aws.String(associationID)},
+						}, nil)}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(
+					withExternalName(associationID),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockAssociateResolverRule: func(*awsroute53resolver.AssociateResolverRuleInput) awsroute53resolver.AssociateResolverRuleRequest {
+						return awsroute53resolver.AssociateResolverRuleRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ResolverRuleAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockDisassociateResolverRule: func(*awsroute53resolver.DisassociateResolverRuleInput) awsroute53resolver.DisassociateResolverRuleRequest {
+						return awsroute53resolver.DisassociateResolverRuleRequest{Request: request(&awsroute53resolver.DisassociateResolverRuleOutput{}, nil)}
+					},
+				},
+				cr: association(withExternalName(associationID)),
+			},
+			want: want{
+				cr: association(withExternalName(associationID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockDisassociateResolverRule: func(*awsroute53resolver.DisassociateResolverRuleInput) awsroute53resolver.DisassociateResolverRuleRequest {
+						return awsroute53resolver.DisassociateResolverRuleRequest{Request: request(nil, errBoom)}
+					},
+				},
+				cr: association(withExternalName(associationID)),
+			},
+			want: want{
+				cr:  association(withExternalName(associationID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketobject
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an S3 BucketObject resource"
+	errCreateClient      = "cannot create S3 client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the S3 BucketObject custom resource"
+
+	errDescribe = "cannot describe S3 BucketObject"
+	errCreate   = "cannot create S3 BucketObject"
+	errUpdate   = "cannot update S3 BucketObject"
+	errDelete   = "cannot delete S3 BucketObject"
+
+	errGetContent = "cannot get content of S3 BucketObject"
+	errNoContent  = "either content or contentFrom must be set"
+)
+
+// SetupBucketObject adds a controller that reconciles S3 bucket objects.
+func SetupBucketObject(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.BucketObjectGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.BucketObject{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BucketObjectGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewBucketObjectClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (s3.BucketObjectClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BucketObject)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client s3.BucketObjectClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.BucketObject)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.HeadObjectRequest(&awss3.HeadObjectInput{
+		Bucket: cr.Spec.ForProvider.Bucket,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(s3.IsObjectNotFound, err), errDescribe)
+	}
+	observed := *rsp.HeadObjectOutput
+
+	content, err := e.getContent(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetContent)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	s3.LateInitializeBucketObject(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	upToDate := s3.IsBucketObjectUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider, s3.ContentMD5(content), observed)
+	cr.Status.AtProvider = s3.GenerateBucketObjectObservation(cr.Status.AtProvider, observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) getContent(ctx context.Context, p v1alpha1.BucketObjectParameters) ([]byte, error) {
+	switch {
+	case p.Content != nil:
+		return []byte(*p.Content), nil
+	case p.ContentFrom != nil && p.ContentFrom.ConfigMapKeyRef != nil:
+		ref := p.ContentFrom.ConfigMapKeyRef
+		cm := &corev1.ConfigMap{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return nil, err
+		}
+		if v, ok := cm.Data[ref.Key]; ok {
+			return []byte(v), nil
+		}
+		if v, ok := cm.BinaryData[ref.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Errorf("key %s not found in ConfigMap %s/%s", ref.Key, ref.Namespace, ref.Name)
+	case p.ContentFrom != nil && p.ContentFrom.SecretKeyRef != nil:
+		ref := p.ContentFrom.SecretKeyRef
+		s := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, err
+		}
+		if v, ok := s.Data[ref.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Errorf("key %s not found in Secret %s/%s", ref.Key, ref.Namespace, ref.Name)
+	}
+	return nil, errors.New(errNoContent)
+}
+
+func (e *external) upload(ctx context.Context, cr *v1alpha1.BucketObject) error {
+	content, err := e.getContent(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errGetContent)
+	}
+	rsp, err := e.client.PutObjectRequest(s3.GeneratePutObjectInput(cr.Spec.ForProvider, content)).Send(ctx)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.ETag = aws.StringValue(rsp.ETag)
+	cr.Status.AtProvider.ContentMD5 = s3.ContentMD5(content)
+	return nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.BucketObject)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	return managed.ExternalCreation{}, errors.Wrap(e.upload(ctx, cr), errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.BucketObject)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.upload(ctx, cr), errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.BucketObject)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteObjectRequest(&awss3.DeleteObjectInput{
+		Bucket: cr.Spec.ForProvider.Bucket,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(s3.IsObjectNotFound, err), errDelete)
+}
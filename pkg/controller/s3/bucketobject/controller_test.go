@@ -0,0 +1,611 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketobject
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	bucket  = "bootstrap"
+	key     = "scripts/init.sh"
+	content = "#!/bin/sh\necho hello\n"
+	// The MD5 digest of content.
+	contentMD5 = "d604a220708aa59433ba410986cd4ffa"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	client s3.BucketObjectClient
+	kube   client.Client
+	cr     *v1alpha1.BucketObject
+}
+
+type objectModifier func(*v1alpha1.BucketObject)
+
+func withConditions(c ...runtimev1alpha1.Condition) objectModifier {
+	return func(r *v1alpha1.BucketObject) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.BucketObjectObservation) objectModifier {
+	return func(r *v1alpha1.BucketObject) { r.Status.AtProvider = o }
+}
+
+func withContent(c string) objectModifier {
+	return func(r *v1alpha1.BucketObject) { r.Spec.ForProvider.Content = aws.String(c) }
+}
+
+func object(m ...objectModifier) *v1alpha1.BucketObject {
+	cr := &v1alpha1.BucketObject{
+		Spec: v1alpha1.BucketObjectSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.BucketObjectParameters{
+				Bucket:               aws.String(bucket),
+				Key:                  key,
+				Content:              aws.String(content),
+				ContentType:          aws.String("text/x-sh"),
+				ServerSideEncryption: aws.String("AES256"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func head(etag string, err error) func(*awss3.HeadObjectInput) awss3.HeadObjectRequest {
+	return func(*awss3.HeadObjectInput) awss3.HeadObjectRequest {
+		return awss3.HeadObjectRequest{Request: request(&awss3.HeadObjectOutput{
+			ETag:                 aws.String(etag),
+			ContentType:          aws.String("text/x-sh"),
+			ContentLength:        aws.Int64(int64(len(content))),
+			ServerSideEncryption: awss3.ServerSideEncryptionAes256,
+		}, err)}
+	}
+}
+
+func put(etag string, err error) func(*awss3.PutObjectInput) awss3.PutObjectRequest {
+	return func(*awss3.PutObjectInput) awss3.PutObjectRequest {
+		return awss3.PutObjectRequest{Request: request(&awss3.PutObjectOutput{ETag: aws.String(etag)}, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (s3.BucketObjectClient, error)
+		cr          *v1alpha1.BucketObject
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i s3.BucketObjectClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: object(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i s3.BucketObjectClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: object(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.BucketObject
+		result managed.ExternalObservation
+		err    error
+	}
+
+	etag := `"` + contentMD5 + `"`
+	status := v1alpha1.BucketObjectObservation{ETag: etag, ContentLength: int64(len(content))}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockHeadObject: head(etag, nil),
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockHeadObject: head(etag, nil),
+				},
+				cr: object(withContent("other")),
+			},
+			want: want{
+				cr: object(
+					withContent("other"),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"EncryptedWithKMS": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockHeadObject: head(`"kms-etag"`, nil),
+				},
+				cr: object(withStatus(v1alpha1.BucketObjectObservation{ETag: `"kms-etag"`, ContentMD5: contentMD5})),
+			},
+			want: want{
+				cr: object(
+					withStatus(v1alpha1.BucketObjectObservation{ETag: `"kms-etag"`, ContentMD5: contentMD5, ContentLength: int64(len(content))}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentFromConfigMap": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key != (client.ObjectKey{Namespace: "default", Name: "scripts"}) {
+							return errBoom
+						}
+						obj.(*corev1.ConfigMap).Data = map[string]string{"init.sh": content}
+						return nil
+					},
+				},
+				client: &fake.MockBucketObjectClient{
+					MockHeadObject: head(etag, nil),
+				},
+				cr: object(func(r *v1alpha1.BucketObject) {
+					r.Spec.ForProvider.Content = nil
+					r.Spec.ForProvider.ContentFrom = &v1alpha1.ContentSource{
+						ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Namespace: "default", Name: "scripts", Key: "init.sh"},
+					}
+				}),
+			},
+			want: want{
+				cr: object(func(r *v1alpha1.BucketObject) {
+					r.Spec.ForProvider.Content = nil
+					r.Spec.ForProvider.ContentFrom = &v1alpha1.ContentSource{
+						ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Namespace: "default", Name: "scripts", Key: "init.sh"},
+					}
+				}, withStatus(status), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoContent": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockHeadObject: head(etag, nil),
+				},
+				cr: object(func(r *v1alpha1.BucketObject) { r.Spec.ForProvider.Content = nil }),
+			},
+			want: want{
+				cr:  object(func(r *v1alpha1.BucketObject) { r.Spec.ForProvider.Content = nil }),
+				err: errors.Wrap(errors.New(errNoContent), errGetContent),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockHeadObject: head("", awserr.New("NotFound", "", nil)),
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(),
+			},
+		},
+		"FailedHeadRequest": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockHeadObject: head("", errBoom),
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.BucketObject
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockPutObject: put(`"etag"`, nil),
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(
+					withStatus(v1alpha1.BucketObjectObservation{ETag: `"etag"`, ContentMD5: contentMD5}),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockPutObject: put("", errBoom),
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.BucketObject
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockPutObject: put(`"etag"`, nil),
+				},
+				cr: object(withStatus(v1alpha1.BucketObjectObservation{ETag: `"old"`, ContentMD5: "old"})),
+			},
+			want: want{
+				cr: object(withStatus(v1alpha1.BucketObjectObservation{ETag: `"etag"`, ContentMD5: contentMD5})),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockPutObject: put("", errBoom),
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.BucketObject
+		err error
+	}
+
+	del := func(err error) func(*awss3.DeleteObjectInput) awss3.DeleteObjectRequest {
+		return func(*awss3.DeleteObjectInput) awss3.DeleteObjectRequest {
+			return awss3.DeleteObjectRequest{Request: request(&awss3.DeleteObjectOutput{}, err)}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockDeleteObject: del(nil),
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockBucketObjectClient{
+					MockDeleteObject: del(errBoom),
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,9 +22,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/crossplane/provider-aws/apis/storage/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+	"github.com/crossplane/provider-aws/pkg/controller/shard"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -51,6 +52,7 @@ func SetupBucketClaimScheduling(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&storagev1alpha1.Bucket{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasClassSelector(),
@@ -72,6 +74,7 @@ func SetupBucketClaimDefaulting(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&storagev1alpha1.Bucket{}).
 		WithEventFilter(resource.NewPredicates(resource.AllOf(
 			resource.HasNoClassSelector(),
@@ -112,7 +115,8 @@ func SetupBucketClaimBinding(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		Watches(&source.Kind{Type: &v1alpha3.S3Bucket{}}, &resource.EnqueueRequestForClaim{}).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		Watches(shard.NewKind(mgr.GetCache(), &v1alpha3.S3Bucket{}), &resource.EnqueueRequestForClaim{}).
 		For(&storagev1alpha1.Bucket{}).
 		WithEventFilter(p).
 		Complete(r)
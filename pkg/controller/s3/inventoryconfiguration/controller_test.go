@@ -0,0 +1,542 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventoryconfiguration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	bucket  = "logs"
+	id      = "daily"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client s3.InventoryConfigurationClient
+	kube   client.Client
+	cr     *v1alpha1.InventoryConfiguration
+}
+
+type configurationModifier func(*v1alpha1.InventoryConfiguration)
+
+func withConditions(c ...runtimev1alpha1.Condition) configurationModifier {
+	return func(r *v1alpha1.InventoryConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withFrequency(f string) configurationModifier {
+	return func(r *v1alpha1.InventoryConfiguration) { r.Spec.ForProvider.Schedule.Frequency = f }
+}
+
+func configuration(m ...configurationModifier) *v1alpha1.InventoryConfiguration {
+	cr := &v1alpha1.InventoryConfiguration{
+		Spec: v1alpha1.InventoryConfigurationSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.InventoryConfigurationParameters{
+				Bucket: aws.String(bucket),
+				Destination: v1alpha1.InventoryDestination{
+					Bucket: aws.String("reports"),
+					Format: "CSV",
+				},
+				IncludedObjectVersions: "Current",
+				IsEnabled:              true,
+				Schedule:               v1alpha1.InventorySchedule{Frequency: "Daily"},
+			},
+		},
+	}
+	meta.SetExternalName(cr, id)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (s3.InventoryConfigurationClient, error)
+		cr          *v1alpha1.InventoryConfiguration
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i s3.InventoryConfigurationClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: configuration(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i s3.InventoryConfigurationClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: configuration(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: configuration(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: configuration(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: configuration(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.InventoryConfiguration
+		result managed.ExternalObservation
+		err    error
+	}
+
+	get := func(c *awss3.InventoryConfiguration, err error) func(*awss3.GetBucketInventoryConfigurationInput) awss3.GetBucketInventoryConfigurationRequest {
+		return func(*awss3.GetBucketInventoryConfigurationInput) awss3.GetBucketInventoryConfigurationRequest {
+			return awss3.GetBucketInventoryConfigurationRequest{Request: request(&awss3.GetBucketInventoryConfigurationOutput{InventoryConfiguration: c}, err)}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockGetBucketInventoryConfiguration: get(s3.GenerateInventoryConfiguration(id, awsclients.PartitionAWS, configuration().Spec.ForProvider), nil),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr: configuration(withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockGetBucketInventoryConfiguration: get(s3.GenerateInventoryConfiguration(id, awsclients.PartitionAWS, configuration().Spec.ForProvider), nil),
+				},
+				cr: configuration(withFrequency("Weekly")),
+			},
+			want: want{
+				cr: configuration(withFrequency("Weekly"), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockGetBucketInventoryConfiguration: get(nil, awserr.New("NoSuchConfiguration", "", nil)),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr: configuration(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockGetBucketInventoryConfiguration: get(nil, errBoom),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr:  configuration(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, partition: awsclients.PartitionAWS}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func put(err error) func(*awss3.PutBucketInventoryConfigurationInput) awss3.PutBucketInventoryConfigurationRequest {
+	return func(*awss3.PutBucketInventoryConfigurationInput) awss3.PutBucketInventoryConfigurationRequest {
+		return awss3.PutBucketInventoryConfigurationRequest{Request: request(&awss3.PutBucketInventoryConfigurationOutput{}, err)}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.InventoryConfiguration
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockPutBucketInventoryConfiguration: put(nil),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr: configuration(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockPutBucketInventoryConfiguration: put(errBoom),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr:  configuration(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, partition: awsclients.PartitionAWS}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.InventoryConfiguration
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockPutBucketInventoryConfiguration: put(nil),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr: configuration(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockPutBucketInventoryConfiguration: put(errBoom),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr:  configuration(),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, partition: awsclients.PartitionAWS}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.InventoryConfiguration
+		err error
+	}
+
+	del := func(err error) func(*awss3.DeleteBucketInventoryConfigurationInput) awss3.DeleteBucketInventoryConfigurationRequest {
+		return func(*awss3.DeleteBucketInventoryConfigurationInput) awss3.DeleteBucketInventoryConfigurationRequest {
+			return awss3.DeleteBucketInventoryConfigurationRequest{Request: request(&awss3.DeleteBucketInventoryConfigurationOutput{}, err)}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockDeleteBucketInventoryConfiguration: del(nil),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr: configuration(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockDeleteBucketInventoryConfiguration: del(awserr.New("NoSuchConfiguration", "", nil)),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr: configuration(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockInventoryConfigurationClient{
+					MockDeleteBucketInventoryConfiguration: del(errBoom),
+				},
+				cr: configuration(),
+			},
+			want: want{
+				cr:  configuration(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, partition: awsclients.PartitionAWS}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
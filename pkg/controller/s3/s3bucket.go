@@ -30,6 +30,7 @@ import (
 	bucketv1alpha3 "github.com/crossplane/provider-aws/apis/storage/v1alpha3"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
 	"github.com/crossplane/provider-aws/pkg/controller/utils"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
@@ -83,6 +84,7 @@ func SetupS3Bucket(mgr ctrl.Manager, l logging.Logger) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
 		For(&bucketv1alpha3.S3Bucket{}).
 		Owns(&corev1.Secret{}).
 		Complete(r)
@@ -0,0 +1,237 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3control "github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an S3 AccessPoint resource"
+	errCreateClient      = "cannot create S3 Control client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the S3 AccessPoint custom resource"
+
+	errDescribe = "cannot describe S3 AccessPoint"
+	errCreate   = "cannot create S3 AccessPoint"
+	errDelete   = "cannot delete S3 AccessPoint"
+
+	errGetPolicy     = "cannot get policy of S3 AccessPoint"
+	errPutPolicy     = "cannot put policy of S3 AccessPoint"
+	errDeletePolicy  = "cannot delete policy of S3 AccessPoint"
+	errComparePolicy = "cannot compare policy of S3 AccessPoint"
+)
+
+// SetupAccessPoint adds a controller that reconciles S3 access points.
+func SetupAccessPoint(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AccessPointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.AccessPoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AccessPointGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3control.NewAccessPointClient}))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (s3control.AccessPointClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client s3control.AccessPointClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.GetAccessPointRequest(&awss3control.GetAccessPointInput{
+		AccountId: aws.String(cr.Spec.ForProvider.AccountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(s3control.IsAccessPointNotFound, err), errDescribe)
+	}
+
+	policy, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPolicy)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	s3control.LateInitializeAccessPoint(&cr.Spec.ForProvider, *rsp.GetAccessPointOutput)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = v1alpha1.AccessPointObservation{NetworkOrigin: string(rsp.NetworkOrigin)}
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	upToDate, err := s3control.IsAccessPointPolicyUpToDate(cr.Spec.ForProvider.Policy, policy)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errComparePolicy)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) getPolicy(ctx context.Context, cr *v1alpha1.AccessPoint) (*string, error) {
+	rsp, err := e.client.GetAccessPointPolicyRequest(&awss3control.GetAccessPointPolicyInput{
+		AccountId: aws.String(cr.Spec.ForProvider.AccountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if s3control.IsAccessPointPolicyNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Policy, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+	if _, err := e.client.CreateAccessPointRequest(s3control.GenerateCreateAccessPointInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if cr.Spec.ForProvider.Policy == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err := e.client.PutAccessPointPolicyRequest(&awss3control.PutAccessPointPolicyInput{
+		AccountId: aws.String(cr.Spec.ForProvider.AccountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+		Policy:    cr.Spec.ForProvider.Policy,
+	}).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errPutPolicy)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	if cr.Spec.ForProvider.Policy == nil {
+		_, err := e.client.DeleteAccessPointPolicyRequest(&awss3control.DeleteAccessPointPolicyInput{
+			AccountId: aws.String(cr.Spec.ForProvider.AccountID),
+			Name:      aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(s3control.IsAccessPointPolicyNotFound, err), errDeletePolicy)
+	}
+	_, err := e.client.PutAccessPointPolicyRequest(&awss3control.PutAccessPointPolicyInput{
+		AccountId: aws.String(cr.Spec.ForProvider.AccountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+		Policy:    cr.Spec.ForProvider.Policy,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errPutPolicy)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.client.DeleteAccessPointRequest(&awss3control.DeleteAccessPointInput{
+		AccountId: aws.String(cr.Spec.ForProvider.AccountID),
+		Name:      aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(s3control.IsAccessPointNotFound, err), errDelete)
+}
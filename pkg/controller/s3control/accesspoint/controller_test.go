@@ -0,0 +1,689 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awss3control "github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	accountID = "123456789012"
+	apName    = "analytics"
+	policy    = `{"Version": "2012-10-17", "Statement": []}`
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client s3control.AccessPointClient
+	kube   client.Client
+	cr     *v1alpha1.AccessPoint
+}
+
+type accessPointModifier func(*v1alpha1.AccessPoint)
+
+func withConditions(c ...runtimev1alpha1.Condition) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(o v1alpha1.AccessPointObservation) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Status.AtProvider = o }
+}
+
+func withPolicy(p *string) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Spec.ForProvider.Policy = p }
+}
+
+func accessPoint(m ...accessPointModifier) *v1alpha1.AccessPoint {
+	cr := &v1alpha1.AccessPoint{
+		Spec: v1alpha1.AccessPointSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.AccessPointParameters{
+				AccountID: accountID,
+				Bucket:    aws.String("data"),
+				VPCID:     aws.String("vpc-1"),
+				PublicAccessBlockConfiguration: &v1alpha1.PublicAccessBlockConfiguration{
+					BlockPublicACLs:       aws.Bool(true),
+					IgnorePublicACLs:      aws.Bool(true),
+					BlockPublicPolicy:     aws.Bool(true),
+					RestrictPublicBuckets: aws.Bool(true),
+				},
+				Policy: aws.String(policy),
+			},
+		},
+	}
+	meta.SetExternalName(cr, apName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(data interface{}, err error) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: data, Error: err}
+}
+
+func get(err error) func(*awss3control.GetAccessPointInput) awss3control.GetAccessPointRequest {
+	return func(*awss3control.GetAccessPointInput) awss3control.GetAccessPointRequest {
+		return awss3control.GetAccessPointRequest{Request: request(&awss3control.GetAccessPointOutput{
+			Name:          aws.String(apName),
+			Bucket:        aws.String("data"),
+			NetworkOrigin: awss3control.NetworkOriginVpc,
+			VpcConfiguration: &awss3control.VpcConfiguration{
+				VpcId: aws.String("vpc-1"),
+			},
+			PublicAccessBlockConfiguration: &awss3control.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+		}, err)}
+	}
+}
+
+func getPolicy(p *string, err error) func(*awss3control.GetAccessPointPolicyInput) awss3control.GetAccessPointPolicyRequest {
+	return func(*awss3control.GetAccessPointPolicyInput) awss3control.GetAccessPointPolicyRequest {
+		return awss3control.GetAccessPointPolicyRequest{Request: request(&awss3control.GetAccessPointPolicyOutput{Policy: p}, err)}
+	}
+}
+
+func putPolicy(err error) func(*awss3control.PutAccessPointPolicyInput) awss3control.PutAccessPointPolicyRequest {
+	return func(*awss3control.PutAccessPointPolicyInput) awss3control.PutAccessPointPolicyRequest {
+		return awss3control.PutAccessPointPolicyRequest{Request: request(&awss3control.PutAccessPointPolicyOutput{}, err)}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (s3control.AccessPointClient, error)
+		cr          *v1alpha1.AccessPoint
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i s3control.AccessPointClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: accessPoint(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i s3control.AccessPointClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: accessPoint(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AccessPoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	status := v1alpha1.AccessPointObservation{NetworkOrigin: "VPC"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint:       get(nil),
+					MockGetAccessPointPolicy: getPolicy(aws.String(`{"Version":"2012-10-17","Statement":[]}`), nil),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PolicyMissing": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint:       get(nil),
+					MockGetAccessPointPolicy: getPolicy(nil, awserr.New("NoSuchAccessPointPolicy", "", nil)),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PolicyRemoved": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint:       get(nil),
+					MockGetAccessPointPolicy: getPolicy(aws.String(policy), nil),
+				},
+				cr: accessPoint(withPolicy(nil)),
+			},
+			want: want{
+				cr: accessPoint(
+					withPolicy(nil),
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint:       get(nil),
+					MockGetAccessPointPolicy: getPolicy(aws.String(policy), nil),
+				},
+				cr: accessPoint(func(r *v1alpha1.AccessPoint) { r.Spec.ForProvider.PublicAccessBlockConfiguration = nil }),
+			},
+			want: want{
+				cr: accessPoint(
+					withStatus(status),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint: get(awserr.New("NoSuchAccessPoint", "", nil)),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint: get(errBoom),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedGetPolicyRequest": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint:       get(nil),
+					MockGetAccessPointPolicy: getPolicy(nil, errBoom),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: errors.Wrap(errBoom, errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AccessPoint
+		result managed.ExternalCreation
+		err    error
+	}
+
+	create := func(err error) func(*awss3control.CreateAccessPointInput) awss3control.CreateAccessPointRequest {
+		return func(*awss3control.CreateAccessPointInput) awss3control.CreateAccessPointRequest {
+			return awss3control.CreateAccessPointRequest{Request: request(&awss3control.CreateAccessPointOutput{}, err)}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockCreateAccessPoint:    create(nil),
+					MockPutAccessPointPolicy: putPolicy(nil),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"SuccessfulWithoutPolicy": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockCreateAccessPoint: create(nil),
+				},
+				cr: accessPoint(withPolicy(nil)),
+			},
+			want: want{
+				cr: accessPoint(withPolicy(nil), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockCreateAccessPoint: create(errBoom),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+		"FailedPutPolicy": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockCreateAccessPoint:    create(nil),
+					MockPutAccessPointPolicy: putPolicy(errBoom),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errPutPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AccessPoint
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	deletePolicy := func(err error) func(*awss3control.DeleteAccessPointPolicyInput) awss3control.DeleteAccessPointPolicyRequest {
+		return func(*awss3control.DeleteAccessPointPolicyInput) awss3control.DeleteAccessPointPolicyRequest {
+			return awss3control.DeleteAccessPointPolicyRequest{Request: request(&awss3control.DeleteAccessPointPolicyOutput{}, err)}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PolicyPut": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockPutAccessPointPolicy: putPolicy(nil),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(),
+			},
+		},
+		"PolicyDeleted": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPointPolicy: deletePolicy(nil),
+				},
+				cr: accessPoint(withPolicy(nil)),
+			},
+			want: want{
+				cr: accessPoint(withPolicy(nil)),
+			},
+		},
+		"FailedPutPolicy": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockPutAccessPointPolicy: putPolicy(errBoom),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: errors.Wrap(errBoom, errPutPolicy),
+			},
+		},
+		"FailedDeletePolicy": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPointPolicy: deletePolicy(errBoom),
+				},
+				cr: accessPoint(withPolicy(nil)),
+			},
+			want: want{
+				cr:  accessPoint(withPolicy(nil)),
+				err: errors.Wrap(errBoom, errDeletePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.AccessPoint
+		err error
+	}
+
+	del := func(err error) func(*awss3control.DeleteAccessPointInput) awss3control.DeleteAccessPointRequest {
+		return func(*awss3control.DeleteAccessPointInput) awss3control.DeleteAccessPointRequest {
+			return awss3control.DeleteAccessPointRequest{Request: request(&awss3control.DeleteAccessPointOutput{}, err)}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPoint: del(nil),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPoint: del(awserr.New("NoSuchAccessPoint", "", nil)),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPoint: del(errBoom),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
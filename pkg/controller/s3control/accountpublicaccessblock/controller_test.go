@@ -0,0 +1,584 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountpublicaccessblock
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awss3control "github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	accountID = "123456789012"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client s3control.AccountPublicAccessBlockClient
+	kube   client.Client
+	cr     *v1alpha1.AccountPublicAccessBlock
+}
+
+type blockModifier func(*v1alpha1.AccountPublicAccessBlock)
+
+func withConditions(c ...runtimev1alpha1.Condition) blockModifier {
+	return func(r *v1alpha1.AccountPublicAccessBlock) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withBlockPublicACLs(b bool) blockModifier {
+	return func(r *v1alpha1.AccountPublicAccessBlock) {
+		r.Spec.ForProvider.PublicAccessBlockConfiguration.BlockPublicACLs = &b
+	}
+}
+
+func withAllFlags(b bool) blockModifier {
+	return func(r *v1alpha1.AccountPublicAccessBlock) {
+		r.Spec.ForProvider.PublicAccessBlockConfiguration = v1alpha1.PublicAccessBlockConfiguration{
+			BlockPublicACLs:       aws.Bool(b),
+			IgnorePublicACLs:      aws.Bool(b),
+			BlockPublicPolicy:     aws.Bool(b),
+			RestrictPublicBuckets: aws.Bool(b),
+		}
+	}
+}
+
+func block(m ...blockModifier) *v1alpha1.AccountPublicAccessBlock {
+	cr := &v1alpha1.AccountPublicAccessBlock{
+		Spec: v1alpha1.AccountPublicAccessBlockSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.AccountPublicAccessBlockParameters{AccountID: accountID},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getBlock(b bool) func(*awss3control.GetPublicAccessBlockInput) awss3control.GetPublicAccessBlockRequest {
+	return func(*awss3control.GetPublicAccessBlockInput) awss3control.GetPublicAccessBlockRequest {
+		return awss3control.GetPublicAccessBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awss3control.GetPublicAccessBlockOutput{
+				PublicAccessBlockConfiguration: &awss3control.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       aws.Bool(b),
+					IgnorePublicAcls:      aws.Bool(b),
+					BlockPublicPolicy:     aws.Bool(b),
+					RestrictPublicBuckets: aws.Bool(b),
+				},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (s3control.AccountPublicAccessBlockClient, error)
+		cr          *v1alpha1.AccountPublicAccessBlock
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i s3control.AccountPublicAccessBlockClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: block(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i s3control.AccountPublicAccessBlockClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: block(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AccountPublicAccessBlock
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialize": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockGetPublicAccessBlock: getBlock(true),
+				},
+				cr: block(),
+			},
+			want: want{
+				cr: block(withAllFlags(true), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockGetPublicAccessBlock: getBlock(false),
+				},
+				cr: block(withBlockPublicACLs(true)),
+			},
+			want: want{
+				cr: block(withAllFlags(false), withBlockPublicACLs(true), withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockGetPublicAccessBlock: func(*awss3control.GetPublicAccessBlockInput) awss3control.GetPublicAccessBlockRequest {
+						return awss3control.GetPublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awss3control.ErrCodeNoSuchPublicAccessBlockConfiguration, "", nil)},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr: block(),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockGetPublicAccessBlock: func(*awss3control.GetPublicAccessBlockInput) awss3control.GetPublicAccessBlockRequest {
+						return awss3control.GetPublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr:  block(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AccountPublicAccessBlock
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockPutPublicAccessBlock: func(input *awss3control.PutPublicAccessBlockInput) awss3control.PutPublicAccessBlockRequest {
+						if diff := cmp.Diff(accountID, aws.StringValue(input.AccountId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(true, aws.BoolValue(input.PublicAccessBlockConfiguration.BlockPublicAcls)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awss3control.PutPublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awss3control.PutPublicAccessBlockOutput{}},
+						}
+					},
+				},
+				cr: block(withBlockPublicACLs(true)),
+			},
+			want: want{
+				cr: block(withBlockPublicACLs(true), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockPutPublicAccessBlock: func(*awss3control.PutPublicAccessBlockInput) awss3control.PutPublicAccessBlockRequest {
+						return awss3control.PutPublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr:  block(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.AccountPublicAccessBlock
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockPutPublicAccessBlock: func(*awss3control.PutPublicAccessBlockInput) awss3control.PutPublicAccessBlockRequest {
+						return awss3control.PutPublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awss3control.PutPublicAccessBlockOutput{}},
+						}
+					},
+				},
+				cr: block(withAllFlags(true)),
+			},
+			want: want{
+				cr: block(withAllFlags(true)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockPutPublicAccessBlock: func(*awss3control.PutPublicAccessBlockInput) awss3control.PutPublicAccessBlockRequest {
+						return awss3control.PutPublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr:  block(),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.AccountPublicAccessBlock
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockDeletePublicAccessBlock: func(*awss3control.DeletePublicAccessBlockInput) awss3control.DeletePublicAccessBlockRequest {
+						return awss3control.DeletePublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awss3control.DeletePublicAccessBlockOutput{}},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr: block(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockDeletePublicAccessBlock: func(*awss3control.DeletePublicAccessBlockInput) awss3control.DeletePublicAccessBlockRequest {
+						return awss3control.DeletePublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awss3control.ErrCodeNoSuchPublicAccessBlockConfiguration, "", nil)},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr: block(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockAccountPublicAccessBlockClient{
+					MockDeletePublicAccessBlock: func(*awss3control.DeletePublicAccessBlockInput) awss3control.DeletePublicAccessBlockRequest {
+						return awss3control.DeletePublicAccessBlockRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: block(),
+			},
+			want: want{
+				cr:  block(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequota
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsservicequotas "github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/servicequotas/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a ServiceQuota resource"
+	errCreateClient      = "cannot create Service Quotas client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the ServiceQuota custom resource"
+
+	errGetQuota   = "failed to get the service quota"
+	errGetRequest = "failed to get the service quota increase request"
+	errRequest    = "failed to request the service quota increase"
+)
+
+// SetupServiceQuota adds a controller that reconciles ServiceQuotas.
+func SetupServiceQuota(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ServiceQuotaGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.ServiceQuota{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceQuotaGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: servicequotas.NewServiceQuotaClient}))))),
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (servicequotas.ServiceQuotaClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ServiceQuota)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client servicequotas.ServiceQuotaClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ServiceQuota)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The quota outlives the resource, which is considered gone as soon as
+	// it is deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.GetServiceQuotaRequest(&awsservicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(cr.Spec.ForProvider.ServiceCode),
+		QuotaCode:   aws.String(cr.Spec.ForProvider.QuotaCode),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetQuota)
+	}
+	quota := rsp.Quota
+
+	var req *awsservicequotas.RequestedServiceQuotaChange
+	if id := meta.GetExternalName(cr); id != "" {
+		r, err := e.client.GetRequestedServiceQuotaChangeRequest(&awsservicequotas.GetRequestedServiceQuotaChangeInput{
+			RequestId: aws.String(id),
+		}).Send(ctx)
+		if resource.Ignore(servicequotas.IsNotFound, err) != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetRequest)
+		}
+		if err == nil {
+			req = r.RequestedQuota
+		}
+	}
+
+	cr.Status.AtProvider = servicequotas.GenerateServiceQuotaObservation(quota, req)
+	upToDate := servicequotas.IsServiceQuotaUpToDate(cr.Spec.ForProvider, quota, req)
+
+	// Without an increase request there is nothing to observe but the quota
+	// itself, which only satisfies the resource once it is high enough.
+	if req == nil && !upToDate {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	switch {
+	case cr.Status.AtProvider.Value >= cr.Spec.ForProvider.DesiredValue:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case servicequotas.IsRequestPending(req):
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ServiceQuota)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	return managed.ExternalCreation{}, e.request(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ServiceQuota)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	return managed.ExternalUpdate{}, e.request(ctx, cr)
+}
+
+// request files a new increase request and records its ID as the external
+// name so that later observations track it.
+func (e *external) request(ctx context.Context, cr *v1alpha1.ServiceQuota) error {
+	rsp, err := e.client.RequestServiceQuotaIncreaseRequest(servicequotas.GenerateRequestServiceQuotaIncreaseInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errRequest)
+	}
+	if rsp.RequestedQuota == nil {
+		return nil
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(rsp.RequestedQuota.Id))
+
+	return errors.Wrap(e.kube.Update(ctx, cr), errSpecUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ServiceQuota)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	// Service Quotas has no API to withdraw an increase request or to lower a
+	// quota, so the quota is left as it is.
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	return nil
+}
@@ -0,0 +1,651 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequota
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsservicequotas "github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/servicequotas/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	requestID   = "d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6"
+	quotaARN    = "arn:aws:servicequotas:us-east-1:123456789012:ec2/L-1216C47A"
+	deletedAt   = metav1.Now()
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsservicequotas.ErrCodeNoSuchResourceException, "not found", nil)
+)
+
+type args struct {
+	client servicequotas.ServiceQuotaClient
+	kube   client.Client
+	cr     *v1alpha1.ServiceQuota
+}
+
+type quotaModifier func(*v1alpha1.ServiceQuota)
+
+func withConditions(c ...runtimev1alpha1.Condition) quotaModifier {
+	return func(r *v1alpha1.ServiceQuota) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) quotaModifier {
+	return func(r *v1alpha1.ServiceQuota) { meta.SetExternalName(r, s) }
+}
+
+func withObservation(o v1alpha1.ServiceQuotaObservation) quotaModifier {
+	return func(r *v1alpha1.ServiceQuota) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() quotaModifier {
+	return func(r *v1alpha1.ServiceQuota) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func quota(m ...quotaModifier) *v1alpha1.ServiceQuota {
+	cr := &v1alpha1.ServiceQuota{
+		Spec: v1alpha1.ServiceQuotaSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ServiceQuotaParameters{
+				ServiceCode:  "ec2",
+				QuotaCode:    "L-1216C47A",
+				DesiredValue: 256,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getQuota(v float64) func(*awsservicequotas.GetServiceQuotaInput) awsservicequotas.GetServiceQuotaRequest {
+	return func(*awsservicequotas.GetServiceQuotaInput) awsservicequotas.GetServiceQuotaRequest {
+		return awsservicequotas.GetServiceQuotaRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicequotas.GetServiceQuotaOutput{
+				Quota: &awsservicequotas.ServiceQuota{QuotaArn: aws.String(quotaARN), Value: aws.Float64(v)},
+			}},
+		}
+	}
+}
+
+func getRequest(v float64, s awsservicequotas.RequestStatus) func(*awsservicequotas.GetRequestedServiceQuotaChangeInput) awsservicequotas.GetRequestedServiceQuotaChangeRequest {
+	return func(*awsservicequotas.GetRequestedServiceQuotaChangeInput) awsservicequotas.GetRequestedServiceQuotaChangeRequest {
+		return awsservicequotas.GetRequestedServiceQuotaChangeRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicequotas.GetRequestedServiceQuotaChangeOutput{
+				RequestedQuota: &awsservicequotas.RequestedServiceQuotaChange{Id: aws.String(requestID), DesiredValue: aws.Float64(v), Status: s},
+			}},
+		}
+	}
+}
+
+func requestIncrease(*awsservicequotas.RequestServiceQuotaIncreaseInput) awsservicequotas.RequestServiceQuotaIncreaseRequest {
+	return awsservicequotas.RequestServiceQuotaIncreaseRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsservicequotas.RequestServiceQuotaIncreaseOutput{
+			RequestedQuota: &awsservicequotas.RequestedServiceQuotaChange{Id: aws.String(requestID)},
+		}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (servicequotas.ServiceQuotaClient, error)
+		cr          *v1alpha1.ServiceQuota
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i servicequotas.ServiceQuotaClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: quota(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i servicequotas.ServiceQuotaClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: quota(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: quota(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: quota(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: quota(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ServiceQuota
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"QuotaAlreadyHighEnough": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockGetServiceQuota: getQuota(512),
+				},
+				cr: quota(),
+			},
+			want: want{
+				cr: quota(
+					withObservation(v1alpha1.ServiceQuotaObservation{QuotaARN: quotaARN, Value: 512}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoRequest": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockGetServiceQuota: getQuota(64),
+				},
+				cr: quota(),
+			},
+			want: want{
+				cr: quota(withObservation(v1alpha1.ServiceQuotaObservation{QuotaARN: quotaARN, Value: 64})),
+			},
+		},
+		"RequestPending": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockGetServiceQuota:                getQuota(64),
+					MockGetRequestedServiceQuotaChange: getRequest(256, awsservicequotas.RequestStatusCaseOpened),
+				},
+				cr: quota(withExternalName(requestID)),
+			},
+			want: want{
+				cr: quota(
+					withExternalName(requestID),
+					withObservation(v1alpha1.ServiceQuotaObservation{QuotaARN: quotaARN, Value: 64, RequestedValue: 256, RequestStatus: "CASE_OPENED"}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RequestDenied": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockGetServiceQuota:                getQuota(64),
+					MockGetRequestedServiceQuotaChange: getRequest(256, awsservicequotas.RequestStatusDenied),
+				},
+				cr: quota(withExternalName(requestID)),
+			},
+			want: want{
+				cr: quota(
+					withExternalName(requestID),
+					withObservation(v1alpha1.ServiceQuotaObservation{QuotaARN: quotaARN, Value: 64, RequestedValue: 256, RequestStatus: "DENIED"}),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DesiredValueRaised": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockGetServiceQuota:                getQuota(128),
+					MockGetRequestedServiceQuotaChange: getRequest(128, awsservicequotas.RequestStatusApproved),
+				},
+				cr: quota(withExternalName(requestID)),
+			},
+			want: want{
+				cr: quota(
+					withExternalName(requestID),
+					withObservation(v1alpha1.ServiceQuotaObservation{QuotaARN: quotaARN, Value: 128, RequestedValue: 128, RequestStatus: "APPROVED"}),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RequestNotFound": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockGetServiceQuota: getQuota(64),
+					MockGetRequestedServiceQuotaChange: func(*awsservicequotas.GetRequestedServiceQuotaChangeInput) awsservicequotas.GetRequestedServiceQuotaChangeRequest {
+						return awsservicequotas.GetRequestedServiceQuotaChangeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: quota(withExternalName(requestID)),
+			},
+			want: want{
+				cr: quota(
+					withExternalName(requestID),
+					withObservation(v1alpha1.ServiceQuotaObservation{QuotaARN: quotaARN, Value: 64})),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: quota(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: quota(withDeletionTimestamp()),
+			},
+		},
+		"FailedGetQuota": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockGetServiceQuota: func(*awsservicequotas.GetServiceQuotaInput) awsservicequotas.GetServiceQuotaRequest {
+						return awsservicequotas.GetServiceQuotaRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: quota(),
+			},
+			want: want{
+				cr:  quota(),
+				err: errors.Wrap(errBoom, errGetQuota),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockGetServiceQuota: getQuota(64),
+					MockGetRequestedServiceQuotaChange: func(*awsservicequotas.GetRequestedServiceQuotaChangeInput) awsservicequotas.GetRequestedServiceQuotaChangeRequest {
+						return awsservicequotas.GetRequestedServiceQuotaChangeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: quota(withExternalName(requestID)),
+			},
+			want: want{
+				cr:  quota(withExternalName(requestID)),
+				err: errors.Wrap(errBoom, errGetRequest),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ServiceQuota
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockServiceQuotaClient{
+					MockRequestServiceQuotaIncrease: func(input *awsservicequotas.RequestServiceQuotaIncreaseInput) awsservicequotas.RequestServiceQuotaIncreaseRequest {
+						if diff := cmp.Diff(float64(256), aws.Float64Value(input.DesiredValue)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return requestIncrease(input)
+					},
+				},
+				cr: quota(),
+			},
+			want: want{
+				cr: quota(withExternalName(requestID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockRequestServiceQuotaIncrease: func(*awsservicequotas.RequestServiceQuotaIncreaseInput) awsservicequotas.RequestServiceQuotaIncreaseRequest {
+						return awsservicequotas.RequestServiceQuotaIncreaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: quota(),
+			},
+			want: want{
+				cr:  quota(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errRequest),
+			},
+		},
+		"FailedSpecUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &fake.MockServiceQuotaClient{
+					MockRequestServiceQuotaIncrease: requestIncrease,
+				},
+				cr: quota(),
+			},
+			want: want{
+				cr:  quota(withExternalName(requestID), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errSpecUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ServiceQuota
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockServiceQuotaClient{
+					MockRequestServiceQuotaIncrease: requestIncrease,
+				},
+				cr: quota(withExternalName("previous")),
+			},
+			want: want{
+				cr: quota(withExternalName(requestID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockServiceQuotaClient{
+					MockRequestServiceQuotaIncrease: func(*awsservicequotas.RequestServiceQuotaIncreaseInput) awsservicequotas.RequestServiceQuotaIncreaseRequest {
+						return awsservicequotas.RequestServiceQuotaIncreaseRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: quota(withExternalName("previous")),
+			},
+			want: want{
+				cr:  quota(withExternalName("previous")),
+				err: errors.Wrap(errBoom, errRequest),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ServiceQuota
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr: quota(withExternalName(requestID)),
+			},
+			want: want{
+				cr: quota(withExternalName(requestID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
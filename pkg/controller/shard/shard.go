@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shard partitions managed resources across multiple replicas of the
+// provider.
+package shard
+
+import (
+	"context"
+	"hash/fnv"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errInvalidShards = "number of shards must be at least 1"
+	errInvalidIndex  = "shard index must be between 0 and the number of shards minus 1"
+)
+
+// A Shard identifies the subset of managed resources that a replica of the
+// provider is responsible for.
+type Shard struct {
+	// Index of this shard, starting at 0.
+	Index uint32
+
+	// Total number of shards.
+	Total uint32
+}
+
+// Validate returns an error if the shard is not well formed.
+func (s Shard) Validate() error {
+	if s.Total == 0 {
+		return errors.New(errInvalidShards)
+	}
+	if s.Index >= s.Total {
+		return errors.New(errInvalidIndex)
+	}
+	return nil
+}
+
+// Leads returns true if this is the first shard. Controllers that do not
+// reconcile managed resources, such as those that bind resource claims or
+// report the status of Providers, act on objects that belong to every shard
+// and therefore run only in the leading shard.
+func (s Shard) Leads() bool {
+	return s.Index == 0
+}
+
+// Owns returns true if the supplied object belongs to this shard. Objects that
+// are not managed resources belong to every shard. Managed resources are
+// assigned by a hash of their name, which never changes for a given resource.
+func (s Shard) Owns(obj interface{}) bool {
+	if s.Total <= 1 {
+		return true
+	}
+	if t, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = t.Obj
+	}
+	mg, ok := obj.(resource.Managed)
+	if !ok {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(mg.GetName()))
+	return h.Sum32()%s.Total == s.Index
+}
+
+// NewCacheFunc returns a cache.NewCacheFunc that builds a cache whose
+// informers only deliver events for managed resources owned by the supplied
+// shard. Reads from the cache are not filtered, so that references to managed
+// resources owned by other shards can still be resolved.
+func NewCacheFunc(s Shard) cache.NewCacheFunc {
+	return func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+		c, err := cache.New(config, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &shardedCache{Cache: c, shard: s}, nil
+	}
+}
+
+// OwnerOf returns a function that returns true if the supplied object belongs
+// to the shard whose events the supplied cache delivers. Every object belongs
+// to a cache that was not built by NewCacheFunc.
+func OwnerOf(c cache.Cache) func(obj interface{}) bool {
+	if sc, ok := c.(*shardedCache); ok {
+		return sc.shard.Owns
+	}
+	return func(_ interface{}) bool { return true }
+}
+
+// NewKind returns a source of events for objects of the supplied type that
+// delivers events for managed resources owned by every shard, not only those
+// owned by the shard of the supplied cache. Controllers use it to watch the
+// managed resources that the objects they reconcile depend on.
+func NewKind(c cache.Cache, obj runtime.Object) *source.Kind {
+	if sc, ok := c.(*shardedCache); ok {
+		c = sc.Cache
+	}
+	k := &source.Kind{Type: obj}
+	// The manager injects its own cache only into sources that have none.
+	_ = k.InjectCache(c)
+	return k
+}
+
+type shardedCache struct {
+	cache.Cache
+	shard Shard
+}
+
+func (c *shardedCache) GetInformer(ctx context.Context, obj runtime.Object) (cache.Informer, error) {
+	i, err := c.Cache.GetInformer(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	return &shardedInformer{Informer: i, shard: c.shard}, nil
+}
+
+func (c *shardedCache) GetInformerForKind(ctx context.Context, gvk schema.GroupVersionKind) (cache.Informer, error) {
+	i, err := c.Cache.GetInformerForKind(ctx, gvk)
+	if err != nil {
+		return nil, err
+	}
+	return &shardedInformer{Informer: i, shard: c.shard}, nil
+}
+
+type shardedInformer struct {
+	cache.Informer
+	shard Shard
+}
+
+func (i *shardedInformer) AddEventHandler(h toolscache.ResourceEventHandler) {
+	i.Informer.AddEventHandler(toolscache.FilteringResourceEventHandler{FilterFunc: i.shard.Owns, Handler: h})
+}
+
+func (i *shardedInformer) AddEventHandlerWithResyncPeriod(h toolscache.ResourceEventHandler, resyncPeriod time.Duration) {
+	i.Informer.AddEventHandlerWithResyncPeriod(toolscache.FilteringResourceEventHandler{FilterFunc: i.shard.Owns, Handler: h}, resyncPeriod)
+}
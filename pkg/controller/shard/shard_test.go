@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func managed(name string) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetName(name)
+	return mg
+}
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		shard Shard
+		err   error
+	}{
+		"Valid": {
+			shard: Shard{Index: 1, Total: 2},
+		},
+		"NoShards": {
+			shard: Shard{},
+			err:   errors.New(errInvalidShards),
+		},
+		"IndexOutOfRange": {
+			shard: Shard{Index: 2, Total: 2},
+			err:   errors.New(errInvalidIndex),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.shard.Validate()
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwns(t *testing.T) {
+	cases := map[string]struct {
+		shard Shard
+		obj   interface{}
+		want  bool
+	}{
+		"SingleShard": {
+			shard: Shard{Index: 0, Total: 1},
+			obj:   managed("cool"),
+			want:  true,
+		},
+		"NotManaged": {
+			shard: Shard{Index: 0, Total: 3},
+			obj:   &corev1.Secret{},
+			want:  true,
+		},
+		"TombstoneOwned": {
+			shard: Shard{Index: 1, Total: 3},
+			obj:   toolscache.DeletedFinalStateUnknown{Obj: managed("cool")},
+			want:  true,
+		},
+		"TombstoneNotOwned": {
+			shard: Shard{Index: 0, Total: 3},
+			obj:   toolscache.DeletedFinalStateUnknown{Obj: managed("cool")},
+			want:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.shard.Owns(tc.obj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLeads(t *testing.T) {
+	leaders := 0
+	for idx := uint32(0); idx < 3; idx++ {
+		if (Shard{Index: idx, Total: 3}).Leads() {
+			leaders++
+		}
+	}
+	if diff := cmp.Diff(1, leaders); diff != "" {
+		t.Errorf("leading shards: -want, +got:\n%s", diff)
+	}
+	if !(Shard{Index: 0, Total: 1}).Leads() {
+		t.Errorf("single shard: want leading, got not leading")
+	}
+}
+
+func TestOwnerOf(t *testing.T) {
+	mg := managed("cool")
+	if diff := cmp.Diff(true, OwnerOf(nil)(mg)); diff != "" {
+		t.Errorf("unsharded cache: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(false, OwnerOf(&shardedCache{shard: Shard{Index: 0, Total: 3}})(mg)); diff != "" {
+		t.Errorf("other shard: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(true, OwnerOf(&shardedCache{shard: Shard{Index: 1, Total: 3}})(mg)); diff != "" {
+		t.Errorf("owning shard: -want, +got:\n%s", diff)
+	}
+}
+
+func TestOwnsExactlyOnce(t *testing.T) {
+	total := uint32(4)
+	for i := 0; i < 100; i++ {
+		mg := managed(fmt.Sprintf("resource-%d", i))
+		owners := 0
+		for idx := uint32(0); idx < total; idx++ {
+			if (Shard{Index: idx, Total: total}).Owns(mg) {
+				owners++
+			}
+			if (Shard{Index: idx, Total: total}).Owns(toolscache.DeletedFinalStateUnknown{Obj: mg}) != (Shard{Index: idx, Total: total}).Owns(mg) {
+				t.Errorf("%s: tombstone ownership differs from object ownership in shard %d", mg.GetName(), idx)
+			}
+		}
+		if owners != 1 {
+			t.Errorf("%s: want exactly one owning shard, got %d", mg.GetName(), owners)
+		}
+	}
+}
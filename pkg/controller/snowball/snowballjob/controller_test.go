@@ -0,0 +1,680 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowballjob
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssnowball "github.com/aws/aws-sdk-go-v2/service/snowball"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/snowball/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/snowball"
+	"github.com/crossplane/provider-aws/pkg/clients/snowball/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	jobID       = "JID123e4567-e89b-12d3-a456-426655440000"
+	addressID   = "ADID1234ab12-3eec-4eb3-9be6-9374c10eb51b"
+	deletedAt   = metav1.Now()
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awssnowball.ErrCodeInvalidResourceException, "not found", nil)
+)
+
+type args struct {
+	client snowball.SnowballJobClient
+	kube   client.Client
+	cr     *v1alpha1.SnowballJob
+}
+
+type jobModifier func(*v1alpha1.SnowballJob)
+
+func withConditions(c ...runtimev1alpha1.Condition) jobModifier {
+	return func(r *v1alpha1.SnowballJob) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) jobModifier {
+	return func(r *v1alpha1.SnowballJob) { meta.SetExternalName(r, s) }
+}
+
+func withJobState(s awssnowball.JobState) jobModifier {
+	return func(r *v1alpha1.SnowballJob) { r.Status.AtProvider.JobState = string(s) }
+}
+
+func withOutboundShipment(s *v1alpha1.Shipment) jobModifier {
+	return func(r *v1alpha1.SnowballJob) { r.Status.AtProvider.OutboundShipment = s }
+}
+
+func withShippingOption(s string) jobModifier {
+	return func(r *v1alpha1.SnowballJob) { r.Spec.ForProvider.ShippingOption = &s }
+}
+
+func withDeletionTimestamp() jobModifier {
+	return func(r *v1alpha1.SnowballJob) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func job(m ...jobModifier) *v1alpha1.SnowballJob {
+	cr := &v1alpha1.SnowballJob{
+		Spec: v1alpha1.SnowballJobSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.SnowballJobParameters{
+				JobType:   "IMPORT",
+				AddressID: addressID,
+				S3Resources: []v1alpha1.S3Resource{
+					{Bucket: aws.String("archive")},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeJob(state awssnowball.JobState, shipping awssnowball.ShippingOption) func(*awssnowball.DescribeJobInput) awssnowball.DescribeJobRequest {
+	return func(*awssnowball.DescribeJobInput) awssnowball.DescribeJobRequest {
+		return awssnowball.DescribeJobRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssnowball.DescribeJobOutput{
+				JobMetadata: &awssnowball.JobMetadata{
+					JobId:     aws.String(jobID),
+					JobType:   awssnowball.JobTypeImport,
+					JobState:  state,
+					AddressId: aws.String(addressID),
+					Resources: &awssnowball.JobResource{
+						S3Resources: []awssnowball.S3Resource{{BucketArn: aws.String("arn:aws:s3:::archive")}},
+					},
+					ShippingDetails: &awssnowball.ShippingDetails{
+						ShippingOption:   shipping,
+						OutboundShipment: &awssnowball.Shipment{Status: aws.String("InTransit"), TrackingNumber: aws.String("1Z999")},
+					},
+				},
+			}},
+		}
+	}
+}
+
+var outbound = &v1alpha1.Shipment{Status: "InTransit", TrackingNumber: "1Z999"}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (snowball.SnowballJobClient, error)
+		cr          *v1alpha1.SnowballJob
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i snowball.SnowballJobClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: job(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i snowball.SnowballJobClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: job(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SnowballJob
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: job(),
+			},
+			want: want{
+				cr: job(),
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockSnowballJobClient{
+					MockDescribeJob: describeJob(awssnowball.JobStateNew, awssnowball.ShippingOptionStandard),
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr: job(
+					withExternalName(jobID),
+					withShippingOption("STANDARD"),
+					withJobState(awssnowball.JobStateNew),
+					withOutboundShipment(outbound),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockDescribeJob: describeJob(awssnowball.JobStateNew, awssnowball.ShippingOptionStandard),
+				},
+				cr: job(withExternalName(jobID), withShippingOption("EXPRESS")),
+			},
+			want: want{
+				cr: job(
+					withExternalName(jobID),
+					withShippingOption("EXPRESS"),
+					withJobState(awssnowball.JobStateNew),
+					withOutboundShipment(outbound),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ShippedJobIsNotUpdated": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockDescribeJob: describeJob(awssnowball.JobStateInTransitToCustomer, awssnowball.ShippingOptionStandard),
+				},
+				cr: job(withExternalName(jobID), withShippingOption("EXPRESS")),
+			},
+			want: want{
+				cr: job(
+					withExternalName(jobID),
+					withShippingOption("EXPRESS"),
+					withJobState(awssnowball.JobStateInTransitToCustomer),
+					withOutboundShipment(outbound),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Cancelled": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockDescribeJob: describeJob(awssnowball.JobStateCancelled, awssnowball.ShippingOptionStandard),
+				},
+				cr: job(withExternalName(jobID), withShippingOption("STANDARD")),
+			},
+			want: want{
+				cr: job(
+					withExternalName(jobID),
+					withShippingOption("STANDARD"),
+					withJobState(awssnowball.JobStateCancelled),
+					withOutboundShipment(outbound),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeletedAfterComplete": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockDescribeJob: describeJob(awssnowball.JobStateComplete, awssnowball.ShippingOptionStandard),
+				},
+				cr: job(withExternalName(jobID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withDeletionTimestamp()),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockDescribeJob: func(*awssnowball.DescribeJobInput) awssnowball.DescribeJobRequest {
+						return awssnowball.DescribeJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr: job(withExternalName(jobID)),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockDescribeJob: func(*awssnowball.DescribeJobInput) awssnowball.DescribeJobRequest {
+						return awssnowball.DescribeJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr:  job(withExternalName(jobID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, partition: awsclients.PartitionAWS}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SnowballJob
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockSnowballJobClient{
+					MockCreateJob: func(input *awssnowball.CreateJobInput) awssnowball.CreateJobRequest {
+						if diff := cmp.Diff("arn:aws:s3:::archive", aws.StringValue(input.Resources.S3Resources[0].BucketArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssnowball.CreateJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssnowball.CreateJobOutput{
+								JobId: aws.String(jobID),
+							}},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockCreateJob: func(*awssnowball.CreateJobInput) awssnowball.CreateJobRequest {
+						return awssnowball.CreateJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(),
+			},
+			want: want{
+				cr:  job(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, partition: awsclients.PartitionAWS}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SnowballJob
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockUpdateJob: func(input *awssnowball.UpdateJobInput) awssnowball.UpdateJobRequest {
+						if diff := cmp.Diff(jobID, aws.StringValue(input.JobId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(awssnowball.ShippingOptionExpress, input.ShippingOption); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssnowball.UpdateJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssnowball.UpdateJobOutput{}},
+						}
+					},
+				},
+				cr: job(withExternalName(jobID), withShippingOption("EXPRESS")),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withShippingOption("EXPRESS")),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockUpdateJob: func(*awssnowball.UpdateJobInput) awssnowball.UpdateJobRequest {
+						return awssnowball.UpdateJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr:  job(withExternalName(jobID)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, partition: awsclients.PartitionAWS}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SnowballJob
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockCancelJob: func(*awssnowball.CancelJobInput) awssnowball.CancelJobRequest {
+						return awssnowball.CancelJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssnowball.CancelJobOutput{}},
+						}
+					},
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockCancelJob: func(*awssnowball.CancelJobInput) awssnowball.CancelJobRequest {
+						return awssnowball.CancelJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr: job(withExternalName(jobID), withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockSnowballJobClient{
+					MockCancelJob: func(*awssnowball.CancelJobInput) awssnowball.CancelJobRequest {
+						return awssnowball.CancelJobRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: job(withExternalName(jobID)),
+			},
+			want: want{
+				cr:  job(withExternalName(jobID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, partition: awsclients.PartitionAWS}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package document
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsssm "github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/ssm"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a Document resource"
+	errCreateClient      = "cannot create SSM client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errKubeUpdateFailed  = "cannot update Document custom resource"
+
+	errDescribe = "failed to describe Document"
+	errCreate   = "failed to create the Document resource"
+	errUpdate   = "failed to update the Document resource"
+	errDelete   = "failed to delete the Document resource"
+
+	errGetContent        = "failed to get the content of the Document"
+	errGetPermission     = "failed to get the sharing permissions of the Document"
+	errCompare           = "failed to compare the Document content"
+	errSetDefault        = "failed to set the default version of the Document"
+	errModifyPermissions = "failed to modify the sharing permissions of the Document"
+)
+
+// SetupDocument adds a controller that reconciles Documents.
+func SetupDocument(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DocumentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.Document{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DocumentGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: ssm.NewDocumentClient}))))),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ssm.DocumentClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Document)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client ssm.DocumentClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Document)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeDocumentRequest(&awsssm.DescribeDocumentInput{
+		Name: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ssm.IsDocumentNotFound, err), errDescribe)
+	}
+	if rsp.Document == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed := *rsp.Document
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ssm.LateInitializeDocument(&cr.Spec.ForProvider, &observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ssm.GenerateDocumentObservation(observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.DocumentStatusActive:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.DocumentStatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.DocumentStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case v1alpha1.DocumentStatusUpdating:
+		// An update in progress leaves the document usable at its current
+		// default version.
+		cr.SetConditions(runtimev1alpha1.Available())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	content, err := e.client.GetDocumentRequest(&awsssm.GetDocumentInput{
+		Name:            aws.String(meta.GetExternalName(cr)),
+		DocumentFormat:  awsssm.DocumentFormat(aws.StringValue(cr.Spec.ForProvider.DocumentFormat)),
+		DocumentVersion: observed.DefaultVersion,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetContent)
+	}
+
+	perm, err := e.client.DescribeDocumentPermissionRequest(&awsssm.DescribeDocumentPermissionInput{
+		Name:           aws.String(meta.GetExternalName(cr)),
+		PermissionType: awsssm.DocumentPermissionTypeShare,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPermission)
+	}
+
+	upToDate, err := ssm.IsDocumentUpToDate(cr.Spec.ForProvider, observed, *content.GetDocumentOutput, perm.AccountIds)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Document)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	_, err := e.client.CreateDocumentRequest(ssm.GenerateCreateDocumentInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Document)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := aws.String(meta.GetExternalName(cr))
+
+	content, err := e.client.GetDocumentRequest(&awsssm.GetDocumentInput{
+		Name:            name,
+		DocumentFormat:  awsssm.DocumentFormat(aws.StringValue(cr.Spec.ForProvider.DocumentFormat)),
+		DocumentVersion: aws.String(cr.Status.AtProvider.DefaultVersion),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetContent)
+	}
+	upToDate, err := ssm.IsDocumentVersionUpToDate(cr.Spec.ForProvider, *content.GetDocumentOutput)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCompare)
+	}
+
+	// Changing the content creates a new version of the document, which
+	// only takes effect once it is made the default version.
+	if !upToDate {
+		rsp, err := e.client.UpdateDocumentRequest(ssm.GenerateUpdateDocumentInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+		if rsp.DocumentDescription != nil {
+			if _, err := e.client.UpdateDocumentDefaultVersionRequest(&awsssm.UpdateDocumentDefaultVersionInput{
+				Name:            name,
+				DocumentVersion: rsp.DocumentDescription.DocumentVersion,
+			}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errSetDefault)
+			}
+		}
+	}
+
+	perm, err := e.client.DescribeDocumentPermissionRequest(&awsssm.DescribeDocumentPermissionInput{
+		Name:           name,
+		PermissionType: awsssm.DocumentPermissionTypeShare,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPermission)
+	}
+	add, remove := ssm.DiffDocumentAccountIDs(cr.Spec.ForProvider.AccountIDs, perm.AccountIds)
+	if len(add) == 0 && len(remove) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ModifyDocumentPermissionRequest(&awsssm.ModifyDocumentPermissionInput{
+		Name:               name,
+		PermissionType:     awsssm.DocumentPermissionTypeShare,
+		AccountIdsToAdd:    add,
+		AccountIdsToRemove: remove,
+	}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errModifyPermissions)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Document)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	_, err := e.client.DeleteDocumentRequest(&awsssm.DeleteDocumentInput{
+		Name: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(ssm.IsDocumentNotFound, err), errDelete)
+}
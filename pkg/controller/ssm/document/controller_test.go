@@ -0,0 +1,730 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package document
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsssm "github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ssm"
+	"github.com/crossplane/provider-aws/pkg/clients/ssm/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+
+	documentName = "restart-service"
+
+	desiredContent = `{"schemaVersion": "2.2", "description": "Restart a service", "mainSteps": [{"action": "aws:runShellScript", "name": "restart", "inputs": {"runCommand": ["systemctl restart app"]}}]}`
+
+	// observedContent is the desired content as returned by AWS, with
+	// different formatting and key order.
+	observedContent = `{
+  "description": "Restart a service",
+  "schemaVersion": "2.2",
+  "mainSteps": [
+    {
+      "name": "restart",
+      "action": "aws:runShellScript",
+      "inputs": {
+        "runCommand": [
+          "systemctl restart app"
+        ]
+      }
+    }
+  ]
+}`
+
+	changedContent = `{"schemaVersion": "2.2", "description": "Restart a service", "mainSteps": []}`
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsssm.ErrCodeInvalidDocument, "not found", nil)
+)
+
+type args struct {
+	client ssm.DocumentClient
+	kube   client.Client
+	cr     *v1alpha1.Document
+}
+
+type documentModifier func(*v1alpha1.Document)
+
+func withConditions(c ...runtimev1alpha1.Condition) documentModifier {
+	return func(r *v1alpha1.Document) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.DocumentObservation) documentModifier {
+	return func(r *v1alpha1.Document) { r.Status.AtProvider = o }
+}
+
+func withContent(s string) documentModifier {
+	return func(r *v1alpha1.Document) { r.Spec.ForProvider.Content = s }
+}
+
+func withAccountIDs(ids ...string) documentModifier {
+	return func(r *v1alpha1.Document) { r.Spec.ForProvider.AccountIDs = ids }
+}
+
+func withTargetType(s *string) documentModifier {
+	return func(r *v1alpha1.Document) { r.Spec.ForProvider.TargetType = s }
+}
+
+func document(m ...documentModifier) *v1alpha1.Document {
+	cr := &v1alpha1.Document{
+		Spec: v1alpha1.DocumentSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DocumentParameters{
+				Content:        desiredContent,
+				DocumentFormat: aws.String("JSON"),
+				DocumentType:   aws.String("Command"),
+				TargetType:     aws.String("/AWS::EC2::Instance"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, documentName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func description(status awsssm.DocumentStatus) *awsssm.DocumentDescription {
+	return &awsssm.DocumentDescription{
+		Name:           aws.String(documentName),
+		DefaultVersion: aws.String("1"),
+		LatestVersion:  aws.String("1"),
+		DocumentFormat: awsssm.DocumentFormatJson,
+		DocumentType:   awsssm.DocumentTypeCommand,
+		TargetType:     aws.String("/AWS::EC2::Instance"),
+		Status:         status,
+	}
+}
+
+func observation(status string) v1alpha1.DocumentObservation {
+	return v1alpha1.DocumentObservation{
+		DefaultVersion: "1",
+		LatestVersion:  "1",
+		Status:         status,
+	}
+}
+
+func describeDocument(d *awsssm.DocumentDescription, err error) func(*awsssm.DescribeDocumentInput) awsssm.DescribeDocumentRequest {
+	return func(*awsssm.DescribeDocumentInput) awsssm.DescribeDocumentRequest {
+		return awsssm.DescribeDocumentRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsssm.DescribeDocumentOutput{Document: d}, Error: err},
+		}
+	}
+}
+
+func getDocument(content string, err error) func(*awsssm.GetDocumentInput) awsssm.GetDocumentRequest {
+	return func(*awsssm.GetDocumentInput) awsssm.GetDocumentRequest {
+		return awsssm.GetDocumentRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsssm.GetDocumentOutput{Content: aws.String(content)}, Error: err},
+		}
+	}
+}
+
+func describePermission(ids ...string) func(*awsssm.DescribeDocumentPermissionInput) awsssm.DescribeDocumentPermissionRequest {
+	return func(*awsssm.DescribeDocumentPermissionInput) awsssm.DescribeDocumentPermissionRequest {
+		return awsssm.DescribeDocumentPermissionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsssm.DescribeDocumentPermissionOutput{AccountIds: ids}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (ssm.DocumentClient, error)
+		cr          *v1alpha1.Document
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ssm.DocumentClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: document(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i ssm.DocumentClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: document(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: document(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: document(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: document(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Document
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SemanticallyEqualContent": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDescribeDocument:           describeDocument(description(awsssm.DocumentStatusActive), nil),
+					MockGetDocument:                getDocument(observedContent, nil),
+					MockDescribeDocumentPermission: describePermission(),
+				},
+				cr: document(),
+			},
+			want: want{
+				cr: document(
+					withObservation(observation(v1alpha1.DocumentStatusActive)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDescribeDocument:           describeDocument(description(awsssm.DocumentStatusActive), nil),
+					MockGetDocument:                getDocument(observedContent, nil),
+					MockDescribeDocumentPermission: describePermission(),
+				},
+				cr: document(withContent(changedContent)),
+			},
+			want: want{
+				cr: document(
+					withContent(changedContent),
+					withObservation(observation(v1alpha1.DocumentStatusActive)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"SharingChanged": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDescribeDocument:           describeDocument(description(awsssm.DocumentStatusActive), nil),
+					MockGetDocument:                getDocument(observedContent, nil),
+					MockDescribeDocumentPermission: describePermission("123456789012"),
+				},
+				cr: document(withAccountIDs("210987654321")),
+			},
+			want: want{
+				cr: document(
+					withAccountIDs("210987654321"),
+					withObservation(observation(v1alpha1.DocumentStatusActive)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitTargetType": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockDocumentClient{
+					MockDescribeDocument:           describeDocument(description(awsssm.DocumentStatusActive), nil),
+					MockGetDocument:                getDocument(observedContent, nil),
+					MockDescribeDocumentPermission: describePermission(),
+				},
+				cr: document(withTargetType(nil)),
+			},
+			want: want{
+				cr: document(
+					withObservation(observation(v1alpha1.DocumentStatusActive)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Updating": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDescribeDocument: describeDocument(description(awsssm.DocumentStatusUpdating), nil),
+				},
+				cr: document(withContent(changedContent)),
+			},
+			want: want{
+				cr: document(
+					withContent(changedContent),
+					withObservation(observation(v1alpha1.DocumentStatusUpdating)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDescribeDocument: describeDocument(nil, errNotFound),
+				},
+				cr: document(),
+			},
+			want: want{
+				cr: document(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDescribeDocument: describeDocument(nil, errBoom),
+				},
+				cr: document(),
+			},
+			want: want{
+				cr:  document(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedGetContent": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDescribeDocument: describeDocument(description(awsssm.DocumentStatusActive), nil),
+					MockGetDocument:      getDocument("", errBoom),
+				},
+				cr: document(),
+			},
+			want: want{
+				cr: document(
+					withObservation(observation(v1alpha1.DocumentStatusActive)),
+					withConditions(runtimev1alpha1.Available())),
+				err: errors.Wrap(errBoom, errGetContent),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Document
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockCreateDocument: func(input *awsssm.CreateDocumentInput) awsssm.CreateDocumentRequest {
+						if aws.StringValue(input.Name) != documentName {
+							t.Errorf("unexpected document name %q", aws.StringValue(input.Name))
+						}
+						return awsssm.CreateDocumentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsssm.CreateDocumentOutput{}},
+						}
+					},
+				},
+				cr: document(),
+			},
+			want: want{
+				cr: document(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockCreateDocument: func(input *awsssm.CreateDocumentInput) awsssm.CreateDocumentRequest {
+						return awsssm.CreateDocumentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: document(),
+			},
+			want: want{
+				cr:  document(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NewDefaultVersion": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockGetDocument: getDocument(observedContent, nil),
+					MockUpdateDocument: func(input *awsssm.UpdateDocumentInput) awsssm.UpdateDocumentRequest {
+						if diff := cmp.Diff(ssm.DocumentVersionLatest, aws.StringValue(input.DocumentVersion)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsssm.UpdateDocumentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsssm.UpdateDocumentOutput{
+								DocumentDescription: &awsssm.DocumentDescription{DocumentVersion: aws.String("2")},
+							}},
+						}
+					},
+					MockUpdateDocumentDefaultVersion: func(input *awsssm.UpdateDocumentDefaultVersionInput) awsssm.UpdateDocumentDefaultVersionRequest {
+						if diff := cmp.Diff("2", aws.StringValue(input.DocumentVersion)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsssm.UpdateDocumentDefaultVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsssm.UpdateDocumentDefaultVersionOutput{}},
+						}
+					},
+					MockDescribeDocumentPermission: describePermission(),
+				},
+				cr: document(withContent(changedContent), withObservation(observation(v1alpha1.DocumentStatusActive))),
+			},
+		},
+		"SharingOnly": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockGetDocument:                getDocument(observedContent, nil),
+					MockDescribeDocumentPermission: describePermission("123456789012"),
+					MockModifyDocumentPermission: func(input *awsssm.ModifyDocumentPermissionInput) awsssm.ModifyDocumentPermissionRequest {
+						if diff := cmp.Diff([]string{"210987654321"}, input.AccountIdsToAdd); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff([]string{"123456789012"}, input.AccountIdsToRemove); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsssm.ModifyDocumentPermissionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsssm.ModifyDocumentPermissionOutput{}},
+						}
+					},
+				},
+				cr: document(withAccountIDs("210987654321"), withObservation(observation(v1alpha1.DocumentStatusActive))),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockGetDocument: getDocument(observedContent, nil),
+					MockUpdateDocument: func(input *awsssm.UpdateDocumentInput) awsssm.UpdateDocumentRequest {
+						return awsssm.UpdateDocumentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: document(withContent(changedContent), withObservation(observation(v1alpha1.DocumentStatusActive))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Document
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDeleteDocument: func(input *awsssm.DeleteDocumentInput) awsssm.DeleteDocumentRequest {
+						return awsssm.DeleteDocumentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsssm.DeleteDocumentOutput{}},
+						}
+					},
+				},
+				cr: document(),
+			},
+			want: want{
+				cr: document(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDeleteDocument: func(input *awsssm.DeleteDocumentInput) awsssm.DeleteDocumentRequest {
+						return awsssm.DeleteDocumentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: document(),
+			},
+			want: want{
+				cr: document(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockDocumentClient{
+					MockDeleteDocument: func(input *awsssm.DeleteDocumentInput) awsssm.DeleteDocumentRequest {
+						return awsssm.DeleteDocumentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: document(),
+			},
+			want: want{
+				cr:  document(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,510 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessioncredentials
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sts/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sts"
+	"github.com/crossplane/provider-aws/pkg/clients/sts/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	credentialsName = "some-credentials"
+	roleARN         = "arn:aws:iam::123456789012:role/some-role"
+	now             = time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	errBoom         = errors.New("boom")
+
+	credentials = &awssts.Credentials{
+		AccessKeyId:     aws.String("ASIAEXAMPLE"),
+		SecretAccessKey: aws.String("secret"),
+		SessionToken:    aws.String("token"),
+		Expiration:      aws.Time(now.Add(time.Hour)),
+	}
+)
+
+type args struct {
+	sts sts.SessionCredentialsClient
+	cr  *v1alpha1.SessionCredentials
+}
+
+type credentialsModifier func(*v1alpha1.SessionCredentials)
+
+func withConditions(c ...runtimev1alpha1.Condition) credentialsModifier {
+	return func(r *v1alpha1.SessionCredentials) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExpiration(t time.Time) credentialsModifier {
+	return func(r *v1alpha1.SessionCredentials) {
+		r.Status.AtProvider.RoleARN = roleARN
+		r.Status.AtProvider.Expiration = &metav1.Time{Time: t}
+	}
+}
+
+func withDeletionTimestamp() credentialsModifier {
+	return func(r *v1alpha1.SessionCredentials) { r.SetDeletionTimestamp(&metav1.Time{Time: now}) }
+}
+
+func sessionCredentials(m ...credentialsModifier) *v1alpha1.SessionCredentials {
+	cr := &v1alpha1.SessionCredentials{
+		ObjectMeta: metav1.ObjectMeta{Name: credentialsName},
+		Spec: v1alpha1.SessionCredentialsSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.SessionCredentialsParameters{
+				RoleARN: aws.String(roleARN),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (sts.SessionCredentialsClient, error)
+		cr          *v1alpha1.SessionCredentials
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i sts.SessionCredentialsClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: sessionCredentials(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i sts.SessionCredentialsClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: sessionCredentials(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: sessionCredentials(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: sessionCredentials(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: sessionCredentials(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SessionCredentials
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotIssued": {
+			args: args{
+				cr: sessionCredentials(),
+			},
+			want: want{
+				cr: sessionCredentials(),
+			},
+		},
+		"Valid": {
+			args: args{
+				cr: sessionCredentials(withExpiration(now.Add(time.Hour))),
+			},
+			want: want{
+				cr: sessionCredentials(withExpiration(now.Add(time.Hour)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DueForRefresh": {
+			args: args{
+				cr: sessionCredentials(withExpiration(now.Add(time.Minute))),
+			},
+			want: want{
+				cr: sessionCredentials(withExpiration(now.Add(time.Minute)),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Expired": {
+			args: args{
+				cr: sessionCredentials(withExpiration(now.Add(-time.Minute))),
+			},
+			want: want{
+				cr: sessionCredentials(withExpiration(now.Add(-time.Minute)),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: sessionCredentials(withExpiration(now.Add(time.Hour)), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: sessionCredentials(withExpiration(now.Add(time.Hour)), withDeletionTimestamp()),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sts, now: func() time.Time { return now }}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SessionCredentials
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sts: &fake.MockSessionCredentialsClient{
+					MockAssumeRole: func(input *awssts.AssumeRoleInput) awssts.AssumeRoleRequest {
+						if diff := cmp.Diff(credentialsName, aws.StringValue(input.RoleSessionName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssts.AssumeRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssts.AssumeRoleOutput{
+								Credentials: credentials,
+							}},
+						}
+					},
+				},
+				cr: sessionCredentials(),
+			},
+			want: want{
+				cr: sessionCredentials(withExpiration(now.Add(time.Hour)),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalCreation{ConnectionDetails: sts.GetConnectionDetails(credentials)},
+			},
+		},
+		"AssumeRoleFailed": {
+			args: args{
+				sts: &fake.MockSessionCredentialsClient{
+					MockAssumeRole: func(input *awssts.AssumeRoleInput) awssts.AssumeRoleRequest {
+						return awssts.AssumeRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: sessionCredentials(),
+			},
+			want: want{
+				cr:  sessionCredentials(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errAssumeRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sts, now: func() time.Time { return now }}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	refreshed := &awssts.Credentials{
+		AccessKeyId:     aws.String("ASIAREFRESHED"),
+		SecretAccessKey: aws.String("secret"),
+		SessionToken:    aws.String("token"),
+		Expiration:      aws.Time(now.Add(2 * time.Hour)),
+	}
+
+	type want struct {
+		cr     *v1alpha1.SessionCredentials
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sts: &fake.MockSessionCredentialsClient{
+					MockAssumeRole: func(input *awssts.AssumeRoleInput) awssts.AssumeRoleRequest {
+						return awssts.AssumeRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssts.AssumeRoleOutput{
+								Credentials: refreshed,
+							}},
+						}
+					},
+				},
+				cr: sessionCredentials(withExpiration(now.Add(time.Minute))),
+			},
+			want: want{
+				cr:     sessionCredentials(withExpiration(now.Add(2 * time.Hour))),
+				result: managed.ExternalUpdate{ConnectionDetails: sts.GetConnectionDetails(refreshed)},
+			},
+		},
+		"AssumeRoleFailed": {
+			args: args{
+				sts: &fake.MockSessionCredentialsClient{
+					MockAssumeRole: func(input *awssts.AssumeRoleInput) awssts.AssumeRoleRequest {
+						return awssts.AssumeRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: sessionCredentials(withExpiration(now.Add(time.Minute))),
+			},
+			want: want{
+				cr:  sessionCredentials(withExpiration(now.Add(time.Minute))),
+				err: errors.Wrap(errBoom, errAssumeRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sts, now: func() time.Time { return now }}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cr := sessionCredentials(withExpiration(now.Add(time.Hour)))
+	e := &external{now: func() time.Time { return now }}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("Delete(...): unexpected error %v", err)
+	}
+	want := sessionCredentials(withExpiration(now.Add(time.Hour)), withConditions(runtimev1alpha1.Deleting()))
+	if diff := cmp.Diff(want, cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssynthetics "github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/errorutils"
+	"github.com/crossplane/provider-aws/pkg/clients/metrics"
+	"github.com/crossplane/provider-aws/pkg/clients/propagation"
+	"github.com/crossplane/provider-aws/pkg/clients/synthetics"
+	"github.com/crossplane/provider-aws/pkg/controller/ratelimit"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a Canary resource"
+	errCreateClient      = "cannot create Synthetics client"
+	errGetProvider       = "cannot get provider"
+	errGetProviderSecret = "cannot get provider secret"
+	errSpecUpdate        = "cannot update spec of the Canary custom resource"
+
+	errDescribe = "failed to describe Canary"
+	errCreate   = "failed to create the Canary resource"
+	errUpdate   = "failed to update the Canary resource"
+	errDelete   = "failed to delete the Canary resource"
+	errStart    = "failed to start the Canary"
+	errStop     = "failed to stop the Canary"
+)
+
+// SetupCanary adds a controller that reconciles Canaries.
+func SetupCanary(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.CanaryGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ControllerOptions(name)).
+		For(&v1alpha1.Canary{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CanaryGroupVersionKind),
+			managed.WithExternalConnecter(metrics.NewConnecter(mgr, drift.NewConnecter(mgr, propagation.NewConnecter(mgr, errorutils.NewConnecter(&connector{kube: mgr.GetClient(), newClientFn: synthetics.NewCanaryClient}))))),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (synthetics.CanaryClient, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Canary)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	p := &awsv1alpha3.Provider{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ProviderReference.Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+
+	ctx, err := awsclients.WithProvider(ctx, c.kube, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		awsClient, err := c.newClientFn(ctx, []byte{}, p.Spec.Region, awsclients.UsePodServiceAccount)
+		return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, errors.New(errGetProviderSecret)
+	}
+
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return nil, errors.Wrap(err, errGetProviderSecret)
+	}
+
+	awsClient, err := c.newClientFn(ctx, s.Data[p.Spec.CredentialsSecretRef.Key], p.Spec.Region, awsclients.UseProviderSecret)
+	return &external{client: awsClient, kube: c.kube}, errors.Wrap(err, errCreateClient)
+}
+
+type external struct {
+	kube   client.Client
+	client synthetics.CanaryClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Canary)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(synthetics.IsNotFound, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	synthetics.LateInitializeCanary(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSpecUpdate)
+		}
+	}
+
+	obs := synthetics.GenerateCanaryObservation(*observed)
+	obs.CodeChecksum = cr.Status.AtProvider.CodeChecksum
+	cr.Status.AtProvider = obs
+
+	switch awssynthetics.CanaryState(obs.State) {
+	case awssynthetics.CanaryStateCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awssynthetics.CanaryStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case awssynthetics.CanaryStateError:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	default:
+		cr.SetConditions(runtimev1alpha1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: isTransitioning(awssynthetics.CanaryState(obs.State)) ||
+			(synthetics.IsCanaryUpToDate(cr.Spec.ForProvider, *observed, obs.CodeChecksum) &&
+				synthetics.IsRunningUpToDate(cr.Spec.ForProvider, awssynthetics.CanaryState(obs.State))),
+	}, nil
+}
+
+// isTransitioning returns true if the canary is in a state in which it
+// cannot be updated, started or stopped.
+func isTransitioning(s awssynthetics.CanaryState) bool {
+	switch s {
+	case awssynthetics.CanaryStateCreating, awssynthetics.CanaryStateUpdating,
+		awssynthetics.CanaryStateStarting, awssynthetics.CanaryStateStopping,
+		awssynthetics.CanaryStateDeleting:
+		return true
+	}
+	return false
+}
+
+func (e *external) get(ctx context.Context, name string) (*awssynthetics.Canary, error) {
+	rsp, err := e.client.GetCanaryRequest(&awssynthetics.GetCanaryInput{Name: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Canary, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Canary)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	input, err := synthetics.GenerateCreateCanaryInput(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if _, err := e.client.CreateCanaryRequest(input).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	cr.Status.AtProvider.CodeChecksum = synthetics.CodeChecksum(cr.Spec.ForProvider.Code)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update either applies configuration changes or starts or stops the canary.
+// The canary is busy for a while after each of these operations, so only
+// one of them is performed per reconcile.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Canary)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if !synthetics.IsCanaryUpToDate(cr.Spec.ForProvider, *observed, cr.Status.AtProvider.CodeChecksum) {
+		input, err := synthetics.GenerateUpdateCanaryInput(meta.GetExternalName(cr), cr.Spec.ForProvider, cr.Status.AtProvider.CodeChecksum)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+		if _, err := e.client.UpdateCanaryRequest(input).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+		cr.Status.AtProvider.CodeChecksum = synthetics.CodeChecksum(cr.Spec.ForProvider.Code)
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if cr.Spec.ForProvider.Running == nil || *cr.Spec.ForProvider.Running {
+		_, err := e.client.StartCanaryRequest(&awssynthetics.StartCanaryInput{Name: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errStart)
+	}
+	_, err = e.client.StopCanaryRequest(&awssynthetics.StopCanaryInput{Name: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errStop)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Canary)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	// A running canary cannot be deleted. Stopping one that is not running
+	// results in a conflict, which is expected. Deleting one that is still
+	// stopping results in a conflict as well; deletion is retried until the
+	// canary is gone.
+	_, err := e.client.StopCanaryRequest(&awssynthetics.StopCanaryInput{Name: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err := resource.Ignore(synthetics.IsNotFound, resource.Ignore(synthetics.IsConflict, err)); err != nil {
+		return errors.Wrap(err, errStop)
+	}
+
+	_, err = e.client.DeleteCanaryRequest(&awssynthetics.DeleteCanaryInput{Name: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	return errors.Wrap(resource.Ignore(synthetics.IsNotFound, resource.Ignore(synthetics.IsConflict, err)), errDelete)
+}
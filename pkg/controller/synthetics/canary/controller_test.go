@@ -0,0 +1,751 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssynthetics "github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/synthetics/v1alpha1"
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/synthetics"
+	"github.com/crossplane/provider-aws/pkg/clients/synthetics/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	canaryName  = "example"
+	roleARN     = "arn:aws:iam::123456789012:role/canary"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awssynthetics.ErrCodeResourceNotFoundException, "not found", nil)
+	errConflict = awserr.New(awssynthetics.ErrCodeConflictException, "conflict", nil)
+)
+
+type args struct {
+	client synthetics.CanaryClient
+	kube   client.Client
+	cr     *v1alpha1.Canary
+}
+
+type canaryModifier func(*v1alpha1.Canary)
+
+func withConditions(c ...runtimev1alpha1.Condition) canaryModifier {
+	return func(r *v1alpha1.Canary) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) canaryModifier {
+	return func(r *v1alpha1.Canary) { meta.SetExternalName(r, s) }
+}
+
+func withRunning(b bool) canaryModifier {
+	return func(r *v1alpha1.Canary) { r.Spec.ForProvider.Running = aws.Bool(b) }
+}
+
+func withExpression(s string) canaryModifier {
+	return func(r *v1alpha1.Canary) { r.Spec.ForProvider.Schedule.Expression = s }
+}
+
+func withObservation(o v1alpha1.CanaryObservation) canaryModifier {
+	return func(r *v1alpha1.Canary) { r.Status.AtProvider = o }
+}
+
+func params() v1alpha1.CanaryParameters {
+	return v1alpha1.CanaryParameters{
+		ArtifactS3Bucket: aws.String("artifacts"),
+		Code: v1alpha1.CanaryCode{
+			Handler: "index.handler",
+			Script:  aws.String("exports.handler = async () => {};"),
+		},
+		ExecutionRoleARN:             aws.String(roleARN),
+		FailureRetentionPeriodInDays: aws.Int64(31),
+		RuntimeVersion:               "syn-nodejs-2.0",
+		Schedule: v1alpha1.CanarySchedule{
+			DurationInSeconds: aws.Int64(0),
+			Expression:        "rate(5 minutes)",
+		},
+		SuccessRetentionPeriodInDays: aws.Int64(31),
+	}
+}
+
+// checksum is the checksum of the code in params.
+var checksum = synthetics.CodeChecksum(params().Code)
+
+func canary(m ...canaryModifier) *v1alpha1.Canary {
+	cr := &v1alpha1.Canary{
+		Spec: v1alpha1.CanarySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: params(),
+		},
+	}
+	meta.SetExternalName(cr, canaryName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(state awssynthetics.CanaryState) *awssynthetics.GetCanaryOutput {
+	return &awssynthetics.GetCanaryOutput{Canary: &awssynthetics.Canary{
+		ArtifactS3Location:           aws.String("artifacts/"),
+		Code:                         &awssynthetics.CanaryCodeOutput{Handler: aws.String("index.handler")},
+		ExecutionRoleArn:             aws.String(roleARN),
+		FailureRetentionPeriodInDays: aws.Int64(31),
+		Id:                           aws.String("id"),
+		Name:                         aws.String(canaryName),
+		RuntimeVersion:               aws.String("syn-nodejs-2.0"),
+		Schedule: &awssynthetics.CanaryScheduleOutput{
+			DurationInSeconds: aws.Int64(0),
+			Expression:        aws.String("rate(5 minutes)"),
+		},
+		Status:                       &awssynthetics.CanaryStatus{State: state},
+		SuccessRetentionPeriodInDays: aws.Int64(31),
+	}}
+}
+
+func getCanary(state awssynthetics.CanaryState) func(*awssynthetics.GetCanaryInput) awssynthetics.GetCanaryRequest {
+	return func(*awssynthetics.GetCanaryInput) awssynthetics.GetCanaryRequest {
+		return awssynthetics.GetCanaryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: observed(state)},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (synthetics.CanaryClient, error)
+		cr          *v1alpha1.Canary
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i synthetics.CanaryClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: canary(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i synthetics.CanaryClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: canary(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Canary
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Running": {
+			args: args{
+				client: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateRunning)},
+				cr:     canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+			want: want{
+				cr: canary(
+					withObservation(v1alpha1.CanaryObservation{ID: "id", State: "RUNNING", CodeChecksum: checksum}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ReadyToStart": {
+			args: args{
+				client: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateReady)},
+				cr:     canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+			want: want{
+				cr: canary(
+					withObservation(v1alpha1.CanaryObservation{ID: "id", State: "READY", CodeChecksum: checksum}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Stopped": {
+			args: args{
+				client: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateStopped)},
+				cr:     canary(withRunning(false), withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+			want: want{
+				cr: canary(
+					withRunning(false),
+					withObservation(v1alpha1.CanaryObservation{ID: "id", State: "STOPPED", CodeChecksum: checksum}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CodeChanged": {
+			args: args{
+				client: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateRunning)},
+				cr:     canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: "old"})),
+			},
+			want: want{
+				cr: canary(
+					withObservation(v1alpha1.CanaryObservation{ID: "id", State: "RUNNING", CodeChecksum: "old"}),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateCreating)},
+				cr:     canary(withExpression("rate(1 minute)"), withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+			want: want{
+				cr: canary(
+					withExpression("rate(1 minute)"),
+					withObservation(v1alpha1.CanaryObservation{ID: "id", State: "CREATING", CodeChecksum: checksum}),
+					withConditions(runtimev1alpha1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Error": {
+			args: args{
+				client: &fake.MockCanaryClient{MockGetCanary: getCanary(awssynthetics.CanaryStateError)},
+				cr:     canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+			want: want{
+				cr: canary(
+					withObservation(v1alpha1.CanaryObservation{ID: "id", State: "ERROR", CodeChecksum: checksum}),
+					withConditions(runtimev1alpha1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockCanaryClient{
+					MockGetCanary: func(*awssynthetics.GetCanaryInput) awssynthetics.GetCanaryRequest {
+						return awssynthetics.GetCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				cr: canary(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				client: &fake.MockCanaryClient{
+					MockGetCanary: func(*awssynthetics.GetCanaryInput) awssynthetics.GetCanaryRequest {
+						return awssynthetics.GetCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				cr:  canary(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Canary
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCanaryClient{
+					MockCreateCanary: func(input *awssynthetics.CreateCanaryInput) awssynthetics.CreateCanaryRequest {
+						if len(input.Code.ZipFile) == 0 {
+							t.Errorf("expected the inline script to be packaged")
+						}
+						return awssynthetics.CreateCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.CreateCanaryOutput{}},
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				cr: canary(
+					withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum}),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockCanaryClient{
+					MockCreateCanary: func(input *awssynthetics.CreateCanaryInput) awssynthetics.CreateCanaryRequest {
+						return awssynthetics.CreateCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: canary(),
+			},
+			want: want{
+				cr:  canary(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Canary
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateConfiguration": {
+			args: args{
+				client: &fake.MockCanaryClient{
+					MockGetCanary: getCanary(awssynthetics.CanaryStateRunning),
+					MockUpdateCanary: func(input *awssynthetics.UpdateCanaryInput) awssynthetics.UpdateCanaryRequest {
+						if diff := cmp.Diff("rate(1 minute)", aws.StringValue(input.Schedule.Expression)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if input.Code != nil {
+							t.Errorf("expected unchanged code not to be sent")
+						}
+						return awssynthetics.UpdateCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.UpdateCanaryOutput{}},
+						}
+					},
+				},
+				cr: canary(withExpression("rate(1 minute)"), withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+			want: want{
+				cr: canary(withExpression("rate(1 minute)"), withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+		},
+		"UpdateCode": {
+			args: args{
+				client: &fake.MockCanaryClient{
+					MockGetCanary: getCanary(awssynthetics.CanaryStateRunning),
+					MockUpdateCanary: func(input *awssynthetics.UpdateCanaryInput) awssynthetics.UpdateCanaryRequest {
+						if input.Code == nil {
+							t.Errorf("expected changed code to be sent")
+						}
+						return awssynthetics.UpdateCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.UpdateCanaryOutput{}},
+						}
+					},
+				},
+				cr: canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: "old"})),
+			},
+			want: want{
+				cr: canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+		},
+		"Start": {
+			args: args{
+				client: &fake.MockCanaryClient{
+					MockGetCanary: getCanary(awssynthetics.CanaryStateReady),
+					MockStartCanary: func(input *awssynthetics.StartCanaryInput) awssynthetics.StartCanaryRequest {
+						return awssynthetics.StartCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.StartCanaryOutput{}},
+						}
+					},
+				},
+				cr: canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+			want: want{
+				cr: canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+		},
+		"Stop": {
+			args: args{
+				client: &fake.MockCanaryClient{
+					MockGetCanary: getCanary(awssynthetics.CanaryStateRunning),
+					MockStopCanary: func(input *awssynthetics.StopCanaryInput) awssynthetics.StopCanaryRequest {
+						return awssynthetics.StopCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: canary(withRunning(false), withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+			},
+			want: want{
+				cr:  canary(withRunning(false), withObservation(v1alpha1.CanaryObservation{CodeChecksum: checksum})),
+				err: errors.Wrap(errBoom, errStop),
+			},
+		},
+		"FailedUpdateRequest": {
+			args: args{
+				client: &fake.MockCanaryClient{
+					MockGetCanary: getCanary(awssynthetics.CanaryStateRunning),
+					MockUpdateCanary: func(input *awssynthetics.UpdateCanaryInput) awssynthetics.UpdateCanaryRequest {
+						return awssynthetics.UpdateCanaryRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: "old"})),
+			},
+			want: want{
+				cr:  canary(withObservation(v1alpha1.CanaryObservation{CodeChecksum: "old"})),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Canary
+		err error
+	}
+
+	stop := func(err error) func(*awssynthetics.StopCanaryInput) awssynthetics.StopCanaryRequest {
+		return func(*awssynthetics.StopCanaryInput) awssynthetics.StopCanaryRequest {
+			return awssynthetics.StopCanaryRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.StopCanaryOutput{}, Error: err},
+			}
+		}
+	}
+	del := func(err error) func(*awssynthetics.DeleteCanaryInput) awssynthetics.DeleteCanaryRequest {
+		return func(*awssynthetics.DeleteCanaryInput) awssynthetics.DeleteCanaryRequest {
+			return awssynthetics.DeleteCanaryRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssynthetics.DeleteCanaryOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCanaryClient{MockStopCanary: stop(nil), MockDeleteCanary: del(nil)},
+				cr:     canary(),
+			},
+			want: want{
+				cr: canary(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotRunning": {
+			args: args{
+				client: &fake.MockCanaryClient{MockStopCanary: stop(errConflict), MockDeleteCanary: del(nil)},
+				cr:     canary(),
+			},
+			want: want{
+				cr: canary(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockCanaryClient{MockStopCanary: stop(errNotFound), MockDeleteCanary: del(errNotFound)},
+				cr:     canary(),
+			},
+			want: want{
+				cr: canary(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedStop": {
+			args: args{
+				client: &fake.MockCanaryClient{MockStopCanary: stop(errBoom)},
+				cr:     canary(),
+			},
+			want: want{
+				cr:  canary(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errStop),
+			},
+		},
+		"FailedDelete": {
+			args: args{
+				client: &fake.MockCanaryClient{MockStopCanary: stop(nil), MockDeleteCanary: del(errBoom)},
+				cr:     canary(),
+			},
+			want: want{
+				cr:  canary(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -42,6 +42,11 @@ func RetrieveAwsConfigFromProvider(ctx context.Context, client client.Reader, pr
 		return nil, errors.Wrapf(err, "cannot get provider %s", n)
 	}
 
+	ctx, err := awsclients.WithProvider(ctx, client, p)
+	if err != nil {
+		return nil, err
+	}
+
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
 		cfg, err := awsclients.UsePodServiceAccount(ctx, []byte{}, awsclients.DefaultSection, p.Spec.Region)
 		return cfg, errors.Wrap(err, "cannot create new AWS configuration using IAM roles for ServiceAccount")
@@ -53,8 +58,7 @@ func RetrieveAwsConfigFromProvider(ctx context.Context, client client.Reader, pr
 
 	secret := &corev1.Secret{}
 	n = types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
-	err := client.Get(ctx, n, secret)
-	if err != nil {
+	if err := client.Get(ctx, n, secret); err != nil {
 		return nil, errors.Wrapf(err, "cannot get provider secret %s", n)
 	}
 
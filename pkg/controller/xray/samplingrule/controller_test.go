@@ -0,0 +1,660 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samplingrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsxray "github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
+	"github.com/crossplane/provider-aws/apis/xray/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/xray"
+	"github.com/crossplane/provider-aws/pkg/clients/xray/fake"
+)
+
+const (
+	providerName         = "aws-creds"
+	testRegion           = "us-east-1"
+	secretNamespace      = "crossplane-system"
+	connectionSecretName = "my-little-secret"
+	secretKey            = "credentials"
+	credData             = "confidential!"
+)
+
+var (
+	ruleName = "some-rule"
+	ruleARN  = "arn:aws:xray:us-east-1:123456789012:sampling-rule/some-rule"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	xray xray.SamplingRuleClient
+	kube client.Client
+	cr   *v1alpha1.SamplingRule
+}
+
+type ruleModifier func(*v1alpha1.SamplingRule)
+
+func withConditions(c ...runtimev1alpha1.Condition) ruleModifier {
+	return func(r *v1alpha1.SamplingRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withVersion(v int64) ruleModifier {
+	return func(r *v1alpha1.SamplingRule) { r.Spec.ForProvider.Version = aws.Int64(v) }
+}
+
+func withFixedRate(s string) ruleModifier {
+	return func(r *v1alpha1.SamplingRule) { r.Spec.ForProvider.FixedRate = s }
+}
+
+func withRuleARN(s string) ruleModifier {
+	return func(r *v1alpha1.SamplingRule) { r.Status.AtProvider.RuleARN = s }
+}
+
+func samplingRule(m ...ruleModifier) *v1alpha1.SamplingRule {
+	cr := &v1alpha1.SamplingRule{
+		Spec: v1alpha1.SamplingRuleSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: runtimev1alpha1.Reference{Name: providerName},
+			},
+			ForProvider: v1alpha1.SamplingRuleParameters{
+				FixedRate:     "0.05",
+				HTTPMethod:    "*",
+				Host:          "*",
+				Priority:      100,
+				ReservoirSize: 1,
+				ResourceARN:   "*",
+				ServiceName:   "*",
+				ServiceType:   "*",
+				URLPath:       "*",
+			},
+		},
+	}
+	meta.SetExternalName(cr, ruleName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func record() awsxray.SamplingRuleRecord {
+	return awsxray.SamplingRuleRecord{
+		SamplingRule: &awsxray.SamplingRule{
+			FixedRate:     aws.Float64(0.05),
+			HTTPMethod:    aws.String("*"),
+			Host:          aws.String("*"),
+			Priority:      aws.Int64(100),
+			ReservoirSize: aws.Int64(1),
+			ResourceARN:   aws.String("*"),
+			RuleARN:       aws.String(ruleARN),
+			RuleName:      aws.String(ruleName),
+			ServiceName:   aws.String("*"),
+			ServiceType:   aws.String("*"),
+			URLPath:       aws.String("*"),
+			Version:       aws.Int64(1),
+		},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestConnect(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(credData),
+		},
+	}
+
+	providerSA := func(saVal bool) awsv1alpha3.Provider {
+		return awsv1alpha3.Provider{
+			Spec: awsv1alpha3.ProviderSpec{
+				Region:            testRegion,
+				UseServiceAccount: &saVal,
+				ProviderSpec: runtimev1alpha1.ProviderSpec{
+					CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+						SecretReference: runtimev1alpha1.SecretReference{
+							Namespace: secretNamespace,
+							Name:      connectionSecretName,
+						},
+						Key: secretKey,
+					},
+				},
+			},
+		}
+	}
+	type args struct {
+		kube        client.Client
+		newClientFn func(ctx context.Context, credentials []byte, region string, auth awsclients.AuthMethod) (xray.SamplingRuleClient, error)
+		cr          *v1alpha1.SamplingRule
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							secret.DeepCopyInto(obj.(*corev1.Secret))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i xray.SamplingRuleClient, e error) {
+					if diff := cmp.Diff(credData, string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: samplingRule(),
+			},
+		},
+		"SuccessfulUseServiceAccount": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						if key == (client.ObjectKey{Name: providerName}) {
+							p := providerSA(true)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						}
+						return errBoom
+					},
+				},
+				newClientFn: func(_ context.Context, credentials []byte, region string, _ awsclients.AuthMethod) (i xray.SamplingRuleClient, e error) {
+					if diff := cmp.Diff("", string(credentials)); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(testRegion, region); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					return nil, nil
+				},
+				cr: samplingRule(),
+			},
+		},
+		"ProviderGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						return errBoom
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderSecret),
+			},
+		},
+		"SecretGetFailedNil": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						switch key {
+						case client.ObjectKey{Name: providerName}:
+							p := providerSA(false)
+							p.SetCredentialsSecretReference(nil)
+							p.DeepCopyInto(obj.(*awsv1alpha3.Provider))
+							return nil
+						case client.ObjectKey{Namespace: secretNamespace, Name: connectionSecretName}:
+							return errBoom
+						default:
+							return nil
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				err: errors.New(errGetProviderSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newClientFn: tc.newClientFn}
+			_, err := c.Connect(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SamplingRule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockGetSamplingRules: func(input *awsxray.GetSamplingRulesInput) awsxray.GetSamplingRulesRequest {
+						return awsxray.GetSamplingRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.GetSamplingRulesOutput{
+								SamplingRuleRecords: []awsxray.SamplingRuleRecord{record()},
+							}},
+						}
+					},
+				},
+				cr: samplingRule(withVersion(1)),
+			},
+			want: want{
+				cr: samplingRule(
+					withVersion(1),
+					withRuleARN(ruleARN),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockGetSamplingRules: func(input *awsxray.GetSamplingRulesInput) awsxray.GetSamplingRulesRequest {
+						return awsxray.GetSamplingRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.GetSamplingRulesOutput{
+								SamplingRuleRecords: []awsxray.SamplingRuleRecord{record()},
+							}},
+						}
+					},
+				},
+				cr: samplingRule(withVersion(1), withFixedRate("0.5")),
+			},
+			want: want{
+				cr: samplingRule(
+					withVersion(1),
+					withFixedRate("0.5"),
+					withRuleARN(ruleARN),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockGetSamplingRules: func(input *awsxray.GetSamplingRulesInput) awsxray.GetSamplingRulesRequest {
+						return awsxray.GetSamplingRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.GetSamplingRulesOutput{}},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockGetSamplingRules: func(input *awsxray.GetSamplingRulesInput) awsxray.GetSamplingRulesRequest {
+						return awsxray.GetSamplingRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr:  samplingRule(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				xray: &fake.MockSamplingRuleClient{
+					MockGetSamplingRules: func(input *awsxray.GetSamplingRulesInput) awsxray.GetSamplingRulesRequest {
+						return awsxray.GetSamplingRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.GetSamplingRulesOutput{
+								SamplingRuleRecords: []awsxray.SamplingRuleRecord{record()},
+							}},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(
+					withVersion(1),
+					withRuleARN(ruleARN),
+					withConditions(runtimev1alpha1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitFailedKubeUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				xray: &fake.MockSamplingRuleClient{
+					MockGetSamplingRules: func(input *awsxray.GetSamplingRulesInput) awsxray.GetSamplingRulesRequest {
+						return awsxray.GetSamplingRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.GetSamplingRulesOutput{
+								SamplingRuleRecords: []awsxray.SamplingRuleRecord{record()},
+							}},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr:  samplingRule(withVersion(1)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SamplingRule
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockCreateSamplingRule: func(input *awsxray.CreateSamplingRuleInput) awsxray.CreateSamplingRuleRequest {
+						return awsxray.CreateSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.CreateSamplingRuleOutput{}},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InvalidFixedRate": {
+			args: args{
+				cr: samplingRule(withFixedRate("lots")),
+			},
+			want: want{
+				cr:  samplingRule(withFixedRate("lots"), withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errors.New("fixedRate must be a decimal number between 0 and 1"), errCreate),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockCreateSamplingRule: func(input *awsxray.CreateSamplingRuleInput) awsxray.CreateSamplingRuleRequest {
+						return awsxray.CreateSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr:  samplingRule(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SamplingRule
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockUpdateSamplingRule: func(input *awsxray.UpdateSamplingRuleInput) awsxray.UpdateSamplingRuleRequest {
+						return awsxray.UpdateSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.UpdateSamplingRuleOutput{}},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockUpdateSamplingRule: func(input *awsxray.UpdateSamplingRuleInput) awsxray.UpdateSamplingRuleRequest {
+						return awsxray.UpdateSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr:  samplingRule(),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SamplingRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockDeleteSamplingRule: func(input *awsxray.DeleteSamplingRuleInput) awsxray.DeleteSamplingRuleRequest {
+						return awsxray.DeleteSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsxray.DeleteSamplingRuleOutput{}},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockDeleteSamplingRule: func(input *awsxray.DeleteSamplingRuleInput) awsxray.DeleteSamplingRuleRequest {
+						return awsxray.DeleteSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsxray.ErrCodeInvalidRequestException, "", nil)},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr: samplingRule(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				xray: &fake.MockSamplingRuleClient{
+					MockDeleteSamplingRule: func(input *awsxray.DeleteSamplingRuleInput) awsxray.DeleteSamplingRuleRequest {
+						return awsxray.DeleteSamplingRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: samplingRule(),
+			},
+			want: want{
+				cr:  samplingRule(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.xray}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package e2e exercises managed resource controllers against the AWS API, or
+// against LocalStack standing in for it. Unlike the unit tests, whose fake
+// clients answer immediately and in a single page, these tests catch bugs that
+// only show with real API behavior, such as paginated listings and resources
+// that take a while to become visible after they were created.
+//
+// The harness and the tests built on it are guarded by the e2e build tag. Run
+// them with make test-e2e, which starts LocalStack, or point them at an AWS
+// account using the environment variables documented on NewHarness:
+//
+//	E2E_AWS_CREDENTIALS=~/.aws/credentials go test -tags e2e ./pkg/controller/...
+package e2e
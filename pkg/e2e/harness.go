@@ -0,0 +1,233 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-aws/apis"
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/utils"
+)
+
+// Environment variables that configure the harness.
+const (
+	// EnvEndpoint is the URL of an AWS compatible HTTPS endpoint, such as
+	// the edge port of LocalStack, that serves every AWS API.
+	EnvEndpoint = "E2E_AWS_ENDPOINT"
+
+	// EnvCredentials is the path of an AWS credentials file whose default
+	// profile is used to authenticate.
+	EnvCredentials = "E2E_AWS_CREDENTIALS"
+
+	// EnvRegion is the AWS region resources are created in.
+	EnvRegion = "E2E_AWS_REGION"
+
+	// EnvTimeout is how long the harness waits for the AWS API to reflect a
+	// change, in a format accepted by time.ParseDuration.
+	EnvTimeout = "E2E_TIMEOUT"
+)
+
+// Defaults of the harness.
+const (
+	DefaultRegion   = "us-east-1"
+	DefaultTimeout  = 5 * time.Minute
+	DefaultInterval = 5 * time.Second
+
+	// LocalStack accepts any credentials.
+	localStackCredentials = "[default]\naws_access_key_id = test\naws_secret_access_key = test\n"
+)
+
+const (
+	providerName    = "e2e"
+	secretNamespace = "crossplane-system"
+	secretName      = "e2e-aws-credentials"
+	secretKey       = "credentials"
+)
+
+// A Harness runs managed resource controllers against the AWS API. It holds
+// a fake Kubernetes API server that serves the Provider and credentials the
+// controllers connect with, and the managed resources under test.
+type Harness struct {
+	// Kube is the Kubernetes client controllers under test should use.
+	Kube client.Client
+
+	// Provider is the name of the Provider managed resources should refer
+	// to.
+	Provider string
+
+	// Timeout is how long the harness waits for the AWS API to reflect a
+	// change before it fails the test.
+	Timeout time.Duration
+
+	// Interval is how long the harness waits between observations.
+	Interval time.Duration
+
+	ctx context.Context
+	run string
+}
+
+// NewHarness returns a harness configured by the environment. It skips the
+// test unless either EnvEndpoint or EnvCredentials is set, so that the e2e
+// tests never fall back to whatever AWS credentials happen to be at hand.
+//
+// When EnvEndpoint is set all requests are sent to that endpoint rather than
+// to AWS, authenticated with the credentials of EnvCredentials if it is set
+// and with LocalStack's test credentials otherwise.
+func NewHarness(t *testing.T) *Harness {
+	t.Helper()
+
+	endpoint, path := os.Getenv(EnvEndpoint), os.Getenv(EnvCredentials)
+	if endpoint == "" && path == "" {
+		t.Skipf("neither %s nor %s is set", EnvEndpoint, EnvCredentials)
+	}
+
+	creds := []byte(localStackCredentials)
+	if path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("cannot read %s: %v", EnvCredentials, err)
+		}
+		creds = b
+	}
+
+	region := os.Getenv(EnvRegion)
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	timeout := DefaultTimeout
+	if s := os.Getenv(EnvTimeout); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			t.Fatalf("cannot parse %s: %v", EnvTimeout, err)
+		}
+		timeout = d
+	}
+
+	ctx := context.Background()
+	if endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			t.Fatalf("cannot parse %s: %v", EnvEndpoint, err)
+		}
+		ctx = awsclients.WithTransportOptions(ctx, WithEndpoint(u), awsclients.WithInsecureSkipVerify())
+	}
+
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatalf("cannot build scheme: %v", err)
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("cannot build scheme: %v", err)
+	}
+
+	p := &v1alpha3.Provider{
+		ObjectMeta: metav1.ObjectMeta{Name: providerName},
+		Spec: v1alpha3.ProviderSpec{
+			ProviderSpec: runtimev1alpha1.ProviderSpec{
+				CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+					SecretReference: runtimev1alpha1.SecretReference{Namespace: secretNamespace, Name: secretName},
+					Key:             secretKey,
+				},
+			},
+			Region: region,
+		},
+	}
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: secretNamespace, Name: secretName},
+		Data:       map[string][]byte{secretKey: creds},
+	}
+
+	return &Harness{
+		Kube:     fake.NewFakeClientWithScheme(s, p, sec),
+		Provider: providerName,
+		Timeout:  timeout,
+		Interval: DefaultInterval,
+		ctx:      ctx,
+		run:      strconv.FormatInt(time.Now().Unix(), 36),
+	}
+}
+
+// Context returns the context controllers under test should be called with.
+// It carries the transport options that send requests to EnvEndpoint.
+func (h *Harness) Context() context.Context {
+	return h.ctx
+}
+
+// Name returns a name for a resource that is unique to this run of the tests,
+// so that runs against the same account do not interfere with each other.
+func (h *Harness) Name(prefix string) string {
+	return fmt.Sprintf("e2e-%s-%s", prefix, h.run)
+}
+
+// ProviderReference returns a reference to the Provider of the harness.
+func (h *Harness) ProviderReference() runtimev1alpha1.Reference {
+	return runtimev1alpha1.Reference{Name: h.Provider}
+}
+
+// Config returns an AWS configuration that uses the Provider of the harness.
+// Tests use it to manage the resources a resource under test depends on
+// directly, rather than through the controllers of those resources.
+func (h *Harness) Config(t *testing.T) *aws.Config {
+	t.Helper()
+
+	cfg, err := utils.RetrieveAwsConfigFromProvider(h.ctx, h.Kube, h.ProviderReference())
+	if err != nil {
+		t.Fatalf("cannot create AWS configuration: %v", err)
+	}
+	return cfg
+}
+
+// WithEndpoint makes the transport connect to the supplied endpoint whatever
+// host a request is addressed to. AWS compatible endpoints like LocalStack
+// tell services apart by the signature of a request rather than by its host.
+func WithEndpoint(u *url.URL) awsclients.TransportOption {
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), "443")
+	}
+	d := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	return func(t *http.Transport) {
+		t.Proxy = nil
+		t.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return d.DialContext(ctx, network, addr)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A Lifecycle describes how to exercise the controller of a kind of managed
+// resource.
+type Lifecycle struct {
+	// Name is the prefix of the names of the resources the lifecycle
+	// creates.
+	Name string
+
+	// Connecter connects to the external resource. It is usually the
+	// connector of the controller under test, using the Kube client of the
+	// harness.
+	Connecter managed.ExternalConnecter
+
+	// Initializers run when a resource is created, like the initializers of
+	// the managed reconciler. Controllers that do not configure their
+	// initializers must include managed.NewNameAsExternalName.
+	Initializers []managed.Initializer
+
+	// Resource returns the desired managed resource with the supplied name.
+	// Its external resource must not exist yet.
+	Resource func(name string) resource.Managed
+
+	// Update changes the desired state of the supplied managed resource.
+	// Lifecycles without it do not exercise updates.
+	Update func(mg resource.Managed)
+
+	// Siblings is the number of additional resources created before the one
+	// under test, so that the controller has to tell it apart from similar
+	// resources and page through listings to find it.
+	Siblings int
+}
+
+// Run exercises the lifecycle of a managed resource: it checks that the
+// resource does not exist, creates it, waits until it is observed to exist
+// and be up to date, updates it, waits until the update is observed, deletes
+// it and waits until it is observed to be gone. While it waits it calls
+// the controller like the managed reconciler would, retrying errors until the
+// timeout of the harness expires, so that the AWS API may take its time to
+// reflect a change.
+func (h *Harness) Run(t *testing.T, l Lifecycle) {
+	t.Helper()
+
+	for i := 0; i < l.Siblings; i++ {
+		s := h.Create(t, l, h.Name(fmt.Sprintf("%s-%d", l.Name, i)))
+		defer h.Delete(t, l, s)
+	}
+
+	mg := l.Resource(h.Name(l.Name))
+	h.initialize(t, l, mg)
+
+	o, err := h.observe(l, mg)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if o.ResourceExists {
+		t.Fatalf("Observe(...): external resource of %s exists before it was created", mg.GetName())
+	}
+
+	h.create(t, l, mg)
+	defer func() {
+		if !meta.WasDeleted(mg) {
+			h.Delete(t, l, mg)
+		}
+	}()
+
+	if l.Update != nil {
+		l.Update(mg)
+		if err := h.Kube.Update(h.ctx, mg); err != nil {
+			t.Fatalf("cannot update %s: %v", mg.GetName(), err)
+		}
+		if err := h.converge(l, mg); err != nil {
+			t.Fatalf("%s was not updated: %v", mg.GetName(), err)
+		}
+	}
+
+	h.Delete(t, l, mg)
+}
+
+// Create creates the managed resource with the supplied name and waits until
+// it is observed to exist and be up to date. Tests use it to create resources
+// the resource under test depends on, and must delete them when done.
+func (h *Harness) Create(t *testing.T, l Lifecycle, name string) resource.Managed {
+	t.Helper()
+
+	mg := l.Resource(name)
+	h.initialize(t, l, mg)
+	h.create(t, l, mg)
+	return mg
+}
+
+// Delete deletes the supplied managed resource and waits until it is
+// observed to be gone.
+func (h *Harness) Delete(t *testing.T, l Lifecycle, mg resource.Managed) {
+	t.Helper()
+
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+
+	var last error
+	err := wait.PollImmediate(h.Interval, h.Timeout, func() (bool, error) {
+		o, err := h.observe(l, mg)
+		if err != nil {
+			last = err
+			return false, nil
+		}
+		if !o.ResourceExists {
+			return true, nil
+		}
+		last = errors.New("external resource still exists")
+		ext, err := l.Connecter.Connect(h.ctx, mg)
+		if err != nil {
+			last = errors.Wrap(err, "Connect(...)")
+			return false, nil
+		}
+		if err := ext.Delete(h.ctx, mg); err != nil {
+			last = errors.Wrap(err, "Delete(...)")
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Errorf("%s was not deleted: %v", mg.GetName(), lastOr(last, err))
+	}
+}
+
+func (h *Harness) initialize(t *testing.T, l Lifecycle, mg resource.Managed) {
+	t.Helper()
+
+	if err := h.Kube.Create(h.ctx, mg); err != nil {
+		t.Fatalf("cannot create %s: %v", mg.GetName(), err)
+	}
+	for _, i := range l.Initializers {
+		if err := i.Initialize(h.ctx, mg); err != nil {
+			t.Fatalf("Initialize(...): %v", err)
+		}
+	}
+}
+
+func (h *Harness) create(t *testing.T, l Lifecycle, mg resource.Managed) {
+	t.Helper()
+
+	ext, err := l.Connecter.Connect(h.ctx, mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	if _, err := ext.Create(h.ctx, mg); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+	if err := h.converge(l, mg); err != nil {
+		t.Fatalf("%s was not created: %v", mg.GetName(), err)
+	}
+}
+
+// converge reconciles the supplied managed resource until it is observed to
+// exist and be up to date.
+func (h *Harness) converge(l Lifecycle, mg resource.Managed) error {
+	var last error
+	err := wait.PollImmediate(h.Interval, h.Timeout, func() (bool, error) {
+		ext, err := l.Connecter.Connect(h.ctx, mg)
+		if err != nil {
+			last = errors.Wrap(err, "Connect(...)")
+			return false, nil
+		}
+		o, err := ext.Observe(h.ctx, mg)
+		switch {
+		case err != nil:
+			last = errors.Wrap(err, "Observe(...)")
+			return false, nil
+		case !o.ResourceExists:
+			last = errors.New("external resource does not exist")
+			return false, nil
+		case o.ResourceUpToDate:
+			return true, nil
+		}
+		last = errors.New("external resource is not up to date")
+		if _, err := ext.Update(h.ctx, mg); err != nil {
+			last = errors.Wrap(err, "Update(...)")
+		}
+		return false, nil
+	})
+	return lastOr(last, err)
+}
+
+func (h *Harness) observe(l Lifecycle, mg resource.Managed) (managed.ExternalObservation, error) {
+	ext, err := l.Connecter.Connect(h.ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "Connect(...)")
+	}
+	return ext.Observe(h.ctx, mg)
+}
+
+// lastOr returns the last error a poll saw, if any, because it tells more
+// about why the poll timed out than the timeout itself.
+func lastOr(last, err error) error {
+	if err != nil && last != nil {
+		return errors.Wrap(last, err.Error())
+	}
+	return err
+}
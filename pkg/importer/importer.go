@@ -0,0 +1,307 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package importer generates managed resources for the existing resources of
+// an AWS account, so that they can be adopted by Crossplane.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iamv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errList           = "cannot list %s resources"
+	errUnknownKind    = "unknown kind %q, supported kinds are %s"
+	errToUnstructured = "cannot convert managed resource to unstructured"
+	errMarshal        = "cannot marshal managed resource"
+	errWrite          = "cannot write managed resource"
+	errUnescapePolicy = "cannot unescape assume role policy document of role %s"
+
+	maxNameLength = 253
+	nameTagKey    = "Name"
+)
+
+var invalidName = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// A Lister returns a managed resource for every external resource of one kind
+// that exists in the account and region of the supplied configuration. The
+// external name of each managed resource is set, and its parameters are
+// initialized from the external resource.
+type Lister func(ctx context.Context, cfg aws.Config) ([]resource.Managed, error)
+
+// Listers of every kind of managed resource that can be imported.
+var Listers = map[string]Lister{
+	v1beta1.VPCKind: func(ctx context.Context, cfg aws.Config) ([]resource.Managed, error) {
+		return ListVPCs(ctx, awsec2.New(cfg))
+	},
+	v1beta1.SubnetKind: func(ctx context.Context, cfg aws.Config) ([]resource.Managed, error) {
+		return ListSubnets(ctx, awsec2.New(cfg))
+	},
+	v1beta1.SecurityGroupKind: func(ctx context.Context, cfg aws.Config) ([]resource.Managed, error) {
+		return ListSecurityGroups(ctx, awsec2.New(cfg))
+	},
+	v1beta1.InternetGatewayKind: func(ctx context.Context, cfg aws.Config) ([]resource.Managed, error) {
+		return ListInternetGateways(ctx, awsec2.New(cfg))
+	},
+	iamv1beta1.IAMRoleKind: func(ctx context.Context, cfg aws.Config) ([]resource.Managed, error) {
+		return ListIAMRoles(ctx, awsiam.New(cfg))
+	},
+	iamv1alpha1.IAMUserKind: func(ctx context.Context, cfg aws.Config) ([]resource.Managed, error) {
+		return ListIAMUsers(ctx, awsiam.New(cfg))
+	},
+}
+
+// Kinds returns the kinds of managed resources that can be imported.
+func Kinds() []string {
+	kinds := make([]string, 0, len(Listers))
+	for k := range Listers {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// Options that apply to every imported managed resource.
+type Options struct {
+	// ProviderName is the name of the Provider the managed resources use.
+	ProviderName string
+
+	// ReclaimPolicy of the managed resources.
+	ReclaimPolicy runtimev1alpha1.ReclaimPolicy
+}
+
+// Import returns a managed resource for every external resource of the
+// supplied kinds. Managed resources are named after their external resources,
+// with a numeric suffix where the names of different external resources of the
+// same kind would otherwise collide.
+func Import(ctx context.Context, cfg aws.Config, kinds []string, o Options) ([]resource.Managed, error) {
+	all := []resource.Managed{}
+	for _, k := range kinds {
+		list, ok := Listers[k]
+		if !ok {
+			return nil, errors.Errorf(errUnknownKind, k, strings.Join(Kinds(), ", "))
+		}
+		mgs, err := list(ctx, cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, errList, k)
+		}
+		names := map[string]int{}
+		for _, mg := range mgs {
+			names[mg.GetName()]++
+			if n := names[mg.GetName()]; n > 1 {
+				mg.SetName(fmt.Sprintf("%s-%d", mg.GetName(), n))
+			}
+			mg.SetProviderReference(runtimev1alpha1.Reference{Name: o.ProviderName})
+			mg.SetReclaimPolicy(o.ReclaimPolicy)
+		}
+		all = append(all, mgs...)
+	}
+	return all, nil
+}
+
+// Write the supplied managed resources to the supplied writer as a stream of
+// YAML documents. Their status and fields that are set by the API server are
+// omitted.
+func Write(w io.Writer, mgs []resource.Managed) error {
+	for _, mg := range mgs {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+		if err != nil {
+			return errors.Wrap(err, errToUnstructured)
+		}
+		delete(u, "status")
+		unstructured.RemoveNestedField(u, "metadata", "creationTimestamp")
+
+		b, err := yaml.Marshal(u)
+		if err != nil {
+			return errors.Wrap(err, errMarshal)
+		}
+		if _, err := io.WriteString(w, "---\n"+string(b)); err != nil {
+			return errors.Wrap(err, errWrite)
+		}
+	}
+	return nil
+}
+
+// Name returns a valid Kubernetes object name derived from the supplied name
+// of an external resource.
+func Name(external string) string {
+	n := invalidName.ReplaceAllString(strings.ToLower(external), "-")
+	if len(n) > maxNameLength {
+		n = n[:maxNameLength]
+	}
+	n = strings.Trim(n, ".-")
+	if n == "" {
+		return "imported"
+	}
+	return n
+}
+
+// nameOf returns the name of an EC2 resource, which is the value of its Name
+// tag if it has one, and its ID otherwise.
+func nameOf(id *string, tags []awsec2.Tag) string {
+	for _, t := range tags {
+		if aws.StringValue(t.Key) == nameTagKey && aws.StringValue(t.Value) != "" {
+			return Name(aws.StringValue(t.Value))
+		}
+	}
+	return Name(aws.StringValue(id))
+}
+
+// ListVPCs returns a VPC for every VPC in the account and region of the
+// supplied client.
+func ListVPCs(ctx context.Context, c ec2.VPCClient) ([]resource.Managed, error) {
+	mgs := []resource.Managed{}
+	p := awsec2.NewDescribeVpcsPaginator(c.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{}))
+	for p.Next(ctx) {
+		for i := range p.CurrentPage().Vpcs {
+			v := p.CurrentPage().Vpcs[i]
+			cr := &v1beta1.VPC{}
+			cr.SetGroupVersionKind(v1beta1.VPCGroupVersionKind)
+			cr.SetName(nameOf(v.VpcId, v.Tags))
+			meta.SetExternalName(cr, aws.StringValue(v.VpcId))
+			ec2.LateInitializeVPC(&cr.Spec.ForProvider, &v)
+			cr.Spec.ForProvider.Tags = v1beta1.BuildFromEC2Tags(v.Tags)
+			mgs = append(mgs, cr)
+		}
+	}
+	return mgs, p.Err()
+}
+
+// ListSubnets returns a Subnet for every subnet in the account and region of
+// the supplied client.
+func ListSubnets(ctx context.Context, c ec2.SubnetClient) ([]resource.Managed, error) {
+	mgs := []resource.Managed{}
+	p := awsec2.NewDescribeSubnetsPaginator(c.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{}))
+	for p.Next(ctx) {
+		for i := range p.CurrentPage().Subnets {
+			s := p.CurrentPage().Subnets[i]
+			cr := &v1beta1.Subnet{}
+			cr.SetGroupVersionKind(v1beta1.SubnetGroupVersionKind)
+			cr.SetName(nameOf(s.SubnetId, s.Tags))
+			meta.SetExternalName(cr, aws.StringValue(s.SubnetId))
+			ec2.LateInitializeSubnet(&cr.Spec.ForProvider, &s)
+			mgs = append(mgs, cr)
+		}
+	}
+	return mgs, p.Err()
+}
+
+// ListSecurityGroups returns a SecurityGroup for every security group in the
+// account and region of the supplied client.
+func ListSecurityGroups(ctx context.Context, c ec2.SecurityGroupClient) ([]resource.Managed, error) {
+	mgs := []resource.Managed{}
+	p := awsec2.NewDescribeSecurityGroupsPaginator(c.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{}))
+	for p.Next(ctx) {
+		for i := range p.CurrentPage().SecurityGroups {
+			sg := p.CurrentPage().SecurityGroups[i]
+			cr := &v1beta1.SecurityGroup{}
+			cr.SetGroupVersionKind(v1beta1.SecurityGroupGroupVersionKind)
+			cr.SetName(nameOf(sg.GroupId, sg.Tags))
+			meta.SetExternalName(cr, aws.StringValue(sg.GroupId))
+			ec2.LateInitializeSG(&cr.Spec.ForProvider, &sg)
+			mgs = append(mgs, cr)
+		}
+	}
+	return mgs, p.Err()
+}
+
+// ListInternetGateways returns an InternetGateway for every internet gateway
+// in the account and region of the supplied client.
+func ListInternetGateways(ctx context.Context, c ec2.InternetGatewayClient) ([]resource.Managed, error) {
+	mgs := []resource.Managed{}
+	p := awsec2.NewDescribeInternetGatewaysPaginator(c.DescribeInternetGatewaysRequest(&awsec2.DescribeInternetGatewaysInput{}))
+	for p.Next(ctx) {
+		for i := range p.CurrentPage().InternetGateways {
+			ig := p.CurrentPage().InternetGateways[i]
+			cr := &v1beta1.InternetGateway{}
+			cr.SetGroupVersionKind(v1beta1.InternetGatewayGroupVersionKind)
+			cr.SetName(nameOf(ig.InternetGatewayId, ig.Tags))
+			meta.SetExternalName(cr, aws.StringValue(ig.InternetGatewayId))
+			ec2.LateInitializeIG(&cr.Spec.ForProvider, &ig)
+			mgs = append(mgs, cr)
+		}
+	}
+	return mgs, p.Err()
+}
+
+// ListIAMRoles returns an IAMRole for every IAM role in the account of the
+// supplied client.
+func ListIAMRoles(ctx context.Context, c iam.RoleClient) ([]resource.Managed, error) {
+	mgs := []resource.Managed{}
+	p := awsiam.NewListRolesPaginator(c.ListRolesRequest(&awsiam.ListRolesInput{}))
+	for p.Next(ctx) {
+		for i := range p.CurrentPage().Roles {
+			r := p.CurrentPage().Roles[i]
+			// IAM returns policy documents URL encoded.
+			doc, err := url.QueryUnescape(aws.StringValue(r.AssumeRolePolicyDocument))
+			if err != nil {
+				return nil, errors.Wrapf(err, errUnescapePolicy, aws.StringValue(r.RoleName))
+			}
+			r.AssumeRolePolicyDocument = aws.String(doc)
+
+			cr := &iamv1beta1.IAMRole{}
+			cr.SetGroupVersionKind(iamv1beta1.IAMRoleGroupVersionKind)
+			cr.SetName(Name(aws.StringValue(r.RoleName)))
+			meta.SetExternalName(cr, aws.StringValue(r.RoleName))
+			iam.LateInitializeRole(&cr.Spec.ForProvider, &r)
+			mgs = append(mgs, cr)
+		}
+	}
+	return mgs, p.Err()
+}
+
+// ListIAMUsers returns an IAMUser for every IAM user in the account of the
+// supplied client.
+func ListIAMUsers(ctx context.Context, c iam.UserClient) ([]resource.Managed, error) {
+	mgs := []resource.Managed{}
+	p := awsiam.NewListUsersPaginator(c.ListUsersRequest(&awsiam.ListUsersInput{}))
+	for p.Next(ctx) {
+		for i := range p.CurrentPage().Users {
+			u := p.CurrentPage().Users[i]
+			cr := &iamv1alpha1.IAMUser{}
+			cr.SetGroupVersionKind(iamv1alpha1.IAMUserGroupVersionKind)
+			cr.SetName(Name(aws.StringValue(u.UserName)))
+			meta.SetExternalName(cr, aws.StringValue(u.UserName))
+			iam.LateInitializeUser(&cr.Spec.ForProvider, &u)
+			mgs = append(mgs, cr)
+		}
+	}
+	return mgs, p.Err()
+}
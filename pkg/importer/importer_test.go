@@ -0,0 +1,223 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	ec2fake "github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+	iamfake "github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var errBoom = errors.New("boom")
+
+func TestName(t *testing.T) {
+	cases := map[string]struct {
+		external string
+		want     string
+	}{
+		"Valid":     {external: "vpc-123", want: "vpc-123"},
+		"Uppercase": {external: "MyRole", want: "myrole"},
+		"Invalid":   {external: "service role+for@lambda", want: "service-role-for-lambda"},
+		"Trimmed":   {external: "_internal_", want: "internal"},
+		"Empty":     {external: "+++", want: "imported"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Name(tc.external)); diff != "" {
+				t.Errorf("Name(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListVPCs(t *testing.T) {
+	type want struct {
+		mgs []resource.Managed
+		err error
+	}
+
+	vpc := func(name, id string) *v1beta1.VPC {
+		cr := &v1beta1.VPC{}
+		cr.SetGroupVersionKind(v1beta1.VPCGroupVersionKind)
+		cr.SetName(name)
+		meta.SetExternalName(cr, id)
+		cr.Spec.ForProvider.CIDRBlock = "10.0.0.0/16"
+		cr.Spec.ForProvider.InstanceTenancy = aws.String("default")
+		return cr
+	}
+
+	cases := map[string]struct {
+		vpcs []awsec2.Vpc
+		err  error
+		want want
+	}{
+		"Listed": {
+			vpcs: []awsec2.Vpc{
+				{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("10.0.0.0/16"), InstanceTenancy: awsec2.TenancyDefault},
+				{VpcId: aws.String("vpc-2"), CidrBlock: aws.String("10.0.0.0/16"), InstanceTenancy: awsec2.TenancyDefault,
+					Tags: []awsec2.Tag{{Key: aws.String("Name"), Value: aws.String("Production")}}},
+			},
+			want: want{
+				mgs: []resource.Managed{
+					vpc("vpc-1", "vpc-1"),
+					func() resource.Managed {
+						cr := vpc("production", "vpc-2")
+						cr.Spec.ForProvider.Tags = []v1beta1.Tag{{Key: "Name", Value: "Production"}}
+						return cr
+					}(),
+				},
+			},
+		},
+		"Error": {
+			err: errBoom,
+			want: want{
+				mgs: []resource.Managed{},
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var describe func(*awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest
+			describe = func(*awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+				return awsec2.DescribeVpcsRequest{
+					Request: &aws.Request{Operation: &aws.Operation{Name: "DescribeVpcs"}, HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: tc.err, Data: &awsec2.DescribeVpcsOutput{Vpcs: tc.vpcs}},
+					Copy:    describe,
+				}
+			}
+			c := &ec2fake.MockVPCClient{MockDescribe: describe}
+			mgs, err := ListVPCs(context.Background(), c)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ListVPCs(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mgs, mgs); diff != "" {
+				t.Errorf("ListVPCs(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListIAMRoles(t *testing.T) {
+	var list func(*awsiam.ListRolesInput) awsiam.ListRolesRequest
+	list = func(*awsiam.ListRolesInput) awsiam.ListRolesRequest {
+		return awsiam.ListRolesRequest{
+			Request: &aws.Request{Operation: &aws.Operation{Name: "ListRoles"}, HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListRolesOutput{Roles: []awsiam.Role{{
+				RoleName:                 aws.String("Lambda_Exec"),
+				Path:                     aws.String("/"),
+				AssumeRolePolicyDocument: aws.String("%7B%22Version%22%3A%222012-10-17%22%7D"),
+			}}}},
+			Copy: list,
+		}
+	}
+	c := &iamfake.MockRoleClient{MockListRolesRequest: list}
+
+	want := &iamv1beta1.IAMRole{}
+	want.SetGroupVersionKind(iamv1beta1.IAMRoleGroupVersionKind)
+	want.SetName("lambda-exec")
+	meta.SetExternalName(want, "Lambda_Exec")
+	want.Spec.ForProvider.AssumeRolePolicyDocument = `{"Version":"2012-10-17"}`
+	want.Spec.ForProvider.Path = aws.String("/")
+
+	mgs, err := ListIAMRoles(context.Background(), c)
+	if err != nil {
+		t.Fatalf("ListIAMRoles(...): unexpected error %v", err)
+	}
+	if diff := cmp.Diff([]resource.Managed{want}, mgs); diff != "" {
+		t.Errorf("ListIAMRoles(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestImport(t *testing.T) {
+	Listers["Test"] = func(_ context.Context, _ aws.Config) ([]resource.Managed, error) {
+		return []resource.Managed{
+			&v1beta1.VPC{ObjectMeta: metav1.ObjectMeta{Name: "main"}},
+			&v1beta1.VPC{ObjectMeta: metav1.ObjectMeta{Name: "main"}},
+		}, nil
+	}
+	defer delete(Listers, "Test")
+
+	mgs, err := Import(context.Background(), aws.Config{}, []string{"Test"}, Options{ProviderName: "example", ReclaimPolicy: runtimev1alpha1.ReclaimRetain})
+	if err != nil {
+		t.Fatalf("Import(...): unexpected error %v", err)
+	}
+	got := []string{}
+	for _, mg := range mgs {
+		if mg.GetProviderReference().Name != "example" || mg.GetReclaimPolicy() != runtimev1alpha1.ReclaimRetain {
+			t.Errorf("Import(...): %s does not use the supplied options", mg.GetName())
+		}
+		got = append(got, mg.GetName())
+	}
+	if diff := cmp.Diff([]string{"main", "main-2"}, got); diff != "" {
+		t.Errorf("Import(...): -want names, +got names:\n%s", diff)
+	}
+
+	if _, err := Import(context.Background(), aws.Config{}, []string{"Unknown"}, Options{}); err == nil {
+		t.Errorf("Import(...): want error for an unknown kind")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	cr := &v1beta1.VPC{}
+	cr.SetGroupVersionKind(v1beta1.VPCGroupVersionKind)
+	cr.SetName("main")
+	meta.SetExternalName(cr, "vpc-1")
+	cr.Spec.ForProvider.CIDRBlock = "10.0.0.0/16"
+	cr.SetProviderReference(runtimev1alpha1.Reference{Name: "example"})
+	cr.SetReclaimPolicy(runtimev1alpha1.ReclaimRetain)
+
+	b := &bytes.Buffer{}
+	if err := Write(b, []resource.Managed{cr}); err != nil {
+		t.Fatalf("Write(...): unexpected error %v", err)
+	}
+	want := `---
+apiVersion: ec2.aws.crossplane.io/v1beta1
+kind: VPC
+metadata:
+  annotations:
+    crossplane.io/external-name: vpc-1
+  name: main
+spec:
+  forProvider:
+    cidrBlock: 10.0.0.0/16
+  providerRef:
+    name: example
+  reclaimPolicy: Retain
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("Write(...): -want, +got:\n%s", diff)
+	}
+}